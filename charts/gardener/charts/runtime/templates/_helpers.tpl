@@ -1,3 +1,14 @@
+{{- define "gardener-apiserver.watchCacheSizes" }}
+{{- if .Values.global.apiserver.watchCacheSizes }}
+{{- if .Values.global.apiserver.watchCacheSizes.default }}
+- --default-watch-cache-size={{ .Values.global.apiserver.watchCacheSizes.default }}
+{{- end }}
+{{- if .Values.global.apiserver.watchCacheSizes.resources }}
+- --watch-cache-sizes={{ range .Values.global.apiserver.watchCacheSizes.resources }}{{ .resource }}{{ if .apiGroup }}.{{ .apiGroup }}{{ end }}#{{ .size }},{{ end }}
+{{- end }}
+{{- end }}
+{{- end -}}
+
 {{- define "gardener-apiserver.featureGates" }}
 {{- if .Values.global.apiserver.featureGates }}
 - --feature-gates={{ range $feature, $enabled := .Values.global.apiserver.featureGates }}{{ $feature }}={{ $enabled }},{{ end }}
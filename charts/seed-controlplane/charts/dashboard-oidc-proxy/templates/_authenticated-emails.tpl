@@ -0,0 +1,9 @@
+{{- define "dashboard-oidc-proxy.authenticated-emails" -}}
+# Users which are granted access to the dashboards of Shoot {{ .Values.shoot.name }} in project {{ .Values.shoot.project }}.
+{{- range .Values.members }}
+{{- if .email }}
+# {{ .role }}
+{{ .email }}
+{{- end }}
+{{- end }}
+{{- end -}}
@@ -90,3 +90,23 @@ rules:
 - level: None
 {{- end -}}
 {{- end -}}
+
+{{- define "kube-apiserver.authenticationWebhookKubeconfig" -}}
+apiVersion: v1
+kind: Config
+clusters:
+- name: authentication-webhook
+  cluster:
+    server: {{ required ".authenticationWebhook.url is required" .Values.authenticationWebhook.url }}
+    {{- if .Values.authenticationWebhook.caBundle }}
+    certificate-authority-data: {{ .Values.authenticationWebhook.caBundle | b64enc }}
+    {{- end }}
+users:
+- name: kube-apiserver
+contexts:
+- name: authentication-webhook
+  context:
+    cluster: authentication-webhook
+    user: kube-apiserver
+current-context: authentication-webhook
+{{- end -}}
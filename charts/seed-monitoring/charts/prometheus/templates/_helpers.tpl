@@ -56,3 +56,9 @@ key_file: /etc/prometheus/seed/prometheus.key
 {{- end }}
 {{- end -}}
 
+{{- define "prometheus.scrape-target" -}}
+{{- $values := index . 0 -}}
+{{- if or (not $values.scrapeTargets) (has (index . 1) $values.scrapeTargets) -}}
+true
+{{- end -}}
+{{- end -}}
@@ -0,0 +1,48 @@
+{{- define "mount-kubelet-data-volume" -}}
+- path: /opt/bin/mount-kubelet-data-volume
+  permissions: 0755
+  content:
+    inline:
+      encoding: ""
+      data: |
+        #!/bin/bash
+        set -o errexit
+        set -o nounset
+        set -o pipefail
+
+        KUBELET_DIR="/var/lib/kubelet"
+        LABEL="kubelet-data"
+        SIZE="{{ required ".worker.kubeletDataVolume.size is required" .Values.worker.kubeletDataVolume.size }}"
+
+        if mountpoint -q "$KUBELET_DIR"; then
+          echo "$KUBELET_DIR is already mounted"
+          exit 0
+        fi
+
+        if ! blkid -L "$LABEL" >/dev/null; then
+          DEVICE=""
+          while read -r name size type; do
+            if [[ "$type" != "disk" ]] || [[ "$size" != "$SIZE" ]]; then
+              continue
+            fi
+            # Skip disks which are partitioned, formatted or mounted already.
+            if [[ "$(lsblk -npo NAME "$name" | wc -l)" -ne 1 ]] || [[ -n "$(blkid -o value -s TYPE "$name" || true)" ]]; then
+              continue
+            fi
+            DEVICE="$name"
+            break
+          done < <(lsblk -dbnpo NAME,SIZE,TYPE)
+
+          if [[ -z "$DEVICE" ]]; then
+            echo "Could not find an unused disk of size $SIZE bytes for the kubelet data volume"
+            exit 1
+          fi
+
+          echo "Formatting $DEVICE as kubelet data volume"
+          mkfs.ext4 -L "$LABEL" "$DEVICE"
+        fi
+
+        mkdir -p "$KUBELET_DIR"
+        mount "LABEL=$LABEL" "$KUBELET_DIR"
+        echo "Mounted kubelet data volume to $KUBELET_DIR"
+{{- end -}}
@@ -16,6 +16,9 @@ resource "alicloud_vpc" "vpc" {
   name       = "{{ required "clusterName is required" .Values.clusterName }}-vpc"
   cidr_block = "{{ required "vpc.cidr is required" .Values.vpc.cidr }}"
 }
+{{- end }}
+{{- if .Values.create.natGateway }}
+
 resource "alicloud_nat_gateway" "nat_gateway" {
   vpc_id = "{{ required "vpc.id is required" .Values.vpc.id }}"
   spec   = "Small"
@@ -25,40 +28,25 @@ resource "alicloud_nat_gateway" "nat_gateway" {
 
 
 // Loop zones
+// The resources of a zone are defined in a module of the zone, see _modules.tf, hence they are independent of the
+// resources of the other zones.
 {{ range $index, $zone := .Values.zones }}
 
-resource "alicloud_vswitch" "vsw_z{{ $index }}" {
-  name              = "{{ required "clusterName is required" $.Values.clusterName }}-{{ required "zone.name is required" $zone.name }}-vsw"
-  vpc_id            = "{{ required "vpc.id is required" $.Values.vpc.id }}"
-  cidr_block        = "{{ required "zone.cidr.worker is required" $zone.cidr.worker }}"
-  availability_zone = "{{ required "zone.name is required" $zone.name }}"
-}
-
-// Create a new EIP.
-resource "alicloud_eip" "eip_natgw_z{{ $index }}" {
-  name                 = "{{ required "clusterName is required" $.Values.clusterName }}-eip-natgw-z{{ $index }}"
-  bandwidth            = "100"
-  instance_charge_type = "PostPaid"
-  internet_charge_type = "{{ required "vpc.internetChargeType is required" $.Values.vpc.internetChargeType }}"
-}
-
-resource "alicloud_eip_association" "eip_natgw_asso_z{{ $index }}" {
-  allocation_id = "${alicloud_eip.eip_natgw_z{{ $index }}.id}"
-  instance_id   = "{{ required "natGatewayID is required" $.Values.vpc.natGatewayID }}"
-}
+module "zone_z{{ $index }}" {
+  source = "./modules/zone_z{{ $index }}"
 
-resource "alicloud_snat_entry" "snat_z{{ $index }}" {
-  snat_table_id     = "{{ required "snatTableID is required" $.Values.vpc.snatTableID }}"
-  source_vswitch_id = "${alicloud_vswitch.vsw_z{{ $index }}.id}"
-  snat_ip           = "${alicloud_eip.eip_natgw_z{{ $index }}.ip_address}"
+  vpc_id = "{{ required "vpc.id is required" $.Values.vpc.id }}"
+  {{- if not $zone.natGateway.create }}
+  nat_gateway_id = "{{ required "zone.natGateway.id is required" $zone.natGateway.id }}"
+  snat_table_id  = "{{ required "zone.natGateway.snatTableID is required" $zone.natGateway.snatTableID }}"
+  {{- end }}
 }
 
 // Output
 output "vswitch_id_z{{ $index }}" {
-  value = "${alicloud_vswitch.vsw_z{{ $index }}.id}"
+  value = "${module.zone_z{{ $index }}.vswitch_id}"
 }
- 
-{{end}}
+{{ end }}
 // End of loop zones
 
 resource "alicloud_security_group" "sg" {
@@ -96,6 +84,16 @@ resource "alicloud_security_group_rule" "allow_all_internal_udp_in" {
   cidr_ip           = "{{ required "pod is required" .Values.vpc.cidr }}"
 }
  
+// We have introduced new output variables. However, they are not applied for
+// existing clusters as Terraform won't detect a diff when we run `terraform plan`.
+// Workaround: Providing a null-resource for letting Terraform think that there are
+// differences, enabling the Gardener to start an actual `terraform apply` job.
+resource "null_resource" "outputs" {
+  triggers = {
+    recompute = "egress-ips"
+  }
+}
+
 //=====================================================================
 //= Output variables
 //=====================================================================
@@ -111,4 +109,8 @@ output "vpc_id" {
 output "key_pair_name" {
   value = "${alicloud_key_pair.publickey.key_name}"
 }
+
+output "egress_ips" {
+  value = "{{ range $index, $zone := .Values.zones }}{{ if $index }},{{ end }}${module.zone_z{{ $index }}.egress_ip}{{ end }}"
+}
 {{- end -}}
\ No newline at end of file
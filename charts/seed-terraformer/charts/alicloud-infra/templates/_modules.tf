@@ -0,0 +1,92 @@
+{{- define "alicloud-infra.modules" -}}
+{{- range $index, $zone := .Values.zones }}
+module.zone_z{{ $index }}.tf: |-
+{{ include "alicloud-infra.zone" (dict "Values" $.Values "index" $index "zone" $zone) | indent 2 }}
+{{- end }}
+{{- end -}}
+
+{{- define "alicloud-infra.zone" -}}
+{{- $index := .index -}}
+{{- $zone := .zone -}}
+variable "vpc_id" {}
+{{- if not $zone.natGateway.create }}
+
+variable "nat_gateway_id" {}
+
+variable "snat_table_id" {}
+{{- end }}
+
+resource "alicloud_vswitch" "vsw" {
+  name              = "{{ required "clusterName is required" .Values.clusterName }}-{{ required "zone.name is required" $zone.name }}-vsw"
+  vpc_id            = "${var.vpc_id}"
+  cidr_block        = "{{ required "zone.cidr.worker is required" $zone.cidr.worker }}"
+  availability_zone = "{{ required "zone.name is required" $zone.name }}"
+}
+{{- if $zone.natGateway.create }}
+
+// Create an enhanced NAT gateway and a route table for the zone.
+resource "alicloud_nat_gateway" "nat_gateway" {
+  vpc_id     = "${var.vpc_id}"
+  name       = "{{ required "clusterName is required" .Values.clusterName }}-natgw-z{{ $index }}"
+  nat_type   = "Enhanced"
+  vswitch_id = "${alicloud_vswitch.vsw.id}"
+}
+
+resource "alicloud_route_table" "rt" {
+  vpc_id = "${var.vpc_id}"
+  name   = "{{ required "clusterName is required" .Values.clusterName }}-rt-z{{ $index }}"
+}
+
+resource "alicloud_route_table_attachment" "rt_asso" {
+  vswitch_id     = "${alicloud_vswitch.vsw.id}"
+  route_table_id = "${alicloud_route_table.rt.id}"
+}
+
+resource "alicloud_route_entry" "natgw_route" {
+  route_table_id        = "${alicloud_route_table.rt.id}"
+  destination_cidrblock = "0.0.0.0/0"
+  nexthop_type          = "NatGateway"
+  nexthop_id            = "${alicloud_nat_gateway.nat_gateway.id}"
+}
+{{- end }}
+
+// Create a new EIP.
+resource "alicloud_eip" "eip_natgw" {
+  name                 = "{{ required "clusterName is required" .Values.clusterName }}-eip-natgw-z{{ $index }}"
+  bandwidth            = "{{ required "zone.eip.bandwidth is required" $zone.eip.bandwidth }}"
+  instance_charge_type = "PostPaid"
+  internet_charge_type = "{{ required "zone.eip.internetChargeType is required" $zone.eip.internetChargeType }}"
+
+  // Changing the internet charge type would recreate the EIP and thus change the egress IP of the zone.
+  lifecycle {
+    ignore_changes = ["internet_charge_type"]
+  }
+}
+
+resource "alicloud_eip_association" "eip_natgw_asso" {
+  allocation_id = "${alicloud_eip.eip_natgw.id}"
+  {{- if $zone.natGateway.create }}
+  instance_id   = "${alicloud_nat_gateway.nat_gateway.id}"
+  {{- else }}
+  instance_id   = "${var.nat_gateway_id}"
+  {{- end }}
+}
+
+resource "alicloud_snat_entry" "snat" {
+  {{- if $zone.natGateway.create }}
+  snat_table_id     = "${alicloud_nat_gateway.nat_gateway.snat_table_ids}"
+  {{- else }}
+  snat_table_id     = "${var.snat_table_id}"
+  {{- end }}
+  source_vswitch_id = "${alicloud_vswitch.vsw.id}"
+  snat_ip           = "${alicloud_eip.eip_natgw.ip_address}"
+}
+
+output "vswitch_id" {
+  value = "${alicloud_vswitch.vsw.id}"
+}
+
+output "egress_ip" {
+  value = "${alicloud_eip.eip_natgw.ip_address}"
+}
+{{- end -}}
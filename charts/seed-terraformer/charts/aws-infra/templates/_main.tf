@@ -129,6 +129,15 @@ resource "aws_security_group_rule" "nodes_egress_all" {
 }
 
 {{ range $index, $zone := .Values.zones }}
+{{ if $zone.existingSubnets -}}
+output "subnet_nodes_z{{ $index }}" {
+  value = "{{ required "zone.existingSubnets.worker is required" $zone.existingSubnets.worker }}"
+}
+
+output "subnet_public_utility_z{{ $index }}" {
+  value = "{{ required "zone.existingSubnets.public is required" $zone.existingSubnets.public }}"
+}
+{{- else -}}
 resource "aws_subnet" "nodes_z{{ $index }}" {
   vpc_id            = "{{ required "vpc.id is required" $.Values.vpc.id }}"
   cidr_block        = "{{ required "zone.cidr.worker is required" $zone.cidr.worker }}"
@@ -150,8 +159,12 @@ resource "aws_subnet" "private_utility_z{{ $index }}" {
     Name = "{{ required "clusterName is required" $.Values.clusterName }}-private-utility-z{{ $index }}"
     "kubernetes.io/cluster/{{ required "clusterName is required" $.Values.clusterName }}"  = "1"
     "kubernetes.io/role/internal-elb" = "use"
+{{- range $key, $value := $.Values.additionalTags }}
+    {{ $key | quote }} = {{ $value | quote }}
+{{- end }}
   }
 }
+{{- end }}
 
 resource "aws_security_group_rule" "nodes_tcp_internal_z{{ $index }}" {
   type              = "ingress"
@@ -171,6 +184,7 @@ resource "aws_security_group_rule" "nodes_udp_internal_z{{ $index }}" {
   security_group_id = "${aws_security_group.nodes.id}"
 }
 
+{{ if not $zone.existingSubnets -}}
 resource "aws_subnet" "public_utility_z{{ $index }}" {
   vpc_id            = "{{ required "vpc.id is required" $.Values.vpc.id }}"
   cidr_block        = "{{ required "zone.cidr.public is required" $zone.cidr.public }}"
@@ -180,12 +194,16 @@ resource "aws_subnet" "public_utility_z{{ $index }}" {
     Name = "{{ required "clusterName is required" $.Values.clusterName }}-public-utility-z{{ $index }}"
     "kubernetes.io/cluster/{{ required "clusterName is required" $.Values.clusterName }}"  = "1"
     "kubernetes.io/role/elb" = "use"
+{{- range $key, $value := $.Values.additionalTags }}
+    {{ $key | quote }} = {{ $value | quote }}
+{{- end }}
   }
 }
 
 output "subnet_public_utility_z{{ $index }}" {
   value = "${aws_subnet.public_utility_z{{ $index }}.id}"
 }
+{{- end }}
 
 resource "aws_security_group_rule" "nodes_tcp_public_z{{ $index }}" {
   type              = "ingress"
@@ -205,12 +223,17 @@ resource "aws_security_group_rule" "nodes_udp_public_z{{ $index }}" {
   security_group_id = "${aws_security_group.nodes.id}"
 }
 
+{{ if not $zone.existingSubnets -}}
+{{ if $zone.natGateway.create -}}
 resource "aws_eip" "eip_natgw_z{{ $index }}" {
   vpc = true
 
   tags {
     Name = "{{ required "clusterName is required" $.Values.clusterName }}-eip-natgw-z{{ $index }}"
     "kubernetes.io/cluster/{{ required "clusterName is required" $.Values.clusterName }}"  = "1"
+{{- range $key, $value := $.Values.additionalTags }}
+    {{ $key | quote }} = {{ $value | quote }}
+{{- end }}
   }
 }
 
@@ -221,8 +244,16 @@ resource "aws_nat_gateway" "natgw_z{{ $index }}" {
   tags {
     Name = "{{ required "clusterName is required" $.Values.clusterName }}-natgw-z{{ $index }}"
     "kubernetes.io/cluster/{{ required "clusterName is required" $.Values.clusterName }}"  = "1"
+{{- range $key, $value := $.Values.additionalTags }}
+    {{ $key | quote }} = {{ $value | quote }}
+{{- end }}
   }
 }
+{{- else }}
+data "aws_nat_gateway" "natgw_z{{ $index }}" {
+  id = "{{ required "zone.natGateway.id is required" $zone.natGateway.id }}"
+}
+{{- end }}
 
 resource "aws_route_table" "routetable_private_utility_z{{ $index }}" {
   vpc_id = "{{ required "vpc.id is required" $.Values.vpc.id }}"
@@ -233,7 +264,7 @@ resource "aws_route_table" "routetable_private_utility_z{{ $index }}" {
 resource "aws_route" "private_utility_z{{ $index }}_nat" {
   route_table_id         = "${aws_route_table.routetable_private_utility_z{{ $index }}.id}"
   destination_cidr_block = "0.0.0.0/0"
-  nat_gateway_id         = "${aws_nat_gateway.natgw_z{{ $index }}.id}"
+  nat_gateway_id         = "{{ required "zone.natGateway.id is required" $zone.natGateway.id }}"
 }
 
 resource "aws_route_table_association" "routetable_private_utility_z{{ $index }}_association_private_utility_z{{ $index }}" {
@@ -250,6 +281,7 @@ resource "aws_route_table_association" "routetable_private_utility_z{{ $index }}
   subnet_id      = "${aws_subnet.nodes_z{{ $index }}.id}"
   route_table_id = "${aws_route_table.routetable_private_utility_z{{ $index }}.id}"
 }
+{{- end }}
 {{end}}
 
 //=====================================================================
@@ -374,6 +406,16 @@ resource "aws_key_pair" "kubernetes" {
   public_key = "{{ required "sshPublicKey is required" .Values.sshPublicKey }}"
 }
 
+// We have introduced new output variables. However, they are not applied for
+// existing clusters as Terraform won't detect a diff when we run `terraform plan`.
+// Workaround: Providing a null-resource for letting Terraform think that there are
+// differences, enabling the Gardener to start an actual `terraform apply` job.
+resource "null_resource" "outputs" {
+  triggers = {
+    recompute = "egress-ips"
+  }
+}
+
 //=====================================================================
 //= Output variables
 //=====================================================================
@@ -397,6 +439,10 @@ output "security_group_nodes" {
 output "nodes_role_arn" {
   value = "${aws_iam_role.nodes.arn}"
 }
+
+output "egress_ips" {
+  value = "{{ range $index, $zone := .Values.zones }}{{ if not $zone.existingSubnets }}{{ if $zone.natGateway.create }}${aws_eip.eip_natgw_z{{ $index }}.public_ip}{{ else }}${data.aws_nat_gateway.natgw_z{{ $index }}.public_ip}{{ end }},{{ end }}{{ end }}"
+}
 {{- end -}}
 
 
@@ -404,11 +450,18 @@ output "nodes_role_arn" {
 tags {
   Name = "{{ required "clusterName is required" .clusterName }}"
   "kubernetes.io/cluster/{{ required "clusterName is required" .clusterName }}" = "1"
+{{- include "aws-infra.additional-tags" . }}
 }
 {{- end -}}
 {{- define "aws-infra.tags-with-suffix" -}}
 tags {
   Name = "{{ required "clusterName is required" .clusterName }}-{{ required "suffix is required" .suffix }}"
   "kubernetes.io/cluster/{{ required "clusterName is required" .clusterName }}" = "1"
+{{- include "aws-infra.additional-tags" . }}
 }
 {{- end -}}
+{{- define "aws-infra.additional-tags" -}}
+{{- range $key, $value := .additionalTags }}
+  {{ $key | quote }} = {{ $value | quote }}
+{{- end }}
+{{- end -}}
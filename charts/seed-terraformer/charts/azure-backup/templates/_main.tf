@@ -2,8 +2,16 @@
 provider "azurerm" {
   subscription_id = "{{ required "azure.subscriptionID is required" .Values.azure.subscriptionID }}"
   tenant_id       = "{{ required "azure.tenantID is required" .Values.azure.tenantID }}"
+  {{- if .Values.azure.useManagedIdentity }}
+  use_msi         = true
+  {{- else if .Values.azure.useClientCertificate }}
+  client_id                   = "${var.CLIENT_ID}"
+  client_certificate_path     = "/tfvars/client-certificate.pfx"
+  client_certificate_password = "${var.CLIENT_CERTIFICATE_PASSWORD}"
+  {{- else }}
   client_id       = "${var.CLIENT_ID}"
   client_secret   = "${var.CLIENT_SECRET}"
+  {{- end }}
 }
 
 resource "azurerm_resource_group" "rg" {
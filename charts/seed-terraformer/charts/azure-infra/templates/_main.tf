@@ -2,14 +2,21 @@
 provider "azurerm" {
   subscription_id = "{{ required "azure.subscriptionID is required" .Values.azure.subscriptionID }}"
   tenant_id       = "{{ required "azure.tenantID is required" .Values.azure.tenantID }}"
+  {{- if .Values.azure.useClientCertificate }}
+  client_id                   = "${var.CLIENT_ID}"
+  client_certificate_path     = "/tfvars/client-certificate.pfx"
+  client_certificate_password = "${var.CLIENT_CERTIFICATE_PASSWORD}"
+  {{- else }}
   client_id       = "${var.CLIENT_ID}"
   client_secret   = "${var.CLIENT_SECRET}"
+  {{- end }}
 }
 
 {{ if .Values.create.resourceGroup -}}
 resource "azurerm_resource_group" "rg" {
   name     = "{{ required "resourceGroup.name is required" .Values.resourceGroup.name }}"
   location = "{{ required "azure.region is required" .Values.azure.region }}"
+{{- include "azure-infra.tags" .Values }}
 }
 {{- end}}
 
@@ -23,6 +30,7 @@ resource "azurerm_virtual_network" "vnet" {
   resource_group_name = "{{ required "resourceGroup.name is required" .Values.resourceGroup.name }}"
   location            = "{{ required "azure.region is required" .Values.azure.region }}"
   address_space       = ["{{ required "resourceGroup.vnet.cidr is required" .Values.resourceGroup.vnet.cidr }}"]
+{{- include "azure-infra.tags" .Values }}
 }
 {{- end}}
 
@@ -35,16 +43,34 @@ resource "azurerm_subnet" "workers" {
   network_security_group_id = "${azurerm_network_security_group.workers.id}"
 }
 
+{{ if .Values.peering -}}
+resource "azurerm_virtual_network_peering" "peering" {
+  name                         = "{{ required "clusterName is required" .Values.clusterName }}-peering"
+  resource_group_name          = "{{ required "resourceGroup.name is required" .Values.resourceGroup.name }}"
+  virtual_network_name         = "{{ required "resourceGroup.vnet.name is required" .Values.resourceGroup.vnet.name }}"
+  remote_virtual_network_id    = "{{ required "peering.remoteVNetID is required" .Values.peering.remoteVNetID }}"
+  allow_virtual_network_access = true
+  allow_forwarded_traffic      = {{ .Values.peering.allowForwardedTraffic | default false }}
+  use_remote_gateways          = {{ .Values.peering.useRemoteGateways | default false }}
+{{- if .Values.create.vnet }}
+
+  depends_on = ["azurerm_virtual_network.vnet"]
+{{- end }}
+}
+{{- end }}
+
 resource "azurerm_route_table" "workers" {
   name                = "worker_route_table"
   location            = "{{ required "azure.region is required" .Values.azure.region }}"
   resource_group_name = "{{ required "resourceGroup.name is required" .Values.resourceGroup.name }}"
+{{- include "azure-infra.tags" .Values }}
 }
 
 resource "azurerm_network_security_group" "workers" {
   name                = "{{ required "clusterName is required" .Values.clusterName }}-workers"
   location            = "{{ required "azure.region is required" .Values.azure.region }}"
   resource_group_name = "{{ required "resourceGroup.name is required" .Values.resourceGroup.name }}"
+{{- include "azure-infra.tags" .Values }}
 }
 
 #=====================================================================
@@ -58,6 +84,7 @@ resource "azurerm_availability_set" "workers" {
   platform_update_domain_count = "{{ required "azure.countUpdateDomains is required" .Values.azure.countUpdateDomains }}"
   platform_fault_domain_count  = "{{ required "azure.countFaultDomains is required" .Values.azure.countFaultDomains }}"
   managed                      = true
+{{- include "azure-infra.tags" .Values }}
 }
 
 //=====================================================================
@@ -92,3 +119,12 @@ output "securityGroupName" {
   value = "${azurerm_network_security_group.workers.name}"
 }
 {{- end -}}
+{{- define "azure-infra.tags" -}}
+{{- if .additionalTags }}
+  tags = {
+{{- range $key, $value := .additionalTags }}
+    {{ $key | quote }} = {{ $value | quote }}
+{{- end }}
+  }
+{{- end }}
+{{- end -}}
@@ -7,5 +7,12 @@ variable "CLIENT_ID" {
 variable "CLIENT_SECRET" {
   description = "Azure client secret of technical user"
   type        = "string"
+  default     = ""
+}
+
+variable "CLIENT_CERTIFICATE_PASSWORD" {
+  description = "Password of the Azure client certificate of technical user"
+  type        = "string"
+  default     = ""
 }
 {{- end -}}
@@ -13,6 +13,49 @@ resource "google_service_account" "serviceaccount" {
   account_id   = "{{ required "clusterName is required" .Values.clusterName }}"
   display_name = "{{ required "clusterName is required" .Values.clusterName }}"
 }
+{{- if .Values.machineControllerServiceAccount.enabled }}
+
+// The machine-controller-manager only needs to manage instances which run as the service account above. Its key is
+// created and rotated by Gardener outside of Terraform, hence it is not part of the Terraform state.
+resource "google_service_account" "machine-controller" {
+  account_id   = "{{ required "machineControllerServiceAccount.accountID is required" .Values.machineControllerServiceAccount.accountID }}"
+  display_name = "{{ required "clusterName is required" .Values.clusterName }} machine-controller-manager"
+}
+
+// Deleted custom roles are only purged after some weeks, hence the id of the role must not be reused by another
+// Shoot. Terraform restores the role of this Shoot if it has been deleted before.
+resource "google_project_iam_custom_role" "machine-controller" {
+  role_id     = "{{ .Values.machineControllerServiceAccount.accountID | replace "-" "_" }}"
+  title       = "{{ .Values.clusterName }} machine-controller-manager"
+  permissions = [
+    "compute.disks.create",
+    "compute.disks.setLabels",
+    "compute.images.useReadOnly",
+    "compute.instances.create",
+    "compute.instances.delete",
+    "compute.instances.get",
+    "compute.instances.list",
+    "compute.instances.setLabels",
+    "compute.instances.setMetadata",
+    "compute.instances.setServiceAccount",
+    "compute.instances.setTags",
+    "compute.subnetworks.use",
+    "compute.subnetworks.useExternalIp",
+    "compute.zoneOperations.get",
+  ]
+}
+
+resource "google_project_iam_member" "machine-controller" {
+  role   = "projects/${google_project_iam_custom_role.machine-controller.project}/roles/${google_project_iam_custom_role.machine-controller.role_id}"
+  member = "serviceAccount:${google_service_account.machine-controller.email}"
+}
+
+resource "google_service_account_iam_member" "machine-controller-service-account-user" {
+  service_account_id = "${google_service_account.serviceaccount.name}"
+  role               = "roles/iam.serviceAccountUser"
+  member             = "serviceAccount:${google_service_account.machine-controller.email}"
+}
+{{- end }}
 
 //=====================================================================
 //= Networks
@@ -40,6 +83,49 @@ resource "google_compute_subnetwork" "subnetwork-internal" {
   region        = "{{ required "google.region is required" .Values.google.region }}"
 }
 {{- end}}
+{{- if .Values.create.cloudNAT }}
+
+resource "google_compute_router" "router" {
+  name    = "{{ required "clusterName is required" .Values.clusterName }}-cloud-router"
+  region  = "{{ required "google.region is required" .Values.google.region }}"
+  network = "{{ required "vpc.name is required" .Values.vpc.name }}"
+}
+{{- range $i, $name := .Values.cloudNAT.natIPNames }}
+
+data "google_compute_address" "nat-ip-{{ $i }}" {
+  name   = "{{ $name }}"
+  region = "{{ required "google.region is required" $.Values.google.region }}"
+}
+{{- end }}
+
+resource "google_compute_router_nat" "nat" {
+  name                               = "{{ required "clusterName is required" .Values.clusterName }}-cloud-nat"
+  router                             = "${google_compute_router.router.name}"
+  region                             = "{{ required "google.region is required" .Values.google.region }}"
+  {{- if .Values.cloudNAT.natIPNames }}
+  nat_ip_allocate_option             = "MANUAL_ONLY"
+  nat_ips                            = [{{ range $i, $name := .Values.cloudNAT.natIPNames }}{{ if $i }}, {{ end }}"${data.google_compute_address.nat-ip-{{ $i }}.self_link}"{{ end }}]
+  {{- else }}
+  nat_ip_allocate_option             = "AUTO_ONLY"
+  {{- end }}
+  source_subnetwork_ip_ranges_to_nat = "LIST_OF_SUBNETWORKS"
+  {{- if .Values.cloudNAT.minPortsPerVM }}
+  min_ports_per_vm                   = {{ .Values.cloudNAT.minPortsPerVM }}
+  {{- end }}
+
+  subnetwork {
+    name                    = "${google_compute_subnetwork.subnetwork-nodes.self_link}"
+    source_ip_ranges_to_nat = ["ALL_IP_RANGES"]
+  }
+  {{- if .Values.cloudNAT.logFilter }}
+
+  log_config {
+    enable = true
+    filter = "{{ .Values.cloudNAT.logFilter }}"
+  }
+  {{- end }}
+}
+{{- end }}
 //=====================================================================
 //= Firewall
 //=====================================================================
@@ -110,7 +196,7 @@ resource "google_compute_firewall" "rule-allow-health-checks" {
 // differences, enabling the Gardener to start an actual `terraform apply` job.
 resource "null_resource" "outputs" {
   triggers = {
-    recompute = "outputs"
+    recompute = "egress-ips"
   }
 }
 
@@ -129,6 +215,22 @@ output "service_account_email" {
 output "subnet_nodes" {
   value = "${google_compute_subnetwork.subnetwork-nodes.name}"
 }
+{{- if .Values.machineControllerServiceAccount.enabled }}
+
+output "machine_controller_service_account_email" {
+  value = "${google_service_account.machine-controller.email}"
+}
+{{- end }}
+{{- if .Values.create.cloudNAT }}
+{{- if .Values.cloudNAT.natIPNames }}
+
+// The worker machines are created without external IPs if the Cloud NAT is enabled, hence, their traffic leaves the
+// VPC with the static NAT IPs.
+output "egress_ips" {
+  value = "{{ range $i, $name := .Values.cloudNAT.natIPNames }}{{ if $i }},{{ end }}${data.google_compute_address.nat-ip-{{ $i }}.address}{{ end }}"
+}
+{{- end }}
+{{- end }}
 {{ if .Values.networks.internal -}}
 output "subnet_internal" {
   value = "${google_compute_subnetwork.subnetwork-internal.name}"
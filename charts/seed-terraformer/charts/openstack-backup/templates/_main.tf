@@ -1,11 +1,16 @@
 {{- define "openstack-backup.main" -}}
 provider "openstack" {
   auth_url    = "{{ required "openstack.authURL is required" .Values.openstack.authURL }}"
+  region      = "{{ required "openstack.region is required" .Values.openstack.region }}"
+  {{- if .Values.openstack.applicationCredentials }}
+  application_credential_id     = "${var.APPLICATION_CREDENTIAL_ID}"
+  application_credential_secret = "${var.APPLICATION_CREDENTIAL_SECRET}"
+  {{- else }}
   domain_name = "{{ required "openstack.domainName is required" .Values.openstack.domainName }}"
   tenant_name = "{{ required "openstack.tenantName is required" .Values.openstack.tenantName }}"
-  region      = "{{ required "openstack.region is required" .Values.openstack.region }}"
   user_name   = "${var.USER_NAME}"
   password    = "${var.PASSWORD}"
+  {{- end }}
   insecure    = true
 }
 
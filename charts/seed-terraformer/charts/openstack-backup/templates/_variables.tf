@@ -8,4 +8,16 @@ variable "PASSWORD" {
   description = "OpenStack password"
   type        = "string"
 }
+
+variable "APPLICATION_CREDENTIAL_ID" {
+  description = "OpenStack application credential id"
+  type        = "string"
+  default     = ""
+}
+
+variable "APPLICATION_CREDENTIAL_SECRET" {
+  description = "OpenStack application credential secret"
+  type        = "string"
+  default     = ""
+}
 {{- end -}}
@@ -1,11 +1,16 @@
 {{- define "openstack-infra.main" -}}
 provider "openstack" {
   auth_url    = "{{ required "openstack.authURL is required" .Values.openstack.authURL }}"
+  region      = "{{ required "openstack.region is required" .Values.openstack.region }}"
+  {{- if .Values.openstack.applicationCredentials }}
+  application_credential_id     = "${var.APPLICATION_CREDENTIAL_ID}"
+  application_credential_secret = "${var.APPLICATION_CREDENTIAL_SECRET}"
+  {{- else }}
   domain_name = "{{ required "openstack.domainName is required" .Values.openstack.domainName }}"
   tenant_name = "{{ required "openstack.tenantName is required" .Values.openstack.tenantName }}"
-  region      = "{{ required "openstack.region is required" .Values.openstack.region }}"
   user_name   = "${var.USER_NAME}"
   password    = "${var.PASSWORD}"
+  {{- end }}
   insecure    = true
 }
 
@@ -16,12 +21,25 @@ provider "openstack" {
 data "openstack_networking_network_v2" "fip" {
   name = "{{ required "openstack.floatingPoolName is required" .Values.openstack.floatingPoolName }}"
 }
+{{- if .Values.openstack.floatingPoolSubnetName }}
+
+data "openstack_networking_subnet_v2" "fip" {
+  name       = "{{ .Values.openstack.floatingPoolSubnetName }}"
+  network_id = "${data.openstack_networking_network_v2.fip.id}"
+}
+{{- end }}
 
 {{ if .Values.create.router -}}
 resource "openstack_networking_router_v2" "router" {
   name                = "{{ required "clusterName is required" .Values.clusterName }}"
   region              = "{{ required "openstack.region is required" .Values.openstack.region }}"
   external_network_id = "${data.openstack_networking_network_v2.fip.id}"
+  {{- if .Values.openstack.floatingPoolSubnetName }}
+
+  external_fixed_ip {
+    subnet_id = "${data.openstack_networking_subnet_v2.fip.id}"
+  }
+  {{- end }}
 }
 {{- end}}
 
@@ -123,6 +141,13 @@ output "floating_network_id" {
   value = "${data.openstack_networking_network_v2.fip.id}"
 }
 
+{{- if .Values.openstack.floatingPoolSubnetName }}
+
+output "floating_subnet_id" {
+  value = "${data.openstack_networking_subnet_v2.fip.id}"
+}
+{{- end }}
+
 output "subnet_id" {
   value = "${openstack_networking_subnet_v2.cluster.id}"
 }
@@ -7,8 +7,13 @@ DIR_CLOUDCONFIG="$DIR_CLOUDCONFIG_DOWNLOADER/downloads"
 
 PATH_CLOUDCONFIG_DOWNLOADER_SERVER="$DIR_CLOUDCONFIG_DOWNLOADER/credentials/server"
 PATH_CLOUDCONFIG_DOWNLOADER_CA_CERT="$DIR_CLOUDCONFIG_DOWNLOADER/credentials/ca.crt"
+PATH_CLOUDCONFIG_DOWNLOADER_CLIENT_CERT="$DIR_CLOUDCONFIG_DOWNLOADER/credentials/client.crt"
+PATH_CLOUDCONFIG_DOWNLOADER_CLIENT_KEY="$DIR_CLOUDCONFIG_DOWNLOADER/credentials/client.key"
 PATH_CLOUDCONFIG="{{ .configFilePath }}"
 PATH_CLOUDCONFIG_OLD="${PATH_CLOUDCONFIG}.old"
+PATH_CA_BUNDLE="$DIR_CLOUDCONFIG_DOWNLOADER/ca-bundle.crt"
+PATH_CA_BUNDLE_KUBELET="$DIR_CLOUDCONFIG_DOWNLOADER/ca-bundle-kubelet.crt"
+PATH_CA_BUNDLE_REBOOTSTRAP_TIME="$DIR_CLOUDCONFIG_DOWNLOADER/ca-bundle-rebootstrap-time"
 
 mkdir -p "$DIR_CLOUDCONFIG" "$DIR_KUBELET"
 
@@ -24,6 +29,16 @@ function docker-preload() {
   fi
 }
 
+function update-credential() {
+  path="$1"
+  if ! diff "$path.new" "$path" >/dev/null 2>&1; then
+    echo "Updating $path"
+    mv "$path.new" "$path"
+  else
+    rm -f "$path.new"
+  fi
+}
+
 {{ range $name, $image := (required ".images is required" .images) -}}
 docker-preload "{{ $name }}" "{{ $image }}"
 {{ end }}
@@ -36,6 +51,54 @@ if [ ! -f "$PATH_CLOUDCONFIG_OLD" ]; then
   touch "$PATH_CLOUDCONFIG_OLD"
 fi
 
+{{- if .downloader }}
+
+# The client certificate of the downloader is re-signed while the cluster CA is rotated. It is replaced as long as the
+# previous one is still accepted, otherwise the node could not download the cloud-config anymore.
+cat << 'EOF' | base64 -d > "$PATH_CLOUDCONFIG_DOWNLOADER_CLIENT_CERT.new"
+{{ required ".downloader.clientCert is required" .downloader.clientCert | b64enc }}
+EOF
+cat << 'EOF' | base64 -d > "$PATH_CLOUDCONFIG_DOWNLOADER_CLIENT_KEY.new"
+{{ required ".downloader.clientKey is required" .downloader.clientKey | b64enc }}
+EOF
+update-credential "$PATH_CLOUDCONFIG_DOWNLOADER_CLIENT_CERT"
+update-credential "$PATH_CLOUDCONFIG_DOWNLOADER_CLIENT_KEY"
+{{- end }}
+
+{{- if .caBundle }}
+
+# While the cluster CA is rotated, its bundle contains both the old and the new CA. The downloader trusts the bundle
+# right away so that it can still download the cloud-config once the kube-apiserver serves a certificate signed by the
+# new CA. The kubelet must be bootstrapped again to trust the new bundle and to receive a client certificate signed by
+# the new CA. To not restart all kubelets at the same time, every node waits for a random delay before it
+# re-bootstraps its kubelet.
+if [ ! -f "$PATH_CA_BUNDLE_KUBELET" ]; then
+  cp "$PATH_CLOUDCONFIG_DOWNLOADER_CA_CERT" "$PATH_CA_BUNDLE_KUBELET"
+fi
+
+cat << 'EOF' | base64 -d > "$PATH_CA_BUNDLE"
+{{ .caBundle | b64enc }}
+EOF
+cp "$PATH_CA_BUNDLE" "$PATH_CLOUDCONFIG_DOWNLOADER_CA_CERT.new"
+update-credential "$PATH_CLOUDCONFIG_DOWNLOADER_CA_CERT"
+
+KUBELET_REBOOTSTRAP=false
+if ! diff "$PATH_CA_BUNDLE" "$PATH_CA_BUNDLE_KUBELET" >/dev/null; then
+  if [ ! -f "$PATH_CA_BUNDLE_REBOOTSTRAP_TIME" ]; then
+    echo "Seen new cluster CA bundle, scheduling the re-bootstrap of the kubelet"
+    echo $(( $(date +%s) + RANDOM % {{ required ".caBundleRolloutMaxDelaySeconds is required" .caBundleRolloutMaxDelaySeconds }} )) > "$PATH_CA_BUNDLE_REBOOTSTRAP_TIME"
+  fi
+  if [ "$(date +%s)" -ge "$(cat "$PATH_CA_BUNDLE_REBOOTSTRAP_TIME")" ]; then
+    echo "Re-bootstrapping the kubelet with the new cluster CA bundle"
+    cp "$PATH_CA_BUNDLE" "$PATH_CA_BUNDLE_KUBELET"
+    rm -f "$DIR_KUBELET/kubeconfig-real" "$DIR_KUBELET"/pki/kubelet-client*
+    KUBELET_REBOOTSTRAP=true
+  fi
+else
+  rm -f "$PATH_CA_BUNDLE_REBOOTSTRAP_TIME"
+fi
+{{- end }}
+
 if [[ ! -f "$DIR_KUBELET/kubeconfig-real" ]]; then
   cat <<EOF > "$DIR_KUBELET/kubeconfig-bootstrap"
 ---
@@ -78,5 +141,14 @@ if ! diff "$PATH_CLOUDCONFIG" "$PATH_CLOUDCONFIG_OLD" >/dev/null; then
   fi
 fi
 
+{{- if .caBundle }}
+
+if [[ "$KUBELET_REBOOTSTRAP" == "true" ]]; then
+  systemctl restart kubelet.service
+  rm -f "$PATH_CA_BUNDLE_REBOOTSTRAP_TIME"
+  echo "Successfully restarted the kubelet to re-bootstrap it with the new cluster CA bundle."
+fi
+{{- end }}
+
 rm "$PATH_CLOUDCONFIG"
 {{- end}}
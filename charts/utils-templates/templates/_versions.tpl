@@ -47,11 +47,21 @@ apiregistration.k8s.io/v1
 {{- end -}}
 
 {{- define "networkpolicyversion" -}}
+{{- if and (include "capabilities.has" (list . "extensions/v1beta1/NetworkPolicy")) (not (include "capabilities.has" (list . "networking.k8s.io/v1/NetworkPolicy"))) -}}
+extensions/v1beta1
+{{- else -}}
 networking.k8s.io/v1
 {{- end -}}
+{{- end -}}
 
 {{- define "priorityclassversion" -}}
-{{- if semverCompare ">= 1.14-0" .Capabilities.KubeVersion.GitVersion -}}
+{{- if include "capabilities.has" (list . "scheduling.k8s.io/v1/PriorityClass") -}}
+scheduling.k8s.io/v1
+{{- else if include "capabilities.has" (list . "scheduling.k8s.io/v1beta1/PriorityClass") -}}
+scheduling.k8s.io/v1beta1
+{{- else if include "capabilities.has" (list . "scheduling.k8s.io/v1alpha1/PriorityClass") -}}
+scheduling.k8s.io/v1alpha1
+{{- else if semverCompare ">= 1.14-0" .Capabilities.KubeVersion.GitVersion -}}
 scheduling.k8s.io/v1
 {{- else if semverCompare ">= 1.11-0" .Capabilities.KubeVersion.GitVersion -}}
 scheduling.k8s.io/v1beta1
@@ -77,11 +87,19 @@ policy/v1beta1
 {{- end -}}
 
 {{- define "podsecuritypolicyversion" -}}
+{{- if and (include "capabilities.has" (list . "extensions/v1beta1/PodSecurityPolicy")) (not (include "capabilities.has" (list . "policy/v1beta1/PodSecurityPolicy"))) -}}
+extensions/v1beta1
+{{- else -}}
 policy/v1beta1
 {{- end -}}
+{{- end -}}
 
 {{- define "ingressversion" -}}
-{{- if semverCompare ">= 1.14-0" .Capabilities.KubeVersion.GitVersion -}}
+{{- if include "capabilities.has" (list . "networking.k8s.io/v1beta1/Ingress") -}}
+networking.k8s.io/v1beta1
+{{- else if include "capabilities.has" (list . "extensions/v1beta1/Ingress") -}}
+extensions/v1beta1
+{{- else if semverCompare ">= 1.14-0" .Capabilities.KubeVersion.GitVersion -}}
 networking.k8s.io/v1beta1
 {{- else -}}
 extensions/v1beta1
@@ -95,3 +113,32 @@ storage.k8s.io/v1
 storage.k8s.io/v1beta1
 {{- end -}}
 {{- end -}}
+
+{{- /*
+The capability helpers below return a non-empty string if the cluster serves the respective API. The API versions
+and kinds are discovered by the chart renderer; if they are unknown, the Kubernetes version decides.
+"capabilities.has" expects a list of the root context and an API version (optionally with kind), e.g.
+(list . "policy/v1beta1/PodSecurityPolicy"). It also works for contexts whose capabilities only contain the
+Kubernetes version, e.g. the Shoot API server capabilities passed to the kube-addon-manager.
+*/ -}}
+
+{{- define "capabilities.has" -}}
+{{- $root := index . 0 -}}
+{{- if $root.Capabilities.APIVersions -}}
+{{- if $root.Capabilities.APIVersions.Has (index . 1) -}}
+true
+{{- end -}}
+{{- end -}}
+{{- end -}}
+
+{{- define "priorityclassenabled" -}}
+{{- if include "capabilities.has" (list . "scheduling.k8s.io/v1/PriorityClass") -}}
+true
+{{- else if include "capabilities.has" (list . "scheduling.k8s.io/v1beta1/PriorityClass") -}}
+true
+{{- else if include "capabilities.has" (list . "scheduling.k8s.io/v1alpha1/PriorityClass") -}}
+true
+{{- else if semverCompare ">= 1.11-0" .Capabilities.KubeVersion.GitVersion -}}
+true
+{{- end -}}
+{{- end -}}
@@ -0,0 +1,255 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/operation/terraformer"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	k8s "k8s.io/client-go/kubernetes"
+)
+
+// lastAppliedConfigurationAnnotation is dropped from the bundled Shoot because it duplicates the spec.
+const lastAppliedConfigurationAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+func newBundleCommand(opts *Options) *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "bundle",
+		Short: "Export a diagnostic bundle of the targeted shoot for support tickets",
+		Long: `Bundle writes a gzipped tar archive with the Shoot manifest, its last operation, last error, and
+conditions, the events of the Shoot and of its seed namespace, a summary of the Terraform state of its
+infrastructure, and the status of its control plane pods. The bundle never contains secrets: It does not
+include Secrets, and the Terraform summary only lists resource addresses, resource IDs, and output names.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			k8sGardenClient, gardenClient, err := opts.gardenClients()
+			if err != nil {
+				return err
+			}
+
+			shoot, err := opts.targetedShoot(gardenClient)
+			if err != nil {
+				return err
+			}
+
+			var seedClient k8s.Interface
+			seedName, seedNamespace, err := controlPlaneLocation(shoot)
+			if err == nil {
+				if seedClient, err = opts.seedClient(k8sGardenClient, gardenClient, seedName); err != nil {
+					return err
+				}
+			}
+
+			files, err := collectBundle(k8sGardenClient, seedClient, seedNamespace, shoot)
+			if err != nil {
+				return err
+			}
+
+			if len(output) == 0 {
+				output = fmt.Sprintf("%s-%s-%s.tar.gz", shoot.Namespace, shoot.Name, time.Now().UTC().Format("20060102-150405"))
+			}
+			f, err := os.OpenFile(output, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			if err := writeBundle(f, files); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(opts.out, "Wrote diagnostic bundle of shoot %s/%s to %s.\n", shoot.Namespace, shoot.Name, output)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "", "path of the bundle (defaults to <namespace>-<name>-<timestamp>.tar.gz)")
+	return cmd
+}
+
+// bundleFile is a file of the diagnostic bundle.
+type bundleFile struct {
+	name string
+	data []byte
+}
+
+// collectBundle collects the files of the diagnostic bundle of the given Shoot. If the control plane has not been
+// created yet then <seedClient> is nil and the seed related files are omitted.
+func collectBundle(k8sGardenClient, seedClient k8s.Interface, seedNamespace string, shoot *gardenv1beta1.Shoot) ([]bundleFile, error) {
+	var files []bundleFile
+
+	manifest, err := shootManifest(shoot)
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, bundleFile{"shoot.yaml", manifest})
+
+	var status bytes.Buffer
+	if err := printShootInfo(&status, shoot); err != nil {
+		return nil, err
+	}
+	files = append(files, bundleFile{"status.txt", status.Bytes()})
+
+	shootEvents, err := k8sGardenClient.CoreV1().Events(shoot.Namespace).List(metav1.ListOptions{
+		FieldSelector: fields.SelectorFromSet(fields.Set{"involvedObject.kind": "Shoot", "involvedObject.name": shoot.Name}).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, bundleFile{"events-shoot.txt", formatEvents(shootEvents.Items)})
+
+	if seedClient == nil {
+		return files, nil
+	}
+
+	seedEvents, err := seedClient.CoreV1().Events(seedNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, bundleFile{"events-seed.txt", formatEvents(seedEvents.Items)})
+
+	pods, err := seedClient.CoreV1().Pods(seedNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, bundleFile{"controlplane-pods.txt", formatPods(pods.Items)})
+
+	stateName := fmt.Sprintf("%s.%s%s", shoot.Name, common.TerraformerPurposeInfra, common.TerraformerStateSuffix)
+	state, err := seedClient.CoreV1().ConfigMaps(seedNamespace).Get(stateName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil {
+		summary, err := formatStateSummary(state)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, bundleFile{"terraform-infra.txt", summary})
+	}
+
+	return files, nil
+}
+
+func shootManifest(shoot *gardenv1beta1.Shoot) ([]byte, error) {
+	shoot = shoot.DeepCopy()
+	delete(shoot.Annotations, lastAppliedConfigurationAnnotation)
+	shoot.ManagedFields = nil
+	shoot.APIVersion, shoot.Kind = gardenv1beta1.SchemeGroupVersion.String(), "Shoot"
+	return yaml.Marshal(shoot)
+}
+
+func formatEvents(events []corev1.Event) []byte {
+	sort.Slice(events, func(i, j int) bool { return events[i].LastTimestamp.Before(&events[j].LastTimestamp) })
+
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "LAST SEEN\tTYPE\tREASON\tOBJECT\tCOUNT\tMESSAGE")
+	for _, event := range events {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s/%s\t%d\t%s\n", event.LastTimestamp, event.Type, event.Reason, event.InvolvedObject.Kind, event.InvolvedObject.Name, event.Count, event.Message)
+	}
+	w.Flush()
+	return buf.Bytes()
+}
+
+func formatPods(pods []corev1.Pod) []byte {
+	sort.Slice(pods, func(i, j int) bool { return pods[i].Name < pods[j].Name })
+
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tPHASE\tREADY\tRESTARTS\tLAST TERMINATION\tNODE")
+	for _, pod := range pods {
+		var ready, restarts int32
+		var lastTermination string
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Ready {
+				ready++
+			}
+			restarts += status.RestartCount
+			if terminated := status.LastTerminationState.Terminated; terminated != nil {
+				lastTermination = fmt.Sprintf("%s: %s (exit code %d)", status.Name, terminated.Reason, terminated.ExitCode)
+			}
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d/%d\t%d\t%s\t%s\n", pod.Name, pod.Status.Phase, ready, len(pod.Spec.Containers), restarts, lastTermination, pod.Spec.NodeName)
+	}
+	w.Flush()
+	return buf.Bytes()
+}
+
+func formatStateSummary(state *corev1.ConfigMap) ([]byte, error) {
+	resources, outputs, err := terraformer.StateSummary([]byte(state.Data[terraformer.StateKey]))
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]string, 0, len(resources))
+	for address := range resources {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "Terraformer version: %s\n\n", state.Annotations[terraformer.StateVersionAnnotation])
+	w := tabwriter.NewWriter(&buf, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "RESOURCE\tID")
+	for _, address := range addresses {
+		fmt.Fprintf(w, "%s\t%s\n", address, resources[address])
+	}
+	w.Flush()
+	fmt.Fprintf(&buf, "\nOutputs (values redacted): %v\n", outputs)
+	return buf.Bytes(), nil
+}
+
+// writeBundle writes the given files as gzipped tar archive to <out>.
+func writeBundle(out io.Writer, files []bundleFile) error {
+	var (
+		gzipWriter = gzip.NewWriter(out)
+		tarWriter  = tar.NewWriter(gzipWriter)
+		now        = time.Now()
+	)
+
+	for _, file := range files {
+		if err := tarWriter.WriteHeader(&tar.Header{Name: file.name, Mode: 0600, Size: int64(len(file.data)), ModTime: now}); err != nil {
+			return err
+		}
+		if _, err := tarWriter.Write(file.data); err != nil {
+			return err
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardenclientset "github.com/gardener/gardener/pkg/client/garden/clientset/versioned"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	// TargetKindGarden is the kind of the target if the garden cluster is targeted.
+	TargetKindGarden = "garden"
+	// TargetKindSeed is the kind of the target if a seed cluster is targeted.
+	TargetKindSeed = "seed"
+	// TargetKindShoot is the kind of the target if a shoot cluster is targeted.
+	TargetKindShoot = "shoot"
+
+	targetFileName     = "target.json"
+	kubeconfigFileName = "kubeconfig.yaml"
+)
+
+// Target is the cluster which is currently targeted by gardenctl.
+type Target struct {
+	// Kind is the kind of the targeted cluster (garden, seed, or shoot).
+	Kind string `json:"kind"`
+	// Namespace is the project namespace of the targeted shoot.
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the name of the targeted seed or shoot.
+	Name string `json:"name,omitempty"`
+}
+
+// Options has all the context and parameters needed to run gardenctl.
+type Options struct {
+	// GardenKubeconfig is the path to the kubeconfig of the garden cluster.
+	GardenKubeconfig string
+	// Home is the directory in which gardenctl stores the target and the kubeconfig of the targeted cluster.
+	Home string
+
+	out io.Writer
+}
+
+// AddFlags adds flags for gardenctl to the specified FlagSet.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.GardenKubeconfig, "garden-kubeconfig", os.Getenv("GARDEN_KUBECONFIG"), "path to the kubeconfig of the garden cluster (defaults to $GARDEN_KUBECONFIG)")
+	fs.StringVar(&o.Home, "home", defaultHome(), "directory in which the target and the kubeconfig of the targeted cluster are stored (defaults to $GARDENCTL_HOME or ~/.gardenctl)")
+}
+
+func defaultHome() string {
+	if home := os.Getenv("GARDENCTL_HOME"); len(home) > 0 {
+		return home
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		return filepath.Join(home, ".gardenctl")
+	}
+	return ".gardenctl"
+}
+
+// NewCommandGardenctl creates a *cobra.Command object with default parameters.
+func NewCommandGardenctl(out io.Writer) *cobra.Command {
+	opts := &Options{out: out}
+
+	cmd := &cobra.Command{
+		Use:   "gardenctl",
+		Short: "Target Gardener clusters and inspect Shoots",
+		Long: `gardenctl targets the garden cluster, a seed cluster, or a shoot cluster by fetching the
+kubeconfig of the cluster from the garden cluster. It shows the last operation and the conditions
+of Shoots and streams the logs of their control plane components from the seed cluster.`,
+		SilenceUsage: true,
+	}
+
+	opts.AddFlags(cmd.PersistentFlags())
+	cmd.AddCommand(
+		newTargetCommand(opts),
+		newEnvCommand(opts),
+		newKubeconfigCommand(opts),
+		newInfoCommand(opts),
+		newLogsCommand(opts),
+		newBundleCommand(opts),
+	)
+	return cmd
+}
+
+func (o *Options) gardenConfig() (*rest.Config, error) {
+	if len(o.GardenKubeconfig) == 0 {
+		return nil, errors.New("the kubeconfig of the garden cluster must be given with --garden-kubeconfig or $GARDEN_KUBECONFIG")
+	}
+	return clientcmd.BuildConfigFromFlags("", o.GardenKubeconfig)
+}
+
+func (o *Options) gardenClients() (k8s.Interface, gardenclientset.Interface, error) {
+	config, err := o.gardenConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	k8sClient, err := k8s.NewForConfig(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	gardenClient, err := gardenclientset.NewForConfig(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	return k8sClient, gardenClient, nil
+}
+
+// seedClient returns a client for the seed cluster with the given name, using the kubeconfig in the secret referenced
+// by the Seed.
+func (o *Options) seedClient(k8sGardenClient k8s.Interface, gardenClient gardenclientset.Interface, name string) (k8s.Interface, error) {
+	kubeconfig, err := seedKubeconfig(k8sGardenClient, gardenClient, name)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	return k8s.NewForConfig(config)
+}
+
+func seedKubeconfig(k8sGardenClient k8s.Interface, gardenClient gardenclientset.Interface, name string) ([]byte, error) {
+	seed, err := gardenClient.GardenV1beta1().Seeds().Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := k8sGardenClient.CoreV1().Secrets(seed.Spec.SecretRef.Namespace).Get(seed.Spec.SecretRef.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	kubeconfig, ok := secret.Data[kubernetes.KubeConfig]
+	if !ok {
+		return nil, fmt.Errorf("secret %s/%s of seed %q does not contain a kubeconfig", secret.Namespace, secret.Name, name)
+	}
+	return kubeconfig, nil
+}
+
+func shootKubeconfig(k8sGardenClient k8s.Interface, shoot *gardenv1beta1.Shoot) ([]byte, error) {
+	secret, err := k8sGardenClient.CoreV1().Secrets(shoot.Namespace).Get(fmt.Sprintf("%s.kubeconfig", shoot.Name), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	kubeconfig, ok := secret.Data[kubernetes.KubeConfig]
+	if !ok {
+		return nil, fmt.Errorf("secret %s/%s does not contain a kubeconfig", secret.Namespace, secret.Name)
+	}
+	return kubeconfig, nil
+}
+
+func (o *Options) targetPath() string {
+	return filepath.Join(o.Home, targetFileName)
+}
+
+func (o *Options) kubeconfigPath() string {
+	return filepath.Join(o.Home, kubeconfigFileName)
+}
+
+// readTarget reads the current target. If nothing has been targeted yet, the garden cluster is returned.
+func (o *Options) readTarget() (*Target, error) {
+	data, err := ioutil.ReadFile(o.targetPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &Target{Kind: TargetKindGarden}, nil
+		}
+		return nil, err
+	}
+
+	target := &Target{}
+	if err := json.Unmarshal(data, target); err != nil {
+		return nil, fmt.Errorf("could not read target %s: %v", o.targetPath(), err)
+	}
+	return target, nil
+}
+
+// writeTarget stores the given target together with the kubeconfig of the targeted cluster.
+func (o *Options) writeTarget(target *Target, kubeconfig []byte) error {
+	if err := os.MkdirAll(o.Home, 0700); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(target)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(o.targetPath(), data, 0600); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(o.kubeconfigPath(), kubeconfig, 0600)
+}
+
+// targetedShoot returns the targeted Shoot, or an error if no Shoot is targeted.
+func (o *Options) targetedShoot(gardenClient gardenclientset.Interface) (*gardenv1beta1.Shoot, error) {
+	target, err := o.readTarget()
+	if err != nil {
+		return nil, err
+	}
+	if target.Kind != TargetKindShoot {
+		return nil, errors.New("no shoot is targeted, use 'gardenctl target shoot <name> --namespace <project-namespace>'")
+	}
+
+	return gardenClient.GardenV1beta1().Shoots(target.Namespace).Get(target.Name, metav1.GetOptions{})
+}
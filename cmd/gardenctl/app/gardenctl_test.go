@@ -0,0 +1,263 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/gardener/gardener/cmd/gardenctl/app"
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardenfake "github.com/gardener/gardener/pkg/client/garden/clientset/versioned/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const gardenKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: garden
+  cluster:
+    server: https://garden.example.com
+contexts:
+- name: garden
+  context:
+    cluster: garden
+    user: admin
+current-context: garden
+users:
+- name: admin
+  user:
+    token: foo
+`
+
+var _ = Describe("gardenctl", func() {
+	Describe("commands", func() {
+		var (
+			home           string
+			kubeconfigPath string
+			out            *bytes.Buffer
+
+			run = func(args ...string) error {
+				cmd := NewCommandGardenctl(out)
+				cmd.SetArgs(append(args, "--home", home, "--garden-kubeconfig", kubeconfigPath))
+				cmd.SetOutput(ioutil.Discard)
+				return cmd.Execute()
+			}
+		)
+
+		BeforeEach(func() {
+			var err error
+			home, err = ioutil.TempDir("", "gardenctl")
+			Expect(err).NotTo(HaveOccurred())
+
+			kubeconfigPath = filepath.Join(home, "garden.yaml")
+			Expect(ioutil.WriteFile(kubeconfigPath, []byte(gardenKubeconfig), 0600)).To(Succeed())
+
+			out = &bytes.Buffer{}
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(home)).To(Succeed())
+		})
+
+		It("should target the garden cluster and store its kubeconfig", func() {
+			Expect(run("target", "garden")).To(Succeed())
+
+			Expect(out.String()).To(ContainSubstring("Targeted the garden cluster."))
+			Expect(ioutil.ReadFile(filepath.Join(home, "target.json"))).To(MatchJSON(`{"kind":"garden"}`))
+			Expect(ioutil.ReadFile(filepath.Join(home, "kubeconfig.yaml"))).To(Equal([]byte(gardenKubeconfig)))
+		})
+
+		It("should print the environment of the targeted cluster", func() {
+			Expect(run("env")).To(Succeed())
+
+			Expect(out.String()).To(HavePrefix("export KUBECONFIG=" + filepath.Join(home, "kubeconfig.yaml") + "\n"))
+			Expect(out.String()).To(ContainSubstring("# Targeted the garden cluster"))
+		})
+
+		It("should print the kubeconfig of the targeted cluster", func() {
+			Expect(run("kubeconfig")).To(Succeed())
+
+			Expect(out.String()).To(Equal(gardenKubeconfig))
+		})
+
+		It("should fail if the garden kubeconfig is not given", func() {
+			kubeconfigPath = ""
+
+			Expect(run("target", "garden")).To(MatchError(ContainSubstring("the kubeconfig of the garden cluster must be given")))
+		})
+
+		It("should fail for unknown target kinds", func() {
+			Expect(run("target", "project", "foo")).To(MatchError(ContainSubstring("unknown target kind \"project\"")))
+		})
+
+		It("should fail if the name of the seed is missing", func() {
+			Expect(run("target", "seed")).To(MatchError("the name of the seed must be given"))
+		})
+
+		It("should fail if the namespace of the shoot is missing", func() {
+			Expect(run("target", "shoot", "foo")).To(MatchError("the name of the shoot and its project namespace must be given"))
+		})
+
+		It("should fail to show the info if no shoot is targeted", func() {
+			Expect(run("info")).To(MatchError(ContainSubstring("no shoot is targeted")))
+		})
+	})
+
+	Describe("#seedKubeconfig", func() {
+		It("should return the kubeconfig referenced by the seed", func() {
+			k8sGardenClient := fake.NewSimpleClientset(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "garden", Name: "seed-secret"},
+				Data:       map[string][]byte{"kubeconfig": []byte("seed-kubeconfig")},
+			})
+			gardenClient := gardenfake.NewSimpleClientset(&gardenv1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: "seed"},
+				Spec: gardenv1beta1.SeedSpec{
+					SecretRef: corev1.SecretReference{Namespace: "garden", Name: "seed-secret"},
+				},
+			})
+
+			Expect(ExportSeedKubeconfig(k8sGardenClient, gardenClient, "seed")).To(Equal([]byte("seed-kubeconfig")))
+		})
+
+		It("should fail if the secret does not contain a kubeconfig", func() {
+			k8sGardenClient := fake.NewSimpleClientset(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "garden", Name: "seed-secret"},
+			})
+			gardenClient := gardenfake.NewSimpleClientset(&gardenv1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: "seed"},
+				Spec: gardenv1beta1.SeedSpec{
+					SecretRef: corev1.SecretReference{Namespace: "garden", Name: "seed-secret"},
+				},
+			})
+
+			_, err := ExportSeedKubeconfig(k8sGardenClient, gardenClient, "seed")
+			Expect(err).To(MatchError(ContainSubstring("does not contain a kubeconfig")))
+		})
+	})
+
+	Describe("#shootKubeconfig", func() {
+		It("should return the kubeconfig of the shoot from its project namespace", func() {
+			k8sGardenClient := fake.NewSimpleClientset(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "garden-dev", Name: "foo.kubeconfig"},
+				Data:       map[string][]byte{"kubeconfig": []byte("shoot-kubeconfig")},
+			})
+			shoot := &gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Namespace: "garden-dev", Name: "foo"}}
+
+			Expect(ExportShootKubeconfig(k8sGardenClient, shoot)).To(Equal([]byte("shoot-kubeconfig")))
+		})
+	})
+
+	Describe("#printShootInfo", func() {
+		It("should print the last operation, the last error and the conditions", func() {
+			seed := "aws-eu1"
+			shoot := &gardenv1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "garden-dev", Name: "foo"},
+				Spec:       gardenv1beta1.ShootSpec{Cloud: gardenv1beta1.Cloud{Seed: &seed}},
+				Status: gardenv1beta1.ShootStatus{
+					TechnicalID: "shoot--dev--foo",
+					LastOperation: &gardencorev1alpha1.LastOperation{
+						Type:        gardencorev1alpha1.LastOperationTypeReconcile,
+						State:       gardencorev1alpha1.LastOperationStateError,
+						Progress:    42,
+						Description: "Deploying Shoot infrastructure",
+					},
+					LastError: &gardencorev1alpha1.LastError{Description: "quota exceeded"},
+					Conditions: []gardencorev1alpha1.Condition{
+						{Type: gardenv1beta1.ShootAPIServerAvailable, Status: gardencorev1alpha1.ConditionTrue, Reason: "HealthzRequestSucceeded", Message: "API server /healthz endpoint responded with success status code."},
+					},
+				},
+			}
+			out := &bytes.Buffer{}
+
+			Expect(ExportPrintShootInfo(out, shoot)).To(Succeed())
+
+			Expect(out.String()).To(SatisfyAll(
+				MatchRegexp(`Shoot:\s+garden-dev/foo\n`),
+				MatchRegexp(`Seed:\s+aws-eu1\n`),
+				MatchRegexp(`Technical ID:\s+shoot--dev--foo\n`),
+				MatchRegexp(`Last operation:\s+Reconcile Error \(42%\)`),
+				ContainSubstring("Deploying Shoot infrastructure"),
+				MatchRegexp(`Last error:\s+quota exceeded\n`),
+				MatchRegexp(`APIServerAvailable\s+True\s+HealthzRequestSucceeded`),
+			))
+		})
+
+		It("should omit the conditions if there are none", func() {
+			shoot := &gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Namespace: "garden-dev", Name: "foo"}}
+			out := &bytes.Buffer{}
+
+			Expect(ExportPrintShootInfo(out, shoot)).To(Succeed())
+
+			Expect(out.String()).NotTo(ContainSubstring("CONDITION"))
+		})
+	})
+
+	Describe("#controlPlaneLocation", func() {
+		It("should prefer the seed from the status", func() {
+			specSeed := "spec-seed"
+			shoot := &gardenv1beta1.Shoot{
+				Spec:   gardenv1beta1.ShootSpec{Cloud: gardenv1beta1.Cloud{Seed: &specSeed}},
+				Status: gardenv1beta1.ShootStatus{Seed: "status-seed", TechnicalID: "shoot--dev--foo"},
+			}
+
+			seedName, namespace, err := ExportControlPlaneLocation(shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(seedName).To(Equal("status-seed"))
+			Expect(namespace).To(Equal("shoot--dev--foo"))
+		})
+
+		It("should fail if the control plane has not been created yet", func() {
+			_, _, err := ExportControlPlaneLocation(&gardenv1beta1.Shoot{})
+			Expect(err).To(MatchError(ContainSubstring("has not been created yet")))
+		})
+	})
+
+	Describe("#controlPlanePod", func() {
+		newPod := func(name string, phase corev1.PodPhase) corev1.Pod {
+			return corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Status:     corev1.PodStatus{Phase: phase},
+			}
+		}
+
+		It("should return the first running pod of the component", func() {
+			pods := []corev1.Pod{
+				newPod("kube-apiserver-b", corev1.PodRunning),
+				newPod("kube-apiserver-a", corev1.PodPending),
+				newPod("kube-apiserver-c", corev1.PodRunning),
+				newPod("etcd-main-0", corev1.PodRunning),
+			}
+
+			pod, err := ExportControlPlanePod(pods, "kube-apiserver")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pod.Name).To(Equal("kube-apiserver-b"))
+		})
+
+		It("should fail if no pod of the component is running", func() {
+			_, err := ExportControlPlanePod([]corev1.Pod{newPod("etcd-main-0", corev1.PodFailed)}, "etcd-main")
+			Expect(err).To(MatchError(ContainSubstring("no running pod found")))
+		})
+	})
+})
@@ -0,0 +1,80 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+
+	"github.com/spf13/cobra"
+)
+
+func newInfoCommand(opts *Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "info",
+		Short: "Show the last operation and the conditions of the targeted shoot",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, gardenClient, err := opts.gardenClients()
+			if err != nil {
+				return err
+			}
+
+			shoot, err := opts.targetedShoot(gardenClient)
+			if err != nil {
+				return err
+			}
+
+			return printShootInfo(opts.out, shoot)
+		},
+	}
+}
+
+// printShootInfo prints the seed, the last operation, the last error, and the conditions of the given Shoot.
+func printShootInfo(out io.Writer, shoot *gardenv1beta1.Shoot) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+
+	fmt.Fprintf(w, "Shoot:\t%s/%s\n", shoot.Namespace, shoot.Name)
+	if shoot.Spec.Cloud.Seed != nil {
+		fmt.Fprintf(w, "Seed:\t%s\n", *shoot.Spec.Cloud.Seed)
+	}
+	fmt.Fprintf(w, "Technical ID:\t%s\n", shoot.Status.TechnicalID)
+
+	if op := shoot.Status.LastOperation; op != nil {
+		fmt.Fprintf(w, "Last operation:\t%s %s (%d%%) at %s\n", op.Type, op.State, op.Progress, op.LastUpdateTime)
+		fmt.Fprintf(w, "\t%s\n", op.Description)
+	}
+	if lastError := shoot.Status.LastError; lastError != nil {
+		fmt.Fprintf(w, "Last error:\t%s\n", lastError.Description)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(shoot.Status.Conditions) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(out)
+	w = tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "CONDITION\tSTATUS\tREASON\tLAST TRANSITION\tMESSAGE")
+	for _, condition := range shoot.Status.Conditions {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", condition.Type, condition.Status, condition.Reason, condition.LastTransitionTime, condition.Message)
+	}
+	return w.Flush()
+}
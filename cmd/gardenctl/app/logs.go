@@ -0,0 +1,123 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+
+	"github.com/spf13/cobra"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newLogsCommand(opts *Options) *cobra.Command {
+	var (
+		container string
+		follow    bool
+		tail      int64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "logs COMPONENT",
+		Short: "Stream the logs of a control plane component of the targeted shoot from its seed",
+		Long: `Logs streams the logs of the first running pod in the seed namespace of the targeted shoot
+whose name starts with COMPONENT, e.g. 'kube-apiserver', 'kube-controller-manager', or 'etcd-main'.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			k8sGardenClient, gardenClient, err := opts.gardenClients()
+			if err != nil {
+				return err
+			}
+
+			shoot, err := opts.targetedShoot(gardenClient)
+			if err != nil {
+				return err
+			}
+			seedName, namespace, err := controlPlaneLocation(shoot)
+			if err != nil {
+				return err
+			}
+
+			seedClient, err := opts.seedClient(k8sGardenClient, gardenClient, seedName)
+			if err != nil {
+				return err
+			}
+
+			podList, err := seedClient.CoreV1().Pods(namespace).List(metav1.ListOptions{})
+			if err != nil {
+				return err
+			}
+			pod, err := controlPlanePod(podList.Items, args[0])
+			if err != nil {
+				return err
+			}
+
+			logOptions := &corev1.PodLogOptions{Container: container, Follow: follow}
+			if tail >= 0 {
+				logOptions.TailLines = &tail
+			}
+
+			stream, err := seedClient.CoreV1().Pods(namespace).GetLogs(pod.Name, logOptions).Stream()
+			if err != nil {
+				return err
+			}
+			defer stream.Close()
+
+			_, err = io.Copy(opts.out, stream)
+			return err
+		},
+	}
+
+	cmd.Flags().StringVarP(&container, "container", "c", "", "container of the pod (defaults to the only container)")
+	cmd.Flags().BoolVarP(&follow, "follow", "f", false, "follow the logs")
+	cmd.Flags().Int64Var(&tail, "tail", -1, "number of recent lines to show (defaults to all lines)")
+	return cmd
+}
+
+// controlPlaneLocation returns the name of the seed and the namespace in the seed which host the control plane of
+// the given Shoot.
+func controlPlaneLocation(shoot *gardenv1beta1.Shoot) (string, string, error) {
+	seedName := shoot.Status.Seed
+	if len(seedName) == 0 && shoot.Spec.Cloud.Seed != nil {
+		seedName = *shoot.Spec.Cloud.Seed
+	}
+	if len(seedName) == 0 || len(shoot.Status.TechnicalID) == 0 {
+		return "", "", fmt.Errorf("the control plane of shoot %s/%s has not been created yet", shoot.Namespace, shoot.Name)
+	}
+	return seedName, shoot.Status.TechnicalID, nil
+}
+
+// controlPlanePod returns the running pod whose name starts with the given component. Pods are compared by name to
+// make the choice deterministic.
+func controlPlanePod(pods []corev1.Pod, component string) (*corev1.Pod, error) {
+	var candidates []corev1.Pod
+	for _, pod := range pods {
+		if strings.HasPrefix(pod.Name, component) && pod.Status.Phase == corev1.PodRunning {
+			candidates = append(candidates, pod)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no running pod found for component %q", component)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].Name < candidates[j].Name })
+	return &candidates[0], nil
+}
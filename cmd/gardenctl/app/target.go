@@ -0,0 +1,148 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTargetCommand(opts *Options) *cobra.Command {
+	var namespace string
+
+	cmd := &cobra.Command{
+		Use:   "target (garden | seed NAME | shoot NAME --namespace NAMESPACE)",
+		Short: "Target the garden cluster, a seed cluster, or a shoot cluster",
+		Long: `Target stores the targeted cluster and writes its kubeconfig into the gardenctl home directory.
+Use 'eval $(gardenctl env)' to point kubectl to the targeted cluster.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			target := &Target{Kind: args[0]}
+
+			switch target.Kind {
+			case TargetKindGarden:
+				if len(args) != 1 {
+					return fmt.Errorf("the garden cluster is targeted without a name")
+				}
+			case TargetKindSeed:
+				if len(args) != 2 {
+					return fmt.Errorf("the name of the seed must be given")
+				}
+				target.Name = args[1]
+			case TargetKindShoot:
+				if len(args) != 2 || len(namespace) == 0 {
+					return fmt.Errorf("the name of the shoot and its project namespace must be given")
+				}
+				target.Name, target.Namespace = args[1], namespace
+			default:
+				return fmt.Errorf("unknown target kind %q, must be one of %s, %s, %s", target.Kind, TargetKindGarden, TargetKindSeed, TargetKindShoot)
+			}
+
+			kubeconfig, err := opts.fetchKubeconfig(target)
+			if err != nil {
+				return err
+			}
+			if err := opts.writeTarget(target, kubeconfig); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(opts.out, "Targeted %s. Run 'eval $(gardenctl env)' to use its kubeconfig %s.\n", target, opts.kubeconfigPath())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "project namespace of the shoot")
+	return cmd
+}
+
+func newEnvCommand(opts *Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "env",
+		Short: "Print the shell commands which point kubectl to the targeted cluster",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			target, err := opts.readTarget()
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(opts.out, "export KUBECONFIG=%s\n", opts.kubeconfigPath())
+			fmt.Fprintf(opts.out, "# Targeted %s, run 'eval $(gardenctl env)' to configure your shell.\n", target)
+			return nil
+		},
+	}
+}
+
+func newKubeconfigCommand(opts *Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "kubeconfig",
+		Short: "Print the kubeconfig of the targeted cluster",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			target, err := opts.readTarget()
+			if err != nil {
+				return err
+			}
+
+			kubeconfig, err := opts.fetchKubeconfig(target)
+			if err != nil {
+				return err
+			}
+
+			_, err = opts.out.Write(kubeconfig)
+			return err
+		},
+	}
+}
+
+// fetchKubeconfig fetches the kubeconfig of the given target from the garden cluster.
+func (o *Options) fetchKubeconfig(target *Target) ([]byte, error) {
+	if target.Kind == TargetKindGarden {
+		if _, err := o.gardenConfig(); err != nil {
+			return nil, err
+		}
+		return ioutil.ReadFile(o.GardenKubeconfig)
+	}
+
+	k8sGardenClient, gardenClient, err := o.gardenClients()
+	if err != nil {
+		return nil, err
+	}
+
+	if target.Kind == TargetKindSeed {
+		return seedKubeconfig(k8sGardenClient, gardenClient, target.Name)
+	}
+
+	shoot, err := gardenClient.GardenV1beta1().Shoots(target.Namespace).Get(target.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return shootKubeconfig(k8sGardenClient, shoot)
+}
+
+// String returns a human-readable description of the target.
+func (t *Target) String() string {
+	switch t.Kind {
+	case TargetKindSeed:
+		return fmt.Sprintf("seed %q", t.Name)
+	case TargetKindShoot:
+		return fmt.Sprintf("shoot %q in namespace %q", t.Name, t.Namespace)
+	}
+	return "the garden cluster"
+}
@@ -35,20 +35,29 @@ import (
 	plantvalidator "github.com/gardener/gardener/plugin/pkg/plant"
 
 	"github.com/gardener/gardener/plugin/pkg/global/deletionconfirmation"
+	landscapefreeze "github.com/gardener/gardener/plugin/pkg/global/freeze"
+	"github.com/gardener/gardener/plugin/pkg/global/projectrestrictions"
 	"github.com/gardener/gardener/plugin/pkg/global/resourcereferencemanager"
+	shootdeletionprotection "github.com/gardener/gardener/plugin/pkg/shoot/deletionprotection"
 	shootdns "github.com/gardener/gardener/plugin/pkg/shoot/dns"
+	shootexternalvalidation "github.com/gardener/gardener/plugin/pkg/shoot/externalvalidation"
+	shootforcedeletion "github.com/gardener/gardener/plugin/pkg/shoot/forcedeletion"
 	shootquotavalidator "github.com/gardener/gardener/plugin/pkg/shoot/quotavalidator"
 	shootseedmanager "github.com/gardener/gardener/plugin/pkg/shoot/seedmanager"
+	shoottemplate "github.com/gardener/gardener/plugin/pkg/shoot/template"
 	shootvalidator "github.com/gardener/gardener/plugin/pkg/shoot/validator"
+	shootversionskew "github.com/gardener/gardener/plugin/pkg/shoot/versionskew"
 
 	"github.com/spf13/cobra"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/admission"
 	openapinamer "k8s.io/apiserver/pkg/endpoints/openapi"
 	genericapiserver "k8s.io/apiserver/pkg/server"
+	genericfilters "k8s.io/apiserver/pkg/server/filters"
 	genericoptions "k8s.io/apiserver/pkg/server/options"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	kubeinformers "k8s.io/client-go/informers"
@@ -85,12 +94,14 @@ These so-called control plane components are hosted in Kubernetes clusters thems
 	flags := cmd.Flags()
 	utilfeature.DefaultMutableFeatureGate.AddFlag(flags)
 	opts.Recommended.AddFlags(flags)
+	opts.OperationLog.AddFlags(flags)
 	return cmd
 }
 
 // Options has all the context and parameters needed to run a Gardener API server.
 type Options struct {
 	Recommended           *genericoptions.RecommendedOptions
+	OperationLog          *OperationLogOptions
 	CoreInformerFactory   gardencoreinformers.SharedInformerFactory
 	GardenInformerFactory gardeninformers.SharedInformerFactory
 	KubeInformerFactory   kubeinformers.SharedInformerFactory
@@ -101,18 +112,29 @@ type Options struct {
 // NewOptions returns a new Options object.
 func NewOptions(out, errOut io.Writer) *Options {
 	o := &Options{
-		Recommended: genericoptions.NewRecommendedOptions(fmt.Sprintf("/registry/%s", garden.GroupName), api.Codecs.LegacyCodec(gardencorev1alpha1.SchemeGroupVersion, gardenv1beta1.SchemeGroupVersion), genericoptions.NewProcessInfo("gardener-apiserver", "garden")),
-		StdOut:      out,
-		StdErr:      errOut,
+		Recommended:  genericoptions.NewRecommendedOptions(fmt.Sprintf("/registry/%s", garden.GroupName), api.Codecs.LegacyCodec(gardencorev1alpha1.SchemeGroupVersion, gardenv1beta1.SchemeGroupVersion), genericoptions.NewProcessInfo("gardener-apiserver", "garden")),
+		OperationLog: &OperationLogOptions{},
+		StdOut:       out,
+		StdErr:       errOut,
 	}
 	o.Recommended.Etcd.StorageConfig.EncodeVersioner = runtime.NewMultiGroupVersioner(gardenv1beta1.SchemeGroupVersion, schema.GroupKind{Group: gardenv1beta1.GroupName})
+	o.Recommended.Etcd.WatchCacheSizes = defaultWatchCacheSizes
 	return o
 }
 
+// defaultWatchCacheSizes are the watch cache sizes of the resources which exist once per Shoot. They are larger than
+// the default watch cache size so that watches of the controllers do not expire in landscapes with many Shoots,
+// which would force them to list all objects again. They can be overwritten with the --watch-cache-sizes flag.
+var defaultWatchCacheSizes = []string{
+	fmt.Sprintf("shoots.%s#500", garden.GroupName),
+	fmt.Sprintf("backupinfrastructures.%s#500", garden.GroupName),
+}
+
 // validate validates all the required options.
 func (o Options) validate(args []string) error {
 	errs := []error{}
 	errs = append(errs, o.Recommended.Validate()...)
+	errs = append(errs, o.OperationLog.Validate()...)
 
 	// Require server certificate specification
 	keyCert := &o.Recommended.SecureServing.ServerCert.CertKey
@@ -127,21 +149,35 @@ func (o *Options) complete() error {
 	// Admission plugin registration
 	resourcereferencemanager.Register(o.Recommended.Admission.Plugins)
 	deletionconfirmation.Register(o.Recommended.Admission.Plugins)
+	landscapefreeze.Register(o.Recommended.Admission.Plugins)
+	projectrestrictions.Register(o.Recommended.Admission.Plugins)
 	shootquotavalidator.Register(o.Recommended.Admission.Plugins)
 	shootseedmanager.Register(o.Recommended.Admission.Plugins)
 	shootdns.Register(o.Recommended.Admission.Plugins)
+	shootdeletionprotection.Register(o.Recommended.Admission.Plugins)
+	shootforcedeletion.Register(o.Recommended.Admission.Plugins)
+	shoottemplate.Register(o.Recommended.Admission.Plugins)
 	shootvalidator.Register(o.Recommended.Admission.Plugins)
+	shootversionskew.Register(o.Recommended.Admission.Plugins)
+	shootexternalvalidation.Register(o.Recommended.Admission.Plugins)
 	controllerregistrationresources.Register(o.Recommended.Admission.Plugins)
 	plantvalidator.Register(o.Recommended.Admission.Plugins)
 
 	allOrderedPlugins := []string{
+		projectrestrictions.PluginName,
 		resourcereferencemanager.PluginName,
+		landscapefreeze.PluginName,
+		shoottemplate.PluginName,
 		shootdns.PluginName,
 		shootquotavalidator.PluginName,
 		shootseedmanager.PluginName,
 		shootvalidator.PluginName,
+		shootversionskew.PluginName,
+		shootforcedeletion.PluginName,
+		shootexternalvalidation.PluginName,
 		controllerregistrationresources.PluginName,
 		plantvalidator.PluginName,
+		shootdeletionprotection.PluginName,
 		deletionconfirmation.PluginName,
 	}
 
@@ -154,6 +190,8 @@ func (o *Options) config() (*apiserver.Config, error) {
 	// Create clientset for the owned API groups
 	// Use loopback config to create a new Kubernetes client for the owned API groups
 	gardenerAPIServerConfig := genericapiserver.NewRecommendedConfig(api.Codecs)
+	// The log subresource of Shoots streams the operation logs, hence, its requests must not time out.
+	gardenerAPIServerConfig.LongRunningFunc = genericfilters.BasicLongRunningRequestCheck(sets.NewString("watch"), sets.NewString("log"))
 
 	// Create clientset for the native Kubernetes API group
 	// Use remote kubeconfig file (if set) or in-cluster config to create a new Kubernetes client for the native Kubernetes API groups
@@ -211,9 +249,16 @@ func (o *Options) config() (*apiserver.Config, error) {
 		return nil, err
 	}
 
+	operationLogLocation, err := o.OperationLog.location()
+	if err != nil {
+		return nil, err
+	}
+
 	return &apiserver.Config{
 		GenericConfig: gardenerAPIServerConfig,
-		ExtraConfig:   apiserver.ExtraConfig{},
+		ExtraConfig: apiserver.ExtraConfig{
+			OperationLogLocation: operationLogLocation,
+		},
 	}, nil
 }
 
@@ -0,0 +1,85 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"fmt"
+	"net/url"
+
+	shootstore "github.com/gardener/gardener/pkg/registry/garden/shoot/storage"
+
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/transport"
+)
+
+// OperationLogOptions contains the options for streaming the operation logs of Shoots from the Gardener controller
+// manager via the log subresource of Shoots.
+type OperationLogOptions struct {
+	// ControllerManagerURL is the base URL of the HTTPS server of the Gardener controller manager. If it is empty then
+	// the log subresource of Shoots is not available.
+	ControllerManagerURL string
+	// ControllerManagerCAFile is the path to the CA bundle which is used to verify the certificate of the Gardener
+	// controller manager.
+	ControllerManagerCAFile string
+	// ControllerManagerTokenFile is the path to a file containing the bearer token which is used to authenticate at the
+	// Gardener controller manager.
+	ControllerManagerTokenFile string
+}
+
+// AddFlags adds the flags of the operation log options to the given flag set.
+func (o *OperationLogOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.ControllerManagerURL, "controller-manager-url", o.ControllerManagerURL, "Base URL of the HTTPS server of the Gardener controller manager which serves the operation logs of Shoots. If empty, the log subresource of Shoots is not available.")
+	fs.StringVar(&o.ControllerManagerCAFile, "controller-manager-ca-file", o.ControllerManagerCAFile, "Path to the CA bundle which is used to verify the certificate of the Gardener controller manager.")
+	fs.StringVar(&o.ControllerManagerTokenFile, "controller-manager-token-file", o.ControllerManagerTokenFile, "Path to a file containing the bearer token which is used to authenticate at the Gardener controller manager.")
+}
+
+// Validate validates the operation log options.
+func (o *OperationLogOptions) Validate() []error {
+	if len(o.ControllerManagerURL) == 0 {
+		return nil
+	}
+
+	var errs []error
+	if u, err := url.Parse(o.ControllerManagerURL); err != nil {
+		errs = append(errs, fmt.Errorf("--controller-manager-url is invalid: %v", err))
+	} else if u.Scheme != "https" || len(u.Host) == 0 {
+		errs = append(errs, fmt.Errorf("--controller-manager-url must be an https URL"))
+	}
+	if len(o.ControllerManagerTokenFile) == 0 {
+		errs = append(errs, fmt.Errorf("--controller-manager-token-file is required if --controller-manager-url is set"))
+	}
+	return errs
+}
+
+// location returns the location of the operation logs described by the options, or nil if no URL is configured.
+func (o *OperationLogOptions) location() (*shootstore.OperationLogLocation, error) {
+	if len(o.ControllerManagerURL) == 0 {
+		return nil, nil
+	}
+
+	u, err := url.Parse(o.ControllerManagerURL)
+	if err != nil {
+		return nil, err
+	}
+	rt, err := transport.New(&transport.Config{
+		TLS:             transport.TLSConfig{CAFile: o.ControllerManagerCAFile},
+		BearerTokenFile: o.ControllerManagerTokenFile,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &shootstore.OperationLogLocation{URL: u, Transport: rt}, nil
+}
@@ -36,7 +36,9 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/server/handlers"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation/common"
+	seedpkg "github.com/gardener/gardener/pkg/operation/seed"
 	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/flow"
 	"github.com/gardener/gardener/pkg/version"
 
 	"github.com/sirupsen/logrus"
@@ -44,6 +46,7 @@ import (
 	"github.com/spf13/pflag"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/client-go/discovery"
@@ -163,6 +166,31 @@ func (o *Options) run(ctx context.Context, cancel context.CancelFunc) error {
 		o.config = c
 	}
 
+	if o.config.SeedSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(o.config.SeedSelector); err != nil {
+			return fmt.Errorf("invalid seed selector: %v", err)
+		}
+	}
+	if o.config.SeedAgent != nil && *o.config.SeedAgent && o.config.SeedSelector == nil {
+		return fmt.Errorf("a seed selector is required when running as seed agent")
+	}
+	if conn := o.config.SeedClientConnection; conn != nil {
+		if o.config.SeedAgent == nil || !*o.config.SeedAgent {
+			return fmt.Errorf("a seed client connection can only be used when running as seed agent")
+		}
+		if len(conn.SeedName) == 0 {
+			return fmt.Errorf("the seed client connection requires the name of the seed")
+		}
+	}
+	if rateLimit := o.config.Controllers.Shoot.CloudAPIRateLimit; rateLimit != nil {
+		if rateLimit.QPS <= 0 {
+			return fmt.Errorf("the qps of the cloud API rate limit must be greater than 0")
+		}
+		if rateLimit.Burst <= 0 {
+			return fmt.Errorf("the burst of the cloud API rate limit must be greater than 0")
+		}
+	}
+
 	// Add feature flags
 	if err := features.FeatureGate.SetFromMap(o.config.FeatureGates); err != nil {
 		return err
@@ -228,6 +256,8 @@ type Gardener struct {
 	Logger                 *logrus.Logger
 	Recorder               record.EventRecorder
 	LeaderElection         *leaderelection.LeaderElectionConfig
+	FlowRegistry           *flow.Registry
+	OperationLogs          *logger.OperationLogs
 }
 
 func restConfigFromClientConnectionConfiguration(cfg componentbaseconfig.ClientConnectionConfiguration) (*rest.Config, error) {
@@ -274,8 +304,12 @@ func NewGardener(cfg *config.ControllerManagerConfiguration) (*Gardener, error)
 		return nil, errors.New("config is required")
 	}
 
+	// Keep the log lines of the running Shoot operations so that they can be followed via the HTTPS server.
+	operationLogs := logger.NewOperationLogs(logger.DefaultOperationLogLines)
+
 	// Initialize logger
 	logger := logger.NewLogger(cfg.LogLevel)
+	logger.AddHook(operationLogs)
 	logger.Info("Starting Gardener controller manager...")
 	logger.Infof("Feature Gates: %s", features.FeatureGate.String())
 
@@ -330,6 +364,21 @@ func NewGardener(cfg *config.ControllerManagerConfiguration) (*Gardener, error)
 		return nil, err
 	}
 
+	if auth := cfg.SeedAuthentication; auth != nil {
+		kubernetes.SetTokenRequester(kubernetes.NewTokenRequester(k8sGardenClient.Kubernetes(), gardenerNamespace, auth.ServiceAccountName, auth.TokenExpiration.Duration))
+	}
+
+	// Seed agents may access their Seed cluster directly (e.g., in-cluster) so that the Seed secret in the Garden
+	// cluster does not need to contain a kubeconfig and the Seed's API server does not need to be reachable from outside.
+	if conn := cfg.SeedClientConnection; conn != nil {
+		seedRestCfg, err := restConfigFromClientConnectionConfiguration(conn.ClientConnection)
+		if err != nil {
+			return nil, err
+		}
+		seedpkg.SetLocalClientConfig(conn.SeedName, seedRestCfg)
+		logger.Infof("Using the local client connection for Seed %q.", conn.SeedName)
+	}
+
 	return &Gardener{
 		Identity:               identity,
 		GardenerNamespace:      gardenerNamespace,
@@ -341,6 +390,8 @@ func NewGardener(cfg *config.ControllerManagerConfiguration) (*Gardener, error)
 		K8sGardenCoreInformers: gardencoreinformers.NewSharedInformerFactory(k8sGardenClient.GardenCore(), 0),
 		KubeInformerFactory:    kubeinformers.NewSharedInformerFactory(k8sGardenClient.Kubernetes(), 0),
 		LeaderElection:         leaderElectionConfig,
+		FlowRegistry:           flow.NewRegistry(),
+		OperationLogs:          operationLogs,
 	}, nil
 }
 
@@ -361,7 +412,7 @@ func (g *Gardener) Run(ctx context.Context, cancel context.CancelFunc) error {
 	}
 
 	// Start HTTP server
-	go server.Serve(ctx, g.K8sGardenClient, g.K8sGardenInformers, g.Config.Server)
+	go server.Serve(ctx, g.K8sGardenClient, g.K8sGardenInformers, g.Config.Server, g.Config.Debugging, g.FlowRegistry, g.OperationLogs, g.Recorder)
 	handlers.UpdateHealth(true)
 
 	// If leader election is enabled, run via LeaderElector until done and exit.
@@ -401,6 +452,8 @@ func (g *Gardener) startControllers(ctx context.Context) {
 		g.Identity,
 		g.GardenerNamespace,
 		g.Recorder,
+		g.FlowRegistry,
+		g.OperationLogs,
 	).Run(ctx)
 }
 
@@ -0,0 +1,123 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/gardener/gardener/pkg/install"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// Options has all the context and parameters needed to run gardener-install.
+type Options struct {
+	// Kubeconfig is the path to the kubeconfig of the cluster into which the Gardener is installed.
+	Kubeconfig string
+	// ConfigFile is the path to the configuration file of the installation.
+	ConfigFile string
+	// ChartPath is the path to the directory containing the Gardener charts and the image vector.
+	ChartPath string
+	// LogLevel is the level/severity for the logs.
+	LogLevel string
+}
+
+// AddFlags adds flags for gardener-install to the specified FlagSet.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Kubeconfig, "kubeconfig", os.Getenv("KUBECONFIG"), "path to the kubeconfig of the cluster into which the Gardener is installed (defaults to $KUBECONFIG)")
+	fs.StringVar(&o.ConfigFile, "config", o.ConfigFile, "path to the configuration file of the installation")
+	fs.StringVar(&o.ChartPath, "chart-path", common.ChartPath, "path to the directory containing the Gardener charts and images.yaml")
+	fs.StringVar(&o.LogLevel, "log-level", "info", "the level/severity for the logs (debug, info, error)")
+}
+
+func (o *Options) validate(args []string) error {
+	if len(args) != 0 {
+		return errors.New("arguments are not supported")
+	}
+	if len(o.ConfigFile) == 0 {
+		return errors.New("the configuration file must be given with --config")
+	}
+	if len(o.Kubeconfig) == 0 {
+		return errors.New("the kubeconfig must be given with --kubeconfig or $KUBECONFIG")
+	}
+	return nil
+}
+
+func (o *Options) run(ctx context.Context) error {
+	config, err := install.LoadConfiguration(o.ConfigFile)
+	if err != nil {
+		return err
+	}
+	restConfig, err := clientcmd.BuildConfigFromFlags("", o.Kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	log := logger.NewLogger(o.LogLevel)
+	installer, err := install.New(restConfig, config, o.ChartPath, log)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Installing Gardener %s", config.Version)
+	if err := installer.Install(ctx); err != nil {
+		return err
+	}
+	log.Infof("Gardener %s has been installed successfully", config.Version)
+	return nil
+}
+
+// NewCommandGardenerInstall creates a *cobra.Command object with default parameters.
+func NewCommandGardenerInstall() *cobra.Command {
+	opts := &Options{}
+
+	cmd := &cobra.Command{
+		Use:   "gardener-install",
+		Short: "Install the Gardener into a Garden cluster",
+		Long: `gardener-install deploys etcd, the Gardener API server and the Gardener controller manager together
+with their APIServices, webhook configurations and certificates into a Kubernetes cluster. The installation
+is described by a single configuration file and can be repeated to update the Gardener.`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.validate(args); err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			signals := make(chan os.Signal, 1)
+			signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+			go func() {
+				<-signals
+				cancel()
+			}()
+
+			return opts.run(ctx)
+		},
+	}
+
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
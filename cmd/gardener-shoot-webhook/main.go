@@ -0,0 +1,102 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/shootwebhook"
+)
+
+// annotationsFlag is a repeatable flag which collects annotations of the form <key>=<value>.
+type annotationsFlag map[string]string
+
+func (a annotationsFlag) String() string {
+	pairs := make([]string, 0, len(a))
+	for key, value := range a {
+		pairs = append(pairs, key+"="+value)
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (a annotationsFlag) Set(value string) error {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 || len(parts[0]) == 0 {
+		return fmt.Errorf("annotation %q must have the form <key>=<value>", value)
+	}
+	a[parts[0]] = parts[1]
+	return nil
+}
+
+var (
+	bindAddress = flag.String("bind-address", "127.0.0.1", "The IP address on which the webhooks are served")
+	port        = flag.Int("port", 9443, "The port on which the webhooks are served")
+	certFile    = flag.String("tls-cert-file", "", "The file containing the x509 certificate for HTTPS")
+	keyFile     = flag.String("tls-private-key-file", "", "The file containing the x509 private key matching --tls-cert-file")
+	logLevel    = flag.String("log-level", "info", "The level/severity for the logs (debug, info, error)")
+
+	serviceLoadBalancerAnnotations = annotationsFlag{}
+)
+
+func main() {
+	flag.Var(serviceLoadBalancerAnnotations, "service-load-balancer-annotation", "An annotation of the form <key>=<value> which is added to services of type LoadBalancer (can be repeated)")
+	flag.Parse()
+
+	log := logger.NewLogger(*logLevel)
+	if len(*certFile) == 0 || len(*keyFile) == 0 {
+		log.Fatal("--tls-cert-file and --tls-private-key-file must be provided")
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(shootwebhook.ServiceDefaultsPath, shootwebhook.NewServiceDefaultsHandler(log, serviceLoadBalancerAnnotations))
+
+	server := &http.Server{
+		Addr:    net.JoinHostPort(*bindAddress, strconv.Itoa(*port)),
+		Handler: mux,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-signals
+		cancel()
+	}()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer shutdownCancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Error(err)
+		}
+	}()
+
+	log.Infof("Serving webhooks on %s with %d service load balancer annotation(s)", server.Addr, len(serviceLoadBalancerAnnotations))
+	if err := server.ListenAndServeTLS(*certFile, *keyFile); err != nil && err != http.ErrServerClosed {
+		log.Fatal(err)
+	}
+}
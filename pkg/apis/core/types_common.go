@@ -28,6 +28,11 @@ const (
 	ErrorInfraQuotaExceeded ErrorCode = "ERR_INFRA_QUOTA_EXCEEDED"
 	// ErrorInfraDependencies indicates that the last error occurred due to dependent objects on the cloud provider level.
 	ErrorInfraDependencies ErrorCode = "ERR_INFRA_DEPENDENCIES"
+	// ErrorInfraConflict indicates that the last error occurred due to conflicting (e.g., already existing) objects on
+	// the cloud provider level.
+	ErrorInfraConflict ErrorCode = "ERR_INFRA_CONFLICT"
+	// ErrorInfraTimeout indicates that the last error occurred due to a timeout while waiting for the cloud provider.
+	ErrorInfraTimeout ErrorCode = "ERR_INFRA_TIMEOUT"
 )
 
 // LastError indicates the last occurred error for an operation on a resource.
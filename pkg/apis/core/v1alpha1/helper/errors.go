@@ -45,12 +45,14 @@ var (
 	unauthorizedRegexp           = regexp.MustCompile(`(?i)(Unauthorized|InvalidClientTokenId|SignatureDoesNotMatch|Authentication failed|AuthFailure|AuthorizationFailed|invalid character|invalid_grant|invalid_client|Authorization Profile was not found|cannot fetch token|no active subscriptions|InvalidAccessKeyId|InvalidSecretAccessKey)`)
 	quotaExceededRegexp          = regexp.MustCompile(`(?i)(LimitExceeded|Quota)`)
 	insufficientPrivilegesRegexp = regexp.MustCompile(`(?i)(AccessDenied|Forbidden|deny|denied)`)
-	dependenciesRegexp           = regexp.MustCompile(`(?i)(PendingVerification|Access Not Configured|accessNotConfigured|DependencyViolation|OptInRequired|DeleteConflict|Conflict|inactive billing state)`)
+	dependenciesRegexp           = regexp.MustCompile(`(?i)(PendingVerification|Access Not Configured|accessNotConfigured|DependencyViolation|OptInRequired|DeleteConflict|inactive billing state)`)
+	conflictRegexp               = regexp.MustCompile(`(?i)(Conflict|AlreadyExists|already exists|InUse|in use by)`)
+	timeoutRegexp                = regexp.MustCompile(`(?i)(timeout while waiting|timed out|deadline exceeded|RequestTimeout)`)
 )
 
 // DetermineError determines the Garden error code for the given error message.
 func DetermineError(message string) error {
-	code := determineErrorCode(message)
+	code := DetermineErrorCode(message)
 	if code == "" {
 		return errors.New(message)
 	}
@@ -58,7 +60,9 @@ func DetermineError(message string) error {
 	return &errorWithCode{code, message}
 }
 
-func determineErrorCode(message string) gardencorev1alpha1.ErrorCode {
+// DetermineErrorCode determines the Garden error code for the given error message. It returns an empty code if the
+// message does not indicate any known cause.
+func DetermineErrorCode(message string) gardencorev1alpha1.ErrorCode {
 	switch {
 	case unauthorizedRegexp.MatchString(message):
 		return gardencorev1alpha1.ErrorInfraUnauthorized
@@ -68,6 +72,10 @@ func determineErrorCode(message string) gardencorev1alpha1.ErrorCode {
 		return gardencorev1alpha1.ErrorInfraInsufficientPrivileges
 	case dependenciesRegexp.MatchString(message):
 		return gardencorev1alpha1.ErrorInfraDependencies
+	case conflictRegexp.MatchString(message):
+		return gardencorev1alpha1.ErrorInfraConflict
+	case timeoutRegexp.MatchString(message):
+		return gardencorev1alpha1.ErrorInfraTimeout
 	default:
 		return ""
 	}
@@ -90,6 +98,28 @@ func ExtractErrorCodes(err error) []gardencorev1alpha1.ErrorCode {
 	return codes
 }
 
+// nonRetryableErrorCodes are the error codes of problems which are not resolved by retrying an operation but require
+// an action of the user, e.g. updating the cloud provider credentials or requesting a higher quota.
+var nonRetryableErrorCodes = map[gardencorev1alpha1.ErrorCode]struct{}{
+	gardencorev1alpha1.ErrorInfraUnauthorized:           {},
+	gardencorev1alpha1.ErrorInfraInsufficientPrivileges: {},
+	gardencorev1alpha1.ErrorInfraQuotaExceeded:          {},
+}
+
+// HasNonRetryableErrorCode returns true if the given last error carries an error code of a problem which is not
+// resolved by retrying the operation.
+func HasNonRetryableErrorCode(lastError *gardencorev1alpha1.LastError) bool {
+	if lastError == nil {
+		return false
+	}
+	for _, code := range lastError.Codes {
+		if _, ok := nonRetryableErrorCodes[code]; ok {
+			return true
+		}
+	}
+	return false
+}
+
 // FormatLastErrDescription formats the error message string for the last occurred error.
 func FormatLastErrDescription(err error) string {
 	errString := err.Error()
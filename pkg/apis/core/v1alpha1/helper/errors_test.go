@@ -38,6 +38,28 @@ var _ = Describe("helper", func() {
 				Entry("quota exceeded", "limitexceeded", NewErrorWithCode(gardencorev1alpha1.ErrorInfraQuotaExceeded, "limitexceeded")),
 				Entry("insufficient privileges", "accessdenied", NewErrorWithCode(gardencorev1alpha1.ErrorInfraInsufficientPrivileges, "accessdenied")),
 				Entry("infrastructure dependencies", "pendingverification", NewErrorWithCode(gardencorev1alpha1.ErrorInfraDependencies, "pendingverification")),
+				Entry("infrastructure dependencies before conflicts", "DeleteConflict", NewErrorWithCode(gardencorev1alpha1.ErrorInfraDependencies, "DeleteConflict")),
+				Entry("infrastructure conflict", "vpc already exists", NewErrorWithCode(gardencorev1alpha1.ErrorInfraConflict, "vpc already exists")),
+				Entry("infrastructure conflict formerly reported as dependencies", "Conflict: subnet is in use", NewErrorWithCode(gardencorev1alpha1.ErrorInfraConflict, "Conflict: subnet is in use")),
+				Entry("infrastructure timeout", "timeout while waiting for state", NewErrorWithCode(gardencorev1alpha1.ErrorInfraTimeout, "timeout while waiting for state")),
+			)
+		})
+
+		Describe("#HasNonRetryableErrorCode", func() {
+			DescribeTable("should determine whether the last error may be resolved by retrying",
+				func(lastError *gardencorev1alpha1.LastError, expected bool) {
+					Expect(HasNonRetryableErrorCode(lastError)).To(Equal(expected))
+				},
+
+				Entry("no last error", nil, false),
+				Entry("no codes", &gardencorev1alpha1.LastError{Description: "foo"}, false),
+				Entry("unauthorized", &gardencorev1alpha1.LastError{Codes: []gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraUnauthorized}}, true),
+				Entry("insufficient privileges", &gardencorev1alpha1.LastError{Codes: []gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraInsufficientPrivileges}}, true),
+				Entry("quota exceeded", &gardencorev1alpha1.LastError{Codes: []gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraQuotaExceeded}}, true),
+				Entry("infrastructure dependencies", &gardencorev1alpha1.LastError{Codes: []gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraDependencies}}, false),
+				Entry("infrastructure conflict", &gardencorev1alpha1.LastError{Codes: []gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraConflict}}, false),
+				Entry("infrastructure timeout", &gardencorev1alpha1.LastError{Codes: []gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraTimeout}}, false),
+				Entry("retryable and non-retryable codes", &gardencorev1alpha1.LastError{Codes: []gardencorev1alpha1.ErrorCode{gardencorev1alpha1.ErrorInfraTimeout, gardencorev1alpha1.ErrorInfraUnauthorized}}, true),
 			)
 		})
 	})
@@ -55,6 +55,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&WorkerList{},
 		&ControlPlane{},
 		&ControlPlaneList{},
+		&TerraformRun{},
+		&TerraformRunList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
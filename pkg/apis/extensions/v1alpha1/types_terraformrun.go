@@ -0,0 +1,110 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TerraformRun is a record of a single execution of Terraform by the Terraformer.
+type TerraformRun struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TerraformRunSpec   `json:"spec"`
+	Status TerraformRunStatus `json:"status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TerraformRunList is a list of TerraformRun resources.
+type TerraformRunList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of TerraformRuns.
+	Items []TerraformRun `json:"items"`
+}
+
+// TerraformRunSpec is the spec for a TerraformRun resource.
+type TerraformRunSpec struct {
+	// Name is the name of the Terraform configuration, e.g. the name of the Shoot.
+	Name string `json:"name"`
+	// Purpose is the purpose of the Terraform configuration, e.g. 'infra'.
+	Purpose string `json:"purpose"`
+	// Command is the executed Terraform command ('apply', 'destroy' or 'import').
+	Command string `json:"command"`
+}
+
+// TerraformRunStatus is the status for a TerraformRun resource.
+type TerraformRunStatus struct {
+	// Outcome is the outcome of the run.
+	Outcome TerraformRunOutcome `json:"outcome"`
+	// StartTime is the time when the run was started.
+	StartTime metav1.Time `json:"startTime"`
+	// CompletionTime is the time when the run was completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// ExitCode is the exit code of the last executed Terraform pod.
+	// +optional
+	ExitCode *int32 `json:"exitCode,omitempty"`
+	// Changes is the summary of the resources changed by the run as reported by Terraform.
+	// +optional
+	Changes *TerraformRunChanges `json:"changes,omitempty"`
+	// Errors are the errors reported by Terraform (at most ten, duplicates are omitted).
+	// +optional
+	Errors []TerraformRunError `json:"errors,omitempty"`
+	// LogsRef is a reference to the ConfigMap containing the (truncated) logs of the run.
+	// +optional
+	LogsRef *corev1.LocalObjectReference `json:"logsRef,omitempty"`
+}
+
+// TerraformRunError is an error reported by Terraform.
+type TerraformRunError struct {
+	// Message is the error message.
+	Message string `json:"message"`
+	// Code is the classification of the error, e.g. ERR_INFRA_QUOTA_EXCEEDED.
+	// +optional
+	Code gardencorev1alpha1.ErrorCode `json:"code,omitempty"`
+}
+
+// TerraformRunChanges is the summary of the resources changed by a run.
+type TerraformRunChanges struct {
+	// Added is the number of added resources.
+	Added int `json:"added"`
+	// Changed is the number of changed resources.
+	Changed int `json:"changed"`
+	// Destroyed is the number of destroyed resources.
+	Destroyed int `json:"destroyed"`
+}
+
+// TerraformRunOutcome is the outcome of a TerraformRun.
+type TerraformRunOutcome string
+
+const (
+	// TerraformRunRunning indicates that the run has not been completed yet.
+	TerraformRunRunning TerraformRunOutcome = "Running"
+	// TerraformRunSucceeded indicates that the run has been completed successfully.
+	TerraformRunSucceeded TerraformRunOutcome = "Succeeded"
+	// TerraformRunFailed indicates that the run has failed.
+	TerraformRunFailed TerraformRunOutcome = "Failed"
+)
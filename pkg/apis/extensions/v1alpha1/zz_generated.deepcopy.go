@@ -22,6 +22,7 @@ package v1alpha1
 
 import (
 	corev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -749,6 +750,156 @@ func (in *OperatingSystemConfigStatus) DeepCopy() *OperatingSystemConfigStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformRun) DeepCopyInto(out *TerraformRun) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformRun.
+func (in *TerraformRun) DeepCopy() *TerraformRun {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TerraformRun) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformRunChanges) DeepCopyInto(out *TerraformRunChanges) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformRunChanges.
+func (in *TerraformRunChanges) DeepCopy() *TerraformRunChanges {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformRunChanges)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformRunError) DeepCopyInto(out *TerraformRunError) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformRunError.
+func (in *TerraformRunError) DeepCopy() *TerraformRunError {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformRunError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformRunList) DeepCopyInto(out *TerraformRunList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TerraformRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformRunList.
+func (in *TerraformRunList) DeepCopy() *TerraformRunList {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformRunList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TerraformRunList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformRunSpec) DeepCopyInto(out *TerraformRunSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformRunSpec.
+func (in *TerraformRunSpec) DeepCopy() *TerraformRunSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformRunSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformRunStatus) DeepCopyInto(out *TerraformRunStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ExitCode != nil {
+		in, out := &in.ExitCode, &out.ExitCode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = new(TerraformRunChanges)
+		**out = **in
+	}
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]TerraformRunError, len(*in))
+		copy(*out, *in)
+	}
+	if in.LogsRef != nil {
+		in, out := &in.LogsRef, &out.LogsRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformRunStatus.
+func (in *TerraformRunStatus) DeepCopy() *TerraformRunStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformRunStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Unit) DeepCopyInto(out *Unit) {
 	*out = *in
@@ -19,6 +19,8 @@ import (
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/apis/garden"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 // DetermineCloudProviderInProfile takes a CloudProfile specification and returns the cloud provider this profile is used for.
@@ -132,3 +134,135 @@ func GetK8SNetworks(shoot *garden.Shoot) (gardencore.K8SNetworks, error) {
 	}
 	return gardencore.K8SNetworks{}, nil
 }
+
+// GetShootZones returns the zones of the Shoot cluster.
+func GetShootZones(cloud garden.Cloud) []string {
+	switch {
+	case cloud.AWS != nil:
+		return cloud.AWS.Zones
+	case cloud.GCP != nil:
+		return cloud.GCP.Zones
+	case cloud.OpenStack != nil:
+		return cloud.OpenStack.Zones
+	case cloud.Alicloud != nil:
+		return cloud.Alicloud.Zones
+	case cloud.Packet != nil:
+		return cloud.Packet.Zones
+	}
+	return nil
+}
+
+// GetShootWorkers returns the generic worker pool settings of the Shoot cluster.
+func GetShootWorkers(cloud garden.Cloud) []garden.Worker {
+	var workers []garden.Worker
+
+	switch {
+	case cloud.AWS != nil:
+		for _, w := range cloud.AWS.Workers {
+			workers = append(workers, w.Worker)
+		}
+	case cloud.Azure != nil:
+		for _, w := range cloud.Azure.Workers {
+			workers = append(workers, w.Worker)
+		}
+	case cloud.GCP != nil:
+		for _, w := range cloud.GCP.Workers {
+			workers = append(workers, w.Worker)
+		}
+	case cloud.OpenStack != nil:
+		for _, w := range cloud.OpenStack.Workers {
+			workers = append(workers, w.Worker)
+		}
+	case cloud.Alicloud != nil:
+		for _, w := range cloud.Alicloud.Workers {
+			workers = append(workers, w.Worker)
+		}
+	case cloud.Packet != nil:
+		for _, w := range cloud.Packet.Workers {
+			workers = append(workers, w.Worker)
+		}
+	}
+
+	return workers
+}
+
+// GetShootWorkerNetworks returns the networks of the Shoot cluster in which the worker nodes are created.
+func GetShootWorkerNetworks(cloud garden.Cloud) []gardencore.CIDR {
+	switch {
+	case cloud.AWS != nil:
+		return cloud.AWS.Networks.Workers
+	case cloud.Azure != nil:
+		return []gardencore.CIDR{cloud.Azure.Networks.Workers}
+	case cloud.GCP != nil:
+		return cloud.GCP.Networks.Workers
+	case cloud.OpenStack != nil:
+		return cloud.OpenStack.Networks.Workers
+	case cloud.Alicloud != nil:
+		return cloud.Alicloud.Networks.Workers
+	case cloud.Local != nil:
+		return cloud.Local.Networks.Workers
+	}
+	return nil
+}
+
+// WorkerArchitecture returns the CPU architecture of the machines of the given worker pool.
+func WorkerArchitecture(worker garden.Worker) string {
+	return architecture(worker.Architecture)
+}
+
+// MachineTypeArchitecture returns the CPU architecture of the given machine type.
+func MachineTypeArchitecture(machineType garden.MachineType) string {
+	return architecture(machineType.Architecture)
+}
+
+// IsAWSRegionalMachineImageForArchitecture returns true if the given regional machine image is built for <arch>.
+func IsAWSRegionalMachineImageForArchitecture(image garden.AWSRegionalMachineImage, arch string) bool {
+	return architecture(image.Architecture) == arch
+}
+
+func architecture(arch *string) string {
+	if arch == nil {
+		return garden.ArchitectureAMD64
+	}
+	return *arch
+}
+
+// IsSeedVisible returns true if the given Seed is selectable for the scheduling of new Shoots. The scheduling setting
+// of the Seed takes precedence over its Visible field.
+func IsSeedVisible(seed *garden.Seed) bool {
+	if settings := seed.Spec.Settings; settings != nil && settings.Scheduling != nil {
+		return settings.Scheduling.Visible
+	}
+	return seed.Spec.Visible != nil && *seed.Spec.Visible
+}
+
+// IsSeedShootDNSEnabled returns true if the DNS records of the Shoots in the given Seed are managed by Gardener.
+func IsSeedShootDNSEnabled(seed *garden.Seed) bool {
+	if settings := seed.Spec.Settings; settings != nil && settings.ShootDNS != nil {
+		return settings.ShootDNS.Enabled
+	}
+	return true
+}
+
+// ShootUsesUnmanagedDNS returns true if the DNS records of the given Shoot are not managed by Gardener.
+func ShootUsesUnmanagedDNS(shoot *garden.Shoot) bool {
+	return shoot.Spec.DNS.Provider != nil && *shoot.Spec.DNS.Provider == garden.DNSUnmanaged
+}
+
+// GetShootRequiredDeletionApprovals returns the number of distinct users who have to approve the deletion of the given
+// Shoot, i.e., the highest number of required approvals of all DeletionProtectionPolicies matching the Shoot.
+func GetShootRequiredDeletionApprovals(policies []*garden.DeletionProtectionPolicy, shoot *garden.Shoot) (int32, error) {
+	var requiredApprovals int32
+
+	for _, policy := range policies {
+		selector, err := metav1.LabelSelectorAsSelector(&policy.Spec.ShootSelector)
+		if err != nil {
+			return 0, err
+		}
+		if selector.Matches(labels.Set(shoot.Labels)) && policy.Spec.RequiredApprovals > requiredApprovals {
+			requiredApprovals = policy.Spec.RequiredApprovals
+		}
+	}
+
+	return requiredApprovals, nil
+}
@@ -152,4 +152,48 @@ var _ = Describe("helper", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+
+	Describe("#IsSeedVisible", func() {
+		var (
+			trueVar  = true
+			falseVar = false
+		)
+
+		It("should use the Visible field if no scheduling setting is given", func() {
+			Expect(IsSeedVisible(&garden.Seed{Spec: garden.SeedSpec{Visible: &trueVar}})).To(BeTrue())
+			Expect(IsSeedVisible(&garden.Seed{Spec: garden.SeedSpec{Visible: &falseVar}})).To(BeFalse())
+			Expect(IsSeedVisible(&garden.Seed{})).To(BeFalse())
+		})
+
+		It("should let the scheduling setting take precedence over the Visible field", func() {
+			seed := &garden.Seed{
+				Spec: garden.SeedSpec{
+					Visible: &trueVar,
+					Settings: &garden.SeedSettings{
+						Scheduling: &garden.SeedSettingScheduling{Visible: false},
+					},
+				},
+			}
+
+			Expect(IsSeedVisible(seed)).To(BeFalse())
+		})
+	})
+
+	Describe("#IsSeedShootDNSEnabled", func() {
+		It("should consider the shoot DNS enabled if no setting is given", func() {
+			Expect(IsSeedShootDNSEnabled(&garden.Seed{})).To(BeTrue())
+		})
+
+		It("should return the shoot DNS setting", func() {
+			seed := &garden.Seed{
+				Spec: garden.SeedSpec{
+					Settings: &garden.SeedSettings{
+						ShootDNS: &garden.SeedSettingShootDNS{Enabled: false},
+					},
+				},
+			}
+
+			Expect(IsSeedShootDNSEnabled(seed)).To(BeFalse())
+		})
+	})
 })
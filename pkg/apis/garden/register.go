@@ -49,6 +49,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&BackupInfrastructureList{},
 		&CloudProfile{},
 		&CloudProfileList{},
+		&DeletionProtectionPolicy{},
+		&DeletionProtectionPolicyList{},
 		&Project{},
 		&ProjectList{},
 		&Quota{},
@@ -59,6 +61,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&SecretBindingList{},
 		&Shoot{},
 		&ShootList{},
+		&ShootTemplate{},
+		&ShootTemplateList{},
 	)
 	return nil
 }
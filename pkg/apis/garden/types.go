@@ -85,6 +85,19 @@ type CloudProfileSpec struct {
 	// CABundle is a certificate bundle which will be installed onto every host machine of the Shoot cluster.
 	// +optional
 	CABundle *string
+	// Terraformer pins the version of the Terraformer which creates the infrastructure of the Shoots using this
+	// profile. If not set, the version of the image vector of the Gardener controller manager is used.
+	// +optional
+	Terraformer *TerraformerSettings
+	// CustomMachineImages controls which Shoots using this profile may specify custom machine images for their worker
+	// pools. If not set, custom machine images are not allowed.
+	CustomMachineImages *CustomMachineImages
+}
+
+// CustomMachineImages controls which Shoots may specify custom machine images for their worker pools.
+type CustomMachineImages struct {
+	// Projects is a list of names of the projects whose Shoots may use custom machine images.
+	Projects []string
 }
 
 // AWSProfile defines certain constraints and definitions for the AWS cloud.
@@ -125,11 +138,16 @@ type AWSMachineImageMapping struct {
 	Regions []AWSRegionalMachineImage
 }
 
+// AWSRegionalMachineImage defines the technical id of a machine image in a region.
 type AWSRegionalMachineImage struct {
 	// Name is the name of a region.
 	Name string
 	// AMI is the technical id of the image (specific for region stated in the 'Name' field).
 	AMI string
+	// Architecture is the CPU architecture the image is built for (default: amd64). A region may contain one entry
+	// per architecture.
+	// +optional
+	Architecture *string
 }
 
 // AzureProfile defines certain constraints and definitions for the Azure cloud.
@@ -225,6 +243,10 @@ type OpenStackProfile struct {
 	// RequestTimeout specifies the HTTP timeout against the OpenStack API.
 	// +optional
 	RequestTimeout *string
+	// NetworkMTU is the MTU of the networks in the OpenStack system. It is propagated into the CNI and VPN
+	// configuration of Shoots; if not set, 1500 is assumed.
+	// +optional
+	NetworkMTU *int32
 }
 
 // OpenStackConstraints is an object containing constraints for certain values in the Shoot specification.
@@ -382,6 +404,9 @@ type MachineType struct {
 	GPU resource.Quantity
 	// Memory is the amount of memory for this machine type.
 	Memory resource.Quantity
+	// Architecture is the CPU architecture of this machine type (default: amd64).
+	// +optional
+	Architecture *string
 }
 
 // OpenStackMachineType contains certain properties of a machine type in OpenStack
@@ -417,6 +442,12 @@ type Zone struct {
 	Region string
 	// Names is a list of availability zone names in this region.
 	Names []string
+	// UnavailableMachineTypes is a list of machine type names that cannot be used in the zones of this entry.
+	// Zones of the same region with different availability can be declared in separate entries.
+	UnavailableMachineTypes []string
+	// UnavailableVolumeTypes is a list of volume type names that cannot be used in the zones of this entry.
+	// Zones of the same region with different availability can be declared in separate entries.
+	UnavailableVolumeTypes []string
 }
 
 // MachineImageName is a string alias.
@@ -431,6 +462,13 @@ const (
 	MachineImageCoreOSAlicloud MachineImageName = "coreos-alicloud"
 )
 
+const (
+	// ArchitectureAMD64 is a constant for the amd64 CPU architecture.
+	ArchitectureAMD64 = "amd64"
+	// ArchitectureARM64 is a constant for the arm64 CPU architecture.
+	ArchitectureARM64 = "arm64"
+)
+
 ////////////////////////////////////////////////////
 //                    PROJECTS                    //
 ////////////////////////////////////////////////////
@@ -488,6 +526,32 @@ type ProjectSpec struct {
 	// Namespace is the name of the namespace that has been created for the Project object.
 	// +optional
 	Namespace *string
+	// Restrictions restricts the cloud profiles, regions and cloud provider secrets which may be used by the Shoots of the project.
+	// +optional
+	Restrictions *ProjectRestrictions
+}
+
+// ProjectRestrictions restricts the cloud profiles, regions and cloud provider secrets which may be used by the Shoots of a project.
+type ProjectRestrictions struct {
+	// CloudProfiles is a list of cloud profiles which may be used by the Shoots. All cloud profiles are allowed if it is empty.
+	// +optional
+	CloudProfiles []ProjectCloudProfile
+	// Secrets is a list of references to cloud provider secrets which may be referenced by the secret bindings in the
+	// project namespace. All secrets are allowed if it is empty.
+	// +optional
+	Secrets []corev1.SecretReference
+	// DefaultSecretBinding is the name of the secret binding which is used by Shoots that do not reference one.
+	// +optional
+	DefaultSecretBinding *string
+}
+
+// ProjectCloudProfile is a cloud profile which may be used by the Shoots of a project.
+type ProjectCloudProfile struct {
+	// Name is the name of the cloud profile.
+	Name string
+	// Regions is a list of regions of the cloud profile which may be used by the Shoots. All regions are allowed if it is empty.
+	// +optional
+	Regions []string
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -554,6 +618,10 @@ type SeedSpec struct {
 	// IngressDomain is the domain of the Seed cluster pointing to the ingress controller endpoint. It will be used
 	// to construct ingress URLs for system applications running in Shoot clusters.
 	IngressDomain string
+	// IngressTLS configures the TLS certificates of the ingresses for the monitoring and logging endpoints of the
+	// Shoot clusters. If not set, self-signed certificates are generated for every ingress.
+	// +optional
+	IngressTLS *SeedIngressTLS
 	// SecretRef is a reference to a Secret object containing the Kubeconfig and the cloud provider credentials for
 	// the account the Seed cluster has been deployed to.
 	SecretRef corev1.SecretReference
@@ -565,6 +633,30 @@ type SeedSpec struct {
 	// Protected prevent that the Seed Cluster can be used for regular Shoot cluster control planes.
 	// +optional
 	Protected *bool
+	// Settings contains certain settings for this seed cluster.
+	// +optional
+	Settings *SeedSettings
+	// Backup holds the configuration of the backup infrastructure of the Shoot clusters hosted by this Seed.
+	// +optional
+	Backup *SeedBackup
+}
+
+// SeedBackup holds the configuration of the backup infrastructure of the Shoot clusters hosted by a Seed.
+type SeedBackup struct {
+	// Region is the region in which the backup buckets are created, e.g. if backups must stay in a certain
+	// country. It must be a region of the cloud profile of the Seed unless another Provider is configured. Defaults
+	// to the region of the Seed.
+	// +optional
+	Region *string
+	// Provider is the cloud provider of the backup buckets if they shall be created with another cloud provider than
+	// the one of the Seed, e.g. to store the backups of the Shoots hosted by a GCP Seed in AWS S3. It requires a
+	// SecretRef and a Region. Defaults to the cloud provider of the Seed.
+	// +optional
+	Provider *CloudProvider
+	// SecretRef is a reference to a Secret containing the cloud provider credentials for the backup buckets. Defaults
+	// to the secret of the Seed.
+	// +optional
+	SecretRef *corev1.SecretReference
 }
 
 // SeedStatus holds the most recently observed status of the Seed cluster.
@@ -572,6 +664,49 @@ type SeedStatus struct {
 	// Conditions represents the latest available observations of a Seed's current state.
 	// +optional
 	Conditions []gardencore.Condition
+	// Orphans is a list of resources in the Seed cluster which do not belong to any Shoot anymore.
+	// +optional
+	Orphans []SeedOrphan
+	// KubernetesVersion is the Kubernetes version of the Seed cluster. It is used to check whether the Kubernetes
+	// versions of the Shoots hosted by the Seed cluster are compatible.
+	// +optional
+	KubernetesVersion string
+	// ProviderHealth reports the health of the cloud provider account of the Seed, i.e. the reachability of the cloud
+	// API and the remaining headroom of the quotas in the region of the Seed.
+	// +optional
+	ProviderHealth *SeedProviderHealth
+}
+
+// SeedProviderHealth reports the health of the cloud provider account of a Seed.
+type SeedProviderHealth struct {
+	// LastCheckTime is the last time the cloud provider account has been checked.
+	LastCheckTime metav1.Time
+	// Quotas is the list of quotas of the cloud provider account in the region of the Seed.
+	// +optional
+	Quotas []SeedProviderQuota
+}
+
+// SeedProviderQuota is a quota of the cloud provider account of a Seed.
+type SeedProviderQuota struct {
+	// Name is the name of the quota (e.g., instances, elastic-ips, or vpcs).
+	Name string
+	// Limit is the maximal number of resources allowed by the quota.
+	Limit int64
+	// Used is the number of resources currently counting against the quota.
+	Used int64
+}
+
+// SeedOrphan is a resource in the Seed cluster which does not belong to any Shoot anymore, e.g. because its
+// deletion has failed.
+type SeedOrphan struct {
+	// Kind is the kind of the orphaned resource (Namespace, Secret, or DNSEntry).
+	Kind string
+	// Namespace is the namespace of the orphaned resource. It is empty for Namespaces.
+	Namespace string
+	// Name is the name of the orphaned resource.
+	Name string
+	// DetectionTime is the time when the resource has been detected as orphaned for the first time.
+	DetectionTime metav1.Time
 }
 
 // SeedCloud defines the cloud profile and the region this Seed cluster belongs to.
@@ -582,6 +717,137 @@ type SeedCloud struct {
 	Region string
 }
 
+// SeedIngressTLS configures the TLS certificates of the ingresses in the Shoot namespaces of a Seed cluster.
+// Exactly one of its fields must be set.
+type SeedIngressTLS struct {
+	// SecretRef is a reference to a Secret object containing a wildcard certificate for "*.<ingressDomain>" in
+	// the keys "tls.crt" and "tls.key". The ingress hosts are flattened so that they are covered by the certificate.
+	// +optional
+	SecretRef *corev1.SecretReference
+	// ACME configures the issuance of a certificate for every ingress via the ACME protocol. It requires that
+	// cert-manager is running in the Seed cluster.
+	// +optional
+	ACME *SeedIngressACME
+}
+
+// SeedIngressACME configures the issuance of the ingress certificates via the ACME protocol.
+type SeedIngressACME struct {
+	// ClusterIssuer is the name of the cert-manager ClusterIssuer in the Seed cluster which issues the certificates.
+	ClusterIssuer string
+}
+
+// SeedSettings contains certain settings for this seed cluster.
+type SeedSettings struct {
+	// NetworkPolicies controls the network policies deployed into the Shoot namespaces of this seed cluster.
+	// +optional
+	NetworkPolicies *SeedSettingNetworkPolicies
+	// DashboardAuthentication controls how the access to the monitoring and logging dashboards of the Shoots is
+	// protected. If not set, generated basic authentication credentials are used.
+	// +optional
+	DashboardAuthentication *SeedSettingDashboardAuthentication
+	// ExcessCapacityReservation controls the excess capacity reservation for shoot control planes in this seed
+	// cluster. If not set, the configuration of the Gardener controller manager is used.
+	// +optional
+	ExcessCapacityReservation *SeedSettingExcessCapacityReservation
+	// ShootDNS controls whether the DNS records of the Shoots in this seed cluster are managed by Gardener.
+	// +optional
+	ShootDNS *SeedSettingShootDNS
+	// Scheduling controls whether this seed cluster is considered by the scheduling of new Shoots. If set, it
+	// takes precedence over the Visible field of the seed specification.
+	// +optional
+	Scheduling *SeedSettingScheduling
+	// VerticalPodAutoscaler controls whether the vertical pod autoscaler is deployed into this seed cluster. If not
+	// set, the VPA feature gate of the Gardener controller manager is used.
+	// +optional
+	VerticalPodAutoscaler *SeedSettingVerticalPodAutoscaler
+	// LoadBalancerServices controls the services of type LoadBalancer created in this seed cluster.
+	// +optional
+	LoadBalancerServices *SeedSettingLoadBalancerServices
+	// Terraformer pins the version of the Terraformer used for the Shoots and backup infrastructures in this seed
+	// cluster. It takes precedence over the version pinned in the CloudProfile.
+	// +optional
+	Terraformer *TerraformerSettings
+}
+
+// SeedSettingNetworkPolicies controls the network policies deployed into the Shoot namespaces of a seed cluster.
+type SeedSettingNetworkPolicies struct {
+	// Enabled controls whether a default-deny network policy plus the policies explicitly allowing the required
+	// traffic between the control plane components are deployed into the Shoot namespaces. Defaults to true.
+	Enabled bool
+}
+
+// SeedSettingDashboardAuthentication controls how the access to the monitoring and logging dashboards of the Shoots
+// in a seed cluster is protected.
+type SeedSettingDashboardAuthentication struct {
+	// OIDC configures an OpenID Connect proxy in front of the dashboards. Only users which are owner or members
+	// of the Shoot's project are granted access.
+	// +optional
+	OIDC *SeedDashboardOIDC
+}
+
+// SeedSettingExcessCapacityReservation controls the excess capacity reservation for shoot control planes in a
+// seed cluster.
+type SeedSettingExcessCapacityReservation struct {
+	// Enabled controls whether the excess capacity reservation is deployed into the seed cluster.
+	Enabled bool
+	// Replicas is the number of low-priority placeholder pods reserving capacity in the seed cluster. If not set,
+	// the number is computed from the number of Shoots hosted by the seed cluster.
+	// +optional
+	Replicas *int32
+	// Resources are the resources (requests and limits) reserved by every placeholder pod. If not set, 500m CPU
+	// and 1200Mi memory are reserved per pod. Resources which are not given keep their default.
+	// +optional
+	Resources corev1.ResourceList
+}
+
+// SeedSettingShootDNS controls whether the DNS records of the Shoots in a seed cluster are managed by Gardener.
+type SeedSettingShootDNS struct {
+	// Enabled controls whether the DNS records of the Shoots are managed. If disabled, only Shoots with an unmanaged
+	// DNS provider can be scheduled onto the seed cluster, and they are reached via the load balancer of their
+	// kube-apiserver. Shoots which already have DNS records keep them. Defaults to true.
+	Enabled bool
+}
+
+// SeedSettingScheduling controls whether a seed cluster is considered by the scheduling of new Shoots.
+type SeedSettingScheduling struct {
+	// Visible controls whether the seed cluster is selectable for the seedmanager admission plugin.
+	Visible bool
+}
+
+// SeedSettingVerticalPodAutoscaler controls whether the vertical pod autoscaler is deployed into a seed cluster.
+type SeedSettingVerticalPodAutoscaler struct {
+	// Enabled controls whether the vertical pod autoscaler is deployed into the seed cluster and whether
+	// VerticalPodAutoscaler resources are created for the Shoot control plane components.
+	Enabled bool
+}
+
+// SeedSettingLoadBalancerServices controls the services of type LoadBalancer created in a seed cluster.
+type SeedSettingLoadBalancerServices struct {
+	// Annotations are added to the services of type LoadBalancer, e.g. the kube-apiserver services of the Shoots.
+	// They take precedence over the annotations set by Gardener for the respective cloud provider.
+	// +optional
+	Annotations map[string]string
+}
+
+// TerraformerSettings contains the settings for the Terraformer which runs Terraform to create infrastructure resources.
+type TerraformerSettings struct {
+	// Version is the tag of the Terraformer image. The image bundles Terraform and its provider plugins, hence,
+	// the tag pins their versions. It must be a semantic version.
+	Version string
+}
+
+// SeedDashboardOIDC configures the OpenID Connect proxy protecting the dashboards of the Shoots in a seed cluster.
+type SeedDashboardOIDC struct {
+	// IssuerURL is the URL of the OpenID Connect provider. It must use the https scheme.
+	IssuerURL string
+	// ClientID is the ID of the OpenID Connect client. The client must allow redirects to
+	// "https://*.<ingressDomain>/oauth2/callback".
+	ClientID string
+	// SecretRef is a reference to a Secret object containing the secret of the OpenID Connect client in the
+	// key "clientSecret".
+	SecretRef corev1.SecretReference
+}
+
 // SeedNetworks contains CIDRs for the pod, service and node networks of a Kubernetes cluster.
 type SeedNetworks struct {
 	// Nodes is the CIDR of the node network.
@@ -665,18 +931,78 @@ const (
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// SecretBinding holds a reference to a secret with cloud provider credentials and the quotas which apply to its usage.
 type SecretBinding struct {
 	metav1.TypeMeta
 	// Standard object metadata.
 	// +optional
 	metav1.ObjectMeta
-	// SecretRef is a reference to a secret object in the same or another namespace.
+	// SecretRef is a reference to a secret object in the same or another namespace. It must not be set if the
+	// credentials are read from Vault.
 	SecretRef corev1.SecretReference
+	// VaultRef is a reference to a path in Vault from which the cloud provider credentials are read whenever a Shoot
+	// is reconciled.
+	// +optional
+	VaultRef *SecretBindingVaultReference
 	// Quotas is a list of references to Quota objects in the same or another namespace.
 	// +optional
 	Quotas []corev1.ObjectReference
+	// Rotation describes a rotation of the cloud provider credentials to another secret. While the rotation is in
+	// progress, the Shoots switch to the new secret whereas the previous one is kept until all of them use it.
+	// +optional
+	Rotation *SecretBindingRotation
+}
+
+// SecretBindingVaultReference is a reference to cloud provider credentials stored in Vault.
+type SecretBindingVaultReference struct {
+	// Path is the path of the credentials relative to the Vault path of the SecretBinding's namespace, e.g.
+	// 'aws/creds/deployer' for dynamic AWS credentials.
+	Path string
+	// KeyMapping maps keys of the cloud provider secret to keys of the data returned by Vault. Keys which are not
+	// mapped are taken over unchanged.
+	// +optional
+	KeyMapping map[string]string
+}
+
+// SecretBindingRotation describes a rotation of the cloud provider credentials of a SecretBinding.
+type SecretBindingRotation struct {
+	// SecretRef is a reference to the secret containing the new credentials.
+	SecretRef corev1.SecretReference
+	// Status contains the progress of the rotation. It is maintained by the Gardener.
+	// +optional
+	Status *SecretBindingRotationStatus
+}
+
+// SecretBindingRotationStatus contains the progress of a credentials rotation.
+type SecretBindingRotationStatus struct {
+	// Phase is the current phase of the rotation.
+	Phase SecretBindingRotationPhase
+	// LastUpdateTime is the last time the status has been updated.
+	LastUpdateTime metav1.Time
+	// Description is a human-readable message about the progress of the rotation.
+	Description string
+	// RotatedShoots is the list of Shoots (in the namespace of the SecretBinding) which have been verified to work
+	// with the new credentials.
+	// +optional
+	RotatedShoots []string
+	// PreviousSecretRef is a reference to the secret with the previous credentials after the rotation has been
+	// completed. It is no longer used by the Gardener.
+	// +optional
+	PreviousSecretRef *corev1.SecretReference
 }
 
+// SecretBindingRotationPhase is the phase of a credentials rotation.
+type SecretBindingRotationPhase string
+
+const (
+	// SecretBindingRotationPhaseRolling is the phase in which the Shoots are switched to the new credentials. The
+	// previous credentials must remain valid in this phase.
+	SecretBindingRotationPhaseRolling SecretBindingRotationPhase = "Rolling"
+	// SecretBindingRotationPhaseCompleted is the phase after all Shoots use the new credentials. The previous
+	// credentials can be revoked.
+	SecretBindingRotationPhaseCompleted SecretBindingRotationPhase = "Completed"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // SecretBindingList is a collection of SecretBindings.
@@ -689,6 +1015,114 @@ type SecretBindingList struct {
 	Items []SecretBinding
 }
 
+////////////////////////////////////////////////////
+//                 SHOOT TEMPLATES                //
+////////////////////////////////////////////////////
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootTemplate contains default settings for Shoot clusters which reference it by name. Templates in the
+// garden namespace can be referenced by Shoots of all projects.
+type ShootTemplate struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta
+	// Spec contains the default settings of the ShootTemplate.
+	Spec ShootTemplateSpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootTemplateList is a collection of ShootTemplates.
+type ShootTemplateList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta
+	// Items is the list of ShootTemplates.
+	Items []ShootTemplate
+}
+
+// ShootTemplateSpec is the specification of a ShootTemplate. Its settings are only applied to a Shoot if the
+// Shoot does not specify them itself.
+type ShootTemplateSpec struct {
+	// Addons contains the default addon configuration.
+	// +optional
+	Addons *Addons
+	// Networks contains the default networks of the Kubernetes cluster.
+	// +optional
+	Networks *gardencore.K8SNetworks
+	// Workers is a list of default worker pools.
+	// +optional
+	Workers []ShootTemplateWorker
+}
+
+// ShootTemplateWorker is the provider independent configuration of a default worker pool.
+type ShootTemplateWorker struct {
+	Worker
+	// VolumeType is the type of the root volumes.
+	// +optional
+	VolumeType string
+	// VolumeSize is the size of the root volume.
+	// +optional
+	VolumeSize string
+}
+
+// ShootTemplateReference is a reference to a ShootTemplate.
+type ShootTemplateReference struct {
+	// Name is the name of the ShootTemplate.
+	Name string
+	// Namespace is the namespace of the ShootTemplate. It defaults to the namespace of the Shoot.
+	// +optional
+	Namespace string
+}
+
+////////////////////////////////////////////////////
+//          DELETION PROTECTION POLICIES          //
+////////////////////////////////////////////////////
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DeletionProtectionPolicy protects the Shoots matching its label selector from being deleted without the approval
+// of multiple users, and it extends the retention of their backups after deletion.
+type DeletionProtectionPolicy struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta
+	// Spec contains the specification of the DeletionProtectionPolicy.
+	Spec DeletionProtectionPolicySpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DeletionProtectionPolicyList is a collection of DeletionProtectionPolicies.
+type DeletionProtectionPolicyList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta
+	// Items is the list of DeletionProtectionPolicies.
+	Items []DeletionProtectionPolicy
+}
+
+// DeletionProtectionPolicySpec is the specification of a DeletionProtectionPolicy.
+type DeletionProtectionPolicySpec struct {
+	// ShootSelector selects the Shoots (across all projects) which are protected by the policy.
+	ShootSelector metav1.LabelSelector
+	// RequiredApprovals is the number of distinct users who have to approve the deletion of a protected Shoot
+	// before it can be deleted.
+	RequiredApprovals int32
+	// BackupRetention is the minimum duration the backup infrastructure of a deleted protected Shoot is kept before
+	// it is garbage collected.
+	// +optional
+	BackupRetention *metav1.Duration
+}
+
 ////////////////////////////////////////////////////
 //                      SHOOTS                    //
 ////////////////////////////////////////////////////
@@ -696,6 +1130,7 @@ type SecretBindingList struct {
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// Shoot represents a Shoot cluster created and managed by Gardener.
 type Shoot struct {
 	metav1.TypeMeta
 	// Standard object metadata.
@@ -742,6 +1177,70 @@ type ShootSpec struct {
 	// operations should be performed.
 	// +optional
 	Maintenance *Maintenance
+	// Monitoring contains information about the monitoring stack of the Shoot which is deployed in the Seed.
+	// +optional
+	Monitoring *Monitoring
+	// SizingProfile is the profile which determines the resources and replica counts of the control plane and addon
+	// components of the Shoot. If it is not set then it is selected based on the maximum number of worker nodes.
+	// +optional
+	SizingProfile *SizingProfile
+	// Storage contains information about the StorageClasses which are managed by Gardener in the Shoot cluster.
+	// +optional
+	Storage *Storage
+	// Template is a reference to a ShootTemplate whose settings are applied to the Shoot when it is created.
+	// +optional
+	Template *ShootTemplateReference
+}
+
+// SizingProfile is a profile which determines the resources and replica counts of the system components of a Shoot.
+type SizingProfile string
+
+const (
+	// SizingProfileTiny is the sizing profile for Shoots with up to 2 worker nodes.
+	SizingProfileTiny SizingProfile = "tiny"
+	// SizingProfileSmall is the sizing profile for Shoots with up to 10 worker nodes.
+	SizingProfileSmall SizingProfile = "small"
+	// SizingProfileMedium is the sizing profile for Shoots with up to 50 worker nodes.
+	SizingProfileMedium SizingProfile = "medium"
+	// SizingProfileLarge is the sizing profile for Shoots with up to 100 worker nodes.
+	SizingProfileLarge SizingProfile = "large"
+	// SizingProfileXLarge is the sizing profile for Shoots with more than 100 worker nodes.
+	SizingProfileXLarge SizingProfile = "xlarge"
+)
+
+// Storage contains information about the StorageClasses which are managed by Gardener in the Shoot cluster.
+type Storage struct {
+	// Classes is a list of StorageClasses which are created in addition to the default StorageClasses of the cloud
+	// provider. A class with the same name as a default StorageClass replaces it.
+	// +optional
+	Classes []StorageClass
+}
+
+// StorageClass contains the provider independent settings of a StorageClass in the Shoot cluster. Gardener computes
+// the provisioner and its parameters based on the cloud provider of the Shoot.
+type StorageClass struct {
+	// Name is the name of the StorageClass.
+	Name string
+	// Default specifies whether the StorageClass is the default StorageClass of the Shoot cluster. At most one
+	// StorageClass can be the default one; it replaces the default StorageClass of the cloud provider.
+	// +optional
+	Default *bool
+	// VolumeType is the type of the volumes provisioned for this StorageClass (e.g., gp2 or io1 on AWS, pd-ssd on GCP,
+	// Premium_LRS on Azure, cloud_ssd on Alicloud, or a Cinder volume type on OpenStack).
+	// +optional
+	VolumeType *string
+	// Encrypted specifies whether the provisioned volumes are encrypted. Only supported for AWS and Alicloud, the volumes
+	// on the other cloud providers are always encrypted at rest.
+	// +optional
+	Encrypted *bool
+	// Zones restricts the provisioning of volumes to the given zones of the Shoot. All zones of the Shoot are allowed
+	// if it is not set.
+	// +optional
+	Zones []string
+	// Parameters is a map of additional parameters which are passed to the provisioner as they are. They must not
+	// overwrite the parameters computed by Gardener.
+	// +optional
+	Parameters map[string]string
 }
 
 // ShootStatus holds the most recently observed status of the Shoot cluster.
@@ -749,6 +1248,9 @@ type ShootStatus struct {
 	// Conditions represents the latest available observations of a Shoots's current state.
 	// +optional
 	Conditions []gardencore.Condition
+	// Constraints represents conditions of a Shoot's current state that constrain some operations on it.
+	// +optional
+	Constraints []gardencore.Condition
 	// Gardener holds information about the Gardener which last acted on the Shoot.
 	Gardener Gardener
 	// LastOperation holds information about the last operation on the Shoot.
@@ -765,6 +1267,10 @@ type ShootStatus struct {
 	// must be retried until we give up).
 	// +optional
 	RetryCycleStartTime *metav1.Time
+	// RetryCount is the number of consecutive failed attempts of the current operation. It is reset when the operation
+	// succeeds, when a new retry cycle starts or when the Shoot is annotated with the `retry` operation.
+	// +optional
+	RetryCount int32
 	// Seed is the name of the seed cluster that runs the control plane of the Shoot. This value is only written
 	// after a successful create/reconcile operation. It will be used when control planes are moved between Seeds.
 	Seed string
@@ -774,6 +1280,71 @@ type ShootStatus struct {
 	// UID is a unique identifier for the Shoot cluster to avoid portability between Kubernetes clusters.
 	// It is used to compute unique hashes.
 	UID types.UID
+	// APIServerSLO contains the service level objective attainment of the kube-apiserver of the Shoot cluster.
+	// +optional
+	APIServerSLO *APIServerSLO
+	// EgressIPs is the list of static IP addresses the worker nodes of the Shoot cluster use for egress traffic
+	// to the internet. It is empty if the egress IPs are not known or not stable, e.g. when they are allocated automatically.
+	// +optional
+	EgressIPs []string
+	// KubeletVersion is the Kubernetes version of the kubelets of the Shoot, i.e., the version which has been applied
+	// by the last successful reconciliation. It is used to prevent updates which violate the version skew policy.
+	// +optional
+	KubeletVersion string
+	// ReconciledStateHash is the hash of the effective desired state (the specification of the Shoot, the versions of
+	// the referenced cloud profile, seed and secrets, and the Gardener version) which has been applied by the last
+	// successful reconciliation. It is used to skip reconciliations if nothing has changed since then.
+	// +optional
+	ReconciledStateHash string
+	// Hibernated indicates whether the Shoot has been hibernated by the last successful operation.
+	// +optional
+	Hibernated bool
+	// AccessAudit contains the timestamps of security relevant accesses to the Shoot and its cloud provider account.
+	// +optional
+	AccessAudit *ShootAccessAudit
+}
+
+// ShootAccessAudit contains the timestamps of security relevant accesses to a Shoot cluster and its cloud provider
+// account which are observed by the Gardener.
+type ShootAccessAudit struct {
+	// LastCredentialsUseTime is the last time the Gardener used the cloud provider credentials of the Shoot to
+	// create, update or delete its infrastructure.
+	// +optional
+	LastCredentialsUseTime *metav1.Time
+	// LastKubeconfigReadTime is the last time the kubeconfig secret of the Shoot was read in the garden cluster.
+	// +optional
+	LastKubeconfigReadTime *metav1.Time
+	// LastKubeconfigRotationTime is the last time the kubeconfig secret of the Shoot was issued with new credentials.
+	// +optional
+	LastKubeconfigRotationTime *metav1.Time
+	// LastSSHKeypairReadTime is the last time the SSH key pair for the worker nodes of the Shoot was read in the
+	// garden cluster.
+	// +optional
+	LastSSHKeypairReadTime *metav1.Time
+}
+
+// APIServerSLO contains the service level objective attainment of the kube-apiserver of a Shoot cluster within a
+// rolling window of 30 days.
+type APIServerSLO struct {
+	// Availability is the percentage of kube-apiserver requests which were not answered with a server error.
+	Availability string
+	// Latency is the percentage of kube-apiserver requests which were answered within one second.
+	Latency string
+	// Samples are the daily service level indicators the attainment is computed from.
+	// +optional
+	Samples []APIServerSLOSample
+	// LastUpdateTime is the time when the service level indicators were last computed.
+	LastUpdateTime metav1.Time
+}
+
+// APIServerSLOSample contains the service level indicators of the kube-apiserver of a Shoot cluster for one day.
+type APIServerSLOSample struct {
+	// Day is the day (UTC) of the sample in the format YYYY-MM-DD.
+	Day string
+	// Availability is the percentage of kube-apiserver requests which were not answered with a server error.
+	Availability string
+	// Latency is the percentage of kube-apiserver requests which were answered within one second.
+	Latency string
 }
 
 ///////////////////////////////
@@ -792,6 +1363,16 @@ type Cloud struct {
 	// Seed is the name of a Seed object.
 	// +optional
 	Seed *string
+	// Tags is a map of additional tags (e.g., cost center, owner, environment) which are applied to all resources
+	// managed by Gardener in the cloud provider account. On GCP, OpenStack and Alicloud they are applied to the
+	// machines only (as GCE labels, server metadata and ECS tags). Not supported for Packet and Local.
+	// +optional
+	Tags map[string]string
+	// ServiceLoadBalancer contains defaults for the load balancers of services of type LoadBalancer in the Shoot
+	// cluster. They are applied when such a service is created; annotations set on the service take precedence.
+	// Not supported for Packet and Local.
+	// +optional
+	ServiceLoadBalancer *ServiceLoadBalancer
 	// AWS contains the Shoot specification for the Amazon Web Services cloud.
 	// +optional
 	AWS *AWSCloud
@@ -815,8 +1396,17 @@ type Cloud struct {
 	Local *Local
 }
 
-// AWSCloud contains the Shoot specification for AWS.
+// ServiceLoadBalancer contains defaults for the load balancers of services of type LoadBalancer in the Shoot cluster.
+type ServiceLoadBalancer struct {
+	// Internal specifies whether the load balancers are only reachable from within the network of the Shoot.
+	// +optional
+	Internal *bool
+	// Annotations is a map of additional annotations which are added to the services.
+	// +optional
+	Annotations map[string]string
+}
 
+// AWSCloud contains the Shoot specification for AWS.
 type AWSCloud struct {
 	// MachineImage holds information about the machine image to use for all workers.
 	// It will default to the first image stated in the referenced CloudProfile if no
@@ -829,6 +1419,17 @@ type AWSCloud struct {
 	Workers []AWSWorker
 	// Zones is a list of availability zones to deploy the Shoot cluster to.
 	Zones []string
+	// ServiceLoadBalancer contains AWS specific defaults for the load balancers of services of type LoadBalancer in
+	// the Shoot cluster.
+	// +optional
+	ServiceLoadBalancer *AWSServiceLoadBalancer
+}
+
+// AWSServiceLoadBalancer contains AWS specific defaults for the load balancers of services of type LoadBalancer.
+type AWSServiceLoadBalancer struct {
+	// Type is the type of the load balancers ('classic' or 'nlb'). Defaults to 'classic'.
+	// +optional
+	Type *string
 }
 
 // AWSNetworks holds information about the Kubernetes and infrastructure networks.
@@ -842,6 +1443,38 @@ type AWSNetworks struct {
 	Public []gardencore.CIDR
 	// Workers is a list of worker subnets (private) to create (used for the VMs).
 	Workers []gardencore.CIDR
+	// ExistingSubnets is a list of already existing subnets per zone which shall be used instead of creating
+	// new ones. It is only allowed in combination with an existing VPC. The routing (e.g., NAT gateways) for
+	// existing subnets must already be configured.
+	// +optional
+	ExistingSubnets []AWSZoneSubnets
+	// ExistingNATGateways is a list of already existing NAT gateways per zone which shall be used for the private
+	// subnets instead of creating new ones. It is only allowed in combination with an existing VPC and for zones
+	// without existing subnets.
+	// +optional
+	ExistingNATGateways []AWSZoneNATGateway
+}
+
+// AWSZoneNATGateway references an existing NAT gateway in a zone.
+type AWSZoneNATGateway struct {
+	// Zone is the name of the availability zone the NAT gateway belongs to.
+	Zone string
+	// ID is the id of the existing NAT gateway. If it is not provided, the available NAT gateway of the existing
+	// VPC in the zone is discovered automatically.
+	// +optional
+	ID *string
+}
+
+// AWSZoneSubnets contains the ids of existing subnets in a zone.
+type AWSZoneSubnets struct {
+	// Zone is the name of the availability zone the subnets belong to.
+	Zone string
+	// Internal is the id of the existing private subnet (used for internal load balancers).
+	Internal string
+	// Public is the id of the existing public subnet (used for bastion and load balancers).
+	Public string
+	// Workers is the id of the existing worker subnet (private) (used for the VMs).
+	Workers string
 }
 
 // AWSVPC contains either an id (of an existing VPC) or the CIDR (for a VPC to be created).
@@ -852,6 +1485,10 @@ type AWSVPC struct {
 	// CIDR is a CIDR range for a new VPC.
 	// +optional
 	CIDR *gardencore.CIDR
+	// InternetGatewayID is the id of an existing internet gateway attached to the existing VPC. If it is not
+	// provided, the internet gateway attached to the VPC is discovered automatically.
+	// +optional
+	InternetGatewayID *string
 }
 
 // AWSWorker is the definition of a worker group.
@@ -861,10 +1498,21 @@ type AWSWorker struct {
 	VolumeType string
 	// VolumeSize is the size of the root volume.
 	VolumeSize string
+	// VolumeIOPS is the number of I/O operations per second (IOPS) that the root volume supports. It is only
+	// allowed for the provisioned IOPS volume types (io1, io2) and for gp3 volumes.
+	// +optional
+	VolumeIOPS *int64
+	// VolumeThroughput is the throughput in MiB/s that the root volume supports. It is only allowed for gp3 volumes.
+	// +optional
+	VolumeThroughput *int64
 }
 
 // Alicloud contains the Shoot specification for Alibaba cloud
 type Alicloud struct {
+	// APIServerLoadBalancer contains the configuration of the SLB which exposes the kube-apiserver of the Shoot.
+	// The address type must not be 'intranet' because the kube-apiserver must be reachable from the Seed and Garden cluster.
+	// +optional
+	APIServerLoadBalancer *AlicloudLoadBalancer
 	// MachineImage holds information about the machine image to use for all workers.
 	// It will default to the first image stated in the referenced CloudProfile if no
 	// value has been provided.
@@ -876,6 +1524,23 @@ type Alicloud struct {
 	Workers []AlicloudWorker
 	// Zones is a list of availability zones to deploy the Shoot cluster to, currently, only one is supported.
 	Zones []string
+	// ServiceLoadBalancer contains Alicloud specific defaults for the SLBs of services of type LoadBalancer in the
+	// Shoot cluster.
+	// +optional
+	ServiceLoadBalancer *AlicloudLoadBalancer
+}
+
+// AlicloudLoadBalancer contains the configuration of an Alicloud server load balancer (SLB).
+type AlicloudLoadBalancer struct {
+	// Spec is the instance specification of the SLB, e.g. 'slb.s2.small'.
+	// +optional
+	Spec *string
+	// AddressType is the address type of the SLB ('internet' or 'intranet'). Defaults to 'internet'.
+	// +optional
+	AddressType *string
+	// Bandwidth is the maximum bandwidth of the SLB in Mbps. If set, the SLB is charged by bandwidth.
+	// +optional
+	Bandwidth *int32
 }
 
 // AlicloudVPC contains either an id (of an existing VPC) or the CIDR (for a VPC to be created).
@@ -895,6 +1560,41 @@ type AlicloudNetworks struct {
 	VPC AlicloudVPC
 	// Workers is a CIDR of a worker subnet (private) to create (used for the VMs).
 	Workers []gardencore.CIDR
+	// NatGateway contains the configuration of the NAT gateways which are used by the worker vswitches.
+	// +optional
+	NatGateway *AlicloudNatGateway
+}
+
+// AlicloudNatGateway contains the configuration of the NAT gateways of a Shoot.
+type AlicloudNatGateway struct {
+	// PerZone indicates that an enhanced NAT gateway is created for each zone (attached to the zone's worker
+	// vswitch) instead of using one NAT gateway for all zones.
+	// +optional
+	PerZone bool
+	// EIPBandwidth is the maximum bandwidth of the NAT EIPs in Mbps. It applies to all zones which do not
+	// specify their own bandwidth. Defaults to 100.
+	// +optional
+	EIPBandwidth *int32
+	// EIPInternetChargeType is the internet charge type of the NAT EIPs ('PayByTraffic' or 'PayByBandwidth'). It
+	// applies to all zones which do not specify their own charge type. If not set, the charge type of the EIP of
+	// the VPC's NAT gateway is kept, or 'PayByTraffic' is used if there is none.
+	// +optional
+	EIPInternetChargeType *string
+	// Zones contains the EIP configuration of the NAT of each zone.
+	// +optional
+	Zones []AlicloudNatGatewayZone
+}
+
+// AlicloudNatGatewayZone contains the EIP configuration of the NAT of a zone.
+type AlicloudNatGatewayZone struct {
+	// Name is the name of the zone.
+	Name string
+	// EIPBandwidth is the maximum bandwidth of the zone's EIP in Mbps. Defaults to 100.
+	// +optional
+	EIPBandwidth *int32
+	// EIPInternetChargeType is the internet charge type of the zone's EIP ('PayByTraffic' or 'PayByBandwidth').
+	// +optional
+	EIPInternetChargeType *string
 }
 
 // AlicloudWorker is the definition of a worker group.
@@ -974,6 +1674,26 @@ type AzureVNet struct {
 	// CIDR is a CIDR range for a new VNet.
 	// +optional
 	CIDR *gardencore.CIDR
+	// Peering contains the configuration for peering the VNet with a hub VNet.
+	// +optional
+	Peering *AzureVNetPeering
+}
+
+// AzureVNetPeering contains the configuration for peering the VNet of a Shoot with a remote (hub) VNet.
+// Only the peering from the Shoot VNet to the remote VNet is created; the peering in the opposite direction
+// must be established by the owner of the remote VNet.
+type AzureVNetPeering struct {
+	// RemoteVNetID is the resource id of the remote VNet.
+	RemoteVNetID string
+	// RemoteCIDRs is the list of address spaces of the remote VNet. They must not overlap with the networks
+	// of the Shoot.
+	RemoteCIDRs []gardencore.CIDR
+	// AllowForwardedTraffic controls whether forwarded traffic from VMs in the remote VNet is allowed.
+	// +optional
+	AllowForwardedTraffic bool
+	// UseRemoteGateways controls whether the gateways of the remote VNet are used.
+	// +optional
+	UseRemoteGateways bool
 }
 
 // AzureWorker is the definition of a worker group.
@@ -1010,6 +1730,10 @@ type GCPNetworks struct {
 	Internal *gardencore.CIDR
 	// Workers is a list of CIDRs of worker subnets (private) to create (used for the VMs).
 	Workers []gardencore.CIDR
+	// CloudNAT contains the configuration of a Cloud NAT for the worker subnet. If set, a Cloud Router and a
+	// Cloud NAT are created for the Shoot and the worker machines are created without external IPs.
+	// +optional
+	CloudNAT *GCPCloudNAT
 }
 
 // GCPVPC indicates whether to use an existing VPC or create a new one.
@@ -1018,6 +1742,26 @@ type GCPVPC struct {
 	Name string
 }
 
+// GCPCloudNAT contains the configuration of a Cloud NAT.
+type GCPCloudNAT struct {
+	// MinPortsPerVM is the minimum number of ports allocated to a VM from the NAT IPs. Defaults to 64.
+	// +optional
+	MinPortsPerVM *int32
+	// NatIPNames is a list of names of existing static external IP addresses in the Shoot's region which
+	// are used for the NAT. If empty, NAT IPs are allocated automatically.
+	// +optional
+	NatIPNames []string
+	// Logging contains the logging configuration of the NAT. If not set, logging is disabled.
+	// +optional
+	Logging *GCPCloudNATLogging
+}
+
+// GCPCloudNATLogging contains the logging configuration of a Cloud NAT.
+type GCPCloudNATLogging struct {
+	// Filter specifies which NAT events are logged. Must be one of 'ERRORS_ONLY', 'TRANSLATIONS_ONLY' or 'ALL'.
+	Filter string
+}
+
 // GCPWorker is the definition of a worker group.
 type GCPWorker struct {
 	Worker
@@ -1033,6 +1777,10 @@ type OpenStackCloud struct {
 	FloatingPoolName string
 	// LoadBalancerProvider is the name of the load balancer provider in the OpenStack environment.
 	LoadBalancerProvider string
+	// FloatingPoolSubnetName is the name of a subnet of the floating pool network. If set, FIPs for the
+	// router and for services of type LoadBalancer are allocated from this subnet only.
+	// +optional
+	FloatingPoolSubnetName *string
 	// MachineImage holds information about the machine image to use for all workers.
 	// It will default to the first image stated in the referenced CloudProfile if no
 	// value has been provided.
@@ -1104,10 +1852,115 @@ type Worker struct {
 	Annotations map[string]string
 	// Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.
 	Labels map[string]string
+	// InstanceTagLabels is a list of keys of the `Labels` of this worker pool whose key/value pairs are additionally
+	// added as tags (AWS, Alicloud) or labels (GCP) to the cloud provider instances of this worker pool, e.g. to allow
+	// cost and inventory tools to group the machines by their Kubernetes labels.
+	InstanceTagLabels []string
 	// Taints is a list of taints for all the `Node` objects in this worker pool.
 	Taints []corev1.Taint
+	// Zones is a subset of the Shoot's zones in which the machines of this worker pool are created. If it is
+	// empty then the worker pool spans all zones of the Shoot.
+	Zones []string
+	// OSUpdates contains configuration for in-place updates of the operating system of this worker pool. If it is
+	// set then the nodes receive OS patches in-place instead of being replaced.
+	OSUpdates *WorkerOSUpdates
+	// CustomMachineImage is the provider-specific id of a machine image which is not listed in the CloudProfile, e.g.
+	// an AMI on AWS or an URN ('<publisher>:<offer>:<sku>:<version>') on Azure. The image must run the same operating
+	// system as the machine image of the Shoot. It is only allowed if the CustomMachineImages feature gate of the
+	// Gardener API server is enabled and the CloudProfile allows custom machine images for the project of the Shoot.
+	CustomMachineImage *string
+	// MaxPods is the maximum number of pods which can run on a node of this worker pool (default: 110). It must not
+	// exceed the number of addresses of the pod CIDR of a node.
+	// +optional
+	MaxPods *int32
+	// DataVolumes is a list of additional volumes which are attached to the machines of this worker pool, e.g. as
+	// local scratch disks. They are only supported for AWS and GCP.
+	// +optional
+	DataVolumes []DataVolume
+	// KubeletDataVolumeName is the name of a data volume which is formatted and mounted at the root directory of the
+	// kubelet ('/var/lib/kubelet'), i.e., the pod volumes and container logs are stored on it instead of the root volume.
+	// +optional
+	KubeletDataVolumeName *string
+	// Kubelet contains configuration for the image garbage collection and the container log rotation of the kubelets
+	// of this worker pool.
+	// +optional
+	Kubelet *WorkerKubeletConfig
+	// Architecture is the CPU architecture of the machines of this worker pool (default: amd64). It must match the
+	// architecture of the machine type.
+	// +optional
+	Architecture *string
+	// Capacity contains configuration for cost-optimized worker pools which mix on-demand and spot (preemptible)
+	// machines and which may fall back to other machine types. It is only supported for GCP and Alicloud.
+	// +optional
+	Capacity *WorkerCapacity
 }
 
+// WorkerCapacity contains configuration for mixing on-demand and spot machines and for fallback machine types of a
+// worker pool. Every combination of a machine type and a purchasing option results in its own machine deployments
+// which are prioritized by the cluster-autoscaler.
+type WorkerCapacity struct {
+	// FallbackMachineTypes is an ordered list of machine types which are used by the cluster-autoscaler if machines
+	// of the machine type of the worker pool cannot be created, e.g. because the cloud provider lacks capacity.
+	// +optional
+	FallbackMachineTypes []string
+	// SpotPercentage is the percentage of the maximum number of machines of the worker pool (autoScalerMax) which may
+	// be spot (preemptible) machines (default: 0). Spot machines are preferred by the cluster-autoscaler, however, the
+	// minimum number of machines (autoScalerMin) is always provided by on-demand machines.
+	// +optional
+	SpotPercentage *int32
+}
+
+// WorkerKubeletConfig contains configuration for the kubelets of a worker pool.
+type WorkerKubeletConfig struct {
+	// ImageGCHighThresholdPercent is the percent of disk usage after which the image garbage collection is always
+	// run (default: 50).
+	// +optional
+	ImageGCHighThresholdPercent *int32
+	// ImageGCLowThresholdPercent is the percent of disk usage before which the image garbage collection is never
+	// run, it must be lower than the high threshold (default: 40).
+	// +optional
+	ImageGCLowThresholdPercent *int32
+	// ContainerLogMaxSize is the maximum size of a container log file before it is rotated (default: 100Mi).
+	// +optional
+	ContainerLogMaxSize *resource.Quantity
+	// ContainerLogMaxFiles is the maximum number of rotated log files which are kept per container (default: 14).
+	// +optional
+	ContainerLogMaxFiles *int32
+}
+
+// DataVolume contains the configuration of an additional volume which is attached to the machines of a worker pool.
+type DataVolume struct {
+	// Name is the name of the data volume, it must be unique within the worker pool.
+	Name string
+	// Size is the size of the data volume, e.g. '100Gi'.
+	Size string
+	// Type is the provider-specific type of the data volume (default: type of the root volume).
+	// +optional
+	Type *string
+	// Encrypted determines whether the data volume is encrypted (default: true).
+	// +optional
+	Encrypted *bool
+}
+
+// WorkerOSUpdates contains configuration for in-place updates of the operating system of a worker pool.
+type WorkerOSUpdates struct {
+	// Channel is the update channel from which the operating system receives in-place updates.
+	Channel WorkerOSUpdateChannel
+	// RebootWindow is the daily time window in which nodes may be rebooted to activate updates. If it is not set
+	// then the maintenance time window of the Shoot is used.
+	RebootWindow *MaintenanceTimeWindow
+}
+
+// WorkerOSUpdateChannel is a channel from which the operating system receives in-place updates.
+type WorkerOSUpdateChannel string
+
+const (
+	// WorkerOSUpdateChannelStable is the channel for stable operating system updates.
+	WorkerOSUpdateChannelStable WorkerOSUpdateChannel = "stable"
+	// WorkerOSUpdateChannelBeta is the channel for beta operating system updates.
+	WorkerOSUpdateChannelBeta WorkerOSUpdateChannel = "beta"
+)
+
 // Addons is a collection of configuration for specific addons which are managed by the Gardener.
 type Addons struct {
 	// KubernetesDashboard holds configuration settings for the kubernetes dashboard addon.
@@ -1164,6 +2017,16 @@ type KubernetesDashboard struct {
 	AuthenticationMode *string
 }
 
+const (
+	// KubernetesDashboardAuthModeBasic uses basic authentication mode for auth.
+	KubernetesDashboardAuthModeBasic = "basic"
+	// KubernetesDashboardAuthModeToken uses token-based mode for auth.
+	KubernetesDashboardAuthModeToken = "token"
+	// KubernetesDashboardAuthModeOIDC uses token-based mode for auth and expects an OIDC front-proxy to pass
+	// the user's ID token in the Authorization header. It requires the kube-apiserver's OIDC configuration.
+	KubernetesDashboardAuthModeOIDC = "oidc"
+)
+
 // ClusterAutoscaler describes configuration values for the cluster-autoscaler addon.
 type ClusterAutoscaler struct {
 	Addon
@@ -1234,6 +2097,10 @@ type DNS struct {
 	// this behavior, i.e. forcing the Gardener to only look into the given secret.
 	// +optional
 	SecretName *string
+	// TTL is the time-to-live in seconds of the DNS records for the API server of the Shoot cluster. Lower values
+	// make changes of the API server endpoint visible faster at the cost of more DNS queries. Defaults to 120.
+	// +optional
+	TTL *int64
 }
 
 // DNSUnmanaged is a constant for the 'unmanaged' DNS provider.
@@ -1333,6 +2200,32 @@ type KubeAPIServerConfig struct {
 	// AuditConfig contains configuration settings for the audit of the kube-apiserver.
 	// +optional
 	AuditConfig *AuditConfig
+	// AllowedCIDRs is a list of CIDRs from which the load balancer of the kube-apiserver accepts connections. The
+	// node network, the static egress IPs of the worker nodes and the node and pod networks of the Seed are always
+	// allowed. If empty, the kube-apiserver is reachable from everywhere. It is not supported for Alicloud and local Shoots.
+	// +optional
+	AllowedCIDRs []gardencore.CIDR
+	// EnableBasicAuthentication defines whether the kube-apiserver accepts basic authentication. Basic
+	// authentication is deprecated and should be disabled. Defaults to true.
+	// +optional
+	EnableBasicAuthentication *bool
+	// AuthenticationWebhook configures an external webhook which authenticates bearer tokens.
+	// +optional
+	AuthenticationWebhook *AuthenticationWebhook
+}
+
+// AuthenticationWebhook contains the configuration of an external token authentication webhook of the
+// kube-apiserver.
+type AuthenticationWebhook struct {
+	// URL is the HTTPS endpoint of the webhook which receives TokenReview requests.
+	URL string
+	// CABundle is the PEM-encoded bundle of certificate authorities which is used to verify the serving
+	// certificate of the webhook. If empty, the system's trusted root certificates are used.
+	// +optional
+	CABundle *string
+	// CacheTTL is the duration to cache the responses of the webhook. Defaults to 2m.
+	// +optional
+	CacheTTL *metav1.Duration
 }
 
 // AuditConfig contains settings for audit of the api server
@@ -1408,6 +2301,18 @@ type KubeControllerManagerConfig struct {
 	// HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager.
 	// +optional
 	HorizontalPodAutoscalerConfig *HorizontalPodAutoscalerConfig
+	// NodeCIDRMaskSize is the mask size of the pod CIDR which is assigned to every node (default: 24). It determines
+	// the maximum number of nodes of the Shoot (given by the size of the pods network) and the maximum number of pods
+	// per node. It cannot be changed once the Shoot has been created.
+	// +optional
+	NodeCIDRMaskSize *int
+	// NodeMonitorGracePeriod is the amount of time which a running node may be unresponsive before it is marked
+	// unhealthy (default: 40s).
+	// +optional
+	NodeMonitorGracePeriod *metav1.Duration
+	// PodEvictionTimeout is the grace period for deleting pods on failed nodes (default: 2m).
+	// +optional
+	PodEvictionTimeout *metav1.Duration
 }
 
 // HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager.
@@ -1482,6 +2387,10 @@ type Maintenance struct {
 	// TimeWindow contains information about the time window for maintenance operations.
 	// +optional
 	TimeWindow *MaintenanceTimeWindow
+	// ConfineSpecUpdateRollout prevents that changes to the specification of the Shoot are rolled out outside of its
+	// maintenance time window. Changes of the hibernation state and the deletion are not confined.
+	// +optional
+	ConfineSpecUpdateRollout *bool
 }
 
 // MaintenanceAutoUpdate contains information about which constraints should be automatically updated.
@@ -1500,6 +2409,18 @@ type MaintenanceTimeWindow struct {
 	End string
 }
 
+// Monitoring contains information about the monitoring stack of the Shoot which is deployed in the Seed.
+type Monitoring struct {
+	// Enabled indicates whether the monitoring stack (Prometheus, Alertmanager, Grafana and kube-state-metrics)
+	// is deployed for the Shoot. Defaults to true.
+	// +optional
+	Enabled *bool
+	// ScrapeTargets is an allowlist of the scrape jobs of the Shoot's Prometheus. If it is empty, all jobs are
+	// scraped.
+	// +optional
+	ScrapeTargets []string
+}
+
 const (
 	// DefaultETCDBackupSchedule is a constant for the default schedule to take backups of a Shoot cluster (5 minutes).
 	DefaultETCDBackupSchedule = "0 */24 * * *"
@@ -1568,6 +2489,12 @@ const (
 const (
 	// SeedAvailable is a constant for a condition type indicating the Seed cluster availability.
 	SeedAvailable gardencore.ConditionType = "Available"
+	// SeedShootVersionsCompatible is a constant for a condition type indicating whether the Kubernetes versions of
+	// all Shoots hosted by the Seed cluster are compatible with the Kubernetes version of the Seed cluster.
+	SeedShootVersionsCompatible gardencore.ConditionType = "ShootVersionsCompatible"
+	// SeedProviderHealthy is a constant for a condition type indicating whether the cloud API of the Seed's cloud
+	// provider account is reachable and whether its quotas have enough headroom for new Shoots.
+	SeedProviderHealthy gardencore.ConditionType = "ProviderHealthy"
 
 	// ShootControlPlaneHealthy is a constant for a condition type indicating the control plane health.
 	ShootControlPlaneHealthy gardencore.ConditionType = "ControlPlaneHealthy"
@@ -1577,6 +2504,19 @@ const (
 	ShootSystemComponentsHealthy gardencore.ConditionType = "SystemComponentsHealthy"
 	// ShootAPIServerAvailable is a constant for a condition type indicating the api server is available.
 	ShootAPIServerAvailable gardencore.ConditionType = "APIServerAvailable"
+	// ShootHibernationPossible is a constant for a constraint type indicating whether the Shoot can be hibernated
+	// and woken up again without manual intervention.
+	ShootHibernationPossible gardencore.ConditionType = "HibernationPossible"
+	// ShootBackupReady is a constant for a condition type indicating that the backup bucket of the Shoot's etcd is
+	// reachable and writable.
+	ShootBackupReady gardencore.ConditionType = "BackupReady"
+	// ShootTunnelHealthy is a constant for a condition type indicating that the node, pod and service networks of
+	// the Shoot are reachable from the Seed through the VPN tunnel.
+	ShootTunnelHealthy gardencore.ConditionType = "TunnelHealthy"
+
+	// BackupInfrastructureBucketReady is a constant for a condition type indicating that the backup bucket exists,
+	// is writable, and that the credentials used to access it are valid.
+	BackupInfrastructureBucketReady gardencore.ConditionType = "BucketReady"
 )
 
 ////////////////////////////////////////////////////
@@ -1617,6 +2557,9 @@ type BackupInfrastructureSpec struct {
 	Seed string
 	// ShootUID is a unique identifier for the Shoot cluster for which the BackupInfrastructure object is created.
 	ShootUID types.UID
+	// Region is the region in which the backup bucket is created. Defaults to the region of the Seed.
+	// +optional
+	Region *string
 }
 
 // BackupInfrastructureStatus holds the most recently observed status of the Backup Infrastructure.
@@ -1631,4 +2574,7 @@ type BackupInfrastructureStatus struct {
 	// BackupInfrastructure's generation, which is updated on mutation by the API Server.
 	// +optional
 	ObservedGeneration *int64
+	// Conditions represents the latest available observations of a BackupInfrastructure's current state.
+	// +optional
+	Conditions []gardencore.Condition
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils"
 
 	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -33,13 +34,16 @@ func SetDefaults_Shoot(obj *Shoot) {
 		defaultPodCIDR     = gardencorev1alpha1.DefaultPodNetworkCIDR
 		defaultServiceCIDR = gardencorev1alpha1.DefaultServiceNetworkCIDR
 		defaultProxyMode   = ProxyModeIPTables
+		// Shoots referencing a ShootTemplate get their pod and service networks defaulted by the ShootTemplate
+		// admission plugin after the template has been applied.
+		defaultK8SNetworks = obj.Spec.Template == nil
 	)
 
 	if cloud.AWS != nil {
-		if cloud.AWS.Networks.Pods == nil {
+		if defaultK8SNetworks && cloud.AWS.Networks.Pods == nil {
 			obj.Spec.Cloud.AWS.Networks.Pods = &defaultPodCIDR
 		}
-		if cloud.AWS.Networks.Services == nil {
+		if defaultK8SNetworks && cloud.AWS.Networks.Services == nil {
 			obj.Spec.Cloud.AWS.Networks.Services = &defaultServiceCIDR
 		}
 		if cloud.AWS.Networks.Nodes == nil {
@@ -52,10 +56,10 @@ func SetDefaults_Shoot(obj *Shoot) {
 	}
 
 	if cloud.Azure != nil {
-		if cloud.Azure.Networks.Pods == nil {
+		if defaultK8SNetworks && cloud.Azure.Networks.Pods == nil {
 			obj.Spec.Cloud.Azure.Networks.Pods = &defaultPodCIDR
 		}
-		if cloud.Azure.Networks.Services == nil {
+		if defaultK8SNetworks && cloud.Azure.Networks.Services == nil {
 			obj.Spec.Cloud.Azure.Networks.Services = &defaultServiceCIDR
 		}
 		if cloud.Azure.Networks.Nodes == nil {
@@ -64,10 +68,10 @@ func SetDefaults_Shoot(obj *Shoot) {
 	}
 
 	if cloud.GCP != nil {
-		if cloud.GCP.Networks.Pods == nil {
+		if defaultK8SNetworks && cloud.GCP.Networks.Pods == nil {
 			obj.Spec.Cloud.GCP.Networks.Pods = &defaultPodCIDR
 		}
-		if cloud.GCP.Networks.Services == nil {
+		if defaultK8SNetworks && cloud.GCP.Networks.Services == nil {
 			obj.Spec.Cloud.GCP.Networks.Services = &defaultServiceCIDR
 		}
 		if cloud.GCP.Networks.Nodes == nil && len(cloud.GCP.Networks.Workers) > 0 {
@@ -76,11 +80,11 @@ func SetDefaults_Shoot(obj *Shoot) {
 	}
 
 	if cloud.Alicloud != nil {
-		if cloud.Alicloud.Networks.Pods == nil {
+		if defaultK8SNetworks && cloud.Alicloud.Networks.Pods == nil {
 			podCIDR := gardencorev1alpha1.CIDR("100.64.0.0/11")
 			obj.Spec.Cloud.Alicloud.Networks.Pods = &podCIDR
 		}
-		if cloud.Alicloud.Networks.Services == nil {
+		if defaultK8SNetworks && cloud.Alicloud.Networks.Services == nil {
 			svcCIDR := gardencorev1alpha1.CIDR("100.104.0.0/13")
 			obj.Spec.Cloud.Alicloud.Networks.Services = &svcCIDR
 		}
@@ -94,10 +98,10 @@ func SetDefaults_Shoot(obj *Shoot) {
 	}
 
 	if cloud.OpenStack != nil {
-		if cloud.OpenStack.Networks.Pods == nil {
+		if defaultK8SNetworks && cloud.OpenStack.Networks.Pods == nil {
 			obj.Spec.Cloud.OpenStack.Networks.Pods = &defaultPodCIDR
 		}
-		if cloud.OpenStack.Networks.Services == nil {
+		if defaultK8SNetworks && cloud.OpenStack.Networks.Services == nil {
 			obj.Spec.Cloud.OpenStack.Networks.Services = &defaultServiceCIDR
 		}
 		if cloud.OpenStack.Networks.Nodes == nil && len(cloud.OpenStack.Networks.Workers) > 0 {
@@ -106,19 +110,19 @@ func SetDefaults_Shoot(obj *Shoot) {
 	}
 
 	if cloud.Packet != nil {
-		if cloud.Packet.Networks.Pods == nil {
+		if defaultK8SNetworks && cloud.Packet.Networks.Pods == nil {
 			obj.Spec.Cloud.Packet.Networks.Pods = &defaultPodCIDR
 		}
-		if cloud.Packet.Networks.Services == nil {
+		if defaultK8SNetworks && cloud.Packet.Networks.Services == nil {
 			obj.Spec.Cloud.Packet.Networks.Services = &defaultServiceCIDR
 		}
 	}
 
 	if cloud.Local != nil {
-		if cloud.Local.Networks.Pods == nil {
+		if defaultK8SNetworks && cloud.Local.Networks.Pods == nil {
 			obj.Spec.Cloud.Local.Networks.Pods = &defaultPodCIDR
 		}
-		if cloud.Local.Networks.Services == nil {
+		if defaultK8SNetworks && cloud.Local.Networks.Services == nil {
 			obj.Spec.Cloud.Local.Networks.Services = &defaultServiceCIDR
 		}
 		if cloud.Local.Networks.Nodes == nil && len(cloud.Local.Networks.Workers) > 0 {
@@ -137,6 +141,23 @@ func SetDefaults_Shoot(obj *Shoot) {
 		}
 	}
 
+	if kubeAPIServer := obj.Spec.Kubernetes.KubeAPIServer; kubeAPIServer != nil {
+		if kubeAPIServer.EnableBasicAuthentication == nil {
+			kubeAPIServer.EnableBasicAuthentication = &trueVar
+		}
+		if webhook := kubeAPIServer.AuthenticationWebhook; webhook != nil && webhook.CacheTTL == nil {
+			webhook.CacheTTL = &metav1.Duration{Duration: DefaultAuthenticationWebhookCacheTTL}
+		}
+
+		// The dashboard cannot use basic authentication if the kube-apiserver does not accept it.
+		if !*kubeAPIServer.EnableBasicAuthentication && obj.Spec.Addons != nil {
+			if dashboard := obj.Spec.Addons.KubernetesDashboard; dashboard != nil && dashboard.AuthenticationMode == nil {
+				tokenAuthMode := KubernetesDashboardAuthModeToken
+				dashboard.AuthenticationMode = &tokenAuthMode
+			}
+		}
+	}
+
 	if obj.Spec.Maintenance == nil {
 		mt := utils.RandomMaintenanceTimeWindow()
 
@@ -177,6 +198,16 @@ func SetDefaults_Seed(obj *Seed) {
 	if obj.Spec.Protected == nil {
 		obj.Spec.Protected = &falseVar
 	}
+
+	if obj.Spec.Settings == nil {
+		obj.Spec.Settings = &SeedSettings{}
+	}
+	if obj.Spec.Settings.NetworkPolicies == nil {
+		obj.Spec.Settings.NetworkPolicies = &SeedSettingNetworkPolicies{Enabled: true}
+	}
+	if obj.Spec.Settings.ShootDNS == nil {
+		obj.Spec.Settings.ShootDNS = &SeedSettingShootDNS{Enabled: true}
+	}
 }
 
 // SetDefaults_Project sets default values for Project objects.
@@ -195,7 +226,7 @@ func SetDefaults_Project(obj *Project) {
 
 // SetDefaults_KubernetesDashboard sets default values for KubernetesDashboard objects.
 func SetDefaults_KubernetesDashboard(obj *KubernetesDashboard) {
-	defaultAuthMode := "basic"
+	defaultAuthMode := KubernetesDashboardAuthModeBasic
 	if obj.AuthenticationMode == nil {
 		obj.AuthenticationMode = &defaultAuthMode
 	}
@@ -222,6 +253,10 @@ func SetDefaults_SecretBinding(obj *SecretBinding) {
 			obj.Quotas[i].Namespace = obj.Namespace
 		}
 	}
+
+	if obj.Rotation != nil && len(obj.Rotation.SecretRef.Namespace) == 0 {
+		obj.Rotation.SecretRef.Namespace = obj.Namespace
+	}
 }
 
 // SetDefaults_MachineType sets default values for MachineType objects.
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"strconv"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -88,7 +90,7 @@ func IsShootHibernated(shoot *gardenv1beta1.Shoot) bool {
 
 // ShootWantsClusterAutoscaler checks if the given Shoot needs a cluster autoscaler.
 // This is determined by checking whether one of the Shoot workers has a different
-// AutoScalerMax than AutoScalerMin.
+// AutoScalerMax than AutoScalerMin or a capacity configuration.
 func ShootWantsClusterAutoscaler(shoot *gardenv1beta1.Shoot) (bool, error) {
 	cloudProvider, err := GetShootCloudProvider(shoot)
 	if err != nil {
@@ -97,7 +99,7 @@ func ShootWantsClusterAutoscaler(shoot *gardenv1beta1.Shoot) (bool, error) {
 
 	workers := GetShootCloudProviderWorkers(cloudProvider, shoot)
 	for _, worker := range workers {
-		if worker.AutoScalerMax > worker.AutoScalerMin {
+		if worker.AutoScalerMax > worker.AutoScalerMin || worker.Capacity != nil {
 			return true, nil
 		}
 	}
@@ -114,6 +116,22 @@ func ShootWantsAlertmanager(shoot *gardenv1beta1.Shoot, secrets map[string]*core
 	return false
 }
 
+// ShootWantsMonitoring checks if the monitoring stack of the given Shoot shall be deployed.
+func ShootWantsMonitoring(shoot *gardenv1beta1.Shoot) bool {
+	if monitoring := shoot.Spec.Monitoring; monitoring != nil && monitoring.Enabled != nil {
+		return *monitoring.Enabled
+	}
+	return true
+}
+
+// ShootWantsBasicAuthentication checks if the kube-apiserver of the given Shoot shall accept basic authentication.
+func ShootWantsBasicAuthentication(shoot *gardenv1beta1.Shoot) bool {
+	if kubeAPIServer := shoot.Spec.Kubernetes.KubeAPIServer; kubeAPIServer != nil && kubeAPIServer.EnableBasicAuthentication != nil {
+		return *kubeAPIServer.EnableBasicAuthentication
+	}
+	return true
+}
+
 // ShootIgnoreAlerts checks if the alerts for the annotated shoot cluster should be ignored.
 func ShootIgnoreAlerts(shoot *gardenv1beta1.Shoot) bool {
 	ignore := false
@@ -123,6 +141,19 @@ func ShootIgnoreAlerts(shoot *gardenv1beta1.Shoot) bool {
 	return ignore
 }
 
+// ShootWantsForceDeletion checks if the given shoot is marked for deletion and has been annotated to be force-deleted,
+// and whether the force deletion has been confirmed by setting the confirmation annotation to the name of the shoot.
+func ShootWantsForceDeletion(shoot *gardenv1beta1.Shoot) bool {
+	if shoot.DeletionTimestamp == nil || shoot.Annotations[common.ConfirmationForceDeletion] != shoot.Name {
+		return false
+	}
+	force := false
+	if value, ok := shoot.Annotations[common.ShootForceDelete]; ok {
+		force, _ = strconv.ParseBool(value)
+	}
+	return force
+}
+
 // GetShootCloudProviderWorkers retrieves the cloud-specific workers of the given Shoot.
 func GetShootCloudProviderWorkers(cloudProvider gardenv1beta1.CloudProvider, shoot *gardenv1beta1.Shoot) []gardenv1beta1.Worker {
 	var (
@@ -225,6 +256,37 @@ func GetMachineTypesFromCloudProfile(cloudProvider gardenv1beta1.CloudProvider,
 	return machineTypes
 }
 
+// GetRegionsFromCloudProfile retrieves the list of regions from the cloud profile for the given cloud provider.
+func GetRegionsFromCloudProfile(cloudProvider gardenv1beta1.CloudProvider, profile *gardenv1beta1.CloudProfile) []string {
+	var (
+		regions []string
+		zones   []gardenv1beta1.Zone
+	)
+
+	switch cloudProvider {
+	case gardenv1beta1.CloudProviderAWS:
+		zones = profile.Spec.AWS.Constraints.Zones
+	case gardenv1beta1.CloudProviderAzure:
+		for _, domainCount := range profile.Spec.Azure.CountFaultDomains {
+			regions = append(regions, domainCount.Region)
+		}
+	case gardenv1beta1.CloudProviderGCP:
+		zones = profile.Spec.GCP.Constraints.Zones
+	case gardenv1beta1.CloudProviderPacket:
+		zones = profile.Spec.Packet.Constraints.Zones
+	case gardenv1beta1.CloudProviderOpenStack:
+		zones = profile.Spec.OpenStack.Constraints.Zones
+	case gardenv1beta1.CloudProviderAlicloud:
+		zones = profile.Spec.Alicloud.Constraints.Zones
+	}
+
+	for _, zone := range zones {
+		regions = append(regions, zone.Region)
+	}
+
+	return regions
+}
+
 // DetermineCloudProviderInShoot takes a Shoot cloud object and returns the cloud provider this profile is used for.
 // If it is not able to determine it, an error will be returned.
 func DetermineCloudProviderInShoot(cloudObj gardenv1beta1.Cloud) (gardenv1beta1.CloudProvider, error) {
@@ -284,7 +346,7 @@ func DetermineMachineImage(cloudProfile gardenv1beta1.CloudProfile, name gardenv
 		for _, image := range cloudProfile.Spec.AWS.Constraints.MachineImages {
 			if machineImageToString(image.Name) == currentMachineImageName {
 				for _, regionMapping := range image.Regions {
-					if regionMapping.Name == region {
+					if regionMapping.Name == region && architecture(regionMapping.Architecture) == gardenv1beta1.ArchitectureAMD64 {
 						return true, &gardenv1beta1.AWSMachineImage{
 							Name: image.Name,
 							AMI:  regionMapping.AMI,
@@ -337,6 +399,34 @@ func DetermineMachineImage(cloudProfile gardenv1beta1.CloudProfile, name gardenv
 	return false, nil, nil
 }
 
+// WorkerArchitecture returns the CPU architecture of the machines of the given worker pool.
+func WorkerArchitecture(worker gardenv1beta1.Worker) string {
+	return architecture(worker.Architecture)
+}
+
+// FindAWSMachineImageAMI returns the AMI of the machine image <name> for the given <region> and <arch> from the
+// given <machineImages>. It returns false if no such AMI exists.
+func FindAWSMachineImageAMI(machineImages []gardenv1beta1.AWSMachineImageMapping, name gardenv1beta1.MachineImageName, region, arch string) (string, bool) {
+	for _, image := range machineImages {
+		if image.Name != name {
+			continue
+		}
+		for _, regionMapping := range image.Regions {
+			if regionMapping.Name == region && architecture(regionMapping.Architecture) == arch {
+				return regionMapping.AMI, true
+			}
+		}
+	}
+	return "", false
+}
+
+func architecture(arch *string) string {
+	if arch == nil {
+		return gardenv1beta1.ArchitectureAMD64
+	}
+	return *arch
+}
+
 // UpdateMachineImage updates the machine image for the given cloud provider.
 func UpdateMachineImage(cloudProvider gardenv1beta1.CloudProvider, machineImage interface{}) func(*gardenv1beta1.Cloud) {
 	switch cloudProvider {
@@ -661,3 +751,61 @@ func ReadShootedSeed(shoot *gardenv1beta1.Shoot) (*ShootedSeed, error) {
 
 	return shootedSeed, nil
 }
+
+// SecretBindingRotationInProgress returns true if the cloud provider credentials of the given SecretBinding are
+// currently being rotated. A rotation only starts once the SecretBinding controller has accepted it, i.e. has moved
+// it into the 'Rolling' phase.
+func SecretBindingRotationInProgress(binding *gardenv1beta1.SecretBinding) bool {
+	rotation := binding.Rotation
+	return rotation != nil && rotation.Status != nil && rotation.Status.Phase == gardenv1beta1.SecretBindingRotationPhaseRolling
+}
+
+// SecretBindingActiveSecretRef returns the reference to the secret whose credentials shall be used for the Shoots of
+// the given SecretBinding. During a credentials rotation, this is the secret of the rotation.
+func SecretBindingActiveSecretRef(binding *gardenv1beta1.SecretBinding) corev1.SecretReference {
+	if SecretBindingRotationInProgress(binding) {
+		return binding.Rotation.SecretRef
+	}
+	return binding.SecretRef
+}
+
+// SecretBindingReferencesSecret returns true if the given SecretBinding refers to the secret <namespace>/<name>,
+// either directly or as secret of a credentials rotation in progress.
+func SecretBindingReferencesSecret(binding *gardenv1beta1.SecretBinding, namespace, name string) bool {
+	matches := func(ref corev1.SecretReference) bool {
+		return ref.Namespace == namespace && ref.Name == name
+	}
+	return matches(binding.SecretRef) || (SecretBindingRotationInProgress(binding) && matches(binding.Rotation.SecretRef))
+}
+
+// IsShootRotated returns true if the Shoot with the given name has been switched to the new credentials of the
+// credentials rotation of the given SecretBinding.
+func IsShootRotated(binding *gardenv1beta1.SecretBinding, shootName string) bool {
+	if binding.Rotation == nil || binding.Rotation.Status == nil {
+		return false
+	}
+	for _, name := range binding.Rotation.Status.RotatedShoots {
+		if name == shootName {
+			return true
+		}
+	}
+	return false
+}
+
+// GetShootBackupRetention returns the minimum duration the backup of the given Shoot has to be kept after its deletion,
+// i.e., the longest backup retention of all DeletionProtectionPolicies matching the Shoot.
+func GetShootBackupRetention(policies []*gardenv1beta1.DeletionProtectionPolicy, shoot *gardenv1beta1.Shoot) (time.Duration, error) {
+	var retention time.Duration
+
+	for _, policy := range policies {
+		selector, err := metav1.LabelSelectorAsSelector(&policy.Spec.ShootSelector)
+		if err != nil {
+			return 0, err
+		}
+		if selector.Matches(labels.Set(shoot.Labels)) && policy.Spec.BackupRetention != nil && policy.Spec.BackupRetention.Duration > retention {
+			retention = policy.Spec.BackupRetention.Duration
+		}
+	}
+
+	return retention, nil
+}
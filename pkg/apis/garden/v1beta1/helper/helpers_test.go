@@ -15,6 +15,8 @@
 package helper_test
 
 import (
+	"time"
+
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	. "github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/operation/common"
@@ -192,7 +194,7 @@ var _ = Describe("helper", func() {
 			&gardenv1beta1.Shoot{
 				Spec: gardenv1beta1.ShootSpec{
 					Cloud: gardenv1beta1.Cloud{
-						Local:&gardenv1beta1.Local{
+						Local: &gardenv1beta1.Local{
 							MachineImage: &gardenv1beta1.LocalMachineImage{
 								Name: gardenv1beta1.MachineImageName("some-machineimage"),
 							},
@@ -274,6 +276,58 @@ var _ = Describe("helper", func() {
 			},
 		}, alertingSecrets, false))
 
+	DescribeTable("#ShootWantsForceDeletion",
+		func(deletionTimestamp *metav1.Time, annotations map[string]string, wantsForceDeletion bool) {
+			shoot := &gardenv1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "shoot",
+					DeletionTimestamp: deletionTimestamp,
+					Annotations:       annotations,
+				},
+			}
+			Expect(ShootWantsForceDeletion(shoot)).To(Equal(wantsForceDeletion))
+		},
+		Entry("not marked for deletion", nil, map[string]string{common.ShootForceDelete: "true", common.ConfirmationForceDeletion: "shoot"}, false),
+		Entry("no annotation", &metav1.Time{}, nil, false),
+		Entry("annotation set to false", &metav1.Time{}, map[string]string{common.ShootForceDelete: "false", common.ConfirmationForceDeletion: "shoot"}, false),
+		Entry("invalid annotation", &metav1.Time{}, map[string]string{common.ShootForceDelete: "yes please", common.ConfirmationForceDeletion: "shoot"}, false),
+		Entry("annotation set to true without confirmation", &metav1.Time{}, map[string]string{common.ShootForceDelete: "true"}, false),
+		Entry("annotation set to true with wrong confirmation", &metav1.Time{}, map[string]string{common.ShootForceDelete: "true", common.ConfirmationForceDeletion: "other"}, false),
+		Entry("annotation set to true with confirmation", &metav1.Time{}, map[string]string{common.ShootForceDelete: "true", common.ConfirmationForceDeletion: "shoot"}, true))
+
+	var (
+		enabled  = true
+		disabled = false
+	)
+	DescribeTable("#ShootWantsMonitoring",
+		func(monitoring *gardenv1beta1.Monitoring, wantsMonitoring bool) {
+			shoot := &gardenv1beta1.Shoot{Spec: gardenv1beta1.ShootSpec{Monitoring: monitoring}}
+			Expect(ShootWantsMonitoring(shoot)).To(Equal(wantsMonitoring))
+		},
+		Entry("monitoring not configured", nil, true),
+		Entry("monitoring not explicitly enabled", &gardenv1beta1.Monitoring{}, true),
+		Entry("monitoring enabled", &gardenv1beta1.Monitoring{Enabled: &enabled}, true),
+		Entry("monitoring disabled", &gardenv1beta1.Monitoring{Enabled: &disabled}, false))
+
+	DescribeTable("#GetRegionsFromCloudProfile",
+		func(cloudProvider gardenv1beta1.CloudProvider, spec gardenv1beta1.CloudProfileSpec, expected []string) {
+			Expect(GetRegionsFromCloudProfile(cloudProvider, &gardenv1beta1.CloudProfile{Spec: spec})).To(Equal(expected))
+		},
+		Entry("AWS", gardenv1beta1.CloudProviderAWS, gardenv1beta1.CloudProfileSpec{
+			AWS: &gardenv1beta1.AWSProfile{
+				Constraints: gardenv1beta1.AWSConstraints{
+					Zones: []gardenv1beta1.Zone{{Region: "eu-west-1"}, {Region: "eu-central-1"}},
+				},
+			},
+		}, []string{"eu-west-1", "eu-central-1"}),
+		Entry("Azure", gardenv1beta1.CloudProviderAzure, gardenv1beta1.CloudProfileSpec{
+			Azure: &gardenv1beta1.AzureProfile{
+				CountFaultDomains: []gardenv1beta1.AzureDomainCount{{Region: "westeurope"}},
+			},
+		}, []string{"westeurope"}),
+		Entry("Local", gardenv1beta1.CloudProviderLocal, gardenv1beta1.CloudProfileSpec{}, nil),
+	)
+
 	Describe("#ReadShootedSeed", func() {
 		var (
 			shoot                    *gardenv1beta1.Shoot
@@ -461,4 +515,103 @@ var _ = Describe("helper", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+
+	Describe("SecretBinding rotation", func() {
+		var (
+			secretRef         = corev1.SecretReference{Namespace: "garden-foo", Name: "old"}
+			rotationSecretRef = corev1.SecretReference{Namespace: "garden-foo", Name: "new"}
+			binding           *gardenv1beta1.SecretBinding
+		)
+
+		BeforeEach(func() {
+			binding = &gardenv1beta1.SecretBinding{
+				SecretRef: secretRef,
+				Rotation: &gardenv1beta1.SecretBindingRotation{
+					SecretRef: rotationSecretRef,
+				},
+			}
+		})
+
+		It("should use the current secret as long as the rotation has not been accepted", func() {
+			Expect(SecretBindingRotationInProgress(binding)).To(BeFalse())
+			Expect(SecretBindingActiveSecretRef(binding)).To(Equal(secretRef))
+			Expect(SecretBindingReferencesSecret(binding, rotationSecretRef.Namespace, rotationSecretRef.Name)).To(BeFalse())
+		})
+
+		It("should use the rotation secret while the rotation is rolling", func() {
+			binding.Rotation.Status = &gardenv1beta1.SecretBindingRotationStatus{
+				Phase:         gardenv1beta1.SecretBindingRotationPhaseRolling,
+				RotatedShoots: []string{"foo"},
+			}
+
+			Expect(SecretBindingRotationInProgress(binding)).To(BeTrue())
+			Expect(SecretBindingActiveSecretRef(binding)).To(Equal(rotationSecretRef))
+			Expect(SecretBindingReferencesSecret(binding, secretRef.Namespace, secretRef.Name)).To(BeTrue())
+			Expect(SecretBindingReferencesSecret(binding, rotationSecretRef.Namespace, rotationSecretRef.Name)).To(BeTrue())
+			Expect(IsShootRotated(binding, "foo")).To(BeTrue())
+			Expect(IsShootRotated(binding, "bar")).To(BeFalse())
+		})
+
+		It("should use the current secret after the rotation has been completed", func() {
+			binding.SecretRef = rotationSecretRef
+			binding.Rotation.Status = &gardenv1beta1.SecretBindingRotationStatus{
+				Phase:             gardenv1beta1.SecretBindingRotationPhaseCompleted,
+				PreviousSecretRef: &secretRef,
+			}
+
+			Expect(SecretBindingRotationInProgress(binding)).To(BeFalse())
+			Expect(SecretBindingActiveSecretRef(binding)).To(Equal(rotationSecretRef))
+			Expect(SecretBindingReferencesSecret(binding, secretRef.Namespace, secretRef.Name)).To(BeFalse())
+		})
+	})
+
+	Describe("#GetShootBackupRetention", func() {
+		var (
+			shoot = &gardenv1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"environment": "production"},
+				},
+			}
+
+			newPolicy = func(selector metav1.LabelSelector, retention *metav1.Duration) *gardenv1beta1.DeletionProtectionPolicy {
+				return &gardenv1beta1.DeletionProtectionPolicy{
+					Spec: gardenv1beta1.DeletionProtectionPolicySpec{
+						ShootSelector:   selector,
+						BackupRetention: retention,
+					},
+				}
+			}
+			production  = metav1.LabelSelector{MatchLabels: map[string]string{"environment": "production"}}
+			development = metav1.LabelSelector{MatchLabels: map[string]string{"environment": "development"}}
+		)
+
+		It("should return the longest retention of all matching policies", func() {
+			policies := []*gardenv1beta1.DeletionProtectionPolicy{
+				newPolicy(production, &metav1.Duration{Duration: 24 * time.Hour}),
+				newPolicy(production, &metav1.Duration{Duration: 72 * time.Hour}),
+				newPolicy(production, nil),
+				newPolicy(development, &metav1.Duration{Duration: 240 * time.Hour}),
+			}
+
+			retention, err := GetShootBackupRetention(policies, shoot)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retention).To(Equal(72 * time.Hour))
+		})
+
+		It("should return no retention if no policy matches", func() {
+			retention, err := GetShootBackupRetention([]*gardenv1beta1.DeletionProtectionPolicy{newPolicy(development, &metav1.Duration{Duration: time.Hour})}, shoot)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retention).To(BeZero())
+		})
+
+		It("should return an error for invalid selectors", func() {
+			invalid := metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "environment", Operator: "Foo"}}}
+
+			_, err := GetShootBackupRetention([]*gardenv1beta1.DeletionProtectionPolicy{newPolicy(invalid, nil)}, shoot)
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
@@ -51,6 +51,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&BackupInfrastructureList{},
 		&CloudProfile{},
 		&CloudProfileList{},
+		&DeletionProtectionPolicy{},
+		&DeletionProtectionPolicyList{},
 		&Project{},
 		&ProjectList{},
 		&Quota{},
@@ -61,6 +63,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&SecretBindingList{},
 		&Shoot{},
 		&ShootList{},
+		&ShootTemplate{},
+		&ShootTemplateList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -86,6 +86,20 @@ type CloudProfileSpec struct {
 	// CABundle is a certificate bundle which will be installed onto every host machine of the Shoot cluster.
 	// +optional
 	CABundle *string `json:"caBundle,omitempty"`
+	// Terraformer pins the version of the Terraformer which creates the infrastructure of the Shoots using this
+	// profile. If not set, the version of the image vector of the Gardener controller manager is used.
+	// +optional
+	Terraformer *TerraformerSettings `json:"terraformer,omitempty"`
+	// CustomMachineImages controls which Shoots using this profile may specify custom machine images for their worker
+	// pools. If not set, custom machine images are not allowed.
+	// +optional
+	CustomMachineImages *CustomMachineImages `json:"customMachineImages,omitempty"`
+}
+
+// CustomMachineImages controls which Shoots may specify custom machine images for their worker pools.
+type CustomMachineImages struct {
+	// Projects is a list of names of the projects whose Shoots may use custom machine images.
+	Projects []string `json:"projects"`
 }
 
 // AWSProfile defines certain constraints and definitions for the AWS cloud.
@@ -126,11 +140,16 @@ type AWSMachineImageMapping struct {
 	Regions []AWSRegionalMachineImage `json:"regions"`
 }
 
+// AWSRegionalMachineImage defines the technical id of a machine image in a region.
 type AWSRegionalMachineImage struct {
 	// Name is the name of a region.
 	Name string `json:"name"`
 	// AMI is the technical id of the image (specific for region stated in the 'Name' field).
 	AMI string `json:"ami"`
+	// Architecture is the CPU architecture the image is built for (default: amd64). A region may contain one entry
+	// per architecture.
+	// +optional
+	Architecture *string `json:"architecture,omitempty"`
 }
 
 // AzureProfile defines certain constraints and definitions for the Azure cloud.
@@ -226,6 +245,10 @@ type OpenStackProfile struct {
 	// RequestTimeout specifies the HTTP timeout against the OpenStack API.
 	// +optional
 	RequestTimeout *string `json:"requestTimeout,omitempty"`
+	// NetworkMTU is the MTU of the networks in the OpenStack system. It is propagated into the CNI and VPN
+	// configuration of Shoots; if not set, 1500 is assumed.
+	// +optional
+	NetworkMTU *int32 `json:"networkMTU,omitempty"`
 }
 
 // OpenStackConstraints is an object containing constraints for certain values in the Shoot specification.
@@ -299,13 +322,15 @@ type AlicloudMachineImage struct {
 // AlicloudMachineType defines certain machine types and zone constraints.
 type AlicloudMachineType struct {
 	MachineType `json:",inline"`
-	Zones       []string `json:"zones"`
+	// Zones is a list of availability zones in which the machine type is available.
+	Zones []string `json:"zones"`
 }
 
 // AlicloudVolumeType defines certain volume types and zone constraints.
 type AlicloudVolumeType struct {
 	VolumeType `json:",inline"`
-	Zones      []string `json:"zones"`
+	// Zones is a list of availability zones in which the volume type is available.
+	Zones []string `json:"zones"`
 }
 
 // PacketProfile defines constraints and definitions in Packet Cloud environment.
@@ -383,6 +408,9 @@ type MachineType struct {
 	GPU resource.Quantity `json:"gpu"`
 	// Memory is the amount of memory for this machine type.
 	Memory resource.Quantity `json:"memory"`
+	// Architecture is the CPU architecture of this machine type (default: amd64).
+	// +optional
+	Architecture *string `json:"architecture,omitempty"`
 }
 
 // OpenStackMachineType contains certain properties of a machine type in OpenStack
@@ -411,6 +439,14 @@ type Zone struct {
 	Region string `json:"region"`
 	// Names is a list of availability zone names in this region.
 	Names []string `json:"names"`
+	// UnavailableMachineTypes is a list of machine type names that cannot be used in the zones of this entry.
+	// Zones of the same region with different availability can be declared in separate entries.
+	// +optional
+	UnavailableMachineTypes []string `json:"unavailableMachineTypes,omitempty"`
+	// UnavailableVolumeTypes is a list of volume type names that cannot be used in the zones of this entry.
+	// Zones of the same region with different availability can be declared in separate entries.
+	// +optional
+	UnavailableVolumeTypes []string `json:"unavailableVolumeTypes,omitempty"`
 }
 
 // MachineImageName is a string alias.
@@ -427,6 +463,13 @@ const (
 	MachineImageCoreOSAlicloud MachineImageName = "coreos-alicloud"
 )
 
+const (
+	// ArchitectureAMD64 is a constant for the amd64 CPU architecture.
+	ArchitectureAMD64 = "amd64"
+	// ArchitectureARM64 is a constant for the arm64 CPU architecture.
+	ArchitectureARM64 = "arm64"
+)
+
 ////////////////////////////////////////////////////
 //                    PROJECTS                    //
 ////////////////////////////////////////////////////
@@ -485,6 +528,32 @@ type ProjectSpec struct {
 	// A nil value means that Gardener will determine the name of the namespace.
 	// +optional
 	Namespace *string `json:"namespace,omitempty"`
+	// Restrictions restricts the cloud profiles, regions and cloud provider secrets which may be used by the Shoots of the project.
+	// +optional
+	Restrictions *ProjectRestrictions `json:"restrictions,omitempty"`
+}
+
+// ProjectRestrictions restricts the cloud profiles, regions and cloud provider secrets which may be used by the Shoots of a project.
+type ProjectRestrictions struct {
+	// CloudProfiles is a list of cloud profiles which may be used by the Shoots. All cloud profiles are allowed if it is empty.
+	// +optional
+	CloudProfiles []ProjectCloudProfile `json:"cloudProfiles,omitempty"`
+	// Secrets is a list of references to cloud provider secrets which may be referenced by the secret bindings in the
+	// project namespace. All secrets are allowed if it is empty.
+	// +optional
+	Secrets []corev1.SecretReference `json:"secrets,omitempty"`
+	// DefaultSecretBinding is the name of the secret binding which is used by Shoots that do not reference one.
+	// +optional
+	DefaultSecretBinding *string `json:"defaultSecretBinding,omitempty"`
+}
+
+// ProjectCloudProfile is a cloud profile which may be used by the Shoots of a project.
+type ProjectCloudProfile struct {
+	// Name is the name of the cloud profile.
+	Name string `json:"name"`
+	// Regions is a list of regions of the cloud profile which may be used by the Shoots. All regions are allowed if it is empty.
+	// +optional
+	Regions []string `json:"regions,omitempty"`
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -552,6 +621,10 @@ type SeedSpec struct {
 	// IngressDomain is the domain of the Seed cluster pointing to the ingress controller endpoint. It will be used
 	// to construct ingress URLs for system applications running in Shoot clusters.
 	IngressDomain string `json:"ingressDomain"`
+	// IngressTLS configures the TLS certificates of the ingresses for the monitoring and logging endpoints of the
+	// Shoot clusters. If not set, self-signed certificates are generated for every ingress.
+	// +optional
+	IngressTLS *SeedIngressTLS `json:"ingressTLS,omitempty"`
 	// SecretRef is a reference to a Secret object containing the Kubeconfig and the cloud provider credentials for
 	// the account the Seed cluster has been deployed to.
 	SecretRef corev1.SecretReference `json:"secretRef"`
@@ -563,6 +636,30 @@ type SeedSpec struct {
 	// Protected prevent that the Seed Cluster can be used for regular Shoot cluster control planes.
 	// +optional
 	Protected *bool `json:"protected,omitempty"`
+	// Settings contains certain settings for this seed cluster.
+	// +optional
+	Settings *SeedSettings `json:"settings,omitempty"`
+	// Backup holds the configuration of the backup infrastructure of the Shoot clusters hosted by this Seed.
+	// +optional
+	Backup *SeedBackup `json:"backup,omitempty"`
+}
+
+// SeedBackup holds the configuration of the backup infrastructure of the Shoot clusters hosted by a Seed.
+type SeedBackup struct {
+	// Region is the region in which the backup buckets are created, e.g. if backups must stay in a certain
+	// country. It must be a region of the cloud profile of the Seed unless another Provider is configured. Defaults
+	// to the region of the Seed.
+	// +optional
+	Region *string `json:"region,omitempty"`
+	// Provider is the cloud provider of the backup buckets if they shall be created with another cloud provider than
+	// the one of the Seed, e.g. to store the backups of the Shoots hosted by a GCP Seed in AWS S3. It requires a
+	// SecretRef and a Region. Defaults to the cloud provider of the Seed.
+	// +optional
+	Provider *CloudProvider `json:"provider,omitempty"`
+	// SecretRef is a reference to a Secret containing the cloud provider credentials for the backup buckets. Defaults
+	// to the secret of the Seed.
+	// +optional
+	SecretRef *corev1.SecretReference `json:"secretRef,omitempty"`
 }
 
 // SeedStatus holds the most recently observed status of the Seed cluster.
@@ -570,6 +667,50 @@ type SeedStatus struct {
 	// Conditions represents the latest available observations of a Seed's current state.
 	// +optional
 	Conditions []gardencorev1alpha1.Condition `json:"conditions,omitempty"`
+	// Orphans is a list of resources in the Seed cluster which do not belong to any Shoot anymore.
+	// +optional
+	Orphans []SeedOrphan `json:"orphans,omitempty"`
+	// KubernetesVersion is the Kubernetes version of the Seed cluster. It is used to check whether the Kubernetes
+	// versions of the Shoots hosted by the Seed cluster are compatible.
+	// +optional
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+	// ProviderHealth reports the health of the cloud provider account of the Seed, i.e. the reachability of the cloud
+	// API and the remaining headroom of the quotas in the region of the Seed.
+	// +optional
+	ProviderHealth *SeedProviderHealth `json:"providerHealth,omitempty"`
+}
+
+// SeedProviderHealth reports the health of the cloud provider account of a Seed.
+type SeedProviderHealth struct {
+	// LastCheckTime is the last time the cloud provider account has been checked.
+	LastCheckTime metav1.Time `json:"lastCheckTime"`
+	// Quotas is the list of quotas of the cloud provider account in the region of the Seed.
+	// +optional
+	Quotas []SeedProviderQuota `json:"quotas,omitempty"`
+}
+
+// SeedProviderQuota is a quota of the cloud provider account of a Seed.
+type SeedProviderQuota struct {
+	// Name is the name of the quota (e.g., instances, elastic-ips, or vpcs).
+	Name string `json:"name"`
+	// Limit is the maximal number of resources allowed by the quota.
+	Limit int64 `json:"limit"`
+	// Used is the number of resources currently counting against the quota.
+	Used int64 `json:"used"`
+}
+
+// SeedOrphan is a resource in the Seed cluster which does not belong to any Shoot anymore, e.g. because its
+// deletion has failed.
+type SeedOrphan struct {
+	// Kind is the kind of the orphaned resource (Namespace, Secret, or DNSEntry).
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the orphaned resource. It is empty for Namespaces.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the name of the orphaned resource.
+	Name string `json:"name"`
+	// DetectionTime is the time when the resource has been detected as orphaned for the first time.
+	DetectionTime metav1.Time `json:"detectionTime"`
 }
 
 // SeedCloud defines the cloud profile and the region this Seed cluster belongs to.
@@ -580,6 +721,137 @@ type SeedCloud struct {
 	Region string `json:"region"`
 }
 
+// SeedIngressTLS configures the TLS certificates of the ingresses in the Shoot namespaces of a Seed cluster.
+// Exactly one of its fields must be set.
+type SeedIngressTLS struct {
+	// SecretRef is a reference to a Secret object containing a wildcard certificate for "*.<ingressDomain>" in
+	// the keys "tls.crt" and "tls.key". The ingress hosts are flattened so that they are covered by the certificate.
+	// +optional
+	SecretRef *corev1.SecretReference `json:"secretRef,omitempty"`
+	// ACME configures the issuance of a certificate for every ingress via the ACME protocol. It requires that
+	// cert-manager is running in the Seed cluster.
+	// +optional
+	ACME *SeedIngressACME `json:"acme,omitempty"`
+}
+
+// SeedIngressACME configures the issuance of the ingress certificates via the ACME protocol.
+type SeedIngressACME struct {
+	// ClusterIssuer is the name of the cert-manager ClusterIssuer in the Seed cluster which issues the certificates.
+	ClusterIssuer string `json:"clusterIssuer"`
+}
+
+// SeedSettings contains certain settings for this seed cluster.
+type SeedSettings struct {
+	// NetworkPolicies controls the network policies deployed into the Shoot namespaces of this seed cluster.
+	// +optional
+	NetworkPolicies *SeedSettingNetworkPolicies `json:"networkPolicies,omitempty"`
+	// DashboardAuthentication controls how the access to the monitoring and logging dashboards of the Shoots is
+	// protected. If not set, generated basic authentication credentials are used.
+	// +optional
+	DashboardAuthentication *SeedSettingDashboardAuthentication `json:"dashboardAuthentication,omitempty"`
+	// ExcessCapacityReservation controls the excess capacity reservation for shoot control planes in this seed
+	// cluster. If not set, the configuration of the Gardener controller manager is used.
+	// +optional
+	ExcessCapacityReservation *SeedSettingExcessCapacityReservation `json:"excessCapacityReservation,omitempty"`
+	// ShootDNS controls whether the DNS records of the Shoots in this seed cluster are managed by Gardener.
+	// +optional
+	ShootDNS *SeedSettingShootDNS `json:"shootDNS,omitempty"`
+	// Scheduling controls whether this seed cluster is considered by the scheduling of new Shoots. If set, it
+	// takes precedence over the Visible field of the seed specification.
+	// +optional
+	Scheduling *SeedSettingScheduling `json:"scheduling,omitempty"`
+	// VerticalPodAutoscaler controls whether the vertical pod autoscaler is deployed into this seed cluster. If not
+	// set, the VPA feature gate of the Gardener controller manager is used.
+	// +optional
+	VerticalPodAutoscaler *SeedSettingVerticalPodAutoscaler `json:"verticalPodAutoscaler,omitempty"`
+	// LoadBalancerServices controls the services of type LoadBalancer created in this seed cluster.
+	// +optional
+	LoadBalancerServices *SeedSettingLoadBalancerServices `json:"loadBalancerServices,omitempty"`
+	// Terraformer pins the version of the Terraformer used for the Shoots and backup infrastructures in this seed
+	// cluster. It takes precedence over the version pinned in the CloudProfile.
+	// +optional
+	Terraformer *TerraformerSettings `json:"terraformer,omitempty"`
+}
+
+// SeedSettingNetworkPolicies controls the network policies deployed into the Shoot namespaces of a seed cluster.
+type SeedSettingNetworkPolicies struct {
+	// Enabled controls whether a default-deny network policy plus the policies explicitly allowing the required
+	// traffic between the control plane components are deployed into the Shoot namespaces. Defaults to true.
+	Enabled bool `json:"enabled"`
+}
+
+// SeedSettingDashboardAuthentication controls how the access to the monitoring and logging dashboards of the Shoots
+// in a seed cluster is protected.
+type SeedSettingDashboardAuthentication struct {
+	// OIDC configures an OpenID Connect proxy in front of the dashboards. Only users which are owner or members
+	// of the Shoot's project are granted access.
+	// +optional
+	OIDC *SeedDashboardOIDC `json:"oidc,omitempty"`
+}
+
+// SeedSettingExcessCapacityReservation controls the excess capacity reservation for shoot control planes in a
+// seed cluster.
+type SeedSettingExcessCapacityReservation struct {
+	// Enabled controls whether the excess capacity reservation is deployed into the seed cluster.
+	Enabled bool `json:"enabled"`
+	// Replicas is the number of low-priority placeholder pods reserving capacity in the seed cluster. If not set,
+	// the number is computed from the number of Shoots hosted by the seed cluster.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Resources are the resources (requests and limits) reserved by every placeholder pod. If not set, 500m CPU
+	// and 1200Mi memory are reserved per pod. Resources which are not given keep their default.
+	// +optional
+	Resources corev1.ResourceList `json:"resources,omitempty"`
+}
+
+// SeedSettingShootDNS controls whether the DNS records of the Shoots in a seed cluster are managed by Gardener.
+type SeedSettingShootDNS struct {
+	// Enabled controls whether the DNS records of the Shoots are managed. If disabled, only Shoots with an unmanaged
+	// DNS provider can be scheduled onto the seed cluster, and they are reached via the load balancer of their
+	// kube-apiserver. Shoots which already have DNS records keep them. Defaults to true.
+	Enabled bool `json:"enabled"`
+}
+
+// SeedSettingScheduling controls whether a seed cluster is considered by the scheduling of new Shoots.
+type SeedSettingScheduling struct {
+	// Visible controls whether the seed cluster is selectable for the seedmanager admission plugin.
+	Visible bool `json:"visible"`
+}
+
+// SeedSettingVerticalPodAutoscaler controls whether the vertical pod autoscaler is deployed into a seed cluster.
+type SeedSettingVerticalPodAutoscaler struct {
+	// Enabled controls whether the vertical pod autoscaler is deployed into the seed cluster and whether
+	// VerticalPodAutoscaler resources are created for the Shoot control plane components.
+	Enabled bool `json:"enabled"`
+}
+
+// SeedSettingLoadBalancerServices controls the services of type LoadBalancer created in a seed cluster.
+type SeedSettingLoadBalancerServices struct {
+	// Annotations are added to the services of type LoadBalancer, e.g. the kube-apiserver services of the Shoots.
+	// They take precedence over the annotations set by Gardener for the respective cloud provider.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// TerraformerSettings contains the settings for the Terraformer which runs Terraform to create infrastructure resources.
+type TerraformerSettings struct {
+	// Version is the tag of the Terraformer image. The image bundles Terraform and its provider plugins, hence,
+	// the tag pins their versions. It must be a semantic version.
+	Version string `json:"version"`
+}
+
+// SeedDashboardOIDC configures the OpenID Connect proxy protecting the dashboards of the Shoots in a seed cluster.
+type SeedDashboardOIDC struct {
+	// IssuerURL is the URL of the OpenID Connect provider. It must use the https scheme.
+	IssuerURL string `json:"issuerURL"`
+	// ClientID is the ID of the OpenID Connect client. The client must allow redirects to
+	// "https://*.<ingressDomain>/oauth2/callback".
+	ClientID string `json:"clientID"`
+	// SecretRef is a reference to a Secret object containing the secret of the OpenID Connect client in the
+	// key "clientSecret".
+	SecretRef corev1.SecretReference `json:"secretRef"`
+}
+
 // SeedNetworks contains CIDRs for the pod, service and node networks of a Kubernetes cluster.
 type SeedNetworks struct {
 	// Nodes is the CIDR of the node network.
@@ -597,6 +869,7 @@ type SeedNetworks struct {
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// Quota holds certain information about resource usage limitations and lifetime for Shoot objects.
 type Quota struct {
 	metav1.TypeMeta `json:",inline"`
 	// Standard object metadata.
@@ -647,18 +920,78 @@ const (
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// SecretBinding holds a reference to a secret with cloud provider credentials and the quotas which apply to its usage.
 type SecretBinding struct {
 	metav1.TypeMeta `json:",inline"`
 	// Standard object metadata.
 	// +optional
 	metav1.ObjectMeta `json:"metadata,omitempty"`
-	// SecretRef is a reference to a secret object in the same or another namespace.
+	// SecretRef is a reference to a secret object in the same or another namespace. It must not be set if the
+	// credentials are read from Vault.
 	SecretRef corev1.SecretReference `json:"secretRef"`
+	// VaultRef is a reference to a path in Vault from which the cloud provider credentials are read whenever a Shoot
+	// is reconciled.
+	// +optional
+	VaultRef *SecretBindingVaultReference `json:"vaultRef,omitempty"`
 	// Quotas is a list of references to Quota objects in the same or another namespace.
 	// +optional
 	Quotas []corev1.ObjectReference `json:"quotas,omitempty"`
+	// Rotation describes a rotation of the cloud provider credentials to another secret. While the rotation is in
+	// progress, the Shoots switch to the new secret whereas the previous one is kept until all of them use it.
+	// +optional
+	Rotation *SecretBindingRotation `json:"rotation,omitempty"`
+}
+
+// SecretBindingVaultReference is a reference to cloud provider credentials stored in Vault.
+type SecretBindingVaultReference struct {
+	// Path is the path of the credentials relative to the Vault path of the SecretBinding's namespace, e.g.
+	// 'aws/creds/deployer' for dynamic AWS credentials.
+	Path string `json:"path"`
+	// KeyMapping maps keys of the cloud provider secret to keys of the data returned by Vault. Keys which are not
+	// mapped are taken over unchanged.
+	// +optional
+	KeyMapping map[string]string `json:"keyMapping,omitempty"`
+}
+
+// SecretBindingRotation describes a rotation of the cloud provider credentials of a SecretBinding.
+type SecretBindingRotation struct {
+	// SecretRef is a reference to the secret containing the new credentials.
+	SecretRef corev1.SecretReference `json:"secretRef"`
+	// Status contains the progress of the rotation. It is maintained by the Gardener.
+	// +optional
+	Status *SecretBindingRotationStatus `json:"status,omitempty"`
+}
+
+// SecretBindingRotationStatus contains the progress of a credentials rotation.
+type SecretBindingRotationStatus struct {
+	// Phase is the current phase of the rotation.
+	Phase SecretBindingRotationPhase `json:"phase"`
+	// LastUpdateTime is the last time the status has been updated.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+	// Description is a human-readable message about the progress of the rotation.
+	Description string `json:"description"`
+	// RotatedShoots is the list of Shoots (in the namespace of the SecretBinding) which have been verified to work
+	// with the new credentials.
+	// +optional
+	RotatedShoots []string `json:"rotatedShoots,omitempty"`
+	// PreviousSecretRef is a reference to the secret with the previous credentials after the rotation has been
+	// completed. It is no longer used by the Gardener.
+	// +optional
+	PreviousSecretRef *corev1.SecretReference `json:"previousSecretRef,omitempty"`
 }
 
+// SecretBindingRotationPhase is the phase of a credentials rotation.
+type SecretBindingRotationPhase string
+
+const (
+	// SecretBindingRotationPhaseRolling is the phase in which the Shoots are switched to the new credentials. The
+	// previous credentials must remain valid in this phase.
+	SecretBindingRotationPhaseRolling SecretBindingRotationPhase = "Rolling"
+	// SecretBindingRotationPhaseCompleted is the phase after all Shoots use the new credentials. The previous
+	// credentials can be revoked.
+	SecretBindingRotationPhaseCompleted SecretBindingRotationPhase = "Completed"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // SecretBindingList is a collection of SecretBindings.
@@ -672,13 +1005,121 @@ type SecretBindingList struct {
 }
 
 ////////////////////////////////////////////////////
-//                      SHOOTS                    //
+//                 SHOOT TEMPLATES                //
+////////////////////////////////////////////////////
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootTemplate contains default settings for Shoot clusters which reference it by name. Templates in the
+// garden namespace can be referenced by Shoots of all projects.
+type ShootTemplate struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec contains the default settings of the ShootTemplate.
+	Spec ShootTemplateSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootTemplateList is a collection of ShootTemplates.
+type ShootTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// Items is the list of ShootTemplates.
+	Items []ShootTemplate `json:"items"`
+}
+
+// ShootTemplateSpec is the specification of a ShootTemplate. Its settings are only applied to a Shoot if the
+// Shoot does not specify them itself.
+type ShootTemplateSpec struct {
+	// Addons contains the default addon configuration.
+	// +optional
+	Addons *Addons `json:"addons,omitempty"`
+	// Networks contains the default networks of the Kubernetes cluster.
+	// +optional
+	Networks *gardencorev1alpha1.K8SNetworks `json:"networks,omitempty"`
+	// Workers is a list of default worker pools.
+	// +optional
+	Workers []ShootTemplateWorker `json:"workers,omitempty"`
+}
+
+// ShootTemplateWorker is the provider independent configuration of a default worker pool.
+type ShootTemplateWorker struct {
+	Worker `json:",inline"`
+	// VolumeType is the type of the root volumes.
+	// +optional
+	VolumeType string `json:"volumeType,omitempty"`
+	// VolumeSize is the size of the root volume.
+	// +optional
+	VolumeSize string `json:"volumeSize,omitempty"`
+}
+
+// ShootTemplateReference is a reference to a ShootTemplate.
+type ShootTemplateReference struct {
+	// Name is the name of the ShootTemplate.
+	Name string `json:"name"`
+	// Namespace is the namespace of the ShootTemplate. It defaults to the namespace of the Shoot.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+////////////////////////////////////////////////////
+//          DELETION PROTECTION POLICIES          //
 ////////////////////////////////////////////////////
 
 // +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DeletionProtectionPolicy protects the Shoots matching its label selector from being deleted without the approval
+// of multiple users, and it extends the retention of their backups after deletion.
+type DeletionProtectionPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec contains the specification of the DeletionProtectionPolicy.
+	Spec DeletionProtectionPolicySpec `json:"spec"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// DeletionProtectionPolicyList is a collection of DeletionProtectionPolicies.
+type DeletionProtectionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// Items is the list of DeletionProtectionPolicies.
+	Items []DeletionProtectionPolicy `json:"items"`
+}
+
+// DeletionProtectionPolicySpec is the specification of a DeletionProtectionPolicy.
+type DeletionProtectionPolicySpec struct {
+	// ShootSelector selects the Shoots (across all projects) which are protected by the policy.
+	ShootSelector metav1.LabelSelector `json:"shootSelector"`
+	// RequiredApprovals is the number of distinct users who have to approve the deletion of a protected Shoot
+	// before it can be deleted.
+	RequiredApprovals int32 `json:"requiredApprovals"`
+	// BackupRetention is the minimum duration the backup infrastructure of a deleted protected Shoot is kept before
+	// it is garbage collected.
+	// +optional
+	BackupRetention *metav1.Duration `json:"backupRetention,omitempty"`
+}
+
+////////////////////////////////////////////////////
+//                      SHOOTS                    //
+////////////////////////////////////////////////////
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +k8s:openapi-gen=x-kubernetes-print-columns:custom-columns=NAMESPACE:.metadata.namespace,NAME:.metadata.name,SEED:.spec.cloud.seed,DOMAIN:.spec.dns.domain,VERSION:.spec.kubernetes.version,CONTROL:.status.conditions[?(@.type == 'ControlPlaneHealthy')].status,NODES:.status.conditions[?(@.type == 'EveryNodeReady')].status,SYSTEM:.status.conditions[?(@.type == 'SystemComponentsHealthy')].status,LATEST:.status.lastOperation.state
+// Shoot represents a Shoot cluster created and managed by Gardener.
 type Shoot struct {
 	metav1.TypeMeta `json:",inline"`
 	// Standard object metadata.
@@ -724,8 +1165,71 @@ type ShootSpec struct {
 	// Maintenance contains information about the time window for maintenance operations and which
 	// operations should be performed.
 	// +optional
-
 	Maintenance *Maintenance `json:"maintenance,omitempty"`
+	// Monitoring contains information about the monitoring stack of the Shoot which is deployed in the Seed.
+	// +optional
+	Monitoring *Monitoring `json:"monitoring,omitempty"`
+	// SizingProfile is the profile which determines the resources and replica counts of the control plane and addon
+	// components of the Shoot. If it is not set then it is selected based on the maximum number of worker nodes.
+	// +optional
+	SizingProfile *SizingProfile `json:"sizingProfile,omitempty"`
+	// Storage contains information about the StorageClasses which are managed by Gardener in the Shoot cluster.
+	// +optional
+	Storage *Storage `json:"storage,omitempty"`
+	// Template is a reference to a ShootTemplate whose settings are applied to the Shoot when it is created.
+	// +optional
+	Template *ShootTemplateReference `json:"template,omitempty"`
+}
+
+// SizingProfile is a profile which determines the resources and replica counts of the system components of a Shoot.
+type SizingProfile string
+
+const (
+	// SizingProfileTiny is the sizing profile for Shoots with up to 2 worker nodes.
+	SizingProfileTiny SizingProfile = "tiny"
+	// SizingProfileSmall is the sizing profile for Shoots with up to 10 worker nodes.
+	SizingProfileSmall SizingProfile = "small"
+	// SizingProfileMedium is the sizing profile for Shoots with up to 50 worker nodes.
+	SizingProfileMedium SizingProfile = "medium"
+	// SizingProfileLarge is the sizing profile for Shoots with up to 100 worker nodes.
+	SizingProfileLarge SizingProfile = "large"
+	// SizingProfileXLarge is the sizing profile for Shoots with more than 100 worker nodes.
+	SizingProfileXLarge SizingProfile = "xlarge"
+)
+
+// Storage contains information about the StorageClasses which are managed by Gardener in the Shoot cluster.
+type Storage struct {
+	// Classes is a list of StorageClasses which are created in addition to the default StorageClasses of the cloud
+	// provider. A class with the same name as a default StorageClass replaces it.
+	// +optional
+	Classes []StorageClass `json:"classes,omitempty"`
+}
+
+// StorageClass contains the provider independent settings of a StorageClass in the Shoot cluster. Gardener computes
+// the provisioner and its parameters based on the cloud provider of the Shoot.
+type StorageClass struct {
+	// Name is the name of the StorageClass.
+	Name string `json:"name"`
+	// Default specifies whether the StorageClass is the default StorageClass of the Shoot cluster. At most one
+	// StorageClass can be the default one; it replaces the default StorageClass of the cloud provider.
+	// +optional
+	Default *bool `json:"default,omitempty"`
+	// VolumeType is the type of the volumes provisioned for this StorageClass (e.g., gp2 or io1 on AWS, pd-ssd on GCP,
+	// Premium_LRS on Azure, cloud_ssd on Alicloud, or a Cinder volume type on OpenStack).
+	// +optional
+	VolumeType *string `json:"volumeType,omitempty"`
+	// Encrypted specifies whether the provisioned volumes are encrypted. Only supported for AWS and Alicloud, the volumes
+	// on the other cloud providers are always encrypted at rest.
+	// +optional
+	Encrypted *bool `json:"encrypted,omitempty"`
+	// Zones restricts the provisioning of volumes to the given zones of the Shoot. All zones of the Shoot are allowed
+	// if it is not set.
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+	// Parameters is a map of additional parameters which are passed to the provisioner as they are. They must not
+	// overwrite the parameters computed by Gardener.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
 }
 
 // ShootStatus holds the most recently observed status of the Shoot cluster.
@@ -733,6 +1237,9 @@ type ShootStatus struct {
 	// Conditions represents the latest available observations of a Shoots's current state.
 	// +optional
 	Conditions []gardencorev1alpha1.Condition `json:"conditions,omitempty"`
+	// Constraints represents conditions of a Shoot's current state that constrain some operations on it.
+	// +optional
+	Constraints []gardencorev1alpha1.Condition `json:"constraints,omitempty"`
 	// Gardener holds information about the Gardener which last acted on the Shoot.
 	Gardener Gardener `json:"gardener"`
 	// LastOperation holds information about the last operation on the Shoot.
@@ -749,6 +1256,10 @@ type ShootStatus struct {
 	// must be retried until we give up).
 	// +optional
 	RetryCycleStartTime *metav1.Time `json:"retryCycleStartTime,omitempty"`
+	// RetryCount is the number of consecutive failed attempts of the current operation. It is reset when the operation
+	// succeeds, when a new retry cycle starts or when the Shoot is annotated with the `retry` operation.
+	// +optional
+	RetryCount int32 `json:"retryCount,omitempty"`
 	// Seed is the name of the seed cluster that runs the control plane of the Shoot. This value is only written
 	// after a successful create/reconcile operation. It will be used when control planes are moved between Seeds.
 	Seed string `json:"seed,omitempty"`
@@ -758,6 +1269,71 @@ type ShootStatus struct {
 	// UID is a unique identifier for the Shoot cluster to avoid portability between Kubernetes clusters.
 	// It is used to compute unique hashes.
 	UID types.UID `json:"uid"`
+	// APIServerSLO contains the service level objective attainment of the kube-apiserver of the Shoot cluster.
+	// +optional
+	APIServerSLO *APIServerSLO `json:"apiServerSLO,omitempty"`
+	// EgressIPs is the list of static IP addresses the worker nodes of the Shoot cluster use for egress traffic
+	// to the internet. It is empty if the egress IPs are not known or not stable, e.g. when they are allocated automatically.
+	// +optional
+	EgressIPs []string `json:"egressIPs,omitempty"`
+	// KubeletVersion is the Kubernetes version of the kubelets of the Shoot, i.e., the version which has been applied
+	// by the last successful reconciliation. It is used to prevent updates which violate the version skew policy.
+	// +optional
+	KubeletVersion string `json:"kubeletVersion,omitempty"`
+	// ReconciledStateHash is the hash of the effective desired state (the specification of the Shoot, the versions of
+	// the referenced cloud profile, seed and secrets, and the Gardener version) which has been applied by the last
+	// successful reconciliation. It is used to skip reconciliations if nothing has changed since then.
+	// +optional
+	ReconciledStateHash string `json:"reconciledStateHash,omitempty"`
+	// Hibernated indicates whether the Shoot has been hibernated by the last successful operation.
+	// +optional
+	Hibernated bool `json:"hibernated,omitempty"`
+	// AccessAudit contains the timestamps of security relevant accesses to the Shoot and its cloud provider account.
+	// +optional
+	AccessAudit *ShootAccessAudit `json:"accessAudit,omitempty"`
+}
+
+// ShootAccessAudit contains the timestamps of security relevant accesses to a Shoot cluster and its cloud provider
+// account which are observed by the Gardener.
+type ShootAccessAudit struct {
+	// LastCredentialsUseTime is the last time the Gardener used the cloud provider credentials of the Shoot to
+	// create, update or delete its infrastructure.
+	// +optional
+	LastCredentialsUseTime *metav1.Time `json:"lastCredentialsUseTime,omitempty"`
+	// LastKubeconfigReadTime is the last time the kubeconfig secret of the Shoot was read in the garden cluster.
+	// +optional
+	LastKubeconfigReadTime *metav1.Time `json:"lastKubeconfigReadTime,omitempty"`
+	// LastKubeconfigRotationTime is the last time the kubeconfig secret of the Shoot was issued with new credentials.
+	// +optional
+	LastKubeconfigRotationTime *metav1.Time `json:"lastKubeconfigRotationTime,omitempty"`
+	// LastSSHKeypairReadTime is the last time the SSH key pair for the worker nodes of the Shoot was read in the
+	// garden cluster.
+	// +optional
+	LastSSHKeypairReadTime *metav1.Time `json:"lastSSHKeypairReadTime,omitempty"`
+}
+
+// APIServerSLO contains the service level objective attainment of the kube-apiserver of a Shoot cluster within a
+// rolling window of 30 days.
+type APIServerSLO struct {
+	// Availability is the percentage of kube-apiserver requests which were not answered with a server error.
+	Availability string `json:"availability"`
+	// Latency is the percentage of kube-apiserver requests which were answered within one second.
+	Latency string `json:"latency"`
+	// Samples are the daily service level indicators the attainment is computed from.
+	// +optional
+	Samples []APIServerSLOSample `json:"samples,omitempty"`
+	// LastUpdateTime is the time when the service level indicators were last computed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+}
+
+// APIServerSLOSample contains the service level indicators of the kube-apiserver of a Shoot cluster for one day.
+type APIServerSLOSample struct {
+	// Day is the day (UTC) of the sample in the format YYYY-MM-DD.
+	Day string `json:"day"`
+	// Availability is the percentage of kube-apiserver requests which were not answered with a server error.
+	Availability string `json:"availability"`
+	// Latency is the percentage of kube-apiserver requests which were answered within one second.
+	Latency string `json:"latency"`
 }
 
 ///////////////////////////////
@@ -776,6 +1352,16 @@ type Cloud struct {
 	// Seed is the name of a Seed object.
 	// +optional
 	Seed *string `json:"seed,omitempty"`
+	// Tags is a map of additional tags (e.g., cost center, owner, environment) which are applied to all resources
+	// managed by Gardener in the cloud provider account. On GCP, OpenStack and Alicloud they are applied to the
+	// machines only (as GCE labels, server metadata and ECS tags). Not supported for Packet and Local.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+	// ServiceLoadBalancer contains defaults for the load balancers of services of type LoadBalancer in the Shoot
+	// cluster. They are applied when such a service is created; annotations set on the service take precedence.
+	// Not supported for Packet and Local.
+	// +optional
+	ServiceLoadBalancer *ServiceLoadBalancer `json:"serviceLoadBalancer,omitempty"`
 	// AWS contains the Shoot specification for the Amazon Web Services cloud.
 	// +optional
 	AWS *AWSCloud `json:"aws,omitempty"`
@@ -799,8 +1385,17 @@ type Cloud struct {
 	Local *Local `json:"local,omitempty"`
 }
 
-// AWSCloud contains the Shoot specification for AWS.
+// ServiceLoadBalancer contains defaults for the load balancers of services of type LoadBalancer in the Shoot cluster.
+type ServiceLoadBalancer struct {
+	// Internal specifies whether the load balancers are only reachable from within the network of the Shoot.
+	// +optional
+	Internal *bool `json:"internal,omitempty"`
+	// Annotations is a map of additional annotations which are added to the services.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
 
+// AWSCloud contains the Shoot specification for AWS.
 type AWSCloud struct {
 	// MachineImage holds information about the machine image to use for all workers.
 	// It will default to the first image stated in the referenced CloudProfile if no
@@ -813,6 +1408,17 @@ type AWSCloud struct {
 	Workers []AWSWorker `json:"workers"`
 	// Zones is a list of availability zones to deploy the Shoot cluster to.
 	Zones []string `json:"zones"`
+	// ServiceLoadBalancer contains AWS specific defaults for the load balancers of services of type LoadBalancer in
+	// the Shoot cluster.
+	// +optional
+	ServiceLoadBalancer *AWSServiceLoadBalancer `json:"serviceLoadBalancer,omitempty"`
+}
+
+// AWSServiceLoadBalancer contains AWS specific defaults for the load balancers of services of type LoadBalancer.
+type AWSServiceLoadBalancer struct {
+	// Type is the type of the load balancers ('classic' or 'nlb'). Defaults to 'classic'.
+	// +optional
+	Type *string `json:"type,omitempty"`
 }
 
 // AWSNetworks holds information about the Kubernetes and infrastructure networks.
@@ -826,6 +1432,38 @@ type AWSNetworks struct {
 	Public []gardencorev1alpha1.CIDR `json:"public"`
 	// Workers is a list of worker subnets (private) to create (used for the VMs).
 	Workers []gardencorev1alpha1.CIDR `json:"workers"`
+	// ExistingSubnets is a list of already existing subnets per zone which shall be used instead of creating
+	// new ones. It is only allowed in combination with an existing VPC. The routing (e.g., NAT gateways) for
+	// existing subnets must already be configured.
+	// +optional
+	ExistingSubnets []AWSZoneSubnets `json:"existingSubnets,omitempty"`
+	// ExistingNATGateways is a list of already existing NAT gateways per zone which shall be used for the private
+	// subnets instead of creating new ones. It is only allowed in combination with an existing VPC and for zones
+	// without existing subnets.
+	// +optional
+	ExistingNATGateways []AWSZoneNATGateway `json:"existingNATGateways,omitempty"`
+}
+
+// AWSZoneNATGateway references an existing NAT gateway in a zone.
+type AWSZoneNATGateway struct {
+	// Zone is the name of the availability zone the NAT gateway belongs to.
+	Zone string `json:"zone"`
+	// ID is the id of the existing NAT gateway. If it is not provided, the available NAT gateway of the existing
+	// VPC in the zone is discovered automatically.
+	// +optional
+	ID *string `json:"id,omitempty"`
+}
+
+// AWSZoneSubnets contains the ids of existing subnets in a zone.
+type AWSZoneSubnets struct {
+	// Zone is the name of the availability zone the subnets belong to.
+	Zone string `json:"zone"`
+	// Internal is the id of the existing private subnet (used for internal load balancers).
+	Internal string `json:"internal"`
+	// Public is the id of the existing public subnet (used for bastion and load balancers).
+	Public string `json:"public"`
+	// Workers is the id of the existing worker subnet (private) (used for the VMs).
+	Workers string `json:"workers"`
 }
 
 // AWSVPC contains either an id (of an existing VPC) or the CIDR (for a VPC to be created).
@@ -836,6 +1474,10 @@ type AWSVPC struct {
 	// CIDR is a CIDR range for a new VPC.
 	// +optional
 	CIDR *gardencorev1alpha1.CIDR `json:"cidr,omitempty"`
+	// InternetGatewayID is the id of an existing internet gateway attached to the existing VPC. If it is not
+	// provided, the internet gateway attached to the VPC is discovered automatically.
+	// +optional
+	InternetGatewayID *string `json:"internetGatewayID,omitempty"`
 }
 
 // AWSWorker is the definition of a worker group.
@@ -845,10 +1487,21 @@ type AWSWorker struct {
 	VolumeType string `json:"volumeType"`
 	// VolumeSize is the size of the root volume.
 	VolumeSize string `json:"volumeSize"`
+	// VolumeIOPS is the number of I/O operations per second (IOPS) that the root volume supports. It is only
+	// allowed for the provisioned IOPS volume types (io1, io2) and for gp3 volumes.
+	// +optional
+	VolumeIOPS *int64 `json:"volumeIOPS,omitempty"`
+	// VolumeThroughput is the throughput in MiB/s that the root volume supports. It is only allowed for gp3 volumes.
+	// +optional
+	VolumeThroughput *int64 `json:"volumeThroughput,omitempty"`
 }
 
 // Alicloud contains the Shoot specification for Alibaba cloud
 type Alicloud struct {
+	// APIServerLoadBalancer contains the configuration of the SLB which exposes the kube-apiserver of the Shoot.
+	// The address type must not be 'intranet' because the kube-apiserver must be reachable from the Seed and Garden cluster.
+	// +optional
+	APIServerLoadBalancer *AlicloudLoadBalancer `json:"apiServerLoadBalancer,omitempty"`
 	// MachineImage holds information about the machine image to use for all workers.
 	// It will default to the first image stated in the referenced CloudProfile if no
 	// value has been provided.
@@ -860,6 +1513,23 @@ type Alicloud struct {
 	Workers []AlicloudWorker `json:"workers"`
 	// Zones is a list of availability zones to deploy the Shoot cluster to, currently, only one is supported.
 	Zones []string `json:"zones"`
+	// ServiceLoadBalancer contains Alicloud specific defaults for the SLBs of services of type LoadBalancer in the
+	// Shoot cluster.
+	// +optional
+	ServiceLoadBalancer *AlicloudLoadBalancer `json:"serviceLoadBalancer,omitempty"`
+}
+
+// AlicloudLoadBalancer contains the configuration of an Alicloud server load balancer (SLB).
+type AlicloudLoadBalancer struct {
+	// Spec is the instance specification of the SLB, e.g. 'slb.s2.small'.
+	// +optional
+	Spec *string `json:"spec,omitempty"`
+	// AddressType is the address type of the SLB ('internet' or 'intranet'). Defaults to 'internet'.
+	// +optional
+	AddressType *string `json:"addressType,omitempty"`
+	// Bandwidth is the maximum bandwidth of the SLB in Mbps. If set, the SLB is charged by bandwidth.
+	// +optional
+	Bandwidth *int32 `json:"bandwidth,omitempty"`
 }
 
 // AlicloudVPC contains either an id (of an existing VPC) or the CIDR (for a VPC to be created).
@@ -879,6 +1549,41 @@ type AlicloudNetworks struct {
 	VPC AlicloudVPC `json:"vpc"`
 	// Workers is a CIDR of a worker subnet (private) to create (used for the VMs).
 	Workers []gardencorev1alpha1.CIDR `json:"workers"`
+	// NatGateway contains the configuration of the NAT gateways which are used by the worker vswitches.
+	// +optional
+	NatGateway *AlicloudNatGateway `json:"natGateway,omitempty"`
+}
+
+// AlicloudNatGateway contains the configuration of the NAT gateways of a Shoot.
+type AlicloudNatGateway struct {
+	// PerZone indicates that an enhanced NAT gateway is created for each zone (attached to the zone's worker
+	// vswitch) instead of using one NAT gateway for all zones.
+	// +optional
+	PerZone bool `json:"perZone,omitempty"`
+	// EIPBandwidth is the maximum bandwidth of the NAT EIPs in Mbps. It applies to all zones which do not
+	// specify their own bandwidth. Defaults to 100.
+	// +optional
+	EIPBandwidth *int32 `json:"eipBandwidth,omitempty"`
+	// EIPInternetChargeType is the internet charge type of the NAT EIPs ('PayByTraffic' or 'PayByBandwidth'). It
+	// applies to all zones which do not specify their own charge type. If not set, the charge type of the EIP of
+	// the VPC's NAT gateway is kept, or 'PayByTraffic' is used if there is none.
+	// +optional
+	EIPInternetChargeType *string `json:"eipInternetChargeType,omitempty"`
+	// Zones contains the EIP configuration of the NAT of each zone.
+	// +optional
+	Zones []AlicloudNatGatewayZone `json:"zones,omitempty"`
+}
+
+// AlicloudNatGatewayZone contains the EIP configuration of the NAT of a zone.
+type AlicloudNatGatewayZone struct {
+	// Name is the name of the zone.
+	Name string `json:"name"`
+	// EIPBandwidth is the maximum bandwidth of the zone's EIP in Mbps. Defaults to 100.
+	// +optional
+	EIPBandwidth *int32 `json:"eipBandwidth,omitempty"`
+	// EIPInternetChargeType is the internet charge type of the zone's EIP ('PayByTraffic' or 'PayByBandwidth').
+	// +optional
+	EIPInternetChargeType *string `json:"eipInternetChargeType,omitempty"`
 }
 
 // AlicloudWorker is the definition of a worker group.
@@ -958,6 +1663,26 @@ type AzureVNet struct {
 	// CIDR is a CIDR range for a new VNet.
 	// +optional
 	CIDR *gardencorev1alpha1.CIDR `json:"cidr,omitempty"`
+	// Peering contains the configuration for peering the VNet with a hub VNet.
+	// +optional
+	Peering *AzureVNetPeering `json:"peering,omitempty"`
+}
+
+// AzureVNetPeering contains the configuration for peering the VNet of a Shoot with a remote (hub) VNet.
+// Only the peering from the Shoot VNet to the remote VNet is created; the peering in the opposite direction
+// must be established by the owner of the remote VNet.
+type AzureVNetPeering struct {
+	// RemoteVNetID is the resource id of the remote VNet.
+	RemoteVNetID string `json:"remoteVNetID"`
+	// RemoteCIDRs is the list of address spaces of the remote VNet. They must not overlap with the networks
+	// of the Shoot.
+	RemoteCIDRs []gardencorev1alpha1.CIDR `json:"remoteCIDRs"`
+	// AllowForwardedTraffic controls whether forwarded traffic from VMs in the remote VNet is allowed.
+	// +optional
+	AllowForwardedTraffic bool `json:"allowForwardedTraffic,omitempty"`
+	// UseRemoteGateways controls whether the gateways of the remote VNet are used.
+	// +optional
+	UseRemoteGateways bool `json:"useRemoteGateways,omitempty"`
 }
 
 // AzureWorker is the definition of a worker group.
@@ -995,6 +1720,10 @@ type GCPNetworks struct {
 	// Internal is a private subnet (used for internal load balancers).
 	// +optional
 	Internal *gardencorev1alpha1.CIDR `json:"internal,omitempty"`
+	// CloudNAT contains the configuration of a Cloud NAT for the worker subnet. If set, a Cloud Router and a
+	// Cloud NAT are created for the Shoot and the worker machines are created without external IPs.
+	// +optional
+	CloudNAT *GCPCloudNAT `json:"cloudNAT,omitempty"`
 }
 
 // GCPVPC indicates whether to use an existing VPC or create a new one.
@@ -1003,6 +1732,26 @@ type GCPVPC struct {
 	Name string `json:"name"`
 }
 
+// GCPCloudNAT contains the configuration of a Cloud NAT.
+type GCPCloudNAT struct {
+	// MinPortsPerVM is the minimum number of ports allocated to a VM from the NAT IPs. Defaults to 64.
+	// +optional
+	MinPortsPerVM *int32 `json:"minPortsPerVM,omitempty"`
+	// NatIPNames is a list of names of existing static external IP addresses in the Shoot's region which
+	// are used for the NAT. If empty, NAT IPs are allocated automatically.
+	// +optional
+	NatIPNames []string `json:"natIPNames,omitempty"`
+	// Logging contains the logging configuration of the NAT. If not set, logging is disabled.
+	// +optional
+	Logging *GCPCloudNATLogging `json:"logging,omitempty"`
+}
+
+// GCPCloudNATLogging contains the logging configuration of a Cloud NAT.
+type GCPCloudNATLogging struct {
+	// Filter specifies which NAT events are logged. Must be one of 'ERRORS_ONLY', 'TRANSLATIONS_ONLY' or 'ALL'.
+	Filter string `json:"filter"`
+}
+
 // GCPWorker is the definition of a worker group.
 type GCPWorker struct {
 	Worker `json:",inline"`
@@ -1018,6 +1767,10 @@ type OpenStackCloud struct {
 	FloatingPoolName string `json:"floatingPoolName"`
 	// LoadBalancerProvider is the name of the load balancer provider in the OpenStack environment.
 	LoadBalancerProvider string `json:"loadBalancerProvider"`
+	// FloatingPoolSubnetName is the name of a subnet of the floating pool network. If set, FIPs for the
+	// router and for services of type LoadBalancer are allocated from this subnet only.
+	// +optional
+	FloatingPoolSubnetName *string `json:"floatingPoolSubnetName,omitempty"`
 	// MachineImage holds information about the machine image to use for all workers.
 	// It will default to the first image stated in the referenced CloudProfile if no
 	// value has been provided.
@@ -1094,11 +1847,121 @@ type Worker struct {
 	// Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.
 	// +optional
 	Labels map[string]string `json:"labels,omitempty"`
+	// InstanceTagLabels is a list of keys of the `Labels` of this worker pool whose key/value pairs are additionally
+	// added as tags (AWS, Alicloud) or labels (GCP) to the cloud provider instances of this worker pool, e.g. to allow
+	// cost and inventory tools to group the machines by their Kubernetes labels.
+	// +optional
+	InstanceTagLabels []string `json:"instanceTagLabels,omitempty"`
 	// Taints is a list of taints for all the `Node` objects in this worker pool.
 	// +optional
 	Taints []corev1.Taint `json:"taints,omitempty"`
+	// Zones is a subset of the Shoot's zones in which the machines of this worker pool are created. If it is
+	// empty then the worker pool spans all zones of the Shoot.
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+	// OSUpdates contains configuration for in-place updates of the operating system of this worker pool. If it is
+	// set then the nodes receive OS patches in-place instead of being replaced.
+	// +optional
+	OSUpdates *WorkerOSUpdates `json:"osUpdates,omitempty"`
+	// CustomMachineImage is the provider-specific id of a machine image which is not listed in the CloudProfile, e.g.
+	// an AMI on AWS or an URN ('<publisher>:<offer>:<sku>:<version>') on Azure. The image must run the same operating
+	// system as the machine image of the Shoot. It is only allowed if the CustomMachineImages feature gate of the
+	// Gardener API server is enabled and the CloudProfile allows custom machine images for the project of the Shoot.
+	// +optional
+	CustomMachineImage *string `json:"customMachineImage,omitempty"`
+	// MaxPods is the maximum number of pods which can run on a node of this worker pool (default: 110). It must not
+	// exceed the number of addresses of the pod CIDR of a node.
+	// +optional
+	MaxPods *int32 `json:"maxPods,omitempty"`
+	// DataVolumes is a list of additional volumes which are attached to the machines of this worker pool, e.g. as
+	// local scratch disks. They are only supported for AWS and GCP.
+	// +optional
+	DataVolumes []DataVolume `json:"dataVolumes,omitempty"`
+	// KubeletDataVolumeName is the name of a data volume which is formatted and mounted at the root directory of the
+	// kubelet ('/var/lib/kubelet'), i.e., the pod volumes and container logs are stored on it instead of the root volume.
+	// +optional
+	KubeletDataVolumeName *string `json:"kubeletDataVolumeName,omitempty"`
+	// Kubelet contains configuration for the image garbage collection and the container log rotation of the kubelets
+	// of this worker pool.
+	// +optional
+	Kubelet *WorkerKubeletConfig `json:"kubelet,omitempty"`
+	// Architecture is the CPU architecture of the machines of this worker pool (default: amd64). It must match the
+	// architecture of the machine type.
+	// +optional
+	Architecture *string `json:"architecture,omitempty"`
+	// Capacity contains configuration for cost-optimized worker pools which mix on-demand and spot (preemptible)
+	// machines and which may fall back to other machine types. It is only supported for GCP and Alicloud.
+	// +optional
+	Capacity *WorkerCapacity `json:"capacity,omitempty"`
+}
+
+// WorkerCapacity contains configuration for mixing on-demand and spot machines and for fallback machine types of a
+// worker pool. Every combination of a machine type and a purchasing option results in its own machine deployments
+// which are prioritized by the cluster-autoscaler.
+type WorkerCapacity struct {
+	// FallbackMachineTypes is an ordered list of machine types which are used by the cluster-autoscaler if machines
+	// of the machine type of the worker pool cannot be created, e.g. because the cloud provider lacks capacity.
+	// +optional
+	FallbackMachineTypes []string `json:"fallbackMachineTypes,omitempty"`
+	// SpotPercentage is the percentage of the maximum number of machines of the worker pool (autoScalerMax) which may
+	// be spot (preemptible) machines (default: 0). Spot machines are preferred by the cluster-autoscaler, however, the
+	// minimum number of machines (autoScalerMin) is always provided by on-demand machines.
+	// +optional
+	SpotPercentage *int32 `json:"spotPercentage,omitempty"`
+}
+
+// WorkerKubeletConfig contains configuration for the kubelets of a worker pool.
+type WorkerKubeletConfig struct {
+	// ImageGCHighThresholdPercent is the percent of disk usage after which the image garbage collection is always
+	// run (default: 50).
+	// +optional
+	ImageGCHighThresholdPercent *int32 `json:"imageGCHighThresholdPercent,omitempty"`
+	// ImageGCLowThresholdPercent is the percent of disk usage before which the image garbage collection is never
+	// run, it must be lower than the high threshold (default: 40).
+	// +optional
+	ImageGCLowThresholdPercent *int32 `json:"imageGCLowThresholdPercent,omitempty"`
+	// ContainerLogMaxSize is the maximum size of a container log file before it is rotated (default: 100Mi).
+	// +optional
+	ContainerLogMaxSize *resource.Quantity `json:"containerLogMaxSize,omitempty"`
+	// ContainerLogMaxFiles is the maximum number of rotated log files which are kept per container (default: 14).
+	// +optional
+	ContainerLogMaxFiles *int32 `json:"containerLogMaxFiles,omitempty"`
+}
+
+// DataVolume contains the configuration of an additional volume which is attached to the machines of a worker pool.
+type DataVolume struct {
+	// Name is the name of the data volume, it must be unique within the worker pool.
+	Name string `json:"name"`
+	// Size is the size of the data volume, e.g. '100Gi'.
+	Size string `json:"size"`
+	// Type is the provider-specific type of the data volume (default: type of the root volume).
+	// +optional
+	Type *string `json:"type,omitempty"`
+	// Encrypted determines whether the data volume is encrypted (default: true).
+	// +optional
+	Encrypted *bool `json:"encrypted,omitempty"`
+}
+
+// WorkerOSUpdates contains configuration for in-place updates of the operating system of a worker pool.
+type WorkerOSUpdates struct {
+	// Channel is the update channel from which the operating system receives in-place updates.
+	Channel WorkerOSUpdateChannel `json:"channel"`
+	// RebootWindow is the daily time window in which nodes may be rebooted to activate updates. If it is not set
+	// then the maintenance time window of the Shoot is used.
+	// +optional
+	RebootWindow *MaintenanceTimeWindow `json:"rebootWindow,omitempty"`
 }
 
+// WorkerOSUpdateChannel is a channel from which the operating system receives in-place updates.
+type WorkerOSUpdateChannel string
+
+const (
+	// WorkerOSUpdateChannelStable is the channel for stable operating system updates.
+	WorkerOSUpdateChannelStable WorkerOSUpdateChannel = "stable"
+	// WorkerOSUpdateChannelBeta is the channel for beta operating system updates.
+	WorkerOSUpdateChannelBeta WorkerOSUpdateChannel = "beta"
+)
+
 var (
 	// DefaultWorkerMaxSurge is the default value for Worker MaxSurge.
 	DefaultWorkerMaxSurge = intstr.FromInt(1)
@@ -1163,6 +2026,16 @@ type KubernetesDashboard struct {
 	AuthenticationMode *string `json:"authenticationMode,omitempty"`
 }
 
+const (
+	// KubernetesDashboardAuthModeBasic uses basic authentication mode for auth.
+	KubernetesDashboardAuthModeBasic = "basic"
+	// KubernetesDashboardAuthModeToken uses token-based mode for auth.
+	KubernetesDashboardAuthModeToken = "token"
+	// KubernetesDashboardAuthModeOIDC uses token-based mode for auth and expects an OIDC front-proxy to pass
+	// the user's ID token in the Authorization header. It requires the kube-apiserver's OIDC configuration.
+	KubernetesDashboardAuthModeOIDC = "oidc"
+)
+
 // ClusterAutoscaler describes configuration values for the cluster-autoscaler addon.
 type ClusterAutoscaler struct {
 	Addon `json:",inline"`
@@ -1233,6 +2106,10 @@ type DNS struct {
 	// this behavior, i.e. forcing the Gardener to only look into the given secret.
 	// +optional
 	SecretName *string `json:"secretName,omitempty"`
+	// TTL is the time-to-live in seconds of the DNS records for the API server of the Shoot cluster. Lower values
+	// make changes of the API server endpoint visible faster at the cost of more DNS queries. Defaults to 120.
+	// +optional
+	TTL *int64 `json:"ttl,omitempty"`
 }
 
 // DNSUnmanaged is a constant for the 'unmanaged' DNS provider.
@@ -1332,8 +2209,37 @@ type KubeAPIServerConfig struct {
 	// AuditConfig contains configuration settings for the audit of the kube-apiserver.
 	// +optional
 	AuditConfig *AuditConfig `json:"auditConfig,omitempty"`
+	// AllowedCIDRs is a list of CIDRs from which the load balancer of the kube-apiserver accepts connections. The
+	// node network, the static egress IPs of the worker nodes and the node and pod networks of the Seed are always
+	// allowed. If empty, the kube-apiserver is reachable from everywhere. It is not supported for Alicloud and local Shoots.
+	// +optional
+	AllowedCIDRs []gardencorev1alpha1.CIDR `json:"allowedCIDRs,omitempty"`
+	// EnableBasicAuthentication defines whether the kube-apiserver accepts basic authentication. Basic
+	// authentication is deprecated and should be disabled. Defaults to true.
+	// +optional
+	EnableBasicAuthentication *bool `json:"enableBasicAuthentication,omitempty"`
+	// AuthenticationWebhook configures an external webhook which authenticates bearer tokens.
+	// +optional
+	AuthenticationWebhook *AuthenticationWebhook `json:"authenticationWebhook,omitempty"`
+}
+
+// AuthenticationWebhook contains the configuration of an external token authentication webhook of the
+// kube-apiserver.
+type AuthenticationWebhook struct {
+	// URL is the HTTPS endpoint of the webhook which receives TokenReview requests.
+	URL string `json:"url"`
+	// CABundle is the PEM-encoded bundle of certificate authorities which is used to verify the serving
+	// certificate of the webhook. If empty, the system's trusted root certificates are used.
+	// +optional
+	CABundle *string `json:"caBundle,omitempty"`
+	// CacheTTL is the duration to cache the responses of the webhook. Defaults to 2m.
+	// +optional
+	CacheTTL *metav1.Duration `json:"cacheTTL,omitempty"`
 }
 
+// DefaultAuthenticationWebhookCacheTTL is the default duration to cache the responses of the authentication webhook.
+const DefaultAuthenticationWebhookCacheTTL = 2 * time.Minute
+
 // AuditConfig contains settings for audit of the api server
 type AuditConfig struct {
 	// AuditPolicy contains configuration settings for audit policy of the kube-apiserver.
@@ -1407,6 +2313,18 @@ type KubeControllerManagerConfig struct {
 	// HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager.
 	// +optional
 	HorizontalPodAutoscalerConfig *HorizontalPodAutoscalerConfig `json:"horizontalPodAutoscaler,omitempty"`
+	// NodeCIDRMaskSize is the mask size of the pod CIDR which is assigned to every node (default: 24). It determines
+	// the maximum number of nodes of the Shoot (given by the size of the pods network) and the maximum number of pods
+	// per node. It cannot be changed once the Shoot has been created.
+	// +optional
+	NodeCIDRMaskSize *int `json:"nodeCIDRMaskSize,omitempty"`
+	// NodeMonitorGracePeriod is the amount of time which a running node may be unresponsive before it is marked
+	// unhealthy (default: 40s).
+	// +optional
+	NodeMonitorGracePeriod *GardenerDuration `json:"nodeMonitorGracePeriod,omitempty"`
+	// PodEvictionTimeout is the grace period for deleting pods on failed nodes (default: 2m).
+	// +optional
+	PodEvictionTimeout *GardenerDuration `json:"podEvictionTimeout,omitempty"`
 }
 
 // GardenerDuration is a workaround for missing OpenAPI functions on metav1.Duration struct.
@@ -1486,6 +2404,10 @@ const (
 	DefaultInitialReadinessDelay = 30 * time.Second
 	// DefaultCPUInitializationPeriod is the for the default value of the CPUInitializationPeriod in the Shoot cluster
 	DefaultCPUInitializationPeriod = 5 * time.Minute
+	// DefaultNodeMonitorGracePeriod is the default time which a running node may be unresponsive before it is marked unhealthy.
+	DefaultNodeMonitorGracePeriod = 40 * time.Second
+	// DefaultPodEvictionTimeout is the default grace period for deleting pods on failed nodes of a Shoot cluster.
+	DefaultPodEvictionTimeout = 2 * time.Minute
 )
 
 // KubeSchedulerConfig contains configuration settings for the kube-scheduler.
@@ -1536,6 +2458,10 @@ type Maintenance struct {
 	// TimeWindow contains information about the time window for maintenance operations.
 	// +optional
 	TimeWindow *MaintenanceTimeWindow `json:"timeWindow,omitempty"`
+	// ConfineSpecUpdateRollout prevents that changes to the specification of the Shoot are rolled out outside of its
+	// maintenance time window. Changes of the hibernation state and the deletion are not confined.
+	// +optional
+	ConfineSpecUpdateRollout *bool `json:"confineSpecUpdateRollout,omitempty"`
 }
 
 // MaintenanceAutoUpdate contains information about which constraints should be automatically updated.
@@ -1554,6 +2480,18 @@ type MaintenanceTimeWindow struct {
 	End string `json:"end"`
 }
 
+// Monitoring contains information about the monitoring stack of the Shoot which is deployed in the Seed.
+type Monitoring struct {
+	// Enabled indicates whether the monitoring stack (Prometheus, Alertmanager, Grafana and kube-state-metrics)
+	// is deployed for the Shoot. Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// ScrapeTargets is an allowlist of the scrape jobs of the Shoot's Prometheus. If it is empty, all jobs are
+	// scraped.
+	// +optional
+	ScrapeTargets []string `json:"scrapeTargets,omitempty"`
+}
+
 ////////////////////////
 // Shoot Status Types //
 ////////////////////////
@@ -1586,6 +2524,24 @@ const (
 	ShootEventMaintenanceDone = "MaintenanceDone"
 	// ShootEventMaintenanceError indicates that a maintenance operation has failed.
 	ShootEventMaintenanceError = "MaintenanceError"
+	// ShootEventCredentialsRefreshed indicates that changed cloud provider credentials have been redeployed.
+	ShootEventCredentialsRefreshed = "CredentialsRefreshed"
+	// ShootEventCredentialsRefreshError indicates that changed cloud provider credentials could not be redeployed.
+	ShootEventCredentialsRefreshError = "CredentialsRefreshError"
+	// ShootEventCredentialsRotated indicates that the Shoot has been switched to the new credentials of a rotation.
+	ShootEventCredentialsRotated = "CredentialsRotated"
+	// ShootEventCredentialsRotationError indicates that the Shoot could not be switched to the new credentials of a rotation.
+	ShootEventCredentialsRotationError = "CredentialsRotationError"
+	// ShootEventKubeconfigRotated indicates that the kubeconfig of the Shoot has been issued with new credentials.
+	ShootEventKubeconfigRotated = "KubeconfigRotated"
+	// ShootEventOrphanedInfrastructureResources indicates that cloud resources of the Shoot have been found which are
+	// no longer managed by Terraform.
+	ShootEventOrphanedInfrastructureResources = "OrphanedInfrastructureResources"
+
+	// SecretBindingEventRotationStarted indicates that a rotation of the cloud provider credentials has been started.
+	SecretBindingEventRotationStarted = "RotationStarted"
+	// SecretBindingEventRotationCompleted indicates that a rotation of the cloud provider credentials has been completed.
+	SecretBindingEventRotationCompleted = "RotationCompleted"
 
 	// ProjectEventNamespaceReconcileFailed indicates that the namespace reconciliation has failed.
 	ProjectEventNamespaceReconcileFailed = "NamespaceReconcileFailed"
@@ -1613,6 +2569,12 @@ const (
 const (
 	// SeedAvailable is a constant for a condition type indicating the Seed cluster availability.
 	SeedAvailable gardencorev1alpha1.ConditionType = "Available"
+	// SeedShootVersionsCompatible is a constant for a condition type indicating whether the Kubernetes versions of
+	// all Shoots hosted by the Seed cluster are compatible with the Kubernetes version of the Seed cluster.
+	SeedShootVersionsCompatible gardencorev1alpha1.ConditionType = "ShootVersionsCompatible"
+	// SeedProviderHealthy is a constant for a condition type indicating whether the cloud API of the Seed's cloud
+	// provider account is reachable and whether its quotas have enough headroom for new Shoots.
+	SeedProviderHealthy gardencorev1alpha1.ConditionType = "ProviderHealthy"
 
 	// ShootControlPlaneHealthy is a constant for a condition type indicating the control plane health.
 	ShootControlPlaneHealthy gardencorev1alpha1.ConditionType = "ControlPlaneHealthy"
@@ -1624,6 +2586,19 @@ const (
 	ShootAlertsInactive gardencorev1alpha1.ConditionType = "AlertsInactive"
 	// ShootAPIServerAvailable is a constant for a condition type indicating that the Shoot clusters API server is available.
 	ShootAPIServerAvailable gardencorev1alpha1.ConditionType = "APIServerAvailable"
+	// ShootHibernationPossible is a constant for a constraint type indicating whether the Shoot can be hibernated
+	// and woken up again without manual intervention.
+	ShootHibernationPossible gardencorev1alpha1.ConditionType = "HibernationPossible"
+	// ShootBackupReady is a constant for a condition type indicating that the backup bucket of the Shoot's etcd is
+	// reachable and writable.
+	ShootBackupReady gardencorev1alpha1.ConditionType = "BackupReady"
+	// ShootTunnelHealthy is a constant for a condition type indicating that the node, pod and service networks of
+	// the Shoot are reachable from the Seed through the VPN tunnel.
+	ShootTunnelHealthy gardencorev1alpha1.ConditionType = "TunnelHealthy"
+
+	// BackupInfrastructureBucketReady is a constant for a condition type indicating that the backup bucket exists,
+	// is writable, and that the credentials used to access it are valid.
+	BackupInfrastructureBucketReady gardencorev1alpha1.ConditionType = "BucketReady"
 )
 
 ////////////////////////////////////////////////////
@@ -1664,6 +2639,9 @@ type BackupInfrastructureSpec struct {
 	Seed string `json:"seed"`
 	// ShootUID is a unique identifier for the Shoot cluster for which the BackupInfrastructure object is created.
 	ShootUID types.UID `json:"shootUID"`
+	// Region is the region in which the backup bucket is created. Defaults to the region of the Seed.
+	// +optional
+	Region *string `json:"region,omitempty"`
 }
 
 // BackupInfrastructureStatus holds the most recently observed status of the Backup Infrastructure.
@@ -1678,4 +2656,7 @@ type BackupInfrastructureStatus struct {
 	// BackupInfrastructure's generation, which is updated on mutation by the API Server.
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions represents the latest available observations of a BackupInfrastructure's current state.
+	// +optional
+	Conditions []gardencorev1alpha1.Condition `json:"conditions,omitempty"`
 }
@@ -1,5 +1,21 @@
 // +build !ignore_autogenerated
 
+/*
+Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
 // Code generated by conversion-gen. DO NOT EDIT.
 
 package v1beta1
@@ -12,6 +28,7 @@ import (
 	garden "github.com/gardener/gardener/pkg/apis/garden"
 	v1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -25,6 +42,26 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*APIServerSLO)(nil), (*garden.APIServerSLO)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIServerSLO_To_garden_APIServerSLO(a.(*APIServerSLO), b.(*garden.APIServerSLO), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.APIServerSLO)(nil), (*APIServerSLO)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_APIServerSLO_To_v1beta1_APIServerSLO(a.(*garden.APIServerSLO), b.(*APIServerSLO), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIServerSLOSample)(nil), (*garden.APIServerSLOSample)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIServerSLOSample_To_garden_APIServerSLOSample(a.(*APIServerSLOSample), b.(*garden.APIServerSLOSample), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.APIServerSLOSample)(nil), (*APIServerSLOSample)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_APIServerSLOSample_To_v1beta1_APIServerSLOSample(a.(*garden.APIServerSLOSample), b.(*APIServerSLOSample), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AWSCloud)(nil), (*garden.AWSCloud)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AWSCloud_To_garden_AWSCloud(a.(*AWSCloud), b.(*garden.AWSCloud), scope)
 	}); err != nil {
@@ -95,6 +132,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AWSServiceLoadBalancer)(nil), (*garden.AWSServiceLoadBalancer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AWSServiceLoadBalancer_To_garden_AWSServiceLoadBalancer(a.(*AWSServiceLoadBalancer), b.(*garden.AWSServiceLoadBalancer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AWSServiceLoadBalancer)(nil), (*AWSServiceLoadBalancer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AWSServiceLoadBalancer_To_v1beta1_AWSServiceLoadBalancer(a.(*garden.AWSServiceLoadBalancer), b.(*AWSServiceLoadBalancer), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AWSVPC)(nil), (*garden.AWSVPC)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AWSVPC_To_garden_AWSVPC(a.(*AWSVPC), b.(*garden.AWSVPC), scope)
 	}); err != nil {
@@ -115,6 +162,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AWSZoneNATGateway)(nil), (*garden.AWSZoneNATGateway)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AWSZoneNATGateway_To_garden_AWSZoneNATGateway(a.(*AWSZoneNATGateway), b.(*garden.AWSZoneNATGateway), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AWSZoneNATGateway)(nil), (*AWSZoneNATGateway)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AWSZoneNATGateway_To_v1beta1_AWSZoneNATGateway(a.(*garden.AWSZoneNATGateway), b.(*AWSZoneNATGateway), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AWSZoneSubnets)(nil), (*garden.AWSZoneSubnets)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AWSZoneSubnets_To_garden_AWSZoneSubnets(a.(*AWSZoneSubnets), b.(*garden.AWSZoneSubnets), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AWSZoneSubnets)(nil), (*AWSZoneSubnets)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AWSZoneSubnets_To_v1beta1_AWSZoneSubnets(a.(*garden.AWSZoneSubnets), b.(*AWSZoneSubnets), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Addon)(nil), (*garden.Addon)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Addon_To_garden_Addon(a.(*Addon), b.(*garden.Addon), scope)
 	}); err != nil {
@@ -165,6 +232,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AlicloudLoadBalancer)(nil), (*garden.AlicloudLoadBalancer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AlicloudLoadBalancer_To_garden_AlicloudLoadBalancer(a.(*AlicloudLoadBalancer), b.(*garden.AlicloudLoadBalancer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AlicloudLoadBalancer)(nil), (*AlicloudLoadBalancer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AlicloudLoadBalancer_To_v1beta1_AlicloudLoadBalancer(a.(*garden.AlicloudLoadBalancer), b.(*AlicloudLoadBalancer), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AlicloudMachineImage)(nil), (*garden.AlicloudMachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AlicloudMachineImage_To_garden_AlicloudMachineImage(a.(*AlicloudMachineImage), b.(*garden.AlicloudMachineImage), scope)
 	}); err != nil {
@@ -185,6 +262,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AlicloudNatGateway)(nil), (*garden.AlicloudNatGateway)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AlicloudNatGateway_To_garden_AlicloudNatGateway(a.(*AlicloudNatGateway), b.(*garden.AlicloudNatGateway), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AlicloudNatGateway)(nil), (*AlicloudNatGateway)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AlicloudNatGateway_To_v1beta1_AlicloudNatGateway(a.(*garden.AlicloudNatGateway), b.(*AlicloudNatGateway), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AlicloudNatGatewayZone)(nil), (*garden.AlicloudNatGatewayZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AlicloudNatGatewayZone_To_garden_AlicloudNatGatewayZone(a.(*AlicloudNatGatewayZone), b.(*garden.AlicloudNatGatewayZone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AlicloudNatGatewayZone)(nil), (*AlicloudNatGatewayZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AlicloudNatGatewayZone_To_v1beta1_AlicloudNatGatewayZone(a.(*garden.AlicloudNatGatewayZone), b.(*AlicloudNatGatewayZone), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AlicloudNetworks)(nil), (*garden.AlicloudNetworks)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AlicloudNetworks_To_garden_AlicloudNetworks(a.(*AlicloudNetworks), b.(*garden.AlicloudNetworks), scope)
 	}); err != nil {
@@ -255,6 +352,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AuthenticationWebhook)(nil), (*garden.AuthenticationWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AuthenticationWebhook_To_garden_AuthenticationWebhook(a.(*AuthenticationWebhook), b.(*garden.AuthenticationWebhook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AuthenticationWebhook)(nil), (*AuthenticationWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AuthenticationWebhook_To_v1beta1_AuthenticationWebhook(a.(*garden.AuthenticationWebhook), b.(*AuthenticationWebhook), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AzureCloud)(nil), (*garden.AzureCloud)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AzureCloud_To_garden_AzureCloud(a.(*AzureCloud), b.(*garden.AzureCloud), scope)
 	}); err != nil {
@@ -335,6 +442,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AzureVNetPeering)(nil), (*garden.AzureVNetPeering)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AzureVNetPeering_To_garden_AzureVNetPeering(a.(*AzureVNetPeering), b.(*garden.AzureVNetPeering), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.AzureVNetPeering)(nil), (*AzureVNetPeering)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_AzureVNetPeering_To_v1beta1_AzureVNetPeering(a.(*garden.AzureVNetPeering), b.(*AzureVNetPeering), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AzureWorker)(nil), (*garden.AzureWorker)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AzureWorker_To_garden_AzureWorker(a.(*AzureWorker), b.(*garden.AzureWorker), scope)
 	}); err != nil {
@@ -455,6 +572,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CustomMachineImages)(nil), (*garden.CustomMachineImages)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CustomMachineImages_To_garden_CustomMachineImages(a.(*CustomMachineImages), b.(*garden.CustomMachineImages), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.CustomMachineImages)(nil), (*CustomMachineImages)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_CustomMachineImages_To_v1beta1_CustomMachineImages(a.(*garden.CustomMachineImages), b.(*CustomMachineImages), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DNS)(nil), (*garden.DNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_DNS_To_garden_DNS(a.(*DNS), b.(*garden.DNS), scope)
 	}); err != nil {
@@ -475,6 +602,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DataVolume)(nil), (*garden.DataVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DataVolume_To_garden_DataVolume(a.(*DataVolume), b.(*garden.DataVolume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.DataVolume)(nil), (*DataVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_DataVolume_To_v1beta1_DataVolume(a.(*garden.DataVolume), b.(*DataVolume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DeletionProtectionPolicy)(nil), (*garden.DeletionProtectionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DeletionProtectionPolicy_To_garden_DeletionProtectionPolicy(a.(*DeletionProtectionPolicy), b.(*garden.DeletionProtectionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.DeletionProtectionPolicy)(nil), (*DeletionProtectionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_DeletionProtectionPolicy_To_v1beta1_DeletionProtectionPolicy(a.(*garden.DeletionProtectionPolicy), b.(*DeletionProtectionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DeletionProtectionPolicyList)(nil), (*garden.DeletionProtectionPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DeletionProtectionPolicyList_To_garden_DeletionProtectionPolicyList(a.(*DeletionProtectionPolicyList), b.(*garden.DeletionProtectionPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.DeletionProtectionPolicyList)(nil), (*DeletionProtectionPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_DeletionProtectionPolicyList_To_v1beta1_DeletionProtectionPolicyList(a.(*garden.DeletionProtectionPolicyList), b.(*DeletionProtectionPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DeletionProtectionPolicySpec)(nil), (*garden.DeletionProtectionPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DeletionProtectionPolicySpec_To_garden_DeletionProtectionPolicySpec(a.(*DeletionProtectionPolicySpec), b.(*garden.DeletionProtectionPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.DeletionProtectionPolicySpec)(nil), (*DeletionProtectionPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_DeletionProtectionPolicySpec_To_v1beta1_DeletionProtectionPolicySpec(a.(*garden.DeletionProtectionPolicySpec), b.(*DeletionProtectionPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GCPCloud)(nil), (*garden.GCPCloud)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_GCPCloud_To_garden_GCPCloud(a.(*GCPCloud), b.(*garden.GCPCloud), scope)
 	}); err != nil {
@@ -485,6 +652,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*GCPCloudNAT)(nil), (*garden.GCPCloudNAT)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPCloudNAT_To_garden_GCPCloudNAT(a.(*GCPCloudNAT), b.(*garden.GCPCloudNAT), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.GCPCloudNAT)(nil), (*GCPCloudNAT)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_GCPCloudNAT_To_v1beta1_GCPCloudNAT(a.(*garden.GCPCloudNAT), b.(*GCPCloudNAT), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GCPCloudNATLogging)(nil), (*garden.GCPCloudNATLogging)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPCloudNATLogging_To_garden_GCPCloudNATLogging(a.(*GCPCloudNATLogging), b.(*garden.GCPCloudNATLogging), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.GCPCloudNATLogging)(nil), (*GCPCloudNATLogging)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_GCPCloudNATLogging_To_v1beta1_GCPCloudNATLogging(a.(*garden.GCPCloudNATLogging), b.(*GCPCloudNATLogging), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GCPConstraints)(nil), (*garden.GCPConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_GCPConstraints_To_garden_GCPConstraints(a.(*GCPConstraints), b.(*garden.GCPConstraints), scope)
 	}); err != nil {
@@ -815,6 +1002,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Monitoring)(nil), (*garden.Monitoring)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Monitoring_To_garden_Monitoring(a.(*Monitoring), b.(*garden.Monitoring), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.Monitoring)(nil), (*Monitoring)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_Monitoring_To_v1beta1_Monitoring(a.(*garden.Monitoring), b.(*Monitoring), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Monocular)(nil), (*garden.Monocular)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Monocular_To_garden_Monocular(a.(*Monocular), b.(*garden.Monocular), scope)
 	}); err != nil {
@@ -1015,6 +1212,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectCloudProfile)(nil), (*garden.ProjectCloudProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectCloudProfile_To_garden_ProjectCloudProfile(a.(*ProjectCloudProfile), b.(*garden.ProjectCloudProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ProjectCloudProfile)(nil), (*ProjectCloudProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ProjectCloudProfile_To_v1beta1_ProjectCloudProfile(a.(*garden.ProjectCloudProfile), b.(*ProjectCloudProfile), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectList)(nil), (*garden.ProjectList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ProjectList_To_garden_ProjectList(a.(*ProjectList), b.(*garden.ProjectList), scope)
 	}); err != nil {
@@ -1025,6 +1232,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectRestrictions)(nil), (*garden.ProjectRestrictions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectRestrictions_To_garden_ProjectRestrictions(a.(*ProjectRestrictions), b.(*garden.ProjectRestrictions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ProjectRestrictions)(nil), (*ProjectRestrictions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ProjectRestrictions_To_v1beta1_ProjectRestrictions(a.(*garden.ProjectRestrictions), b.(*ProjectRestrictions), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectSpec)(nil), (*garden.ProjectSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ProjectSpec_To_garden_ProjectSpec(a.(*ProjectSpec), b.(*garden.ProjectSpec), scope)
 	}); err != nil {
@@ -1095,6 +1312,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SecretBindingRotation)(nil), (*garden.SecretBindingRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SecretBindingRotation_To_garden_SecretBindingRotation(a.(*SecretBindingRotation), b.(*garden.SecretBindingRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SecretBindingRotation)(nil), (*SecretBindingRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SecretBindingRotation_To_v1beta1_SecretBindingRotation(a.(*garden.SecretBindingRotation), b.(*SecretBindingRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecretBindingRotationStatus)(nil), (*garden.SecretBindingRotationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SecretBindingRotationStatus_To_garden_SecretBindingRotationStatus(a.(*SecretBindingRotationStatus), b.(*garden.SecretBindingRotationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SecretBindingRotationStatus)(nil), (*SecretBindingRotationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SecretBindingRotationStatus_To_v1beta1_SecretBindingRotationStatus(a.(*garden.SecretBindingRotationStatus), b.(*SecretBindingRotationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecretBindingVaultReference)(nil), (*garden.SecretBindingVaultReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SecretBindingVaultReference_To_garden_SecretBindingVaultReference(a.(*SecretBindingVaultReference), b.(*garden.SecretBindingVaultReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SecretBindingVaultReference)(nil), (*SecretBindingVaultReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SecretBindingVaultReference_To_v1beta1_SecretBindingVaultReference(a.(*garden.SecretBindingVaultReference), b.(*SecretBindingVaultReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Seed)(nil), (*garden.Seed)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Seed_To_garden_Seed(a.(*Seed), b.(*garden.Seed), scope)
 	}); err != nil {
@@ -1105,6 +1352,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedBackup)(nil), (*garden.SeedBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedBackup_To_garden_SeedBackup(a.(*SeedBackup), b.(*garden.SeedBackup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SeedBackup)(nil), (*SeedBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SeedBackup_To_v1beta1_SeedBackup(a.(*garden.SeedBackup), b.(*SeedBackup), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedCloud)(nil), (*garden.SeedCloud)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedCloud_To_garden_SeedCloud(a.(*SeedCloud), b.(*garden.SeedCloud), scope)
 	}); err != nil {
@@ -1115,6 +1372,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedDashboardOIDC)(nil), (*garden.SeedDashboardOIDC)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedDashboardOIDC_To_garden_SeedDashboardOIDC(a.(*SeedDashboardOIDC), b.(*garden.SeedDashboardOIDC), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SeedDashboardOIDC)(nil), (*SeedDashboardOIDC)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SeedDashboardOIDC_To_v1beta1_SeedDashboardOIDC(a.(*garden.SeedDashboardOIDC), b.(*SeedDashboardOIDC), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedIngressACME)(nil), (*garden.SeedIngressACME)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedIngressACME_To_garden_SeedIngressACME(a.(*SeedIngressACME), b.(*garden.SeedIngressACME), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SeedIngressACME)(nil), (*SeedIngressACME)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SeedIngressACME_To_v1beta1_SeedIngressACME(a.(*garden.SeedIngressACME), b.(*SeedIngressACME), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedIngressTLS)(nil), (*garden.SeedIngressTLS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedIngressTLS_To_garden_SeedIngressTLS(a.(*SeedIngressTLS), b.(*garden.SeedIngressTLS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SeedIngressTLS)(nil), (*SeedIngressTLS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SeedIngressTLS_To_v1beta1_SeedIngressTLS(a.(*garden.SeedIngressTLS), b.(*SeedIngressTLS), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedList)(nil), (*garden.SeedList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedList_To_garden_SeedList(a.(*SeedList), b.(*garden.SeedList), scope)
 	}); err != nil {
@@ -1135,6 +1422,116 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedOrphan)(nil), (*garden.SeedOrphan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedOrphan_To_garden_SeedOrphan(a.(*SeedOrphan), b.(*garden.SeedOrphan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SeedOrphan)(nil), (*SeedOrphan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SeedOrphan_To_v1beta1_SeedOrphan(a.(*garden.SeedOrphan), b.(*SeedOrphan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedProviderHealth)(nil), (*garden.SeedProviderHealth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedProviderHealth_To_garden_SeedProviderHealth(a.(*SeedProviderHealth), b.(*garden.SeedProviderHealth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SeedProviderHealth)(nil), (*SeedProviderHealth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SeedProviderHealth_To_v1beta1_SeedProviderHealth(a.(*garden.SeedProviderHealth), b.(*SeedProviderHealth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedProviderQuota)(nil), (*garden.SeedProviderQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedProviderQuota_To_garden_SeedProviderQuota(a.(*SeedProviderQuota), b.(*garden.SeedProviderQuota), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SeedProviderQuota)(nil), (*SeedProviderQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SeedProviderQuota_To_v1beta1_SeedProviderQuota(a.(*garden.SeedProviderQuota), b.(*SeedProviderQuota), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedSettingDashboardAuthentication)(nil), (*garden.SeedSettingDashboardAuthentication)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedSettingDashboardAuthentication_To_garden_SeedSettingDashboardAuthentication(a.(*SeedSettingDashboardAuthentication), b.(*garden.SeedSettingDashboardAuthentication), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SeedSettingDashboardAuthentication)(nil), (*SeedSettingDashboardAuthentication)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SeedSettingDashboardAuthentication_To_v1beta1_SeedSettingDashboardAuthentication(a.(*garden.SeedSettingDashboardAuthentication), b.(*SeedSettingDashboardAuthentication), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedSettingExcessCapacityReservation)(nil), (*garden.SeedSettingExcessCapacityReservation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedSettingExcessCapacityReservation_To_garden_SeedSettingExcessCapacityReservation(a.(*SeedSettingExcessCapacityReservation), b.(*garden.SeedSettingExcessCapacityReservation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SeedSettingExcessCapacityReservation)(nil), (*SeedSettingExcessCapacityReservation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SeedSettingExcessCapacityReservation_To_v1beta1_SeedSettingExcessCapacityReservation(a.(*garden.SeedSettingExcessCapacityReservation), b.(*SeedSettingExcessCapacityReservation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedSettingLoadBalancerServices)(nil), (*garden.SeedSettingLoadBalancerServices)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedSettingLoadBalancerServices_To_garden_SeedSettingLoadBalancerServices(a.(*SeedSettingLoadBalancerServices), b.(*garden.SeedSettingLoadBalancerServices), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SeedSettingLoadBalancerServices)(nil), (*SeedSettingLoadBalancerServices)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SeedSettingLoadBalancerServices_To_v1beta1_SeedSettingLoadBalancerServices(a.(*garden.SeedSettingLoadBalancerServices), b.(*SeedSettingLoadBalancerServices), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedSettingNetworkPolicies)(nil), (*garden.SeedSettingNetworkPolicies)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedSettingNetworkPolicies_To_garden_SeedSettingNetworkPolicies(a.(*SeedSettingNetworkPolicies), b.(*garden.SeedSettingNetworkPolicies), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SeedSettingNetworkPolicies)(nil), (*SeedSettingNetworkPolicies)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SeedSettingNetworkPolicies_To_v1beta1_SeedSettingNetworkPolicies(a.(*garden.SeedSettingNetworkPolicies), b.(*SeedSettingNetworkPolicies), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedSettingScheduling)(nil), (*garden.SeedSettingScheduling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedSettingScheduling_To_garden_SeedSettingScheduling(a.(*SeedSettingScheduling), b.(*garden.SeedSettingScheduling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SeedSettingScheduling)(nil), (*SeedSettingScheduling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SeedSettingScheduling_To_v1beta1_SeedSettingScheduling(a.(*garden.SeedSettingScheduling), b.(*SeedSettingScheduling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedSettingShootDNS)(nil), (*garden.SeedSettingShootDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedSettingShootDNS_To_garden_SeedSettingShootDNS(a.(*SeedSettingShootDNS), b.(*garden.SeedSettingShootDNS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SeedSettingShootDNS)(nil), (*SeedSettingShootDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SeedSettingShootDNS_To_v1beta1_SeedSettingShootDNS(a.(*garden.SeedSettingShootDNS), b.(*SeedSettingShootDNS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedSettingVerticalPodAutoscaler)(nil), (*garden.SeedSettingVerticalPodAutoscaler)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedSettingVerticalPodAutoscaler_To_garden_SeedSettingVerticalPodAutoscaler(a.(*SeedSettingVerticalPodAutoscaler), b.(*garden.SeedSettingVerticalPodAutoscaler), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SeedSettingVerticalPodAutoscaler)(nil), (*SeedSettingVerticalPodAutoscaler)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SeedSettingVerticalPodAutoscaler_To_v1beta1_SeedSettingVerticalPodAutoscaler(a.(*garden.SeedSettingVerticalPodAutoscaler), b.(*SeedSettingVerticalPodAutoscaler), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedSettings)(nil), (*garden.SeedSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedSettings_To_garden_SeedSettings(a.(*SeedSettings), b.(*garden.SeedSettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SeedSettings)(nil), (*SeedSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SeedSettings_To_v1beta1_SeedSettings(a.(*garden.SeedSettings), b.(*SeedSettings), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedSpec)(nil), (*garden.SeedSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedSpec_To_garden_SeedSpec(a.(*SeedSpec), b.(*garden.SeedSpec), scope)
 	}); err != nil {
@@ -1155,6 +1552,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ServiceLoadBalancer)(nil), (*garden.ServiceLoadBalancer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ServiceLoadBalancer_To_garden_ServiceLoadBalancer(a.(*ServiceLoadBalancer), b.(*garden.ServiceLoadBalancer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ServiceLoadBalancer)(nil), (*ServiceLoadBalancer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ServiceLoadBalancer_To_v1beta1_ServiceLoadBalancer(a.(*garden.ServiceLoadBalancer), b.(*ServiceLoadBalancer), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Shoot)(nil), (*garden.Shoot)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Shoot_To_garden_Shoot(a.(*Shoot), b.(*garden.Shoot), scope)
 	}); err != nil {
@@ -1165,6 +1572,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootAccessAudit)(nil), (*garden.ShootAccessAudit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootAccessAudit_To_garden_ShootAccessAudit(a.(*ShootAccessAudit), b.(*garden.ShootAccessAudit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ShootAccessAudit)(nil), (*ShootAccessAudit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootAccessAudit_To_v1beta1_ShootAccessAudit(a.(*garden.ShootAccessAudit), b.(*ShootAccessAudit), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootList)(nil), (*garden.ShootList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootList_To_garden_ShootList(a.(*ShootList), b.(*garden.ShootList), scope)
 	}); err != nil {
@@ -1195,33 +1612,143 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*VolumeType)(nil), (*garden.VolumeType)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_VolumeType_To_garden_VolumeType(a.(*VolumeType), b.(*garden.VolumeType), scope)
+	if err := s.AddGeneratedConversionFunc((*ShootTemplate)(nil), (*garden.ShootTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootTemplate_To_garden_ShootTemplate(a.(*ShootTemplate), b.(*garden.ShootTemplate), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*garden.VolumeType)(nil), (*VolumeType)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_garden_VolumeType_To_v1beta1_VolumeType(a.(*garden.VolumeType), b.(*VolumeType), scope)
+	if err := s.AddGeneratedConversionFunc((*garden.ShootTemplate)(nil), (*ShootTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootTemplate_To_v1beta1_ShootTemplate(a.(*garden.ShootTemplate), b.(*ShootTemplate), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Worker)(nil), (*garden.Worker)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_Worker_To_garden_Worker(a.(*Worker), b.(*garden.Worker), scope)
+	if err := s.AddGeneratedConversionFunc((*ShootTemplateList)(nil), (*garden.ShootTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootTemplateList_To_garden_ShootTemplateList(a.(*ShootTemplateList), b.(*garden.ShootTemplateList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*garden.Worker)(nil), (*Worker)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_garden_Worker_To_v1beta1_Worker(a.(*garden.Worker), b.(*Worker), scope)
+	if err := s.AddGeneratedConversionFunc((*garden.ShootTemplateList)(nil), (*ShootTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootTemplateList_To_v1beta1_ShootTemplateList(a.(*garden.ShootTemplateList), b.(*ShootTemplateList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Zone)(nil), (*garden.Zone)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_Zone_To_garden_Zone(a.(*Zone), b.(*garden.Zone), scope)
+	if err := s.AddGeneratedConversionFunc((*ShootTemplateReference)(nil), (*garden.ShootTemplateReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootTemplateReference_To_garden_ShootTemplateReference(a.(*ShootTemplateReference), b.(*garden.ShootTemplateReference), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*garden.Zone)(nil), (*Zone)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_garden_Zone_To_v1beta1_Zone(a.(*garden.Zone), b.(*Zone), scope)
+	if err := s.AddGeneratedConversionFunc((*garden.ShootTemplateReference)(nil), (*ShootTemplateReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootTemplateReference_To_v1beta1_ShootTemplateReference(a.(*garden.ShootTemplateReference), b.(*ShootTemplateReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootTemplateSpec)(nil), (*garden.ShootTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootTemplateSpec_To_garden_ShootTemplateSpec(a.(*ShootTemplateSpec), b.(*garden.ShootTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ShootTemplateSpec)(nil), (*ShootTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootTemplateSpec_To_v1beta1_ShootTemplateSpec(a.(*garden.ShootTemplateSpec), b.(*ShootTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootTemplateWorker)(nil), (*garden.ShootTemplateWorker)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootTemplateWorker_To_garden_ShootTemplateWorker(a.(*ShootTemplateWorker), b.(*garden.ShootTemplateWorker), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ShootTemplateWorker)(nil), (*ShootTemplateWorker)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootTemplateWorker_To_v1beta1_ShootTemplateWorker(a.(*garden.ShootTemplateWorker), b.(*ShootTemplateWorker), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Storage)(nil), (*garden.Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Storage_To_garden_Storage(a.(*Storage), b.(*garden.Storage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.Storage)(nil), (*Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_Storage_To_v1beta1_Storage(a.(*garden.Storage), b.(*Storage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageClass)(nil), (*garden.StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StorageClass_To_garden_StorageClass(a.(*StorageClass), b.(*garden.StorageClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.StorageClass)(nil), (*StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_StorageClass_To_v1beta1_StorageClass(a.(*garden.StorageClass), b.(*StorageClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TerraformerSettings)(nil), (*garden.TerraformerSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TerraformerSettings_To_garden_TerraformerSettings(a.(*TerraformerSettings), b.(*garden.TerraformerSettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.TerraformerSettings)(nil), (*TerraformerSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_TerraformerSettings_To_v1beta1_TerraformerSettings(a.(*garden.TerraformerSettings), b.(*TerraformerSettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VolumeType)(nil), (*garden.VolumeType)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VolumeType_To_garden_VolumeType(a.(*VolumeType), b.(*garden.VolumeType), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.VolumeType)(nil), (*VolumeType)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_VolumeType_To_v1beta1_VolumeType(a.(*garden.VolumeType), b.(*VolumeType), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Worker)(nil), (*garden.Worker)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Worker_To_garden_Worker(a.(*Worker), b.(*garden.Worker), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.Worker)(nil), (*Worker)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_Worker_To_v1beta1_Worker(a.(*garden.Worker), b.(*Worker), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerCapacity)(nil), (*garden.WorkerCapacity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerCapacity_To_garden_WorkerCapacity(a.(*WorkerCapacity), b.(*garden.WorkerCapacity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.WorkerCapacity)(nil), (*WorkerCapacity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_WorkerCapacity_To_v1beta1_WorkerCapacity(a.(*garden.WorkerCapacity), b.(*WorkerCapacity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerKubeletConfig)(nil), (*garden.WorkerKubeletConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerKubeletConfig_To_garden_WorkerKubeletConfig(a.(*WorkerKubeletConfig), b.(*garden.WorkerKubeletConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.WorkerKubeletConfig)(nil), (*WorkerKubeletConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_WorkerKubeletConfig_To_v1beta1_WorkerKubeletConfig(a.(*garden.WorkerKubeletConfig), b.(*WorkerKubeletConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerOSUpdates)(nil), (*garden.WorkerOSUpdates)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerOSUpdates_To_garden_WorkerOSUpdates(a.(*WorkerOSUpdates), b.(*garden.WorkerOSUpdates), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.WorkerOSUpdates)(nil), (*WorkerOSUpdates)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_WorkerOSUpdates_To_v1beta1_WorkerOSUpdates(a.(*garden.WorkerOSUpdates), b.(*WorkerOSUpdates), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Zone)(nil), (*garden.Zone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Zone_To_garden_Zone(a.(*Zone), b.(*garden.Zone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.Zone)(nil), (*Zone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_Zone_To_v1beta1_Zone(a.(*garden.Zone), b.(*Zone), scope)
 	}); err != nil {
 		return err
 	}
@@ -1238,6 +1765,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1beta1_APIServerSLO_To_garden_APIServerSLO(in *APIServerSLO, out *garden.APIServerSLO, s conversion.Scope) error {
+	out.Availability = in.Availability
+	out.Latency = in.Latency
+	out.Samples = *(*[]garden.APIServerSLOSample)(unsafe.Pointer(&in.Samples))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1beta1_APIServerSLO_To_garden_APIServerSLO is an autogenerated conversion function.
+func Convert_v1beta1_APIServerSLO_To_garden_APIServerSLO(in *APIServerSLO, out *garden.APIServerSLO, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIServerSLO_To_garden_APIServerSLO(in, out, s)
+}
+
+func autoConvert_garden_APIServerSLO_To_v1beta1_APIServerSLO(in *garden.APIServerSLO, out *APIServerSLO, s conversion.Scope) error {
+	out.Availability = in.Availability
+	out.Latency = in.Latency
+	out.Samples = *(*[]APIServerSLOSample)(unsafe.Pointer(&in.Samples))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_garden_APIServerSLO_To_v1beta1_APIServerSLO is an autogenerated conversion function.
+func Convert_garden_APIServerSLO_To_v1beta1_APIServerSLO(in *garden.APIServerSLO, out *APIServerSLO, s conversion.Scope) error {
+	return autoConvert_garden_APIServerSLO_To_v1beta1_APIServerSLO(in, out, s)
+}
+
+func autoConvert_v1beta1_APIServerSLOSample_To_garden_APIServerSLOSample(in *APIServerSLOSample, out *garden.APIServerSLOSample, s conversion.Scope) error {
+	out.Day = in.Day
+	out.Availability = in.Availability
+	out.Latency = in.Latency
+	return nil
+}
+
+// Convert_v1beta1_APIServerSLOSample_To_garden_APIServerSLOSample is an autogenerated conversion function.
+func Convert_v1beta1_APIServerSLOSample_To_garden_APIServerSLOSample(in *APIServerSLOSample, out *garden.APIServerSLOSample, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIServerSLOSample_To_garden_APIServerSLOSample(in, out, s)
+}
+
+func autoConvert_garden_APIServerSLOSample_To_v1beta1_APIServerSLOSample(in *garden.APIServerSLOSample, out *APIServerSLOSample, s conversion.Scope) error {
+	out.Day = in.Day
+	out.Availability = in.Availability
+	out.Latency = in.Latency
+	return nil
+}
+
+// Convert_garden_APIServerSLOSample_To_v1beta1_APIServerSLOSample is an autogenerated conversion function.
+func Convert_garden_APIServerSLOSample_To_v1beta1_APIServerSLOSample(in *garden.APIServerSLOSample, out *APIServerSLOSample, s conversion.Scope) error {
+	return autoConvert_garden_APIServerSLOSample_To_v1beta1_APIServerSLOSample(in, out, s)
+}
+
 func autoConvert_v1beta1_AWSCloud_To_garden_AWSCloud(in *AWSCloud, out *garden.AWSCloud, s conversion.Scope) error {
 	out.MachineImage = (*garden.AWSMachineImage)(unsafe.Pointer(in.MachineImage))
 	if err := Convert_v1beta1_AWSNetworks_To_garden_AWSNetworks(&in.Networks, &out.Networks, s); err != nil {
@@ -1255,6 +1832,7 @@ func autoConvert_v1beta1_AWSCloud_To_garden_AWSCloud(in *AWSCloud, out *garden.A
 		out.Workers = nil
 	}
 	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.ServiceLoadBalancer = (*garden.AWSServiceLoadBalancer)(unsafe.Pointer(in.ServiceLoadBalancer))
 	return nil
 }
 
@@ -1280,6 +1858,7 @@ func autoConvert_garden_AWSCloud_To_v1beta1_AWSCloud(in *garden.AWSCloud, out *A
 		out.Workers = nil
 	}
 	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.ServiceLoadBalancer = (*AWSServiceLoadBalancer)(unsafe.Pointer(in.ServiceLoadBalancer))
 	return nil
 }
 
@@ -1377,6 +1956,8 @@ func autoConvert_v1beta1_AWSNetworks_To_garden_AWSNetworks(in *AWSNetworks, out
 	out.Internal = *(*[]core.CIDR)(unsafe.Pointer(&in.Internal))
 	out.Public = *(*[]core.CIDR)(unsafe.Pointer(&in.Public))
 	out.Workers = *(*[]core.CIDR)(unsafe.Pointer(&in.Workers))
+	out.ExistingSubnets = *(*[]garden.AWSZoneSubnets)(unsafe.Pointer(&in.ExistingSubnets))
+	out.ExistingNATGateways = *(*[]garden.AWSZoneNATGateway)(unsafe.Pointer(&in.ExistingNATGateways))
 	return nil
 }
 
@@ -1396,6 +1977,8 @@ func autoConvert_garden_AWSNetworks_To_v1beta1_AWSNetworks(in *garden.AWSNetwork
 	out.Internal = *(*[]v1alpha1.CIDR)(unsafe.Pointer(&in.Internal))
 	out.Public = *(*[]v1alpha1.CIDR)(unsafe.Pointer(&in.Public))
 	out.Workers = *(*[]v1alpha1.CIDR)(unsafe.Pointer(&in.Workers))
+	out.ExistingSubnets = *(*[]AWSZoneSubnets)(unsafe.Pointer(&in.ExistingSubnets))
+	out.ExistingNATGateways = *(*[]AWSZoneNATGateway)(unsafe.Pointer(&in.ExistingNATGateways))
 	return nil
 }
 
@@ -1431,6 +2014,7 @@ func Convert_garden_AWSProfile_To_v1beta1_AWSProfile(in *garden.AWSProfile, out
 func autoConvert_v1beta1_AWSRegionalMachineImage_To_garden_AWSRegionalMachineImage(in *AWSRegionalMachineImage, out *garden.AWSRegionalMachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.AMI = in.AMI
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
 	return nil
 }
 
@@ -1442,6 +2026,7 @@ func Convert_v1beta1_AWSRegionalMachineImage_To_garden_AWSRegionalMachineImage(i
 func autoConvert_garden_AWSRegionalMachineImage_To_v1beta1_AWSRegionalMachineImage(in *garden.AWSRegionalMachineImage, out *AWSRegionalMachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.AMI = in.AMI
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
 	return nil
 }
 
@@ -1450,9 +2035,30 @@ func Convert_garden_AWSRegionalMachineImage_To_v1beta1_AWSRegionalMachineImage(i
 	return autoConvert_garden_AWSRegionalMachineImage_To_v1beta1_AWSRegionalMachineImage(in, out, s)
 }
 
+func autoConvert_v1beta1_AWSServiceLoadBalancer_To_garden_AWSServiceLoadBalancer(in *AWSServiceLoadBalancer, out *garden.AWSServiceLoadBalancer, s conversion.Scope) error {
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	return nil
+}
+
+// Convert_v1beta1_AWSServiceLoadBalancer_To_garden_AWSServiceLoadBalancer is an autogenerated conversion function.
+func Convert_v1beta1_AWSServiceLoadBalancer_To_garden_AWSServiceLoadBalancer(in *AWSServiceLoadBalancer, out *garden.AWSServiceLoadBalancer, s conversion.Scope) error {
+	return autoConvert_v1beta1_AWSServiceLoadBalancer_To_garden_AWSServiceLoadBalancer(in, out, s)
+}
+
+func autoConvert_garden_AWSServiceLoadBalancer_To_v1beta1_AWSServiceLoadBalancer(in *garden.AWSServiceLoadBalancer, out *AWSServiceLoadBalancer, s conversion.Scope) error {
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	return nil
+}
+
+// Convert_garden_AWSServiceLoadBalancer_To_v1beta1_AWSServiceLoadBalancer is an autogenerated conversion function.
+func Convert_garden_AWSServiceLoadBalancer_To_v1beta1_AWSServiceLoadBalancer(in *garden.AWSServiceLoadBalancer, out *AWSServiceLoadBalancer, s conversion.Scope) error {
+	return autoConvert_garden_AWSServiceLoadBalancer_To_v1beta1_AWSServiceLoadBalancer(in, out, s)
+}
+
 func autoConvert_v1beta1_AWSVPC_To_garden_AWSVPC(in *AWSVPC, out *garden.AWSVPC, s conversion.Scope) error {
 	out.ID = (*string)(unsafe.Pointer(in.ID))
 	out.CIDR = (*core.CIDR)(unsafe.Pointer(in.CIDR))
+	out.InternetGatewayID = (*string)(unsafe.Pointer(in.InternetGatewayID))
 	return nil
 }
 
@@ -1464,6 +2070,7 @@ func Convert_v1beta1_AWSVPC_To_garden_AWSVPC(in *AWSVPC, out *garden.AWSVPC, s c
 func autoConvert_garden_AWSVPC_To_v1beta1_AWSVPC(in *garden.AWSVPC, out *AWSVPC, s conversion.Scope) error {
 	out.ID = (*string)(unsafe.Pointer(in.ID))
 	out.CIDR = (*v1alpha1.CIDR)(unsafe.Pointer(in.CIDR))
+	out.InternetGatewayID = (*string)(unsafe.Pointer(in.InternetGatewayID))
 	return nil
 }
 
@@ -1478,6 +2085,8 @@ func autoConvert_v1beta1_AWSWorker_To_garden_AWSWorker(in *AWSWorker, out *garde
 	}
 	out.VolumeType = in.VolumeType
 	out.VolumeSize = in.VolumeSize
+	out.VolumeIOPS = (*int64)(unsafe.Pointer(in.VolumeIOPS))
+	out.VolumeThroughput = (*int64)(unsafe.Pointer(in.VolumeThroughput))
 	return nil
 }
 
@@ -1492,6 +2101,8 @@ func autoConvert_garden_AWSWorker_To_v1beta1_AWSWorker(in *garden.AWSWorker, out
 	}
 	out.VolumeType = in.VolumeType
 	out.VolumeSize = in.VolumeSize
+	out.VolumeIOPS = (*int64)(unsafe.Pointer(in.VolumeIOPS))
+	out.VolumeThroughput = (*int64)(unsafe.Pointer(in.VolumeThroughput))
 	return nil
 }
 
@@ -1500,6 +2111,54 @@ func Convert_garden_AWSWorker_To_v1beta1_AWSWorker(in *garden.AWSWorker, out *AW
 	return autoConvert_garden_AWSWorker_To_v1beta1_AWSWorker(in, out, s)
 }
 
+func autoConvert_v1beta1_AWSZoneNATGateway_To_garden_AWSZoneNATGateway(in *AWSZoneNATGateway, out *garden.AWSZoneNATGateway, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	return nil
+}
+
+// Convert_v1beta1_AWSZoneNATGateway_To_garden_AWSZoneNATGateway is an autogenerated conversion function.
+func Convert_v1beta1_AWSZoneNATGateway_To_garden_AWSZoneNATGateway(in *AWSZoneNATGateway, out *garden.AWSZoneNATGateway, s conversion.Scope) error {
+	return autoConvert_v1beta1_AWSZoneNATGateway_To_garden_AWSZoneNATGateway(in, out, s)
+}
+
+func autoConvert_garden_AWSZoneNATGateway_To_v1beta1_AWSZoneNATGateway(in *garden.AWSZoneNATGateway, out *AWSZoneNATGateway, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	return nil
+}
+
+// Convert_garden_AWSZoneNATGateway_To_v1beta1_AWSZoneNATGateway is an autogenerated conversion function.
+func Convert_garden_AWSZoneNATGateway_To_v1beta1_AWSZoneNATGateway(in *garden.AWSZoneNATGateway, out *AWSZoneNATGateway, s conversion.Scope) error {
+	return autoConvert_garden_AWSZoneNATGateway_To_v1beta1_AWSZoneNATGateway(in, out, s)
+}
+
+func autoConvert_v1beta1_AWSZoneSubnets_To_garden_AWSZoneSubnets(in *AWSZoneSubnets, out *garden.AWSZoneSubnets, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.Internal = in.Internal
+	out.Public = in.Public
+	out.Workers = in.Workers
+	return nil
+}
+
+// Convert_v1beta1_AWSZoneSubnets_To_garden_AWSZoneSubnets is an autogenerated conversion function.
+func Convert_v1beta1_AWSZoneSubnets_To_garden_AWSZoneSubnets(in *AWSZoneSubnets, out *garden.AWSZoneSubnets, s conversion.Scope) error {
+	return autoConvert_v1beta1_AWSZoneSubnets_To_garden_AWSZoneSubnets(in, out, s)
+}
+
+func autoConvert_garden_AWSZoneSubnets_To_v1beta1_AWSZoneSubnets(in *garden.AWSZoneSubnets, out *AWSZoneSubnets, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.Internal = in.Internal
+	out.Public = in.Public
+	out.Workers = in.Workers
+	return nil
+}
+
+// Convert_garden_AWSZoneSubnets_To_v1beta1_AWSZoneSubnets is an autogenerated conversion function.
+func Convert_garden_AWSZoneSubnets_To_v1beta1_AWSZoneSubnets(in *garden.AWSZoneSubnets, out *AWSZoneSubnets, s conversion.Scope) error {
+	return autoConvert_garden_AWSZoneSubnets_To_v1beta1_AWSZoneSubnets(in, out, s)
+}
+
 func autoConvert_v1beta1_Addon_To_garden_Addon(in *Addon, out *garden.Addon, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	return nil
@@ -1575,6 +2234,7 @@ func Convert_garden_AdmissionPlugin_To_v1beta1_AdmissionPlugin(in *garden.Admiss
 }
 
 func autoConvert_v1beta1_Alicloud_To_garden_Alicloud(in *Alicloud, out *garden.Alicloud, s conversion.Scope) error {
+	out.APIServerLoadBalancer = (*garden.AlicloudLoadBalancer)(unsafe.Pointer(in.APIServerLoadBalancer))
 	out.MachineImage = (*garden.AlicloudMachineImage)(unsafe.Pointer(in.MachineImage))
 	if err := Convert_v1beta1_AlicloudNetworks_To_garden_AlicloudNetworks(&in.Networks, &out.Networks, s); err != nil {
 		return err
@@ -1591,6 +2251,7 @@ func autoConvert_v1beta1_Alicloud_To_garden_Alicloud(in *Alicloud, out *garden.A
 		out.Workers = nil
 	}
 	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.ServiceLoadBalancer = (*garden.AlicloudLoadBalancer)(unsafe.Pointer(in.ServiceLoadBalancer))
 	return nil
 }
 
@@ -1600,6 +2261,7 @@ func Convert_v1beta1_Alicloud_To_garden_Alicloud(in *Alicloud, out *garden.Alicl
 }
 
 func autoConvert_garden_Alicloud_To_v1beta1_Alicloud(in *garden.Alicloud, out *Alicloud, s conversion.Scope) error {
+	out.APIServerLoadBalancer = (*AlicloudLoadBalancer)(unsafe.Pointer(in.APIServerLoadBalancer))
 	out.MachineImage = (*AlicloudMachineImage)(unsafe.Pointer(in.MachineImage))
 	if err := Convert_garden_AlicloudNetworks_To_v1beta1_AlicloudNetworks(&in.Networks, &out.Networks, s); err != nil {
 		return err
@@ -1616,6 +2278,7 @@ func autoConvert_garden_Alicloud_To_v1beta1_Alicloud(in *garden.Alicloud, out *A
 		out.Workers = nil
 	}
 	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.ServiceLoadBalancer = (*AlicloudLoadBalancer)(unsafe.Pointer(in.ServiceLoadBalancer))
 	return nil
 }
 
@@ -1658,6 +2321,30 @@ func Convert_garden_AlicloudConstraints_To_v1beta1_AlicloudConstraints(in *garde
 	return autoConvert_garden_AlicloudConstraints_To_v1beta1_AlicloudConstraints(in, out, s)
 }
 
+func autoConvert_v1beta1_AlicloudLoadBalancer_To_garden_AlicloudLoadBalancer(in *AlicloudLoadBalancer, out *garden.AlicloudLoadBalancer, s conversion.Scope) error {
+	out.Spec = (*string)(unsafe.Pointer(in.Spec))
+	out.AddressType = (*string)(unsafe.Pointer(in.AddressType))
+	out.Bandwidth = (*int32)(unsafe.Pointer(in.Bandwidth))
+	return nil
+}
+
+// Convert_v1beta1_AlicloudLoadBalancer_To_garden_AlicloudLoadBalancer is an autogenerated conversion function.
+func Convert_v1beta1_AlicloudLoadBalancer_To_garden_AlicloudLoadBalancer(in *AlicloudLoadBalancer, out *garden.AlicloudLoadBalancer, s conversion.Scope) error {
+	return autoConvert_v1beta1_AlicloudLoadBalancer_To_garden_AlicloudLoadBalancer(in, out, s)
+}
+
+func autoConvert_garden_AlicloudLoadBalancer_To_v1beta1_AlicloudLoadBalancer(in *garden.AlicloudLoadBalancer, out *AlicloudLoadBalancer, s conversion.Scope) error {
+	out.Spec = (*string)(unsafe.Pointer(in.Spec))
+	out.AddressType = (*string)(unsafe.Pointer(in.AddressType))
+	out.Bandwidth = (*int32)(unsafe.Pointer(in.Bandwidth))
+	return nil
+}
+
+// Convert_garden_AlicloudLoadBalancer_To_v1beta1_AlicloudLoadBalancer is an autogenerated conversion function.
+func Convert_garden_AlicloudLoadBalancer_To_v1beta1_AlicloudLoadBalancer(in *garden.AlicloudLoadBalancer, out *AlicloudLoadBalancer, s conversion.Scope) error {
+	return autoConvert_garden_AlicloudLoadBalancer_To_v1beta1_AlicloudLoadBalancer(in, out, s)
+}
+
 func autoConvert_v1beta1_AlicloudMachineImage_To_garden_AlicloudMachineImage(in *AlicloudMachineImage, out *garden.AlicloudMachineImage, s conversion.Scope) error {
 	out.Name = garden.MachineImageName(in.Name)
 	out.ID = in.ID
@@ -1706,6 +2393,56 @@ func Convert_garden_AlicloudMachineType_To_v1beta1_AlicloudMachineType(in *garde
 	return autoConvert_garden_AlicloudMachineType_To_v1beta1_AlicloudMachineType(in, out, s)
 }
 
+func autoConvert_v1beta1_AlicloudNatGateway_To_garden_AlicloudNatGateway(in *AlicloudNatGateway, out *garden.AlicloudNatGateway, s conversion.Scope) error {
+	out.PerZone = in.PerZone
+	out.EIPBandwidth = (*int32)(unsafe.Pointer(in.EIPBandwidth))
+	out.EIPInternetChargeType = (*string)(unsafe.Pointer(in.EIPInternetChargeType))
+	out.Zones = *(*[]garden.AlicloudNatGatewayZone)(unsafe.Pointer(&in.Zones))
+	return nil
+}
+
+// Convert_v1beta1_AlicloudNatGateway_To_garden_AlicloudNatGateway is an autogenerated conversion function.
+func Convert_v1beta1_AlicloudNatGateway_To_garden_AlicloudNatGateway(in *AlicloudNatGateway, out *garden.AlicloudNatGateway, s conversion.Scope) error {
+	return autoConvert_v1beta1_AlicloudNatGateway_To_garden_AlicloudNatGateway(in, out, s)
+}
+
+func autoConvert_garden_AlicloudNatGateway_To_v1beta1_AlicloudNatGateway(in *garden.AlicloudNatGateway, out *AlicloudNatGateway, s conversion.Scope) error {
+	out.PerZone = in.PerZone
+	out.EIPBandwidth = (*int32)(unsafe.Pointer(in.EIPBandwidth))
+	out.EIPInternetChargeType = (*string)(unsafe.Pointer(in.EIPInternetChargeType))
+	out.Zones = *(*[]AlicloudNatGatewayZone)(unsafe.Pointer(&in.Zones))
+	return nil
+}
+
+// Convert_garden_AlicloudNatGateway_To_v1beta1_AlicloudNatGateway is an autogenerated conversion function.
+func Convert_garden_AlicloudNatGateway_To_v1beta1_AlicloudNatGateway(in *garden.AlicloudNatGateway, out *AlicloudNatGateway, s conversion.Scope) error {
+	return autoConvert_garden_AlicloudNatGateway_To_v1beta1_AlicloudNatGateway(in, out, s)
+}
+
+func autoConvert_v1beta1_AlicloudNatGatewayZone_To_garden_AlicloudNatGatewayZone(in *AlicloudNatGatewayZone, out *garden.AlicloudNatGatewayZone, s conversion.Scope) error {
+	out.Name = in.Name
+	out.EIPBandwidth = (*int32)(unsafe.Pointer(in.EIPBandwidth))
+	out.EIPInternetChargeType = (*string)(unsafe.Pointer(in.EIPInternetChargeType))
+	return nil
+}
+
+// Convert_v1beta1_AlicloudNatGatewayZone_To_garden_AlicloudNatGatewayZone is an autogenerated conversion function.
+func Convert_v1beta1_AlicloudNatGatewayZone_To_garden_AlicloudNatGatewayZone(in *AlicloudNatGatewayZone, out *garden.AlicloudNatGatewayZone, s conversion.Scope) error {
+	return autoConvert_v1beta1_AlicloudNatGatewayZone_To_garden_AlicloudNatGatewayZone(in, out, s)
+}
+
+func autoConvert_garden_AlicloudNatGatewayZone_To_v1beta1_AlicloudNatGatewayZone(in *garden.AlicloudNatGatewayZone, out *AlicloudNatGatewayZone, s conversion.Scope) error {
+	out.Name = in.Name
+	out.EIPBandwidth = (*int32)(unsafe.Pointer(in.EIPBandwidth))
+	out.EIPInternetChargeType = (*string)(unsafe.Pointer(in.EIPInternetChargeType))
+	return nil
+}
+
+// Convert_garden_AlicloudNatGatewayZone_To_v1beta1_AlicloudNatGatewayZone is an autogenerated conversion function.
+func Convert_garden_AlicloudNatGatewayZone_To_v1beta1_AlicloudNatGatewayZone(in *garden.AlicloudNatGatewayZone, out *AlicloudNatGatewayZone, s conversion.Scope) error {
+	return autoConvert_garden_AlicloudNatGatewayZone_To_v1beta1_AlicloudNatGatewayZone(in, out, s)
+}
+
 func autoConvert_v1beta1_AlicloudNetworks_To_garden_AlicloudNetworks(in *AlicloudNetworks, out *garden.AlicloudNetworks, s conversion.Scope) error {
 	// TODO: Inefficient conversion - can we improve it?
 	if err := s.Convert(&in.K8SNetworks, &out.K8SNetworks, 0); err != nil {
@@ -1715,6 +2452,7 @@ func autoConvert_v1beta1_AlicloudNetworks_To_garden_AlicloudNetworks(in *Aliclou
 		return err
 	}
 	out.Workers = *(*[]core.CIDR)(unsafe.Pointer(&in.Workers))
+	out.NatGateway = (*garden.AlicloudNatGateway)(unsafe.Pointer(in.NatGateway))
 	return nil
 }
 
@@ -1732,6 +2470,7 @@ func autoConvert_garden_AlicloudNetworks_To_v1beta1_AlicloudNetworks(in *garden.
 		return err
 	}
 	out.Workers = *(*[]v1alpha1.CIDR)(unsafe.Pointer(&in.Workers))
+	out.NatGateway = (*AlicloudNatGateway)(unsafe.Pointer(in.NatGateway))
 	return nil
 }
 
@@ -1880,6 +2619,30 @@ func Convert_garden_AuditPolicy_To_v1beta1_AuditPolicy(in *garden.AuditPolicy, o
 	return autoConvert_garden_AuditPolicy_To_v1beta1_AuditPolicy(in, out, s)
 }
 
+func autoConvert_v1beta1_AuthenticationWebhook_To_garden_AuthenticationWebhook(in *AuthenticationWebhook, out *garden.AuthenticationWebhook, s conversion.Scope) error {
+	out.URL = in.URL
+	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
+	out.CacheTTL = (*metav1.Duration)(unsafe.Pointer(in.CacheTTL))
+	return nil
+}
+
+// Convert_v1beta1_AuthenticationWebhook_To_garden_AuthenticationWebhook is an autogenerated conversion function.
+func Convert_v1beta1_AuthenticationWebhook_To_garden_AuthenticationWebhook(in *AuthenticationWebhook, out *garden.AuthenticationWebhook, s conversion.Scope) error {
+	return autoConvert_v1beta1_AuthenticationWebhook_To_garden_AuthenticationWebhook(in, out, s)
+}
+
+func autoConvert_garden_AuthenticationWebhook_To_v1beta1_AuthenticationWebhook(in *garden.AuthenticationWebhook, out *AuthenticationWebhook, s conversion.Scope) error {
+	out.URL = in.URL
+	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
+	out.CacheTTL = (*metav1.Duration)(unsafe.Pointer(in.CacheTTL))
+	return nil
+}
+
+// Convert_garden_AuthenticationWebhook_To_v1beta1_AuthenticationWebhook is an autogenerated conversion function.
+func Convert_garden_AuthenticationWebhook_To_v1beta1_AuthenticationWebhook(in *garden.AuthenticationWebhook, out *AuthenticationWebhook, s conversion.Scope) error {
+	return autoConvert_garden_AuthenticationWebhook_To_v1beta1_AuthenticationWebhook(in, out, s)
+}
+
 func autoConvert_v1beta1_AzureCloud_To_garden_AzureCloud(in *AzureCloud, out *garden.AzureCloud, s conversion.Scope) error {
 	out.MachineImage = (*garden.AzureMachineImage)(unsafe.Pointer(in.MachineImage))
 	if err := Convert_v1beta1_AzureNetworks_To_garden_AzureNetworks(&in.Networks, &out.Networks, s); err != nil {
@@ -2097,6 +2860,7 @@ func Convert_garden_AzureResourceGroup_To_v1beta1_AzureResourceGroup(in *garden.
 func autoConvert_v1beta1_AzureVNet_To_garden_AzureVNet(in *AzureVNet, out *garden.AzureVNet, s conversion.Scope) error {
 	out.Name = (*string)(unsafe.Pointer(in.Name))
 	out.CIDR = (*core.CIDR)(unsafe.Pointer(in.CIDR))
+	out.Peering = (*garden.AzureVNetPeering)(unsafe.Pointer(in.Peering))
 	return nil
 }
 
@@ -2108,6 +2872,7 @@ func Convert_v1beta1_AzureVNet_To_garden_AzureVNet(in *AzureVNet, out *garden.Az
 func autoConvert_garden_AzureVNet_To_v1beta1_AzureVNet(in *garden.AzureVNet, out *AzureVNet, s conversion.Scope) error {
 	out.Name = (*string)(unsafe.Pointer(in.Name))
 	out.CIDR = (*v1alpha1.CIDR)(unsafe.Pointer(in.CIDR))
+	out.Peering = (*AzureVNetPeering)(unsafe.Pointer(in.Peering))
 	return nil
 }
 
@@ -2116,6 +2881,32 @@ func Convert_garden_AzureVNet_To_v1beta1_AzureVNet(in *garden.AzureVNet, out *Az
 	return autoConvert_garden_AzureVNet_To_v1beta1_AzureVNet(in, out, s)
 }
 
+func autoConvert_v1beta1_AzureVNetPeering_To_garden_AzureVNetPeering(in *AzureVNetPeering, out *garden.AzureVNetPeering, s conversion.Scope) error {
+	out.RemoteVNetID = in.RemoteVNetID
+	out.RemoteCIDRs = *(*[]core.CIDR)(unsafe.Pointer(&in.RemoteCIDRs))
+	out.AllowForwardedTraffic = in.AllowForwardedTraffic
+	out.UseRemoteGateways = in.UseRemoteGateways
+	return nil
+}
+
+// Convert_v1beta1_AzureVNetPeering_To_garden_AzureVNetPeering is an autogenerated conversion function.
+func Convert_v1beta1_AzureVNetPeering_To_garden_AzureVNetPeering(in *AzureVNetPeering, out *garden.AzureVNetPeering, s conversion.Scope) error {
+	return autoConvert_v1beta1_AzureVNetPeering_To_garden_AzureVNetPeering(in, out, s)
+}
+
+func autoConvert_garden_AzureVNetPeering_To_v1beta1_AzureVNetPeering(in *garden.AzureVNetPeering, out *AzureVNetPeering, s conversion.Scope) error {
+	out.RemoteVNetID = in.RemoteVNetID
+	out.RemoteCIDRs = *(*[]v1alpha1.CIDR)(unsafe.Pointer(&in.RemoteCIDRs))
+	out.AllowForwardedTraffic = in.AllowForwardedTraffic
+	out.UseRemoteGateways = in.UseRemoteGateways
+	return nil
+}
+
+// Convert_garden_AzureVNetPeering_To_v1beta1_AzureVNetPeering is an autogenerated conversion function.
+func Convert_garden_AzureVNetPeering_To_v1beta1_AzureVNetPeering(in *garden.AzureVNetPeering, out *AzureVNetPeering, s conversion.Scope) error {
+	return autoConvert_garden_AzureVNetPeering_To_v1beta1_AzureVNetPeering(in, out, s)
+}
+
 func autoConvert_v1beta1_AzureWorker_To_garden_AzureWorker(in *AzureWorker, out *garden.AzureWorker, s conversion.Scope) error {
 	if err := Convert_v1beta1_Worker_To_garden_Worker(&in.Worker, &out.Worker, s); err != nil {
 		return err
@@ -2243,6 +3034,7 @@ func Convert_garden_BackupInfrastructureList_To_v1beta1_BackupInfrastructureList
 func autoConvert_v1beta1_BackupInfrastructureSpec_To_garden_BackupInfrastructureSpec(in *BackupInfrastructureSpec, out *garden.BackupInfrastructureSpec, s conversion.Scope) error {
 	out.Seed = in.Seed
 	out.ShootUID = types.UID(in.ShootUID)
+	out.Region = (*string)(unsafe.Pointer(in.Region))
 	return nil
 }
 
@@ -2254,6 +3046,7 @@ func Convert_v1beta1_BackupInfrastructureSpec_To_garden_BackupInfrastructureSpec
 func autoConvert_garden_BackupInfrastructureSpec_To_v1beta1_BackupInfrastructureSpec(in *garden.BackupInfrastructureSpec, out *BackupInfrastructureSpec, s conversion.Scope) error {
 	out.Seed = in.Seed
 	out.ShootUID = types.UID(in.ShootUID)
+	out.Region = (*string)(unsafe.Pointer(in.Region))
 	return nil
 }
 
@@ -2268,6 +3061,7 @@ func autoConvert_v1beta1_BackupInfrastructureStatus_To_garden_BackupInfrastructu
 	if err := metav1.Convert_int64_To_Pointer_int64(&in.ObservedGeneration, &out.ObservedGeneration, s); err != nil {
 		return err
 	}
+	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -2282,6 +3076,7 @@ func autoConvert_garden_BackupInfrastructureStatus_To_v1beta1_BackupInfrastructu
 	if err := metav1.Convert_Pointer_int64_To_int64(&in.ObservedGeneration, &out.ObservedGeneration, s); err != nil {
 		return err
 	}
+	out.Conditions = *(*[]v1alpha1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -2295,6 +3090,8 @@ func autoConvert_v1beta1_Cloud_To_garden_Cloud(in *Cloud, out *garden.Cloud, s c
 	out.Region = in.Region
 	out.SecretBindingRef = in.SecretBindingRef
 	out.Seed = (*string)(unsafe.Pointer(in.Seed))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.ServiceLoadBalancer = (*garden.ServiceLoadBalancer)(unsafe.Pointer(in.ServiceLoadBalancer))
 	if in.AWS != nil {
 		in, out := &in.AWS, &out.AWS
 		*out = new(garden.AWSCloud)
@@ -2371,6 +3168,8 @@ func autoConvert_garden_Cloud_To_v1beta1_Cloud(in *garden.Cloud, out *Cloud, s c
 	out.Region = in.Region
 	out.SecretBindingRef = in.SecretBindingRef
 	out.Seed = (*string)(unsafe.Pointer(in.Seed))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.ServiceLoadBalancer = (*ServiceLoadBalancer)(unsafe.Pointer(in.ServiceLoadBalancer))
 	if in.AWS != nil {
 		in, out := &in.AWS, &out.AWS
 		*out = new(AWSCloud)
@@ -2523,6 +3322,8 @@ func autoConvert_v1beta1_CloudProfileSpec_To_garden_CloudProfileSpec(in *CloudPr
 	out.Packet = (*garden.PacketProfile)(unsafe.Pointer(in.Packet))
 	out.Local = (*garden.LocalProfile)(unsafe.Pointer(in.Local))
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
+	out.Terraformer = (*garden.TerraformerSettings)(unsafe.Pointer(in.Terraformer))
+	out.CustomMachineImages = (*garden.CustomMachineImages)(unsafe.Pointer(in.CustomMachineImages))
 	return nil
 }
 
@@ -2540,6 +3341,8 @@ func autoConvert_garden_CloudProfileSpec_To_v1beta1_CloudProfileSpec(in *garden.
 	out.Packet = (*PacketProfile)(unsafe.Pointer(in.Packet))
 	out.Local = (*LocalProfile)(unsafe.Pointer(in.Local))
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
+	out.Terraformer = (*TerraformerSettings)(unsafe.Pointer(in.Terraformer))
+	out.CustomMachineImages = (*CustomMachineImages)(unsafe.Pointer(in.CustomMachineImages))
 	return nil
 }
 
@@ -2572,11 +3375,32 @@ func Convert_garden_ClusterAutoscaler_To_v1beta1_ClusterAutoscaler(in *garden.Cl
 	return autoConvert_garden_ClusterAutoscaler_To_v1beta1_ClusterAutoscaler(in, out, s)
 }
 
+func autoConvert_v1beta1_CustomMachineImages_To_garden_CustomMachineImages(in *CustomMachineImages, out *garden.CustomMachineImages, s conversion.Scope) error {
+	out.Projects = *(*[]string)(unsafe.Pointer(&in.Projects))
+	return nil
+}
+
+// Convert_v1beta1_CustomMachineImages_To_garden_CustomMachineImages is an autogenerated conversion function.
+func Convert_v1beta1_CustomMachineImages_To_garden_CustomMachineImages(in *CustomMachineImages, out *garden.CustomMachineImages, s conversion.Scope) error {
+	return autoConvert_v1beta1_CustomMachineImages_To_garden_CustomMachineImages(in, out, s)
+}
+
+func autoConvert_garden_CustomMachineImages_To_v1beta1_CustomMachineImages(in *garden.CustomMachineImages, out *CustomMachineImages, s conversion.Scope) error {
+	out.Projects = *(*[]string)(unsafe.Pointer(&in.Projects))
+	return nil
+}
+
+// Convert_garden_CustomMachineImages_To_v1beta1_CustomMachineImages is an autogenerated conversion function.
+func Convert_garden_CustomMachineImages_To_v1beta1_CustomMachineImages(in *garden.CustomMachineImages, out *CustomMachineImages, s conversion.Scope) error {
+	return autoConvert_garden_CustomMachineImages_To_v1beta1_CustomMachineImages(in, out, s)
+}
+
 func autoConvert_v1beta1_DNS_To_garden_DNS(in *DNS, out *garden.DNS, s conversion.Scope) error {
 	out.Provider = (*string)(unsafe.Pointer(in.Provider))
 	out.HostedZoneID = (*string)(unsafe.Pointer(in.HostedZoneID))
 	out.Domain = (*string)(unsafe.Pointer(in.Domain))
 	out.SecretName = (*string)(unsafe.Pointer(in.SecretName))
+	out.TTL = (*int64)(unsafe.Pointer(in.TTL))
 	return nil
 }
 
@@ -2590,6 +3414,7 @@ func autoConvert_garden_DNS_To_v1beta1_DNS(in *garden.DNS, out *DNS, s conversio
 	out.HostedZoneID = (*string)(unsafe.Pointer(in.HostedZoneID))
 	out.Domain = (*string)(unsafe.Pointer(in.Domain))
 	out.SecretName = (*string)(unsafe.Pointer(in.SecretName))
+	out.TTL = (*int64)(unsafe.Pointer(in.TTL))
 	return nil
 }
 
@@ -2618,6 +3443,104 @@ func Convert_garden_DNSProviderConstraint_To_v1beta1_DNSProviderConstraint(in *g
 	return autoConvert_garden_DNSProviderConstraint_To_v1beta1_DNSProviderConstraint(in, out, s)
 }
 
+func autoConvert_v1beta1_DataVolume_To_garden_DataVolume(in *DataVolume, out *garden.DataVolume, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Size = in.Size
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	return nil
+}
+
+// Convert_v1beta1_DataVolume_To_garden_DataVolume is an autogenerated conversion function.
+func Convert_v1beta1_DataVolume_To_garden_DataVolume(in *DataVolume, out *garden.DataVolume, s conversion.Scope) error {
+	return autoConvert_v1beta1_DataVolume_To_garden_DataVolume(in, out, s)
+}
+
+func autoConvert_garden_DataVolume_To_v1beta1_DataVolume(in *garden.DataVolume, out *DataVolume, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Size = in.Size
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	return nil
+}
+
+// Convert_garden_DataVolume_To_v1beta1_DataVolume is an autogenerated conversion function.
+func Convert_garden_DataVolume_To_v1beta1_DataVolume(in *garden.DataVolume, out *DataVolume, s conversion.Scope) error {
+	return autoConvert_garden_DataVolume_To_v1beta1_DataVolume(in, out, s)
+}
+
+func autoConvert_v1beta1_DeletionProtectionPolicy_To_garden_DeletionProtectionPolicy(in *DeletionProtectionPolicy, out *garden.DeletionProtectionPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_DeletionProtectionPolicySpec_To_garden_DeletionProtectionPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_DeletionProtectionPolicy_To_garden_DeletionProtectionPolicy is an autogenerated conversion function.
+func Convert_v1beta1_DeletionProtectionPolicy_To_garden_DeletionProtectionPolicy(in *DeletionProtectionPolicy, out *garden.DeletionProtectionPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_DeletionProtectionPolicy_To_garden_DeletionProtectionPolicy(in, out, s)
+}
+
+func autoConvert_garden_DeletionProtectionPolicy_To_v1beta1_DeletionProtectionPolicy(in *garden.DeletionProtectionPolicy, out *DeletionProtectionPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_garden_DeletionProtectionPolicySpec_To_v1beta1_DeletionProtectionPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_garden_DeletionProtectionPolicy_To_v1beta1_DeletionProtectionPolicy is an autogenerated conversion function.
+func Convert_garden_DeletionProtectionPolicy_To_v1beta1_DeletionProtectionPolicy(in *garden.DeletionProtectionPolicy, out *DeletionProtectionPolicy, s conversion.Scope) error {
+	return autoConvert_garden_DeletionProtectionPolicy_To_v1beta1_DeletionProtectionPolicy(in, out, s)
+}
+
+func autoConvert_v1beta1_DeletionProtectionPolicyList_To_garden_DeletionProtectionPolicyList(in *DeletionProtectionPolicyList, out *garden.DeletionProtectionPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]garden.DeletionProtectionPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_DeletionProtectionPolicyList_To_garden_DeletionProtectionPolicyList is an autogenerated conversion function.
+func Convert_v1beta1_DeletionProtectionPolicyList_To_garden_DeletionProtectionPolicyList(in *DeletionProtectionPolicyList, out *garden.DeletionProtectionPolicyList, s conversion.Scope) error {
+	return autoConvert_v1beta1_DeletionProtectionPolicyList_To_garden_DeletionProtectionPolicyList(in, out, s)
+}
+
+func autoConvert_garden_DeletionProtectionPolicyList_To_v1beta1_DeletionProtectionPolicyList(in *garden.DeletionProtectionPolicyList, out *DeletionProtectionPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]DeletionProtectionPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_garden_DeletionProtectionPolicyList_To_v1beta1_DeletionProtectionPolicyList is an autogenerated conversion function.
+func Convert_garden_DeletionProtectionPolicyList_To_v1beta1_DeletionProtectionPolicyList(in *garden.DeletionProtectionPolicyList, out *DeletionProtectionPolicyList, s conversion.Scope) error {
+	return autoConvert_garden_DeletionProtectionPolicyList_To_v1beta1_DeletionProtectionPolicyList(in, out, s)
+}
+
+func autoConvert_v1beta1_DeletionProtectionPolicySpec_To_garden_DeletionProtectionPolicySpec(in *DeletionProtectionPolicySpec, out *garden.DeletionProtectionPolicySpec, s conversion.Scope) error {
+	out.ShootSelector = in.ShootSelector
+	out.RequiredApprovals = in.RequiredApprovals
+	out.BackupRetention = (*metav1.Duration)(unsafe.Pointer(in.BackupRetention))
+	return nil
+}
+
+// Convert_v1beta1_DeletionProtectionPolicySpec_To_garden_DeletionProtectionPolicySpec is an autogenerated conversion function.
+func Convert_v1beta1_DeletionProtectionPolicySpec_To_garden_DeletionProtectionPolicySpec(in *DeletionProtectionPolicySpec, out *garden.DeletionProtectionPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_DeletionProtectionPolicySpec_To_garden_DeletionProtectionPolicySpec(in, out, s)
+}
+
+func autoConvert_garden_DeletionProtectionPolicySpec_To_v1beta1_DeletionProtectionPolicySpec(in *garden.DeletionProtectionPolicySpec, out *DeletionProtectionPolicySpec, s conversion.Scope) error {
+	out.ShootSelector = in.ShootSelector
+	out.RequiredApprovals = in.RequiredApprovals
+	out.BackupRetention = (*metav1.Duration)(unsafe.Pointer(in.BackupRetention))
+	return nil
+}
+
+// Convert_garden_DeletionProtectionPolicySpec_To_v1beta1_DeletionProtectionPolicySpec is an autogenerated conversion function.
+func Convert_garden_DeletionProtectionPolicySpec_To_v1beta1_DeletionProtectionPolicySpec(in *garden.DeletionProtectionPolicySpec, out *DeletionProtectionPolicySpec, s conversion.Scope) error {
+	return autoConvert_garden_DeletionProtectionPolicySpec_To_v1beta1_DeletionProtectionPolicySpec(in, out, s)
+}
+
 func autoConvert_v1beta1_GCPCloud_To_garden_GCPCloud(in *GCPCloud, out *garden.GCPCloud, s conversion.Scope) error {
 	out.MachineImage = (*garden.GCPMachineImage)(unsafe.Pointer(in.MachineImage))
 	if err := Convert_v1beta1_GCPNetworks_To_garden_GCPNetworks(&in.Networks, &out.Networks, s); err != nil {
@@ -2668,19 +3591,63 @@ func Convert_garden_GCPCloud_To_v1beta1_GCPCloud(in *garden.GCPCloud, out *GCPCl
 	return autoConvert_garden_GCPCloud_To_v1beta1_GCPCloud(in, out, s)
 }
 
-func autoConvert_v1beta1_GCPConstraints_To_garden_GCPConstraints(in *GCPConstraints, out *garden.GCPConstraints, s conversion.Scope) error {
-	out.DNSProviders = *(*[]garden.DNSProviderConstraint)(unsafe.Pointer(&in.DNSProviders))
-	if err := Convert_v1beta1_KubernetesConstraints_To_garden_KubernetesConstraints(&in.Kubernetes, &out.Kubernetes, s); err != nil {
-		return err
-	}
-	out.MachineImages = *(*[]garden.GCPMachineImage)(unsafe.Pointer(&in.MachineImages))
-	out.MachineTypes = *(*[]garden.MachineType)(unsafe.Pointer(&in.MachineTypes))
-	out.VolumeTypes = *(*[]garden.VolumeType)(unsafe.Pointer(&in.VolumeTypes))
-	out.Zones = *(*[]garden.Zone)(unsafe.Pointer(&in.Zones))
+func autoConvert_v1beta1_GCPCloudNAT_To_garden_GCPCloudNAT(in *GCPCloudNAT, out *garden.GCPCloudNAT, s conversion.Scope) error {
+	out.MinPortsPerVM = (*int32)(unsafe.Pointer(in.MinPortsPerVM))
+	out.NatIPNames = *(*[]string)(unsafe.Pointer(&in.NatIPNames))
+	out.Logging = (*garden.GCPCloudNATLogging)(unsafe.Pointer(in.Logging))
 	return nil
 }
 
-// Convert_v1beta1_GCPConstraints_To_garden_GCPConstraints is an autogenerated conversion function.
+// Convert_v1beta1_GCPCloudNAT_To_garden_GCPCloudNAT is an autogenerated conversion function.
+func Convert_v1beta1_GCPCloudNAT_To_garden_GCPCloudNAT(in *GCPCloudNAT, out *garden.GCPCloudNAT, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPCloudNAT_To_garden_GCPCloudNAT(in, out, s)
+}
+
+func autoConvert_garden_GCPCloudNAT_To_v1beta1_GCPCloudNAT(in *garden.GCPCloudNAT, out *GCPCloudNAT, s conversion.Scope) error {
+	out.MinPortsPerVM = (*int32)(unsafe.Pointer(in.MinPortsPerVM))
+	out.NatIPNames = *(*[]string)(unsafe.Pointer(&in.NatIPNames))
+	out.Logging = (*GCPCloudNATLogging)(unsafe.Pointer(in.Logging))
+	return nil
+}
+
+// Convert_garden_GCPCloudNAT_To_v1beta1_GCPCloudNAT is an autogenerated conversion function.
+func Convert_garden_GCPCloudNAT_To_v1beta1_GCPCloudNAT(in *garden.GCPCloudNAT, out *GCPCloudNAT, s conversion.Scope) error {
+	return autoConvert_garden_GCPCloudNAT_To_v1beta1_GCPCloudNAT(in, out, s)
+}
+
+func autoConvert_v1beta1_GCPCloudNATLogging_To_garden_GCPCloudNATLogging(in *GCPCloudNATLogging, out *garden.GCPCloudNATLogging, s conversion.Scope) error {
+	out.Filter = in.Filter
+	return nil
+}
+
+// Convert_v1beta1_GCPCloudNATLogging_To_garden_GCPCloudNATLogging is an autogenerated conversion function.
+func Convert_v1beta1_GCPCloudNATLogging_To_garden_GCPCloudNATLogging(in *GCPCloudNATLogging, out *garden.GCPCloudNATLogging, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPCloudNATLogging_To_garden_GCPCloudNATLogging(in, out, s)
+}
+
+func autoConvert_garden_GCPCloudNATLogging_To_v1beta1_GCPCloudNATLogging(in *garden.GCPCloudNATLogging, out *GCPCloudNATLogging, s conversion.Scope) error {
+	out.Filter = in.Filter
+	return nil
+}
+
+// Convert_garden_GCPCloudNATLogging_To_v1beta1_GCPCloudNATLogging is an autogenerated conversion function.
+func Convert_garden_GCPCloudNATLogging_To_v1beta1_GCPCloudNATLogging(in *garden.GCPCloudNATLogging, out *GCPCloudNATLogging, s conversion.Scope) error {
+	return autoConvert_garden_GCPCloudNATLogging_To_v1beta1_GCPCloudNATLogging(in, out, s)
+}
+
+func autoConvert_v1beta1_GCPConstraints_To_garden_GCPConstraints(in *GCPConstraints, out *garden.GCPConstraints, s conversion.Scope) error {
+	out.DNSProviders = *(*[]garden.DNSProviderConstraint)(unsafe.Pointer(&in.DNSProviders))
+	if err := Convert_v1beta1_KubernetesConstraints_To_garden_KubernetesConstraints(&in.Kubernetes, &out.Kubernetes, s); err != nil {
+		return err
+	}
+	out.MachineImages = *(*[]garden.GCPMachineImage)(unsafe.Pointer(&in.MachineImages))
+	out.MachineTypes = *(*[]garden.MachineType)(unsafe.Pointer(&in.MachineTypes))
+	out.VolumeTypes = *(*[]garden.VolumeType)(unsafe.Pointer(&in.VolumeTypes))
+	out.Zones = *(*[]garden.Zone)(unsafe.Pointer(&in.Zones))
+	return nil
+}
+
+// Convert_v1beta1_GCPConstraints_To_garden_GCPConstraints is an autogenerated conversion function.
 func Convert_v1beta1_GCPConstraints_To_garden_GCPConstraints(in *GCPConstraints, out *garden.GCPConstraints, s conversion.Scope) error {
 	return autoConvert_v1beta1_GCPConstraints_To_garden_GCPConstraints(in, out, s)
 }
@@ -2732,6 +3699,7 @@ func autoConvert_v1beta1_GCPNetworks_To_garden_GCPNetworks(in *GCPNetworks, out
 	out.VPC = (*garden.GCPVPC)(unsafe.Pointer(in.VPC))
 	out.Workers = *(*[]core.CIDR)(unsafe.Pointer(&in.Workers))
 	out.Internal = (*core.CIDR)(unsafe.Pointer(in.Internal))
+	out.CloudNAT = (*garden.GCPCloudNAT)(unsafe.Pointer(in.CloudNAT))
 	return nil
 }
 
@@ -2748,6 +3716,7 @@ func autoConvert_garden_GCPNetworks_To_v1beta1_GCPNetworks(in *garden.GCPNetwork
 	out.VPC = (*GCPVPC)(unsafe.Pointer(in.VPC))
 	out.Internal = (*v1alpha1.CIDR)(unsafe.Pointer(in.Internal))
 	out.Workers = *(*[]v1alpha1.CIDR)(unsafe.Pointer(&in.Workers))
+	out.CloudNAT = (*GCPCloudNAT)(unsafe.Pointer(in.CloudNAT))
 	return nil
 }
 
@@ -3036,6 +4005,9 @@ func autoConvert_v1beta1_KubeAPIServerConfig_To_garden_KubeAPIServerConfig(in *K
 	out.OIDCConfig = (*garden.OIDCConfig)(unsafe.Pointer(in.OIDCConfig))
 	out.AdmissionPlugins = *(*[]garden.AdmissionPlugin)(unsafe.Pointer(&in.AdmissionPlugins))
 	out.AuditConfig = (*garden.AuditConfig)(unsafe.Pointer(in.AuditConfig))
+	out.AllowedCIDRs = *(*[]core.CIDR)(unsafe.Pointer(&in.AllowedCIDRs))
+	out.EnableBasicAuthentication = (*bool)(unsafe.Pointer(in.EnableBasicAuthentication))
+	out.AuthenticationWebhook = (*garden.AuthenticationWebhook)(unsafe.Pointer(in.AuthenticationWebhook))
 	return nil
 }
 
@@ -3052,6 +4024,9 @@ func autoConvert_garden_KubeAPIServerConfig_To_v1beta1_KubeAPIServerConfig(in *g
 	out.OIDCConfig = (*OIDCConfig)(unsafe.Pointer(in.OIDCConfig))
 	out.AdmissionPlugins = *(*[]AdmissionPlugin)(unsafe.Pointer(&in.AdmissionPlugins))
 	out.AuditConfig = (*AuditConfig)(unsafe.Pointer(in.AuditConfig))
+	out.AllowedCIDRs = *(*[]v1alpha1.CIDR)(unsafe.Pointer(&in.AllowedCIDRs))
+	out.EnableBasicAuthentication = (*bool)(unsafe.Pointer(in.EnableBasicAuthentication))
+	out.AuthenticationWebhook = (*AuthenticationWebhook)(unsafe.Pointer(in.AuthenticationWebhook))
 	return nil
 }
 
@@ -3065,6 +4040,9 @@ func autoConvert_v1beta1_KubeControllerManagerConfig_To_garden_KubeControllerMan
 		return err
 	}
 	out.HorizontalPodAutoscalerConfig = (*garden.HorizontalPodAutoscalerConfig)(unsafe.Pointer(in.HorizontalPodAutoscalerConfig))
+	out.NodeCIDRMaskSize = (*int)(unsafe.Pointer(in.NodeCIDRMaskSize))
+	out.NodeMonitorGracePeriod = (*metav1.Duration)(unsafe.Pointer(in.NodeMonitorGracePeriod))
+	out.PodEvictionTimeout = (*metav1.Duration)(unsafe.Pointer(in.PodEvictionTimeout))
 	return nil
 }
 
@@ -3078,6 +4056,9 @@ func autoConvert_garden_KubeControllerManagerConfig_To_v1beta1_KubeControllerMan
 		return err
 	}
 	out.HorizontalPodAutoscalerConfig = (*HorizontalPodAutoscalerConfig)(unsafe.Pointer(in.HorizontalPodAutoscalerConfig))
+	out.NodeCIDRMaskSize = (*int)(unsafe.Pointer(in.NodeCIDRMaskSize))
+	out.NodeMonitorGracePeriod = (*GardenerDuration)(unsafe.Pointer(in.NodeMonitorGracePeriod))
+	out.PodEvictionTimeout = (*GardenerDuration)(unsafe.Pointer(in.PodEvictionTimeout))
 	return nil
 }
 
@@ -3416,6 +4397,7 @@ func autoConvert_v1beta1_MachineType_To_garden_MachineType(in *MachineType, out
 	out.CPU = in.CPU
 	out.GPU = in.GPU
 	out.Memory = in.Memory
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
 	return nil
 }
 
@@ -3430,6 +4412,7 @@ func autoConvert_garden_MachineType_To_v1beta1_MachineType(in *garden.MachineTyp
 	out.CPU = in.CPU
 	out.GPU = in.GPU
 	out.Memory = in.Memory
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
 	return nil
 }
 
@@ -3441,6 +4424,7 @@ func Convert_garden_MachineType_To_v1beta1_MachineType(in *garden.MachineType, o
 func autoConvert_v1beta1_Maintenance_To_garden_Maintenance(in *Maintenance, out *garden.Maintenance, s conversion.Scope) error {
 	out.AutoUpdate = (*garden.MaintenanceAutoUpdate)(unsafe.Pointer(in.AutoUpdate))
 	out.TimeWindow = (*garden.MaintenanceTimeWindow)(unsafe.Pointer(in.TimeWindow))
+	out.ConfineSpecUpdateRollout = (*bool)(unsafe.Pointer(in.ConfineSpecUpdateRollout))
 	return nil
 }
 
@@ -3452,6 +4436,7 @@ func Convert_v1beta1_Maintenance_To_garden_Maintenance(in *Maintenance, out *gar
 func autoConvert_garden_Maintenance_To_v1beta1_Maintenance(in *garden.Maintenance, out *Maintenance, s conversion.Scope) error {
 	out.AutoUpdate = (*MaintenanceAutoUpdate)(unsafe.Pointer(in.AutoUpdate))
 	out.TimeWindow = (*MaintenanceTimeWindow)(unsafe.Pointer(in.TimeWindow))
+	out.ConfineSpecUpdateRollout = (*bool)(unsafe.Pointer(in.ConfineSpecUpdateRollout))
 	return nil
 }
 
@@ -3502,6 +4487,28 @@ func Convert_garden_MaintenanceTimeWindow_To_v1beta1_MaintenanceTimeWindow(in *g
 	return autoConvert_garden_MaintenanceTimeWindow_To_v1beta1_MaintenanceTimeWindow(in, out, s)
 }
 
+func autoConvert_v1beta1_Monitoring_To_garden_Monitoring(in *Monitoring, out *garden.Monitoring, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.ScrapeTargets = *(*[]string)(unsafe.Pointer(&in.ScrapeTargets))
+	return nil
+}
+
+// Convert_v1beta1_Monitoring_To_garden_Monitoring is an autogenerated conversion function.
+func Convert_v1beta1_Monitoring_To_garden_Monitoring(in *Monitoring, out *garden.Monitoring, s conversion.Scope) error {
+	return autoConvert_v1beta1_Monitoring_To_garden_Monitoring(in, out, s)
+}
+
+func autoConvert_garden_Monitoring_To_v1beta1_Monitoring(in *garden.Monitoring, out *Monitoring, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.ScrapeTargets = *(*[]string)(unsafe.Pointer(&in.ScrapeTargets))
+	return nil
+}
+
+// Convert_garden_Monitoring_To_v1beta1_Monitoring is an autogenerated conversion function.
+func Convert_garden_Monitoring_To_v1beta1_Monitoring(in *garden.Monitoring, out *Monitoring, s conversion.Scope) error {
+	return autoConvert_garden_Monitoring_To_v1beta1_Monitoring(in, out, s)
+}
+
 func autoConvert_v1beta1_Monocular_To_garden_Monocular(in *Monocular, out *garden.Monocular, s conversion.Scope) error {
 	if err := Convert_v1beta1_Addon_To_garden_Addon(&in.Addon, &out.Addon, s); err != nil {
 		return err
@@ -3591,6 +4598,7 @@ func Convert_garden_OIDCConfig_To_v1beta1_OIDCConfig(in *garden.OIDCConfig, out
 func autoConvert_v1beta1_OpenStackCloud_To_garden_OpenStackCloud(in *OpenStackCloud, out *garden.OpenStackCloud, s conversion.Scope) error {
 	out.FloatingPoolName = in.FloatingPoolName
 	out.LoadBalancerProvider = in.LoadBalancerProvider
+	out.FloatingPoolSubnetName = (*string)(unsafe.Pointer(in.FloatingPoolSubnetName))
 	out.MachineImage = (*garden.OpenStackMachineImage)(unsafe.Pointer(in.MachineImage))
 	if err := Convert_v1beta1_OpenStackNetworks_To_garden_OpenStackNetworks(&in.Networks, &out.Networks, s); err != nil {
 		return err
@@ -3618,6 +4626,7 @@ func Convert_v1beta1_OpenStackCloud_To_garden_OpenStackCloud(in *OpenStackCloud,
 func autoConvert_garden_OpenStackCloud_To_v1beta1_OpenStackCloud(in *garden.OpenStackCloud, out *OpenStackCloud, s conversion.Scope) error {
 	out.FloatingPoolName = in.FloatingPoolName
 	out.LoadBalancerProvider = in.LoadBalancerProvider
+	out.FloatingPoolSubnetName = (*string)(unsafe.Pointer(in.FloatingPoolSubnetName))
 	out.MachineImage = (*OpenStackMachineImage)(unsafe.Pointer(in.MachineImage))
 	if err := Convert_garden_OpenStackNetworks_To_v1beta1_OpenStackNetworks(&in.Networks, &out.Networks, s); err != nil {
 		return err
@@ -3806,6 +4815,7 @@ func autoConvert_v1beta1_OpenStackProfile_To_garden_OpenStackProfile(in *OpenSta
 	out.DNSServers = *(*[]string)(unsafe.Pointer(&in.DNSServers))
 	out.DHCPDomain = (*string)(unsafe.Pointer(in.DHCPDomain))
 	out.RequestTimeout = (*string)(unsafe.Pointer(in.RequestTimeout))
+	out.NetworkMTU = (*int32)(unsafe.Pointer(in.NetworkMTU))
 	return nil
 }
 
@@ -3822,6 +4832,7 @@ func autoConvert_garden_OpenStackProfile_To_v1beta1_OpenStackProfile(in *garden.
 	out.DNSServers = *(*[]string)(unsafe.Pointer(&in.DNSServers))
 	out.DHCPDomain = (*string)(unsafe.Pointer(in.DHCPDomain))
 	out.RequestTimeout = (*string)(unsafe.Pointer(in.RequestTimeout))
+	out.NetworkMTU = (*int32)(unsafe.Pointer(in.NetworkMTU))
 	return nil
 }
 
@@ -4090,6 +5101,28 @@ func Convert_garden_Project_To_v1beta1_Project(in *garden.Project, out *Project,
 	return autoConvert_garden_Project_To_v1beta1_Project(in, out, s)
 }
 
+func autoConvert_v1beta1_ProjectCloudProfile_To_garden_ProjectCloudProfile(in *ProjectCloudProfile, out *garden.ProjectCloudProfile, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Regions = *(*[]string)(unsafe.Pointer(&in.Regions))
+	return nil
+}
+
+// Convert_v1beta1_ProjectCloudProfile_To_garden_ProjectCloudProfile is an autogenerated conversion function.
+func Convert_v1beta1_ProjectCloudProfile_To_garden_ProjectCloudProfile(in *ProjectCloudProfile, out *garden.ProjectCloudProfile, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectCloudProfile_To_garden_ProjectCloudProfile(in, out, s)
+}
+
+func autoConvert_garden_ProjectCloudProfile_To_v1beta1_ProjectCloudProfile(in *garden.ProjectCloudProfile, out *ProjectCloudProfile, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Regions = *(*[]string)(unsafe.Pointer(&in.Regions))
+	return nil
+}
+
+// Convert_garden_ProjectCloudProfile_To_v1beta1_ProjectCloudProfile is an autogenerated conversion function.
+func Convert_garden_ProjectCloudProfile_To_v1beta1_ProjectCloudProfile(in *garden.ProjectCloudProfile, out *ProjectCloudProfile, s conversion.Scope) error {
+	return autoConvert_garden_ProjectCloudProfile_To_v1beta1_ProjectCloudProfile(in, out, s)
+}
+
 func autoConvert_v1beta1_ProjectList_To_garden_ProjectList(in *ProjectList, out *garden.ProjectList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]garden.Project)(unsafe.Pointer(&in.Items))
@@ -4112,6 +5145,30 @@ func Convert_garden_ProjectList_To_v1beta1_ProjectList(in *garden.ProjectList, o
 	return autoConvert_garden_ProjectList_To_v1beta1_ProjectList(in, out, s)
 }
 
+func autoConvert_v1beta1_ProjectRestrictions_To_garden_ProjectRestrictions(in *ProjectRestrictions, out *garden.ProjectRestrictions, s conversion.Scope) error {
+	out.CloudProfiles = *(*[]garden.ProjectCloudProfile)(unsafe.Pointer(&in.CloudProfiles))
+	out.Secrets = *(*[]v1.SecretReference)(unsafe.Pointer(&in.Secrets))
+	out.DefaultSecretBinding = (*string)(unsafe.Pointer(in.DefaultSecretBinding))
+	return nil
+}
+
+// Convert_v1beta1_ProjectRestrictions_To_garden_ProjectRestrictions is an autogenerated conversion function.
+func Convert_v1beta1_ProjectRestrictions_To_garden_ProjectRestrictions(in *ProjectRestrictions, out *garden.ProjectRestrictions, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectRestrictions_To_garden_ProjectRestrictions(in, out, s)
+}
+
+func autoConvert_garden_ProjectRestrictions_To_v1beta1_ProjectRestrictions(in *garden.ProjectRestrictions, out *ProjectRestrictions, s conversion.Scope) error {
+	out.CloudProfiles = *(*[]ProjectCloudProfile)(unsafe.Pointer(&in.CloudProfiles))
+	out.Secrets = *(*[]v1.SecretReference)(unsafe.Pointer(&in.Secrets))
+	out.DefaultSecretBinding = (*string)(unsafe.Pointer(in.DefaultSecretBinding))
+	return nil
+}
+
+// Convert_garden_ProjectRestrictions_To_v1beta1_ProjectRestrictions is an autogenerated conversion function.
+func Convert_garden_ProjectRestrictions_To_v1beta1_ProjectRestrictions(in *garden.ProjectRestrictions, out *ProjectRestrictions, s conversion.Scope) error {
+	return autoConvert_garden_ProjectRestrictions_To_v1beta1_ProjectRestrictions(in, out, s)
+}
+
 func autoConvert_v1beta1_ProjectSpec_To_garden_ProjectSpec(in *ProjectSpec, out *garden.ProjectSpec, s conversion.Scope) error {
 	out.CreatedBy = (*rbacv1.Subject)(unsafe.Pointer(in.CreatedBy))
 	out.Description = (*string)(unsafe.Pointer(in.Description))
@@ -4119,6 +5176,7 @@ func autoConvert_v1beta1_ProjectSpec_To_garden_ProjectSpec(in *ProjectSpec, out
 	out.Purpose = (*string)(unsafe.Pointer(in.Purpose))
 	out.Members = *(*[]rbacv1.Subject)(unsafe.Pointer(&in.Members))
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	out.Restrictions = (*garden.ProjectRestrictions)(unsafe.Pointer(in.Restrictions))
 	return nil
 }
 
@@ -4134,6 +5192,7 @@ func autoConvert_garden_ProjectSpec_To_v1beta1_ProjectSpec(in *garden.ProjectSpe
 	out.Purpose = (*string)(unsafe.Pointer(in.Purpose))
 	out.Members = *(*[]rbacv1.Subject)(unsafe.Pointer(&in.Members))
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	out.Restrictions = (*ProjectRestrictions)(unsafe.Pointer(in.Restrictions))
 	return nil
 }
 
@@ -4239,7 +5298,9 @@ func Convert_garden_QuotaSpec_To_v1beta1_QuotaSpec(in *garden.QuotaSpec, out *Qu
 func autoConvert_v1beta1_SecretBinding_To_garden_SecretBinding(in *SecretBinding, out *garden.SecretBinding, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.SecretRef = in.SecretRef
+	out.VaultRef = (*garden.SecretBindingVaultReference)(unsafe.Pointer(in.VaultRef))
 	out.Quotas = *(*[]v1.ObjectReference)(unsafe.Pointer(&in.Quotas))
+	out.Rotation = (*garden.SecretBindingRotation)(unsafe.Pointer(in.Rotation))
 	return nil
 }
 
@@ -4251,7 +5312,9 @@ func Convert_v1beta1_SecretBinding_To_garden_SecretBinding(in *SecretBinding, ou
 func autoConvert_garden_SecretBinding_To_v1beta1_SecretBinding(in *garden.SecretBinding, out *SecretBinding, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.SecretRef = in.SecretRef
+	out.VaultRef = (*SecretBindingVaultReference)(unsafe.Pointer(in.VaultRef))
 	out.Quotas = *(*[]v1.ObjectReference)(unsafe.Pointer(&in.Quotas))
+	out.Rotation = (*SecretBindingRotation)(unsafe.Pointer(in.Rotation))
 	return nil
 }
 
@@ -4282,6 +5345,78 @@ func Convert_garden_SecretBindingList_To_v1beta1_SecretBindingList(in *garden.Se
 	return autoConvert_garden_SecretBindingList_To_v1beta1_SecretBindingList(in, out, s)
 }
 
+func autoConvert_v1beta1_SecretBindingRotation_To_garden_SecretBindingRotation(in *SecretBindingRotation, out *garden.SecretBindingRotation, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	out.Status = (*garden.SecretBindingRotationStatus)(unsafe.Pointer(in.Status))
+	return nil
+}
+
+// Convert_v1beta1_SecretBindingRotation_To_garden_SecretBindingRotation is an autogenerated conversion function.
+func Convert_v1beta1_SecretBindingRotation_To_garden_SecretBindingRotation(in *SecretBindingRotation, out *garden.SecretBindingRotation, s conversion.Scope) error {
+	return autoConvert_v1beta1_SecretBindingRotation_To_garden_SecretBindingRotation(in, out, s)
+}
+
+func autoConvert_garden_SecretBindingRotation_To_v1beta1_SecretBindingRotation(in *garden.SecretBindingRotation, out *SecretBindingRotation, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	out.Status = (*SecretBindingRotationStatus)(unsafe.Pointer(in.Status))
+	return nil
+}
+
+// Convert_garden_SecretBindingRotation_To_v1beta1_SecretBindingRotation is an autogenerated conversion function.
+func Convert_garden_SecretBindingRotation_To_v1beta1_SecretBindingRotation(in *garden.SecretBindingRotation, out *SecretBindingRotation, s conversion.Scope) error {
+	return autoConvert_garden_SecretBindingRotation_To_v1beta1_SecretBindingRotation(in, out, s)
+}
+
+func autoConvert_v1beta1_SecretBindingRotationStatus_To_garden_SecretBindingRotationStatus(in *SecretBindingRotationStatus, out *garden.SecretBindingRotationStatus, s conversion.Scope) error {
+	out.Phase = garden.SecretBindingRotationPhase(in.Phase)
+	out.LastUpdateTime = in.LastUpdateTime
+	out.Description = in.Description
+	out.RotatedShoots = *(*[]string)(unsafe.Pointer(&in.RotatedShoots))
+	out.PreviousSecretRef = (*v1.SecretReference)(unsafe.Pointer(in.PreviousSecretRef))
+	return nil
+}
+
+// Convert_v1beta1_SecretBindingRotationStatus_To_garden_SecretBindingRotationStatus is an autogenerated conversion function.
+func Convert_v1beta1_SecretBindingRotationStatus_To_garden_SecretBindingRotationStatus(in *SecretBindingRotationStatus, out *garden.SecretBindingRotationStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_SecretBindingRotationStatus_To_garden_SecretBindingRotationStatus(in, out, s)
+}
+
+func autoConvert_garden_SecretBindingRotationStatus_To_v1beta1_SecretBindingRotationStatus(in *garden.SecretBindingRotationStatus, out *SecretBindingRotationStatus, s conversion.Scope) error {
+	out.Phase = SecretBindingRotationPhase(in.Phase)
+	out.LastUpdateTime = in.LastUpdateTime
+	out.Description = in.Description
+	out.RotatedShoots = *(*[]string)(unsafe.Pointer(&in.RotatedShoots))
+	out.PreviousSecretRef = (*v1.SecretReference)(unsafe.Pointer(in.PreviousSecretRef))
+	return nil
+}
+
+// Convert_garden_SecretBindingRotationStatus_To_v1beta1_SecretBindingRotationStatus is an autogenerated conversion function.
+func Convert_garden_SecretBindingRotationStatus_To_v1beta1_SecretBindingRotationStatus(in *garden.SecretBindingRotationStatus, out *SecretBindingRotationStatus, s conversion.Scope) error {
+	return autoConvert_garden_SecretBindingRotationStatus_To_v1beta1_SecretBindingRotationStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_SecretBindingVaultReference_To_garden_SecretBindingVaultReference(in *SecretBindingVaultReference, out *garden.SecretBindingVaultReference, s conversion.Scope) error {
+	out.Path = in.Path
+	out.KeyMapping = *(*map[string]string)(unsafe.Pointer(&in.KeyMapping))
+	return nil
+}
+
+// Convert_v1beta1_SecretBindingVaultReference_To_garden_SecretBindingVaultReference is an autogenerated conversion function.
+func Convert_v1beta1_SecretBindingVaultReference_To_garden_SecretBindingVaultReference(in *SecretBindingVaultReference, out *garden.SecretBindingVaultReference, s conversion.Scope) error {
+	return autoConvert_v1beta1_SecretBindingVaultReference_To_garden_SecretBindingVaultReference(in, out, s)
+}
+
+func autoConvert_garden_SecretBindingVaultReference_To_v1beta1_SecretBindingVaultReference(in *garden.SecretBindingVaultReference, out *SecretBindingVaultReference, s conversion.Scope) error {
+	out.Path = in.Path
+	out.KeyMapping = *(*map[string]string)(unsafe.Pointer(&in.KeyMapping))
+	return nil
+}
+
+// Convert_garden_SecretBindingVaultReference_To_v1beta1_SecretBindingVaultReference is an autogenerated conversion function.
+func Convert_garden_SecretBindingVaultReference_To_v1beta1_SecretBindingVaultReference(in *garden.SecretBindingVaultReference, out *SecretBindingVaultReference, s conversion.Scope) error {
+	return autoConvert_garden_SecretBindingVaultReference_To_v1beta1_SecretBindingVaultReference(in, out, s)
+}
+
 func autoConvert_v1beta1_Seed_To_garden_Seed(in *Seed, out *garden.Seed, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_SeedSpec_To_garden_SeedSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -4314,6 +5449,30 @@ func Convert_garden_Seed_To_v1beta1_Seed(in *garden.Seed, out *Seed, s conversio
 	return autoConvert_garden_Seed_To_v1beta1_Seed(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedBackup_To_garden_SeedBackup(in *SeedBackup, out *garden.SeedBackup, s conversion.Scope) error {
+	out.Region = (*string)(unsafe.Pointer(in.Region))
+	out.Provider = (*garden.CloudProvider)(unsafe.Pointer(in.Provider))
+	out.SecretRef = (*v1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	return nil
+}
+
+// Convert_v1beta1_SeedBackup_To_garden_SeedBackup is an autogenerated conversion function.
+func Convert_v1beta1_SeedBackup_To_garden_SeedBackup(in *SeedBackup, out *garden.SeedBackup, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedBackup_To_garden_SeedBackup(in, out, s)
+}
+
+func autoConvert_garden_SeedBackup_To_v1beta1_SeedBackup(in *garden.SeedBackup, out *SeedBackup, s conversion.Scope) error {
+	out.Region = (*string)(unsafe.Pointer(in.Region))
+	out.Provider = (*CloudProvider)(unsafe.Pointer(in.Provider))
+	out.SecretRef = (*v1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	return nil
+}
+
+// Convert_garden_SeedBackup_To_v1beta1_SeedBackup is an autogenerated conversion function.
+func Convert_garden_SeedBackup_To_v1beta1_SeedBackup(in *garden.SeedBackup, out *SeedBackup, s conversion.Scope) error {
+	return autoConvert_garden_SeedBackup_To_v1beta1_SeedBackup(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedCloud_To_garden_SeedCloud(in *SeedCloud, out *garden.SeedCloud, s conversion.Scope) error {
 	out.Profile = in.Profile
 	out.Region = in.Region
@@ -4336,6 +5495,72 @@ func Convert_garden_SeedCloud_To_v1beta1_SeedCloud(in *garden.SeedCloud, out *Se
 	return autoConvert_garden_SeedCloud_To_v1beta1_SeedCloud(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedDashboardOIDC_To_garden_SeedDashboardOIDC(in *SeedDashboardOIDC, out *garden.SeedDashboardOIDC, s conversion.Scope) error {
+	out.IssuerURL = in.IssuerURL
+	out.ClientID = in.ClientID
+	out.SecretRef = in.SecretRef
+	return nil
+}
+
+// Convert_v1beta1_SeedDashboardOIDC_To_garden_SeedDashboardOIDC is an autogenerated conversion function.
+func Convert_v1beta1_SeedDashboardOIDC_To_garden_SeedDashboardOIDC(in *SeedDashboardOIDC, out *garden.SeedDashboardOIDC, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedDashboardOIDC_To_garden_SeedDashboardOIDC(in, out, s)
+}
+
+func autoConvert_garden_SeedDashboardOIDC_To_v1beta1_SeedDashboardOIDC(in *garden.SeedDashboardOIDC, out *SeedDashboardOIDC, s conversion.Scope) error {
+	out.IssuerURL = in.IssuerURL
+	out.ClientID = in.ClientID
+	out.SecretRef = in.SecretRef
+	return nil
+}
+
+// Convert_garden_SeedDashboardOIDC_To_v1beta1_SeedDashboardOIDC is an autogenerated conversion function.
+func Convert_garden_SeedDashboardOIDC_To_v1beta1_SeedDashboardOIDC(in *garden.SeedDashboardOIDC, out *SeedDashboardOIDC, s conversion.Scope) error {
+	return autoConvert_garden_SeedDashboardOIDC_To_v1beta1_SeedDashboardOIDC(in, out, s)
+}
+
+func autoConvert_v1beta1_SeedIngressACME_To_garden_SeedIngressACME(in *SeedIngressACME, out *garden.SeedIngressACME, s conversion.Scope) error {
+	out.ClusterIssuer = in.ClusterIssuer
+	return nil
+}
+
+// Convert_v1beta1_SeedIngressACME_To_garden_SeedIngressACME is an autogenerated conversion function.
+func Convert_v1beta1_SeedIngressACME_To_garden_SeedIngressACME(in *SeedIngressACME, out *garden.SeedIngressACME, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedIngressACME_To_garden_SeedIngressACME(in, out, s)
+}
+
+func autoConvert_garden_SeedIngressACME_To_v1beta1_SeedIngressACME(in *garden.SeedIngressACME, out *SeedIngressACME, s conversion.Scope) error {
+	out.ClusterIssuer = in.ClusterIssuer
+	return nil
+}
+
+// Convert_garden_SeedIngressACME_To_v1beta1_SeedIngressACME is an autogenerated conversion function.
+func Convert_garden_SeedIngressACME_To_v1beta1_SeedIngressACME(in *garden.SeedIngressACME, out *SeedIngressACME, s conversion.Scope) error {
+	return autoConvert_garden_SeedIngressACME_To_v1beta1_SeedIngressACME(in, out, s)
+}
+
+func autoConvert_v1beta1_SeedIngressTLS_To_garden_SeedIngressTLS(in *SeedIngressTLS, out *garden.SeedIngressTLS, s conversion.Scope) error {
+	out.SecretRef = (*v1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	out.ACME = (*garden.SeedIngressACME)(unsafe.Pointer(in.ACME))
+	return nil
+}
+
+// Convert_v1beta1_SeedIngressTLS_To_garden_SeedIngressTLS is an autogenerated conversion function.
+func Convert_v1beta1_SeedIngressTLS_To_garden_SeedIngressTLS(in *SeedIngressTLS, out *garden.SeedIngressTLS, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedIngressTLS_To_garden_SeedIngressTLS(in, out, s)
+}
+
+func autoConvert_garden_SeedIngressTLS_To_v1beta1_SeedIngressTLS(in *garden.SeedIngressTLS, out *SeedIngressTLS, s conversion.Scope) error {
+	out.SecretRef = (*v1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	out.ACME = (*SeedIngressACME)(unsafe.Pointer(in.ACME))
+	return nil
+}
+
+// Convert_garden_SeedIngressTLS_To_v1beta1_SeedIngressTLS is an autogenerated conversion function.
+func Convert_garden_SeedIngressTLS_To_v1beta1_SeedIngressTLS(in *garden.SeedIngressTLS, out *SeedIngressTLS, s conversion.Scope) error {
+	return autoConvert_garden_SeedIngressTLS_To_v1beta1_SeedIngressTLS(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedList_To_garden_SeedList(in *SeedList, out *garden.SeedList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]garden.Seed)(unsafe.Pointer(&in.Items))
@@ -4382,17 +5607,270 @@ func Convert_garden_SeedNetworks_To_v1beta1_SeedNetworks(in *garden.SeedNetworks
 	return autoConvert_garden_SeedNetworks_To_v1beta1_SeedNetworks(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedOrphan_To_garden_SeedOrphan(in *SeedOrphan, out *garden.SeedOrphan, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.DetectionTime = in.DetectionTime
+	return nil
+}
+
+// Convert_v1beta1_SeedOrphan_To_garden_SeedOrphan is an autogenerated conversion function.
+func Convert_v1beta1_SeedOrphan_To_garden_SeedOrphan(in *SeedOrphan, out *garden.SeedOrphan, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedOrphan_To_garden_SeedOrphan(in, out, s)
+}
+
+func autoConvert_garden_SeedOrphan_To_v1beta1_SeedOrphan(in *garden.SeedOrphan, out *SeedOrphan, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.DetectionTime = in.DetectionTime
+	return nil
+}
+
+// Convert_garden_SeedOrphan_To_v1beta1_SeedOrphan is an autogenerated conversion function.
+func Convert_garden_SeedOrphan_To_v1beta1_SeedOrphan(in *garden.SeedOrphan, out *SeedOrphan, s conversion.Scope) error {
+	return autoConvert_garden_SeedOrphan_To_v1beta1_SeedOrphan(in, out, s)
+}
+
+func autoConvert_v1beta1_SeedProviderHealth_To_garden_SeedProviderHealth(in *SeedProviderHealth, out *garden.SeedProviderHealth, s conversion.Scope) error {
+	out.LastCheckTime = in.LastCheckTime
+	out.Quotas = *(*[]garden.SeedProviderQuota)(unsafe.Pointer(&in.Quotas))
+	return nil
+}
+
+// Convert_v1beta1_SeedProviderHealth_To_garden_SeedProviderHealth is an autogenerated conversion function.
+func Convert_v1beta1_SeedProviderHealth_To_garden_SeedProviderHealth(in *SeedProviderHealth, out *garden.SeedProviderHealth, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedProviderHealth_To_garden_SeedProviderHealth(in, out, s)
+}
+
+func autoConvert_garden_SeedProviderHealth_To_v1beta1_SeedProviderHealth(in *garden.SeedProviderHealth, out *SeedProviderHealth, s conversion.Scope) error {
+	out.LastCheckTime = in.LastCheckTime
+	out.Quotas = *(*[]SeedProviderQuota)(unsafe.Pointer(&in.Quotas))
+	return nil
+}
+
+// Convert_garden_SeedProviderHealth_To_v1beta1_SeedProviderHealth is an autogenerated conversion function.
+func Convert_garden_SeedProviderHealth_To_v1beta1_SeedProviderHealth(in *garden.SeedProviderHealth, out *SeedProviderHealth, s conversion.Scope) error {
+	return autoConvert_garden_SeedProviderHealth_To_v1beta1_SeedProviderHealth(in, out, s)
+}
+
+func autoConvert_v1beta1_SeedProviderQuota_To_garden_SeedProviderQuota(in *SeedProviderQuota, out *garden.SeedProviderQuota, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Limit = in.Limit
+	out.Used = in.Used
+	return nil
+}
+
+// Convert_v1beta1_SeedProviderQuota_To_garden_SeedProviderQuota is an autogenerated conversion function.
+func Convert_v1beta1_SeedProviderQuota_To_garden_SeedProviderQuota(in *SeedProviderQuota, out *garden.SeedProviderQuota, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedProviderQuota_To_garden_SeedProviderQuota(in, out, s)
+}
+
+func autoConvert_garden_SeedProviderQuota_To_v1beta1_SeedProviderQuota(in *garden.SeedProviderQuota, out *SeedProviderQuota, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Limit = in.Limit
+	out.Used = in.Used
+	return nil
+}
+
+// Convert_garden_SeedProviderQuota_To_v1beta1_SeedProviderQuota is an autogenerated conversion function.
+func Convert_garden_SeedProviderQuota_To_v1beta1_SeedProviderQuota(in *garden.SeedProviderQuota, out *SeedProviderQuota, s conversion.Scope) error {
+	return autoConvert_garden_SeedProviderQuota_To_v1beta1_SeedProviderQuota(in, out, s)
+}
+
+func autoConvert_v1beta1_SeedSettingDashboardAuthentication_To_garden_SeedSettingDashboardAuthentication(in *SeedSettingDashboardAuthentication, out *garden.SeedSettingDashboardAuthentication, s conversion.Scope) error {
+	out.OIDC = (*garden.SeedDashboardOIDC)(unsafe.Pointer(in.OIDC))
+	return nil
+}
+
+// Convert_v1beta1_SeedSettingDashboardAuthentication_To_garden_SeedSettingDashboardAuthentication is an autogenerated conversion function.
+func Convert_v1beta1_SeedSettingDashboardAuthentication_To_garden_SeedSettingDashboardAuthentication(in *SeedSettingDashboardAuthentication, out *garden.SeedSettingDashboardAuthentication, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedSettingDashboardAuthentication_To_garden_SeedSettingDashboardAuthentication(in, out, s)
+}
+
+func autoConvert_garden_SeedSettingDashboardAuthentication_To_v1beta1_SeedSettingDashboardAuthentication(in *garden.SeedSettingDashboardAuthentication, out *SeedSettingDashboardAuthentication, s conversion.Scope) error {
+	out.OIDC = (*SeedDashboardOIDC)(unsafe.Pointer(in.OIDC))
+	return nil
+}
+
+// Convert_garden_SeedSettingDashboardAuthentication_To_v1beta1_SeedSettingDashboardAuthentication is an autogenerated conversion function.
+func Convert_garden_SeedSettingDashboardAuthentication_To_v1beta1_SeedSettingDashboardAuthentication(in *garden.SeedSettingDashboardAuthentication, out *SeedSettingDashboardAuthentication, s conversion.Scope) error {
+	return autoConvert_garden_SeedSettingDashboardAuthentication_To_v1beta1_SeedSettingDashboardAuthentication(in, out, s)
+}
+
+func autoConvert_v1beta1_SeedSettingExcessCapacityReservation_To_garden_SeedSettingExcessCapacityReservation(in *SeedSettingExcessCapacityReservation, out *garden.SeedSettingExcessCapacityReservation, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.Resources = *(*v1.ResourceList)(unsafe.Pointer(&in.Resources))
+	return nil
+}
+
+// Convert_v1beta1_SeedSettingExcessCapacityReservation_To_garden_SeedSettingExcessCapacityReservation is an autogenerated conversion function.
+func Convert_v1beta1_SeedSettingExcessCapacityReservation_To_garden_SeedSettingExcessCapacityReservation(in *SeedSettingExcessCapacityReservation, out *garden.SeedSettingExcessCapacityReservation, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedSettingExcessCapacityReservation_To_garden_SeedSettingExcessCapacityReservation(in, out, s)
+}
+
+func autoConvert_garden_SeedSettingExcessCapacityReservation_To_v1beta1_SeedSettingExcessCapacityReservation(in *garden.SeedSettingExcessCapacityReservation, out *SeedSettingExcessCapacityReservation, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.Resources = *(*v1.ResourceList)(unsafe.Pointer(&in.Resources))
+	return nil
+}
+
+// Convert_garden_SeedSettingExcessCapacityReservation_To_v1beta1_SeedSettingExcessCapacityReservation is an autogenerated conversion function.
+func Convert_garden_SeedSettingExcessCapacityReservation_To_v1beta1_SeedSettingExcessCapacityReservation(in *garden.SeedSettingExcessCapacityReservation, out *SeedSettingExcessCapacityReservation, s conversion.Scope) error {
+	return autoConvert_garden_SeedSettingExcessCapacityReservation_To_v1beta1_SeedSettingExcessCapacityReservation(in, out, s)
+}
+
+func autoConvert_v1beta1_SeedSettingLoadBalancerServices_To_garden_SeedSettingLoadBalancerServices(in *SeedSettingLoadBalancerServices, out *garden.SeedSettingLoadBalancerServices, s conversion.Scope) error {
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	return nil
+}
+
+// Convert_v1beta1_SeedSettingLoadBalancerServices_To_garden_SeedSettingLoadBalancerServices is an autogenerated conversion function.
+func Convert_v1beta1_SeedSettingLoadBalancerServices_To_garden_SeedSettingLoadBalancerServices(in *SeedSettingLoadBalancerServices, out *garden.SeedSettingLoadBalancerServices, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedSettingLoadBalancerServices_To_garden_SeedSettingLoadBalancerServices(in, out, s)
+}
+
+func autoConvert_garden_SeedSettingLoadBalancerServices_To_v1beta1_SeedSettingLoadBalancerServices(in *garden.SeedSettingLoadBalancerServices, out *SeedSettingLoadBalancerServices, s conversion.Scope) error {
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	return nil
+}
+
+// Convert_garden_SeedSettingLoadBalancerServices_To_v1beta1_SeedSettingLoadBalancerServices is an autogenerated conversion function.
+func Convert_garden_SeedSettingLoadBalancerServices_To_v1beta1_SeedSettingLoadBalancerServices(in *garden.SeedSettingLoadBalancerServices, out *SeedSettingLoadBalancerServices, s conversion.Scope) error {
+	return autoConvert_garden_SeedSettingLoadBalancerServices_To_v1beta1_SeedSettingLoadBalancerServices(in, out, s)
+}
+
+func autoConvert_v1beta1_SeedSettingNetworkPolicies_To_garden_SeedSettingNetworkPolicies(in *SeedSettingNetworkPolicies, out *garden.SeedSettingNetworkPolicies, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1beta1_SeedSettingNetworkPolicies_To_garden_SeedSettingNetworkPolicies is an autogenerated conversion function.
+func Convert_v1beta1_SeedSettingNetworkPolicies_To_garden_SeedSettingNetworkPolicies(in *SeedSettingNetworkPolicies, out *garden.SeedSettingNetworkPolicies, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedSettingNetworkPolicies_To_garden_SeedSettingNetworkPolicies(in, out, s)
+}
+
+func autoConvert_garden_SeedSettingNetworkPolicies_To_v1beta1_SeedSettingNetworkPolicies(in *garden.SeedSettingNetworkPolicies, out *SeedSettingNetworkPolicies, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_garden_SeedSettingNetworkPolicies_To_v1beta1_SeedSettingNetworkPolicies is an autogenerated conversion function.
+func Convert_garden_SeedSettingNetworkPolicies_To_v1beta1_SeedSettingNetworkPolicies(in *garden.SeedSettingNetworkPolicies, out *SeedSettingNetworkPolicies, s conversion.Scope) error {
+	return autoConvert_garden_SeedSettingNetworkPolicies_To_v1beta1_SeedSettingNetworkPolicies(in, out, s)
+}
+
+func autoConvert_v1beta1_SeedSettingScheduling_To_garden_SeedSettingScheduling(in *SeedSettingScheduling, out *garden.SeedSettingScheduling, s conversion.Scope) error {
+	out.Visible = in.Visible
+	return nil
+}
+
+// Convert_v1beta1_SeedSettingScheduling_To_garden_SeedSettingScheduling is an autogenerated conversion function.
+func Convert_v1beta1_SeedSettingScheduling_To_garden_SeedSettingScheduling(in *SeedSettingScheduling, out *garden.SeedSettingScheduling, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedSettingScheduling_To_garden_SeedSettingScheduling(in, out, s)
+}
+
+func autoConvert_garden_SeedSettingScheduling_To_v1beta1_SeedSettingScheduling(in *garden.SeedSettingScheduling, out *SeedSettingScheduling, s conversion.Scope) error {
+	out.Visible = in.Visible
+	return nil
+}
+
+// Convert_garden_SeedSettingScheduling_To_v1beta1_SeedSettingScheduling is an autogenerated conversion function.
+func Convert_garden_SeedSettingScheduling_To_v1beta1_SeedSettingScheduling(in *garden.SeedSettingScheduling, out *SeedSettingScheduling, s conversion.Scope) error {
+	return autoConvert_garden_SeedSettingScheduling_To_v1beta1_SeedSettingScheduling(in, out, s)
+}
+
+func autoConvert_v1beta1_SeedSettingShootDNS_To_garden_SeedSettingShootDNS(in *SeedSettingShootDNS, out *garden.SeedSettingShootDNS, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1beta1_SeedSettingShootDNS_To_garden_SeedSettingShootDNS is an autogenerated conversion function.
+func Convert_v1beta1_SeedSettingShootDNS_To_garden_SeedSettingShootDNS(in *SeedSettingShootDNS, out *garden.SeedSettingShootDNS, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedSettingShootDNS_To_garden_SeedSettingShootDNS(in, out, s)
+}
+
+func autoConvert_garden_SeedSettingShootDNS_To_v1beta1_SeedSettingShootDNS(in *garden.SeedSettingShootDNS, out *SeedSettingShootDNS, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_garden_SeedSettingShootDNS_To_v1beta1_SeedSettingShootDNS is an autogenerated conversion function.
+func Convert_garden_SeedSettingShootDNS_To_v1beta1_SeedSettingShootDNS(in *garden.SeedSettingShootDNS, out *SeedSettingShootDNS, s conversion.Scope) error {
+	return autoConvert_garden_SeedSettingShootDNS_To_v1beta1_SeedSettingShootDNS(in, out, s)
+}
+
+func autoConvert_v1beta1_SeedSettingVerticalPodAutoscaler_To_garden_SeedSettingVerticalPodAutoscaler(in *SeedSettingVerticalPodAutoscaler, out *garden.SeedSettingVerticalPodAutoscaler, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1beta1_SeedSettingVerticalPodAutoscaler_To_garden_SeedSettingVerticalPodAutoscaler is an autogenerated conversion function.
+func Convert_v1beta1_SeedSettingVerticalPodAutoscaler_To_garden_SeedSettingVerticalPodAutoscaler(in *SeedSettingVerticalPodAutoscaler, out *garden.SeedSettingVerticalPodAutoscaler, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedSettingVerticalPodAutoscaler_To_garden_SeedSettingVerticalPodAutoscaler(in, out, s)
+}
+
+func autoConvert_garden_SeedSettingVerticalPodAutoscaler_To_v1beta1_SeedSettingVerticalPodAutoscaler(in *garden.SeedSettingVerticalPodAutoscaler, out *SeedSettingVerticalPodAutoscaler, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_garden_SeedSettingVerticalPodAutoscaler_To_v1beta1_SeedSettingVerticalPodAutoscaler is an autogenerated conversion function.
+func Convert_garden_SeedSettingVerticalPodAutoscaler_To_v1beta1_SeedSettingVerticalPodAutoscaler(in *garden.SeedSettingVerticalPodAutoscaler, out *SeedSettingVerticalPodAutoscaler, s conversion.Scope) error {
+	return autoConvert_garden_SeedSettingVerticalPodAutoscaler_To_v1beta1_SeedSettingVerticalPodAutoscaler(in, out, s)
+}
+
+func autoConvert_v1beta1_SeedSettings_To_garden_SeedSettings(in *SeedSettings, out *garden.SeedSettings, s conversion.Scope) error {
+	out.NetworkPolicies = (*garden.SeedSettingNetworkPolicies)(unsafe.Pointer(in.NetworkPolicies))
+	out.DashboardAuthentication = (*garden.SeedSettingDashboardAuthentication)(unsafe.Pointer(in.DashboardAuthentication))
+	out.ExcessCapacityReservation = (*garden.SeedSettingExcessCapacityReservation)(unsafe.Pointer(in.ExcessCapacityReservation))
+	out.ShootDNS = (*garden.SeedSettingShootDNS)(unsafe.Pointer(in.ShootDNS))
+	out.Scheduling = (*garden.SeedSettingScheduling)(unsafe.Pointer(in.Scheduling))
+	out.VerticalPodAutoscaler = (*garden.SeedSettingVerticalPodAutoscaler)(unsafe.Pointer(in.VerticalPodAutoscaler))
+	out.LoadBalancerServices = (*garden.SeedSettingLoadBalancerServices)(unsafe.Pointer(in.LoadBalancerServices))
+	out.Terraformer = (*garden.TerraformerSettings)(unsafe.Pointer(in.Terraformer))
+	return nil
+}
+
+// Convert_v1beta1_SeedSettings_To_garden_SeedSettings is an autogenerated conversion function.
+func Convert_v1beta1_SeedSettings_To_garden_SeedSettings(in *SeedSettings, out *garden.SeedSettings, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedSettings_To_garden_SeedSettings(in, out, s)
+}
+
+func autoConvert_garden_SeedSettings_To_v1beta1_SeedSettings(in *garden.SeedSettings, out *SeedSettings, s conversion.Scope) error {
+	out.NetworkPolicies = (*SeedSettingNetworkPolicies)(unsafe.Pointer(in.NetworkPolicies))
+	out.DashboardAuthentication = (*SeedSettingDashboardAuthentication)(unsafe.Pointer(in.DashboardAuthentication))
+	out.ExcessCapacityReservation = (*SeedSettingExcessCapacityReservation)(unsafe.Pointer(in.ExcessCapacityReservation))
+	out.ShootDNS = (*SeedSettingShootDNS)(unsafe.Pointer(in.ShootDNS))
+	out.Scheduling = (*SeedSettingScheduling)(unsafe.Pointer(in.Scheduling))
+	out.VerticalPodAutoscaler = (*SeedSettingVerticalPodAutoscaler)(unsafe.Pointer(in.VerticalPodAutoscaler))
+	out.LoadBalancerServices = (*SeedSettingLoadBalancerServices)(unsafe.Pointer(in.LoadBalancerServices))
+	out.Terraformer = (*TerraformerSettings)(unsafe.Pointer(in.Terraformer))
+	return nil
+}
+
+// Convert_garden_SeedSettings_To_v1beta1_SeedSettings is an autogenerated conversion function.
+func Convert_garden_SeedSettings_To_v1beta1_SeedSettings(in *garden.SeedSettings, out *SeedSettings, s conversion.Scope) error {
+	return autoConvert_garden_SeedSettings_To_v1beta1_SeedSettings(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedSpec_To_garden_SeedSpec(in *SeedSpec, out *garden.SeedSpec, s conversion.Scope) error {
 	if err := Convert_v1beta1_SeedCloud_To_garden_SeedCloud(&in.Cloud, &out.Cloud, s); err != nil {
 		return err
 	}
 	out.IngressDomain = in.IngressDomain
+	out.IngressTLS = (*garden.SeedIngressTLS)(unsafe.Pointer(in.IngressTLS))
 	out.SecretRef = in.SecretRef
 	if err := Convert_v1beta1_SeedNetworks_To_garden_SeedNetworks(&in.Networks, &out.Networks, s); err != nil {
 		return err
 	}
 	out.Visible = (*bool)(unsafe.Pointer(in.Visible))
 	out.Protected = (*bool)(unsafe.Pointer(in.Protected))
+	out.Settings = (*garden.SeedSettings)(unsafe.Pointer(in.Settings))
+	out.Backup = (*garden.SeedBackup)(unsafe.Pointer(in.Backup))
 	return nil
 }
 
@@ -4406,12 +5884,15 @@ func autoConvert_garden_SeedSpec_To_v1beta1_SeedSpec(in *garden.SeedSpec, out *S
 		return err
 	}
 	out.IngressDomain = in.IngressDomain
+	out.IngressTLS = (*SeedIngressTLS)(unsafe.Pointer(in.IngressTLS))
 	out.SecretRef = in.SecretRef
 	if err := Convert_garden_SeedNetworks_To_v1beta1_SeedNetworks(&in.Networks, &out.Networks, s); err != nil {
 		return err
 	}
 	out.Visible = (*bool)(unsafe.Pointer(in.Visible))
 	out.Protected = (*bool)(unsafe.Pointer(in.Protected))
+	out.Settings = (*SeedSettings)(unsafe.Pointer(in.Settings))
+	out.Backup = (*SeedBackup)(unsafe.Pointer(in.Backup))
 	return nil
 }
 
@@ -4422,6 +5903,9 @@ func Convert_garden_SeedSpec_To_v1beta1_SeedSpec(in *garden.SeedSpec, out *SeedS
 
 func autoConvert_v1beta1_SeedStatus_To_garden_SeedStatus(in *SeedStatus, out *garden.SeedStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
+	out.Orphans = *(*[]garden.SeedOrphan)(unsafe.Pointer(&in.Orphans))
+	out.KubernetesVersion = in.KubernetesVersion
+	out.ProviderHealth = (*garden.SeedProviderHealth)(unsafe.Pointer(in.ProviderHealth))
 	return nil
 }
 
@@ -4432,6 +5916,9 @@ func Convert_v1beta1_SeedStatus_To_garden_SeedStatus(in *SeedStatus, out *garden
 
 func autoConvert_garden_SeedStatus_To_v1beta1_SeedStatus(in *garden.SeedStatus, out *SeedStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1alpha1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.Orphans = *(*[]SeedOrphan)(unsafe.Pointer(&in.Orphans))
+	out.KubernetesVersion = in.KubernetesVersion
+	out.ProviderHealth = (*SeedProviderHealth)(unsafe.Pointer(in.ProviderHealth))
 	return nil
 }
 
@@ -4440,6 +5927,28 @@ func Convert_garden_SeedStatus_To_v1beta1_SeedStatus(in *garden.SeedStatus, out
 	return autoConvert_garden_SeedStatus_To_v1beta1_SeedStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_ServiceLoadBalancer_To_garden_ServiceLoadBalancer(in *ServiceLoadBalancer, out *garden.ServiceLoadBalancer, s conversion.Scope) error {
+	out.Internal = (*bool)(unsafe.Pointer(in.Internal))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	return nil
+}
+
+// Convert_v1beta1_ServiceLoadBalancer_To_garden_ServiceLoadBalancer is an autogenerated conversion function.
+func Convert_v1beta1_ServiceLoadBalancer_To_garden_ServiceLoadBalancer(in *ServiceLoadBalancer, out *garden.ServiceLoadBalancer, s conversion.Scope) error {
+	return autoConvert_v1beta1_ServiceLoadBalancer_To_garden_ServiceLoadBalancer(in, out, s)
+}
+
+func autoConvert_garden_ServiceLoadBalancer_To_v1beta1_ServiceLoadBalancer(in *garden.ServiceLoadBalancer, out *ServiceLoadBalancer, s conversion.Scope) error {
+	out.Internal = (*bool)(unsafe.Pointer(in.Internal))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	return nil
+}
+
+// Convert_garden_ServiceLoadBalancer_To_v1beta1_ServiceLoadBalancer is an autogenerated conversion function.
+func Convert_garden_ServiceLoadBalancer_To_v1beta1_ServiceLoadBalancer(in *garden.ServiceLoadBalancer, out *ServiceLoadBalancer, s conversion.Scope) error {
+	return autoConvert_garden_ServiceLoadBalancer_To_v1beta1_ServiceLoadBalancer(in, out, s)
+}
+
 func autoConvert_v1beta1_Shoot_To_garden_Shoot(in *Shoot, out *garden.Shoot, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_ShootSpec_To_garden_ShootSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -4472,6 +5981,32 @@ func Convert_garden_Shoot_To_v1beta1_Shoot(in *garden.Shoot, out *Shoot, s conve
 	return autoConvert_garden_Shoot_To_v1beta1_Shoot(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootAccessAudit_To_garden_ShootAccessAudit(in *ShootAccessAudit, out *garden.ShootAccessAudit, s conversion.Scope) error {
+	out.LastCredentialsUseTime = (*metav1.Time)(unsafe.Pointer(in.LastCredentialsUseTime))
+	out.LastKubeconfigReadTime = (*metav1.Time)(unsafe.Pointer(in.LastKubeconfigReadTime))
+	out.LastKubeconfigRotationTime = (*metav1.Time)(unsafe.Pointer(in.LastKubeconfigRotationTime))
+	out.LastSSHKeypairReadTime = (*metav1.Time)(unsafe.Pointer(in.LastSSHKeypairReadTime))
+	return nil
+}
+
+// Convert_v1beta1_ShootAccessAudit_To_garden_ShootAccessAudit is an autogenerated conversion function.
+func Convert_v1beta1_ShootAccessAudit_To_garden_ShootAccessAudit(in *ShootAccessAudit, out *garden.ShootAccessAudit, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootAccessAudit_To_garden_ShootAccessAudit(in, out, s)
+}
+
+func autoConvert_garden_ShootAccessAudit_To_v1beta1_ShootAccessAudit(in *garden.ShootAccessAudit, out *ShootAccessAudit, s conversion.Scope) error {
+	out.LastCredentialsUseTime = (*metav1.Time)(unsafe.Pointer(in.LastCredentialsUseTime))
+	out.LastKubeconfigReadTime = (*metav1.Time)(unsafe.Pointer(in.LastKubeconfigReadTime))
+	out.LastKubeconfigRotationTime = (*metav1.Time)(unsafe.Pointer(in.LastKubeconfigRotationTime))
+	out.LastSSHKeypairReadTime = (*metav1.Time)(unsafe.Pointer(in.LastSSHKeypairReadTime))
+	return nil
+}
+
+// Convert_garden_ShootAccessAudit_To_v1beta1_ShootAccessAudit is an autogenerated conversion function.
+func Convert_garden_ShootAccessAudit_To_v1beta1_ShootAccessAudit(in *garden.ShootAccessAudit, out *ShootAccessAudit, s conversion.Scope) error {
+	return autoConvert_garden_ShootAccessAudit_To_v1beta1_ShootAccessAudit(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootList_To_garden_ShootList(in *ShootList, out *garden.ShootList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -4528,6 +6063,10 @@ func autoConvert_v1beta1_ShootSpec_To_garden_ShootSpec(in *ShootSpec, out *garde
 		return err
 	}
 	out.Maintenance = (*garden.Maintenance)(unsafe.Pointer(in.Maintenance))
+	out.Monitoring = (*garden.Monitoring)(unsafe.Pointer(in.Monitoring))
+	out.SizingProfile = (*garden.SizingProfile)(unsafe.Pointer(in.SizingProfile))
+	out.Storage = (*garden.Storage)(unsafe.Pointer(in.Storage))
+	out.Template = (*garden.ShootTemplateReference)(unsafe.Pointer(in.Template))
 	return nil
 }
 
@@ -4550,6 +6089,10 @@ func autoConvert_garden_ShootSpec_To_v1beta1_ShootSpec(in *garden.ShootSpec, out
 		return err
 	}
 	out.Maintenance = (*Maintenance)(unsafe.Pointer(in.Maintenance))
+	out.Monitoring = (*Monitoring)(unsafe.Pointer(in.Monitoring))
+	out.SizingProfile = (*SizingProfile)(unsafe.Pointer(in.SizingProfile))
+	out.Storage = (*Storage)(unsafe.Pointer(in.Storage))
+	out.Template = (*ShootTemplateReference)(unsafe.Pointer(in.Template))
 	return nil
 }
 
@@ -4560,6 +6103,7 @@ func Convert_garden_ShootSpec_To_v1beta1_ShootSpec(in *garden.ShootSpec, out *Sh
 
 func autoConvert_v1beta1_ShootStatus_To_garden_ShootStatus(in *ShootStatus, out *garden.ShootStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
+	out.Constraints = *(*[]core.Condition)(unsafe.Pointer(&in.Constraints))
 	if err := Convert_v1beta1_Gardener_To_garden_Gardener(&in.Gardener, &out.Gardener, s); err != nil {
 		return err
 	}
@@ -4567,9 +6111,16 @@ func autoConvert_v1beta1_ShootStatus_To_garden_ShootStatus(in *ShootStatus, out
 	out.LastError = (*core.LastError)(unsafe.Pointer(in.LastError))
 	out.ObservedGeneration = in.ObservedGeneration
 	out.RetryCycleStartTime = (*metav1.Time)(unsafe.Pointer(in.RetryCycleStartTime))
+	out.RetryCount = in.RetryCount
 	out.Seed = in.Seed
 	out.TechnicalID = in.TechnicalID
 	out.UID = types.UID(in.UID)
+	out.APIServerSLO = (*garden.APIServerSLO)(unsafe.Pointer(in.APIServerSLO))
+	out.EgressIPs = *(*[]string)(unsafe.Pointer(&in.EgressIPs))
+	out.KubeletVersion = in.KubeletVersion
+	out.ReconciledStateHash = in.ReconciledStateHash
+	out.Hibernated = in.Hibernated
+	out.AccessAudit = (*garden.ShootAccessAudit)(unsafe.Pointer(in.AccessAudit))
 	return nil
 }
 
@@ -4580,6 +6131,7 @@ func Convert_v1beta1_ShootStatus_To_garden_ShootStatus(in *ShootStatus, out *gar
 
 func autoConvert_garden_ShootStatus_To_v1beta1_ShootStatus(in *garden.ShootStatus, out *ShootStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1alpha1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.Constraints = *(*[]v1alpha1.Condition)(unsafe.Pointer(&in.Constraints))
 	if err := Convert_garden_Gardener_To_v1beta1_Gardener(&in.Gardener, &out.Gardener, s); err != nil {
 		return err
 	}
@@ -4587,9 +6139,16 @@ func autoConvert_garden_ShootStatus_To_v1beta1_ShootStatus(in *garden.ShootStatu
 	out.LastError = (*v1alpha1.LastError)(unsafe.Pointer(in.LastError))
 	out.ObservedGeneration = in.ObservedGeneration
 	out.RetryCycleStartTime = (*metav1.Time)(unsafe.Pointer(in.RetryCycleStartTime))
+	out.RetryCount = in.RetryCount
 	out.Seed = in.Seed
 	out.TechnicalID = in.TechnicalID
 	out.UID = types.UID(in.UID)
+	out.APIServerSLO = (*APIServerSLO)(unsafe.Pointer(in.APIServerSLO))
+	out.EgressIPs = *(*[]string)(unsafe.Pointer(&in.EgressIPs))
+	out.KubeletVersion = in.KubeletVersion
+	out.ReconciledStateHash = in.ReconciledStateHash
+	out.Hibernated = in.Hibernated
+	out.AccessAudit = (*ShootAccessAudit)(unsafe.Pointer(in.AccessAudit))
 	return nil
 }
 
@@ -4598,6 +6157,238 @@ func Convert_garden_ShootStatus_To_v1beta1_ShootStatus(in *garden.ShootStatus, o
 	return autoConvert_garden_ShootStatus_To_v1beta1_ShootStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootTemplate_To_garden_ShootTemplate(in *ShootTemplate, out *garden.ShootTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ShootTemplateSpec_To_garden_ShootTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ShootTemplate_To_garden_ShootTemplate is an autogenerated conversion function.
+func Convert_v1beta1_ShootTemplate_To_garden_ShootTemplate(in *ShootTemplate, out *garden.ShootTemplate, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootTemplate_To_garden_ShootTemplate(in, out, s)
+}
+
+func autoConvert_garden_ShootTemplate_To_v1beta1_ShootTemplate(in *garden.ShootTemplate, out *ShootTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_garden_ShootTemplateSpec_To_v1beta1_ShootTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_garden_ShootTemplate_To_v1beta1_ShootTemplate is an autogenerated conversion function.
+func Convert_garden_ShootTemplate_To_v1beta1_ShootTemplate(in *garden.ShootTemplate, out *ShootTemplate, s conversion.Scope) error {
+	return autoConvert_garden_ShootTemplate_To_v1beta1_ShootTemplate(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootTemplateList_To_garden_ShootTemplateList(in *ShootTemplateList, out *garden.ShootTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]garden.ShootTemplate, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_ShootTemplate_To_garden_ShootTemplate(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_ShootTemplateList_To_garden_ShootTemplateList is an autogenerated conversion function.
+func Convert_v1beta1_ShootTemplateList_To_garden_ShootTemplateList(in *ShootTemplateList, out *garden.ShootTemplateList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootTemplateList_To_garden_ShootTemplateList(in, out, s)
+}
+
+func autoConvert_garden_ShootTemplateList_To_v1beta1_ShootTemplateList(in *garden.ShootTemplateList, out *ShootTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ShootTemplate, len(*in))
+		for i := range *in {
+			if err := Convert_garden_ShootTemplate_To_v1beta1_ShootTemplate(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_garden_ShootTemplateList_To_v1beta1_ShootTemplateList is an autogenerated conversion function.
+func Convert_garden_ShootTemplateList_To_v1beta1_ShootTemplateList(in *garden.ShootTemplateList, out *ShootTemplateList, s conversion.Scope) error {
+	return autoConvert_garden_ShootTemplateList_To_v1beta1_ShootTemplateList(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootTemplateReference_To_garden_ShootTemplateReference(in *ShootTemplateReference, out *garden.ShootTemplateReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_v1beta1_ShootTemplateReference_To_garden_ShootTemplateReference is an autogenerated conversion function.
+func Convert_v1beta1_ShootTemplateReference_To_garden_ShootTemplateReference(in *ShootTemplateReference, out *garden.ShootTemplateReference, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootTemplateReference_To_garden_ShootTemplateReference(in, out, s)
+}
+
+func autoConvert_garden_ShootTemplateReference_To_v1beta1_ShootTemplateReference(in *garden.ShootTemplateReference, out *ShootTemplateReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_garden_ShootTemplateReference_To_v1beta1_ShootTemplateReference is an autogenerated conversion function.
+func Convert_garden_ShootTemplateReference_To_v1beta1_ShootTemplateReference(in *garden.ShootTemplateReference, out *ShootTemplateReference, s conversion.Scope) error {
+	return autoConvert_garden_ShootTemplateReference_To_v1beta1_ShootTemplateReference(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootTemplateSpec_To_garden_ShootTemplateSpec(in *ShootTemplateSpec, out *garden.ShootTemplateSpec, s conversion.Scope) error {
+	out.Addons = (*garden.Addons)(unsafe.Pointer(in.Addons))
+	out.Networks = (*core.K8SNetworks)(unsafe.Pointer(in.Networks))
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = make([]garden.ShootTemplateWorker, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_ShootTemplateWorker_To_garden_ShootTemplateWorker(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Workers = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_ShootTemplateSpec_To_garden_ShootTemplateSpec is an autogenerated conversion function.
+func Convert_v1beta1_ShootTemplateSpec_To_garden_ShootTemplateSpec(in *ShootTemplateSpec, out *garden.ShootTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootTemplateSpec_To_garden_ShootTemplateSpec(in, out, s)
+}
+
+func autoConvert_garden_ShootTemplateSpec_To_v1beta1_ShootTemplateSpec(in *garden.ShootTemplateSpec, out *ShootTemplateSpec, s conversion.Scope) error {
+	out.Addons = (*Addons)(unsafe.Pointer(in.Addons))
+	out.Networks = (*v1alpha1.K8SNetworks)(unsafe.Pointer(in.Networks))
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = make([]ShootTemplateWorker, len(*in))
+		for i := range *in {
+			if err := Convert_garden_ShootTemplateWorker_To_v1beta1_ShootTemplateWorker(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Workers = nil
+	}
+	return nil
+}
+
+// Convert_garden_ShootTemplateSpec_To_v1beta1_ShootTemplateSpec is an autogenerated conversion function.
+func Convert_garden_ShootTemplateSpec_To_v1beta1_ShootTemplateSpec(in *garden.ShootTemplateSpec, out *ShootTemplateSpec, s conversion.Scope) error {
+	return autoConvert_garden_ShootTemplateSpec_To_v1beta1_ShootTemplateSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootTemplateWorker_To_garden_ShootTemplateWorker(in *ShootTemplateWorker, out *garden.ShootTemplateWorker, s conversion.Scope) error {
+	if err := Convert_v1beta1_Worker_To_garden_Worker(&in.Worker, &out.Worker, s); err != nil {
+		return err
+	}
+	out.VolumeType = in.VolumeType
+	out.VolumeSize = in.VolumeSize
+	return nil
+}
+
+// Convert_v1beta1_ShootTemplateWorker_To_garden_ShootTemplateWorker is an autogenerated conversion function.
+func Convert_v1beta1_ShootTemplateWorker_To_garden_ShootTemplateWorker(in *ShootTemplateWorker, out *garden.ShootTemplateWorker, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootTemplateWorker_To_garden_ShootTemplateWorker(in, out, s)
+}
+
+func autoConvert_garden_ShootTemplateWorker_To_v1beta1_ShootTemplateWorker(in *garden.ShootTemplateWorker, out *ShootTemplateWorker, s conversion.Scope) error {
+	if err := Convert_garden_Worker_To_v1beta1_Worker(&in.Worker, &out.Worker, s); err != nil {
+		return err
+	}
+	out.VolumeType = in.VolumeType
+	out.VolumeSize = in.VolumeSize
+	return nil
+}
+
+// Convert_garden_ShootTemplateWorker_To_v1beta1_ShootTemplateWorker is an autogenerated conversion function.
+func Convert_garden_ShootTemplateWorker_To_v1beta1_ShootTemplateWorker(in *garden.ShootTemplateWorker, out *ShootTemplateWorker, s conversion.Scope) error {
+	return autoConvert_garden_ShootTemplateWorker_To_v1beta1_ShootTemplateWorker(in, out, s)
+}
+
+func autoConvert_v1beta1_Storage_To_garden_Storage(in *Storage, out *garden.Storage, s conversion.Scope) error {
+	out.Classes = *(*[]garden.StorageClass)(unsafe.Pointer(&in.Classes))
+	return nil
+}
+
+// Convert_v1beta1_Storage_To_garden_Storage is an autogenerated conversion function.
+func Convert_v1beta1_Storage_To_garden_Storage(in *Storage, out *garden.Storage, s conversion.Scope) error {
+	return autoConvert_v1beta1_Storage_To_garden_Storage(in, out, s)
+}
+
+func autoConvert_garden_Storage_To_v1beta1_Storage(in *garden.Storage, out *Storage, s conversion.Scope) error {
+	out.Classes = *(*[]StorageClass)(unsafe.Pointer(&in.Classes))
+	return nil
+}
+
+// Convert_garden_Storage_To_v1beta1_Storage is an autogenerated conversion function.
+func Convert_garden_Storage_To_v1beta1_Storage(in *garden.Storage, out *Storage, s conversion.Scope) error {
+	return autoConvert_garden_Storage_To_v1beta1_Storage(in, out, s)
+}
+
+func autoConvert_v1beta1_StorageClass_To_garden_StorageClass(in *StorageClass, out *garden.StorageClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	out.VolumeType = (*string)(unsafe.Pointer(in.VolumeType))
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.Parameters = *(*map[string]string)(unsafe.Pointer(&in.Parameters))
+	return nil
+}
+
+// Convert_v1beta1_StorageClass_To_garden_StorageClass is an autogenerated conversion function.
+func Convert_v1beta1_StorageClass_To_garden_StorageClass(in *StorageClass, out *garden.StorageClass, s conversion.Scope) error {
+	return autoConvert_v1beta1_StorageClass_To_garden_StorageClass(in, out, s)
+}
+
+func autoConvert_garden_StorageClass_To_v1beta1_StorageClass(in *garden.StorageClass, out *StorageClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	out.VolumeType = (*string)(unsafe.Pointer(in.VolumeType))
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.Parameters = *(*map[string]string)(unsafe.Pointer(&in.Parameters))
+	return nil
+}
+
+// Convert_garden_StorageClass_To_v1beta1_StorageClass is an autogenerated conversion function.
+func Convert_garden_StorageClass_To_v1beta1_StorageClass(in *garden.StorageClass, out *StorageClass, s conversion.Scope) error {
+	return autoConvert_garden_StorageClass_To_v1beta1_StorageClass(in, out, s)
+}
+
+func autoConvert_v1beta1_TerraformerSettings_To_garden_TerraformerSettings(in *TerraformerSettings, out *garden.TerraformerSettings, s conversion.Scope) error {
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_v1beta1_TerraformerSettings_To_garden_TerraformerSettings is an autogenerated conversion function.
+func Convert_v1beta1_TerraformerSettings_To_garden_TerraformerSettings(in *TerraformerSettings, out *garden.TerraformerSettings, s conversion.Scope) error {
+	return autoConvert_v1beta1_TerraformerSettings_To_garden_TerraformerSettings(in, out, s)
+}
+
+func autoConvert_garden_TerraformerSettings_To_v1beta1_TerraformerSettings(in *garden.TerraformerSettings, out *TerraformerSettings, s conversion.Scope) error {
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_garden_TerraformerSettings_To_v1beta1_TerraformerSettings is an autogenerated conversion function.
+func Convert_garden_TerraformerSettings_To_v1beta1_TerraformerSettings(in *garden.TerraformerSettings, out *TerraformerSettings, s conversion.Scope) error {
+	return autoConvert_garden_TerraformerSettings_To_v1beta1_TerraformerSettings(in, out, s)
+}
+
 func autoConvert_v1beta1_VolumeType_To_garden_VolumeType(in *VolumeType, out *garden.VolumeType, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
@@ -4631,7 +6422,17 @@ func autoConvert_v1beta1_Worker_To_garden_Worker(in *Worker, out *garden.Worker,
 	// WARNING: in.MaxUnavailable requires manual conversion: inconvertible types (*k8s.io/apimachinery/pkg/util/intstr.IntOrString vs k8s.io/apimachinery/pkg/util/intstr.IntOrString)
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.InstanceTagLabels = *(*[]string)(unsafe.Pointer(&in.InstanceTagLabels))
 	out.Taints = *(*[]v1.Taint)(unsafe.Pointer(&in.Taints))
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.OSUpdates = (*garden.WorkerOSUpdates)(unsafe.Pointer(in.OSUpdates))
+	out.CustomMachineImage = (*string)(unsafe.Pointer(in.CustomMachineImage))
+	out.MaxPods = (*int32)(unsafe.Pointer(in.MaxPods))
+	out.DataVolumes = *(*[]garden.DataVolume)(unsafe.Pointer(&in.DataVolumes))
+	out.KubeletDataVolumeName = (*string)(unsafe.Pointer(in.KubeletDataVolumeName))
+	out.Kubelet = (*garden.WorkerKubeletConfig)(unsafe.Pointer(in.Kubelet))
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.Capacity = (*garden.WorkerCapacity)(unsafe.Pointer(in.Capacity))
 	return nil
 }
 
@@ -4644,13 +6445,95 @@ func autoConvert_garden_Worker_To_v1beta1_Worker(in *garden.Worker, out *Worker,
 	// WARNING: in.MaxUnavailable requires manual conversion: inconvertible types (k8s.io/apimachinery/pkg/util/intstr.IntOrString vs *k8s.io/apimachinery/pkg/util/intstr.IntOrString)
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.InstanceTagLabels = *(*[]string)(unsafe.Pointer(&in.InstanceTagLabels))
 	out.Taints = *(*[]v1.Taint)(unsafe.Pointer(&in.Taints))
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.OSUpdates = (*WorkerOSUpdates)(unsafe.Pointer(in.OSUpdates))
+	out.CustomMachineImage = (*string)(unsafe.Pointer(in.CustomMachineImage))
+	out.MaxPods = (*int32)(unsafe.Pointer(in.MaxPods))
+	out.DataVolumes = *(*[]DataVolume)(unsafe.Pointer(&in.DataVolumes))
+	out.KubeletDataVolumeName = (*string)(unsafe.Pointer(in.KubeletDataVolumeName))
+	out.Kubelet = (*WorkerKubeletConfig)(unsafe.Pointer(in.Kubelet))
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.Capacity = (*WorkerCapacity)(unsafe.Pointer(in.Capacity))
 	return nil
 }
 
+func autoConvert_v1beta1_WorkerCapacity_To_garden_WorkerCapacity(in *WorkerCapacity, out *garden.WorkerCapacity, s conversion.Scope) error {
+	out.FallbackMachineTypes = *(*[]string)(unsafe.Pointer(&in.FallbackMachineTypes))
+	out.SpotPercentage = (*int32)(unsafe.Pointer(in.SpotPercentage))
+	return nil
+}
+
+// Convert_v1beta1_WorkerCapacity_To_garden_WorkerCapacity is an autogenerated conversion function.
+func Convert_v1beta1_WorkerCapacity_To_garden_WorkerCapacity(in *WorkerCapacity, out *garden.WorkerCapacity, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerCapacity_To_garden_WorkerCapacity(in, out, s)
+}
+
+func autoConvert_garden_WorkerCapacity_To_v1beta1_WorkerCapacity(in *garden.WorkerCapacity, out *WorkerCapacity, s conversion.Scope) error {
+	out.FallbackMachineTypes = *(*[]string)(unsafe.Pointer(&in.FallbackMachineTypes))
+	out.SpotPercentage = (*int32)(unsafe.Pointer(in.SpotPercentage))
+	return nil
+}
+
+// Convert_garden_WorkerCapacity_To_v1beta1_WorkerCapacity is an autogenerated conversion function.
+func Convert_garden_WorkerCapacity_To_v1beta1_WorkerCapacity(in *garden.WorkerCapacity, out *WorkerCapacity, s conversion.Scope) error {
+	return autoConvert_garden_WorkerCapacity_To_v1beta1_WorkerCapacity(in, out, s)
+}
+
+func autoConvert_v1beta1_WorkerKubeletConfig_To_garden_WorkerKubeletConfig(in *WorkerKubeletConfig, out *garden.WorkerKubeletConfig, s conversion.Scope) error {
+	out.ImageGCHighThresholdPercent = (*int32)(unsafe.Pointer(in.ImageGCHighThresholdPercent))
+	out.ImageGCLowThresholdPercent = (*int32)(unsafe.Pointer(in.ImageGCLowThresholdPercent))
+	out.ContainerLogMaxSize = (*resource.Quantity)(unsafe.Pointer(in.ContainerLogMaxSize))
+	out.ContainerLogMaxFiles = (*int32)(unsafe.Pointer(in.ContainerLogMaxFiles))
+	return nil
+}
+
+// Convert_v1beta1_WorkerKubeletConfig_To_garden_WorkerKubeletConfig is an autogenerated conversion function.
+func Convert_v1beta1_WorkerKubeletConfig_To_garden_WorkerKubeletConfig(in *WorkerKubeletConfig, out *garden.WorkerKubeletConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerKubeletConfig_To_garden_WorkerKubeletConfig(in, out, s)
+}
+
+func autoConvert_garden_WorkerKubeletConfig_To_v1beta1_WorkerKubeletConfig(in *garden.WorkerKubeletConfig, out *WorkerKubeletConfig, s conversion.Scope) error {
+	out.ImageGCHighThresholdPercent = (*int32)(unsafe.Pointer(in.ImageGCHighThresholdPercent))
+	out.ImageGCLowThresholdPercent = (*int32)(unsafe.Pointer(in.ImageGCLowThresholdPercent))
+	out.ContainerLogMaxSize = (*resource.Quantity)(unsafe.Pointer(in.ContainerLogMaxSize))
+	out.ContainerLogMaxFiles = (*int32)(unsafe.Pointer(in.ContainerLogMaxFiles))
+	return nil
+}
+
+// Convert_garden_WorkerKubeletConfig_To_v1beta1_WorkerKubeletConfig is an autogenerated conversion function.
+func Convert_garden_WorkerKubeletConfig_To_v1beta1_WorkerKubeletConfig(in *garden.WorkerKubeletConfig, out *WorkerKubeletConfig, s conversion.Scope) error {
+	return autoConvert_garden_WorkerKubeletConfig_To_v1beta1_WorkerKubeletConfig(in, out, s)
+}
+
+func autoConvert_v1beta1_WorkerOSUpdates_To_garden_WorkerOSUpdates(in *WorkerOSUpdates, out *garden.WorkerOSUpdates, s conversion.Scope) error {
+	out.Channel = garden.WorkerOSUpdateChannel(in.Channel)
+	out.RebootWindow = (*garden.MaintenanceTimeWindow)(unsafe.Pointer(in.RebootWindow))
+	return nil
+}
+
+// Convert_v1beta1_WorkerOSUpdates_To_garden_WorkerOSUpdates is an autogenerated conversion function.
+func Convert_v1beta1_WorkerOSUpdates_To_garden_WorkerOSUpdates(in *WorkerOSUpdates, out *garden.WorkerOSUpdates, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerOSUpdates_To_garden_WorkerOSUpdates(in, out, s)
+}
+
+func autoConvert_garden_WorkerOSUpdates_To_v1beta1_WorkerOSUpdates(in *garden.WorkerOSUpdates, out *WorkerOSUpdates, s conversion.Scope) error {
+	out.Channel = WorkerOSUpdateChannel(in.Channel)
+	out.RebootWindow = (*MaintenanceTimeWindow)(unsafe.Pointer(in.RebootWindow))
+	return nil
+}
+
+// Convert_garden_WorkerOSUpdates_To_v1beta1_WorkerOSUpdates is an autogenerated conversion function.
+func Convert_garden_WorkerOSUpdates_To_v1beta1_WorkerOSUpdates(in *garden.WorkerOSUpdates, out *WorkerOSUpdates, s conversion.Scope) error {
+	return autoConvert_garden_WorkerOSUpdates_To_v1beta1_WorkerOSUpdates(in, out, s)
+}
+
 func autoConvert_v1beta1_Zone_To_garden_Zone(in *Zone, out *garden.Zone, s conversion.Scope) error {
 	out.Region = in.Region
 	out.Names = *(*[]string)(unsafe.Pointer(&in.Names))
+	out.UnavailableMachineTypes = *(*[]string)(unsafe.Pointer(&in.UnavailableMachineTypes))
+	out.UnavailableVolumeTypes = *(*[]string)(unsafe.Pointer(&in.UnavailableVolumeTypes))
 	return nil
 }
 
@@ -4662,6 +6545,8 @@ func Convert_v1beta1_Zone_To_garden_Zone(in *Zone, out *garden.Zone, s conversio
 func autoConvert_garden_Zone_To_v1beta1_Zone(in *garden.Zone, out *Zone, s conversion.Scope) error {
 	out.Region = in.Region
 	out.Names = *(*[]string)(unsafe.Pointer(&in.Names))
+	out.UnavailableMachineTypes = *(*[]string)(unsafe.Pointer(&in.UnavailableMachineTypes))
+	out.UnavailableVolumeTypes = *(*[]string)(unsafe.Pointer(&in.UnavailableVolumeTypes))
 	return nil
 }
 
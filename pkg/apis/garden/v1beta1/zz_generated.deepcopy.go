@@ -24,10 +24,49 @@ import (
 	v1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerSLO) DeepCopyInto(out *APIServerSLO) {
+	*out = *in
+	if in.Samples != nil {
+		in, out := &in.Samples, &out.Samples
+		*out = make([]APIServerSLOSample, len(*in))
+		copy(*out, *in)
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerSLO.
+func (in *APIServerSLO) DeepCopy() *APIServerSLO {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerSLO)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerSLOSample) DeepCopyInto(out *APIServerSLOSample) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerSLOSample.
+func (in *APIServerSLOSample) DeepCopy() *APIServerSLOSample {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerSLOSample)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSCloud) DeepCopyInto(out *AWSCloud) {
 	*out = *in
@@ -49,6 +88,11 @@ func (in *AWSCloud) DeepCopyInto(out *AWSCloud) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ServiceLoadBalancer != nil {
+		in, out := &in.ServiceLoadBalancer, &out.ServiceLoadBalancer
+		*out = new(AWSServiceLoadBalancer)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -134,7 +178,9 @@ func (in *AWSMachineImageMapping) DeepCopyInto(out *AWSMachineImageMapping) {
 	if in.Regions != nil {
 		in, out := &in.Regions, &out.Regions
 		*out = make([]AWSRegionalMachineImage, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -169,6 +215,18 @@ func (in *AWSNetworks) DeepCopyInto(out *AWSNetworks) {
 		*out = make([]v1alpha1.CIDR, len(*in))
 		copy(*out, *in)
 	}
+	if in.ExistingSubnets != nil {
+		in, out := &in.ExistingSubnets, &out.ExistingSubnets
+		*out = make([]AWSZoneSubnets, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExistingNATGateways != nil {
+		in, out := &in.ExistingNATGateways, &out.ExistingNATGateways
+		*out = make([]AWSZoneNATGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -202,6 +260,11 @@ func (in *AWSProfile) DeepCopy() *AWSProfile {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSRegionalMachineImage) DeepCopyInto(out *AWSRegionalMachineImage) {
 	*out = *in
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -215,6 +278,27 @@ func (in *AWSRegionalMachineImage) DeepCopy() *AWSRegionalMachineImage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSServiceLoadBalancer) DeepCopyInto(out *AWSServiceLoadBalancer) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSServiceLoadBalancer.
+func (in *AWSServiceLoadBalancer) DeepCopy() *AWSServiceLoadBalancer {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSServiceLoadBalancer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSVPC) DeepCopyInto(out *AWSVPC) {
 	*out = *in
@@ -228,6 +312,11 @@ func (in *AWSVPC) DeepCopyInto(out *AWSVPC) {
 		*out = new(v1alpha1.CIDR)
 		**out = **in
 	}
+	if in.InternetGatewayID != nil {
+		in, out := &in.InternetGatewayID, &out.InternetGatewayID
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -245,6 +334,16 @@ func (in *AWSVPC) DeepCopy() *AWSVPC {
 func (in *AWSWorker) DeepCopyInto(out *AWSWorker) {
 	*out = *in
 	in.Worker.DeepCopyInto(&out.Worker)
+	if in.VolumeIOPS != nil {
+		in, out := &in.VolumeIOPS, &out.VolumeIOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.VolumeThroughput != nil {
+		in, out := &in.VolumeThroughput, &out.VolumeThroughput
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -258,6 +357,43 @@ func (in *AWSWorker) DeepCopy() *AWSWorker {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSZoneNATGateway) DeepCopyInto(out *AWSZoneNATGateway) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSZoneNATGateway.
+func (in *AWSZoneNATGateway) DeepCopy() *AWSZoneNATGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSZoneNATGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSZoneSubnets) DeepCopyInto(out *AWSZoneSubnets) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSZoneSubnets.
+func (in *AWSZoneSubnets) DeepCopy() *AWSZoneSubnets {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSZoneSubnets)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Addon) DeepCopyInto(out *Addon) {
 	*out = *in
@@ -349,6 +485,11 @@ func (in *AdmissionPlugin) DeepCopy() *AdmissionPlugin {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Alicloud) DeepCopyInto(out *Alicloud) {
 	*out = *in
+	if in.APIServerLoadBalancer != nil {
+		in, out := &in.APIServerLoadBalancer, &out.APIServerLoadBalancer
+		*out = new(AlicloudLoadBalancer)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MachineImage != nil {
 		in, out := &in.MachineImage, &out.MachineImage
 		*out = new(AlicloudMachineImage)
@@ -367,6 +508,11 @@ func (in *Alicloud) DeepCopyInto(out *Alicloud) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ServiceLoadBalancer != nil {
+		in, out := &in.ServiceLoadBalancer, &out.ServiceLoadBalancer
+		*out = new(AlicloudLoadBalancer)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -428,6 +574,37 @@ func (in *AlicloudConstraints) DeepCopy() *AlicloudConstraints {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlicloudLoadBalancer) DeepCopyInto(out *AlicloudLoadBalancer) {
+	*out = *in
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(string)
+		**out = **in
+	}
+	if in.AddressType != nil {
+		in, out := &in.AddressType, &out.AddressType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlicloudLoadBalancer.
+func (in *AlicloudLoadBalancer) DeepCopy() *AlicloudLoadBalancer {
+	if in == nil {
+		return nil
+	}
+	out := new(AlicloudLoadBalancer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlicloudMachineImage) DeepCopyInto(out *AlicloudMachineImage) {
 	*out = *in
@@ -466,6 +643,65 @@ func (in *AlicloudMachineType) DeepCopy() *AlicloudMachineType {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlicloudNatGateway) DeepCopyInto(out *AlicloudNatGateway) {
+	*out = *in
+	if in.EIPBandwidth != nil {
+		in, out := &in.EIPBandwidth, &out.EIPBandwidth
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EIPInternetChargeType != nil {
+		in, out := &in.EIPInternetChargeType, &out.EIPInternetChargeType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]AlicloudNatGatewayZone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlicloudNatGateway.
+func (in *AlicloudNatGateway) DeepCopy() *AlicloudNatGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(AlicloudNatGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlicloudNatGatewayZone) DeepCopyInto(out *AlicloudNatGatewayZone) {
+	*out = *in
+	if in.EIPBandwidth != nil {
+		in, out := &in.EIPBandwidth, &out.EIPBandwidth
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EIPInternetChargeType != nil {
+		in, out := &in.EIPInternetChargeType, &out.EIPInternetChargeType
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlicloudNatGatewayZone.
+func (in *AlicloudNatGatewayZone) DeepCopy() *AlicloudNatGatewayZone {
+	if in == nil {
+		return nil
+	}
+	out := new(AlicloudNatGatewayZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlicloudNetworks) DeepCopyInto(out *AlicloudNetworks) {
 	*out = *in
@@ -476,6 +712,11 @@ func (in *AlicloudNetworks) DeepCopyInto(out *AlicloudNetworks) {
 		*out = make([]v1alpha1.CIDR, len(*in))
 		copy(*out, *in)
 	}
+	if in.NatGateway != nil {
+		in, out := &in.NatGateway, &out.NatGateway
+		*out = new(AlicloudNatGateway)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -613,6 +854,32 @@ func (in *AuditPolicy) DeepCopy() *AuditPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationWebhook) DeepCopyInto(out *AuthenticationWebhook) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = new(string)
+		**out = **in
+	}
+	if in.CacheTTL != nil {
+		in, out := &in.CacheTTL, &out.CacheTTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationWebhook.
+func (in *AuthenticationWebhook) DeepCopy() *AuthenticationWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureCloud) DeepCopyInto(out *AzureCloud) {
 	*out = *in
@@ -794,6 +1061,11 @@ func (in *AzureVNet) DeepCopyInto(out *AzureVNet) {
 		*out = new(v1alpha1.CIDR)
 		**out = **in
 	}
+	if in.Peering != nil {
+		in, out := &in.Peering, &out.Peering
+		*out = new(AzureVNetPeering)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -807,6 +1079,27 @@ func (in *AzureVNet) DeepCopy() *AzureVNet {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureVNetPeering) DeepCopyInto(out *AzureVNetPeering) {
+	*out = *in
+	if in.RemoteCIDRs != nil {
+		in, out := &in.RemoteCIDRs, &out.RemoteCIDRs
+		*out = make([]v1alpha1.CIDR, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVNetPeering.
+func (in *AzureVNetPeering) DeepCopy() *AzureVNetPeering {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureVNetPeering)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureWorker) DeepCopyInto(out *AzureWorker) {
 	*out = *in
@@ -845,7 +1138,7 @@ func (in *BackupInfrastructure) DeepCopyInto(out *BackupInfrastructure) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -904,6 +1197,11 @@ func (in *BackupInfrastructureList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupInfrastructureSpec) DeepCopyInto(out *BackupInfrastructureSpec) {
 	*out = *in
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -930,6 +1228,13 @@ func (in *BackupInfrastructureStatus) DeepCopyInto(out *BackupInfrastructureStat
 		*out = new(v1alpha1.LastError)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1alpha1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -952,6 +1257,18 @@ func (in *Cloud) DeepCopyInto(out *Cloud) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ServiceLoadBalancer != nil {
+		in, out := &in.ServiceLoadBalancer, &out.ServiceLoadBalancer
+		*out = new(ServiceLoadBalancer)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AWS != nil {
 		in, out := &in.AWS, &out.AWS
 		*out = new(AWSCloud)
@@ -1120,6 +1437,16 @@ func (in *CloudProfileSpec) DeepCopyInto(out *CloudProfileSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Terraformer != nil {
+		in, out := &in.Terraformer, &out.Terraformer
+		*out = new(TerraformerSettings)
+		**out = **in
+	}
+	if in.CustomMachineImages != nil {
+		in, out := &in.CustomMachineImages, &out.CustomMachineImages
+		*out = new(CustomMachineImages)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1150,6 +1477,27 @@ func (in *ClusterAutoscaler) DeepCopy() *ClusterAutoscaler {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomMachineImages) DeepCopyInto(out *CustomMachineImages) {
+	*out = *in
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomMachineImages.
+func (in *CustomMachineImages) DeepCopy() *CustomMachineImages {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomMachineImages)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNS) DeepCopyInto(out *DNS) {
 	*out = *in
@@ -1173,6 +1521,11 @@ func (in *DNS) DeepCopyInto(out *DNS) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -1203,30 +1556,138 @@ func (in *DNSProviderConstraint) DeepCopy() *DNSProviderConstraint {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GCPCloud) DeepCopyInto(out *GCPCloud) {
+func (in *DataVolume) DeepCopyInto(out *DataVolume) {
 	*out = *in
-	if in.MachineImage != nil {
-		in, out := &in.MachineImage, &out.MachineImage
-		*out = new(GCPMachineImage)
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
 		**out = **in
 	}
-	in.Networks.DeepCopyInto(&out.Networks)
-	if in.Workers != nil {
-		in, out := &in.Workers, &out.Workers
-		*out = make([]GCPWorker, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Zones != nil {
-		in, out := &in.Zones, &out.Zones
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Encrypted != nil {
+		in, out := &in.Encrypted, &out.Encrypted
+		*out = new(bool)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPCloud.
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataVolume.
+func (in *DataVolume) DeepCopy() *DataVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(DataVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeletionProtectionPolicy) DeepCopyInto(out *DeletionProtectionPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeletionProtectionPolicy.
+func (in *DeletionProtectionPolicy) DeepCopy() *DeletionProtectionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DeletionProtectionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeletionProtectionPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeletionProtectionPolicyList) DeepCopyInto(out *DeletionProtectionPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeletionProtectionPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeletionProtectionPolicyList.
+func (in *DeletionProtectionPolicyList) DeepCopy() *DeletionProtectionPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeletionProtectionPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeletionProtectionPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeletionProtectionPolicySpec) DeepCopyInto(out *DeletionProtectionPolicySpec) {
+	*out = *in
+	in.ShootSelector.DeepCopyInto(&out.ShootSelector)
+	if in.BackupRetention != nil {
+		in, out := &in.BackupRetention, &out.BackupRetention
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeletionProtectionPolicySpec.
+func (in *DeletionProtectionPolicySpec) DeepCopy() *DeletionProtectionPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeletionProtectionPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPCloud) DeepCopyInto(out *GCPCloud) {
+	*out = *in
+	if in.MachineImage != nil {
+		in, out := &in.MachineImage, &out.MachineImage
+		*out = new(GCPMachineImage)
+		**out = **in
+	}
+	in.Networks.DeepCopyInto(&out.Networks)
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = make([]GCPWorker, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPCloud.
 func (in *GCPCloud) DeepCopy() *GCPCloud {
 	if in == nil {
 		return nil
@@ -1236,6 +1697,53 @@ func (in *GCPCloud) DeepCopy() *GCPCloud {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPCloudNAT) DeepCopyInto(out *GCPCloudNAT) {
+	*out = *in
+	if in.MinPortsPerVM != nil {
+		in, out := &in.MinPortsPerVM, &out.MinPortsPerVM
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NatIPNames != nil {
+		in, out := &in.NatIPNames, &out.NatIPNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(GCPCloudNATLogging)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPCloudNAT.
+func (in *GCPCloudNAT) DeepCopy() *GCPCloudNAT {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPCloudNAT)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPCloudNATLogging) DeepCopyInto(out *GCPCloudNATLogging) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPCloudNATLogging.
+func (in *GCPCloudNATLogging) DeepCopy() *GCPCloudNATLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPCloudNATLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GCPConstraints) DeepCopyInto(out *GCPConstraints) {
 	*out = *in
@@ -1319,6 +1827,11 @@ func (in *GCPNetworks) DeepCopyInto(out *GCPNetworks) {
 		*out = new(v1alpha1.CIDR)
 		**out = **in
 	}
+	if in.CloudNAT != nil {
+		in, out := &in.CloudNAT, &out.CloudNAT
+		*out = new(GCPCloudNAT)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1619,6 +2132,21 @@ func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 		*out = new(AuditConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AllowedCIDRs != nil {
+		in, out := &in.AllowedCIDRs, &out.AllowedCIDRs
+		*out = make([]v1alpha1.CIDR, len(*in))
+		copy(*out, *in)
+	}
+	if in.EnableBasicAuthentication != nil {
+		in, out := &in.EnableBasicAuthentication, &out.EnableBasicAuthentication
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AuthenticationWebhook != nil {
+		in, out := &in.AuthenticationWebhook, &out.AuthenticationWebhook
+		*out = new(AuthenticationWebhook)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1641,6 +2169,21 @@ func (in *KubeControllerManagerConfig) DeepCopyInto(out *KubeControllerManagerCo
 		*out = new(HorizontalPodAutoscalerConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NodeCIDRMaskSize != nil {
+		in, out := &in.NodeCIDRMaskSize, &out.NodeCIDRMaskSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.NodeMonitorGracePeriod != nil {
+		in, out := &in.NodeMonitorGracePeriod, &out.NodeMonitorGracePeriod
+		*out = new(GardenerDuration)
+		**out = **in
+	}
+	if in.PodEvictionTimeout != nil {
+		in, out := &in.PodEvictionTimeout, &out.PodEvictionTimeout
+		*out = new(GardenerDuration)
+		**out = **in
+	}
 	return
 }
 
@@ -1963,6 +2506,11 @@ func (in *MachineType) DeepCopyInto(out *MachineType) {
 	out.CPU = in.CPU.DeepCopy()
 	out.GPU = in.GPU.DeepCopy()
 	out.Memory = in.Memory.DeepCopy()
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -1989,6 +2537,11 @@ func (in *Maintenance) DeepCopyInto(out *Maintenance) {
 		*out = new(MaintenanceTimeWindow)
 		**out = **in
 	}
+	if in.ConfineSpecUpdateRollout != nil {
+		in, out := &in.ConfineSpecUpdateRollout, &out.ConfineSpecUpdateRollout
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -2034,6 +2587,32 @@ func (in *MaintenanceTimeWindow) DeepCopy() *MaintenanceTimeWindow {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Monitoring) DeepCopyInto(out *Monitoring) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ScrapeTargets != nil {
+		in, out := &in.ScrapeTargets, &out.ScrapeTargets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitoring.
+func (in *Monitoring) DeepCopy() *Monitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(Monitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Monocular) DeepCopyInto(out *Monocular) {
 	*out = *in
@@ -2139,6 +2718,11 @@ func (in *OIDCConfig) DeepCopy() *OIDCConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenStackCloud) DeepCopyInto(out *OpenStackCloud) {
 	*out = *in
+	if in.FloatingPoolSubnetName != nil {
+		in, out := &in.FloatingPoolSubnetName, &out.FloatingPoolSubnetName
+		*out = new(string)
+		**out = **in
+	}
 	if in.MachineImage != nil {
 		in, out := &in.MachineImage, &out.MachineImage
 		*out = new(OpenStackMachineImage)
@@ -2333,6 +2917,11 @@ func (in *OpenStackProfile) DeepCopyInto(out *OpenStackProfile) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.NetworkMTU != nil {
+		in, out := &in.NetworkMTU, &out.NetworkMTU
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -2556,6 +3145,27 @@ func (in *Project) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectCloudProfile) DeepCopyInto(out *ProjectCloudProfile) {
+	*out = *in
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectCloudProfile.
+func (in *ProjectCloudProfile) DeepCopy() *ProjectCloudProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectCloudProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectList) DeepCopyInto(out *ProjectList) {
 	*out = *in
@@ -2589,6 +3199,39 @@ func (in *ProjectList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectRestrictions) DeepCopyInto(out *ProjectRestrictions) {
+	*out = *in
+	if in.CloudProfiles != nil {
+		in, out := &in.CloudProfiles, &out.CloudProfiles
+		*out = make([]ProjectCloudProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]v1.SecretReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultSecretBinding != nil {
+		in, out := &in.DefaultSecretBinding, &out.DefaultSecretBinding
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectRestrictions.
+func (in *ProjectRestrictions) DeepCopy() *ProjectRestrictions {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectRestrictions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 	*out = *in
@@ -2622,6 +3265,11 @@ func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Restrictions != nil {
+		in, out := &in.Restrictions, &out.Restrictions
+		*out = new(ProjectRestrictions)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2745,11 +3393,21 @@ func (in *SecretBinding) DeepCopyInto(out *SecretBinding) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	out.SecretRef = in.SecretRef
+	if in.VaultRef != nil {
+		in, out := &in.VaultRef, &out.VaultRef
+		*out = new(SecretBindingVaultReference)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Quotas != nil {
 		in, out := &in.Quotas, &out.Quotas
 		*out = make([]v1.ObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(SecretBindingRotation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2805,61 +3463,223 @@ func (in *SecretBindingList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Seed) DeepCopyInto(out *Seed) {
+func (in *SecretBindingRotation) DeepCopyInto(out *SecretBindingRotation) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.SecretRef = in.SecretRef
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(SecretBindingRotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Seed.
-func (in *Seed) DeepCopy() *Seed {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretBindingRotation.
+func (in *SecretBindingRotation) DeepCopy() *SecretBindingRotation {
 	if in == nil {
 		return nil
 	}
-	out := new(Seed)
+	out := new(SecretBindingRotation)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Seed) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedCloud) DeepCopyInto(out *SeedCloud) {
+func (in *SecretBindingRotationStatus) DeepCopyInto(out *SecretBindingRotationStatus) {
 	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	if in.RotatedShoots != nil {
+		in, out := &in.RotatedShoots, &out.RotatedShoots
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PreviousSecretRef != nil {
+		in, out := &in.PreviousSecretRef, &out.PreviousSecretRef
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedCloud.
-func (in *SeedCloud) DeepCopy() *SeedCloud {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretBindingRotationStatus.
+func (in *SecretBindingRotationStatus) DeepCopy() *SecretBindingRotationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedCloud)
+	out := new(SecretBindingRotationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedList) DeepCopyInto(out *SeedList) {
+func (in *SecretBindingVaultReference) DeepCopyInto(out *SecretBindingVaultReference) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	out.ListMeta = in.ListMeta
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Seed, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	if in.KeyMapping != nil {
+		in, out := &in.KeyMapping, &out.KeyMapping
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretBindingVaultReference.
+func (in *SecretBindingVaultReference) DeepCopy() *SecretBindingVaultReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretBindingVaultReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Seed) DeepCopyInto(out *Seed) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Seed.
+func (in *Seed) DeepCopy() *Seed {
+	if in == nil {
+		return nil
+	}
+	out := new(Seed)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Seed) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedBackup) DeepCopyInto(out *SeedBackup) {
+	*out = *in
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.Provider != nil {
+		in, out := &in.Provider, &out.Provider
+		*out = new(CloudProvider)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedBackup.
+func (in *SeedBackup) DeepCopy() *SeedBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedCloud) DeepCopyInto(out *SeedCloud) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedCloud.
+func (in *SeedCloud) DeepCopy() *SeedCloud {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedCloud)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedDashboardOIDC) DeepCopyInto(out *SeedDashboardOIDC) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedDashboardOIDC.
+func (in *SeedDashboardOIDC) DeepCopy() *SeedDashboardOIDC {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedDashboardOIDC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedIngressACME) DeepCopyInto(out *SeedIngressACME) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedIngressACME.
+func (in *SeedIngressACME) DeepCopy() *SeedIngressACME {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedIngressACME)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedIngressTLS) DeepCopyInto(out *SeedIngressTLS) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
+	if in.ACME != nil {
+		in, out := &in.ACME, &out.ACME
+		*out = new(SeedIngressACME)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedIngressTLS.
+func (in *SeedIngressTLS) DeepCopy() *SeedIngressTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedIngressTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedList) DeepCopyInto(out *SeedList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Seed, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2897,10 +3717,262 @@ func (in *SeedNetworks) DeepCopy() *SeedNetworks {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedOrphan) DeepCopyInto(out *SeedOrphan) {
+	*out = *in
+	in.DetectionTime.DeepCopyInto(&out.DetectionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedOrphan.
+func (in *SeedOrphan) DeepCopy() *SeedOrphan {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedOrphan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedProviderHealth) DeepCopyInto(out *SeedProviderHealth) {
+	*out = *in
+	in.LastCheckTime.DeepCopyInto(&out.LastCheckTime)
+	if in.Quotas != nil {
+		in, out := &in.Quotas, &out.Quotas
+		*out = make([]SeedProviderQuota, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedProviderHealth.
+func (in *SeedProviderHealth) DeepCopy() *SeedProviderHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedProviderHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedProviderQuota) DeepCopyInto(out *SeedProviderQuota) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedProviderQuota.
+func (in *SeedProviderQuota) DeepCopy() *SeedProviderQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedProviderQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedSettingDashboardAuthentication) DeepCopyInto(out *SeedSettingDashboardAuthentication) {
+	*out = *in
+	if in.OIDC != nil {
+		in, out := &in.OIDC, &out.OIDC
+		*out = new(SeedDashboardOIDC)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingDashboardAuthentication.
+func (in *SeedSettingDashboardAuthentication) DeepCopy() *SeedSettingDashboardAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedSettingDashboardAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedSettingExcessCapacityReservation) DeepCopyInto(out *SeedSettingExcessCapacityReservation) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingExcessCapacityReservation.
+func (in *SeedSettingExcessCapacityReservation) DeepCopy() *SeedSettingExcessCapacityReservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedSettingExcessCapacityReservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedSettingLoadBalancerServices) DeepCopyInto(out *SeedSettingLoadBalancerServices) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingLoadBalancerServices.
+func (in *SeedSettingLoadBalancerServices) DeepCopy() *SeedSettingLoadBalancerServices {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedSettingLoadBalancerServices)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedSettingNetworkPolicies) DeepCopyInto(out *SeedSettingNetworkPolicies) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingNetworkPolicies.
+func (in *SeedSettingNetworkPolicies) DeepCopy() *SeedSettingNetworkPolicies {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedSettingNetworkPolicies)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedSettingScheduling) DeepCopyInto(out *SeedSettingScheduling) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingScheduling.
+func (in *SeedSettingScheduling) DeepCopy() *SeedSettingScheduling {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedSettingScheduling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedSettingShootDNS) DeepCopyInto(out *SeedSettingShootDNS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingShootDNS.
+func (in *SeedSettingShootDNS) DeepCopy() *SeedSettingShootDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedSettingShootDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedSettingVerticalPodAutoscaler) DeepCopyInto(out *SeedSettingVerticalPodAutoscaler) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingVerticalPodAutoscaler.
+func (in *SeedSettingVerticalPodAutoscaler) DeepCopy() *SeedSettingVerticalPodAutoscaler {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedSettingVerticalPodAutoscaler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedSettings) DeepCopyInto(out *SeedSettings) {
+	*out = *in
+	if in.NetworkPolicies != nil {
+		in, out := &in.NetworkPolicies, &out.NetworkPolicies
+		*out = new(SeedSettingNetworkPolicies)
+		**out = **in
+	}
+	if in.DashboardAuthentication != nil {
+		in, out := &in.DashboardAuthentication, &out.DashboardAuthentication
+		*out = new(SeedSettingDashboardAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExcessCapacityReservation != nil {
+		in, out := &in.ExcessCapacityReservation, &out.ExcessCapacityReservation
+		*out = new(SeedSettingExcessCapacityReservation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShootDNS != nil {
+		in, out := &in.ShootDNS, &out.ShootDNS
+		*out = new(SeedSettingShootDNS)
+		**out = **in
+	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SeedSettingScheduling)
+		**out = **in
+	}
+	if in.VerticalPodAutoscaler != nil {
+		in, out := &in.VerticalPodAutoscaler, &out.VerticalPodAutoscaler
+		*out = new(SeedSettingVerticalPodAutoscaler)
+		**out = **in
+	}
+	if in.LoadBalancerServices != nil {
+		in, out := &in.LoadBalancerServices, &out.LoadBalancerServices
+		*out = new(SeedSettingLoadBalancerServices)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Terraformer != nil {
+		in, out := &in.Terraformer, &out.Terraformer
+		*out = new(TerraformerSettings)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettings.
+func (in *SeedSettings) DeepCopy() *SeedSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedSpec) DeepCopyInto(out *SeedSpec) {
 	*out = *in
 	out.Cloud = in.Cloud
+	if in.IngressTLS != nil {
+		in, out := &in.IngressTLS, &out.IngressTLS
+		*out = new(SeedIngressTLS)
+		(*in).DeepCopyInto(*out)
+	}
 	out.SecretRef = in.SecretRef
 	out.Networks = in.Networks
 	if in.Visible != nil {
@@ -2908,26 +3980,251 @@ func (in *SeedSpec) DeepCopyInto(out *SeedSpec) {
 		*out = new(bool)
 		**out = **in
 	}
-	if in.Protected != nil {
-		in, out := &in.Protected, &out.Protected
-		*out = new(bool)
+	if in.Protected != nil {
+		in, out := &in.Protected, &out.Protected
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = new(SeedSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(SeedBackup)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSpec.
+func (in *SeedSpec) DeepCopy() *SeedSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedStatus) DeepCopyInto(out *SeedStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1alpha1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Orphans != nil {
+		in, out := &in.Orphans, &out.Orphans
+		*out = make([]SeedOrphan, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ProviderHealth != nil {
+		in, out := &in.ProviderHealth, &out.ProviderHealth
+		*out = new(SeedProviderHealth)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedStatus.
+func (in *SeedStatus) DeepCopy() *SeedStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLoadBalancer) DeepCopyInto(out *ServiceLoadBalancer) {
+	*out = *in
+	if in.Internal != nil {
+		in, out := &in.Internal, &out.Internal
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLoadBalancer.
+func (in *ServiceLoadBalancer) DeepCopy() *ServiceLoadBalancer {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceLoadBalancer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Shoot) DeepCopyInto(out *Shoot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Shoot.
+func (in *Shoot) DeepCopy() *Shoot {
+	if in == nil {
+		return nil
+	}
+	out := new(Shoot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Shoot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootAccessAudit) DeepCopyInto(out *ShootAccessAudit) {
+	*out = *in
+	if in.LastCredentialsUseTime != nil {
+		in, out := &in.LastCredentialsUseTime, &out.LastCredentialsUseTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastKubeconfigReadTime != nil {
+		in, out := &in.LastKubeconfigReadTime, &out.LastKubeconfigReadTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastKubeconfigRotationTime != nil {
+		in, out := &in.LastKubeconfigRotationTime, &out.LastKubeconfigRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSSHKeypairReadTime != nil {
+		in, out := &in.LastSSHKeypairReadTime, &out.LastSSHKeypairReadTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootAccessAudit.
+func (in *ShootAccessAudit) DeepCopy() *ShootAccessAudit {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootAccessAudit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootList) DeepCopyInto(out *ShootList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Shoot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootList.
+func (in *ShootList) DeepCopy() *ShootList {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
+	*out = *in
+	if in.Addons != nil {
+		in, out := &in.Addons, &out.Addons
+		*out = new(Addons)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(Backup)
+		**out = **in
+	}
+	in.Cloud.DeepCopyInto(&out.Cloud)
+	in.DNS.DeepCopyInto(&out.DNS)
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(Hibernation)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Kubernetes.DeepCopyInto(&out.Kubernetes)
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(Maintenance)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(Monitoring)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SizingProfile != nil {
+		in, out := &in.SizingProfile, &out.SizingProfile
+		*out = new(SizingProfile)
+		**out = **in
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(Storage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(ShootTemplateReference)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSpec.
-func (in *SeedSpec) DeepCopy() *SeedSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootSpec.
+func (in *ShootSpec) DeepCopy() *ShootSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedSpec)
+	out := new(ShootSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedStatus) DeepCopyInto(out *SeedStatus) {
+func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -2936,41 +4233,77 @@ func (in *SeedStatus) DeepCopyInto(out *SeedStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Constraints != nil {
+		in, out := &in.Constraints, &out.Constraints
+		*out = make([]v1alpha1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.Gardener = in.Gardener
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1alpha1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(v1alpha1.LastError)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryCycleStartTime != nil {
+		in, out := &in.RetryCycleStartTime, &out.RetryCycleStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.APIServerSLO != nil {
+		in, out := &in.APIServerSLO, &out.APIServerSLO
+		*out = new(APIServerSLO)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressIPs != nil {
+		in, out := &in.EgressIPs, &out.EgressIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AccessAudit != nil {
+		in, out := &in.AccessAudit, &out.AccessAudit
+		*out = new(ShootAccessAudit)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedStatus.
-func (in *SeedStatus) DeepCopy() *SeedStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootStatus.
+func (in *ShootStatus) DeepCopy() *ShootStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedStatus)
+	out := new(ShootStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Shoot) DeepCopyInto(out *Shoot) {
+func (in *ShootTemplate) DeepCopyInto(out *ShootTemplate) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Shoot.
-func (in *Shoot) DeepCopy() *Shoot {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootTemplate.
+func (in *ShootTemplate) DeepCopy() *ShootTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(Shoot)
+	out := new(ShootTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Shoot) DeepCopyObject() runtime.Object {
+func (in *ShootTemplate) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2978,13 +4311,13 @@ func (in *Shoot) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ShootList) DeepCopyInto(out *ShootList) {
+func (in *ShootTemplateList) DeepCopyInto(out *ShootTemplateList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Shoot, len(*in))
+		*out = make([]ShootTemplate, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2992,18 +4325,18 @@ func (in *ShootList) DeepCopyInto(out *ShootList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootList.
-func (in *ShootList) DeepCopy() *ShootList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootTemplateList.
+func (in *ShootTemplateList) DeepCopy() *ShootTemplateList {
 	if in == nil {
 		return nil
 	}
-	out := new(ShootList)
+	out := new(ShootTemplateList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ShootList) DeepCopyObject() runtime.Object {
+func (in *ShootTemplateList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3011,78 +4344,149 @@ func (in *ShootList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
+func (in *ShootTemplateReference) DeepCopyInto(out *ShootTemplateReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootTemplateReference.
+func (in *ShootTemplateReference) DeepCopy() *ShootTemplateReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootTemplateReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootTemplateSpec) DeepCopyInto(out *ShootTemplateSpec) {
 	*out = *in
 	if in.Addons != nil {
 		in, out := &in.Addons, &out.Addons
 		*out = new(Addons)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Backup != nil {
-		in, out := &in.Backup, &out.Backup
-		*out = new(Backup)
-		**out = **in
-	}
-	in.Cloud.DeepCopyInto(&out.Cloud)
-	in.DNS.DeepCopyInto(&out.DNS)
-	if in.Hibernation != nil {
-		in, out := &in.Hibernation, &out.Hibernation
-		*out = new(Hibernation)
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = new(v1alpha1.K8SNetworks)
 		(*in).DeepCopyInto(*out)
 	}
-	in.Kubernetes.DeepCopyInto(&out.Kubernetes)
-	if in.Maintenance != nil {
-		in, out := &in.Maintenance, &out.Maintenance
-		*out = new(Maintenance)
-		(*in).DeepCopyInto(*out)
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = make([]ShootTemplateWorker, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootSpec.
-func (in *ShootSpec) DeepCopy() *ShootSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootTemplateSpec.
+func (in *ShootTemplateSpec) DeepCopy() *ShootTemplateSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ShootSpec)
+	out := new(ShootTemplateSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
+func (in *ShootTemplateWorker) DeepCopyInto(out *ShootTemplateWorker) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1alpha1.Condition, len(*in))
+	in.Worker.DeepCopyInto(&out.Worker)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootTemplateWorker.
+func (in *ShootTemplateWorker) DeepCopy() *ShootTemplateWorker {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootTemplateWorker)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Storage) DeepCopyInto(out *Storage) {
+	*out = *in
+	if in.Classes != nil {
+		in, out := &in.Classes, &out.Classes
+		*out = make([]StorageClass, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	out.Gardener = in.Gardener
-	if in.LastOperation != nil {
-		in, out := &in.LastOperation, &out.LastOperation
-		*out = new(v1alpha1.LastOperation)
-		(*in).DeepCopyInto(*out)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Storage.
+func (in *Storage) DeepCopy() *Storage {
+	if in == nil {
+		return nil
 	}
-	if in.LastError != nil {
-		in, out := &in.LastError, &out.LastError
-		*out = new(v1alpha1.LastError)
-		(*in).DeepCopyInto(*out)
+	out := new(Storage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageClass) DeepCopyInto(out *StorageClass) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(bool)
+		**out = **in
 	}
-	if in.RetryCycleStartTime != nil {
-		in, out := &in.RetryCycleStartTime, &out.RetryCycleStartTime
-		*out = (*in).DeepCopy()
+	if in.VolumeType != nil {
+		in, out := &in.VolumeType, &out.VolumeType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Encrypted != nil {
+		in, out := &in.Encrypted, &out.Encrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootStatus.
-func (in *ShootStatus) DeepCopy() *ShootStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageClass.
+func (in *StorageClass) DeepCopy() *StorageClass {
 	if in == nil {
 		return nil
 	}
-	out := new(ShootStatus)
+	out := new(StorageClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformerSettings) DeepCopyInto(out *TerraformerSettings) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformerSettings.
+func (in *TerraformerSettings) DeepCopy() *TerraformerSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformerSettings)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3135,6 +4539,11 @@ func (in *Worker) DeepCopyInto(out *Worker) {
 			(*out)[key] = val
 		}
 	}
+	if in.InstanceTagLabels != nil {
+		in, out := &in.InstanceTagLabels, &out.InstanceTagLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Taints != nil {
 		in, out := &in.Taints, &out.Taints
 		*out = make([]v1.Taint, len(*in))
@@ -3142,6 +4551,53 @@ func (in *Worker) DeepCopyInto(out *Worker) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OSUpdates != nil {
+		in, out := &in.OSUpdates, &out.OSUpdates
+		*out = new(WorkerOSUpdates)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomMachineImage != nil {
+		in, out := &in.CustomMachineImage, &out.CustomMachineImage
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxPods != nil {
+		in, out := &in.MaxPods, &out.MaxPods
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DataVolumes != nil {
+		in, out := &in.DataVolumes, &out.DataVolumes
+		*out = make([]DataVolume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.KubeletDataVolumeName != nil {
+		in, out := &in.KubeletDataVolumeName, &out.KubeletDataVolumeName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Kubelet != nil {
+		in, out := &in.Kubelet, &out.Kubelet
+		*out = new(WorkerKubeletConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = new(WorkerCapacity)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3155,6 +4611,89 @@ func (in *Worker) DeepCopy() *Worker {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerCapacity) DeepCopyInto(out *WorkerCapacity) {
+	*out = *in
+	if in.FallbackMachineTypes != nil {
+		in, out := &in.FallbackMachineTypes, &out.FallbackMachineTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SpotPercentage != nil {
+		in, out := &in.SpotPercentage, &out.SpotPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerCapacity.
+func (in *WorkerCapacity) DeepCopy() *WorkerCapacity {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerCapacity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerKubeletConfig) DeepCopyInto(out *WorkerKubeletConfig) {
+	*out = *in
+	if in.ImageGCHighThresholdPercent != nil {
+		in, out := &in.ImageGCHighThresholdPercent, &out.ImageGCHighThresholdPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ImageGCLowThresholdPercent != nil {
+		in, out := &in.ImageGCLowThresholdPercent, &out.ImageGCLowThresholdPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ContainerLogMaxSize != nil {
+		in, out := &in.ContainerLogMaxSize, &out.ContainerLogMaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ContainerLogMaxFiles != nil {
+		in, out := &in.ContainerLogMaxFiles, &out.ContainerLogMaxFiles
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerKubeletConfig.
+func (in *WorkerKubeletConfig) DeepCopy() *WorkerKubeletConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerKubeletConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerOSUpdates) DeepCopyInto(out *WorkerOSUpdates) {
+	*out = *in
+	if in.RebootWindow != nil {
+		in, out := &in.RebootWindow, &out.RebootWindow
+		*out = new(MaintenanceTimeWindow)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerOSUpdates.
+func (in *WorkerOSUpdates) DeepCopy() *WorkerOSUpdates {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerOSUpdates)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Zone) DeepCopyInto(out *Zone) {
 	*out = *in
@@ -3163,6 +4702,16 @@ func (in *Zone) DeepCopyInto(out *Zone) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.UnavailableMachineTypes != nil {
+		in, out := &in.UnavailableMachineTypes, &out.UnavailableMachineTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnavailableVolumeTypes != nil {
+		in, out := &in.UnavailableVolumeTypes, &out.UnavailableVolumeTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
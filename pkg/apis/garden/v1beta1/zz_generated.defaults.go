@@ -22,6 +22,8 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&SeedList{}, func(obj interface{}) { SetObjectDefaults_SeedList(obj.(*SeedList)) })
 	scheme.AddTypeDefaultingFunc(&Shoot{}, func(obj interface{}) { SetObjectDefaults_Shoot(obj.(*Shoot)) })
 	scheme.AddTypeDefaultingFunc(&ShootList{}, func(obj interface{}) { SetObjectDefaults_ShootList(obj.(*ShootList)) })
+	scheme.AddTypeDefaultingFunc(&ShootTemplate{}, func(obj interface{}) { SetObjectDefaults_ShootTemplate(obj.(*ShootTemplate)) })
+	scheme.AddTypeDefaultingFunc(&ShootTemplateList{}, func(obj interface{}) { SetObjectDefaults_ShootTemplateList(obj.(*ShootTemplateList)) })
 	return nil
 }
 
@@ -175,3 +177,22 @@ func SetObjectDefaults_ShootList(in *ShootList) {
 		SetObjectDefaults_Shoot(a)
 	}
 }
+
+func SetObjectDefaults_ShootTemplate(in *ShootTemplate) {
+	if in.Spec.Addons != nil {
+		if in.Spec.Addons.KubernetesDashboard != nil {
+			SetDefaults_KubernetesDashboard(in.Spec.Addons.KubernetesDashboard)
+		}
+	}
+	for i := range in.Spec.Workers {
+		a := &in.Spec.Workers[i]
+		SetDefaults_Worker(&a.Worker)
+	}
+}
+
+func SetObjectDefaults_ShootTemplateList(in *ShootTemplateList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ShootTemplate(a)
+	}
+}
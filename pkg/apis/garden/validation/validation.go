@@ -18,10 +18,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/Masterminds/semver"
 
@@ -52,8 +54,9 @@ var (
 		string(garden.ProxyModeIPVS),
 	)
 	availableKubernetesDashboardAuthenticationModes = sets.NewString(
-		"basic",
-		"token",
+		garden.KubernetesDashboardAuthModeBasic,
+		garden.KubernetesDashboardAuthModeToken,
+		garden.KubernetesDashboardAuthModeOIDC,
 	)
 )
 
@@ -113,6 +116,28 @@ func ValidateCloudProfile(cloudProfile *garden.CloudProfile) field.ErrorList {
 
 	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&cloudProfile.ObjectMeta, false, ValidateName, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, ValidateCloudProfileSpec(&cloudProfile.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateCloudProfileCatalogSecretRef(cloudProfile, field.NewPath("metadata", "annotations"))...)
+
+	return allErrs
+}
+
+// validateCloudProfileCatalogSecretRef validates the annotation which enables refreshing the CloudProfile from the
+// catalog of its cloud provider. Refreshing is only supported for AWS CloudProfiles.
+func validateCloudProfileCatalogSecretRef(cloudProfile *garden.CloudProfile, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	secretRef, ok := cloudProfile.Annotations[common.CloudProfileCatalogSecretRef]
+	if !ok {
+		return allErrs
+	}
+
+	idxPath := fldPath.Key(common.CloudProfileCatalogSecretRef)
+	if parts := strings.Split(secretRef, "/"); len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		allErrs = append(allErrs, field.Invalid(idxPath, secretRef, "must have the form <namespace>/<name>"))
+	}
+	if cloudProfile.Spec.AWS == nil {
+		allErrs = append(allErrs, field.Forbidden(idxPath, "refreshing from the catalog of the cloud provider is only supported for AWS cloud profiles"))
+	}
 
 	return allErrs
 }
@@ -127,6 +152,14 @@ func ValidateCloudProfileUpdate(newProfile, oldProfile *garden.CloudProfile) fie
 	return allErrs
 }
 
+const (
+	minNetworkMTU = 1280
+	maxNetworkMTU = 9000
+
+	minDNSRecordTTL = 30
+	maxDNSRecordTTL = 86400
+)
+
 // ValidateCloudProfileSpec validates the specification of a CloudProfile object.
 func ValidateCloudProfileSpec(spec *garden.CloudProfileSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -141,7 +174,7 @@ func ValidateCloudProfileSpec(spec *garden.CloudProfileSpec, fldPath *field.Path
 		allErrs = append(allErrs, validateAWSMachineImages(spec.AWS.Constraints.MachineImages, fldPath.Child("aws", "constraints", "machineImages"))...)
 		allErrs = append(allErrs, validateMachineTypeConstraints(spec.AWS.Constraints.MachineTypes, fldPath.Child("aws", "constraints", "machineTypes"))...)
 		allErrs = append(allErrs, validateVolumeTypeConstraints(spec.AWS.Constraints.VolumeTypes, fldPath.Child("aws", "constraints", "volumeTypes"))...)
-		allErrs = append(allErrs, validateZones(spec.AWS.Constraints.Zones, fldPath.Child("aws", "constraints", "zones"))...)
+		allErrs = append(allErrs, validateZones(spec.AWS.Constraints.Zones, machineTypeNames(spec.AWS.Constraints.MachineTypes), volumeTypeNames(spec.AWS.Constraints.VolumeTypes), fldPath.Child("aws", "constraints", "zones"))...)
 	}
 
 	if spec.Azure != nil {
@@ -158,7 +191,7 @@ func ValidateCloudProfileSpec(spec *garden.CloudProfileSpec, fldPath *field.Path
 		allErrs = append(allErrs, validateGCPMachineImages(spec.GCP.Constraints.MachineImages, fldPath.Child("gcp", "constraints", "machineImages"))...)
 		allErrs = append(allErrs, validateMachineTypeConstraints(spec.GCP.Constraints.MachineTypes, fldPath.Child("gcp", "constraints", "machineTypes"))...)
 		allErrs = append(allErrs, validateVolumeTypeConstraints(spec.GCP.Constraints.VolumeTypes, fldPath.Child("gcp", "constraints", "volumeTypes"))...)
-		allErrs = append(allErrs, validateZones(spec.GCP.Constraints.Zones, fldPath.Child("gcp", "constraints", "zones"))...)
+		allErrs = append(allErrs, validateZones(spec.GCP.Constraints.Zones, machineTypeNames(spec.GCP.Constraints.MachineTypes), volumeTypeNames(spec.GCP.Constraints.VolumeTypes), fldPath.Child("gcp", "constraints", "zones"))...)
 	}
 
 	if spec.Alicloud != nil {
@@ -166,7 +199,7 @@ func ValidateCloudProfileSpec(spec *garden.CloudProfileSpec, fldPath *field.Path
 		allErrs = append(allErrs, validateAlicloudMachineImages(spec.Alicloud.Constraints.MachineImages, fldPath.Child("alicloud", "constraints", "machineImages"))...)
 		allErrs = append(allErrs, validateAlicloudMachineTypeConstraints(spec.Alicloud.Constraints.MachineTypes, spec.Alicloud.Constraints.Zones, fldPath.Child("alicloud", "constraints", "machineTypes"))...)
 		allErrs = append(allErrs, validateAlicloudVolumeTypeConstraints(spec.Alicloud.Constraints.VolumeTypes, spec.Alicloud.Constraints.Zones, fldPath.Child("alicloud", "constraints", "volumeTypes"))...)
-		allErrs = append(allErrs, validateZones(spec.Alicloud.Constraints.Zones, fldPath.Child("alicloud", "constraints", "zones"))...)
+		allErrs = append(allErrs, validateZones(spec.Alicloud.Constraints.Zones, alicloudMachineTypeNames(spec.Alicloud.Constraints.MachineTypes), alicloudVolumeTypeNames(spec.Alicloud.Constraints.VolumeTypes), fldPath.Child("alicloud", "constraints", "zones"))...)
 	}
 
 	if spec.Packet != nil {
@@ -174,14 +207,14 @@ func ValidateCloudProfileSpec(spec *garden.CloudProfileSpec, fldPath *field.Path
 		allErrs = append(allErrs, validatePacketMachineImages(spec.Packet.Constraints.MachineImages, fldPath.Child("packet", "constraints", "machineImages"))...)
 		allErrs = append(allErrs, validateMachineTypeConstraints(spec.Packet.Constraints.MachineTypes, fldPath.Child("packet", "constraints", "machineTypes"))...)
 		allErrs = append(allErrs, validateVolumeTypeConstraints(spec.Packet.Constraints.VolumeTypes, fldPath.Child("packet", "constraints", "volumeTypes"))...)
-		allErrs = append(allErrs, validateZones(spec.Packet.Constraints.Zones, fldPath.Child("packet", "constraints", "zones"))...)
+		allErrs = append(allErrs, validateZones(spec.Packet.Constraints.Zones, machineTypeNames(spec.Packet.Constraints.MachineTypes), volumeTypeNames(spec.Packet.Constraints.VolumeTypes), fldPath.Child("packet", "constraints", "zones"))...)
 	}
 
 	if spec.OpenStack != nil {
 		allErrs = append(allErrs, validateKubernetesConstraints(spec.OpenStack.Constraints.Kubernetes, fldPath.Child("openstack", "constraints", "kubernetes"))...)
 		allErrs = append(allErrs, validateOpenStackMachineImages(spec.OpenStack.Constraints.MachineImages, fldPath.Child("openstack", "constraints", "machineImages"))...)
 		allErrs = append(allErrs, validateOpenStackMachineTypeConstraints(spec.OpenStack.Constraints.MachineTypes, fldPath.Child("openstack", "constraints", "machineTypes"))...)
-		allErrs = append(allErrs, validateZones(spec.OpenStack.Constraints.Zones, fldPath.Child("openstack", "constraints", "zones"))...)
+		allErrs = append(allErrs, validateZones(spec.OpenStack.Constraints.Zones, openStackMachineTypeNames(spec.OpenStack.Constraints.MachineTypes), nil, fldPath.Child("openstack", "constraints", "zones"))...)
 
 		floatingPoolPath := fldPath.Child("openstack", "constraints", "floatingPools")
 		if len(spec.OpenStack.Constraints.FloatingPools) == 0 {
@@ -221,6 +254,10 @@ func ValidateCloudProfileSpec(spec *garden.CloudProfileSpec, fldPath *field.Path
 				allErrs = append(allErrs, field.Invalid(fldPath.Child("openstack", "requestTimeout"), *spec.OpenStack.RequestTimeout, fmt.Sprintf("invalid duration: %v", err)))
 			}
 		}
+
+		if mtu := spec.OpenStack.NetworkMTU; mtu != nil && (*mtu < minNetworkMTU || *mtu > maxNetworkMTU) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("openstack", "networkMTU"), *mtu, fmt.Sprintf("must be between %d and %d", minNetworkMTU, maxNetworkMTU)))
+		}
 	}
 
 	if spec.CABundle != nil {
@@ -230,6 +267,30 @@ func ValidateCloudProfileSpec(spec *garden.CloudProfileSpec, fldPath *field.Path
 		}
 	}
 
+	if spec.Terraformer != nil {
+		allErrs = append(allErrs, validateTerraformerSettings(spec.Terraformer, fldPath.Child("terraformer"))...)
+	}
+	if spec.CustomMachineImages != nil {
+		for i, project := range spec.CustomMachineImages.Projects {
+			if len(project) == 0 {
+				allErrs = append(allErrs, field.Required(fldPath.Child("customMachineImages", "projects").Index(i), "project name must not be empty"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateTerraformerSettings(terraformer *garden.TerraformerSettings, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	versionPath := fldPath.Child("version")
+	if len(terraformer.Version) == 0 {
+		allErrs = append(allErrs, field.Required(versionPath, "must provide a version"))
+	} else if _, err := semver.NewVersion(terraformer.Version); err != nil {
+		allErrs = append(allErrs, field.Invalid(versionPath, terraformer.Version, fmt.Sprintf("must be a semantic version: %v", err)))
+	}
+
 	return allErrs
 }
 
@@ -280,6 +341,9 @@ func validateMachineTypeConstraints(machineTypes []garden.MachineType, fldPath *
 		allErrs = append(allErrs, validateResourceQuantityValue("cpu", machineType.CPU, cpuPath)...)
 		allErrs = append(allErrs, validateResourceQuantityValue("gpu", machineType.GPU, gpuPath)...)
 		allErrs = append(allErrs, validateResourceQuantityValue("memory", machineType.Memory, memoryPath)...)
+		if machineType.Architecture != nil {
+			allErrs = append(allErrs, validateArchitecture(*machineType.Architecture, idxPath.Child("architecture"))...)
+		}
 	}
 
 	return allErrs
@@ -353,10 +417,17 @@ func validateAWSMachineImages(machineImages []garden.AWSMachineImageMapping, fld
 		for j, region := range image.Regions {
 			regionIdxPath := idxPath.Child("regions").Index(j)
 
-			if regionNames[region.Name] {
+			architecture := garden.ArchitectureAMD64
+			if region.Architecture != nil {
+				architecture = *region.Architecture
+				allErrs = append(allErrs, validateArchitecture(architecture, regionIdxPath.Child("architecture"))...)
+			}
+
+			regionKey := region.Name + "/" + architecture
+			if regionNames[regionKey] {
 				allErrs = append(allErrs, field.Duplicate(regionIdxPath, region.Name))
 			}
-			regionNames[region.Name] = true
+			regionNames[regionKey] = true
 
 			if !r.MatchString(region.AMI) {
 				allErrs = append(allErrs, field.Invalid(regionIdxPath.Child("ami"), region.AMI, fmt.Sprintf("ami's must match the regex %s", r)))
@@ -545,7 +616,7 @@ func validateAlicloudVolumeTypeConstraints(volumeTypes []garden.AlicloudVolumeTy
 	return allErrs
 }
 
-func validateZones(zones []garden.Zone, fldPath *field.Path) field.ErrorList {
+func validateZones(zones []garden.Zone, machineTypes, volumeTypes []string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if len(zones) == 0 {
@@ -571,11 +642,63 @@ func validateZones(zones []garden.Zone, fldPath *field.Path) field.ErrorList {
 				allErrs = append(allErrs, field.Required(namePath, "zone name cannot be empty"))
 			}
 		}
+
+		for j, name := range zone.UnavailableMachineTypes {
+			if !sets.NewString(machineTypes...).Has(name) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("unavailableMachineTypes").Index(j), name, machineTypes))
+			}
+		}
+
+		for j, name := range zone.UnavailableVolumeTypes {
+			if !sets.NewString(volumeTypes...).Has(name) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("unavailableVolumeTypes").Index(j), name, volumeTypes))
+			}
+		}
 	}
 
 	return allErrs
 }
 
+func machineTypeNames(machineTypes []garden.MachineType) []string {
+	names := []string{}
+	for _, machineType := range machineTypes {
+		names = append(names, machineType.Name)
+	}
+	return names
+}
+
+func openStackMachineTypeNames(machineTypes []garden.OpenStackMachineType) []string {
+	names := []string{}
+	for _, machineType := range machineTypes {
+		names = append(names, machineType.Name)
+	}
+	return names
+}
+
+func alicloudMachineTypeNames(machineTypes []garden.AlicloudMachineType) []string {
+	names := []string{}
+	for _, machineType := range machineTypes {
+		names = append(names, machineType.Name)
+	}
+	return names
+}
+
+func volumeTypeNames(volumeTypes []garden.VolumeType) []string {
+	names := []string{}
+	for _, volumeType := range volumeTypes {
+		names = append(names, volumeType.Name)
+	}
+	return names
+}
+
+func alicloudVolumeTypeNames(volumeTypes []garden.AlicloudVolumeType) []string {
+	names := []string{}
+	for _, volumeType := range volumeTypes {
+		names = append(names, volumeType.Name)
+	}
+	return names
+}
+
 func validateAzureDomainCount(domainCount []garden.AzureDomainCount, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -657,6 +780,54 @@ func ValidateProjectSpec(projectSpec *garden.ProjectSpec, fldPath *field.Path) f
 	if purpose := projectSpec.Description; purpose != nil && len(*purpose) == 0 {
 		allErrs = append(allErrs, field.Required(fldPath.Child("purpose"), "must provide a purpose when key is present"))
 	}
+	if restrictions := projectSpec.Restrictions; restrictions != nil {
+		allErrs = append(allErrs, validateProjectRestrictions(restrictions, fldPath.Child("restrictions"))...)
+	}
+
+	return allErrs
+}
+
+func validateProjectRestrictions(restrictions *garden.ProjectRestrictions, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	cloudProfiles := sets.NewString()
+	for i, cloudProfile := range restrictions.CloudProfiles {
+		idxPath := fldPath.Child("cloudProfiles").Index(i)
+
+		if len(cloudProfile.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must specify a cloud profile"))
+		} else if cloudProfiles.Has(cloudProfile.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), cloudProfile.Name))
+		}
+		cloudProfiles.Insert(cloudProfile.Name)
+
+		for j, region := range cloudProfile.Regions {
+			if len(region) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("regions").Index(j), "must specify a region"))
+			}
+		}
+	}
+
+	secrets := sets.NewString()
+	for i, secret := range restrictions.Secrets {
+		idxPath := fldPath.Child("secrets").Index(i)
+
+		if len(secret.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must specify a secret"))
+		}
+		if len(secret.Namespace) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("namespace"), "must specify the namespace of the secret"))
+		}
+		key := secret.Namespace + "/" + secret.Name
+		if secrets.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, key))
+		}
+		secrets.Insert(key)
+	}
+
+	if defaultSecretBinding := restrictions.DefaultSecretBinding; defaultSecretBinding != nil && len(*defaultSecretBinding) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("defaultSecretBinding"), "must provide a secret binding when key is present"))
+	}
 
 	return allErrs
 }
@@ -727,11 +898,23 @@ func ValidateSeedUpdate(newSeed, oldSeed *garden.Seed) field.ErrorList {
 
 	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newSeed.ObjectMeta, &oldSeed.ObjectMeta, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSeed.Spec.Networks, oldSeed.Spec.Networks, field.NewPath("spec", "networks"))...)
+	if oldProvider := seedBackupProvider(oldSeed); oldProvider != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(seedBackupProvider(newSeed), oldProvider, field.NewPath("spec", "backup", "provider"))...)
+	}
 	allErrs = append(allErrs, ValidateSeed(newSeed)...)
 
 	return allErrs
 }
 
+// seedBackupProvider returns the backup provider of the given Seed. It can be set for existing Seeds but cannot be
+// changed or removed once set because the existing backup buckets would be orphaned.
+func seedBackupProvider(seed *garden.Seed) *garden.CloudProvider {
+	if seed.Spec.Backup == nil {
+		return nil
+	}
+	return seed.Spec.Backup.Provider
+}
+
 //ValidateSeedAnnotation validates the annotations of seed
 func ValidateSeedAnnotation(annotations map[string]string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -746,6 +929,39 @@ func ValidateSeedAnnotation(annotations map[string]string, fldPath *field.Path)
 	return allErrs
 }
 
+// availableSeedBackupProviders are the cloud providers which can store the backups of Seeds of other cloud providers.
+// The backups of OpenStack Seeds depend on the cloud profile of the Seed and hence cannot be stored elsewhere.
+var availableSeedBackupProviders = sets.NewString(
+	string(garden.CloudProviderAWS),
+	string(garden.CloudProviderAzure),
+	string(garden.CloudProviderGCP),
+	string(garden.CloudProviderAlicloud),
+)
+
+func validateSeedBackup(backup *garden.SeedBackup, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if backup.Region != nil && len(*backup.Region) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("region"), *backup.Region, "region must not be empty"))
+	}
+	if backup.SecretRef != nil {
+		allErrs = append(allErrs, validateSecretReference(*backup.SecretRef, fldPath.Child("secretRef"))...)
+	}
+	if backup.Provider != nil {
+		if !availableSeedBackupProviders.Has(string(*backup.Provider)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("provider"), *backup.Provider, availableSeedBackupProviders.List()))
+		}
+		if backup.SecretRef == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("secretRef"), "must provide the credentials of the backup provider"))
+		}
+		if backup.Region == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("region"), "must provide a region of the backup provider"))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateSeedSpec validates the specification of a Seed object.
 func ValidateSeedSpec(seedSpec *garden.SeedSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -761,6 +977,25 @@ func ValidateSeedSpec(seedSpec *garden.SeedSpec, fldPath *field.Path) field.Erro
 	allErrs = append(allErrs, validateDNS1123Subdomain(seedSpec.IngressDomain, fldPath.Child("ingressDomain"))...)
 	allErrs = append(allErrs, validateSecretReference(seedSpec.SecretRef, fldPath.Child("secretRef"))...)
 
+	if seedSpec.IngressTLS != nil {
+		allErrs = append(allErrs, validateSeedIngressTLS(seedSpec.IngressTLS, fldPath.Child("ingressTLS"))...)
+	}
+	if seedSpec.Settings != nil && seedSpec.Settings.DashboardAuthentication != nil && seedSpec.Settings.DashboardAuthentication.OIDC != nil {
+		allErrs = append(allErrs, validateSeedDashboardOIDC(seedSpec.Settings.DashboardAuthentication.OIDC, fldPath.Child("settings", "dashboardAuthentication", "oidc"))...)
+	}
+	if seedSpec.Settings != nil && seedSpec.Settings.ExcessCapacityReservation != nil {
+		allErrs = append(allErrs, validateSeedExcessCapacityReservation(seedSpec.Settings.ExcessCapacityReservation, fldPath.Child("settings", "excessCapacityReservation"))...)
+	}
+	if seedSpec.Settings != nil && seedSpec.Settings.LoadBalancerServices != nil {
+		allErrs = append(allErrs, apivalidation.ValidateAnnotations(seedSpec.Settings.LoadBalancerServices.Annotations, fldPath.Child("settings", "loadBalancerServices", "annotations"))...)
+	}
+	if seedSpec.Settings != nil && seedSpec.Settings.Terraformer != nil {
+		allErrs = append(allErrs, validateTerraformerSettings(seedSpec.Settings.Terraformer, fldPath.Child("settings", "terraformer"))...)
+	}
+	if seedSpec.Backup != nil {
+		allErrs = append(allErrs, validateSeedBackup(seedSpec.Backup, fldPath.Child("backup"))...)
+	}
+
 	networksPath := fldPath.Child("networks")
 
 	networks := []cidrvalidation.CIDR{
@@ -774,6 +1009,58 @@ func ValidateSeedSpec(seedSpec *garden.SeedSpec, fldPath *field.Path) field.Erro
 	return allErrs
 }
 
+func validateSeedExcessCapacityReservation(reservation *garden.SeedSettingExcessCapacityReservation, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if reservation.Replicas != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*reservation.Replicas), fldPath.Child("replicas"))...)
+	}
+
+	resourcesPath := fldPath.Child("resources")
+	for name, quantity := range reservation.Resources {
+		if name != corev1.ResourceCPU && name != corev1.ResourceMemory {
+			allErrs = append(allErrs, field.NotSupported(resourcesPath, name, []string{string(corev1.ResourceCPU), string(corev1.ResourceMemory)}))
+			continue
+		}
+		allErrs = append(allErrs, validateResourceQuantityValue(string(name), quantity, resourcesPath.Key(string(name)))...)
+	}
+
+	return allErrs
+}
+
+func validateSeedIngressTLS(ingressTLS *garden.SeedIngressTLS, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch {
+	case ingressTLS.SecretRef != nil && ingressTLS.ACME != nil:
+		allErrs = append(allErrs, field.Forbidden(fldPath, "must not specify both secretRef and acme"))
+	case ingressTLS.SecretRef != nil:
+		allErrs = append(allErrs, validateSecretReference(*ingressTLS.SecretRef, fldPath.Child("secretRef"))...)
+	case ingressTLS.ACME != nil:
+		if len(ingressTLS.ACME.ClusterIssuer) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("acme", "clusterIssuer"), "must provide the name of a cluster issuer"))
+		}
+	default:
+		allErrs = append(allErrs, field.Required(fldPath, "must specify either secretRef or acme"))
+	}
+
+	return allErrs
+}
+
+func validateSeedDashboardOIDC(oidc *garden.SeedDashboardOIDC, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if issuerURL, err := url.Parse(oidc.IssuerURL); err != nil || issuerURL.Scheme != "https" || len(issuerURL.Host) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("issuerURL"), oidc.IssuerURL, "must be a valid https URL"))
+	}
+	if len(oidc.ClientID) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("clientID"), "must provide a client id"))
+	}
+	allErrs = append(allErrs, validateSecretReference(oidc.SecretRef, fldPath.Child("secretRef"))...)
+
+	return allErrs
+}
+
 func validateCIDR(cidr gardencore.CIDR, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -855,6 +1142,110 @@ func isValidQuotaMetric(metric corev1.ResourceName) bool {
 	return false
 }
 
+////////////////////////////////////////////////////
+//          DELETION PROTECTION POLICIES          //
+////////////////////////////////////////////////////
+
+// ValidateDeletionProtectionPolicy validates a DeletionProtectionPolicy object.
+func ValidateDeletionProtectionPolicy(policy *garden.DeletionProtectionPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&policy.ObjectMeta, false, ValidateName, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateDeletionProtectionPolicySpec(&policy.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateDeletionProtectionPolicyUpdate validates a DeletionProtectionPolicy object before an update.
+func ValidateDeletionProtectionPolicyUpdate(newPolicy, oldPolicy *garden.DeletionProtectionPolicy) field.ErrorList {
+	allErrs := apivalidation.ValidateObjectMetaUpdate(&newPolicy.ObjectMeta, &oldPolicy.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateDeletionProtectionPolicy(newPolicy)...)
+	return allErrs
+}
+
+// ValidateDeletionProtectionPolicySpec validates the specification of a DeletionProtectionPolicy object.
+func ValidateDeletionProtectionPolicySpec(spec *garden.DeletionProtectionPolicySpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&spec.ShootSelector, fldPath.Child("shootSelector"))...)
+
+	if spec.RequiredApprovals < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("requiredApprovals"), spec.RequiredApprovals, "number of required approvals must not be negative"))
+	}
+	if spec.BackupRetention != nil && spec.BackupRetention.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("backupRetention"), *spec.BackupRetention, "backup retention must not be negative"))
+	}
+	if spec.RequiredApprovals == 0 && spec.BackupRetention == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "either requiredApprovals or backupRetention must be set"))
+	}
+
+	return allErrs
+}
+
+////////////////////////////////////////////////////
+//                  SHOOT TEMPLATES               //
+////////////////////////////////////////////////////
+
+// ValidateShootTemplate validates a ShootTemplate object.
+func ValidateShootTemplate(shootTemplate *garden.ShootTemplate) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&shootTemplate.ObjectMeta, true, ValidateName, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateShootTemplateSpec(&shootTemplate.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateShootTemplateUpdate validates a ShootTemplate object before an update.
+func ValidateShootTemplateUpdate(newShootTemplate, oldShootTemplate *garden.ShootTemplate) field.ErrorList {
+	allErrs := apivalidation.ValidateObjectMetaUpdate(&newShootTemplate.ObjectMeta, &oldShootTemplate.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateShootTemplate(newShootTemplate)...)
+	return allErrs
+}
+
+// ValidateShootTemplateSpec validates the specification of a ShootTemplate object.
+func ValidateShootTemplateSpec(spec *garden.ShootTemplateSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateAddons(spec.Addons, fldPath.Child("addons"))...)
+
+	if networks := spec.Networks; networks != nil {
+		networksPath := fldPath.Child("networks")
+		if networks.Nodes != nil {
+			allErrs = append(allErrs, validateCIDR(*networks.Nodes, networksPath.Child("nodes"))...)
+		}
+		if networks.Pods != nil {
+			allErrs = append(allErrs, validateCIDR(*networks.Pods, networksPath.Child("pods"))...)
+		}
+		if networks.Services != nil {
+			allErrs = append(allErrs, validateCIDR(*networks.Services, networksPath.Child("services"))...)
+		}
+	}
+
+	var (
+		workersPath = fldPath.Child("workers")
+		workerNames = make(map[string]bool)
+		workers     []garden.Worker
+	)
+	for i, worker := range spec.Workers {
+		idxPath := workersPath.Index(i)
+		allErrs = append(allErrs, ValidateWorker(worker.Worker, idxPath)...)
+		if len(worker.VolumeSize) > 0 {
+			allErrs = append(allErrs, validateWorkerVolumeSize(worker.VolumeSize, idxPath.Child("volumeSize"))...)
+		}
+		if workerNames[worker.Name] {
+			allErrs = append(allErrs, field.Duplicate(idxPath, worker.Name))
+		}
+		workerNames[worker.Name] = true
+		workers = append(workers, worker.Worker)
+	}
+	if len(workers) > 0 {
+		allErrs = append(allErrs, ValidateWorkers(workers, workersPath)...)
+	}
+
+	return allErrs
+}
+
 // validateResourceQuantityValue validates the value of a resource quantity.
 func validateResourceQuantityValue(key string, value resource.Quantity, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -875,10 +1266,84 @@ func ValidateSecretBinding(binding *garden.SecretBinding) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&binding.ObjectMeta, true, ValidateName, field.NewPath("metadata"))...)
-	allErrs = append(allErrs, validateSecretReferenceOptionalNamespace(binding.SecretRef, field.NewPath("secretRef"))...)
+	if binding.VaultRef != nil {
+		allErrs = append(allErrs, validateSecretBindingVaultReference(binding, field.NewPath("vaultRef"))...)
+	} else {
+		allErrs = append(allErrs, validateSecretReferenceOptionalNamespace(binding.SecretRef, field.NewPath("secretRef"))...)
+	}
 	for i, quota := range binding.Quotas {
 		allErrs = append(allErrs, validateObjectReferenceOptionalNamespace(quota, field.NewPath("quotas").Index(i))...)
 	}
+	if binding.Rotation != nil {
+		allErrs = append(allErrs, validateSecretBindingRotation(binding, field.NewPath("rotation"))...)
+	}
+
+	return allErrs
+}
+
+// validateSecretBindingVaultReference validates the Vault reference of a SecretBinding. The path is relative to the
+// Vault path of the SecretBinding's namespace, hence it must not escape it.
+func validateSecretBindingVaultReference(binding *garden.SecretBinding, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs  = field.ErrorList{}
+		vaultRef = binding.VaultRef
+		pathPath = fldPath.Child("path")
+	)
+
+	if binding.SecretRef != (corev1.SecretReference{}) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("secretRef"), "must not be set if the credentials are read from Vault"))
+	}
+	if binding.Rotation != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("rotation"), "credentials read from Vault cannot be rotated"))
+	}
+
+	if len(vaultRef.Path) == 0 {
+		allErrs = append(allErrs, field.Required(pathPath, "must provide the path of the credentials in Vault"))
+	} else {
+		for _, segment := range strings.Split(vaultRef.Path, "/") {
+			if len(segment) == 0 || segment == "." || segment == ".." {
+				allErrs = append(allErrs, field.Invalid(pathPath, vaultRef.Path, "must be a relative path without empty, '.' or '..' segments"))
+				break
+			}
+		}
+	}
+
+	keyMappingPath := fldPath.Child("keyMapping")
+	for key, vaultKey := range vaultRef.KeyMapping {
+		for _, msg := range validation.IsConfigMapKey(key) {
+			allErrs = append(allErrs, field.Invalid(keyMappingPath, key, msg))
+		}
+		if len(vaultKey) == 0 {
+			allErrs = append(allErrs, field.Required(keyMappingPath.Key(key), "must provide the key of the Vault data"))
+		}
+	}
+
+	return allErrs
+}
+
+var availableSecretBindingRotationPhases = sets.NewString(
+	string(garden.SecretBindingRotationPhaseRolling),
+	string(garden.SecretBindingRotationPhaseCompleted),
+)
+
+func validateSecretBindingRotation(binding *garden.SecretBinding, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs  = field.ErrorList{}
+		rotation = binding.Rotation
+	)
+
+	allErrs = append(allErrs, validateSecretReferenceOptionalNamespace(rotation.SecretRef, fldPath.Child("secretRef"))...)
+
+	completed := false
+	if rotation.Status != nil {
+		if !availableSecretBindingRotationPhases.Has(string(rotation.Status.Phase)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("status", "phase"), rotation.Status.Phase, availableSecretBindingRotationPhases.List()))
+		}
+		completed = rotation.Status.Phase == garden.SecretBindingRotationPhaseCompleted
+	}
+	if !completed && apiequality.Semantic.DeepEqual(rotation.SecretRef, binding.SecretRef) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("secretRef"), rotation.SecretRef, "must reference another secret than .secretRef"))
+	}
 
 	return allErrs
 }
@@ -888,13 +1353,35 @@ func ValidateSecretBindingUpdate(newBinding, oldBinding *garden.SecretBinding) f
 	allErrs := field.ErrorList{}
 
 	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newBinding.ObjectMeta, &oldBinding.ObjectMeta, field.NewPath("metadata"))...)
-	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newBinding.SecretRef, oldBinding.SecretRef, field.NewPath("secretRef"))...)
+	if !secretBindingRotationCompleted(newBinding, oldBinding) {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newBinding.SecretRef, oldBinding.SecretRef, field.NewPath("secretRef"))...)
+	}
+	if oldRotation, newRotation := oldBinding.Rotation, newBinding.Rotation; oldRotation != nil && newRotation != nil && secretBindingRotationInProgress(oldRotation) {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newRotation.SecretRef, oldRotation.SecretRef, field.NewPath("rotation", "secretRef"))...)
+	}
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newBinding.VaultRef, oldBinding.VaultRef, field.NewPath("vaultRef"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newBinding.Quotas, oldBinding.Quotas, field.NewPath("quotas"))...)
 	allErrs = append(allErrs, ValidateSecretBinding(newBinding)...)
 
 	return allErrs
 }
 
+// secretBindingRotationCompleted returns true if the update of the SecretBinding completes its credentials rotation,
+// i.e. if it switches the secret reference to the secret of the rotation. This is the only allowed modification of
+// the secret reference.
+func secretBindingRotationCompleted(newBinding, oldBinding *garden.SecretBinding) bool {
+	if oldBinding.Rotation == nil || newBinding.Rotation == nil || newBinding.Rotation.Status == nil {
+		return false
+	}
+	return newBinding.Rotation.Status.Phase == garden.SecretBindingRotationPhaseCompleted &&
+		apiequality.Semantic.DeepEqual(newBinding.SecretRef, oldBinding.Rotation.SecretRef) &&
+		apiequality.Semantic.DeepEqual(newBinding.Rotation.SecretRef, oldBinding.Rotation.SecretRef)
+}
+
+func secretBindingRotationInProgress(rotation *garden.SecretBindingRotation) bool {
+	return rotation.Status == nil || rotation.Status.Phase != garden.SecretBindingRotationPhaseCompleted
+}
+
 func validateLocalObjectReference(ref *corev1.LocalObjectReference, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -972,6 +1459,9 @@ func ValidateShootUpdate(newShoot, oldShoot *garden.Shoot) field.ErrorList {
 
 	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newShoot.ObjectMeta, &oldShoot.ObjectMeta, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, ValidateShootSpecUpdate(&newShoot.Spec, &oldShoot.Spec, newShoot.DeletionTimestamp != nil, field.NewPath("spec"))...)
+	if newShoot.DeletionTimestamp == nil {
+		allErrs = append(allErrs, validateWorkerPoolDeletion(newShoot, oldShoot)...)
+	}
 	allErrs = append(allErrs, ValidateShoot(newShoot)...)
 
 	return allErrs
@@ -988,15 +1478,37 @@ func ValidateShootSpec(spec *garden.ShootSpec, fldPath *field.Path) field.ErrorL
 	}
 
 	allErrs = append(allErrs, validateAddons(spec.Addons, fldPath.Child("addons"))...)
+	allErrs = append(allErrs, validateKubernetesDashboardOIDC(spec.Addons, spec.Kubernetes, fldPath)...)
+	allErrs = append(allErrs, validateKubernetesDashboardBasicAuth(spec.Addons, spec.Kubernetes, fldPath)...)
 	allErrs = append(allErrs, validateCloud(spec.Cloud, fldPath.Child("cloud"))...)
 	allErrs = append(allErrs, validateDNS(spec.DNS, fldPath.Child("dns"))...)
 	allErrs = append(allErrs, validateKubernetes(spec.Kubernetes, fldPath.Child("kubernetes"))...)
 	allErrs = append(allErrs, validateMaintenance(spec.Maintenance, fldPath.Child("maintenance"))...)
+	allErrs = append(allErrs, validateMonitoring(spec.Monitoring, fldPath.Child("monitoring"))...)
 	allErrs = append(allErrs, ValidateHibernation(spec.Hibernation, fldPath.Child("hibernation"))...)
+	allErrs = append(allErrs, validateNodeCIDRCapacity(spec, fldPath)...)
+	allErrs = append(allErrs, validateStorage(spec, fldPath.Child("storage"))...)
+	allErrs = append(allErrs, validateKubeAPIServerAllowedCIDRsSupported(spec, fldPath)...)
+
+	if spec.SizingProfile != nil && !availableSizingProfiles.Has(string(*spec.SizingProfile)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("sizingProfile"), *spec.SizingProfile, availableSizingProfiles.List()))
+	}
+
+	if spec.Template != nil && len(spec.Template.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("template", "name"), "must provide the name of a shoot template"))
+	}
 
 	return allErrs
 }
 
+var availableSizingProfiles = sets.NewString(
+	string(garden.SizingProfileTiny),
+	string(garden.SizingProfileSmall),
+	string(garden.SizingProfileMedium),
+	string(garden.SizingProfileLarge),
+	string(garden.SizingProfileXLarge),
+)
+
 // ValidateShootStatusUpdate validates the status field of a Shoot object.
 func ValidateShootStatusUpdate(newStatus, oldStatus garden.ShootStatus) field.ErrorList {
 	var (
@@ -1067,31 +1579,69 @@ func validateAddons(addons *garden.Addons, fldPath *field.Path) field.ErrorList
 	return allErrs
 }
 
-func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
+func validateKubernetesDashboardOIDC(addons *garden.Addons, kubernetes garden.Kubernetes, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
-	workerNames := make(map[string]bool)
 
-	if len(cloud.Profile) == 0 {
-		allErrs = append(allErrs, field.Required(fldPath.Child("profile"), "must specify a cloud profile"))
-	}
-	if len(cloud.Region) == 0 {
-		allErrs = append(allErrs, field.Required(fldPath.Child("region"), "must specify a region"))
+	if addons == nil || addons.KubernetesDashboard == nil || !addons.KubernetesDashboard.Enabled {
+		return allErrs
 	}
-	if len(cloud.SecretBindingRef.Name) == 0 {
-		allErrs = append(allErrs, field.Required(fldPath.Child("secretBindingRef", "name"), "must specify a name"))
+
+	if authMode := addons.KubernetesDashboard.AuthenticationMode; authMode == nil || *authMode != garden.KubernetesDashboardAuthModeOIDC {
+		return allErrs
 	}
-	if cloud.Seed != nil && len(*cloud.Seed) == 0 {
-		allErrs = append(allErrs, field.Invalid(fldPath.Child("seed"), cloud.Seed, "seed name must not be empty when providing the key"))
+
+	if kubernetes.KubeAPIServer == nil || kubernetes.KubeAPIServer.OIDCConfig == nil || kubernetes.KubeAPIServer.OIDCConfig.IssuerURL == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("addons", "kubernetes-dashboard", "authenticationMode"), "oidc authentication mode requires the kube-apiserver's OIDC configuration (spec.kubernetes.kubeAPIServer.oidcConfig.issuerURL)"))
 	}
 
-	aws := cloud.AWS
-	awsPath := fldPath.Child("aws")
-	if aws != nil {
-		zoneCount := len(aws.Zones)
-		if zoneCount == 0 {
-			allErrs = append(allErrs, field.Required(awsPath.Child("zones"), "must specify at least one zone"))
-			return allErrs
-		}
+	return allErrs
+}
+
+func validateKubernetesDashboardBasicAuth(addons *garden.Addons, kubernetes garden.Kubernetes, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if addons == nil || addons.KubernetesDashboard == nil || !addons.KubernetesDashboard.Enabled {
+		return allErrs
+	}
+
+	if authMode := addons.KubernetesDashboard.AuthenticationMode; authMode == nil || *authMode != garden.KubernetesDashboardAuthModeBasic {
+		return allErrs
+	}
+
+	if kubernetes.KubeAPIServer != nil && kubernetes.KubeAPIServer.EnableBasicAuthentication != nil && !*kubernetes.KubeAPIServer.EnableBasicAuthentication {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("addons", "kubernetes-dashboard", "authenticationMode"), "basic authentication mode requires the kube-apiserver to accept basic authentication (spec.kubernetes.kubeAPIServer.enableBasicAuthentication)"))
+	}
+
+	return allErrs
+}
+
+func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	workerNames := make(map[string]bool)
+
+	if len(cloud.Profile) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("profile"), "must specify a cloud profile"))
+	}
+	if len(cloud.Region) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("region"), "must specify a region"))
+	}
+	if len(cloud.SecretBindingRef.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("secretBindingRef", "name"), "must specify a name"))
+	}
+	if cloud.Seed != nil && len(*cloud.Seed) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("seed"), cloud.Seed, "seed name must not be empty when providing the key"))
+	}
+	allErrs = append(allErrs, validateCloudTags(cloud, fldPath.Child("tags"))...)
+	allErrs = append(allErrs, validateServiceLoadBalancer(cloud, fldPath)...)
+
+	aws := cloud.AWS
+	awsPath := fldPath.Child("aws")
+	if aws != nil {
+		zoneCount := len(aws.Zones)
+		if zoneCount == 0 {
+			allErrs = append(allErrs, field.Required(awsPath.Child("zones"), "must specify at least one zone"))
+			return allErrs
+		}
 
 		nodes, pods, services, networkErrors := transformK8SNetworks(aws.Networks.K8SNetworks, awsPath.Child("networks"))
 		allErrs = append(allErrs, networkErrors...)
@@ -1141,6 +1691,8 @@ func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
 			allErrs = append(allErrs, vpcCIDR.ValidateNotSubset(pods, services)...)
 		}
 
+		allErrs = append(allErrs, validateAWSExistingNetworkResources(aws, awsPath.Child("networks"))...)
+
 		// make sure that VPC cidrs don't overlap with eachother
 		allErrs = append(allErrs, validateCIDROVerlap(allVPCCIDRs, allVPCCIDRs, false)...)
 
@@ -1156,9 +1708,14 @@ func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
 		for i, worker := range aws.Workers {
 			idxPath := workersPath.Index(i)
 			allErrs = append(allErrs, ValidateWorker(worker.Worker, idxPath)...)
+			allErrs = append(allErrs, validateWorkerCapacityUnsupported(worker.Worker, "AWS", idxPath)...)
+			allErrs = append(allErrs, validateWorkerInstanceTags(worker.Worker, cloud.Tags, awsTagConstraints, idxPath.Child("instanceTagLabels"))...)
+			allErrs = append(allErrs, validateWorkerZones(worker.Zones, aws.Zones, idxPath.Child("zones"))...)
 			allErrs = append(allErrs, validateWorkerVolumeSize(worker.VolumeSize, idxPath.Child("volumeSize"))...)
 			allErrs = append(allErrs, validateWorkerMinimumVolumeSize(worker.VolumeSize, 20, idxPath.Child("volumeSize"))...)
 			allErrs = append(allErrs, validateWorkerVolumeType(worker.VolumeType, idxPath.Child("volumeType"))...)
+			allErrs = append(allErrs, validateAWSWorkerVolumeIOPS(worker, idxPath.Child("volumeIOPS"))...)
+			allErrs = append(allErrs, validateAWSWorkerVolumeThroughput(worker, idxPath.Child("volumeThroughput"))...)
 			if workerNames[worker.Name] {
 				allErrs = append(allErrs, field.Duplicate(idxPath, worker.Name))
 			}
@@ -1171,13 +1728,10 @@ func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
 	azure := cloud.Azure
 	azurePath := fldPath.Child("azure")
 	if azure != nil {
-		// Currently, we will not allow deployments into existing resource groups or VNets although this functionality
-		// is already implemented, because the Azure cloud provider (v1.9) is not cleaning up self-created resources properly.
-		// This resources would be orphaned when the cluster will be deleted. We block these cases thereby that the Azure shoot
-		// validation here will fail for those cases.
-		// TODO: remove the following block and uncomment below blocks once deployment into existing resource groups/vnets works properly.
-		if azure.ResourceGroup != nil {
-			allErrs = append(allErrs, field.Invalid(azurePath.Child("resourceGroup", "name"), azure.ResourceGroup.Name, "specifying an existing resource group is not supported yet."))
+		// Shoots may be deployed into existing resource groups and VNets. The resources of the Azure cloud provider are not
+		// orphaned as the deletion flow removes all load balancer services and volumes before the infrastructure.
+		if azure.ResourceGroup != nil && len(azure.ResourceGroup.Name) == 0 {
+			allErrs = append(allErrs, field.Invalid(azurePath.Child("resourceGroup", "name"), azure.ResourceGroup.Name, "resource group name must not be empty when resource group key is provided"))
 		}
 
 		nodes, pods, services, networkErrors := transformK8SNetworks(azure.Networks.K8SNetworks, azurePath.Child("networks"))
@@ -1190,8 +1744,16 @@ func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
 			allErrs = append(allErrs, nodes.ValidateSubset(workerCIDR)...)
 		}
 
+		var vnetCIDR cidrvalidation.CIDR
 		if azure.Networks.VNet.Name != nil {
-			allErrs = append(allErrs, field.Invalid(azurePath.Child("networks", "vnet", "name"), *(azure.Networks.VNet.Name), "specifying an existing vnet is not supported yet"))
+			if len(*azure.Networks.VNet.Name) == 0 {
+				allErrs = append(allErrs, field.Invalid(azurePath.Child("networks", "vnet", "name"), *(azure.Networks.VNet.Name), "vnet name must not be empty when providing the key"))
+			} else if azure.ResourceGroup == nil {
+				allErrs = append(allErrs, field.Invalid(azurePath.Child("networks", "vnet", "name"), *(azure.Networks.VNet.Name), "an existing vnet can only be used in combination with an existing resource group"))
+			}
+			if azure.Networks.VNet.CIDR != nil {
+				allErrs = append(allErrs, field.Invalid(azurePath.Child("networks", "vnet"), azure.Networks.VNet, "must specify either a vnet name or a cidr"))
+			}
 		} else {
 			if azure.Networks.VNet.CIDR == nil {
 				allErrs = append(allErrs, field.Required(azurePath.Child("networks", "vnet", "cidr"), "must specify a vnet cidr"))
@@ -1201,20 +1763,15 @@ func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
 				allErrs = append(allErrs, vpcCIDR.ValidateSubset(nodes)...)
 				allErrs = append(allErrs, vpcCIDR.ValidateSubset(workerCIDR)...)
 				allErrs = append(allErrs, vpcCIDR.ValidateNotSubset(pods, services)...)
+				vnetCIDR = vpcCIDR
 			}
 		}
 
-		// TODO: re-enable once deployment into existing resource group works properly.
-		// if azure.ResourceGroup != nil && len(azure.ResourceGroup.Name) == 0 {
-		// 	allErrs = append(allErrs, field.Invalid(azurePath.Child("resourceGroup", "name"), azure.ResourceGroup.Name, "resource group name must not be empty when resource group key is provided"))
-		// }
-
-		// TODO: re-enable once deployment into existing vnet works properly.
-		// if (azure.Networks.VNet.Name == nil && azure.Networks.VNet.CIDR == nil) || (azure.Networks.VNet.Name != nil && azure.Networks.VNet.CIDR != nil) {
-		// 	allErrs = append(allErrs, field.Invalid(azurePath.Child("networks", "vnet"), azure.Networks.VNet, "must specify either a vnet name or a cidr"))
-		// } else if azure.Networks.VNet.CIDR != nil && azure.Networks.VNet.Name == nil {
-		// 	allErrs = append(allErrs, validateCIDR(*(azure.Networks.VNet.CIDR), azurePath.Child("networks", "vnet", "cidr"))...)
-		// }
+		if peering := azure.Networks.VNet.Peering; peering != nil {
+			// For an existing vnet the address space is not known, hence, only the networks of the shoot can be checked.
+			shootCIDRs := []cidrvalidation.CIDR{workerCIDR, vnetCIDR, pods, services}
+			allErrs = append(allErrs, validateAzureVNetPeering(peering, shootCIDRs, azurePath.Child("networks", "vnet", "peering"))...)
+		}
 
 		workersPath := azurePath.Child("workers")
 		if len(azure.Workers) == 0 {
@@ -1226,6 +1783,16 @@ func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
 		for i, worker := range azure.Workers {
 			idxPath := workersPath.Index(i)
 			allErrs = append(allErrs, ValidateWorker(worker.Worker, idxPath)...)
+			allErrs = append(allErrs, validateWorkerCapacityUnsupported(worker.Worker, "Azure", idxPath)...)
+			allErrs = append(allErrs, validateWorkerDataVolumesUnsupported(worker.Worker, "Azure", idxPath)...)
+			allErrs = append(allErrs, validateWorkerInstanceTagLabelsUnsupported(worker.Worker, "Azure", idxPath)...)
+			allErrs = append(allErrs, validateWorkerArchitectureUnsupported(worker.Worker, "Azure", idxPath)...)
+			if len(worker.Zones) > 0 {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("zones"), "zones are not supported for Azure workers"))
+			}
+			if worker.CustomMachineImage != nil && len(strings.Split(*worker.CustomMachineImage, ":")) != 4 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("customMachineImage"), *worker.CustomMachineImage, "must have the form <publisher>:<offer>:<sku>:<version>"))
+			}
 			allErrs = append(allErrs, validateWorkerVolumeSize(worker.VolumeSize, idxPath.Child("volumeSize"))...)
 			allErrs = append(allErrs, validateWorkerMinimumVolumeSize(worker.VolumeSize, 35, idxPath.Child("volumeSize"))...)
 			allErrs = append(allErrs, validateWorkerVolumeType(worker.VolumeType, idxPath.Child("volumeType"))...)
@@ -1278,6 +1845,10 @@ func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
 			allErrs = append(allErrs, field.Invalid(gcpPath.Child("networks", "vpc", "name"), gcp.Networks.VPC.Name, "vpc name must not be empty when vpc key is provided"))
 		}
 
+		if gcp.Networks.CloudNAT != nil {
+			allErrs = append(allErrs, validateGCPCloudNAT(gcp.Networks.CloudNAT, gcpPath.Child("networks", "cloudNAT"))...)
+		}
+
 		workersPath := gcpPath.Child("workers")
 		if len(gcp.Workers) == 0 {
 			allErrs = append(allErrs, field.Required(workersPath, "must specify at least one worker"))
@@ -1288,6 +1859,10 @@ func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
 		for i, worker := range gcp.Workers {
 			idxPath := workersPath.Index(i)
 			allErrs = append(allErrs, ValidateWorker(worker.Worker, idxPath)...)
+			allErrs = append(allErrs, validateGCPWorkerDataVolumes(worker.Worker, idxPath.Child("dataVolumes"))...)
+			allErrs = append(allErrs, validateGCPWorkerInstanceTagLabels(worker.Worker, cloud.Tags, idxPath.Child("instanceTagLabels"))...)
+			allErrs = append(allErrs, validateWorkerArchitectureUnsupported(worker.Worker, "GCP", idxPath)...)
+			allErrs = append(allErrs, validateWorkerZones(worker.Zones, gcp.Zones, idxPath.Child("zones"))...)
 			allErrs = append(allErrs, validateWorkerVolumeSize(worker.VolumeSize, idxPath.Child("volumeSize"))...)
 			allErrs = append(allErrs, validateWorkerMinimumVolumeSize(worker.VolumeSize, 20, idxPath.Child("volumeSize"))...)
 			allErrs = append(allErrs, validateWorkerVolumeType(worker.VolumeType, idxPath.Child("volumeType"))...)
@@ -1307,6 +1882,10 @@ func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
 			allErrs = append(allErrs, field.Required(openStackPath.Child("floatingPoolName"), "must specify a floating pool name"))
 		}
 
+		if openStack.FloatingPoolSubnetName != nil && len(*openStack.FloatingPoolSubnetName) == 0 {
+			allErrs = append(allErrs, field.Invalid(openStackPath.Child("floatingPoolSubnetName"), *openStack.FloatingPoolSubnetName, "floating pool subnet name must not be empty when specified"))
+		}
+
 		if len(openStack.LoadBalancerProvider) == 0 {
 			allErrs = append(allErrs, field.Required(openStackPath.Child("loadBalancerProvider"), "must specify a load balancer provider"))
 		}
@@ -1351,6 +1930,11 @@ func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
 		for i, worker := range openStack.Workers {
 			idxPath := workersPath.Index(i)
 			allErrs = append(allErrs, ValidateWorker(worker.Worker, idxPath)...)
+			allErrs = append(allErrs, validateWorkerCapacityUnsupported(worker.Worker, "OpenStack", idxPath)...)
+			allErrs = append(allErrs, validateWorkerDataVolumesUnsupported(worker.Worker, "OpenStack", idxPath)...)
+			allErrs = append(allErrs, validateWorkerInstanceTagLabelsUnsupported(worker.Worker, "OpenStack", idxPath)...)
+			allErrs = append(allErrs, validateWorkerArchitectureUnsupported(worker.Worker, "OpenStack", idxPath)...)
+			allErrs = append(allErrs, validateWorkerZones(worker.Zones, openStack.Zones, idxPath.Child("zones"))...)
 			if workerNames[worker.Name] {
 				allErrs = append(allErrs, field.Duplicate(idxPath, worker.Name))
 			}
@@ -1399,6 +1983,21 @@ func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
 			allErrs = append(allErrs, vpcCIDR.ValidateNotSubset(pods, services)...)
 		}
 
+		if loadBalancer := alicloud.APIServerLoadBalancer; loadBalancer != nil {
+			allErrs = append(allErrs, validateAlicloudLoadBalancer(loadBalancer, alicloudPath.Child("apiServerLoadBalancer"))...)
+			// The kube-apiserver must be reachable from the Seed and the Garden cluster.
+			if loadBalancer.AddressType != nil && *loadBalancer.AddressType == "intranet" {
+				allErrs = append(allErrs, field.Forbidden(alicloudPath.Child("apiServerLoadBalancer", "addressType"), "the kube-apiserver cannot be exposed by an intranet load balancer"))
+			}
+		}
+		if alicloud.ServiceLoadBalancer != nil {
+			allErrs = append(allErrs, validateAlicloudLoadBalancer(alicloud.ServiceLoadBalancer, alicloudPath.Child("serviceLoadBalancer"))...)
+		}
+
+		if alicloud.Networks.NatGateway != nil {
+			allErrs = append(allErrs, validateAlicloudNatGateway(alicloud.Networks.NatGateway, alicloud.Zones, alicloudPath.Child("networks", "natGateway"))...)
+		}
+
 		if len(alicloud.Workers) == 0 {
 			allErrs = append(allErrs, field.Required(alicloudPath.Child("workers"), "must specify at least one worker"))
 			return allErrs
@@ -1406,6 +2005,10 @@ func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
 		for i, worker := range alicloud.Workers {
 			idxPath := alicloudPath.Child("workers").Index(i)
 			allErrs = append(allErrs, ValidateWorker(worker.Worker, idxPath)...)
+			allErrs = append(allErrs, validateWorkerDataVolumesUnsupported(worker.Worker, "Alicloud", idxPath)...)
+			allErrs = append(allErrs, validateWorkerInstanceTags(worker.Worker, cloud.Tags, alicloudInstanceTagConstraints, idxPath.Child("instanceTagLabels"))...)
+			allErrs = append(allErrs, validateWorkerArchitectureUnsupported(worker.Worker, "Alicloud", idxPath)...)
+			allErrs = append(allErrs, validateWorkerZones(worker.Zones, alicloud.Zones, idxPath.Child("zones"))...)
 			allErrs = append(allErrs, validateWorkerVolumeSize(worker.VolumeSize, idxPath.Child("volumeSize"))...)
 			allErrs = append(allErrs, validateWorkerMinimumVolumeSize(worker.VolumeSize, 30, idxPath.Child("volumeSize"))...)
 			allErrs = append(allErrs, validateWorkerVolumeType(worker.VolumeType, idxPath.Child("volumeType"))...)
@@ -1436,9 +2039,17 @@ func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
 		for i, worker := range packet.Workers {
 			idxPath := packetPath.Child("workers").Index(i)
 			allErrs = append(allErrs, ValidateWorker(worker.Worker, idxPath)...)
+			allErrs = append(allErrs, validateWorkerCapacityUnsupported(worker.Worker, "Packet", idxPath)...)
+			allErrs = append(allErrs, validateWorkerDataVolumesUnsupported(worker.Worker, "Packet", idxPath)...)
+			allErrs = append(allErrs, validateWorkerInstanceTagLabelsUnsupported(worker.Worker, "Packet", idxPath)...)
+			allErrs = append(allErrs, validateWorkerArchitectureUnsupported(worker.Worker, "Packet", idxPath)...)
+			allErrs = append(allErrs, validateWorkerZones(worker.Zones, packet.Zones, idxPath.Child("zones"))...)
 			allErrs = append(allErrs, validateWorkerVolumeSize(worker.VolumeSize, idxPath.Child("volumeSize"))...)
 			allErrs = append(allErrs, validateWorkerMinimumVolumeSize(worker.VolumeSize, 20, idxPath.Child("volumeSize"))...)
 			allErrs = append(allErrs, validateWorkerVolumeType(worker.VolumeType, idxPath.Child("volumeType"))...)
+			if worker.CustomMachineImage != nil {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("customMachineImage"), "custom machine images are not supported for Packet"))
+			}
 			if workerNames[worker.Name] {
 				allErrs = append(allErrs, field.Duplicate(idxPath, worker.Name))
 			}
@@ -1450,6 +2061,73 @@ func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
 	return allErrs
 }
 
+// shootSpecUpdateRule is a rule for a field of the Shoot specification which must not change after the creation of
+// the Shoot. A rule with a cloud provider only applies to Shoots of this provider.
+type shootSpecUpdateRule struct {
+	path     string
+	provider garden.CloudProvider
+	// values returns the new and the old value of the field.
+	values func(newSpec, oldSpec *garden.ShootSpec) (interface{}, interface{})
+}
+
+// immutableShootSpecFields contains the rules for all fields of the Shoot specification which are immutable. The
+// networks of providers with zones are compared only for the zones which are kept, as zones may be added to or
+// removed from the end of the list. All fields which are not listed here may be changed freely, except for the
+// worker pools whose deletion must be confirmed (see validateWorkerPoolDeletion).
+var immutableShootSpecFields = []shootSpecUpdateRule{
+	{path: "cloud.profile", values: func(n, o *garden.ShootSpec) (interface{}, interface{}) { return n.Cloud.Profile, o.Cloud.Profile }},
+	{path: "cloud.region", values: func(n, o *garden.ShootSpec) (interface{}, interface{}) { return n.Cloud.Region, o.Cloud.Region }},
+	{path: "cloud.secretBindingRef", values: func(n, o *garden.ShootSpec) (interface{}, interface{}) {
+		return n.Cloud.SecretBindingRef, o.Cloud.SecretBindingRef
+	}},
+	{path: "cloud.seed", values: func(n, o *garden.ShootSpec) (interface{}, interface{}) { return n.Cloud.Seed, o.Cloud.Seed }},
+	{path: "cloud.aws.networks", provider: garden.CloudProviderAWS, values: func(n, o *garden.ShootSpec) (interface{}, interface{}) {
+		length := zonePrefixLength(n.Cloud.AWS.Zones, o.Cloud.AWS.Zones)
+		newNetworks, oldNetworks := n.Cloud.AWS.Networks, o.Cloud.AWS.Networks
+		newNetworks.Internal, oldNetworks.Internal = truncateZoneCIDRs(newNetworks.Internal, length), truncateZoneCIDRs(oldNetworks.Internal, length)
+		newNetworks.Public, oldNetworks.Public = truncateZoneCIDRs(newNetworks.Public, length), truncateZoneCIDRs(oldNetworks.Public, length)
+		newNetworks.Workers, oldNetworks.Workers = truncateZoneCIDRs(newNetworks.Workers, length), truncateZoneCIDRs(oldNetworks.Workers, length)
+		return newNetworks, oldNetworks
+	}},
+	{path: "cloud.azure.resourceGroup", provider: garden.CloudProviderAzure, values: func(n, o *garden.ShootSpec) (interface{}, interface{}) {
+		return n.Cloud.Azure.ResourceGroup, o.Cloud.Azure.ResourceGroup
+	}},
+	{path: "cloud.azure.networks", provider: garden.CloudProviderAzure, values: func(n, o *garden.ShootSpec) (interface{}, interface{}) {
+		return n.Cloud.Azure.Networks, o.Cloud.Azure.Networks
+	}},
+	{path: "cloud.gcp.networks", provider: garden.CloudProviderGCP, values: func(n, o *garden.ShootSpec) (interface{}, interface{}) {
+		length := zonePrefixLength(n.Cloud.GCP.Zones, o.Cloud.GCP.Zones)
+		newNetworks, oldNetworks := n.Cloud.GCP.Networks, o.Cloud.GCP.Networks
+		newNetworks.Workers, oldNetworks.Workers = truncateZoneCIDRs(newNetworks.Workers, length), truncateZoneCIDRs(oldNetworks.Workers, length)
+		return newNetworks, oldNetworks
+	}},
+	{path: "cloud.openstack.networks", provider: garden.CloudProviderOpenStack, values: func(n, o *garden.ShootSpec) (interface{}, interface{}) {
+		length := zonePrefixLength(n.Cloud.OpenStack.Zones, o.Cloud.OpenStack.Zones)
+		newNetworks, oldNetworks := n.Cloud.OpenStack.Networks, o.Cloud.OpenStack.Networks
+		newNetworks.Workers, oldNetworks.Workers = truncateZoneCIDRs(newNetworks.Workers, length), truncateZoneCIDRs(oldNetworks.Workers, length)
+		return newNetworks, oldNetworks
+	}},
+	{path: "cloud.alicloud.networks", provider: garden.CloudProviderAlicloud, values: func(n, o *garden.ShootSpec) (interface{}, interface{}) {
+		length := zonePrefixLength(n.Cloud.Alicloud.Zones, o.Cloud.Alicloud.Zones)
+		newNetworks, oldNetworks := n.Cloud.Alicloud.Networks, o.Cloud.Alicloud.Networks
+		newNetworks.Workers, oldNetworks.Workers = truncateZoneCIDRs(newNetworks.Workers, length), truncateZoneCIDRs(oldNetworks.Workers, length)
+		return newNetworks, oldNetworks
+	}},
+	{path: "cloud.packet.networks", provider: garden.CloudProviderPacket, values: func(n, o *garden.ShootSpec) (interface{}, interface{}) {
+		return n.Cloud.Packet.Networks, o.Cloud.Packet.Networks
+	}},
+	{path: "cloud.packet.zones", provider: garden.CloudProviderPacket, values: func(n, o *garden.ShootSpec) (interface{}, interface{}) {
+		return n.Cloud.Packet.Zones, o.Cloud.Packet.Zones
+	}},
+	{path: "kubernetes.kubeControllerManager.nodeCIDRMaskSize", values: func(n, o *garden.ShootSpec) (interface{}, interface{}) {
+		// The pod CIDRs of existing nodes have already been allocated with the old mask size, hence, it must not
+		// change. An unset mask size is equivalent to the default one.
+		return nodeCIDRMaskSize(n), nodeCIDRMaskSize(o)
+	}},
+	{path: "dns.provider", values: func(n, o *garden.ShootSpec) (interface{}, interface{}) { return n.DNS.Provider, o.DNS.Provider }},
+	{path: "dns.domain", values: func(n, o *garden.ShootSpec) (interface{}, interface{}) { return n.DNS.Domain, o.DNS.Domain }},
+}
+
 // ValidateShootSpecUpdate validates the specification of a Shoot object.
 func ValidateShootSpecUpdate(newSpec, oldSpec *garden.ShootSpec, deletionTimestampSet bool, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -1459,114 +2137,185 @@ func ValidateShootSpecUpdate(newSpec, oldSpec *garden.ShootSpec, deletionTimesta
 		return allErrs
 	}
 
-	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.SecretBindingRef, oldSpec.Cloud.SecretBindingRef, fldPath.Child("cloud", "secretBindingRef"))...)
-	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.Profile, oldSpec.Cloud.Profile, fldPath.Child("cloud", "profile"))...)
-	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.Region, oldSpec.Cloud.Region, fldPath.Child("cloud", "region"))...)
-	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.Seed, oldSpec.Cloud.Seed, fldPath.Child("cloud", "seed"))...)
-
-	awsPath := fldPath.Child("cloud", "aws")
-	if oldSpec.Cloud.AWS != nil && newSpec.Cloud.AWS == nil {
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.AWS, oldSpec.Cloud.AWS, awsPath)...)
+	// The cloud provider type is immutable. All provider specific rules require the same provider in both
+	// specifications, hence, no further rules are checked if it changed.
+	oldProvider, err := helper.DetermineCloudProviderInShoot(oldSpec.Cloud)
+	if err != nil {
 		return allErrs
-	} else if newSpec.Cloud.AWS != nil {
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.AWS.Networks, oldSpec.Cloud.AWS.Networks, awsPath.Child("networks"))...)
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.AWS.Zones, oldSpec.Cloud.AWS.Zones, awsPath.Child("zones"))...)
 	}
-
-	azurePath := fldPath.Child("cloud", "azure")
-	if oldSpec.Cloud.Azure != nil && newSpec.Cloud.Azure == nil {
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.Azure, oldSpec.Cloud.Azure, azurePath)...)
+	newProvider, _ := helper.DetermineCloudProviderInShoot(newSpec.Cloud)
+	if newProvider != oldProvider {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("cloud", string(oldProvider)), newProvider, fmt.Sprintf("field is immutable, the cloud provider type %q cannot be changed", oldProvider)))
 		return allErrs
-	} else if newSpec.Cloud.Azure != nil {
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.Azure.ResourceGroup, oldSpec.Cloud.Azure.ResourceGroup, azurePath.Child("resourceGroup"))...)
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.Azure.Networks, oldSpec.Cloud.Azure.Networks, azurePath.Child("networks"))...)
 	}
 
-	gcpPath := fldPath.Child("cloud", "gcp")
-	if oldSpec.Cloud.GCP != nil && newSpec.Cloud.GCP == nil {
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.GCP, oldSpec.Cloud.GCP, gcpPath)...)
-		return allErrs
-	} else if newSpec.Cloud.GCP != nil {
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.GCP.Networks, oldSpec.Cloud.GCP.Networks, gcpPath.Child("networks"))...)
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.GCP.Zones, oldSpec.Cloud.GCP.Zones, gcpPath.Child("zones"))...)
+	for _, rule := range immutableShootSpecFields {
+		if len(rule.provider) > 0 && rule.provider != oldProvider {
+			continue
+		}
+		newValue, oldValue := rule.values(newSpec, oldSpec)
+		allErrs = append(allErrs, validateImmutableShootSpecField(newValue, oldValue, fieldPath(fldPath, rule.path))...)
 	}
 
-	openStackPath := fldPath.Child("cloud", "openstack")
-	if oldSpec.Cloud.OpenStack != nil && newSpec.Cloud.OpenStack == nil {
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.OpenStack, oldSpec.Cloud.OpenStack, openStackPath)...)
-		return allErrs
-	} else if newSpec.Cloud.OpenStack != nil {
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.OpenStack.Networks, oldSpec.Cloud.OpenStack.Networks, openStackPath.Child("networks"))...)
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.OpenStack.Zones, oldSpec.Cloud.OpenStack.Zones, openStackPath.Child("zones"))...)
+	if zonesPath := fldPath.Child("cloud", string(oldProvider), "zones"); oldProvider != garden.CloudProviderPacket && oldProvider != garden.CloudProviderLocal {
+		allErrs = append(allErrs, validateZonesUpdate(helper.GetShootZones(newSpec.Cloud), helper.GetShootZones(oldSpec.Cloud), zonesPath)...)
 	}
+	allErrs = append(allErrs, validateKubernetesVersionUpdate(newSpec.Kubernetes.Version, oldSpec.Kubernetes.Version, fldPath.Child("kubernetes", "version"))...)
+
+	return allErrs
+}
+
+// validateImmutableShootSpecField returns an error if the <newValue> of an immutable field differs from its <oldValue>.
+// For structured values, the message lists the nested fields which have been changed.
+func validateImmutableShootSpecField(newValue, oldValue interface{}, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
 
-	alicloudPath := fldPath.Child("cloud", "alicloud")
-	if oldSpec.Cloud.Alicloud != nil && newSpec.Cloud.Alicloud == nil {
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.Alicloud, oldSpec.Cloud.Alicloud, alicloudPath)...)
+	if apiequality.Semantic.DeepEqual(newValue, oldValue) {
 		return allErrs
-	} else if newSpec.Cloud.Alicloud != nil {
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.Alicloud.Networks, oldSpec.Cloud.Alicloud.Networks, alicloudPath.Child("networks"))...)
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.Alicloud.Zones, oldSpec.Cloud.Alicloud.Zones, alicloudPath.Child("zones"))...)
 	}
 
-	packetPath := fldPath.Child("cloud", "packet")
-	if oldSpec.Cloud.Packet != nil && newSpec.Cloud.Packet == nil {
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.Packet, oldSpec.Cloud.Packet, packetPath)...)
-		return allErrs
-	} else if newSpec.Cloud.Packet != nil {
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.Packet.Networks, oldSpec.Cloud.Packet.Networks, packetPath.Child("networks"))...)
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.Packet.Zones, oldSpec.Cloud.Packet.Zones, packetPath.Child("zones"))...)
+	message := "field is immutable"
+	if changed := changedFields(newValue, oldValue); len(changed) > 0 {
+		message += fmt.Sprintf(", changed fields: %s", strings.Join(changed, ", "))
 	}
+	return append(allErrs, field.Invalid(fldPath, newValue, message))
+}
 
-	allErrs = append(allErrs, validateDNSUpdate(newSpec.DNS, oldSpec.DNS, fldPath.Child("dns"))...)
-	allErrs = append(allErrs, validateKubernetesVersionUpdate(newSpec.Kubernetes.Version, oldSpec.Kubernetes.Version, fldPath.Child("kubernetes", "version"))...)
+// changedFields returns the paths of the nested fields which differ between the JSON representations of <newValue>
+// and <oldValue>. It returns nothing if the values are not JSON objects.
+func changedFields(newValue, oldValue interface{}) []string {
+	var newObj, oldObj interface{}
+	if newData, err := json.Marshal(newValue); err != nil || json.Unmarshal(newData, &newObj) != nil {
+		return nil
+	}
+	if oldData, err := json.Marshal(oldValue); err != nil || json.Unmarshal(oldData, &oldObj) != nil {
+		return nil
+	}
 
-	return allErrs
+	newMap, newOK := newObj.(map[string]interface{})
+	oldMap, oldOK := oldObj.(map[string]interface{})
+	if !newOK || !oldOK {
+		return nil
+	}
+
+	changed := sets.NewString()
+	collectChangedFields(newMap, oldMap, "", changed)
+	return changed.List()
 }
 
-func validateDNSUpdate(new, old garden.DNS, fldPath *field.Path) field.ErrorList {
-	allErrs := field.ErrorList{}
+func collectChangedFields(newMap, oldMap map[string]interface{}, prefix string, changed sets.String) {
+	keys := sets.StringKeySet(newMap).Union(sets.StringKeySet(oldMap))
+	for _, key := range keys.List() {
+		newValue, oldValue := newMap[key], oldMap[key]
+		if apiequality.Semantic.DeepEqual(newValue, oldValue) {
+			continue
+		}
+		newNested, newOK := newValue.(map[string]interface{})
+		oldNested, oldOK := oldValue.(map[string]interface{})
+		if newOK && oldOK {
+			collectChangedFields(newNested, oldNested, prefix+apiFieldName(key)+".", changed)
+			continue
+		}
+		changed.Insert(prefix + apiFieldName(key))
+	}
+}
 
-	if new.Provider != old.Provider {
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Provider, old.Provider, fldPath.Child("provider"))...)
+// apiFieldName converts the name of a field of the internal API types to the name of the field in the versioned API,
+// e.g. 'VPC' to 'vpc' or 'InternetGatewayID' to 'internetGatewayID'.
+func apiFieldName(name string) string {
+	upper := 0
+	for upper < len(name) && unicode.IsUpper(rune(name[upper])) {
+		upper++
 	}
-	if new.Domain != old.Domain {
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Domain, old.Domain, fldPath.Child("domain"))...)
+	if upper > 1 && upper < len(name) {
+		upper--
 	}
+	return strings.ToLower(name[:upper]) + name[upper:]
+}
 
-	return allErrs
+// fieldPath returns the path of a dot-separated field relative to <fldPath>.
+func fieldPath(fldPath *field.Path, path string) *field.Path {
+	for _, name := range strings.Split(path, ".") {
+		fldPath = fldPath.Child(name)
+	}
+	return fldPath
 }
 
-func validateKubernetesVersionUpdate(new, old string, fldPath *field.Path) field.ErrorList {
+// validateWorkerPoolDeletion validates that the deletion of worker pools is confirmed by listing their names in the
+// worker pool deletion confirmation annotation of the Shoot, as the nodes of the pools are deleted together with
+// their workload.
+func validateWorkerPoolDeletion(newShoot, oldShoot *garden.Shoot) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	if len(new) == 0 {
-		allErrs = append(allErrs, field.Invalid(fldPath, new, "cannot validate kubernetes version upgrade because it is unset"))
+	oldProvider, err := helper.DetermineCloudProviderInShoot(oldShoot.Spec.Cloud)
+	if err != nil {
+		return allErrs
+	}
+	if newProvider, _ := helper.DetermineCloudProviderInShoot(newShoot.Spec.Cloud); newProvider != oldProvider {
 		return allErrs
 	}
 
-	// Forbid Kubernetes version downgrade
-	downgrade, err := utils.CompareVersions(new, "<", old)
-	if err != nil {
-		allErrs = append(allErrs, field.Invalid(fldPath, new, err.Error()))
+	newPools := sets.NewString()
+	for _, worker := range helper.GetShootWorkers(newShoot.Spec.Cloud) {
+		newPools.Insert(worker.Name)
 	}
-	if downgrade {
-		allErrs = append(allErrs, field.Forbidden(fldPath, "kubernetes version downgrade is not supported"))
+	removedPools := sets.NewString()
+	for _, worker := range helper.GetShootWorkers(oldShoot.Spec.Cloud) {
+		if !newPools.Has(worker.Name) {
+			removedPools.Insert(worker.Name)
+		}
 	}
 
-	// Forbid Kubernetes version upgrade which skips a minor version
-	oldVersion, err := semver.NewVersion(old)
-	if err != nil {
-		allErrs = append(allErrs, field.Invalid(fldPath, old, err.Error()))
+	confirmedPools := sets.NewString()
+	for _, name := range strings.Split(newShoot.Annotations[common.ConfirmationWorkerPoolDeletion], ",") {
+		confirmedPools.Insert(strings.TrimSpace(name))
 	}
-	nextMinorVersion := oldVersion.IncMinor().IncMinor()
 
-	skippingMinorVersion, err := utils.CompareVersions(new, ">=", nextMinorVersion.String())
-	if err != nil {
-		allErrs = append(allErrs, field.Invalid(fldPath, new, err.Error()))
+	if unconfirmed := removedPools.Difference(confirmedPools); unconfirmed.Len() > 0 {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "cloud", string(oldProvider), "workers"),
+			fmt.Sprintf("deleting the worker pools %s deletes their nodes and must be confirmed by listing them in the annotation %s", strings.Join(unconfirmed.List(), ", "), common.ConfirmationWorkerPoolDeletion)))
+	}
+
+	return allErrs
+}
+
+// zonePrefixLength returns the number of zones which are kept when the list of zones changes from <oldZones> to
+// <newZones>. Zones may only be added to or removed from the end of the list.
+func zonePrefixLength(newZones, oldZones []string) int {
+	if len(newZones) < len(oldZones) {
+		return len(newZones)
+	}
+	return len(oldZones)
+}
+
+// truncateZoneCIDRs returns the per-zone networks of the first <length> zones.
+func truncateZoneCIDRs(cidrs []gardencore.CIDR, length int) []gardencore.CIDR {
+	if len(cidrs) > length {
+		return cidrs[:length]
+	}
+	return cidrs
+}
+
+// validateZonesUpdate validates that the zones of a Shoot are only changed by adding zones to or removing zones from
+// the end of the list. This keeps the index-based subnets and machine deployments of the remaining zones stable.
+func validateZonesUpdate(newZones, oldZones []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	length := zonePrefixLength(newZones, oldZones)
+	if !apiequality.Semantic.DeepEqual(newZones[:length], oldZones[:length]) {
+		allErrs = append(allErrs, field.Invalid(fldPath, newZones, "zones may only be added to or removed from the end of the list"))
 	}
-	if skippingMinorVersion {
-		allErrs = append(allErrs, field.Forbidden(fldPath, "kubernetes version upgrade cannot skip a minor version"))
+
+	return allErrs
+}
+
+// validateKubernetesVersionUpdate validates the update of the Kubernetes version. Downgrades and upgrades which skip a
+// minor version are rejected by the ShootVersionSkew admission plugin which allows operators to bypass these checks.
+func validateKubernetesVersionUpdate(new, old string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(new) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, new, "cannot validate kubernetes version upgrade because it is unset"))
 	}
 
 	return allErrs
@@ -1593,149 +2342,567 @@ func validateDNS(dns garden.DNS, fldPath *field.Path) field.ErrorList {
 		allErrs = append(allErrs, field.Required(fldPath.Child("provider"), "`.spec.dns.provider` must be set when `.spec.dns.secretName` is set"))
 	}
 
-	return allErrs
-}
-
-func validateCIDRParse(cidrPaths ...cidrvalidation.CIDR) (allErrs field.ErrorList) {
-	for _, cidrPath := range cidrPaths {
-		if cidrPath == nil {
-			continue
-		}
-		allErrs = append(allErrs, cidrPath.ValidateParse()...)
+	if ttl := dns.TTL; ttl != nil && (*ttl < minDNSRecordTTL || *ttl > maxDNSRecordTTL) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ttl"), *ttl, fmt.Sprintf("must be between %d and %d seconds", minDNSRecordTTL, maxDNSRecordTTL)))
 	}
-	return allErrs
-}
 
-func validateCIDROVerlap(leftPaths, rightPaths []cidrvalidation.CIDR, overlap bool) (allErrs field.ErrorList) {
-	for _, left := range leftPaths {
-		if left == nil {
-			continue
-		}
-		if overlap {
-			allErrs = append(allErrs, left.ValidateSubset(rightPaths...)...)
-		} else {
-			allErrs = append(allErrs, left.ValidateNotSubset(rightPaths...)...)
-		}
-	}
 	return allErrs
 }
 
-func transformK8SNetworks(networks gardencore.K8SNetworks, fldPath *field.Path) (nodes, pods, services cidrvalidation.CIDR, allErrs field.ErrorList) {
-	cidrs := []cidrvalidation.CIDR{}
-
-	if networks.Nodes != nil {
-		nodes = cidrvalidation.NewCIDR(*networks.Nodes, fldPath.Child("nodes"))
-		allErrs = append(allErrs, nodes.ValidateParse()...)
-		cidrs = append(cidrs, nodes)
-	} else {
-		allErrs = append(allErrs, field.Required(fldPath.Child("nodes"), "nodes CIDR cannot be unset"))
-	}
-
-	if networks.Pods != nil {
-		pods = cidrvalidation.NewCIDR(*networks.Pods, fldPath.Child("pods"))
-		allErrs = append(allErrs, pods.ValidateParse()...)
-		cidrs = append(cidrs, pods)
-	} else {
-		allErrs = append(allErrs, field.Required(fldPath.Child("pods"), "pods CIDR cannot be unset"))
-	}
-
-	if networks.Services != nil {
-		services = cidrvalidation.NewCIDR(*networks.Services, fldPath.Child("services"))
-		allErrs = append(allErrs, services.ValidateParse()...)
-		cidrs = append(cidrs, services)
-	} else {
-		allErrs = append(allErrs, field.Required(fldPath.Child("services"), "services CIDR cannot be unset"))
-	}
-	allErrs = append(allErrs, validateCIDROVerlap(cidrs, cidrs, false)...)
-
-	return nodes, pods, services, allErrs
-}
-
-func validateKubernetes(kubernetes garden.Kubernetes, fldPath *field.Path) field.ErrorList {
+// validateAWSExistingNetworkResources validates the references to existing subnets, NAT and internet gateways which can
+// only be used in combination with an existing VPC.
+func validateAWSExistingNetworkResources(aws *garden.AWSCloud, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	if len(kubernetes.Version) == 0 {
-		allErrs = append(allErrs, field.Required(fldPath.Child("version"), "kubernetes version must not be empty"))
-		return allErrs
+	if igwID := aws.Networks.VPC.InternetGatewayID; igwID != nil {
+		if aws.Networks.VPC.ID == nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("vpc", "internetGatewayID"), "an internet gateway can only be specified for an existing vpc"))
+		} else if len(*igwID) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("vpc", "internetGatewayID"), *igwID, "internet gateway id must not be empty when providing the key"))
+		}
 	}
 
-	if kubeAPIServer := kubernetes.KubeAPIServer; kubeAPIServer != nil {
-		if oidc := kubeAPIServer.OIDCConfig; oidc != nil {
-			oidcPath := fldPath.Child("kubeAPIServer", "oidcConfig")
+	var (
+		zones               = sets.NewString(aws.Zones...)
+		existingSubnetZones = sets.NewString()
+		existingNATZones    = sets.NewString()
+		existingVPC         = aws.Networks.VPC.ID != nil
+		existingSubnetsPath = fldPath.Child("existingSubnets")
+		existingNATPath     = fldPath.Child("existingNATGateways")
+	)
 
-			geqKubernetes111, err := utils.CheckVersionMeetsConstraint(kubernetes.Version, ">= 1.11")
-			if err != nil {
-				geqKubernetes111 = false
-			}
+	if len(aws.Networks.ExistingSubnets) > 0 && !existingVPC {
+		allErrs = append(allErrs, field.Forbidden(existingSubnetsPath, "existing subnets can only be used in combination with an existing vpc"))
+	} else {
+		for i, subnets := range aws.Networks.ExistingSubnets {
+			idxPath := existingSubnetsPath.Index(i)
 
-			if oidc.CABundle != nil {
-				if _, err := utils.DecodeCertificate([]byte(*oidc.CABundle)); err != nil {
-					allErrs = append(allErrs, field.Invalid(oidcPath.Child("caBundle"), *oidc.CABundle, "caBundle is not a valid PEM-encoded certificate"))
-				}
+			if !zones.Has(subnets.Zone) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("zone"), subnets.Zone, zones.List()))
 			}
-			if oidc.ClientID != nil && len(*oidc.ClientID) == 0 {
-				allErrs = append(allErrs, field.Invalid(oidcPath.Child("clientID"), *oidc.ClientID, "client id cannot be empty when key is provided"))
+			if existingSubnetZones.Has(subnets.Zone) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("zone"), subnets.Zone))
 			}
-			if oidc.GroupsClaim != nil && len(*oidc.GroupsClaim) == 0 {
-				allErrs = append(allErrs, field.Invalid(oidcPath.Child("groupsClaim"), *oidc.GroupsClaim, "groups claim cannot be empty when key is provided"))
+			existingSubnetZones.Insert(subnets.Zone)
+
+			if len(subnets.Internal) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("internal"), "must specify the id of the internal subnet"))
 			}
-			if oidc.GroupsPrefix != nil && len(*oidc.GroupsPrefix) == 0 {
-				allErrs = append(allErrs, field.Invalid(oidcPath.Child("groupsPrefix"), *oidc.GroupsPrefix, "groups prefix cannot be empty when key is provided"))
+			if len(subnets.Public) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("public"), "must specify the id of the public subnet"))
 			}
-			if oidc.IssuerURL != nil && len(*oidc.IssuerURL) == 0 {
-				allErrs = append(allErrs, field.Invalid(oidcPath.Child("issuerURL"), *oidc.IssuerURL, "issuer url cannot be empty when key is provided"))
+			if len(subnets.Workers) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("workers"), "must specify the id of the workers subnet"))
 			}
-			if oidc.SigningAlgs != nil && len(oidc.SigningAlgs) == 0 {
-				allErrs = append(allErrs, field.Invalid(oidcPath.Child("signingAlgs"), oidc.SigningAlgs, "signings algs cannot be empty when key is provided"))
+		}
+	}
+
+	if len(aws.Networks.ExistingNATGateways) > 0 && !existingVPC {
+		allErrs = append(allErrs, field.Forbidden(existingNATPath, "existing nat gateways can only be used in combination with an existing vpc"))
+	} else {
+		for i, natGateway := range aws.Networks.ExistingNATGateways {
+			idxPath := existingNATPath.Index(i)
+
+			if !zones.Has(natGateway.Zone) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("zone"), natGateway.Zone, zones.List()))
 			}
-			if !geqKubernetes111 && oidc.RequiredClaims != nil {
-				allErrs = append(allErrs, field.Forbidden(oidcPath.Child("requiredClaims"), "required claims cannot be provided when version is not greater or equal 1.11"))
+			if existingNATZones.Has(natGateway.Zone) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("zone"), natGateway.Zone))
 			}
-			if oidc.UsernameClaim != nil && len(*oidc.UsernameClaim) == 0 {
-				allErrs = append(allErrs, field.Invalid(oidcPath.Child("usernameClaim"), *oidc.UsernameClaim, "username claim cannot be empty when key is provided"))
+			existingNATZones.Insert(natGateway.Zone)
+
+			if existingSubnetZones.Has(natGateway.Zone) {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("zone"), "the routing of existing subnets must already be configured, hence no nat gateway can be specified for their zone"))
 			}
-			if oidc.UsernamePrefix != nil && len(*oidc.UsernamePrefix) == 0 {
-				allErrs = append(allErrs, field.Invalid(oidcPath.Child("usernamePrefix"), *oidc.UsernamePrefix, "username prefix cannot be empty when key is provided"))
+			if natGateway.ID != nil && len(*natGateway.ID) == 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("id"), *natGateway.ID, "nat gateway id must not be empty when providing the key"))
 			}
 		}
+	}
 
-		admissionPluginsPath := fldPath.Child("kubeAPIServer", "admissionPlugins")
-		for i, plugin := range kubeAPIServer.AdmissionPlugins {
-			idxPath := admissionPluginsPath.Index(i)
+	return allErrs
+}
 
-			if len(plugin.Name) == 0 {
-				allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
-			}
-		}
+var azureVNetIDRegex = regexp.MustCompile(`^/subscriptions/[^/]+/resourceGroups/[^/]+/providers/Microsoft\.Network/virtualNetworks/[^/]+$`)
 
-		if auditConfig := kubeAPIServer.AuditConfig; auditConfig != nil {
-			auditPath := fldPath.Child("kubeAPIServer", "auditConfig")
-			if auditPolicy := auditConfig.AuditPolicy; auditPolicy != nil && auditConfig.AuditPolicy.ConfigMapRef != nil {
-				allErrs = append(allErrs, validateLocalObjectReference(auditPolicy.ConfigMapRef, auditPath.Child("auditPolicy", "configMapRef"))...)
-			}
-		}
+// validateAzureVNetPeering validates the peering configuration of an Azure vnet. The address spaces of the remote
+// vnet must not overlap with any of the given <shootCIDRs>.
+func validateAzureVNetPeering(peering *garden.AzureVNetPeering, shootCIDRs []cidrvalidation.CIDR, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !azureVNetIDRegex.MatchString(peering.RemoteVNetID) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("remoteVNetID"), peering.RemoteVNetID, fmt.Sprintf("remote vnet id must match the regex %s", azureVNetIDRegex)))
 	}
 
-	allErrs = append(allErrs, validateKubeControllerManager(kubernetes.Version, kubernetes.KubeControllerManager, fldPath.Child("kubeControllerManager"))...)
-	allErrs = append(allErrs, validateKubeProxy(kubernetes.KubeProxy, fldPath.Child("kubeProxy"))...)
+	if len(peering.RemoteCIDRs) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("remoteCIDRs"), "must specify the address spaces of the remote vnet"))
+		return allErrs
+	}
+
+	remoteCIDRs := make([]cidrvalidation.CIDR, 0, len(peering.RemoteCIDRs))
+	for i, cidr := range peering.RemoteCIDRs {
+		remoteCIDRs = append(remoteCIDRs, cidrvalidation.NewCIDR(cidr, fldPath.Child("remoteCIDRs").Index(i)))
+	}
+	allErrs = append(allErrs, validateCIDRParse(remoteCIDRs...)...)
+
+	allErrs = append(allErrs, validateCIDROVerlap(shootCIDRs, remoteCIDRs, false)...)
+	allErrs = append(allErrs, validateCIDROVerlap(remoteCIDRs, shootCIDRs, false)...)
 
 	return allErrs
 }
 
-func validateKubeControllerManager(kubernetesVersion string, kcm *garden.KubeControllerManagerConfig, fldPath *field.Path) field.ErrorList {
+var availableGCPCloudNATLogFilters = sets.NewString("ERRORS_ONLY", "TRANSLATIONS_ONLY", "ALL")
+
+func validateGCPCloudNAT(cloudNAT *garden.GCPCloudNAT, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	k8sVersionLessThan112, err := utils.CompareVersions(kubernetesVersion, "<", "1.12")
-	if err != nil {
-		allErrs = append(allErrs, field.Invalid(fldPath, kubernetesVersion, err.Error()))
+	if cloudNAT.MinPortsPerVM != nil && (*cloudNAT.MinPortsPerVM < 2 || *cloudNAT.MinPortsPerVM > 65536) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minPortsPerVM"), *cloudNAT.MinPortsPerVM, "must be between 2 and 65536"))
 	}
-	if kcm != nil {
-		if hpa := kcm.HorizontalPodAutoscalerConfig; hpa != nil {
-			fldPath = fldPath.Child("horizontalPodAutoscaler")
 
-			if hpa.SyncPeriod != nil && hpa.SyncPeriod.Duration < 1*time.Second {
-				allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), *hpa.SyncPeriod, "syncPeriod must not be less than a second"))
-			}
+	natIPNames := sets.NewString()
+	for i, name := range cloudNAT.NatIPNames {
+		idxPath := fldPath.Child("natIPNames").Index(i)
+		if len(name) == 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath, name, "nat ip name must not be empty"))
+			continue
+		}
+		if natIPNames.Has(name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, name))
+		}
+		natIPNames.Insert(name)
+	}
+
+	if cloudNAT.Logging != nil && !availableGCPCloudNATLogFilters.Has(cloudNAT.Logging.Filter) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("logging", "filter"), cloudNAT.Logging.Filter, availableGCPCloudNATLogFilters.List()))
+	}
+
+	return allErrs
+}
+
+var (
+	availableAlicloudInternetChargeTypes = sets.NewString("PayByTraffic", "PayByBandwidth")
+	// alicloudMaxEIPBandwidths contains the maximum EIP bandwidth (in Mbps) per internet charge type.
+	alicloudMaxEIPBandwidths = map[string]int32{
+		"PayByTraffic":   200,
+		"PayByBandwidth": 500,
+	}
+)
+
+func validateAlicloudNatGateway(natGateway *garden.AlicloudNatGateway, zones []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateAlicloudEIP(natGateway.EIPBandwidth, natGateway.EIPInternetChargeType, fldPath)...)
+
+	var (
+		availableZones = sets.NewString(zones...)
+		seenZones      = sets.NewString()
+	)
+	for i, zone := range natGateway.Zones {
+		idxPath := fldPath.Child("zones").Index(i)
+
+		if !availableZones.Has(zone.Name) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("name"), zone.Name, zones))
+		}
+		if seenZones.Has(zone.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), zone.Name))
+		}
+		seenZones.Insert(zone.Name)
+
+		chargeType := zone.EIPInternetChargeType
+		if chargeType == nil {
+			chargeType = natGateway.EIPInternetChargeType
+		}
+		allErrs = append(allErrs, validateAlicloudEIP(zone.EIPBandwidth, chargeType, idxPath)...)
+	}
+
+	return allErrs
+}
+
+func validateAlicloudEIP(bandwidth *int32, chargeType *string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	maxBandwidth := alicloudMaxEIPBandwidths["PayByBandwidth"]
+	if chargeType != nil {
+		if !availableAlicloudInternetChargeTypes.Has(*chargeType) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("eipInternetChargeType"), *chargeType, availableAlicloudInternetChargeTypes.List()))
+		} else {
+			maxBandwidth = alicloudMaxEIPBandwidths[*chargeType]
+		}
+	}
+
+	if bandwidth != nil && (*bandwidth < 1 || *bandwidth > maxBandwidth) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("eipBandwidth"), *bandwidth, fmt.Sprintf("must be between 1 and %d", maxBandwidth)))
+	}
+
+	return allErrs
+}
+
+var (
+	availableAlicloudLoadBalancerSpecs        = sets.NewString("slb.s1.small", "slb.s2.small", "slb.s2.medium", "slb.s3.small", "slb.s3.medium", "slb.s3.large")
+	availableAlicloudLoadBalancerAddressTypes = sets.NewString("internet", "intranet")
+)
+
+func validateAlicloudLoadBalancer(loadBalancer *garden.AlicloudLoadBalancer, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if loadBalancer.Spec != nil && !availableAlicloudLoadBalancerSpecs.Has(*loadBalancer.Spec) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("spec"), *loadBalancer.Spec, availableAlicloudLoadBalancerSpecs.List()))
+	}
+	if loadBalancer.AddressType != nil && !availableAlicloudLoadBalancerAddressTypes.Has(*loadBalancer.AddressType) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("addressType"), *loadBalancer.AddressType, availableAlicloudLoadBalancerAddressTypes.List()))
+	}
+	if loadBalancer.Bandwidth != nil && (*loadBalancer.Bandwidth < 1 || *loadBalancer.Bandwidth > 5120) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("bandwidth"), *loadBalancer.Bandwidth, "must be between 1 and 5120"))
+	}
+
+	return allErrs
+}
+
+var availableAWSServiceLoadBalancerTypes = sets.NewString(
+	"classic",
+	"nlb",
+)
+
+// validateServiceLoadBalancer validates the defaults for the load balancers of services of type LoadBalancer in the
+// Shoot cluster.
+func validateServiceLoadBalancer(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if loadBalancer := cloud.ServiceLoadBalancer; loadBalancer != nil {
+		loadBalancerPath := fldPath.Child("serviceLoadBalancer")
+		if cloud.Packet != nil || cloud.Local != nil {
+			return append(allErrs, field.Forbidden(loadBalancerPath, "service load balancer defaults are not supported for Packet and Local"))
+		}
+		allErrs = append(allErrs, apivalidation.ValidateAnnotations(loadBalancer.Annotations, loadBalancerPath.Child("annotations"))...)
+
+		if alicloud := cloud.Alicloud; alicloud != nil && alicloud.ServiceLoadBalancer != nil && alicloud.ServiceLoadBalancer.AddressType != nil && loadBalancer.Internal != nil {
+			allErrs = append(allErrs, field.Forbidden(loadBalancerPath.Child("internal"), "must not be set together with the address type of the Alicloud service load balancer"))
+		}
+	}
+
+	if aws := cloud.AWS; aws != nil && aws.ServiceLoadBalancer != nil && aws.ServiceLoadBalancer.Type != nil && !availableAWSServiceLoadBalancerTypes.Has(*aws.ServiceLoadBalancer.Type) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("aws", "serviceLoadBalancer", "type"), *aws.ServiceLoadBalancer.Type, availableAWSServiceLoadBalancerTypes.List()))
+	}
+
+	return allErrs
+}
+
+// cloudTagConstraints describes the restrictions a cloud provider imposes on resource tags.
+type cloudTagConstraints struct {
+	maxTags           int
+	maxKeyLength      int
+	maxValueLength    int
+	reservedKeys      []string
+	reservedPrefixes  []string
+	invalidCharacters string
+	// keyRegex and valueRegex restrict the tag keys and values to the characters allowed by the provider (optional).
+	keyRegex   *regexp.Regexp
+	valueRegex *regexp.Regexp
+	// caseInsensitiveKeys indicates that the provider does not distinguish tag keys by case.
+	caseInsensitiveKeys bool
+}
+
+var (
+	// awsTagConstraints leaves room for the two tags (Name, kubernetes.io/cluster/<name>) set by Gardener itself.
+	awsTagConstraints = cloudTagConstraints{
+		maxTags:          48,
+		maxKeyLength:     127,
+		maxValueLength:   255,
+		reservedKeys:     []string{"Name"},
+		reservedPrefixes: []string{"aws:", "kubernetes.io/"},
+	}
+	// azureTagConstraints leaves room for the three tags (Name, kubernetes.io-cluster-<name>, kubernetes.io-role-node)
+	// set by Gardener itself on the virtual machines.
+	azureTagConstraints = cloudTagConstraints{
+		maxTags:             47,
+		maxKeyLength:        512,
+		maxValueLength:      256,
+		reservedKeys:        []string{"Name"},
+		reservedPrefixes:    []string{"microsoft", "azure", "windows", "kubernetes.io-"},
+		invalidCharacters:   `<>%&\?/`,
+		caseInsensitiveKeys: true,
+	}
+	// alicloudInstanceTagConstraints leaves room for the two tags (kubernetes.io/cluster/<name>,
+	// kubernetes.io/role/worker/<name>) set by Gardener itself on the ECS instances.
+	alicloudInstanceTagConstraints = cloudTagConstraints{
+		maxTags:          18,
+		maxKeyLength:     128,
+		maxValueLength:   128,
+		reservedPrefixes: []string{"aliyun", "acs:", "kubernetes.io/"},
+	}
+	// gcpLabelConstraints leaves room for the label (name) set by Gardener itself on the GCE instances and disks.
+	gcpLabelConstraints = cloudTagConstraints{
+		maxTags:        63,
+		maxKeyLength:   63,
+		maxValueLength: 63,
+		reservedKeys:   []string{"name"},
+		keyRegex:       regexp.MustCompile(`^[a-z][a-z0-9_-]*$`),
+		valueRegex:     regexp.MustCompile(`^[a-z0-9_-]*$`),
+	}
+	// openstackMetadataConstraints leaves room for the two metadata items (kubernetes.io-cluster-<name>,
+	// kubernetes.io-role-node) set by Gardener itself on the servers, the default quota allows 128 items.
+	openstackMetadataConstraints = cloudTagConstraints{
+		maxTags:          126,
+		maxKeyLength:     255,
+		maxValueLength:   255,
+		reservedPrefixes: []string{"kubernetes.io-"},
+	}
+)
+
+func validateCloudTags(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(cloud.Tags) == 0 {
+		return allErrs
+	}
+
+	var constraints cloudTagConstraints
+	switch {
+	case cloud.AWS != nil:
+		constraints = awsTagConstraints
+	case cloud.Azure != nil:
+		constraints = azureTagConstraints
+	case cloud.GCP != nil:
+		constraints = gcpLabelConstraints
+	case cloud.OpenStack != nil:
+		constraints = openstackMetadataConstraints
+	case cloud.Alicloud != nil:
+		constraints = alicloudInstanceTagConstraints
+	default:
+		return append(allErrs, field.Forbidden(fldPath, "additional tags are only supported for AWS, Azure, GCP, OpenStack and Alicloud"))
+	}
+
+	if len(cloud.Tags) > constraints.maxTags {
+		allErrs = append(allErrs, field.Invalid(fldPath, len(cloud.Tags), fmt.Sprintf("must not specify more than %d tags", constraints.maxTags)))
+	}
+
+	for key, value := range cloud.Tags {
+		allErrs = append(allErrs, validateCloudTag(key, value, constraints, fldPath.Key(key))...)
+	}
+
+	return allErrs
+}
+
+func validateCloudTag(key, value string, constraints cloudTagConstraints, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(key) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, key, "tag key must not be empty"))
+	}
+	if len(key) > constraints.maxKeyLength {
+		allErrs = append(allErrs, field.TooLong(fldPath, key, constraints.maxKeyLength))
+	}
+	if len(value) > constraints.maxValueLength {
+		allErrs = append(allErrs, field.TooLong(fldPath, value, constraints.maxValueLength))
+	}
+	for _, reservedKey := range constraints.reservedKeys {
+		if key == reservedKey || (constraints.caseInsensitiveKeys && strings.EqualFold(key, reservedKey)) {
+			allErrs = append(allErrs, field.Invalid(fldPath, key, "tag key is reserved"))
+		}
+	}
+	for _, prefix := range constraints.reservedPrefixes {
+		if strings.HasPrefix(strings.ToLower(key), strings.ToLower(prefix)) {
+			allErrs = append(allErrs, field.Invalid(fldPath, key, fmt.Sprintf("tag key must not start with reserved prefix %q", prefix)))
+		}
+	}
+	if len(constraints.invalidCharacters) > 0 && strings.ContainsAny(key, constraints.invalidCharacters) {
+		allErrs = append(allErrs, field.Invalid(fldPath, key, fmt.Sprintf("tag key must not contain any of the characters %q", constraints.invalidCharacters)))
+	}
+	if constraints.keyRegex != nil && len(key) > 0 && !constraints.keyRegex.MatchString(key) {
+		allErrs = append(allErrs, field.Invalid(fldPath, key, fmt.Sprintf("tag key must match the regex %q", constraints.keyRegex.String())))
+	}
+	if constraints.valueRegex != nil && !constraints.valueRegex.MatchString(value) {
+		allErrs = append(allErrs, field.Invalid(fldPath, value, fmt.Sprintf("tag value must match the regex %q", constraints.valueRegex.String())))
+	}
+
+	return allErrs
+}
+
+func validateCIDRParse(cidrPaths ...cidrvalidation.CIDR) (allErrs field.ErrorList) {
+	for _, cidrPath := range cidrPaths {
+		if cidrPath == nil {
+			continue
+		}
+		allErrs = append(allErrs, cidrPath.ValidateParse()...)
+	}
+	return allErrs
+}
+
+func validateCIDROVerlap(leftPaths, rightPaths []cidrvalidation.CIDR, overlap bool) (allErrs field.ErrorList) {
+	for _, left := range leftPaths {
+		if left == nil {
+			continue
+		}
+		if overlap {
+			allErrs = append(allErrs, left.ValidateSubset(rightPaths...)...)
+		} else {
+			allErrs = append(allErrs, left.ValidateNotSubset(rightPaths...)...)
+		}
+	}
+	return allErrs
+}
+
+func transformK8SNetworks(networks gardencore.K8SNetworks, fldPath *field.Path) (nodes, pods, services cidrvalidation.CIDR, allErrs field.ErrorList) {
+	cidrs := []cidrvalidation.CIDR{}
+
+	if networks.Nodes != nil {
+		nodes = cidrvalidation.NewCIDR(*networks.Nodes, fldPath.Child("nodes"))
+		allErrs = append(allErrs, nodes.ValidateParse()...)
+		cidrs = append(cidrs, nodes)
+	} else {
+		allErrs = append(allErrs, field.Required(fldPath.Child("nodes"), "nodes CIDR cannot be unset"))
+	}
+
+	if networks.Pods != nil {
+		pods = cidrvalidation.NewCIDR(*networks.Pods, fldPath.Child("pods"))
+		allErrs = append(allErrs, pods.ValidateParse()...)
+		cidrs = append(cidrs, pods)
+	} else {
+		allErrs = append(allErrs, field.Required(fldPath.Child("pods"), "pods CIDR cannot be unset"))
+	}
+
+	if networks.Services != nil {
+		services = cidrvalidation.NewCIDR(*networks.Services, fldPath.Child("services"))
+		allErrs = append(allErrs, services.ValidateParse()...)
+		cidrs = append(cidrs, services)
+	} else {
+		allErrs = append(allErrs, field.Required(fldPath.Child("services"), "services CIDR cannot be unset"))
+	}
+	allErrs = append(allErrs, validateCIDROVerlap(cidrs, cidrs, false)...)
+
+	return nodes, pods, services, allErrs
+}
+
+func validateKubernetes(kubernetes garden.Kubernetes, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(kubernetes.Version) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("version"), "kubernetes version must not be empty"))
+		return allErrs
+	}
+
+	if kubeAPIServer := kubernetes.KubeAPIServer; kubeAPIServer != nil {
+		if oidc := kubeAPIServer.OIDCConfig; oidc != nil {
+			oidcPath := fldPath.Child("kubeAPIServer", "oidcConfig")
+
+			geqKubernetes111, err := utils.CheckVersionMeetsConstraint(kubernetes.Version, ">= 1.11")
+			if err != nil {
+				geqKubernetes111 = false
+			}
+
+			if oidc.CABundle != nil {
+				if _, err := utils.DecodeCertificate([]byte(*oidc.CABundle)); err != nil {
+					allErrs = append(allErrs, field.Invalid(oidcPath.Child("caBundle"), *oidc.CABundle, "caBundle is not a valid PEM-encoded certificate"))
+				}
+			}
+			if oidc.ClientID != nil && len(*oidc.ClientID) == 0 {
+				allErrs = append(allErrs, field.Invalid(oidcPath.Child("clientID"), *oidc.ClientID, "client id cannot be empty when key is provided"))
+			}
+			if oidc.GroupsClaim != nil && len(*oidc.GroupsClaim) == 0 {
+				allErrs = append(allErrs, field.Invalid(oidcPath.Child("groupsClaim"), *oidc.GroupsClaim, "groups claim cannot be empty when key is provided"))
+			}
+			if oidc.GroupsPrefix != nil && len(*oidc.GroupsPrefix) == 0 {
+				allErrs = append(allErrs, field.Invalid(oidcPath.Child("groupsPrefix"), *oidc.GroupsPrefix, "groups prefix cannot be empty when key is provided"))
+			}
+			if oidc.IssuerURL != nil && len(*oidc.IssuerURL) == 0 {
+				allErrs = append(allErrs, field.Invalid(oidcPath.Child("issuerURL"), *oidc.IssuerURL, "issuer url cannot be empty when key is provided"))
+			}
+			if oidc.SigningAlgs != nil && len(oidc.SigningAlgs) == 0 {
+				allErrs = append(allErrs, field.Invalid(oidcPath.Child("signingAlgs"), oidc.SigningAlgs, "signings algs cannot be empty when key is provided"))
+			}
+			if !geqKubernetes111 && oidc.RequiredClaims != nil {
+				allErrs = append(allErrs, field.Forbidden(oidcPath.Child("requiredClaims"), "required claims cannot be provided when version is not greater or equal 1.11"))
+			}
+			if oidc.UsernameClaim != nil && len(*oidc.UsernameClaim) == 0 {
+				allErrs = append(allErrs, field.Invalid(oidcPath.Child("usernameClaim"), *oidc.UsernameClaim, "username claim cannot be empty when key is provided"))
+			}
+			if oidc.UsernamePrefix != nil && len(*oidc.UsernamePrefix) == 0 {
+				allErrs = append(allErrs, field.Invalid(oidcPath.Child("usernamePrefix"), *oidc.UsernamePrefix, "username prefix cannot be empty when key is provided"))
+			}
+		}
+
+		admissionPluginsPath := fldPath.Child("kubeAPIServer", "admissionPlugins")
+		for i, plugin := range kubeAPIServer.AdmissionPlugins {
+			idxPath := admissionPluginsPath.Index(i)
+
+			if len(plugin.Name) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+			}
+		}
+
+		allowedCIDRsPath := fldPath.Child("kubeAPIServer", "allowedCIDRs")
+		for i, cidr := range kubeAPIServer.AllowedCIDRs {
+			allErrs = append(allErrs, validateCIDR(cidr, allowedCIDRsPath.Index(i))...)
+		}
+
+		if auditConfig := kubeAPIServer.AuditConfig; auditConfig != nil {
+			auditPath := fldPath.Child("kubeAPIServer", "auditConfig")
+			if auditPolicy := auditConfig.AuditPolicy; auditPolicy != nil && auditConfig.AuditPolicy.ConfigMapRef != nil {
+				allErrs = append(allErrs, validateLocalObjectReference(auditPolicy.ConfigMapRef, auditPath.Child("auditPolicy", "configMapRef"))...)
+			}
+		}
+
+		if webhook := kubeAPIServer.AuthenticationWebhook; webhook != nil {
+			allErrs = append(allErrs, validateAuthenticationWebhook(webhook, fldPath.Child("kubeAPIServer", "authenticationWebhook"))...)
+		}
+	}
+
+	allErrs = append(allErrs, validateKubeControllerManager(kubernetes.Version, kubernetes.KubeControllerManager, fldPath.Child("kubeControllerManager"))...)
+	allErrs = append(allErrs, validateKubeProxy(kubernetes.KubeProxy, fldPath.Child("kubeProxy"))...)
+
+	return allErrs
+}
+
+func validateAuthenticationWebhook(webhook *garden.AuthenticationWebhook, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(webhook.URL) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("url"), "must provide the url of the webhook"))
+	} else if u, err := url.Parse(webhook.URL); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), webhook.URL, err.Error()))
+	} else if u.Scheme != "https" || len(u.Host) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), webhook.URL, "url must be an absolute https url"))
+	} else if u.User != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), webhook.URL, "url must not contain user info"))
+	}
+
+	if webhook.CABundle != nil {
+		if _, err := utils.DecodeCertificate([]byte(*webhook.CABundle)); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("caBundle"), *webhook.CABundle, "caBundle is not a valid PEM-encoded certificate"))
+		}
+	}
+
+	if webhook.CacheTTL != nil && webhook.CacheTTL.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("cacheTTL"), webhook.CacheTTL.Duration.String(), "cacheTTL must not be negative"))
+	}
+
+	return allErrs
+}
+
+func validateKubeControllerManager(kubernetesVersion string, kcm *garden.KubeControllerManagerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	k8sVersionLessThan112, err := utils.CompareVersions(kubernetesVersion, "<", "1.12")
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, kubernetesVersion, err.Error()))
+	}
+	if kcm != nil {
+		if maskSize := kcm.NodeCIDRMaskSize; maskSize != nil && (*maskSize < minNodeCIDRMaskSize || *maskSize > maxNodeCIDRMaskSize) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("nodeCIDRMaskSize"), *maskSize, fmt.Sprintf("must be between %d and %d", minNodeCIDRMaskSize, maxNodeCIDRMaskSize)))
+		}
+		if gracePeriod := kcm.NodeMonitorGracePeriod; gracePeriod != nil && (gracePeriod.Duration < minNodeMonitorGracePeriod || gracePeriod.Duration > maxNodeMonitorGracePeriod) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("nodeMonitorGracePeriod"), *gracePeriod, fmt.Sprintf("must be between %s and %s", minNodeMonitorGracePeriod, maxNodeMonitorGracePeriod)))
+		}
+		if timeout := kcm.PodEvictionTimeout; timeout != nil && (timeout.Duration < minPodEvictionTimeout || timeout.Duration > maxPodEvictionTimeout) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("podEvictionTimeout"), *timeout, fmt.Sprintf("must be between %s and %s", minPodEvictionTimeout, maxPodEvictionTimeout)))
+		}
+		if hpa := kcm.HorizontalPodAutoscalerConfig; hpa != nil {
+			fldPath = fldPath.Child("horizontalPodAutoscaler")
+
+			if hpa.SyncPeriod != nil && hpa.SyncPeriod.Duration < 1*time.Second {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), *hpa.SyncPeriod, "syncPeriod must not be less than a second"))
+			}
 			if hpa.Tolerance != nil && *hpa.Tolerance <= 0 {
 				allErrs = append(allErrs, field.Invalid(fldPath.Child("tolerance"), *hpa.Tolerance, "tolerance of must be greater than 0"))
 			}
@@ -1778,6 +2945,98 @@ func validateKubeControllerManager(kubernetesVersion string, kcm *garden.KubeCon
 	return allErrs
 }
 
+const (
+	minNodeCIDRMaskSize     = 16
+	maxNodeCIDRMaskSize     = 28
+	defaultNodeCIDRMaskSize = 24
+	// defaultMaxPods is the maximum number of pods the kubelet runs on a node if the worker pool does not configure it.
+	defaultMaxPods = 110
+
+	// The node monitor grace period must exceed the node status update frequency of the kubelet (10s) by far,
+	// otherwise nodes are marked unhealthy after a single missed status update.
+	minNodeMonitorGracePeriod = 20 * time.Second
+	maxNodeMonitorGracePeriod = 30 * time.Minute
+	minPodEvictionTimeout     = 10 * time.Second
+	maxPodEvictionTimeout     = 24 * time.Hour
+)
+
+// validateNodeCIDRCapacity validates that the pods network offers a pod CIDR for the maximum number of nodes of all
+// worker pools, and that the pod CIDR of a node offers an address for the maximum number of pods of its worker pool.
+// nodeCIDRMaskSize returns the node CIDR mask size of the given Shoot specification, or the default one if it is not
+// configured.
+func nodeCIDRMaskSize(spec *garden.ShootSpec) int {
+	if kcm := spec.Kubernetes.KubeControllerManager; kcm != nil && kcm.NodeCIDRMaskSize != nil {
+		return *kcm.NodeCIDRMaskSize
+	}
+	return defaultNodeCIDRMaskSize
+}
+
+func validateNodeCIDRCapacity(spec *garden.ShootSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	cloudProvider, err := helper.DetermineCloudProviderInShoot(spec.Cloud)
+	if err != nil {
+		return allErrs
+	}
+
+	maskSize, maskSizePath := nodeCIDRMaskSize(spec), fldPath.Child("kubernetes", "kubeControllerManager", "nodeCIDRMaskSize")
+	if maskSize < minNodeCIDRMaskSize || maskSize > maxNodeCIDRMaskSize {
+		// Already reported by the kube-controller-manager validation.
+		return allErrs
+	}
+
+	var (
+		podsPerNode = int64(1) << uint(32-maskSize)
+		workersPath = fldPath.Child("cloud", string(cloudProvider), "workers")
+		maxNodes    int64
+	)
+	for i, worker := range helper.GetShootWorkers(spec.Cloud) {
+		maxNodes += int64(worker.AutoScalerMax)
+
+		idxPath := workersPath.Index(i).Child("maxPods")
+		if worker.MaxPods == nil {
+			// The default is only checked against explicitly configured mask sizes, the default mask size offers
+			// enough addresses for it.
+			if int64(defaultMaxPods) > podsPerNode {
+				allErrs = append(allErrs, field.Required(idxPath, fmt.Sprintf("must be set as the default of %d pods exceeds the %d addresses of the pod CIDR of a node (node CIDR mask size %d)", defaultMaxPods, podsPerNode, maskSize)))
+			}
+			continue
+		}
+		if *worker.MaxPods <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath, *worker.MaxPods, "must be greater than 0"))
+		} else if int64(*worker.MaxPods) > podsPerNode {
+			allErrs = append(allErrs, field.Invalid(idxPath, *worker.MaxPods, fmt.Sprintf("must not exceed the %d addresses of the pod CIDR of a node (node CIDR mask size %d)", podsPerNode, maskSize)))
+		}
+	}
+
+	// The node capacity of the pods network is only validated for explicitly configured mask sizes in order to not
+	// reject updates of existing Shoots.
+	if kcm := spec.Kubernetes.KubeControllerManager; kcm == nil || kcm.NodeCIDRMaskSize == nil {
+		return allErrs
+	}
+
+	k8sNetworks, err := helper.GetK8SNetworks(&garden.Shoot{Spec: *spec})
+	if err != nil || k8sNetworks.Pods == nil {
+		return allErrs
+	}
+	_, podsNetwork, err := net.ParseCIDR(string(*k8sNetworks.Pods))
+	if err != nil {
+		// Already reported by the network validation.
+		return allErrs
+	}
+	podsPrefixLength, _ := podsNetwork.Mask.Size()
+
+	if maskSize < podsPrefixLength {
+		allErrs = append(allErrs, field.Invalid(maskSizePath, maskSize, fmt.Sprintf("must not be smaller than the prefix length %d of the pods network", podsPrefixLength)))
+		return allErrs
+	}
+	if nodeCIDRs := int64(1) << uint(maskSize-podsPrefixLength); maxNodes > nodeCIDRs {
+		allErrs = append(allErrs, field.Invalid(maskSizePath, maskSize, fmt.Sprintf("the pods network %s offers only %d node CIDRs but the worker pools may scale up to %d nodes", *k8sNetworks.Pods, nodeCIDRs, maxNodes)))
+	}
+
+	return allErrs
+}
+
 func validateKubeProxy(kp *garden.KubeProxyConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -1792,37 +3051,230 @@ func validateKubeProxy(kp *garden.KubeProxyConfig, fldPath *field.Path) field.Er
 	return allErrs
 }
 
-func validateMaintenance(maintenance *garden.Maintenance, fldPath *field.Path) field.ErrorList {
+func validateMaintenance(maintenance *garden.Maintenance, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if maintenance == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "maintenance information is required"))
+		return allErrs
+	}
+
+	if maintenance.AutoUpdate == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("autoUpdate"), "auto update information is required"))
+	}
+
+	if maintenance.TimeWindow == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("timeWindow"), "time window information is required"))
+	} else {
+		maintenanceTimeWindow, err := utils.ParseMaintenanceTimeWindow(maintenance.TimeWindow.Begin, maintenance.TimeWindow.End)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeWindow", "begin/end"), maintenance.TimeWindow, err.Error()))
+		}
+
+		if err == nil {
+			duration := maintenanceTimeWindow.Duration()
+			if duration > 6*time.Hour {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("timeWindow"), "time window must not be greater than 6 hours"))
+				return allErrs
+			}
+			if duration < 30*time.Minute {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("timeWindow"), "time window must not be smaller than 30 minutes"))
+				return allErrs
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// availablePrometheusScrapeTargets are the scrape jobs of the Prometheus which monitors a Shoot.
+var availablePrometheusScrapeTargets = sets.NewString(
+	"alertmanager",
+	"annotated-seed-service-endpoints",
+	"blackbox-apiserver",
+	"blackbox-exporter-k8s-service-check",
+	"cadvisor",
+	"cloud-controller-manager",
+	"cluster-autoscaler",
+	"coredns",
+	"kube-apiserver",
+	"kube-controller-manager",
+	"kube-etcd3",
+	"kube-kubelet",
+	"kube-kubelet-seed",
+	"kube-scheduler",
+	"kube-state-metrics",
+	"machine-controller-manager",
+	"node-exporter",
+	"prometheus",
+	"vpa-exporter",
+	"vpn-connection",
+	"vpn-probe-apiserver-proxy",
+)
+
+func validateMonitoring(monitoring *garden.Monitoring, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if monitoring == nil {
+		return allErrs
+	}
+
+	if monitoring.Enabled != nil && !*monitoring.Enabled && len(monitoring.ScrapeTargets) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("scrapeTargets"), "scrape targets must not be specified if monitoring is disabled"))
+	}
+
+	seen := sets.NewString()
+	for i, target := range monitoring.ScrapeTargets {
+		idxPath := fldPath.Child("scrapeTargets").Index(i)
+		if !availablePrometheusScrapeTargets.Has(target) {
+			allErrs = append(allErrs, field.NotSupported(idxPath, target, availablePrometheusScrapeTargets.List()))
+		}
+		if seen.Has(target) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, target))
+		}
+		seen.Insert(target)
+	}
+
+	return allErrs
+}
+
+// kubeAPIServerAllowedCIDRsUnsupported contains the cloud providers whose kube-apiserver load balancer does not
+// support source ranges. Alicloud SLBs require pre-created access control lists and local Shoots expose the
+// kube-apiserver via a node port.
+var kubeAPIServerAllowedCIDRsUnsupported = map[garden.CloudProvider]bool{
+	garden.CloudProviderAlicloud: true,
+	garden.CloudProviderLocal:    true,
+}
+
+// validateKubeAPIServerAllowedCIDRsSupported forbids restricting the access to the kube-apiserver for cloud providers
+// which cannot enforce it.
+func validateKubeAPIServerAllowedCIDRsSupported(spec *garden.ShootSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.Kubernetes.KubeAPIServer == nil || len(spec.Kubernetes.KubeAPIServer.AllowedCIDRs) == 0 {
+		return allErrs
+	}
+
+	cloudProvider, err := helper.DetermineCloudProviderInShoot(spec.Cloud)
+	if err != nil {
+		return allErrs
+	}
+	if kubeAPIServerAllowedCIDRsUnsupported[cloudProvider] {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("kubernetes", "kubeAPIServer", "allowedCIDRs"), fmt.Sprintf("restricting the access to the kube-apiserver is not supported for cloud provider %q", cloudProvider)))
+	}
+
+	return allErrs
+}
+
+// storageClassComputedParameters contains the provisioner parameters per cloud provider which are computed by Gardener
+// from the provider independent settings of a StorageClass. They must not be overwritten by additional parameters.
+var storageClassComputedParameters = map[garden.CloudProvider]sets.String{
+	garden.CloudProviderAWS:       sets.NewString("type", "encrypted"),
+	garden.CloudProviderAzure:     sets.NewString("storageaccounttype", "kind"),
+	garden.CloudProviderGCP:       sets.NewString("type"),
+	garden.CloudProviderOpenStack: sets.NewString("type", "availability"),
+	garden.CloudProviderAlicloud:  sets.NewString("type", "encrypted", "zoneId"),
+	garden.CloudProviderLocal:     sets.NewString(),
+}
+
+// validateStorage validates the StorageClasses of the Shoot against the capabilities of its cloud provider.
+func validateStorage(spec *garden.ShootSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	if maintenance == nil {
-		allErrs = append(allErrs, field.Required(fldPath, "maintenance information is required"))
+	if spec.Storage == nil || len(spec.Storage.Classes) == 0 {
 		return allErrs
 	}
 
-	if maintenance.AutoUpdate == nil {
-		allErrs = append(allErrs, field.Required(fldPath.Child("autoUpdate"), "auto update information is required"))
+	cloudProvider, err := helper.DetermineCloudProviderInShoot(spec.Cloud)
+	if err != nil {
+		return allErrs
+	}
+	computedParameters, ok := storageClassComputedParameters[cloudProvider]
+	if !ok {
+		return append(allErrs, field.Forbidden(fldPath.Child("classes"), fmt.Sprintf("storage classes are not supported for cloud provider %q", cloudProvider)))
 	}
 
-	if maintenance.TimeWindow == nil {
-		allErrs = append(allErrs, field.Required(fldPath.Child("timeWindow"), "time window information is required"))
-	} else {
-		maintenanceTimeWindow, err := utils.ParseMaintenanceTimeWindow(maintenance.TimeWindow.Begin, maintenance.TimeWindow.End)
-		if err != nil {
-			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeWindow", "begin/end"), maintenance.TimeWindow, err.Error()))
+	var (
+		shootZones = sets.NewString(helper.GetShootZones(spec.Cloud)...)
+		names      = sets.NewString()
+		defaults   int
+	)
+
+	for i, class := range spec.Storage.Classes {
+		idxPath := fldPath.Child("classes").Index(i)
+
+		if len(class.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide the name of the storage class"))
+		} else {
+			for _, msg := range apivalidation.NameIsDNSSubdomain(class.Name, false) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), class.Name, msg))
+			}
+			if names.Has(class.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), class.Name))
+			}
+			names.Insert(class.Name)
 		}
 
-		if err == nil {
-			duration := maintenanceTimeWindow.Duration()
-			if duration > 6*time.Hour {
-				allErrs = append(allErrs, field.Forbidden(fldPath.Child("timeWindow"), "time window must not be greater than 6 hours"))
-				return allErrs
+		if class.Default != nil && *class.Default {
+			if defaults++; defaults > 1 {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("default"), "only one storage class can be the default storage class"))
 			}
-			if duration < 30*time.Minute {
-				allErrs = append(allErrs, field.Forbidden(fldPath.Child("timeWindow"), "time window must not be smaller than 30 minutes"))
-				return allErrs
+		}
+
+		if class.VolumeType != nil {
+			if cloudProvider == garden.CloudProviderLocal {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("volumeType"), "volume types are not supported for the local provider"))
+			} else if len(*class.VolumeType) == 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("volumeType"), *class.VolumeType, "volume type must not be empty"))
+			}
+		}
+
+		if class.Encrypted != nil && cloudProvider != garden.CloudProviderAWS && cloudProvider != garden.CloudProviderAlicloud {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("encrypted"), "encryption can only be configured for AWS and Alicloud"))
+		}
+
+		if len(class.Zones) > 0 {
+			zonesPath := idxPath.Child("zones")
+			switch cloudProvider {
+			case garden.CloudProviderAWS, garden.CloudProviderGCP, garden.CloudProviderOpenStack:
+				if ok, err := utils.CheckVersionMeetsConstraint(spec.Kubernetes.Version, ">= 1.12"); err == nil && !ok {
+					allErrs = append(allErrs, field.Forbidden(zonesPath, "zones can only be configured for Kubernetes versions >= 1.12"))
+				}
+			case garden.CloudProviderAlicloud:
+			default:
+				allErrs = append(allErrs, field.Forbidden(zonesPath, fmt.Sprintf("zones are not supported for cloud provider %q", cloudProvider)))
+			}
+			for j, zone := range class.Zones {
+				if !shootZones.Has(zone) {
+					allErrs = append(allErrs, field.NotSupported(zonesPath.Index(j), zone, shootZones.List()))
+				}
 			}
 		}
+
+		for key := range class.Parameters {
+			if computedParameters.Has(key) {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("parameters").Key(key), "parameter is computed by Gardener and must not be overwritten"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateWorkerZones validates that the zones selected by a worker are a subset of the Shoot's zones.
+func validateWorkerZones(workerZones, zones []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	seen := sets.NewString()
+	for i, zone := range workerZones {
+		idxPath := fldPath.Index(i)
+		if !utils.ValueExists(zone, zones) {
+			allErrs = append(allErrs, field.NotSupported(idxPath, zone, zones))
+		}
+		if seen.Has(zone) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, zone))
+		}
+		seen.Insert(zone)
 	}
 
 	return allErrs
@@ -1867,6 +3319,321 @@ func ValidateWorker(worker garden.Worker, fldPath *field.Path) field.ErrorList {
 	if len(worker.Taints) > 0 {
 		allErrs = append(allErrs, validateTaints(worker.Taints, fldPath.Child("taints"))...)
 	}
+	if worker.OSUpdates != nil {
+		allErrs = append(allErrs, validateWorkerOSUpdates(worker.OSUpdates, fldPath.Child("osUpdates"))...)
+	}
+	if worker.CustomMachineImage != nil && len(strings.TrimSpace(*worker.CustomMachineImage)) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("customMachineImage"), "must not be empty if set"))
+	}
+	allErrs = append(allErrs, validateWorkerDataVolumes(worker, fldPath)...)
+	if worker.Architecture != nil {
+		allErrs = append(allErrs, validateArchitecture(*worker.Architecture, fldPath.Child("architecture"))...)
+	}
+	if worker.Kubelet != nil {
+		allErrs = append(allErrs, validateWorkerKubeletConfig(worker.Kubelet, fldPath.Child("kubelet"))...)
+	}
+	if worker.Capacity != nil {
+		allErrs = append(allErrs, validateWorkerCapacity(worker, fldPath.Child("capacity"))...)
+	}
+	allErrs = append(allErrs, validateWorkerInstanceTagLabels(worker, fldPath.Child("instanceTagLabels"))...)
+
+	return allErrs
+}
+
+func validateWorkerInstanceTagLabels(worker garden.Worker, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		keys    = sets.NewString()
+	)
+
+	for i, key := range worker.InstanceTagLabels {
+		idxPath := fldPath.Index(i)
+		if len(key) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath, "must specify a label key"))
+			continue
+		}
+		if keys.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, key))
+		}
+		keys.Insert(key)
+		if _, ok := worker.Labels[key]; !ok {
+			allErrs = append(allErrs, field.Invalid(idxPath, key, "must be the key of a label of the worker pool"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateWorkerInstanceTags validates the tags which result from the instance tag labels of the given worker against
+// the given constraints of the cloud provider. The <cloudTags> are added to the instances as well and count towards
+// the maximum number of tags.
+func validateWorkerInstanceTags(worker garden.Worker, cloudTags map[string]string, constraints cloudTagConstraints, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(cloudTags)+len(worker.InstanceTagLabels) > constraints.maxTags {
+		allErrs = append(allErrs, field.Invalid(fldPath, len(worker.InstanceTagLabels), fmt.Sprintf("must not select more than %d labels (the shoot already specifies %d additional tags)", constraints.maxTags-len(cloudTags), len(cloudTags))))
+	}
+
+	for i, key := range worker.InstanceTagLabels {
+		if len(key) == 0 {
+			continue
+		}
+		idxPath := fldPath.Index(i)
+		if _, ok := cloudTags[key]; ok {
+			allErrs = append(allErrs, field.Invalid(idxPath, key, "tag key is already used by the additional tags of the shoot"))
+		}
+		allErrs = append(allErrs, validateCloudTag(key, worker.Labels[key], constraints, idxPath)...)
+	}
+
+	return allErrs
+}
+
+var gcpLabelKeyRegex = regexp.MustCompile(`^[a-z]`)
+
+// validateGCPWorkerInstanceTagLabels validates the GCE labels which result from the instance tag labels of the given
+// worker. The <cloudLabels> are added to the instances as well and count towards the maximum number of labels.
+func validateGCPWorkerInstanceTagLabels(worker garden.Worker, cloudLabels map[string]string, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs   = field.ErrorList{}
+		keys      = sets.NewString()
+		cloudKeys = sets.StringKeySet(cloudLabels)
+	)
+
+	if len(cloudLabels)+len(worker.InstanceTagLabels) > gcpLabelConstraints.maxTags {
+		allErrs = append(allErrs, field.Invalid(fldPath, len(worker.InstanceTagLabels), fmt.Sprintf("must not select more than %d labels (the shoot already specifies %d additional tags)", gcpLabelConstraints.maxTags-len(cloudLabels), len(cloudLabels))))
+	}
+
+	for i, key := range worker.InstanceTagLabels {
+		var (
+			idxPath      = fldPath.Index(i)
+			sanitizedKey = utils.SanitizeGCPLabel(key)
+		)
+
+		switch {
+		case len(key) == 0:
+			continue
+		case !gcpLabelKeyRegex.MatchString(sanitizedKey):
+			allErrs = append(allErrs, field.Invalid(idxPath, key, fmt.Sprintf("the resulting GCP label key %q must start with a lowercase letter", sanitizedKey)))
+		case sanitizedKey == "name":
+			allErrs = append(allErrs, field.Invalid(idxPath, key, "the resulting GCP label key \"name\" is reserved"))
+		case cloudKeys.Has(sanitizedKey):
+			allErrs = append(allErrs, field.Invalid(idxPath, key, fmt.Sprintf("the resulting GCP label key %q is already used by the additional tags of the shoot", sanitizedKey)))
+		case keys.Has(sanitizedKey):
+			allErrs = append(allErrs, field.Invalid(idxPath, key, fmt.Sprintf("the resulting GCP label key %q conflicts with the one of another label", sanitizedKey)))
+		}
+		keys.Insert(sanitizedKey)
+	}
+
+	return allErrs
+}
+
+func validateWorkerInstanceTagLabelsUnsupported(worker garden.Worker, provider string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(worker.InstanceTagLabels) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("instanceTagLabels"), fmt.Sprintf("instance tags are not supported for %s workers", provider)))
+	}
+
+	return allErrs
+}
+
+func validateWorkerCapacity(worker garden.Worker, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs      = field.ErrorList{}
+		machineTypes = sets.NewString(worker.MachineType)
+	)
+
+	for i, machineType := range worker.Capacity.FallbackMachineTypes {
+		idxPath := fldPath.Child("fallbackMachineTypes").Index(i)
+		if len(machineType) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath, "must specify a machine type"))
+			continue
+		}
+		if machineTypes.Has(machineType) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, machineType))
+		}
+		machineTypes.Insert(machineType)
+	}
+
+	if spotPercentage := worker.Capacity.SpotPercentage; spotPercentage != nil {
+		if *spotPercentage < 0 || *spotPercentage > 100 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("spotPercentage"), *spotPercentage, "must be between 0 and 100"))
+		} else if onDemandMaximum := worker.AutoScalerMax - worker.AutoScalerMax*int(*spotPercentage)/100; onDemandMaximum < worker.AutoScalerMin {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("spotPercentage"), *spotPercentage, fmt.Sprintf("the remaining on-demand machines (%d) must cover the minimum of the worker pool (%d)", onDemandMaximum, worker.AutoScalerMin)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateWorkerCapacityUnsupported(worker garden.Worker, provider string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if worker.Capacity != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("capacity"), fmt.Sprintf("capacity configuration is not supported for %s workers", provider)))
+	}
+
+	return allErrs
+}
+
+const (
+	// defaultImageGCHighThresholdPercent is the default image garbage collection high threshold of the kubelets.
+	defaultImageGCHighThresholdPercent = 50
+	// defaultImageGCLowThresholdPercent is the default image garbage collection low threshold of the kubelets.
+	defaultImageGCLowThresholdPercent = 40
+)
+
+func validateWorkerKubeletConfig(kubelet *garden.WorkerKubeletConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var (
+		highThreshold int32 = defaultImageGCHighThresholdPercent
+		lowThreshold  int32 = defaultImageGCLowThresholdPercent
+	)
+	if kubelet.ImageGCHighThresholdPercent != nil {
+		highThreshold = *kubelet.ImageGCHighThresholdPercent
+		if highThreshold < 0 || highThreshold > 100 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("imageGCHighThresholdPercent"), highThreshold, "must be between 0 and 100"))
+		}
+	}
+	if kubelet.ImageGCLowThresholdPercent != nil {
+		lowThreshold = *kubelet.ImageGCLowThresholdPercent
+		if lowThreshold < 0 || lowThreshold > 100 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("imageGCLowThresholdPercent"), lowThreshold, "must be between 0 and 100"))
+		}
+	}
+	if lowThreshold >= highThreshold {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("imageGCLowThresholdPercent"), lowThreshold, fmt.Sprintf("must be lower than the high threshold (%d)", highThreshold)))
+	}
+
+	if size := kubelet.ContainerLogMaxSize; size != nil && size.Cmp(resource.MustParse("1Mi")) < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("containerLogMaxSize"), size.String(), "must be at least 1Mi"))
+	}
+	if files := kubelet.ContainerLogMaxFiles; files != nil && *files < 2 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("containerLogMaxFiles"), *files, "must be at least 2"))
+	}
+
+	return allErrs
+}
+
+// maxWorkerDataVolumes is the maximum number of data volumes per machine of a worker pool.
+const maxWorkerDataVolumes = 8
+
+func validateWorkerDataVolumes(worker garden.Worker, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs         = field.ErrorList{}
+		dataVolumesPath = fldPath.Child("dataVolumes")
+		names           = sets.NewString()
+		sizes           = make(map[string]int, len(worker.DataVolumes))
+	)
+
+	if len(worker.DataVolumes) > maxWorkerDataVolumes {
+		allErrs = append(allErrs, field.Forbidden(dataVolumesPath, fmt.Sprintf("must not specify more than %d data volumes", maxWorkerDataVolumes)))
+	}
+
+	for i, volume := range worker.DataVolumes {
+		idxPath := dataVolumesPath.Index(i)
+
+		allErrs = append(allErrs, validateDNS1123Label(volume.Name, idxPath.Child("name"))...)
+		if names.Has(volume.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), volume.Name))
+		}
+		names.Insert(volume.Name)
+
+		allErrs = append(allErrs, validateWorkerVolumeSize(volume.Size, idxPath.Child("size"))...)
+		if volume.Type != nil && len(*volume.Type) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("type"), "must not be empty if set"))
+		}
+		sizes[volume.Size]++
+	}
+
+	if name := worker.KubeletDataVolumeName; name != nil {
+		kubeletDataVolumePath := fldPath.Child("kubeletDataVolumeName")
+		if !names.Has(*name) {
+			allErrs = append(allErrs, field.NotFound(kubeletDataVolumePath, *name))
+		}
+		for _, volume := range worker.DataVolumes {
+			// The kubelet data volume is identified by its size on the machines as the device names differ between
+			// the providers and machine types.
+			if volume.Name == *name && sizes[volume.Size] > 1 {
+				allErrs = append(allErrs, field.Invalid(kubeletDataVolumePath, *name, "the size of the kubelet data volume must differ from the sizes of the other data volumes"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateWorkerDataVolumesUnsupported(worker garden.Worker, provider string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(worker.DataVolumes) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("dataVolumes"), fmt.Sprintf("data volumes are not supported for %s workers", provider)))
+	}
+	if worker.KubeletDataVolumeName != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("kubeletDataVolumeName"), fmt.Sprintf("data volumes are not supported for %s workers", provider)))
+	}
+
+	return allErrs
+}
+
+func validateGCPWorkerDataVolumes(worker garden.Worker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, volume := range worker.DataVolumes {
+		if volume.Encrypted != nil && !*volume.Encrypted {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("encrypted"), *volume.Encrypted, "GCP disks are always encrypted"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateWorkerArchitectureUnsupported(worker garden.Worker, provider string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if worker.Architecture != nil && *worker.Architecture != garden.ArchitectureAMD64 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("architecture"), fmt.Sprintf("only %s is supported for %s workers", garden.ArchitectureAMD64, provider)))
+	}
+
+	return allErrs
+}
+
+var availableArchitectures = sets.NewString(
+	garden.ArchitectureAMD64,
+	garden.ArchitectureARM64,
+)
+
+func validateArchitecture(architecture string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !availableArchitectures.Has(architecture) {
+		allErrs = append(allErrs, field.NotSupported(fldPath, architecture, availableArchitectures.List()))
+	}
+
+	return allErrs
+}
+
+var availableWorkerOSUpdateChannels = sets.NewString(
+	string(garden.WorkerOSUpdateChannelStable),
+	string(garden.WorkerOSUpdateChannelBeta),
+)
+
+func validateWorkerOSUpdates(osUpdates *garden.WorkerOSUpdates, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !availableWorkerOSUpdateChannels.Has(string(osUpdates.Channel)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("channel"), osUpdates.Channel, availableWorkerOSUpdateChannels.List()))
+	}
+
+	if window := osUpdates.RebootWindow; window != nil {
+		rebootWindow, err := utils.ParseMaintenanceTimeWindow(window.Begin, window.End)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("rebootWindow", "begin/end"), window, err.Error()))
+		} else if rebootWindow.Duration() < 30*time.Minute {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("rebootWindow"), "time window must not be smaller than 30 minutes"))
+		}
+	}
 
 	return allErrs
 }
@@ -1939,6 +3706,20 @@ func ValidateWorkers(workers []garden.Worker, fldPath *field.Path) field.ErrorLi
 
 	if !atLeastOneActivePool {
 		allErrs = append(allErrs, field.Forbidden(fldPath, "at least one worker pool with min>0 and max> 0 needed"))
+		return allErrs
+	}
+
+	// Some system components (e.g., the VPN) are only available for amd64, hence, they require an active amd64 pool.
+	atLeastOneActiveAMD64Pool := false
+	for _, worker := range workers {
+		if worker.AutoScalerMin != 0 && worker.AutoScalerMax != 0 && (worker.Architecture == nil || *worker.Architecture == garden.ArchitectureAMD64) {
+			atLeastOneActiveAMD64Pool = true
+			break
+		}
+	}
+
+	if !atLeastOneActiveAMD64Pool {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("at least one %s worker pool with min>0 and max> 0 needed", garden.ArchitectureAMD64)))
 	}
 
 	return allErrs
@@ -2054,6 +3835,76 @@ func validateWorkerVolumeType(volumeType string, fldPath *field.Path) field.Erro
 	return allErrs
 }
 
+// awsVolumeIOPSConstraints contains the IOPS limits for the AWS EBS volume types which support provisioned IOPS.
+var awsVolumeIOPSConstraints = map[string]struct {
+	min, max, maxPerGi int64
+}{
+	"io1": {min: 100, max: 64000, maxPerGi: 50},
+	"io2": {min: 100, max: 64000, maxPerGi: 500},
+	"gp3": {min: 3000, max: 16000, maxPerGi: 500},
+}
+
+func validateAWSWorkerVolumeIOPS(worker garden.AWSWorker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if worker.VolumeIOPS == nil {
+		return allErrs
+	}
+	iops := *worker.VolumeIOPS
+
+	constraints, ok := awsVolumeIOPSConstraints[worker.VolumeType]
+	if !ok {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("volume iops can only be specified for the volume types %s", strings.Join(sets.StringKeySet(awsVolumeIOPSConstraints).List(), ", "))))
+		return allErrs
+	}
+
+	if iops < constraints.min || iops > constraints.max {
+		allErrs = append(allErrs, field.Invalid(fldPath, iops, fmt.Sprintf("volume iops must be between %d and %d for volume type %s", constraints.min, constraints.max, worker.VolumeType)))
+	}
+	if maxIOPS := constraints.maxPerGi * int64(common.DiskSize(worker.VolumeSize)); iops > maxIOPS {
+		allErrs = append(allErrs, field.Invalid(fldPath, iops, fmt.Sprintf("volume iops must not exceed %d per Gi of volume size (%d) for volume type %s", constraints.maxPerGi, maxIOPS, worker.VolumeType)))
+	}
+
+	return allErrs
+}
+
+const (
+	// awsVolumeThroughputMin and awsVolumeThroughputMax are the throughput limits in MiB/s of AWS EBS gp3 volumes.
+	awsVolumeThroughputMin = 125
+	awsVolumeThroughputMax = 1000
+	// awsVolumeThroughputIOPSRatio is the number of IOPS an AWS EBS gp3 volume needs per MiB/s of throughput.
+	awsVolumeThroughputIOPSRatio = 4
+	// awsVolumeBaselineIOPS is the number of IOPS of an AWS EBS gp3 volume without provisioned IOPS.
+	awsVolumeBaselineIOPS = 3000
+)
+
+func validateAWSWorkerVolumeThroughput(worker garden.AWSWorker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if worker.VolumeThroughput == nil {
+		return allErrs
+	}
+	throughput := *worker.VolumeThroughput
+
+	if worker.VolumeType != "gp3" {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "volume throughput can only be specified for the volume type gp3"))
+		return allErrs
+	}
+
+	if throughput < awsVolumeThroughputMin || throughput > awsVolumeThroughputMax {
+		allErrs = append(allErrs, field.Invalid(fldPath, throughput, fmt.Sprintf("volume throughput must be between %d and %d MiB/s", awsVolumeThroughputMin, awsVolumeThroughputMax)))
+	}
+	iops := int64(awsVolumeBaselineIOPS)
+	if worker.VolumeIOPS != nil {
+		iops = *worker.VolumeIOPS
+	}
+	if maxThroughput := iops / awsVolumeThroughputIOPSRatio; throughput > maxThroughput {
+		allErrs = append(allErrs, field.Invalid(fldPath, throughput, fmt.Sprintf("volume throughput must not exceed 1 MiB/s per %d iops (%d MiB/s)", awsVolumeThroughputIOPSRatio, maxThroughput)))
+	}
+
+	return allErrs
+}
+
 // validateDNS1123Subdomain validates that a name is a proper DNS subdomain.
 func validateDNS1123Subdomain(value string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -2111,6 +3962,9 @@ func ValidateBackupInfrastructureSpec(spec *garden.BackupInfrastructureSpec, fld
 	if len(spec.ShootUID) == 0 {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("shootUID"), spec.Seed, "shootUID must not be empty"))
 	}
+	if spec.Region != nil && len(*spec.Region) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("region"), *spec.Region, "region must not be empty"))
+	}
 
 	return allErrs
 }
@@ -2121,6 +3975,7 @@ func ValidateBackupInfrastructureSpecUpdate(newSpec, oldSpec *garden.BackupInfra
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Seed, oldSpec.Seed, fldPath.Child("seed"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.ShootUID, oldSpec.ShootUID, fldPath.Child("shootUID"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Region, oldSpec.Region, fldPath.Child("region"))...)
 	return allErrs
 }
 
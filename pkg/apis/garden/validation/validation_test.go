@@ -181,6 +181,25 @@ var _ = Describe("validation", func() {
 				}))
 			})
 
+			It("should allow refreshing from the catalog of the cloud provider", func() {
+				awsCloudProfile.Annotations = map[string]string{common.CloudProfileCatalogSecretRef: "garden/aws-catalog"}
+
+				errorList := ValidateCloudProfile(awsCloudProfile)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid catalog secret references", func() {
+				awsCloudProfile.Annotations = map[string]string{common.CloudProfileCatalogSecretRef: "aws-catalog"}
+
+				errorList := ValidateCloudProfile(awsCloudProfile)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("metadata.annotations[cloudprofile.garden.sapcloud.io/catalog-secret-ref]"),
+				}))))
+			})
+
 			Context("kubernetes version constraints", func() {
 				It("should enforce that at least one version has been defined", func() {
 					awsCloudProfile.Spec.AWS.Constraints.Kubernetes.Versions = []string{}
@@ -294,6 +313,51 @@ var _ = Describe("validation", func() {
 					}))
 				})
 
+				It("should allow one machine image per architecture in the same region", func() {
+					awsCloudProfile.Spec.AWS.Constraints.MachineImages = []garden.AWSMachineImageMapping{
+						{
+							Name: garden.MachineImageName("some-machineimage"),
+							Regions: []garden.AWSRegionalMachineImage{
+								{
+									Name: "my-region",
+									AMI:  "ami-a1b2c3d4",
+								},
+								{
+									Name:         "my-region",
+									AMI:          "ami-e5f6a7b8",
+									Architecture: makeStringPointer(garden.ArchitectureARM64),
+								},
+							},
+						},
+					}
+
+					errorList := ValidateCloudProfile(awsCloudProfile)
+
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should forbid machine images with unsupported architectures", func() {
+					awsCloudProfile.Spec.AWS.Constraints.MachineImages = []garden.AWSMachineImageMapping{
+						{
+							Name: garden.MachineImageName("some-machineimage"),
+							Regions: []garden.AWSRegionalMachineImage{
+								{
+									Name:         "my-region",
+									AMI:          "ami-a1b2c3d4",
+									Architecture: makeStringPointer("sparc"),
+								},
+							},
+						},
+					}
+
+					errorList := ValidateCloudProfile(awsCloudProfile)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal(fmt.Sprintf("spec.%s.constraints.machineImages[0].regions[0].architecture", fldPath)),
+					}))))
+				})
+
 				It("should forbid machine images with invalid amis", func() {
 					awsCloudProfile.Spec.AWS.Constraints.MachineImages = []garden.AWSMachineImageMapping{
 						{
@@ -441,6 +505,71 @@ var _ = Describe("validation", func() {
 						"Field": Equal(fmt.Sprintf("spec.%s.constraints.zones[0].names[0]", fldPath)),
 					}))
 				})
+
+				It("should allow marking known machine and volume types as unavailable", func() {
+					awsCloudProfile.Spec.AWS.Constraints.Zones = []garden.Zone{
+						{
+							Region:                  "my-region-",
+							Names:                   []string{"my-region-a"},
+							UnavailableMachineTypes: []string{machineType.Name},
+							UnavailableVolumeTypes:  []string{"volume-type-1"},
+						},
+					}
+
+					errorList := ValidateCloudProfile(awsCloudProfile)
+
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should forbid marking unknown machine and volume types as unavailable", func() {
+					awsCloudProfile.Spec.AWS.Constraints.Zones = []garden.Zone{
+						{
+							Region:                  "my-region-",
+							Names:                   []string{"my-region-a"},
+							UnavailableMachineTypes: []string{"unknown-machine-type"},
+							UnavailableVolumeTypes:  []string{"unknown-volume-type"},
+						},
+					}
+
+					errorList := ValidateCloudProfile(awsCloudProfile)
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal(fmt.Sprintf("spec.%s.constraints.zones[0].unavailableMachineTypes[0]", fldPath)),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal(fmt.Sprintf("spec.%s.constraints.zones[0].unavailableVolumeTypes[0]", fldPath)),
+						})),
+					))
+				})
+			})
+
+			Context("terraformer validation", func() {
+				It("should forbid an empty Terraformer version", func() {
+					awsCloudProfile.Spec.Terraformer = &garden.TerraformerSettings{}
+
+					errorList := ValidateCloudProfile(awsCloudProfile)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.terraformer.version"),
+					}))))
+				})
+			})
+
+			Context("custom machine images validation", func() {
+				It("should forbid empty project names", func() {
+					awsCloudProfile.Spec.CustomMachineImages = &garden.CustomMachineImages{Projects: []string{"dev", ""}}
+
+					errorList := ValidateCloudProfile(awsCloudProfile)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.customMachineImages.projects[1]"),
+					}))))
+				})
 			})
 		})
 
@@ -776,6 +905,17 @@ var _ = Describe("validation", func() {
 				}
 			})
 
+			It("should forbid refreshing from the catalog of the cloud provider", func() {
+				gcpCloudProfile.Annotations = map[string]string{common.CloudProfileCatalogSecretRef: "garden/gcp-catalog"}
+
+				errorList := ValidateCloudProfile(gcpCloudProfile)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("metadata.annotations[cloudprofile.garden.sapcloud.io/catalog-secret-ref]"),
+				}))))
+			})
+
 			Context("kubernetes version constraints", func() {
 				It("should enforce that at least one version has been defined", func() {
 					gcpCloudProfile.Spec.GCP.Constraints.Kubernetes.Versions = []string{}
@@ -1288,6 +1428,29 @@ var _ = Describe("validation", func() {
 					}))))
 				})
 			})
+
+			Context("networkMTU validation", func() {
+				It("should accept MTUs of overlay networks", func() {
+					mtu := int32(1450)
+					openStackCloudProfile.Spec.OpenStack.NetworkMTU = &mtu
+
+					errorList := ValidateCloudProfile(openStackCloudProfile)
+
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should reject MTUs out of range", func() {
+					mtu := int32(576)
+					openStackCloudProfile.Spec.OpenStack.NetworkMTU = &mtu
+
+					errorList := ValidateCloudProfile(openStackCloudProfile)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal(fmt.Sprintf("spec.%s.networkMTU", fldPath)),
+					}))))
+				})
+			})
 		})
 
 		Context("tests for Alicloud cloud profiles", func() {
@@ -1899,6 +2062,65 @@ var _ = Describe("validation", func() {
 			}))))
 		})
 
+		It("should allow valid restrictions", func() {
+			project.Spec.Restrictions = &garden.ProjectRestrictions{
+				CloudProfiles: []garden.ProjectCloudProfile{
+					{Name: "aws"},
+					{Name: "gcp", Regions: []string{"europe-west1"}},
+				},
+				Secrets: []corev1.SecretReference{
+					{Name: "secret-1", Namespace: "garden"},
+					{Name: "secret-2", Namespace: "garden"},
+				},
+				DefaultSecretBinding: makeStringPointer("secret-2"),
+			}
+
+			errorList := ValidateProject(project)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid invalid restrictions", func() {
+			project.Spec.Restrictions = &garden.ProjectRestrictions{
+				CloudProfiles: []garden.ProjectCloudProfile{
+					{Name: "aws"},
+					{Name: "aws", Regions: []string{""}},
+					{Name: ""},
+				},
+				Secrets: []corev1.SecretReference{
+					{Name: "secret-1", Namespace: "garden"},
+					{Name: "secret-1", Namespace: "garden"},
+					{Name: "", Namespace: ""},
+				},
+				DefaultSecretBinding: makeStringPointer(""),
+			}
+
+			errorList := ValidateProject(project)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.restrictions.cloudProfiles[1].name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.restrictions.cloudProfiles[1].regions[0]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.restrictions.cloudProfiles[2].name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.restrictions.secrets[1]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.restrictions.secrets[2].name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.restrictions.secrets[2].namespace"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.restrictions.defaultSecretBinding"),
+			}))))
+		})
+
 		DescribeTable("owner validation",
 			func(apiGroup, kind, name, namespace string, expectType field.ErrorType, field string) {
 				subject := rbacv1.Subject{
@@ -2116,6 +2338,163 @@ var _ = Describe("validation", func() {
 			}))
 		})
 
+		It("should forbid an empty backup region", func() {
+			seed.Spec.Backup = &garden.SeedBackup{Region: makeStringPointer("")}
+
+			Expect(ValidateSeed(seed)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.backup.region"),
+			}))
+		})
+
+		It("should allow storing backups with another cloud provider", func() {
+			provider := garden.CloudProviderGCP
+			seed.Spec.Backup = &garden.SeedBackup{
+				Provider:  &provider,
+				Region:    makeStringPointer("europe-west1"),
+				SecretRef: &corev1.SecretReference{Name: "backup", Namespace: "garden"},
+			}
+
+			Expect(ValidateSeed(seed)).To(BeEmpty())
+		})
+
+		It("should forbid a backup provider without credentials and region", func() {
+			provider := garden.CloudProviderGCP
+			seed.Spec.Backup = &garden.SeedBackup{Provider: &provider}
+
+			Expect(ValidateSeed(seed)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.backup.secretRef"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.backup.region"),
+			}))
+		})
+
+		It("should forbid unsupported backup providers", func() {
+			provider := garden.CloudProviderOpenStack
+			seed.Spec.Backup = &garden.SeedBackup{
+				Provider:  &provider,
+				Region:    makeStringPointer("eu-de-1"),
+				SecretRef: &corev1.SecretReference{Name: "backup", Namespace: "garden"},
+			}
+
+			Expect(ValidateSeed(seed)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.backup.provider"),
+			}))
+		})
+
+		It("should allow a wildcard certificate or ACME for the ingresses", func() {
+			seed.Spec.IngressTLS = &garden.SeedIngressTLS{
+				SecretRef: &corev1.SecretReference{Name: "wildcard", Namespace: "garden"},
+			}
+			Expect(ValidateSeed(seed)).To(BeEmpty())
+
+			seed.Spec.IngressTLS = &garden.SeedIngressTLS{
+				ACME: &garden.SeedIngressACME{ClusterIssuer: "letsencrypt"},
+			}
+			Expect(ValidateSeed(seed)).To(BeEmpty())
+		})
+
+		It("should forbid invalid ingress TLS configurations", func() {
+			seed.Spec.IngressTLS = &garden.SeedIngressTLS{}
+			Expect(ValidateSeed(seed)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.ingressTLS"),
+			}))
+
+			seed.Spec.IngressTLS = &garden.SeedIngressTLS{
+				SecretRef: &corev1.SecretReference{Name: "wildcard", Namespace: "garden"},
+				ACME:      &garden.SeedIngressACME{ClusterIssuer: "letsencrypt"},
+			}
+			Expect(ValidateSeed(seed)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.ingressTLS"),
+			}))
+
+			seed.Spec.IngressTLS = &garden.SeedIngressTLS{
+				ACME: &garden.SeedIngressACME{},
+			}
+			Expect(ValidateSeed(seed)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.ingressTLS.acme.clusterIssuer"),
+			}))
+		})
+
+		It("should forbid invalid OIDC settings for the dashboards", func() {
+			seed.Spec.Settings = &garden.SeedSettings{
+				DashboardAuthentication: &garden.SeedSettingDashboardAuthentication{
+					OIDC: &garden.SeedDashboardOIDC{
+						IssuerURL: "http://issuer.example.com",
+					},
+				},
+			}
+
+			Expect(ValidateSeed(seed)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.settings.dashboardAuthentication.oidc.issuerURL"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.settings.dashboardAuthentication.oidc.clientID"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.settings.dashboardAuthentication.oidc.secretRef.name"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.settings.dashboardAuthentication.oidc.secretRef.namespace"),
+			}))
+		})
+
+		It("should forbid invalid excess capacity reservation settings", func() {
+			replicas := int32(-1)
+			seed.Spec.Settings = &garden.SeedSettings{
+				ExcessCapacityReservation: &garden.SeedSettingExcessCapacityReservation{
+					Enabled:  true,
+					Replicas: &replicas,
+					Resources: corev1.ResourceList{
+						corev1.ResourceCPU:     resource.MustParse("-1"),
+						corev1.ResourceStorage: resource.MustParse("1Gi"),
+					},
+				},
+			}
+
+			Expect(ValidateSeed(seed)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.settings.excessCapacityReservation.replicas"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.settings.excessCapacityReservation.resources[cpu]"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.settings.excessCapacityReservation.resources"),
+			}))
+		})
+
+		It("should forbid invalid annotations for the load balancer services", func() {
+			seed.Spec.Settings = &garden.SeedSettings{
+				LoadBalancerServices: &garden.SeedSettingLoadBalancerServices{
+					Annotations: map[string]string{"foo/bar/baz": "qux"},
+				},
+			}
+
+			Expect(ValidateSeed(seed)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.settings.loadBalancerServices.annotations"),
+			}))
+		})
+
+		It("should forbid Terraformer versions which are no semantic versions", func() {
+			seed.Spec.Settings = &garden.SeedSettings{
+				Terraformer: &garden.TerraformerSettings{Version: "latest"},
+			}
+
+			Expect(ValidateSeed(seed)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.settings.terraformer.version"),
+			}))
+		})
+
 		It("should fail updating immutable fields", func() {
 			newSeed := prepareSeedForUpdate(seed)
 			newSeed.Spec.Networks = garden.SeedNetworks{
@@ -2132,6 +2511,55 @@ var _ = Describe("validation", func() {
 			}))
 
 		})
+
+		It("should allow setting the backup provider", func() {
+			provider := garden.CloudProviderGCP
+			newSeed := prepareSeedForUpdate(seed)
+			newSeed.Spec.Backup = &garden.SeedBackup{
+				Provider:  &provider,
+				Region:    makeStringPointer("europe-west1"),
+				SecretRef: &corev1.SecretReference{Name: "backup", Namespace: "garden"},
+			}
+
+			Expect(ValidateSeedUpdate(newSeed, seed)).To(BeEmpty())
+		})
+
+		It("should forbid changing the backup provider", func() {
+			provider := garden.CloudProviderGCP
+			seed.Spec.Backup = &garden.SeedBackup{
+				Provider:  &provider,
+				Region:    makeStringPointer("europe-west1"),
+				SecretRef: &corev1.SecretReference{Name: "backup", Namespace: "garden"},
+			}
+			newProvider := garden.CloudProviderAzure
+			newSeed := prepareSeedForUpdate(seed)
+			newSeed.Spec.Backup = &garden.SeedBackup{
+				Provider:  &newProvider,
+				Region:    makeStringPointer("westeurope"),
+				SecretRef: &corev1.SecretReference{Name: "backup", Namespace: "garden"},
+			}
+
+			Expect(ValidateSeedUpdate(newSeed, seed)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.backup.provider"),
+			}))
+		})
+
+		It("should forbid removing the backup provider", func() {
+			provider := garden.CloudProviderGCP
+			seed.Spec.Backup = &garden.SeedBackup{
+				Provider:  &provider,
+				Region:    makeStringPointer("europe-west1"),
+				SecretRef: &corev1.SecretReference{Name: "backup", Namespace: "garden"},
+			}
+			newSeed := prepareSeedForUpdate(seed)
+			newSeed.Spec.Backup = nil
+
+			Expect(ValidateSeedUpdate(newSeed, seed)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.backup.provider"),
+			}))
+		})
 	})
 
 	Describe("#ValidateQuota", func() {
@@ -2190,41 +2618,208 @@ var _ = Describe("validation", func() {
 		})
 	})
 
-	Describe("#ValidateSecretBinding, #ValidateSecretBindingUpdate", func() {
-		var secretBinding *garden.SecretBinding
+	Describe("#ValidateDeletionProtectionPolicy, #ValidateDeletionProtectionPolicyUpdate", func() {
+		var policy *garden.DeletionProtectionPolicy
 
 		BeforeEach(func() {
-			secretBinding = &garden.SecretBinding{
+			policy = &garden.DeletionProtectionPolicy{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "profile",
-					Namespace: "garden",
+					Name: "production",
 				},
-				SecretRef: corev1.SecretReference{
-					Name:      "my-secret",
-					Namespace: "my-namespace",
+				Spec: garden.DeletionProtectionPolicySpec{
+					ShootSelector: metav1.LabelSelector{
+						MatchLabels: map[string]string{"environment": "production"},
+					},
+					RequiredApprovals: 2,
+					BackupRetention:   &metav1.Duration{Duration: 720 * time.Hour},
 				},
 			}
 		})
 
 		It("should not return any errors", func() {
-			errorList := ValidateSecretBinding(secretBinding)
+			errorList := ValidateDeletionProtectionPolicy(policy)
 
-			Expect(len(errorList)).To(Equal(0))
+			Expect(errorList).To(BeEmpty())
 		})
 
-		It("should forbid empty SecretBinding resources", func() {
-			secretBinding.ObjectMeta = metav1.ObjectMeta{}
-			secretBinding.SecretRef = corev1.SecretReference{}
+		It("should forbid DeletionProtectionPolicy specification with empty or invalid keys", func() {
+			policy.ObjectMeta = metav1.ObjectMeta{}
+			policy.Spec.ShootSelector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "environment", Operator: "Foo"}}
+			policy.Spec.RequiredApprovals = -1
+			policy.Spec.BackupRetention = &metav1.Duration{Duration: -time.Hour}
 
-			errorList := ValidateSecretBinding(secretBinding)
+			errorList := ValidateDeletionProtectionPolicy(policy)
 
-			Expect(len(errorList)).To(Equal(3))
-			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
-				"Type":  Equal(field.ErrorTypeRequired),
-				"Field": Equal("metadata.name"),
-			}))
-			Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
-				"Type":  Equal(field.ErrorTypeRequired),
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("metadata.name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.shootSelector.matchExpressions[0].operator"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.requiredApprovals"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.backupRetention"),
+				})),
+			))
+		})
+
+		It("should forbid DeletionProtectionPolicies without any effect", func() {
+			policy.Spec.RequiredApprovals = 0
+			policy.Spec.BackupRetention = nil
+
+			errorList := ValidateDeletionProtectionPolicy(policy)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec"),
+			}))))
+		})
+
+		It("should forbid changing the name of a DeletionProtectionPolicy", func() {
+			policy.ResourceVersion = "1"
+			newPolicy := policy.DeepCopy()
+			newPolicy.Name = "development"
+
+			errorList := ValidateDeletionProtectionPolicyUpdate(newPolicy, policy)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("metadata.name"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateShootTemplate, #ValidateShootTemplateUpdate", func() {
+		var (
+			shootTemplate *garden.ShootTemplate
+
+			podsCIDR = gardencore.CIDR("100.96.0.0/11")
+		)
+
+		BeforeEach(func() {
+			shootTemplate = &garden.ShootTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "template-1",
+					Namespace: "my-namespace",
+				},
+				Spec: garden.ShootTemplateSpec{
+					Networks: &gardencore.K8SNetworks{
+						Pods: &podsCIDR,
+					},
+					Workers: []garden.ShootTemplateWorker{
+						{
+							Worker: garden.Worker{
+								Name:          "worker-1",
+								MachineType:   "large",
+								AutoScalerMin: 1,
+								AutoScalerMax: 1,
+								MaxSurge:      intstr.FromInt(1),
+							},
+							VolumeSize: "20Gi",
+						},
+					},
+				},
+			}
+		})
+
+		It("should not return any errors", func() {
+			errorList := ValidateShootTemplate(shootTemplate)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid ShootTemplate specification with empty or invalid keys", func() {
+			invalidCIDR := gardencore.CIDR("invalid-cidr")
+			shootTemplate.ObjectMeta = metav1.ObjectMeta{}
+			shootTemplate.Spec.Networks.Services = &invalidCIDR
+			shootTemplate.Spec.Workers[0].VolumeSize = "-20Gi"
+			shootTemplate.Spec.Workers = append(shootTemplate.Spec.Workers, shootTemplate.Spec.Workers[0])
+
+			errorList := ValidateShootTemplate(shootTemplate)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("metadata.name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("metadata.namespace"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.networks.services"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.workers[0].volumeSize"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.workers[1].volumeSize"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.workers[1]"),
+				})),
+			))
+		})
+
+		It("should forbid changing the name of a ShootTemplate", func() {
+			newShootTemplate := prepareShootTemplateForUpdate(shootTemplate)
+			newShootTemplate.Name = "template-2"
+
+			errorList := ValidateShootTemplateUpdate(newShootTemplate, shootTemplate)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("metadata.name"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateSecretBinding, #ValidateSecretBindingUpdate", func() {
+		var secretBinding *garden.SecretBinding
+
+		BeforeEach(func() {
+			secretBinding = &garden.SecretBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "profile",
+					Namespace: "garden",
+				},
+				SecretRef: corev1.SecretReference{
+					Name:      "my-secret",
+					Namespace: "my-namespace",
+				},
+			}
+		})
+
+		It("should not return any errors", func() {
+			errorList := ValidateSecretBinding(secretBinding)
+
+			Expect(len(errorList)).To(Equal(0))
+		})
+
+		It("should forbid empty SecretBinding resources", func() {
+			secretBinding.ObjectMeta = metav1.ObjectMeta{}
+			secretBinding.SecretRef = corev1.SecretReference{}
+
+			errorList := ValidateSecretBinding(secretBinding)
+
+			Expect(len(errorList)).To(Equal(3))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("metadata.name"),
+			}))
+			Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
 				"Field": Equal("metadata.namespace"),
 			}))
 			Expect(*errorList[2]).To(MatchFields(IgnoreExtras, Fields{
@@ -2247,6 +2842,71 @@ var _ = Describe("validation", func() {
 			}))
 		})
 
+		It("should allow reading the credentials from Vault", func() {
+			secretBinding.SecretRef = corev1.SecretReference{}
+			secretBinding.VaultRef = &garden.SecretBindingVaultReference{
+				Path:       "aws/creds/deployer",
+				KeyMapping: map[string]string{"accessKeyID": "access_key"},
+			}
+
+			Expect(ValidateSecretBinding(secretBinding)).To(BeEmpty())
+		})
+
+		It("should forbid a secret reference or rotation along with a Vault reference", func() {
+			secretBinding.VaultRef = &garden.SecretBindingVaultReference{Path: "aws/creds/deployer"}
+			secretBinding.Rotation = &garden.SecretBindingRotation{
+				SecretRef: corev1.SecretReference{Name: "new-secret", Namespace: "my-namespace"},
+			}
+
+			Expect(ValidateSecretBinding(secretBinding)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("secretRef"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("rotation"),
+			}))
+		})
+
+		It("should forbid Vault paths escaping the namespace's path", func() {
+			secretBinding.SecretRef = corev1.SecretReference{}
+
+			for _, path := range []string{"", "/aws/creds/deployer", "../garden-other/aws/creds/deployer", "aws//creds", "aws/creds/"} {
+				secretBinding.VaultRef = &garden.SecretBindingVaultReference{Path: path}
+
+				Expect(ValidateSecretBinding(secretBinding)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Field": Equal("vaultRef.path"),
+				}))), path)
+			}
+		})
+
+		It("should forbid invalid Vault key mappings", func() {
+			secretBinding.SecretRef = corev1.SecretReference{}
+			secretBinding.VaultRef = &garden.SecretBindingVaultReference{
+				Path:       "aws/creds/deployer",
+				KeyMapping: map[string]string{"access key": "access_key", "secretAccessKey": ""},
+			}
+
+			Expect(ValidateSecretBinding(secretBinding)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("vaultRef.keyMapping"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("vaultRef.keyMapping[secretAccessKey]"),
+			}))
+		})
+
+		It("should forbid changing the Vault reference", func() {
+			secretBinding.SecretRef = corev1.SecretReference{}
+			secretBinding.VaultRef = &garden.SecretBindingVaultReference{Path: "aws/creds/deployer"}
+			newSecretBinding := prepareSecretBindingForUpdate(secretBinding)
+			newSecretBinding.VaultRef.Path = "aws/creds/admin"
+
+			Expect(ValidateSecretBindingUpdate(newSecretBinding, secretBinding)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("vaultRef"),
+			}))
+		})
+
 		It("should forbid updating the secret binding spec", func() {
 			newSecretBinding := prepareSecretBindingForUpdate(secretBinding)
 			newSecretBinding.SecretRef.Name = "another-name"
@@ -2267,6 +2927,110 @@ var _ = Describe("validation", func() {
 				"Field": Equal("quotas"),
 			}))
 		})
+
+		Context("credentials rotation", func() {
+			BeforeEach(func() {
+				secretBinding.Rotation = &garden.SecretBindingRotation{
+					SecretRef: corev1.SecretReference{
+						Name:      "my-new-secret",
+						Namespace: "my-namespace",
+					},
+				}
+			})
+
+			It("should allow a rotation to another secret", func() {
+				errorList := ValidateSecretBinding(secretBinding)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid a rotation without secret name", func() {
+				secretBinding.Rotation.SecretRef.Name = ""
+
+				errorList := ValidateSecretBinding(secretBinding)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("rotation.secretRef.name"),
+				}))))
+			})
+
+			It("should forbid a rotation to the current secret", func() {
+				secretBinding.Rotation.SecretRef = secretBinding.SecretRef
+
+				errorList := ValidateSecretBinding(secretBinding)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("rotation.secretRef"),
+				}))))
+			})
+
+			It("should forbid unsupported rotation phases", func() {
+				secretBinding.Rotation.Status = &garden.SecretBindingRotationStatus{
+					Phase: garden.SecretBindingRotationPhase("foo"),
+				}
+
+				errorList := ValidateSecretBinding(secretBinding)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("rotation.status.phase"),
+				}))))
+			})
+
+			It("should forbid changing the secret of a rotation in progress", func() {
+				secretBinding.Rotation.Status = &garden.SecretBindingRotationStatus{
+					Phase: garden.SecretBindingRotationPhaseRolling,
+				}
+				newSecretBinding := prepareSecretBindingForUpdate(secretBinding)
+				newSecretBinding.Rotation.SecretRef.Name = "another-name"
+
+				errorList := ValidateSecretBindingUpdate(newSecretBinding, secretBinding)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("rotation.secretRef"),
+				}))))
+			})
+
+			It("should forbid switching the secret before the rotation is completed", func() {
+				secretBinding.Rotation.Status = &garden.SecretBindingRotationStatus{
+					Phase: garden.SecretBindingRotationPhaseRolling,
+				}
+				newSecretBinding := prepareSecretBindingForUpdate(secretBinding)
+				newSecretBinding.SecretRef = secretBinding.Rotation.SecretRef
+				newSecretBinding.Rotation.Status.Phase = garden.SecretBindingRotationPhaseCompleted
+				newSecretBinding.Rotation.SecretRef.Name = "another-name"
+
+				errorList := ValidateSecretBindingUpdate(newSecretBinding, secretBinding)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("secretRef"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("rotation.secretRef"),
+					})),
+				))
+			})
+
+			It("should allow switching the secret when completing the rotation", func() {
+				secretBinding.Rotation.Status = &garden.SecretBindingRotationStatus{
+					Phase: garden.SecretBindingRotationPhaseRolling,
+				}
+				newSecretBinding := prepareSecretBindingForUpdate(secretBinding)
+				newSecretBinding.SecretRef = secretBinding.Rotation.SecretRef
+				newSecretBinding.Rotation.Status.Phase = garden.SecretBindingRotationPhaseCompleted
+				newSecretBinding.Rotation.Status.PreviousSecretRef = &secretBinding.SecretRef
+
+				errorList := ValidateSecretBindingUpdate(newSecretBinding, secretBinding)
+
+				Expect(errorList).To(BeEmpty())
+			})
+		})
 	})
 
 	Describe("#ValidateWorker", func() {
@@ -2299,6 +3063,54 @@ var _ = Describe("validation", func() {
 			Entry("percentage is not less than zero", intstr.FromString("-90%"), intstr.FromString("90%"), field.ErrorTypeInvalid),
 		)
 
+		DescribeTable("validate OS update configuration",
+			func(osUpdates *garden.WorkerOSUpdates, matcher gomegatypes.GomegaMatcher) {
+				worker := garden.Worker{
+					Name:           "worker-name",
+					MachineType:    "large",
+					MaxSurge:       intstr.FromInt(1),
+					MaxUnavailable: intstr.FromInt(0),
+					OSUpdates:      osUpdates,
+				}
+				errList := ValidateWorker(worker, field.NewPath("worker"))
+
+				Expect(errList).To(matcher)
+			},
+
+			Entry("valid channel", &garden.WorkerOSUpdates{Channel: garden.WorkerOSUpdateChannelStable}, BeEmpty()),
+			Entry("valid reboot window", &garden.WorkerOSUpdates{
+				Channel:      garden.WorkerOSUpdateChannelBeta,
+				RebootWindow: &garden.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"},
+			}, BeEmpty()),
+			Entry("unsupported channel", &garden.WorkerOSUpdates{Channel: "nightly"}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("worker.osUpdates.channel"),
+			})))),
+			Entry("too small reboot window", &garden.WorkerOSUpdates{
+				Channel:      garden.WorkerOSUpdateChannelStable,
+				RebootWindow: &garden.MaintenanceTimeWindow{Begin: "220000+0100", End: "221000+0100"},
+			}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("worker.osUpdates.rebootWindow"),
+			})))),
+		)
+
+		It("should forbid an empty custom machine image", func() {
+			worker := garden.Worker{
+				Name:               "worker-name",
+				MachineType:        "large",
+				MaxSurge:           intstr.FromInt(1),
+				MaxUnavailable:     intstr.FromInt(0),
+				CustomMachineImage: makeStringPointer(" "),
+			}
+			errList := ValidateWorker(worker, field.NewPath("worker"))
+
+			Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("worker.customMachineImage"),
+			}))))
+		})
+
 		DescribeTable("reject when labels are invalid",
 			func(labels map[string]string, expectType field.ErrorType) {
 				worker := garden.Worker{
@@ -2386,23 +3198,168 @@ var _ = Describe("validation", func() {
 			// uniqueness by key/effect
 			Entry("not unique", []corev1.Taint{{Key: "foo", Value: "bar", Effect: corev1.TaintEffectNoSchedule}, {Key: "foo", Value: "baz", Effect: corev1.TaintEffectNoSchedule}}, field.ErrorTypeDuplicate),
 		)
-	})
 
-	Describe("#ValidateWorkers", func() {
-		DescribeTable("validate that at least one active worker pool is configured",
-			func(min1, max1, min2, max2 int, matcher gomegatypes.GomegaMatcher) {
-				workers := []garden.Worker{
-					{
-						AutoScalerMin: min1,
-						AutoScalerMax: max1,
-					},
-					{
-						AutoScalerMin: min2,
-						AutoScalerMax: max2,
-					},
+		DescribeTable("validate data volumes",
+			func(dataVolumes []garden.DataVolume, kubeletDataVolumeName *string, matcher gomegatypes.GomegaMatcher) {
+				worker := garden.Worker{
+					Name:                  "worker-name",
+					MachineType:           "large",
+					MaxSurge:              intstr.FromInt(1),
+					MaxUnavailable:        intstr.FromInt(0),
+					DataVolumes:           dataVolumes,
+					KubeletDataVolumeName: kubeletDataVolumeName,
 				}
-
-				errList := ValidateWorkers(workers, nil)
+				errList := ValidateWorker(worker, field.NewPath("worker"))
+
+				Expect(errList).To(matcher)
+			},
+
+			Entry("valid data volumes", []garden.DataVolume{
+				{Name: "kubelet", Size: "100Gi", Type: makeStringPointer("gp2"), Encrypted: makeBoolPointer(true)},
+				{Name: "scratch", Size: "50Gi"},
+			}, makeStringPointer("kubelet"), BeEmpty()),
+			Entry("invalid name", []garden.DataVolume{{Name: "Scratch", Size: "50Gi"}}, nil, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("worker.dataVolumes[0].name"),
+			})))),
+			Entry("duplicate names", []garden.DataVolume{{Name: "scratch", Size: "50Gi"}, {Name: "scratch", Size: "60Gi"}}, nil, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("worker.dataVolumes[1].name"),
+			})))),
+			Entry("invalid size", []garden.DataVolume{{Name: "scratch", Size: "50G"}}, nil, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("worker.dataVolumes[0].size"),
+			})))),
+			Entry("empty type", []garden.DataVolume{{Name: "scratch", Size: "50Gi", Type: makeStringPointer("")}}, nil, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("worker.dataVolumes[0].type"),
+			})))),
+			Entry("unknown kubelet data volume", []garden.DataVolume{{Name: "scratch", Size: "50Gi"}}, makeStringPointer("kubelet"), ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotFound),
+				"Field": Equal("worker.kubeletDataVolumeName"),
+			})))),
+			Entry("kubelet data volume without unique size", []garden.DataVolume{{Name: "kubelet", Size: "50Gi"}, {Name: "scratch", Size: "50Gi"}}, makeStringPointer("kubelet"), ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("worker.kubeletDataVolumeName"),
+			})))),
+		)
+
+		DescribeTable("validate architecture",
+			func(architecture *string, matcher gomegatypes.GomegaMatcher) {
+				worker := garden.Worker{
+					Name:           "worker-name",
+					MachineType:    "large",
+					MaxSurge:       intstr.FromInt(1),
+					MaxUnavailable: intstr.FromInt(0),
+					Architecture:   architecture,
+				}
+				errList := ValidateWorker(worker, field.NewPath("worker"))
+
+				Expect(errList).To(matcher)
+			},
+
+			Entry("no architecture", nil, BeEmpty()),
+			Entry("amd64", makeStringPointer(garden.ArchitectureAMD64), BeEmpty()),
+			Entry("arm64", makeStringPointer(garden.ArchitectureARM64), BeEmpty()),
+			Entry("unsupported architecture", makeStringPointer("sparc"), ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("worker.architecture"),
+			})))),
+		)
+
+		DescribeTable("validate capacity configuration",
+			func(capacity *garden.WorkerCapacity, matcher gomegatypes.GomegaMatcher) {
+				worker := garden.Worker{
+					Name:           "worker-name",
+					MachineType:    "large",
+					AutoScalerMin:  2,
+					AutoScalerMax:  10,
+					MaxSurge:       intstr.FromInt(1),
+					MaxUnavailable: intstr.FromInt(0),
+					Capacity:       capacity,
+				}
+				errList := ValidateWorker(worker, field.NewPath("worker"))
+
+				Expect(errList).To(matcher)
+			},
+
+			Entry("valid configuration", &garden.WorkerCapacity{FallbackMachineTypes: []string{"medium"}, SpotPercentage: makeInt32Pointer(80)}, BeEmpty()),
+			Entry("empty fallback machine type", &garden.WorkerCapacity{FallbackMachineTypes: []string{""}}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("worker.capacity.fallbackMachineTypes[0]"),
+			})))),
+			Entry("duplicate fallback machine type", &garden.WorkerCapacity{FallbackMachineTypes: []string{"medium", "large"}}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("worker.capacity.fallbackMachineTypes[1]"),
+			})))),
+			Entry("spot percentage out of range", &garden.WorkerCapacity{SpotPercentage: makeInt32Pointer(101)}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("worker.capacity.spotPercentage"),
+			})))),
+			Entry("spot percentage not leaving enough on-demand machines", &garden.WorkerCapacity{SpotPercentage: makeInt32Pointer(90)}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("worker.capacity.spotPercentage"),
+			})))),
+		)
+
+		DescribeTable("validate kubelet configuration",
+			func(kubelet *garden.WorkerKubeletConfig, matcher gomegatypes.GomegaMatcher) {
+				worker := garden.Worker{
+					Name:           "worker-name",
+					MachineType:    "large",
+					MaxSurge:       intstr.FromInt(1),
+					MaxUnavailable: intstr.FromInt(0),
+					Kubelet:        kubelet,
+				}
+				errList := ValidateWorker(worker, field.NewPath("worker"))
+
+				Expect(errList).To(matcher)
+			},
+
+			Entry("valid configuration", &garden.WorkerKubeletConfig{
+				ImageGCHighThresholdPercent: makeInt32Pointer(70),
+				ImageGCLowThresholdPercent:  makeInt32Pointer(60),
+				ContainerLogMaxSize:         resource.NewQuantity(10*1024*1024, resource.BinarySI),
+				ContainerLogMaxFiles:        makeInt32Pointer(5),
+			}, BeEmpty()),
+			Entry("high threshold out of range", &garden.WorkerKubeletConfig{ImageGCHighThresholdPercent: makeInt32Pointer(101)}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("worker.kubelet.imageGCHighThresholdPercent"),
+			})))),
+			Entry("low threshold not lower than default high threshold", &garden.WorkerKubeletConfig{ImageGCLowThresholdPercent: makeInt32Pointer(50)}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("worker.kubelet.imageGCLowThresholdPercent"),
+			})))),
+			Entry("high threshold not higher than default low threshold", &garden.WorkerKubeletConfig{ImageGCHighThresholdPercent: makeInt32Pointer(30)}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("worker.kubelet.imageGCLowThresholdPercent"),
+			})))),
+			Entry("too small container log size", &garden.WorkerKubeletConfig{ContainerLogMaxSize: resource.NewQuantity(1024, resource.BinarySI)}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("worker.kubelet.containerLogMaxSize"),
+			})))),
+			Entry("too few container log files", &garden.WorkerKubeletConfig{ContainerLogMaxFiles: makeInt32Pointer(1)}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("worker.kubelet.containerLogMaxFiles"),
+			})))),
+		)
+	})
+
+	Describe("#ValidateWorkers", func() {
+		DescribeTable("validate that at least one active worker pool is configured",
+			func(min1, max1, min2, max2 int, matcher gomegatypes.GomegaMatcher) {
+				workers := []garden.Worker{
+					{
+						AutoScalerMin: min1,
+						AutoScalerMax: max1,
+					},
+					{
+						AutoScalerMin: min2,
+						AutoScalerMax: max2,
+					},
+				}
+
+				errList := ValidateWorkers(workers, nil)
 
 				Expect(errList).To(matcher)
 			},
@@ -2412,6 +3369,33 @@ var _ = Describe("validation", func() {
 				"Type": Equal(field.ErrorTypeForbidden),
 			})))),
 		)
+
+		DescribeTable("validate that at least one active amd64 worker pool is configured",
+			func(architecture1, architecture2 *string, matcher gomegatypes.GomegaMatcher) {
+				workers := []garden.Worker{
+					{
+						AutoScalerMin: 1,
+						AutoScalerMax: 1,
+						Architecture:  architecture1,
+					},
+					{
+						AutoScalerMin: 1,
+						AutoScalerMax: 1,
+						Architecture:  architecture2,
+					},
+				}
+
+				errList := ValidateWorkers(workers, nil)
+
+				Expect(errList).To(matcher)
+			},
+
+			Entry("default architecture", nil, makeStringPointer(garden.ArchitectureARM64), HaveLen(0)),
+			Entry("amd64 and arm64", makeStringPointer(garden.ArchitectureAMD64), makeStringPointer(garden.ArchitectureARM64), HaveLen(0)),
+			Entry("only arm64", makeStringPointer(garden.ArchitectureARM64), makeStringPointer(garden.ArchitectureARM64), ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type": Equal(field.ErrorTypeForbidden),
+			})))),
+		)
 	})
 
 	Describe("#ValidateHibernationSchedules", func() {
@@ -2799,6 +3783,47 @@ var _ = Describe("validation", func() {
 			))
 		})
 
+		It("should allow the oidc authentication mode for the kubernetes-dashboard if the kube-apiserver's OIDC config is set", func() {
+			shoot.Spec.Addons.KubernetesDashboard.AuthenticationMode = makeStringPointer(garden.KubernetesDashboardAuthModeOIDC)
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid the oidc authentication mode for the kubernetes-dashboard if the kube-apiserver's OIDC config is not set", func() {
+			shoot.Spec.Addons.KubernetesDashboard.AuthenticationMode = makeStringPointer(garden.KubernetesDashboardAuthModeOIDC)
+			shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig = nil
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.addons.kubernetes-dashboard.authenticationMode"),
+			}))))
+		})
+
+		It("should forbid the basic authentication mode for the kubernetes-dashboard if the kube-apiserver does not accept basic authentication", func() {
+			shoot.Spec.Addons.KubernetesDashboard.AuthenticationMode = makeStringPointer(garden.KubernetesDashboardAuthModeBasic)
+			shoot.Spec.Kubernetes.KubeAPIServer.EnableBasicAuthentication = makeBoolPointer(false)
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.addons.kubernetes-dashboard.authenticationMode"),
+			}))))
+		})
+
+		It("should allow the token authentication mode for the kubernetes-dashboard if the kube-apiserver does not accept basic authentication", func() {
+			shoot.Spec.Addons.KubernetesDashboard.AuthenticationMode = makeStringPointer(garden.KubernetesDashboardAuthModeToken)
+			shoot.Spec.Kubernetes.KubeAPIServer.EnableBasicAuthentication = makeBoolPointer(false)
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
 		It("should forbid unsupported cloud specification (provider independent)", func() {
 			shoot.Spec.Cloud.Profile = ""
 			shoot.Spec.Cloud.Region = ""
@@ -2839,59 +3864,535 @@ var _ = Describe("validation", func() {
 
 			errorList := ValidateShootUpdate(newShoot, shoot)
 
-			Expect(errorList).To(ConsistOf(
-				PointTo(MatchFields(IgnoreExtras, Fields{
-					"Type":  Equal(field.ErrorTypeInvalid),
-					"Field": Equal("spec.cloud.profile"),
-				})),
-				PointTo(MatchFields(IgnoreExtras, Fields{
-					"Type":  Equal(field.ErrorTypeInvalid),
-					"Field": Equal("spec.cloud.region"),
-				})),
-				PointTo(MatchFields(IgnoreExtras, Fields{
-					"Type":  Equal(field.ErrorTypeInvalid),
-					"Field": Equal("spec.cloud.secretBindingRef"),
-				})),
-				PointTo(MatchFields(IgnoreExtras, Fields{
-					"Type":  Equal(field.ErrorTypeInvalid),
-					"Field": Equal("spec.cloud.seed"),
-				}))),
-			)
-		})
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.cloud.profile"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.cloud.region"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.cloud.secretBindingRef"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.cloud.seed"),
+				}))),
+			)
+		})
+
+		Context("AWS specific validation", func() {
+			var (
+				fldPath  = "aws"
+				awsCloud *garden.AWSCloud
+			)
+
+			BeforeEach(func() {
+				awsCloud = &garden.AWSCloud{
+					Networks: garden.AWSNetworks{
+						K8SNetworks: k8sNetworks,
+						Internal:    []gardencore.CIDR{"10.250.1.0/24"},
+						Public:      []gardencore.CIDR{"10.250.2.0/24"},
+						Workers:     []gardencore.CIDR{"10.250.3.0/24"},
+						VPC: garden.AWSVPC{
+							CIDR: &vpcCIDR,
+						},
+					},
+					Workers: []garden.AWSWorker{
+						{
+							Worker:     worker,
+							VolumeSize: "20Gi",
+							VolumeType: "default",
+						},
+					},
+					Zones: []string{"eu-west-1a"},
+				}
+				shoot.Spec.Cloud.AWS = awsCloud
+			})
+
+			It("should not return any errors", func() {
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(HaveLen(0))
+			})
+
+			Context("node CIDR capacity", func() {
+				var (
+					maskSize = func(size int) *garden.KubeControllerManagerConfig {
+						return &garden.KubeControllerManagerConfig{NodeCIDRMaskSize: &size}
+					}
+					maxPods = func(pods int32) *int32 { return &pods }
+				)
+
+				It("should allow a smaller node CIDR with fewer pods per node", func() {
+					shoot.Spec.Kubernetes.KubeControllerManager = maskSize(26)
+					shoot.Spec.Cloud.AWS.Workers[0].MaxPods = maxPods(60)
+
+					errorList := ValidateShoot(shoot)
+
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should forbid node CIDR mask sizes out of range", func() {
+					shoot.Spec.Kubernetes.KubeControllerManager = maskSize(30)
+
+					errorList := ValidateShoot(shoot)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeControllerManager.nodeCIDRMaskSize"),
+					}))))
+				})
+
+				It("should forbid more pods per node than addresses in the node CIDR", func() {
+					shoot.Spec.Kubernetes.KubeControllerManager = maskSize(26)
+					shoot.Spec.Cloud.AWS.Workers[0].MaxPods = maxPods(110)
+
+					errorList := ValidateShoot(shoot)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].maxPods", fldPath)),
+					}))))
+				})
+
+				It("should require the max pods if the node CIDR is too small for the default", func() {
+					shoot.Spec.Kubernetes.KubeControllerManager = maskSize(26)
+					shoot.Spec.Cloud.AWS.Workers[0].MaxPods = nil
+
+					errorList := ValidateShoot(shoot)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeRequired),
+						"Field":  Equal(fmt.Sprintf("spec.cloud.%s.workers[0].maxPods", fldPath)),
+						"Detail": ContainSubstring("default of 110 pods exceeds the 64 addresses"),
+					}))))
+				})
+
+				It("should allow the default max pods with a node CIDR of mask size 25", func() {
+					shoot.Spec.Kubernetes.KubeControllerManager = maskSize(25)
+					shoot.Spec.Cloud.AWS.Workers[0].MaxPods = nil
+
+					errorList := ValidateShoot(shoot)
+
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should forbid changing the node CIDR mask size", func() {
+					newShoot := prepareShootForUpdate(shoot)
+					newShoot.Spec.Kubernetes.KubeControllerManager = maskSize(25)
+
+					errorList := ValidateShootUpdate(newShoot, shoot)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeControllerManager.nodeCIDRMaskSize"),
+					}))))
+				})
+
+				It("should allow setting the node CIDR mask size to the default", func() {
+					newShoot := prepareShootForUpdate(shoot)
+					newShoot.Spec.Kubernetes.KubeControllerManager = maskSize(24)
+
+					errorList := ValidateShootUpdate(newShoot, shoot)
+
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should forbid a non-positive number of pods per node", func() {
+					shoot.Spec.Cloud.AWS.Workers[0].MaxPods = maxPods(0)
+
+					errorList := ValidateShoot(shoot)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].maxPods", fldPath)),
+					}))))
+				})
+
+				It("should forbid more nodes than node CIDRs in the pods network", func() {
+					pods := gardencore.CIDR("100.96.0.0/20")
+					shoot.Spec.Cloud.AWS.Networks.Pods = &pods
+					shoot.Spec.Kubernetes.KubeControllerManager = maskSize(24)
+					shoot.Spec.Cloud.AWS.Workers[0].AutoScalerMax = 20
+
+					errorList := ValidateShoot(shoot)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.kubernetes.kubeControllerManager.nodeCIDRMaskSize"),
+						"Detail": ContainSubstring("offers only 16 node CIDRs but the worker pools may scale up to 20 nodes"),
+					}))))
+				})
+
+				It("should forbid node CIDRs larger than the pods network", func() {
+					pods := gardencore.CIDR("100.96.0.0/20")
+					shoot.Spec.Cloud.AWS.Networks.Pods = &pods
+					shoot.Spec.Kubernetes.KubeControllerManager = maskSize(16)
+
+					errorList := ValidateShoot(shoot)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.kubernetes.kubeControllerManager.nodeCIDRMaskSize"),
+						"Detail": ContainSubstring("must not be smaller than the prefix length 20"),
+					}))))
+				})
+			})
+
+			Context("node monitoring and pod eviction timings", func() {
+				duration := func(d time.Duration) *metav1.Duration { return &metav1.Duration{Duration: d} }
+
+				It("should allow timings within the ranges", func() {
+					shoot.Spec.Kubernetes.KubeControllerManager = &garden.KubeControllerManagerConfig{
+						NodeMonitorGracePeriod: duration(5 * time.Minute),
+						PodEvictionTimeout:     duration(30 * time.Second),
+					}
+
+					errorList := ValidateShoot(shoot)
+
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should forbid timings out of the ranges", func() {
+					shoot.Spec.Kubernetes.KubeControllerManager = &garden.KubeControllerManagerConfig{
+						NodeMonitorGracePeriod: duration(10 * time.Second),
+						PodEvictionTimeout:     duration(48 * time.Hour),
+					}
+
+					errorList := ValidateShoot(shoot)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeControllerManager.nodeMonitorGracePeriod"),
+					})), PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeControllerManager.podEvictionTimeout"),
+					}))))
+				})
+			})
+
+			It("should allow valid additional tags", func() {
+				shoot.Spec.Cloud.Tags = map[string]string{"cost-center": "1234", "owner": "team-a"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid reserved or too long tag keys", func() {
+				shoot.Spec.Cloud.Tags = map[string]string{
+					"aws:createdBy":                  "foo",
+					"Name":                           "foo",
+					strings.Repeat("k", 128):         "foo",
+					"kubernetes.io/cluster/my-shoot": "1",
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.tags[aws:createdBy]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.tags[Name]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeTooLong),
+						"Field": Equal(fmt.Sprintf("spec.cloud.tags[%s]", strings.Repeat("k", 128))),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.tags[kubernetes.io/cluster/my-shoot]"),
+					})),
+				))
+			})
+
+			It("should allow selecting worker labels as instance tags", func() {
+				shoot.Spec.Cloud.AWS.Workers[0].Labels = map[string]string{"team": "a", "cost-center": "1234"}
+				shoot.Spec.Cloud.AWS.Workers[0].InstanceTagLabels = []string{"team", "cost-center"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid instance tag labels", func() {
+				shoot.Spec.Cloud.Tags = map[string]string{"team": "b"}
+				shoot.Spec.Cloud.AWS.Workers[0].Labels = map[string]string{"team": "a", "kubernetes.io/role": "node"}
+				shoot.Spec.Cloud.AWS.Workers[0].InstanceTagLabels = []string{"", "missing", "team", "team", "kubernetes.io/role"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.cloud.aws.workers[0].instanceTagLabels[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.aws.workers[0].instanceTagLabels[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.aws.workers[0].instanceTagLabels[2]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.cloud.aws.workers[0].instanceTagLabels[3]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.aws.workers[0].instanceTagLabels[3]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.aws.workers[0].instanceTagLabels[4]"),
+					})),
+				))
+			})
+
+			It("should allow valid service load balancer defaults", func() {
+				shoot.Spec.Cloud.ServiceLoadBalancer = &garden.ServiceLoadBalancer{
+					Internal:    makeBoolPointer(true),
+					Annotations: map[string]string{"service.beta.kubernetes.io/aws-load-balancer-connection-idle-timeout": "300"},
+				}
+				shoot.Spec.Cloud.AWS.ServiceLoadBalancer = &garden.AWSServiceLoadBalancer{Type: makeStringPointer("nlb")}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid service load balancer defaults", func() {
+				shoot.Spec.Cloud.ServiceLoadBalancer = &garden.ServiceLoadBalancer{
+					Annotations: map[string]string{"invalid key": "foo"},
+				}
+				shoot.Spec.Cloud.AWS.ServiceLoadBalancer = &garden.AWSServiceLoadBalancer{Type: makeStringPointer("alb")}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.serviceLoadBalancer.annotations"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.cloud.aws.serviceLoadBalancer.type"),
+					})),
+				))
+			})
+
+			It("should allow existing subnets and an internet gateway for an existing vpc", func() {
+				shoot.Spec.Cloud.AWS.Networks.VPC = garden.AWSVPC{
+					ID:                makeStringPointer("vpc-123456"),
+					InternetGatewayID: makeStringPointer("igw-123456"),
+				}
+				shoot.Spec.Cloud.AWS.Networks.ExistingSubnets = []garden.AWSZoneSubnets{
+					{Zone: "eu-west-1a", Internal: "subnet-1", Public: "subnet-2", Workers: "subnet-3"},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid existing subnets and an internet gateway without an existing vpc", func() {
+				shoot.Spec.Cloud.AWS.Networks.VPC.InternetGatewayID = makeStringPointer("igw-123456")
+				shoot.Spec.Cloud.AWS.Networks.ExistingSubnets = []garden.AWSZoneSubnets{
+					{Zone: "eu-west-1a", Internal: "subnet-1", Public: "subnet-2", Workers: "subnet-3"},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.cloud.aws.networks.vpc.internetGatewayID"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.cloud.aws.networks.existingSubnets"),
+					})),
+				))
+			})
+
+			It("should forbid incomplete existing subnets in unknown or duplicate zones", func() {
+				shoot.Spec.Cloud.AWS.Networks.VPC = garden.AWSVPC{ID: makeStringPointer("vpc-123456")}
+				shoot.Spec.Cloud.AWS.Networks.ExistingSubnets = []garden.AWSZoneSubnets{
+					{Zone: "eu-west-1a", Internal: "subnet-1", Public: "subnet-2"},
+					{Zone: "eu-west-1a", Internal: "subnet-1", Public: "subnet-2", Workers: "subnet-3"},
+					{Zone: "eu-west-1c", Internal: "subnet-1", Public: "subnet-2", Workers: "subnet-3"},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.cloud.aws.networks.existingSubnets[0].workers"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.cloud.aws.networks.existingSubnets[1].zone"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.cloud.aws.networks.existingSubnets[2].zone"),
+					})),
+				))
+			})
+
+			It("should allow existing nat gateways for an existing vpc", func() {
+				shoot.Spec.Cloud.AWS.Networks.VPC = garden.AWSVPC{ID: makeStringPointer("vpc-123456")}
+				shoot.Spec.Cloud.AWS.Networks.ExistingNATGateways = []garden.AWSZoneNATGateway{
+					{Zone: "eu-west-1a", ID: makeStringPointer("nat-123456")},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid existing nat gateways without an existing vpc", func() {
+				shoot.Spec.Cloud.AWS.Networks.ExistingNATGateways = []garden.AWSZoneNATGateway{
+					{Zone: "eu-west-1a"},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.cloud.aws.networks.existingNATGateways"),
+					})),
+				))
+			})
+
+			It("should forbid invalid existing nat gateways", func() {
+				shoot.Spec.Cloud.AWS.Networks.VPC = garden.AWSVPC{ID: makeStringPointer("vpc-123456")}
+				shoot.Spec.Cloud.AWS.Networks.ExistingSubnets = []garden.AWSZoneSubnets{
+					{Zone: "eu-west-1a", Internal: "subnet-1", Public: "subnet-2", Workers: "subnet-3"},
+				}
+				shoot.Spec.Cloud.AWS.Networks.ExistingNATGateways = []garden.AWSZoneNATGateway{
+					{Zone: "eu-west-1a", ID: makeStringPointer("")},
+					{Zone: "eu-west-1a"},
+					{Zone: "eu-west-1c"},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.cloud.aws.networks.existingNATGateways[0].zone"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.aws.networks.existingNATGateways[0].id"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.cloud.aws.networks.existingNATGateways[1].zone"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.cloud.aws.networks.existingNATGateways[1].zone"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.cloud.aws.networks.existingNATGateways[2].zone"),
+					})),
+				))
+			})
+
+			It("should allow volume iops for provisioned iops volume types", func() {
+				shoot.Spec.Cloud.AWS.Workers[0].VolumeType = "io1"
+				shoot.Spec.Cloud.AWS.Workers[0].VolumeIOPS = makeInt64Pointer(1000)
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid volume iops for volume types without provisioned iops", func() {
+				shoot.Spec.Cloud.AWS.Workers[0].VolumeType = "gp2"
+				shoot.Spec.Cloud.AWS.Workers[0].VolumeIOPS = makeInt64Pointer(1000)
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.cloud.aws.workers[0].volumeIOPS"),
+				}))))
+			})
+
+			It("should forbid volume iops out of the allowed range", func() {
+				shoot.Spec.Cloud.AWS.Workers[0].VolumeType = "gp3"
+				shoot.Spec.Cloud.AWS.Workers[0].VolumeIOPS = makeInt64Pointer(20000)
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.cloud.aws.workers[0].volumeIOPS"),
+						"Detail": Equal("volume iops must be between 3000 and 16000 for volume type gp3"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.cloud.aws.workers[0].volumeIOPS"),
+						"Detail": Equal("volume iops must not exceed 500 per Gi of volume size (10000) for volume type gp3"),
+					})),
+				))
+			})
+
+			It("should allow volume throughput for gp3 volumes", func() {
+				shoot.Spec.Cloud.AWS.Workers[0].VolumeType = "gp3"
+				shoot.Spec.Cloud.AWS.Workers[0].VolumeIOPS = makeInt64Pointer(4000)
+				shoot.Spec.Cloud.AWS.Workers[0].VolumeThroughput = makeInt64Pointer(1000)
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid volume throughput for other volume types", func() {
+				shoot.Spec.Cloud.AWS.Workers[0].VolumeType = "io1"
+				shoot.Spec.Cloud.AWS.Workers[0].VolumeThroughput = makeInt64Pointer(125)
 
-		Context("AWS specific validation", func() {
-			var (
-				fldPath  = "aws"
-				awsCloud *garden.AWSCloud
-			)
+				errorList := ValidateShoot(shoot)
 
-			BeforeEach(func() {
-				awsCloud = &garden.AWSCloud{
-					Networks: garden.AWSNetworks{
-						K8SNetworks: k8sNetworks,
-						Internal:    []gardencore.CIDR{"10.250.1.0/24"},
-						Public:      []gardencore.CIDR{"10.250.2.0/24"},
-						Workers:     []gardencore.CIDR{"10.250.3.0/24"},
-						VPC: garden.AWSVPC{
-							CIDR: &vpcCIDR,
-						},
-					},
-					Workers: []garden.AWSWorker{
-						{
-							Worker:     worker,
-							VolumeSize: "20Gi",
-							VolumeType: "default",
-						},
-					},
-					Zones: []string{"eu-west-1a"},
-				}
-				shoot.Spec.Cloud.AWS = awsCloud
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.cloud.aws.workers[0].volumeThroughput"),
+				}))))
 			})
 
-			It("should not return any errors", func() {
+			It("should forbid volume throughput out of the allowed range or exceeding the iops", func() {
+				shoot.Spec.Cloud.AWS.Workers[0].VolumeType = "gp3"
+				shoot.Spec.Cloud.AWS.Workers[0].VolumeThroughput = makeInt64Pointer(1001)
+
 				errorList := ValidateShoot(shoot)
 
-				Expect(errorList).To(HaveLen(0))
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.cloud.aws.workers[0].volumeThroughput"),
+						"Detail": Equal("volume throughput must be between 125 and 1000 MiB/s"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.cloud.aws.workers[0].volumeThroughput"),
+						"Detail": Equal("volume throughput must not exceed 1 MiB/s per 4 iops (750 MiB/s)"),
+					})),
+				))
 			})
 
 			Context("CIDR", func() {
@@ -3234,6 +4735,19 @@ var _ = Describe("validation", func() {
 				}))
 			})
 
+			It("should forbid capacity configuration", func() {
+				shoot.Spec.Cloud.AWS.Workers[0].Capacity = &garden.WorkerCapacity{FallbackMachineTypes: []string{"other"}}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].capacity", fldPath)),
+					})),
+				))
+			})
+
 			It("should forbid worker pools with names that are not DNS-1123 label compliant", func() {
 				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
 					{
@@ -3283,6 +4797,136 @@ var _ = Describe("validation", func() {
 				}))
 			})
 
+			It("should list the changed fields of the networks", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				cidr := gardencore.CIDR("255.255.255.255/32")
+				newShoot.Spec.Cloud.AWS.Networks.Pods = &cidr
+				newShoot.Spec.Cloud.AWS.Networks.VPC.CIDR = &cidr
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal(fmt.Sprintf("spec.cloud.%s.networks", fldPath)),
+					"Detail": Equal("field is immutable, changed fields: pods, vpc.cidr"),
+				}))))
+			})
+
+			It("should forbid changing the cloud provider type", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Cloud.AWS = nil
+				newShoot.Spec.Cloud.GCP = &garden.GCPCloud{}
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal(fmt.Sprintf("spec.cloud.%s", fldPath)),
+					"Detail": ContainSubstring("cloud provider type \"aws\" cannot be changed"),
+				}))))
+			})
+
+			It("should allow changing the settings of worker pools", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Cloud.AWS.Workers[0].MachineType = "xlarge"
+				newShoot.Spec.Cloud.AWS.Workers[0].AutoScalerMax = 3
+				newShoot.Spec.Cloud.AWS.Workers = append(newShoot.Spec.Cloud.AWS.Workers, garden.AWSWorker{
+					Worker:     garden.Worker{Name: "new-pool", MachineType: "large", AutoScalerMin: 1, AutoScalerMax: 1, MaxSurge: intstr.FromInt(1), MaxUnavailable: intstr.FromInt(0)},
+					VolumeSize: "20Gi",
+					VolumeType: "default",
+				})
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+			})
+
+			It("should require a confirmation for deleting worker pools", func() {
+				shoot.Spec.Cloud.AWS.Workers = append(shoot.Spec.Cloud.AWS.Workers, garden.AWSWorker{
+					Worker:     garden.Worker{Name: "other-pool", MachineType: "large", AutoScalerMin: 1, AutoScalerMax: 1, MaxSurge: intstr.FromInt(1), MaxUnavailable: intstr.FromInt(0)},
+					VolumeSize: "20Gi",
+					VolumeType: "default",
+				})
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Cloud.AWS.Workers = newShoot.Spec.Cloud.AWS.Workers[:1]
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal(fmt.Sprintf("spec.cloud.%s.workers", fldPath)),
+					"Detail": ContainSubstring("other-pool"),
+				}))))
+
+				newShoot.Annotations = map[string]string{common.ConfirmationWorkerPoolDeletion: "foo, other-pool"}
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+			})
+
+			It("should allow adding and removing zones at the end of the list", func() {
+				shoot.Spec.Cloud.AWS.Zones = []string{"eu-west-1a", "eu-west-1b"}
+				shoot.Spec.Cloud.AWS.Networks.Internal = []gardencore.CIDR{"10.250.1.0/24", "10.250.4.0/24"}
+				shoot.Spec.Cloud.AWS.Networks.Public = []gardencore.CIDR{"10.250.2.0/24", "10.250.5.0/24"}
+				shoot.Spec.Cloud.AWS.Networks.Workers = []gardencore.CIDR{"10.250.3.0/24", "10.250.6.0/24"}
+
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Cloud.AWS.Zones = append(newShoot.Spec.Cloud.AWS.Zones, "eu-west-1c")
+				newShoot.Spec.Cloud.AWS.Networks.Internal = append(newShoot.Spec.Cloud.AWS.Networks.Internal, "10.250.7.0/24")
+				newShoot.Spec.Cloud.AWS.Networks.Public = append(newShoot.Spec.Cloud.AWS.Networks.Public, "10.250.8.0/24")
+				newShoot.Spec.Cloud.AWS.Networks.Workers = append(newShoot.Spec.Cloud.AWS.Networks.Workers, "10.250.9.0/24")
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+
+				newShoot = prepareShootForUpdate(shoot)
+				newShoot.Spec.Cloud.AWS.Zones = newShoot.Spec.Cloud.AWS.Zones[:1]
+				newShoot.Spec.Cloud.AWS.Networks.Internal = newShoot.Spec.Cloud.AWS.Networks.Internal[:1]
+				newShoot.Spec.Cloud.AWS.Networks.Public = newShoot.Spec.Cloud.AWS.Networks.Public[:1]
+				newShoot.Spec.Cloud.AWS.Networks.Workers = newShoot.Spec.Cloud.AWS.Networks.Workers[:1]
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+			})
+
+			It("should forbid removing zones other than the last ones", func() {
+				shoot.Spec.Cloud.AWS.Zones = []string{"eu-west-1a", "eu-west-1b"}
+				shoot.Spec.Cloud.AWS.Networks.Internal = []gardencore.CIDR{"10.250.1.0/24", "10.250.4.0/24"}
+				shoot.Spec.Cloud.AWS.Networks.Public = []gardencore.CIDR{"10.250.2.0/24", "10.250.5.0/24"}
+				shoot.Spec.Cloud.AWS.Networks.Workers = []gardencore.CIDR{"10.250.3.0/24", "10.250.6.0/24"}
+
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Cloud.AWS.Zones = newShoot.Spec.Cloud.AWS.Zones[1:]
+				newShoot.Spec.Cloud.AWS.Networks.Internal = newShoot.Spec.Cloud.AWS.Networks.Internal[1:]
+				newShoot.Spec.Cloud.AWS.Networks.Public = newShoot.Spec.Cloud.AWS.Networks.Public[1:]
+				newShoot.Spec.Cloud.AWS.Networks.Workers = newShoot.Spec.Cloud.AWS.Networks.Workers[1:]
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal(fmt.Sprintf("spec.cloud.%s.networks", fldPath)),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal(fmt.Sprintf("spec.cloud.%s.zones", fldPath)),
+					})),
+				))
+			})
+
+			It("should forbid worker zones which are not part of the shoot's zones", func() {
+				shoot.Spec.Cloud.AWS.Workers[0].Zones = []string{"eu-west-1a", "eu-west-1b", "eu-west-1a"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].zones[1]", fldPath)),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].zones[2]", fldPath)),
+					})),
+				))
+			})
+
 			It("should forbid removing the AWS section", func() {
 				newShoot := prepareShootForUpdate(shoot)
 				newShoot.Spec.Cloud.AWS = nil
@@ -3334,6 +4978,94 @@ var _ = Describe("validation", func() {
 				Expect(len(errorList)).To(Equal(0))
 			})
 
+			It("should forbid tag keys with invalid characters", func() {
+				shoot.Spec.Cloud.Tags = map[string]string{"cost/center": "1234"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.cloud.tags[cost/center]"),
+				}))))
+			})
+
+			It("should forbid tag keys which are reserved for the tags set by Gardener", func() {
+				shoot.Spec.Cloud.Tags = map[string]string{"name": "foo", "kubernetes.io-role-node": "1"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.cloud.tags[name]"),
+						"Detail": Equal("tag key is reserved"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.tags[kubernetes.io-role-node]"),
+					})),
+				))
+			})
+
+			It("should forbid more tags than left by the tags set by Gardener", func() {
+				shoot.Spec.Cloud.Tags = map[string]string{}
+				for i := 0; i < 48; i++ {
+					shoot.Spec.Cloud.Tags[fmt.Sprintf("tag-%d", i)] = "value"
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.cloud.tags"),
+					"Detail": Equal("must not specify more than 47 tags"),
+				}))))
+			})
+
+			It("should forbid encryption and zones for storage classes", func() {
+				shoot.Spec.Storage = &garden.Storage{
+					Classes: []garden.StorageClass{
+						{Name: "premium", VolumeType: makeStringPointer("Premium_LRS"), Encrypted: makeBoolPointer(true), Zones: []string{"1"}},
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.storage.classes[0].encrypted"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.storage.classes[0].zones"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.storage.classes[0].zones[0]"),
+					})),
+				))
+			})
+
+			It("should forbid custom machine images which are no URNs", func() {
+				shoot.Spec.Cloud.Azure.Workers[0].CustomMachineImage = makeStringPointer("my-image")
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].customMachineImage", fldPath)),
+				}))))
+			})
+
+			It("should allow custom machine images given as URN", func() {
+				shoot.Spec.Cloud.Azure.Workers[0].CustomMachineImage = makeStringPointer("publisher:offer:sku:1.0.0")
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
 			It("should forbid specifying a resource group configuration", func() {
 				shoot.Spec.Cloud.Azure.ResourceGroup = &garden.AzureResourceGroup{}
 
@@ -3360,6 +5092,46 @@ var _ = Describe("validation", func() {
 				}))
 			})
 
+			It("should allow specifying an existing vnet in an existing resource group", func() {
+				shoot.Spec.Cloud.Azure.ResourceGroup = &garden.AzureResourceGroup{Name: "existing-group"}
+				shoot.Spec.Cloud.Azure.Networks.VNet = garden.AzureVNet{
+					Name: makeStringPointer("existing-vnet"),
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should allow a vnet peering with a non-overlapping remote vnet", func() {
+				shoot.Spec.Cloud.Azure.Networks.VNet.Peering = &garden.AzureVNetPeering{
+					RemoteVNetID: "/subscriptions/sub/resourceGroups/hub/providers/Microsoft.Network/virtualNetworks/hub-vnet",
+					RemoteCIDRs:  []gardencore.CIDR{"192.168.0.0/16"},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid a vnet peering with an invalid or overlapping remote vnet", func() {
+				shoot.Spec.Cloud.Azure.Networks.VNet.Peering = &garden.AzureVNetPeering{
+					RemoteVNetID: "hub-vnet",
+					RemoteCIDRs:  []gardencore.CIDR{"10.250.3.0/24"},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.networks.vnet.peering.remoteVNetID", fldPath)),
+				}))))
+				Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.networks.vnet.peering.remoteCIDRs[0]", fldPath)),
+				}))))
+			})
+
 			Context("CIDR", func() {
 
 				It("should forbid invalid VNet CIDRs", func() {
@@ -3645,29 +5417,99 @@ var _ = Describe("validation", func() {
 
 				errorList := ValidateShoot(shoot)
 
-				Expect(len(errorList)).To(Equal(1))
-				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
-					"Type":  Equal(field.ErrorTypeTooLong),
-					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].name", fldPath)),
-				}))
+				Expect(len(errorList)).To(Equal(1))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeTooLong),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].name", fldPath)),
+				}))
+			})
+
+			It("should forbid worker pools with names that are not DNS-1123 label compliant", func() {
+				shoot.Spec.Cloud.Azure.Workers = []garden.AzureWorker{
+					{
+						Worker:     invalidWorkerName,
+						VolumeSize: "35Gi",
+						VolumeType: "ok",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(1))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].name", fldPath)),
+				}))
+			})
+
+			It("should forbid capacity configuration", func() {
+				shoot.Spec.Cloud.Azure.Workers[0].Capacity = &garden.WorkerCapacity{FallbackMachineTypes: []string{"other"}}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].capacity", fldPath)),
+					})),
+				))
+			})
+
+			It("should forbid data volumes", func() {
+				shoot.Spec.Cloud.Azure.Workers[0].DataVolumes = []garden.DataVolume{{Name: "scratch", Size: "50Gi"}}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].dataVolumes", fldPath)),
+					})),
+				))
+			})
+
+			It("should forbid instance tag labels", func() {
+				shoot.Spec.Cloud.Azure.Workers[0].Labels = map[string]string{"team": "a"}
+				shoot.Spec.Cloud.Azure.Workers[0].InstanceTagLabels = []string{"team"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].instanceTagLabels", fldPath)),
+					})),
+				))
+			})
+
+			It("should forbid non-amd64 architectures", func() {
+				shoot.Spec.Cloud.Azure.Workers[0].Architecture = makeStringPointer(garden.ArchitectureARM64)
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].architecture", fldPath)),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers", fldPath)),
+					})),
+				))
 			})
 
-			It("should forbid worker pools with names that are not DNS-1123 label compliant", func() {
-				shoot.Spec.Cloud.Azure.Workers = []garden.AzureWorker{
-					{
-						Worker:     invalidWorkerName,
-						VolumeSize: "35Gi",
-						VolumeType: "ok",
-					},
-				}
+			It("should forbid worker zones", func() {
+				shoot.Spec.Cloud.Azure.Workers[0].Zones = []string{"1"}
 
 				errorList := ValidateShoot(shoot)
 
-				Expect(len(errorList)).To(Equal(1))
-				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
-					"Type":  Equal(field.ErrorTypeInvalid),
-					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].name", fldPath)),
-				}))
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].zones", fldPath)),
+					})),
+				))
 			})
 
 			It("should forbid updating resource group and zones", func() {
@@ -3680,7 +5522,7 @@ var _ = Describe("validation", func() {
 
 				errorList := ValidateShootUpdate(newShoot, shoot)
 
-				Expect(len(errorList)).To(Equal(3))
+				Expect(len(errorList)).To(Equal(2))
 				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
 					"Type":  Equal(field.ErrorTypeInvalid),
 					"Field": Equal(fmt.Sprintf("spec.cloud.%s.resourceGroup", fldPath)),
@@ -3689,10 +5531,6 @@ var _ = Describe("validation", func() {
 					"Type":  Equal(field.ErrorTypeInvalid),
 					"Field": Equal(fmt.Sprintf("spec.cloud.%s.networks", fldPath)),
 				}))
-				Expect(*errorList[2]).To(MatchFields(IgnoreExtras, Fields{
-					"Type":  Equal(field.ErrorTypeInvalid),
-					"Field": Equal(fmt.Sprintf("spec.cloud.%s.resourceGroup.name", fldPath)),
-				}))
 			})
 
 			It("should forbid removing the Azure section", func() {
@@ -3748,6 +5586,121 @@ var _ = Describe("validation", func() {
 				Expect(errorList).To(BeEmpty())
 			})
 
+			It("should allow valid additional labels", func() {
+				shoot.Spec.Cloud.Tags = map[string]string{"cost-center": "1234", "owner": "team_a"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid or reserved additional labels", func() {
+				shoot.Spec.Cloud.Tags = map[string]string{
+					"name":        "foo",
+					"Owner":       "team-a",
+					"cost-center": "Team/A",
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.tags[name]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.tags[Owner]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.tags[cost-center]"),
+					})),
+				))
+			})
+
+			It("should forbid instance tag labels which conflict with the additional labels", func() {
+				shoot.Spec.Cloud.Tags = map[string]string{"team": "a"}
+				shoot.Spec.Cloud.GCP.Workers[0].Labels = map[string]string{"Team": "b"}
+				shoot.Spec.Cloud.GCP.Workers[0].InstanceTagLabels = []string{"Team"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.cloud.gcp.workers[0].instanceTagLabels[0]"),
+				}))))
+			})
+
+			It("should forbid instance tag labels which result in invalid or conflicting GCP label keys", func() {
+				shoot.Spec.Cloud.GCP.Workers[0].Labels = map[string]string{
+					"example.com/team": "a",
+					"example.com_team": "a",
+					"1st":              "a",
+					"Name":             "a",
+					"cost-center":      "1234",
+				}
+				shoot.Spec.Cloud.GCP.Workers[0].InstanceTagLabels = []string{"example.com/team", "example.com_team", "1st", "Name", "cost-center"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.gcp.workers[0].instanceTagLabels[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.gcp.workers[0].instanceTagLabels[2]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.gcp.workers[0].instanceTagLabels[3]"),
+					})),
+				))
+			})
+
+			It("should allow a valid cloud NAT configuration", func() {
+				shoot.Spec.Cloud.GCP.Networks.CloudNAT = &garden.GCPCloudNAT{
+					MinPortsPerVM: makeInt32Pointer(2048),
+					NatIPNames:    []string{"nat-ip-1", "nat-ip-2"},
+					Logging:       &garden.GCPCloudNATLogging{Filter: "ERRORS_ONLY"},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid an invalid cloud NAT configuration", func() {
+				shoot.Spec.Cloud.GCP.Networks.CloudNAT = &garden.GCPCloudNAT{
+					MinPortsPerVM: makeInt32Pointer(1),
+					NatIPNames:    []string{"nat-ip-1", "", "nat-ip-1"},
+					Logging:       &garden.GCPCloudNATLogging{Filter: "EVERYTHING"},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.gcp.networks.cloudNAT.minPortsPerVM"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.gcp.networks.cloudNAT.natIPNames[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.cloud.gcp.networks.cloudNAT.natIPNames[2]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.cloud.gcp.networks.cloudNAT.logging.filter"),
+					})),
+				))
+			})
+
 			Context("CIDR", func() {
 				It("should forbid invalid workers CIDR", func() {
 					shoot.Spec.Cloud.GCP.Networks.Workers = []gardencore.CIDR{invalidCIDR}
@@ -4088,12 +6041,214 @@ var _ = Describe("validation", func() {
 				shoot.Spec.Cloud.Alicloud = alicloud
 			})
 
+			It("should forbid invalid service load balancer defaults", func() {
+				shoot.Spec.Cloud.ServiceLoadBalancer = &garden.ServiceLoadBalancer{Internal: makeBoolPointer(true)}
+				shoot.Spec.Cloud.Alicloud.ServiceLoadBalancer = &garden.AlicloudLoadBalancer{
+					Spec:        makeStringPointer("slb.s9.huge"),
+					AddressType: makeStringPointer("intranet"),
+					Bandwidth:   makeInt32Pointer(0),
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.cloud.serviceLoadBalancer.internal"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.cloud.alicloud.serviceLoadBalancer.spec"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.alicloud.serviceLoadBalancer.bandwidth"),
+					})),
+				))
+			})
+
 			It("should not return any errors", func() {
 				errorList := ValidateShoot(shoot)
 
 				Expect(len(errorList)).To(Equal(0))
 			})
 
+			It("should allow valid additional tags", func() {
+				shoot.Spec.Cloud.Tags = map[string]string{"cost-center": "1234", "owner": "team-a"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid more instance tag labels than left by the additional tags", func() {
+				shoot.Spec.Cloud.Tags = map[string]string{}
+				for i := 0; i < 17; i++ {
+					shoot.Spec.Cloud.Tags[fmt.Sprintf("tag-%d", i)] = "value"
+				}
+				shoot.Spec.Cloud.Alicloud.Workers[0].Labels = map[string]string{"team": "a", "tag-0": "a"}
+				shoot.Spec.Cloud.Alicloud.Workers[0].InstanceTagLabels = []string{"team", "tag-0"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.cloud.alicloud.workers[0].instanceTagLabels"),
+						"Detail": Equal("must not select more than 1 labels (the shoot already specifies 17 additional tags)"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.alicloud.workers[0].instanceTagLabels[1]"),
+					})),
+				))
+			})
+
+			It("should allow a valid kube-apiserver load balancer configuration", func() {
+				shoot.Spec.Cloud.Alicloud.APIServerLoadBalancer = &garden.AlicloudLoadBalancer{
+					Spec:        makeStringPointer("slb.s3.medium"),
+					AddressType: makeStringPointer("internet"),
+					Bandwidth:   makeInt32Pointer(500),
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid an invalid kube-apiserver load balancer configuration", func() {
+				shoot.Spec.Cloud.Alicloud.APIServerLoadBalancer = &garden.AlicloudLoadBalancer{
+					Spec:        makeStringPointer("slb.s9.huge"),
+					AddressType: makeStringPointer("public"),
+					Bandwidth:   makeInt32Pointer(0),
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.cloud.alicloud.apiServerLoadBalancer.spec"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.cloud.alicloud.apiServerLoadBalancer.addressType"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.alicloud.apiServerLoadBalancer.bandwidth"),
+					})),
+				))
+			})
+
+			It("should forbid an intranet kube-apiserver load balancer", func() {
+				shoot.Spec.Cloud.Alicloud.APIServerLoadBalancer = &garden.AlicloudLoadBalancer{
+					AddressType: makeStringPointer("intranet"),
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.cloud.alicloud.apiServerLoadBalancer.addressType"),
+					})),
+				))
+			})
+
+			It("should allow a valid per zone NAT gateway configuration", func() {
+				shoot.Spec.Cloud.Alicloud.Networks.NatGateway = &garden.AlicloudNatGateway{
+					PerZone: true,
+					Zones: []garden.AlicloudNatGatewayZone{
+						{
+							Name:                  "cn-beijing-f",
+							EIPBandwidth:          makeInt32Pointer(200),
+							EIPInternetChargeType: makeStringPointer("PayByBandwidth"),
+						},
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should allow setting the EIP bandwidth and charge type for all zones", func() {
+				shoot.Spec.Cloud.Alicloud.Networks.NatGateway = &garden.AlicloudNatGateway{
+					EIPBandwidth:          makeInt32Pointer(50),
+					EIPInternetChargeType: makeStringPointer("PayByTraffic"),
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid EIP bandwidths exceeding the limit of the charge type", func() {
+				shoot.Spec.Cloud.Alicloud.Networks.NatGateway = &garden.AlicloudNatGateway{
+					EIPBandwidth:          makeInt32Pointer(300),
+					EIPInternetChargeType: makeStringPointer("PayByTraffic"),
+					Zones: []garden.AlicloudNatGatewayZone{
+						{
+							Name:         "cn-beijing-f",
+							EIPBandwidth: makeInt32Pointer(250),
+						},
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.alicloud.networks.natGateway.eipBandwidth"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.alicloud.networks.natGateway.zones[0].eipBandwidth"),
+					})),
+				))
+			})
+
+			It("should forbid an invalid NAT gateway zone configuration", func() {
+				shoot.Spec.Cloud.Alicloud.Networks.NatGateway = &garden.AlicloudNatGateway{
+					Zones: []garden.AlicloudNatGatewayZone{
+						{
+							Name:                  "cn-beijing-f",
+							EIPBandwidth:          makeInt32Pointer(0),
+							EIPInternetChargeType: makeStringPointer("PayByMonth"),
+						},
+						{
+							Name: "cn-beijing-f",
+						},
+						{
+							Name: "cn-beijing-a",
+						},
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.alicloud.networks.natGateway.zones[0].eipBandwidth"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.cloud.alicloud.networks.natGateway.zones[0].eipInternetChargeType"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.cloud.alicloud.networks.natGateway.zones[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.cloud.alicloud.networks.natGateway.zones[2].name"),
+					})),
+				))
+			})
+
 			Context("CIDR", func() {
 
 				It("should forbid invalid VPC CIDRs", func() {
@@ -4235,6 +6390,35 @@ var _ = Describe("validation", func() {
 				})
 			})
 
+			It("should forbid data volumes", func() {
+				shoot.Spec.Cloud.Alicloud.Workers[0].DataVolumes = []garden.DataVolume{{Name: "kubelet", Size: "50Gi"}}
+				shoot.Spec.Cloud.Alicloud.Workers[0].KubeletDataVolumeName = makeStringPointer("kubelet")
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].dataVolumes", fldPath)),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].kubeletDataVolumeName", fldPath)),
+					})),
+				))
+			})
+
+			It("should forbid restricting the access to the kube-apiserver", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AllowedCIDRs = []gardencore.CIDR{"203.0.113.0/24"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.kubernetes.kubeAPIServer.allowedCIDRs"),
+				}))))
+			})
+
 			It("should forbid an empty worker list", func() {
 				shoot.Spec.Cloud.Alicloud.Workers = []garden.AlicloudWorker{}
 
@@ -4477,12 +6661,45 @@ var _ = Describe("validation", func() {
 				shoot.Spec.Cloud.Packet = packet
 			})
 
+			It("should forbid service load balancer defaults", func() {
+				shoot.Spec.Cloud.ServiceLoadBalancer = &garden.ServiceLoadBalancer{Internal: makeBoolPointer(true)}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.cloud.serviceLoadBalancer"),
+				}))))
+			})
+
 			It("should not return any errors", func() {
 				errorList := ValidateShoot(shoot)
 
 				Expect(len(errorList)).To(Equal(0))
 			})
 
+			It("should forbid additional tags", func() {
+				shoot.Spec.Cloud.Tags = map[string]string{"owner": "team-a"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.cloud.tags"),
+				}))))
+			})
+
+			It("should forbid custom machine images", func() {
+				shoot.Spec.Cloud.Packet.Workers[0].CustomMachineImage = makeStringPointer("my-image")
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.cloud.packet.workers[0].customMachineImage"),
+				}))))
+			})
+
 			Context("CIDR", func() {
 
 				It("should forbid non-specified k8s networks", func() {
@@ -4523,6 +6740,37 @@ var _ = Describe("validation", func() {
 				})
 			})
 
+			It("should forbid capacity configuration", func() {
+				shoot.Spec.Cloud.Packet.Workers[0].Capacity = &garden.WorkerCapacity{FallbackMachineTypes: []string{"other"}}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].capacity", fldPath)),
+					})),
+				))
+			})
+
+			It("should forbid data volumes", func() {
+				shoot.Spec.Cloud.Packet.Workers[0].DataVolumes = []garden.DataVolume{{Name: "kubelet", Size: "50Gi"}}
+				shoot.Spec.Cloud.Packet.Workers[0].KubeletDataVolumeName = makeStringPointer("kubelet")
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].dataVolumes", fldPath)),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].kubeletDataVolumeName", fldPath)),
+					})),
+				))
+			})
+
 			It("should forbid an empty worker list", func() {
 				shoot.Spec.Cloud.Packet.Workers = []garden.PacketWorker{}
 
@@ -4710,6 +6958,25 @@ var _ = Describe("validation", func() {
 				Expect(len(errorList)).To(Equal(0))
 			})
 
+			It("should allow valid additional tags", func() {
+				shoot.Spec.Cloud.Tags = map[string]string{"cost-center": "1234", "owner": "team-a"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid additional tags which are reserved for the metadata set by Gardener", func() {
+				shoot.Spec.Cloud.Tags = map[string]string{"kubernetes.io-role-node": "1"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.cloud.tags[kubernetes.io-role-node]"),
+				}))))
+			})
+
 			It("should forbid invalid floating pool name configuration", func() {
 				shoot.Spec.Cloud.OpenStack.FloatingPoolName = ""
 
@@ -4722,6 +6989,25 @@ var _ = Describe("validation", func() {
 				}))
 			})
 
+			It("should allow specifying a floating pool subnet", func() {
+				shoot.Spec.Cloud.OpenStack.FloatingPoolSubnetName = makeStringPointer("my-fip-subnet")
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid an empty floating pool subnet name", func() {
+				shoot.Spec.Cloud.OpenStack.FloatingPoolSubnetName = makeStringPointer("")
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.floatingPoolSubnetName", fldPath)),
+				}))))
+			})
+
 			It("should forbid invalid load balancer provider configuration", func() {
 				shoot.Spec.Cloud.OpenStack.LoadBalancerProvider = ""
 
@@ -4815,6 +7101,37 @@ var _ = Describe("validation", func() {
 				})
 			})
 
+			It("should forbid capacity configuration", func() {
+				shoot.Spec.Cloud.OpenStack.Workers[0].Capacity = &garden.WorkerCapacity{FallbackMachineTypes: []string{"other"}}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].capacity", fldPath)),
+					})),
+				))
+			})
+
+			It("should forbid data volumes", func() {
+				shoot.Spec.Cloud.OpenStack.Workers[0].DataVolumes = []garden.DataVolume{{Name: "kubelet", Size: "50Gi"}}
+				shoot.Spec.Cloud.OpenStack.Workers[0].KubeletDataVolumeName = makeStringPointer("kubelet")
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].dataVolumes", fldPath)),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].kubeletDataVolumeName", fldPath)),
+					})),
+				))
+			})
+
 			It("should forbid an empty worker list", func() {
 				shoot.Spec.Cloud.OpenStack.Workers = []garden.OpenStackWorker{}
 
@@ -5052,6 +7369,30 @@ var _ = Describe("validation", func() {
 				}))))
 			})
 
+			It("should allow specifying a valid ttl", func() {
+				ttl := int64(300)
+				shoot.Spec.DNS.TTL = &ttl
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(HaveLen(0))
+			})
+
+			DescribeTable("should forbid specifying a ttl out of range",
+				func(ttl int64) {
+					shoot.Spec.DNS.TTL = &ttl
+
+					errorList := ValidateShoot(shoot)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.dns.ttl"),
+					}))))
+				},
+				Entry("too low", int64(10)),
+				Entry("too high", int64(100000)),
+			)
+
 			It("should forbid updating the dns domain", func() {
 				newShoot := prepareShootForUpdate(shoot)
 				newShoot.Spec.DNS.Domain = makeStringPointer("another-domain.com")
@@ -5330,6 +7671,27 @@ var _ = Describe("validation", func() {
 			})
 		})
 
+		Context("AllowedCIDRs validation", func() {
+			It("should allow valid CIDRs", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AllowedCIDRs = []gardencore.CIDR{"10.250.0.0/16", "52.1.2.3/32"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid CIDRs", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AllowedCIDRs = []gardencore.CIDR{"10.250.0.0/16", "52.1.2.3"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.kubernetes.kubeAPIServer.allowedCIDRs[1]"),
+				}))))
+			})
+		})
+
 		Context("AuditConfig validation", func() {
 			It("should forbid empty name", func() {
 				shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.AuditPolicy.ConfigMapRef.Name = ""
@@ -5351,6 +7713,56 @@ var _ = Describe("validation", func() {
 
 		})
 
+		Context("AuthenticationWebhook validation", func() {
+			It("should allow a valid webhook", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AuthenticationWebhook = &garden.AuthenticationWebhook{
+					URL:      "https://authn.example.com/tokenreview",
+					CABundle: shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig.CABundle,
+					CacheTTL: &metav1.Duration{Duration: time.Minute},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid webhook settings", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AuthenticationWebhook = &garden.AuthenticationWebhook{
+					URL:      "http://authn.example.com/tokenreview",
+					CABundle: makeStringPointer("invalid"),
+					CacheTTL: &metav1.Duration{Duration: -time.Minute},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.authenticationWebhook.url"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.authenticationWebhook.caBundle"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.authenticationWebhook.cacheTTL"),
+					})),
+				))
+			})
+
+			It("should require the url of the webhook", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AuthenticationWebhook = &garden.AuthenticationWebhook{}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.kubernetes.kubeAPIServer.authenticationWebhook.url"),
+				}))))
+			})
+		})
+
 		It("should require a kubernetes version", func() {
 			shoot.Spec.Kubernetes.Version = ""
 
@@ -5379,32 +7791,6 @@ var _ = Describe("validation", func() {
 			}))
 		})
 
-		It("should forbid kubernetes version downgrades", func() {
-			newShoot := prepareShootForUpdate(shoot)
-			newShoot.Spec.Kubernetes.Version = "1.7.2"
-
-			errorList := ValidateShootUpdate(newShoot, shoot)
-
-			Expect(len(errorList)).To(Equal(1))
-			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
-				"Type":  Equal(field.ErrorTypeForbidden),
-				"Field": Equal("spec.kubernetes.version"),
-			}))
-		})
-
-		It("should forbid kubernetes version upgrades skipping a minor version", func() {
-			newShoot := prepareShootForUpdate(shoot)
-			newShoot.Spec.Kubernetes.Version = "1.10.1"
-
-			errorList := ValidateShootUpdate(newShoot, shoot)
-
-			Expect(len(errorList)).To(Equal(1))
-			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
-				"Type":  Equal(field.ErrorTypeForbidden),
-				"Field": Equal("spec.kubernetes.version"),
-			}))
-		})
-
 		Context("maintenance section", func() {
 			It("should forbid not specifying the maintenance section", func() {
 				shoot.Spec.Maintenance = nil
@@ -5488,6 +7874,156 @@ var _ = Describe("validation", func() {
 			})
 		})
 
+		Context("monitoring section", func() {
+			It("should allow disabling the monitoring", func() {
+				shoot.Spec.Monitoring = &garden.Monitoring{Enabled: makeBoolPointer(false)}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should allow restricting the scrape targets", func() {
+				shoot.Spec.Monitoring = &garden.Monitoring{ScrapeTargets: []string{"kube-apiserver", "kube-etcd3"}}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid unknown and duplicate scrape targets", func() {
+				shoot.Spec.Monitoring = &garden.Monitoring{ScrapeTargets: []string{"kube-apiserver", "foo", "kube-apiserver"}}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.monitoring.scrapeTargets[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.monitoring.scrapeTargets[2]"),
+					})),
+				))
+			})
+
+			It("should forbid scrape targets if the monitoring is disabled", func() {
+				shoot.Spec.Monitoring = &garden.Monitoring{Enabled: makeBoolPointer(false), ScrapeTargets: []string{"kube-apiserver"}}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.monitoring.scrapeTargets"),
+				}))))
+			})
+		})
+
+		Context("sizing profile", func() {
+			It("should allow known sizing profiles", func() {
+				profile := garden.SizingProfileLarge
+				shoot.Spec.SizingProfile = &profile
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid unknown sizing profiles", func() {
+				profile := garden.SizingProfile("huge")
+				shoot.Spec.SizingProfile = &profile
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.sizingProfile"),
+				}))))
+			})
+		})
+
+		Context("storage section", func() {
+			It("should allow valid storage classes", func() {
+				shoot.Spec.Kubernetes.Version = "1.12.1"
+				shoot.Spec.Kubernetes.KubeControllerManager = nil
+				shoot.Spec.Storage = &garden.Storage{
+					Classes: []garden.StorageClass{
+						{Name: "default", Default: makeBoolPointer(true), VolumeType: makeStringPointer("gp2"), Encrypted: makeBoolPointer(true)},
+						{Name: "fast", VolumeType: makeStringPointer("io1"), Zones: []string{"eu-west-1a"}, Parameters: map[string]string{"iopsPerGB": "10"}},
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid storage classes", func() {
+				shoot.Spec.Storage = &garden.Storage{
+					Classes: []garden.StorageClass{
+						{Name: "", Default: makeBoolPointer(true)},
+						{Name: "Fast", VolumeType: makeStringPointer("")},
+						{Name: "Fast", Default: makeBoolPointer(true), Parameters: map[string]string{"type": "io1"}},
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.storage.classes[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.storage.classes[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.storage.classes[1].volumeType"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.storage.classes[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.storage.classes[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.storage.classes[2].default"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.storage.classes[2].parameters[type]"),
+					})),
+				))
+			})
+
+			It("should forbid zones for Kubernetes versions < 1.12 and zones which are not used by the Shoot", func() {
+				shoot.Spec.Storage = &garden.Storage{
+					Classes: []garden.StorageClass{
+						{Name: "zonal", Zones: []string{"eu-west-1a", "eu-west-1b"}},
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.storage.classes[0].zones"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.storage.classes[0].zones[1]"),
+					})),
+				))
+			})
+		})
+
 		It("should forbid updating the spec for shoots with deletion timestamp", func() {
 			newShoot := prepareShootForUpdate(shoot)
 			deletionTimestamp := metav1.NewTime(time.Now())
@@ -5643,10 +8179,11 @@ var _ = Describe("validation", func() {
 			newBackupInfrastructure := prepareBackupInfrastructureForUpdate(backupInfrastructure)
 			newBackupInfrastructure.Spec.Seed = "another-seed"
 			newBackupInfrastructure.Spec.ShootUID = "another-uid"
+			newBackupInfrastructure.Spec.Region = makeStringPointer("eu-central-1")
 
 			errorList := ValidateBackupInfrastructureUpdate(newBackupInfrastructure, backupInfrastructure)
 
-			Expect(len(errorList)).To(Equal(2))
+			Expect(len(errorList)).To(Equal(3))
 			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
 				"Type":  Equal(field.ErrorTypeInvalid),
 				"Field": Equal("spec.seed"),
@@ -5655,6 +8192,21 @@ var _ = Describe("validation", func() {
 				"Type":  Equal(field.ErrorTypeInvalid),
 				"Field": Equal("spec.shootUID"),
 			}))
+			Expect(*errorList[2]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.region"),
+			}))
+		})
+
+		It("should forbid an empty region", func() {
+			backupInfrastructure.Spec.Region = makeStringPointer("")
+
+			errorList := ValidateBackupInfrastructure(backupInfrastructure)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.region"),
+			}))
 		})
 	})
 })
@@ -5681,6 +8233,12 @@ func prepareShootForUpdate(shoot *garden.Shoot) *garden.Shoot {
 	return s
 }
 
+func prepareShootTemplateForUpdate(shootTemplate *garden.ShootTemplate) *garden.ShootTemplate {
+	s := shootTemplate.DeepCopy()
+	s.ResourceVersion = "1"
+	return s
+}
+
 func prepareSeedForUpdate(seed *garden.Seed) *garden.Seed {
 	s := seed.DeepCopy()
 	s.ResourceVersion = "1"
@@ -5704,3 +8262,15 @@ func prepareProjectForUpdate(project *garden.Project) *garden.Project {
 	p.ResourceVersion = "1"
 	return p
 }
+
+func makeInt64Pointer(i int64) *int64 {
+	return &i
+}
+
+func makeInt32Pointer(i int32) *int32 {
+	return &i
+}
+
+func makeBoolPointer(b bool) *bool {
+	return &b
+}
@@ -17,12 +17,15 @@ package apiserver
 import (
 	corerest "github.com/gardener/gardener/pkg/registry/core/rest"
 	gardenrest "github.com/gardener/gardener/pkg/registry/garden/rest"
+	shootstore "github.com/gardener/gardener/pkg/registry/garden/shoot/storage"
 
 	genericapiserver "k8s.io/apiserver/pkg/server"
 )
 
 type ExtraConfig struct {
-	// Place you custom config here.
+	// OperationLogLocation describes how the operation logs of Shoots are retrieved from the Gardener controller
+	// manager. If it is nil then the log subresource of Shoots is not available.
+	OperationLogLocation *shootstore.OperationLogLocation
 }
 
 type Config struct {
@@ -67,7 +70,7 @@ func (c completedConfig) New() (*GardenerServer, error) {
 		coreStorageProvider = corerest.StorageProvider{}
 		coreAPIGroupInfo    = coreStorageProvider.NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
 
-		gardenStorageProvider = gardenrest.StorageProvider{}
+		gardenStorageProvider = gardenrest.StorageProvider{OperationLogLocation: c.ExtraConfig.OperationLogLocation}
 		gardenAPIGroupInfo    = gardenStorageProvider.NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
 	)
 
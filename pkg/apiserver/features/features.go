@@ -15,6 +15,8 @@
 package features
 
 import (
+	"github.com/gardener/gardener/pkg/features"
+
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 )
 
@@ -23,7 +25,9 @@ var (
 	// right now the Generic API server uses this feature gate as default
 	// TODO change it once it moves to ComponentConfig
 	FeatureGate  = utilfeature.DefaultMutableFeatureGate
-	featureGates = map[utilfeature.Feature]utilfeature.FeatureSpec{}
+	featureGates = map[utilfeature.Feature]utilfeature.FeatureSpec{
+		features.CustomMachineImages: {Default: false, PreRelease: utilfeature.Alpha},
+	}
 )
 
 // RegisterFeatureGates registers the feature gates of the Gardener API Server.
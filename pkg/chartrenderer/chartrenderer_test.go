@@ -0,0 +1,156 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chartrenderer_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/gardener/gardener/pkg/chartrenderer"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	testing "k8s.io/client-go/testing"
+	"k8s.io/helm/pkg/chartutil"
+	"k8s.io/helm/pkg/engine"
+)
+
+const versionsTemplate = `networkpolicy: {{ include "networkpolicyversion" . }}
+priorityclass: {{ include "priorityclassversion" . }}
+podsecuritypolicy: {{ include "podsecuritypolicyversion" . }}
+ingress: {{ include "ingressversion" . }}
+priorityclassenabled: {{ include "priorityclassenabled" . | quote }}
+`
+
+var _ = Describe("chartrenderer", func() {
+	Describe("#DiscoverCapabilities", func() {
+		It("should discover the server version and the served api versions and kinds", func() {
+			disc := &fakediscovery.FakeDiscovery{
+				Fake: &testing.Fake{
+					Resources: []*metav1.APIResourceList{
+						{
+							GroupVersion: "policy/v1beta1",
+							APIResources: []metav1.APIResource{
+								{Name: "podsecuritypolicies", Kind: "PodSecurityPolicy"},
+								{Name: "poddisruptionbudgets", Kind: "PodDisruptionBudget"},
+								{Name: "poddisruptionbudgets/status", Kind: "PodDisruptionBudget"},
+							},
+						},
+						{
+							GroupVersion: "scheduling.k8s.io/v1beta1",
+							APIResources: []metav1.APIResource{
+								{Name: "priorityclasses", Kind: "PriorityClass"},
+							},
+						},
+					},
+				},
+				FakedServerVersion: &version.Info{GitVersion: "v1.12.7"},
+			}
+
+			capabilities, err := DiscoverCapabilities(disc)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(capabilities.KubeVersion.GitVersion).To(Equal("v1.12.7"))
+			Expect(capabilities.APIVersions).To(Equal(chartutil.NewVersionSet(
+				"v1",
+				"policy/v1beta1",
+				"policy/v1beta1/PodSecurityPolicy",
+				"policy/v1beta1/PodDisruptionBudget",
+				"scheduling.k8s.io/v1beta1",
+				"scheduling.k8s.io/v1beta1/PriorityClass",
+			)))
+		})
+	})
+
+	Describe("#Render", func() {
+		var chartPath string
+
+		BeforeEach(func() {
+			utilsTemplates, err := filepath.Abs(filepath.Join("..", "..", "charts", "utils-templates"))
+			Expect(err).NotTo(HaveOccurred())
+
+			chartPath, err = ioutil.TempDir("", "chartrenderer")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(os.MkdirAll(filepath.Join(chartPath, "templates"), 0755)).To(Succeed())
+			Expect(os.MkdirAll(filepath.Join(chartPath, "charts"), 0755)).To(Succeed())
+			Expect(os.Symlink(utilsTemplates, filepath.Join(chartPath, "charts", "utils-templates"))).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(chartPath, "Chart.yaml"), []byte("apiVersion: v1\nname: test\nversion: 0.1.0\n"), 0644)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(chartPath, "templates", "versions.yaml"), []byte(versionsTemplate), 0644)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(chartPath)).To(Succeed())
+		})
+
+		render := func(capabilities *chartutil.Capabilities) string {
+			renderedChart, err := New(engine.New(), capabilities).Render(chartPath, "test", "default", nil)
+			Expect(err).NotTo(HaveOccurred())
+			return renderedChart.FileContent("versions.yaml")
+		}
+
+		It("should render the api versions served by the cluster", func() {
+			Expect(render(&chartutil.Capabilities{
+				KubeVersion: &version.Info{GitVersion: "v1.10.13"},
+				APIVersions: chartutil.NewVersionSet(
+					"v1",
+					"extensions/v1beta1/Ingress",
+					"extensions/v1beta1/NetworkPolicy",
+					"extensions/v1beta1/PodSecurityPolicy",
+					"networking.k8s.io/v1/NetworkPolicy",
+					"policy/v1beta1/PodSecurityPolicy",
+				),
+			})).To(Equal(`networkpolicy: networking.k8s.io/v1
+priorityclass: scheduling.k8s.io/v1alpha1
+podsecuritypolicy: policy/v1beta1
+ingress: extensions/v1beta1
+priorityclassenabled: ""
+`))
+		})
+
+		It("should prefer the served api versions over the kubernetes version", func() {
+			Expect(render(&chartutil.Capabilities{
+				KubeVersion: &version.Info{GitVersion: "v1.10.13"},
+				APIVersions: chartutil.NewVersionSet(
+					"v1",
+					"extensions/v1beta1/Ingress",
+					"extensions/v1beta1/NetworkPolicy",
+					"extensions/v1beta1/PodSecurityPolicy",
+					"scheduling.k8s.io/v1alpha1/PriorityClass",
+				),
+			})).To(Equal(`networkpolicy: extensions/v1beta1
+priorityclass: scheduling.k8s.io/v1alpha1
+podsecuritypolicy: extensions/v1beta1
+ingress: extensions/v1beta1
+priorityclassenabled: "true"
+`))
+		})
+
+		It("should fall back to the kubernetes version if the api versions are unknown", func() {
+			Expect(render(&chartutil.Capabilities{
+				KubeVersion: &version.Info{GitVersion: "v1.14.1"},
+			})).To(Equal(`networkpolicy: networking.k8s.io/v1
+priorityclass: scheduling.k8s.io/v1
+podsecuritypolicy: policy/v1beta1
+ingress: networking.k8s.io/v1beta1
+priorityclassenabled: "true"
+`))
+		})
+	})
+})
@@ -69,14 +69,52 @@ func New(engine *engine.Engine, capabilities *chartutil.Capabilities) Interface
 }
 
 // DiscoverCapabilities discovers the capabilities required for chart renderers using the given
-// DiscoveryInterface.
+// DiscoveryInterface. Besides the server version, the capabilities contain all API group versions
+// served by the cluster (e.g. `policy/v1beta1`) as well as all served kinds in the form
+// `<group-version>/<kind>` (e.g. `policy/v1beta1/PodSecurityPolicy`), so that charts can check for
+// `.Capabilities.APIVersions.Has` instead of comparing Kubernetes versions.
 func DiscoverCapabilities(disc discovery.DiscoveryInterface) (*chartutil.Capabilities, error) {
 	sv, err := disc.ServerVersion()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get kubernetes server version %v", err)
 	}
 
-	return &chartutil.Capabilities{KubeVersion: sv}, nil
+	apiVersions, err := discoverAPIVersions(disc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chartutil.Capabilities{APIVersions: apiVersions, KubeVersion: sv}, nil
+}
+
+// discoverAPIVersions computes the set of group versions and kinds served by the cluster. Group versions
+// whose resources cannot be discovered (e.g. because an aggregated API server is unavailable) are still
+// contained, only their kinds are missing.
+func discoverAPIVersions(disc discovery.DiscoveryInterface) (chartutil.VersionSet, error) {
+	groups, resourceLists, err := discovery.ServerGroupsAndResources(disc)
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, fmt.Errorf("failed to discover the api versions of the kubernetes server %v", err)
+	}
+
+	apiVersions := chartutil.NewVersionSet("v1")
+	for _, group := range groups {
+		for _, version := range group.Versions {
+			apiVersions[version.GroupVersion] = struct{}{}
+		}
+	}
+	for _, resourceList := range resourceLists {
+		apiVersions[resourceList.GroupVersion] = struct{}{}
+		for _, resource := range resourceList.APIResources {
+			// Subresources like `deployments/scale` are reported with the kind of their parent or of
+			// their own type, hence they are skipped.
+			if strings.Contains(resource.Name, "/") {
+				continue
+			}
+			apiVersions[fmt.Sprintf("%s/%s", resourceList.GroupVersion, resource.Kind)] = struct{}{}
+		}
+	}
+
+	return apiVersions, nil
 }
 
 // Render loads the chart from the given location <chartPath> and calls the Render() function
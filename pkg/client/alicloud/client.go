@@ -15,23 +15,34 @@
 package alicloud
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 // DefaultInternetChargeType is used for EIP
 const DefaultInternetChargeType = "PayByTraffic"
 
 type client struct {
-	vpcCli *vpc.Client
+	vpcCli  *vpc.Client
+	limiter *rate.Limiter
 }
 
 // NewClient creates a new Client for the given Alicloud credentials <accessKeyID>, <accessKeySecret>, and
 // the region <region>.
 func NewClient(accessKeyID, accessKeySecret, region string) (ClientInterface, error) {
+	return NewClientWithRateLimiter(accessKeyID, accessKeySecret, region, nil)
+}
+
+// NewClientWithRateLimiter creates a new Client like NewClient whose requests wait for a token of the given <limiter>
+// before they are sent. The requests are not limited if <limiter> is nil.
+func NewClientWithRateLimiter(accessKeyID, accessKeySecret, region string, limiter *rate.Limiter) (ClientInterface, error) {
 	var vpcCli *vpc.Client
 	var err error
 	if accessKeyID != "" && accessKeySecret != "" && region != "" {
@@ -40,7 +51,19 @@ func NewClient(accessKeyID, accessKeySecret, region string) (ClientInterface, er
 		err = errors.New("alicloudAccessKeyID or alicloudAccessKeySecret can't be empty")
 	}
 
-	return &client{vpcCli}, err
+	return &client{vpcCli, limiter}, err
+}
+
+// wait blocks until the rate limit of the client allows to send the next request. The SDK does not allow to wrap
+// its HTTP transport, hence it must be called before every request.
+func (c *client) wait() error {
+	if c.limiter == nil {
+		return nil
+	}
+	if err := c.limiter.Wait(context.TODO()); err != nil {
+		return fmt.Errorf("failed waiting for the cloud API rate limit: %v", err)
+	}
+	return nil
 }
 
 //GetCIDR gets CIDR of the VPC specified by vpcID
@@ -48,6 +71,9 @@ func (c *client) GetCIDR(vpcID string) (string, error) {
 	req := vpc.CreateDescribeVpcsRequest()
 	req.VpcId = vpcID
 
+	if err := c.wait(); err != nil {
+		return "", err
+	}
 	resp, err := c.vpcCli.DescribeVpcs(req)
 	if err != nil {
 		return "", err
@@ -66,6 +92,9 @@ func (c *client) GetNatGatewayInfo(vpcID string) (string, string, error) {
 	req := vpc.CreateDescribeNatGatewaysRequest()
 	req.VpcId = vpcID
 
+	if err := c.wait(); err != nil {
+		return "", "", err
+	}
 	resp, err := c.vpcCli.DescribeNatGateways(req)
 	if err != nil {
 		return "", "", err
@@ -85,6 +114,9 @@ func (c *client) GetEIPInternetChargeType(vpcID string) (string, error) {
 	req := vpc.CreateDescribeNatGatewaysRequest()
 	req.VpcId = vpcID
 
+	if err := c.wait(); err != nil {
+		return "", err
+	}
 	resp, err := c.vpcCli.DescribeNatGateways(req)
 	if err != nil {
 		return "", err
@@ -100,6 +132,9 @@ func (c *client) GetEIPInternetChargeType(vpcID string) (string, error) {
 	iplist := natgw.IpLists.IpList[0]
 	eipReq := vpc.CreateDescribeEipAddressesRequest()
 	eipReq.AllocationId = iplist.AllocationId
+	if err := c.wait(); err != nil {
+		return "", err
+	}
 	eipResp, err := c.vpcCli.DescribeEipAddresses(eipReq)
 	if err != nil {
 		return "", err
@@ -110,3 +145,102 @@ func (c *client) GetEIPInternetChargeType(vpcID string) (string, error) {
 
 	return eipResp.EipAddresses.EipAddress[0].InternetChargeType, nil
 }
+
+// listPageSize is the maximum page size accepted by the Alicloud VPC API.
+const listPageSize = 50
+
+// ListNetworkResources lists the VPCs, VSwitches, NAT gateways and EIPs whose name is contained in <names>.
+// It returns a map of the resource IDs to their types.
+func (c *client) ListNetworkResources(names sets.String) (map[string]string, error) {
+	resources := make(map[string]string)
+
+	for page := 1; ; page++ {
+		req := vpc.CreateDescribeVpcsRequest()
+		req.PageNumber = requests.NewInteger(page)
+		req.PageSize = requests.NewInteger(listPageSize)
+
+		if err := c.wait(); err != nil {
+			return nil, err
+		}
+		resp, err := c.vpcCli.DescribeVpcs(req)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range resp.Vpcs.Vpc {
+			if names.Has(v.VpcName) {
+				resources[v.VpcId] = "vpc"
+			}
+		}
+		if page*listPageSize >= resp.TotalCount {
+			break
+		}
+	}
+
+	for page := 1; ; page++ {
+		req := vpc.CreateDescribeVSwitchesRequest()
+		req.PageNumber = requests.NewInteger(page)
+		req.PageSize = requests.NewInteger(listPageSize)
+
+		if err := c.wait(); err != nil {
+			return nil, err
+		}
+		resp, err := c.vpcCli.DescribeVSwitches(req)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range resp.VSwitches.VSwitch {
+			if names.Has(v.VSwitchName) {
+				resources[v.VSwitchId] = "vswitch"
+			}
+		}
+		if page*listPageSize >= resp.TotalCount {
+			break
+		}
+	}
+
+	for page := 1; ; page++ {
+		req := vpc.CreateDescribeNatGatewaysRequest()
+		req.PageNumber = requests.NewInteger(page)
+		req.PageSize = requests.NewInteger(listPageSize)
+
+		if err := c.wait(); err != nil {
+			return nil, err
+		}
+		resp, err := c.vpcCli.DescribeNatGateways(req)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range resp.NatGateways.NatGateway {
+			if names.Has(n.Name) {
+				resources[n.NatGatewayId] = "nat_gateway"
+			}
+		}
+		if page*listPageSize >= resp.TotalCount {
+			break
+		}
+	}
+
+	for page := 1; ; page++ {
+		req := vpc.CreateDescribeEipAddressesRequest()
+		req.PageNumber = requests.NewInteger(page)
+		req.PageSize = requests.NewInteger(listPageSize)
+
+		if err := c.wait(); err != nil {
+			return nil, err
+		}
+		resp, err := c.vpcCli.DescribeEipAddresses(req)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range resp.EipAddresses.EipAddress {
+			if names.Has(e.Name) {
+				resources[e.AllocationId] = "eip"
+			}
+		}
+		if page*listPageSize >= resp.TotalCount {
+			break
+		}
+	}
+
+	return resources, nil
+}
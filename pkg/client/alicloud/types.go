@@ -14,10 +14,13 @@
 
 package alicloud
 
+import "k8s.io/apimachinery/pkg/util/sets"
+
 // ClientInterface is an interface which must be implemented by Alicloud clients.
 type ClientInterface interface {
 	GetCIDR(vpcID string) (string, error)
 	//Return NatGatewayID, SnatTableID
 	GetNatGatewayInfo(vpcID string) (string, string, error)
 	GetEIPInternetChargeType(vpcID string) (string, error)
+	ListNetworkResources(names sets.String) (map[string]string, error)
 }
@@ -15,21 +15,63 @@
 package aws
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/sts"
+	"golang.org/x/time/rate"
 )
 
+// s3RequestTimeout is the timeout of a single request to the S3 API.
+const s3RequestTimeout = 30 * time.Second
+
 // NewClient creates a new Client for the given AWS credentials <accessKeyID>, <secretAccessKey>, and
 // the AWS region <region>.
 // It initializes the clients for the various services like EC2, ELB, etc.
 func NewClient(accessKeyID, secretAccessKey, region string) ClientInterface {
+	return newClient(accessKeyID, secretAccessKey, region)
+}
+
+// NewClientWithRateLimiter creates a new Client like NewClient whose requests (including retries) wait for a token
+// of the given <limiter> before they are sent. The requests are not limited if <limiter> is nil.
+func NewClientWithRateLimiter(accessKeyID, secretAccessKey, region string, limiter *rate.Limiter) ClientInterface {
+	c := newClient(accessKeyID, secretAccessKey, region)
+	if limiter == nil {
+		return c
+	}
+
+	rateLimitHandler := request.NamedHandler{
+		Name: "gardener.RateLimitHandler",
+		Fn: func(r *request.Request) {
+			if err := limiter.Wait(r.Context()); err != nil {
+				r.Error = awserr.New(request.CanceledErrorCode, "failed waiting for the cloud API rate limit", err)
+			}
+		},
+	}
+	for _, handlers := range []*request.Handlers{&c.EC2.Handlers, &c.ELB.Handlers, &c.STS.Handlers} {
+		handlers.Sign.PushFrontNamed(rateLimitHandler)
+	}
+	return c
+}
+
+func newClient(accessKeyID, secretAccessKey, region string) *Client {
 	var (
 		awsConfig = &aws.Config{
 			Credentials: credentials.NewStaticCredentials(accessKeyID, secretAccessKey, ""),
@@ -39,9 +81,12 @@ func NewClient(accessKeyID, secretAccessKey, region string) ClientInterface {
 	)
 
 	return &Client{
-		EC2: ec2.New(sess, config),
-		ELB: elb.New(sess, config),
-		STS: sts.New(sess, config),
+		EC2:        ec2.New(sess, config),
+		ELB:        elb.New(sess, config),
+		STS:        sts.New(sess, config),
+		signer:     v4.NewSigner(awsConfig.Credentials, disableURIPathEscaping),
+		region:     region,
+		httpClient: &http.Client{Timeout: s3RequestTimeout},
 	}
 }
 
@@ -81,11 +126,119 @@ func (c *Client) GetInternetGateway(vpcID string) (string, error) {
 	return "", fmt.Errorf("no attached internet gateway found for vpc %s", vpcID)
 }
 
+// GetSubnetInfo returns the ID of the VPC, the name of the availability zone and the CIDR of the subnet with the given
+// <subnetID>.
+func (c *Client) GetSubnetInfo(subnetID string) (*SubnetInfo, error) {
+	describeSubnetsOutput, err := c.EC2.DescribeSubnets(&ec2.DescribeSubnetsInput{
+		SubnetIds: []*string{aws.String(subnetID)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(describeSubnetsOutput.Subnets) != 1 {
+		return nil, fmt.Errorf("no subnet found with id %s", subnetID)
+	}
+	subnet := describeSubnetsOutput.Subnets[0]
+
+	return &SubnetInfo{
+		VPCID:            aws.StringValue(subnet.VpcId),
+		AvailabilityZone: aws.StringValue(subnet.AvailabilityZone),
+		CIDR:             aws.StringValue(subnet.CidrBlock),
+	}, nil
+}
+
+// GetNATGateway returns the ID of an available NAT gateway of the given VPC <vpcID> which is located in the
+// availability zone <zone>.
+func (c *Client) GetNATGateway(vpcID, zone string) (string, error) {
+	describeNatGatewaysOutput, err := c.EC2.DescribeNatGateways(&ec2.DescribeNatGatewaysInput{
+		Filter: []*ec2.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []*string{aws.String(vpcID)},
+			},
+			{
+				Name:   aws.String("state"),
+				Values: []*string{aws.String(ec2.NatGatewayStateAvailable)},
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for _, natGateway := range describeNatGatewaysOutput.NatGateways {
+		subnet, err := c.GetSubnetInfo(aws.StringValue(natGateway.SubnetId))
+		if err != nil {
+			return "", err
+		}
+		if subnet.AvailabilityZone == zone {
+			return aws.StringValue(natGateway.NatGatewayId), nil
+		}
+	}
+	return "", fmt.Errorf("no available nat gateway found in zone %s of vpc %s", zone, vpcID)
+}
+
+// TagResources adds the given <tags> to the EC2 resources with the given <ids>. Existing tags with the same keys
+// are overwritten.
+func (c *Client) TagResources(ids []string, tags map[string]string) error {
+	input := &ec2.CreateTagsInput{Resources: aws.StringSlice(ids)}
+	for key, value := range tags {
+		input.Tags = append(input.Tags, &ec2.Tag{Key: aws.String(key), Value: aws.String(value)})
+	}
+	_, err := c.EC2.CreateTags(input)
+	return err
+}
+
+// UntagResources removes the tags with the given <keys> from the EC2 resources with the given <ids>.
+func (c *Client) UntagResources(ids []string, keys ...string) error {
+	input := &ec2.DeleteTagsInput{Resources: aws.StringSlice(ids)}
+	for _, key := range keys {
+		input.Tags = append(input.Tags, &ec2.Tag{Key: aws.String(key)})
+	}
+	_, err := c.EC2.DeleteTags(input)
+	return err
+}
+
+// ListTerraformManagedResources returns all EC2 resources which are tagged with <clusterName> by the Terraform
+// infrastructure configuration (tag value "1", in contrast to the tag value "owned" used by Kubernetes). The
+// result maps the resource ids to their resource types.
+func (c *Client) ListTerraformManagedResources(ctx context.Context, clusterName string) (map[string]string, error) {
+	var (
+		results = map[string]string{}
+		input   = &ec2.DescribeTagsInput{
+			Filters: []*ec2.Filter{
+				{
+					Name:   aws.String("key"),
+					Values: []*string{aws.String(fmt.Sprintf("kubernetes.io/cluster/%s", clusterName))},
+				},
+				{
+					Name:   aws.String("value"),
+					Values: []*string{aws.String("1")},
+				},
+			},
+		}
+	)
+
+	if err := c.EC2.DescribeTagsPagesWithContext(ctx, input, func(page *ec2.DescribeTagsOutput, lastPage bool) bool {
+		for _, tag := range page.Tags {
+			if tag.ResourceId != nil && tag.ResourceType != nil {
+				results[*tag.ResourceId] = *tag.ResourceType
+			}
+		}
+		return true
+	}); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
 // The following functions are only temporary needed due to https://github.com/gardener/gardener/issues/129.
 
 // ListKubernetesELBs returns the list of load balancers in the given <vpcID> tagged with <clusterName>.
-func (c *Client) ListKubernetesELBs(vpcID, clusterName string) ([]string, error) {
-	output, err := c.ELB.DescribeLoadBalancers(&elb.DescribeLoadBalancersInput{})
+func (c *Client) ListKubernetesELBs(ctx context.Context, vpcID, clusterName string) ([]string, error) {
+	output, err := c.ELB.DescribeLoadBalancersWithContext(ctx, &elb.DescribeLoadBalancersInput{})
 	if err != nil {
 		return nil, err
 	}
@@ -93,7 +246,7 @@ func (c *Client) ListKubernetesELBs(vpcID, clusterName string) ([]string, error)
 	results := []string{}
 	for _, lb := range output.LoadBalancerDescriptions {
 		if lb.VPCId != nil && *lb.VPCId == vpcID {
-			tags, err := c.ELB.DescribeTags(&elb.DescribeTagsInput{
+			tags, err := c.ELB.DescribeTagsWithContext(ctx, &elb.DescribeTagsInput{
 				LoadBalancerNames: []*string{lb.LoadBalancerName},
 			})
 			if err != nil {
@@ -115,8 +268,8 @@ func (c *Client) ListKubernetesELBs(vpcID, clusterName string) ([]string, error)
 
 // DeleteELB deletes the load balancer with the specific <name>. If it does not exist,
 // no error is returned.
-func (c *Client) DeleteELB(name string) error {
-	if _, err := c.ELB.DeleteLoadBalancer(&elb.DeleteLoadBalancerInput{LoadBalancerName: aws.String(name)}); err != nil {
+func (c *Client) DeleteELB(ctx context.Context, name string) error {
+	if _, err := c.ELB.DeleteLoadBalancerWithContext(ctx, &elb.DeleteLoadBalancerInput{LoadBalancerName: aws.String(name)}); err != nil {
 		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == elb.ErrCodeAccessPointNotFoundException {
 			return nil
 		}
@@ -126,8 +279,8 @@ func (c *Client) DeleteELB(name string) error {
 }
 
 // ListKubernetesSecurityGroups returns the list of security groups in the given <vpcID> tagged with <clusterName>.
-func (c *Client) ListKubernetesSecurityGroups(vpcID, clusterName string) ([]string, error) {
-	groups, err := c.EC2.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+func (c *Client) ListKubernetesSecurityGroups(ctx context.Context, vpcID, clusterName string) ([]string, error) {
+	groups, err := c.EC2.DescribeSecurityGroupsWithContext(ctx, &ec2.DescribeSecurityGroupsInput{
 		Filters: []*ec2.Filter{
 			{
 				Name:   aws.String("vpc-id"),
@@ -157,8 +310,8 @@ func (c *Client) ListKubernetesSecurityGroups(vpcID, clusterName string) ([]stri
 
 // DeleteSecurityGroup deletes the security group with the specific <id>. If it does not exist,
 // no error is returned.
-func (c *Client) DeleteSecurityGroup(id string) error {
-	if _, err := c.EC2.DeleteSecurityGroup(&ec2.DeleteSecurityGroupInput{GroupId: aws.String(id)}); err != nil {
+func (c *Client) DeleteSecurityGroup(ctx context.Context, id string) error {
+	if _, err := c.EC2.DeleteSecurityGroupWithContext(ctx, &ec2.DeleteSecurityGroupInput{GroupId: aws.String(id)}); err != nil {
 		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "InvalidGroup.NotFound" {
 			return nil
 		}
@@ -166,3 +319,331 @@ func (c *Client) DeleteSecurityGroup(id string) error {
 	}
 	return nil
 }
+
+// ProbeBucket verifies that the S3 bucket <bucketName> exists, that it is writable, and that the credentials of the
+// Client are valid by writing and deleting the object <objectName>.
+func (c *Client) ProbeBucket(ctx context.Context, bucketName, objectName string) error {
+	objectURL := fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", bucketName, c.region, objectName)
+
+	if err := c.doS3Request(ctx, http.MethodPut, objectURL, []byte(time.Now().UTC().Format(time.RFC3339))); err != nil {
+		return fmt.Errorf("could not write object %q to bucket %q: %v", objectName, bucketName, err)
+	}
+	if err := c.doS3Request(ctx, http.MethodDelete, objectURL, nil); err != nil {
+		return fmt.Errorf("could not delete object %q from bucket %q: %v", objectName, bucketName, err)
+	}
+	return nil
+}
+
+// disableURIPathEscaping configures the signer to not escape the request paths again, as the S3 object keys in the
+// request URLs are already escaped.
+func disableURIPathEscaping(signer *v4.Signer) {
+	signer.DisableURIPathEscaping = true
+}
+
+// listBucketResult is the response of the S3 ListObjectsV2 API.
+type listBucketResult struct {
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// PurgeBucket deletes all objects of the S3 bucket <bucketName> and the bucket itself. It does not return an error
+// if the bucket does not exist.
+func (c *Client) PurgeBucket(ctx context.Context, bucketName string) error {
+	bucketURL := fmt.Sprintf("https://%s.s3.%s.amazonaws.com", bucketName, c.region)
+
+	for {
+		query := url.Values{"list-type": []string{"2"}}
+		var result listBucketResult
+
+		for {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			status, body, err := c.s3Request(ctx, http.MethodGet, fmt.Sprintf("%s/?%s", bucketURL, query.Encode()), nil)
+			if err != nil {
+				return err
+			}
+			if status == http.StatusNotFound {
+				return nil
+			}
+			if status >= http.StatusMultipleChoices {
+				return fmt.Errorf("could not list objects of bucket %q: request failed with status %d: %s", bucketName, status, string(body))
+			}
+
+			result = listBucketResult{}
+			if err := xml.Unmarshal(body, &result); err != nil {
+				return fmt.Errorf("could not decode objects of bucket %q: %v", bucketName, err)
+			}
+
+			for _, object := range result.Contents {
+				objectURL := fmt.Sprintf("%s/%s", bucketURL, (&url.URL{Path: object.Key}).EscapedPath())
+				if err := c.doS3Request(ctx, http.MethodDelete, objectURL, nil); err != nil {
+					return fmt.Errorf("could not delete object %q from bucket %q: %v", object.Key, bucketName, err)
+				}
+			}
+
+			if !result.IsTruncated {
+				break
+			}
+			query.Set("continuation-token", result.NextContinuationToken)
+		}
+
+		status, body, err := c.s3Request(ctx, http.MethodDelete, bucketURL+"/", nil)
+		if err != nil {
+			return err
+		}
+		switch {
+		case status == http.StatusNotFound, status < http.StatusMultipleChoices:
+			return nil
+		case status == http.StatusConflict:
+			// Objects which have been written while the bucket was purged prevent its deletion, hence it is emptied again.
+			continue
+		}
+		return fmt.Errorf("could not delete bucket %q: request failed with status %d: %s", bucketName, status, string(body))
+	}
+}
+
+// ListRegions returns the names of all regions which are enabled for the account of the Client.
+func (c *Client) ListRegions() ([]string, error) {
+	describeRegionsOutput, err := c.EC2.DescribeRegions(&ec2.DescribeRegionsInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	var regions []string
+	for _, region := range describeRegionsOutput.Regions {
+		regions = append(regions, *region.RegionName)
+	}
+	return regions, nil
+}
+
+// ListAvailabilityZones returns the names of all available zones in the region of the Client.
+func (c *Client) ListAvailabilityZones() ([]string, error) {
+	describeAvailabilityZonesInput := &ec2.DescribeAvailabilityZonesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("state"),
+				Values: []*string{aws.String(ec2.AvailabilityZoneStateAvailable)},
+			},
+		},
+	}
+	describeAvailabilityZonesOutput, err := c.EC2.DescribeAvailabilityZones(describeAvailabilityZonesInput)
+	if err != nil {
+		return nil, err
+	}
+
+	var zones []string
+	for _, zone := range describeAvailabilityZonesOutput.AvailabilityZones {
+		zones = append(zones, *zone.ZoneName)
+	}
+	return zones, nil
+}
+
+// GetImageName returns the name and the owner of the image <imageID> in the region of the Client.
+func (c *Client) GetImageName(imageID string) (string, string, error) {
+	describeImagesOutput, err := c.EC2.DescribeImages(&ec2.DescribeImagesInput{ImageIds: []*string{aws.String(imageID)}})
+	if err != nil {
+		return "", "", err
+	}
+	if len(describeImagesOutput.Images) == 0 {
+		return "", "", fmt.Errorf("image %q not found in region %s", imageID, c.region)
+	}
+
+	image := describeImagesOutput.Images[0]
+	return *image.Name, *image.OwnerId, nil
+}
+
+// FindImage returns the ID of the image with the given <name> which is owned by <ownerID> in the region of the
+// Client. If there is no such image then the returned string will be empty.
+func (c *Client) FindImage(name, ownerID string) (string, error) {
+	describeImagesInput := &ec2.DescribeImagesInput{
+		Owners: []*string{aws.String(ownerID)},
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("name"),
+				Values: []*string{aws.String(name)},
+			},
+		},
+	}
+	describeImagesOutput, err := c.EC2.DescribeImages(describeImagesInput)
+	if err != nil {
+		return "", err
+	}
+	if len(describeImagesOutput.Images) == 0 {
+		return "", nil
+	}
+	return *describeImagesOutput.Images[0].ImageId, nil
+}
+
+// GetAccountAttributes returns the values of the account attributes with the given <names> (e.g., max-instances or
+// vpc-max-elastic-ips) in the region of the Client.
+func (c *Client) GetAccountAttributes(names ...string) (map[string]string, error) {
+	describeAccountAttributesOutput, err := c.EC2.DescribeAccountAttributes(&ec2.DescribeAccountAttributesInput{AttributeNames: aws.StringSlice(names)})
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := map[string]string{}
+	for _, attribute := range describeAccountAttributesOutput.AccountAttributes {
+		if len(attribute.AttributeValues) > 0 && attribute.AttributeValues[0].AttributeValue != nil {
+			attributes[*attribute.AttributeName] = *attribute.AttributeValues[0].AttributeValue
+		}
+	}
+	return attributes, nil
+}
+
+// CountInstances returns the number of pending or running instances in the region of the Client.
+func (c *Client) CountInstances() (int64, error) {
+	describeInstancesInput := &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("instance-state-name"),
+				Values: aws.StringSlice([]string{ec2.InstanceStateNamePending, ec2.InstanceStateNameRunning}),
+			},
+		},
+	}
+
+	var count int64
+	if err := c.EC2.DescribeInstancesPages(describeInstancesInput, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+		for _, reservation := range page.Reservations {
+			count += int64(len(reservation.Instances))
+		}
+		return !lastPage
+	}); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// CountAddresses returns the number of elastic IP addresses allocated for use with VPCs in the region of the Client.
+func (c *Client) CountAddresses() (int64, error) {
+	describeAddressesInput := &ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("domain"),
+				Values: []*string{aws.String(ec2.DomainTypeVpc)},
+			},
+		},
+	}
+	describeAddressesOutput, err := c.EC2.DescribeAddresses(describeAddressesInput)
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(describeAddressesOutput.Addresses)), nil
+}
+
+// CountVPCs returns the number of VPCs in the region of the Client.
+func (c *Client) CountVPCs() (int64, error) {
+	describeVpcsOutput, err := c.EC2.DescribeVpcs(&ec2.DescribeVpcsInput{})
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(describeVpcsOutput.Vpcs)), nil
+}
+
+// GetServiceQuota returns the value of the quota <quotaCode> of the service <serviceCode> (e.g., L-F678F1CE of vpc for
+// the VPCs per region) in the region of the Client. It falls back to the default value of AWS if no value has been
+// applied to the account.
+func (c *Client) GetServiceQuota(ctx context.Context, serviceCode, quotaCode string) (float64, error) {
+	value, err := c.getServiceQuota(ctx, "GetServiceQuota", serviceCode, quotaCode)
+	if err == errNoSuchServiceQuota {
+		value, err = c.getServiceQuota(ctx, "GetAWSDefaultServiceQuota", serviceCode, quotaCode)
+	}
+	return value, err
+}
+
+// errNoSuchServiceQuota is returned by getServiceQuota if the requested quota does not exist.
+var errNoSuchServiceQuota = errors.New("service quota does not exist")
+
+// getServiceQuota calls the operation <target> of the Service Quotas API for the quota <quotaCode> of the service
+// <serviceCode> and returns the value of the quota.
+func (c *Client) getServiceQuota(ctx context.Context, target, serviceCode, quotaCode string) (float64, error) {
+	body, err := json.Marshal(map[string]string{"ServiceCode": serviceCode, "QuotaCode": quotaCode})
+	if err != nil {
+		return 0, err
+	}
+	reader := bytes.NewReader(body)
+
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("https://servicequotas.%s.amazonaws.com/", c.region), reader)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "ServiceQuotasV20190624."+target)
+	if _, err := c.signer.Sign(req, reader, "servicequotas", c.region, time.Now()); err != nil {
+		return 0, err
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err
+	}
+
+	var result struct {
+		Type  string `json:"__type"`
+		Quota struct {
+			Value *float64 `json:"Value"`
+		} `json:"Quota"`
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return 0, fmt.Errorf("could not decode service quota %s of service %s: %v", quotaCode, serviceCode, err)
+	}
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		if strings.HasSuffix(result.Type, "NoSuchResourceException") {
+			return 0, errNoSuchServiceQuota
+		}
+		return 0, fmt.Errorf("could not get service quota %s of service %s: request failed with status %d: %s", quotaCode, serviceCode, resp.StatusCode, string(respBody))
+	}
+	if result.Quota.Value == nil {
+		return 0, fmt.Errorf("service quota %s of service %s has no value", quotaCode, serviceCode)
+	}
+	return *result.Quota.Value, nil
+}
+
+// doS3Request sends a signed request with the given <method> and <body> to the S3 <url>.
+func (c *Client) doS3Request(ctx context.Context, method, url string, body []byte) error {
+	status, message, err := c.s3Request(ctx, method, url, body)
+	if err != nil {
+		return err
+	}
+	if status >= http.StatusMultipleChoices {
+		return fmt.Errorf("request failed with status %q: %s", fmt.Sprintf("%d %s", status, http.StatusText(status)), string(message))
+	}
+	return nil
+}
+
+// s3Request sends a signed request with the given <method> and <body> to the S3 <url> and returns the status code
+// and the body of the response.
+func (c *Client) s3Request(ctx context.Context, method, url string, body []byte) (int, []byte, error) {
+	reader := bytes.NewReader(body)
+
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		return 0, nil, err
+	}
+	if _, err := c.signer.Sign(req, reader, "s3", c.region, time.Now()); err != nil {
+		return 0, nil, err
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, err
+	}
+	return resp.StatusCode, respBody, nil
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	. "github.com/gardener/gardener/pkg/client/aws"
+	"github.com/gardener/gardener/pkg/utils/test"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("client", func() {
+	Describe("#PurgeBucket", func() {
+		const (
+			bucketName = "backup"
+			bucketHost = "backup.s3.eu-west-1.amazonaws.com"
+		)
+
+		var (
+			ctx    = context.TODO()
+			server *httptest.Server
+			client ClientInterface
+
+			lock     sync.Mutex
+			objects  map[string]bool
+			requests []string
+			handler  http.HandlerFunc
+		)
+
+		listObjects := func(w http.ResponseWriter) {
+			// Each page of the listing contains a single object to test the pagination.
+			fmt.Fprint(w, `<ListBucketResult>`)
+			for key := range objects {
+				fmt.Fprintf(w, `<Contents><Key>%s</Key></Contents>`, key)
+				if len(objects) > 1 {
+					fmt.Fprint(w, `<IsTruncated>true</IsTruncated><NextContinuationToken>next</NextContinuationToken>`)
+				}
+				break
+			}
+			fmt.Fprint(w, `</ListBucketResult>`)
+		}
+
+		BeforeEach(func() {
+			objects = map[string]bool{"etcd/full": true, "etcd/incr 1": true}
+			requests = nil
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				lock.Lock()
+				defer lock.Unlock()
+
+				Expect(r.Host).To(Equal(bucketHost))
+				Expect(r.Header.Get("Authorization")).To(HavePrefix("AWS4-HMAC-SHA256"))
+				requests = append(requests, r.Method+" "+r.URL.EscapedPath())
+
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/":
+					listObjects(w)
+				case r.Method == http.MethodDelete && r.URL.Path == "/":
+					if len(objects) > 0 {
+						w.WriteHeader(http.StatusConflict)
+						return
+					}
+					w.WriteHeader(http.StatusNoContent)
+				case r.Method == http.MethodDelete:
+					delete(objects, r.URL.Path[1:])
+					w.WriteHeader(http.StatusNoContent)
+				default:
+					w.WriteHeader(http.StatusMethodNotAllowed)
+				}
+			}
+
+			server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { handler(w, r) }))
+			client = ExportWithHTTPClient(NewClient("access-key-id", "secret-access-key", "eu-west-1"), test.NewRedirectingHTTPClient(server))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should delete all objects and the bucket", func() {
+			Expect(client.PurgeBucket(ctx, bucketName)).To(Succeed())
+
+			Expect(objects).To(BeEmpty())
+			Expect(requests).To(ContainElement("DELETE /etcd/incr%201"))
+			Expect(requests).To(ContainElement("DELETE /etcd/full"))
+			Expect(requests[len(requests)-1]).To(Equal("DELETE /"))
+		})
+
+		It("should empty the bucket again if objects have been written meanwhile", func() {
+			deleteBucketCalls := 0
+			defaultHandler := handler
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodDelete && r.URL.Path == "/" {
+					deleteBucketCalls++
+					if deleteBucketCalls == 1 {
+						lock.Lock()
+						objects["etcd/late"] = true
+						lock.Unlock()
+					}
+				}
+				defaultHandler(w, r)
+			}
+
+			Expect(client.PurgeBucket(ctx, bucketName)).To(Succeed())
+
+			Expect(objects).To(BeEmpty())
+			Expect(deleteBucketCalls).To(Equal(2))
+		})
+
+		It("should not fail if the bucket does not exist", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			}
+
+			Expect(client.PurgeBucket(ctx, bucketName)).To(Succeed())
+		})
+
+		It("should fail if the objects cannot be listed", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+			}
+
+			Expect(client.PurgeBucket(ctx, bucketName)).To(MatchError(ContainSubstring("could not list objects")))
+		})
+
+		It("should stop if the context is cancelled", func() {
+			cancelledCtx, cancel := context.WithCancel(ctx)
+			cancel()
+
+			Expect(client.PurgeBucket(cancelledCtx, bucketName)).To(Equal(context.Canceled))
+		})
+	})
+
+	Describe("#GetServiceQuota", func() {
+		var (
+			ctx     = context.TODO()
+			server  *httptest.Server
+			client  ClientInterface
+			targets []string
+			handler http.HandlerFunc
+		)
+
+		BeforeEach(func() {
+			targets = nil
+			server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Host).To(Equal("servicequotas.eu-west-1.amazonaws.com"))
+				Expect(r.Header.Get("Authorization")).To(HavePrefix("AWS4-HMAC-SHA256"))
+				targets = append(targets, r.Header.Get("X-Amz-Target"))
+				handler(w, r)
+			}))
+			client = ExportWithHTTPClient(NewClient("access-key-id", "secret-access-key", "eu-west-1"), test.NewRedirectingHTTPClient(server))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should return the applied value of the quota", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{"Quota":{"ServiceCode":"vpc","QuotaCode":"L-F678F1CE","Value":20.0}}`)
+			}
+
+			Expect(client.GetServiceQuota(ctx, "vpc", "L-F678F1CE")).To(Equal(float64(20)))
+			Expect(targets).To(Equal([]string{"ServiceQuotasV20190624.GetServiceQuota"}))
+		})
+
+		It("should fall back to the default value if no value has been applied", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("X-Amz-Target") == "ServiceQuotasV20190624.GetServiceQuota" {
+					w.WriteHeader(http.StatusBadRequest)
+					fmt.Fprint(w, `{"__type":"NoSuchResourceException","Message":"The request failed because the specified resource does not exist."}`)
+					return
+				}
+				fmt.Fprint(w, `{"Quota":{"ServiceCode":"vpc","QuotaCode":"L-F678F1CE","Value":5.0}}`)
+			}
+
+			Expect(client.GetServiceQuota(ctx, "vpc", "L-F678F1CE")).To(Equal(float64(5)))
+			Expect(targets).To(Equal([]string{"ServiceQuotasV20190624.GetServiceQuota", "ServiceQuotasV20190624.GetAWSDefaultServiceQuota"}))
+		})
+
+		It("should fail if the quota cannot be read", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"__type":"AccessDeniedException","Message":"not authorized"}`)
+			}
+
+			_, err := client.GetServiceQuota(ctx, "vpc", "L-F678F1CE")
+			Expect(err).To(MatchError(ContainSubstring("not authorized")))
+		})
+	})
+})
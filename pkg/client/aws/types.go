@@ -15,6 +15,10 @@
 package aws
 
 import (
+	"context"
+	"net/http"
+
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/sts"
@@ -24,12 +28,28 @@ import (
 type ClientInterface interface {
 	GetAccountID() (string, error)
 	GetInternetGateway(string) (string, error)
+	GetSubnetInfo(subnetID string) (*SubnetInfo, error)
+	GetNATGateway(vpcID, zone string) (string, error)
+	TagResources(ids []string, tags map[string]string) error
+	UntagResources(ids []string, keys ...string) error
+	ListTerraformManagedResources(ctx context.Context, clusterName string) (map[string]string, error)
+	ProbeBucket(ctx context.Context, bucketName, objectName string) error
+	PurgeBucket(ctx context.Context, bucketName string) error
+	ListRegions() ([]string, error)
+	ListAvailabilityZones() ([]string, error)
+	GetImageName(imageID string) (string, string, error)
+	FindImage(name, ownerID string) (string, error)
+	GetAccountAttributes(names ...string) (map[string]string, error)
+	CountInstances() (int64, error)
+	CountAddresses() (int64, error)
+	CountVPCs() (int64, error)
+	GetServiceQuota(ctx context.Context, serviceCode, quotaCode string) (float64, error)
 
 	// The following functions are only temporary needed due to https://github.com/gardener/gardener/issues/129.
-	ListKubernetesELBs(vpcID, clusterName string) ([]string, error)
-	ListKubernetesSecurityGroups(vpcID, clusterName string) ([]string, error)
-	DeleteELB(name string) error
-	DeleteSecurityGroup(id string) error
+	ListKubernetesELBs(ctx context.Context, vpcID, clusterName string) ([]string, error)
+	ListKubernetesSecurityGroups(ctx context.Context, vpcID, clusterName string) ([]string, error)
+	DeleteELB(ctx context.Context, name string) error
+	DeleteSecurityGroup(ctx context.Context, id string) error
 }
 
 // Client is a struct containing several clients for the different AWS services it needs to interact with.
@@ -40,4 +60,18 @@ type Client struct {
 	EC2 *ec2.EC2
 	ELB *elb.ELB
 	STS *sts.STS
+
+	signer     *v4.Signer
+	region     string
+	httpClient *http.Client
+}
+
+// SubnetInfo contains information about an existing subnet.
+type SubnetInfo struct {
+	// VPCID is the id of the VPC the subnet belongs to.
+	VPCID string
+	// AvailabilityZone is the name of the availability zone the subnet is located in.
+	AvailabilityZone string
+	// CIDR is the IPv4 CIDR block of the subnet.
+	CIDR string
 }
@@ -0,0 +1,245 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils/ratelimiter"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	resourceManagerURL           = "https://management.azure.com"
+	resourceManagerAPIVersion    = "2018-05-01"
+	activeDirectoryURL           = "https://login.microsoftonline.com"
+	resourceGroupDeletionTimeout = 30 * time.Minute
+	resourceGroupType            = "Microsoft.Resources/resourceGroups"
+)
+
+// Resource is an Azure resource.
+type Resource struct {
+	// Name is the name of the resource.
+	Name string
+	// Type is the type of the resource, e.g. "Microsoft.Network/virtualNetworks".
+	Type string
+}
+
+// ResourceGroupClient is a client for the resource groups of an Azure subscription which authenticates with the
+// client secret of a service principal.
+type ResourceGroupClient struct {
+	tenantID       string
+	subscriptionID string
+	clientID       string
+	clientSecret   string
+	httpClient     *http.Client
+	pollInterval   time.Duration
+}
+
+// NewResourceGroupClient creates a new ResourceGroupClient for the subscription <subscriptionID> which authenticates
+// with the service principal <clientID> of the tenant <tenantID>.
+func NewResourceGroupClient(tenantID, subscriptionID, clientID, clientSecret string) *ResourceGroupClient {
+	return NewResourceGroupClientWithRateLimiter(tenantID, subscriptionID, clientID, clientSecret, nil)
+}
+
+// NewResourceGroupClientWithRateLimiter creates a new ResourceGroupClient like NewResourceGroupClient whose requests
+// wait for a token of the given <limiter> before they are sent. The requests are not limited if <limiter> is nil.
+func NewResourceGroupClientWithRateLimiter(tenantID, subscriptionID, clientID, clientSecret string, limiter *rate.Limiter) *ResourceGroupClient {
+	return &ResourceGroupClient{
+		tenantID:       tenantID,
+		subscriptionID: subscriptionID,
+		clientID:       clientID,
+		clientSecret:   clientSecret,
+		httpClient:     &http.Client{Timeout: requestTimeout, Transport: ratelimiter.NewRoundTripper(limiter, nil)},
+		pollInterval:   10 * time.Second,
+	}
+}
+
+// DeleteResourceGroup deletes the resource group <name> including all its resources and waits until it is gone.
+// It does not return an error if the resource group does not exist.
+func (c *ResourceGroupClient) DeleteResourceGroup(ctx context.Context, name string) error {
+	token, err := c.token(ctx)
+	if err != nil {
+		return fmt.Errorf("could not authenticate service principal: %v", err)
+	}
+
+	resourceGroupURL := fmt.Sprintf("%s/subscriptions/%s/resourcegroups/%s?api-version=%s", resourceManagerURL, url.PathEscape(c.subscriptionID), url.PathEscape(name), resourceManagerAPIVersion)
+
+	status, message, err := c.doRequest(ctx, http.MethodDelete, resourceGroupURL, token)
+	if err != nil {
+		return err
+	}
+	if status == http.StatusNotFound {
+		return nil
+	}
+	if status >= http.StatusMultipleChoices {
+		return fmt.Errorf("could not delete resource group %q: request failed with status %d: %s", name, status, message)
+	}
+
+	// The deletion of a resource group is asynchronous, the resource group is reported until all its resources are gone.
+	ctx, cancel := context.WithTimeout(ctx, resourceGroupDeletionTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("resource group %q has not been deleted yet: %v", name, ctx.Err())
+		case <-ticker.C:
+		}
+
+		status, message, err := c.doRequest(ctx, http.MethodGet, resourceGroupURL, token)
+		if err != nil {
+			return err
+		}
+		if status == http.StatusNotFound {
+			return nil
+		}
+		if status >= http.StatusMultipleChoices {
+			return fmt.Errorf("could not get resource group %q: request failed with status %d: %s", name, status, message)
+		}
+	}
+}
+
+// resourceList is the response of the Resource Manager API for listing the resources of a resource group.
+type resourceList struct {
+	Value []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+		Type string `json:"type"`
+	} `json:"value"`
+	NextLink string `json:"nextLink"`
+}
+
+// ListResources returns the resource group <name> itself and the resources it contains, each mapped from its ID to
+// its name and type. It returns an empty result if the resource group does not exist.
+func (c *ResourceGroupClient) ListResources(ctx context.Context, name string) (map[string]Resource, error) {
+	token, err := c.token(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not authenticate service principal: %v", err)
+	}
+
+	var (
+		resources        = map[string]Resource{}
+		resourceGroupURL = fmt.Sprintf("%s/subscriptions/%s/resourcegroups/%s", resourceManagerURL, url.PathEscape(c.subscriptionID), url.PathEscape(name))
+		requestURL       = fmt.Sprintf("%s/resources?api-version=%s", resourceGroupURL, resourceManagerAPIVersion)
+	)
+
+	status, message, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("%s?api-version=%s", resourceGroupURL, resourceManagerAPIVersion), token)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound {
+		return resources, nil
+	}
+	if status >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("could not get resource group %q: request failed with status %d: %s", name, status, message)
+	}
+	var resourceGroup struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal([]byte(message), &resourceGroup); err != nil {
+		return nil, fmt.Errorf("could not decode resource group %q: %v", name, err)
+	}
+	resources[resourceGroup.ID] = Resource{Name: name, Type: resourceGroupType}
+
+	for len(requestURL) > 0 {
+		status, message, err := c.doRequest(ctx, http.MethodGet, requestURL, token)
+		if err != nil {
+			return nil, err
+		}
+		if status >= http.StatusMultipleChoices {
+			return nil, fmt.Errorf("could not list resources of resource group %q: request failed with status %d: %s", name, status, message)
+		}
+
+		var list resourceList
+		if err := json.Unmarshal([]byte(message), &list); err != nil {
+			return nil, fmt.Errorf("could not decode resources of resource group %q: %v", name, err)
+		}
+		for _, resource := range list.Value {
+			resources[resource.ID] = Resource{Name: resource.Name, Type: resource.Type}
+		}
+		requestURL = list.NextLink
+	}
+
+	return resources, nil
+}
+
+// token requests an access token for the Azure Resource Manager with the client credentials of the service principal.
+func (c *ResourceGroupClient) token(ctx context.Context) (string, error) {
+	form := url.Values{
+		"grant_type":    []string{"client_credentials"},
+		"client_id":     []string{c.clientID},
+		"client_secret": []string{c.clientSecret},
+		"resource":      []string{resourceManagerURL + "/"},
+	}
+
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/%s/oauth2/token", activeDirectoryURL, url.PathEscape(c.tenantID)), strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		message, _ := ioutil.ReadAll(resp.Body)
+		return "", fmt.Errorf("request failed with status %q: %s", resp.Status, string(message))
+	}
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", err
+	}
+	return token.AccessToken, nil
+}
+
+// doRequest sends a request with the given <method> and the bearer <token> to the Resource Manager <requestURL> and
+// returns the status code and the body of the response.
+func (c *ResourceGroupClient) doRequest(ctx context.Context, method, requestURL, token string) (int, string, error) {
+	req, err := http.NewRequest(method, requestURL, nil)
+	if err != nil {
+		return 0, "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+
+	message, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, "", err
+	}
+	return resp.StatusCode, string(message), nil
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	. "github.com/gardener/gardener/pkg/client/azure"
+	"github.com/gardener/gardener/pkg/utils/test"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("resources", func() {
+	Describe("#DeleteResourceGroup", func() {
+		const (
+			resourceGroupName = "backup"
+			resourceGroupPath = "/subscriptions/subscription/resourcegroups/backup"
+			tokenPath         = "/tenant/oauth2/token"
+		)
+
+		var (
+			ctx    = context.TODO()
+			server *httptest.Server
+			client *ResourceGroupClient
+
+			lock      sync.Mutex
+			remaining int
+			requests  []string
+			handler   http.HandlerFunc
+		)
+
+		BeforeEach(func() {
+			// The resource group is reported twice after the deletion request before it is gone.
+			remaining = 2
+			requests = nil
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				lock.Lock()
+				defer lock.Unlock()
+
+				requests = append(requests, r.Method+" "+r.Host+r.URL.Path)
+
+				switch {
+				case r.Method == http.MethodPost && r.URL.Path == tokenPath:
+					Expect(r.ParseForm()).To(Succeed())
+					Expect(r.PostForm.Get("client_id")).To(Equal("client"))
+					Expect(r.PostForm.Get("client_secret")).To(Equal("secret"))
+					w.Write([]byte(`{"access_token":"token"}`))
+				case r.URL.Path == resourceGroupPath:
+					Expect(r.Header.Get("Authorization")).To(Equal("Bearer token"))
+					if r.Method == http.MethodDelete {
+						w.WriteHeader(http.StatusAccepted)
+						return
+					}
+					if remaining == 0 {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					remaining--
+					w.Write([]byte(`{"properties":{"provisioningState":"Deleting"}}`))
+				default:
+					w.WriteHeader(http.StatusMethodNotAllowed)
+				}
+			}
+
+			server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { handler(w, r) }))
+			client = ExportWithHTTPClient(NewResourceGroupClient("tenant", "subscription", "client", "secret"), test.NewRedirectingHTTPClient(server), time.Millisecond)
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should delete the resource group and wait until it is gone", func() {
+			Expect(client.DeleteResourceGroup(ctx, resourceGroupName)).To(Succeed())
+
+			Expect(remaining).To(BeZero())
+			Expect(requests).To(Equal([]string{
+				"POST login.microsoftonline.com" + tokenPath,
+				"DELETE management.azure.com" + resourceGroupPath,
+				"GET management.azure.com" + resourceGroupPath,
+				"GET management.azure.com" + resourceGroupPath,
+				"GET management.azure.com" + resourceGroupPath,
+			}))
+		})
+
+		It("should not fail if the resource group does not exist", func() {
+			remaining = 0
+			defaultHandler := handler
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodDelete {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				defaultHandler(w, r)
+			}
+
+			Expect(client.DeleteResourceGroup(ctx, resourceGroupName)).To(Succeed())
+		})
+
+		It("should fail if the service principal cannot be authenticated", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+			}
+
+			Expect(client.DeleteResourceGroup(ctx, resourceGroupName)).To(MatchError(ContainSubstring("could not authenticate service principal")))
+		})
+
+		It("should stop waiting if the context is cancelled", func() {
+			// The resource group never disappears.
+			remaining = -1
+			timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+			defer cancel()
+
+			Expect(client.DeleteResourceGroup(timeoutCtx, resourceGroupName)).NotTo(Succeed())
+			Expect(timeoutCtx.Err()).To(Equal(context.DeadlineExceeded))
+		})
+	})
+})
@@ -0,0 +1,143 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	storageAPIVersion = "2018-03-28"
+
+	// requestTimeout is the timeout of a single request to the Azure APIs.
+	requestTimeout = 30 * time.Second
+)
+
+// StorageClient is a client for the Blob service of an Azure storage account which authenticates with the
+// account's shared key.
+type StorageClient struct {
+	accountName string
+	accountKey  []byte
+	httpClient  *http.Client
+}
+
+// NewStorageClient creates a new StorageClient for the storage account <accountName> with the base64 encoded
+// access key <accountKey>.
+func NewStorageClient(accountName, accountKey string) (*StorageClient, error) {
+	key, err := base64.StdEncoding.DecodeString(accountKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode storage account key: %v", err)
+	}
+
+	return &StorageClient{
+		accountName: accountName,
+		accountKey:  key,
+		httpClient:  &http.Client{Timeout: requestTimeout},
+	}, nil
+}
+
+// ProbeContainer verifies that the blob container <containerName> exists, that it is writable, and that the
+// access key of the storage account is valid by writing and deleting the blob <blobName>.
+func (c *StorageClient) ProbeContainer(ctx context.Context, containerName, blobName string) error {
+	path := fmt.Sprintf("/%s/%s", containerName, blobName)
+
+	if err := c.doBlobRequest(ctx, http.MethodPut, path, []byte(time.Now().UTC().Format(time.RFC3339))); err != nil {
+		return fmt.Errorf("could not write blob %q to container %q: %v", blobName, containerName, err)
+	}
+	if err := c.doBlobRequest(ctx, http.MethodDelete, path, nil); err != nil {
+		return fmt.Errorf("could not delete blob %q from container %q: %v", blobName, containerName, err)
+	}
+	return nil
+}
+
+// doBlobRequest sends a request with the given <method> and <body> for the blob <path> to the Blob service.
+func (c *StorageClient) doBlobRequest(ctx context.Context, method, path string, body []byte) error {
+	req, err := http.NewRequest(method, fmt.Sprintf("https://%s.blob.core.windows.net%s", c.accountName, path), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("x-ms-version", storageAPIVersion)
+	if method == http.MethodPut {
+		req.Header.Set("x-ms-blob-type", "BlockBlob")
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("SharedKey %s:%s", c.accountName, c.sign(req, path)))
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		message, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("request failed with status %q: %s", resp.Status, string(message))
+	}
+	return nil
+}
+
+// sign computes the shared key signature of the given request, see
+// https://docs.microsoft.com/en-us/rest/api/storageservices/authorize-with-shared-key.
+func (c *StorageClient) sign(req *http.Request, path string) string {
+	var contentLength string
+	if req.ContentLength > 0 {
+		contentLength = strconv.FormatInt(req.ContentLength, 10)
+	}
+
+	var msHeaders []string
+	for name := range req.Header {
+		if name := strings.ToLower(name); strings.HasPrefix(name, "x-ms-") {
+			msHeaders = append(msHeaders, name)
+		}
+	}
+	sort.Strings(msHeaders)
+
+	var canonicalizedHeaders strings.Builder
+	for _, name := range msHeaders {
+		fmt.Fprintf(&canonicalizedHeaders, "%s:%s\n", name, req.Header.Get(name))
+	}
+
+	stringToSign := strings.Join([]string{
+		req.Method,
+		"", // Content-Encoding
+		"", // Content-Language
+		contentLength,
+		"", // Content-MD5
+		"", // Content-Type
+		"", // Date
+		"", // If-Modified-Since
+		"", // If-Match
+		"", // If-None-Match
+		"", // If-Unmodified-Since
+		"", // Range
+		canonicalizedHeaders.String() + fmt.Sprintf("/%s%s", c.accountName, path),
+	}, "\n")
+
+	mac := hmac.New(sha256.New, c.accountKey)
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
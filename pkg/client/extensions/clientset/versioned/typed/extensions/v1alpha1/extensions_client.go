@@ -16,6 +16,7 @@ type ExtensionsV1alpha1Interface interface {
 	ExtensionsGetter
 	InfrastructuresGetter
 	OperatingSystemConfigsGetter
+	TerraformRunsGetter
 	WorkersGetter
 }
 
@@ -44,6 +45,10 @@ func (c *ExtensionsV1alpha1Client) OperatingSystemConfigs(namespace string) Oper
 	return newOperatingSystemConfigs(c, namespace)
 }
 
+func (c *ExtensionsV1alpha1Client) TerraformRuns(namespace string) TerraformRunInterface {
+	return newTerraformRuns(c, namespace)
+}
+
 func (c *ExtensionsV1alpha1Client) Workers(namespace string) WorkerInterface {
 	return newWorkers(c, namespace)
 }
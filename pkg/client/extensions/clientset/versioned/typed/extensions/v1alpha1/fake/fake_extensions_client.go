@@ -32,6 +32,10 @@ func (c *FakeExtensionsV1alpha1) OperatingSystemConfigs(namespace string) v1alph
 	return &FakeOperatingSystemConfigs{c, namespace}
 }
 
+func (c *FakeExtensionsV1alpha1) TerraformRuns(namespace string) v1alpha1.TerraformRunInterface {
+	return &FakeTerraformRuns{c, namespace}
+}
+
 func (c *FakeExtensionsV1alpha1) Workers(namespace string) v1alpha1.WorkerInterface {
 	return &FakeWorkers{c, namespace}
 }
@@ -12,4 +12,6 @@ type InfrastructureExpansion interface{}
 
 type OperatingSystemConfigExpansion interface{}
 
+type TerraformRunExpansion interface{}
+
 type WorkerExpansion interface{}
@@ -0,0 +1,175 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	scheme "github.com/gardener/gardener/pkg/client/extensions/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// TerraformRunsGetter has a method to return a TerraformRunInterface.
+// A group's client should implement this interface.
+type TerraformRunsGetter interface {
+	TerraformRuns(namespace string) TerraformRunInterface
+}
+
+// TerraformRunInterface has methods to work with TerraformRun resources.
+type TerraformRunInterface interface {
+	Create(*v1alpha1.TerraformRun) (*v1alpha1.TerraformRun, error)
+	Update(*v1alpha1.TerraformRun) (*v1alpha1.TerraformRun, error)
+	UpdateStatus(*v1alpha1.TerraformRun) (*v1alpha1.TerraformRun, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.TerraformRun, error)
+	List(opts v1.ListOptions) (*v1alpha1.TerraformRunList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.TerraformRun, err error)
+	TerraformRunExpansion
+}
+
+// terraformRuns implements TerraformRunInterface
+type terraformRuns struct {
+	client rest.Interface
+	ns     string
+}
+
+// newTerraformRuns returns a TerraformRuns
+func newTerraformRuns(c *ExtensionsV1alpha1Client, namespace string) *terraformRuns {
+	return &terraformRuns{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the terraformRun, and returns the corresponding terraformRun object, and an error if there is any.
+func (c *terraformRuns) Get(name string, options v1.GetOptions) (result *v1alpha1.TerraformRun, err error) {
+	result = &v1alpha1.TerraformRun{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("terraformruns").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of TerraformRuns that match those selectors.
+func (c *terraformRuns) List(opts v1.ListOptions) (result *v1alpha1.TerraformRunList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.TerraformRunList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("terraformruns").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested terraformRuns.
+func (c *terraformRuns) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("terraformruns").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a terraformRun and creates it.  Returns the server's representation of the terraformRun, and an error, if there is any.
+func (c *terraformRuns) Create(terraformRun *v1alpha1.TerraformRun) (result *v1alpha1.TerraformRun, err error) {
+	result = &v1alpha1.TerraformRun{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("terraformruns").
+		Body(terraformRun).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a terraformRun and updates it. Returns the server's representation of the terraformRun, and an error, if there is any.
+func (c *terraformRuns) Update(terraformRun *v1alpha1.TerraformRun) (result *v1alpha1.TerraformRun, err error) {
+	result = &v1alpha1.TerraformRun{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("terraformruns").
+		Name(terraformRun.Name).
+		Body(terraformRun).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *terraformRuns) UpdateStatus(terraformRun *v1alpha1.TerraformRun) (result *v1alpha1.TerraformRun, err error) {
+	result = &v1alpha1.TerraformRun{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("terraformruns").
+		Name(terraformRun.Name).
+		SubResource("status").
+		Body(terraformRun).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the terraformRun and deletes it. Returns an error if one occurs.
+func (c *terraformRuns) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("terraformruns").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *terraformRuns) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("terraformruns").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched terraformRun.
+func (c *terraformRuns) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.TerraformRun, err error) {
+	result = &v1alpha1.TerraformRun{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("terraformruns").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
@@ -18,6 +18,8 @@ type Interface interface {
 	Infrastructures() InfrastructureInformer
 	// OperatingSystemConfigs returns a OperatingSystemConfigInformer.
 	OperatingSystemConfigs() OperatingSystemConfigInformer
+	// TerraformRuns returns a TerraformRunInformer.
+	TerraformRuns() TerraformRunInformer
 	// Workers returns a WorkerInformer.
 	Workers() WorkerInformer
 }
@@ -58,6 +60,11 @@ func (v *version) OperatingSystemConfigs() OperatingSystemConfigInformer {
 	return &operatingSystemConfigInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// TerraformRuns returns a TerraformRunInformer.
+func (v *version) TerraformRuns() TerraformRunInformer {
+	return &terraformRunInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Workers returns a WorkerInformer.
 func (v *version) Workers() WorkerInformer {
 	return &workerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
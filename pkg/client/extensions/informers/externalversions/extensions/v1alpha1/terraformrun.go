@@ -0,0 +1,73 @@
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	time "time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	versioned "github.com/gardener/gardener/pkg/client/extensions/clientset/versioned"
+	internalinterfaces "github.com/gardener/gardener/pkg/client/extensions/informers/externalversions/internalinterfaces"
+	v1alpha1 "github.com/gardener/gardener/pkg/client/extensions/listers/extensions/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// TerraformRunInformer provides access to a shared informer and lister for
+// TerraformRuns.
+type TerraformRunInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.TerraformRunLister
+}
+
+type terraformRunInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewTerraformRunInformer constructs a new informer for TerraformRun type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewTerraformRunInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredTerraformRunInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredTerraformRunInformer constructs a new informer for TerraformRun type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredTerraformRunInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ExtensionsV1alpha1().TerraformRuns(namespace).List(options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ExtensionsV1alpha1().TerraformRuns(namespace).Watch(options)
+			},
+		},
+		&extensionsv1alpha1.TerraformRun{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *terraformRunInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredTerraformRunInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *terraformRunInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&extensionsv1alpha1.TerraformRun{}, f.defaultInformer)
+}
+
+func (f *terraformRunInformer) Lister() v1alpha1.TerraformRunLister {
+	return v1alpha1.NewTerraformRunLister(f.Informer().GetIndexer())
+}
@@ -47,6 +47,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Extensions().V1alpha1().Infrastructures().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("operatingsystemconfigs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Extensions().V1alpha1().OperatingSystemConfigs().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("terraformruns"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Extensions().V1alpha1().TerraformRuns().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("workers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Extensions().V1alpha1().Workers().Informer()}, nil
 
@@ -42,6 +42,14 @@ type OperatingSystemConfigListerExpansion interface{}
 // OperatingSystemConfigNamespaceLister.
 type OperatingSystemConfigNamespaceListerExpansion interface{}
 
+// TerraformRunListerExpansion allows custom methods to be added to
+// TerraformRunLister.
+type TerraformRunListerExpansion interface{}
+
+// TerraformRunNamespaceListerExpansion allows custom methods to be added to
+// TerraformRunNamespaceLister.
+type TerraformRunNamespaceListerExpansion interface{}
+
 // WorkerListerExpansion allows custom methods to be added to
 // WorkerLister.
 type WorkerListerExpansion interface{}
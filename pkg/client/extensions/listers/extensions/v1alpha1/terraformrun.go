@@ -0,0 +1,78 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// TerraformRunLister helps list TerraformRuns.
+type TerraformRunLister interface {
+	// List lists all TerraformRuns in the indexer.
+	List(selector labels.Selector) (ret []*v1alpha1.TerraformRun, err error)
+	// TerraformRuns returns an object that can list and get TerraformRuns.
+	TerraformRuns(namespace string) TerraformRunNamespaceLister
+	TerraformRunListerExpansion
+}
+
+// terraformRunLister implements the TerraformRunLister interface.
+type terraformRunLister struct {
+	indexer cache.Indexer
+}
+
+// NewTerraformRunLister returns a new TerraformRunLister.
+func NewTerraformRunLister(indexer cache.Indexer) TerraformRunLister {
+	return &terraformRunLister{indexer: indexer}
+}
+
+// List lists all TerraformRuns in the indexer.
+func (s *terraformRunLister) List(selector labels.Selector) (ret []*v1alpha1.TerraformRun, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.TerraformRun))
+	})
+	return ret, err
+}
+
+// TerraformRuns returns an object that can list and get TerraformRuns.
+func (s *terraformRunLister) TerraformRuns(namespace string) TerraformRunNamespaceLister {
+	return terraformRunNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// TerraformRunNamespaceLister helps list and get TerraformRuns.
+type TerraformRunNamespaceLister interface {
+	// List lists all TerraformRuns in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*v1alpha1.TerraformRun, err error)
+	// Get retrieves the TerraformRun from the indexer for a given namespace and name.
+	Get(name string) (*v1alpha1.TerraformRun, error)
+	TerraformRunNamespaceListerExpansion
+}
+
+// terraformRunNamespaceLister implements the TerraformRunNamespaceLister
+// interface.
+type terraformRunNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all TerraformRuns in the indexer for a given namespace.
+func (s terraformRunNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.TerraformRun, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.TerraformRun))
+	})
+	return ret, err
+}
+
+// Get retrieves the TerraformRun from the indexer for a given namespace and name.
+func (s terraformRunNamespaceLister) Get(name string) (*v1alpha1.TerraformRun, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("terraformrun"), name)
+	}
+	return obj.(*v1alpha1.TerraformRun), nil
+}
@@ -20,6 +20,10 @@ func (c *FakeGarden) CloudProfiles() internalversion.CloudProfileInterface {
 	return &FakeCloudProfiles{c}
 }
 
+func (c *FakeGarden) DeletionProtectionPolicies() internalversion.DeletionProtectionPolicyInterface {
+	return &FakeDeletionProtectionPolicies{c}
+}
+
 func (c *FakeGarden) Projects() internalversion.ProjectInterface {
 	return &FakeProjects{c}
 }
@@ -40,6 +44,10 @@ func (c *FakeGarden) Shoots(namespace string) internalversion.ShootInterface {
 	return &FakeShoots{c, namespace}
 }
 
+func (c *FakeGarden) ShootTemplates(namespace string) internalversion.ShootTemplateInterface {
+	return &FakeShootTemplates{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeGarden) RESTClient() rest.Interface {
@@ -11,11 +11,13 @@ type GardenInterface interface {
 	RESTClient() rest.Interface
 	BackupInfrastructuresGetter
 	CloudProfilesGetter
+	DeletionProtectionPoliciesGetter
 	ProjectsGetter
 	QuotasGetter
 	SecretBindingsGetter
 	SeedsGetter
 	ShootsGetter
+	ShootTemplatesGetter
 }
 
 // GardenClient is used to interact with features provided by the garden.sapcloud.io group.
@@ -31,6 +33,10 @@ func (c *GardenClient) CloudProfiles() CloudProfileInterface {
 	return newCloudProfiles(c)
 }
 
+func (c *GardenClient) DeletionProtectionPolicies() DeletionProtectionPolicyInterface {
+	return newDeletionProtectionPolicies(c)
+}
+
 func (c *GardenClient) Projects() ProjectInterface {
 	return newProjects(c)
 }
@@ -51,6 +57,10 @@ func (c *GardenClient) Shoots(namespace string) ShootInterface {
 	return newShoots(c, namespace)
 }
 
+func (c *GardenClient) ShootTemplates(namespace string) ShootTemplateInterface {
+	return newShootTemplates(c, namespace)
+}
+
 // NewForConfig creates a new GardenClient for the given config.
 func NewForConfig(c *rest.Config) (*GardenClient, error) {
 	config := *c
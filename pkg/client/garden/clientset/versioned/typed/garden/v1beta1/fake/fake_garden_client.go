@@ -20,6 +20,10 @@ func (c *FakeGardenV1beta1) CloudProfiles() v1beta1.CloudProfileInterface {
 	return &FakeCloudProfiles{c}
 }
 
+func (c *FakeGardenV1beta1) DeletionProtectionPolicies() v1beta1.DeletionProtectionPolicyInterface {
+	return &FakeDeletionProtectionPolicies{c}
+}
+
 func (c *FakeGardenV1beta1) Projects() v1beta1.ProjectInterface {
 	return &FakeProjects{c}
 }
@@ -40,6 +44,10 @@ func (c *FakeGardenV1beta1) Shoots(namespace string) v1beta1.ShootInterface {
 	return &FakeShoots{c, namespace}
 }
 
+func (c *FakeGardenV1beta1) ShootTemplates(namespace string) v1beta1.ShootTemplateInterface {
+	return &FakeShootTemplates{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeGardenV1beta1) RESTClient() rest.Interface {
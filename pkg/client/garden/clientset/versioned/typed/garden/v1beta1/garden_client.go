@@ -13,11 +13,13 @@ type GardenV1beta1Interface interface {
 	RESTClient() rest.Interface
 	BackupInfrastructuresGetter
 	CloudProfilesGetter
+	DeletionProtectionPoliciesGetter
 	ProjectsGetter
 	QuotasGetter
 	SecretBindingsGetter
 	SeedsGetter
 	ShootsGetter
+	ShootTemplatesGetter
 }
 
 // GardenV1beta1Client is used to interact with features provided by the garden.sapcloud.io group.
@@ -33,6 +35,10 @@ func (c *GardenV1beta1Client) CloudProfiles() CloudProfileInterface {
 	return newCloudProfiles(c)
 }
 
+func (c *GardenV1beta1Client) DeletionProtectionPolicies() DeletionProtectionPolicyInterface {
+	return newDeletionProtectionPolicies(c)
+}
+
 func (c *GardenV1beta1Client) Projects() ProjectInterface {
 	return newProjects(c)
 }
@@ -53,6 +59,10 @@ func (c *GardenV1beta1Client) Shoots(namespace string) ShootInterface {
 	return newShoots(c, namespace)
 }
 
+func (c *GardenV1beta1Client) ShootTemplates(namespace string) ShootTemplateInterface {
+	return newShootTemplates(c, namespace)
+}
+
 // NewForConfig creates a new GardenV1beta1Client for the given config.
 func NewForConfig(c *rest.Config) (*GardenV1beta1Client, error) {
 	config := *c
@@ -6,6 +6,8 @@ type BackupInfrastructureExpansion interface{}
 
 type CloudProfileExpansion interface{}
 
+type DeletionProtectionPolicyExpansion interface{}
+
 type ProjectExpansion interface{}
 
 type QuotaExpansion interface{}
@@ -15,3 +17,5 @@ type SecretBindingExpansion interface{}
 type SeedExpansion interface{}
 
 type ShootExpansion interface{}
+
+type ShootTemplateExpansion interface{}
@@ -0,0 +1,73 @@
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	time "time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	versioned "github.com/gardener/gardener/pkg/client/garden/clientset/versioned"
+	internalinterfaces "github.com/gardener/gardener/pkg/client/garden/informers/externalversions/internalinterfaces"
+	v1beta1 "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ShootTemplateInformer provides access to a shared informer and lister for
+// ShootTemplates.
+type ShootTemplateInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1beta1.ShootTemplateLister
+}
+
+type shootTemplateInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewShootTemplateInformer constructs a new informer for ShootTemplate type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewShootTemplateInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredShootTemplateInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredShootTemplateInformer constructs a new informer for ShootTemplate type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredShootTemplateInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.GardenV1beta1().ShootTemplates(namespace).List(options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.GardenV1beta1().ShootTemplates(namespace).Watch(options)
+			},
+		},
+		&gardenv1beta1.ShootTemplate{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *shootTemplateInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredShootTemplateInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *shootTemplateInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&gardenv1beta1.ShootTemplate{}, f.defaultInformer)
+}
+
+func (f *shootTemplateInformer) Lister() v1beta1.ShootTemplateLister {
+	return v1beta1.NewShootTemplateLister(f.Informer().GetIndexer())
+}
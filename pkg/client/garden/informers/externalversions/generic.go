@@ -41,6 +41,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().V1beta1().BackupInfrastructures().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("cloudprofiles"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().V1beta1().CloudProfiles().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("deletionprotectionpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().V1beta1().DeletionProtectionPolicies().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("projects"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().V1beta1().Projects().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("quotas"):
@@ -51,6 +53,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().V1beta1().Seeds().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("shoots"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().V1beta1().Shoots().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("shoottemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().V1beta1().ShootTemplates().Informer()}, nil
 
 	}
 
@@ -0,0 +1,72 @@
+// Code generated by informer-gen. DO NOT EDIT.
+
+package internalversion
+
+import (
+	time "time"
+
+	garden "github.com/gardener/gardener/pkg/apis/garden"
+	clientsetinternalversion "github.com/gardener/gardener/pkg/client/garden/clientset/internalversion"
+	internalinterfaces "github.com/gardener/gardener/pkg/client/garden/informers/internalversion/internalinterfaces"
+	internalversion "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// DeletionProtectionPolicyInformer provides access to a shared informer and lister for
+// DeletionProtectionPolicies.
+type DeletionProtectionPolicyInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() internalversion.DeletionProtectionPolicyLister
+}
+
+type deletionProtectionPolicyInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// NewDeletionProtectionPolicyInformer constructs a new informer for DeletionProtectionPolicy type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewDeletionProtectionPolicyInformer(client clientsetinternalversion.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredDeletionProtectionPolicyInformer(client, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredDeletionProtectionPolicyInformer constructs a new informer for DeletionProtectionPolicy type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredDeletionProtectionPolicyInformer(client clientsetinternalversion.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.Garden().DeletionProtectionPolicies().List(options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.Garden().DeletionProtectionPolicies().Watch(options)
+			},
+		},
+		&garden.DeletionProtectionPolicy{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *deletionProtectionPolicyInformer) defaultInformer(client clientsetinternalversion.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredDeletionProtectionPolicyInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *deletionProtectionPolicyInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&garden.DeletionProtectionPolicy{}, f.defaultInformer)
+}
+
+func (f *deletionProtectionPolicyInformer) Lister() internalversion.DeletionProtectionPolicyLister {
+	return internalversion.NewDeletionProtectionPolicyLister(f.Informer().GetIndexer())
+}
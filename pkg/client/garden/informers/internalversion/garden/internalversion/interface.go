@@ -12,6 +12,8 @@ type Interface interface {
 	BackupInfrastructures() BackupInfrastructureInformer
 	// CloudProfiles returns a CloudProfileInformer.
 	CloudProfiles() CloudProfileInformer
+	// DeletionProtectionPolicies returns a DeletionProtectionPolicyInformer.
+	DeletionProtectionPolicies() DeletionProtectionPolicyInformer
 	// Projects returns a ProjectInformer.
 	Projects() ProjectInformer
 	// Quotas returns a QuotaInformer.
@@ -22,6 +24,8 @@ type Interface interface {
 	Seeds() SeedInformer
 	// Shoots returns a ShootInformer.
 	Shoots() ShootInformer
+	// ShootTemplates returns a ShootTemplateInformer.
+	ShootTemplates() ShootTemplateInformer
 }
 
 type version struct {
@@ -45,6 +49,11 @@ func (v *version) CloudProfiles() CloudProfileInformer {
 	return &cloudProfileInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// DeletionProtectionPolicies returns a DeletionProtectionPolicyInformer.
+func (v *version) DeletionProtectionPolicies() DeletionProtectionPolicyInformer {
+	return &deletionProtectionPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Projects returns a ProjectInformer.
 func (v *version) Projects() ProjectInformer {
 	return &projectInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -69,3 +78,8 @@ func (v *version) Seeds() SeedInformer {
 func (v *version) Shoots() ShootInformer {
 	return &shootInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// ShootTemplates returns a ShootTemplateInformer.
+func (v *version) ShootTemplates() ShootTemplateInformer {
+	return &shootTemplateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
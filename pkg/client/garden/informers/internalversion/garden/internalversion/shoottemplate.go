@@ -0,0 +1,73 @@
+// Code generated by informer-gen. DO NOT EDIT.
+
+package internalversion
+
+import (
+	time "time"
+
+	garden "github.com/gardener/gardener/pkg/apis/garden"
+	clientsetinternalversion "github.com/gardener/gardener/pkg/client/garden/clientset/internalversion"
+	internalinterfaces "github.com/gardener/gardener/pkg/client/garden/informers/internalversion/internalinterfaces"
+	internalversion "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ShootTemplateInformer provides access to a shared informer and lister for
+// ShootTemplates.
+type ShootTemplateInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() internalversion.ShootTemplateLister
+}
+
+type shootTemplateInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewShootTemplateInformer constructs a new informer for ShootTemplate type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewShootTemplateInformer(client clientsetinternalversion.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredShootTemplateInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredShootTemplateInformer constructs a new informer for ShootTemplate type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredShootTemplateInformer(client clientsetinternalversion.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.Garden().ShootTemplates(namespace).List(options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.Garden().ShootTemplates(namespace).Watch(options)
+			},
+		},
+		&garden.ShootTemplate{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *shootTemplateInformer) defaultInformer(client clientsetinternalversion.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredShootTemplateInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *shootTemplateInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&garden.ShootTemplate{}, f.defaultInformer)
+}
+
+func (f *shootTemplateInformer) Lister() internalversion.ShootTemplateLister {
+	return internalversion.NewShootTemplateLister(f.Informer().GetIndexer())
+}
@@ -41,6 +41,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().InternalVersion().BackupInfrastructures().Informer()}, nil
 	case garden.SchemeGroupVersion.WithResource("cloudprofiles"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().InternalVersion().CloudProfiles().Informer()}, nil
+	case garden.SchemeGroupVersion.WithResource("deletionprotectionpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().InternalVersion().DeletionProtectionPolicies().Informer()}, nil
 	case garden.SchemeGroupVersion.WithResource("projects"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().InternalVersion().Projects().Informer()}, nil
 	case garden.SchemeGroupVersion.WithResource("quotas"):
@@ -51,6 +53,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().InternalVersion().Seeds().Informer()}, nil
 	case garden.SchemeGroupVersion.WithResource("shoots"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().InternalVersion().Shoots().Informer()}, nil
+	case garden.SchemeGroupVersion.WithResource("shoottemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().InternalVersion().ShootTemplates().Informer()}, nil
 
 	}
 
@@ -14,6 +14,10 @@ type BackupInfrastructureNamespaceListerExpansion interface{}
 // CloudProfileLister.
 type CloudProfileListerExpansion interface{}
 
+// DeletionProtectionPolicyListerExpansion allows custom methods to be added to
+// DeletionProtectionPolicyLister.
+type DeletionProtectionPolicyListerExpansion interface{}
+
 // ProjectListerExpansion allows custom methods to be added to
 // ProjectLister.
 type ProjectListerExpansion interface{}
@@ -45,3 +49,11 @@ type ShootListerExpansion interface{}
 // ShootNamespaceListerExpansion allows custom methods to be added to
 // ShootNamespaceLister.
 type ShootNamespaceListerExpansion interface{}
+
+// ShootTemplateListerExpansion allows custom methods to be added to
+// ShootTemplateLister.
+type ShootTemplateListerExpansion interface{}
+
+// ShootTemplateNamespaceListerExpansion allows custom methods to be added to
+// ShootTemplateNamespaceLister.
+type ShootTemplateNamespaceListerExpansion interface{}
@@ -0,0 +1,78 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ShootTemplateLister helps list ShootTemplates.
+type ShootTemplateLister interface {
+	// List lists all ShootTemplates in the indexer.
+	List(selector labels.Selector) (ret []*v1beta1.ShootTemplate, err error)
+	// ShootTemplates returns an object that can list and get ShootTemplates.
+	ShootTemplates(namespace string) ShootTemplateNamespaceLister
+	ShootTemplateListerExpansion
+}
+
+// shootTemplateLister implements the ShootTemplateLister interface.
+type shootTemplateLister struct {
+	indexer cache.Indexer
+}
+
+// NewShootTemplateLister returns a new ShootTemplateLister.
+func NewShootTemplateLister(indexer cache.Indexer) ShootTemplateLister {
+	return &shootTemplateLister{indexer: indexer}
+}
+
+// List lists all ShootTemplates in the indexer.
+func (s *shootTemplateLister) List(selector labels.Selector) (ret []*v1beta1.ShootTemplate, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.ShootTemplate))
+	})
+	return ret, err
+}
+
+// ShootTemplates returns an object that can list and get ShootTemplates.
+func (s *shootTemplateLister) ShootTemplates(namespace string) ShootTemplateNamespaceLister {
+	return shootTemplateNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// ShootTemplateNamespaceLister helps list and get ShootTemplates.
+type ShootTemplateNamespaceLister interface {
+	// List lists all ShootTemplates in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*v1beta1.ShootTemplate, err error)
+	// Get retrieves the ShootTemplate from the indexer for a given namespace and name.
+	Get(name string) (*v1beta1.ShootTemplate, error)
+	ShootTemplateNamespaceListerExpansion
+}
+
+// shootTemplateNamespaceLister implements the ShootTemplateNamespaceLister
+// interface.
+type shootTemplateNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all ShootTemplates in the indexer for a given namespace.
+func (s shootTemplateNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.ShootTemplate, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.ShootTemplate))
+	})
+	return ret, err
+}
+
+// Get retrieves the ShootTemplate from the indexer for a given namespace and name.
+func (s shootTemplateNamespaceLister) Get(name string) (*v1beta1.ShootTemplate, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("shoottemplate"), name)
+	}
+	return obj.(*v1beta1.ShootTemplate), nil
+}
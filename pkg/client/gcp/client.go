@@ -16,34 +16,50 @@ package gcp
 
 import (
 	"context"
+	"net/http"
+
+	"github.com/gardener/gardener/pkg/utils/ratelimiter"
 
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
+	"golang.org/x/time/rate"
 	compute "google.golang.org/api/compute/v1"
 )
 
 // Client is a struct containing the client for the GCP service it needs to interact with.
 type Client struct {
 	computeService *compute.Service
+	oauthClient    *http.Client
 }
 
 // NewClient creates a new Client for the given GCP service account
 func NewClient(ctx context.Context, serviceAccount []byte, projectID string) (ClientInterface, error) {
-	computeService, err := createComputeService(ctx, serviceAccount)
+	return NewClientWithRateLimiter(ctx, serviceAccount, projectID, nil)
+}
+
+// NewClientWithRateLimiter creates a new Client like NewClient whose requests wait for a token of the given <limiter>
+// before they are sent. The requests are not limited if <limiter> is nil.
+func NewClientWithRateLimiter(ctx context.Context, serviceAccount []byte, projectID string, limiter *rate.Limiter) (ClientInterface, error) {
+	oauthClient, err := createOAuthClient(ctx, serviceAccount)
+	if err != nil {
+		return nil, err
+	}
+	oauthClient.Transport = ratelimiter.NewRoundTripper(limiter, oauthClient.Transport)
+
+	computeService, err := compute.New(oauthClient)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Client{computeService}, nil
+	return &Client{computeService, oauthClient}, nil
 }
 
-// createComputeService initializes a compute service client and returns it
-func createComputeService(ctx context.Context, serviceaccount []byte) (*compute.Service, error) {
+// createOAuthClient initializes an HTTP client authenticated with the given service account and returns it
+func createOAuthClient(ctx context.Context, serviceaccount []byte) (*http.Client, error) {
 	jwt, err := google.JWTConfigFromJSON(serviceaccount, compute.CloudPlatformScope)
 	if err != nil {
 		return nil, err
 	}
 
-	oauthClient := oauth2.NewClient(ctx, jwt.TokenSource(ctx))
-	return compute.New(oauthClient)
+	return oauth2.NewClient(ctx, jwt.TokenSource(ctx)), nil
 }
@@ -15,10 +15,19 @@
 package gcp
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
 	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 // ClientInterface is an interface which must be implemented by GCP clients.
@@ -27,11 +36,35 @@ type ClientInterface interface {
 	ListKubernetesRoutesForNetwork(ctx context.Context, project, networkName, namespace string) ([]string, error)
 	DeleteFirewallRule(ctx context.Context, project, firewallRuleName string) error
 	DeleteRoute(ctx context.Context, project, routeName string) error
+	ProbeBucket(ctx context.Context, bucketName, objectName string) error
+	PurgeBucket(ctx context.Context, bucketName string) error
+	ListNetworkResources(ctx context.Context, project, region string, names sets.String) (map[string]string, error)
+	GetQuotas(ctx context.Context, project, region string) (map[string]Quota, error)
+	ListServiceAccountKeys(ctx context.Context, serviceAccountEmail string) ([]ServiceAccountKey, error)
+	CreateServiceAccountKey(ctx context.Context, serviceAccountEmail string) (*ServiceAccountKey, error)
+	DeleteServiceAccountKey(ctx context.Context, keyName string) error
+}
+
+// Quota is the limit and the usage of a Compute Engine quota.
+type Quota struct {
+	Limit int64
+	Usage int64
+}
+
+// ServiceAccountKey is a user-managed key of a service account. The PrivateKeyData is only set for newly created keys
+// and contains the service account JSON document.
+type ServiceAccountKey struct {
+	Name           string
+	PrivateKeyData []byte
 }
 
 const (
 	fwNamePrefix string = "k8s"
 	routePrefix  string = "shoot--"
+
+	storageURL       string = "https://storage.googleapis.com/storage/v1"
+	storageUploadURL string = "https://storage.googleapis.com/upload/storage/v1"
+	iamURL           string = "https://iam.googleapis.com/v1"
 )
 
 // ListKubernetesFirewallRulesForNetwork returns a list of all k8s created firewall rules within the shoot network.
@@ -79,3 +112,221 @@ func (c *Client) DeleteRoute(ctx context.Context, project, route string) error {
 	_, err := c.computeService.Routes.Delete(project, route).Context(ctx).Do()
 	return err
 }
+
+// ListNetworkResources returns the networks, the subnetworks and routers of the <region>, and the firewall rules of
+// the <project> whose name is contained in <names>. The result maps the names of the resources to their types.
+func (c *Client) ListNetworkResources(ctx context.Context, project, region string, names sets.String) (map[string]string, error) {
+	resources := map[string]string{}
+
+	if err := c.computeService.Networks.List(project).Pages(ctx, func(page *compute.NetworkList) error {
+		for _, network := range page.Items {
+			if names.Has(network.Name) {
+				resources[network.Name] = "network"
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := c.computeService.Subnetworks.List(project, region).Pages(ctx, func(page *compute.SubnetworkList) error {
+		for _, subnetwork := range page.Items {
+			if names.Has(subnetwork.Name) {
+				resources[subnetwork.Name] = "subnetwork"
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := c.computeService.Routers.List(project, region).Pages(ctx, func(page *compute.RouterList) error {
+		for _, router := range page.Items {
+			if names.Has(router.Name) {
+				resources[router.Name] = "router"
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := c.computeService.Firewalls.List(project).Pages(ctx, func(page *compute.FirewallList) error {
+		for _, firewall := range page.Items {
+			if names.Has(firewall.Name) {
+				resources[firewall.Name] = "firewall"
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return resources, nil
+}
+
+// GetQuotas returns the Compute Engine quotas of the <project> (e.g., NETWORKS) and of its <region> (e.g., INSTANCES or
+// STATIC_ADDRESSES) mapped by their metric names. The quotas of the region take precedence over the ones of the project.
+func (c *Client) GetQuotas(ctx context.Context, project, region string) (map[string]Quota, error) {
+	projectInfo, err := c.computeService.Projects.Get(project).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	regionInfo, err := c.computeService.Regions.Get(project, region).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	quotas := map[string]Quota{}
+	for _, quota := range append(projectInfo.Quotas, regionInfo.Quotas...) {
+		quotas[quota.Metric] = Quota{Limit: int64(quota.Limit), Usage: int64(quota.Usage)}
+	}
+	return quotas, nil
+}
+
+// ProbeBucket verifies that the storage bucket <bucketName> exists, that it is writable, and that the service account
+// of the Client is valid by writing and deleting the object <objectName>.
+func (c *Client) ProbeBucket(ctx context.Context, bucketName, objectName string) error {
+	var (
+		uploadURL = fmt.Sprintf("%s/b/%s/o?uploadType=media&name=%s", storageUploadURL, url.PathEscape(bucketName), url.QueryEscape(objectName))
+		objectURL = fmt.Sprintf("%s/b/%s/o/%s", storageURL, url.PathEscape(bucketName), url.PathEscape(objectName))
+	)
+
+	if err := c.doRequest(ctx, http.MethodPost, uploadURL, []byte(time.Now().UTC().Format(time.RFC3339))); err != nil {
+		return fmt.Errorf("could not write object %q to bucket %q: %v", objectName, bucketName, err)
+	}
+	if err := c.doRequest(ctx, http.MethodDelete, objectURL, nil); err != nil {
+		return fmt.Errorf("could not delete object %q from bucket %q: %v", objectName, bucketName, err)
+	}
+	return nil
+}
+
+// objectList is the response of the Cloud Storage API for listing the objects of a bucket.
+type objectList struct {
+	Items []struct {
+		Name string `json:"name"`
+	} `json:"items"`
+	NextPageToken string `json:"nextPageToken"`
+}
+
+// PurgeBucket deletes all objects of the storage bucket <bucketName> and the bucket itself. It does not return an
+// error if the bucket does not exist.
+func (c *Client) PurgeBucket(ctx context.Context, bucketName string) error {
+	var (
+		bucketURL = fmt.Sprintf("%s/b/%s", storageURL, url.PathEscape(bucketName))
+		query     = url.Values{"fields": []string{"items(name),nextPageToken"}}
+	)
+
+	for {
+		var objects objectList
+		if err := c.doRequestInto(ctx, http.MethodGet, fmt.Sprintf("%s/o?%s", bucketURL, query.Encode()), nil, &objects); err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("could not list objects of bucket %q: %v", bucketName, err)
+		}
+
+		for _, object := range objects.Items {
+			if err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("%s/o/%s", bucketURL, url.PathEscape(object.Name)), nil); err != nil && !isNotFound(err) {
+				return fmt.Errorf("could not delete object %q from bucket %q: %v", object.Name, bucketName, err)
+			}
+		}
+
+		if len(objects.NextPageToken) == 0 {
+			break
+		}
+		query.Set("pageToken", objects.NextPageToken)
+	}
+
+	if err := c.doRequest(ctx, http.MethodDelete, bucketURL, nil); err != nil && !isNotFound(err) {
+		return fmt.Errorf("could not delete bucket %q: %v", bucketName, err)
+	}
+	return nil
+}
+
+// serviceAccountKey is the representation of a service account key in the IAM API.
+type serviceAccountKey struct {
+	Name           string `json:"name"`
+	PrivateKeyData string `json:"privateKeyData"`
+}
+
+// ListServiceAccountKeys returns the user-managed keys of the service account with the given <serviceAccountEmail>.
+// The private key data of the keys is not returned by the IAM API.
+func (c *Client) ListServiceAccountKeys(ctx context.Context, serviceAccountEmail string) ([]ServiceAccountKey, error) {
+	var (
+		keysURL = fmt.Sprintf("%s/keys?keyTypes=USER_MANAGED", serviceAccountURL(serviceAccountEmail))
+		list    struct {
+			Keys []serviceAccountKey `json:"keys"`
+		}
+	)
+
+	if err := c.doRequestInto(ctx, http.MethodGet, keysURL, nil, &list); err != nil {
+		return nil, fmt.Errorf("could not list keys of service account %q: %v", serviceAccountEmail, err)
+	}
+
+	keys := make([]ServiceAccountKey, 0, len(list.Keys))
+	for _, key := range list.Keys {
+		keys = append(keys, ServiceAccountKey{Name: key.Name})
+	}
+	return keys, nil
+}
+
+// CreateServiceAccountKey creates a new key for the service account with the given <serviceAccountEmail> and returns
+// it together with its private key data.
+func (c *Client) CreateServiceAccountKey(ctx context.Context, serviceAccountEmail string) (*ServiceAccountKey, error) {
+	var key serviceAccountKey
+	if err := c.doRequestInto(ctx, http.MethodPost, serviceAccountURL(serviceAccountEmail)+"/keys", nil, &key); err != nil {
+		return nil, fmt.Errorf("could not create key for service account %q: %v", serviceAccountEmail, err)
+	}
+
+	privateKeyData, err := base64.StdEncoding.DecodeString(key.PrivateKeyData)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode private key data of key %q: %v", key.Name, err)
+	}
+	return &ServiceAccountKey{Name: key.Name, PrivateKeyData: privateKeyData}, nil
+}
+
+// DeleteServiceAccountKey deletes the service account key with the given <keyName>
+// ("projects/<project>/serviceAccounts/<email>/keys/<id>"). If it does not exist, no error is returned.
+func (c *Client) DeleteServiceAccountKey(ctx context.Context, keyName string) error {
+	if err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("%s/%s", iamURL, keyName), nil); err != nil && !isNotFound(err) {
+		return fmt.Errorf("could not delete service account key %q: %v", keyName, err)
+	}
+	return nil
+}
+
+// serviceAccountURL returns the IAM API URL of the service account with the given <serviceAccountEmail>. The project
+// is inferred from the email address.
+func serviceAccountURL(serviceAccountEmail string) string {
+	return fmt.Sprintf("%s/projects/-/serviceAccounts/%s", iamURL, url.PathEscape(serviceAccountEmail))
+}
+
+// doRequest sends a request with the given <method> and <body> to the Google API <requestURL>.
+func (c *Client) doRequest(ctx context.Context, method, requestURL string, body []byte) error {
+	return c.doRequestInto(ctx, method, requestURL, body, nil)
+}
+
+// doRequestInto sends a request with the given <method> and <body> to the Google API <requestURL> and decodes the
+// JSON response into <into> (if it is not nil).
+func (c *Client) doRequestInto(ctx context.Context, method, requestURL string, body []byte, into interface{}) error {
+	req, err := http.NewRequest(method, requestURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.oauthClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer googleapi.CloseBody(resp)
+
+	if err := googleapi.CheckResponse(resp); err != nil {
+		return err
+	}
+	if into == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(into)
+}
+
+func isNotFound(err error) bool {
+	apiErr, ok := err.(*googleapi.Error)
+	return ok && apiErr.Code == http.StatusNotFound
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+
+	. "github.com/gardener/gardener/pkg/client/gcp"
+	"github.com/gardener/gardener/pkg/utils/test"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("client", func() {
+	Describe("#PurgeBucket", func() {
+		const (
+			bucketName = "backup"
+			bucketPath = "/storage/v1/b/backup"
+		)
+
+		var (
+			ctx    = context.TODO()
+			server *httptest.Server
+			client ClientInterface
+
+			lock     sync.Mutex
+			objects  map[string]bool
+			requests []string
+			handler  http.HandlerFunc
+		)
+
+		BeforeEach(func() {
+			objects = map[string]bool{"etcd/full": true, "etcd/incr 1": true}
+			requests = nil
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				lock.Lock()
+				defer lock.Unlock()
+
+				Expect(r.Host).To(Equal("storage.googleapis.com"))
+				requests = append(requests, r.Method+" "+r.URL.EscapedPath())
+
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == bucketPath+"/o":
+					// Each page of the listing contains a single object to test the pagination.
+					var keys []string
+					for key := range objects {
+						keys = append(keys, key)
+					}
+					sort.Strings(keys)
+
+					list := map[string]interface{}{}
+					if len(keys) > 0 {
+						list["items"] = []map[string]string{{"name": keys[0]}}
+					}
+					if len(keys) > 1 {
+						list["nextPageToken"] = "next"
+					}
+					Expect(json.NewEncoder(w).Encode(list)).To(Succeed())
+				case r.Method == http.MethodDelete && r.URL.Path == bucketPath:
+					w.WriteHeader(http.StatusNoContent)
+				case r.Method == http.MethodDelete:
+					delete(objects, r.URL.Path[len(bucketPath+"/o/"):])
+					w.WriteHeader(http.StatusNoContent)
+				default:
+					w.WriteHeader(http.StatusMethodNotAllowed)
+				}
+			}
+
+			server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { handler(w, r) }))
+			client = ExportNewHTTPClient(test.NewRedirectingHTTPClient(server))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should delete all objects and the bucket", func() {
+			Expect(client.PurgeBucket(ctx, bucketName)).To(Succeed())
+
+			Expect(objects).To(BeEmpty())
+			Expect(requests).To(ContainElement("DELETE " + bucketPath + "/o/etcd%2Fincr%201"))
+			Expect(requests).To(ContainElement("DELETE " + bucketPath + "/o/etcd%2Ffull"))
+			Expect(requests[len(requests)-1]).To(Equal("DELETE " + bucketPath))
+		})
+
+		It("should not fail if the bucket does not exist", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			}
+
+			Expect(client.PurgeBucket(ctx, bucketName)).To(Succeed())
+		})
+
+		It("should fail if the objects cannot be listed", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+			}
+
+			Expect(client.PurgeBucket(ctx, bucketName)).To(MatchError(ContainSubstring("could not list objects")))
+		})
+	})
+
+	Describe("service account keys", func() {
+		const (
+			serviceAccountEmail = "gardener-mcm@project.iam.gserviceaccount.com"
+			keysPath            = "/v1/projects/-/serviceAccounts/gardener-mcm@project.iam.gserviceaccount.com/keys"
+			keyName             = "projects/project/serviceAccounts/gardener-mcm@project.iam.gserviceaccount.com/keys/1"
+		)
+
+		var (
+			ctx    = context.TODO()
+			server *httptest.Server
+			client ClientInterface
+
+			requests []string
+			handler  http.HandlerFunc
+		)
+
+		BeforeEach(func() {
+			requests = nil
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Host).To(Equal("iam.googleapis.com"))
+				requests = append(requests, r.Method+" "+r.URL.Path)
+
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == keysPath:
+					Expect(r.URL.Query().Get("keyTypes")).To(Equal("USER_MANAGED"))
+					Expect(json.NewEncoder(w).Encode(map[string]interface{}{
+						"keys": []map[string]string{{"name": keyName}},
+					})).To(Succeed())
+				case r.Method == http.MethodPost && r.URL.Path == keysPath:
+					Expect(json.NewEncoder(w).Encode(map[string]string{
+						"name":           keyName,
+						"privateKeyData": base64.StdEncoding.EncodeToString([]byte(`{"type":"service_account"}`)),
+					})).To(Succeed())
+				case r.Method == http.MethodDelete:
+					w.WriteHeader(http.StatusNotFound)
+				default:
+					w.WriteHeader(http.StatusMethodNotAllowed)
+				}
+			}
+
+			server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { handler(w, r) }))
+			client = ExportNewHTTPClient(test.NewRedirectingHTTPClient(server))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should list the user-managed keys", func() {
+			Expect(client.ListServiceAccountKeys(ctx, serviceAccountEmail)).To(Equal([]ServiceAccountKey{{Name: keyName}}))
+		})
+
+		It("should create a key and decode its private key data", func() {
+			Expect(client.CreateServiceAccountKey(ctx, serviceAccountEmail)).To(Equal(&ServiceAccountKey{
+				Name:           keyName,
+				PrivateKeyData: []byte(`{"type":"service_account"}`),
+			}))
+		})
+
+		It("should not fail if the key to delete does not exist", func() {
+			Expect(client.DeleteServiceAccountKey(ctx, keyName)).To(Succeed())
+			Expect(requests).To(Equal([]string{"DELETE /v1/" + keyName}))
+		})
+
+		It("should fail if the key cannot be created", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+			}
+
+			_, err := client.CreateServiceAccountKey(ctx, serviceAccountEmail)
+			Expect(err).To(MatchError(ContainSubstring("could not create key")))
+		})
+	})
+})
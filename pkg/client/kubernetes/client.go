@@ -94,9 +94,17 @@ func NewClientFromSecret(k8sClient Interface, namespace, secretName string, opts
 }
 
 // NewClientFromSecretObject creates a new Client struct for a given Kubernetes Secret object. The Secret must
-// contain a field "kubeconfig" which will be used.
+// contain a field "kubeconfig" which will be used. If the Secret also contains a field "tokenAudience" then the
+// client authenticates with short-lived tokens requested for this audience (see SetTokenRequester).
 func NewClientFromSecretObject(secret *corev1.Secret, opts client.Options) (Interface, error) {
 	if kubeconfig, ok := secret.Data[KubeConfig]; ok {
+		if audience, ok := secret.Data[TokenAudience]; ok {
+			config, err := restConfigFromTokenAudienceSecret(kubeconfig, string(audience))
+			if err != nil {
+				return nil, err
+			}
+			return NewForConfig(config, opts)
+		}
 		return NewClientFromBytes(kubeconfig, opts)
 	}
 	return nil, errors.New("the secret does not contain a field with name 'kubeconfig'")
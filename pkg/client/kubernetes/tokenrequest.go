@@ -0,0 +1,143 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	kubernetesclientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// TokenAudience is the key in a kubeconfig secret which marks the kubeconfig as credential-less. Instead of static
+// credentials, clients created from such a secret authenticate with short-lived service account tokens of the
+// Garden cluster which are requested for the given audience.
+const TokenAudience = "tokenAudience"
+
+// TokenRequester requests short-lived tokens for a service account of the Garden cluster via the TokenRequest API.
+// The tokens are cached per audience and renewed automatically after 80% of their validity has passed.
+type TokenRequester struct {
+	client             kubernetesclientset.Interface
+	namespace          string
+	serviceAccountName string
+	expirationSeconds  int64
+	now                func() time.Time
+
+	lock    sync.Mutex
+	sources map[string]oauth2.TokenSource
+}
+
+// NewTokenRequester creates a new TokenRequester for the service account <namespace>/<serviceAccountName> which
+// requests tokens with the given <expiration>.
+func NewTokenRequester(client kubernetesclientset.Interface, namespace, serviceAccountName string, expiration time.Duration) *TokenRequester {
+	return &TokenRequester{
+		client:             client,
+		namespace:          namespace,
+		serviceAccountName: serviceAccountName,
+		expirationSeconds:  int64(expiration / time.Second),
+		now:                time.Now,
+		sources:            map[string]oauth2.TokenSource{},
+	}
+}
+
+// TokenSource returns the (cached) token source for the given <audience>.
+func (r *TokenRequester) TokenSource(audience string) oauth2.TokenSource {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if source, ok := r.sources[audience]; ok {
+		return source
+	}
+	source := oauth2.ReuseTokenSource(nil, &tokenRequestSource{r, audience})
+	r.sources[audience] = source
+	return source
+}
+
+type tokenRequestSource struct {
+	requester *TokenRequester
+	audience  string
+}
+
+// Token requests a new token for the service account. Its expiry is set to 80% of the validity returned by the
+// API server, so that the token is renewed well before it actually expires.
+func (s *tokenRequestSource) Token() (*oauth2.Token, error) {
+	var (
+		r            = s.requester
+		now          = r.now()
+		tokenRequest = &authenticationv1.TokenRequest{
+			Spec: authenticationv1.TokenRequestSpec{
+				Audiences:         []string{s.audience},
+				ExpirationSeconds: &r.expirationSeconds,
+			},
+		}
+	)
+
+	result, err := r.client.CoreV1().ServiceAccounts(r.namespace).CreateToken(r.serviceAccountName, tokenRequest)
+	if err != nil {
+		return nil, fmt.Errorf("could not request token for service account %s/%s: %v", r.namespace, r.serviceAccountName, err)
+	}
+
+	validity := result.Status.ExpirationTimestamp.Time.Sub(now)
+	return &oauth2.Token{
+		AccessToken: result.Status.Token,
+		TokenType:   "Bearer",
+		Expiry:      now.Add(validity * 4 / 5),
+	}, nil
+}
+
+var (
+	tokenRequester     *TokenRequester
+	tokenRequesterLock sync.RWMutex
+)
+
+// SetTokenRequester sets the TokenRequester which is used to authenticate clients created from kubeconfig secrets
+// containing the TokenAudience key.
+func SetTokenRequester(requester *TokenRequester) {
+	tokenRequesterLock.Lock()
+	defer tokenRequesterLock.Unlock()
+	tokenRequester = requester
+}
+
+// restConfigFromTokenAudienceSecret creates a rest config from the credential-less <kubeconfig> which authenticates
+// with tokens requested for <audience>.
+func restConfigFromTokenAudienceSecret(kubeconfig []byte, audience string) (*rest.Config, error) {
+	tokenRequesterLock.RLock()
+	requester := tokenRequester
+	tokenRequesterLock.RUnlock()
+
+	if requester == nil {
+		return nil, errors.New("the secret requires a token for audience '" + audience + "' but token requests are not configured")
+	}
+
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	config.BearerToken = ""
+	config.BearerTokenFile = ""
+
+	source := requester.TokenSource(audience)
+	config.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+		return &oauth2.Transport{Source: source, Base: rt}
+	}
+	return config, nil
+}
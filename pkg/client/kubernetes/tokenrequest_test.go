@@ -0,0 +1,85 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes_test
+
+import (
+	"time"
+
+	. "github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var _ = Describe("TokenRequester", func() {
+	var (
+		client   *fake.Clientset
+		requests []*authenticationv1.TokenRequest
+		validity time.Duration
+	)
+
+	BeforeEach(func() {
+		requests = nil
+		validity = time.Hour
+
+		client = fake.NewSimpleClientset()
+		client.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			Expect(action.GetSubresource()).To(Equal("token"))
+			Expect(action.GetNamespace()).To(Equal("garden"))
+
+			tokenRequest := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenRequest)
+			requests = append(requests, tokenRequest)
+			tokenRequest.Status = authenticationv1.TokenRequestStatus{
+				Token:               "token",
+				ExpirationTimestamp: metav1.NewTime(time.Now().Add(validity)),
+			}
+			return true, tokenRequest, nil
+		})
+	})
+
+	Describe("#TokenSource", func() {
+		It("should request a token for the audience and reuse it", func() {
+			source := NewTokenRequester(client, "garden", "gardener", time.Hour).TokenSource("seed")
+
+			token, err := source.Token()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(token.AccessToken).To(Equal("token"))
+			Expect(token.Expiry).To(BeTemporally("~", time.Now().Add(48*time.Minute), time.Minute))
+
+			_, err = source.Token()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(requests).To(HaveLen(1))
+			Expect(requests[0].Spec.Audiences).To(ConsistOf("seed"))
+			Expect(*requests[0].Spec.ExpirationSeconds).To(Equal(int64(3600)))
+		})
+
+		It("should renew expired tokens", func() {
+			validity = 0
+			source := NewTokenRequester(client, "garden", "gardener", time.Hour).TokenSource("seed")
+
+			_, err := source.Token()
+			Expect(err).NotTo(HaveOccurred())
+			_, err = source.Token()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(requests).To(HaveLen(2))
+		})
+	})
+})
@@ -0,0 +1,279 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openstack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils/ratelimiter"
+
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const requestTimeout = 30 * time.Second
+
+// Credentials are the credentials which are used to authenticate against the OpenStack Identity service (Keystone).
+// Either the application credential or the user name and password must be set.
+type Credentials struct {
+	DomainName                  string
+	TenantName                  string
+	UserName                    string
+	UserDomainName              string
+	Password                    string
+	ApplicationCredentialID     string
+	ApplicationCredentialSecret string
+}
+
+// NetworkClient is a client for the OpenStack Networking service (Neutron) which authenticates against Keystone v3.
+type NetworkClient struct {
+	authURL     string
+	region      string
+	credentials Credentials
+	httpClient  *http.Client
+}
+
+// NewNetworkClient creates a new NetworkClient for the region <region> which authenticates with the given
+// <credentials> against the Keystone v3 endpoint <authURL>.
+func NewNetworkClient(authURL, region string, credentials Credentials) *NetworkClient {
+	return NewNetworkClientWithRateLimiter(authURL, region, credentials, nil)
+}
+
+// NewNetworkClientWithRateLimiter creates a new NetworkClient like NewNetworkClient whose requests wait for a token of
+// the given <limiter> before they are sent. The requests are not limited if <limiter> is nil.
+func NewNetworkClientWithRateLimiter(authURL, region string, credentials Credentials, limiter *rate.Limiter) *NetworkClient {
+	return &NetworkClient{
+		authURL:     strings.TrimSuffix(authURL, "/"),
+		region:      region,
+		credentials: credentials,
+		httpClient:  &http.Client{Timeout: requestTimeout, Transport: ratelimiter.NewRoundTripper(limiter, nil)},
+	}
+}
+
+// networkResourceTypes maps the Neutron collection names to the types of the resources they contain.
+var networkResourceTypes = map[string]string{
+	"networks":        "network",
+	"subnets":         "subnet",
+	"routers":         "router",
+	"security-groups": "security_group",
+}
+
+// ListNetworkResources lists the networks, subnets, routers and security groups whose name is contained in <names>.
+// It returns a map of the resource IDs to their types.
+func (c *NetworkClient) ListNetworkResources(ctx context.Context, names sets.String) (map[string]string, error) {
+	token, endpoint, err := c.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make(map[string]string)
+	for collection, resourceType := range networkResourceTypes {
+		for _, name := range names.List() {
+			list, err := c.listNetworkResources(ctx, token, endpoint, collection, name)
+			if err != nil {
+				return nil, err
+			}
+			for _, resource := range list {
+				resources[resource.ID] = resourceType
+			}
+		}
+	}
+	return resources, nil
+}
+
+type networkResource struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (c *NetworkClient) listNetworkResources(ctx context.Context, token, endpoint, collection, name string) ([]networkResource, error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v2.0/%s?name=%s", endpoint, collection, url.QueryEscape(name)), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Auth-Token", token)
+	req.Header.Set("Accept", "application/json")
+
+	statusCode, body, err := c.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not list %s with name %q: status %d: %s", collection, name, statusCode, string(body))
+	}
+
+	// The Neutron API wraps the list into a field named after the collection, e.g. "security_groups".
+	var list map[string][]networkResource
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, err
+	}
+	return list[strings.Replace(collection, "-", "_", -1)], nil
+}
+
+type authRequest struct {
+	Auth authRequestAuth `json:"auth"`
+}
+
+type authRequestAuth struct {
+	Identity authRequestIdentity `json:"identity"`
+	Scope    *authRequestScope   `json:"scope,omitempty"`
+}
+
+type authRequestIdentity struct {
+	Methods               []string                          `json:"methods"`
+	Password              *authRequestPassword              `json:"password,omitempty"`
+	ApplicationCredential *authRequestApplicationCredential `json:"application_credential,omitempty"`
+}
+
+type authRequestPassword struct {
+	User authRequestUser `json:"user"`
+}
+
+type authRequestUser struct {
+	Name     string            `json:"name"`
+	Domain   authRequestDomain `json:"domain"`
+	Password string            `json:"password"`
+}
+
+type authRequestApplicationCredential struct {
+	ID     string `json:"id"`
+	Secret string `json:"secret"`
+}
+
+type authRequestScope struct {
+	Project authRequestProject `json:"project"`
+}
+
+type authRequestProject struct {
+	Name   string            `json:"name"`
+	Domain authRequestDomain `json:"domain"`
+}
+
+type authRequestDomain struct {
+	Name string `json:"name"`
+}
+
+type authResponse struct {
+	Token struct {
+		Catalog []struct {
+			Type      string `json:"type"`
+			Endpoints []struct {
+				Interface string `json:"interface"`
+				Region    string `json:"region"`
+				RegionID  string `json:"region_id"`
+				URL       string `json:"url"`
+			} `json:"endpoints"`
+		} `json:"catalog"`
+	} `json:"token"`
+}
+
+// authenticate requests a token from Keystone and returns it together with the public endpoint of the network
+// service in the region of the client.
+func (c *NetworkClient) authenticate(ctx context.Context) (string, string, error) {
+	var auth authRequest
+	if len(c.credentials.ApplicationCredentialID) > 0 && len(c.credentials.ApplicationCredentialSecret) > 0 {
+		// Application credentials are always scoped to the project they have been created for.
+		auth.Auth.Identity = authRequestIdentity{
+			Methods: []string{"application_credential"},
+			ApplicationCredential: &authRequestApplicationCredential{
+				ID:     c.credentials.ApplicationCredentialID,
+				Secret: c.credentials.ApplicationCredentialSecret,
+			},
+		}
+	} else {
+		userDomainName := c.credentials.UserDomainName
+		if len(userDomainName) == 0 {
+			userDomainName = c.credentials.DomainName
+		}
+		auth.Auth.Identity = authRequestIdentity{
+			Methods: []string{"password"},
+			Password: &authRequestPassword{
+				User: authRequestUser{
+					Name:     c.credentials.UserName,
+					Domain:   authRequestDomain{Name: userDomainName},
+					Password: c.credentials.Password,
+				},
+			},
+		}
+		auth.Auth.Scope = &authRequestScope{
+			Project: authRequestProject{
+				Name:   c.credentials.TenantName,
+				Domain: authRequestDomain{Name: c.credentials.DomainName},
+			},
+		}
+	}
+
+	data, err := json.Marshal(auth)
+	if err != nil {
+		return "", "", err
+	}
+	req, err := http.NewRequest(http.MethodPost, c.authURL+"/auth/tokens", bytes.NewReader(data))
+	if err != nil {
+		return "", "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", "", err
+	}
+	if resp.StatusCode != http.StatusCreated {
+		return "", "", fmt.Errorf("could not authenticate against %q: status %d: %s", c.authURL, resp.StatusCode, string(body))
+	}
+
+	var token authResponse
+	if err := json.Unmarshal(body, &token); err != nil {
+		return "", "", err
+	}
+	for _, service := range token.Token.Catalog {
+		if service.Type != "network" {
+			continue
+		}
+		for _, endpoint := range service.Endpoints {
+			if endpoint.Interface == "public" && (endpoint.Region == c.region || endpoint.RegionID == c.region) {
+				return resp.Header.Get("X-Subject-Token"), strings.TrimSuffix(endpoint.URL, "/"), nil
+			}
+		}
+	}
+	return "", "", fmt.Errorf("no public network endpoint found for region %q in the service catalog", c.region)
+}
+
+func (c *NetworkClient) do(ctx context.Context, req *http.Request) (int, []byte, error) {
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, err
+	}
+	return resp.StatusCode, body, nil
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openstack_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/gardener/gardener/pkg/client/openstack"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var _ = Describe("network", func() {
+	Describe("#ListNetworkResources", func() {
+		var (
+			ctx    = context.TODO()
+			server *httptest.Server
+
+			authStatus int
+			identity   map[string]interface{}
+			scope      map[string]interface{}
+		)
+
+		BeforeEach(func() {
+			authStatus = http.StatusCreated
+			identity = nil
+			scope = nil
+
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodPost && r.URL.Path == "/v3/auth/tokens":
+					var body struct {
+						Auth struct {
+							Identity map[string]interface{} `json:"identity"`
+							Scope    map[string]interface{} `json:"scope"`
+						} `json:"auth"`
+					}
+					Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+					identity, scope = body.Auth.Identity, body.Auth.Scope
+
+					w.Header().Set("X-Subject-Token", "token")
+					w.WriteHeader(authStatus)
+					fmt.Fprintf(w, `{"token":{"catalog":[
+						{"type":"compute","endpoints":[{"interface":"public","region":"region","url":"http://compute"}]},
+						{"type":"network","endpoints":[
+							{"interface":"internal","region":"region","url":"http://internal"},
+							{"interface":"public","region":"other","url":"http://other"},
+							{"interface":"public","region":"region","url":"%s/network/"}
+						]}
+					]}}`, "http://"+r.Host)
+				case r.Method == http.MethodGet && r.URL.Path == "/network/v2.0/networks":
+					Expect(r.Header.Get("X-Auth-Token")).To(Equal("token"))
+					Expect(r.URL.Query().Get("name")).To(Equal("shoot--foo--bar"))
+					w.Write([]byte(`{"networks":[{"id":"net-1","name":"shoot--foo--bar"}]}`))
+				case r.Method == http.MethodGet && r.URL.Path == "/network/v2.0/subnets":
+					w.Write([]byte(`{"subnets":[{"id":"subnet-1","name":"shoot--foo--bar"}]}`))
+				case r.Method == http.MethodGet && r.URL.Path == "/network/v2.0/routers":
+					w.Write([]byte(`{"routers":[]}`))
+				case r.Method == http.MethodGet && r.URL.Path == "/network/v2.0/security-groups":
+					w.Write([]byte(`{"security_groups":[{"id":"sg-1","name":"shoot--foo--bar"}]}`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should authenticate with user name and password and list the network resources", func() {
+			client := NewNetworkClient(server.URL+"/v3/", "region", Credentials{
+				DomainName: "domain",
+				TenantName: "tenant",
+				UserName:   "user",
+				Password:   "password",
+			})
+
+			resources, err := client.ListNetworkResources(ctx, sets.NewString("shoot--foo--bar"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resources).To(Equal(map[string]string{
+				"net-1":    "network",
+				"subnet-1": "subnet",
+				"sg-1":     "security_group",
+			}))
+
+			Expect(identity).To(HaveKeyWithValue("methods", ConsistOf("password")))
+			Expect(identity).To(HaveKeyWithValue("password", HaveKeyWithValue("user", And(
+				HaveKeyWithValue("name", "user"),
+				HaveKeyWithValue("password", "password"),
+				HaveKeyWithValue("domain", HaveKeyWithValue("name", "domain")),
+			))))
+			Expect(scope).To(HaveKeyWithValue("project", And(
+				HaveKeyWithValue("name", "tenant"),
+				HaveKeyWithValue("domain", HaveKeyWithValue("name", "domain")),
+			)))
+		})
+
+		It("should authenticate with the application credential", func() {
+			client := NewNetworkClient(server.URL+"/v3", "region", Credentials{
+				DomainName:                  "domain",
+				TenantName:                  "tenant",
+				ApplicationCredentialID:     "id",
+				ApplicationCredentialSecret: "secret",
+			})
+
+			_, err := client.ListNetworkResources(ctx, sets.NewString("shoot--foo--bar"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(identity).To(HaveKeyWithValue("methods", ConsistOf("application_credential")))
+			Expect(identity).To(HaveKeyWithValue("application_credential", And(
+				HaveKeyWithValue("id", "id"),
+				HaveKeyWithValue("secret", "secret"),
+			)))
+			Expect(scope).To(BeNil())
+		})
+
+		It("should fail if the authentication is rejected", func() {
+			authStatus = http.StatusUnauthorized
+			client := NewNetworkClient(server.URL+"/v3", "region", Credentials{UserName: "user", Password: "wrong"})
+
+			_, err := client.ListNetworkResources(ctx, sets.NewString("shoot--foo--bar"))
+			Expect(err).To(MatchError(ContainSubstring("status 401")))
+		})
+
+		It("should fail if there is no network endpoint for the region", func() {
+			client := NewNetworkClient(server.URL+"/v3", "unknown", Credentials{UserName: "user", Password: "password"})
+
+			_, err := client.ListNetworkResources(ctx, sets.NewString("shoot--foo--bar"))
+			Expect(err).To(MatchError(ContainSubstring(`region "unknown"`)))
+		})
+	})
+})
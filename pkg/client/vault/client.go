@@ -0,0 +1,259 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vault
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/logger"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// NewClientFromSecret creates a new Client for the Vault described by the given secret.
+func NewClientFromSecret(secret *corev1.Secret) (ClientInterface, error) {
+	address := strings.TrimSuffix(string(secret.Data[DataKeyAddress]), "/")
+	if len(address) == 0 {
+		return nil, fmt.Errorf("vault secret %s/%s does not contain key %q", secret.Namespace, secret.Name, DataKeyAddress)
+	}
+
+	token, role := string(secret.Data[DataKeyToken]), string(secret.Data[DataKeyRole])
+	if len(token) == 0 && len(role) == 0 {
+		return nil, fmt.Errorf("vault secret %s/%s must contain either key %q or key %q", secret.Namespace, secret.Name, DataKeyToken, DataKeyRole)
+	}
+
+	tlsConfig := &tls.Config{}
+	if caBundle := secret.Data[DataKeyCABundle]; len(caBundle) > 0 {
+		rootCAs := x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(caBundle) {
+			return nil, fmt.Errorf("could not parse CA bundle of vault secret %s/%s", secret.Namespace, secret.Name)
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	authPath := DefaultAuthPath
+	if v := string(secret.Data[DataKeyAuthPath]); len(v) > 0 {
+		authPath = strings.Trim(v, "/")
+	}
+	pathPrefix := DefaultPathPrefix
+	if v := string(secret.Data[DataKeyPathPrefix]); len(v) > 0 {
+		pathPrefix = strings.Trim(v, "/")
+	}
+
+	return &Client{
+		address:    address,
+		token:      token,
+		role:       role,
+		authPath:   authPath,
+		pathPrefix: pathPrefix,
+		httpClient: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+		leases: make(map[string]*lease),
+	}, nil
+}
+
+// PathPrefix returns the path below which the credentials of the project namespaces are stored.
+func (c *Client) PathPrefix() string {
+	return c.pathPrefix
+}
+
+// Read returns the data of the secret at the given path which has been issued for the given <key>, e.g. the key of a
+// Shoot. The data of secrets of the KV secrets engine version 2 is unwrapped. Secrets with a lease (e.g. dynamic
+// credentials) are cached per key and path until half of their lease duration has elapsed. When they are read again
+// afterwards, new credentials are requested and the lease of the previous ones is revoked.
+func (c *Client) Read(key, path string) (map[string]interface{}, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	var (
+		now      = Now()
+		cacheKey = key + "|" + path
+	)
+
+	cached, ok := c.leases[cacheKey]
+	if ok && now.Before(cached.renewTime) {
+		return copyData(cached.data), nil
+	}
+
+	token, tokenExpirationTime, err := c.login(now)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(http.MethodGet, "/v1/"+strings.TrimPrefix(path, "/"), token, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, fmt.Errorf("vault returned no data for path %q", path)
+	}
+
+	data := resp.Data
+	// The KV secrets engine version 2 wraps the secret data along with its metadata.
+	if wrapped, ok := resp.Data["data"].(map[string]interface{}); ok {
+		if _, ok := resp.Data["metadata"]; ok {
+			data = wrapped
+		}
+	}
+
+	if ok && len(cached.leaseID) > 0 {
+		if err := c.revoke(token, cached.leaseID); err != nil {
+			logger.Logger.Warnf("Could not revoke the lease %s of the previous credentials read from Vault: %v", cached.leaseID, err)
+		}
+	}
+	delete(c.leases, cacheKey)
+
+	if len(resp.LeaseID) > 0 && resp.LeaseDuration > 0 {
+		// Leases are revoked along with the token which requested them, hence the credentials must not be used longer
+		// than the token.
+		expirationTime := now.Add(time.Duration(resp.LeaseDuration) * time.Second)
+		if !tokenExpirationTime.IsZero() && tokenExpirationTime.Before(expirationTime) {
+			expirationTime = tokenExpirationTime
+		}
+		c.leases[cacheKey] = &lease{
+			leaseID:   resp.LeaseID,
+			data:      data,
+			renewTime: now.Add(expirationTime.Sub(now) / 2),
+		}
+	}
+
+	return copyData(data), nil
+}
+
+// Revoke revokes the leases of all secrets which have been issued for the given <key>. It is called when the
+// credentials are not used anymore, e.g. after the Shoot has been deleted.
+func (c *Client) Revoke(key string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	leaseIDs := map[string]string{}
+	for cacheKey, cached := range c.leases {
+		if strings.HasPrefix(cacheKey, key+"|") {
+			leaseIDs[cacheKey] = cached.leaseID
+		}
+	}
+	if len(leaseIDs) == 0 {
+		return nil
+	}
+
+	token, _, err := c.login(Now())
+	if err != nil {
+		return err
+	}
+
+	for cacheKey, leaseID := range leaseIDs {
+		if err := c.revoke(token, leaseID); err != nil {
+			return err
+		}
+		delete(c.leases, cacheKey)
+	}
+	return nil
+}
+
+// login returns the static token or a token of the Kubernetes auth method along with its expiration time. The token
+// is cached until half of its lease duration has elapsed.
+func (c *Client) login(now time.Time) (string, time.Time, error) {
+	if len(c.token) > 0 {
+		return c.token, time.Time{}, nil
+	}
+	if len(c.loginToken) > 0 && now.Before(c.loginTokenRenewTime) {
+		return c.loginToken, c.loginTokenExpirationTime, nil
+	}
+
+	jwt, err := ioutil.ReadFile(serviceAccountTokenFile)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("could not read service account token for the vault login: %v", err)
+	}
+
+	body, err := json.Marshal(map[string]string{"role": c.role, "jwt": string(jwt)})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	resp, err := c.do(http.MethodPost, fmt.Sprintf("/v1/auth/%s/login", c.authPath), "", bytes.NewReader(body))
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("vault login failed: %v", err)
+	}
+	if resp.Auth == nil || len(resp.Auth.ClientToken) == 0 {
+		return "", time.Time{}, fmt.Errorf("vault login did not return a token")
+	}
+
+	c.loginToken = resp.Auth.ClientToken
+	c.loginTokenExpirationTime = time.Time{}
+	c.loginTokenRenewTime = time.Time{}
+	if resp.Auth.LeaseDuration > 0 {
+		leaseDuration := time.Duration(resp.Auth.LeaseDuration) * time.Second
+		c.loginTokenExpirationTime = now.Add(leaseDuration)
+		c.loginTokenRenewTime = now.Add(leaseDuration / 2)
+	}
+	return c.loginToken, c.loginTokenExpirationTime, nil
+}
+
+// revoke revokes the lease with the given id.
+func (c *Client) revoke(token, leaseID string) error {
+	body, err := json.Marshal(map[string]string{"lease_id": leaseID})
+	if err != nil {
+		return err
+	}
+	_, err = c.do(http.MethodPut, "/v1/sys/leases/revoke", token, bytes.NewReader(body))
+	return err
+}
+
+func (c *Client) do(method, path, token string, body io.Reader) (*response, error) {
+	req, err := http.NewRequest(method, c.address+path, body)
+	if err != nil {
+		return nil, err
+	}
+	if len(token) > 0 {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	httpResp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	resp := &response{}
+	if err := json.NewDecoder(httpResp.Body).Decode(resp); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("could not decode vault response (status code %d): %v", httpResp.StatusCode, err)
+	}
+	if httpResp.StatusCode != http.StatusOK && httpResp.StatusCode != http.StatusNoContent {
+		return nil, fmt.Errorf("vault returned status code %d: %s", httpResp.StatusCode, strings.Join(resp.Errors, ", "))
+	}
+	return resp, nil
+}
+
+func copyData(data map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(data))
+	for k, v := range data {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vault_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	. "github.com/gardener/gardener/pkg/client/vault"
+
+	corev1 "k8s.io/api/core/v1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("client", func() {
+	const path = "gardener/garden-dev/aws/creds/deployer"
+
+	var (
+		server *httptest.Server
+		client ClientInterface
+
+		lock      sync.Mutex
+		issued    int
+		leaseTTL  int64
+		revoked   []string
+		now       time.Time
+		originNow func() time.Time
+	)
+
+	BeforeEach(func() {
+		issued, leaseTTL, revoked = 0, 3600, nil
+		now = time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC)
+		originNow = Now
+		Now = func() time.Time { return now }
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			lock.Lock()
+			defer lock.Unlock()
+
+			Expect(r.Header.Get("X-Vault-Token")).To(Equal("token"))
+
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/v1/"+path:
+				issued++
+				fmt.Fprintf(w, `{"lease_id":"%s/%d","lease_duration":%d,"data":{"access_key":"key-%d"}}`, path, issued, leaseTTL, issued)
+			case r.Method == http.MethodPut && r.URL.Path == "/v1/sys/leases/revoke":
+				body := map[string]string{}
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				revoked = append(revoked, body["lease_id"])
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+		var err error
+		client, err = NewClientFromSecret(&corev1.Secret{Data: map[string][]byte{
+			DataKeyAddress: []byte(server.URL),
+			DataKeyToken:   []byte("token"),
+		}})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+		Now = originNow
+	})
+
+	Describe("#Read", func() {
+		It("should cache credentials with a lease until half of the lease duration has elapsed", func() {
+			data, err := client.Read("garden-dev/shoot", path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal(map[string]interface{}{"access_key": "key-1"}))
+
+			now = now.Add(29 * time.Minute)
+			data, err = client.Read("garden-dev/shoot", path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal(map[string]interface{}{"access_key": "key-1"}))
+			Expect(issued).To(Equal(1))
+			Expect(revoked).To(BeEmpty())
+		})
+
+		It("should read new credentials and revoke the previous lease afterwards", func() {
+			_, err := client.Read("garden-dev/shoot", path)
+			Expect(err).NotTo(HaveOccurred())
+
+			now = now.Add(31 * time.Minute)
+			data, err := client.Read("garden-dev/shoot", path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal(map[string]interface{}{"access_key": "key-2"}))
+			Expect(revoked).To(ConsistOf(path + "/1"))
+		})
+
+		It("should issue credentials per key", func() {
+			_, err := client.Read("garden-dev/shoot", path)
+			Expect(err).NotTo(HaveOccurred())
+			data, err := client.Read("garden-dev/other", path)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(data).To(Equal(map[string]interface{}{"access_key": "key-2"}))
+			Expect(revoked).To(BeEmpty())
+		})
+
+		It("should not cache secrets without a lease", func() {
+			leaseTTL = 0
+
+			_, err := client.Read("garden-dev/shoot", path)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = client.Read("garden-dev/shoot", path)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(issued).To(Equal(2))
+			Expect(revoked).To(BeEmpty())
+		})
+	})
+
+	Describe("#Revoke", func() {
+		It("should revoke the leases of the given key only", func() {
+			_, err := client.Read("garden-dev/shoot", path)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = client.Read("garden-dev/other", path)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.Revoke("garden-dev/shoot")).To(Succeed())
+			Expect(revoked).To(ConsistOf(path + "/1"))
+
+			Expect(client.Revoke("garden-dev/shoot")).To(Succeed())
+			Expect(revoked).To(ConsistOf(path + "/1"))
+		})
+	})
+})
@@ -0,0 +1,99 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vault
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// DataKeyAddress is the key in the Vault secret whose value is the address of Vault.
+	DataKeyAddress = "address"
+	// DataKeyCABundle is the key in the Vault secret whose value is the CA bundle for the serving certificate of Vault.
+	DataKeyCABundle = "caBundle"
+	// DataKeyToken is the key in the Vault secret whose value is a static Vault token.
+	DataKeyToken = "token"
+	// DataKeyRole is the key in the Vault secret whose value is the role used for the Kubernetes auth method.
+	DataKeyRole = "role"
+	// DataKeyAuthPath is the key in the Vault secret whose value is the mount path of the Kubernetes auth method.
+	DataKeyAuthPath = "authPath"
+	// DataKeyPathPrefix is the key in the Vault secret whose value is the path below which the credentials of the
+	// project namespaces are stored.
+	DataKeyPathPrefix = "pathPrefix"
+
+	// DefaultAuthPath is the default mount path of the Kubernetes auth method.
+	DefaultAuthPath = "kubernetes"
+	// DefaultPathPrefix is the default path below which the credentials of the project namespaces are stored.
+	DefaultPathPrefix = "gardener"
+
+	// serviceAccountTokenFile is the file containing the token of the service account of the Gardener controller
+	// manager which is used to log in with the Kubernetes auth method.
+	serviceAccountTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+)
+
+// Now returns the current time. It is a variable so that it can be overwritten in tests.
+var Now = time.Now
+
+// ClientInterface is an interface which must be implemented by Vault clients.
+type ClientInterface interface {
+	// Read reads the secret at the given path which is issued for the given key and returns its data.
+	Read(key, path string) (map[string]interface{}, error)
+	// Revoke revokes the leases of all secrets which have been issued for the given key.
+	Revoke(key string) error
+	// PathPrefix returns the path below which the credentials of the project namespaces are stored.
+	PathPrefix() string
+}
+
+// Client is a minimal client for the HTTP API of Vault. It authenticates with a static token or with the Kubernetes
+// auth method using the service account token of the Gardener controller manager.
+type Client struct {
+	address    string
+	token      string
+	role       string
+	authPath   string
+	pathPrefix string
+	httpClient *http.Client
+
+	lock sync.Mutex
+	// loginToken is the token of the Kubernetes auth method which is used until loginTokenRenewTime.
+	loginToken               string
+	loginTokenRenewTime      time.Time
+	loginTokenExpirationTime time.Time
+	// leases holds the secrets with a lease by their keys and paths.
+	leases map[string]*lease
+}
+
+// lease is a secret with a lease which has been read from Vault.
+type lease struct {
+	leaseID   string
+	data      map[string]interface{}
+	renewTime time.Time
+}
+
+// response is the part of a Vault API response which is evaluated.
+type response struct {
+	LeaseID       string                 `json:"lease_id"`
+	LeaseDuration int64                  `json:"lease_duration"`
+	Data          map[string]interface{} `json:"data"`
+	Auth          *auth                  `json:"auth"`
+	Errors        []string               `json:"errors"`
+}
+
+type auth struct {
+	ClientToken   string `json:"client_token"`
+	LeaseDuration int64  `json:"lease_duration"`
+}
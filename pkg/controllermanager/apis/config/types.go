@@ -40,8 +40,23 @@ type ControllerManagerConfiguration struct {
 	KubernetesLogLevel klog.Level
 	// Server defines the configuration of the HTTP server.
 	Server ServerConfiguration
+	// Debugging holds the configuration of the profiling endpoints.
+	Debugging *componentbaseconfig.DebuggingConfiguration
 	// ShootBackup contains configuration settings for the etcd backups.
 	ShootBackup *ShootBackup
+	// SeedSelector restricts the Seeds which are handled by this instance to those whose labels match the
+	// selector. Shoots, BackupInfrastructures and ControllerInstallations are only handled if they belong to a
+	// selected Seed. Shoots which have not been scheduled yet are treated as if their Seed had no labels.
+	SeedSelector *metav1.LabelSelector
+	// SeedAgent indicates that this instance runs as agent for the Seeds selected by SeedSelector. Agents only run
+	// the controllers acting on Seed clusters and leave all other controllers to the central instance.
+	SeedAgent *bool
+	// SeedAuthentication configures the short-lived tokens which are used for Seeds whose secret does not contain
+	// static credentials.
+	SeedAuthentication *SeedAuthentication
+	// SeedClientConnection specifies the connection which a seed agent uses to access its Seed cluster instead of
+	// the kubeconfig in the Seed secret. The Seed secret does not need to contain a kubeconfig in this case.
+	SeedClientConnection *SeedClientConnection
 	// FeatureGates is a map of feature names to bools that enable or disable alpha/experimental
 	// features. This field modifies piecemeal the built-in default values from
 	// "github.com/gardener/gardener/pkg/features/gardener_features.go".
@@ -95,6 +110,17 @@ type CloudProfileControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
 	// events.
 	ConcurrentSyncs int
+	// CatalogSync defines the configuration for refreshing CloudProfiles from the
+	// catalogs of the cloud providers. It is disabled if not set.
+	// +optional
+	CatalogSync *CloudProfileCatalogSyncConfiguration
+}
+
+// CloudProfileCatalogSyncConfiguration defines the configuration for refreshing
+// CloudProfiles from the catalogs of the cloud providers.
+type CloudProfileCatalogSyncConfiguration struct {
+	// SyncPeriod is the duration how often the catalogs are fetched for a CloudProfile.
+	SyncPeriod metav1.Duration
 }
 
 // ControllerRegistrationControllerConfiguration defines the configuration of the
@@ -160,14 +186,35 @@ type SeedControllerConfiguration struct {
 	ReserveExcessCapacity *bool
 	// SyncPeriod is the duration how often the existing resources are reconciled.
 	SyncPeriod metav1.Duration
+	// ProviderHealthCheckPeriod is the duration how often the cloud provider accounts of the Seeds are checked for
+	// the reachability of the cloud API and the headroom of their quotas. If not set, the accounts are not checked.
+	// +optional
+	ProviderHealthCheckPeriod *metav1.Duration
+	// MinimumQuotaHeadroomPercentage is the percentage of each quota of a Seed's cloud provider account which must
+	// still be available. Otherwise, the Seed is reported as unhealthy and not considered for new Shoots. It defaults
+	// to 10.
+	// +optional
+	MinimumQuotaHeadroomPercentage *int
+	// ProviderQuotaLimits maps quota names to limits which overwrite the limits determined from the cloud API, e.g.
+	// to leave a part of the cloud provider account to other consumers.
+	// +optional
+	ProviderQuotaLimits map[string]int64
 }
 
 // ShootControllerConfiguration defines the configuration of the CloudProfile
 // controller.
 type ShootControllerConfiguration struct {
+	// CloudAPIRateLimit limits the rate of the Terraform executions and cloud API requests issued by the Shoot
+	// operations per cloud provider account. If not set, the rate is not limited.
+	// +optional
+	CloudAPIRateLimit *CloudAPIRateLimit
 	// ConcurrentSyncs is the number of workers used for the controller to work on
 	// events.
 	ConcurrentSyncs int
+	// ReconcileInMaintenanceOnly determines whether Shoots whose specification has not changed are only reconciled
+	// during their maintenance time window. Defaults to false.
+	// +optional
+	ReconcileInMaintenanceOnly *bool
 	// RespectSyncPeriodOverwrite determines whether a sync period overwrite of a
 	// Shoot (via annotation) is respected or not. Defaults to false.
 	// +optional
@@ -175,8 +222,14 @@ type ShootControllerConfiguration struct {
 	// RetryDuration is the maximum duration how often a reconciliation will be retried
 	// in case of errors.
 	RetryDuration metav1.Duration
+	// RetryMaxAttempts is the number of consecutive failed attempts after which an operation
+	// is not retried anymore and the Shoot is put into the `Failed` state, even if the
+	// RetryDuration has not yet elapsed. Defaults to 10.
+	// +optional
+	RetryMaxAttempts *int32
 	// RetrySyncPeriod is the duration how fast Shoots with an errornous operation are
-	// re-added to the queue so that the operation can be retried. Defaults to 15s.
+	// re-added to the queue so that the operation can be retried. It is doubled with
+	// every consecutive failed attempt but never exceeds the SyncPeriod. Defaults to 15s.
 	// +optional
 	RetrySyncPeriod *metav1.Duration
 	// SyncPeriod is the duration how often the existing resources are reconciled.
@@ -244,6 +297,10 @@ type BackupInfrastructureControllerConfiguration struct {
 	// If value is set to 0 then the BackupInfrastructureController will trigger deletion immediately.
 	// +optional
 	DeletionGracePeriodDays *int
+	// BucketProbePeriod is the duration how often the backup buckets are probed for existence, writability, and
+	// valid credentials.
+	// +optional
+	BucketProbePeriod *metav1.Duration
 }
 
 // DiscoveryConfiguration defines the configuration of how to discover API groups.
@@ -304,6 +361,32 @@ type TLSServer struct {
 	ServerKeyPath string
 }
 
+// CloudAPIRateLimit defines a token bucket per cloud provider account.
+type CloudAPIRateLimit struct {
+	// QPS is the number of tokens which are added to the bucket per second.
+	QPS float32
+	// Burst is the maximum number of tokens the bucket can hold.
+	Burst int32
+}
+
+// SeedAuthentication configures the tokens which are requested for a service account of the Garden cluster to
+// authenticate against Seed clusters.
+type SeedAuthentication struct {
+	// ServiceAccountName is the name of the service account in the namespace of the controller manager.
+	ServiceAccountName string
+	// TokenExpiration is the requested validity of the tokens. Tokens are renewed after 80% of their validity.
+	TokenExpiration *metav1.Duration
+}
+
+// SeedClientConnection specifies the connection of a seed agent to its Seed cluster.
+type SeedClientConnection struct {
+	// SeedName is the name of the Seed which is accessed with this connection.
+	SeedName string
+	// ClientConnection specifies the kubeconfig file and client connection settings. The in-cluster configuration is
+	// used if no kubeconfig file is given.
+	ClientConnection componentbaseconfig.ClientConnectionConfiguration
+}
+
 // ShootBackup holds information about backup settings.
 type ShootBackup struct {
 	// Schedule defines the cron schedule according to which a backup is taken from etcd.
@@ -110,11 +110,23 @@ func SetDefaults_ControllerManagerConfiguration(obj *ControllerManagerConfigurat
 			obj.Controllers.Seed.ReserveExcessCapacity = &trueVar
 		}
 	}
+	if obj.Controllers.Seed.MinimumQuotaHeadroomPercentage == nil {
+		minimumQuotaHeadroomPercentage := 10
+		obj.Controllers.Seed.MinimumQuotaHeadroomPercentage = &minimumQuotaHeadroomPercentage
+	}
 
+	if obj.Controllers.Shoot.ReconcileInMaintenanceOnly == nil {
+		falseVar := false
+		obj.Controllers.Shoot.ReconcileInMaintenanceOnly = &falseVar
+	}
 	if obj.Controllers.Shoot.RespectSyncPeriodOverwrite == nil {
 		falseVar := false
 		obj.Controllers.Shoot.RespectSyncPeriodOverwrite = &falseVar
 	}
+	if obj.Controllers.Shoot.RetryMaxAttempts == nil {
+		var defaultShootRetryMaxAttempts int32 = DefaultShootRetryMaxAttempts
+		obj.Controllers.Shoot.RetryMaxAttempts = &defaultShootRetryMaxAttempts
+	}
 	if obj.Controllers.Shoot.RetrySyncPeriod == nil {
 		durationVar := metav1.Duration{Duration: 15 * time.Second}
 		obj.Controllers.Shoot.RetrySyncPeriod = &durationVar
@@ -124,6 +136,10 @@ func SetDefaults_ControllerManagerConfiguration(obj *ControllerManagerConfigurat
 		var defaultBackupInfrastructureDeletionGracePeriodDays = DefaultBackupInfrastructureDeletionGracePeriodDays
 		obj.Controllers.BackupInfrastructure.DeletionGracePeriodDays = &defaultBackupInfrastructureDeletionGracePeriodDays
 	}
+	if obj.Controllers.BackupInfrastructure.BucketProbePeriod == nil {
+		durationVar := metav1.Duration{Duration: 5 * time.Minute}
+		obj.Controllers.BackupInfrastructure.BucketProbePeriod = &durationVar
+	}
 
 	if obj.Controllers.Plant == nil {
 		obj.Controllers.Plant = &PlantConfiguration{
@@ -144,6 +160,14 @@ func SetDefaults_ControllerManagerConfiguration(obj *ControllerManagerConfigurat
 		}
 	}
 
+	if obj.SeedAuthentication != nil && obj.SeedAuthentication.TokenExpiration == nil {
+		obj.SeedAuthentication.TokenExpiration = &metav1.Duration{Duration: DefaultSeedTokenExpiration}
+	}
+
+	if obj.SeedClientConnection != nil {
+		SetDefaults_ClientConnection(&obj.SeedClientConnection.ClientConnection)
+	}
+
 	if obj.Discovery.TTL == nil {
 		obj.Discovery.TTL = &metav1.Duration{Duration: DefaultDiscoveryTTL}
 	}
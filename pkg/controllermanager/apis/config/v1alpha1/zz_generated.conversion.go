@@ -27,6 +27,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	componentbaseconfig "k8s.io/component-base/config"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
 	klog "k8s.io/klog"
 )
@@ -48,6 +49,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CloudAPIRateLimit)(nil), (*config.CloudAPIRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudAPIRateLimit_To_config_CloudAPIRateLimit(a.(*CloudAPIRateLimit), b.(*config.CloudAPIRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CloudAPIRateLimit)(nil), (*CloudAPIRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CloudAPIRateLimit_To_v1alpha1_CloudAPIRateLimit(a.(*config.CloudAPIRateLimit), b.(*CloudAPIRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CloudProfileCatalogSyncConfiguration)(nil), (*config.CloudProfileCatalogSyncConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudProfileCatalogSyncConfiguration_To_config_CloudProfileCatalogSyncConfiguration(a.(*CloudProfileCatalogSyncConfiguration), b.(*config.CloudProfileCatalogSyncConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CloudProfileCatalogSyncConfiguration)(nil), (*CloudProfileCatalogSyncConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CloudProfileCatalogSyncConfiguration_To_v1alpha1_CloudProfileCatalogSyncConfiguration(a.(*config.CloudProfileCatalogSyncConfiguration), b.(*CloudProfileCatalogSyncConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudProfileControllerConfiguration)(nil), (*config.CloudProfileControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudProfileControllerConfiguration_To_config_CloudProfileControllerConfiguration(a.(*CloudProfileControllerConfiguration), b.(*config.CloudProfileControllerConfiguration), scope)
 	}); err != nil {
@@ -178,6 +199,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedAuthentication)(nil), (*config.SeedAuthentication)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedAuthentication_To_config_SeedAuthentication(a.(*SeedAuthentication), b.(*config.SeedAuthentication), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SeedAuthentication)(nil), (*SeedAuthentication)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SeedAuthentication_To_v1alpha1_SeedAuthentication(a.(*config.SeedAuthentication), b.(*SeedAuthentication), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedClientConnection)(nil), (*config.SeedClientConnection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedClientConnection_To_config_SeedClientConnection(a.(*SeedClientConnection), b.(*config.SeedClientConnection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SeedClientConnection)(nil), (*SeedClientConnection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SeedClientConnection_To_v1alpha1_SeedClientConnection(a.(*config.SeedClientConnection), b.(*SeedClientConnection), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedControllerConfiguration)(nil), (*config.SeedControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SeedControllerConfiguration_To_config_SeedControllerConfiguration(a.(*SeedControllerConfiguration), b.(*config.SeedControllerConfiguration), scope)
 	}); err != nil {
@@ -285,6 +326,7 @@ func autoConvert_v1alpha1_BackupInfrastructureControllerConfiguration_To_config_
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.SyncPeriod = in.SyncPeriod
 	out.DeletionGracePeriodDays = (*int)(unsafe.Pointer(in.DeletionGracePeriodDays))
+	out.BucketProbePeriod = (*v1.Duration)(unsafe.Pointer(in.BucketProbePeriod))
 	return nil
 }
 
@@ -297,6 +339,7 @@ func autoConvert_config_BackupInfrastructureControllerConfiguration_To_v1alpha1_
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.SyncPeriod = in.SyncPeriod
 	out.DeletionGracePeriodDays = (*int)(unsafe.Pointer(in.DeletionGracePeriodDays))
+	out.BucketProbePeriod = (*v1.Duration)(unsafe.Pointer(in.BucketProbePeriod))
 	return nil
 }
 
@@ -305,8 +348,51 @@ func Convert_config_BackupInfrastructureControllerConfiguration_To_v1alpha1_Back
 	return autoConvert_config_BackupInfrastructureControllerConfiguration_To_v1alpha1_BackupInfrastructureControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_CloudAPIRateLimit_To_config_CloudAPIRateLimit(in *CloudAPIRateLimit, out *config.CloudAPIRateLimit, s conversion.Scope) error {
+	out.QPS = in.QPS
+	out.Burst = in.Burst
+	return nil
+}
+
+// Convert_v1alpha1_CloudAPIRateLimit_To_config_CloudAPIRateLimit is an autogenerated conversion function.
+func Convert_v1alpha1_CloudAPIRateLimit_To_config_CloudAPIRateLimit(in *CloudAPIRateLimit, out *config.CloudAPIRateLimit, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudAPIRateLimit_To_config_CloudAPIRateLimit(in, out, s)
+}
+
+func autoConvert_config_CloudAPIRateLimit_To_v1alpha1_CloudAPIRateLimit(in *config.CloudAPIRateLimit, out *CloudAPIRateLimit, s conversion.Scope) error {
+	out.QPS = in.QPS
+	out.Burst = in.Burst
+	return nil
+}
+
+// Convert_config_CloudAPIRateLimit_To_v1alpha1_CloudAPIRateLimit is an autogenerated conversion function.
+func Convert_config_CloudAPIRateLimit_To_v1alpha1_CloudAPIRateLimit(in *config.CloudAPIRateLimit, out *CloudAPIRateLimit, s conversion.Scope) error {
+	return autoConvert_config_CloudAPIRateLimit_To_v1alpha1_CloudAPIRateLimit(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloudProfileCatalogSyncConfiguration_To_config_CloudProfileCatalogSyncConfiguration(in *CloudProfileCatalogSyncConfiguration, out *config.CloudProfileCatalogSyncConfiguration, s conversion.Scope) error {
+	out.SyncPeriod = in.SyncPeriod
+	return nil
+}
+
+// Convert_v1alpha1_CloudProfileCatalogSyncConfiguration_To_config_CloudProfileCatalogSyncConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_CloudProfileCatalogSyncConfiguration_To_config_CloudProfileCatalogSyncConfiguration(in *CloudProfileCatalogSyncConfiguration, out *config.CloudProfileCatalogSyncConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudProfileCatalogSyncConfiguration_To_config_CloudProfileCatalogSyncConfiguration(in, out, s)
+}
+
+func autoConvert_config_CloudProfileCatalogSyncConfiguration_To_v1alpha1_CloudProfileCatalogSyncConfiguration(in *config.CloudProfileCatalogSyncConfiguration, out *CloudProfileCatalogSyncConfiguration, s conversion.Scope) error {
+	out.SyncPeriod = in.SyncPeriod
+	return nil
+}
+
+// Convert_config_CloudProfileCatalogSyncConfiguration_To_v1alpha1_CloudProfileCatalogSyncConfiguration is an autogenerated conversion function.
+func Convert_config_CloudProfileCatalogSyncConfiguration_To_v1alpha1_CloudProfileCatalogSyncConfiguration(in *config.CloudProfileCatalogSyncConfiguration, out *CloudProfileCatalogSyncConfiguration, s conversion.Scope) error {
+	return autoConvert_config_CloudProfileCatalogSyncConfiguration_To_v1alpha1_CloudProfileCatalogSyncConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudProfileControllerConfiguration_To_config_CloudProfileControllerConfiguration(in *CloudProfileControllerConfiguration, out *config.CloudProfileControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.CatalogSync = (*config.CloudProfileCatalogSyncConfiguration)(unsafe.Pointer(in.CatalogSync))
 	return nil
 }
 
@@ -317,6 +403,7 @@ func Convert_v1alpha1_CloudProfileControllerConfiguration_To_config_CloudProfile
 
 func autoConvert_config_CloudProfileControllerConfiguration_To_v1alpha1_CloudProfileControllerConfiguration(in *config.CloudProfileControllerConfiguration, out *CloudProfileControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.CatalogSync = (*CloudProfileCatalogSyncConfiguration)(unsafe.Pointer(in.CatalogSync))
 	return nil
 }
 
@@ -368,7 +455,8 @@ func Convert_config_ControllerInstallationControllerConfiguration_To_v1alpha1_Co
 }
 
 func autoConvert_v1alpha1_ControllerManagerConfiguration_To_config_ControllerManagerConfiguration(in *ControllerManagerConfiguration, out *config.ControllerManagerConfiguration, s conversion.Scope) error {
-	if err := configv1alpha1.Convert_v1alpha1_ClientConnectionConfiguration_To_config_ClientConnectionConfiguration(&in.ClientConnection, &out.ClientConnection, s); err != nil {
+	// TODO: Inefficient conversion - can we improve it?
+	if err := s.Convert(&in.ClientConnection, &out.ClientConnection, 0); err != nil {
 		return err
 	}
 	if err := Convert_v1alpha1_ControllerManagerControllerConfiguration_To_config_ControllerManagerControllerConfiguration(&in.Controllers, &out.Controllers, s); err != nil {
@@ -385,7 +473,12 @@ func autoConvert_v1alpha1_ControllerManagerConfiguration_To_config_ControllerMan
 	if err := Convert_v1alpha1_ServerConfiguration_To_config_ServerConfiguration(&in.Server, &out.Server, s); err != nil {
 		return err
 	}
+	out.Debugging = (*componentbaseconfig.DebuggingConfiguration)(unsafe.Pointer(in.Debugging))
 	out.ShootBackup = (*config.ShootBackup)(unsafe.Pointer(in.ShootBackup))
+	out.SeedSelector = (*v1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	out.SeedAgent = (*bool)(unsafe.Pointer(in.SeedAgent))
+	out.SeedAuthentication = (*config.SeedAuthentication)(unsafe.Pointer(in.SeedAuthentication))
+	out.SeedClientConnection = (*config.SeedClientConnection)(unsafe.Pointer(in.SeedClientConnection))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	return nil
 }
@@ -396,7 +489,8 @@ func Convert_v1alpha1_ControllerManagerConfiguration_To_config_ControllerManager
 }
 
 func autoConvert_config_ControllerManagerConfiguration_To_v1alpha1_ControllerManagerConfiguration(in *config.ControllerManagerConfiguration, out *ControllerManagerConfiguration, s conversion.Scope) error {
-	if err := configv1alpha1.Convert_config_ClientConnectionConfiguration_To_v1alpha1_ClientConnectionConfiguration(&in.ClientConnection, &out.ClientConnection, s); err != nil {
+	// TODO: Inefficient conversion - can we improve it?
+	if err := s.Convert(&in.ClientConnection, &out.ClientConnection, 0); err != nil {
 		return err
 	}
 	if err := Convert_config_ControllerManagerControllerConfiguration_To_v1alpha1_ControllerManagerControllerConfiguration(&in.Controllers, &out.Controllers, s); err != nil {
@@ -413,7 +507,12 @@ func autoConvert_config_ControllerManagerConfiguration_To_v1alpha1_ControllerMan
 	if err := Convert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(&in.Server, &out.Server, s); err != nil {
 		return err
 	}
+	out.Debugging = (*configv1alpha1.DebuggingConfiguration)(unsafe.Pointer(in.Debugging))
 	out.ShootBackup = (*ShootBackup)(unsafe.Pointer(in.ShootBackup))
+	out.SeedSelector = (*v1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	out.SeedAgent = (*bool)(unsafe.Pointer(in.SeedAgent))
+	out.SeedAuthentication = (*SeedAuthentication)(unsafe.Pointer(in.SeedAuthentication))
+	out.SeedClientConnection = (*SeedClientConnection)(unsafe.Pointer(in.SeedClientConnection))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	return nil
 }
@@ -568,7 +667,8 @@ func Convert_config_HTTPSServer_To_v1alpha1_HTTPSServer(in *config.HTTPSServer,
 }
 
 func autoConvert_v1alpha1_LeaderElectionConfiguration_To_config_LeaderElectionConfiguration(in *LeaderElectionConfiguration, out *config.LeaderElectionConfiguration, s conversion.Scope) error {
-	if err := configv1alpha1.Convert_v1alpha1_LeaderElectionConfiguration_To_config_LeaderElectionConfiguration(&in.LeaderElectionConfiguration, &out.LeaderElectionConfiguration, s); err != nil {
+	// TODO: Inefficient conversion - can we improve it?
+	if err := s.Convert(&in.LeaderElectionConfiguration, &out.LeaderElectionConfiguration, 0); err != nil {
 		return err
 	}
 	out.LockObjectNamespace = in.LockObjectNamespace
@@ -582,7 +682,8 @@ func Convert_v1alpha1_LeaderElectionConfiguration_To_config_LeaderElectionConfig
 }
 
 func autoConvert_config_LeaderElectionConfiguration_To_v1alpha1_LeaderElectionConfiguration(in *config.LeaderElectionConfiguration, out *LeaderElectionConfiguration, s conversion.Scope) error {
-	if err := configv1alpha1.Convert_config_LeaderElectionConfiguration_To_v1alpha1_LeaderElectionConfiguration(&in.LeaderElectionConfiguration, &out.LeaderElectionConfiguration, s); err != nil {
+	// TODO: Inefficient conversion - can we improve it?
+	if err := s.Convert(&in.LeaderElectionConfiguration, &out.LeaderElectionConfiguration, 0); err != nil {
 		return err
 	}
 	out.LockObjectNamespace = in.LockObjectNamespace
@@ -677,10 +778,63 @@ func Convert_config_SecretBindingControllerConfiguration_To_v1alpha1_SecretBindi
 	return autoConvert_config_SecretBindingControllerConfiguration_To_v1alpha1_SecretBindingControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_SeedAuthentication_To_config_SeedAuthentication(in *SeedAuthentication, out *config.SeedAuthentication, s conversion.Scope) error {
+	out.ServiceAccountName = in.ServiceAccountName
+	out.TokenExpiration = (*v1.Duration)(unsafe.Pointer(in.TokenExpiration))
+	return nil
+}
+
+// Convert_v1alpha1_SeedAuthentication_To_config_SeedAuthentication is an autogenerated conversion function.
+func Convert_v1alpha1_SeedAuthentication_To_config_SeedAuthentication(in *SeedAuthentication, out *config.SeedAuthentication, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedAuthentication_To_config_SeedAuthentication(in, out, s)
+}
+
+func autoConvert_config_SeedAuthentication_To_v1alpha1_SeedAuthentication(in *config.SeedAuthentication, out *SeedAuthentication, s conversion.Scope) error {
+	out.ServiceAccountName = in.ServiceAccountName
+	out.TokenExpiration = (*v1.Duration)(unsafe.Pointer(in.TokenExpiration))
+	return nil
+}
+
+// Convert_config_SeedAuthentication_To_v1alpha1_SeedAuthentication is an autogenerated conversion function.
+func Convert_config_SeedAuthentication_To_v1alpha1_SeedAuthentication(in *config.SeedAuthentication, out *SeedAuthentication, s conversion.Scope) error {
+	return autoConvert_config_SeedAuthentication_To_v1alpha1_SeedAuthentication(in, out, s)
+}
+
+func autoConvert_v1alpha1_SeedClientConnection_To_config_SeedClientConnection(in *SeedClientConnection, out *config.SeedClientConnection, s conversion.Scope) error {
+	out.SeedName = in.SeedName
+	// TODO: Inefficient conversion - can we improve it?
+	if err := s.Convert(&in.ClientConnection, &out.ClientConnection, 0); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_SeedClientConnection_To_config_SeedClientConnection is an autogenerated conversion function.
+func Convert_v1alpha1_SeedClientConnection_To_config_SeedClientConnection(in *SeedClientConnection, out *config.SeedClientConnection, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedClientConnection_To_config_SeedClientConnection(in, out, s)
+}
+
+func autoConvert_config_SeedClientConnection_To_v1alpha1_SeedClientConnection(in *config.SeedClientConnection, out *SeedClientConnection, s conversion.Scope) error {
+	out.SeedName = in.SeedName
+	// TODO: Inefficient conversion - can we improve it?
+	if err := s.Convert(&in.ClientConnection, &out.ClientConnection, 0); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_SeedClientConnection_To_v1alpha1_SeedClientConnection is an autogenerated conversion function.
+func Convert_config_SeedClientConnection_To_v1alpha1_SeedClientConnection(in *config.SeedClientConnection, out *SeedClientConnection, s conversion.Scope) error {
+	return autoConvert_config_SeedClientConnection_To_v1alpha1_SeedClientConnection(in, out, s)
+}
+
 func autoConvert_v1alpha1_SeedControllerConfiguration_To_config_SeedControllerConfiguration(in *SeedControllerConfiguration, out *config.SeedControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.ReserveExcessCapacity = (*bool)(unsafe.Pointer(in.ReserveExcessCapacity))
 	out.SyncPeriod = in.SyncPeriod
+	out.ProviderHealthCheckPeriod = (*v1.Duration)(unsafe.Pointer(in.ProviderHealthCheckPeriod))
+	out.MinimumQuotaHeadroomPercentage = (*int)(unsafe.Pointer(in.MinimumQuotaHeadroomPercentage))
+	out.ProviderQuotaLimits = *(*map[string]int64)(unsafe.Pointer(&in.ProviderQuotaLimits))
 	return nil
 }
 
@@ -693,6 +847,9 @@ func autoConvert_config_SeedControllerConfiguration_To_v1alpha1_SeedControllerCo
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.ReserveExcessCapacity = (*bool)(unsafe.Pointer(in.ReserveExcessCapacity))
 	out.SyncPeriod = in.SyncPeriod
+	out.ProviderHealthCheckPeriod = (*v1.Duration)(unsafe.Pointer(in.ProviderHealthCheckPeriod))
+	out.MinimumQuotaHeadroomPercentage = (*int)(unsafe.Pointer(in.MinimumQuotaHeadroomPercentage))
+	out.ProviderQuotaLimits = *(*map[string]int64)(unsafe.Pointer(&in.ProviderQuotaLimits))
 	return nil
 }
 
@@ -798,9 +955,12 @@ func Convert_config_ShootCareControllerConfiguration_To_v1alpha1_ShootCareContro
 }
 
 func autoConvert_v1alpha1_ShootControllerConfiguration_To_config_ShootControllerConfiguration(in *ShootControllerConfiguration, out *config.ShootControllerConfiguration, s conversion.Scope) error {
+	out.CloudAPIRateLimit = (*config.CloudAPIRateLimit)(unsafe.Pointer(in.CloudAPIRateLimit))
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.ReconcileInMaintenanceOnly = (*bool)(unsafe.Pointer(in.ReconcileInMaintenanceOnly))
 	out.RespectSyncPeriodOverwrite = (*bool)(unsafe.Pointer(in.RespectSyncPeriodOverwrite))
 	out.RetryDuration = in.RetryDuration
+	out.RetryMaxAttempts = (*int32)(unsafe.Pointer(in.RetryMaxAttempts))
 	out.RetrySyncPeriod = (*v1.Duration)(unsafe.Pointer(in.RetrySyncPeriod))
 	out.SyncPeriod = in.SyncPeriod
 	return nil
@@ -812,9 +972,12 @@ func Convert_v1alpha1_ShootControllerConfiguration_To_config_ShootControllerConf
 }
 
 func autoConvert_config_ShootControllerConfiguration_To_v1alpha1_ShootControllerConfiguration(in *config.ShootControllerConfiguration, out *ShootControllerConfiguration, s conversion.Scope) error {
+	out.CloudAPIRateLimit = (*CloudAPIRateLimit)(unsafe.Pointer(in.CloudAPIRateLimit))
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.ReconcileInMaintenanceOnly = (*bool)(unsafe.Pointer(in.ReconcileInMaintenanceOnly))
 	out.RespectSyncPeriodOverwrite = (*bool)(unsafe.Pointer(in.RespectSyncPeriodOverwrite))
 	out.RetryDuration = in.RetryDuration
+	out.RetryMaxAttempts = (*int32)(unsafe.Pointer(in.RetryMaxAttempts))
 	out.RetrySyncPeriod = (*v1.Duration)(unsafe.Pointer(in.RetrySyncPeriod))
 	out.SyncPeriod = in.SyncPeriod
 	return nil
@@ -23,6 +23,7 @@ package config
 import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	componentbaseconfig "k8s.io/component-base/config"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -34,6 +35,11 @@ func (in *BackupInfrastructureControllerConfiguration) DeepCopyInto(out *BackupI
 		*out = new(int)
 		**out = **in
 	}
+	if in.BucketProbePeriod != nil {
+		in, out := &in.BucketProbePeriod, &out.BucketProbePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -47,9 +53,47 @@ func (in *BackupInfrastructureControllerConfiguration) DeepCopy() *BackupInfrast
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAPIRateLimit) DeepCopyInto(out *CloudAPIRateLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAPIRateLimit.
+func (in *CloudAPIRateLimit) DeepCopy() *CloudAPIRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAPIRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudProfileCatalogSyncConfiguration) DeepCopyInto(out *CloudProfileCatalogSyncConfiguration) {
+	*out = *in
+	out.SyncPeriod = in.SyncPeriod
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudProfileCatalogSyncConfiguration.
+func (in *CloudProfileCatalogSyncConfiguration) DeepCopy() *CloudProfileCatalogSyncConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudProfileCatalogSyncConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudProfileControllerConfiguration) DeepCopyInto(out *CloudProfileControllerConfiguration) {
 	*out = *in
+	if in.CatalogSync != nil {
+		in, out := &in.CatalogSync, &out.CatalogSync
+		*out = new(CloudProfileCatalogSyncConfiguration)
+		**out = **in
+	}
 	return
 }
 
@@ -105,11 +149,36 @@ func (in *ControllerManagerConfiguration) DeepCopyInto(out *ControllerManagerCon
 	out.LeaderElection = in.LeaderElection
 	in.Discovery.DeepCopyInto(&out.Discovery)
 	out.Server = in.Server
+	if in.Debugging != nil {
+		in, out := &in.Debugging, &out.Debugging
+		*out = new(componentbaseconfig.DebuggingConfiguration)
+		**out = **in
+	}
 	if in.ShootBackup != nil {
 		in, out := &in.ShootBackup, &out.ShootBackup
 		*out = new(ShootBackup)
 		**out = **in
 	}
+	if in.SeedSelector != nil {
+		in, out := &in.SeedSelector, &out.SeedSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SeedAgent != nil {
+		in, out := &in.SeedAgent, &out.SeedAgent
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SeedAuthentication != nil {
+		in, out := &in.SeedAuthentication, &out.SeedAuthentication
+		*out = new(SeedAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SeedClientConnection != nil {
+		in, out := &in.SeedClientConnection, &out.SeedClientConnection
+		*out = new(SeedClientConnection)
+		**out = **in
+	}
 	if in.FeatureGates != nil {
 		in, out := &in.FeatureGates, &out.FeatureGates
 		*out = make(map[string]bool, len(*in))
@@ -145,7 +214,7 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 	if in.CloudProfile != nil {
 		in, out := &in.CloudProfile, &out.CloudProfile
 		*out = new(CloudProfileControllerConfiguration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ControllerRegistration != nil {
 		in, out := &in.ControllerRegistration, &out.ControllerRegistration
@@ -347,6 +416,44 @@ func (in *SecretBindingControllerConfiguration) DeepCopy() *SecretBindingControl
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedAuthentication) DeepCopyInto(out *SeedAuthentication) {
+	*out = *in
+	if in.TokenExpiration != nil {
+		in, out := &in.TokenExpiration, &out.TokenExpiration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedAuthentication.
+func (in *SeedAuthentication) DeepCopy() *SeedAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedClientConnection) DeepCopyInto(out *SeedClientConnection) {
+	*out = *in
+	out.ClientConnection = in.ClientConnection
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedClientConnection.
+func (in *SeedClientConnection) DeepCopy() *SeedClientConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedClientConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedControllerConfiguration) DeepCopyInto(out *SeedControllerConfiguration) {
 	*out = *in
@@ -356,6 +463,23 @@ func (in *SeedControllerConfiguration) DeepCopyInto(out *SeedControllerConfigura
 		**out = **in
 	}
 	out.SyncPeriod = in.SyncPeriod
+	if in.ProviderHealthCheckPeriod != nil {
+		in, out := &in.ProviderHealthCheckPeriod, &out.ProviderHealthCheckPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MinimumQuotaHeadroomPercentage != nil {
+		in, out := &in.MinimumQuotaHeadroomPercentage, &out.MinimumQuotaHeadroomPercentage
+		*out = new(int)
+		**out = **in
+	}
+	if in.ProviderQuotaLimits != nil {
+		in, out := &in.ProviderQuotaLimits, &out.ProviderQuotaLimits
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -444,12 +568,27 @@ func (in *ShootCareControllerConfiguration) DeepCopy() *ShootCareControllerConfi
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootControllerConfiguration) DeepCopyInto(out *ShootControllerConfiguration) {
 	*out = *in
+	if in.CloudAPIRateLimit != nil {
+		in, out := &in.CloudAPIRateLimit, &out.CloudAPIRateLimit
+		*out = new(CloudAPIRateLimit)
+		**out = **in
+	}
+	if in.ReconcileInMaintenanceOnly != nil {
+		in, out := &in.ReconcileInMaintenanceOnly, &out.ReconcileInMaintenanceOnly
+		*out = new(bool)
+		**out = **in
+	}
 	if in.RespectSyncPeriodOverwrite != nil {
 		in, out := &in.RespectSyncPeriodOverwrite, &out.RespectSyncPeriodOverwrite
 		*out = new(bool)
 		**out = **in
 	}
 	out.RetryDuration = in.RetryDuration
+	if in.RetryMaxAttempts != nil {
+		in, out := &in.RetryMaxAttempts, &out.RetryMaxAttempts
+		*out = new(int32)
+		**out = **in
+	}
 	if in.RetrySyncPeriod != nil {
 		in, out := &in.RetrySyncPeriod, &out.RetrySyncPeriod
 		*out = new(v1.Duration)
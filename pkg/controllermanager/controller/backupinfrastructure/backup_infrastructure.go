@@ -51,6 +51,7 @@ type Controller struct {
 	backupInfrastructureSynced cache.InformerSynced
 
 	seedSynced             cache.InformerSynced
+	seedFilter             func(obj interface{}) bool
 	workerCh               chan int
 	numberOfRunningWorkers int
 }
@@ -74,6 +75,7 @@ func NewBackupInfrastructureController(k8sGardenClient kubernetes.Interface, gar
 		secrets:                    secrets,
 		imageVector:                imageVector,
 		backupInfrastructureLister: backupInfrastructureLister,
+		seedFilter:                 controllerutils.SeedFilterFunc(gardenv1beta1Informer.Seeds().Lister(), config.SeedSelector),
 		backupInfrastructureQueue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "BackupInfrastructure"),
 		workerCh:                   make(chan int),
 	}
@@ -83,6 +85,9 @@ func NewBackupInfrastructureController(k8sGardenClient kubernetes.Interface, gar
 		UpdateFunc: backupInfrastructureController.backupInfrastructureUpdate,
 		DeleteFunc: backupInfrastructureController.backupInfrastructureDelete,
 	})
+	gardenv1beta1Informer.Seeds().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: backupInfrastructureController.seedUpdate,
+	})
 	backupInfrastructureController.backupInfrastructureSynced = backupInfrastructureInformer.Informer().HasSynced
 	backupInfrastructureController.seedSynced = gardenv1beta1Informer.Seeds().Informer().HasSynced
 	return backupInfrastructureController
@@ -110,8 +115,12 @@ func (c *Controller) Run(ctx context.Context, workers int) {
 
 	logger.Logger.Info("BackupInfrastructure controller initialized.")
 
+	// The running Terraform jobs are aborted once the controller is stopped, hence, we hand the context of the
+	// controller to the reconciler.
+	reconcileBackupInfrastructureKey := func(key string) error { return c.reconcileBackupInfrastructureKey(ctx, key) }
+
 	for i := 0; i < workers; i++ {
-		controllerutils.CreateWorker(ctx, c.backupInfrastructureQueue, "backupinfrastructure", c.reconcileBackupInfrastructureKey, &waitGroup, c.workerCh)
+		controllerutils.CreateWorker(ctx, c.backupInfrastructureQueue, "backupinfrastructure", reconcileBackupInfrastructureKey, &waitGroup, c.workerCh)
 	}
 
 	// Shutdown handling
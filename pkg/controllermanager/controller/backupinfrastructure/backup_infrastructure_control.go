@@ -15,6 +15,7 @@
 package backupinfrastructure
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -23,14 +24,18 @@ import (
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation"
 	botanistpkg "github.com/gardener/gardener/pkg/operation/botanist"
 	cloudbotanistpkg "github.com/gardener/gardener/pkg/operation/cloudbotanist"
 	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/operation/terraformer"
+	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -38,11 +43,11 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
-	"k8s.io/client-go/util/retry"
 )
 
 func (c *Controller) backupInfrastructureAdd(obj interface{}) {
@@ -62,8 +67,10 @@ func (c *Controller) backupInfrastructureUpdate(oldObj, newObj interface{}) {
 
 	// If the generation did not change for an update event (i.e., no changes to the .spec section have
 	// been made), we do not want to add the BackupInfrastructure to the queue. The periodic reconciliation is handled
-	// elsewhere by adding the BackupInfrastructure to the queue to dedicated times.
-	if newBackupInfrastructure.Generation == newBackupInfrastructure.Status.ObservedGeneration {
+	// elsewhere by adding the BackupInfrastructure to the queue to dedicated times. An exception is a confirmed force
+	// purge of a BackupInfrastructure which is already being deleted, as operators are typically waiting for it.
+	if newBackupInfrastructure.Generation == newBackupInfrastructure.Status.ObservedGeneration &&
+		!(newBackupInfrastructure.DeletionTimestamp != nil && forcePurgeConfirmed(newBackupInfrastructure) && !forcePurgeConfirmed(oldObj.(*gardenv1beta1.BackupInfrastructure))) {
 		backupInfrastructureLogger.Debug("Do not need to do anything as the Update event occurred due to .status field changes")
 		return
 	}
@@ -80,7 +87,26 @@ func (c *Controller) backupInfrastructureDelete(obj interface{}) {
 	c.backupInfrastructureQueue.Add(key)
 }
 
-func (c *Controller) reconcileBackupInfrastructureKey(key string) error {
+func (c *Controller) seedUpdate(oldObj, newObj interface{}) {
+	newSeed, ok := newObj.(*gardenv1beta1.Seed)
+	if !ok || !controllerutils.SeedLabelsChanged(oldObj, newObj) {
+		return
+	}
+
+	// The BackupInfrastructures of the Seed might have been (de-)selected by the seed selector.
+	backupInfrastructures, err := c.backupInfrastructureLister.List(labels.Everything())
+	if err != nil {
+		logger.Logger.Errorf("Failed to list BackupInfrastructures of Seed %s: %v", newSeed.Name, err)
+		return
+	}
+	for _, backupInfrastructure := range backupInfrastructures {
+		if backupInfrastructure.Spec.Seed == newSeed.Name {
+			c.backupInfrastructureAdd(backupInfrastructure)
+		}
+	}
+}
+
+func (c *Controller) reconcileBackupInfrastructureKey(ctx context.Context, key string) error {
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
 		return err
@@ -95,6 +121,11 @@ func (c *Controller) reconcileBackupInfrastructureKey(key string) error {
 		logger.Logger.Infof("[BACKUPINFRASTRUCTURE RECONCILE] %s - unable to retrieve object from store: %v", key, err)
 		return err
 	}
+	if !c.seedFilter(backupInfrastructure) {
+		logger.Logger.Debugf("[BACKUPINFRASTRUCTURE RECONCILE] %s - skipping because the Seed of the BackupInfrastructure is not selected by my seed selector", key)
+		c.backupInfrastructureQueue.Forget(key)
+		return nil
+	}
 
 	backupInfrastructureLogger := logger.NewFieldLogger(logger.Logger, "backupinfrastructure", fmt.Sprintf("%s/%s", backupInfrastructure.Namespace, backupInfrastructure.Name))
 
@@ -105,7 +136,10 @@ func (c *Controller) reconcileBackupInfrastructureKey(key string) error {
 	}
 
 	durationToNextSync := c.config.Controllers.BackupInfrastructure.SyncPeriod.Duration
-	if reconcileErr := c.control.ReconcileBackupInfrastructure(backupInfrastructure, key); reconcileErr != nil {
+	if bucketProbePeriod := c.config.Controllers.BackupInfrastructure.BucketProbePeriod; bucketProbePeriod != nil && bucketProbePeriod.Duration < durationToNextSync {
+		durationToNextSync = bucketProbePeriod.Duration
+	}
+	if reconcileErr := c.control.ReconcileBackupInfrastructure(ctx, backupInfrastructure, key); reconcileErr != nil {
 		durationToNextSync = 15 * time.Second
 	}
 	c.backupInfrastructureQueue.AddAfter(key, durationToNextSync)
@@ -120,7 +154,8 @@ type ControlInterface interface {
 	// If an implementation returns a non-nil error, the invocation will be retried using a rate-limited strategy.
 	// Implementors should sink any errors that they do not wish to trigger a retry, and they may feel free to
 	// exit exceptionally at any point provided they wish the update to be re-run at a later point in time.
-	ReconcileBackupInfrastructure(backupInfrastructure *gardenv1beta1.BackupInfrastructure, key string) error
+	// Running operations are aborted once the given context is cancelled.
+	ReconcileBackupInfrastructure(ctx context.Context, backupInfrastructure *gardenv1beta1.BackupInfrastructure, key string) error
 }
 
 // NewDefaultControl returns a new instance of the default implementation ControlInterface that
@@ -140,7 +175,7 @@ type defaultControl struct {
 	recorder           record.EventRecorder
 }
 
-func (c *defaultControl) ReconcileBackupInfrastructure(obj *gardenv1beta1.BackupInfrastructure, key string) error {
+func (c *defaultControl) ReconcileBackupInfrastructure(ctx context.Context, obj *gardenv1beta1.BackupInfrastructure, key string) error {
 	key, err := cache.MetaNamespaceKeyFunc(obj)
 	if err != nil {
 		return err
@@ -156,11 +191,16 @@ func (c *defaultControl) ReconcileBackupInfrastructure(obj *gardenv1beta1.Backup
 	logger.Logger.Infof("[BACKUPINFRASTRUCTURE RECONCILE] %s", key)
 
 	// Skip further logic if the last successful reconciliation happened less than the specified syncPeriod ago
-	// and the object does not have an explicit reconcile instruction in its annotations.
-	syncPeriod := c.config.Controllers.BackupInfrastructure.SyncPeriod.Duration
-	if backupInfrastructure.DeletionTimestamp == nil &&
-		!nextReconcileScheduleReached(obj, syncPeriod) &&
-		!kutil.HasMetaDataAnnotation(&obj.ObjectMeta, common.BackupInfrastructureOperation, common.BackupInfrastructureReconcile) {
+	// and the object does not have an explicit reconcile instruction in its annotations. The backup bucket is
+	// still probed if the last probe happened more than the specified bucketProbePeriod ago.
+	var (
+		syncPeriod         = c.config.Controllers.BackupInfrastructure.SyncPeriod.Duration
+		reconcileScheduled = backupInfrastructure.DeletionTimestamp != nil ||
+			nextReconcileScheduleReached(obj, syncPeriod) ||
+			kutil.HasMetaDataAnnotation(&obj.ObjectMeta, common.BackupInfrastructureOperation, common.BackupInfrastructureReconcile)
+		probeScheduled = nextBucketProbeScheduleReached(obj, c.config.Controllers.BackupInfrastructure.BucketProbePeriod)
+	)
+	if !reconcileScheduled && !probeScheduled {
 		logger.Logger.Infof("Skip reconciliation for BackupInfrastructure %s. Last successful operation happened less than %q ago and reconcile annotation is not set.", key, syncPeriod)
 		return nil
 	}
@@ -168,24 +208,34 @@ func (c *defaultControl) ReconcileBackupInfrastructure(obj *gardenv1beta1.Backup
 	backupInfrastructureJSON, _ := json.Marshal(backupInfrastructure)
 	backupInfrastructureLogger.Debugf(string(backupInfrastructureJSON))
 
-	op, err := operation.NewWithBackupInfrastructure(backupInfrastructure, backupInfrastructureLogger, c.k8sGardenClient, c.k8sGardenInformers, c.identity, c.secrets, c.imageVector)
+	operationID, err := utils.GenerateRandomString(8)
+	if err != nil {
+		return err
+	}
+
+	op, err := operation.NewWithBackupInfrastructure(backupInfrastructure, backupInfrastructureLogger.WithField(logger.FieldOperationID, operationID), c.k8sGardenClient, c.k8sGardenInformers, c.identity, c.secrets, c.imageVector)
 	if err != nil {
 		backupInfrastructureLogger.Errorf("Could not initialize a new operation: %s", err.Error())
 		return err
 	}
 
+	if !reconcileScheduled {
+		logger.Logger.Infof("Skip reconciliation for BackupInfrastructure %s. Last successful operation happened less than %q ago and reconcile annotation is not set, only probing the backup bucket.", key, syncPeriod)
+		return c.probeBackupBucket(ctx, op)
+	}
+
 	// The deletionTimestamp labels a BackupInfrastructure as intended to get deleted. Before deletion,
 	// it has to be ensured that no infrastructure resources are depending on the BackupInfrastructure anymore.
 	// When this happens the controller will remove the finalizer from the BackupInfrastructure so that it can be garbage collected.
 	if backupInfrastructure.DeletionTimestamp != nil {
-		gracePeriod := time.Hour * 24 * time.Duration(*c.config.Controllers.BackupInfrastructure.DeletionGracePeriodDays)
+		gracePeriod := deletionGracePeriod(backupInfrastructure, time.Hour*24*time.Duration(*c.config.Controllers.BackupInfrastructure.DeletionGracePeriodDays))
 		if time.Now().Sub(backupInfrastructure.DeletionTimestamp.Time) > gracePeriod {
 			if updateErr := c.updateBackupInfrastructureStatus(op, gardencorev1alpha1.LastOperationStateProcessing, operationType, "Deletion of Backup Infrastructure in progress.", 1, nil); updateErr != nil {
 				backupInfrastructureLogger.Errorf("Could not update the BackupInfrastructure status after deletion start: %+v", updateErr)
 				return updateErr
 			}
 
-			if deleteErr := c.deleteBackupInfrastructure(op); deleteErr != nil {
+			if deleteErr := c.deleteBackupInfrastructure(ctx, op); deleteErr != nil {
 				c.recorder.Eventf(backupInfrastructure, corev1.EventTypeWarning, gardenv1beta1.EventDeleteError, "%s", deleteErr.Description)
 				if updateErr := c.updateBackupInfrastructureStatus(op, gardencorev1alpha1.LastOperationStateError, operationType, deleteErr.Description+" Operation will be retried.", 1, deleteErr); updateErr != nil {
 					backupInfrastructureLogger.Errorf("Could not update the BackupInfrastructure status after deletion error: %+v", updateErr)
@@ -212,7 +262,7 @@ func (c *defaultControl) ReconcileBackupInfrastructure(obj *gardenv1beta1.Backup
 		backupInfrastructureLogger.Errorf("Could not update the BackupInfrastructure status after reconciliation start: %+v", updateErr)
 		return updateErr
 	}
-	if reconcileErr := c.reconcileBackupInfrastructure(op); reconcileErr != nil {
+	if reconcileErr := c.reconcileBackupInfrastructure(ctx, op); reconcileErr != nil {
 		c.recorder.Eventf(backupInfrastructure, corev1.EventTypeWarning, gardenv1beta1.EventReconcileError, "%s", reconcileErr.Description)
 		if updateErr := c.updateBackupInfrastructureStatus(op, gardencorev1alpha1.LastOperationStateError, operationType, reconcileErr.Description+" Operation will be retried.", 1, reconcileErr); updateErr != nil {
 			backupInfrastructureLogger.Errorf("Could not update the BackupInfrastructure status after reconciliation error: %+v", updateErr)
@@ -224,12 +274,15 @@ func (c *defaultControl) ReconcileBackupInfrastructure(obj *gardenv1beta1.Backup
 		backupInfrastructureLogger.Errorf("Could not update the Shoot status after reconciliation success: %+v", updateErr)
 		return updateErr
 	}
+	if probeErr := c.probeBackupBucket(ctx, op); probeErr != nil {
+		return probeErr
+	}
 
-	if _, updateErr := kutil.TryUpdateBackupInfrastructureAnnotations(op.K8sGardenClient.Garden(), retry.DefaultRetry, obj.ObjectMeta,
-		func(backupInfrastructure *gardenv1beta1.BackupInfrastructure) (*gardenv1beta1.BackupInfrastructure, error) {
-			delete(backupInfrastructure.Annotations, common.BackupInfrastructureOperation)
-			return backupInfrastructure, nil
-		}); updateErr != nil {
+	newBackupInfrastructure := obj.DeepCopy()
+	if updateErr := kutil.TryUpdate(ctx, kutil.DefaultBackoff, op.K8sGardenClient.Client(), newBackupInfrastructure, func() error {
+		delete(newBackupInfrastructure.Annotations, common.BackupInfrastructureOperation)
+		return nil
+	}); updateErr != nil {
 		backupInfrastructureLogger.Errorf("Could not remove %q annotation: %+v", common.BackupInfrastructureOperation, updateErr)
 		return updateErr
 	}
@@ -237,16 +290,43 @@ func (c *defaultControl) ReconcileBackupInfrastructure(obj *gardenv1beta1.Backup
 	return nil
 }
 
+// deletionGracePeriod returns the period the given BackupInfrastructure is kept after its deletion timestamp has been
+// set, i.e., the configured grace period or the retention required by the DeletionProtectionPolicies which matched the
+// Shoot, whichever is longer.
+func deletionGracePeriod(backupInfrastructure *gardenv1beta1.BackupInfrastructure, configuredGracePeriod time.Duration) time.Duration {
+	if value, ok := backupInfrastructure.Annotations[common.BackupInfrastructureRetention]; ok {
+		if retention, err := time.ParseDuration(value); err == nil && retention > configuredGracePeriod {
+			return retention
+		}
+	}
+	return configuredGracePeriod
+}
+
+// forcePurgeRequested returns true if the given BackupInfrastructure is annotated to be purged without Terraform.
+func forcePurgeRequested(backupInfrastructure *gardenv1beta1.BackupInfrastructure) bool {
+	return kutil.HasMetaDataAnnotation(&backupInfrastructure.ObjectMeta, common.BackupInfrastructureOperation, common.BackupInfrastructureForcePurge)
+}
+
+// forcePurgeConfirmed returns true if the given BackupInfrastructure is annotated to be purged without Terraform and
+// the purge has been confirmed by setting the confirmation annotation to the name of the BackupInfrastructure.
+func forcePurgeConfirmed(backupInfrastructure *gardenv1beta1.BackupInfrastructure) bool {
+	return forcePurgeRequested(backupInfrastructure) &&
+		kutil.HasMetaDataAnnotation(&backupInfrastructure.ObjectMeta, common.ConfirmationForcePurge, backupInfrastructure.Name)
+}
+
 // reconcileBackupInfrastructure reconciles a BackupInfrastructure state.
-func (c *defaultControl) reconcileBackupInfrastructure(o *operation.Operation) *gardencorev1alpha1.LastError {
+func (c *defaultControl) reconcileBackupInfrastructure(ctx context.Context, o *operation.Operation) *gardencorev1alpha1.LastError {
 	// We create botanists (which will do the actual work).
 	botanist, err := botanistpkg.New(o)
 	if err != nil {
 		return formatError("Failed to create a Botanist", err)
 	}
-	seedCloudBotanist, err := cloudbotanistpkg.New(o, common.CloudPurposeSeed)
+	backupCloudBotanist, err := cloudbotanistpkg.New(o, common.CloudPurposeBackup)
 	if err != nil {
-		return formatError("Failed to create a Seed CloudBotanist", err)
+		return formatError("Failed to create a backup CloudBotanist", err)
+	}
+	if err := validateBackupRegion(o); err != nil {
+		return formatError("Invalid backup region", err)
 	}
 
 	var (
@@ -262,7 +342,7 @@ func (c *defaultControl) reconcileBackupInfrastructure(o *operation.Operation) *
 
 		_ = g.Add(flow.Task{
 			Name:         "Deploying backup infrastructure",
-			Fn:           flow.SimpleTaskFn(seedCloudBotanist.DeployBackupInfrastructure),
+			Fn:           flow.TaskFn(backupCloudBotanist.DeployBackupInfrastructure),
 			Dependencies: flow.NewTaskIDs(deployBackupNamespace),
 		})
 
@@ -271,6 +351,7 @@ func (c *defaultControl) reconcileBackupInfrastructure(o *operation.Operation) *
 	err = f.Run(flow.Opts{
 		Logger:           o.Logger,
 		ProgressReporter: o.ReportBackupInfrastructureProgress,
+		Context:          ctx,
 	})
 	if err != nil {
 		o.Logger.Errorf("Failed to reconcile backup infrastructure %q: %+v", o.BackupInfrastructure.Name, err)
@@ -285,8 +366,23 @@ func (c *defaultControl) reconcileBackupInfrastructure(o *operation.Operation) *
 	return nil
 }
 
+// validateBackupRegion checks that the region of the backup bucket is a region of the cloud profile of the Seed.
+// Backup buckets of another provider than the one of the Seed cannot be checked against the cloud profile.
+func validateBackupRegion(o *operation.Operation) error {
+	region := o.BackupInfrastructure.Spec.Region
+	if region == nil || o.Seed.BackupProvider != o.Seed.CloudProvider {
+		return nil
+	}
+
+	regions := helper.GetRegionsFromCloudProfile(o.Seed.CloudProvider, o.Seed.CloudProfile)
+	if !utils.ValueExists(*region, regions) {
+		return fmt.Errorf("region %q is not a region of cloud profile %q, supported regions are %v", *region, o.Seed.CloudProfile.Name, regions)
+	}
+	return nil
+}
+
 // deleteBackupInfrastructure deletes a BackupInfrastructure entirely.
-func (c *defaultControl) deleteBackupInfrastructure(o *operation.Operation) *gardencorev1alpha1.LastError {
+func (c *defaultControl) deleteBackupInfrastructure(ctx context.Context, o *operation.Operation) *gardencorev1alpha1.LastError {
 	// We create botanists (which will do the actual work).
 	botanist, err := botanistpkg.New(o)
 	if err != nil {
@@ -304,9 +400,19 @@ func (c *defaultControl) deleteBackupInfrastructure(o *operation.Operation) *gar
 		return formatError("Failed to retrieve the backup namespace in the Seed cluster", err)
 	}
 
-	seedCloudBotanist, err := cloudbotanistpkg.New(o, common.CloudPurposeSeed)
+	backupCloudBotanist, err := cloudbotanistpkg.New(o, common.CloudPurposeBackup)
 	if err != nil {
-		return formatError("Failed to create a Seed CloudBotanist", err)
+		return formatError("Failed to create a backup CloudBotanist", err)
+	}
+
+	// An operator can request to purge the backup infrastructure directly via the cloud provider API instead of
+	// destroying it with Terraform, e.g. if the Terraform state is missing or corrupted. As this irrevocably deletes all
+	// backups, the request must be confirmed explicitly.
+	forcePurge := forcePurgeConfirmed(o.BackupInfrastructure)
+	if forcePurgeRequested(o.BackupInfrastructure) && !forcePurge {
+		message := fmt.Sprintf("Force purge of backup infrastructure has been requested but is not confirmed (annotation %q must be set to %q), destroying it with Terraform", common.ConfirmationForcePurge, o.BackupInfrastructure.Name)
+		o.Logger.Info(message)
+		c.recorder.Eventf(o.BackupInfrastructure, corev1.EventTypeWarning, gardenv1beta1.EventDeleting, "%s", message)
 	}
 
 	// We check whether the Backup namespace in the Seed cluster is already in a terminating state, i.e. whether
@@ -320,12 +426,16 @@ func (c *defaultControl) deleteBackupInfrastructure(o *operation.Operation) *gar
 		g                           = flow.NewGraph("Backup infrastructure deletion")
 		destroyBackupInfrastructure = g.Add(flow.Task{
 			Name: "Destroying backup infrastructure",
-			Fn:   flow.SimpleTaskFn(seedCloudBotanist.DestroyBackupInfrastructure).DoIf(cleanupBackupInfrastructureResources),
+			Fn:   flow.TaskFn(backupCloudBotanist.DestroyBackupInfrastructure).DoIf(cleanupBackupInfrastructureResources && !forcePurge),
+		})
+		purgeBackupInfrastructure = g.Add(flow.Task{
+			Name: "Purging backup infrastructure",
+			Fn:   flow.TaskFn(backupCloudBotanist.PurgeBackupInfrastructure).DoIf(cleanupBackupInfrastructureResources && forcePurge),
 		})
 		deleteBackupNamespace = g.Add(flow.Task{
 			Name:         "Deleting backup namespace",
 			Fn:           flow.SimpleTaskFn(botanist.DeleteBackupNamespace).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			Dependencies: flow.NewTaskIDs(destroyBackupInfrastructure),
+			Dependencies: flow.NewTaskIDs(destroyBackupInfrastructure, purgeBackupInfrastructure),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Waiting until backup namespace is deleted",
@@ -337,6 +447,7 @@ func (c *defaultControl) deleteBackupInfrastructure(o *operation.Operation) *gar
 	err = f.Run(flow.Opts{
 		Logger:           o.Logger,
 		ProgressReporter: o.ReportBackupInfrastructureProgress,
+		Context:          ctx,
 	})
 	if err != nil {
 		o.Logger.Errorf("Failed to delete backup infrastructure %q: %+v", o.BackupInfrastructure.Name, err)
@@ -363,26 +474,70 @@ func (c *defaultControl) updateBackupInfrastructureStatus(o *operation.Operation
 		LastUpdateTime: metav1.Now(),
 	}
 
-	newBackupInfrastructure, err := kutil.TryUpdateBackupInfrastructureStatus(c.k8sGardenClient.Garden(), retry.DefaultRetry, o.BackupInfrastructure.ObjectMeta,
-		func(backupInfrastructure *gardenv1beta1.BackupInfrastructure) (*gardenv1beta1.BackupInfrastructure, error) {
-			backupInfrastructure.Status.LastOperation = lastOperation
-			backupInfrastructure.Status.LastError = lastError
-			backupInfrastructure.Status.ObservedGeneration = backupInfrastructure.Generation
-			return backupInfrastructure, nil
-		})
-	if err == nil {
-		o.BackupInfrastructure = newBackupInfrastructure
+	newBackupInfrastructure := o.BackupInfrastructure.DeepCopy()
+	if err := kutil.TryUpdateStatus(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), newBackupInfrastructure, func() error {
+		newBackupInfrastructure.Status.LastOperation = lastOperation
+		newBackupInfrastructure.Status.LastError = lastError
+		newBackupInfrastructure.Status.ObservedGeneration = newBackupInfrastructure.Generation
+		return nil
+	}); err != nil {
+		return err
 	}
-	return err
+	o.BackupInfrastructure = newBackupInfrastructure
+	return nil
 }
 
-func (c *defaultControl) removeFinalizer(op *operation.Operation) error {
-	backupInfrastructureFinalizers := sets.NewString(op.BackupInfrastructure.Finalizers...)
-	backupInfrastructureFinalizers.Delete(gardenv1beta1.GardenerName)
-	op.BackupInfrastructure.Finalizers = backupInfrastructureFinalizers.UnsortedList()
+// probeBackupBucket verifies that the backup bucket exists, that it is writable, and that the credentials used to
+// access it are valid. The result is published as BucketReady condition of the BackupInfrastructure.
+func (c *defaultControl) probeBackupBucket(ctx context.Context, o *operation.Operation) error {
+	condition := gardencorev1alpha1helper.GetOrInitCondition(o.BackupInfrastructure.Status.Conditions, gardenv1beta1.BackupInfrastructureBucketReady)
 
-	newBackupInfrastructure, err := c.k8sGardenClient.Garden().GardenV1beta1().BackupInfrastructures(op.BackupInfrastructure.Namespace).Update(op.BackupInfrastructure)
+	backupCloudBotanist, err := cloudbotanistpkg.New(o, common.CloudPurposeBackup)
 	if err != nil {
+		condition = gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(condition, fmt.Sprintf("Failed to create a Seed CloudBotanist (%s).", err.Error()))
+	} else {
+		probeCtx, cancel := context.WithTimeout(ctx, time.Minute)
+		condition = computeBucketReadyCondition(condition, backupCloudBotanist.ProbeBackupInfrastructure(probeCtx))
+		cancel()
+	}
+
+	if condition.Status == gardencorev1alpha1.ConditionFalse {
+		c.recorder.Eventf(o.BackupInfrastructure, corev1.EventTypeWarning, condition.Reason, "%s", condition.Message)
+	}
+
+	newBackupInfrastructure := o.BackupInfrastructure.DeepCopy()
+	if err := kutil.TryUpdateStatus(ctx, kutil.DefaultBackoff, c.k8sGardenClient.Client(), newBackupInfrastructure, func() error {
+		newBackupInfrastructure.Status.Conditions = gardencorev1alpha1helper.MergeConditions(newBackupInfrastructure.Status.Conditions, condition)
+		return nil
+	}); err != nil {
+		o.Logger.Errorf("Could not update the BackupInfrastructure conditions: %+v", err)
+		return err
+	}
+	o.BackupInfrastructure = newBackupInfrastructure
+	return nil
+}
+
+// computeBucketReadyCondition computes the BucketReady condition based on the result <probeErr> of the bucket probe.
+func computeBucketReadyCondition(condition gardencorev1alpha1.Condition, probeErr error) gardencorev1alpha1.Condition {
+	switch {
+	case probeErr == common.ErrBackupBucketProbeNotSupported:
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionUnknown, "BucketProbeNotSupported", probeErr.Error())
+	case terraformer.IsVariablesNotFoundError(probeErr):
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionUnknown, "BucketNotYetCreated", "The backup bucket has not yet been created.")
+	case probeErr != nil:
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "BucketProbeFailed", probeErr.Error())
+	}
+	return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "BucketProbeSucceeded", "The backup bucket exists, is writable, and the credentials are valid.")
+}
+
+func (c *defaultControl) removeFinalizer(op *operation.Operation) error {
+	newBackupInfrastructure := op.BackupInfrastructure.DeepCopy()
+	if err := kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), newBackupInfrastructure, func() error {
+		backupInfrastructureFinalizers := sets.NewString(newBackupInfrastructure.Finalizers...)
+		backupInfrastructureFinalizers.Delete(gardenv1beta1.GardenerName)
+		newBackupInfrastructure.Finalizers = backupInfrastructureFinalizers.UnsortedList()
+		return nil
+	}); err != nil {
 		op.Logger.Errorf("Could not remove finalizer of the BackupInfrastructure: %+v", err.Error())
 		return err
 	}
@@ -411,6 +566,18 @@ func formatError(message string, err error) *gardencorev1alpha1.LastError {
 	}
 }
 
+func nextBucketProbeScheduleReached(obj *gardenv1beta1.BackupInfrastructure, bucketProbePeriod *metav1.Duration) bool {
+	if bucketProbePeriod == nil {
+		return false
+	}
+
+	condition := gardencorev1alpha1helper.GetCondition(obj.Status.Conditions, gardenv1beta1.BackupInfrastructureBucketReady)
+	if condition == nil {
+		return true
+	}
+	return time.Now().After(condition.LastUpdateTime.Add(bucketProbePeriod.Duration))
+}
+
 func nextReconcileScheduleReached(obj *gardenv1beta1.BackupInfrastructure, syncPeriod time.Duration) bool {
 	lastOperation := obj.Status.LastOperation
 
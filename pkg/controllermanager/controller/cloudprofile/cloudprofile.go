@@ -22,6 +22,7 @@ import (
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	gardenmetrics "github.com/gardener/gardener/pkg/controllermanager/metrics"
 	"github.com/gardener/gardener/pkg/logger"
@@ -37,7 +38,9 @@ type Controller struct {
 	k8sGardenClient    kubernetes.Interface
 	k8sGardenInformers gardeninformers.SharedInformerFactory
 
-	control ControlInterface
+	config         *config.ControllerManagerConfiguration
+	control        ControlInterface
+	catalogControl CatalogControlInterface
 
 	cloudProfileLister gardenlisters.CloudProfileLister
 	cloudProfileQueue  workqueue.RateLimitingInterface
@@ -50,9 +53,9 @@ type Controller struct {
 	numberOfRunningWorkers int
 }
 
-// NewCloudProfileController takes a Kubernetes client <k8sGardenClient> and a <k8sGardenInformers> for the Garden clusters.
-// It creates and return a new Garden controller to control CloudProfiles.
-func NewCloudProfileController(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory) *Controller {
+// NewCloudProfileController takes a Kubernetes client <k8sGardenClient> and a <k8sGardenInformers> for the Garden clusters
+// as well as the controller manager <config>. It creates and return a new Garden controller to control CloudProfiles.
+func NewCloudProfileController(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, config *config.ControllerManagerConfiguration) *Controller {
 	var (
 		gardenv1beta1Informer = k8sGardenInformers.Garden().V1beta1()
 		cloudProfileInformer  = gardenv1beta1Informer.CloudProfiles()
@@ -67,7 +70,9 @@ func NewCloudProfileController(k8sGardenClient kubernetes.Interface, k8sGardenIn
 		cloudProfileQueue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "cloudprofile"),
 		seedLister:         seedLister,
 		shootLister:        shootLister,
+		config:             config,
 		control:            NewDefaultControl(k8sGardenClient, seedLister, shootLister),
+		catalogControl:     NewDefaultCatalogControl(k8sGardenClient),
 		workerCh:           make(chan int),
 	}
 
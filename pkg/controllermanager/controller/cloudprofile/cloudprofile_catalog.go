@@ -0,0 +1,375 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudprofile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	awsclient "github.com/gardener/gardener/pkg/client/aws"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/cloudbotanist/awsbotanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// catalogGenerated records the entries of a CloudProfile which have been generated from the catalog of the cloud
+// provider. Entries which are not recorded here belong to the operator and are never modified by the controller.
+type catalogGenerated struct {
+	// LastSyncTime is the time when the catalog has been fetched for the last time.
+	LastSyncTime metav1.Time `json:"lastSyncTime"`
+	// Zones is the list of regions whose zone entries have been generated.
+	Zones []string `json:"zones,omitempty"`
+	// MachineImages maps machine image names to the list of regions whose image ids have been generated.
+	MachineImages map[string][]string `json:"machineImages,omitempty"`
+}
+
+// awsCatalog contains the data fetched from the catalog of AWS.
+type awsCatalog struct {
+	// zones maps region names to the names of the available zones.
+	zones map[string][]string
+	// machineImages maps machine image names to a map of region names and AMIs.
+	machineImages map[string]map[string]string
+}
+
+// CatalogControlInterface implements the control logic for refreshing CloudProfiles from the catalogs of the cloud
+// providers.
+type CatalogControlInterface interface {
+	// SyncCatalog refreshes the given CloudProfile if its last sync is older than <syncPeriod>. It returns the
+	// duration after which the CloudProfile should be synced again.
+	SyncCatalog(cloudProfile *gardenv1beta1.CloudProfile, syncPeriod time.Duration) (time.Duration, error)
+}
+
+// NewDefaultCatalogControl returns a new instance of the default implementation CatalogControlInterface that
+// implements the documented semantics for refreshing CloudProfiles.
+func NewDefaultCatalogControl(k8sGardenClient kubernetes.Interface) CatalogControlInterface {
+	return &defaultCatalogControl{k8sGardenClient.Client(), awsclient.NewClient}
+}
+
+type defaultCatalogControl struct {
+	client       client.Client
+	newAWSClient func(accessKeyID, secretAccessKey, region string) awsclient.ClientInterface
+}
+
+func (c *defaultCatalogControl) SyncCatalog(obj *gardenv1beta1.CloudProfile, syncPeriod time.Duration) (time.Duration, error) {
+	var (
+		ctx                = context.TODO()
+		cloudProfile       = obj.DeepCopy()
+		cloudProfileLogger = logger.NewFieldLogger(logger.Logger, "cloudprofile", cloudProfile.Name)
+	)
+
+	generated, err := decodeCatalogGenerated(cloudProfile)
+	if err != nil {
+		return syncPeriod, err
+	}
+	if nextSync := generated.LastSyncTime.Add(syncPeriod); time.Now().Before(nextSync) {
+		return time.Until(nextSync), nil
+	}
+
+	secret, err := c.getSecret(ctx, cloudProfile.Annotations[common.CloudProfileCatalogSecretRef])
+	if err != nil {
+		return syncPeriod, err
+	}
+
+	cloudProvider, err := helper.DetermineCloudProviderInProfile(cloudProfile.Spec)
+	if err != nil {
+		return syncPeriod, err
+	}
+
+	switch cloudProvider {
+	case gardenv1beta1.CloudProviderAWS:
+		newClient := func(region string) awsclient.ClientInterface {
+			return c.newAWSClient(string(secret.Data[awsbotanist.AccessKeyID]), string(secret.Data[awsbotanist.SecretAccessKey]), region)
+		}
+		catalog, err := fetchAWSCatalog(newClient, cloudProfile.Spec.AWS, generated)
+		if err != nil {
+			return syncPeriod, err
+		}
+
+		// The catalog is merged into the latest version of the CloudProfile, so that concurrent changes of the operator
+		// are neither lost nor overwritten.
+		if err := kutil.TryUpdate(ctx, kutil.DefaultBackoff, c.client, cloudProfile, func() error {
+			if cloudProfile.Spec.AWS == nil {
+				return fmt.Errorf("CloudProfile %q is no longer an AWS profile", cloudProfile.Name)
+			}
+			generated, err := decodeCatalogGenerated(cloudProfile)
+			if err != nil {
+				return err
+			}
+			generated = mergeAWSCatalog(cloudProfile.Spec.AWS, generated, catalog)
+			return encodeCatalogGenerated(cloudProfile, generated)
+		}); err != nil {
+			return syncPeriod, err
+		}
+	default:
+		cloudProfileLogger.Infof("Refreshing CloudProfiles from the catalog of cloud provider %q is not supported", cloudProvider)
+		return syncPeriod, nil
+	}
+
+	cloudProfileLogger.Infof("Refreshed CloudProfile from the catalog of cloud provider %q", cloudProvider)
+	return syncPeriod, nil
+}
+
+func (c *defaultCatalogControl) getSecret(ctx context.Context, secretRef string) (*corev1.Secret, error) {
+	parts := strings.Split(secretRef, "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("annotation %s must have the form <namespace>/<name>, got %q", common.CloudProfileCatalogSecretRef, secretRef)
+	}
+	secret := &corev1.Secret{}
+	return secret, c.client.Get(ctx, kutil.Key(parts[0], parts[1]), secret)
+}
+
+// decodeCatalogGenerated returns the record of the generated entries of the given CloudProfile.
+func decodeCatalogGenerated(cloudProfile *gardenv1beta1.CloudProfile) (*catalogGenerated, error) {
+	generated := &catalogGenerated{}
+	if data, ok := cloudProfile.Annotations[common.CloudProfileCatalogGenerated]; ok {
+		if err := json.Unmarshal([]byte(data), generated); err != nil {
+			return nil, fmt.Errorf("could not decode annotation %s: %v", common.CloudProfileCatalogGenerated, err)
+		}
+	}
+	return generated, nil
+}
+
+// encodeCatalogGenerated records the given generated entries on the given CloudProfile together with the current time
+// as time of the last sync.
+func encodeCatalogGenerated(cloudProfile *gardenv1beta1.CloudProfile, generated *catalogGenerated) error {
+	generated.LastSyncTime = metav1.Now()
+	data, err := json.Marshal(generated)
+	if err != nil {
+		return err
+	}
+	metav1.SetMetaDataAnnotation(&cloudProfile.ObjectMeta, common.CloudProfileCatalogGenerated, string(data))
+	return nil
+}
+
+// fetchAWSCatalog fetches the available zones of all regions as well as the AMIs of the machine images of the given
+// <profile> in all regions. The AMIs are looked up by the name and the owner of an image which has been specified by
+// the operator.
+func fetchAWSCatalog(newClient func(region string) awsclient.ClientInterface, profile *gardenv1beta1.AWSProfile, generated *catalogGenerated) (*awsCatalog, error) {
+	catalog := &awsCatalog{
+		zones:         map[string][]string{},
+		machineImages: map[string]map[string]string{},
+	}
+
+	if len(profile.Constraints.Zones) == 0 {
+		return nil, fmt.Errorf("cannot fetch the catalog without any zone in the CloudProfile")
+	}
+
+	regions, err := newClient(profile.Constraints.Zones[0].Region).ListRegions()
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(regions)
+
+	for _, region := range regions {
+		zones, err := newClient(region).ListAvailabilityZones()
+		if err != nil {
+			return nil, err
+		}
+		if len(zones) > 0 {
+			sort.Strings(zones)
+			catalog.zones[region] = zones
+		}
+	}
+
+	for _, image := range profile.Constraints.MachineImages {
+		var (
+			name             = string(image.Name)
+			generatedRegions = sets.NewString(generated.MachineImages[name]...)
+			operatorRegions  = sets.NewString()
+			reference        *gardenv1beta1.AWSRegionalMachineImage
+		)
+
+		for i, regionalImage := range image.Regions {
+			if generatedRegions.Has(regionalImage.Name) {
+				continue
+			}
+			operatorRegions.Insert(regionalImage.Name)
+			if reference == nil {
+				reference = &image.Regions[i]
+			}
+		}
+		if reference == nil {
+			continue
+		}
+
+		imageName, ownerID, err := newClient(reference.Name).GetImageName(reference.AMI)
+		if err != nil {
+			return nil, err
+		}
+
+		catalog.machineImages[name] = map[string]string{}
+		for _, region := range regions {
+			if operatorRegions.Has(region) {
+				continue
+			}
+			ami, err := newClient(region).FindImage(imageName, ownerID)
+			if err != nil {
+				return nil, err
+			}
+			if len(ami) > 0 {
+				catalog.machineImages[name][region] = ami
+			}
+		}
+	}
+
+	return catalog, nil
+}
+
+// mergeAWSCatalog merges the <catalog> into the given <profile>. Entries which have been added by the operator are kept
+// as they are. Generated entries are updated, added, or removed according to the catalog. It returns the record of the
+// generated entries after the merge.
+func mergeAWSCatalog(profile *gardenv1beta1.AWSProfile, generated *catalogGenerated, catalog *awsCatalog) *catalogGenerated {
+	result := &catalogGenerated{
+		LastSyncTime:  generated.LastSyncTime,
+		MachineImages: map[string][]string{},
+	}
+	profile.Constraints.Zones, result.Zones = mergeZones(profile.Constraints.Zones, generated.Zones, catalog.zones)
+
+	for i, image := range profile.Constraints.MachineImages {
+		var (
+			name             = string(image.Name)
+			amis, fetched    = catalog.machineImages[name]
+			generatedRegions = sets.NewString(generated.MachineImages[name]...)
+			knownRegions     = sets.NewString()
+			regions          []gardenv1beta1.AWSRegionalMachineImage
+		)
+
+		if !fetched {
+			if len(generated.MachineImages[name]) > 0 {
+				result.MachineImages[name] = generated.MachineImages[name]
+			}
+			continue
+		}
+
+		for _, regionalImage := range image.Regions {
+			// The catalog only contains amd64 images, entries for other architectures are always kept.
+			if arch := regionalImage.Architecture; arch != nil && *arch != gardenv1beta1.ArchitectureAMD64 {
+				regions = append(regions, regionalImage)
+				continue
+			}
+			if !generatedRegions.Has(regionalImage.Name) {
+				knownRegions.Insert(regionalImage.Name)
+				regions = append(regions, regionalImage)
+				continue
+			}
+			if ami, ok := amis[regionalImage.Name]; ok {
+				regionalImage.AMI = ami
+				knownRegions.Insert(regionalImage.Name)
+				regions = append(regions, regionalImage)
+				result.MachineImages[name] = append(result.MachineImages[name], regionalImage.Name)
+			}
+		}
+		for _, region := range sets.StringKeySet(amis).List() {
+			if knownRegions.Has(region) {
+				continue
+			}
+			regions = append(regions, gardenv1beta1.AWSRegionalMachineImage{Name: region, AMI: amis[region]})
+			result.MachineImages[name] = append(result.MachineImages[name], region)
+		}
+		profile.Constraints.MachineImages[i].Regions = regions
+	}
+
+	return result
+}
+
+// mergeZones merges the zones of the <catalog> into the given <zones>. The entries of regions which are not part of the
+// <generatedRegions> belong to the operator and are kept as they are. The zones of the other regions are merged by their
+// names, so that regions whose zones are split into several entries with different availability keep their entries:
+// Zones which are no longer part of the catalog are removed from the entries, and entries without any zone are removed.
+// New zones are added to the entry of their region without unavailable types, or to a new entry if there is none. It
+// returns the merged zones and the regions whose entries have been generated.
+func mergeZones(zones []gardenv1beta1.Zone, generatedRegions []string, catalog map[string][]string) ([]gardenv1beta1.Zone, []string) {
+	var (
+		generated     = sets.NewString(generatedRegions...)
+		operator      = sets.NewString()
+		knownZones    = map[string]sets.String{}
+		mergedRegions []string
+		merged        []gardenv1beta1.Zone
+	)
+
+	for _, zone := range zones {
+		if !generated.Has(zone.Region) {
+			operator.Insert(zone.Region)
+			merged = append(merged, zone)
+			continue
+		}
+
+		catalogZones, ok := catalog[zone.Region]
+		if !ok {
+			continue
+		}
+		if _, ok := knownZones[zone.Region]; !ok {
+			knownZones[zone.Region] = sets.NewString()
+			mergedRegions = append(mergedRegions, zone.Region)
+		}
+
+		var (
+			available = sets.NewString(catalogZones...)
+			names     []string
+		)
+		for _, name := range zone.Names {
+			if available.Has(name) && !knownZones[zone.Region].Has(name) {
+				names = append(names, name)
+				knownZones[zone.Region].Insert(name)
+			}
+		}
+		if len(names) > 0 {
+			zone.Names = names
+			merged = append(merged, zone)
+		}
+	}
+
+	for _, region := range sets.StringKeySet(catalog).List() {
+		if operator.Has(region) {
+			continue
+		}
+		if _, ok := knownZones[region]; !ok {
+			knownZones[region] = sets.NewString()
+			mergedRegions = append(mergedRegions, region)
+		}
+
+		newZones := sets.NewString(catalog[region]...).Difference(knownZones[region]).List()
+		if len(newZones) == 0 {
+			continue
+		}
+
+		added := false
+		for i, zone := range merged {
+			if zone.Region == region && len(zone.UnavailableMachineTypes) == 0 && len(zone.UnavailableVolumeTypes) == 0 {
+				merged[i].Names = append(merged[i].Names, newZones...)
+				added = true
+				break
+			}
+		}
+		if !added {
+			merged = append(merged, gardenv1beta1.Zone{Region: region, Names: newZones})
+		}
+	}
+
+	return merged, mergedRegions
+}
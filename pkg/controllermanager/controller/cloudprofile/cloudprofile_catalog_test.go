@@ -0,0 +1,249 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudprofile
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	awsclient "github.com/gardener/gardener/pkg/client/aws"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// fakeAWSClient serves the catalog of a single AWS region.
+type fakeAWSClient struct {
+	awsclient.ClientInterface
+
+	region  string
+	regions []string
+	zones   map[string][]string
+}
+
+func (c *fakeAWSClient) ListRegions() ([]string, error) {
+	return c.regions, nil
+}
+
+func (c *fakeAWSClient) ListAvailabilityZones() ([]string, error) {
+	return c.zones[c.region], nil
+}
+
+var _ = Describe("CloudProfile catalog", func() {
+	Describe("#SyncCatalog", func() {
+		var (
+			ctx          = context.TODO()
+			c            client.Client
+			control      *defaultCatalogControl
+			cloudProfile *gardenv1beta1.CloudProfile
+		)
+
+		BeforeEach(func() {
+			cloudProfile = &gardenv1beta1.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "aws",
+					Annotations: map[string]string{
+						common.CloudProfileCatalogSecretRef: "garden/catalog",
+						common.CloudProfileCatalogGenerated: `{"lastSyncTime":"2019-01-01T00:00:00Z","zones":["us-east-1"]}`,
+					},
+				},
+				Spec: gardenv1beta1.CloudProfileSpec{
+					AWS: &gardenv1beta1.AWSProfile{
+						Constraints: gardenv1beta1.AWSConstraints{
+							Zones: []gardenv1beta1.Zone{
+								{Region: "us-east-1", Names: []string{"us-east-1a"}},
+							},
+						},
+					},
+				},
+			}
+
+			scheme := runtime.NewScheme()
+			Expect(kubernetesscheme.AddToScheme(scheme)).To(Succeed())
+			Expect(gardenv1beta1.AddToScheme(scheme)).To(Succeed())
+			c = fake.NewFakeClientWithScheme(scheme,
+				cloudProfile.DeepCopy(),
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "garden", Name: "catalog"}},
+			)
+
+			control = &defaultCatalogControl{
+				client: c,
+				newAWSClient: func(accessKeyID, secretAccessKey, region string) awsclient.ClientInterface {
+					return &fakeAWSClient{
+						region:  region,
+						regions: []string{"us-east-1", "eu-central-1"},
+						zones: map[string][]string{
+							"us-east-1":    {"us-east-1a", "us-east-1b"},
+							"eu-central-1": {"eu-central-1a"},
+						},
+					}
+				},
+			}
+		})
+
+		It("should merge the catalog into the latest version of the CloudProfile", func() {
+			// The operator has changed the CloudProfile after it has been read from the cache.
+			latest := &gardenv1beta1.CloudProfile{}
+			Expect(c.Get(ctx, kutil.Key("aws"), latest)).To(Succeed())
+			latest.Spec.AWS.Constraints.Zones = append(latest.Spec.AWS.Constraints.Zones, gardenv1beta1.Zone{Region: "eu-west-1", Names: []string{"eu-west-1a"}})
+			Expect(c.Update(ctx, latest)).To(Succeed())
+
+			requeueAfter, err := control.SyncCatalog(cloudProfile, time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requeueAfter).To(Equal(time.Hour))
+
+			synced := &gardenv1beta1.CloudProfile{}
+			Expect(c.Get(ctx, kutil.Key("aws"), synced)).To(Succeed())
+			Expect(synced.Spec.AWS.Constraints.Zones).To(Equal([]gardenv1beta1.Zone{
+				{Region: "us-east-1", Names: []string{"us-east-1a", "us-east-1b"}},
+				{Region: "eu-west-1", Names: []string{"eu-west-1a"}},
+				{Region: "eu-central-1", Names: []string{"eu-central-1a"}},
+			}))
+
+			generated := &catalogGenerated{}
+			Expect(json.Unmarshal([]byte(synced.Annotations[common.CloudProfileCatalogGenerated]), generated)).To(Succeed())
+			Expect(generated.Zones).To(Equal([]string{"us-east-1", "eu-central-1"}))
+			Expect(generated.LastSyncTime.Time).To(BeTemporally("~", time.Now(), time.Minute))
+		})
+
+		It("should not sync the CloudProfile before the sync period has passed", func() {
+			cloudProfile.Annotations[common.CloudProfileCatalogGenerated] = `{"lastSyncTime":"` + time.Now().UTC().Format(time.RFC3339) + `","zones":["us-east-1"]}`
+			control.newAWSClient = func(accessKeyID, secretAccessKey, region string) awsclient.ClientInterface {
+				Fail("catalog has been fetched")
+				return nil
+			}
+
+			requeueAfter, err := control.SyncCatalog(cloudProfile, time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requeueAfter).To(BeNumerically("~", time.Hour, time.Minute))
+		})
+	})
+
+	Describe("#mergeAWSCatalog", func() {
+		var (
+			profile   *gardenv1beta1.AWSProfile
+			generated *catalogGenerated
+			catalog   *awsCatalog
+		)
+
+		BeforeEach(func() {
+			profile = &gardenv1beta1.AWSProfile{
+				Constraints: gardenv1beta1.AWSConstraints{
+					MachineImages: []gardenv1beta1.AWSMachineImageMapping{
+						{
+							Name: gardenv1beta1.MachineImageCoreOS,
+							Regions: []gardenv1beta1.AWSRegionalMachineImage{
+								{Name: "eu-west-1", AMI: "ami-operator"},
+								{Name: "us-east-1", AMI: "ami-outdated"},
+								{Name: "ap-south-1", AMI: "ami-removed"},
+							},
+						},
+					},
+					Zones: []gardenv1beta1.Zone{
+						{Region: "eu-west-1", Names: []string{"eu-west-1a"}},
+						{Region: "us-east-1", Names: []string{"us-east-1a"}, UnavailableMachineTypes: []string{"p2.xlarge"}},
+						{Region: "ap-south-1", Names: []string{"ap-south-1a"}},
+					},
+				},
+			}
+			generated = &catalogGenerated{
+				Zones:         []string{"us-east-1", "ap-south-1"},
+				MachineImages: map[string][]string{"coreos": {"us-east-1", "ap-south-1"}},
+			}
+			catalog = &awsCatalog{
+				zones: map[string][]string{
+					"eu-west-1":    {"eu-west-1a", "eu-west-1b"},
+					"us-east-1":    {"us-east-1a", "us-east-1b"},
+					"eu-central-1": {"eu-central-1a"},
+				},
+				machineImages: map[string]map[string]string{
+					"coreos": {
+						"us-east-1":    "ami-current",
+						"eu-central-1": "ami-new",
+					},
+				},
+			}
+		})
+
+		It("should keep operator entries and update, add, and remove generated entries", func() {
+			result := mergeAWSCatalog(profile, generated, catalog)
+
+			Expect(profile.Constraints.Zones).To(Equal([]gardenv1beta1.Zone{
+				{Region: "eu-west-1", Names: []string{"eu-west-1a"}},
+				{Region: "us-east-1", Names: []string{"us-east-1a"}, UnavailableMachineTypes: []string{"p2.xlarge"}},
+				{Region: "eu-central-1", Names: []string{"eu-central-1a"}},
+				{Region: "us-east-1", Names: []string{"us-east-1b"}},
+			}))
+			Expect(profile.Constraints.MachineImages[0].Regions).To(Equal([]gardenv1beta1.AWSRegionalMachineImage{
+				{Name: "eu-west-1", AMI: "ami-operator"},
+				{Name: "us-east-1", AMI: "ami-current"},
+				{Name: "eu-central-1", AMI: "ami-new"},
+			}))
+			Expect(result.Zones).To(Equal([]string{"us-east-1", "eu-central-1"}))
+			Expect(result.MachineImages).To(Equal(map[string][]string{"coreos": {"us-east-1", "eu-central-1"}}))
+		})
+
+		It("should merge the zones of regions which are split into several entries by their names", func() {
+			profile.Constraints.Zones = []gardenv1beta1.Zone{
+				{Region: "us-east-1", Names: []string{"us-east-1a", "us-east-1c"}, UnavailableMachineTypes: []string{"p2.xlarge"}},
+				{Region: "us-east-1", Names: []string{"us-east-1d"}},
+			}
+			generated.Zones = []string{"us-east-1"}
+			catalog.zones = map[string][]string{"us-east-1": {"us-east-1a", "us-east-1b", "us-east-1d"}}
+
+			result := mergeAWSCatalog(profile, generated, catalog)
+
+			Expect(profile.Constraints.Zones).To(Equal([]gardenv1beta1.Zone{
+				{Region: "us-east-1", Names: []string{"us-east-1a"}, UnavailableMachineTypes: []string{"p2.xlarge"}},
+				{Region: "us-east-1", Names: []string{"us-east-1d", "us-east-1b"}},
+			}))
+			Expect(result.Zones).To(Equal([]string{"us-east-1"}))
+		})
+
+		It("should remove generated entries whose zones are no longer available", func() {
+			profile.Constraints.Zones = []gardenv1beta1.Zone{
+				{Region: "us-east-1", Names: []string{"us-east-1a"}},
+				{Region: "us-east-1", Names: []string{"us-east-1c"}, UnavailableMachineTypes: []string{"p2.xlarge"}},
+			}
+			generated.Zones = []string{"us-east-1"}
+			catalog.zones = map[string][]string{"us-east-1": {"us-east-1a"}}
+
+			mergeAWSCatalog(profile, generated, catalog)
+
+			Expect(profile.Constraints.Zones).To(Equal([]gardenv1beta1.Zone{
+				{Region: "us-east-1", Names: []string{"us-east-1a"}},
+			}))
+		})
+
+		It("should keep generated machine image entries which have not been fetched", func() {
+			catalog.machineImages = map[string]map[string]string{}
+
+			result := mergeAWSCatalog(profile, generated, catalog)
+
+			Expect(profile.Constraints.MachineImages[0].Regions).To(HaveLen(3))
+			Expect(result.MachineImages).To(Equal(generated.MachineImages))
+		})
+	})
+})
@@ -15,6 +15,7 @@
 package cloudprofile
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -24,6 +25,8 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -70,7 +73,19 @@ func (c *Controller) reconcileCloudProfileKey(key string) error {
 
 	if err := c.control.ReconcileCloudProfile(cloudProfile, key); err != nil {
 		c.cloudProfileQueue.AddAfter(key, 15*time.Second)
+		return nil
+	}
+
+	catalogSync := c.config.Controllers.CloudProfile.CatalogSync
+	if _, ok := cloudProfile.Annotations[common.CloudProfileCatalogSecretRef]; !ok || catalogSync == nil || cloudProfile.DeletionTimestamp != nil {
+		return nil
 	}
+
+	requeueAfter, err := c.catalogControl.SyncCatalog(cloudProfile, catalogSync.SyncPeriod.Duration)
+	if err != nil {
+		logger.Logger.Errorf("[CLOUDPROFILE CATALOG SYNC] %s - could not refresh CloudProfile: %v", key, err)
+	}
+	c.cloudProfileQueue.AddAfter(key, requeueAfter)
 	return nil
 }
 
@@ -129,11 +144,12 @@ func (c *defaultControl) ReconcileCloudProfile(obj *gardenv1beta1.CloudProfile,
 		if len(associatedShoots) == 0 && len(associatedSeeds) == 0 {
 			cloudProfileLogger.Infof("No Shoots and Seeds are referencing the CloudProfile. Deletion accepted.")
 
-			finalizers := sets.NewString(cloudProfile.Finalizers...)
-			finalizers.Delete(gardenv1beta1.GardenerName)
-			cloudProfile.Finalizers = finalizers.UnsortedList()
-
-			if _, err := c.k8sGardenClient.Garden().GardenV1beta1().CloudProfiles().Update(cloudProfile); err != nil && !apierrors.IsNotFound(err) {
+			if err := kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), cloudProfile, func() error {
+				finalizers := sets.NewString(cloudProfile.Finalizers...)
+				finalizers.Delete(gardenv1beta1.GardenerName)
+				cloudProfile.Finalizers = finalizers.UnsortedList()
+				return nil
+			}); err != nil && !apierrors.IsNotFound(err) {
 				logger.Logger.Error(err)
 				return err
 			}
@@ -55,6 +55,7 @@ type Controller struct {
 	seedQueue  workqueue.RateLimitingInterface
 	seedLister gardenlisters.SeedLister
 	seedSynced cache.InformerSynced
+	seedFilter func(obj interface{}) bool
 
 	controllerRegistrationLister gardencorelisters.ControllerRegistrationLister
 	controllerRegistrationSynced cache.InformerSynced
@@ -95,6 +96,7 @@ func NewController(k8sGardenClient kubernetes.Interface, gardenInformerFactory g
 
 		seedLister: seedLister,
 		seedQueue:  seedQueue,
+		seedFilter: controllerutils.SeedFilterFunc(seedLister, config.SeedSelector),
 
 		controllerInstallationLister: controllerInstallationLister,
 		controllerInstallationQueue:  controllerInstallationQueue,
@@ -110,6 +112,9 @@ func NewController(k8sGardenClient kubernetes.Interface, gardenInformerFactory g
 		UpdateFunc: controller.controllerInstallationUpdate,
 		DeleteFunc: controller.controllerInstallationDelete,
 	})
+	seedInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: controller.seedUpdate,
+	})
 	controller.controllerInstallationSynced = controllerInstallationInformer.Informer().HasSynced
 
 	return controller
@@ -22,6 +22,7 @@ import (
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	"github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/v1alpha1"
@@ -29,28 +30,28 @@ import (
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
+	seedpkg "github.com/gardener/gardener/pkg/operation/seed"
 	"github.com/gardener/gardener/pkg/utils"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
 	multierror "github.com/hashicorp/go-multierror"
 
-	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"github.com/sirupsen/logrus"
 
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
-	"k8s.io/client-go/util/retry"
 )
 
 const installationTypeHelm = "helm"
@@ -87,6 +88,25 @@ func (c *Controller) controllerInstallationDelete(obj interface{}) {
 	c.controllerInstallationQueue.Add(key)
 }
 
+func (c *Controller) seedUpdate(oldObj, newObj interface{}) {
+	newSeed, ok := newObj.(*gardenv1beta1.Seed)
+	if !ok || !controllerutils.SeedLabelsChanged(oldObj, newObj) {
+		return
+	}
+
+	// The ControllerInstallations of the Seed might have been (de-)selected by the seed selector.
+	controllerInstallations, err := c.controllerInstallationLister.List(labels.Everything())
+	if err != nil {
+		logger.Logger.Errorf("Failed to list ControllerInstallations of Seed %s: %v", newSeed.Name, err)
+		return
+	}
+	for _, controllerInstallation := range controllerInstallations {
+		if controllerInstallation.Spec.SeedRef.Name == newSeed.Name {
+			c.controllerInstallationAdd(controllerInstallation)
+		}
+	}
+}
+
 func (c *Controller) reconcileControllerInstallationKey(key string) error {
 	_, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
@@ -102,6 +122,10 @@ func (c *Controller) reconcileControllerInstallationKey(key string) error {
 		logger.Logger.Infof("[CONTROLLERINSTALLATION RECONCILE] %s - unable to retrieve object from store: %v", key, err)
 		return err
 	}
+	if !c.seedFilter(controllerInstallation) {
+		logger.Logger.Debugf("[CONTROLLERINSTALLATION RECONCILE] %s - skipping because the Seed of the ControllerInstallation is not selected by my seed selector", key)
+		return nil
+	}
 
 	return c.controllerInstallationControl.Reconcile(controllerInstallation)
 }
@@ -150,16 +174,13 @@ func (c *defaultControllerInstallationControl) Reconcile(obj *gardencorev1alpha1
 }
 
 func (c *defaultControllerInstallationControl) reconcile(controllerInstallation *gardencorev1alpha1.ControllerInstallation, logger logrus.FieldLogger) error {
-	controllerInstallation, err := kutil.TryUpdateControllerInstallationWithEqualFunc(c.k8sGardenClient.GardenCore(), retry.DefaultBackoff, controllerInstallation.ObjectMeta, func(c *gardencorev1alpha1.ControllerInstallation) (*gardencorev1alpha1.ControllerInstallation, error) {
-		if finalizers := sets.NewString(c.Finalizers...); !finalizers.Has(FinalizerName) {
+	if err := kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), controllerInstallation, func() error {
+		if finalizers := sets.NewString(controllerInstallation.Finalizers...); !finalizers.Has(FinalizerName) {
 			finalizers.Insert(FinalizerName)
-			c.Finalizers = finalizers.UnsortedList()
+			controllerInstallation.Finalizers = finalizers.UnsortedList()
 		}
-		return c, nil
-	}, func(cur, updated *gardencorev1alpha1.ControllerInstallation) bool {
-		return sets.NewString(cur.Finalizers...).Has(FinalizerName)
-	})
-	if err != nil {
+		return nil
+	}); err != nil {
 		return err
 	}
 
@@ -170,7 +191,7 @@ func (c *defaultControllerInstallationControl) reconcile(controllerInstallation
 	)
 
 	defer func() {
-		if _, err := c.updateConditions(controllerInstallation, conditionValid, conditionInstalled); err != nil {
+		if err := c.updateConditions(controllerInstallation, conditionValid, conditionInstalled); err != nil {
 			logger.Errorf("Failed to update the conditions : %+v", err)
 		}
 	}()
@@ -190,9 +211,7 @@ func (c *defaultControllerInstallationControl) reconcile(controllerInstallation
 		return err
 	}
 
-	k8sSeedClient, err := kubernetes.NewClientFromSecret(c.k8sGardenClient, seed.Spec.SecretRef.Namespace, seed.Spec.SecretRef.Name, client.Options{
-		Scheme: kubernetes.SeedScheme,
-	})
+	k8sSeedClient, err := seedpkg.NewClient(c.k8sGardenClient, seed)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionFalse, "SeedNotFound", fmt.Sprintf("Referenced Seed does not exist: %+v", err))
@@ -280,19 +299,14 @@ func (c *defaultControllerInstallationControl) reconcile(controllerInstallation
 		return err
 	}
 
-	controllerInstallation, err = kutil.TryUpdateControllerInstallationStatusWithEqualFunc(c.k8sGardenClient.GardenCore(), retry.DefaultBackoff, controllerInstallation.ObjectMeta,
-		func(controllerInstallation *gardencorev1alpha1.ControllerInstallation) (*gardencorev1alpha1.ControllerInstallation, error) {
-			controllerInstallation.Status.ProviderStatus = &gardencorev1alpha1.ProviderConfig{
-				RawExtension: runtime.RawExtension{
-					Raw: status,
-				},
-			}
-			return controllerInstallation, nil
-		}, func(cur, updated *gardencorev1alpha1.ControllerInstallation) bool {
-			return equality.Semantic.DeepEqual(cur.Status.ProviderStatus, updated.Status.ProviderStatus)
-		},
-	)
-	if err != nil {
+	if err := kutil.TryUpdateStatus(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), controllerInstallation, func() error {
+		controllerInstallation.Status.ProviderStatus = &gardencorev1alpha1.ProviderConfig{
+			RawExtension: runtime.RawExtension{
+				Raw: status,
+			},
+		}
+		return nil
+	}); err != nil {
 		conditionInstalled = helper.UpdatedCondition(conditionInstalled, gardencorev1alpha1.ConditionFalse, "InstallationFailed", fmt.Sprintf("Could not write status for new resources: %+v", err))
 		return err
 	}
@@ -314,7 +328,7 @@ func (c *defaultControllerInstallationControl) delete(controllerInstallation *ga
 	)
 
 	defer func() {
-		if _, err := c.updateConditions(controllerInstallation, conditionValid, conditionInstalled); err != nil {
+		if err := c.updateConditions(controllerInstallation, conditionValid, conditionInstalled); err != nil {
 			logger.Errorf("Failed to update the conditions when trying to delete: %+v", err)
 		}
 	}()
@@ -324,9 +338,7 @@ func (c *defaultControllerInstallationControl) delete(controllerInstallation *ga
 		return err
 	}
 
-	k8sSeedClient, err := kubernetes.NewClientFromSecret(c.k8sGardenClient, seed.Spec.SecretRef.Namespace, seed.Spec.SecretRef.Name, client.Options{
-		Scheme: kubernetes.SeedScheme,
-	})
+	k8sSeedClient, err := seedpkg.NewClient(c.k8sGardenClient, seed)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionFalse, "SeedNotFound", fmt.Sprintf("Referenced Seed does not exist: %+v", err))
@@ -349,26 +361,20 @@ func (c *defaultControllerInstallationControl) delete(controllerInstallation *ga
 	}
 	conditionInstalled = helper.UpdatedCondition(conditionInstalled, gardencorev1alpha1.ConditionFalse, "DeletionSuccessful", "Deletion of old resources succeeded.")
 
-	_, err = kutil.TryUpdateControllerInstallationWithEqualFunc(c.k8sGardenClient.GardenCore(), retry.DefaultBackoff, controllerInstallation.ObjectMeta, func(c *gardencorev1alpha1.ControllerInstallation) (*gardencorev1alpha1.ControllerInstallation, error) {
-		finalizers := sets.NewString(c.Finalizers...)
+	return kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), controllerInstallation, func() error {
+		finalizers := sets.NewString(controllerInstallation.Finalizers...)
 		finalizers.Delete(FinalizerName)
-		c.Finalizers = finalizers.UnsortedList()
-		return c, nil
-	}, func(cur, updated *gardencorev1alpha1.ControllerInstallation) bool {
-		return !sets.NewString(cur.Finalizers...).Has(FinalizerName)
+		controllerInstallation.Finalizers = finalizers.UnsortedList()
+		return nil
 	})
-	return err
 }
 
-func (c *defaultControllerInstallationControl) updateConditions(controllerInstallation *gardencorev1alpha1.ControllerInstallation, conditions ...gardencorev1alpha1.Condition) (*gardencorev1alpha1.ControllerInstallation, error) {
-	return kutil.TryUpdateControllerInstallationStatusWithEqualFunc(c.k8sGardenClient.GardenCore(), retry.DefaultBackoff, controllerInstallation.ObjectMeta,
-		func(controllerInstallation *gardencorev1alpha1.ControllerInstallation) (*gardencorev1alpha1.ControllerInstallation, error) {
-			controllerInstallation.Status.Conditions = conditions
-			return controllerInstallation, nil
-		}, func(cur, updated *gardencorev1alpha1.ControllerInstallation) bool {
-			return equality.Semantic.DeepEqual(cur.Status.Conditions, updated.Status.Conditions)
-		},
-	)
+func (c *defaultControllerInstallationControl) updateConditions(controllerInstallation *gardencorev1alpha1.ControllerInstallation, conditions ...gardencorev1alpha1.Condition) error {
+	newControllerInstallation := controllerInstallation.DeepCopy()
+	return kutil.TryUpdateStatus(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), newControllerInstallation, func() error {
+		newControllerInstallation.Status.Conditions = conditions
+		return nil
+	})
 }
 
 func (c *defaultControllerInstallationControl) isResponsible(controllerInstallation *gardencorev1alpha1.ControllerInstallation) (bool, error) {
@@ -30,6 +30,7 @@ import (
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/prometheus/client_golang/prometheus"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
@@ -61,12 +62,16 @@ type Controller struct {
 
 	controllerInstallationSynced cache.InformerSynced
 
+	shootSynced cache.InformerSynced
+
+	secrets map[string]*corev1.Secret
+
 	workerCh               chan int
 	numberOfRunningWorkers int
 }
 
 // NewController instantiates a new ControllerRegistration controller.
-func NewController(k8sGardenClient kubernetes.Interface, gardenInformerFactory gardeninformers.SharedInformerFactory, gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory, config *config.ControllerManagerConfiguration, recorder record.EventRecorder) *Controller {
+func NewController(k8sGardenClient kubernetes.Interface, gardenInformerFactory gardeninformers.SharedInformerFactory, gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory, secrets map[string]*corev1.Secret, config *config.ControllerManagerConfiguration, recorder record.EventRecorder) *Controller {
 	var (
 		gardenInformer     = gardenInformerFactory.Garden().V1beta1()
 		gardenCoreInformer = gardenCoreInformerFactory.Core().V1alpha1()
@@ -81,6 +86,9 @@ func NewController(k8sGardenClient kubernetes.Interface, gardenInformerFactory g
 
 		controllerInstallationInformer = gardenCoreInformer.ControllerInstallations()
 		controllerInstallationLister   = controllerInstallationInformer.Lister()
+
+		shootInformer = gardenInformer.Shoots()
+		shootLister   = shootInformer.Lister()
 	)
 
 	controller := &Controller{
@@ -88,7 +96,7 @@ func NewController(k8sGardenClient kubernetes.Interface, gardenInformerFactory g
 		k8sGardenInformers:            gardenInformerFactory,
 		k8sGardenCoreInformers:        gardenCoreInformerFactory,
 		seedControl:                   NewDefaultSeedControl(k8sGardenClient, gardenInformerFactory, gardenCoreInformerFactory, recorder, config, controllerRegistrationLister, controllerInstallationLister, controllerRegistrationQueue),
-		controllerRegistrationControl: NewDefaultControllerRegistrationControl(k8sGardenClient, gardenInformerFactory, gardenCoreInformerFactory, recorder, config, seedLister, controllerRegistrationLister, controllerInstallationLister),
+		controllerRegistrationControl: NewDefaultControllerRegistrationControl(k8sGardenClient, gardenInformerFactory, gardenCoreInformerFactory, secrets, recorder, config, seedLister, shootLister, controllerRegistrationLister, controllerInstallationLister),
 		config:                        config,
 		recorder:                      recorder,
 
@@ -98,6 +106,8 @@ func NewController(k8sGardenClient kubernetes.Interface, gardenInformerFactory g
 		controllerRegistrationLister: controllerRegistrationLister,
 		controllerRegistrationQueue:  controllerRegistrationQueue,
 
+		secrets: secrets,
+
 		workerCh: make(chan int),
 	}
 
@@ -117,6 +127,13 @@ func NewController(k8sGardenClient kubernetes.Interface, gardenInformerFactory g
 
 	controller.controllerInstallationSynced = controllerInstallationInformer.Informer().HasSynced
 
+	shootInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    controller.shootAdd,
+		UpdateFunc: controller.shootUpdate,
+		DeleteFunc: controller.shootDelete,
+	})
+	controller.shootSynced = shootInformer.Informer().HasSynced
+
 	return controller
 }
 
@@ -124,7 +141,7 @@ func NewController(k8sGardenClient kubernetes.Interface, gardenInformerFactory g
 func (c *Controller) Run(ctx context.Context, workers int) {
 	var waitGroup sync.WaitGroup
 
-	if !cache.WaitForCacheSync(ctx.Done(), c.seedSynced, c.controllerRegistrationSynced, c.controllerInstallationSynced) {
+	if !cache.WaitForCacheSync(ctx.Done(), c.seedSynced, c.controllerRegistrationSynced, c.controllerInstallationSynced, c.shootSynced) {
 		logger.Logger.Error("Timed out waiting for caches to sync")
 		return
 	}
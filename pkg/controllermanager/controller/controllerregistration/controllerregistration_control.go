@@ -15,6 +15,7 @@
 package controllerregistration
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -31,6 +32,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/garden"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	multierror "github.com/hashicorp/go-multierror"
 
@@ -40,10 +42,10 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
-	"k8s.io/client-go/util/retry"
 )
 
 func (c *Controller) controllerRegistrationAdd(obj interface{}) {
@@ -97,17 +99,19 @@ type ControlInterface interface {
 // implements the documented semantics for ControllerRegistrations. updater is the UpdaterInterface used
 // to update the status of ControllerRegistrations. You should use an instance returned from NewDefaultControllerRegistrationControl() for any
 // scenario other than testing.
-func NewDefaultControllerRegistrationControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, recorder record.EventRecorder, config *config.ControllerManagerConfiguration, seedLister gardenlisters.SeedLister, controllerRegistrationLister gardencorelisters.ControllerRegistrationLister, controllerInstallationLister gardencorelisters.ControllerInstallationLister) ControlInterface {
-	return &defaultControllerRegistrationControl{k8sGardenClient, k8sGardenInformers, k8sGardenCoreInformers, recorder, config, seedLister, controllerRegistrationLister, controllerInstallationLister}
+func NewDefaultControllerRegistrationControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, secrets map[string]*corev1.Secret, recorder record.EventRecorder, config *config.ControllerManagerConfiguration, seedLister gardenlisters.SeedLister, shootLister gardenlisters.ShootLister, controllerRegistrationLister gardencorelisters.ControllerRegistrationLister, controllerInstallationLister gardencorelisters.ControllerInstallationLister) ControlInterface {
+	return &defaultControllerRegistrationControl{k8sGardenClient, k8sGardenInformers, k8sGardenCoreInformers, secrets, recorder, config, seedLister, shootLister, controllerRegistrationLister, controllerInstallationLister}
 }
 
 type defaultControllerRegistrationControl struct {
 	k8sGardenClient              kubernetes.Interface
 	k8sGardenInformers           gardeninformers.SharedInformerFactory
 	k8sGardenCoreInformers       gardencoreinformers.SharedInformerFactory
+	secrets                      map[string]*corev1.Secret
 	recorder                     record.EventRecorder
 	config                       *config.ControllerManagerConfiguration
 	seedLister                   gardenlisters.SeedLister
+	shootLister                  gardenlisters.ShootLister
 	controllerRegistrationLister gardencorelisters.ControllerRegistrationLister
 	controllerInstallationLister gardencorelisters.ControllerInstallationLister
 }
@@ -146,35 +150,38 @@ func (c *defaultControllerRegistrationControl) reconcile(controllerRegistration
 	}
 
 	if mustWriteFinalizer {
-		controllerRegistration, err = kutil.TryUpdateControllerRegistrationWithEqualFunc(c.k8sGardenClient.GardenCore(), retry.DefaultBackoff, controllerRegistration.ObjectMeta, func(c *gardencorev1alpha1.ControllerRegistration) (*gardencorev1alpha1.ControllerRegistration, error) {
-			if finalizers := sets.NewString(c.Finalizers...); !finalizers.Has(FinalizerName) {
+		if err := kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), controllerRegistration, func() error {
+			if finalizers := sets.NewString(controllerRegistration.Finalizers...); !finalizers.Has(FinalizerName) {
 				finalizers.Insert(FinalizerName)
-				c.Finalizers = finalizers.UnsortedList()
+				controllerRegistration.Finalizers = finalizers.UnsortedList()
 			}
-			return c, nil
-		}, func(cur, updated *gardencorev1alpha1.ControllerRegistration) bool {
-			return sets.NewString(cur.Finalizers...).Has(FinalizerName)
-		})
-		if err != nil {
+			return nil
+		}); err != nil {
 			return err
 		}
 	}
 
 	// Live lookup to prevent working on a stale cache and trying to create multiple installations for the same
 	// registration/seed combination.
-	controllerInstallationList, err := c.k8sGardenClient.GardenCore().CoreV1alpha1().ControllerInstallations().List(metav1.ListOptions{})
-	if err != nil {
-		return err
-	}
-
-	for _, controllerInstallation := range controllerInstallationList.Items {
+	if err := kutil.EachListItem(func(options metav1.ListOptions) (runtime.Object, error) {
+		return c.k8sGardenClient.GardenCore().CoreV1alpha1().ControllerInstallations().List(options)
+	}, func(obj runtime.Object) error {
+		controllerInstallation := obj.(*gardencorev1alpha1.ControllerInstallation)
 		if controllerInstallation.Spec.RegistrationRef.Name == controllerRegistration.Name {
 			installationsMap[controllerInstallation.Spec.SeedRef.Name] = controllerInstallation.Name
 		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	seedRequiredExtensions, err := c.computeSeedRequiredExtensions()
+	if err != nil {
+		return err
 	}
 
 	for _, seed := range seedList {
-		if err := c.reconcileSeedInstallations(controllerRegistration, seed, installationsMap); err != nil {
+		if err := c.reconcileSeedInstallations(controllerRegistration, seed, installationsMap, seedRequiredExtensions[seed.Name].isNeededBy(controllerRegistration)); err != nil {
 			result = multierror.Append(result, err)
 		}
 	}
@@ -182,8 +189,28 @@ func (c *defaultControllerRegistrationControl) reconcile(controllerRegistration
 	return result
 }
 
-func (c *defaultControllerRegistrationControl) reconcileSeedInstallations(controllerRegistration *gardencorev1alpha1.ControllerRegistration, seed *gardenv1beta1.Seed, installationsMap map[string]string) error {
-	if seed.DeletionTimestamp != nil {
+func (c *defaultControllerRegistrationControl) computeSeedRequiredExtensions() (map[string]requiredExtensions, error) {
+	internalDomain, err := garden.GetInternalDomain(c.secrets)
+	if err != nil {
+		return nil, err
+	}
+	defaultDomains, err := garden.GetDefaultDomains(c.secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	shootList, err := c.shootLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	return computeSeedRequiredExtensions(shootList, internalDomain, defaultDomains), nil
+}
+
+// reconcileSeedInstallations makes sure that the controller of the given registration is installed in the given Seed
+// if and only if the Seed is not being deleted and at least one of its Shoots needs the controller.
+func (c *defaultControllerRegistrationControl) reconcileSeedInstallations(controllerRegistration *gardencorev1alpha1.ControllerRegistration, seed *gardenv1beta1.Seed, installationsMap map[string]string, needed bool) error {
+	if seed.DeletionTimestamp != nil || !needed {
 		if installation, ok := installationsMap[seed.Name]; ok {
 			if err := c.k8sGardenClient.GardenCore().CoreV1alpha1().ControllerInstallations().Delete(installation, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
 				return err
@@ -192,16 +219,14 @@ func (c *defaultControllerRegistrationControl) reconcileSeedInstallations(contro
 		return nil
 	}
 
-	seed, err := kutil.TryUpdateSeedWithEqualFunc(c.k8sGardenClient.Garden(), retry.DefaultBackoff, seed.ObjectMeta, func(s *gardenv1beta1.Seed) (*gardenv1beta1.Seed, error) {
-		if finalizers := sets.NewString(s.Finalizers...); !finalizers.Has(FinalizerName) {
+	seed = seed.DeepCopy()
+	if err := kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), seed, func() error {
+		if finalizers := sets.NewString(seed.Finalizers...); !finalizers.Has(FinalizerName) {
 			finalizers.Insert(FinalizerName)
-			s.Finalizers = finalizers.UnsortedList()
+			seed.Finalizers = finalizers.UnsortedList()
 		}
-		return s, nil
-	}, func(cur, updated *gardenv1beta1.Seed) bool {
-		return sets.NewString(cur.Finalizers...).Has(FinalizerName)
-	})
-	if err != nil {
+		return nil
+	}); err != nil {
 		return err
 	}
 
@@ -283,15 +308,12 @@ func (c *defaultControllerRegistrationControl) delete(controllerRegistration *ga
 		return fmt.Errorf("deletion of installations is still pending")
 	}
 
-	_, err = kutil.TryUpdateControllerRegistrationWithEqualFunc(c.k8sGardenClient.GardenCore(), retry.DefaultBackoff, controllerRegistration.ObjectMeta, func(c *gardencorev1alpha1.ControllerRegistration) (*gardencorev1alpha1.ControllerRegistration, error) {
-		finalizers := sets.NewString(c.Finalizers...)
+	return kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), controllerRegistration, func() error {
+		finalizers := sets.NewString(controllerRegistration.Finalizers...)
 		finalizers.Delete(FinalizerName)
-		c.Finalizers = finalizers.UnsortedList()
-		return c, nil
-	}, func(cur, updated *gardencorev1alpha1.ControllerRegistration) bool {
-		return !sets.NewString(cur.Finalizers...).Has(FinalizerName)
+		controllerRegistration.Finalizers = finalizers.UnsortedList()
+		return nil
 	})
-	return err
 }
 
 func convertObjToMap(in interface{}) (map[string]interface{}, error) {
@@ -0,0 +1,140 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerregistration
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardenv1beta1helper "github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/operation/garden"
+	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
+
+	dnsv1alpha1 "github.com/gardener/external-dns-management/pkg/apis/dns/v1alpha1"
+)
+
+// requiredExtensions is a set of extension resource kinds and types which are needed by a group of Shoots.
+type requiredExtensions map[string]map[string]struct{}
+
+func (r requiredExtensions) insert(extensionKind, extensionType string) {
+	if len(extensionType) == 0 || extensionType == gardenv1beta1.DNSUnmanaged {
+		return
+	}
+	if _, ok := r[extensionKind]; !ok {
+		r[extensionKind] = map[string]struct{}{}
+	}
+	r[extensionKind][extensionType] = struct{}{}
+}
+
+// equal returns true if both sets contain exactly the same kinds and types.
+func (r requiredExtensions) equal(other requiredExtensions) bool {
+	if len(r) != len(other) {
+		return false
+	}
+	for extensionKind, types := range r {
+		otherTypes, ok := other[extensionKind]
+		if !ok || len(types) != len(otherTypes) {
+			return false
+		}
+		for extensionType := range types {
+			if _, ok := otherTypes[extensionType]; !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// computedExtensionKinds are the extension kinds whose required types are computed from the Shoots (see
+// computeShootRequiredExtensions). Gardener cannot tell which Seeds need a controller for any other kind.
+var computedExtensionKinds = map[string]struct{}{
+	extensionsv1alpha1.OperatingSystemConfigResource: {},
+	dnsv1alpha1.DNSProviderKind:                      {},
+}
+
+// isNeededBy returns true if the given ControllerRegistration supports at least one of the required extensions. A
+// ControllerRegistration without resources or with a resource of a kind which is not computed is always needed.
+func (r requiredExtensions) isNeededBy(controllerRegistration *gardencorev1alpha1.ControllerRegistration) bool {
+	if len(controllerRegistration.Spec.Resources) == 0 {
+		return true
+	}
+	for _, resource := range controllerRegistration.Spec.Resources {
+		if _, ok := computedExtensionKinds[resource.Kind]; !ok {
+			return true
+		}
+	}
+
+	for extensionKind, types := range r {
+		for extensionType := range types {
+			if helper.IsResourceSupported(controllerRegistration.Spec.Resources, extensionKind, extensionType) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// computeShootRequiredExtensions returns the extensions the given Shoot needs in its Seed. It mirrors what the
+// Botanist checks before it creates the extension resources for the Shoot. The internal domain is needed by every
+// Shoot and is therefore added per Seed (see computeSeedRequiredExtensions).
+func computeShootRequiredExtensions(shoot *gardenv1beta1.Shoot, defaultDomains []*garden.DefaultDomain) requiredExtensions {
+	required := requiredExtensions{}
+
+	if machineImageName, err := gardenv1beta1helper.GetShootMachineImageName(shoot); err == nil {
+		required.insert(extensionsv1alpha1.OperatingSystemConfigResource, string(machineImageName))
+	}
+
+	if externalClusterDomain := shootpkg.ConstructExternalClusterDomain(shoot); externalClusterDomain != nil {
+		switch defaultDomain := garden.DomainIsDefaultDomain(*externalClusterDomain, defaultDomains); {
+		case shoot.Spec.DNS.SecretName != nil && shoot.Spec.DNS.Provider != nil:
+			required.insert(dnsv1alpha1.DNSProviderKind, *shoot.Spec.DNS.Provider)
+		case defaultDomain != nil:
+			required.insert(dnsv1alpha1.DNSProviderKind, defaultDomain.Provider)
+		case shoot.Spec.DNS.Provider != nil:
+			required.insert(dnsv1alpha1.DNSProviderKind, *shoot.Spec.DNS.Provider)
+		}
+	}
+
+	return required
+}
+
+// computeSeedRequiredExtensions returns a map from Seed names to the extensions which are needed by the Shoots
+// scheduled on the respective Seed. Seeds without any Shoot do not appear in the result.
+func computeSeedRequiredExtensions(shootList []*gardenv1beta1.Shoot, internalDomain *garden.InternalDomain, defaultDomains []*garden.DefaultDomain) map[string]requiredExtensions {
+	out := map[string]requiredExtensions{}
+
+	for _, shoot := range shootList {
+		if shoot.Spec.Cloud.Seed == nil {
+			continue
+		}
+
+		seedName := *shoot.Spec.Cloud.Seed
+		if _, ok := out[seedName]; !ok {
+			out[seedName] = requiredExtensions{}
+			if internalDomain != nil {
+				out[seedName].insert(dnsv1alpha1.DNSProviderKind, internalDomain.Provider)
+			}
+		}
+
+		for extensionKind, types := range computeShootRequiredExtensions(shoot, defaultDomains) {
+			for extensionType := range types {
+				out[seedName].insert(extensionKind, extensionType)
+			}
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerregistration
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation/garden"
+
+	dnsv1alpha1 "github.com/gardener/external-dns-management/pkg/apis/dns/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RequiredExtensions", func() {
+	var (
+		internalDomain = &garden.InternalDomain{Domain: "internal.example.com", Provider: "aws-route53"}
+		defaultDomains = []*garden.DefaultDomain{{Domain: "default.example.com", Provider: "google-clouddns"}}
+
+		newShoot = func(seed *string, machineImage gardenv1beta1.MachineImageName, dns gardenv1beta1.DNS) *gardenv1beta1.Shoot {
+			return &gardenv1beta1.Shoot{
+				Spec: gardenv1beta1.ShootSpec{
+					Cloud: gardenv1beta1.Cloud{
+						Seed: seed,
+						AWS: &gardenv1beta1.AWSCloud{
+							MachineImage: &gardenv1beta1.AWSMachineImage{Name: machineImage},
+						},
+					},
+					DNS: dns,
+				},
+			}
+		}
+		strPtr = func(s string) *string { return &s }
+	)
+
+	Describe("#computeShootRequiredExtensions", func() {
+		It("should require the machine image and the provider of the default domain", func() {
+			shoot := newShoot(strPtr("seed"), "coreos", gardenv1beta1.DNS{Domain: strPtr("foo.default.example.com")})
+
+			Expect(computeShootRequiredExtensions(shoot, defaultDomains)).To(Equal(requiredExtensions{
+				extensionsv1alpha1.OperatingSystemConfigResource: {"coreos": {}},
+				dnsv1alpha1.DNSProviderKind:                      {"google-clouddns": {}},
+			}))
+		})
+
+		It("should require the provider configured in the Shoot", func() {
+			shoot := newShoot(strPtr("seed"), "ubuntu", gardenv1beta1.DNS{Domain: strPtr("foo.bar.com"), Provider: strPtr("azure-dns"), SecretName: strPtr("dns")})
+
+			Expect(computeShootRequiredExtensions(shoot, defaultDomains)).To(Equal(requiredExtensions{
+				extensionsv1alpha1.OperatingSystemConfigResource: {"ubuntu": {}},
+				dnsv1alpha1.DNSProviderKind:                      {"azure-dns": {}},
+			}))
+		})
+
+		It("should not require any DNS provider for unmanaged domains", func() {
+			shoot := newShoot(strPtr("seed"), "coreos", gardenv1beta1.DNS{Domain: strPtr("foo.bar.com"), Provider: strPtr(gardenv1beta1.DNSUnmanaged)})
+
+			Expect(computeShootRequiredExtensions(shoot, defaultDomains)).To(Equal(requiredExtensions{
+				extensionsv1alpha1.OperatingSystemConfigResource: {"coreos": {}},
+			}))
+		})
+	})
+
+	Describe("#computeSeedRequiredExtensions", func() {
+		It("should merge the needs of all Shoots per Seed and ignore unscheduled Shoots", func() {
+			shoots := []*gardenv1beta1.Shoot{
+				newShoot(strPtr("seed-1"), "coreos", gardenv1beta1.DNS{Domain: strPtr("a.default.example.com")}),
+				newShoot(strPtr("seed-1"), "ubuntu", gardenv1beta1.DNS{Domain: strPtr("b.default.example.com")}),
+				newShoot(strPtr("seed-2"), "coreos", gardenv1beta1.DNS{}),
+				newShoot(nil, "suse-jeos", gardenv1beta1.DNS{}),
+			}
+
+			Expect(computeSeedRequiredExtensions(shoots, internalDomain, defaultDomains)).To(Equal(map[string]requiredExtensions{
+				"seed-1": {
+					extensionsv1alpha1.OperatingSystemConfigResource: {"coreos": {}, "ubuntu": {}},
+					dnsv1alpha1.DNSProviderKind:                      {"aws-route53": {}, "google-clouddns": {}},
+				},
+				"seed-2": {
+					extensionsv1alpha1.OperatingSystemConfigResource: {"coreos": {}},
+					dnsv1alpha1.DNSProviderKind:                      {"aws-route53": {}},
+				},
+			}))
+		})
+	})
+
+	Describe("#isNeededBy", func() {
+		required := requiredExtensions{extensionsv1alpha1.OperatingSystemConfigResource: {"coreos": {}}}
+
+		It("should return true if the registration supports a required extension", func() {
+			Expect(required.isNeededBy(&gardencorev1alpha1.ControllerRegistration{
+				Spec: gardencorev1alpha1.ControllerRegistrationSpec{
+					Resources: []gardencorev1alpha1.ControllerResource{{Kind: extensionsv1alpha1.OperatingSystemConfigResource, Type: "CoreOS"}},
+				},
+			})).To(BeTrue())
+		})
+
+		It("should return false if the registration does not support any required extension", func() {
+			Expect(required.isNeededBy(&gardencorev1alpha1.ControllerRegistration{
+				Spec: gardencorev1alpha1.ControllerRegistrationSpec{
+					Resources: []gardencorev1alpha1.ControllerResource{{Kind: dnsv1alpha1.DNSProviderKind, Type: "coreos"}},
+				},
+			})).To(BeFalse())
+			Expect(requiredExtensions(nil).isNeededBy(&gardencorev1alpha1.ControllerRegistration{
+				Spec: gardencorev1alpha1.ControllerRegistrationSpec{
+					Resources: []gardencorev1alpha1.ControllerResource{{Kind: extensionsv1alpha1.OperatingSystemConfigResource, Type: "coreos"}},
+				},
+			})).To(BeFalse())
+		})
+
+		It("should return true if the registration supports a kind which is not computed", func() {
+			Expect(requiredExtensions(nil).isNeededBy(&gardencorev1alpha1.ControllerRegistration{
+				Spec: gardencorev1alpha1.ControllerRegistrationSpec{
+					Resources: []gardencorev1alpha1.ControllerResource{
+						{Kind: extensionsv1alpha1.OperatingSystemConfigResource, Type: "ubuntu"},
+						{Kind: extensionsv1alpha1.ControlPlaneResource, Type: "aws"},
+					},
+				},
+			})).To(BeTrue())
+		})
+
+		It("should return true if the registration has no resources", func() {
+			Expect(requiredExtensions(nil).isNeededBy(&gardencorev1alpha1.ControllerRegistration{})).To(BeTrue())
+		})
+	})
+
+	Describe("#equal", func() {
+		It("should compare kinds and types", func() {
+			a := requiredExtensions{"A": {"x": {}}}
+			Expect(a.equal(requiredExtensions{"A": {"x": {}}})).To(BeTrue())
+			Expect(a.equal(requiredExtensions{"A": {"y": {}}})).To(BeFalse())
+			Expect(a.equal(requiredExtensions{"A": {"x": {}}, "B": {"x": {}}})).To(BeFalse())
+		})
+	})
+})
@@ -15,6 +15,7 @@
 package controllerregistration
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -34,7 +35,6 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
-	"k8s.io/client-go/util/retry"
 	"k8s.io/client-go/util/workqueue"
 )
 
@@ -146,16 +146,13 @@ func (c *defaultSeedControl) Reconcile(obj *gardenv1beta1.Seed) error {
 			}
 		}
 
-		_, err = kutil.TryUpdateSeedWithEqualFunc(c.k8sGardenClient.Garden(), retry.DefaultBackoff, seed.ObjectMeta, func(s *gardenv1beta1.Seed) (*gardenv1beta1.Seed, error) {
-			finalizers := sets.NewString(s.Finalizers...)
+		newSeed := seed.DeepCopy()
+		if err := kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), newSeed, func() error {
+			finalizers := sets.NewString(newSeed.Finalizers...)
 			finalizers.Delete(FinalizerName)
-			s.Finalizers = finalizers.UnsortedList()
-			return s, nil
-		}, func(cur, updated *gardenv1beta1.Seed) bool {
-			finalizers := sets.NewString(cur.Finalizers...)
-			return !finalizers.Has(FinalizerName)
-		})
-		if err != nil {
+			newSeed.Finalizers = finalizers.UnsortedList()
+			return nil
+		}); err != nil {
 			logger.Errorf("Could not update the Seed specification: %s", err.Error())
 			return err
 		}
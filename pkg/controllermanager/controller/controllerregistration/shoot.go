@@ -0,0 +1,85 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerregistration
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/garden"
+
+	"k8s.io/client-go/tools/cache"
+)
+
+// The Shoot event handlers enqueue the Seed the Shoot is scheduled on. The Seed reconciliation in turn enqueues all
+// ControllerRegistrations which then compute whether they have to be installed in the Seed.
+
+func (c *Controller) shootAdd(obj interface{}) {
+	shoot, ok := obj.(*gardenv1beta1.Shoot)
+	if !ok {
+		return
+	}
+	c.enqueueShootSeed(shoot)
+}
+
+func (c *Controller) shootUpdate(oldObj, newObj interface{}) {
+	oldShoot, ok := oldObj.(*gardenv1beta1.Shoot)
+	if !ok {
+		return
+	}
+	newShoot, ok := newObj.(*gardenv1beta1.Shoot)
+	if !ok {
+		return
+	}
+
+	if seedName(oldShoot) == seedName(newShoot) && c.shootRequiredExtensions(oldShoot).equal(c.shootRequiredExtensions(newShoot)) {
+		return
+	}
+
+	c.enqueueShootSeed(oldShoot)
+	c.enqueueShootSeed(newShoot)
+}
+
+func (c *Controller) shootDelete(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	shoot, ok := obj.(*gardenv1beta1.Shoot)
+	if !ok {
+		logger.Logger.Errorf("Couldn't get Shoot from object %+v", obj)
+		return
+	}
+	c.enqueueShootSeed(shoot)
+}
+
+func (c *Controller) enqueueShootSeed(shoot *gardenv1beta1.Shoot) {
+	if name := seedName(shoot); len(name) > 0 {
+		c.seedQueue.Add(name)
+	}
+}
+
+func (c *Controller) shootRequiredExtensions(shoot *gardenv1beta1.Shoot) requiredExtensions {
+	defaultDomains, err := garden.GetDefaultDomains(c.secrets)
+	if err != nil {
+		logger.Logger.Errorf("Couldn't determine the default domains: %v", err)
+	}
+	return computeShootRequiredExtensions(shoot, defaultDomains)
+}
+
+func seedName(shoot *gardenv1beta1.Shoot) string {
+	if shoot.Spec.Cloud.Seed == nil {
+		return ""
+	}
+	return *shoot.Spec.Cloud.Seed
+}
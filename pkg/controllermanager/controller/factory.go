@@ -22,6 +22,7 @@ import (
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/client/vault"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	backupinfrastructurecontroller "github.com/gardener/gardener/pkg/controllermanager/controller/backupinfrastructure"
 	cloudprofilecontroller "github.com/gardener/gardener/pkg/controllermanager/controller/cloudprofile"
@@ -37,6 +38,7 @@ import (
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/operation/garden"
+	"github.com/gardener/gardener/pkg/utils/flow"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/version"
@@ -69,10 +71,12 @@ type GardenControllerFactory struct {
 	k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory
 	k8sInformers           kubeinformers.SharedInformerFactory
 	recorder               record.EventRecorder
+	flowRegistry           *flow.Registry
+	operationLogs          *logger.OperationLogs
 }
 
 // NewGardenControllerFactory creates a new factory for controllers for the Garden API group.
-func NewGardenControllerFactory(k8sGardenClient kubernetes.Interface, gardenInformerFactory gardeninformers.SharedInformerFactory, gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory, kubeInformerFactory kubeinformers.SharedInformerFactory, cfg *config.ControllerManagerConfiguration, identity *gardenv1beta1.Gardener, gardenNamespace string, recorder record.EventRecorder) *GardenControllerFactory {
+func NewGardenControllerFactory(k8sGardenClient kubernetes.Interface, gardenInformerFactory gardeninformers.SharedInformerFactory, gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory, kubeInformerFactory kubeinformers.SharedInformerFactory, cfg *config.ControllerManagerConfiguration, identity *gardenv1beta1.Gardener, gardenNamespace string, recorder record.EventRecorder, flowRegistry *flow.Registry, operationLogs *logger.OperationLogs) *GardenControllerFactory {
 	return &GardenControllerFactory{
 		cfg:                    cfg,
 		identity:               identity,
@@ -82,22 +86,25 @@ func NewGardenControllerFactory(k8sGardenClient kubernetes.Interface, gardenInfo
 		k8sGardenCoreInformers: gardenCoreInformerFactory,
 		k8sInformers:           kubeInformerFactory,
 		recorder:               recorder,
+		flowRegistry:           flowRegistry,
+		operationLogs:          operationLogs,
 	}
 }
 
 // Run starts all the controllers for the Garden API group. It also performs bootstrapping tasks.
 func (f *GardenControllerFactory) Run(ctx context.Context) {
 	var (
-		cloudProfileInformer           = f.k8sGardenInformers.Garden().V1beta1().CloudProfiles().Informer()
-		secretBindingInformer          = f.k8sGardenInformers.Garden().V1beta1().SecretBindings().Informer()
-		quotaInformer                  = f.k8sGardenInformers.Garden().V1beta1().Quotas().Informer()
-		projectInformer                = f.k8sGardenInformers.Garden().V1beta1().Projects().Informer()
-		seedInformer                   = f.k8sGardenInformers.Garden().V1beta1().Seeds().Informer()
-		shootInformer                  = f.k8sGardenInformers.Garden().V1beta1().Shoots().Informer()
-		backupInfrastructureInformer   = f.k8sGardenInformers.Garden().V1beta1().BackupInfrastructures().Informer()
-		controllerRegistrationInformer = f.k8sGardenCoreInformers.Core().V1alpha1().ControllerRegistrations().Informer()
-		controllerInstallationInformer = f.k8sGardenCoreInformers.Core().V1alpha1().ControllerInstallations().Informer()
-		plantInformer                  = f.k8sGardenCoreInformers.Core().V1alpha1().Plants().Informer()
+		cloudProfileInformer             = f.k8sGardenInformers.Garden().V1beta1().CloudProfiles().Informer()
+		secretBindingInformer            = f.k8sGardenInformers.Garden().V1beta1().SecretBindings().Informer()
+		quotaInformer                    = f.k8sGardenInformers.Garden().V1beta1().Quotas().Informer()
+		projectInformer                  = f.k8sGardenInformers.Garden().V1beta1().Projects().Informer()
+		seedInformer                     = f.k8sGardenInformers.Garden().V1beta1().Seeds().Informer()
+		shootInformer                    = f.k8sGardenInformers.Garden().V1beta1().Shoots().Informer()
+		backupInfrastructureInformer     = f.k8sGardenInformers.Garden().V1beta1().BackupInfrastructures().Informer()
+		deletionProtectionPolicyInformer = f.k8sGardenInformers.Garden().V1beta1().DeletionProtectionPolicies().Informer()
+		controllerRegistrationInformer   = f.k8sGardenCoreInformers.Core().V1alpha1().ControllerRegistrations().Informer()
+		controllerInstallationInformer   = f.k8sGardenCoreInformers.Core().V1alpha1().ControllerInstallations().Informer()
+		plantInformer                    = f.k8sGardenCoreInformers.Core().V1alpha1().Plants().Informer()
 
 		namespaceInformer = f.k8sInformers.Core().V1().Namespaces().Informer()
 		secretInformer    = f.k8sInformers.Core().V1().Secrets().Informer()
@@ -105,7 +112,7 @@ func (f *GardenControllerFactory) Run(ctx context.Context) {
 	)
 
 	f.k8sGardenInformers.Start(ctx.Done())
-	if !cache.WaitForCacheSync(ctx.Done(), cloudProfileInformer.HasSynced, secretBindingInformer.HasSynced, quotaInformer.HasSynced, projectInformer.HasSynced, seedInformer.HasSynced, shootInformer.HasSynced, backupInfrastructureInformer.HasSynced) {
+	if !cache.WaitForCacheSync(ctx.Done(), cloudProfileInformer.HasSynced, secretBindingInformer.HasSynced, quotaInformer.HasSynced, projectInformer.HasSynced, seedInformer.HasSynced, shootInformer.HasSynced, backupInfrastructureInformer.HasSynced, deletionProtectionPolicyInformer.HasSynced) {
 		panic("Timed out waiting for Garden caches to sync")
 	}
 
@@ -127,27 +134,37 @@ func (f *GardenControllerFactory) Run(ctx context.Context) {
 
 	runtime.Must(garden.VerifyInternalDomainSecret(f.k8sGardenClient, len(shootList), secrets[common.GardenRoleInternalDomain]))
 
+	// The Vault client caches the credentials it has read, hence it is shared by all operations.
+	var vaultClient vault.ClientInterface
+	if vaultSecret, ok := secrets[common.GardenRoleVault]; ok {
+		vaultClient, err = vault.NewClientFromSecret(vaultSecret)
+		runtime.Must(err)
+	}
+
 	imageVector, err := ReadGlobalImageVectorWithEnvOverride()
 	runtime.Must(err)
 
 	gardenNamespace := &corev1.Namespace{}
 	runtime.Must(f.k8sGardenClient.Client().Get(context.TODO(), kutil.Key(common.GardenNamespace), gardenNamespace))
 
-	runtime.Must(garden.BootstrapCluster(f.k8sGardenClient, common.GardenNamespace, secrets))
-	logger.Logger.Info("Successfully bootstrapped the Garden cluster.")
+	seedAgent := f.cfg.SeedAgent != nil && *f.cfg.SeedAgent
+	if !seedAgent {
+		runtime.Must(garden.BootstrapCluster(f.k8sGardenClient, common.GardenNamespace, secrets))
+		logger.Logger.Info("Successfully bootstrapped the Garden cluster.")
+	}
 
 	// Initialize the workqueue metrics collection.
 	gardenmetrics.RegisterWorkqueMetrics()
 
 	var (
-		shootController                  = shootcontroller.NewShootController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sGardenCoreInformers, f.k8sInformers, f.cfg, f.identity, f.gardenNamespace, secrets, imageVector, f.recorder)
+		shootController                  = shootcontroller.NewShootController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sGardenCoreInformers, f.k8sInformers, f.cfg, f.identity, f.gardenNamespace, secrets, vaultClient, imageVector, f.recorder, f.flowRegistry, f.operationLogs)
 		seedController                   = seedcontroller.NewSeedController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sInformers, secrets, imageVector, f.cfg, f.recorder)
 		quotaController                  = quotacontroller.NewQuotaController(f.k8sGardenClient, f.k8sGardenInformers, f.recorder)
 		projectController                = projectcontroller.NewProjectController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sInformers, f.recorder)
-		cloudProfileController           = cloudprofilecontroller.NewCloudProfileController(f.k8sGardenClient, f.k8sGardenInformers)
+		cloudProfileController           = cloudprofilecontroller.NewCloudProfileController(f.k8sGardenClient, f.k8sGardenInformers, f.cfg)
 		secretBindingController          = secretbindingcontroller.NewSecretBindingController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sInformers, f.recorder)
 		backupInfrastructureController   = backupinfrastructurecontroller.NewBackupInfrastructureController(f.k8sGardenClient, f.k8sGardenInformers, f.cfg, f.identity, f.gardenNamespace, secrets, imageVector, f.recorder)
-		controllerRegistrationController = controllerregistrationcontroller.NewController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sGardenCoreInformers, f.cfg, f.recorder)
+		controllerRegistrationController = controllerregistrationcontroller.NewController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sGardenCoreInformers, secrets, f.cfg, f.recorder)
 		controllerInstallationController = controllerinstallationcontroller.NewController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sGardenCoreInformers, f.cfg, f.recorder, gardenNamespace)
 		plantController                  = plantcontroller.NewController(f.k8sGardenClient, f.k8sGardenCoreInformers, f.k8sInformers, f.cfg, f.recorder)
 	)
@@ -155,18 +172,27 @@ func (f *GardenControllerFactory) Run(ctx context.Context) {
 	// Initialize the Controller metrics collection.
 	gardenmetrics.RegisterControllerMetrics(shootController, seedController, quotaController, cloudProfileController, secretBindingController, backupInfrastructureController)
 
-	go shootController.Run(ctx, f.cfg.Controllers.Shoot.ConcurrentSyncs, f.cfg.Controllers.ShootCare.ConcurrentSyncs, f.cfg.Controllers.ShootMaintenance.ConcurrentSyncs, f.cfg.Controllers.ShootQuota.ConcurrentSyncs, f.cfg.Controllers.ShootHibernation.ConcurrentSyncs)
 	go seedController.Run(ctx, f.cfg.Controllers.Seed.ConcurrentSyncs)
-	go quotaController.Run(ctx, f.cfg.Controllers.Quota.ConcurrentSyncs)
-	go projectController.Run(ctx, f.cfg.Controllers.Project.ConcurrentSyncs)
-	go cloudProfileController.Run(ctx, f.cfg.Controllers.CloudProfile.ConcurrentSyncs)
-	go secretBindingController.Run(ctx, f.cfg.Controllers.SecretBinding.ConcurrentSyncs)
 	go backupInfrastructureController.Run(ctx, f.cfg.Controllers.BackupInfrastructure.ConcurrentSyncs)
-	go controllerRegistrationController.Run(ctx, f.cfg.Controllers.ControllerRegistration.ConcurrentSyncs)
 	go controllerInstallationController.Run(ctx, f.cfg.Controllers.ControllerInstallation.ConcurrentSyncs)
-	go plantController.Run(ctx, f.cfg.Controllers.Plant.ConcurrentSyncs)
 
-	logger.Logger.Infof("Gardener controller manager (version %s) initialized.", version.Get().GitVersion)
+	if seedAgent {
+		// Seed agents only act on the Seed clusters selected by their seed selector. The maintenance, quota and
+		// hibernation schedule handling of Shoots as well as all other controllers are left to the central instance.
+		go shootController.Run(ctx, f.cfg.Controllers.Shoot.ConcurrentSyncs, f.cfg.Controllers.ShootCare.ConcurrentSyncs, 0, 0, 0)
+
+		logger.Logger.Infof("Gardener controller manager (version %s) initialized as seed agent.", version.Get().GitVersion)
+	} else {
+		go shootController.Run(ctx, f.cfg.Controllers.Shoot.ConcurrentSyncs, f.cfg.Controllers.ShootCare.ConcurrentSyncs, f.cfg.Controllers.ShootMaintenance.ConcurrentSyncs, f.cfg.Controllers.ShootQuota.ConcurrentSyncs, f.cfg.Controllers.ShootHibernation.ConcurrentSyncs)
+		go quotaController.Run(ctx, f.cfg.Controllers.Quota.ConcurrentSyncs)
+		go projectController.Run(ctx, f.cfg.Controllers.Project.ConcurrentSyncs)
+		go cloudProfileController.Run(ctx, f.cfg.Controllers.CloudProfile.ConcurrentSyncs)
+		go secretBindingController.Run(ctx, f.cfg.Controllers.SecretBinding.ConcurrentSyncs)
+		go controllerRegistrationController.Run(ctx, f.cfg.Controllers.ControllerRegistration.ConcurrentSyncs)
+		go plantController.Run(ctx, f.cfg.Controllers.Plant.ConcurrentSyncs)
+
+		logger.Logger.Infof("Gardener controller manager (version %s) initialized.", version.Get().GitVersion)
+	}
 
 	// Shutdown handling
 	<-ctx.Done()
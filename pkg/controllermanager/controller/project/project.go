@@ -37,7 +37,6 @@ import (
 	kubecorev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
-	"k8s.io/client-go/util/retry"
 	"k8s.io/client-go/util/workqueue"
 
 	multierror "github.com/hashicorp/go-multierror"
@@ -80,7 +79,7 @@ func NewProjectController(k8sGardenClient kubernetes.Interface, gardenInformerFa
 		namespaceInformer = corev1Informer.Namespaces()
 		namespaceLister   = namespaceInformer.Lister()
 
-		projectUpdater = NewRealUpdater(k8sGardenClient, projectLister)
+		projectUpdater = NewRealUpdater(k8sGardenClient)
 	)
 
 	projectController := &Controller{
@@ -193,9 +192,10 @@ func (c *Controller) migrateRoleBindingToProjectMembers() error {
 
 	for _, roleBinding := range roleBindingList.Items {
 		if projectName, ok := namespaceToProject[roleBinding.Namespace]; ok {
-			if _, err := kutils.TryUpdateProject(c.k8sGardenClient.Garden(), retry.DefaultBackoff, metav1.ObjectMeta{Name: projectName}, func(project *gardenv1beta1.Project) (*gardenv1beta1.Project, error) {
+			project := &gardenv1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: projectName}}
+			if err := kutils.TryUpdate(context.TODO(), kutils.DefaultBackoff, c.k8sGardenClient.Client(), project, func() error {
 				project.Spec.Members = roleBinding.Subjects
-				return project, nil
+				return nil
 			}); err != nil {
 				result = multierror.Append(result, err)
 				continue
@@ -15,6 +15,7 @@
 package project
 
 import (
+	"context"
 	"time"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
@@ -29,7 +30,6 @@ import (
 	kubecorev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
-	"k8s.io/client-go/util/retry"
 
 	"github.com/sirupsen/logrus"
 )
@@ -132,11 +132,15 @@ func (c *defaultControl) ReconcileProject(obj *gardenv1beta1.Project) (bool, err
 }
 
 func (c *defaultControl) updateProjectStatus(objectMeta metav1.ObjectMeta, transform func(project *gardenv1beta1.Project) (*gardenv1beta1.Project, error)) (*gardenv1beta1.Project, error) {
-	project, err := kutils.TryUpdateProjectStatus(c.k8sGardenClient.Garden(), retry.DefaultRetry, objectMeta, transform)
-	if err != nil {
+	project := &gardenv1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: objectMeta.Name}}
+	if err := kutils.TryUpdateStatus(context.TODO(), kutils.DefaultBackoff, c.k8sGardenClient.Client(), project, func() error {
+		_, err := transform(project)
+		return err
+	}); err != nil {
 		newProjectLogger(project).Errorf("Error updating the status of the project: %q", err.Error())
+		return nil, err
 	}
-	return project, err
+	return project, nil
 }
 
 func (c *defaultControl) reportEvent(project *gardenv1beta1.Project, isError bool, eventReason, messageFmt string, args ...interface{}) {
@@ -15,7 +15,10 @@
 package project
 
 import (
+	"context"
+
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	kutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/sirupsen/logrus"
 
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
@@ -40,10 +43,12 @@ func (c *defaultControl) delete(project *gardenv1beta1.Project, projectLogger lo
 	}
 
 	// Remove finalizer from project resource.
-	projectFinalizers := sets.NewString(project.Finalizers...)
-	projectFinalizers.Delete(gardenv1beta1.GardenerName)
-	project.Finalizers = projectFinalizers.UnsortedList()
-	if _, err := c.k8sGardenClient.Garden().GardenV1beta1().Projects().Update(project); err != nil && !apierrors.IsNotFound(err) {
+	if err := kutils.TryUpdate(context.TODO(), kutils.DefaultBackoff, c.k8sGardenClient.Client(), project, func() error {
+		projectFinalizers := sets.NewString(project.Finalizers...)
+		projectFinalizers.Delete(gardenv1beta1.GardenerName)
+		project.Finalizers = projectFinalizers.UnsortedList()
+		return nil
+	}); err != nil && !apierrors.IsNotFound(err) {
 		projectLogger.Error(err.Error())
 		return false, err
 	}
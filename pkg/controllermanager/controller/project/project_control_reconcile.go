@@ -31,7 +31,6 @@ import (
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/util/retry"
 
 	"github.com/sirupsen/logrus"
 )
@@ -71,11 +70,10 @@ func (c *defaultControl) reconcile(project *gardenv1beta1.Project, projectLogger
 
 	// Update the name of the created namespace in the projects '.spec.namespace' field.
 	if ns := project.Spec.Namespace; ns == nil {
-		project, err = kutils.TryUpdateProject(c.k8sGardenClient.Garden(), retry.DefaultBackoff, project.ObjectMeta, func(project *gardenv1beta1.Project) (*gardenv1beta1.Project, error) {
+		if err := kutils.TryUpdate(context.TODO(), kutils.DefaultBackoff, c.k8sGardenClient.Client(), project, func() error {
 			project.Spec.Namespace = &namespace.Name
-			return project, nil
-		})
-		if err != nil {
+			return nil
+		}); err != nil {
 			c.reportEvent(project, false, gardenv1beta1.ProjectEventNamespaceReconcileFailed, err.Error())
 			c.updateProjectStatus(project.ObjectMeta, setProjectPhase(gardenv1beta1.ProjectFailed))
 
@@ -157,21 +155,20 @@ func (c *defaultControl) reconcileNamespaceForProject(project *gardenv1beta1.Pro
 		}, false)
 	}
 
-	namespace, err := kutils.TryUpdateNamespace(c.k8sGardenClient.Kubernetes(), retry.DefaultBackoff, metav1.ObjectMeta{Name: *namespaceName}, func(ns *corev1.Namespace) (*corev1.Namespace, error) {
-		if !apiequality.Semantic.DeepDerivative(projectLabels, ns.Labels) {
-			return nil, fmt.Errorf("namespace cannot be used as it needs the project labels %#v", projectLabels)
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: *namespaceName}}
+	if err := kutils.TryUpdate(context.TODO(), kutils.DefaultBackoff, c.k8sGardenClient.Client(), namespace, func() error {
+		if !apiequality.Semantic.DeepDerivative(projectLabels, namespace.Labels) {
+			return fmt.Errorf("namespace cannot be used as it needs the project labels %#v", projectLabels)
 		}
 
-		if metav1.HasAnnotation(ns.ObjectMeta, common.NamespaceProject) && !apiequality.Semantic.DeepDerivative(projectAnnotations, ns.Annotations) {
-			return nil, fmt.Errorf("namespace is already in-use by another project")
+		if metav1.HasAnnotation(namespace.ObjectMeta, common.NamespaceProject) && !apiequality.Semantic.DeepDerivative(projectAnnotations, namespace.Annotations) {
+			return fmt.Errorf("namespace is already in-use by another project")
 		}
 
-		ns.OwnerReferences = common.MergeOwnerReferences(ns.OwnerReferences, *ownerReference)
-		ns.Annotations = utils.MergeStringMaps(ns.Annotations, projectAnnotations)
-
-		return ns, nil
-	})
-	if err != nil {
+		namespace.OwnerReferences = common.MergeOwnerReferences(namespace.OwnerReferences, *ownerReference)
+		namespace.Annotations = utils.MergeStringMaps(namespace.Annotations, projectAnnotations)
+		return nil
+	}); err != nil {
 		if !apierrors.IsNotFound(err) {
 			return nil, err
 		}
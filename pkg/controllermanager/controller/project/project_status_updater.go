@@ -15,11 +15,11 @@
 package project
 
 import (
+	"context"
+
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
-	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
-	"github.com/gardener/gardener/pkg/logger"
-	"k8s.io/client-go/util/retry"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
 // UpdaterInterface is an interface used to update the Project manifest.
@@ -28,38 +28,23 @@ type UpdaterInterface interface {
 	UpdateProjectStatus(project *gardenv1beta1.Project) (*gardenv1beta1.Project, error)
 }
 
-// NewRealUpdater returns a UpdaterInterface that updates the Project manifest, using the supplied client and projectLister.
-func NewRealUpdater(k8sGardenClient kubernetes.Interface, projectLister gardenlisters.ProjectLister) UpdaterInterface {
-	return &realUpdater{k8sGardenClient, projectLister}
+// NewRealUpdater returns a UpdaterInterface that updates the Project manifest, using the supplied client.
+func NewRealUpdater(k8sGardenClient kubernetes.Interface) UpdaterInterface {
+	return &realUpdater{k8sGardenClient}
 }
 
 type realUpdater struct {
 	k8sGardenClient kubernetes.Interface
-	projectLister   gardenlisters.ProjectLister
 }
 
 // UpdateProjectStatus updates the Project manifest. Implementations are required to retry on conflicts,
 // but fail on other errors. If the returned error is nil Project's manifest has been successfully set.
 func (u *realUpdater) UpdateProjectStatus(project *gardenv1beta1.Project) (*gardenv1beta1.Project, error) {
-	var (
-		newProject *gardenv1beta1.Project
-		status     = project.Status
-		updateErr  error
-	)
+	newProject := &gardenv1beta1.Project{ObjectMeta: kutil.ObjectMeta(project.Name)}
 
-	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		project.Status = status
-		newProject, updateErr = u.k8sGardenClient.Garden().GardenV1beta1().Projects().UpdateStatus(project)
-		if updateErr == nil {
-			return nil
-		}
-		updated, err := u.projectLister.Get(project.Name)
-		if err == nil {
-			project = updated.DeepCopy()
-		} else {
-			logger.Logger.Errorf("error getting updated Project %s from lister: %v", project.Name, err)
-		}
-		return updateErr
+	if err := kutil.TryUpdateStatus(context.TODO(), kutil.DefaultBackoff, u.k8sGardenClient.Client(), newProject, func() error {
+		newProject.Status = project.Status
+		return nil
 	}); err != nil {
 		return nil, err
 	}
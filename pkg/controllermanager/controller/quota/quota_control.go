@@ -15,6 +15,7 @@
 package quota
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -25,6 +26,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
@@ -129,10 +131,12 @@ func (c *defaultControl) ReconcileQuota(obj *gardenv1beta1.Quota, key string) er
 			quotaLogger.Info("No SecretBindings are referencing the Quota. Deletion accepted.")
 
 			// Remove finalizer from Quota
-			quotaFinalizers := sets.NewString(quota.Finalizers...)
-			quotaFinalizers.Delete(gardenv1beta1.GardenerName)
-			quota.Finalizers = quotaFinalizers.UnsortedList()
-			if _, err := c.k8sGardenClient.Garden().GardenV1beta1().Quotas(quota.Namespace).Update(quota); err != nil && !apierrors.IsNotFound(err) {
+			if err := kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), quota, func() error {
+				quotaFinalizers := sets.NewString(quota.Finalizers...)
+				quotaFinalizers.Delete(gardenv1beta1.GardenerName)
+				quota.Finalizers = quotaFinalizers.UnsortedList()
+				return nil
+			}); err != nil && !apierrors.IsNotFound(err) {
 				quotaLogger.Error(err.Error())
 				return err
 			}
@@ -67,7 +67,7 @@ func NewSecretBindingController(k8sGardenClient kubernetes.Interface, gardenInfo
 	secretBindingController := &Controller{
 		k8sGardenClient:     k8sGardenClient,
 		k8sGardenInformers:  gardenInformerFactory,
-		control:             NewDefaultControl(k8sGardenClient, gardenInformerFactory, recorder, secretLister, shootLister),
+		control:             NewDefaultControl(k8sGardenClient, gardenInformerFactory, recorder, secretLister, secretBindingLister, shootLister),
 		recorder:            recorder,
 		secretBindingLister: secretBindingLister,
 		secretBindingQueue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "SecretBinding"),
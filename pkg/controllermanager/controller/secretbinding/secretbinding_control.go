@@ -15,8 +15,10 @@
 package secretbinding
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
@@ -25,7 +27,13 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	kubecorev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
@@ -90,16 +98,17 @@ type ControlInterface interface {
 // implements the documented semantics for SecretBindings. updater is the UpdaterInterface used
 // to update the status of SecretBindings. You should use an instance returned from NewDefaultControl() for any
 // scenario other than testing.
-func NewDefaultControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, recorder record.EventRecorder, secretLister kubecorev1listers.SecretLister, shootLister gardenlisters.ShootLister) ControlInterface {
-	return &defaultControl{k8sGardenClient, k8sGardenInformers, recorder, secretLister, shootLister}
+func NewDefaultControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, recorder record.EventRecorder, secretLister kubecorev1listers.SecretLister, secretBindingLister gardenlisters.SecretBindingLister, shootLister gardenlisters.ShootLister) ControlInterface {
+	return &defaultControl{k8sGardenClient, k8sGardenInformers, recorder, secretLister, secretBindingLister, shootLister}
 }
 
 type defaultControl struct {
-	k8sGardenClient    kubernetes.Interface
-	k8sGardenInformers gardeninformers.SharedInformerFactory
-	recorder           record.EventRecorder
-	secretLister       kubecorev1listers.SecretLister
-	shootLister        gardenlisters.ShootLister
+	k8sGardenClient     kubernetes.Interface
+	k8sGardenInformers  gardeninformers.SharedInformerFactory
+	recorder            record.EventRecorder
+	secretLister        kubecorev1listers.SecretLister
+	secretBindingLister gardenlisters.SecretBindingLister
+	shootLister         gardenlisters.ShootLister
 }
 
 func (c *defaultControl) ReconcileSecretBinding(obj *gardenv1beta1.SecretBinding, key string) error {
@@ -131,25 +140,37 @@ func (c *defaultControl) ReconcileSecretBinding(obj *gardenv1beta1.SecretBinding
 			secretBindingLogger.Info("No Shoots are referencing the SecretBinding. Deletion accepted.")
 
 			// Remove finalizer from referenced secret
-			secret, err := c.secretLister.Secrets(secretBinding.SecretRef.Namespace).Get(secretBinding.SecretRef.Name)
-			if err == nil {
-				secretFinalizers := sets.NewString(secret.Finalizers...)
-				secretFinalizers.Delete(gardenv1beta1.ExternalGardenerName)
-				secret.Finalizers = secretFinalizers.UnsortedList()
-				if _, err := c.k8sGardenClient.UpdateSecretObject(secret); err != nil && !apierrors.IsNotFound(err) {
+			if secretBinding.VaultRef == nil {
+				secret, err := c.secretLister.Secrets(secretBinding.SecretRef.Namespace).Get(secretBinding.SecretRef.Name)
+				if err == nil {
+					secretFinalizers := sets.NewString(secret.Finalizers...)
+					secretFinalizers.Delete(gardenv1beta1.ExternalGardenerName)
+					secret.Finalizers = secretFinalizers.UnsortedList()
+					if _, err := c.k8sGardenClient.UpdateSecretObject(secret); err != nil && !apierrors.IsNotFound(err) {
+						secretBindingLogger.Error(err.Error())
+						return err
+					}
+				} else if !apierrors.IsNotFound(err) {
+					secretBindingLogger.Error(err.Error())
+					return err
+				}
+			}
+
+			// Release the secret of an unfinished credentials rotation
+			if secretBinding.Rotation != nil && !apiequality.Semantic.DeepEqual(secretBinding.Rotation.SecretRef, secretBinding.SecretRef) {
+				if err := c.releaseSecret(secretBinding, secretBinding.Rotation.SecretRef); err != nil {
 					secretBindingLogger.Error(err.Error())
 					return err
 				}
-			} else if !apierrors.IsNotFound(err) {
-				secretBindingLogger.Error(err.Error())
-				return err
 			}
 
 			// Remove finalizer from SecretBinding
-			secretBindingFinalizers := sets.NewString(secretBinding.Finalizers...)
-			secretBindingFinalizers.Delete(gardenv1beta1.GardenerName)
-			secretBinding.Finalizers = secretBindingFinalizers.UnsortedList()
-			if _, err := c.k8sGardenClient.Garden().GardenV1beta1().SecretBindings(secretBinding.Namespace).Update(secretBinding); err != nil && !apierrors.IsNotFound(err) {
+			if err := kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), secretBinding, func() error {
+				secretBindingFinalizers := sets.NewString(secretBinding.Finalizers...)
+				secretBindingFinalizers.Delete(gardenv1beta1.GardenerName)
+				secretBinding.Finalizers = secretBindingFinalizers.UnsortedList()
+				return nil
+			}); err != nil && !apierrors.IsNotFound(err) {
 				secretBindingLogger.Error(err.Error())
 				return err
 			}
@@ -159,6 +180,11 @@ func (c *defaultControl) ReconcileSecretBinding(obj *gardenv1beta1.SecretBinding
 		return errors.New("SecretBinding still has references")
 	}
 
+	// Credentials read from Vault are not stored in a secret which would have to be protected.
+	if secretBinding.VaultRef != nil {
+		return nil
+	}
+
 	// Add the Gardener finalizer to the referenced SecretBinding secret to protect it from deletion as long as
 	// the SecretBinding resource does exist.
 	secret, err := c.secretLister.Secrets(secretBinding.SecretRef.Namespace).Get(secretBinding.SecretRef.Name)
@@ -176,5 +202,155 @@ func (c *defaultControl) ReconcileSecretBinding(obj *gardenv1beta1.SecretBinding
 		return err
 	}
 
+	if secretBinding.Rotation != nil {
+		return c.reconcileSecretBindingRotation(secretBinding, secretBindingLogger)
+	}
+
+	return nil
+}
+
+// reconcileSecretBindingRotation drives the credentials rotation of the given SecretBinding through its phases. A new
+// rotation is accepted once its secret exists and is moved into the 'Rolling' phase, in which the Shoot controller
+// switches every Shoot to the new credentials. When all Shoots use them, the new secret becomes the secret of the
+// SecretBinding, the rotation is moved into the 'Completed' phase, and the previous secret is released.
+func (c *defaultControl) reconcileSecretBindingRotation(secretBinding *gardenv1beta1.SecretBinding, secretBindingLogger *logrus.Entry) error {
+	rotation := secretBinding.Rotation.DeepCopy()
+
+	if rotation.Status != nil && rotation.Status.Phase == gardenv1beta1.SecretBindingRotationPhaseCompleted {
+		if rotation.Status.PreviousSecretRef == nil {
+			return nil
+		}
+		if err := c.releaseSecret(secretBinding, *rotation.Status.PreviousSecretRef); err != nil {
+			secretBindingLogger.Error(err.Error())
+			return err
+		}
+		return nil
+	}
+
+	// Protect the new secret from deletion in the same way as the current one.
+	secret, err := c.secretLister.Secrets(rotation.SecretRef.Namespace).Get(rotation.SecretRef.Name)
+	if err != nil {
+		secretBindingLogger.Errorf("Cannot start credentials rotation: %s", err.Error())
+		return err
+	}
+	if secretFinalizers := sets.NewString(secret.Finalizers...); !secretFinalizers.Has(gardenv1beta1.ExternalGardenerName) {
+		secretFinalizers.Insert(gardenv1beta1.ExternalGardenerName)
+		secret.Finalizers = secretFinalizers.UnsortedList()
+		if _, err := c.k8sGardenClient.UpdateSecretObject(secret); err != nil {
+			secretBindingLogger.Error(err.Error())
+			return err
+		}
+	}
+
+	associatedShoots, err := controllerutils.DetermineShootAssociations(secretBinding, c.shootLister)
+	if err != nil {
+		secretBindingLogger.Error(err.Error())
+		return err
+	}
+
+	status := rotation.Status
+	if status == nil {
+		secretBindingLogger.Infof("Starting rotation of cloud provider credentials to secret %s/%s", rotation.SecretRef.Namespace, rotation.SecretRef.Name)
+		c.recorder.Eventf(secretBinding, corev1.EventTypeNormal, gardenv1beta1.SecretBindingEventRotationStarted, "Started rotation of cloud provider credentials to secret %s/%s", rotation.SecretRef.Namespace, rotation.SecretRef.Name)
+		status = &gardenv1beta1.SecretBindingRotationStatus{Phase: gardenv1beta1.SecretBindingRotationPhaseRolling}
+		rotation.Status = status
+	}
+
+	var (
+		rotatedShoots = sets.NewString(status.RotatedShoots...)
+		pendingShoots []string
+	)
+	for _, shoot := range associatedShoots {
+		if _, name, err := cache.SplitMetaNamespaceKey(shoot); err == nil && !rotatedShoots.Has(name) {
+			pendingShoots = append(pendingShoots, shoot)
+		}
+	}
+
+	if len(pendingShoots) == 0 {
+		previousSecretRef := secretBinding.SecretRef
+
+		status.Phase = gardenv1beta1.SecretBindingRotationPhaseCompleted
+		status.PreviousSecretRef = &previousSecretRef
+		status.Description = "All Shoots use the new cloud provider credentials."
+		status.LastUpdateTime = metav1.Now()
+
+		if err := c.updateRotationStatus(secretBinding, rotation, true); err != nil {
+			secretBindingLogger.Error(err.Error())
+			return err
+		}
+
+		secretBindingLogger.Infof("Completed rotation of cloud provider credentials to secret %s/%s", rotation.SecretRef.Namespace, rotation.SecretRef.Name)
+		c.recorder.Eventf(secretBinding, corev1.EventTypeNormal, gardenv1beta1.SecretBindingEventRotationCompleted, "Completed rotation of cloud provider credentials to secret %s/%s", rotation.SecretRef.Namespace, rotation.SecretRef.Name)
+
+		if err := c.releaseSecret(secretBinding, previousSecretRef); err != nil {
+			secretBindingLogger.Error(err.Error())
+			return err
+		}
+		return nil
+	}
+
+	if description := fmt.Sprintf("Waiting for %d Shoot(s) to use the new cloud provider credentials: %s", len(pendingShoots), strings.Join(pendingShoots, ", ")); description != status.Description {
+		status.Description = description
+		status.LastUpdateTime = metav1.Now()
+
+		if err := c.updateRotationStatus(secretBinding, rotation, false); err != nil {
+			secretBindingLogger.Error(err.Error())
+			return err
+		}
+	}
+
+	secretBindingLogger.Infof("Credentials rotation is waiting for the following Shoots: %v", pendingShoots)
+	return errors.New("credentials rotation has not finished yet")
+}
+
+// updateRotationStatus writes the status of the given <rotation> to the SecretBinding. If <completed> is true then the
+// secret of the rotation also becomes the secret of the SecretBinding. It fails if the rotation has been changed in the
+// meantime.
+func (c *defaultControl) updateRotationStatus(secretBinding *gardenv1beta1.SecretBinding, rotation *gardenv1beta1.SecretBindingRotation, completed bool) error {
+	return kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), secretBinding, func() error {
+		if secretBinding.Rotation == nil || !apiequality.Semantic.DeepEqual(secretBinding.Rotation.SecretRef, rotation.SecretRef) {
+			return fmt.Errorf("credentials rotation of SecretBinding %s/%s has been changed in the meantime", secretBinding.Namespace, secretBinding.Name)
+		}
+		if completed {
+			secretBinding.SecretRef = rotation.SecretRef
+		}
+		secretBinding.Rotation.Status = rotation.Status
+		return nil
+	})
+}
+
+// releaseSecret removes the Gardener finalizer from the secret referenced by <secretRef> unless another SecretBinding
+// than the given one still uses it.
+func (c *defaultControl) releaseSecret(secretBinding *gardenv1beta1.SecretBinding, secretRef corev1.SecretReference) error {
+	secretBindings, err := c.secretBindingLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, other := range secretBindings {
+		if other.Namespace == secretBinding.Namespace && other.Name == secretBinding.Name {
+			continue
+		}
+		if apiequality.Semantic.DeepEqual(other.SecretRef, secretRef) || (other.Rotation != nil && apiequality.Semantic.DeepEqual(other.Rotation.SecretRef, secretRef)) {
+			return nil
+		}
+	}
+
+	secret, err := c.secretLister.Secrets(secretRef.Namespace).Get(secretRef.Name)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	secretFinalizers := sets.NewString(secret.Finalizers...)
+	if !secretFinalizers.Has(gardenv1beta1.ExternalGardenerName) {
+		return nil
+	}
+	secretFinalizers.Delete(gardenv1beta1.ExternalGardenerName)
+	secret.Finalizers = secretFinalizers.UnsortedList()
+	if _, err := c.k8sGardenClient.UpdateSecretObject(secret); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
 	return nil
 }
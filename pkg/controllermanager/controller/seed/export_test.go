@@ -0,0 +1,48 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Bridge package to expose internal functions to tests in the seed_test package.
+
+package seed
+
+import (
+	"context"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	awsclient "github.com/gardener/gardener/pkg/client/aws"
+	gcpclient "github.com/gardener/gardener/pkg/client/gcp"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	seedpkg "github.com/gardener/gardener/pkg/operation/seed"
+)
+
+var ExportDeleteOrphans = deleteOrphans
+
+var ExportProviderHealthCheckSupported = providerHealthCheckSupported
+
+// ExportCheckProviderHealth checks the cloud provider account of the given Seed with a control which talks to the cloud
+// APIs through the given clients and updates the Seed status with the given <updater>.
+func ExportCheckProviderHealth(ctx context.Context, updater UpdaterInterface, config *config.ControllerManagerConfiguration, awsClient awsclient.ClientInterface, gcpClient gcpclient.ClientInterface, seed *gardenv1beta1.Seed, seedObj *seedpkg.Seed, condition gardencorev1alpha1.Condition) (gardencorev1alpha1.Condition, error) {
+	c := &defaultControl{
+		updater: updater,
+		config:  config,
+		newAWSClient: func(accessKeyID, secretAccessKey, region string) awsclient.ClientInterface {
+			return awsClient
+		},
+		newGCPClient: func(ctx context.Context, serviceAccount []byte, projectID string) (gcpclient.ClientInterface, error) {
+			return gcpClient, nil
+		},
+	}
+	return c.checkProviderHealth(ctx, seed, seedObj, condition)
+}
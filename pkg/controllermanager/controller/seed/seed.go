@@ -48,6 +48,7 @@ type Controller struct {
 	seedLister gardenlisters.SeedLister
 	seedQueue  workqueue.RateLimitingInterface
 	seedSynced cache.InformerSynced
+	seedFilter func(obj interface{}) bool
 
 	shootLister gardenlisters.ShootLister
 
@@ -65,7 +66,7 @@ func NewSeedController(k8sGardenClient kubernetes.Interface, gardenInformerFacto
 
 		seedInformer               = gardenv1beta1Informer.Seeds()
 		seedLister                 = seedInformer.Lister()
-		seedUpdater                = NewRealUpdater(k8sGardenClient, seedLister)
+		seedUpdater                = NewRealUpdater(k8sGardenClient)
 		secretLister               = corev1Informer.Secrets().Lister()
 		shootLister                = gardenv1beta1Informer.Shoots().Lister()
 		backupInfrastructureLister = gardenv1beta1Informer.BackupInfrastructures().Lister()
@@ -79,6 +80,7 @@ func NewSeedController(k8sGardenClient kubernetes.Interface, gardenInformerFacto
 		recorder:           recorder,
 		seedLister:         seedLister,
 		seedQueue:          workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "seed"),
+		seedFilter:         controllerutils.SeedFilterFunc(seedLister, config.SeedSelector),
 		shootLister:        shootLister,
 		workerCh:           make(chan int),
 	}
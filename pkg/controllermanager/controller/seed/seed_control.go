@@ -15,26 +15,35 @@
 package seed
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	awsclient "github.com/gardener/gardener/pkg/client/aws"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	gcpclient "github.com/gardener/gardener/pkg/client/gcp"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
 	seedpkg "github.com/gardener/gardener/pkg/operation/seed"
+	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	kubecorev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
@@ -88,6 +97,10 @@ func (c *Controller) reconcileSeedKey(key string) error {
 		logger.Logger.Infof("[SEED RECONCILE] %s - unable to retrieve object from store: %v", key, err)
 		return err
 	}
+	if !c.seedFilter(seed) {
+		logger.Logger.Debugf("[SEED RECONCILE] %s - skipping because the Seed is not selected by my seed selector", key)
+		return nil
+	}
 
 	if err := c.control.ReconcileSeed(seed, key); err != nil {
 		c.seedQueue.AddAfter(key, 15*time.Second)
@@ -112,7 +125,7 @@ type ControlInterface interface {
 // to update the status of Seeds. You should use an instance returned from NewDefaultControl() for any
 // scenario other than testing.
 func NewDefaultControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, recorder record.EventRecorder, updater UpdaterInterface, config *config.ControllerManagerConfiguration, secretLister kubecorev1listers.SecretLister, shootLister gardenlisters.ShootLister, backupInfrastructureLister gardenlisters.BackupInfrastructureLister) ControlInterface {
-	return &defaultControl{k8sGardenClient, k8sGardenInformers, secrets, imageVector, recorder, updater, config, secretLister, shootLister, backupInfrastructureLister}
+	return &defaultControl{k8sGardenClient, k8sGardenInformers, secrets, imageVector, recorder, updater, config, secretLister, shootLister, backupInfrastructureLister, awsclient.NewClient, gcpclient.NewClient}
 }
 
 type defaultControl struct {
@@ -126,6 +139,8 @@ type defaultControl struct {
 	secretLister               kubecorev1listers.SecretLister
 	shootLister                gardenlisters.ShootLister
 	backupInfrastructureLister gardenlisters.BackupInfrastructureLister
+	newAWSClient               func(accessKeyID, secretAccessKey, region string) awsclient.ClientInterface
+	newGCPClient               func(ctx context.Context, serviceAccount []byte, projectID string) (gcpclient.ClientInterface, error)
 }
 
 func (c *defaultControl) ReconcileSeed(obj *gardenv1beta1.Seed, key string) error {
@@ -178,10 +193,12 @@ func (c *defaultControl) ReconcileSeed(obj *gardenv1beta1.Seed, key string) erro
 			}
 
 			// Remove finalizer from Seed
-			seedFinalizers := sets.NewString(seed.Finalizers...)
-			seedFinalizers.Delete(gardenv1beta1.GardenerName)
-			seed.Finalizers = seedFinalizers.UnsortedList()
-			if _, err := c.k8sGardenClient.Garden().GardenV1beta1().Seeds().Update(seed); err != nil && !apierrors.IsNotFound(err) {
+			if err := kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), seed, func() error {
+				seedFinalizers := sets.NewString(seed.Finalizers...)
+				seedFinalizers.Delete(gardenv1beta1.GardenerName)
+				seed.Finalizers = seedFinalizers.UnsortedList()
+				return nil
+			}); err != nil && !apierrors.IsNotFound(err) {
 				seedLogger.Error(err.Error())
 				return err
 			}
@@ -219,7 +236,10 @@ func (c *defaultControl) ReconcileSeed(obj *gardenv1beta1.Seed, key string) erro
 	}
 
 	// Initialize conditions based on the current status.
-	conditionSeedAvailable := gardencorev1alpha1helper.GetOrInitCondition(seed.Status.Conditions, gardenv1beta1.SeedAvailable)
+	var (
+		conditionSeedAvailable           = gardencorev1alpha1helper.GetOrInitCondition(seed.Status.Conditions, gardenv1beta1.SeedAvailable)
+		conditionShootVersionsCompatible = gardencorev1alpha1helper.GetOrInitCondition(seed.Status.Conditions, gardenv1beta1.SeedShootVersionsCompatible)
+	)
 
 	seedObj, err := seedpkg.New(c.k8sGardenClient, c.k8sGardenInformers.Garden().V1beta1(), seed)
 	if err != nil {
@@ -245,6 +265,22 @@ func (c *defaultControl) ReconcileSeed(obj *gardenv1beta1.Seed, key string) erro
 		return err
 	}
 
+	// Report the Kubernetes version of the Seed cluster and check whether it is compatible with the Kubernetes versions
+	// of the hosted Shoots (e.g., after the Seed cluster has been upgraded).
+	seedVersion, err := seedObj.GetK8SVersion()
+	if err != nil {
+		seedLogger.Error(err.Error())
+		return err
+	}
+	if err := c.updateSeedKubernetesVersion(seed, seedVersion); err != nil {
+		return err
+	}
+	conditionShootVersionsCompatible, err = c.checkShootVersionsCompatibility(seed, seedVersion, conditionShootVersionsCompatible)
+	if err != nil {
+		seedLogger.Error(err.Error())
+		return err
+	}
+
 	// Bootstrap the Seed cluster.
 	if c.config.Controllers.Seed.ReserveExcessCapacity != nil {
 		seedObj.MustReserveExcessCapacity(*c.config.Controllers.Seed.ReserveExcessCapacity)
@@ -256,12 +292,109 @@ func (c *defaultControl) ReconcileSeed(obj *gardenv1beta1.Seed, key string) erro
 		return err
 	}
 
+	// Report resources in the Seed cluster which do not belong to any Shoot anymore (e.g., after failed deletions)
+	// and clean them up if the operator has confirmed their deletion.
+	orphans, err := c.reconcileOrphans(context.TODO(), seed, seedObj, seedLogger)
+	if err != nil {
+		seedLogger.Errorf("Failed to reconcile orphaned resources: %+v", err)
+		return err
+	}
+	if len(orphans) > 0 {
+		seedLogger.Infof("Found %d orphaned resource(s) in the Seed cluster", len(orphans))
+	}
+	c.updateSeedOrphans(seed, orphans)
+	if len(orphans) == 0 && orphanDeletionConfirmed(seed) {
+		if err := c.removeOrphanDeletionConfirmation(seed); err != nil {
+			seedLogger.Error(err.Error())
+			return err
+		}
+	}
+
+	// Check the cloud provider account of the Seed so that new Shoots are not scheduled onto Seeds whose cloud API is
+	// unreachable or whose quotas are nearly exhausted.
+	conditions := []gardencorev1alpha1.Condition{conditionShootVersionsCompatible}
+	if providerHealthCheckSupported(seedObj.CloudProvider) && providerHealthCheckScheduleReached(seed, c.config.Controllers.Seed.ProviderHealthCheckPeriod) {
+		conditionProviderHealthy := gardencorev1alpha1helper.GetOrInitCondition(seed.Status.Conditions, gardenv1beta1.SeedProviderHealthy)
+		conditionProviderHealthy, err = c.checkProviderHealth(context.TODO(), seed, seedObj, conditionProviderHealthy)
+		if err != nil {
+			seedLogger.Errorf("Failed to report the health of the cloud provider account: %+v", err)
+		}
+		conditions = append(conditions, conditionProviderHealthy)
+	}
+
 	conditionSeedAvailable = gardencorev1alpha1helper.UpdatedCondition(conditionSeedAvailable, gardencorev1alpha1.ConditionTrue, "Passed", "all checks passed")
-	c.updateSeedStatus(seed, conditionSeedAvailable)
+	c.updateSeedStatus(seed, append(conditions, conditionSeedAvailable)...)
 
 	return nil
 }
 
+// checkShootVersionsCompatibility checks whether the Kubernetes versions of all Shoots hosted by the given <seed> are
+// compatible with the Kubernetes version <seedVersion> of the Seed cluster and updates the given <condition> accordingly.
+func (c *defaultControl) checkShootVersionsCompatibility(seed *gardenv1beta1.Seed, seedVersion string, condition gardencorev1alpha1.Condition) (gardencorev1alpha1.Condition, error) {
+	shoots, err := c.shootLister.List(labels.Everything())
+	if err != nil {
+		return condition, err
+	}
+
+	var incompatibleShoots []string
+	for _, shoot := range shoots {
+		if shoot.Spec.Cloud.Seed == nil || *shoot.Spec.Cloud.Seed != seed.Name {
+			continue
+		}
+		if err := utils.CheckSeedShootVersionCompatibility(seedVersion, shoot.Spec.Kubernetes.Version); err != nil {
+			incompatibleShoots = append(incompatibleShoots, fmt.Sprintf("%s/%s (%s)", shoot.Namespace, shoot.Name, shoot.Spec.Kubernetes.Version))
+		}
+	}
+
+	if len(incompatibleShoots) > 0 {
+		sort.Strings(incompatibleShoots)
+		message := fmt.Sprintf("The Kubernetes versions of the following Shoots are not compatible with the Kubernetes version %s of the Seed cluster: %s", seedVersion, strings.Join(incompatibleShoots, ", "))
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "ShootVersionsIncompatible", message), nil
+	}
+	return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "ShootVersionsCompatible", "The Kubernetes versions of all Shoots are compatible with the Kubernetes version of the Seed cluster."), nil
+}
+
+func (c *defaultControl) updateSeedKubernetesVersion(seed *gardenv1beta1.Seed, version string) error {
+	if seed.Status.KubernetesVersion == version {
+		return nil
+	}
+
+	seed.Status.KubernetesVersion = version
+
+	newSeed, err := c.updater.UpdateSeedStatus(seed)
+	if err != nil {
+		logger.Logger.Errorf("Could not update the Seed status: %+v", err)
+		return err
+	}
+	*seed = *newSeed
+
+	return nil
+}
+
+func (c *defaultControl) updateSeedOrphans(seed *gardenv1beta1.Seed, orphans []gardenv1beta1.SeedOrphan) error {
+	if apiequality.Semantic.DeepEqual(seed.Status.Orphans, orphans) {
+		return nil
+	}
+
+	seed.Status.Orphans = orphans
+
+	newSeed, err := c.updater.UpdateSeedStatus(seed)
+	if err != nil {
+		logger.Logger.Errorf("Could not update the Seed status: %+v", err)
+		return err
+	}
+	*seed = *newSeed
+
+	return nil
+}
+
+func (c *defaultControl) removeOrphanDeletionConfirmation(seed *gardenv1beta1.Seed) error {
+	return kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), seed, func() error {
+		delete(seed.Annotations, common.ConfirmationOrphanDeletion)
+		return nil
+	})
+}
+
 func (c *defaultControl) updateSeedStatus(seed *gardenv1beta1.Seed, updateConditions ...gardencorev1alpha1.Condition) error {
 	newConditions := gardencorev1alpha1helper.MergeConditions(seed.Status.Conditions, updateConditions...)
 	if !gardencorev1alpha1helper.ConditionsNeedUpdate(seed.Status.Conditions, newConditions) {
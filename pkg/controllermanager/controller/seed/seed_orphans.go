@@ -0,0 +1,265 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/operation/common"
+	seedpkg "github.com/gardener/gardener/pkg/operation/seed"
+
+	dnsv1alpha1 "github.com/gardener/external-dns-management/pkg/apis/dns/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// OrphanKindNamespace is the kind of orphaned shoot namespaces.
+	OrphanKindNamespace = "Namespace"
+	// OrphanKindSecret is the kind of orphaned DNS provider secrets.
+	OrphanKindSecret = "Secret"
+	// OrphanKindDNSEntry is the kind of orphaned DNS entries.
+	OrphanKindDNSEntry = "DNSEntry"
+)
+
+// DetermineOrphans computes the resources in the Seed cluster which do not belong to any of the given <shoots>
+// anymore. A shoot namespace is orphaned if neither its name matches the technical id of a Shoot nor its UID
+// annotation matches the UID of a Shoot. The DNS entries and the DNS provider secrets in orphaned namespaces are
+// reported separately as they still manage records in the DNS provider. The detection time of orphans which have
+// already been <reported> is preserved, new orphans are detected at <now>.
+func DetermineOrphans(shoots []*gardenv1beta1.Shoot, namespaces []corev1.Namespace, dnsProviders []dnsv1alpha1.DNSProvider, dnsEntries []dnsv1alpha1.DNSEntry, reported []gardenv1beta1.SeedOrphan, now metav1.Time) []gardenv1beta1.SeedOrphan {
+	var (
+		technicalIDs       = sets.NewString()
+		uids               = sets.NewString()
+		orphanedNamespaces = sets.NewString()
+		detectionTimes     = make(map[string]metav1.Time, len(reported))
+		added              = sets.NewString()
+		orphans            []gardenv1beta1.SeedOrphan
+	)
+
+	for _, shoot := range shoots {
+		if len(shoot.Status.TechnicalID) > 0 {
+			technicalIDs.Insert(shoot.Status.TechnicalID)
+		}
+		uids.Insert(string(shoot.UID))
+	}
+
+	for _, orphan := range reported {
+		detectionTimes[orphanKey(orphan.Kind, orphan.Namespace, orphan.Name)] = orphan.DetectionTime
+	}
+
+	addOrphan := func(kind, namespace, name string) {
+		key := orphanKey(kind, namespace, name)
+		if added.Has(key) {
+			return
+		}
+		added.Insert(key)
+
+		detectionTime, ok := detectionTimes[key]
+		if !ok {
+			detectionTime = now
+		}
+		orphans = append(orphans, gardenv1beta1.SeedOrphan{
+			Kind:          kind,
+			Namespace:     namespace,
+			Name:          name,
+			DetectionTime: detectionTime,
+		})
+	}
+
+	for _, namespace := range namespaces {
+		if namespace.Labels[common.GardenRole] != common.GardenRoleShoot {
+			continue
+		}
+		if technicalIDs.Has(namespace.Name) {
+			continue
+		}
+		if uid, ok := namespace.Annotations[common.ShootUID]; ok && uids.Has(uid) {
+			continue
+		}
+
+		orphanedNamespaces.Insert(namespace.Name)
+		addOrphan(OrphanKindNamespace, "", namespace.Name)
+	}
+
+	for _, provider := range dnsProviders {
+		if !orphanedNamespaces.Has(provider.Namespace) || provider.Spec.SecretRef == nil {
+			continue
+		}
+
+		secretNamespace := provider.Spec.SecretRef.Namespace
+		if len(secretNamespace) == 0 {
+			secretNamespace = provider.Namespace
+		}
+		addOrphan(OrphanKindSecret, secretNamespace, provider.Spec.SecretRef.Name)
+	}
+
+	for _, entry := range dnsEntries {
+		if orphanedNamespaces.Has(entry.Namespace) {
+			addOrphan(OrphanKindDNSEntry, entry.Namespace, entry.Name)
+		}
+	}
+
+	return orphans
+}
+
+func orphanKey(kind, namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s", kind, namespace, name)
+}
+
+// detectOrphans lists the shoot namespaces and DNS resources in the Seed cluster and returns those which do not
+// belong to any Shoot in the Garden cluster anymore.
+func (c *defaultControl) detectOrphans(ctx context.Context, k8sSeedClient kubernetes.Interface, seed *gardenv1beta1.Seed) ([]gardenv1beta1.SeedOrphan, error) {
+	shoots, err := c.shootLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceList := &corev1.NamespaceList{}
+	if err := k8sSeedClient.Client().List(ctx, client.MatchingLabels(map[string]string{common.GardenRole: common.GardenRoleShoot}), namespaceList); err != nil {
+		return nil, err
+	}
+
+	dnsProviderList := &dnsv1alpha1.DNSProviderList{}
+	if err := k8sSeedClient.Client().List(ctx, &client.ListOptions{}, dnsProviderList); err != nil {
+		return nil, err
+	}
+
+	dnsEntryList := &dnsv1alpha1.DNSEntryList{}
+	if err := k8sSeedClient.Client().List(ctx, &client.ListOptions{}, dnsEntryList); err != nil {
+		return nil, err
+	}
+
+	return DetermineOrphans(shoots, namespaceList.Items, dnsProviderList.Items, dnsEntryList.Items, seed.Status.Orphans, metav1.Now()), nil
+}
+
+// deleteOrphans deletes the given <orphans> from the Seed cluster. DNS entries are deleted first while the DNS
+// provider credentials are still available, i.e., a namespace is only deleted once no orphaned DNS entry is left
+// in it. Secrets are removed together with their namespace. Namespaces which still contain Terraform states or
+// machines are not deleted as the cloud resources of the Shoot would leak otherwise, their names are returned.
+func deleteOrphans(ctx context.Context, c client.Client, orphans []gardenv1beta1.SeedOrphan) ([]string, error) {
+	var (
+		namespacesWithDNSEntries = sets.NewString()
+		skipped                  []string
+	)
+
+	for _, orphan := range orphans {
+		if orphan.Kind != OrphanKindDNSEntry {
+			continue
+		}
+
+		namespacesWithDNSEntries.Insert(orphan.Namespace)
+		if err := c.Delete(ctx, &dnsv1alpha1.DNSEntry{ObjectMeta: metav1.ObjectMeta{Namespace: orphan.Namespace, Name: orphan.Name}}); err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	for _, orphan := range orphans {
+		if orphan.Kind != OrphanKindNamespace || namespacesWithDNSEntries.Has(orphan.Name) {
+			continue
+		}
+
+		hasResources, err := hasCloudResources(ctx, c, orphan.Name)
+		if err != nil {
+			return nil, err
+		}
+		if hasResources {
+			skipped = append(skipped, orphan.Name)
+			continue
+		}
+
+		if err := c.Delete(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: orphan.Name}}); err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	return skipped, nil
+}
+
+// hasCloudResources checks whether the given shoot namespace still contains Terraform states or machines, i.e.,
+// whether the infrastructure or the machines of the Shoot might still exist with the cloud provider.
+func hasCloudResources(ctx context.Context, c client.Client, namespace string) (bool, error) {
+	configMapList := &corev1.ConfigMapList{}
+	if err := c.List(ctx, &client.ListOptions{Namespace: namespace}, configMapList); err != nil {
+		return false, err
+	}
+	for _, configMap := range configMapList.Items {
+		if strings.HasSuffix(configMap.Name, common.TerraformerStateSuffix) {
+			return true, nil
+		}
+	}
+
+	// The machine resources are only known to the Seed cluster if a machine-controller-manager has ever been deployed.
+	machineList := &machinev1alpha1.MachineList{}
+	if err := c.List(ctx, &client.ListOptions{Namespace: namespace}, machineList); err != nil && !meta.IsNoMatchError(err) {
+		return false, err
+	}
+	return len(machineList.Items) > 0, nil
+}
+
+// reconcileOrphans detects the orphaned resources in the Seed cluster. If the operator has confirmed their deletion
+// by annotating the Seed, the orphans which have already been reported in the Seed status are deleted. It returns
+// the orphans that are still present in the Seed cluster.
+func (c *defaultControl) reconcileOrphans(ctx context.Context, seed *gardenv1beta1.Seed, seedObj *seedpkg.Seed, seedLogger *logrus.Entry) ([]gardenv1beta1.SeedOrphan, error) {
+	k8sSeedClient, err := seedObj.NewClient()
+	if err != nil {
+		return nil, err
+	}
+
+	orphans, err := c.detectOrphans(ctx, k8sSeedClient, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	if !orphanDeletionConfirmed(seed) {
+		return orphans, nil
+	}
+
+	// Only delete orphans the operator has seen in the Seed status when confirming the deletion.
+	reported := sets.NewString()
+	for _, orphan := range seed.Status.Orphans {
+		reported.Insert(orphanKey(orphan.Kind, orphan.Namespace, orphan.Name))
+	}
+	var confirmed []gardenv1beta1.SeedOrphan
+	for _, orphan := range orphans {
+		if reported.Has(orphanKey(orphan.Kind, orphan.Namespace, orphan.Name)) {
+			confirmed = append(confirmed, orphan)
+		}
+	}
+
+	skipped, err := deleteOrphans(ctx, k8sSeedClient.Client(), confirmed)
+	if err != nil {
+		return orphans, err
+	}
+	if len(skipped) > 0 {
+		seedLogger.Infof("Skipped the deletion of the orphaned namespace(s) %s as they still contain Terraform states or machines", strings.Join(skipped, ", "))
+	}
+	return orphans, nil
+}
+
+func orphanDeletionConfirmed(seed *gardenv1beta1.Seed) bool {
+	return seed.Annotations[common.ConfirmationOrphanDeletion] == "true"
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed_test
+
+import (
+	"context"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seed"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	dnsv1alpha1 "github.com/gardener/external-dns-management/pkg/apis/dns/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Seed orphans", func() {
+	Describe("#DetermineOrphans", func() {
+		var (
+			now          = metav1.NewTime(time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC))
+			earlier      = metav1.NewTime(now.Add(-time.Hour))
+			shoots       []*gardenv1beta1.Shoot
+			namespaces   []corev1.Namespace
+			dnsProviders []dnsv1alpha1.DNSProvider
+			dnsEntries   []dnsv1alpha1.DNSEntry
+
+			shootNamespace = func(name, uid string) corev1.Namespace {
+				return corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        name,
+						Labels:      map[string]string{common.GardenRole: common.GardenRoleShoot},
+						Annotations: map[string]string{common.ShootUID: uid},
+					},
+				}
+			}
+		)
+
+		BeforeEach(func() {
+			shoots = []*gardenv1beta1.Shoot{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-dev", UID: "foo-uid"},
+					Status:     gardenv1beta1.ShootStatus{TechnicalID: "shoot--dev--foo"},
+				},
+			}
+			namespaces = []corev1.Namespace{
+				shootNamespace("shoot--dev--foo", "foo-uid"),
+				shootNamespace("shoot--dev--bar", "bar-uid"),
+				{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}},
+			}
+			dnsProviders = []dnsv1alpha1.DNSProvider{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "external", Namespace: "shoot--dev--foo"},
+					Spec:       dnsv1alpha1.DNSProviderSpec{SecretRef: &corev1.SecretReference{Name: "extensions-dns-external"}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "external", Namespace: "shoot--dev--bar"},
+					Spec:       dnsv1alpha1.DNSProviderSpec{SecretRef: &corev1.SecretReference{Name: "extensions-dns-external"}},
+				},
+			}
+			dnsEntries = []dnsv1alpha1.DNSEntry{
+				{ObjectMeta: metav1.ObjectMeta{Name: "external", Namespace: "shoot--dev--foo"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "external", Namespace: "shoot--dev--bar"}},
+			}
+		})
+
+		It("should report the namespaces, DNS provider secrets and DNS entries without Shoot", func() {
+			Expect(DetermineOrphans(shoots, namespaces, dnsProviders, dnsEntries, nil, now)).To(ConsistOf(
+				gardenv1beta1.SeedOrphan{Kind: OrphanKindNamespace, Name: "shoot--dev--bar", DetectionTime: now},
+				gardenv1beta1.SeedOrphan{Kind: OrphanKindSecret, Namespace: "shoot--dev--bar", Name: "extensions-dns-external", DetectionTime: now},
+				gardenv1beta1.SeedOrphan{Kind: OrphanKindDNSEntry, Namespace: "shoot--dev--bar", Name: "external", DetectionTime: now},
+			))
+		})
+
+		It("should not report namespaces whose UID annotation matches a Shoot", func() {
+			namespaces[1] = shootNamespace("shoot-dev-foo", "foo-uid")
+
+			Expect(DetermineOrphans(shoots, namespaces, nil, nil, nil, now)).To(BeEmpty())
+		})
+
+		It("should not report anything if all Shoots exist", func() {
+			shoots = append(shoots, &gardenv1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-dev", UID: "bar-uid"},
+			})
+
+			Expect(DetermineOrphans(shoots, namespaces, dnsProviders, dnsEntries, nil, now)).To(BeEmpty())
+		})
+
+		It("should keep the detection time of already reported orphans", func() {
+			reported := []gardenv1beta1.SeedOrphan{
+				{Kind: OrphanKindNamespace, Name: "shoot--dev--bar", DetectionTime: earlier},
+				{Kind: OrphanKindNamespace, Name: "shoot--dev--baz", DetectionTime: earlier},
+			}
+
+			Expect(DetermineOrphans(shoots, namespaces, nil, nil, reported, now)).To(ConsistOf(
+				gardenv1beta1.SeedOrphan{Kind: OrphanKindNamespace, Name: "shoot--dev--bar", DetectionTime: earlier},
+			))
+		})
+	})
+
+	Describe("#deleteOrphans", func() {
+		var (
+			ctx = context.TODO()
+			c   client.Client
+
+			namespace = func(name string) *corev1.Namespace {
+				return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+			}
+			orphanedNamespace = func(name string) gardenv1beta1.SeedOrphan {
+				return gardenv1beta1.SeedOrphan{Kind: OrphanKindNamespace, Name: name}
+			}
+			namespaceExists = func(name string) bool {
+				err := c.Get(ctx, kutil.Key(name), &corev1.Namespace{})
+				if apierrors.IsNotFound(err) {
+					return false
+				}
+				Expect(err).NotTo(HaveOccurred())
+				return true
+			}
+		)
+
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			Expect(kubernetesscheme.AddToScheme(scheme)).To(Succeed())
+			Expect(dnsv1alpha1.AddToScheme(scheme)).To(Succeed())
+			Expect(machinev1alpha1.AddToScheme(scheme)).To(Succeed())
+
+			c = fake.NewFakeClientWithScheme(scheme,
+				namespace("shoot--dev--empty"),
+				namespace("shoot--dev--state"),
+				&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--dev--state", Name: "state.infra" + common.TerraformerStateSuffix}},
+				namespace("shoot--dev--machines"),
+				&machinev1alpha1.Machine{ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--dev--machines", Name: "machine-1", Finalizers: []string{"machine.sapcloud.io/machine-controller-manager"}}},
+			)
+		})
+
+		It("should delete orphaned namespaces without Terraform states and machines", func() {
+			skipped, err := ExportDeleteOrphans(ctx, c, []gardenv1beta1.SeedOrphan{orphanedNamespace("shoot--dev--empty")})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(skipped).To(BeEmpty())
+			Expect(namespaceExists("shoot--dev--empty")).To(BeFalse())
+		})
+
+		It("should skip orphaned namespaces with Terraform states or machines", func() {
+			skipped, err := ExportDeleteOrphans(ctx, c, []gardenv1beta1.SeedOrphan{
+				orphanedNamespace("shoot--dev--empty"),
+				orphanedNamespace("shoot--dev--state"),
+				orphanedNamespace("shoot--dev--machines"),
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(skipped).To(ConsistOf("shoot--dev--state", "shoot--dev--machines"))
+			Expect(namespaceExists("shoot--dev--empty")).To(BeFalse())
+			Expect(namespaceExists("shoot--dev--state")).To(BeTrue())
+			Expect(namespaceExists("shoot--dev--machines")).To(BeTrue())
+		})
+
+		It("should not delete namespaces with orphaned DNS entries", func() {
+			skipped, err := ExportDeleteOrphans(ctx, c, []gardenv1beta1.SeedOrphan{
+				orphanedNamespace("shoot--dev--empty"),
+				{Kind: OrphanKindDNSEntry, Namespace: "shoot--dev--empty", Name: "external"},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(skipped).To(BeEmpty())
+			Expect(namespaceExists("shoot--dev--empty")).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,225 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	awsclient "github.com/gardener/gardener/pkg/client/aws"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/cloudbotanist/awsbotanist"
+	"github.com/gardener/gardener/pkg/operation/cloudbotanist/gcpbotanist"
+	seedpkg "github.com/gardener/gardener/pkg/operation/seed"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// QuotaInstances is the name of the quota for the number of instances.
+	QuotaInstances = "instances"
+	// QuotaElasticIPs is the name of the quota for the number of elastic IP addresses.
+	QuotaElasticIPs = "elastic-ips"
+	// QuotaVPCs is the name of the quota for the number of VPCs.
+	QuotaVPCs = "vpcs"
+
+	// awsVPCServiceCode and awsVPCQuotaCode identify the quota of VPCs per region in the Service Quotas API of AWS. The
+	// limit cannot be queried from the EC2 API.
+	awsVPCServiceCode = "vpc"
+	awsVPCQuotaCode   = "L-F678F1CE"
+)
+
+// gcpQuotaMetrics maps the names of the reported quotas to the metrics of the corresponding Compute Engine quotas.
+var gcpQuotaMetrics = map[string]string{
+	QuotaInstances:  "INSTANCES",
+	QuotaElasticIPs: "STATIC_ADDRESSES",
+	QuotaVPCs:       "NETWORKS",
+}
+
+// providerHealthCheckSupported returns true if the cloud provider accounts of the given <cloudProvider> can be checked.
+// Currently, only AWS and GCP are supported. The Seeds of other cloud providers are neither checked nor get the
+// ProviderHealthy condition, hence they are always considered for new Shoots.
+func providerHealthCheckSupported(cloudProvider gardenv1beta1.CloudProvider) bool {
+	return cloudProvider == gardenv1beta1.CloudProviderAWS || cloudProvider == gardenv1beta1.CloudProviderGCP
+}
+
+// providerHealthCheckScheduleReached returns true if the cloud provider account of the given <seed> has to be checked
+// again because the last check is older than <checkPeriod>.
+func providerHealthCheckScheduleReached(seed *gardenv1beta1.Seed, checkPeriod *metav1.Duration) bool {
+	if checkPeriod == nil {
+		return false
+	}
+	if seed.Status.ProviderHealth == nil {
+		return true
+	}
+	return time.Now().After(seed.Status.ProviderHealth.LastCheckTime.Add(checkPeriod.Duration))
+}
+
+// checkProviderHealth checks the reachability of the cloud API of the given Seed's cloud provider account and the
+// headroom of its quotas. It reports the quotas in the Seed status and returns the updated <condition>. The cloud
+// provider of the Seed must be supported, see providerHealthCheckSupported.
+func (c *defaultControl) checkProviderHealth(ctx context.Context, seed *gardenv1beta1.Seed, seedObj *seedpkg.Seed, condition gardencorev1alpha1.Condition) (gardencorev1alpha1.Condition, error) {
+	var (
+		quotas []gardenv1beta1.SeedProviderQuota
+		err    error
+	)
+
+	switch seedObj.CloudProvider {
+	case gardenv1beta1.CloudProviderAWS:
+		client := c.newAWSClient(string(seedObj.Secret.Data[awsbotanist.AccessKeyID]), string(seedObj.Secret.Data[awsbotanist.SecretAccessKey]), seed.Spec.Cloud.Region)
+		quotas, err = fetchAWSQuotas(ctx, client)
+	case gardenv1beta1.CloudProviderGCP:
+		quotas, err = c.fetchGCPQuotas(ctx, seedObj.Secret.Data[gcpbotanist.ServiceAccountJSON], seed.Spec.Cloud.Region)
+	default:
+		return condition, fmt.Errorf("checking the cloud provider account of cloud provider %q is not supported", seedObj.CloudProvider)
+	}
+
+	providerHealth := &gardenv1beta1.SeedProviderHealth{LastCheckTime: metav1.Now()}
+	if err != nil {
+		if seed.Status.ProviderHealth != nil {
+			providerHealth.Quotas = seed.Status.ProviderHealth.Quotas
+		}
+		condition = gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "CloudAPIUnreachable", fmt.Sprintf("Could not query the cloud API: %v", err))
+	} else {
+		providerHealth.Quotas = overwriteQuotaLimits(quotas, c.config.Controllers.Seed.ProviderQuotaLimits)
+		condition = ComputeProviderHealthCondition(condition, providerHealth.Quotas, *c.config.Controllers.Seed.MinimumQuotaHeadroomPercentage)
+	}
+
+	return condition, c.updateSeedProviderHealth(seed, providerHealth)
+}
+
+// fetchAWSQuotas returns the limits and the usage of the instances, elastic IP addresses, and VPCs in the region of the
+// given AWS <client>.
+func fetchAWSQuotas(ctx context.Context, client awsclient.ClientInterface) ([]gardenv1beta1.SeedProviderQuota, error) {
+	attributes, err := client.GetAccountAttributes("max-instances", "vpc-max-elastic-ips")
+	if err != nil {
+		return nil, err
+	}
+	maxInstances, err := strconv.ParseInt(attributes["max-instances"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse account attribute max-instances: %v", err)
+	}
+	maxElasticIPs, err := strconv.ParseInt(attributes["vpc-max-elastic-ips"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse account attribute vpc-max-elastic-ips: %v", err)
+	}
+
+	instances, err := client.CountInstances()
+	if err != nil {
+		return nil, err
+	}
+	elasticIPs, err := client.CountAddresses()
+	if err != nil {
+		return nil, err
+	}
+	vpcs, err := client.CountVPCs()
+	if err != nil {
+		return nil, err
+	}
+	maxVPCs, err := client.GetServiceQuota(ctx, awsVPCServiceCode, awsVPCQuotaCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return []gardenv1beta1.SeedProviderQuota{
+		{Name: QuotaInstances, Limit: maxInstances, Used: instances},
+		{Name: QuotaElasticIPs, Limit: maxElasticIPs, Used: elasticIPs},
+		{Name: QuotaVPCs, Limit: int64(maxVPCs), Used: vpcs},
+	}, nil
+}
+
+// fetchGCPQuotas returns the limits and the usage of the instances and static IP addresses in the given <region> and
+// of the networks of the project of the given <serviceAccountJSON>.
+func (c *defaultControl) fetchGCPQuotas(ctx context.Context, serviceAccountJSON []byte, region string) ([]gardenv1beta1.SeedProviderQuota, error) {
+	project, err := gcpbotanist.ExtractProjectID(serviceAccountJSON)
+	if err != nil {
+		return nil, err
+	}
+	client, err := c.newGCPClient(ctx, serviceAccountJSON, project)
+	if err != nil {
+		return nil, err
+	}
+	gcpQuotas, err := client.GetQuotas(ctx, project, region)
+	if err != nil {
+		return nil, err
+	}
+
+	var quotas []gardenv1beta1.SeedProviderQuota
+	for _, name := range []string{QuotaInstances, QuotaElasticIPs, QuotaVPCs} {
+		quota, ok := gcpQuotas[gcpQuotaMetrics[name]]
+		if !ok {
+			return nil, fmt.Errorf("quota %s is not reported for project %s in region %s", gcpQuotaMetrics[name], project, region)
+		}
+		quotas = append(quotas, gardenv1beta1.SeedProviderQuota{Name: name, Limit: quota.Limit, Used: quota.Usage})
+	}
+	return quotas, nil
+}
+
+// overwriteQuotaLimits overwrites the limits of the given <quotas> with the <limits> configured by the operator.
+func overwriteQuotaLimits(quotas []gardenv1beta1.SeedProviderQuota, limits map[string]int64) []gardenv1beta1.SeedProviderQuota {
+	for i, quota := range quotas {
+		if limit, ok := limits[quota.Name]; ok {
+			quotas[i].Limit = limit
+		}
+	}
+	return quotas
+}
+
+// ComputeProviderHealthCondition computes the ProviderHealthy condition of a Seed based on the given <quotas> of its
+// cloud provider account. A quota lacks headroom if less than <minimumHeadroomPercentage> percent of its limit are still
+// available. Quotas without a positive limit are ignored.
+func ComputeProviderHealthCondition(condition gardencorev1alpha1.Condition, quotas []gardenv1beta1.SeedProviderQuota, minimumHeadroomPercentage int) gardencorev1alpha1.Condition {
+	var exhaustedQuotas []string
+	for _, quota := range quotas {
+		if quota.Limit <= 0 {
+			continue
+		}
+		if (quota.Limit-quota.Used)*100 < quota.Limit*int64(minimumHeadroomPercentage) {
+			exhaustedQuotas = append(exhaustedQuotas, fmt.Sprintf("%s (%d/%d used)", quota.Name, quota.Used, quota.Limit))
+		}
+	}
+
+	if len(exhaustedQuotas) > 0 {
+		sort.Strings(exhaustedQuotas)
+		message := fmt.Sprintf("The following quotas of the cloud provider account have less than %d%% headroom: %s", minimumHeadroomPercentage, strings.Join(exhaustedQuotas, ", "))
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "QuotaExhausted", message)
+	}
+	return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "QuotaAvailable", "The cloud API is reachable and all quotas of the cloud provider account have enough headroom.")
+}
+
+func (c *defaultControl) updateSeedProviderHealth(seed *gardenv1beta1.Seed, providerHealth *gardenv1beta1.SeedProviderHealth) error {
+	if apiequality.Semantic.DeepEqual(seed.Status.ProviderHealth, providerHealth) {
+		return nil
+	}
+
+	seed.Status.ProviderHealth = providerHealth
+
+	newSeed, err := c.updater.UpdateSeedStatus(seed)
+	if err != nil {
+		logger.Logger.Errorf("Could not update the Seed status: %+v", err)
+		return err
+	}
+	*seed = *newSeed
+
+	return nil
+}
@@ -0,0 +1,284 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed_test
+
+import (
+	"context"
+	"errors"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	awsclient "github.com/gardener/gardener/pkg/client/aws"
+	gcpclient "github.com/gardener/gardener/pkg/client/gcp"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seed"
+	seedpkg "github.com/gardener/gardener/pkg/operation/seed"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// fakeAWSClient serves the quotas of an AWS account.
+type fakeAWSClient struct {
+	awsclient.ClientInterface
+
+	attributes      map[string]string
+	instances       int64
+	addresses       int64
+	vpcs            int64
+	vpcLimit        float64
+	serviceQuotaErr error
+}
+
+func (c *fakeAWSClient) GetAccountAttributes(names ...string) (map[string]string, error) {
+	return c.attributes, nil
+}
+
+func (c *fakeAWSClient) CountInstances() (int64, error) {
+	return c.instances, nil
+}
+
+func (c *fakeAWSClient) CountAddresses() (int64, error) {
+	return c.addresses, nil
+}
+
+func (c *fakeAWSClient) CountVPCs() (int64, error) {
+	return c.vpcs, nil
+}
+
+func (c *fakeAWSClient) GetServiceQuota(ctx context.Context, serviceCode, quotaCode string) (float64, error) {
+	Expect(serviceCode).To(Equal("vpc"))
+	Expect(quotaCode).To(Equal("L-F678F1CE"))
+	return c.vpcLimit, c.serviceQuotaErr
+}
+
+// fakeGCPClient serves the quotas of a GCP project.
+type fakeGCPClient struct {
+	gcpclient.ClientInterface
+
+	quotas map[string]gcpclient.Quota
+}
+
+func (c *fakeGCPClient) GetQuotas(ctx context.Context, project, region string) (map[string]gcpclient.Quota, error) {
+	Expect(project).To(Equal("my-project"))
+	Expect(region).To(Equal("europe-west1"))
+	return c.quotas, nil
+}
+
+// fakeUpdater records the Seeds whose status is updated.
+type fakeUpdater struct {
+	updated []*gardenv1beta1.Seed
+}
+
+func (u *fakeUpdater) UpdateSeedStatus(seed *gardenv1beta1.Seed) (*gardenv1beta1.Seed, error) {
+	u.updated = append(u.updated, seed.DeepCopy())
+	return seed, nil
+}
+
+var _ = Describe("Seed provider health", func() {
+	Describe("#providerHealthCheckSupported", func() {
+		It("should only support AWS and GCP", func() {
+			Expect(ExportProviderHealthCheckSupported(gardenv1beta1.CloudProviderAWS)).To(BeTrue())
+			Expect(ExportProviderHealthCheckSupported(gardenv1beta1.CloudProviderGCP)).To(BeTrue())
+			Expect(ExportProviderHealthCheckSupported(gardenv1beta1.CloudProviderAzure)).To(BeFalse())
+			Expect(ExportProviderHealthCheckSupported(gardenv1beta1.CloudProviderOpenStack)).To(BeFalse())
+			Expect(ExportProviderHealthCheckSupported(gardenv1beta1.CloudProviderAlicloud)).To(BeFalse())
+		})
+	})
+
+	Describe("#checkProviderHealth", func() {
+		var (
+			ctx = context.TODO()
+
+			updater   *fakeUpdater
+			cfg       *config.ControllerManagerConfiguration
+			awsClient *fakeAWSClient
+			gcpClient *fakeGCPClient
+			seed      *gardenv1beta1.Seed
+			seedObj   *seedpkg.Seed
+			condition gardencorev1alpha1.Condition
+
+			checkProviderHealth = func() (gardencorev1alpha1.Condition, error) {
+				return ExportCheckProviderHealth(ctx, updater, cfg, awsClient, gcpClient, seed, seedObj, condition)
+			}
+		)
+
+		BeforeEach(func() {
+			minimumQuotaHeadroomPercentage := 10
+
+			updater = &fakeUpdater{}
+			cfg = &config.ControllerManagerConfiguration{
+				Controllers: config.ControllerManagerControllerConfiguration{
+					Seed: &config.SeedControllerConfiguration{MinimumQuotaHeadroomPercentage: &minimumQuotaHeadroomPercentage},
+				},
+			}
+			awsClient = &fakeAWSClient{
+				attributes: map[string]string{"max-instances": "100", "vpc-max-elastic-ips": "5"},
+				instances:  20,
+				addresses:  1,
+				vpcs:       2,
+				vpcLimit:   10,
+			}
+			gcpClient = &fakeGCPClient{
+				quotas: map[string]gcpclient.Quota{
+					"INSTANCES":        {Limit: 24, Usage: 3},
+					"STATIC_ADDRESSES": {Limit: 8, Usage: 8},
+					"NETWORKS":         {Limit: 15, Usage: 4},
+				},
+			}
+			seed = &gardenv1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: "seed"},
+				Spec:       gardenv1beta1.SeedSpec{Cloud: gardenv1beta1.SeedCloud{Region: "eu-west-1"}},
+			}
+			seedObj = &seedpkg.Seed{
+				Info:          seed,
+				CloudProvider: gardenv1beta1.CloudProviderAWS,
+				Secret:        &corev1.Secret{Data: map[string][]byte{}},
+			}
+			condition = gardencorev1alpha1.Condition{
+				Type:   gardenv1beta1.SeedProviderHealthy,
+				Status: gardencorev1alpha1.ConditionUnknown,
+			}
+		})
+
+		It("should report the quotas of an AWS account including the VPC limit from the Service Quotas API", func() {
+			condition, err := checkProviderHealth()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionTrue))
+			Expect(updater.updated).To(HaveLen(1))
+			Expect(updater.updated[0].Status.ProviderHealth.Quotas).To(ConsistOf(
+				gardenv1beta1.SeedProviderQuota{Name: QuotaInstances, Limit: 100, Used: 20},
+				gardenv1beta1.SeedProviderQuota{Name: QuotaElasticIPs, Limit: 5, Used: 1},
+				gardenv1beta1.SeedProviderQuota{Name: QuotaVPCs, Limit: 10, Used: 2},
+			))
+		})
+
+		It("should overwrite the limits with the configured ones", func() {
+			cfg.Controllers.Seed.ProviderQuotaLimits = map[string]int64{QuotaVPCs: 2}
+
+			condition, err := checkProviderHealth()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("QuotaExhausted"))
+			Expect(condition.Message).To(ContainSubstring("vpcs (2/2 used)"))
+		})
+
+		It("should report an unreachable cloud API and keep the last known quotas", func() {
+			lastQuotas := []gardenv1beta1.SeedProviderQuota{{Name: QuotaVPCs, Limit: 5, Used: 1}}
+			seed.Status.ProviderHealth = &gardenv1beta1.SeedProviderHealth{Quotas: lastQuotas}
+			awsClient.serviceQuotaErr = errors.New("access denied")
+
+			condition, err := checkProviderHealth()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("CloudAPIUnreachable"))
+			Expect(condition.Message).To(ContainSubstring("access denied"))
+			Expect(updater.updated).To(HaveLen(1))
+			Expect(updater.updated[0].Status.ProviderHealth.Quotas).To(Equal(lastQuotas))
+		})
+
+		Context("GCP", func() {
+			BeforeEach(func() {
+				seed.Spec.Cloud.Region = "europe-west1"
+				seedObj.CloudProvider = gardenv1beta1.CloudProviderGCP
+				seedObj.Secret.Data["serviceaccount.json"] = []byte(`{"project_id":"my-project"}`)
+			})
+
+			It("should report the quotas of the project and the region", func() {
+				condition, err := checkProviderHealth()
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+				Expect(condition.Message).To(ContainSubstring("elastic-ips (8/8 used)"))
+				Expect(updater.updated[0].Status.ProviderHealth.Quotas).To(ConsistOf(
+					gardenv1beta1.SeedProviderQuota{Name: QuotaInstances, Limit: 24, Used: 3},
+					gardenv1beta1.SeedProviderQuota{Name: QuotaElasticIPs, Limit: 8, Used: 8},
+					gardenv1beta1.SeedProviderQuota{Name: QuotaVPCs, Limit: 15, Used: 4},
+				))
+			})
+
+			It("should report an unreachable cloud API if a quota is missing", func() {
+				delete(gcpClient.quotas, "NETWORKS")
+
+				condition, err := checkProviderHealth()
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+				Expect(condition.Reason).To(Equal("CloudAPIUnreachable"))
+			})
+		})
+
+		It("should fail for an unsupported cloud provider", func() {
+			seedObj.CloudProvider = gardenv1beta1.CloudProviderAzure
+
+			_, err := checkProviderHealth()
+
+			Expect(err).To(HaveOccurred())
+			Expect(updater.updated).To(BeEmpty())
+		})
+	})
+
+	Describe("#ComputeProviderHealthCondition", func() {
+		var condition gardencorev1alpha1.Condition
+
+		BeforeEach(func() {
+			condition = gardencorev1alpha1.Condition{
+				Type:   gardenv1beta1.SeedProviderHealthy,
+				Status: gardencorev1alpha1.ConditionUnknown,
+			}
+		})
+
+		It("should report a healthy account if all quotas have enough headroom", func() {
+			quotas := []gardenv1beta1.SeedProviderQuota{
+				{Name: QuotaInstances, Limit: 100, Used: 90},
+				{Name: QuotaVPCs, Limit: 5, Used: 1},
+			}
+
+			condition = ComputeProviderHealthCondition(condition, quotas, 10)
+
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionTrue))
+			Expect(condition.Reason).To(Equal("QuotaAvailable"))
+		})
+
+		It("should report an unhealthy account if a quota lacks headroom", func() {
+			quotas := []gardenv1beta1.SeedProviderQuota{
+				{Name: QuotaInstances, Limit: 100, Used: 91},
+				{Name: QuotaElasticIPs, Limit: 5, Used: 5},
+				{Name: QuotaVPCs, Limit: 5, Used: 1},
+			}
+
+			condition = ComputeProviderHealthCondition(condition, quotas, 10)
+
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("QuotaExhausted"))
+			Expect(condition.Message).To(ContainSubstring("elastic-ips (5/5 used), instances (91/100 used)"))
+		})
+
+		It("should ignore quotas without limit", func() {
+			quotas := []gardenv1beta1.SeedProviderQuota{
+				{Name: QuotaInstances, Limit: 0, Used: 10},
+			}
+
+			condition = ComputeProviderHealthCondition(condition, quotas, 10)
+
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionTrue))
+		})
+	})
+})
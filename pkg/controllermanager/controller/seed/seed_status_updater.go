@@ -15,11 +15,11 @@
 package seed
 
 import (
+	"context"
+
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
-	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
-	"github.com/gardener/gardener/pkg/logger"
-	"k8s.io/client-go/util/retry"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
 // UpdaterInterface is an interface used to update the Seed manifest.
@@ -28,38 +28,23 @@ type UpdaterInterface interface {
 	UpdateSeedStatus(seed *gardenv1beta1.Seed) (*gardenv1beta1.Seed, error)
 }
 
-// NewRealUpdater returns a UpdaterInterface that updates the Seed manifest, using the supplied client and seedLister.
-func NewRealUpdater(k8sGardenClient kubernetes.Interface, seedLister gardenlisters.SeedLister) UpdaterInterface {
-	return &realUpdater{k8sGardenClient, seedLister}
+// NewRealUpdater returns a UpdaterInterface that updates the Seed manifest, using the supplied client.
+func NewRealUpdater(k8sGardenClient kubernetes.Interface) UpdaterInterface {
+	return &realUpdater{k8sGardenClient}
 }
 
 type realUpdater struct {
 	k8sGardenClient kubernetes.Interface
-	seedLister      gardenlisters.SeedLister
 }
 
 // UpdateSeedStatus updates the Seed manifest. Implementations are required to retry on conflicts,
 // but fail on other errors. If the returned error is nil Seed's manifest has been successfully set.
 func (u *realUpdater) UpdateSeedStatus(seed *gardenv1beta1.Seed) (*gardenv1beta1.Seed, error) {
-	var (
-		newSeed   *gardenv1beta1.Seed
-		status    = seed.Status
-		updateErr error
-	)
+	newSeed := &gardenv1beta1.Seed{ObjectMeta: kutil.ObjectMeta(seed.Name)}
 
-	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		seed.Status = status
-		newSeed, updateErr = u.k8sGardenClient.Garden().GardenV1beta1().Seeds().UpdateStatus(seed)
-		if updateErr == nil {
-			return nil
-		}
-		updated, err := u.seedLister.Get(seed.Name)
-		if err == nil {
-			seed = updated.DeepCopy()
-		} else {
-			logger.Logger.Errorf("error getting updated Seed %s from lister: %v", seed.Name, err)
-		}
-		return updateErr
+	if err := kutil.TryUpdateStatus(context.TODO(), kutil.DefaultBackoff, u.k8sGardenClient.Client(), newSeed, func() error {
+		newSeed.Status = seed.Status
+		return nil
 	}); err != nil {
 		return nil, err
 	}
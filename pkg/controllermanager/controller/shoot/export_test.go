@@ -0,0 +1,103 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Bridge package to expose internal functions to tests in the shoot_test package.
+
+package shoot
+
+import (
+	"context"
+
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+
+	kubecorev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/util/workqueue"
+)
+
+var (
+	// ExportMustSkipReconciliation exports mustSkipReconciliation.
+	ExportMustSkipReconciliation = mustSkipReconciliation
+	// ExportLastFullReconciliationDue exports lastFullReconciliationDue.
+	ExportLastFullReconciliationDue = lastFullReconciliationDue
+	// ExportCertificatesNeedRenewal exports certificatesNeedRenewal.
+	ExportCertificatesNeedRenewal = certificatesNeedRenewal
+	// ExportMustPostponeReconciliation exports mustPostponeReconciliation.
+	ExportMustPostponeReconciliation = mustPostponeReconciliation
+	// ExportShootMaintenanceTimeWindow exports shootMaintenanceTimeWindow.
+	ExportShootMaintenanceTimeWindow = shootMaintenanceTimeWindow
+	// ExportMustBackfillHibernatedStatus exports mustBackfillHibernatedStatus.
+	ExportMustBackfillHibernatedStatus = mustBackfillHibernatedStatus
+	// ExportSyncJitter exports syncJitter.
+	ExportSyncJitter = syncJitter
+	// ExportMustCheckInfrastructureDrift exports mustCheckInfrastructureDrift.
+	ExportMustCheckInfrastructureDrift = mustCheckInfrastructureDrift
+	// ExportMustApproveKubeletServingCertificates exports mustApproveKubeletServingCertificates.
+	ExportMustApproveKubeletServingCertificates = mustApproveKubeletServingCertificates
+	// ExportMayRetry exports mayRetry.
+	ExportMayRetry = mayRetry
+	// ExportRetryBackoff exports retryBackoff.
+	ExportRetryBackoff = retryBackoff
+	// ExportNewShootLogger exports newShootLogger.
+	ExportNewShootLogger = newShootLogger
+)
+
+// NewCredentialsTestController returns a Controller which handles the cloud provider credentials of all Shoots with
+// the given listers, control and queues.
+func NewCredentialsTestController(k8sGardenClient kubernetes.Interface, shootLister gardenlisters.ShootLister, secretBindingLister gardenlisters.SecretBindingLister, namespaceLister kubecorev1listers.NamespaceLister, projectLister gardenlisters.ProjectLister, control ControlInterface, secretQueue, shootCredentialsQueue workqueue.RateLimitingInterface) *Controller {
+	respectSyncPeriodOverwrite := false
+	return &Controller{
+		k8sGardenClient: k8sGardenClient,
+		config: &config.ControllerManagerConfiguration{
+			Controllers: config.ControllerManagerControllerConfiguration{
+				Shoot: config.ShootControllerConfiguration{RespectSyncPeriodOverwrite: &respectSyncPeriodOverwrite},
+			},
+		},
+		control:               control,
+		operationLocks:        newOperationLocks(),
+		seedFilter:            func(obj interface{}) bool { return true },
+		shootLister:           shootLister,
+		secretBindingLister:   secretBindingLister,
+		namespaceLister:       namespaceLister,
+		projectLister:         projectLister,
+		secretQueue:           secretQueue,
+		shootCredentialsQueue: shootCredentialsQueue,
+	}
+}
+
+// ExportSecretUpdate exports secretUpdate.
+func (c *Controller) ExportSecretUpdate(oldObj, newObj interface{}) {
+	c.secretUpdate(oldObj, newObj)
+}
+
+// ExportReconcileSecretKey exports reconcileSecretKey.
+func (c *Controller) ExportReconcileSecretKey(key string) error {
+	return c.reconcileSecretKey(key)
+}
+
+// ExportSecretBindingAdd exports secretBindingAdd.
+func (c *Controller) ExportSecretBindingAdd(obj interface{}) {
+	c.secretBindingAdd(obj)
+}
+
+// ExportReconcileShootCredentialsKey exports reconcileShootCredentialsKey.
+func (c *Controller) ExportReconcileShootCredentialsKey(ctx context.Context, key string) error {
+	return c.reconcileShootCredentialsKey(ctx, key)
+}
+
+// ExportTryLockOperation exports the tryLock function of the operation locks of the Controller.
+func (c *Controller) ExportTryLockOperation(key string) (func(), bool) {
+	return c.operationLocks.tryLock(key)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+func (c *Controller) namespaceUpdate(oldObj, newObj interface{}) {
+	var (
+		oldNamespace = oldObj.(*corev1.Namespace)
+		newNamespace = newObj.(*corev1.Namespace)
+	)
+
+	if newNamespace.Name != common.GardenNamespace {
+		return
+	}
+	if !common.IsLandscapeFrozen(oldNamespace) || common.IsLandscapeFrozen(newNamespace) {
+		return
+	}
+
+	shoots, err := c.shootLister.List(labels.Everything())
+	if err != nil {
+		logger.Logger.Errorf("[SHOOT FREEZE] Could not list shoots after the freeze has been lifted: %v", err)
+		return
+	}
+
+	logger.Logger.Infof("[SHOOT FREEZE] The freeze has been lifted, requeueing all shoots")
+	for _, shoot := range shoots {
+		if !c.seedFilter(shoot) {
+			continue
+		}
+		key, err := cache.MetaNamespaceKeyFunc(shoot)
+		if err != nil {
+			logger.Logger.Errorf("[SHOOT FREEZE] Couldn't get key for object %+v: %v", shoot, err)
+			continue
+		}
+		c.getShootQueue(shoot).Add(key)
+	}
+}
+
+// isLandscapeFrozen returns true if the garden namespace carries the freeze annotation. New Shoot operations and
+// maintenance runs are not started while the landscape is frozen.
+func (c *Controller) isLandscapeFrozen() bool {
+	namespace, err := c.namespaceLister.Get(common.GardenNamespace)
+	if err != nil {
+		return false
+	}
+	return common.IsLandscapeFrozen(namespace)
+}
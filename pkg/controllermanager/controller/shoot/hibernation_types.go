@@ -15,18 +15,17 @@
 package shoot
 
 import (
+	"context"
 	"fmt"
 	"sync"
 
 	"github.com/sirupsen/logrus"
 
-	garden "github.com/gardener/gardener/pkg/client/garden/clientset/versioned"
-
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/robfig/cron"
 	"k8s.io/apimachinery/pkg/api/equality"
-	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Cron is an interface that allows mocking cron.Cron.
@@ -90,7 +89,7 @@ func NewHibernationScheduleRegistry() HibernationScheduleRegistry {
 }
 
 type hibernationJob struct {
-	client  garden.Interface
+	client  client.Client
 	logger  logrus.FieldLogger
 	target  *gardenv1beta1.Shoot
 	enabled bool
@@ -98,15 +97,14 @@ type hibernationJob struct {
 
 // Run implements cron.Job.
 func (h *hibernationJob) Run() {
-	_, err := kubernetes.TryUpdateShootHibernation(h.client, retry.DefaultBackoff, h.target.ObjectMeta,
-		func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
-			if shoot.Spec.Hibernation == nil || !equality.Semantic.DeepEqual(h.target.Spec.Hibernation.Schedules, shoot.Spec.Hibernation.Schedules) {
-				return nil, fmt.Errorf("shoot %s/%s hibernation schedule changed mid-air", shoot.Namespace, shoot.Name)
-			}
-			shoot.Spec.Hibernation.Enabled = h.enabled
-			return shoot, nil
-		})
-	if err != nil {
+	shoot := h.target.DeepCopy()
+	if err := kubernetes.TryUpdate(context.TODO(), kubernetes.DefaultBackoff, h.client, shoot, func() error {
+		if shoot.Spec.Hibernation == nil || !equality.Semantic.DeepEqual(h.target.Spec.Hibernation.Schedules, shoot.Spec.Hibernation.Schedules) {
+			return fmt.Errorf("shoot %s/%s hibernation schedule changed mid-air", shoot.Namespace, shoot.Name)
+		}
+		shoot.Spec.Hibernation.Enabled = h.enabled
+		return nil
+	}); err != nil {
 		h.logger.Errorf("Could not set hibernation.enabled to %t: %+v", h.enabled, err)
 		return
 	}
@@ -114,6 +112,6 @@ func (h *hibernationJob) Run() {
 }
 
 // NewHibernationJob creates a new cron.Job that sets the hibernation of the given shoot to enabled when it triggers.
-func NewHibernationJob(client garden.Interface, logger logrus.FieldLogger, target *gardenv1beta1.Shoot, enabled bool) cron.Job {
+func NewHibernationJob(client client.Client, logger logrus.FieldLogger, target *gardenv1beta1.Shoot, enabled bool) cron.Job {
 	return &hibernationJob{client, logger, target, enabled}
 }
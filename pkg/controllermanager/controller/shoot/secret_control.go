@@ -0,0 +1,109 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/logger"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+func (c *Controller) secretUpdate(oldObj, newObj interface{}) {
+	var (
+		oldSecret = oldObj.(*corev1.Secret)
+		newSecret = newObj.(*corev1.Secret)
+	)
+
+	if apiequality.Semantic.DeepEqual(oldSecret.Data, newSecret.Data) {
+		return
+	}
+
+	key, err := cache.MetaNamespaceKeyFunc(newObj)
+	if err != nil {
+		logger.Logger.Errorf("[SHOOT CREDENTIALS] Couldn't get key for object %+v: %v", newObj, err)
+		return
+	}
+	c.secretQueue.Add(key)
+}
+
+func (c *Controller) reconcileSecretKey(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	secretBindings, err := c.secretBindingLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	for _, secretBinding := range secretBindings {
+		if !helper.SecretBindingReferencesSecret(secretBinding, namespace, name) {
+			continue
+		}
+
+		shoots, err := c.shootLister.Shoots(secretBinding.Namespace).List(labels.Everything())
+		if err != nil {
+			return err
+		}
+		for _, shoot := range shoots {
+			if shoot.Spec.Cloud.SecretBindingRef.Name != secretBinding.Name || !c.seedFilter(shoot) {
+				continue
+			}
+			shootKey, err := cache.MetaNamespaceKeyFunc(shoot)
+			if err != nil {
+				logger.Logger.Errorf("[SHOOT CREDENTIALS] Couldn't get key for shoot %+v: %v", shoot, err)
+				continue
+			}
+			logger.Logger.Infof("[SHOOT CREDENTIALS] Secret %s has changed, scheduling credentials refresh of shoot %s", key, shootKey)
+			c.shootCredentialsQueue.Add(shootKey)
+		}
+	}
+
+	return nil
+}
+
+func (c *Controller) secretBindingAdd(obj interface{}) {
+	secretBinding, ok := obj.(*gardenv1beta1.SecretBinding)
+	if !ok || !helper.SecretBindingRotationInProgress(secretBinding) {
+		return
+	}
+
+	shoots, err := c.shootLister.Shoots(secretBinding.Namespace).List(labels.Everything())
+	if err != nil {
+		logger.Logger.Errorf("[SHOOT CREDENTIALS] Could not list shoots for credentials rotation of SecretBinding %s/%s: %v", secretBinding.Namespace, secretBinding.Name, err)
+		return
+	}
+	for _, shoot := range shoots {
+		if shoot.Spec.Cloud.SecretBindingRef.Name != secretBinding.Name || helper.IsShootRotated(secretBinding, shoot.Name) || !c.seedFilter(shoot) {
+			continue
+		}
+		shootKey, err := cache.MetaNamespaceKeyFunc(shoot)
+		if err != nil {
+			logger.Logger.Errorf("[SHOOT CREDENTIALS] Couldn't get key for shoot %+v: %v", shoot, err)
+			continue
+		}
+		c.shootCredentialsQueue.Add(shootKey)
+	}
+}
+
+func (c *Controller) secretBindingUpdate(oldObj, newObj interface{}) {
+	c.secretBindingAdd(newObj)
+}
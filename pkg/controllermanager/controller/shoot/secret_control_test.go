@@ -0,0 +1,191 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot_test
+
+import (
+	"context"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubecorev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// fakeQueue records the keys which are added to it.
+type fakeQueue struct {
+	workqueue.RateLimitingInterface
+	added      []string
+	addedAfter []string
+}
+
+func (q *fakeQueue) Add(item interface{}) {
+	q.added = append(q.added, item.(string))
+}
+
+func (q *fakeQueue) AddAfter(item interface{}, _ time.Duration) {
+	q.addedAfter = append(q.addedAfter, item.(string))
+}
+
+// fakeControl records the Shoots whose credentials are refreshed or rotated.
+type fakeControl struct {
+	shoot.ControlInterface
+	refreshed []string
+	rotated   []string
+	err       error
+}
+
+func (c *fakeControl) RefreshShootCredentials(_ context.Context, s *gardenv1beta1.Shoot) error {
+	c.refreshed = append(c.refreshed, s.Name)
+	return c.err
+}
+
+func (c *fakeControl) RotateShootCredentials(_ context.Context, s *gardenv1beta1.Shoot) error {
+	c.rotated = append(c.rotated, s.Name)
+	return c.err
+}
+
+func newIndexer(objects ...interface{}) cache.Indexer {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, obj := range objects {
+		Expect(indexer.Add(obj)).To(Succeed())
+	}
+	return indexer
+}
+
+func newShoot(name, secretBindingName string) *gardenv1beta1.Shoot {
+	return &gardenv1beta1.Shoot{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-dev"},
+		Spec: gardenv1beta1.ShootSpec{
+			Cloud: gardenv1beta1.Cloud{SecretBindingRef: corev1.LocalObjectReference{Name: secretBindingName}},
+		},
+	}
+}
+
+var _ = Describe("Secret control", func() {
+	var (
+		secretQueue           *fakeQueue
+		shootCredentialsQueue *fakeQueue
+		secretBinding         *gardenv1beta1.SecretBinding
+		shoots                []interface{}
+
+		newController = func() *shoot.Controller {
+			return shoot.NewCredentialsTestController(
+				nil,
+				gardenlisters.NewShootLister(newIndexer(shoots...)),
+				gardenlisters.NewSecretBindingLister(newIndexer(secretBinding)),
+				kubecorev1listers.NewNamespaceLister(newIndexer()),
+				gardenlisters.NewProjectLister(newIndexer()),
+				&fakeControl{},
+				secretQueue,
+				shootCredentialsQueue,
+			)
+		}
+	)
+
+	BeforeEach(func() {
+		secretQueue = &fakeQueue{}
+		shootCredentialsQueue = &fakeQueue{}
+		secretBinding = &gardenv1beta1.SecretBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "binding", Namespace: "garden-dev"},
+			SecretRef:  corev1.SecretReference{Name: "secret", Namespace: "garden-dev"},
+		}
+		shoots = []interface{}{newShoot("foo", "binding"), newShoot("bar", "other")}
+	})
+
+	Describe("#secretUpdate", func() {
+		var secret *corev1.Secret
+
+		BeforeEach(func() {
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: "garden-dev"},
+				Data:       map[string][]byte{"key": []byte("old")},
+			}
+		})
+
+		It("should enqueue the secret if its data has changed", func() {
+			newSecret := secret.DeepCopy()
+			newSecret.Data["key"] = []byte("new")
+
+			newController().ExportSecretUpdate(secret, newSecret)
+
+			Expect(secretQueue.added).To(ConsistOf("garden-dev/secret"))
+		})
+
+		It("should not enqueue the secret if only its metadata has changed", func() {
+			newSecret := secret.DeepCopy()
+			newSecret.Labels = map[string]string{"foo": "bar"}
+
+			newController().ExportSecretUpdate(secret, newSecret)
+
+			Expect(secretQueue.added).To(BeEmpty())
+		})
+	})
+
+	Describe("#reconcileSecretKey", func() {
+		It("should enqueue the Shoots of the SecretBindings referencing the secret", func() {
+			Expect(newController().ExportReconcileSecretKey("garden-dev/secret")).To(Succeed())
+
+			Expect(shootCredentialsQueue.added).To(ConsistOf("garden-dev/foo"))
+		})
+
+		It("should enqueue the Shoots if the secret is the one of a rotation in progress", func() {
+			secretBinding.Rotation = &gardenv1beta1.SecretBindingRotation{
+				SecretRef: corev1.SecretReference{Name: "new-secret", Namespace: "garden-dev"},
+				Status:    &gardenv1beta1.SecretBindingRotationStatus{Phase: gardenv1beta1.SecretBindingRotationPhaseRolling},
+			}
+
+			Expect(newController().ExportReconcileSecretKey("garden-dev/new-secret")).To(Succeed())
+
+			Expect(shootCredentialsQueue.added).To(ConsistOf("garden-dev/foo"))
+		})
+
+		It("should not enqueue any Shoot if the secret is not referenced", func() {
+			Expect(newController().ExportReconcileSecretKey("garden-dev/unrelated")).To(Succeed())
+
+			Expect(shootCredentialsQueue.added).To(BeEmpty())
+		})
+	})
+
+	Describe("#secretBindingAdd", func() {
+		It("should not enqueue any Shoot if no rotation is in progress", func() {
+			newController().ExportSecretBindingAdd(secretBinding)
+
+			Expect(shootCredentialsQueue.added).To(BeEmpty())
+		})
+
+		It("should enqueue the Shoots which have not been rotated yet", func() {
+			shoots = append(shoots, newShoot("baz", "binding"))
+			secretBinding.Rotation = &gardenv1beta1.SecretBindingRotation{
+				SecretRef: corev1.SecretReference{Name: "new-secret", Namespace: "garden-dev"},
+				Status: &gardenv1beta1.SecretBindingRotationStatus{
+					Phase:         gardenv1beta1.SecretBindingRotationPhaseRolling,
+					RotatedShoots: []string{"baz"},
+				},
+			}
+
+			newController().ExportSecretBindingAdd(secretBinding)
+
+			Expect(shootCredentialsQueue.added).To(ConsistOf("garden-dev/foo"))
+		})
+	})
+})
@@ -15,9 +15,12 @@
 package shoot
 
 import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/cache"
 )
 
@@ -32,6 +35,35 @@ func (c *Controller) seedAdd(obj interface{}) {
 
 func (c *Controller) seedUpdate(oldObj, newObj interface{}) {
 	c.seedAdd(newObj)
+
+	// The Shoots of the Seed might have been (de-)selected by the seed selector.
+	if newSeed, ok := newObj.(*gardenv1beta1.Seed); ok && controllerutils.SeedLabelsChanged(oldObj, newObj) {
+		c.enqueueShootsOfSeed(newSeed.Name)
+	}
+}
+
+// enqueueShootsOfSeed adds the Shoots which are assigned to the Seed with the given <seedName> to the queues of the
+// reconciliation, the care and the kubelet CSR approval.
+func (c *Controller) enqueueShootsOfSeed(seedName string) {
+	shoots, err := c.shootLister.List(labels.Everything())
+	if err != nil {
+		logger.Logger.Errorf("Failed to list Shoots of Seed %s: %v", seedName, err)
+		return
+	}
+
+	for _, shoot := range shoots {
+		if shoot.Spec.Cloud.Seed == nil || *shoot.Spec.Cloud.Seed != seedName {
+			continue
+		}
+		key, err := cache.MetaNamespaceKeyFunc(shoot)
+		if err != nil {
+			logger.Logger.Errorf("Couldn't get key for object %+v: %v", shoot, err)
+			continue
+		}
+		c.getShootQueue(shoot).Add(key)
+		c.shootCareQueue.Add(key)
+		c.shootKubeletCSRQueue.Add(key)
+	}
 }
 
 func (c *Controller) seedDelete(obj interface{}) {
@@ -28,10 +28,12 @@ import (
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/client/vault"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	gardenmetrics "github.com/gardener/gardener/pkg/controllermanager/metrics"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/utils/flow"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/reconcilescheduler"
@@ -45,7 +47,6 @@ import (
 	kubecorev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
-	"k8s.io/client-go/util/retry"
 	"k8s.io/client-go/util/workqueue"
 )
 
@@ -60,29 +61,38 @@ type Controller struct {
 	careControl                   CareControlInterface
 	maintenanceControl            MaintenanceControlInterface
 	quotaControl                  QuotaControlInterface
+	kubeletCSRControl             KubeletCSRControlInterface
+	infrastructureDriftControl    InfrastructureDriftControlInterface
 	controllerInstallationControl ControllerInstallationControlInterface
 	recorder                      record.EventRecorder
 	secrets                       map[string]*corev1.Secret
 	imageVector                   imagevector.ImageVector
 	scheduler                     reconcilescheduler.Interface
 	hibernationScheduleRegistry   HibernationScheduleRegistry
+	operationLocks                *operationLocks
+	seedFilter                    func(obj interface{}) bool
 
 	seedLister                   gardenlisters.SeedLister
 	shootLister                  gardenlisters.ShootLister
+	secretBindingLister          gardenlisters.SecretBindingLister
 	projectLister                gardenlisters.ProjectLister
 	namespaceLister              kubecorev1listers.NamespaceLister
 	configMapLister              kubecorev1listers.ConfigMapLister
 	controllerInstallationLister gardencorelisters.ControllerInstallationLister
 
-	seedQueue                   workqueue.RateLimitingInterface
-	shootQueue                  workqueue.RateLimitingInterface
-	shootCareQueue              workqueue.RateLimitingInterface
-	shootMaintenanceQueue       workqueue.RateLimitingInterface
-	shootQuotaQueue             workqueue.RateLimitingInterface
-	shootSeedQueue              workqueue.RateLimitingInterface
-	configMapQueue              workqueue.RateLimitingInterface
-	shootHibernationQueue       workqueue.RateLimitingInterface
-	controllerInstallationQueue workqueue.RateLimitingInterface
+	seedQueue                     workqueue.RateLimitingInterface
+	shootQueue                    workqueue.RateLimitingInterface
+	shootCareQueue                workqueue.RateLimitingInterface
+	shootMaintenanceQueue         workqueue.RateLimitingInterface
+	shootQuotaQueue               workqueue.RateLimitingInterface
+	shootSeedQueue                workqueue.RateLimitingInterface
+	configMapQueue                workqueue.RateLimitingInterface
+	shootHibernationQueue         workqueue.RateLimitingInterface
+	controllerInstallationQueue   workqueue.RateLimitingInterface
+	secretQueue                   workqueue.RateLimitingInterface
+	shootCredentialsQueue         workqueue.RateLimitingInterface
+	shootKubeletCSRQueue          workqueue.RateLimitingInterface
+	shootInfrastructureDriftQueue workqueue.RateLimitingInterface
 
 	shootSynced                  cache.InformerSynced
 	seedSynced                   cache.InformerSynced
@@ -93,6 +103,7 @@ type Controller struct {
 	namespaceSynced              cache.InformerSynced
 	configMapSynced              cache.InformerSynced
 	controllerInstallationSynced cache.InformerSynced
+	secretSynced                 cache.InformerSynced
 
 	numberOfRunningWorkers int
 	workerCh               chan int
@@ -101,7 +112,7 @@ type Controller struct {
 // NewShootController takes a Kubernetes client for the Garden clusters <k8sGardenClient>, a struct
 // holding information about the acting Gardener, a <shootInformer>, and a <recorder> for
 // event recording. It creates a new Gardener controller.
-func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, kubeInformerFactory kubeinformers.SharedInformerFactory, config *config.ControllerManagerConfiguration, identity *gardenv1beta1.Gardener, gardenNamespace string, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, recorder record.EventRecorder) *Controller {
+func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, kubeInformerFactory kubeinformers.SharedInformerFactory, config *config.ControllerManagerConfiguration, identity *gardenv1beta1.Gardener, gardenNamespace string, secrets map[string]*corev1.Secret, vaultClient vault.ClientInterface, imageVector imagevector.ImageVector, recorder record.EventRecorder, flowRegistry *flow.Registry, operationLogs *logger.OperationLogs) *Controller {
 	var (
 		gardenV1beta1Informer      = k8sGardenInformers.Garden().V1beta1()
 		gardenCoreV1alpha1Informer = k8sGardenCoreInformers.Core().V1alpha1()
@@ -122,8 +133,15 @@ func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers
 		configMapInformer = corev1Informer.ConfigMaps()
 		configMapLister   = configMapInformer.Lister()
 
+		secretInformer = corev1Informer.Secrets()
+
+		secretBindingInformer = gardenV1beta1Informer.SecretBindings()
+		secretBindingLister   = secretBindingInformer.Lister()
+
 		controllerInstallationInformer = gardenCoreV1alpha1Informer.ControllerInstallations()
 		controllerInstallationLister   = controllerInstallationInformer.Lister()
+
+		cloudAPIRateLimiters = newCloudAPIRateLimiters(config)
 	)
 
 	shootController := &Controller{
@@ -132,33 +150,42 @@ func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers
 		k8sGardenCoreInformers: k8sGardenCoreInformers,
 
 		config:                        config,
-		control:                       NewDefaultControl(k8sGardenClient, gardenV1beta1Informer, secrets, imageVector, identity, config, gardenNamespace, recorder),
+		control:                       NewDefaultControl(k8sGardenClient, gardenV1beta1Informer, secrets, vaultClient, imageVector, identity, config, gardenNamespace, recorder, flowRegistry, operationLogs, cloudAPIRateLimiters),
 		careControl:                   NewDefaultCareControl(k8sGardenClient, gardenV1beta1Informer, secrets, imageVector, identity, config),
 		maintenanceControl:            NewDefaultMaintenanceControl(k8sGardenClient, gardenV1beta1Informer, secrets, imageVector, identity, recorder),
 		quotaControl:                  NewDefaultQuotaControl(k8sGardenClient, gardenV1beta1Informer),
+		kubeletCSRControl:             NewDefaultKubeletCSRControl(k8sGardenClient, gardenV1beta1Informer, secrets, imageVector, identity),
+		infrastructureDriftControl:    NewDefaultInfrastructureDriftControl(k8sGardenClient, gardenV1beta1Informer, secrets, imageVector, identity, cloudAPIRateLimiters),
 		controllerInstallationControl: NewDefaultControllerInstallationControl(k8sGardenClient, gardenV1beta1Informer, gardenCoreV1alpha1Informer, recorder),
 		recorder:                      recorder,
 		secrets:                       secrets,
 		imageVector:                   imageVector,
 		scheduler:                     reconcilescheduler.New(nil),
 		hibernationScheduleRegistry:   NewHibernationScheduleRegistry(),
+		operationLocks:                newOperationLocks(),
+		seedFilter:                    controllerutils.SeedFilterFunc(seedLister, config.SeedSelector),
 
 		seedLister:                   seedLister,
 		shootLister:                  shootLister,
+		secretBindingLister:          secretBindingLister,
 		projectLister:                projectLister,
 		namespaceLister:              namespaceLister,
 		configMapLister:              configMapLister,
 		controllerInstallationLister: controllerInstallationLister,
 
-		seedQueue:                   workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "seed"),
-		shootQueue:                  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot"),
-		shootCareQueue:              workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-care"),
-		shootMaintenanceQueue:       workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-maintenance"),
-		shootQuotaQueue:             workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-quota"),
-		shootSeedQueue:              workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-seeds"),
-		configMapQueue:              workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "configmaps"),
-		shootHibernationQueue:       workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-hibernation"),
-		controllerInstallationQueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-controllerinstallation"),
+		seedQueue:                     workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "seed"),
+		shootQueue:                    workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot"),
+		shootCareQueue:                workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-care"),
+		shootMaintenanceQueue:         workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-maintenance"),
+		shootQuotaQueue:               workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-quota"),
+		shootSeedQueue:                workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-seeds"),
+		configMapQueue:                workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "configmaps"),
+		shootHibernationQueue:         workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-hibernation"),
+		controllerInstallationQueue:   workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-controllerinstallation"),
+		secretQueue:                   workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "secrets"),
+		shootCredentialsQueue:         workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-credentials"),
+		shootKubeletCSRQueue:          workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-kubelet-csr"),
+		shootInfrastructureDriftQueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-infrastructure-drift"),
 
 		workerCh: make(chan int),
 	}
@@ -169,6 +196,8 @@ func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers
 		DeleteFunc: shootController.seedDelete,
 	})
 
+	// The Shoots are always enqueued and filtered by the seed selector in the reconcilers, the Seed cache might not be
+	// synced yet when the event handlers are called.
 	shootInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    shootController.shootAdd,
 		UpdateFunc: shootController.shootUpdate,
@@ -179,6 +208,15 @@ func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers
 		AddFunc: shootController.shootCareAdd,
 	})
 
+	shootInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    shootController.shootKubeletCSRAdd,
+		UpdateFunc: shootController.shootKubeletCSRUpdate,
+	})
+
+	shootInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: shootController.shootInfrastructureDriftAdd,
+	})
+
 	shootInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    shootController.shootMaintenanceAdd,
 		UpdateFunc: shootController.shootMaintenanceUpdate,
@@ -196,6 +234,10 @@ func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers
 		DeleteFunc: shootController.shootHibernationDelete,
 	})
 
+	namespaceInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: shootController.namespaceUpdate,
+	})
+
 	configMapInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    shootController.configMapAdd,
 		UpdateFunc: shootController.configMapUpdate,
@@ -206,6 +248,15 @@ func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers
 		UpdateFunc: shootController.controllerInstallationUpdate,
 	})
 
+	secretInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: shootController.secretUpdate,
+	})
+
+	secretBindingInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    shootController.secretBindingAdd,
+		UpdateFunc: shootController.secretBindingUpdate,
+	})
+
 	shootController.seedSynced = seedInformer.Informer().HasSynced
 	shootController.shootSynced = shootInformer.Informer().HasSynced
 	shootController.cloudProfileSynced = gardenV1beta1Informer.CloudProfiles().Informer().HasSynced
@@ -215,6 +266,7 @@ func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers
 	shootController.namespaceSynced = namespaceInformer.Informer().HasSynced
 	shootController.configMapSynced = configMapInformer.Informer().HasSynced
 	shootController.controllerInstallationSynced = controllerInstallationInformer.Informer().HasSynced
+	shootController.secretSynced = secretInformer.Informer().HasSynced
 
 	return shootController
 }
@@ -223,7 +275,7 @@ func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers
 func (c *Controller) Run(ctx context.Context, shootWorkers, shootCareWorkers, shootMaintenanceWorkers, shootQuotaWorkers, shootHibernationWorkers int) {
 	var waitGroup sync.WaitGroup
 
-	if !cache.WaitForCacheSync(ctx.Done(), c.shootSynced, c.seedSynced, c.cloudProfileSynced, c.secretBindingSynced, c.quotaSynced, c.projectSynced, c.namespaceSynced, c.configMapSynced, c.controllerInstallationSynced) {
+	if !cache.WaitForCacheSync(ctx.Done(), c.shootSynced, c.seedSynced, c.cloudProfileSynced, c.secretBindingSynced, c.quotaSynced, c.projectSynced, c.namespaceSynced, c.configMapSynced, c.controllerInstallationSynced, c.secretSynced) {
 		logger.Logger.Error("Timed out waiting for caches to sync")
 		return
 	}
@@ -246,12 +298,19 @@ func (c *Controller) Run(ctx context.Context, shootWorkers, shootCareWorkers, sh
 		return
 	}
 	for _, shoot := range shoots {
+		if !c.seedFilter(shoot) {
+			continue
+		}
 		newShoot := shoot.DeepCopy()
 
 		// Check if the status indicates that an operation is processing and mark it as "aborted".
 		if shoot.Status.LastOperation != nil && shoot.Status.LastOperation.State == gardencorev1alpha1.LastOperationStateProcessing {
-			newShoot.Status.LastOperation.State = gardencorev1alpha1.LastOperationStateAborted
-			if _, err := c.k8sGardenClient.Garden().GardenV1beta1().Shoots(newShoot.Namespace).UpdateStatus(newShoot); err != nil {
+			if err := kutil.TryUpdateStatus(ctx, kutil.DefaultBackoff, c.k8sGardenClient.Client(), newShoot, func() error {
+				if newShoot.Status.LastOperation != nil && newShoot.Status.LastOperation.State == gardencorev1alpha1.LastOperationStateProcessing {
+					newShoot.Status.LastOperation.State = gardencorev1alpha1.LastOperationStateAborted
+				}
+				return nil
+			}); err != nil {
 				panic(fmt.Sprintf("Failed to update shoot status [%v]: %v ", newShoot.Name, err.Error()))
 			}
 		}
@@ -268,8 +327,16 @@ func (c *Controller) Run(ctx context.Context, shootWorkers, shootCareWorkers, sh
 
 	logger.Logger.Info("Shoot controller initialized.")
 
+	// The operations of the Shoots are aborted once the controller is stopped, hence, we hand the context of the
+	// controller to the reconcilers.
+	var (
+		reconcileShootKey            = func(key string) error { return c.reconcileShootKey(ctx, key) }
+		reconcileShootCredentialsKey = func(key string) error { return c.reconcileShootCredentialsKey(ctx, key) }
+		reconcileInfrastructureDrift = func(key string) error { return c.reconcileShootInfrastructureDriftKey(ctx, key) }
+	)
+
 	for i := 0; i < shootWorkers; i++ {
-		controllerutils.CreateWorker(ctx, c.shootQueue, "Shoot", c.reconcileShootKey, &waitGroup, c.workerCh)
+		controllerutils.CreateWorker(ctx, c.shootQueue, "Shoot", reconcileShootKey, &waitGroup, c.workerCh)
 	}
 	for i := 0; i < shootCareWorkers; i++ {
 		controllerutils.CreateWorker(ctx, c.shootCareQueue, "Shoot Care", c.reconcileShootCareKey, &waitGroup, c.workerCh)
@@ -281,12 +348,16 @@ func (c *Controller) Run(ctx context.Context, shootWorkers, shootCareWorkers, sh
 		controllerutils.CreateWorker(ctx, c.shootQuotaQueue, "Shoot Quota", c.reconcileShootQuotaKey, &waitGroup, c.workerCh)
 	}
 	for i := 0; i < shootWorkers/2+1; i++ {
-		controllerutils.CreateWorker(ctx, c.shootSeedQueue, "Shooted Seeds", c.reconcileShootKey, &waitGroup, c.workerCh)
+		controllerutils.CreateWorker(ctx, c.shootSeedQueue, "Shooted Seeds", reconcileShootKey, &waitGroup, c.workerCh)
 		controllerutils.CreateWorker(ctx, c.seedQueue, "Seed Queue", c.reconcileSeedKey, &waitGroup, c.workerCh)
 		controllerutils.CreateWorker(ctx, c.controllerInstallationQueue, "ControllerInstallation Queue", c.reconcileControllerInstallationKey, &waitGroup, c.workerCh)
 	}
 	for i := 0; i < shootWorkers/5+1; i++ {
 		controllerutils.CreateWorker(ctx, c.configMapQueue, "ConfigMap", c.reconcileConfigMapKey, &waitGroup, c.workerCh)
+		controllerutils.CreateWorker(ctx, c.secretQueue, "Secret", c.reconcileSecretKey, &waitGroup, c.workerCh)
+		controllerutils.CreateWorker(ctx, c.shootCredentialsQueue, "Shoot Credentials", reconcileShootCredentialsKey, &waitGroup, c.workerCh)
+		controllerutils.CreateWorker(ctx, c.shootKubeletCSRQueue, "Shoot Kubelet CSR", c.reconcileShootKubeletCSRKey, &waitGroup, c.workerCh)
+		controllerutils.CreateWorker(ctx, c.shootInfrastructureDriftQueue, "Shoot Infrastructure Drift", reconcileInfrastructureDrift, &waitGroup, c.workerCh)
 	}
 	for i := 0; i < shootHibernationWorkers; i++ {
 		controllerutils.CreateWorker(ctx, c.shootHibernationQueue, "Scheduled Shoot Hibernation", c.reconcileShootHibernationKey, &waitGroup, c.workerCh)
@@ -302,19 +373,27 @@ func (c *Controller) Run(ctx context.Context, shootWorkers, shootCareWorkers, sh
 	c.configMapQueue.ShutDown()
 	c.shootHibernationQueue.ShutDown()
 	c.controllerInstallationQueue.ShutDown()
+	c.secretQueue.ShutDown()
+	c.shootCredentialsQueue.ShutDown()
+	c.shootKubeletCSRQueue.ShutDown()
+	c.shootInfrastructureDriftQueue.ShutDown()
 
 	for {
 		var (
-			shootQueueLength                  = c.shootQueue.Len()
-			shootCareQueueLength              = c.shootCareQueue.Len()
-			shootMaintenanceQueueLength       = c.shootMaintenanceQueue.Len()
-			shootQuotaQueueLength             = c.shootQuotaQueue.Len()
-			shootSeedQueueLength              = c.shootSeedQueue.Len()
-			seedQueueLength                   = c.seedQueue.Len()
-			configMapQueueLength              = c.configMapQueue.Len()
-			shootHibernationQueueLength       = c.shootHibernationQueue.Len()
-			controllerInstallationQueueLength = c.controllerInstallationQueue.Len()
-			queueLengths                      = shootQueueLength + shootCareQueueLength + shootMaintenanceQueueLength + shootQuotaQueueLength + shootSeedQueueLength + seedQueueLength + configMapQueueLength + shootHibernationQueueLength + controllerInstallationQueueLength
+			shootQueueLength                    = c.shootQueue.Len()
+			shootCareQueueLength                = c.shootCareQueue.Len()
+			shootMaintenanceQueueLength         = c.shootMaintenanceQueue.Len()
+			shootQuotaQueueLength               = c.shootQuotaQueue.Len()
+			shootSeedQueueLength                = c.shootSeedQueue.Len()
+			seedQueueLength                     = c.seedQueue.Len()
+			configMapQueueLength                = c.configMapQueue.Len()
+			shootHibernationQueueLength         = c.shootHibernationQueue.Len()
+			controllerInstallationQueueLength   = c.controllerInstallationQueue.Len()
+			secretQueueLength                   = c.secretQueue.Len()
+			shootCredentialsQueueLength         = c.shootCredentialsQueue.Len()
+			shootKubeletCSRQueueLength          = c.shootKubeletCSRQueue.Len()
+			shootInfrastructureDriftQueueLength = c.shootInfrastructureDriftQueue.Len()
+			queueLengths                        = shootQueueLength + shootCareQueueLength + shootMaintenanceQueueLength + shootQuotaQueueLength + shootSeedQueueLength + seedQueueLength + configMapQueueLength + shootHibernationQueueLength + controllerInstallationQueueLength + secretQueueLength + shootCredentialsQueueLength + shootKubeletCSRQueueLength + shootInfrastructureDriftQueueLength
 		)
 		if queueLengths == 0 && c.numberOfRunningWorkers == 0 {
 			logger.Logger.Debug("No running Shoot worker and no items left in the queues. Terminated Shoot controller...")
@@ -340,6 +419,8 @@ func (c *Controller) CollectMetrics(ch chan<- prometheus.Metric) {
 		return
 	}
 	ch <- metric
+
+	c.collectShootMetrics(ch)
 }
 
 func (c *Controller) getShootQueue(obj interface{}) workqueue.RateLimitingInterface {
@@ -375,11 +456,10 @@ func (c *Controller) migrateMachineImageNames(shoot *gardenv1beta1.Shoot) error
 	}
 
 	if updateMachineImage := helper.UpdateMachineImage(cloudProvider, machineImage); updateMachineImage != nil {
-		_, err = kutil.TryUpdateShoot(c.k8sGardenClient.Garden(), retry.DefaultBackoff, shoot.ObjectMeta, func(s *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
-			updateMachineImage(&s.Spec.Cloud)
-			return s, nil
+		return kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), shoot, func() error {
+			updateMachineImage(&shoot.Spec.Cloud)
+			return nil
 		})
-		return err
 	}
 
 	return nil
@@ -15,6 +15,7 @@
 package shoot
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"time"
@@ -34,7 +35,6 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/tools/cache"
-	"k8s.io/client-go/util/retry"
 )
 
 func (c *Controller) shootCareAdd(obj interface{}) {
@@ -60,6 +60,10 @@ func (c *Controller) reconcileShootCareKey(key string) error {
 		logger.Logger.Infof("[SHOOT CARE] %s - unable to retrieve object from store: %v", key, err)
 		return err
 	}
+	if !c.seedFilter(shoot) {
+		logger.Logger.Debugf("[SHOOT CARE] %s - skipping because the Seed of the Shoot is not selected by my seed selector", key)
+		return nil
+	}
 
 	if err := c.careControl.Care(shoot, key); err != nil {
 		return err
@@ -116,7 +120,7 @@ func shootClientInitializer(b *botanistpkg.Botanist) func() error {
 func (c *defaultCareControl) Care(shootObj *gardenv1beta1.Shoot, key string) error {
 	var (
 		shoot       = shootObj.DeepCopy()
-		shootLogger = logger.NewShootLogger(logger.Logger, shoot.Name, shoot.Namespace, "")
+		shootLogger = newShootLogger(c.k8sGardenInformers.Projects().Lister(), shoot, "")
 	)
 	shootLogger.Debugf("[SHOOT CARE] %s", key)
 
@@ -132,6 +136,10 @@ func (c *defaultCareControl) Care(shootObj *gardenv1beta1.Shoot, key string) err
 		conditionControlPlaneHealthy     = gardencorev1alpha1helper.GetOrInitCondition(shoot.Status.Conditions, gardenv1beta1.ShootControlPlaneHealthy)
 		conditionEveryNodeReady          = gardencorev1alpha1helper.GetOrInitCondition(shoot.Status.Conditions, gardenv1beta1.ShootEveryNodeReady)
 		conditionSystemComponentsHealthy = gardencorev1alpha1helper.GetOrInitCondition(shoot.Status.Conditions, gardenv1beta1.ShootSystemComponentsHealthy)
+		conditionBackupReady             = gardencorev1alpha1helper.GetOrInitCondition(shoot.Status.Conditions, gardenv1beta1.ShootBackupReady)
+		conditionTunnelHealthy           = gardencorev1alpha1helper.GetOrInitCondition(shoot.Status.Conditions, gardenv1beta1.ShootTunnelHealthy)
+
+		constraintHibernationPossible = gardencorev1alpha1helper.GetOrInitCondition(shoot.Status.Constraints, gardenv1beta1.ShootHibernationPossible)
 	)
 
 	botanist, err := botanistpkg.New(operation)
@@ -141,9 +149,15 @@ func (c *defaultCareControl) Care(shootObj *gardenv1beta1.Shoot, key string) err
 		conditionControlPlaneHealthy = gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(conditionControlPlaneHealthy, message)
 		conditionEveryNodeReady = gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(conditionEveryNodeReady, message)
 		conditionSystemComponentsHealthy = gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(conditionSystemComponentsHealthy, message)
+		conditionBackupReady = gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(conditionBackupReady, message)
+		conditionTunnelHealthy = gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(conditionTunnelHealthy, message)
 		operation.Logger.Error(message)
 
-		c.updateShootConditions(shoot, conditionAPIServerAvailable, conditionControlPlaneHealthy, conditionEveryNodeReady, conditionSystemComponentsHealthy)
+		c.updateShootConditions(
+			shoot,
+			[]gardencorev1alpha1.Condition{conditionAPIServerAvailable, conditionControlPlaneHealthy, conditionEveryNodeReady, conditionSystemComponentsHealthy, conditionBackupReady, conditionTunnelHealthy},
+			[]gardencorev1alpha1.Condition{constraintHibernationPossible},
+		)
 		return nil // We do not want to run in the exponential backoff for the condition checks.
 	}
 
@@ -162,39 +176,76 @@ func (c *defaultCareControl) Care(shootObj *gardenv1beta1.Shoot, key string) err
 		conditionSystemComponentsHealthy,
 	)
 
+	// Trigger backup check
+	conditionBackupReady = botanist.BackupChecks(conditionBackupReady)
+
+	// Trigger VPN tunnel check
+	conditionTunnelHealthy = botanist.TunnelChecks(initializeShootClients, conditionTunnelHealthy)
+
+	// Trigger constraints check
+	constraintHibernationPossible = botanist.ConstraintsChecks(initializeShootClients, constraintHibernationPossible)
+
 	// Update Shoot status
-	shoot, err = c.updateShootConditions(shoot, conditionAPIServerAvailable, conditionControlPlaneHealthy, conditionEveryNodeReady, conditionSystemComponentsHealthy)
+	shoot, err = c.updateShootConditions(
+		shoot,
+		[]gardencorev1alpha1.Condition{conditionAPIServerAvailable, conditionControlPlaneHealthy, conditionEveryNodeReady, conditionSystemComponentsHealthy, conditionBackupReady, conditionTunnelHealthy},
+		[]gardencorev1alpha1.Condition{constraintHibernationPossible},
+	)
 	if err != nil {
 		botanist.Logger.Errorf("Could not update Shoot conditions: %+v", err)
 		return nil // We do not want to run in the exponential backoff for the condition checks.
 	}
 
+	// Update the service level objective attainment of the API server
+	if !botanist.Shoot.IsHibernated && botanist.Shoot.WantsMonitoring {
+		apiServerSLO, err := botanist.ComputeAPIServerSLO(time.Now())
+		if err != nil {
+			botanist.Logger.Infof("Could not compute API server SLO: %+v", err)
+		} else if shoot, err = c.updateShootAPIServerSLO(shoot, apiServerSLO); err != nil {
+			botanist.Logger.Errorf("Could not update Shoot API server SLO: %+v", err)
+			return nil // We do not want to run in the exponential backoff for the condition checks.
+		}
+	}
+
 	// Mark Shoot as healthy/unhealthy
-	kutil.TryUpdateShootLabels(
-		c.k8sGardenClient.Garden(),
-		retry.DefaultBackoff, shoot.ObjectMeta,
-		StatusLabelTransform(
-			ComputeStatus(
-				shoot.Status.LastOperation,
-				shoot.Status.LastError,
-				conditionAPIServerAvailable,
-				conditionControlPlaneHealthy,
-				conditionEveryNodeReady,
-				conditionSystemComponentsHealthy,
-			),
-		),
+	status := ComputeStatus(
+		shoot.Status.LastOperation,
+		shoot.Status.LastError,
+		conditionAPIServerAvailable,
+		conditionControlPlaneHealthy,
+		conditionEveryNodeReady,
+		conditionSystemComponentsHealthy,
+		conditionBackupReady,
+		conditionTunnelHealthy,
 	)
+	kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), shoot, func() error {
+		_, err := StatusLabelTransform(status)(shoot)
+		return err
+	})
 	return nil // We do not want to run in the exponential backoff for the condition checks.
 }
 
-func (c *defaultCareControl) updateShootConditions(shoot *gardenv1beta1.Shoot, conditions ...gardencorev1alpha1.Condition) (*gardenv1beta1.Shoot, error) {
-	newShoot, err := kutil.TryUpdateShootConditions(c.k8sGardenClient.Garden(), retry.DefaultBackoff, shoot.ObjectMeta,
-		func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
-			shoot.Status.Conditions = conditions
-			return shoot, nil
-		})
+func (c *defaultCareControl) updateShootConditions(shoot *gardenv1beta1.Shoot, conditions, constraints []gardencorev1alpha1.Condition) (*gardenv1beta1.Shoot, error) {
+	newShoot := shoot.DeepCopy()
+	if err := kutil.TryUpdateStatus(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), newShoot, func() error {
+		newShoot.Status.Conditions = conditions
+		newShoot.Status.Constraints = constraints
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return newShoot, nil
+}
 
-	return newShoot, err
+func (c *defaultCareControl) updateShootAPIServerSLO(shoot *gardenv1beta1.Shoot, apiServerSLO *gardenv1beta1.APIServerSLO) (*gardenv1beta1.Shoot, error) {
+	newShoot := shoot.DeepCopy()
+	if err := kutil.TryUpdateStatus(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), newShoot, func() error {
+		newShoot.Status.APIServerSLO = apiServerSLO
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return newShoot, nil
 }
 
 // garbageCollection cleans the Seed and the Shoot cluster from no longer required
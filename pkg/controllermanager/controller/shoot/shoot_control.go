@@ -15,33 +15,42 @@
 package shoot
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/client/vault"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	controllermanagerfeatures "github.com/gardener/gardener/pkg/controllermanager/features"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
+	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
 	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/flow"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/ratelimiter"
 	"github.com/gardener/gardener/pkg/utils/reconcilescheduler"
 	"github.com/gardener/gardener/pkg/version"
 
+	"golang.org/x/time/rate"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
-	"k8s.io/client-go/util/retry"
 )
 
 func (c *Controller) shootAdd(obj interface{}) {
@@ -59,11 +68,16 @@ func (c *Controller) shootUpdate(oldObj, newObj interface{}) {
 		newShoot        = newObj.(*gardenv1beta1.Shoot)
 		oldShootJSON, _ = json.Marshal(oldShoot)
 		newShootJSON, _ = json.Marshal(newShoot)
-		shootLogger     = logger.NewShootLogger(logger.Logger, newShoot.ObjectMeta.Name, newShoot.ObjectMeta.Namespace, "")
+		shootLogger     = newShootLogger(c.projectLister, newShoot, "")
 	)
 	shootLogger.Debugf(string(oldShootJSON))
 	shootLogger.Debugf(string(newShootJSON))
 
+	// A running reconciliation would only delay the deletion of the Shoot, hence, we abort it after its running tasks.
+	if oldShoot.DeletionTimestamp == nil && newShoot.DeletionTimestamp != nil {
+		c.control.AbortReconciliation(newShoot)
+	}
+
 	// If the generation did not change for an update event (i.e., no changes to the .spec section have
 	// been made), we do not want to add the Shoot to the queue. The period reconciliation is handled
 	// elsewhere by adding the Shoot to the queue to dedicated times.
@@ -108,7 +122,7 @@ func (c *Controller) newShootElement(shoot *gardenv1beta1.Shoot) (*shootElement,
 	}, nil
 }
 
-func (c *Controller) reconcileShootKey(key string) error {
+func (c *Controller) reconcileShootKey(ctx context.Context, key string) error {
 	shootID, err := newIDFromString(key)
 	if err != nil {
 		return err
@@ -126,7 +140,7 @@ func (c *Controller) reconcileShootKey(key string) error {
 	}
 
 	var (
-		shootLogger  = logger.NewShootLogger(logger.Logger, shoot.ObjectMeta.Name, shoot.ObjectMeta.Namespace, "")
+		shootLogger  = newShootLogger(c.projectLister, shoot, "")
 		needsRequeue = true
 		reconcileErr error
 	)
@@ -139,6 +153,14 @@ func (c *Controller) reconcileShootKey(key string) error {
 		return nil
 	}
 
+	// Ignore Shoots whose Seed is handled by another instance.
+	if !c.seedFilter(shoot) {
+		shootLogger.Debug("Do not need to do anything as the Seed of the Shoot is not selected by my seed selector")
+		c.scheduler.Delete(shootID)
+		c.getShootQueue(shoot).Forget(key)
+		return nil
+	}
+
 	shootElement, err := c.newShootElement(shoot)
 	if err != nil {
 		return err
@@ -161,11 +183,32 @@ func (c *Controller) reconcileShootKey(key string) error {
 		mayReconcile, reason = c.scheduler.TestAndActivate(shootElement, shoot.Generation != shoot.Status.ObservedGeneration, shootIsSeed(shoot))
 	}
 
+	var (
+		maintenanceTimeWindow = shootMaintenanceTimeWindow(shoot)
+		now                   = time.Now().UTC()
+	)
+
+	// Shoots which have been hibernated before the hibernation state applied by the last successful operation was
+	// recorded in their status are not hibernated according to it. As the status reflects the specification if the
+	// last operation succeeded and the generation has not changed since then, it is corrected in this case.
+	if mustBackfillHibernatedStatus(shoot) {
+		if updatedShoot, err := c.updateShootStatusHibernated(shoot); err != nil {
+			shootLogger.Errorf("Could not record the hibernation state in the Shoot status: %+v", err)
+		} else {
+			shoot = updatedShoot
+		}
+	}
+
 	switch {
 	case mustIgnoreShoot(shoot.Annotations, c.config.Controllers.Shoot.RespectSyncPeriodOverwrite):
 		// Check whether the shoot has been marked as "never reconcile".
 		shootLogger.Info("Skipping reconciliation because Shoot is marked as 'to-be-ignored'.")
 
+	case c.isLandscapeFrozen():
+		// Do not start new operations while the landscape is frozen. The Shoots are requeued once the freeze is lifted.
+		shootLogger.Info("Skipping reconciliation because the landscape is frozen.")
+		needsRequeue = false
+
 	case !mayReconcile:
 		// If the shoot may not be reconciled (due to above decision) then we mark it as pending in case it was not failed
 		message := fmt.Sprintf("May not yet reconcile shoot %q: %s", shoot.Name, reason)
@@ -175,13 +218,30 @@ func (c *Controller) reconcileShootKey(key string) error {
 			c.updateShootStatusPending(shoot, message)
 		}
 
+	case mustPostponeReconciliation(shoot, c.config.Controllers.Shoot.ReconcileInMaintenanceOnly, maintenanceTimeWindow, now):
+		// The Shoot may only be reconciled during its maintenance time window, hence we requeue it to a random point in
+		// time within the next one.
+		durationToMaintenance := maintenanceTimeWindow.RandomDurationUntilNext(now)
+		shootLogger.Infof("Postponing reconciliation until the next maintenance time window (%s) in %s.", maintenanceTimeWindow, durationToMaintenance)
+		c.getShootQueue(shoot).AddAfter(key, durationToMaintenance)
+		needsRequeue = false
+
 	default:
-		// Otherwise (i.e., shoot is not ignored and may be reconciled) we start the reconcile operation).
-		needsRequeue, reconcileErr = c.control.ReconcileShoot(shoot, key)
+		// Otherwise (i.e., shoot is not ignored and may be reconciled) we start the reconcile operation). It must not run
+		// at the same time as a refresh of the credentials of the Shoot which is processed by another queue.
+		unlock, ok := c.operationLocks.tryLock(key)
+		if !ok {
+			shootLogger.Infof("Postponing reconciliation by %s because the credentials of the Shoot are being refreshed.", operationLockRequeueInterval)
+			c.getShootQueue(shoot).AddAfter(key, operationLockRequeueInterval)
+			needsRequeue = false
+			break
+		}
+		needsRequeue, reconcileErr = c.control.ReconcileShoot(ctx, shoot, key)
+		unlock()
 	}
 	c.scheduler.Done(shootElement.GetID())
 
-	if durationToNextSync := scheduleNextSync(c.config.Controllers.Shoot, reconcileErr != nil, shoot.ObjectMeta, reason); durationToNextSync > 0 && needsRequeue {
+	if durationToNextSync := scheduleNextSync(c.config.Controllers.Shoot, reconcileErr != nil, shoot.ObjectMeta, shoot.Status.RetryCount+1, reason); durationToNextSync > 0 && needsRequeue {
 		c.getShootQueue(shoot).AddAfter(key, durationToNextSync)
 		message := fmt.Sprintf("Scheduled next queuing time for Shoot '%s' in %s (%s)", key, durationToNextSync, time.Now().UTC().Add(durationToNextSync))
 		shootLogger.Infof(message)
@@ -192,21 +252,33 @@ func (c *Controller) reconcileShootKey(key string) error {
 }
 
 func (c *Controller) updateShootStatusPending(shoot *gardenv1beta1.Shoot, message string) error {
-	_, err := kutil.TryUpdateShootStatus(c.k8sGardenClient.Garden(), retry.DefaultRetry, shoot.ObjectMeta,
-		func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
-			shoot.Status.LastOperation = &gardencorev1alpha1.LastOperation{
-				Type:           gardencorev1alpha1helper.ComputeOperationType(shoot.ObjectMeta, shoot.Status.LastOperation),
-				State:          gardencorev1alpha1.LastOperationStateProcessing,
-				Progress:       0,
-				Description:    message,
-				LastUpdateTime: metav1.Now(),
-			}
-			return shoot, nil
-		})
-	return err
+	newShoot := shoot.DeepCopy()
+	return kutil.TryUpdateStatus(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), newShoot, func() error {
+		newShoot.Status.LastOperation = &gardencorev1alpha1.LastOperation{
+			Type:           gardencorev1alpha1helper.ComputeOperationType(newShoot.ObjectMeta, newShoot.Status.LastOperation),
+			State:          gardencorev1alpha1.LastOperationStateProcessing,
+			Progress:       0,
+			Description:    message,
+			LastUpdateTime: metav1.Now(),
+		}
+		return nil
+	})
+}
+
+func (c *Controller) updateShootStatusHibernated(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
+	newShoot := shoot.DeepCopy()
+	if err := kutil.TryUpdateStatus(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), newShoot, func() error {
+		if mustBackfillHibernatedStatus(newShoot) {
+			newShoot.Status.Hibernated = helper.IsShootHibernated(newShoot)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return newShoot, nil
 }
 
-func scheduleNextSync(config config.ShootControllerConfiguration, errorOccurred bool, objectMeta metav1.ObjectMeta, reason *reconcilescheduler.Reason) time.Duration {
+func scheduleNextSync(config config.ShootControllerConfiguration, errorOccurred bool, objectMeta metav1.ObjectMeta, retryCount int32, reason *reconcilescheduler.Reason) time.Duration {
 	switch {
 	case reason == nil, reason.Code() == reconcilescheduler.CodeOther, reason.Code() == reconcilescheduler.CodeActivated:
 	case reason.Code() == reconcilescheduler.CodeParentUnknown:
@@ -216,7 +288,7 @@ func scheduleNextSync(config config.ShootControllerConfiguration, errorOccurred
 	}
 
 	if errorOccurred {
-		return (*config.RetrySyncPeriod).Duration
+		return retryBackoff((*config.RetrySyncPeriod).Duration, config.SyncPeriod.Duration, retryCount)
 	}
 
 	var (
@@ -243,7 +315,20 @@ func scheduleNextSync(config config.ShootControllerConfiguration, errorOccurred
 		nextSyncNano   = currentTimeNano - (currentTimeNano-creationTimeNano)%syncPeriodNano + syncPeriodNano
 	)
 
-	return time.Duration(nextSyncNano - currentTimeNano)
+	return time.Duration(nextSyncNano-currentTimeNano) + syncJitter(syncPeriod.Duration)
+}
+
+// syncJitterDivisor determines the maximum random delay of the periodic reconciliations of Shoots (a tenth of the sync
+// period), so that Shoots created at the same time are not reconciled at the same time.
+const syncJitterDivisor = 10
+
+// syncJitter returns a random duration of at most the tenth of the given <syncPeriod>.
+func syncJitter(syncPeriod time.Duration) time.Duration {
+	maxJitter := syncPeriod.Nanoseconds() / syncJitterDivisor
+	if maxJitter <= 0 {
+		return 0
+	}
+	return time.Duration(utils.RandomFunc(0, maxJitter))
 }
 
 // ControlInterface implements the control logic for updating Shoots. It is implemented as an interface to allow
@@ -254,15 +339,28 @@ type ControlInterface interface {
 	// Implementors should sink any errors that they do not wish to trigger a retry, and they may feel free to
 	// exit exceptionally at any point provided they wish the update to be re-run at a later point in time.
 	// The bool return value determines whether the Shoot should be automatically requeued for reconciliation.
-	ReconcileShoot(shoot *gardenv1beta1.Shoot, key string) (bool, error)
+	// Running operations are aborted once the given context is cancelled.
+	ReconcileShoot(ctx context.Context, shoot *gardenv1beta1.Shoot, key string) (bool, error)
+	// AbortReconciliation stops the running reconciliation of the given Shoot (if any) in between its tasks, e.g. because
+	// the Shoot shall be deleted and the reconciliation would only delay the deletion. The running tasks may finish.
+	AbortReconciliation(shoot *gardenv1beta1.Shoot)
+	// RefreshShootCredentials redeploys the cloud provider credentials of the Shoot into its control plane without
+	// running a full reconciliation. If an implementation returns a non-nil error, the invocation will be retried
+	// using a rate-limited strategy.
+	RefreshShootCredentials(ctx context.Context, shoot *gardenv1beta1.Shoot) error
+	// RotateShootCredentials switches the Shoot to the new cloud provider credentials of a rotation and verifies
+	// them by applying the infrastructure and waiting for the controllers consuming them. If an implementation
+	// returns a non-nil error, the invocation will be retried using a rate-limited strategy.
+	RotateShootCredentials(ctx context.Context, shoot *gardenv1beta1.Shoot) error
 }
 
 // NewDefaultControl returns a new instance of the default implementation ControlInterface that
 // implements the documented semantics for Shoots. updater is the UpdaterInterface used
 // to update the status of Shoots. You should use an instance returned from NewDefaultControl() for any
 // scenario other than testing.
-func NewDefaultControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.Interface, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, identity *gardenv1beta1.Gardener, config *config.ControllerManagerConfiguration, gardenerNamespace string, recorder record.EventRecorder) ControlInterface {
-	return &defaultControl{k8sGardenClient, k8sGardenInformers, secrets, imageVector, identity, config, gardenerNamespace, recorder}
+// The <cloudAPIRateLimiters> hold the token buckets of the cloud provider accounts, they are nil if the rate is not limited.
+func NewDefaultControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.Interface, secrets map[string]*corev1.Secret, vaultClient vault.ClientInterface, imageVector imagevector.ImageVector, identity *gardenv1beta1.Gardener, config *config.ControllerManagerConfiguration, gardenerNamespace string, recorder record.EventRecorder, flowRegistry *flow.Registry, operationLogs *logger.OperationLogs, cloudAPIRateLimiters *ratelimiter.Registry) ControlInterface {
+	return &defaultControl{k8sGardenClient, k8sGardenInformers, secrets, imageVector, identity, config, gardenerNamespace, recorder, vaultClient, cloudAPIRateLimiters, flowRegistry, operationLogs, newReconciliationRegistry()}
 }
 
 type defaultControl struct {
@@ -274,9 +372,28 @@ type defaultControl struct {
 	config             *config.ControllerManagerConfiguration
 	gardenerNamespace  string
 	recorder           record.EventRecorder
+
+	// vaultClient reads the cloud provider credentials of SecretBindings with a Vault reference. It is nil if no Vault
+	// is configured.
+	vaultClient vault.ClientInterface
+	// cloudAPIRateLimiters holds the token buckets of the cloud provider accounts. It is nil if the rate is not limited.
+	cloudAPIRateLimiters *ratelimiter.Registry
+	// flowRegistry holds the trackers of the running flows by the keys of the Shoots, so that they can be dumped.
+	flowRegistry *flow.Registry
+	// operationLogs holds the log lines of the running flows by the keys of the Shoots, so that they can be followed.
+	operationLogs *logger.OperationLogs
+	// reconciliations holds the stop channels of the running reconciliations by the keys of the Shoots, so that
+	// they can be aborted in between their tasks.
+	reconciliations *reconciliationRegistry
+}
+
+func (c *defaultControl) AbortReconciliation(shoot *gardenv1beta1.Shoot) {
+	if c.reconciliations.abort(fmt.Sprintf("%s/%s", shoot.Namespace, shoot.Name)) {
+		newShootLogger(c.k8sGardenInformers.Projects().Lister(), shoot, "").Info("Aborted the running reconciliation because the Shoot shall be deleted.")
+	}
 }
 
-func (c *defaultControl) ReconcileShoot(shootObj *gardenv1beta1.Shoot, key string) (bool, error) {
+func (c *defaultControl) ReconcileShoot(ctx context.Context, shootObj *gardenv1beta1.Shoot, key string) (bool, error) {
 	key, err := cache.MetaNamespaceKeyFunc(shootObj)
 	if err != nil {
 		return true, err
@@ -288,7 +405,7 @@ func (c *defaultControl) ReconcileShoot(shootObj *gardenv1beta1.Shoot, key strin
 
 	var (
 		shoot         = shootObj.DeepCopy()
-		shootLogger   = logger.NewShootLogger(logger.Logger, shoot.Name, shoot.Namespace, operationID)
+		shootLogger   = newShootLogger(c.k8sGardenInformers.Projects().Lister(), shoot, operationID)
 		operationType = gardencorev1alpha1helper.ComputeOperationType(shoot.ObjectMeta, shoot.Status.LastOperation)
 	)
 
@@ -301,10 +418,11 @@ func (c *defaultControl) ReconcileShoot(shootObj *gardenv1beta1.Shoot, key strin
 		shootLogger.Errorf("Could not initialize a new operation: %s", err.Error())
 		return true, err
 	}
+	operation.CloudAPIRateLimiter = cloudAPIRateLimiter(c.cloudAPIRateLimiters, operation)
 
 	// We check whether the Shoot's last operation status field indicates that the last operation failed (i.e. the operation
-	// will not be retried unless the shoot generation changes).
-	if shootIsFailed(shoot) {
+	// will not be retried unless the shoot generation changes). Shoots which shall be force-deleted are processed anyway.
+	if shootIsFailed(shoot) && !helper.ShootWantsForceDeletion(shoot) {
 		if shoot.Status.Gardener.Version == version.Get().GitVersion {
 			shootLogger.Infof("Will not reconcile as the last operation has been set to '%s' and the generation has not changed since then.", gardencorev1alpha1.LastOperationStateFailed)
 			return false, nil
@@ -318,6 +436,13 @@ func (c *defaultControl) ReconcileShoot(shootObj *gardenv1beta1.Shoot, key strin
 		shootLogger.Infof("Successfully rescheduled failed shoot %q for reconciliation due to Gardener version update", shoot.Name)
 	}
 
+	// The credentials of SecretBindings with a Vault reference are only read by the reconciliation and the deletion
+	// which deploy them, as Vault might issue new dynamic credentials for every read.
+	if err := operation.Shoot.ReadVaultCredentials(c.k8sGardenInformers, c.vaultClient); err != nil {
+		shootLogger.Errorf("Could not read the cloud provider credentials from Vault: %s", err.Error())
+		return true, err
+	}
+
 	// When a Shoot clusters deletion timestamp is set we need to delete the cluster and must not trigger a new reconciliation operation.
 	if shoot.DeletionTimestamp != nil {
 		c.recorder.Eventf(shoot, corev1.EventTypeNormal, gardenv1beta1.EventDeleting, "[%s] Deleting Shoot cluster", operationID)
@@ -325,15 +450,21 @@ func (c *defaultControl) ReconcileShoot(shootObj *gardenv1beta1.Shoot, key strin
 			shootLogger.Errorf("Could not update the Shoot status after deletion start: %+v", updateErr)
 			return true, updateErr
 		}
-		if deleteErr := c.deleteShoot(operation); deleteErr != nil {
+		if deleteErr := c.deleteShoot(ctx, operation); deleteErr != nil {
 			c.recorder.Eventf(shoot, corev1.EventTypeWarning, gardenv1beta1.EventDeleteError, "[%s] %s", operationID, deleteErr.Description)
-			if state, updateErr := c.updateShootStatusDeleteError(operation, deleteErr); updateErr != nil {
+			state, updateErr := c.updateShootStatusDeleteError(operation, deleteErr)
+			if updateErr != nil {
 				shootLogger.Errorf("Could not update the Shoot status after deletion error: %+v", updateErr)
 				return state != gardencorev1alpha1.LastOperationStateFailed, updateErr
 			}
-			return true, errors.New(deleteErr.Description)
+			return state != gardencorev1alpha1.LastOperationStateFailed, errors.New(deleteErr.Description)
 		}
 		c.recorder.Eventf(shoot, corev1.EventTypeNormal, gardenv1beta1.EventDeleted, "[%s] Deleted Shoot cluster", operationID)
+		if c.vaultClient != nil {
+			if err := c.vaultClient.Revoke(shootpkg.VaultKey(shoot)); err != nil {
+				shootLogger.Warnf("Could not revoke the cloud provider credentials read from Vault: %+v", err)
+			}
+		}
 		if updateErr := c.updateShootStatusDeleteSuccess(operation); updateErr != nil {
 			shootLogger.Errorf("Could not update the Shoot status after deletion success: %+v", updateErr)
 			return true, updateErr
@@ -341,22 +472,49 @@ func (c *defaultControl) ReconcileShoot(shootObj *gardenv1beta1.Shoot, key strin
 		return false, nil
 	}
 
+	// Skip the reconciliation if the effective desired state of the Shoot has not changed since its last successful
+	// operation, unless a full reconciliation is due or certificates must be renewed.
+	var reconciledStateHash string
+	if controllermanagerfeatures.FeatureGate.Enabled(features.ShootReconcileChangeDetection) {
+		reconciledStateHash, err = computeReconciledStateHash(operation.Shoot.Info, operation.Shoot.CloudProfile, operation.Seed.Info, operation.Shoot.Secret, operation.Seed.Secret, operation.Seed.BackupSecret)
+		if err != nil {
+			shootLogger.Errorf("Could not compute the hash of the desired state: %+v", err)
+			return true, err
+		}
+
+		now := time.Now().UTC()
+		if mustSkipReconciliation(shoot, reconciledStateHash, shootMaintenanceTimeWindow(shoot), now) {
+			needsRenewal, err := shootCertificatesNeedRenewal(operation, now)
+			if err != nil {
+				shootLogger.Errorf("Could not check the certificates of the Shoot, reconciling it anyway: %+v", err)
+			}
+			if err == nil && !needsRenewal {
+				shootLogger.Infof("Skipping reconciliation because the desired state has not changed since the last successful operation (set the %q annotation to %q to enforce it).", common.ShootReconcile, common.ShootReconcileAlways)
+				return true, nil
+			}
+		}
+	}
+
 	// When a Shoot clusters deletion timestamp is not set we need to create/reconcile the cluster.
 	c.recorder.Eventf(shoot, corev1.EventTypeNormal, gardenv1beta1.EventReconciling, "[%s] Reconciling Shoot cluster state", operationID)
 	if updateErr := c.updateShootStatusReconcileStart(operation, operationType); updateErr != nil {
 		shootLogger.Errorf("Could not update the Shoot status after reconciliation start: %+v", updateErr)
 		return true, updateErr
 	}
-	if reconcileErr := c.reconcileShoot(operation, operationType); reconcileErr != nil {
+	stopCh, unregister := c.reconciliations.register(key)
+	defer unregister()
+
+	if reconcileErr := c.reconcileShoot(ctx, stopCh, operation, operationType); reconcileErr != nil {
 		c.recorder.Eventf(shoot, corev1.EventTypeWarning, gardenv1beta1.EventReconcileError, "[%s] %s", operationID, reconcileErr.Description)
-		if state, updateErr := c.updateShootStatusReconcileError(operation, operationType, reconcileErr); updateErr != nil {
+		state, updateErr := c.updateShootStatusReconcileError(operation, operationType, reconcileErr)
+		if updateErr != nil {
 			shootLogger.Errorf("Could not update the Shoot status after reconciliation error: %+v", updateErr)
 			return state != gardencorev1alpha1.LastOperationStateFailed, updateErr
 		}
-		return true, errors.New(reconcileErr.Description)
+		return state != gardencorev1alpha1.LastOperationStateFailed, errors.New(reconcileErr.Description)
 	}
 	c.recorder.Eventf(shoot, corev1.EventTypeNormal, gardenv1beta1.EventReconciled, "[%s] Reconciled Shoot cluster state", operationID)
-	if updateErr := c.updateShootStatusReconcileSuccess(operation, operationType); updateErr != nil {
+	if updateErr := c.updateShootStatusReconcileSuccess(operation, operationType, reconciledStateHash); updateErr != nil {
 		shootLogger.Errorf("Could not update the Shoot status after reconciliation success: %+v", updateErr)
 		return true, updateErr
 	}
@@ -365,6 +523,19 @@ func (c *defaultControl) ReconcileShoot(shootObj *gardenv1beta1.Shoot, key strin
 
 // usesShootedSeed checks whether the seed used by given <shoot> is a shoot cluster itself. If yes it
 // will return true and the shoot object of the shooted seed, otherwise false.
+// shootCertificatesNeedRenewal checks whether any certificate in the namespace of the Shoot of the given operation in
+// its Seed must be renewed by the reconciliation flow.
+func shootCertificatesNeedRenewal(o *operation.Operation, now time.Time) (bool, error) {
+	if err := o.InitializeSeedClients(); err != nil {
+		return false, err
+	}
+	secrets, err := o.K8sSeedClient.ListSecrets(o.Shoot.SeedNamespace, metav1.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+	return certificatesNeedRenewal(secrets.Items, now), nil
+}
+
 func (c *Controller) usesShootedSeed(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
 	seed := shoot.Spec.Cloud.Seed
 	if seed == nil {
@@ -398,3 +569,113 @@ func seedHasShootOwnerReference(meta metav1.ObjectMeta) (bool, string) {
 
 	return false, ""
 }
+
+// trackFlow registers a tracker for the flow which is about to run for the given Shoot, so that its progress can be
+// dumped via the debug endpoints of the controller manager, and starts a new operation log which can be followed
+// while the flow is running. The returned function must be called once it finished.
+func (c *defaultControl) trackFlow(shoot *gardenv1beta1.Shoot) (*flow.Tracker, func()) {
+	var (
+		key                = fmt.Sprintf("%s/%s", shoot.Namespace, shoot.Name)
+		tracker, untrack   = c.flowRegistry.Track(key)
+		finishOperationLog = c.operationLogs.Start(key)
+	)
+
+	return tracker, func() {
+		untrack()
+		finishOperationLog()
+	}
+}
+
+// reconciliationRegistry holds the stop channels of the running reconciliations by the keys of the Shoots.
+type reconciliationRegistry struct {
+	lock    sync.Mutex
+	stopChs map[string]chan struct{}
+}
+
+func newReconciliationRegistry() *reconciliationRegistry {
+	return &reconciliationRegistry{stopChs: map[string]chan struct{}{}}
+}
+
+// register returns a channel for the reconciliation of the Shoot with the given key which is closed once the
+// reconciliation shall be aborted. The returned function must be called once the reconciliation finished.
+func (r *reconciliationRegistry) register(key string) (<-chan struct{}, func()) {
+	stopCh := make(chan struct{})
+
+	r.lock.Lock()
+	r.stopChs[key] = stopCh
+	r.lock.Unlock()
+
+	return stopCh, func() {
+		r.lock.Lock()
+		defer r.lock.Unlock()
+
+		if r.stopChs[key] == stopCh {
+			delete(r.stopChs, key)
+		}
+	}
+}
+
+// abort aborts the running reconciliation of the Shoot with the given key. It returns whether a reconciliation
+// was running.
+func (r *reconciliationRegistry) abort(key string) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	stopCh, ok := r.stopChs[key]
+	if ok {
+		close(stopCh)
+		delete(r.stopChs, key)
+	}
+	return ok
+}
+
+// newCloudAPIRateLimiters returns the registry of the token buckets of the cloud provider accounts which are used by
+// the Shoot operations, or nil if the rate is not limited by the given <config>.
+func newCloudAPIRateLimiters(config *config.ControllerManagerConfiguration) *ratelimiter.Registry {
+	if rateLimit := config.Controllers.Shoot.CloudAPIRateLimit; rateLimit != nil {
+		return ratelimiter.NewRegistry(rateLimit.QPS, int(rateLimit.Burst))
+	}
+	return nil
+}
+
+// cloudAPIRateLimiter returns the token bucket of the cloud provider account of the Shoot of the given <o> from the
+// given <cloudAPIRateLimiters>.
+func cloudAPIRateLimiter(cloudAPIRateLimiters *ratelimiter.Registry, o *operation.Operation) *rate.Limiter {
+	if secret := o.Shoot.Secret; secret != nil && len(secret.Name) > 0 {
+		return cloudAPIRateLimiters.Get(fmt.Sprintf("%s/%s", secret.Namespace, secret.Name))
+	}
+	return nil
+}
+
+// operationLockRequeueInterval is the duration after which an operation on a Shoot is retried if another operation
+// on it is currently running.
+const operationLockRequeueInterval = 15 * time.Second
+
+// operationLocks serializes the operations on Shoots which are processed by different queues (reconciliations and
+// credentials refreshes) by the keys of the Shoots.
+type operationLocks struct {
+	lock sync.Mutex
+	keys sets.String
+}
+
+func newOperationLocks() *operationLocks {
+	return &operationLocks{keys: sets.NewString()}
+}
+
+// tryLock acquires the lock for the Shoot with the given key if no other operation holds it. It returns whether the
+// lock has been acquired and a function which must be called to release it once the operation finished.
+func (l *operationLocks) tryLock(key string) (func(), bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.keys.Has(key) {
+		return nil, false
+	}
+	l.keys.Insert(key)
+
+	return func() {
+		l.lock.Lock()
+		defer l.lock.Unlock()
+		l.keys.Delete(key)
+	}, true
+}
@@ -0,0 +1,300 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/operation/botanist"
+	cloudbotanistpkg "github.com/gardener/gardener/pkg/operation/cloudbotanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	hybridbotanistpkg "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// credentialsRefreshRequeueInterval is the duration after which the credentials refresh of a Shoot is retried if
+// an operation for it is currently running.
+const credentialsRefreshRequeueInterval = time.Minute
+
+func (c *Controller) reconcileShootCredentialsKey(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	shoot, err := c.shootLister.Shoots(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		logger.Logger.Debugf("[SHOOT CREDENTIALS] %s - skipping because Shoot has been deleted", key)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	binding, err := c.secretBindingLister.SecretBindings(shoot.Namespace).Get(shoot.Spec.Cloud.SecretBindingRef.Name)
+	if err != nil {
+		return err
+	}
+
+	var (
+		shootLogger   = newShootLogger(c.projectLister, shoot, "")
+		lastOperation = shoot.Status.LastOperation
+		rotate        = helper.SecretBindingRotationInProgress(binding) && !helper.IsShootRotated(binding, shoot.Name)
+	)
+
+	switch {
+	case shoot.DeletionTimestamp != nil:
+		// The deletion flow refreshes the credentials on its own.
+		shootLogger.Debug("Skipping credentials refresh because the Shoot is being deleted")
+		if rotate {
+			return c.markShootRotated(binding, shoot.Name)
+		}
+		return nil
+
+	case !c.seedFilter(shoot):
+		return nil
+
+	case binding.VaultRef != nil:
+		// Credentials read from Vault are deployed with every reconciliation.
+		shootLogger.Debug("Skipping credentials refresh because the credentials are read from Vault")
+		return nil
+
+	case mustIgnoreShoot(shoot.Annotations, c.config.Controllers.Shoot.RespectSyncPeriodOverwrite):
+		shootLogger.Info("Skipping credentials refresh because Shoot is marked as 'to-be-ignored'.")
+		if rotate {
+			// The rotation must not complete before this Shoot uses the new credentials.
+			c.shootCredentialsQueue.AddAfter(key, credentialsRefreshRequeueInterval)
+		}
+		return nil
+
+	case lastOperation == nil || (lastOperation.Type == gardencorev1alpha1.LastOperationTypeCreate && lastOperation.State != gardencorev1alpha1.LastOperationStateSucceeded):
+		// The next creation attempt deploys the current credentials anyway.
+		shootLogger.Debug("Skipping credentials refresh because the Shoot has not been created yet")
+		if !rotate {
+			return nil
+		}
+		if lastOperation != nil && lastOperation.State == gardencorev1alpha1.LastOperationStateProcessing {
+			// A running creation might have read the previous credentials.
+			c.shootCredentialsQueue.AddAfter(key, credentialsRefreshRequeueInterval)
+			return nil
+		}
+		return c.markShootRotated(binding, shoot.Name)
+
+	case c.isLandscapeFrozen():
+		// All Shoots are reconciled once the freeze is lifted, which deploys the current credentials as well.
+		shootLogger.Info("Skipping credentials refresh because the landscape is frozen.")
+		if rotate {
+			c.shootCredentialsQueue.AddAfter(key, credentialsRefreshRequeueInterval)
+		}
+		return nil
+
+	case lastOperation.State == gardencorev1alpha1.LastOperationStateProcessing:
+		// The running operation might have read the previous credentials, hence we refresh after it has finished.
+		shootLogger.Infof("Postponing credentials refresh by %s because an operation is running", credentialsRefreshRequeueInterval)
+		c.shootCredentialsQueue.AddAfter(key, credentialsRefreshRequeueInterval)
+		return nil
+	}
+
+	// The lister might not reflect a reconciliation which has just been started, hence, the credentials are only
+	// refreshed while no other operation runs on the Shoot.
+	unlock, ok := c.operationLocks.tryLock(key)
+	if !ok {
+		shootLogger.Infof("Postponing credentials refresh by %s because an operation is running", credentialsRefreshRequeueInterval)
+		c.shootCredentialsQueue.AddAfter(key, credentialsRefreshRequeueInterval)
+		return nil
+	}
+	defer unlock()
+
+	if !rotate {
+		return c.control.RefreshShootCredentials(ctx, shoot)
+	}
+
+	if err := c.control.RotateShootCredentials(ctx, shoot); err != nil {
+		return err
+	}
+	return c.markShootRotated(binding, shoot.Name)
+}
+
+// markShootRotated records in the rotation status of the given SecretBinding that the Shoot with the given name
+// uses the new credentials.
+func (c *Controller) markShootRotated(binding *gardenv1beta1.SecretBinding, shootName string) error {
+	newBinding := binding.DeepCopy()
+	return kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), newBinding, func() error {
+		if !helper.SecretBindingRotationInProgress(newBinding) || helper.IsShootRotated(newBinding, shootName) {
+			return nil
+		}
+		newBinding.Rotation.Status.RotatedShoots = append(newBinding.Rotation.Status.RotatedShoots, shootName)
+		newBinding.Rotation.Status.LastUpdateTime = metav1.Now()
+		return nil
+	})
+}
+
+func (c *defaultControl) RefreshShootCredentials(ctx context.Context, shootObj *gardenv1beta1.Shoot) error {
+	return c.updateShootCredentials(ctx, shootObj, false)
+}
+
+func (c *defaultControl) RotateShootCredentials(ctx context.Context, shootObj *gardenv1beta1.Shoot) error {
+	return c.updateShootCredentials(ctx, shootObj, true)
+}
+
+func (c *defaultControl) updateShootCredentials(ctx context.Context, shootObj *gardenv1beta1.Shoot, rotation bool) error {
+	operationID, err := utils.GenerateRandomString(8)
+	if err != nil {
+		return err
+	}
+
+	var (
+		shoot       = shootObj.DeepCopy()
+		shootLogger = newShootLogger(c.k8sGardenInformers.Projects().Lister(), shoot, operationID)
+	)
+
+	o, err := operation.New(shoot, shootLogger, c.k8sGardenClient, c.k8sGardenInformers, c.identity, c.secrets, c.imageVector, c.config.ShootBackup)
+	if err != nil {
+		shootLogger.Errorf("Could not initialize a new operation: %s", err.Error())
+		return err
+	}
+	o.CloudAPIRateLimiter = cloudAPIRateLimiter(c.cloudAPIRateLimiters, o)
+
+	if !rotation {
+		if refreshErr := c.refreshShootCredentials(ctx, o, false); refreshErr != nil {
+			c.recorder.Eventf(shoot, corev1.EventTypeWarning, gardenv1beta1.ShootEventCredentialsRefreshError, "[%s] %s", operationID, refreshErr.Description)
+			return errors.New(refreshErr.Description)
+		}
+
+		c.recorder.Eventf(shoot, corev1.EventTypeNormal, gardenv1beta1.ShootEventCredentialsRefreshed, "[%s] Refreshed cloud provider credentials", operationID)
+		return nil
+	}
+
+	if rotateErr := c.refreshShootCredentials(ctx, o, true); rotateErr != nil {
+		c.recorder.Eventf(shoot, corev1.EventTypeWarning, gardenv1beta1.ShootEventCredentialsRotationError, "[%s] %s", operationID, rotateErr.Description)
+		return errors.New(rotateErr.Description)
+	}
+
+	c.recorder.Eventf(shoot, corev1.EventTypeNormal, gardenv1beta1.ShootEventCredentialsRotated, "[%s] Rotated cloud provider credentials", operationID)
+	return nil
+}
+
+// refreshShootCredentials redeploys the cloud provider credentials of the Shoot into its control plane, i.e. the
+// cloud provider secret and config, the machine class secrets, and the checksums of the components which consume
+// them. It does not touch any other part of the Shoot. The Terraform variables environment does not have to be
+// refreshed as it is computed from the current secret for every Terraformer run.
+// If <rotation> is true, the new credentials are verified by applying the infrastructure with them and by waiting
+// until the controllers consuming them are active again.
+func (c *defaultControl) refreshShootCredentials(ctx context.Context, o *operation.Operation, rotation bool) *gardencorev1alpha1.LastError {
+	botanist, err := botanistpkg.New(o)
+	if err != nil {
+		return formatError("Failed to create a Botanist", err)
+	}
+	seedCloudBotanist, err := cloudbotanistpkg.New(o, common.CloudPurposeSeed)
+	if err != nil {
+		return formatError("Failed to create a Seed CloudBotanist", err)
+	}
+	shootCloudBotanist, err := cloudbotanistpkg.New(o, common.CloudPurposeShoot)
+	if err != nil {
+		return formatError("Failed to create a Shoot CloudBotanist", err)
+	}
+	hybridBotanist, err := hybridbotanistpkg.New(o, botanist, seedCloudBotanist, shootCloudBotanist)
+	if err != nil {
+		return formatError("Failed to create a HybridBotanist", err)
+	}
+
+	var (
+		defaultInterval = 5 * time.Second
+		defaultTimeout  = 30 * time.Second
+		isCloud         = o.Shoot.Info.Spec.Cloud.Local == nil
+		usesCSI         = isCloud && o.Shoot.UsesCSI()
+
+		g                    = flow.NewGraph("Shoot cluster credentials refresh")
+		deployInfrastructure = g.Add(flow.Task{
+			Name: "Verifying credentials by deploying Shoot infrastructure",
+			Fn:   flow.TaskFn(shootCloudBotanist.DeployInfrastructure).DoIf(rotation && isCloud),
+		})
+		deployCloudProviderSecret = g.Add(flow.Task{
+			Name:         "Deploying cloud provider account secret",
+			Fn:           flow.SimpleTaskFn(botanist.DeployCloudProviderSecret).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(deployInfrastructure),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Refreshing machine class secrets",
+			Fn:           flow.SimpleTaskFn(hybridBotanist.RefreshMachineClassSecrets).DoIf(isCloud).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(deployCloudProviderSecret),
+		})
+		refreshCloudProviderConfig = g.Add(flow.Task{
+			Name:         "Refreshing cloud provider configuration",
+			Fn:           flow.SimpleTaskFn(hybridBotanist.RefreshCloudProviderConfig).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(deployCloudProviderSecret),
+		})
+		refreshCloudControllerManagerChecksums = g.Add(flow.Task{
+			Name:         "Refreshing cloud controller manager checksums",
+			Fn:           flow.SimpleTaskFn(botanist.RefreshCloudControllerManagerChecksums).DoIf(isCloud).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(deployCloudProviderSecret, refreshCloudProviderConfig),
+		})
+		refreshKubeControllerManagerChecksums = g.Add(flow.Task{
+			Name:         "Refreshing Kubernetes controller manager checksums",
+			Fn:           flow.SimpleTaskFn(botanist.RefreshKubeControllerManagerChecksums).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(deployCloudProviderSecret, refreshCloudProviderConfig),
+		})
+		deploySecrets = g.Add(flow.Task{
+			Name: "Deploying Shoot certificates / keys",
+			Fn:   flow.SimpleTaskFn(botanist.DeploySecrets).DoIf(usesCSI),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Refreshing CSI Controllers checksums",
+			Fn:           flow.SimpleTaskFn(hybridBotanist.RefreshCSIControllersChecksums).DoIf(usesCSI).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(deployCloudProviderSecret, deploySecrets, refreshCloudProviderConfig),
+		})
+		initializeShootClients = g.Add(flow.Task{
+			Name: "Initializing connection to Shoot",
+			Fn:   flow.SimpleTaskFn(botanist.InitializeShootClients).DoIf(rotation).RetryUntilTimeout(defaultInterval, 2*time.Minute),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Waiting until controllers using the new credentials are active",
+			Fn:           flow.SimpleTaskFn(botanist.WaitForControllersToBeActive).DoIf(rotation).RetryUntilTimeout(defaultInterval, 5*time.Minute),
+			Dependencies: flow.NewTaskIDs(initializeShootClients, refreshCloudControllerManagerChecksums, refreshKubeControllerManagerChecksums),
+		})
+		f = g.Compile()
+	)
+
+	tracker, untrack := c.trackFlow(o.Shoot.Info)
+	defer untrack()
+
+	if err := f.Run(flow.Opts{Logger: o.Logger, Context: ctx, Tracker: tracker}); err != nil {
+		o.Logger.Errorf("Failed to refresh the credentials of Shoot %q: %+v", o.Shoot.Info.Name, err)
+
+		return &gardencorev1alpha1.LastError{
+			Codes:       gardencorev1alpha1helper.ExtractErrorCodes(flow.Causes(err)),
+			Description: fmt.Sprintf("Failed to refresh the cloud provider credentials: %s", gardencorev1alpha1helper.FormatLastErrDescription(err)),
+		}
+	}
+
+	o.Logger.Infof("Successfully refreshed the cloud provider credentials of Shoot %q", o.Shoot.Info.Name)
+	return nil
+}
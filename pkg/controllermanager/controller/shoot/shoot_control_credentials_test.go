@@ -0,0 +1,192 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot_test
+
+import (
+	"context"
+	"errors"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot"
+	mockkubernetes "github.com/gardener/gardener/pkg/mock/gardener/kubernetes"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubecorev1listers "k8s.io/client-go/listers/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Shoot credentials control", func() {
+	var (
+		ctx = context.TODO()
+
+		ctrl                  *gomock.Controller
+		k8sGardenClient       *mockkubernetes.MockInterface
+		c                     client.Client
+		control               *fakeControl
+		shootCredentialsQueue *fakeQueue
+		secretBinding         *gardenv1beta1.SecretBinding
+		s                     *gardenv1beta1.Shoot
+
+		newController = func() *shoot.Controller {
+			c = fake.NewFakeClientWithScheme(kubernetes.GardenScheme, secretBinding.DeepCopy())
+			k8sGardenClient.EXPECT().Client().Return(c).AnyTimes()
+
+			return shoot.NewCredentialsTestController(
+				k8sGardenClient,
+				gardenlisters.NewShootLister(newIndexer(s)),
+				gardenlisters.NewSecretBindingLister(newIndexer(secretBinding)),
+				kubecorev1listers.NewNamespaceLister(newIndexer()),
+				gardenlisters.NewProjectLister(newIndexer()),
+				control,
+				&fakeQueue{},
+				shootCredentialsQueue,
+			)
+		}
+
+		rotatedShoots = func() []string {
+			binding := &gardenv1beta1.SecretBinding{}
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: "garden-dev", Name: "binding"}, binding)).To(Succeed())
+			return binding.Rotation.Status.RotatedShoots
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		k8sGardenClient = mockkubernetes.NewMockInterface(ctrl)
+		control = &fakeControl{}
+		shootCredentialsQueue = &fakeQueue{}
+		secretBinding = &gardenv1beta1.SecretBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "binding", Namespace: "garden-dev"},
+			SecretRef:  corev1.SecretReference{Name: "secret", Namespace: "garden-dev"},
+		}
+		s = newShoot("foo", "binding")
+		s.Status.LastOperation = &gardencorev1alpha1.LastOperation{
+			Type:  gardencorev1alpha1.LastOperationTypeReconcile,
+			State: gardencorev1alpha1.LastOperationStateSucceeded,
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#reconcileShootCredentialsKey", func() {
+		It("should do nothing if the Shoot has been deleted", func() {
+			Expect(newController().ExportReconcileShootCredentialsKey(ctx, "garden-dev/bar")).To(Succeed())
+
+			Expect(control.refreshed).To(BeEmpty())
+			Expect(shootCredentialsQueue.addedAfter).To(BeEmpty())
+		})
+
+		It("should refresh the credentials of a reconciled Shoot", func() {
+			Expect(newController().ExportReconcileShootCredentialsKey(ctx, "garden-dev/foo")).To(Succeed())
+
+			Expect(control.refreshed).To(ConsistOf("foo"))
+			Expect(control.rotated).To(BeEmpty())
+		})
+
+		It("should release the operation lock after the refresh", func() {
+			control.err = errors.New("fake")
+			controller := newController()
+
+			Expect(controller.ExportReconcileShootCredentialsKey(ctx, "garden-dev/foo")).To(MatchError("fake"))
+
+			unlock, ok := controller.ExportTryLockOperation("garden-dev/foo")
+			Expect(ok).To(BeTrue())
+			unlock()
+		})
+
+		It("should postpone the refresh while another operation on the Shoot holds the lock", func() {
+			controller := newController()
+			unlock, ok := controller.ExportTryLockOperation("garden-dev/foo")
+			Expect(ok).To(BeTrue())
+			defer unlock()
+
+			Expect(controller.ExportReconcileShootCredentialsKey(ctx, "garden-dev/foo")).To(Succeed())
+
+			Expect(control.refreshed).To(BeEmpty())
+			Expect(shootCredentialsQueue.addedAfter).To(ConsistOf("garden-dev/foo"))
+		})
+
+		It("should postpone the refresh while an operation is running", func() {
+			s.Status.LastOperation.State = gardencorev1alpha1.LastOperationStateProcessing
+
+			Expect(newController().ExportReconcileShootCredentialsKey(ctx, "garden-dev/foo")).To(Succeed())
+
+			Expect(control.refreshed).To(BeEmpty())
+			Expect(shootCredentialsQueue.addedAfter).To(ConsistOf("garden-dev/foo"))
+		})
+
+		It("should not refresh the credentials of a Shoot which has not been created yet", func() {
+			s.Status.LastOperation = nil
+
+			Expect(newController().ExportReconcileShootCredentialsKey(ctx, "garden-dev/foo")).To(Succeed())
+
+			Expect(control.refreshed).To(BeEmpty())
+			Expect(shootCredentialsQueue.addedAfter).To(BeEmpty())
+		})
+
+		It("should not refresh the credentials read from Vault", func() {
+			secretBinding.VaultRef = &gardenv1beta1.SecretBindingVaultReference{}
+
+			Expect(newController().ExportReconcileShootCredentialsKey(ctx, "garden-dev/foo")).To(Succeed())
+
+			Expect(control.refreshed).To(BeEmpty())
+		})
+
+		Context("rotation", func() {
+			BeforeEach(func() {
+				secretBinding.Rotation = &gardenv1beta1.SecretBindingRotation{
+					SecretRef: corev1.SecretReference{Name: "new-secret", Namespace: "garden-dev"},
+					Status:    &gardenv1beta1.SecretBindingRotationStatus{Phase: gardenv1beta1.SecretBindingRotationPhaseRolling},
+				}
+			})
+
+			It("should rotate the credentials and mark the Shoot as rotated", func() {
+				Expect(newController().ExportReconcileShootCredentialsKey(ctx, "garden-dev/foo")).To(Succeed())
+
+				Expect(control.rotated).To(ConsistOf("foo"))
+				Expect(control.refreshed).To(BeEmpty())
+				Expect(rotatedShoots()).To(ConsistOf("foo"))
+			})
+
+			It("should not mark the Shoot as rotated if the rotation failed", func() {
+				control.err = errors.New("fake")
+
+				Expect(newController().ExportReconcileShootCredentialsKey(ctx, "garden-dev/foo")).To(MatchError("fake"))
+
+				Expect(rotatedShoots()).To(BeEmpty())
+			})
+
+			It("should mark a Shoot which is being deleted as rotated without rotating it", func() {
+				now := metav1.Now()
+				s.DeletionTimestamp = &now
+
+				Expect(newController().ExportReconcileShootCredentialsKey(ctx, "garden-dev/foo")).To(Succeed())
+
+				Expect(control.rotated).To(BeEmpty())
+				Expect(rotatedShoots()).To(ConsistOf("foo"))
+			})
+		})
+	})
+})
@@ -16,11 +16,14 @@ package shoot
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/operation"
 	botanistpkg "github.com/gardener/gardener/pkg/operation/botanist"
 	cloudbotanistpkg "github.com/gardener/gardener/pkg/operation/cloudbotanist"
@@ -36,12 +39,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
-	"k8s.io/client-go/util/retry"
 )
 
 // deleteShoot deletes a Shoot cluster entirely.
 // It receives a Garden object <garden> which stores the Shoot object.
-func (c *defaultControl) deleteShoot(o *operation.Operation) *gardencorev1alpha1.LastError {
+func (c *defaultControl) deleteShoot(ctx context.Context, o *operation.Operation) *gardencorev1alpha1.LastError {
 	// If the .status.uid field is empty, then we assume that there has never been any operation running for this Shoot
 	// cluster. This implies that there can not be any resource which we have to delete. We accept the deletion.
 	if len(o.Shoot.Info.Status.UID) == 0 {
@@ -76,6 +78,12 @@ func (c *defaultControl) deleteShoot(o *operation.Operation) *gardencorev1alpha1
 		return formatError("Failed to retrieve the Shoot namespace in the Seed cluster", err)
 	}
 
+	// If the Shoot has been annotated to be force-deleted then its cloud provider account or credentials are no longer
+	// available. We must not create the cloud botanists and skip all steps which require access to the infrastructure.
+	if helper.ShootWantsForceDeletion(o.Shoot.Info) {
+		return c.forceDeleteShoot(ctx, o, botanist)
+	}
+
 	seedCloudBotanist, err := cloudbotanistpkg.New(o, common.CloudPurposeSeed)
 	if err != nil {
 		return formatError("Failed to create a Seed CloudBotanist", err)
@@ -104,15 +112,15 @@ func (c *defaultControl) deleteShoot(o *operation.Operation) *gardencorev1alpha1
 		kubeAPIServerDeploymentFound = false
 	}
 
-	internalDNSMigrationNeeded, err := c.needsDNSMigration(o, common.TerraformerPurposeInternalDNSDeprecated)
+	internalDNSMigrationNeeded, err := c.needsDNSMigration(ctx, o, common.TerraformerPurposeInternalDNSDeprecated)
 	if err != nil {
 		return formatError("Failed to check whether internal DNS migration is needed", err)
 	}
-	externalDNSMigrationNeeded, err := c.needsDNSMigration(o, common.TerraformerPurposeExternalDNSDeprecated)
+	externalDNSMigrationNeeded, err := c.needsDNSMigration(ctx, o, common.TerraformerPurposeExternalDNSDeprecated)
 	if err != nil {
 		return formatError("Failed to check whether external DNS migration is needed", err)
 	}
-	ingressDNSMigrationNeeded, err := c.needsDNSMigration(o, common.TerraformerPurposeIngressDNSDeprecated)
+	ingressDNSMigrationNeeded, err := c.needsDNSMigration(ctx, o, common.TerraformerPurposeIngressDNSDeprecated)
 	if err != nil {
 		return formatError("Failed to check whether ingress DNS migration is needed", err)
 	}
@@ -262,14 +270,24 @@ func (c *defaultControl) deleteShoot(o *operation.Operation) *gardencorev1alpha1
 		})
 		destroyKube2IAMResources = g.Add(flow.Task{
 			Name:         "Destroying Kube2IAM resources",
-			Fn:           flow.SimpleTaskFn(shootCloudBotanist.DestroyKube2IAMResources),
+			Fn:           flow.TaskFn(shootCloudBotanist.DestroyKube2IAMResources),
 			Dependencies: flow.NewTaskIDs(cleanKubernetesResources),
 		})
 		destroyInfrastructure = g.Add(flow.Task{
 			Name:         "Destroying Shoot infrastructure",
-			Fn:           flow.SimpleTaskFn(shootCloudBotanist.DestroyInfrastructure),
+			Fn:           flow.TaskFn(shootCloudBotanist.DestroyInfrastructure),
 			Dependencies: flow.NewTaskIDs(cleanKubernetesResources, destroyMachines),
 		})
+		verifyInfrastructureDestroyed = g.Add(flow.Task{
+			Name:         "Verifying that no Shoot infrastructure resources are left",
+			Fn:           verifyNoOrphanedInfrastructureResources(shootCloudBotanist),
+			Dependencies: flow.NewTaskIDs(destroyInfrastructure),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Recording use of the cloud provider credentials",
+			Fn:           flow.SimpleTaskFn(botanist.RecordCredentialsUse),
+			Dependencies: flow.NewTaskIDs(destroyInfrastructure),
+		})
 		destroyExternalDomainDNSRecord = g.Add(flow.Task{
 			Name:         "Destroying external domain DNS record",
 			Fn:           flow.TaskFn(botanist.DestroyExternalDomainDNSRecord),
@@ -281,6 +299,7 @@ func (c *defaultControl) deleteShoot(o *operation.Operation) *gardencorev1alpha1
 			destroyNginxIngressResources,
 			destroyKube2IAMResources,
 			destroyInfrastructure,
+			verifyInfrastructureDestroyed,
 			destroyExternalDomainDNSRecord,
 		)
 
@@ -312,9 +331,14 @@ func (c *defaultControl) deleteShoot(o *operation.Operation) *gardencorev1alpha1
 
 		f = g.Compile()
 	)
+	tracker, untrack := c.trackFlow(o.Shoot.Info)
+	defer untrack()
+
 	err = f.Run(flow.Opts{
 		Logger:           o.Logger,
 		ProgressReporter: o.ReportShootProgress,
+		Context:          ctx,
+		Tracker:          tracker,
 	})
 	if err != nil {
 		o.Logger.Errorf("Error deleting Shoot %q: %+v", o.Shoot.Info.Name, err)
@@ -335,57 +359,60 @@ func (c *defaultControl) updateShootStatusDeleteStart(o *operation.Operation) er
 		now    = metav1.Now()
 	)
 
-	newShoot, err := kutil.TryUpdateShootStatus(c.k8sGardenClient.Garden(), retry.DefaultRetry, o.Shoot.Info.ObjectMeta,
-		func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
-			if status.RetryCycleStartTime == nil || (status.LastOperation != nil && status.LastOperation.Type != gardencorev1alpha1.LastOperationTypeDelete) {
-				shoot.Status.RetryCycleStartTime = &now
-			}
-			if len(status.TechnicalID) == 0 {
-				shoot.Status.TechnicalID = o.Shoot.SeedNamespace
-			}
-
-			shoot.Status.Gardener = *o.GardenerInfo
-			shoot.Status.ObservedGeneration = o.Shoot.Info.Generation
-			shoot.Status.LastOperation = &gardencorev1alpha1.LastOperation{
-				Type:           gardencorev1alpha1.LastOperationTypeDelete,
-				State:          gardencorev1alpha1.LastOperationStateProcessing,
-				Progress:       1,
-				Description:    "Deletion of Shoot cluster in progress.",
-				LastUpdateTime: now,
-			}
-			return shoot, nil
-		})
-	if err == nil {
-		o.Shoot.Info = newShoot
+	newShoot := o.Shoot.Info.DeepCopy()
+	if err := kutil.TryUpdateStatus(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), newShoot, func() error {
+		if status.RetryCycleStartTime == nil || o.Shoot.Info.Generation != status.ObservedGeneration || (status.LastOperation != nil && status.LastOperation.Type != gardencorev1alpha1.LastOperationTypeDelete) {
+			newShoot.Status.RetryCycleStartTime = &now
+			newShoot.Status.RetryCount = 0
+		}
+		if len(status.TechnicalID) == 0 {
+			newShoot.Status.TechnicalID = o.Shoot.SeedNamespace
+		}
+
+		newShoot.Status.Gardener = *o.GardenerInfo
+		newShoot.Status.ObservedGeneration = o.Shoot.Info.Generation
+		newShoot.Status.LastOperation = &gardencorev1alpha1.LastOperation{
+			Type:           gardencorev1alpha1.LastOperationTypeDelete,
+			State:          gardencorev1alpha1.LastOperationStateProcessing,
+			Progress:       1,
+			Description:    "Deletion of Shoot cluster in progress.",
+			LastUpdateTime: now,
+		}
+		return nil
+	}); err != nil {
+		return err
 	}
-	return err
+	o.Shoot.Info = newShoot
+	return nil
 }
 
 func (c *defaultControl) updateShootStatusDeleteSuccess(o *operation.Operation) error {
-	newShoot, err := kutil.TryUpdateShootStatus(c.k8sGardenClient.Garden(), retry.DefaultRetry, o.Shoot.Info.ObjectMeta,
-		func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
-			shoot.Status.RetryCycleStartTime = nil
-			shoot.Status.LastError = nil
-			shoot.Status.LastOperation = &gardencorev1alpha1.LastOperation{
-				Type:           gardencorev1alpha1.LastOperationTypeDelete,
-				State:          gardencorev1alpha1.LastOperationStateSucceeded,
-				Progress:       100,
-				Description:    "Shoot cluster has been successfully deleted.",
-				LastUpdateTime: metav1.Now(),
-			}
-			return shoot, nil
-		})
-	if err != nil {
+	newShoot := o.Shoot.Info.DeepCopy()
+	if err := kutil.TryUpdateStatus(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), newShoot, func() error {
+		newShoot.Status.RetryCycleStartTime = nil
+		newShoot.Status.RetryCount = 0
+		newShoot.Status.LastError = nil
+		newShoot.Status.LastOperation = &gardencorev1alpha1.LastOperation{
+			Type:           gardencorev1alpha1.LastOperationTypeDelete,
+			State:          gardencorev1alpha1.LastOperationStateSucceeded,
+			Progress:       100,
+			Description:    "Shoot cluster has been successfully deleted.",
+			LastUpdateTime: metav1.Now(),
+		}
+		return nil
+	}); err != nil {
 		return err
 	}
 	o.Shoot.Info = newShoot
 
 	// Remove finalizer
-	finalizers := sets.NewString(o.Shoot.Info.Finalizers...)
-	finalizers.Delete(gardenv1beta1.GardenerName)
-	o.Shoot.Info.Finalizers = finalizers.List()
-	newShoot, err = c.k8sGardenClient.Garden().GardenV1beta1().Shoots(o.Shoot.Info.Namespace).Update(o.Shoot.Info)
-	if err != nil {
+	newShoot = o.Shoot.Info.DeepCopy()
+	if err := kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), newShoot, func() error {
+		finalizers := sets.NewString(newShoot.Finalizers...)
+		finalizers.Delete(gardenv1beta1.GardenerName)
+		newShoot.Finalizers = finalizers.List()
+		return nil
+	}); err != nil {
 		return err
 	}
 	o.Shoot.Info = newShoot
@@ -414,46 +441,67 @@ func (c *defaultControl) updateShootStatusDeleteError(o *operation.Operation, la
 		description = lastError.Description
 	)
 
-	newShoot, err := kutil.TryUpdateShootStatus(c.k8sGardenClient.Garden(), retry.DefaultRetry, o.Shoot.Info.ObjectMeta,
-		func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
-			if !utils.TimeElapsed(shoot.Status.RetryCycleStartTime, c.config.Controllers.Shoot.RetryDuration.Duration) {
-				description += " Operation will be retried."
-				state = gardencorev1alpha1.LastOperationStateError
-			} else {
-				shoot.Status.RetryCycleStartTime = nil
-			}
-
-			shoot.Status.Gardener = *o.GardenerInfo
-			shoot.Status.LastError = lastError
-			shoot.Status.LastOperation.Type = gardencorev1alpha1.LastOperationTypeDelete
-			shoot.Status.LastOperation.State = state
-			shoot.Status.LastOperation.Description = description
-			shoot.Status.LastOperation.LastUpdateTime = metav1.Now()
-			return shoot, nil
-		},
-	)
-	if err == nil {
+	newShoot := o.Shoot.Info.DeepCopy()
+	if err := kutil.TryUpdateStatus(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), newShoot, func() error {
+		state, description = gardencorev1alpha1.LastOperationStateFailed, lastError.Description
+
+		newShoot.Status.RetryCount++
+		if mayRetry(c.config.Controllers.Shoot, newShoot.Status, lastError) {
+			description += " Operation will be retried."
+			state = gardencorev1alpha1.LastOperationStateError
+		} else {
+			newShoot.Status.RetryCycleStartTime = nil
+		}
+
+		newShoot.Status.Gardener = *o.GardenerInfo
+		newShoot.Status.LastError = lastError
+		newShoot.Status.LastOperation.Type = gardencorev1alpha1.LastOperationTypeDelete
+		newShoot.Status.LastOperation.State = state
+		newShoot.Status.LastOperation.Description = description
+		newShoot.Status.LastOperation.LastUpdateTime = metav1.Now()
+		return nil
+	}); err == nil {
 		o.Shoot.Info = newShoot
 	}
 	o.Logger.Error(description)
 
-	newShootAfterLabel, err := kutil.TryUpdateShootLabels(c.k8sGardenClient.Garden(), retry.DefaultRetry, o.Shoot.Info.ObjectMeta, StatusLabelTransform(StatusUnhealthy))
-	if err == nil {
-		o.Shoot.Info = newShootAfterLabel
+	newShootAfterLabel := o.Shoot.Info.DeepCopy()
+	if err := kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), newShootAfterLabel, func() error {
+		_, err := StatusLabelTransform(StatusUnhealthy)(newShootAfterLabel)
+		return err
+	}); err != nil {
+		return state, err
 	}
-	return state, err
+	o.Shoot.Info = newShootAfterLabel
+	return state, nil
 }
 
-func (c *defaultControl) needsDNSMigration(o *operation.Operation, terraformerPurpose string) (bool, error) {
+func (c *defaultControl) needsDNSMigration(ctx context.Context, o *operation.Operation, terraformerPurpose string) (bool, error) {
 	tf, err := o.NewShootTerraformer(terraformerPurpose)
 	if err != nil {
 		return false, err
 	}
 
-	configExists, err := tf.ConfigExists()
+	configExists, err := tf.ConfigExists(ctx)
 	if err != nil {
 		return false, err
 	}
 
 	return configExists, nil
 }
+
+// verifyNoOrphanedInfrastructureResources returns a task function which fails as long as cloud resources of the
+// Shoot exist which are not contained in the Terraform state (anymore). Such resources would otherwise be leaked
+// when the Shoot is gone.
+func verifyNoOrphanedInfrastructureResources(cloudBotanist cloudbotanistpkg.CloudBotanist) flow.TaskFn {
+	return func(ctx context.Context) error {
+		orphanedResources, err := cloudBotanist.ListOrphanedInfrastructureResources(ctx)
+		if err != nil {
+			return err
+		}
+		if len(orphanedResources) > 0 {
+			return fmt.Errorf("infrastructure resources are left which are not contained in the Terraform state and must be deleted manually: %s", strings.Join(orphanedResources, ", "))
+		}
+		return nil
+	}
+}
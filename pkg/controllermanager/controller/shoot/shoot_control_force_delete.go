@@ -0,0 +1,130 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+// forceDeleteShoot deletes a Shoot cluster whose cloud provider account or credentials are no longer available.
+// It does not try to destroy any infrastructure resources or machines but records them in a config map in the Garden
+// cluster and removes the finalizers of the machine resources in the Seed cluster. Afterwards, all resources of the
+// Shoot in the Seed and Garden clusters are deleted like in the regular deletion flow.
+func (c *defaultControl) forceDeleteShoot(ctx context.Context, o *operation.Operation, botanist *botanistpkg.Botanist) *gardencorev1alpha1.LastError {
+	o.Logger.Warnf("Shoot is annotated with %q, skipping the destruction of its infrastructure", common.ShootForceDelete)
+
+	var (
+		defaultInterval = 5 * time.Second
+		defaultTimeout  = 30 * time.Second
+
+		g = flow.NewGraph("Shoot cluster force deletion")
+
+		recordOrphanedResources = g.Add(flow.Task{
+			Name: "Recording orphaned infrastructure resources",
+			Fn:   flow.TaskFn(botanist.RecordOrphanedResources).RetryUntilTimeout(defaultInterval, defaultTimeout),
+		})
+		deleteSeedMonitoring = g.Add(flow.Task{
+			Name: "Deleting Shoot monitoring stack in Seed",
+			Fn:   flow.SimpleTaskFn(botanist.DeleteSeedMonitoring).RetryUntilTimeout(defaultInterval, defaultTimeout),
+		})
+		deleteKubeAddonManager = g.Add(flow.Task{
+			Name: "Deleting Kubernetes addon manager",
+			Fn:   flow.SimpleTaskFn(botanist.DeleteKubeAddonManager).RetryUntilTimeout(defaultInterval, defaultTimeout),
+		})
+		deleteClusterAutoscaler = g.Add(flow.Task{
+			Name: "Deleting cluster autoscaler",
+			Fn:   flow.SimpleTaskFn(botanist.DeleteClusterAutoscaler).RetryUntilTimeout(defaultInterval, defaultTimeout),
+		})
+		finalizeMachineResources = g.Add(flow.Task{
+			Name:         "Removing finalizers of machine resources",
+			Fn:           flow.TaskFn(botanist.FinalizeMachineResources).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(recordOrphanedResources, deleteClusterAutoscaler),
+		})
+		deleteKubeAPIServer = g.Add(flow.Task{
+			Name:         "Deleting Kubernetes API server",
+			Fn:           flow.SimpleTaskFn(botanist.DeleteKubeAPIServer).Retry(defaultInterval),
+			Dependencies: flow.NewTaskIDs(deleteKubeAddonManager, finalizeMachineResources),
+		})
+		destroyNginxIngressResources = g.Add(flow.Task{
+			Name: "Destroying ingress DNS record",
+			Fn:   flow.TaskFn(botanist.DestroyIngressDNSRecord),
+		})
+		destroyExternalDomainDNSRecord = g.Add(flow.Task{
+			Name:         "Destroying external domain DNS record",
+			Fn:           flow.TaskFn(botanist.DestroyExternalDomainDNSRecord),
+			Dependencies: flow.NewTaskIDs(destroyNginxIngressResources),
+		})
+		deleteBackupInfrastructure = g.Add(flow.Task{
+			Name:         "Deleting backup infrastructure",
+			Fn:           flow.SimpleTaskFn(botanist.DeleteBackupInfrastructure),
+			Dependencies: flow.NewTaskIDs(deleteKubeAPIServer),
+		})
+		syncPoint = flow.NewTaskIDs(
+			deleteSeedMonitoring,
+			deleteKubeAPIServer,
+			destroyNginxIngressResources,
+			destroyExternalDomainDNSRecord,
+		)
+		destroyInternalDomainDNSRecord = g.Add(flow.Task{
+			Name:         "Destroying internal domain DNS record",
+			Fn:           flow.TaskFn(botanist.DestroyInternalDomainDNSRecord),
+			Dependencies: flow.NewTaskIDs(syncPoint),
+		})
+		deleteNamespace = g.Add(flow.Task{
+			Name:         "Deleting Shoot namespace in Seed",
+			Fn:           flow.SimpleTaskFn(botanist.DeleteNamespace).Retry(defaultInterval),
+			Dependencies: flow.NewTaskIDs(syncPoint, destroyInternalDomainDNSRecord, deleteBackupInfrastructure),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Waiting until Shoot namespace in Seed has been deleted",
+			Fn:           flow.SimpleTaskFn(botanist.WaitUntilSeedNamespaceDeleted),
+			Dependencies: flow.NewTaskIDs(deleteNamespace),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Deleting Garden secrets",
+			Fn:           flow.SimpleTaskFn(botanist.DeleteGardenSecrets).Retry(defaultInterval),
+			Dependencies: flow.NewTaskIDs(deleteNamespace),
+		})
+
+		f = g.Compile()
+	)
+	tracker, untrack := c.trackFlow(o.Shoot.Info)
+	defer untrack()
+
+	if err := f.Run(flow.Opts{
+		Logger:           o.Logger,
+		ProgressReporter: o.ReportShootProgress,
+		Context:          ctx,
+		Tracker:          tracker,
+	}); err != nil {
+		o.Logger.Errorf("Error force-deleting Shoot %q: %+v", o.Shoot.Info.Name, err)
+
+		return &gardencorev1alpha1.LastError{
+			Codes:       gardencorev1alpha1helper.ExtractErrorCodes(flow.Causes(err)),
+			Description: gardencorev1alpha1helper.FormatLastErrDescription(err),
+		}
+	}
+
+	o.Logger.Infof("Successfully force-deleted Shoot %q, orphaned resources have been recorded in config map %q", o.Shoot.Info.Name, botanistpkg.OrphanedResourcesConfigMapName(o.Shoot.Info.Name))
+	return nil
+}
@@ -15,12 +15,14 @@
 package shoot
 
 import (
+	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
-	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	"github.com/gardener/gardener/pkg/operation"
 	botanistpkg "github.com/gardener/gardener/pkg/operation/botanist"
 	cloudbotanistpkg "github.com/gardener/gardener/pkg/operation/cloudbotanist"
@@ -30,13 +32,14 @@ import (
 	"github.com/gardener/gardener/pkg/utils/flow"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/util/retry"
 )
 
 // reconcileShoot reconciles the Shoot cluster's state.
 // It receives a Garden object <garden> which stores the Shoot object and the operation type.
-func (c *defaultControl) reconcileShoot(o *operation.Operation, operationType gardencorev1alpha1.LastOperationType) *gardencorev1alpha1.LastError {
+// No further tasks are started once the <stopCh> is closed, the running ones are not interrupted.
+func (c *defaultControl) reconcileShoot(ctx context.Context, stopCh <-chan struct{}, o *operation.Operation, operationType gardencorev1alpha1.LastOperationType) *gardencorev1alpha1.LastError {
 	// We create the botanists (which will do the actual work).
 	var botanist *botanistpkg.Botanist
 	if err := utils.Retry(10*time.Second, 10*time.Minute, func() (ok, severe bool, err error) {
@@ -65,15 +68,27 @@ func (c *defaultControl) reconcileShoot(o *operation.Operation, operationType ga
 		return formatError("Failed to check whether all required extensions exist", err)
 	}
 
+	wakingUp, err := botanist.IsWakingUp(context.TODO())
+	if err != nil {
+		return formatError("Failed to check whether the Shoot is waking up", err)
+	}
+
+	managesShootDNS, err := botanist.ManagesShootDNS(context.TODO())
+	if err != nil {
+		return formatError("Failed to check whether the DNS records of the Shoot are managed", err)
+	}
+	o.Shoot.DisableDNS = !managesShootDNS
+
 	var (
 		defaultTimeout                  = 30 * time.Second
 		defaultInterval                 = 5 * time.Second
-		managedExternalDNS              = o.Shoot.ExternalDomain != nil && o.Shoot.ExternalDomain.Provider != gardenv1beta1.DNSUnmanaged
-		managedInternalDNS              = o.Garden.InternalDomain != nil && o.Garden.InternalDomain.Provider != gardenv1beta1.DNSUnmanaged
+		managedExternalDNS              = managesShootDNS && o.Shoot.ExternalDomain != nil && o.Shoot.ExternalDomain.Provider != gardenv1beta1.DNSUnmanaged
+		managedInternalDNS              = managesShootDNS && o.Garden.InternalDomain != nil && o.Garden.InternalDomain.Provider != gardenv1beta1.DNSUnmanaged
 		isCloud                         = o.Shoot.Info.Spec.Cloud.Local == nil
 		creationPhase                   = operationType == gardencorev1alpha1.LastOperationTypeCreate
-		requireInfrastructureDeployment = creationPhase || controllerutils.HasTask(o.Shoot.Info.Annotations, common.ShootTaskDeployInfrastructure)
-		requireKube2IAMDeployment       = creationPhase || controllerutils.HasTask(o.Shoot.Info.Annotations, common.ShootTaskDeployKube2IAMResource)
+		requireInfrastructureDeployment = creationPhase || common.HasTask(o.Shoot.Info.Annotations, common.ShootTaskDeployInfrastructure) || metav1.HasAnnotation(o.Shoot.Info.ObjectMeta, common.ShootInfrastructureImports)
+		requireKube2IAMDeployment       = creationPhase || common.HasTask(o.Shoot.Info.Annotations, common.ShootTaskDeployKube2IAMResource)
+		restrictedKubeAPIServerAccess   = len(o.Shoot.GetKubeAPIServerSourceRanges(o.Seed.Info.Spec.Networks)) > 0
 
 		g               = flow.NewGraph("Shoot cluster reconciliation")
 		deployNamespace = g.Add(flow.Task{
@@ -85,6 +100,11 @@ func (c *defaultControl) reconcileShoot(o *operation.Operation, operationType ga
 			Fn:           flow.SimpleTaskFn(botanist.DeployCloudMetadataServiceNetworkPolicy).DoIf(isCloud).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			Dependencies: flow.NewTaskIDs(deployNamespace),
 		})
+		_ = g.Add(flow.Task{
+			Name:         "Deploying network policies for the Shoot control plane",
+			Fn:           flow.TaskFn(botanist.DeployNetworkPolicies).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(deployNamespace),
+		})
 		deployCloudProviderSecret = g.Add(flow.Task{
 			Name:         "Deploying cloud provider account secret",
 			Fn:           flow.SimpleTaskFn(botanist.DeployCloudProviderSecret).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -101,9 +121,11 @@ func (c *defaultControl) reconcileShoot(o *operation.Operation, operationType ga
 			Dependencies: flow.NewTaskIDs(deployKubeAPIServerService),
 		})
 		deploySecrets = g.Add(flow.Task{
-			Name:         "Deploying Shoot certificates / keys",
-			Fn:           flow.SimpleTaskFn(botanist.DeploySecrets),
-			Dependencies: flow.NewTaskIDs(deployNamespace),
+			Name: "Deploying Shoot certificates / keys",
+			Fn:   flow.SimpleTaskFn(botanist.DeploySecrets),
+			// The kubeconfigs and the certificate of the kube-apiserver refer to its load balancer if the DNS records of
+			// the Shoot are not managed.
+			Dependencies: flow.NewTaskIDs(deployNamespace, waitUntilKubeAPIServerServiceIsReady),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Deploying internal domain DNS record",
@@ -115,10 +137,30 @@ func (c *defaultControl) reconcileShoot(o *operation.Operation, operationType ga
 			Fn:           flow.TaskFn(botanist.DeployExternalDomainDNSRecord).DoIf(managedExternalDNS),
 			Dependencies: flow.NewTaskIDs(deployNamespace),
 		})
+		deleteMachinesOfRemovedZones = g.Add(flow.Task{
+			Name:         "Deleting Shoot workers of removed zones",
+			Fn:           flow.SimpleTaskFn(hybridBotanist.DeleteMachinesOfRemovedZones).DoIf(isCloud && requireInfrastructureDeployment && !creationPhase).SkipIf(o.Shoot.IsHibernated),
+			Dependencies: flow.NewTaskIDs(deployNamespace),
+		})
 		deployInfrastructure = g.Add(flow.Task{
 			Name:         "Deploying Shoot infrastructure",
-			Fn:           flow.SimpleTaskFn(shootCloudBotanist.DeployInfrastructure).DoIf(requireInfrastructureDeployment),
-			Dependencies: flow.NewTaskIDs(deploySecrets, deployCloudProviderSecret),
+			Fn:           flow.TaskFn(shootCloudBotanist.DeployInfrastructure).DoIf(requireInfrastructureDeployment),
+			Dependencies: flow.NewTaskIDs(deploySecrets, deployCloudProviderSecret, deleteMachinesOfRemovedZones),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Recording use of the cloud provider credentials",
+			Fn:           flow.SimpleTaskFn(botanist.RecordCredentialsUse).DoIf(isCloud && requireInfrastructureDeployment),
+			Dependencies: flow.NewTaskIDs(deployInfrastructure),
+		})
+		updateShootEgressIPs = g.Add(flow.Task{
+			Name:         "Updating Shoot egress IPs",
+			Fn:           flow.SimpleTaskFn(botanist.UpdateShootEgressIPs).DoIf(isCloud),
+			Dependencies: flow.NewTaskIDs(deployInfrastructure),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Allowing Shoot egress IPs to access the Kubernetes API server service",
+			Fn:           flow.SimpleTaskFn(hybridBotanist.DeployKubeAPIServerService).DoIf(isCloud && restrictedKubeAPIServerAccess).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(updateShootEgressIPs, deployKubeAPIServerService),
 		})
 		deployBackupInfrastructure = g.Add(flow.Task{
 			Name: "Deploying backup infrastructure",
@@ -193,17 +235,37 @@ func (c *defaultControl) reconcileShoot(o *operation.Operation, operationType ga
 			Fn:           flow.SimpleTaskFn(hybridBotanist.ComputeShootOperatingSystemConfig).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			Dependencies: flow.NewTaskIDs(initializeShootClients, deployInfrastructure),
 		})
+		relaxProblematicWebhooks = g.Add(flow.Task{
+			Name:         "Relaxing webhooks which would block the wake-up",
+			Fn:           flow.SimpleTaskFn(botanist.RelaxProblematicWebhooks).DoIf(wakingUp).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(initializeShootClients),
+		})
+		migrateStorageClasses = g.Add(flow.Task{
+			Name:         "Migrating storage classes with a changed provisioner",
+			Fn:           flow.TaskFn(hybridBotanist.MigrateStorageClasses).RetryUntilTimeout(defaultInterval, defaultTimeout).SkipIf(o.Shoot.IsHibernated),
+			Dependencies: flow.NewTaskIDs(initializeShootClients),
+		})
+		protectStorageClasses = g.Add(flow.Task{
+			Name:         "Protecting storage classes which are still in use",
+			Fn:           flow.TaskFn(hybridBotanist.ProtectStorageClasses).RetryUntilTimeout(defaultInterval, defaultTimeout).SkipIf(o.Shoot.IsHibernated),
+			Dependencies: flow.NewTaskIDs(initializeShootClients),
+		})
+		waitUntilCloudControllerManagerIsActive = g.Add(flow.Task{
+			Name:         "Waiting until the out-of-tree cloud controller manager is active",
+			Fn:           flow.SimpleTaskFn(botanist.WaitForControllersToBeActive).DoIf(isCloud && o.Shoot.UsesOutOfTreeCloudControllerManager()).SkipIf(o.Shoot.IsHibernated),
+			Dependencies: flow.NewTaskIDs(initializeShootClients, deployCloudControllerManager, deployKubeControllerManager),
+		})
 		deployKubeAddonManager = g.Add(flow.Task{
 			Name:         "Deploying Kubernetes addon manager",
 			Fn:           flow.SimpleTaskFn(hybridBotanist.DeployKubeAddonManager).RetryUntilTimeout(defaultInterval, defaultTimeout).SkipIf(o.Shoot.IsHibernated),
-			Dependencies: flow.NewTaskIDs(initializeShootClients, deployInfrastructure, computeShootOSConfig),
+			Dependencies: flow.NewTaskIDs(initializeShootClients, deployInfrastructure, computeShootOSConfig, relaxProblematicWebhooks, migrateStorageClasses, protectStorageClasses, waitUntilCloudControllerManagerIsActive),
 		})
 		deployMachineControllerManager = g.Add(flow.Task{
 			Name:         "Deploying machine controller manager",
 			Fn:           flow.SimpleTaskFn(botanist.DeployMachineControllerManager).DoIf(isCloud).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			Dependencies: flow.NewTaskIDs(initializeShootClients, deployKubeAddonManager),
 		})
-		deployCSIControllers = g.Add(flow.Task{
+		_ = g.Add(flow.Task{
 			Name:         "Deploying CSI controllers",
 			Fn:           flow.SimpleTaskFn(hybridBotanist.DeployCSIControllers).DoIf(isCloud).RetryUntilTimeout(defaultInterval, defaultTimeout).DoIf(o.Shoot.UsesCSI()),
 			Dependencies: flow.NewTaskIDs(initializeShootClients, deployKubeAddonManager),
@@ -211,11 +273,11 @@ func (c *defaultControl) reconcileShoot(o *operation.Operation, operationType ga
 		reconcileMachines = g.Add(flow.Task{
 			Name:         "Reconciling Shoot workers",
 			Fn:           flow.SimpleTaskFn(hybridBotanist.ReconcileMachines).DoIf(isCloud).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			Dependencies: flow.NewTaskIDs(computeShootOSConfig, deployMachineControllerManager, deployInfrastructure, initializeShootClients),
+			Dependencies: flow.NewTaskIDs(computeShootOSConfig, deployMachineControllerManager, deployInfrastructure, initializeShootClients, relaxProblematicWebhooks),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Deploying Kube2IAM resources",
-			Fn:           flow.SimpleTaskFn(shootCloudBotanist.DeployKube2IAMResources).DoIf(requireKube2IAMDeployment).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Fn:           flow.TaskFn(shootCloudBotanist.DeployKube2IAMResources).DoIf(requireKube2IAMDeployment).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			Dependencies: flow.NewTaskIDs(deployInfrastructure),
 		})
 		_ = g.Add(flow.Task{
@@ -226,22 +288,32 @@ func (c *defaultControl) reconcileShoot(o *operation.Operation, operationType ga
 		waitUntilVPNConnectionExists = g.Add(flow.Task{
 			Name:         "Waiting until the Kubernetes API server can connect to the Shoot workers",
 			Fn:           flow.SimpleTaskFn(botanist.WaitUntilVPNConnectionExists).SkipIf(o.Shoot.IsHibernated),
-			Dependencies: flow.NewTaskIDs(deployKubeAddonManager, reconcileMachines, deployCSIControllers),
+			Dependencies: flow.NewTaskIDs(deployKubeAddonManager, reconcileMachines),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Restoring webhooks relaxed during the wake-up",
+			Fn:           flow.SimpleTaskFn(botanist.RestoreProblematicWebhooks).SkipIf(o.Shoot.IsHibernated).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(waitUntilVPNConnectionExists),
+		})
+		deployDashboardOIDCProxy = g.Add(flow.Task{
+			Name:         "Deploying OIDC proxy for the Shoot dashboards",
+			Fn:           flow.TaskFn(botanist.DeployDashboardOIDCProxy).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(deploySecrets),
 		})
 		deploySeedMonitoring = g.Add(flow.Task{
 			Name:         "Deploying Shoot monitoring stack in Seed",
 			Fn:           flow.SimpleTaskFn(botanist.DeploySeedMonitoring).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerIsReady, initializeShootClients, waitUntilVPNConnectionExists, reconcileMachines),
+			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerIsReady, initializeShootClients, deployDashboardOIDCProxy),
 		})
 		deploySeedLogging = g.Add(flow.Task{
 			Name:         "Deploying shoot logging stack in Seed",
 			Fn:           flow.SimpleTaskFn(botanist.DeploySeedLogging).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerIsReady, initializeShootClients, waitUntilVPNConnectionExists, reconcileMachines),
+			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerIsReady, initializeShootClients, deployDashboardOIDCProxy),
 		})
 		deployClusterAutoscaler = g.Add(flow.Task{
 			Name:         "Deploying cluster autoscaler",
 			Fn:           flow.SimpleTaskFn(botanist.DeployClusterAutoscaler).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			Dependencies: flow.NewTaskIDs(reconcileMachines, deployKubeAddonManager, deploySeedMonitoring),
+			Dependencies: flow.NewTaskIDs(reconcileMachines, deployKubeAddonManager),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Deploying Cert-Broker",
@@ -261,7 +333,15 @@ func (c *defaultControl) reconcileShoot(o *operation.Operation, operationType ga
 		f = g.Compile()
 	)
 
-	err = f.Run(flow.Opts{Logger: o.Logger, ProgressReporter: o.ReportShootProgress})
+	lastKubeconfigRotationTime := kubeconfigRotationTime(o.Shoot.Info)
+
+	tracker, untrack := c.trackFlow(o.Shoot.Info)
+	defer untrack()
+
+	err = f.Run(flow.Opts{Logger: o.Logger, ProgressReporter: o.ReportShootProgress, Context: ctx, StopCh: stopCh, Tracker: tracker})
+	if rotationTime := kubeconfigRotationTime(o.Shoot.Info); rotationTime != nil && !rotationTime.Equal(lastKubeconfigRotationTime) {
+		c.recorder.Event(o.Shoot.Info, corev1.EventTypeNormal, gardenv1beta1.ShootEventKubeconfigRotated, "Kubeconfig has been issued with new credentials")
+	}
 	if err != nil {
 		o.Logger.Errorf("Failed to reconcile Shoot %q: %+v", o.Shoot.Info.Name, err)
 
@@ -284,6 +364,18 @@ func (c *defaultControl) reconcileShoot(o *operation.Operation, operationType ga
 		}
 	}
 
+	// Report cloud resources which have been created for the Shoot but which are no longer managed by Terraform
+	// (e.g., left-overs of failed destroy runs), as they would otherwise silently cause costs.
+	orphanedResources, err := shootCloudBotanist.ListOrphanedInfrastructureResources(ctx)
+	if err != nil {
+		o.Logger.Errorf("Could not detect orphaned infrastructure resources of Shoot %q: %+v", o.Shoot.Info.Name, err)
+	}
+	if len(orphanedResources) > 0 {
+		message := fmt.Sprintf("Found infrastructure resources which are not contained in the Terraform state and must be cleaned up manually: %s", strings.Join(orphanedResources, ", "))
+		o.Logger.Warn(message)
+		c.recorder.Event(o.Shoot.Info, corev1.EventTypeWarning, gardenv1beta1.ShootEventOrphanedInfrastructureResources, message)
+	}
+
 	o.Logger.Infof("Successfully reconciled Shoot %q", o.Shoot.Info.Name)
 	return nil
 }
@@ -295,33 +387,34 @@ func (c *defaultControl) updateShootStatusReconcile(o *operation.Operation, oper
 		observedGeneration = o.Shoot.Info.Generation
 	)
 
-	newShoot, err := kutil.TryUpdateShootStatus(c.k8sGardenClient.Garden(), retry.DefaultRetry, o.Shoot.Info.ObjectMeta,
-		func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
-			if len(status.UID) == 0 {
-				shoot.Status.UID = shoot.UID
-			}
-			if len(status.TechnicalID) == 0 {
-				shoot.Status.TechnicalID = o.Shoot.SeedNamespace
-			}
-			if retryCycleStartTime != nil {
-				shoot.Status.RetryCycleStartTime = retryCycleStartTime
-			}
+	newShoot := o.Shoot.Info.DeepCopy()
+	if err := kutil.TryUpdateStatus(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), newShoot, func() error {
+		if len(status.UID) == 0 {
+			newShoot.Status.UID = newShoot.UID
+		}
+		if len(status.TechnicalID) == 0 {
+			newShoot.Status.TechnicalID = o.Shoot.SeedNamespace
+		}
+		if retryCycleStartTime != nil {
+			newShoot.Status.RetryCycleStartTime = retryCycleStartTime
+			newShoot.Status.RetryCount = 0
+		}
 
-			shoot.Status.Gardener = *(o.GardenerInfo)
-			shoot.Status.ObservedGeneration = observedGeneration
-			shoot.Status.LastOperation = &gardencorev1alpha1.LastOperation{
-				Type:           operationType,
-				State:          state,
-				Progress:       1,
-				Description:    "Reconciliation of Shoot cluster state in progress.",
-				LastUpdateTime: now,
-			}
-			return shoot, nil
-		})
-	if err == nil {
-		o.Shoot.Info = newShoot
+		newShoot.Status.Gardener = *(o.GardenerInfo)
+		newShoot.Status.ObservedGeneration = observedGeneration
+		newShoot.Status.LastOperation = &gardencorev1alpha1.LastOperation{
+			Type:           operationType,
+			State:          state,
+			Progress:       1,
+			Description:    "Reconciliation of Shoot cluster state in progress.",
+			LastUpdateTime: now,
+		}
+		return nil
+	}); err != nil {
+		return err
 	}
-	return err
+	o.Shoot.Info = newShoot
+	return nil
 }
 
 func (c *defaultControl) updateShootStatusResetRetry(o *operation.Operation, operationType gardencorev1alpha1.LastOperationType) error {
@@ -332,6 +425,25 @@ func (c *defaultControl) updateShootStatusResetRetry(o *operation.Operation, ope
 func (c *defaultControl) updateShootStatusReconcileStart(o *operation.Operation, operationType gardencorev1alpha1.LastOperationType) error {
 	var retryCycleStartTime *metav1.Time
 
+	// The kube-apiserver of new Shoots verifies the kubelet serving certificates right away. The kubelets of existing
+	// Shoots are switched to serving certificates with this reconciliation, and the kube-apiserver verifies them once
+	// all nodes have received one, see common.ShootKubeletServingCertificates.
+	if !metav1.HasAnnotation(o.Shoot.Info.ObjectMeta, common.ShootKubeletServingCertificates) {
+		value := common.ShootKubeletServingCertificatesRollingOut
+		if operationType == gardencorev1alpha1.LastOperationTypeCreate {
+			value = "true"
+		}
+
+		newShoot := o.Shoot.Info.DeepCopy()
+		if err := kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), newShoot, func() error {
+			metav1.SetMetaDataAnnotation(&newShoot.ObjectMeta, common.ShootKubeletServingCertificates, value)
+			return nil
+		}); err != nil {
+			return err
+		}
+		o.Shoot.Info = newShoot
+	}
+
 	if o.Shoot.Info.Status.RetryCycleStartTime == nil || o.Shoot.Info.Generation != o.Shoot.Info.Status.ObservedGeneration {
 		now := metav1.Now()
 		retryCycleStartTime = &now
@@ -340,37 +452,40 @@ func (c *defaultControl) updateShootStatusReconcileStart(o *operation.Operation,
 	return c.updateShootStatusReconcile(o, operationType, gardencorev1alpha1.LastOperationStateProcessing, retryCycleStartTime)
 }
 
-func (c *defaultControl) updateShootStatusReconcileSuccess(o *operation.Operation, operationType gardencorev1alpha1.LastOperationType) error {
-	// Remove task list from Shoot annotations since reconciliation was successful.
-	newShoot, err := kutil.TryUpdateShootAnnotations(c.k8sGardenClient.Garden(), retry.DefaultRetry, o.Shoot.Info.ObjectMeta,
-		func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
-			controllerutils.RemoveAllTasks(shoot.Annotations)
-			return shoot, nil
-		})
-
-	if err != nil {
+func (c *defaultControl) updateShootStatusReconcileSuccess(o *operation.Operation, operationType gardencorev1alpha1.LastOperationType, reconciledStateHash string) error {
+	// Remove task list, infrastructure imports and the worker pool deletion confirmation from Shoot annotations since
+	// reconciliation was successful.
+	newShoot := o.Shoot.Info.DeepCopy()
+	if err := kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), newShoot, func() error {
+		common.RemoveAllTasks(newShoot.Annotations)
+		delete(newShoot.Annotations, common.ShootInfrastructureImports)
+		delete(newShoot.Annotations, common.ConfirmationWorkerPoolDeletion)
+		return nil
+	}); err != nil {
 		return err
 	}
 
-	newShoot, err = kutil.TryUpdateShootStatus(c.k8sGardenClient.Garden(), retry.DefaultRetry, newShoot.ObjectMeta,
-		func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
-			shoot.Status.RetryCycleStartTime = nil
-			shoot.Status.Seed = o.Seed.Info.Name
-			shoot.Status.LastError = nil
-			shoot.Status.LastOperation = &gardencorev1alpha1.LastOperation{
-				Type:           operationType,
-				State:          gardencorev1alpha1.LastOperationStateSucceeded,
-				Progress:       100,
-				Description:    "Shoot cluster state has been successfully reconciled.",
-				LastUpdateTime: metav1.Now(),
-			}
-			return shoot, nil
-		})
-
-	if err == nil {
-		o.Shoot.Info = newShoot
+	if err := kutil.TryUpdateStatus(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), newShoot, func() error {
+		newShoot.Status.RetryCycleStartTime = nil
+		newShoot.Status.RetryCount = 0
+		newShoot.Status.Seed = o.Seed.Info.Name
+		newShoot.Status.LastError = nil
+		newShoot.Status.KubeletVersion = o.Shoot.Info.Spec.Kubernetes.Version
+		newShoot.Status.ReconciledStateHash = reconciledStateHash
+		newShoot.Status.Hibernated = o.Shoot.IsHibernated
+		newShoot.Status.LastOperation = &gardencorev1alpha1.LastOperation{
+			Type:           operationType,
+			State:          gardencorev1alpha1.LastOperationStateSucceeded,
+			Progress:       100,
+			Description:    "Shoot cluster state has been successfully reconciled.",
+			LastUpdateTime: metav1.Now(),
+		}
+		return nil
+	}); err != nil {
+		return err
 	}
-	return err
+	o.Shoot.Info = newShoot
+	return nil
 }
 
 func (c *defaultControl) updateShootStatusReconcileError(o *operation.Operation, operationType gardencorev1alpha1.LastOperationType, lastError *gardencorev1alpha1.LastError) (gardencorev1alpha1.LastOperationState, error) {
@@ -379,41 +494,52 @@ func (c *defaultControl) updateShootStatusReconcileError(o *operation.Operation,
 		description   = lastError.Description
 		lastOperation = o.Shoot.Info.Status.LastOperation
 		progress      = 1
-		willRetry     = !utils.TimeElapsed(o.Shoot.Info.Status.RetryCycleStartTime, c.config.Controllers.Shoot.RetryDuration.Duration)
 	)
 
-	newShoot, err := kutil.TryUpdateShootStatus(c.k8sGardenClient.Garden(), retry.DefaultRetry, o.Shoot.Info.ObjectMeta,
-		func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
-			if willRetry {
-				description += " Operation will be retried."
-				state = gardencorev1alpha1.LastOperationStateError
-			} else {
-				shoot.Status.RetryCycleStartTime = nil
-			}
+	newShoot := o.Shoot.Info.DeepCopy()
+	if err := kutil.TryUpdateStatus(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), newShoot, func() error {
+		state, description = gardencorev1alpha1.LastOperationStateFailed, lastError.Description
 
-			if lastOperation != nil {
-				progress = lastOperation.Progress
-			}
+		newShoot.Status.RetryCount++
+		if mayRetry(c.config.Controllers.Shoot, newShoot.Status, lastError) {
+			description += " Operation will be retried."
+			state = gardencorev1alpha1.LastOperationStateError
+		} else {
+			newShoot.Status.RetryCycleStartTime = nil
+		}
 
-			shoot.Status.LastError = lastError
-			shoot.Status.LastOperation = &gardencorev1alpha1.LastOperation{
-				Type:           operationType,
-				State:          state,
-				Progress:       progress,
-				Description:    description,
-				LastUpdateTime: metav1.Now(),
-			}
-			shoot.Status.Gardener = *(o.GardenerInfo)
-			return shoot, nil
-		})
-	if err == nil {
+		if lastOperation != nil {
+			progress = lastOperation.Progress
+		}
+
+		newShoot.Status.LastError = lastError
+		newShoot.Status.LastOperation = &gardencorev1alpha1.LastOperation{
+			Type:           operationType,
+			State:          state,
+			Progress:       progress,
+			Description:    description,
+			LastUpdateTime: metav1.Now(),
+		}
+		newShoot.Status.Gardener = *(o.GardenerInfo)
+		return nil
+	}); err == nil {
 		o.Shoot.Info = newShoot
 	}
 
-	newShootAfterLabel, err := kutil.TryUpdateShootLabels(c.k8sGardenClient.Garden(), retry.DefaultRetry, o.Shoot.Info.ObjectMeta, StatusLabelTransform(StatusUnhealthy))
-	if err == nil {
-		o.Shoot.Info = newShootAfterLabel
+	newShootAfterLabel := o.Shoot.Info.DeepCopy()
+	if err := kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), newShootAfterLabel, func() error {
+		_, err := StatusLabelTransform(StatusUnhealthy)(newShootAfterLabel)
+		return err
+	}); err != nil {
+		return state, err
 	}
+	o.Shoot.Info = newShootAfterLabel
+	return state, nil
+}
 
-	return state, err
+func kubeconfigRotationTime(shoot *gardenv1beta1.Shoot) *metav1.Time {
+	if shoot.Status.AccessAudit == nil {
+		return nil
+	}
+	return shoot.Status.AccessAudit.LastKubeconfigRotationTime
 }
@@ -18,8 +18,6 @@ import (
 	"reflect"
 	"time"
 
-	garden "github.com/gardener/gardener/pkg/client/garden/clientset/versioned"
-
 	"github.com/sirupsen/logrus"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
@@ -27,6 +25,7 @@ import (
 	"github.com/robfig/cron"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func hibernationLogger(key string) logrus.FieldLogger {
@@ -86,7 +85,7 @@ func LocationLogger(logger logrus.FieldLogger, location *time.Location) logrus.F
 }
 
 // ComputeHibernationSchedule computes the HibernationSchedule for the given Shoot.
-func ComputeHibernationSchedule(client garden.Interface, logger logrus.FieldLogger, shoot *gardenv1beta1.Shoot) (HibernationSchedule, error) {
+func ComputeHibernationSchedule(client client.Client, logger logrus.FieldLogger, shoot *gardenv1beta1.Shoot) (HibernationSchedule, error) {
 	var (
 		schedules           = getShootHibernationSchedules(shoot)
 		locationToSchedules = GroupHibernationSchedulesByLocation(schedules)
@@ -215,7 +214,7 @@ func (c *Controller) reconcileShootHibernation(logger logrus.FieldLogger, key st
 		return nil
 	}
 
-	schedule, err := ComputeHibernationSchedule(c.k8sGardenClient.Garden(), logger, shoot)
+	schedule, err := ComputeHibernationSchedule(c.k8sGardenClient.Client(), logger, shoot)
 	if err != nil {
 		return err
 	}
@@ -14,12 +14,14 @@
 package shoot_test
 
 import (
+	"context"
 	"time"
 
-	mockgardenv1beta1 "github.com/gardener/gardener/pkg/mock/gardener/client/garden/clientset/versioned/typed/garden/v1beta1"
+	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-
-	mockgarden "github.com/gardener/gardener/pkg/mock/gardener/client/garden/clientset/versioned"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener/pkg/utils"
 
@@ -81,7 +83,7 @@ var _ = Describe("Shoot Hibernation", func() {
 		Describe("#ComputeHibernationSchedule", func() {
 			It("should compute a correct hibernation schedule", func() {
 				var (
-					c      = mockgarden.NewMockInterface(ctrl)
+					c      = mockclient.NewMockClient(ctrl)
 					logger = utils.NewNopLogger()
 					now    time.Time
 
@@ -224,11 +226,9 @@ var _ = Describe("Shoot Hibernation", func() {
 		Describe("#Run", func() {
 			It("should set the correct hibernation status", func() {
 				var (
-					c           = mockgarden.NewMockInterface(ctrl)
-					gardenIface = mockgardenv1beta1.NewMockGardenV1beta1Interface(ctrl)
-					shootIface  = mockgardenv1beta1.NewMockShootInterface(ctrl)
-					logger      = utils.NewNopLogger()
-					enabled     = true
+					c       = mockclient.NewMockClient(ctrl)
+					logger  = utils.NewNopLogger()
+					enabled = true
 
 					namespace = "foo"
 					name      = "bar"
@@ -245,17 +245,18 @@ var _ = Describe("Shoot Hibernation", func() {
 				)
 
 				gomock.InOrder(
-					c.EXPECT().GardenV1beta1().Return(gardenIface),
-					gardenIface.EXPECT().Shoots(namespace).Return(shootIface),
-					shootIface.EXPECT().Get(name, metav1.GetOptions{}).Return(&shoot, nil),
-
-					c.EXPECT().GardenV1beta1().Return(gardenIface),
-					gardenIface.EXPECT().Shoots(namespace).Return(shootIface),
-					shootIface.EXPECT().Update(gomock.AssignableToTypeOf(&gardenv1beta1.Shoot{})).Do(func(actual *gardenv1beta1.Shoot) {
-						Expect(actual.Spec.Hibernation).To(Equal(&gardenv1beta1.Hibernation{
-							Enabled: enabled,
-						}))
-					}),
+					c.EXPECT().Get(gomock.Any(), kutil.Key(namespace, name), gomock.AssignableToTypeOf(&gardenv1beta1.Shoot{})).
+						DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+							shoot.DeepCopyInto(obj.(*gardenv1beta1.Shoot))
+							return nil
+						}),
+					c.EXPECT().Update(gomock.Any(), gomock.AssignableToTypeOf(&gardenv1beta1.Shoot{})).
+						DoAndReturn(func(_ context.Context, obj runtime.Object) error {
+							Expect(obj.(*gardenv1beta1.Shoot).Spec.Hibernation).To(Equal(&gardenv1beta1.Hibernation{
+								Enabled: enabled,
+							}))
+							return nil
+						}),
 				)
 
 				job.Run()
@@ -0,0 +1,146 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation"
+	cloudbotanistpkg "github.com/gardener/gardener/pkg/operation/cloudbotanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+	"github.com/gardener/gardener/pkg/utils/ratelimiter"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/cache"
+)
+
+// infrastructureDriftCheckPeriod is the period in which the cloud resources of a Shoot are compared with the
+// Terraform state in order to find resources which are no longer managed by Terraform (e.g., left-overs of failed
+// destroy runs) and which would otherwise silently cause costs.
+const infrastructureDriftCheckPeriod = 1 * time.Hour
+
+func (c *Controller) shootInfrastructureDriftAdd(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		logger.Logger.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+	c.shootInfrastructureDriftQueue.Add(key)
+}
+
+func (c *Controller) reconcileShootInfrastructureDriftKey(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	shoot, err := c.shootLister.Shoots(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		logger.Logger.Debugf("[SHOOT INFRASTRUCTURE DRIFT] %s - skipping because Shoot has been deleted", key)
+		return nil
+	}
+	if err != nil {
+		logger.Logger.Infof("[SHOOT INFRASTRUCTURE DRIFT] %s - unable to retrieve object from store: %v", key, err)
+		return err
+	}
+	if !c.seedFilter(shoot) {
+		logger.Logger.Debugf("[SHOOT INFRASTRUCTURE DRIFT] %s - skipping because the Seed of the Shoot is not selected by my seed selector", key)
+		return nil
+	}
+
+	defer c.shootInfrastructureDriftQueue.AddAfter(key, infrastructureDriftCheckPeriod)
+
+	if !mustCheckInfrastructureDrift(shoot) {
+		return nil
+	}
+
+	orphanedResources, err := c.infrastructureDriftControl.ListOrphanedInfrastructureResources(ctx, shoot)
+	if err != nil {
+		logger.Logger.Infof("[SHOOT INFRASTRUCTURE DRIFT] %s - error while listing orphaned infrastructure resources: %v", key, err)
+		return nil
+	}
+	if len(orphanedResources) > 0 {
+		message := fmt.Sprintf("Found infrastructure resources which are not contained in the Terraform state and must be cleaned up manually: %s", strings.Join(orphanedResources, ", "))
+		logger.Logger.Warnf("[SHOOT INFRASTRUCTURE DRIFT] %s - %s", key, message)
+		c.recorder.Event(shoot, corev1.EventTypeWarning, gardenv1beta1.ShootEventOrphanedInfrastructureResources, message)
+	}
+	return nil
+}
+
+// mustCheckInfrastructureDrift checks whether the infrastructure of the given Shoot must be compared with its Terraform
+// state. The state is incomplete while an operation is running or has failed, and the deletion flow verifies on its
+// own that no resources are left. Hence, only the infrastructure of successfully reconciled Shoots is checked.
+func mustCheckInfrastructureDrift(shoot *gardenv1beta1.Shoot) bool {
+	return shoot.DeletionTimestamp == nil &&
+		shoot.Spec.Cloud.Local == nil &&
+		shoot.Status.LastOperation != nil &&
+		shoot.Status.LastOperation.State == gardencorev1alpha1.LastOperationStateSucceeded
+}
+
+// InfrastructureDriftControlInterface implements the control logic for detecting cloud resources of Shoots which are
+// no longer contained in the Terraform state. It is implemented as an interface to allow for extensions that provide
+// different semantics. Currently, there is only one implementation.
+type InfrastructureDriftControlInterface interface {
+	ListOrphanedInfrastructureResources(ctx context.Context, shoot *gardenv1beta1.Shoot) ([]string, error)
+}
+
+// NewDefaultInfrastructureDriftControl returns a new instance of the default implementation of
+// InfrastructureDriftControlInterface which asks the CloudBotanist of the Shoot for the orphaned resources. The
+// requests to the cloud provider count towards the same <cloudAPIRateLimiters> as the Shoot operations.
+func NewDefaultInfrastructureDriftControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.Interface, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, identity *gardenv1beta1.Gardener, cloudAPIRateLimiters *ratelimiter.Registry) InfrastructureDriftControlInterface {
+	return &defaultInfrastructureDriftControl{k8sGardenClient, k8sGardenInformers, secrets, imageVector, identity, cloudAPIRateLimiters}
+}
+
+type defaultInfrastructureDriftControl struct {
+	k8sGardenClient    kubernetes.Interface
+	k8sGardenInformers gardeninformers.Interface
+	secrets            map[string]*corev1.Secret
+	imageVector        imagevector.ImageVector
+	identity           *gardenv1beta1.Gardener
+	// cloudAPIRateLimiters holds the token buckets of the cloud provider accounts. It is nil if the rate is not limited.
+	cloudAPIRateLimiters *ratelimiter.Registry
+}
+
+func (c *defaultInfrastructureDriftControl) ListOrphanedInfrastructureResources(ctx context.Context, shootObj *gardenv1beta1.Shoot) ([]string, error) {
+	var (
+		shoot       = shootObj.DeepCopy()
+		shootLogger = newShootLogger(c.k8sGardenInformers.Projects().Lister(), shoot, "")
+	)
+
+	o, err := operation.New(shoot, shootLogger, c.k8sGardenClient, c.k8sGardenInformers, c.identity, c.secrets, c.imageVector, nil)
+	if err != nil {
+		return nil, err
+	}
+	o.CloudAPIRateLimiter = cloudAPIRateLimiter(c.cloudAPIRateLimiters, o)
+	if err := o.InitializeSeedClients(); err != nil {
+		return nil, err
+	}
+	shootCloudBotanist, err := cloudbotanistpkg.New(o, common.CloudPurposeShoot)
+	if err != nil {
+		return nil, err
+	}
+
+	return shootCloudBotanist.ListOrphanedInfrastructureResources(ctx)
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// kubeletCSRApprovalPeriod is the period in which the serving certificate requests of the kubelets of a Shoot are
+	// approved while a rollout of kubelet serving certificates is in progress or after an approval has failed.
+	kubeletCSRApprovalPeriod = 30 * time.Second
+	// kubeletCSRResyncPeriod is the period in which the serving certificate requests of the kubelets of a Shoot are
+	// approved otherwise. The kubelets of nodes which have not been created by a reconciliation (e.g., by the
+	// cluster-autoscaler) cannot serve logs or exec requests before.
+	kubeletCSRResyncPeriod = 5 * time.Minute
+)
+
+func (c *Controller) shootKubeletCSRAdd(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		logger.Logger.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+	c.shootKubeletCSRQueue.Add(key)
+}
+
+func (c *Controller) shootKubeletCSRUpdate(oldObj, newObj interface{}) {
+	oldShoot, ok1 := oldObj.(*gardenv1beta1.Shoot)
+	newShoot, ok2 := newObj.(*gardenv1beta1.Shoot)
+	if !ok1 || !ok2 {
+		return
+	}
+
+	// New nodes and kubelets restarted with a new configuration request their serving certificates during a
+	// reconciliation, hence, they are approved right after it has succeeded.
+	if !lastOperationSucceeded(oldShoot) && lastOperationSucceeded(newShoot) {
+		c.shootKubeletCSRAdd(newObj)
+	}
+}
+
+func (c *Controller) reconcileShootKubeletCSRKey(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	shoot, err := c.shootLister.Shoots(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		logger.Logger.Debugf("[SHOOT KUBELET CSR] %s - skipping because Shoot has been deleted", key)
+		return nil
+	}
+	if err != nil {
+		logger.Logger.Infof("[SHOOT KUBELET CSR] %s - unable to retrieve object from store: %v", key, err)
+		return err
+	}
+	if !c.seedFilter(shoot) {
+		logger.Logger.Debugf("[SHOOT KUBELET CSR] %s - skipping because the Seed of the Shoot is not selected by my seed selector", key)
+		return nil
+	}
+
+	// Shoots which are skipped here are enqueued again by shootKubeletCSRUpdate after their next successful reconciliation.
+	if !mustApproveKubeletServingCertificates(shoot) {
+		return nil
+	}
+
+	rolloutPending, err := c.kubeletCSRControl.ApproveKubeletServingCertificates(shoot)
+	if err != nil {
+		logger.Logger.Infof("[SHOOT KUBELET CSR] %s - error while approving kubelet serving certificates: %v", key, err)
+	}
+
+	if err != nil || rolloutPending {
+		c.shootKubeletCSRQueue.AddAfter(key, kubeletCSRApprovalPeriod)
+	} else {
+		c.shootKubeletCSRQueue.AddAfter(key, kubeletCSRResyncPeriod)
+	}
+	return nil
+}
+
+// lastOperationSucceeded returns true if the last operation of the given Shoot has succeeded.
+func lastOperationSucceeded(shoot *gardenv1beta1.Shoot) bool {
+	return shoot.Status.LastOperation != nil && shoot.Status.LastOperation.State == gardencorev1alpha1.LastOperationStateSucceeded
+}
+
+// mustApproveKubeletServingCertificates checks whether the serving certificate requests of the kubelets of the given
+// Shoot must be approved. This is the case for all running Shoots whose kubelets request serving certificates, see
+// common.ShootKubeletServingCertificates.
+func mustApproveKubeletServingCertificates(shoot *gardenv1beta1.Shoot) bool {
+	if shoot.DeletionTimestamp != nil || helper.IsShootHibernated(shoot) {
+		return false
+	}
+
+	switch shoot.Annotations[common.ShootKubeletServingCertificates] {
+	case "true", common.ShootKubeletServingCertificatesRollingOut:
+	default:
+		return false
+	}
+
+	atLeast112, err := utils.CompareVersions(shoot.Spec.Kubernetes.Version, ">=", "1.12")
+	return err == nil && atLeast112
+}
+
+// KubeletCSRControlInterface implements the control logic for approving the serving certificate requests of the kubelets
+// of Shoots. It is implemented as an interface to allow for extensions that provide different semantics. Currently,
+// there is only one implementation.
+type KubeletCSRControlInterface interface {
+	ApproveKubeletServingCertificates(shoot *gardenv1beta1.Shoot) (bool, error)
+}
+
+// NewDefaultKubeletCSRControl returns a new instance of the default implementation of KubeletCSRControlInterface
+// which approves the pending serving certificate requests of the kubelets in the Shoot cluster.
+func NewDefaultKubeletCSRControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.Interface, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, identity *gardenv1beta1.Gardener) KubeletCSRControlInterface {
+	return &defaultKubeletCSRControl{k8sGardenClient, k8sGardenInformers, secrets, imageVector, identity}
+}
+
+type defaultKubeletCSRControl struct {
+	k8sGardenClient    kubernetes.Interface
+	k8sGardenInformers gardeninformers.Interface
+	secrets            map[string]*corev1.Secret
+	imageVector        imagevector.ImageVector
+	identity           *gardenv1beta1.Gardener
+}
+
+// ApproveKubeletServingCertificates approves the pending serving certificate requests of the kubelets of the given
+// Shoot. While the kubelet serving certificates are rolled out to the Shoot, it completes the rollout as soon as the
+// kubelets of all nodes have received one, i.e., the kube-apiserver verifies them after the next reconciliation. It
+// returns true if the rollout is still pending.
+func (c *defaultKubeletCSRControl) ApproveKubeletServingCertificates(shootObj *gardenv1beta1.Shoot) (bool, error) {
+	var (
+		shoot       = shootObj.DeepCopy()
+		shootLogger = newShootLogger(c.k8sGardenInformers.Projects().Lister(), shoot, "")
+	)
+
+	o, err := operation.New(shoot, shootLogger, c.k8sGardenClient, c.k8sGardenInformers, c.identity, c.secrets, c.imageVector, nil)
+	if err != nil {
+		return false, err
+	}
+	botanist, err := botanistpkg.New(o)
+	if err != nil {
+		return false, err
+	}
+	if err := botanist.InitializeShootClients(); err != nil {
+		return false, err
+	}
+
+	if err := botanist.ApproveKubeletServingCertificateSigningRequests(); err != nil {
+		return false, err
+	}
+
+	if shoot.Annotations[common.ShootKubeletServingCertificates] != common.ShootKubeletServingCertificatesRollingOut {
+		return false, nil
+	}
+
+	approved, err := botanist.KubeletServingCertificatesApproved()
+	if err != nil || !approved {
+		return true, err
+	}
+
+	shootLogger.Infof("The kubelets of all nodes have received their serving certificates, the kube-apiserver verifies them after the next reconciliation")
+	return false, kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), shoot, func() error {
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, common.ShootKubeletServingCertificates, "true")
+		return nil
+	})
+}
@@ -15,6 +15,7 @@
 package shoot
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -22,7 +23,6 @@ import (
 	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
-	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
@@ -34,7 +34,6 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
-	"k8s.io/client-go/util/retry"
 )
 
 const (
@@ -111,6 +110,10 @@ func (c *Controller) reconcileShootMaintenanceKey(key string) error {
 		logger.Logger.Infof("[SHOOT MAINTENANCE] %s - skipping because Shoot (it is either marked as 'to-be-ignored' or must not be maintained now).", key)
 		return nil
 	}
+	if c.isLandscapeFrozen() {
+		logger.Logger.Infof("[SHOOT MAINTENANCE] %s - skipping because the landscape is frozen", key)
+		return nil
+	}
 
 	return c.maintenanceControl.Maintain(shoot, key)
 }
@@ -156,7 +159,7 @@ func (c *defaultMaintenanceControl) Maintain(shootObj *gardenv1beta1.Shoot, key
 
 	var (
 		shoot       = shootObj.DeepCopy()
-		shootLogger = logger.NewShootLogger(logger.Logger, shoot.Name, shoot.Namespace, operationID)
+		shootLogger = newShootLogger(c.k8sGardenInformers.Projects().Lister(), shoot, operationID)
 		handleError = func(msg string) {
 			c.recorder.Eventf(shoot, corev1.EventTypeWarning, gardenv1beta1.ShootEventMaintenanceError, "[%s] %s", operationID, msg)
 			shootLogger.Error(msg)
@@ -197,25 +200,24 @@ func (c *defaultMaintenanceControl) Maintain(shootObj *gardenv1beta1.Shoot, key
 	}
 
 	// Update the Shoot resource object.
-	_, err = kutil.TryUpdateShoot(c.k8sGardenClient.Garden(), retry.DefaultBackoff, shoot.ObjectMeta, func(s *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
-		if !apiequality.Semantic.DeepEqual(shootObj.Spec.Maintenance.AutoUpdate, s.Spec.Maintenance.AutoUpdate) {
-			return nil, fmt.Errorf("auto update section of Shoot %s/%s changed mid-air", s.Namespace, s.Name)
+	if err := kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), shoot, func() error {
+		if !apiequality.Semantic.DeepEqual(shootObj.Spec.Maintenance.AutoUpdate, shoot.Spec.Maintenance.AutoUpdate) {
+			return fmt.Errorf("auto update section of Shoot %s/%s changed mid-air", shoot.Namespace, shoot.Name)
 		}
 
-		delete(s.Annotations, common.ShootOperation)
+		delete(shoot.Annotations, common.ShootOperation)
 
-		controllerutils.AddTasks(s.Annotations, common.ShootTaskDeployInfrastructure, common.ShootTaskDeployKube2IAMResource)
-		s.Annotations[common.ShootOperation] = common.ShootOperationReconcile
+		common.AddTasks(shoot.Annotations, common.ShootTaskDeployInfrastructure, common.ShootTaskDeployKube2IAMResource)
+		shoot.Annotations[common.ShootOperation] = common.ShootOperationReconcile
 
 		if updateMachineImage != nil {
-			updateMachineImage(&s.Spec.Cloud)
+			updateMachineImage(&shoot.Spec.Cloud)
 		}
 		if updateKubernetesVersion != nil {
-			updateKubernetesVersion(&s.Spec.Kubernetes)
+			updateKubernetesVersion(&shoot.Spec.Kubernetes)
 		}
-		return s, nil
-	})
-	if err != nil {
+		return nil
+	}); err != nil {
 		handleError(fmt.Sprintf("Could not update the Shoot specification: %s", err.Error()))
 		return nil
 	}
@@ -0,0 +1,86 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	gardenmetrics "github.com/gardener/gardener/pkg/controllermanager/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// collectShootMetrics exports the conditions, the last operation and the hibernation status of all Shoots handled
+// by this instance.
+func (c *Controller) collectShootMetrics(ch chan<- prometheus.Metric) {
+	shoots, err := c.shootLister.List(labels.Everything())
+	if err != nil {
+		gardenmetrics.ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+		return
+	}
+	projects, err := c.projectLister.List(labels.Everything())
+	if err != nil {
+		gardenmetrics.ScrapeFailures.With(prometheus.Labels{"kind": "shoots"}).Inc()
+		return
+	}
+
+	projectNames := make(map[string]string, len(projects))
+	for _, project := range projects {
+		if project.Spec.Namespace != nil {
+			projectNames[*project.Spec.Namespace] = project.Name
+		}
+	}
+
+	for _, shoot := range shoots {
+		if !c.seedFilter(shoot) {
+			continue
+		}
+		for _, metric := range shootMetrics(shoot, projectNames) {
+			ch <- metric
+		}
+	}
+}
+
+func shootMetrics(shoot *gardenv1beta1.Shoot, projectNames map[string]string) []prometheus.Metric {
+	var (
+		metrics []prometheus.Metric
+		project = shoot.Namespace
+		seed    string
+	)
+
+	if name, ok := projectNames[shoot.Namespace]; ok {
+		project = name
+	}
+	if shoot.Spec.Cloud.Seed != nil {
+		seed = *shoot.Spec.Cloud.Seed
+	}
+
+	for _, condition := range shoot.Status.Conditions {
+		metrics = append(metrics, prometheus.MustNewConstMetric(gardenmetrics.ShootCondition, prometheus.GaugeValue, 1, shoot.Name, project, seed, string(condition.Type), string(condition.Status)))
+	}
+
+	if lastOperation := shoot.Status.LastOperation; lastOperation != nil {
+		metrics = append(metrics, prometheus.MustNewConstMetric(gardenmetrics.ShootOperationProgress, prometheus.GaugeValue, float64(lastOperation.Progress), shoot.Name, project, seed, string(lastOperation.Type), string(lastOperation.State)))
+	}
+
+	var hibernated float64
+	if helper.IsShootHibernated(shoot) {
+		hibernated = 1
+	}
+	metrics = append(metrics, prometheus.MustNewConstMetric(gardenmetrics.ShootHibernated, prometheus.GaugeValue, hibernated, shoot.Name, project, seed))
+
+	return metrics
+}
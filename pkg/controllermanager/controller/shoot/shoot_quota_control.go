@@ -1,6 +1,7 @@
 package shoot
 
 import (
+	"context"
 	"time"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
@@ -8,6 +9,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
@@ -78,7 +80,7 @@ func (c *defaultQuotaControl) CheckQuota(shootObj *gardenv1beta1.Shoot, key stri
 	var (
 		clusterLifeTime *int
 		shoot           = shootObj.DeepCopy()
-		shootLogger     = logger.NewShootLogger(logger.Logger, shoot.Name, shoot.Namespace, "")
+		shootLogger     = newShootLogger(c.k8sGardenInformers.Projects().Lister(), shoot, "")
 	)
 
 	secretBinding, err := c.k8sGardenInformers.SecretBindings().Lister().SecretBindings(shoot.Namespace).Get(shoot.Spec.Cloud.SecretBindingRef.Name)
@@ -107,17 +109,14 @@ func (c *defaultQuotaControl) CheckQuota(shootObj *gardenv1beta1.Shoot, key stri
 
 	expirationTime, exits := shoot.Annotations[common.ShootExpirationTimestamp]
 	if !exits {
-		annotations := shoot.Annotations
-		annotations[common.ShootExpirationTimestamp] = shoot.CreationTimestamp.Add(time.Duration(*clusterLifeTime*24) * time.Hour).Format(time.RFC3339)
-		shoot.Annotations = annotations
+		expirationTime = shoot.CreationTimestamp.Add(time.Duration(*clusterLifeTime*24) * time.Hour).Format(time.RFC3339)
 
-		shootUpdated, err := c.k8sGardenClient.Garden().GardenV1beta1().Shoots(shoot.Namespace).Update(shoot)
-		if err != nil {
+		if err := kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), shoot, func() error {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, common.ShootExpirationTimestamp, expirationTime)
+			return nil
+		}); err != nil {
 			return err
 		}
-		shoot = shootUpdated
-
-		expirationTime = annotations[common.ShootExpirationTimestamp]
 	}
 	expirationTimeParsed, err := time.Parse(time.RFC3339, expirationTime)
 	if err != nil {
@@ -128,11 +127,10 @@ func (c *defaultQuotaControl) CheckQuota(shootObj *gardenv1beta1.Shoot, key stri
 		shootLogger.Info("[SHOOT QUOTA] Shoot cluster lifetime expired. Shoot will be deleted.")
 
 		// We have to annotate the Shoot to confirm the deletion.
-		annotations := shoot.Annotations
-		annotations[common.ConfirmationDeletion] = "true"
-		shoot.ObjectMeta.Annotations = annotations
-
-		if _, err = c.k8sGardenClient.Garden().GardenV1beta1().Shoots(shoot.Namespace).Update(shoot); err != nil {
+		if err := kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, c.k8sGardenClient.Client(), shoot, func() error {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, common.ConfirmationDeletion, "true")
+			return nil
+		}); err != nil {
 			return err
 		}
 
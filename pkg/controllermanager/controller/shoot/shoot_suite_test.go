@@ -14,12 +14,27 @@
 package shoot_test
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"testing"
+	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot"
+	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -27,6 +42,7 @@ import (
 )
 
 func TestShoot(t *testing.T) {
+	logger.NewLogger("info")
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Controller Shoot Suite")
 }
@@ -141,4 +157,416 @@ var _ = Describe("Shoot Utils", func() {
 			}),
 		)
 	})
+
+	Context("reconciliation", func() {
+		var (
+			now                   = time.Date(2019, time.June, 12, 10, 0, 0, 0, time.UTC)
+			maintenanceTimeWindow = utils.NewMaintenanceTimeWindow(utils.NewMaintenanceTime(3, 0, 0), utils.NewMaintenanceTime(4, 0, 0))
+			hash                  = "hash"
+			s                     *gardenv1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			s = &gardenv1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Status: gardenv1beta1.ShootStatus{
+					ObservedGeneration:  2,
+					ReconciledStateHash: hash,
+					LastOperation: &gardencorev1alpha1.LastOperation{
+						Type:           gardencorev1alpha1.LastOperationTypeReconcile,
+						State:          gardencorev1alpha1.LastOperationStateSucceeded,
+						LastUpdateTime: metav1.NewTime(now.Add(-time.Hour)),
+					},
+				},
+			}
+		})
+
+		Describe("#MustSkipReconciliation", func() {
+			It("should skip shoots whose desired state has not changed", func() {
+				Expect(shoot.ExportMustSkipReconciliation(s, hash, maintenanceTimeWindow, now)).To(BeTrue())
+			})
+
+			It("should not skip shoots whose desired state has changed", func() {
+				Expect(shoot.ExportMustSkipReconciliation(s, "other", maintenanceTimeWindow, now)).To(BeFalse())
+			})
+
+			It("should not skip shoots whose generation has changed", func() {
+				s.Generation = 3
+				Expect(shoot.ExportMustSkipReconciliation(s, hash, maintenanceTimeWindow, now)).To(BeFalse())
+			})
+
+			It("should not skip shoots whose last operation did not succeed", func() {
+				s.Status.LastOperation.State = gardencorev1alpha1.LastOperationStateError
+				Expect(shoot.ExportMustSkipReconciliation(s, hash, maintenanceTimeWindow, now)).To(BeFalse())
+			})
+
+			It("should not skip shoots annotated to be reconciled always", func() {
+				s.Annotations = map[string]string{common.ShootReconcile: common.ShootReconcileAlways}
+				Expect(shoot.ExportMustSkipReconciliation(s, hash, maintenanceTimeWindow, now)).To(BeFalse())
+			})
+
+			It("should not skip shoots which have not been reconciled since the begin of their last maintenance time window", func() {
+				s.Status.LastOperation.LastUpdateTime = metav1.NewTime(time.Date(2019, time.June, 12, 2, 59, 0, 0, time.UTC))
+				Expect(shoot.ExportMustSkipReconciliation(s, hash, maintenanceTimeWindow, now)).To(BeFalse())
+			})
+
+			It("should not skip shoots without maintenance time window which have not been reconciled for a day", func() {
+				s.Status.LastOperation.LastUpdateTime = metav1.NewTime(now.Add(-25 * time.Hour))
+				Expect(shoot.ExportMustSkipReconciliation(s, hash, nil, now)).To(BeFalse())
+			})
+		})
+
+		DescribeTable("#LastFullReconciliationDue",
+			func(maintenanceTimeWindow *utils.MaintenanceTimeWindow, now, expected time.Time) {
+				Expect(shoot.ExportLastFullReconciliationDue(maintenanceTimeWindow, now)).To(Equal(expected))
+			},
+			Entry("no maintenance time window", nil, now, now.Add(-24*time.Hour)),
+			Entry("after the begin of today's window", maintenanceTimeWindow, now, time.Date(2019, time.June, 12, 3, 0, 0, 0, time.UTC)),
+			Entry("before the begin of today's window", maintenanceTimeWindow, time.Date(2019, time.June, 12, 1, 0, 0, 0, time.UTC), time.Date(2019, time.June, 11, 3, 0, 0, 0, time.UTC)),
+		)
+
+		Describe("#CertificatesNeedRenewal", func() {
+			newCertificateSecret := func(notBefore, notAfter time.Time) corev1.Secret {
+				key, err := rsa.GenerateKey(rand.Reader, 1024)
+				Expect(err).NotTo(HaveOccurred())
+				template := &x509.Certificate{
+					SerialNumber: big.NewInt(1),
+					Subject:      pkix.Name{CommonName: "kube-apiserver"},
+					NotBefore:    notBefore,
+					NotAfter:     notAfter,
+				}
+				der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+				Expect(err).NotTo(HaveOccurred())
+
+				return corev1.Secret{Data: map[string][]byte{
+					"tls.crt": pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+					"tls.key": []byte("key"),
+				}}
+			}
+
+			It("should not require a renewal if all certificates are valid for long enough", func() {
+				secrets := []corev1.Secret{newCertificateSecret(now.Add(-24*time.Hour), now.Add(96*time.Hour))}
+				Expect(shoot.ExportCertificatesNeedRenewal(secrets, now)).To(BeFalse())
+			})
+
+			It("should require a renewal if a certificate is within the last fifth of its validity", func() {
+				secrets := []corev1.Secret{
+					newCertificateSecret(now.Add(-24*time.Hour), now.Add(96*time.Hour)),
+					newCertificateSecret(now.Add(-96*time.Hour), now.Add(24*time.Hour)),
+				}
+				Expect(shoot.ExportCertificatesNeedRenewal(secrets, now)).To(BeTrue())
+			})
+
+			It("should ignore data which is not a certificate", func() {
+				secrets := []corev1.Secret{{Data: map[string][]byte{"ca.crt": []byte("foo"), "password": []byte("bar")}}}
+				Expect(shoot.ExportCertificatesNeedRenewal(secrets, now)).To(BeFalse())
+			})
+		})
+
+		Describe("#MustPostponeReconciliation", func() {
+			var (
+				outside = time.Date(2019, time.June, 12, 10, 0, 0, 0, time.UTC)
+				inside  = time.Date(2019, time.June, 12, 3, 30, 0, 0, time.UTC)
+			)
+
+			BeforeEach(func() {
+				s.Spec.Maintenance = &gardenv1beta1.Maintenance{ConfineSpecUpdateRollout: makeBoolPointer(true)}
+				s.Generation = 3
+			})
+
+			It("should postpone spec changes outside of the maintenance time window", func() {
+				Expect(shoot.ExportMustPostponeReconciliation(s, nil, maintenanceTimeWindow, outside)).To(BeTrue())
+			})
+
+			It("should not postpone spec changes within the maintenance time window", func() {
+				Expect(shoot.ExportMustPostponeReconciliation(s, nil, maintenanceTimeWindow, inside)).To(BeFalse())
+			})
+
+			It("should not postpone spec changes if the rollout is not confined", func() {
+				s.Spec.Maintenance.ConfineSpecUpdateRollout = nil
+				Expect(shoot.ExportMustPostponeReconciliation(s, nil, maintenanceTimeWindow, outside)).To(BeFalse())
+			})
+
+			It("should not postpone changes of the hibernation state", func() {
+				s.Spec.Hibernation = &gardenv1beta1.Hibernation{Enabled: true}
+				Expect(shoot.ExportMustPostponeReconciliation(s, nil, maintenanceTimeWindow, outside)).To(BeFalse())
+			})
+
+			It("should not postpone deletions", func() {
+				s.DeletionTimestamp = &metav1.Time{Time: outside}
+				Expect(shoot.ExportMustPostponeReconciliation(s, nil, maintenanceTimeWindow, outside)).To(BeFalse())
+			})
+
+			It("should not postpone the creation", func() {
+				s.Status.LastOperation.Type = gardencorev1alpha1.LastOperationTypeCreate
+				s.Status.LastOperation.State = gardencorev1alpha1.LastOperationStateError
+				Expect(shoot.ExportMustPostponeReconciliation(s, nil, maintenanceTimeWindow, outside)).To(BeFalse())
+			})
+
+			It("should postpone unchanged shoots if they may only be reconciled in the maintenance time window", func() {
+				s.Generation = 2
+				Expect(shoot.ExportMustPostponeReconciliation(s, makeBoolPointer(true), maintenanceTimeWindow, outside)).To(BeTrue())
+				Expect(shoot.ExportMustPostponeReconciliation(s, makeBoolPointer(false), maintenanceTimeWindow, outside)).To(BeFalse())
+			})
+
+			It("should not postpone unchanged shoots annotated to be reconciled always", func() {
+				s.Generation = 2
+				s.Annotations = map[string]string{common.ShootReconcile: common.ShootReconcileAlways}
+				Expect(shoot.ExportMustPostponeReconciliation(s, makeBoolPointer(true), maintenanceTimeWindow, outside)).To(BeFalse())
+			})
+
+			It("should not postpone anything without maintenance time window", func() {
+				Expect(shoot.ExportMustPostponeReconciliation(s, makeBoolPointer(true), nil, outside)).To(BeFalse())
+			})
+		})
+
+		DescribeTable("#ShootMaintenanceTimeWindow",
+			func(maintenance *gardenv1beta1.Maintenance, expected *utils.MaintenanceTimeWindow) {
+				Expect(shoot.ExportShootMaintenanceTimeWindow(&gardenv1beta1.Shoot{Spec: gardenv1beta1.ShootSpec{Maintenance: maintenance}})).To(Equal(expected))
+			},
+			Entry("no maintenance", nil, nil),
+			Entry("no time window", &gardenv1beta1.Maintenance{}, nil),
+			Entry("invalid time window", &gardenv1beta1.Maintenance{TimeWindow: &gardenv1beta1.MaintenanceTimeWindow{Begin: "foo", End: "040000+0000"}}, nil),
+			Entry("valid time window", &gardenv1beta1.Maintenance{TimeWindow: &gardenv1beta1.MaintenanceTimeWindow{Begin: "030000+0000", End: "040000+0000"}}, maintenanceTimeWindow),
+		)
+
+		Describe("#MustBackfillHibernatedStatus", func() {
+			It("should backfill the status of hibernated shoots", func() {
+				s.Spec.Hibernation = &gardenv1beta1.Hibernation{Enabled: true}
+				Expect(shoot.ExportMustBackfillHibernatedStatus(s)).To(BeTrue())
+			})
+
+			It("should not backfill the status if it is up to date", func() {
+				s.Spec.Hibernation = &gardenv1beta1.Hibernation{Enabled: true}
+				s.Status.Hibernated = true
+				Expect(shoot.ExportMustBackfillHibernatedStatus(s)).To(BeFalse())
+			})
+
+			It("should not backfill the status if the hibernation state has not been applied yet", func() {
+				s.Spec.Hibernation = &gardenv1beta1.Hibernation{Enabled: true}
+				s.Generation = 3
+				Expect(shoot.ExportMustBackfillHibernatedStatus(s)).To(BeFalse())
+			})
+		})
+
+		Describe("#SyncJitter", func() {
+			It("should delay by at most a tenth of the sync period", func() {
+				randomFunc := utils.RandomFunc
+				defer func() { utils.RandomFunc = randomFunc }()
+				utils.RandomFunc = func(min, max int64) int64 {
+					return max
+				}
+
+				Expect(shoot.ExportSyncJitter(time.Hour)).To(Equal(6 * time.Minute))
+			})
+		})
+
+		Describe("#RetryBackoff", func() {
+			DescribeTable("should double the retry sync period with every failed attempt up to the sync period",
+				func(retrySyncPeriod, syncPeriod time.Duration, retryCount int32, expected time.Duration) {
+					Expect(shoot.ExportRetryBackoff(retrySyncPeriod, syncPeriod, retryCount)).To(Equal(expected))
+				},
+				Entry("no failed attempt yet", 15*time.Second, time.Hour, int32(0), 15*time.Second),
+				Entry("first failed attempt", 15*time.Second, time.Hour, int32(1), 15*time.Second),
+				Entry("second failed attempt", 15*time.Second, time.Hour, int32(2), 30*time.Second),
+				Entry("fifth failed attempt", 15*time.Second, time.Hour, int32(5), 4*time.Minute),
+				Entry("capped by the sync period", 15*time.Second, time.Hour, int32(9), time.Hour),
+				Entry("capped by the sync period for many failed attempts", 15*time.Second, time.Hour, int32(1000), time.Hour),
+				Entry("retry sync period above the sync period", 2*time.Hour, time.Hour, int32(1), time.Hour),
+				Entry("no sync period", 15*time.Second, time.Duration(0), int32(3), 15*time.Second),
+			)
+		})
+
+		Describe("#MayRetry", func() {
+			var (
+				cfg    config.ShootControllerConfiguration
+				status gardenv1beta1.ShootStatus
+			)
+
+			BeforeEach(func() {
+				maxAttempts := int32(3)
+				cfg = config.ShootControllerConfiguration{
+					RetryDuration:    metav1.Duration{Duration: time.Hour},
+					RetryMaxAttempts: &maxAttempts,
+				}
+				now := metav1.Now()
+				status = gardenv1beta1.ShootStatus{RetryCycleStartTime: &now, RetryCount: 1}
+			})
+
+			It("should retry within the retry duration and below the maximum number of attempts", func() {
+				Expect(shoot.ExportMayRetry(cfg, status, &gardencorev1alpha1.LastError{Description: "foo"})).To(BeTrue())
+			})
+
+			It("should retry without a maximum number of attempts", func() {
+				cfg.RetryMaxAttempts = nil
+				status.RetryCount = 1000
+				Expect(shoot.ExportMayRetry(cfg, status, nil)).To(BeTrue())
+			})
+
+			It("should not retry once the maximum number of attempts has been reached", func() {
+				status.RetryCount = 3
+				Expect(shoot.ExportMayRetry(cfg, status, nil)).To(BeFalse())
+			})
+
+			It("should not retry once the retry duration has elapsed", func() {
+				start := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+				status.RetryCycleStartTime = &start
+				Expect(shoot.ExportMayRetry(cfg, status, nil)).To(BeFalse())
+			})
+
+			It("should not retry without a retry cycle", func() {
+				status.RetryCycleStartTime = nil
+				Expect(shoot.ExportMayRetry(cfg, status, nil)).To(BeFalse())
+			})
+
+			DescribeTable("should only retry errors which may be resolved by retrying",
+				func(code gardencorev1alpha1.ErrorCode, expected bool) {
+					lastError := &gardencorev1alpha1.LastError{Description: "foo", Codes: []gardencorev1alpha1.ErrorCode{code}}
+					Expect(shoot.ExportMayRetry(cfg, status, lastError)).To(Equal(expected))
+				},
+				Entry("infrastructure dependencies", gardencorev1alpha1.ErrorInfraDependencies, true),
+				Entry("infrastructure conflict", gardencorev1alpha1.ErrorInfraConflict, true),
+				Entry("infrastructure timeout", gardencorev1alpha1.ErrorInfraTimeout, true),
+				Entry("unauthorized", gardencorev1alpha1.ErrorInfraUnauthorized, false),
+				Entry("insufficient privileges", gardencorev1alpha1.ErrorInfraInsufficientPrivileges, false),
+				Entry("quota exceeded", gardencorev1alpha1.ErrorInfraQuotaExceeded, false),
+			)
+		})
+	})
+
+	Context("infrastructure drift", func() {
+		var s *gardenv1beta1.Shoot
+
+		BeforeEach(func() {
+			s = &gardenv1beta1.Shoot{
+				Spec: gardenv1beta1.ShootSpec{
+					Cloud: gardenv1beta1.Cloud{AWS: &gardenv1beta1.AWSCloud{}},
+				},
+				Status: gardenv1beta1.ShootStatus{
+					LastOperation: &gardencorev1alpha1.LastOperation{
+						Type:  gardencorev1alpha1.LastOperationTypeReconcile,
+						State: gardencorev1alpha1.LastOperationStateSucceeded,
+					},
+				},
+			}
+		})
+
+		Describe("#MustCheckInfrastructureDrift", func() {
+			It("should check the infrastructure of successfully reconciled Shoots", func() {
+				Expect(shoot.ExportMustCheckInfrastructureDrift(s)).To(BeTrue())
+			})
+
+			DescribeTable("should not check the infrastructure",
+				func(mutate func(*gardenv1beta1.Shoot)) {
+					mutate(s)
+					Expect(shoot.ExportMustCheckInfrastructureDrift(s)).To(BeFalse())
+				},
+				Entry("if the Shoot is being deleted", func(s *gardenv1beta1.Shoot) {
+					now := metav1.Now()
+					s.DeletionTimestamp = &now
+				}),
+				Entry("if the Shoot uses the local provider", func(s *gardenv1beta1.Shoot) {
+					s.Spec.Cloud.AWS = nil
+					s.Spec.Cloud.Local = &gardenv1beta1.Local{}
+				}),
+				Entry("if the Shoot has not been reconciled yet", func(s *gardenv1beta1.Shoot) {
+					s.Status.LastOperation = nil
+				}),
+				Entry("if an operation is running", func(s *gardenv1beta1.Shoot) {
+					s.Status.LastOperation.State = gardencorev1alpha1.LastOperationStateProcessing
+				}),
+				Entry("if the last operation has failed", func(s *gardenv1beta1.Shoot) {
+					s.Status.LastOperation.State = gardencorev1alpha1.LastOperationStateError
+				}),
+			)
+		})
+	})
+
+	Context("kubelet serving certificates", func() {
+		var s *gardenv1beta1.Shoot
+
+		BeforeEach(func() {
+			s = &gardenv1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{common.ShootKubeletServingCertificates: "true"},
+				},
+				Spec: gardenv1beta1.ShootSpec{
+					Kubernetes: gardenv1beta1.Kubernetes{Version: "1.14.1"},
+				},
+			}
+		})
+
+		Describe("#MustApproveKubeletServingCertificates", func() {
+			It("should approve the certificates of Shoots created with them", func() {
+				Expect(shoot.ExportMustApproveKubeletServingCertificates(s)).To(BeTrue())
+			})
+
+			It("should approve the certificates of Shoots rolling them out", func() {
+				s.Annotations[common.ShootKubeletServingCertificates] = common.ShootKubeletServingCertificatesRollingOut
+				Expect(shoot.ExportMustApproveKubeletServingCertificates(s)).To(BeTrue())
+			})
+
+			DescribeTable("should not approve the certificates",
+				func(mutate func(*gardenv1beta1.Shoot)) {
+					mutate(s)
+					Expect(shoot.ExportMustApproveKubeletServingCertificates(s)).To(BeFalse())
+				},
+				Entry("if the Shoot is being deleted", func(s *gardenv1beta1.Shoot) {
+					now := metav1.Now()
+					s.DeletionTimestamp = &now
+				}),
+				Entry("if the Shoot is hibernated", func(s *gardenv1beta1.Shoot) {
+					s.Spec.Hibernation = &gardenv1beta1.Hibernation{Enabled: true}
+				}),
+				Entry("if the Shoot has not been reconciled since they were introduced", func(s *gardenv1beta1.Shoot) {
+					s.Annotations = nil
+				}),
+				Entry("if the Kubernetes version does not support them", func(s *gardenv1beta1.Shoot) {
+					s.Spec.Kubernetes.Version = "1.11.10"
+				}),
+			)
+		})
+	})
+	Describe("#NewShootLogger", func() {
+		var (
+			namespace = "garden-dev"
+			seedName  = "aws-eu1"
+			s         *gardenv1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			s = &gardenv1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "crazy-botany", Namespace: namespace},
+				Spec: gardenv1beta1.ShootSpec{
+					Cloud: gardenv1beta1.Cloud{Seed: &seedName},
+				},
+			}
+		})
+
+		It("should add the same fields as the logger of the operations", func() {
+			projectLister := gardenlisters.NewProjectLister(newIndexer(&gardenv1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+				Spec:       gardenv1beta1.ProjectSpec{Namespace: &namespace},
+			}))
+
+			Expect(shoot.ExportNewShootLogger(projectLister, s, "abcd1234").Data).To(Equal(logrus.Fields{
+				logger.FieldShoot:       "garden-dev/crazy-botany",
+				logger.FieldProject:     "dev",
+				logger.FieldSeed:        seedName,
+				logger.FieldOperationID: "abcd1234",
+			}))
+		})
+
+		It("should omit the fields which are unknown", func() {
+			s.Spec.Cloud.Seed = nil
+
+			Expect(shoot.ExportNewShootLogger(gardenlisters.NewProjectLister(newIndexer()), s, "").Data).To(Equal(logrus.Fields{
+				logger.FieldShoot: "garden-dev/crazy-botany",
+			}))
+		})
+	})
 })
+
+func makeBoolPointer(b bool) *bool {
+	return &b
+}
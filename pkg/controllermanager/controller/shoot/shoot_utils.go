@@ -15,14 +15,26 @@
 package shoot
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/version"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
 )
 
 // Status is the status of a shoot used in the common.ShootStatus label.
@@ -97,11 +109,173 @@ func mustIgnoreShoot(annotations map[string]string, respectSyncPeriodOverwrite *
 	return respectSyncPeriodOverwrite != nil && *respectSyncPeriodOverwrite && ignore
 }
 
+// mayRetry checks whether a failed operation on a Shoot with the given <status> may be retried, i.e. whether its
+// <lastError> may be resolved by retrying and neither the configured retry duration has elapsed since the start of the
+// retry cycle nor the maximum number of consecutive failed attempts has been reached.
+func mayRetry(config config.ShootControllerConfiguration, status gardenv1beta1.ShootStatus, lastError *gardencorev1alpha1.LastError) bool {
+	if gardencorev1alpha1helper.HasNonRetryableErrorCode(lastError) {
+		return false
+	}
+	if utils.TimeElapsed(status.RetryCycleStartTime, config.RetryDuration.Duration) {
+		return false
+	}
+	return config.RetryMaxAttempts == nil || status.RetryCount < *config.RetryMaxAttempts
+}
+
+// retryBackoff computes the duration after which an operation which has failed <retryCount> consecutive times is
+// retried. The <retrySyncPeriod> is doubled with every failed attempt but never exceeds the <syncPeriod>.
+func retryBackoff(retrySyncPeriod, syncPeriod time.Duration, retryCount int32) time.Duration {
+	backoff := retrySyncPeriod
+	for i := int32(1); i < retryCount && backoff < syncPeriod; i++ {
+		backoff *= 2
+	}
+	if backoff > syncPeriod && syncPeriod > 0 {
+		return syncPeriod
+	}
+	return backoff
+}
+
 func shootIsFailed(shoot *gardenv1beta1.Shoot) bool {
 	lastOperation := shoot.Status.LastOperation
 	return lastOperation != nil && lastOperation.State == gardencorev1alpha1.LastOperationStateFailed && shoot.Generation == shoot.Status.ObservedGeneration
 }
 
+// computeReconciledStateHash computes a hash of the effective desired state of the given <shoot>, i.e., of its
+// specification, of the specifications of the referenced <cloudProfile> and <seed>, of the resource versions of the
+// given <secrets>, and of the Gardener version.
+func computeReconciledStateHash(shoot *gardenv1beta1.Shoot, cloudProfile *gardenv1beta1.CloudProfile, seed *gardenv1beta1.Seed, secrets ...*corev1.Secret) (string, error) {
+	parts := []string{version.Get().GitVersion}
+
+	for _, spec := range []interface{}{shoot.Spec, cloudProfile.Spec, seed.Spec} {
+		data, err := json.Marshal(spec)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, string(data))
+	}
+
+	for _, secret := range secrets {
+		if secret != nil {
+			parts = append(parts, fmt.Sprintf("%s/%s@%s", secret.Namespace, secret.Name, secret.ResourceVersion))
+		}
+	}
+
+	return utils.ComputeSHA256Hex([]byte(strings.Join(parts, "\n"))), nil
+}
+
+// mustSkipReconciliation checks whether the reconciliation of the given <shoot> can be skipped because its effective
+// desired state has not changed since its last successful operation. Shoots annotated with 'reconcile=always' or
+// with pending tasks or infrastructure imports are never skipped. Also, a full reconciliation is due once per
+// maintenance time window (or once per day if the Shoot has none) so that drift in the Seed and in the cloud provider
+// account is repaired regularly.
+func mustSkipReconciliation(shoot *gardenv1beta1.Shoot, reconciledStateHash string, maintenanceTimeWindow *utils.MaintenanceTimeWindow, now time.Time) bool {
+	if shoot.Annotations[common.ShootReconcile] == common.ShootReconcileAlways {
+		return false
+	}
+	if _, ok := shoot.Annotations[common.ShootTasks]; ok {
+		return false
+	}
+	if _, ok := shoot.Annotations[common.ShootInfrastructureImports]; ok {
+		return false
+	}
+
+	lastOperation := shoot.Status.LastOperation
+	return lastOperation != nil &&
+		lastOperation.State == gardencorev1alpha1.LastOperationStateSucceeded &&
+		!lastOperation.LastUpdateTime.Time.Before(lastFullReconciliationDue(maintenanceTimeWindow, now)) &&
+		shoot.Generation == shoot.Status.ObservedGeneration &&
+		len(shoot.Status.ReconciledStateHash) > 0 &&
+		shoot.Status.ReconciledStateHash == reconciledStateHash
+}
+
+// lastFullReconciliationDue returns the latest point in time before <now> at which a full reconciliation of a Shoot
+// with the given <maintenanceTimeWindow> became due, i.e., the latest begin of its maintenance time window, or one day
+// before <now> if it has no maintenance time window.
+func lastFullReconciliationDue(maintenanceTimeWindow *utils.MaintenanceTimeWindow, now time.Time) time.Time {
+	now = now.UTC()
+	if maintenanceTimeWindow == nil {
+		return now.Add(-24 * time.Hour)
+	}
+
+	begin := maintenanceTimeWindow.Begin()
+	due := time.Date(now.Year(), now.Month(), now.Day(), begin.Hour(), begin.Minute(), begin.Second(), 0, time.UTC)
+	if due.After(now) {
+		due = due.AddDate(0, 0, -1)
+	}
+	return due
+}
+
+// certificatesNeedRenewal checks whether any of the certificates stored in the given <secrets> (in keys with the
+// '.crt' suffix) expires soon, i.e., within the last fifth of its validity, which is when the reconciliation flow
+// renews certificates by default.
+func certificatesNeedRenewal(secrets []corev1.Secret, now time.Time) bool {
+	for _, secret := range secrets {
+		for key, data := range secret.Data {
+			if !strings.HasSuffix(key, ".crt") {
+				continue
+			}
+			certificate, err := utils.DecodeCertificate(data)
+			if err != nil {
+				continue
+			}
+			if renewAt := certificate.NotAfter.Add(-certificate.NotAfter.Sub(certificate.NotBefore) / 5); !now.Before(renewAt) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// mustPostponeReconciliation checks whether the reconciliation of the given <shoot> must be postponed until its next
+// <maintenanceTimeWindow>. This is the case outside of the maintenance time window if the Shoot confines the rollout of
+// specification changes (unless its hibernation state changes) or, if <reconcileInMaintenanceOnly> is set, if its
+// specification has not changed since its last successful operation. Creations, deletions and retries of failed
+// operations are never postponed.
+func mustPostponeReconciliation(shoot *gardenv1beta1.Shoot, reconcileInMaintenanceOnly *bool, maintenanceTimeWindow *utils.MaintenanceTimeWindow, now time.Time) bool {
+	if maintenanceTimeWindow == nil || maintenanceTimeWindow.Contains(now) || shoot.DeletionTimestamp != nil {
+		return false
+	}
+
+	lastOperation := shoot.Status.LastOperation
+	if lastOperation == nil || (lastOperation.Type == gardencorev1alpha1.LastOperationTypeCreate && lastOperation.State != gardencorev1alpha1.LastOperationStateSucceeded) {
+		return false
+	}
+
+	if shoot.Generation != shoot.Status.ObservedGeneration {
+		maintenance := shoot.Spec.Maintenance
+		return maintenance != nil && maintenance.ConfineSpecUpdateRollout != nil && *maintenance.ConfineSpecUpdateRollout &&
+			shoot.Status.Hibernated == helper.IsShootHibernated(shoot)
+	}
+
+	return reconcileInMaintenanceOnly != nil && *reconcileInMaintenanceOnly &&
+		lastOperation.State == gardencorev1alpha1.LastOperationStateSucceeded &&
+		shoot.Annotations[common.ShootReconcile] != common.ShootReconcileAlways
+}
+
+// mustBackfillHibernatedStatus checks whether the hibernation state recorded in the status of the given <shoot> differs
+// from its specification although the last operation succeeded and the generation has not changed since then.
+func mustBackfillHibernatedStatus(shoot *gardenv1beta1.Shoot) bool {
+	lastOperation := shoot.Status.LastOperation
+	return lastOperation != nil &&
+		lastOperation.State == gardencorev1alpha1.LastOperationStateSucceeded &&
+		shoot.Generation == shoot.Status.ObservedGeneration &&
+		shoot.DeletionTimestamp == nil &&
+		shoot.Status.Hibernated != helper.IsShootHibernated(shoot)
+}
+
+// shootMaintenanceTimeWindow returns the maintenance time window of the given <shoot>. It returns nil if the Shoot
+// does not specify a valid time window.
+func shootMaintenanceTimeWindow(shoot *gardenv1beta1.Shoot) *utils.MaintenanceTimeWindow {
+	if shoot.Spec.Maintenance == nil || shoot.Spec.Maintenance.TimeWindow == nil {
+		return nil
+	}
+	maintenanceTimeWindow, err := utils.ParseMaintenanceTimeWindow(shoot.Spec.Maintenance.TimeWindow.Begin, shoot.Spec.Maintenance.TimeWindow.End)
+	if err != nil {
+		return nil
+	}
+	return maintenanceTimeWindow
+}
+
 // ConditionStatusToStatus converts the given ConditionStatus to a shoot label Status.
 func ConditionStatusToStatus(status gardencorev1alpha1.ConditionStatus) Status {
 	switch status {
@@ -167,3 +341,16 @@ func shootIsSeed(shoot *gardenv1beta1.Shoot) bool {
 	shootedSeed, err := helper.ReadShootedSeed(shoot)
 	return err == nil && shootedSeed != nil
 }
+
+// newShootLogger returns a logger for the given Shoot which carries the same fields as the logger of its operations,
+// i.e., the names of the Shoot, its Project and its Seed and the given <operationID> (if any), see operation.New.
+func newShootLogger(projectLister gardenlisters.ProjectLister, shoot *gardenv1beta1.Shoot, operationID string) *logrus.Entry {
+	var projectName, seedName string
+	if project, err := common.ProjectForNamespace(projectLister, shoot.Namespace); err == nil {
+		projectName = project.Name
+	}
+	if shoot.Spec.Cloud.Seed != nil {
+		seedName = *shoot.Spec.Cloud.Seed
+	}
+	return logger.WithProjectAndSeed(logger.NewShootLogger(logger.Logger, shoot.Name, shoot.Namespace, operationID), projectName, seedName)
+}
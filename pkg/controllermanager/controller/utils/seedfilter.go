@@ -0,0 +1,93 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/logger"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// SeedFilterFunc returns a function which decides whether an object is handled by this controller manager. It only
+// accepts Seeds, Shoots, BackupInfrastructures and ControllerInstallations which belong to a Seed whose labels
+// match the given <seedSelector>. Objects which have not been assigned to a Seed yet are treated as if their Seed
+// had no labels. If the <seedSelector> is nil then all objects are accepted.
+// The function reads the Seeds from the given <seedLister>, hence, it must only be called by the reconcilers after
+// the caches have been synced, and not by the event handlers of the informers.
+func SeedFilterFunc(seedLister gardenlisters.SeedLister, seedSelector *metav1.LabelSelector) func(obj interface{}) bool {
+	if seedSelector == nil {
+		return func(obj interface{}) bool { return true }
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(seedSelector)
+	if err != nil {
+		// The selector has been validated when the configuration was loaded.
+		logger.Logger.Errorf("Could not parse seed selector: %v", err)
+		return func(obj interface{}) bool { return false }
+	}
+
+	return func(obj interface{}) bool {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+
+		var seedName string
+		switch o := obj.(type) {
+		case *gardenv1beta1.Seed:
+			return selector.Matches(labels.Set(o.Labels))
+		case *gardenv1beta1.Shoot:
+			if o.Spec.Cloud.Seed != nil {
+				seedName = *o.Spec.Cloud.Seed
+			}
+		case *gardenv1beta1.BackupInfrastructure:
+			seedName = o.Spec.Seed
+		case *gardencorev1alpha1.ControllerInstallation:
+			seedName = o.Spec.SeedRef.Name
+		default:
+			return false
+		}
+
+		if len(seedName) == 0 {
+			return selector.Matches(labels.Set{})
+		}
+
+		seed, err := seedLister.Get(seedName)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				logger.Logger.Errorf("Could not get Seed %s: %v", seedName, err)
+			}
+			return false
+		}
+		return selector.Matches(labels.Set(seed.Labels))
+	}
+}
+
+// SeedLabelsChanged returns true if the given objects are Seeds with different labels. In this case the objects
+// belonging to the Seed must be handled again as the result of the seed filter may have changed for them.
+func SeedLabelsChanged(oldObj, newObj interface{}) bool {
+	oldSeed, ok1 := oldObj.(*gardenv1beta1.Seed)
+	newSeed, ok2 := newObj.(*gardenv1beta1.Seed)
+	if !ok1 || !ok2 {
+		return false
+	}
+	return !apiequality.Semantic.DeepEqual(oldSeed.Labels, newSeed.Labels)
+}
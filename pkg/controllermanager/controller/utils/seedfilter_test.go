@@ -0,0 +1,98 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils_test
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/utils"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("#SeedFilterFunc", func() {
+	var (
+		seedLister gardenlisters.SeedLister
+		selector   *metav1.LabelSelector
+
+		agentSeed   = &gardenv1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "agent", Labels: map[string]string{"seed.garden.sapcloud.io/agent": "true"}}}
+		centralSeed = &gardenv1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "central"}}
+
+		shootOn = func(seed string) *gardenv1beta1.Shoot {
+			shoot := &gardenv1beta1.Shoot{}
+			if len(seed) > 0 {
+				shoot.Spec.Cloud.Seed = &seed
+			}
+			return shoot
+		}
+	)
+
+	BeforeEach(func() {
+		indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		Expect(indexer.Add(agentSeed)).To(Succeed())
+		Expect(indexer.Add(centralSeed)).To(Succeed())
+		seedLister = gardenlisters.NewSeedLister(indexer)
+
+		selector = &metav1.LabelSelector{MatchLabels: map[string]string{"seed.garden.sapcloud.io/agent": "true"}}
+	})
+
+	It("should accept all objects if no selector is given", func() {
+		filter := utils.SeedFilterFunc(seedLister, nil)
+
+		Expect(filter(centralSeed)).To(BeTrue())
+		Expect(filter(shootOn("central"))).To(BeTrue())
+		Expect(filter(shootOn(""))).To(BeTrue())
+	})
+
+	It("should only accept selected seeds", func() {
+		filter := utils.SeedFilterFunc(seedLister, selector)
+
+		Expect(filter(agentSeed)).To(BeTrue())
+		Expect(filter(centralSeed)).To(BeFalse())
+		Expect(filter(cache.DeletedFinalStateUnknown{Obj: agentSeed})).To(BeTrue())
+	})
+
+	It("should only accept objects belonging to selected seeds", func() {
+		filter := utils.SeedFilterFunc(seedLister, selector)
+
+		Expect(filter(shootOn("agent"))).To(BeTrue())
+		Expect(filter(shootOn("central"))).To(BeFalse())
+		Expect(filter(shootOn("unknown"))).To(BeFalse())
+		Expect(filter(&gardenv1beta1.BackupInfrastructure{Spec: gardenv1beta1.BackupInfrastructureSpec{Seed: "agent"}})).To(BeTrue())
+		Expect(filter(&gardencorev1alpha1.ControllerInstallation{Spec: gardencorev1alpha1.ControllerInstallationSpec{SeedRef: corev1.ObjectReference{Name: "central"}}})).To(BeFalse())
+	})
+
+	It("should treat unscheduled shoots as if their seed had no labels", func() {
+		Expect(utils.SeedFilterFunc(seedLister, selector)(shootOn(""))).To(BeFalse())
+
+		excludeAgents := &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "seed.garden.sapcloud.io/agent", Operator: metav1.LabelSelectorOpDoesNotExist}}}
+		Expect(utils.SeedFilterFunc(seedLister, excludeAgents)(shootOn(""))).To(BeTrue())
+	})
+
+	It("should detect changed seed labels", func() {
+		relabeledSeed := centralSeed.DeepCopy()
+		relabeledSeed.Labels = map[string]string{"seed.garden.sapcloud.io/agent": "true"}
+
+		Expect(utils.SeedLabelsChanged(centralSeed, centralSeed.DeepCopy())).To(BeFalse())
+		Expect(utils.SeedLabelsChanged(centralSeed, relabeledSeed)).To(BeTrue())
+		Expect(utils.SeedLabelsChanged(shootOn("central"), shootOn("agent"))).To(BeFalse())
+	})
+})
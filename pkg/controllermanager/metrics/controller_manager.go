@@ -46,7 +46,7 @@ func RegisterControllerMetrics(controllers ...ControllerMetricsCollector) {
 	// and the collectors which should collect the metrics. At the end register the collector.
 	collector = controllerCollector{
 		controllers: controllers,
-		metricDescs: []*prometheus.Desc{ControllerWorkerSum},
+		metricDescs: []*prometheus.Desc{ControllerWorkerSum, ShootCondition, ShootOperationProgress, ShootHibernated},
 	}
 	prometheus.MustRegister(collector)
 
@@ -0,0 +1,32 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	shootLabels = []string{"name", "project", "seed"}
+
+	// ShootCondition is a metric descriptor which exports the current status of every condition of the Shoots.
+	ShootCondition = prometheus.NewDesc("garden_shoot_condition", "Condition of a Shoot, the value is always 1 and the status is given by the label", append(shootLabels, "condition", "status"), nil)
+
+	// ShootOperationProgress is a metric descriptor which exports the progress of the last operation of the Shoots.
+	ShootOperationProgress = prometheus.NewDesc("garden_shoot_operation_progress_percent", "Progress of the last operation of a Shoot", append(shootLabels, "operation", "state"), nil)
+
+	// ShootHibernated is a metric descriptor which exports whether the Shoots are hibernated.
+	ShootHibernated = prometheus.NewDesc("garden_shoot_hibernated", "Hibernation status of a Shoot (1 if hibernated, 0 otherwise)", shootLabels, nil)
+)
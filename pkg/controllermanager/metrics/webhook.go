@@ -0,0 +1,39 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var webhookDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "garden_cm_webhook_duration_seconds",
+	Help:    "Duration in seconds of the webhook requests served by the Gardener controller manager.",
+	Buckets: prometheus.DefBuckets,
+}, []string{"webhook", "code"})
+
+// RegisterWebhookMetrics registers the metrics of the webhooks served by the Gardener controller manager.
+func RegisterWebhookMetrics() {
+	prometheus.MustRegister(webhookDuration)
+}
+
+// InstrumentWebhook wraps the given <handler> of the webhook with the given <name> for observing the duration
+// of its requests.
+func InstrumentWebhook(name string, handler http.HandlerFunc) http.HandlerFunc {
+	return promhttp.InstrumentHandlerDuration(webhookDuration.MustCurryWith(prometheus.Labels{"webhook": name}), handler)
+}
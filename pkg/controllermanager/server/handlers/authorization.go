@@ -0,0 +1,82 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	kubernetes "k8s.io/client-go/kubernetes"
+)
+
+const bearerPrefix = "Bearer "
+
+// Authorized wraps the given handler so that it is only served to clients which present a bearer token that the
+// garden cluster accepts, and whose user is allowed to `get` the requested non-resource URL in the garden cluster.
+func Authorized(client kubernetes.Interface, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), bearerPrefix)
+		if !strings.HasPrefix(r.Header.Get("Authorization"), bearerPrefix) || len(token) == 0 {
+			http.Error(w, "missing bearer token", http.StatusUnauthorized)
+			return
+		}
+
+		tokenReview, err := client.AuthenticationV1().TokenReviews().Create(&authenticationv1.TokenReview{
+			Spec: authenticationv1.TokenReviewSpec{Token: token},
+		})
+		if err != nil {
+			utilruntime.HandleError(err)
+			http.Error(w, "could not authenticate request", http.StatusInternalServerError)
+			return
+		}
+		if !tokenReview.Status.Authenticated {
+			http.Error(w, "invalid bearer token", http.StatusUnauthorized)
+			return
+		}
+
+		user := tokenReview.Status.User
+		extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+		for key, value := range user.Extra {
+			extra[key] = authorizationv1.ExtraValue(value)
+		}
+
+		subjectAccessReview, err := client.AuthorizationV1().SubjectAccessReviews().Create(&authorizationv1.SubjectAccessReview{
+			Spec: authorizationv1.SubjectAccessReviewSpec{
+				User:   user.Username,
+				Groups: user.Groups,
+				UID:    user.UID,
+				Extra:  extra,
+				NonResourceAttributes: &authorizationv1.NonResourceAttributes{
+					Path: r.URL.Path,
+					Verb: strings.ToLower(r.Method),
+				},
+			},
+		})
+		if err != nil {
+			utilruntime.HandleError(err)
+			http.Error(w, "could not authorize request", http.StatusInternalServerError)
+			return
+		}
+		if !subjectAccessReview.Status.Allowed {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+
+		handler.ServeHTTP(w, r)
+	})
+}
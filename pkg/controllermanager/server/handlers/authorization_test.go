@@ -0,0 +1,97 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/gardener/gardener/pkg/controllermanager/server/handlers"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	testing "k8s.io/client-go/testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Authorized", func() {
+	var (
+		client  *fake.Clientset
+		handler http.Handler
+		allowed bool
+		review  *authorizationv1.SubjectAccessReview
+
+		serve = func(token string) int {
+			r := httptest.NewRequest(http.MethodGet, "/debug/flows", nil)
+			if len(token) > 0 {
+				r.Header.Set("Authorization", "Bearer "+token)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			return w.Code
+		}
+	)
+
+	BeforeEach(func() {
+		allowed = true
+		review = nil
+
+		client = fake.NewSimpleClientset()
+		client.PrependReactor("create", "tokenreviews", func(action testing.Action) (bool, runtime.Object, error) {
+			tokenReview := action.(testing.CreateAction).GetObject().(*authenticationv1.TokenReview)
+			tokenReview.Status.Authenticated = tokenReview.Spec.Token == "valid"
+			tokenReview.Status.User = authenticationv1.UserInfo{Username: "alice", Groups: []string{"operators"}}
+			return true, tokenReview, nil
+		})
+		client.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (bool, runtime.Object, error) {
+			review = action.(testing.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+			review.Status.Allowed = allowed
+			return true, review, nil
+		})
+
+		handler = Authorized(client, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+	})
+
+	It("should serve authorized users", func() {
+		Expect(serve("valid")).To(Equal(http.StatusOK))
+
+		Expect(review).NotTo(BeNil())
+		Expect(review.Spec.User).To(Equal("alice"))
+		Expect(review.Spec.Groups).To(ConsistOf("operators"))
+		Expect(review.Spec.NonResourceAttributes).To(Equal(&authorizationv1.NonResourceAttributes{Path: "/debug/flows", Verb: "get"}))
+	})
+
+	It("should reject requests without bearer token", func() {
+		Expect(serve("")).To(Equal(http.StatusUnauthorized))
+		Expect(review).To(BeNil())
+	})
+
+	It("should reject requests with invalid tokens", func() {
+		Expect(serve("invalid")).To(Equal(http.StatusUnauthorized))
+		Expect(review).To(BeNil())
+	})
+
+	It("should reject users which are not allowed to access the URL", func() {
+		allowed = false
+
+		Expect(serve("valid")).To(Equal(http.StatusForbidden))
+	})
+})
@@ -0,0 +1,48 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+// NewFlowsHandler creates a HTTP handler which dumps the state of the flows which are currently running in the
+// controller manager. The `shoot` query parameter (`<namespace>/<name>`) selects the flow of a single Shoot.
+func NewFlowsHandler(registry *flow.Registry) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var out interface{} = registry.Snapshots()
+
+		if key := r.URL.Query().Get("shoot"); len(key) > 0 {
+			snapshot, ok := registry.Snapshot(key)
+			if !ok {
+				http.Error(w, "no flow is running for shoot "+key, http.StatusNotFound)
+				return
+			}
+			out = snapshot
+		}
+
+		body, err := json.MarshalIndent(out, "", "  ")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/gardener/gardener/pkg/controllermanager/server/handlers"
+	"github.com/gardener/gardener/pkg/utils/flow"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Flows", func() {
+	var (
+		registry *flow.Registry
+		release  chan struct{}
+		done     chan struct{}
+
+		get = func(url string) *httptest.ResponseRecorder {
+			w := httptest.NewRecorder()
+			NewFlowsHandler(registry)(w, httptest.NewRequest(http.MethodGet, url, nil))
+			return w
+		}
+	)
+
+	BeforeEach(func() {
+		registry = flow.NewRegistry()
+		release = make(chan struct{})
+		done = make(chan struct{})
+
+		var (
+			started = make(chan struct{})
+			g       = flow.NewGraph("Shoot cluster reconciliation")
+			_       = g.Add(flow.Task{Name: "Deploying Shoot infrastructure", Fn: func(ctx context.Context) error {
+				close(started)
+				<-release
+				return nil
+			}})
+			f = g.Compile()
+		)
+
+		tracker, untrack := registry.Track("garden-dev/foo")
+		go func() {
+			defer close(done)
+			defer untrack()
+			f.Run(flow.Opts{Tracker: tracker})
+		}()
+		<-started
+	})
+
+	AfterEach(func() {
+		close(release)
+		<-done
+	})
+
+	It("should dump all running flows", func() {
+		w := get("/debug/flows")
+		Expect(w.Code).To(Equal(http.StatusOK))
+
+		snapshots := map[string]*flow.Snapshot{}
+		Expect(json.Unmarshal(w.Body.Bytes(), &snapshots)).To(Succeed())
+		Expect(snapshots).To(HaveKey("garden-dev/foo"))
+		Expect(snapshots["garden-dev/foo"].Name).To(Equal("Shoot cluster reconciliation"))
+	})
+
+	It("should dump the flow of the given Shoot", func() {
+		w := get("/debug/flows?shoot=garden-dev/foo")
+		Expect(w.Code).To(Equal(http.StatusOK))
+
+		snapshot := &flow.Snapshot{}
+		Expect(json.Unmarshal(w.Body.Bytes(), snapshot)).To(Succeed())
+		Expect(snapshot.Tasks).To(HaveLen(1))
+		Expect(snapshot.Tasks[0].ID).To(Equal(flow.TaskID("Deploying Shoot infrastructure")))
+		Expect(snapshot.Tasks[0].State).To(Equal(flow.TaskStateRunning))
+	})
+
+	It("should return not found if no flow is running for the given Shoot", func() {
+		Expect(get("/debug/flows?shoot=garden-dev/bar").Code).To(Equal(http.StatusNotFound))
+	})
+})
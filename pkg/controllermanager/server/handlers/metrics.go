@@ -21,6 +21,7 @@ import (
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -32,6 +33,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
 type metrics struct {
@@ -69,18 +71,29 @@ func (m metrics) initShootMetrics() {
 		Help: "Conditions of a Shoot cluster",
 	}, []string{"name", "project", "condition", "status", "operation", "mail_to"})
 
+	metricShootAPIServerSLO := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "garden_shoot_apiserver_slo",
+		Help: "Service level objective attainment in percent of the API server of a Shoot cluster within a rolling window of 30 days",
+	}, []string{"name", "project", "sli"})
+
 	prometheus.Register(metricShootState)
 	prometheus.Register(metricShootNodeCount)
 	prometheus.Register(metricShootStateConditions)
+	prometheus.Register(metricShootAPIServerSLO)
 
 	m.collect(func() {
-		shoots, err := m.k8sGardenClient.Garden().GardenV1beta1().Shoots(metav1.NamespaceAll).List(metav1.ListOptions{})
-		if err != nil {
+		var shoots []*gardenv1beta1.Shoot
+		if err := kutil.EachListItem(func(options metav1.ListOptions) (runtime.Object, error) {
+			return m.k8sGardenClient.Garden().GardenV1beta1().Shoots(metav1.NamespaceAll).List(options)
+		}, func(obj runtime.Object) error {
+			shoots = append(shoots, obj.(*gardenv1beta1.Shoot))
+			return nil
+		}); err != nil {
 			logger.Logger.Info("Unable to fetch shoots. skip shoot metric set...")
 			return
 		}
 
-		for _, shoot := range shoots.Items {
+		for _, shoot := range shoots {
 			var (
 				mailTo         string
 				nodeCount      int
@@ -146,6 +159,20 @@ func (m metrics) initShootMetrics() {
 				}).Set(conditionStatus)
 			}
 
+			if slo := shoot.Status.APIServerSLO; slo != nil {
+				for sli, percentage := range map[string]string{"availability": slo.Availability, "latency": slo.Latency} {
+					value, err := strconv.ParseFloat(percentage, 64)
+					if err != nil {
+						continue
+					}
+					metricShootAPIServerSLO.With(prometheus.Labels{
+						"name":    shoot.Name,
+						"project": shoot.Namespace,
+						"sli":     sli,
+					}).Set(value)
+				}
+			}
+
 			// Collect the count of nodes
 			switch cloud {
 			case gardenv1beta1.CloudProviderAWS:
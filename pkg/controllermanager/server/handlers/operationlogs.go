@@ -0,0 +1,82 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/logger"
+)
+
+// OperationLogsPath is the path prefix of the operation logs of the Shoots, the full path is
+// `/operationlogs/<namespace>/<name>`.
+const OperationLogsPath = "/operationlogs/"
+
+// NewOperationLogsHandler creates a HTTP handler which streams the log of the operation which is currently running
+// for a Shoot as plain text. The `tailLines` query parameter limits the number of initially returned lines, and with
+// `follow=true` the handler keeps on streaming new lines until the operation finishes or the client disconnects.
+func NewOperationLogsHandler(operationLogs *logger.OperationLogs) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, OperationLogsPath), "/")
+		if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+			http.Error(w, fmt.Sprintf("path must be %s<namespace>/<name>", OperationLogsPath), http.StatusBadRequest)
+			return
+		}
+		key := parts[0] + "/" + parts[1]
+
+		var (
+			query     = r.URL.Query()
+			tailLines int
+			follow    bool
+			err       error
+		)
+		if value := query.Get("tailLines"); len(value) > 0 {
+			if tailLines, err = strconv.Atoi(value); err != nil || tailLines < 0 {
+				http.Error(w, "tailLines must be a non-negative integer", http.StatusBadRequest)
+				return
+			}
+		}
+		if value := query.Get("follow"); len(value) > 0 {
+			if follow, err = strconv.ParseBool(value); err != nil {
+				http.Error(w, "follow must be a boolean", http.StatusBadRequest)
+				return
+			}
+		}
+
+		log, ok := operationLogs.Get(key)
+		if !ok {
+			http.Error(w, "no operation is running for shoot "+key, http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.WriteHeader(http.StatusOK)
+		flusher, _ := w.(http.Flusher)
+
+		log.Follow(r.Context(), tailLines, follow, func(lines []string) error {
+			if _, err := fmt.Fprintln(w, strings.Join(lines, "\n")); err != nil {
+				return err
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+			return nil
+		})
+	}
+}
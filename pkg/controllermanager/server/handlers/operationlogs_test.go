@@ -0,0 +1,100 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers_test
+
+import (
+	"bufio"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/gardener/gardener/pkg/controllermanager/server/handlers"
+	"github.com/gardener/gardener/pkg/logger"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("OperationLogs", func() {
+	var (
+		operationLogs *logger.OperationLogs
+		shootLogger   *logrus.Entry
+		finish        func()
+
+		get = func(url string) *httptest.ResponseRecorder {
+			w := httptest.NewRecorder()
+			NewOperationLogsHandler(operationLogs)(w, httptest.NewRequest(http.MethodGet, url, nil))
+			return w
+		}
+	)
+
+	BeforeEach(func() {
+		operationLogs = logger.NewOperationLogs(logger.DefaultOperationLogLines)
+		l := &logrus.Logger{Out: ioutil.Discard, Level: logrus.InfoLevel, Formatter: &logrus.TextFormatter{}, Hooks: make(logrus.LevelHooks)}
+		l.AddHook(operationLogs)
+		shootLogger = logger.NewShootLogger(l, "foo", "garden-dev", "")
+
+		finish = operationLogs.Start("garden-dev/foo")
+		shootLogger.Info("Deploying Shoot infrastructure")
+		shootLogger.Info("Waiting for Terraform Job")
+	})
+
+	It("should return the log of the running operation", func() {
+		w := get("/operationlogs/garden-dev/foo")
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(w.Header().Get("Content-Type")).To(Equal("text/plain; charset=utf-8"))
+		Expect(w.Body.String()).To(And(ContainSubstring("Deploying Shoot infrastructure\n"), ContainSubstring("Waiting for Terraform Job\n")))
+	})
+
+	It("should only return the requested number of lines", func() {
+		w := get("/operationlogs/garden-dev/foo?tailLines=1")
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(w.Body.String()).NotTo(ContainSubstring("Deploying Shoot infrastructure"))
+		Expect(w.Body.String()).To(ContainSubstring("Waiting for Terraform Job\n"))
+	})
+
+	It("should follow the log until the operation finishes", func() {
+		server := httptest.NewServer(NewOperationLogsHandler(operationLogs))
+		defer server.Close()
+
+		resp, err := http.Get(server.URL + "/operationlogs/garden-dev/foo?follow=true")
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+		reader := bufio.NewReader(resp.Body)
+		Expect(reader.ReadString('\n')).To(ContainSubstring("Deploying Shoot infrastructure"))
+		Expect(reader.ReadString('\n')).To(ContainSubstring("Waiting for Terraform Job"))
+
+		shootLogger.Info("Terraform Job finished")
+		finish()
+
+		rest, err := ioutil.ReadAll(reader)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(rest)).To(ContainSubstring("Terraform Job finished\n"))
+	})
+
+	It("should return 404 if no operation is running for the shoot", func() {
+		finish()
+		Expect(get("/operationlogs/garden-dev/foo").Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("should reject invalid requests", func() {
+		Expect(get("/operationlogs/garden-dev").Code).To(Equal(http.StatusBadRequest))
+		Expect(get("/operationlogs/garden-dev/foo?tailLines=-1").Code).To(Equal(http.StatusBadRequest))
+		Expect(get("/operationlogs/garden-dev/foo?follow=maybe").Code).To(Equal(http.StatusBadRequest))
+	})
+})
@@ -0,0 +1,264 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
+	auditinstall "k8s.io/apiserver/pkg/apis/audit/install"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// EventReasonKubeconfigRead is the reason of events which are recorded for Shoots whose kubeconfig secret has
+	// been read in the garden cluster.
+	EventReasonKubeconfigRead = "KubeconfigRead"
+	// EventReasonSSHKeypairRead is the reason of events which are recorded for Shoots whose SSH key pair secret has
+	// been read in the garden cluster.
+	EventReasonSSHKeypairRead = "SSHKeypairRead"
+
+	// accessAuditResolution is the minimal duration between two updates of the same read timestamp in the access
+	// audit of a Shoot, so that frequent reads do not cause an update of the Shoot status for every single read.
+	accessAuditResolution = time.Minute
+)
+
+// credentialsSecret describes a secret which is synced for every Shoot into its project namespace and whose reads
+// are audited.
+type credentialsSecret struct {
+	suffix       string
+	reason       string
+	lastReadTime func(*gardenv1beta1.ShootAccessAudit) **metav1.Time
+}
+
+var credentialsSecrets = []credentialsSecret{
+	{
+		suffix:       ".kubeconfig",
+		reason:       EventReasonKubeconfigRead,
+		lastReadTime: func(a *gardenv1beta1.ShootAccessAudit) **metav1.Time { return &a.LastKubeconfigReadTime },
+	},
+	{
+		suffix:       ".ssh-keypair",
+		reason:       EventReasonSSHKeypairRead,
+		lastReadTime: func(a *gardenv1beta1.ShootAccessAudit) **metav1.Time { return &a.LastSSHKeypairReadTime },
+	},
+}
+
+type kubeconfigAccessHandler struct {
+	gardenClient  client.Client
+	projectLister gardenlisters.ProjectLister
+	shootLister   gardenlisters.ShootLister
+	recorder      record.EventRecorder
+	// gardenerUsers are the users as which Gardener itself accesses the garden cluster. Their reads are not audited
+	// as Gardener reads the secrets when it syncs them with every reconciliation of a Shoot.
+	gardenerUsers sets.String
+
+	codecs serializer.CodecFactory
+}
+
+// NewAuditKubeconfigAccessHandler creates a new handler for audit events of the garden cluster which records an
+// event on a Shoot whenever its kubeconfig or SSH key pair secret in the project namespace is read, and which
+// maintains the respective timestamps in the access audit of the Shoot status. Reads of the given <gardenerUsers> are
+// ignored.
+func NewAuditKubeconfigAccessHandler(gardenClient client.Client, projectLister gardenlisters.ProjectLister, shootLister gardenlisters.ShootLister, recorder record.EventRecorder, gardenerUsers sets.String) func(http.ResponseWriter, *http.Request) {
+	scheme := runtime.NewScheme()
+	auditinstall.Install(scheme)
+
+	h := &kubeconfigAccessHandler{gardenClient, projectLister, shootLister, recorder, gardenerUsers, serializer.NewCodecFactory(scheme)}
+	return h.AuditKubeconfigAccess
+}
+
+// AuditKubeconfigAccess is a HTTP handler for the audit webhook backend of the garden cluster's kube-apiserver.
+func (h *kubeconfigAccessHandler) AuditKubeconfigAccess(w http.ResponseWriter, r *http.Request) {
+	var eventList = auditv1.EventList{}
+
+	if r.Body == nil {
+		http.Error(w, "missing request body", http.StatusBadRequest)
+		return
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if _, _, err := h.codecs.UniversalDeserializer().Decode(body, nil, &eventList); err != nil {
+		logger.Logger.Errorf("Could not decode audit events: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	for _, event := range eventList.Items {
+		if err := h.recordKubeconfigAccess(event); err != nil {
+			logger.Logger.Errorf("Could not record kubeconfig access of audit event %s: %v", event.AuditID, err)
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// recordKubeconfigAccess records an event on every Shoot whose kubeconfig or SSH key pair secret has been exposed
+// by the given audit event. Named reads only concern the respective Shoot, lists and watches concern all Shoots of
+// the namespace and both of their secrets.
+func (h *kubeconfigAccessHandler) recordKubeconfigAccess(event auditv1.Event) error {
+	ref := event.ObjectRef
+	if event.Stage != auditv1.StageResponseComplete || ref == nil || ref.APIGroup != "" || ref.Resource != "secrets" || len(ref.Subresource) > 0 || len(ref.Namespace) == 0 {
+		return nil
+	}
+	if event.ResponseStatus != nil && event.ResponseStatus.Code >= http.StatusBadRequest {
+		return nil
+	}
+	if h.gardenerUsers.Has(event.User.Username) {
+		return nil
+	}
+
+	var (
+		shootNames []string
+		secrets    []credentialsSecret
+	)
+	switch event.Verb {
+	case "get":
+		for _, secret := range credentialsSecrets {
+			if strings.HasSuffix(ref.Name, secret.suffix) {
+				shootNames = append(shootNames, strings.TrimSuffix(ref.Name, secret.suffix))
+				secrets = append(secrets, secret)
+				break
+			}
+		}
+	case "list", "watch":
+		if len(ref.Name) > 0 {
+			return nil
+		}
+		shoots, err := h.shootLister.Shoots(ref.Namespace).List(labels.Everything())
+		if err != nil {
+			return err
+		}
+		for _, shoot := range shoots {
+			shootNames = append(shootNames, shoot.Name)
+		}
+		secrets = credentialsSecrets
+	default:
+		return nil
+	}
+
+	if len(shootNames) == 0 {
+		return nil
+	}
+
+	if _, err := common.ProjectForNamespace(h.projectLister, ref.Namespace); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Namespace does not belong to a project.
+			return nil
+		}
+		return err
+	}
+
+	readTime := metav1.NewTime(event.StageTimestamp.Time)
+	if readTime.IsZero() {
+		readTime = metav1.Now()
+	}
+
+	for _, name := range shootNames {
+		shoot, err := h.shootLister.Shoots(ref.Namespace).Get(name)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		message := kubeconfigAccessMessage(event)
+		for _, secret := range secrets {
+			logger.Logger.Infof("[AUDIT] Shoot %s/%s: %s: %s", shoot.Namespace, shoot.Name, secret.reason, message)
+			h.recorder.Event(shoot, corev1.EventTypeNormal, secret.reason, message)
+		}
+
+		if err := h.updateAccessAudit(shoot, secrets, readTime); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateAccessAudit updates the read timestamps of the given secrets in the access audit of the Shoot status. The
+// timestamps are only updated if they are older than the resolution of the access audit.
+func (h *kubeconfigAccessHandler) updateAccessAudit(shoot *gardenv1beta1.Shoot, secrets []credentialsSecret, readTime metav1.Time) error {
+	if !accessAuditOutdated(shoot.Status.AccessAudit, secrets, readTime) {
+		return nil
+	}
+
+	newShoot := shoot.DeepCopy()
+	err := kutil.TryUpdateStatus(context.TODO(), kutil.DefaultBackoff, h.gardenClient, newShoot, func() error {
+		if newShoot.Status.AccessAudit == nil {
+			newShoot.Status.AccessAudit = &gardenv1beta1.ShootAccessAudit{}
+		}
+		for _, secret := range secrets {
+			if lastReadTime := secret.lastReadTime(newShoot.Status.AccessAudit); *lastReadTime == nil || (*lastReadTime).Before(&readTime) {
+				*lastReadTime = readTime.DeepCopy()
+			}
+		}
+		return nil
+	})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func accessAuditOutdated(accessAudit *gardenv1beta1.ShootAccessAudit, secrets []credentialsSecret, readTime metav1.Time) bool {
+	if accessAudit == nil {
+		return true
+	}
+	for _, secret := range secrets {
+		lastReadTime := *secret.lastReadTime(accessAudit)
+		if lastReadTime == nil || lastReadTime.Add(accessAuditResolution).Before(readTime.Time) {
+			return true
+		}
+	}
+	return false
+}
+
+func kubeconfigAccessMessage(event auditv1.Event) string {
+	target := fmt.Sprintf("secret %q", event.ObjectRef.Name)
+	if len(event.ObjectRef.Name) == 0 {
+		target = "secrets of the namespace"
+	}
+
+	message := fmt.Sprintf("User %q (groups: %s) performed %q on %s from %s", event.User.Username, strings.Join(event.User.Groups, ","), event.Verb, target, strings.Join(event.SourceIPs, ","))
+	if event.ImpersonatedUser != nil {
+		message += fmt.Sprintf(" impersonating user %q", event.ImpersonatedUser.Username)
+	}
+	return message
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhooks_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/server/handlers/webhooks"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuditKubeconfigAccess", func() {
+	var (
+		namespace = "garden-dev"
+
+		gardenClient client.Client
+		recorder     *record.FakeRecorder
+		handler      func(http.ResponseWriter, *http.Request)
+
+		event = func(verb, name string) auditv1.Event {
+			return auditv1.Event{
+				TypeMeta: metav1.TypeMeta{APIVersion: "audit.k8s.io/v1", Kind: "Event"},
+				Stage:    auditv1.StageResponseComplete,
+				Verb:     verb,
+				User:     authenticationv1.UserInfo{Username: "alice"},
+				ObjectRef: &auditv1.ObjectReference{
+					Resource:  "secrets",
+					Namespace: namespace,
+					Name:      name,
+				},
+			}
+		}
+
+		send = func(events ...auditv1.Event) int {
+			body, err := json.Marshal(auditv1.EventList{
+				TypeMeta: metav1.TypeMeta{APIVersion: "audit.k8s.io/v1", Kind: "EventList"},
+				Items:    events,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			w := httptest.NewRecorder()
+			handler(w, httptest.NewRequest(http.MethodPost, "/webhooks/audit-kubeconfig-access", bytes.NewReader(body)))
+			return w.Code
+		}
+	)
+
+	BeforeEach(func() {
+		informerFactory := gardeninformers.NewSharedInformerFactory(nil, 0)
+		projectInformer := informerFactory.Garden().V1beta1().Projects()
+		shootInformer := informerFactory.Garden().V1beta1().Shoots()
+
+		Expect(projectInformer.Informer().GetStore().Add(&gardenv1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+			Spec:       gardenv1beta1.ProjectSpec{Namespace: &namespace},
+		})).To(Succeed())
+		var shoots []runtime.Object
+		for _, name := range []string{"foo", "bar"} {
+			shoot := &gardenv1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			}
+			Expect(shootInformer.Informer().GetStore().Add(shoot)).To(Succeed())
+			shoots = append(shoots, shoot)
+		}
+		gardenClient = fakeclient.NewFakeClientWithScheme(kubernetes.GardenScheme, shoots...)
+
+		recorder = record.NewFakeRecorder(10)
+		handler = NewAuditKubeconfigAccessHandler(gardenClient, projectInformer.Lister(), shootInformer.Lister(), recorder, sets.NewString("system:serviceaccount:garden:gardener-controller-manager"))
+	})
+
+	getAccessAudit := func(name string) *gardenv1beta1.ShootAccessAudit {
+		shoot := &gardenv1beta1.Shoot{}
+		Expect(gardenClient.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: name}, shoot)).To(Succeed())
+		return shoot.Status.AccessAudit
+	}
+
+	It("should record an event for a read kubeconfig secret", func() {
+		Expect(send(event("get", "foo.kubeconfig"))).To(Equal(http.StatusOK))
+
+		Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonKubeconfigRead)))
+		Expect(recorder.Events).NotTo(Receive())
+
+		accessAudit := getAccessAudit("foo")
+		Expect(accessAudit).NotTo(BeNil())
+		Expect(accessAudit.LastKubeconfigReadTime).NotTo(BeNil())
+		Expect(accessAudit.LastSSHKeypairReadTime).To(BeNil())
+		Expect(getAccessAudit("bar")).To(BeNil())
+	})
+
+	It("should record an event for a read SSH key pair secret", func() {
+		Expect(send(event("get", "foo.ssh-keypair"))).To(Equal(http.StatusOK))
+
+		Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonSSHKeypairRead)))
+		Expect(recorder.Events).NotTo(Receive())
+
+		accessAudit := getAccessAudit("foo")
+		Expect(accessAudit).NotTo(BeNil())
+		Expect(accessAudit.LastSSHKeypairReadTime).NotTo(BeNil())
+		Expect(accessAudit.LastKubeconfigReadTime).To(BeNil())
+	})
+
+	It("should record events for every Shoot of the namespace for listed secrets", func() {
+		Expect(send(event("list", ""))).To(Equal(http.StatusOK))
+
+		Expect(recorder.Events).To(HaveLen(4))
+		for _, name := range []string{"foo", "bar"} {
+			accessAudit := getAccessAudit(name)
+			Expect(accessAudit).NotTo(BeNil())
+			Expect(accessAudit.LastKubeconfigReadTime).NotTo(BeNil())
+			Expect(accessAudit.LastSSHKeypairReadTime).NotTo(BeNil())
+		}
+	})
+
+	It("should use the time of the audit event and not move the read time backwards", func() {
+		readTime := metav1.NewTime(time.Date(2019, 5, 1, 12, 0, 0, 0, time.UTC))
+		read := event("get", "foo.kubeconfig")
+		read.StageTimestamp = metav1.NewMicroTime(readTime.Time)
+		earlierRead := event("get", "foo.kubeconfig")
+		earlierRead.StageTimestamp = metav1.NewMicroTime(readTime.Add(-time.Hour))
+
+		Expect(send(read)).To(Equal(http.StatusOK))
+		Expect(send(earlierRead)).To(Equal(http.StatusOK))
+
+		Expect(getAccessAudit("foo").LastKubeconfigReadTime.Time.Equal(readTime.Time)).To(BeTrue())
+	})
+
+	It("should ignore other secrets, verbs and namespaces", func() {
+		other := event("get", "foo.kubeconfig")
+		other.ObjectRef.Namespace = "kube-system"
+
+		Expect(send(event("get", "foo.token"), event("update", "foo.kubeconfig"), other)).To(Equal(http.StatusOK))
+
+		Expect(recorder.Events).NotTo(Receive())
+		Expect(getAccessAudit("foo")).To(BeNil())
+	})
+
+	It("should ignore reads of Gardener itself", func() {
+		gardenerEvent := event("get", "foo.kubeconfig")
+		gardenerEvent.User.Username = "system:serviceaccount:garden:gardener-controller-manager"
+
+		Expect(send(gardenerEvent)).To(Equal(http.StatusOK))
+
+		Expect(recorder.Events).NotTo(Receive())
+		Expect(getAccessAudit("foo")).To(BeNil())
+	})
+
+	It("should ignore failed requests", func() {
+		failed := event("get", "foo.kubeconfig")
+		failed.ResponseStatus = &metav1.Status{Code: http.StatusForbidden}
+
+		Expect(send(failed)).To(Equal(http.StatusOK))
+
+		Expect(recorder.Events).NotTo(Receive())
+	})
+
+	It("should reject invalid request bodies", func() {
+		w := httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodPost, "/webhooks/audit-kubeconfig-access", bytes.NewReader([]byte("{"))))
+
+		Expect(w.Code).To(Equal(http.StatusBadRequest))
+	})
+})
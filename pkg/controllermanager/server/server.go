@@ -16,24 +16,37 @@ package server
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"net/http/pprof"
+	goruntime "runtime"
 	"time"
 
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	gardenmetrics "github.com/gardener/gardener/pkg/controllermanager/metrics"
 	"github.com/gardener/gardener/pkg/controllermanager/server/handlers"
 	"github.com/gardener/gardener/pkg/controllermanager/server/handlers/webhooks"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/utils/flow"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	componentbaseconfig "k8s.io/component-base/config"
 )
 
-// Serve starts a HTTP and a HTTPS server.
-func Serve(ctx context.Context, k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, serverConfig config.ServerConfiguration) {
+// Serve starts a HTTP and a HTTPS server. The debug endpoints and the audit webhook of the HTTPS server are only
+// served to users of the garden cluster which are allowed to access the respective non-resource URLs.
+func Serve(ctx context.Context, k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, serverConfig config.ServerConfiguration, debuggingConfig *componentbaseconfig.DebuggingConfiguration, flowRegistry *flow.Registry, operationLogs *logger.OperationLogs, recorder record.EventRecorder) {
 	var (
 		listenAddressHTTP  = fmt.Sprintf("%s:%d", serverConfig.HTTP.BindAddress, serverConfig.HTTP.Port)
 		listenAddressHTTPS = fmt.Sprintf("%s:%d", serverConfig.HTTPS.BindAddress, serverConfig.HTTPS.Port)
@@ -68,7 +81,31 @@ func Serve(ctx context.Context, k8sGardenClient kubernetes.Interface, k8sGardenI
 	}
 
 	// Add handlers to HTTPS server and start it.
-	serverMuxHTTPS.HandleFunc("/webhooks/validate-namespace-deletion", webhooks.NewValidateNamespaceDeletionHandler(k8sGardenClient, projectInformer.Lister(), backupInfrastructureInformer.Lister(), shootInformer.Lister()))
+	gardenmetrics.RegisterWebhookMetrics()
+	serverMuxHTTPS.HandleFunc("/webhooks/validate-namespace-deletion", gardenmetrics.InstrumentWebhook("validate-namespace-deletion", webhooks.NewValidateNamespaceDeletionHandler(k8sGardenClient, projectInformer.Lister(), backupInfrastructureInformer.Lister(), shootInformer.Lister())))
+
+	authorized := func(handler http.HandlerFunc) http.Handler {
+		return handlers.Authorized(k8sGardenClient.Kubernetes(), handler)
+	}
+	gardenerUsers := sets.NewString()
+	if username, err := gardenerUsername(k8sGardenClient); err != nil {
+		logger.Logger.Errorf("Could not determine the user of the Gardener controller manager, its own kubeconfig reads will be audited: %v", err)
+	} else if len(username) > 0 {
+		gardenerUsers.Insert(username)
+	}
+	serverMuxHTTPS.Handle("/webhooks/audit-kubeconfig-access", authorized(gardenmetrics.InstrumentWebhook("audit-kubeconfig-access", webhooks.NewAuditKubeconfigAccessHandler(k8sGardenClient.Client(), projectInformer.Lister(), shootInformer.Lister(), recorder, gardenerUsers))))
+	serverMuxHTTPS.Handle("/debug/flows", authorized(handlers.NewFlowsHandler(flowRegistry)))
+	serverMuxHTTPS.Handle(handlers.OperationLogsPath, authorized(handlers.NewOperationLogsHandler(operationLogs)))
+	if debuggingConfig != nil && debuggingConfig.EnableProfiling {
+		serverMuxHTTPS.Handle("/debug/pprof/", authorized(pprof.Index))
+		serverMuxHTTPS.Handle("/debug/pprof/cmdline", authorized(pprof.Cmdline))
+		serverMuxHTTPS.Handle("/debug/pprof/profile", authorized(pprof.Profile))
+		serverMuxHTTPS.Handle("/debug/pprof/symbol", authorized(pprof.Symbol))
+		serverMuxHTTPS.Handle("/debug/pprof/trace", authorized(pprof.Trace))
+		if debuggingConfig.EnableContentionProfiling {
+			goruntime.SetBlockProfileRate(1)
+		}
+	}
 
 	go func() {
 		logger.Logger.Infof("Starting HTTPS server on %s", listenAddressHTTPS)
@@ -89,3 +126,52 @@ func Serve(ctx context.Context, k8sGardenClient kubernetes.Interface, k8sGardenI
 	}
 	logger.Logger.Info("HTTP(S) servers stopped.")
 }
+
+// gardenerUsername returns the name of the user as which the Gardener controller manager accesses the garden cluster.
+// It is reviewed by the garden cluster for bearer tokens (e.g., the token of the service account) and taken from the
+// common name of client certificates. An empty name is returned for other kinds of credentials.
+func gardenerUsername(k8sGardenClient kubernetes.Interface) (string, error) {
+	restConfig := k8sGardenClient.RESTConfig()
+
+	token, err := readDataOrFile([]byte(restConfig.BearerToken), restConfig.BearerTokenFile)
+	if err != nil {
+		return "", err
+	}
+	if len(token) > 0 {
+		tokenReview, err := k8sGardenClient.Kubernetes().AuthenticationV1().TokenReviews().Create(&authenticationv1.TokenReview{
+			Spec: authenticationv1.TokenReviewSpec{Token: string(token)},
+		})
+		if err != nil {
+			return "", err
+		}
+		if !tokenReview.Status.Authenticated {
+			return "", errors.New("the garden cluster did not authenticate the token of the controller manager")
+		}
+		return tokenReview.Status.User.Username, nil
+	}
+
+	certData, err := readDataOrFile(restConfig.CertData, restConfig.CertFile)
+	if err != nil {
+		return "", err
+	}
+	if len(certData) == 0 {
+		return "", nil
+	}
+
+	block, _ := pem.Decode(certData)
+	if block == nil {
+		return "", errors.New("could not decode the client certificate of the controller manager")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return "", err
+	}
+	return cert.Subject.CommonName, nil
+}
+
+func readDataOrFile(data []byte, file string) ([]byte, error) {
+	if len(data) > 0 || len(file) == 0 {
+		return data, nil
+	}
+	return ioutil.ReadFile(file)
+}
@@ -40,4 +40,27 @@ const (
 	// owner @wyb1
 	// alpha: v0.1.0
 	VPA utilfeature.Feature = "VPA"
+
+	// CustomMachineImages allows Shoot worker pools to reference custom machine images.
+	// owner @gardener/gardener-maintainers
+	// alpha: v0.23.0
+	CustomMachineImages utilfeature.Feature = "CustomMachineImages"
+
+	// OutOfTreeCloudControllerManager runs the out-of-tree cloud-controller-manager of the cloud provider instead of
+	// the in-tree one for Shoots on OpenStack and Azure.
+	// owner @gardener/gardener-maintainers
+	// alpha: v0.24.0
+	OutOfTreeCloudControllerManager utilfeature.Feature = "OutOfTreeCloudControllerManager"
+
+	// GCPMinimalServiceAccount creates a service account with minimal permissions for the machine-controller-manager
+	// of every Shoot on GCP instead of passing the service account of the cloud provider secret to it.
+	// owner @gardener/gardener-maintainers
+	// alpha: v0.24.0
+	GCPMinimalServiceAccount utilfeature.Feature = "GCPMinimalServiceAccount"
+
+	// ShootReconcileChangeDetection skips the reconciliation flow of Shoots whose effective desired state has not
+	// changed since their last successful reconciliation.
+	// owner @gardener/gardener-maintainers
+	// alpha: v0.24.0
+	ShootReconcileChangeDetection utilfeature.Feature = "ShootReconcileChangeDetection"
 )
@@ -0,0 +1,88 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ghodss/yaml"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Configuration is the configuration of a Garden cluster installation performed by gardener-install.
+type Configuration struct {
+	// Version is the version of the Gardener which is installed, i.e., the tag of the images of the Gardener API
+	// server and the Gardener controller manager.
+	Version string `json:"version"`
+	// Etcd configures the etcd storing the resources served by the Gardener API server.
+	Etcd EtcdConfiguration `json:"etcd"`
+	// Values are additional values for the Gardener chart (see charts/gardener/values.yaml), e.g., the internal domain
+	// or the controller manager configuration. They take precedence over the values computed by gardener-install.
+	Values map[string]interface{} `json:"values,omitempty"`
+}
+
+// EtcdConfiguration configures the etcd storing the resources served by the Gardener API server.
+type EtcdConfiguration struct {
+	// Deploy specifies whether an etcd is deployed into the Garden cluster (defaults to true). The deployed etcd
+	// consists of a single member without backups and is not suitable for productive landscapes. If it is false, the
+	// connection to an existing etcd must be configured in the values of the Gardener chart (global.apiserver.etcd).
+	Deploy *bool `json:"deploy,omitempty"`
+	// StorageClassName is the name of the storage class of the etcd volume (defaults to the default storage class).
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// StorageSize is the size of the etcd volume (defaults to 10Gi).
+	StorageSize string `json:"storageSize,omitempty"`
+}
+
+const defaultEtcdStorageSize = "10Gi"
+
+// LoadConfiguration reads the configuration file <path>, defaults and validates it.
+func LoadConfiguration(path string) (*Configuration, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return decodeConfiguration(data)
+}
+
+func decodeConfiguration(data []byte) (*Configuration, error) {
+	config := &Configuration{}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("could not decode configuration: %v", err)
+	}
+
+	if config.Etcd.Deploy == nil {
+		deploy := true
+		config.Etcd.Deploy = &deploy
+	}
+	if len(config.Etcd.StorageSize) == 0 {
+		config.Etcd.StorageSize = defaultEtcdStorageSize
+	}
+
+	if err := validateConfiguration(config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func validateConfiguration(config *Configuration) error {
+	if len(config.Version) == 0 {
+		return fmt.Errorf("the version of the Gardener must be given")
+	}
+	if _, err := resource.ParseQuantity(config.Etcd.StorageSize); err != nil {
+		return fmt.Errorf("invalid etcd storage size %q: %v", config.Etcd.StorageSize, err)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Bridge package to expose internal functions to tests in the install_test package.
+
+package install
+
+import (
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	ExportDecodeConfiguration = decodeConfiguration
+	ExportGardenerValues      = gardenerValues
+	ExportScheme              = scheme
+)
+
+// ExportNewInstaller creates a new Installer which uses the given clients.
+func ExportNewInstaller(client kubernetes.Interface, chartApplier kubernetes.ChartApplier, chartPath string, config *Configuration, logger logrus.FieldLogger) *Installer {
+	return &Installer{
+		client:       client,
+		chartApplier: chartApplier,
+		chartPath:    chartPath,
+		config:       config,
+		logger:       logger,
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	gardencorescheme "github.com/gardener/gardener/pkg/client/core/clientset/versioned/scheme"
+	gardenscheme "github.com/gardener/gardener/pkg/client/garden/clientset/versioned/scheme"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	corescheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	apiregistrationv1beta1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1beta1"
+	apiregistrationscheme "k8s.io/kube-aggregator/pkg/client/clientset_generated/clientset/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultInterval = 5 * time.Second
+	defaultTimeout  = 10 * time.Minute
+)
+
+var (
+	// apiServices are the APIServices registered by the Gardener chart.
+	apiServices = []string{
+		"v1beta1.garden.sapcloud.io",
+		"v1alpha1.core.gardener.cloud",
+	}
+
+	// scheme contains the types of the Garden cluster and the APIServices registered by the Gardener chart.
+	scheme = runtime.NewScheme()
+)
+
+func init() {
+	schemeBuilder := runtime.NewSchemeBuilder(
+		corescheme.AddToScheme,
+		gardenscheme.AddToScheme,
+		gardencorescheme.AddToScheme,
+		apiregistrationscheme.AddToScheme,
+	)
+	utilruntime.Must(schemeBuilder.AddToScheme(scheme))
+}
+
+// Installer installs the Gardener into a Garden cluster.
+type Installer struct {
+	client       kubernetes.Interface
+	chartApplier kubernetes.ChartApplier
+	chartPath    string
+	config       *Configuration
+	logger       logrus.FieldLogger
+
+	secrets map[string]*corev1.Secret
+}
+
+// New creates a new Installer which installs the Gardener into the cluster of the given <restConfig> according to
+// the given <config>. The charts and the image vector are read from <chartPath>.
+func New(restConfig *rest.Config, config *Configuration, chartPath string, logger logrus.FieldLogger) (*Installer, error) {
+	k8sClient, err := kubernetes.NewForConfig(restConfig, client.Options{
+		Scheme: scheme,
+	})
+	if err != nil {
+		return nil, err
+	}
+	chartApplier, err := kubernetes.NewChartApplierForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Installer{
+		client:       k8sClient,
+		chartApplier: chartApplier,
+		chartPath:    chartPath,
+		config:       config,
+		logger:       logger,
+	}, nil
+}
+
+// Install deploys etcd, the certificates, the Gardener API server and the Gardener controller manager and waits until
+// they are ready. It can be executed repeatedly, e.g. to update the Gardener, as the generated certificates are kept
+// in the Garden cluster.
+func (i *Installer) Install(ctx context.Context) error {
+	var (
+		deployEtcd = *i.config.Etcd.Deploy
+
+		g               = flow.NewGraph("Gardener installation")
+		deployNamespace = g.Add(flow.Task{
+			Name: "Deploying Gardener namespace",
+			Fn:   flow.SimpleTaskFn(i.deployNamespace),
+		})
+		deploySecrets = g.Add(flow.Task{
+			Name:         "Deploying certificates",
+			Fn:           flow.SimpleTaskFn(i.deploySecrets),
+			Dependencies: flow.NewTaskIDs(deployNamespace),
+		})
+		deployEtcdTask = g.Add(flow.Task{
+			Name:         "Deploying etcd",
+			Fn:           flow.TaskFn(i.deployEtcd).DoIf(deployEtcd),
+			Dependencies: flow.NewTaskIDs(deploySecrets),
+		})
+		waitUntilEtcdReady = g.Add(flow.Task{
+			Name:         "Waiting until etcd is ready",
+			Fn:           flow.TaskFn(i.waitUntilEtcdReady).DoIf(deployEtcd),
+			Dependencies: flow.NewTaskIDs(deployEtcdTask),
+		})
+		deployGardener = g.Add(flow.Task{
+			Name:         "Deploying Gardener API server and controller manager",
+			Fn:           flow.TaskFn(i.deployGardener),
+			Dependencies: flow.NewTaskIDs(deploySecrets, waitUntilEtcdReady),
+		})
+		waitUntilAPIServerReady = g.Add(flow.Task{
+			Name:         "Waiting until Gardener API server is ready",
+			Fn:           flow.TaskFn(i.waitUntilAPIServerReady),
+			Dependencies: flow.NewTaskIDs(deployGardener),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Waiting until Gardener controller manager is ready",
+			Fn:           flow.TaskFn(i.waitUntilControllerManagerReady),
+			Dependencies: flow.NewTaskIDs(waitUntilAPIServerReady),
+		})
+		f = g.Compile()
+	)
+
+	return f.Run(flow.Opts{
+		Logger:  i.logger,
+		Context: ctx,
+	})
+}
+
+func (i *Installer) deployNamespace() error {
+	_, err := i.client.CreateNamespace(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: Namespace,
+		},
+	}, false)
+	if apierrors.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+func (i *Installer) deployEtcd(ctx context.Context) error {
+	imageVector, err := imagevector.ReadFile(filepath.Join(i.chartPath, "images.yaml"))
+	if err != nil {
+		return err
+	}
+	etcdImage, err := imageVector.FindImage("etcd")
+	if err != nil {
+		return err
+	}
+
+	return i.chartApplier.ApplyChart(ctx, filepath.Join(i.chartPath, "garden-etcd"), Namespace, etcdName, nil, etcdValues(i.config, etcdImage.String(), i.secrets))
+}
+
+func (i *Installer) waitUntilEtcdReady(ctx context.Context) error {
+	return i.waitUntil(ctx, func() error {
+		statefulSet := &appsv1.StatefulSet{}
+		if err := i.client.Client().Get(ctx, client.ObjectKey{Namespace: Namespace, Name: etcdName}, statefulSet); err != nil {
+			return err
+		}
+		return health.CheckStatefulSet(statefulSet)
+	})
+}
+
+func (i *Installer) deployGardener(ctx context.Context) error {
+	return i.chartApplier.ApplyChart(ctx, filepath.Join(i.chartPath, "gardener"), Namespace, "gardener", nil, gardenerValues(i.config, i.secrets))
+}
+
+func (i *Installer) waitUntilAPIServerReady(ctx context.Context) error {
+	if err := i.waitUntilDeploymentReady(ctx, apiServerName); err != nil {
+		return err
+	}
+
+	return i.waitUntil(ctx, func() error {
+		for _, name := range apiServices {
+			apiService := &apiregistrationv1beta1.APIService{}
+			if err := i.client.Client().Get(ctx, client.ObjectKey{Name: name}, apiService); err != nil {
+				return err
+			}
+			if !apiServiceAvailable(apiService) {
+				return fmt.Errorf("APIService %q is not yet available", name)
+			}
+		}
+		return nil
+	})
+}
+
+func (i *Installer) waitUntilControllerManagerReady(ctx context.Context) error {
+	return i.waitUntilDeploymentReady(ctx, controllerManagerName)
+}
+
+func (i *Installer) waitUntilDeploymentReady(ctx context.Context, name string) error {
+	return i.waitUntil(ctx, func() error {
+		deployment := &appsv1.Deployment{}
+		if err := i.client.Client().Get(ctx, client.ObjectKey{Namespace: Namespace, Name: name}, deployment); err != nil {
+			return err
+		}
+		return health.CheckDeployment(deployment)
+	})
+}
+
+// waitUntil retries <check> until it succeeds or the default timeout is reached.
+func (i *Installer) waitUntil(ctx context.Context, check func() error) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	return utils.RetryUntil(ctx, defaultInterval, func() (bool, bool, error) {
+		if err := check(); err != nil {
+			i.logger.Infof("Waiting: %v", err)
+			return false, false, err
+		}
+		return true, false, nil
+	})
+}
+
+func apiServiceAvailable(apiService *apiregistrationv1beta1.APIService) bool {
+	for _, condition := range apiService.Status.Conditions {
+		if condition.Type == apiregistrationv1beta1.Available {
+			return condition.Status == apiregistrationv1beta1.ConditionTrue
+		}
+	}
+	return false
+}
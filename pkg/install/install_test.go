@@ -0,0 +1,154 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install_test
+
+import (
+	. "github.com/gardener/gardener/pkg/install"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("install", func() {
+	Describe("#DecodeConfiguration", func() {
+		It("should default the configuration", func() {
+			config, err := ExportDecodeConfiguration([]byte(`version: 0.25.0`))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Version).To(Equal("0.25.0"))
+			Expect(*config.Etcd.Deploy).To(BeTrue())
+			Expect(config.Etcd.StorageSize).To(Equal("10Gi"))
+		})
+
+		It("should keep the given etcd configuration", func() {
+			config, err := ExportDecodeConfiguration([]byte(`
+version: 0.25.0
+etcd:
+  deploy: false
+  storageSize: 20Gi
+  storageClassName: fast
+`))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*config.Etcd.Deploy).To(BeFalse())
+			Expect(config.Etcd.StorageSize).To(Equal("20Gi"))
+			Expect(*config.Etcd.StorageClassName).To(Equal("fast"))
+		})
+
+		It("should fail if the version is missing", func() {
+			_, err := ExportDecodeConfiguration([]byte(`etcd: {}`))
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail if the etcd storage size is invalid", func() {
+			_, err := ExportDecodeConfiguration([]byte(`
+version: 0.25.0
+etcd:
+  storageSize: big
+`))
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#GardenerValues", func() {
+		var (
+			tlsSecret = func(name string) *corev1.Secret {
+				return &corev1.Secret{
+					Data: map[string][]byte{
+						secrets.DataKeyCertificateCA: []byte(name + "-ca"),
+						secrets.DataKeyCertificate:   []byte(name + "-crt"),
+						secrets.DataKeyPrivateKey:    []byte(name + "-key"),
+					},
+				}
+			}
+			deployedSecrets = map[string]*corev1.Secret{
+				APIServerTLSName:         tlsSecret("apiserver"),
+				ControllerManagerTLSName: tlsSecret("controller-manager"),
+				EtcdClientTLSName:        tlsSecret("etcd-client"),
+			}
+		)
+
+		It("should compute the values of the Gardener chart", func() {
+			config, err := ExportDecodeConfiguration([]byte(`version: 0.25.0`))
+			Expect(err).NotTo(HaveOccurred())
+
+			values := ExportGardenerValues(config, deployedSecrets)
+
+			global := values["global"].(map[string]interface{})
+			apiServer := global["apiserver"].(map[string]interface{})
+			Expect(apiServer["image"]).To(Equal(map[string]interface{}{"tag": "0.25.0"}))
+			Expect(apiServer["caBundle"]).To(Equal("apiserver-ca"))
+			Expect(apiServer["tls"]).To(Equal(map[string]interface{}{"crt": "apiserver-crt", "key": "apiserver-key"}))
+			Expect(apiServer["etcd"]).To(Equal(map[string]interface{}{
+				"useSidecar": false,
+				"servers":    "https://gardener-etcd.garden.svc:2379",
+				"caBundle":   "etcd-client-ca",
+				"tls":        map[string]interface{}{"crt": "etcd-client-crt", "key": "etcd-client-key"},
+			}))
+
+			controller := global["controller"].(map[string]interface{})
+			Expect(controller["config"]).To(Equal(map[string]interface{}{
+				"server": map[string]interface{}{
+					"https": map[string]interface{}{
+						"tls": map[string]interface{}{
+							"caBundle": "controller-manager-ca",
+							"crt":      "controller-manager-crt",
+							"key":      "controller-manager-key",
+						},
+					},
+				},
+			}))
+		})
+
+		It("should not configure etcd if it is not deployed", func() {
+			config, err := ExportDecodeConfiguration([]byte(`
+version: 0.25.0
+etcd:
+  deploy: false
+`))
+			Expect(err).NotTo(HaveOccurred())
+
+			values := ExportGardenerValues(config, deployedSecrets)
+
+			apiServer := values["global"].(map[string]interface{})["apiserver"].(map[string]interface{})
+			Expect(apiServer).NotTo(HaveKey("etcd"))
+		})
+
+		It("should let the additional values take precedence", func() {
+			config, err := ExportDecodeConfiguration([]byte(`
+version: 0.25.0
+values:
+  global:
+    apiserver:
+      replicaCount: 2
+      image:
+        tag: custom
+`))
+			Expect(err).NotTo(HaveOccurred())
+
+			values := ExportGardenerValues(config, deployedSecrets)
+
+			apiServer := values["global"].(map[string]interface{})["apiserver"].(map[string]interface{})
+			Expect(apiServer["image"]).To(Equal(map[string]interface{}{"tag": "custom"}))
+			Expect(apiServer["replicaCount"]).To(BeEquivalentTo(2))
+			Expect(apiServer["caBundle"]).To(Equal("apiserver-ca"))
+		})
+	})
+})
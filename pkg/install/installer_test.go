@@ -0,0 +1,192 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install_test
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/install"
+	mock "github.com/gardener/gardener/pkg/mock/gardener/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	apiregistrationv1beta1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// fakeChartApplier records the charts applied by the Installer.
+type fakeChartApplier struct {
+	kubernetes.ChartApplier
+
+	lock   sync.Mutex
+	values map[string]map[string]interface{}
+}
+
+func (f *fakeChartApplier) ApplyChart(_ context.Context, chartPath, namespace, name string, _, values map[string]interface{}) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	Expect(namespace).To(Equal(Namespace))
+	f.values[filepath.Base(chartPath)] = values
+	return nil
+}
+
+var _ = Describe("installer", func() {
+	var (
+		ctx  = context.TODO()
+		ctrl *gomock.Controller
+
+		k8sClient    *mock.MockInterface
+		chartApplier *fakeChartApplier
+		config       *Configuration
+		logger       logrus.FieldLogger
+
+		lock           sync.Mutex
+		createdSecrets map[string]*corev1.Secret
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		k8sClient = mock.NewMockInterface(ctrl)
+		chartApplier = &fakeChartApplier{values: map[string]map[string]interface{}{}}
+
+		var err error
+		config, err = ExportDecodeConfiguration([]byte(`version: 0.25.0`))
+		Expect(err).NotTo(HaveOccurred())
+
+		log := logrus.New()
+		log.Out = ioutil.Discard
+		logger = log
+
+		createdSecrets = map[string]*corev1.Secret{}
+		k8sClient.EXPECT().CreateSecret(Namespace, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(namespace, name string, secretType corev1.SecretType, data map[string][]byte, _ bool) (*corev1.Secret, error) {
+				lock.Lock()
+				defer lock.Unlock()
+
+				secret := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Type:       secretType,
+					Data:       data,
+				}
+				createdSecrets[name] = secret
+				return secret, nil
+			}).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	readyObjects := func() []runtime.Object {
+		var (
+			availableDeployment = func(name string) *appsv1.Deployment {
+				return &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: Namespace},
+					Status: appsv1.DeploymentStatus{
+						Conditions: []appsv1.DeploymentCondition{{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue}},
+					},
+				}
+			}
+			availableAPIService = func(name string) *apiregistrationv1beta1.APIService {
+				return &apiregistrationv1beta1.APIService{
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+					Status: apiregistrationv1beta1.APIServiceStatus{
+						Conditions: []apiregistrationv1beta1.APIServiceCondition{{Type: apiregistrationv1beta1.Available, Status: apiregistrationv1beta1.ConditionTrue}},
+					},
+				}
+			}
+		)
+
+		return []runtime.Object{
+			&appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "gardener-etcd", Namespace: Namespace},
+				Status:     appsv1.StatefulSetStatus{ReadyReplicas: 1},
+			},
+			availableDeployment("gardener-apiserver"),
+			availableDeployment("gardener-controller-manager"),
+			availableAPIService("v1beta1.garden.sapcloud.io"),
+			availableAPIService("v1alpha1.core.gardener.cloud"),
+		}
+	}
+
+	Describe("#Install", func() {
+		BeforeEach(func() {
+			k8sClient.EXPECT().CreateNamespace(gomock.Any(), false).Return(&corev1.Namespace{}, nil)
+			k8sClient.EXPECT().Client().Return(fake.NewFakeClientWithScheme(ExportScheme, readyObjects()...)).AnyTimes()
+		})
+
+		It("should generate the certificates and deploy etcd and the Gardener", func() {
+			k8sClient.EXPECT().ListSecrets(Namespace, gomock.Any()).Return(&corev1.SecretList{}, nil)
+
+			Expect(ExportNewInstaller(k8sClient, chartApplier, "../../charts", config, logger).Install(ctx)).To(Succeed())
+
+			Expect(createdSecrets).To(HaveLen(6))
+			Expect(createdSecrets).To(HaveKey(CAName))
+			Expect(createdSecrets).To(HaveKey(EtcdCAName))
+			Expect(createdSecrets[EtcdClientTLSName].Data[secrets.DataKeyCertificateCA]).To(Equal(createdSecrets[EtcdCAName].Data[secrets.DataKeyCertificateCA]))
+
+			Expect(chartApplier.values).To(HaveKey("garden-etcd"))
+			Expect(chartApplier.values["garden-etcd"]["serverSecretName"]).To(Equal(EtcdServerTLSName))
+
+			apiServer := chartApplier.values["gardener"]["global"].(map[string]interface{})["apiserver"].(map[string]interface{})
+			Expect(apiServer["caBundle"]).To(Equal(string(createdSecrets[CAName].Data[secrets.DataKeyCertificateCA])))
+			Expect(apiServer["tls"]).To(HaveKeyWithValue("crt", string(createdSecrets[APIServerTLSName].Data[secrets.DataKeyCertificate])))
+		})
+
+		It("should reuse the certificates of a previous installation", func() {
+			k8sClient.EXPECT().ListSecrets(Namespace, gomock.Any()).Return(&corev1.SecretList{}, nil)
+			Expect(ExportNewInstaller(k8sClient, chartApplier, "../../charts", config, logger).Install(ctx)).To(Succeed())
+
+			previousSecrets := &corev1.SecretList{}
+			for _, secret := range createdSecrets {
+				previousSecrets.Items = append(previousSecrets.Items, *secret)
+			}
+			previousAPIServerTLS := createdSecrets[APIServerTLSName]
+			createdSecrets = map[string]*corev1.Secret{}
+
+			k8sClient.EXPECT().CreateNamespace(gomock.Any(), false).Return(&corev1.Namespace{}, nil)
+			k8sClient.EXPECT().ListSecrets(Namespace, gomock.Any()).Return(previousSecrets, nil)
+			Expect(ExportNewInstaller(k8sClient, chartApplier, "../../charts", config, logger).Install(ctx)).To(Succeed())
+
+			Expect(createdSecrets).To(BeEmpty())
+			apiServer := chartApplier.values["gardener"]["global"].(map[string]interface{})["apiserver"].(map[string]interface{})
+			Expect(apiServer["tls"]).To(HaveKeyWithValue("crt", string(previousAPIServerTLS.Data[secrets.DataKeyCertificate])))
+		})
+
+		It("should neither generate etcd certificates nor deploy etcd if it is not deployed", func() {
+			*config.Etcd.Deploy = false
+			k8sClient.EXPECT().ListSecrets(Namespace, gomock.Any()).Return(&corev1.SecretList{}, nil)
+
+			Expect(ExportNewInstaller(k8sClient, chartApplier, "../../charts", config, logger).Install(ctx)).To(Succeed())
+
+			Expect(createdSecrets).To(HaveLen(4))
+			Expect(createdSecrets).NotTo(HaveKey(EtcdServerTLSName))
+			Expect(chartApplier.values).NotTo(HaveKey("garden-etcd"))
+			Expect(chartApplier.values).To(HaveKey("gardener"))
+		})
+	})
+})
@@ -0,0 +1,143 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"fmt"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// Namespace is the namespace into which the Gardener is installed.
+	Namespace = "garden"
+
+	// CAName is the name of the secret containing the CA which signs the serving certificates of the Gardener API
+	// server and the Gardener controller manager.
+	CAName = "ca-gardener"
+	// EtcdCAName is the name of the secret containing the CA which signs the certificates of etcd.
+	EtcdCAName = "ca-gardener-etcd"
+
+	// APIServerTLSName is the name of the secret containing the serving certificate of the Gardener API server.
+	APIServerTLSName = "gardener-apiserver-tls"
+	// ControllerManagerTLSName is the name of the secret containing the serving certificate of the Gardener
+	// controller manager.
+	ControllerManagerTLSName = "gardener-controller-manager-tls"
+	// EtcdServerTLSName is the name of the secret containing the serving certificate of etcd.
+	EtcdServerTLSName = "gardener-etcd-server"
+	// EtcdClientTLSName is the name of the secret containing the client certificate of the Gardener API server for
+	// etcd.
+	EtcdClientTLSName = "gardener-etcd-client"
+
+	apiServerName         = "gardener-apiserver"
+	controllerManagerName = "gardener-controller-manager"
+	etcdName              = "gardener-etcd"
+)
+
+// serviceDNSNames returns the DNS names of the service <name> in the Gardener namespace.
+func serviceDNSNames(name string) []string {
+	return []string{
+		name,
+		fmt.Sprintf("%s.%s", name, Namespace),
+		fmt.Sprintf("%s.%s.svc", name, Namespace),
+		fmt.Sprintf("%s.%s.svc.%s", name, Namespace, gardenv1beta1.DefaultDomain),
+	}
+}
+
+func wantedCertificateAuthorities() map[string]*secrets.CertificateSecretConfig {
+	return map[string]*secrets.CertificateSecretConfig{
+		CAName: {
+			Name:       CAName,
+			CommonName: "gardener",
+			CertType:   secrets.CACert,
+		},
+		EtcdCAName: {
+			Name:       EtcdCAName,
+			CommonName: "gardener-etcd",
+			CertType:   secrets.CACert,
+		},
+	}
+}
+
+func wantedSecretsList(certificateAuthorities map[string]*secrets.Certificate, deployEtcd bool) []secrets.ConfigInterface {
+	list := []secrets.ConfigInterface{
+		&secrets.CertificateSecretConfig{
+			Name:       APIServerTLSName,
+			CommonName: apiServerName,
+			DNSNames:   serviceDNSNames(apiServerName),
+			CertType:   secrets.ServerCert,
+			SigningCA:  certificateAuthorities[CAName],
+		},
+		&secrets.CertificateSecretConfig{
+			Name:       ControllerManagerTLSName,
+			CommonName: controllerManagerName,
+			DNSNames:   serviceDNSNames(controllerManagerName),
+			CertType:   secrets.ServerCert,
+			SigningCA:  certificateAuthorities[CAName],
+		},
+	}
+
+	if deployEtcd {
+		list = append(list,
+			&secrets.CertificateSecretConfig{
+				Name:       EtcdServerTLSName,
+				CommonName: etcdName,
+				DNSNames:   append(serviceDNSNames(etcdName), "localhost"),
+				CertType:   secrets.ServerCert,
+				SigningCA:  certificateAuthorities[EtcdCAName],
+			},
+			&secrets.CertificateSecretConfig{
+				Name:       EtcdClientTLSName,
+				CommonName: apiServerName,
+				CertType:   secrets.ClientCert,
+				SigningCA:  certificateAuthorities[EtcdCAName],
+			},
+		)
+	}
+
+	return list
+}
+
+// deploySecrets generates the CAs and certificates of the Gardener components, or reuses (and renews if necessary)
+// those which have been generated by a previous installation.
+func (i *Installer) deploySecrets() error {
+	secretList, err := i.client.ListSecrets(Namespace, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	existingSecretsMap := make(map[string]*corev1.Secret, len(secretList.Items))
+	for _, secret := range secretList.Items {
+		secretObj := secret
+		existingSecretsMap[secret.Name] = &secretObj
+	}
+
+	_, certificateAuthorities, err := secrets.GenerateCertificateAuthorities(i.client, existingSecretsMap, wantedCertificateAuthorities(), Namespace)
+	if err != nil {
+		return err
+	}
+
+	deployedSecrets, err := secrets.GenerateClusterSecrets(i.client, existingSecretsMap, wantedSecretsList(certificateAuthorities, *i.config.Etcd.Deploy), Namespace)
+	if err != nil {
+		return err
+	}
+
+	i.secrets = deployedSecrets
+	return nil
+}
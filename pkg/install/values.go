@@ -0,0 +1,115 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// etcdValues computes the values for the garden-etcd chart.
+func etcdValues(config *Configuration, etcdImage string, deployedSecrets map[string]*corev1.Secret) map[string]interface{} {
+	storage := map[string]interface{}{
+		"size": config.Etcd.StorageSize,
+	}
+	if config.Etcd.StorageClassName != nil {
+		storage["className"] = *config.Etcd.StorageClassName
+	}
+
+	return map[string]interface{}{
+		"images": map[string]interface{}{
+			"etcd": etcdImage,
+		},
+		"serverSecretName": EtcdServerTLSName,
+		"podAnnotations": map[string]interface{}{
+			"checksum/secret-etcd-server": secretChecksum(deployedSecrets[EtcdServerTLSName]),
+		},
+		"storage": storage,
+	}
+}
+
+// gardenerValues computes the values for the Gardener chart. The additional values of the configuration take
+// precedence over the computed ones.
+func gardenerValues(config *Configuration, deployedSecrets map[string]*corev1.Secret) map[string]interface{} {
+	var (
+		apiServerTLS         = deployedSecrets[APIServerTLSName]
+		controllerManagerTLS = deployedSecrets[ControllerManagerTLSName]
+
+		apiServer = map[string]interface{}{
+			"image": map[string]interface{}{
+				"tag": config.Version,
+			},
+			"caBundle": string(apiServerTLS.Data[secrets.DataKeyCertificateCA]),
+			"tls": map[string]interface{}{
+				"crt": string(apiServerTLS.Data[secrets.DataKeyCertificate]),
+				"key": string(apiServerTLS.Data[secrets.DataKeyPrivateKey]),
+			},
+		}
+		controller = map[string]interface{}{
+			"image": map[string]interface{}{
+				"tag": config.Version,
+			},
+			"config": map[string]interface{}{
+				"server": map[string]interface{}{
+					"https": map[string]interface{}{
+						"tls": map[string]interface{}{
+							"caBundle": string(controllerManagerTLS.Data[secrets.DataKeyCertificateCA]),
+							"crt":      string(controllerManagerTLS.Data[secrets.DataKeyCertificate]),
+							"key":      string(controllerManagerTLS.Data[secrets.DataKeyPrivateKey]),
+						},
+					},
+				},
+			},
+		}
+	)
+
+	if *config.Etcd.Deploy {
+		etcdClientTLS := deployedSecrets[EtcdClientTLSName]
+		apiServer["etcd"] = map[string]interface{}{
+			"useSidecar": false,
+			"servers":    fmt.Sprintf("https://%s.%s.svc:2379", etcdName, Namespace),
+			"caBundle":   string(etcdClientTLS.Data[secrets.DataKeyCertificateCA]),
+			"tls": map[string]interface{}{
+				"crt": string(etcdClientTLS.Data[secrets.DataKeyCertificate]),
+				"key": string(etcdClientTLS.Data[secrets.DataKeyPrivateKey]),
+			},
+		}
+	}
+
+	values := map[string]interface{}{
+		"global": map[string]interface{}{
+			"apiserver":  apiServer,
+			"controller": controller,
+		},
+	}
+	return utils.MergeMaps(values, config.Values)
+}
+
+// secretChecksum computes a checksum of the data of the given <secret> which changes whenever the data changes.
+func secretChecksum(secret *corev1.Secret) string {
+	if secret == nil {
+		return ""
+	}
+	jsonString, err := json.Marshal(secret.Data)
+	if err != nil {
+		return ""
+	}
+	return utils.ComputeSHA256Hex(jsonString)
+}
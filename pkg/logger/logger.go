@@ -22,6 +22,18 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+const (
+	// FieldShoot is the log field containing the namespace and the name of the Shoot.
+	FieldShoot = "shoot"
+	// FieldProject is the log field containing the name of the Project.
+	FieldProject = "project"
+	// FieldSeed is the log field containing the name of the Seed.
+	FieldSeed = "seed"
+	// FieldOperationID is the log field containing the ID of the operation. It allows correlating the log lines of
+	// an operation across components.
+	FieldOperationID = "opid"
+)
+
 // Logger is the standard logger for the Gardener which is used for all messages which are not Shoot
 // cluster specific.
 var Logger *logrus.Logger
@@ -47,6 +59,7 @@ func NewLogger(logLevel string) *logrus.Logger {
 	logger := &logrus.Logger{
 		Out:   os.Stderr,
 		Level: level,
+		Hooks: make(logrus.LevelHooks),
 		Formatter: &logrus.TextFormatter{
 			DisableColors: true,
 		},
@@ -66,13 +79,37 @@ func AddWriter(logger *logrus.Logger, writer io.Writer) *logrus.Logger {
 // log message.
 // Example output: time="2017-06-08T13:00:49+02:00" level=info msg="Creating namespace in seed cluster" shoot=core/crazy-botany.
 func NewShootLogger(logger *logrus.Logger, shoot, project, operationID string) *logrus.Entry {
-	fields := constructFields("shoot", fmt.Sprintf("%s/%s", project, shoot))
+	fields := constructFields(FieldShoot, fmt.Sprintf("%s/%s", project, shoot))
 	if operationID != "" {
-		fields["opid"] = operationID
+		fields[FieldOperationID] = operationID
 	}
 	return logger.WithFields(fields)
 }
 
+// WithProjectAndSeed extends the given entry with fields containing the names of the Project and the Seed an
+// operation belongs to. Empty names are omitted.
+// Example output: time="2017-06-08T13:00:49+02:00" level=info msg="something" project=core seed=aws-eu1 shoot=garden-core/crazy-botany.
+func WithProjectAndSeed(entry *logrus.Entry, project, seed string) *logrus.Entry {
+	fields := logrus.Fields{}
+	if project != "" {
+		fields[FieldProject] = project
+	}
+	if seed != "" {
+		fields[FieldSeed] = seed
+	}
+	return entry.WithFields(fields)
+}
+
+// OperationID returns the ID of the operation the given entry has been created for, or an empty string if the
+// entry does not carry any.
+func OperationID(entry *logrus.Entry) string {
+	if entry == nil {
+		return ""
+	}
+	operationID, _ := entry.Data[FieldOperationID].(string)
+	return operationID
+}
+
 // NewFieldLogger extends an existing logrus logger and adds the provided additional field.
 // Example output: time="2017-06-08T13:00:49+02:00" level=info msg="something" <fieldKey>=<fieldValue>.
 func NewFieldLogger(logger *logrus.Logger, fieldKey, fieldValue string) *logrus.Entry {
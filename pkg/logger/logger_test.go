@@ -80,6 +80,34 @@ var _ = Describe("logger", func() {
 			})
 		})
 
+		Describe("#WithProjectAndSeed", func() {
+			It("should return an Entry object with the project and seed fields", func() {
+				entry := WithProjectAndSeed(NewShootLogger(NewLogger("info"), "shoot01", "garden-core", ""), "core", "aws-eu1")
+
+				Expect(entry.Data).To(HaveKeyWithValue("project", "core"))
+				Expect(entry.Data).To(HaveKeyWithValue("seed", "aws-eu1"))
+				Expect(entry.Data).To(HaveKeyWithValue("shoot", "garden-core/shoot01"))
+			})
+
+			It("should omit empty names", func() {
+				entry := WithProjectAndSeed(NewFieldLogger(NewLogger("info"), "foo", "bar"), "", "aws-eu1")
+
+				Expect(entry.Data).NotTo(HaveKey("project"))
+				Expect(entry.Data).To(HaveKeyWithValue("seed", "aws-eu1"))
+			})
+		})
+
+		Describe("#OperationID", func() {
+			It("should return the operation ID of the entry", func() {
+				Expect(OperationID(NewShootLogger(NewLogger("info"), "shoot01", "garden-core", "1234"))).To(Equal("1234"))
+			})
+
+			It("should return an empty string if the entry does not carry an operation ID", func() {
+				Expect(OperationID(NewShootLogger(NewLogger("info"), "shoot01", "garden-core", ""))).To(BeEmpty())
+				Expect(OperationID(nil)).To(BeEmpty())
+			})
+		})
+
 		Describe("#NewFieldLogger", func() {
 			It("should return an Entry object with additional fields", func() {
 				logger := NewLogger("info")
@@ -0,0 +1,194 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultOperationLogLines is the default number of lines which are kept for every running operation.
+const DefaultOperationLogLines = 2000
+
+// OperationLogs is a logrus hook which keeps the human-readable log lines of the currently running operations of Shoots
+// in memory, so that they can be followed while the operations are running. Only the entries of operations which have
+// been started with Start are kept, they are assigned by the `shoot` field of the entries. All methods may be called on
+// a nil OperationLogs, in which case nothing is kept.
+type OperationLogs struct {
+	mutex    sync.RWMutex
+	maxLines int
+	logs     map[string]*OperationLog
+}
+
+// NewOperationLogs creates a new OperationLogs which keeps at most <maxLines> lines for every operation.
+func NewOperationLogs(maxLines int) *OperationLogs {
+	return &OperationLogs{maxLines: maxLines, logs: make(map[string]*OperationLog)}
+}
+
+// Levels implements logrus.Hook. Debug messages are not kept.
+func (o *OperationLogs) Levels() []logrus.Level {
+	return []logrus.Level{logrus.PanicLevel, logrus.FatalLevel, logrus.ErrorLevel, logrus.WarnLevel, logrus.InfoLevel}
+}
+
+// Fire implements logrus.Hook. It appends the given entry to the log of the running operation of its Shoot, if any.
+func (o *OperationLogs) Fire(entry *logrus.Entry) error {
+	key, ok := entry.Data[FieldShoot].(string)
+	if !ok {
+		return nil
+	}
+	if log, ok := o.Get(key); ok {
+		log.append(entry)
+	}
+	return nil
+}
+
+// Start begins a new log for the operation of the Shoot with the given key (`<namespace>/<name>`) and returns a
+// function which finishes it once the operation is done. Finished logs are removed, followers of them are stopped.
+func (o *OperationLogs) Start(key string) func() {
+	if o == nil {
+		return func() {}
+	}
+
+	log := newOperationLog(o.maxLines)
+
+	o.mutex.Lock()
+	if previous, ok := o.logs[key]; ok {
+		previous.finish()
+	}
+	o.logs[key] = log
+	o.mutex.Unlock()
+
+	return func() {
+		o.mutex.Lock()
+		if o.logs[key] == log {
+			delete(o.logs, key)
+		}
+		o.mutex.Unlock()
+		log.finish()
+	}
+}
+
+// Get returns the log of the running operation of the Shoot with the given key. It returns false if no operation is
+// running for the Shoot.
+func (o *OperationLogs) Get(key string) (*OperationLog, bool) {
+	if o == nil {
+		return nil, false
+	}
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+
+	log, ok := o.logs[key]
+	return log, ok
+}
+
+// OperationLog holds the most recent lines of the log of a single operation.
+type OperationLog struct {
+	mutex    sync.Mutex
+	maxLines int
+	lines    []string
+	// dropped is the number of lines which have been dropped from the beginning of the log to satisfy maxLines.
+	dropped  int
+	finished bool
+	// changed is closed and replaced whenever lines are appended or the log is finished.
+	changed chan struct{}
+}
+
+func newOperationLog(maxLines int) *OperationLog {
+	return &OperationLog{maxLines: maxLines, changed: make(chan struct{})}
+}
+
+// FormatOperationLogLine formats the given entry as a line of an operation log, e.g.
+// `2017-06-08T11:00:49Z INFO    Creating namespace in seed cluster`.
+func FormatOperationLogLine(entry *logrus.Entry) string {
+	return fmt.Sprintf("%s %-7s %s", entry.Time.UTC().Format(time.RFC3339), strings.ToUpper(entry.Level.String()), strings.TrimRight(entry.Message, "\n"))
+}
+
+func (l *OperationLog) append(entry *logrus.Entry) {
+	lines := strings.Split(FormatOperationLogLine(entry), "\n")
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.finished {
+		return
+	}
+	l.lines = append(l.lines, lines...)
+	if excess := len(l.lines) - l.maxLines; l.maxLines > 0 && excess > 0 {
+		l.lines = append([]string(nil), l.lines[excess:]...)
+		l.dropped += excess
+	}
+	l.notify()
+}
+
+func (l *OperationLog) finish() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if !l.finished {
+		l.finished = true
+		l.notify()
+	}
+}
+
+func (l *OperationLog) notify() {
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
+
+// read returns the lines following the given position together with the position after them. It also returns
+// whether the log is finished and a channel which is closed once the log changes.
+func (l *OperationLog) read(from int) ([]string, int, bool, <-chan struct{}) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if from < l.dropped {
+		from = l.dropped
+	}
+	lines := append([]string(nil), l.lines[from-l.dropped:]...)
+	return lines, l.dropped + len(l.lines), l.finished, l.changed
+}
+
+// Follow passes the lines of the log to <write>. If <tailLines> is positive then only the given number of the most
+// recent lines is passed initially. If <follow> is true then it keeps on passing new lines until the operation
+// finishes or the context is cancelled.
+func (l *OperationLog) Follow(ctx context.Context, tailLines int, follow bool, write func(lines []string) error) error {
+	lines, next, finished, changed := l.read(0)
+	if tailLines > 0 && len(lines) > tailLines {
+		lines = lines[len(lines)-tailLines:]
+	}
+
+	for {
+		if len(lines) > 0 {
+			if err := write(lines); err != nil {
+				return err
+			}
+		}
+		if !follow || finished {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changed:
+		}
+		lines, next, finished, changed = l.read(next)
+	}
+}
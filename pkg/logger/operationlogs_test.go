@@ -0,0 +1,132 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger_test
+
+import (
+	"context"
+	"io/ioutil"
+	"time"
+
+	. "github.com/gardener/gardener/pkg/logger"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("OperationLogs", func() {
+	var (
+		operationLogs *OperationLogs
+		logger        *logrus.Logger
+		shootLogger   *logrus.Entry
+		key           = "garden-core/crazy-botany"
+
+		collect = func(log *OperationLog, tailLines int) []string {
+			var out []string
+			Expect(log.Follow(context.TODO(), tailLines, false, func(lines []string) error {
+				out = append(out, lines...)
+				return nil
+			})).To(Succeed())
+			return out
+		}
+	)
+
+	BeforeEach(func() {
+		operationLogs = NewOperationLogs(3)
+		logger = &logrus.Logger{Out: ioutil.Discard, Level: logrus.DebugLevel, Formatter: &logrus.TextFormatter{}, Hooks: make(logrus.LevelHooks)}
+		logger.AddHook(operationLogs)
+		shootLogger = NewShootLogger(logger, "crazy-botany", "garden-core", "")
+	})
+
+	It("should only keep the log lines of started operations", func() {
+		shootLogger.Info("before")
+
+		_, ok := operationLogs.Get(key)
+		Expect(ok).To(BeFalse())
+
+		finish := operationLogs.Start(key)
+		shootLogger.Info("first")
+		shootLogger.Debug("debug")
+		logger.Info("unrelated")
+		NewShootLogger(logger, "other", "garden-core", "").Info("other")
+
+		log, ok := operationLogs.Get(key)
+		Expect(ok).To(BeTrue())
+		Expect(collect(log, 0)).To(ConsistOf(HaveSuffix("INFO    first")))
+
+		finish()
+		_, ok = operationLogs.Get(key)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should keep the most recent lines and return the requested tail", func() {
+		operationLogs.Start(key)
+		shootLogger.Info("1")
+		shootLogger.Warn("2\n3")
+		shootLogger.Error("4")
+
+		log, _ := operationLogs.Get(key)
+		lines := collect(log, 0)
+		Expect(lines).To(HaveLen(3))
+		Expect(lines[0]).To(HaveSuffix("WARNING 2"))
+		Expect(lines[1]).To(Equal("3"))
+		Expect(lines[2]).To(HaveSuffix("ERROR   4"))
+		Expect(collect(log, 1)).To(ConsistOf(HaveSuffix("ERROR   4")))
+	})
+
+	It("should follow the log until the operation finishes", func() {
+		var (
+			finish   = operationLogs.Start(key)
+			log, _   = operationLogs.Get(key)
+			received = make(chan string, 10)
+			done     = make(chan error)
+		)
+
+		shootLogger.Info("first")
+		go func() {
+			done <- log.Follow(context.TODO(), 0, true, func(lines []string) error {
+				for _, line := range lines {
+					received <- line
+				}
+				return nil
+			})
+		}()
+
+		Eventually(received).Should(Receive(HaveSuffix("first")))
+		shootLogger.Info("second")
+		Eventually(received).Should(Receive(HaveSuffix("second")))
+
+		finish()
+		Eventually(done, time.Second).Should(Receive(BeNil()))
+	})
+
+	It("should stop following the log if the context is cancelled", func() {
+		operationLogs.Start(key)
+		log, _ := operationLogs.Get(key)
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+
+		Expect(log.Follow(ctx, 0, true, func([]string) error { return nil })).To(Succeed())
+	})
+
+	It("should do nothing if it is nil", func() {
+		var nilOperationLogs *OperationLogs
+
+		nilOperationLogs.Start(key)()
+		_, ok := nilOperationLogs.Get(key)
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -66,6 +66,20 @@ func (mr *MockGardenV1beta1InterfaceMockRecorder) CloudProfiles() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloudProfiles", reflect.TypeOf((*MockGardenV1beta1Interface)(nil).CloudProfiles))
 }
 
+// DeletionProtectionPolicies mocks base method
+func (m *MockGardenV1beta1Interface) DeletionProtectionPolicies() v1beta10.DeletionProtectionPolicyInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletionProtectionPolicies")
+	ret0, _ := ret[0].(v1beta10.DeletionProtectionPolicyInterface)
+	return ret0
+}
+
+// DeletionProtectionPolicies indicates an expected call of DeletionProtectionPolicies
+func (mr *MockGardenV1beta1InterfaceMockRecorder) DeletionProtectionPolicies() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletionProtectionPolicies", reflect.TypeOf((*MockGardenV1beta1Interface)(nil).DeletionProtectionPolicies))
+}
+
 // Projects mocks base method
 func (m *MockGardenV1beta1Interface) Projects() v1beta10.ProjectInterface {
 	m.ctrl.T.Helper()
@@ -150,6 +164,20 @@ func (mr *MockGardenV1beta1InterfaceMockRecorder) Shoots(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Shoots", reflect.TypeOf((*MockGardenV1beta1Interface)(nil).Shoots), arg0)
 }
 
+// ShootTemplates mocks base method
+func (m *MockGardenV1beta1Interface) ShootTemplates(arg0 string) v1beta10.ShootTemplateInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShootTemplates", arg0)
+	ret0, _ := ret[0].(v1beta10.ShootTemplateInterface)
+	return ret0
+}
+
+// ShootTemplates indicates an expected call of ShootTemplates
+func (mr *MockGardenV1beta1InterfaceMockRecorder) ShootTemplates(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShootTemplates", reflect.TypeOf((*MockGardenV1beta1Interface)(nil).ShootTemplates), arg0)
+}
+
 // MockShootInterface is a mock of ShootInterface interface
 type MockShootInterface struct {
 	ctrl     *gomock.Controller
@@ -34,406 +34,457 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Cloud":                          schema_pkg_apis_core_v1alpha1_Cloud(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ClusterInfo":                    schema_pkg_apis_core_v1alpha1_ClusterInfo(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition":                      schema_pkg_apis_core_v1alpha1_Condition(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerDeployment":           schema_pkg_apis_core_v1alpha1_ControllerDeployment(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallation":         schema_pkg_apis_core_v1alpha1_ControllerInstallation(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallationList":     schema_pkg_apis_core_v1alpha1_ControllerInstallationList(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallationSpec":     schema_pkg_apis_core_v1alpha1_ControllerInstallationSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallationStatus":   schema_pkg_apis_core_v1alpha1_ControllerInstallationStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistration":         schema_pkg_apis_core_v1alpha1_ControllerRegistration(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistrationList":     schema_pkg_apis_core_v1alpha1_ControllerRegistrationList(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistrationSpec":     schema_pkg_apis_core_v1alpha1_ControllerRegistrationSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerResource":             schema_pkg_apis_core_v1alpha1_ControllerResource(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Endpoint":                       schema_pkg_apis_core_v1alpha1_Endpoint(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.K8SNetworks":                    schema_pkg_apis_core_v1alpha1_K8SNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Kubernetes":                     schema_pkg_apis_core_v1alpha1_Kubernetes(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError":                      schema_pkg_apis_core_v1alpha1_LastError(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation":                  schema_pkg_apis_core_v1alpha1_LastOperation(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Plant":                          schema_pkg_apis_core_v1alpha1_Plant(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantList":                      schema_pkg_apis_core_v1alpha1_PlantList(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantSpec":                      schema_pkg_apis_core_v1alpha1_PlantSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantStatus":                    schema_pkg_apis_core_v1alpha1_PlantStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig":                 schema_pkg_apis_core_v1alpha1_ProviderConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSCloud":                      schema_pkg_apis_garden_v1beta1_AWSCloud(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSConstraints":                schema_pkg_apis_garden_v1beta1_AWSConstraints(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSMachineImage":               schema_pkg_apis_garden_v1beta1_AWSMachineImage(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSMachineImageMapping":        schema_pkg_apis_garden_v1beta1_AWSMachineImageMapping(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSNetworks":                   schema_pkg_apis_garden_v1beta1_AWSNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSProfile":                    schema_pkg_apis_garden_v1beta1_AWSProfile(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSRegionalMachineImage":       schema_pkg_apis_garden_v1beta1_AWSRegionalMachineImage(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSVPC":                        schema_pkg_apis_garden_v1beta1_AWSVPC(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSWorker":                     schema_pkg_apis_garden_v1beta1_AWSWorker(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addon":                         schema_pkg_apis_garden_v1beta1_Addon(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons":                        schema_pkg_apis_garden_v1beta1_Addons(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AdmissionPlugin":               schema_pkg_apis_garden_v1beta1_AdmissionPlugin(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Alicloud":                      schema_pkg_apis_garden_v1beta1_Alicloud(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudConstraints":           schema_pkg_apis_garden_v1beta1_AlicloudConstraints(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudMachineImage":          schema_pkg_apis_garden_v1beta1_AlicloudMachineImage(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudMachineType":           schema_pkg_apis_garden_v1beta1_AlicloudMachineType(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudNetworks":              schema_pkg_apis_garden_v1beta1_AlicloudNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudProfile":               schema_pkg_apis_garden_v1beta1_AlicloudProfile(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudVPC":                   schema_pkg_apis_garden_v1beta1_AlicloudVPC(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudVolumeType":            schema_pkg_apis_garden_v1beta1_AlicloudVolumeType(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudWorker":                schema_pkg_apis_garden_v1beta1_AlicloudWorker(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditConfig":                   schema_pkg_apis_garden_v1beta1_AuditConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditPolicy":                   schema_pkg_apis_garden_v1beta1_AuditPolicy(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureCloud":                    schema_pkg_apis_garden_v1beta1_AzureCloud(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureConstraints":              schema_pkg_apis_garden_v1beta1_AzureConstraints(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureDomainCount":              schema_pkg_apis_garden_v1beta1_AzureDomainCount(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureMachineImage":             schema_pkg_apis_garden_v1beta1_AzureMachineImage(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureNetworks":                 schema_pkg_apis_garden_v1beta1_AzureNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureProfile":                  schema_pkg_apis_garden_v1beta1_AzureProfile(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureResourceGroup":            schema_pkg_apis_garden_v1beta1_AzureResourceGroup(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureVNet":                     schema_pkg_apis_garden_v1beta1_AzureVNet(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureWorker":                   schema_pkg_apis_garden_v1beta1_AzureWorker(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Backup":                        schema_pkg_apis_garden_v1beta1_Backup(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructure":          schema_pkg_apis_garden_v1beta1_BackupInfrastructure(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureList":      schema_pkg_apis_garden_v1beta1_BackupInfrastructureList(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureSpec":      schema_pkg_apis_garden_v1beta1_BackupInfrastructureSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureStatus":    schema_pkg_apis_garden_v1beta1_BackupInfrastructureStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud":                         schema_pkg_apis_garden_v1beta1_Cloud(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudControllerManagerConfig":  schema_pkg_apis_garden_v1beta1_CloudControllerManagerConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfile":                  schema_pkg_apis_garden_v1beta1_CloudProfile(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileList":              schema_pkg_apis_garden_v1beta1_CloudProfileList(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileSpec":              schema_pkg_apis_garden_v1beta1_CloudProfileSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ClusterAutoscaler":             schema_pkg_apis_garden_v1beta1_ClusterAutoscaler(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS":                           schema_pkg_apis_garden_v1beta1_DNS(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint":         schema_pkg_apis_garden_v1beta1_DNSProviderConstraint(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPCloud":                      schema_pkg_apis_garden_v1beta1_GCPCloud(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPConstraints":                schema_pkg_apis_garden_v1beta1_GCPConstraints(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPMachineImage":               schema_pkg_apis_garden_v1beta1_GCPMachineImage(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPNetworks":                   schema_pkg_apis_garden_v1beta1_GCPNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPProfile":                    schema_pkg_apis_garden_v1beta1_GCPProfile(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPVPC":                        schema_pkg_apis_garden_v1beta1_GCPVPC(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPWorker":                     schema_pkg_apis_garden_v1beta1_GCPWorker(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener":                      schema_pkg_apis_garden_v1beta1_Gardener(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration":              schema_pkg_apis_garden_v1beta1_GardenerDuration(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Heapster":                      schema_pkg_apis_garden_v1beta1_Heapster(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HelmTiller":                    schema_pkg_apis_garden_v1beta1_HelmTiller(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation":                   schema_pkg_apis_garden_v1beta1_Hibernation(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HibernationSchedule":           schema_pkg_apis_garden_v1beta1_HibernationSchedule(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HorizontalPodAutoscalerConfig": schema_pkg_apis_garden_v1beta1_HorizontalPodAutoscalerConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAM":                      schema_pkg_apis_garden_v1beta1_Kube2IAM(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAMRole":                  schema_pkg_apis_garden_v1beta1_Kube2IAMRole(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeAPIServerConfig":           schema_pkg_apis_garden_v1beta1_KubeAPIServerConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeControllerManagerConfig":   schema_pkg_apis_garden_v1beta1_KubeControllerManagerConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeLego":                      schema_pkg_apis_garden_v1beta1_KubeLego(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeProxyConfig":               schema_pkg_apis_garden_v1beta1_KubeProxyConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeSchedulerConfig":           schema_pkg_apis_garden_v1beta1_KubeSchedulerConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig":                 schema_pkg_apis_garden_v1beta1_KubeletConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes":                    schema_pkg_apis_garden_v1beta1_Kubernetes(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConfig":              schema_pkg_apis_garden_v1beta1_KubernetesConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints":         schema_pkg_apis_garden_v1beta1_KubernetesConstraints(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesDashboard":           schema_pkg_apis_garden_v1beta1_KubernetesDashboard(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Local":                         schema_pkg_apis_garden_v1beta1_Local(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalConstraints":              schema_pkg_apis_garden_v1beta1_LocalConstraints(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalMachineImage":             schema_pkg_apis_garden_v1beta1_LocalMachineImage(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalNetworks":                 schema_pkg_apis_garden_v1beta1_LocalNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalProfile":                  schema_pkg_apis_garden_v1beta1_LocalProfile(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType":                   schema_pkg_apis_garden_v1beta1_MachineType(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance":                   schema_pkg_apis_garden_v1beta1_Maintenance(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceAutoUpdate":         schema_pkg_apis_garden_v1beta1_MaintenanceAutoUpdate(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceTimeWindow":         schema_pkg_apis_garden_v1beta1_MaintenanceTimeWindow(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monocular":                     schema_pkg_apis_garden_v1beta1_Monocular(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.NginxIngress":                  schema_pkg_apis_garden_v1beta1_NginxIngress(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OIDCConfig":                    schema_pkg_apis_garden_v1beta1_OIDCConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackCloud":                schema_pkg_apis_garden_v1beta1_OpenStackCloud(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackConstraints":          schema_pkg_apis_garden_v1beta1_OpenStackConstraints(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackFloatingPool":         schema_pkg_apis_garden_v1beta1_OpenStackFloatingPool(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackLoadBalancerProvider": schema_pkg_apis_garden_v1beta1_OpenStackLoadBalancerProvider(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineImage":         schema_pkg_apis_garden_v1beta1_OpenStackMachineImage(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineType":          schema_pkg_apis_garden_v1beta1_OpenStackMachineType(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackNetworks":             schema_pkg_apis_garden_v1beta1_OpenStackNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackProfile":              schema_pkg_apis_garden_v1beta1_OpenStackProfile(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackRouter":               schema_pkg_apis_garden_v1beta1_OpenStackRouter(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackWorker":               schema_pkg_apis_garden_v1beta1_OpenStackWorker(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketCloud":                   schema_pkg_apis_garden_v1beta1_PacketCloud(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketConstraints":             schema_pkg_apis_garden_v1beta1_PacketConstraints(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketMachineImage":            schema_pkg_apis_garden_v1beta1_PacketMachineImage(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketNetworks":                schema_pkg_apis_garden_v1beta1_PacketNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketProfile":                 schema_pkg_apis_garden_v1beta1_PacketProfile(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketWorker":                  schema_pkg_apis_garden_v1beta1_PacketWorker(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Project":                       schema_pkg_apis_garden_v1beta1_Project(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectList":                   schema_pkg_apis_garden_v1beta1_ProjectList(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectSpec":                   schema_pkg_apis_garden_v1beta1_ProjectSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectStatus":                 schema_pkg_apis_garden_v1beta1_ProjectStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Quota":                         schema_pkg_apis_garden_v1beta1_Quota(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaList":                     schema_pkg_apis_garden_v1beta1_QuotaList(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec":                     schema_pkg_apis_garden_v1beta1_QuotaSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBinding":                 schema_pkg_apis_garden_v1beta1_SecretBinding(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBindingList":             schema_pkg_apis_garden_v1beta1_SecretBindingList(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Seed":                          schema_pkg_apis_garden_v1beta1_Seed(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedCloud":                     schema_pkg_apis_garden_v1beta1_SeedCloud(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedList":                      schema_pkg_apis_garden_v1beta1_SeedList(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedNetworks":                  schema_pkg_apis_garden_v1beta1_SeedNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSpec":                      schema_pkg_apis_garden_v1beta1_SeedSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedStatus":                    schema_pkg_apis_garden_v1beta1_SeedStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Shoot":                         schema_pkg_apis_garden_v1beta1_Shoot(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootList":                     schema_pkg_apis_garden_v1beta1_ShootList(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootSpec":                     schema_pkg_apis_garden_v1beta1_ShootSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootStatus":                   schema_pkg_apis_garden_v1beta1_ShootStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType":                    schema_pkg_apis_garden_v1beta1_VolumeType(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Worker":                        schema_pkg_apis_garden_v1beta1_Worker(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone":                          schema_pkg_apis_garden_v1beta1_Zone(ref),
-		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
-		"k8s.io/api/core/v1.Affinity":                                                        schema_k8sio_api_core_v1_Affinity(ref),
-		"k8s.io/api/core/v1.AttachedVolume":                                                  schema_k8sio_api_core_v1_AttachedVolume(ref),
-		"k8s.io/api/core/v1.AvoidPods":                                                       schema_k8sio_api_core_v1_AvoidPods(ref),
-		"k8s.io/api/core/v1.AzureDiskVolumeSource":                                           schema_k8sio_api_core_v1_AzureDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.AzureFilePersistentVolumeSource":                                 schema_k8sio_api_core_v1_AzureFilePersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.AzureFileVolumeSource":                                           schema_k8sio_api_core_v1_AzureFileVolumeSource(ref),
-		"k8s.io/api/core/v1.Binding":                                                         schema_k8sio_api_core_v1_Binding(ref),
-		"k8s.io/api/core/v1.CSIPersistentVolumeSource":                                       schema_k8sio_api_core_v1_CSIPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.CSIVolumeSource":                                                 schema_k8sio_api_core_v1_CSIVolumeSource(ref),
-		"k8s.io/api/core/v1.Capabilities":                                                    schema_k8sio_api_core_v1_Capabilities(ref),
-		"k8s.io/api/core/v1.CephFSPersistentVolumeSource":                                    schema_k8sio_api_core_v1_CephFSPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.CephFSVolumeSource":                                              schema_k8sio_api_core_v1_CephFSVolumeSource(ref),
-		"k8s.io/api/core/v1.CinderPersistentVolumeSource":                                    schema_k8sio_api_core_v1_CinderPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.CinderVolumeSource":                                              schema_k8sio_api_core_v1_CinderVolumeSource(ref),
-		"k8s.io/api/core/v1.ClientIPConfig":                                                  schema_k8sio_api_core_v1_ClientIPConfig(ref),
-		"k8s.io/api/core/v1.ComponentCondition":                                              schema_k8sio_api_core_v1_ComponentCondition(ref),
-		"k8s.io/api/core/v1.ComponentStatus":                                                 schema_k8sio_api_core_v1_ComponentStatus(ref),
-		"k8s.io/api/core/v1.ComponentStatusList":                                             schema_k8sio_api_core_v1_ComponentStatusList(ref),
-		"k8s.io/api/core/v1.ConfigMap":                                                       schema_k8sio_api_core_v1_ConfigMap(ref),
-		"k8s.io/api/core/v1.ConfigMapEnvSource":                                              schema_k8sio_api_core_v1_ConfigMapEnvSource(ref),
-		"k8s.io/api/core/v1.ConfigMapKeySelector":                                            schema_k8sio_api_core_v1_ConfigMapKeySelector(ref),
-		"k8s.io/api/core/v1.ConfigMapList":                                                   schema_k8sio_api_core_v1_ConfigMapList(ref),
-		"k8s.io/api/core/v1.ConfigMapNodeConfigSource":                                       schema_k8sio_api_core_v1_ConfigMapNodeConfigSource(ref),
-		"k8s.io/api/core/v1.ConfigMapProjection":                                             schema_k8sio_api_core_v1_ConfigMapProjection(ref),
-		"k8s.io/api/core/v1.ConfigMapVolumeSource":                                           schema_k8sio_api_core_v1_ConfigMapVolumeSource(ref),
-		"k8s.io/api/core/v1.Container":                                                       schema_k8sio_api_core_v1_Container(ref),
-		"k8s.io/api/core/v1.ContainerImage":                                                  schema_k8sio_api_core_v1_ContainerImage(ref),
-		"k8s.io/api/core/v1.ContainerPort":                                                   schema_k8sio_api_core_v1_ContainerPort(ref),
-		"k8s.io/api/core/v1.ContainerState":                                                  schema_k8sio_api_core_v1_ContainerState(ref),
-		"k8s.io/api/core/v1.ContainerStateRunning":                                           schema_k8sio_api_core_v1_ContainerStateRunning(ref),
-		"k8s.io/api/core/v1.ContainerStateTerminated":                                        schema_k8sio_api_core_v1_ContainerStateTerminated(ref),
-		"k8s.io/api/core/v1.ContainerStateWaiting":                                           schema_k8sio_api_core_v1_ContainerStateWaiting(ref),
-		"k8s.io/api/core/v1.ContainerStatus":                                                 schema_k8sio_api_core_v1_ContainerStatus(ref),
-		"k8s.io/api/core/v1.DaemonEndpoint":                                                  schema_k8sio_api_core_v1_DaemonEndpoint(ref),
-		"k8s.io/api/core/v1.DownwardAPIProjection":                                           schema_k8sio_api_core_v1_DownwardAPIProjection(ref),
-		"k8s.io/api/core/v1.DownwardAPIVolumeFile":                                           schema_k8sio_api_core_v1_DownwardAPIVolumeFile(ref),
-		"k8s.io/api/core/v1.DownwardAPIVolumeSource":                                         schema_k8sio_api_core_v1_DownwardAPIVolumeSource(ref),
-		"k8s.io/api/core/v1.EmptyDirVolumeSource":                                            schema_k8sio_api_core_v1_EmptyDirVolumeSource(ref),
-		"k8s.io/api/core/v1.EndpointAddress":                                                 schema_k8sio_api_core_v1_EndpointAddress(ref),
-		"k8s.io/api/core/v1.EndpointPort":                                                    schema_k8sio_api_core_v1_EndpointPort(ref),
-		"k8s.io/api/core/v1.EndpointSubset":                                                  schema_k8sio_api_core_v1_EndpointSubset(ref),
-		"k8s.io/api/core/v1.Endpoints":                                                       schema_k8sio_api_core_v1_Endpoints(ref),
-		"k8s.io/api/core/v1.EndpointsList":                                                   schema_k8sio_api_core_v1_EndpointsList(ref),
-		"k8s.io/api/core/v1.EnvFromSource":                                                   schema_k8sio_api_core_v1_EnvFromSource(ref),
-		"k8s.io/api/core/v1.EnvVar":                                                          schema_k8sio_api_core_v1_EnvVar(ref),
-		"k8s.io/api/core/v1.EnvVarSource":                                                    schema_k8sio_api_core_v1_EnvVarSource(ref),
-		"k8s.io/api/core/v1.Event":                                                           schema_k8sio_api_core_v1_Event(ref),
-		"k8s.io/api/core/v1.EventList":                                                       schema_k8sio_api_core_v1_EventList(ref),
-		"k8s.io/api/core/v1.EventSeries":                                                     schema_k8sio_api_core_v1_EventSeries(ref),
-		"k8s.io/api/core/v1.EventSource":                                                     schema_k8sio_api_core_v1_EventSource(ref),
-		"k8s.io/api/core/v1.ExecAction":                                                      schema_k8sio_api_core_v1_ExecAction(ref),
-		"k8s.io/api/core/v1.FCVolumeSource":                                                  schema_k8sio_api_core_v1_FCVolumeSource(ref),
-		"k8s.io/api/core/v1.FlexPersistentVolumeSource":                                      schema_k8sio_api_core_v1_FlexPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.FlexVolumeSource":                                                schema_k8sio_api_core_v1_FlexVolumeSource(ref),
-		"k8s.io/api/core/v1.FlockerVolumeSource":                                             schema_k8sio_api_core_v1_FlockerVolumeSource(ref),
-		"k8s.io/api/core/v1.GCEPersistentDiskVolumeSource":                                   schema_k8sio_api_core_v1_GCEPersistentDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.GitRepoVolumeSource":                                             schema_k8sio_api_core_v1_GitRepoVolumeSource(ref),
-		"k8s.io/api/core/v1.GlusterfsPersistentVolumeSource":                                 schema_k8sio_api_core_v1_GlusterfsPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.GlusterfsVolumeSource":                                           schema_k8sio_api_core_v1_GlusterfsVolumeSource(ref),
-		"k8s.io/api/core/v1.HTTPGetAction":                                                   schema_k8sio_api_core_v1_HTTPGetAction(ref),
-		"k8s.io/api/core/v1.HTTPHeader":                                                      schema_k8sio_api_core_v1_HTTPHeader(ref),
-		"k8s.io/api/core/v1.Handler":                                                         schema_k8sio_api_core_v1_Handler(ref),
-		"k8s.io/api/core/v1.HostAlias":                                                       schema_k8sio_api_core_v1_HostAlias(ref),
-		"k8s.io/api/core/v1.HostPathVolumeSource":                                            schema_k8sio_api_core_v1_HostPathVolumeSource(ref),
-		"k8s.io/api/core/v1.ISCSIPersistentVolumeSource":                                     schema_k8sio_api_core_v1_ISCSIPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.ISCSIVolumeSource":                                               schema_k8sio_api_core_v1_ISCSIVolumeSource(ref),
-		"k8s.io/api/core/v1.KeyToPath":                                                       schema_k8sio_api_core_v1_KeyToPath(ref),
-		"k8s.io/api/core/v1.Lifecycle":                                                       schema_k8sio_api_core_v1_Lifecycle(ref),
-		"k8s.io/api/core/v1.LimitRange":                                                      schema_k8sio_api_core_v1_LimitRange(ref),
-		"k8s.io/api/core/v1.LimitRangeItem":                                                  schema_k8sio_api_core_v1_LimitRangeItem(ref),
-		"k8s.io/api/core/v1.LimitRangeList":                                                  schema_k8sio_api_core_v1_LimitRangeList(ref),
-		"k8s.io/api/core/v1.LimitRangeSpec":                                                  schema_k8sio_api_core_v1_LimitRangeSpec(ref),
-		"k8s.io/api/core/v1.List":                                                            schema_k8sio_api_core_v1_List(ref),
-		"k8s.io/api/core/v1.LoadBalancerIngress":                                             schema_k8sio_api_core_v1_LoadBalancerIngress(ref),
-		"k8s.io/api/core/v1.LoadBalancerStatus":                                              schema_k8sio_api_core_v1_LoadBalancerStatus(ref),
-		"k8s.io/api/core/v1.LocalObjectReference":                                            schema_k8sio_api_core_v1_LocalObjectReference(ref),
-		"k8s.io/api/core/v1.LocalVolumeSource":                                               schema_k8sio_api_core_v1_LocalVolumeSource(ref),
-		"k8s.io/api/core/v1.NFSVolumeSource":                                                 schema_k8sio_api_core_v1_NFSVolumeSource(ref),
-		"k8s.io/api/core/v1.Namespace":                                                       schema_k8sio_api_core_v1_Namespace(ref),
-		"k8s.io/api/core/v1.NamespaceList":                                                   schema_k8sio_api_core_v1_NamespaceList(ref),
-		"k8s.io/api/core/v1.NamespaceSpec":                                                   schema_k8sio_api_core_v1_NamespaceSpec(ref),
-		"k8s.io/api/core/v1.NamespaceStatus":                                                 schema_k8sio_api_core_v1_NamespaceStatus(ref),
-		"k8s.io/api/core/v1.Node":                                                            schema_k8sio_api_core_v1_Node(ref),
-		"k8s.io/api/core/v1.NodeAddress":                                                     schema_k8sio_api_core_v1_NodeAddress(ref),
-		"k8s.io/api/core/v1.NodeAffinity":                                                    schema_k8sio_api_core_v1_NodeAffinity(ref),
-		"k8s.io/api/core/v1.NodeCondition":                                                   schema_k8sio_api_core_v1_NodeCondition(ref),
-		"k8s.io/api/core/v1.NodeConfigSource":                                                schema_k8sio_api_core_v1_NodeConfigSource(ref),
-		"k8s.io/api/core/v1.NodeConfigStatus":                                                schema_k8sio_api_core_v1_NodeConfigStatus(ref),
-		"k8s.io/api/core/v1.NodeDaemonEndpoints":                                             schema_k8sio_api_core_v1_NodeDaemonEndpoints(ref),
-		"k8s.io/api/core/v1.NodeList":                                                        schema_k8sio_api_core_v1_NodeList(ref),
-		"k8s.io/api/core/v1.NodeProxyOptions":                                                schema_k8sio_api_core_v1_NodeProxyOptions(ref),
-		"k8s.io/api/core/v1.NodeResources":                                                   schema_k8sio_api_core_v1_NodeResources(ref),
-		"k8s.io/api/core/v1.NodeSelector":                                                    schema_k8sio_api_core_v1_NodeSelector(ref),
-		"k8s.io/api/core/v1.NodeSelectorRequirement":                                         schema_k8sio_api_core_v1_NodeSelectorRequirement(ref),
-		"k8s.io/api/core/v1.NodeSelectorTerm":                                                schema_k8sio_api_core_v1_NodeSelectorTerm(ref),
-		"k8s.io/api/core/v1.NodeSpec":                                                        schema_k8sio_api_core_v1_NodeSpec(ref),
-		"k8s.io/api/core/v1.NodeStatus":                                                      schema_k8sio_api_core_v1_NodeStatus(ref),
-		"k8s.io/api/core/v1.NodeSystemInfo":                                                  schema_k8sio_api_core_v1_NodeSystemInfo(ref),
-		"k8s.io/api/core/v1.ObjectFieldSelector":                                             schema_k8sio_api_core_v1_ObjectFieldSelector(ref),
-		"k8s.io/api/core/v1.ObjectReference":                                                 schema_k8sio_api_core_v1_ObjectReference(ref),
-		"k8s.io/api/core/v1.PersistentVolume":                                                schema_k8sio_api_core_v1_PersistentVolume(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaim":                                           schema_k8sio_api_core_v1_PersistentVolumeClaim(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimCondition":                                  schema_k8sio_api_core_v1_PersistentVolumeClaimCondition(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimList":                                       schema_k8sio_api_core_v1_PersistentVolumeClaimList(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimSpec":                                       schema_k8sio_api_core_v1_PersistentVolumeClaimSpec(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimStatus":                                     schema_k8sio_api_core_v1_PersistentVolumeClaimStatus(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource":                               schema_k8sio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
-		"k8s.io/api/core/v1.PersistentVolumeList":                                            schema_k8sio_api_core_v1_PersistentVolumeList(ref),
-		"k8s.io/api/core/v1.PersistentVolumeSource":                                          schema_k8sio_api_core_v1_PersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.PersistentVolumeSpec":                                            schema_k8sio_api_core_v1_PersistentVolumeSpec(ref),
-		"k8s.io/api/core/v1.PersistentVolumeStatus":                                          schema_k8sio_api_core_v1_PersistentVolumeStatus(ref),
-		"k8s.io/api/core/v1.PhotonPersistentDiskVolumeSource":                                schema_k8sio_api_core_v1_PhotonPersistentDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.Pod":                                                             schema_k8sio_api_core_v1_Pod(ref),
-		"k8s.io/api/core/v1.PodAffinity":                                                     schema_k8sio_api_core_v1_PodAffinity(ref),
-		"k8s.io/api/core/v1.PodAffinityTerm":                                                 schema_k8sio_api_core_v1_PodAffinityTerm(ref),
-		"k8s.io/api/core/v1.PodAntiAffinity":                                                 schema_k8sio_api_core_v1_PodAntiAffinity(ref),
-		"k8s.io/api/core/v1.PodAttachOptions":                                                schema_k8sio_api_core_v1_PodAttachOptions(ref),
-		"k8s.io/api/core/v1.PodCondition":                                                    schema_k8sio_api_core_v1_PodCondition(ref),
-		"k8s.io/api/core/v1.PodDNSConfig":                                                    schema_k8sio_api_core_v1_PodDNSConfig(ref),
-		"k8s.io/api/core/v1.PodDNSConfigOption":                                              schema_k8sio_api_core_v1_PodDNSConfigOption(ref),
-		"k8s.io/api/core/v1.PodExecOptions":                                                  schema_k8sio_api_core_v1_PodExecOptions(ref),
-		"k8s.io/api/core/v1.PodList":                                                         schema_k8sio_api_core_v1_PodList(ref),
-		"k8s.io/api/core/v1.PodLogOptions":                                                   schema_k8sio_api_core_v1_PodLogOptions(ref),
-		"k8s.io/api/core/v1.PodPortForwardOptions":                                           schema_k8sio_api_core_v1_PodPortForwardOptions(ref),
-		"k8s.io/api/core/v1.PodProxyOptions":                                                 schema_k8sio_api_core_v1_PodProxyOptions(ref),
-		"k8s.io/api/core/v1.PodReadinessGate":                                                schema_k8sio_api_core_v1_PodReadinessGate(ref),
-		"k8s.io/api/core/v1.PodSecurityContext":                                              schema_k8sio_api_core_v1_PodSecurityContext(ref),
-		"k8s.io/api/core/v1.PodSignature":                                                    schema_k8sio_api_core_v1_PodSignature(ref),
-		"k8s.io/api/core/v1.PodSpec":                                                         schema_k8sio_api_core_v1_PodSpec(ref),
-		"k8s.io/api/core/v1.PodStatus":                                                       schema_k8sio_api_core_v1_PodStatus(ref),
-		"k8s.io/api/core/v1.PodStatusResult":                                                 schema_k8sio_api_core_v1_PodStatusResult(ref),
-		"k8s.io/api/core/v1.PodTemplate":                                                     schema_k8sio_api_core_v1_PodTemplate(ref),
-		"k8s.io/api/core/v1.PodTemplateList":                                                 schema_k8sio_api_core_v1_PodTemplateList(ref),
-		"k8s.io/api/core/v1.PodTemplateSpec":                                                 schema_k8sio_api_core_v1_PodTemplateSpec(ref),
-		"k8s.io/api/core/v1.PortworxVolumeSource":                                            schema_k8sio_api_core_v1_PortworxVolumeSource(ref),
-		"k8s.io/api/core/v1.PreferAvoidPodsEntry":                                            schema_k8sio_api_core_v1_PreferAvoidPodsEntry(ref),
-		"k8s.io/api/core/v1.PreferredSchedulingTerm":                                         schema_k8sio_api_core_v1_PreferredSchedulingTerm(ref),
-		"k8s.io/api/core/v1.Probe":                                                           schema_k8sio_api_core_v1_Probe(ref),
-		"k8s.io/api/core/v1.ProjectedVolumeSource":                                           schema_k8sio_api_core_v1_ProjectedVolumeSource(ref),
-		"k8s.io/api/core/v1.QuobyteVolumeSource":                                             schema_k8sio_api_core_v1_QuobyteVolumeSource(ref),
-		"k8s.io/api/core/v1.RBDPersistentVolumeSource":                                       schema_k8sio_api_core_v1_RBDPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.RBDVolumeSource":                                                 schema_k8sio_api_core_v1_RBDVolumeSource(ref),
-		"k8s.io/api/core/v1.RangeAllocation":                                                 schema_k8sio_api_core_v1_RangeAllocation(ref),
-		"k8s.io/api/core/v1.ReplicationController":                                           schema_k8sio_api_core_v1_ReplicationController(ref),
-		"k8s.io/api/core/v1.ReplicationControllerCondition":                                  schema_k8sio_api_core_v1_ReplicationControllerCondition(ref),
-		"k8s.io/api/core/v1.ReplicationControllerList":                                       schema_k8sio_api_core_v1_ReplicationControllerList(ref),
-		"k8s.io/api/core/v1.ReplicationControllerSpec":                                       schema_k8sio_api_core_v1_ReplicationControllerSpec(ref),
-		"k8s.io/api/core/v1.ReplicationControllerStatus":                                     schema_k8sio_api_core_v1_ReplicationControllerStatus(ref),
-		"k8s.io/api/core/v1.ResourceFieldSelector":                                           schema_k8sio_api_core_v1_ResourceFieldSelector(ref),
-		"k8s.io/api/core/v1.ResourceQuota":                                                   schema_k8sio_api_core_v1_ResourceQuota(ref),
-		"k8s.io/api/core/v1.ResourceQuotaList":                                               schema_k8sio_api_core_v1_ResourceQuotaList(ref),
-		"k8s.io/api/core/v1.ResourceQuotaSpec":                                               schema_k8sio_api_core_v1_ResourceQuotaSpec(ref),
-		"k8s.io/api/core/v1.ResourceQuotaStatus":                                             schema_k8sio_api_core_v1_ResourceQuotaStatus(ref),
-		"k8s.io/api/core/v1.ResourceRequirements":                                            schema_k8sio_api_core_v1_ResourceRequirements(ref),
-		"k8s.io/api/core/v1.SELinuxOptions":                                                  schema_k8sio_api_core_v1_SELinuxOptions(ref),
-		"k8s.io/api/core/v1.ScaleIOPersistentVolumeSource":                                   schema_k8sio_api_core_v1_ScaleIOPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.ScaleIOVolumeSource":                                             schema_k8sio_api_core_v1_ScaleIOVolumeSource(ref),
-		"k8s.io/api/core/v1.ScopeSelector":                                                   schema_k8sio_api_core_v1_ScopeSelector(ref),
-		"k8s.io/api/core/v1.ScopedResourceSelectorRequirement":                               schema_k8sio_api_core_v1_ScopedResourceSelectorRequirement(ref),
-		"k8s.io/api/core/v1.Secret":                                                          schema_k8sio_api_core_v1_Secret(ref),
-		"k8s.io/api/core/v1.SecretEnvSource":                                                 schema_k8sio_api_core_v1_SecretEnvSource(ref),
-		"k8s.io/api/core/v1.SecretKeySelector":                                               schema_k8sio_api_core_v1_SecretKeySelector(ref),
-		"k8s.io/api/core/v1.SecretList":                                                      schema_k8sio_api_core_v1_SecretList(ref),
-		"k8s.io/api/core/v1.SecretProjection":                                                schema_k8sio_api_core_v1_SecretProjection(ref),
-		"k8s.io/api/core/v1.SecretReference":                                                 schema_k8sio_api_core_v1_SecretReference(ref),
-		"k8s.io/api/core/v1.SecretVolumeSource":                                              schema_k8sio_api_core_v1_SecretVolumeSource(ref),
-		"k8s.io/api/core/v1.SecurityContext":                                                 schema_k8sio_api_core_v1_SecurityContext(ref),
-		"k8s.io/api/core/v1.SerializedReference":                                             schema_k8sio_api_core_v1_SerializedReference(ref),
-		"k8s.io/api/core/v1.Service":                                                         schema_k8sio_api_core_v1_Service(ref),
-		"k8s.io/api/core/v1.ServiceAccount":                                                  schema_k8sio_api_core_v1_ServiceAccount(ref),
-		"k8s.io/api/core/v1.ServiceAccountList":                                              schema_k8sio_api_core_v1_ServiceAccountList(ref),
-		"k8s.io/api/core/v1.ServiceAccountTokenProjection":                                   schema_k8sio_api_core_v1_ServiceAccountTokenProjection(ref),
-		"k8s.io/api/core/v1.ServiceList":                                                     schema_k8sio_api_core_v1_ServiceList(ref),
-		"k8s.io/api/core/v1.ServicePort":                                                     schema_k8sio_api_core_v1_ServicePort(ref),
-		"k8s.io/api/core/v1.ServiceProxyOptions":                                             schema_k8sio_api_core_v1_ServiceProxyOptions(ref),
-		"k8s.io/api/core/v1.ServiceSpec":                                                     schema_k8sio_api_core_v1_ServiceSpec(ref),
-		"k8s.io/api/core/v1.ServiceStatus":                                                   schema_k8sio_api_core_v1_ServiceStatus(ref),
-		"k8s.io/api/core/v1.SessionAffinityConfig":                                           schema_k8sio_api_core_v1_SessionAffinityConfig(ref),
-		"k8s.io/api/core/v1.StorageOSPersistentVolumeSource":                                 schema_k8sio_api_core_v1_StorageOSPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.StorageOSVolumeSource":                                           schema_k8sio_api_core_v1_StorageOSVolumeSource(ref),
-		"k8s.io/api/core/v1.Sysctl":                                                          schema_k8sio_api_core_v1_Sysctl(ref),
-		"k8s.io/api/core/v1.TCPSocketAction":                                                 schema_k8sio_api_core_v1_TCPSocketAction(ref),
-		"k8s.io/api/core/v1.Taint":                                                           schema_k8sio_api_core_v1_Taint(ref),
-		"k8s.io/api/core/v1.Toleration":                                                      schema_k8sio_api_core_v1_Toleration(ref),
-		"k8s.io/api/core/v1.TopologySelectorLabelRequirement":                                schema_k8sio_api_core_v1_TopologySelectorLabelRequirement(ref),
-		"k8s.io/api/core/v1.TopologySelectorTerm":                                            schema_k8sio_api_core_v1_TopologySelectorTerm(ref),
-		"k8s.io/api/core/v1.TypedLocalObjectReference":                                       schema_k8sio_api_core_v1_TypedLocalObjectReference(ref),
-		"k8s.io/api/core/v1.Volume":                                                          schema_k8sio_api_core_v1_Volume(ref),
-		"k8s.io/api/core/v1.VolumeDevice":                                                    schema_k8sio_api_core_v1_VolumeDevice(ref),
-		"k8s.io/api/core/v1.VolumeMount":                                                     schema_k8sio_api_core_v1_VolumeMount(ref),
-		"k8s.io/api/core/v1.VolumeNodeAffinity":                                              schema_k8sio_api_core_v1_VolumeNodeAffinity(ref),
-		"k8s.io/api/core/v1.VolumeProjection":                                                schema_k8sio_api_core_v1_VolumeProjection(ref),
-		"k8s.io/api/core/v1.VolumeSource":                                                    schema_k8sio_api_core_v1_VolumeSource(ref),
-		"k8s.io/api/core/v1.VsphereVirtualDiskVolumeSource":                                  schema_k8sio_api_core_v1_VsphereVirtualDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.WeightedPodAffinityTerm":                                         schema_k8sio_api_core_v1_WeightedPodAffinityTerm(ref),
-		"k8s.io/api/rbac/v1.AggregationRule":                                                 schema_k8sio_api_rbac_v1_AggregationRule(ref),
-		"k8s.io/api/rbac/v1.ClusterRole":                                                     schema_k8sio_api_rbac_v1_ClusterRole(ref),
-		"k8s.io/api/rbac/v1.ClusterRoleBinding":                                              schema_k8sio_api_rbac_v1_ClusterRoleBinding(ref),
-		"k8s.io/api/rbac/v1.ClusterRoleBindingList":                                          schema_k8sio_api_rbac_v1_ClusterRoleBindingList(ref),
-		"k8s.io/api/rbac/v1.ClusterRoleList":                                                 schema_k8sio_api_rbac_v1_ClusterRoleList(ref),
-		"k8s.io/api/rbac/v1.PolicyRule":                                                      schema_k8sio_api_rbac_v1_PolicyRule(ref),
-		"k8s.io/api/rbac/v1.Role":                                                            schema_k8sio_api_rbac_v1_Role(ref),
-		"k8s.io/api/rbac/v1.RoleBinding":                                                     schema_k8sio_api_rbac_v1_RoleBinding(ref),
-		"k8s.io/api/rbac/v1.RoleBindingList":                                                 schema_k8sio_api_rbac_v1_RoleBindingList(ref),
-		"k8s.io/api/rbac/v1.RoleList":                                                        schema_k8sio_api_rbac_v1_RoleList(ref),
-		"k8s.io/api/rbac/v1.RoleRef":                                                         schema_k8sio_api_rbac_v1_RoleRef(ref),
-		"k8s.io/api/rbac/v1.Subject":                                                         schema_k8sio_api_rbac_v1_Subject(ref),
-		"k8s.io/apimachinery/pkg/api/resource.Quantity":                                      schema_apimachinery_pkg_api_resource_Quantity(ref),
-		"k8s.io/apimachinery/pkg/api/resource.int64Amount":                                   schema_apimachinery_pkg_api_resource_int64Amount(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                      schema_pkg_apis_meta_v1_APIGroup(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                                  schema_pkg_apis_meta_v1_APIGroupList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                                   schema_pkg_apis_meta_v1_APIResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                               schema_pkg_apis_meta_v1_APIResourceList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                                   schema_pkg_apis_meta_v1_APIVersions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                                 schema_pkg_apis_meta_v1_CreateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                                 schema_pkg_apis_meta_v1_DeleteOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                      schema_pkg_apis_meta_v1_Duration(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ExportOptions":                                 schema_pkg_apis_meta_v1_ExportOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Fields":                                        schema_pkg_apis_meta_v1_Fields(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                    schema_pkg_apis_meta_v1_GetOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                     schema_pkg_apis_meta_v1_GroupKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                                 schema_pkg_apis_meta_v1_GroupResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                                  schema_pkg_apis_meta_v1_GroupVersion(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                      schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                              schema_pkg_apis_meta_v1_GroupVersionKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                          schema_pkg_apis_meta_v1_GroupVersionResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Initializer":                                   schema_pkg_apis_meta_v1_Initializer(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Initializers":                                  schema_pkg_apis_meta_v1_Initializers(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                                 schema_pkg_apis_meta_v1_InternalEvent(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                                 schema_pkg_apis_meta_v1_LabelSelector(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                      schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                          schema_pkg_apis_meta_v1_List(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                      schema_pkg_apis_meta_v1_ListMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                                   schema_pkg_apis_meta_v1_ListOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":                            schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                     schema_pkg_apis_meta_v1_MicroTime(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                    schema_pkg_apis_meta_v1_ObjectMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                                schema_pkg_apis_meta_v1_OwnerReference(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                         schema_pkg_apis_meta_v1_Patch(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":                                  schema_pkg_apis_meta_v1_PatchOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                                 schema_pkg_apis_meta_v1_Preconditions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                     schema_pkg_apis_meta_v1_RootPaths(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                     schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                        schema_pkg_apis_meta_v1_Status(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                                   schema_pkg_apis_meta_v1_StatusCause(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                                 schema_pkg_apis_meta_v1_StatusDetails(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                          schema_pkg_apis_meta_v1_Time(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                     schema_pkg_apis_meta_v1_Timestamp(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                      schema_pkg_apis_meta_v1_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                                 schema_pkg_apis_meta_v1_UpdateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                    schema_pkg_apis_meta_v1_WatchEvent(ref),
-		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                       schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
-		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                           schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/runtime.Unknown":                                            schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
-		"k8s.io/apimachinery/pkg/util/intstr.IntOrString":                                    schema_apimachinery_pkg_util_intstr_IntOrString(ref),
-		"k8s.io/apimachinery/pkg/version.Info":                                               schema_k8sio_apimachinery_pkg_version_Info(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Cloud":                                 schema_pkg_apis_core_v1alpha1_Cloud(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ClusterInfo":                           schema_pkg_apis_core_v1alpha1_ClusterInfo(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition":                             schema_pkg_apis_core_v1alpha1_Condition(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerDeployment":                  schema_pkg_apis_core_v1alpha1_ControllerDeployment(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallation":                schema_pkg_apis_core_v1alpha1_ControllerInstallation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallationList":            schema_pkg_apis_core_v1alpha1_ControllerInstallationList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallationSpec":            schema_pkg_apis_core_v1alpha1_ControllerInstallationSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallationStatus":          schema_pkg_apis_core_v1alpha1_ControllerInstallationStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistration":                schema_pkg_apis_core_v1alpha1_ControllerRegistration(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistrationList":            schema_pkg_apis_core_v1alpha1_ControllerRegistrationList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistrationSpec":            schema_pkg_apis_core_v1alpha1_ControllerRegistrationSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerResource":                    schema_pkg_apis_core_v1alpha1_ControllerResource(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Endpoint":                              schema_pkg_apis_core_v1alpha1_Endpoint(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.K8SNetworks":                           schema_pkg_apis_core_v1alpha1_K8SNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Kubernetes":                            schema_pkg_apis_core_v1alpha1_Kubernetes(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError":                             schema_pkg_apis_core_v1alpha1_LastError(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation":                         schema_pkg_apis_core_v1alpha1_LastOperation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Plant":                                 schema_pkg_apis_core_v1alpha1_Plant(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantList":                             schema_pkg_apis_core_v1alpha1_PlantList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantSpec":                             schema_pkg_apis_core_v1alpha1_PlantSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantStatus":                           schema_pkg_apis_core_v1alpha1_PlantStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig":                        schema_pkg_apis_core_v1alpha1_ProviderConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.APIServerSLO":                         schema_pkg_apis_garden_v1beta1_APIServerSLO(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.APIServerSLOSample":                   schema_pkg_apis_garden_v1beta1_APIServerSLOSample(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSCloud":                             schema_pkg_apis_garden_v1beta1_AWSCloud(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSConstraints":                       schema_pkg_apis_garden_v1beta1_AWSConstraints(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSMachineImage":                      schema_pkg_apis_garden_v1beta1_AWSMachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSMachineImageMapping":               schema_pkg_apis_garden_v1beta1_AWSMachineImageMapping(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSNetworks":                          schema_pkg_apis_garden_v1beta1_AWSNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSProfile":                           schema_pkg_apis_garden_v1beta1_AWSProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSRegionalMachineImage":              schema_pkg_apis_garden_v1beta1_AWSRegionalMachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSServiceLoadBalancer":               schema_pkg_apis_garden_v1beta1_AWSServiceLoadBalancer(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSVPC":                               schema_pkg_apis_garden_v1beta1_AWSVPC(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSWorker":                            schema_pkg_apis_garden_v1beta1_AWSWorker(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSZoneNATGateway":                    schema_pkg_apis_garden_v1beta1_AWSZoneNATGateway(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSZoneSubnets":                       schema_pkg_apis_garden_v1beta1_AWSZoneSubnets(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addon":                                schema_pkg_apis_garden_v1beta1_Addon(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons":                               schema_pkg_apis_garden_v1beta1_Addons(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AdmissionPlugin":                      schema_pkg_apis_garden_v1beta1_AdmissionPlugin(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Alicloud":                             schema_pkg_apis_garden_v1beta1_Alicloud(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudConstraints":                  schema_pkg_apis_garden_v1beta1_AlicloudConstraints(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudLoadBalancer":                 schema_pkg_apis_garden_v1beta1_AlicloudLoadBalancer(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudMachineImage":                 schema_pkg_apis_garden_v1beta1_AlicloudMachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudMachineType":                  schema_pkg_apis_garden_v1beta1_AlicloudMachineType(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudNatGateway":                   schema_pkg_apis_garden_v1beta1_AlicloudNatGateway(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudNatGatewayZone":               schema_pkg_apis_garden_v1beta1_AlicloudNatGatewayZone(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudNetworks":                     schema_pkg_apis_garden_v1beta1_AlicloudNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudProfile":                      schema_pkg_apis_garden_v1beta1_AlicloudProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudVPC":                          schema_pkg_apis_garden_v1beta1_AlicloudVPC(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudVolumeType":                   schema_pkg_apis_garden_v1beta1_AlicloudVolumeType(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudWorker":                       schema_pkg_apis_garden_v1beta1_AlicloudWorker(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditConfig":                          schema_pkg_apis_garden_v1beta1_AuditConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditPolicy":                          schema_pkg_apis_garden_v1beta1_AuditPolicy(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuthenticationWebhook":                schema_pkg_apis_garden_v1beta1_AuthenticationWebhook(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureCloud":                           schema_pkg_apis_garden_v1beta1_AzureCloud(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureConstraints":                     schema_pkg_apis_garden_v1beta1_AzureConstraints(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureDomainCount":                     schema_pkg_apis_garden_v1beta1_AzureDomainCount(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureMachineImage":                    schema_pkg_apis_garden_v1beta1_AzureMachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureNetworks":                        schema_pkg_apis_garden_v1beta1_AzureNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureProfile":                         schema_pkg_apis_garden_v1beta1_AzureProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureResourceGroup":                   schema_pkg_apis_garden_v1beta1_AzureResourceGroup(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureVNet":                            schema_pkg_apis_garden_v1beta1_AzureVNet(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureVNetPeering":                     schema_pkg_apis_garden_v1beta1_AzureVNetPeering(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureWorker":                          schema_pkg_apis_garden_v1beta1_AzureWorker(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Backup":                               schema_pkg_apis_garden_v1beta1_Backup(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructure":                 schema_pkg_apis_garden_v1beta1_BackupInfrastructure(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureList":             schema_pkg_apis_garden_v1beta1_BackupInfrastructureList(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureSpec":             schema_pkg_apis_garden_v1beta1_BackupInfrastructureSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureStatus":           schema_pkg_apis_garden_v1beta1_BackupInfrastructureStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud":                                schema_pkg_apis_garden_v1beta1_Cloud(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudControllerManagerConfig":         schema_pkg_apis_garden_v1beta1_CloudControllerManagerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfile":                         schema_pkg_apis_garden_v1beta1_CloudProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileList":                     schema_pkg_apis_garden_v1beta1_CloudProfileList(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileSpec":                     schema_pkg_apis_garden_v1beta1_CloudProfileSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ClusterAutoscaler":                    schema_pkg_apis_garden_v1beta1_ClusterAutoscaler(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CustomMachineImages":                  schema_pkg_apis_garden_v1beta1_CustomMachineImages(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS":                                  schema_pkg_apis_garden_v1beta1_DNS(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint":                schema_pkg_apis_garden_v1beta1_DNSProviderConstraint(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DataVolume":                           schema_pkg_apis_garden_v1beta1_DataVolume(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DeletionProtectionPolicy":             schema_pkg_apis_garden_v1beta1_DeletionProtectionPolicy(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DeletionProtectionPolicyList":         schema_pkg_apis_garden_v1beta1_DeletionProtectionPolicyList(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DeletionProtectionPolicySpec":         schema_pkg_apis_garden_v1beta1_DeletionProtectionPolicySpec(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPCloud":                             schema_pkg_apis_garden_v1beta1_GCPCloud(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPCloudNAT":                          schema_pkg_apis_garden_v1beta1_GCPCloudNAT(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPCloudNATLogging":                   schema_pkg_apis_garden_v1beta1_GCPCloudNATLogging(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPConstraints":                       schema_pkg_apis_garden_v1beta1_GCPConstraints(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPMachineImage":                      schema_pkg_apis_garden_v1beta1_GCPMachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPNetworks":                          schema_pkg_apis_garden_v1beta1_GCPNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPProfile":                           schema_pkg_apis_garden_v1beta1_GCPProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPVPC":                               schema_pkg_apis_garden_v1beta1_GCPVPC(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPWorker":                            schema_pkg_apis_garden_v1beta1_GCPWorker(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener":                             schema_pkg_apis_garden_v1beta1_Gardener(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration":                     schema_pkg_apis_garden_v1beta1_GardenerDuration(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Heapster":                             schema_pkg_apis_garden_v1beta1_Heapster(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HelmTiller":                           schema_pkg_apis_garden_v1beta1_HelmTiller(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation":                          schema_pkg_apis_garden_v1beta1_Hibernation(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HibernationSchedule":                  schema_pkg_apis_garden_v1beta1_HibernationSchedule(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HorizontalPodAutoscalerConfig":        schema_pkg_apis_garden_v1beta1_HorizontalPodAutoscalerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAM":                             schema_pkg_apis_garden_v1beta1_Kube2IAM(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAMRole":                         schema_pkg_apis_garden_v1beta1_Kube2IAMRole(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeAPIServerConfig":                  schema_pkg_apis_garden_v1beta1_KubeAPIServerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeControllerManagerConfig":          schema_pkg_apis_garden_v1beta1_KubeControllerManagerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeLego":                             schema_pkg_apis_garden_v1beta1_KubeLego(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeProxyConfig":                      schema_pkg_apis_garden_v1beta1_KubeProxyConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeSchedulerConfig":                  schema_pkg_apis_garden_v1beta1_KubeSchedulerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig":                        schema_pkg_apis_garden_v1beta1_KubeletConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes":                           schema_pkg_apis_garden_v1beta1_Kubernetes(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConfig":                     schema_pkg_apis_garden_v1beta1_KubernetesConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints":                schema_pkg_apis_garden_v1beta1_KubernetesConstraints(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesDashboard":                  schema_pkg_apis_garden_v1beta1_KubernetesDashboard(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Local":                                schema_pkg_apis_garden_v1beta1_Local(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalConstraints":                     schema_pkg_apis_garden_v1beta1_LocalConstraints(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalMachineImage":                    schema_pkg_apis_garden_v1beta1_LocalMachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalNetworks":                        schema_pkg_apis_garden_v1beta1_LocalNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalProfile":                         schema_pkg_apis_garden_v1beta1_LocalProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType":                          schema_pkg_apis_garden_v1beta1_MachineType(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance":                          schema_pkg_apis_garden_v1beta1_Maintenance(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceAutoUpdate":                schema_pkg_apis_garden_v1beta1_MaintenanceAutoUpdate(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceTimeWindow":                schema_pkg_apis_garden_v1beta1_MaintenanceTimeWindow(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monitoring":                           schema_pkg_apis_garden_v1beta1_Monitoring(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monocular":                            schema_pkg_apis_garden_v1beta1_Monocular(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.NginxIngress":                         schema_pkg_apis_garden_v1beta1_NginxIngress(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OIDCConfig":                           schema_pkg_apis_garden_v1beta1_OIDCConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackCloud":                       schema_pkg_apis_garden_v1beta1_OpenStackCloud(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackConstraints":                 schema_pkg_apis_garden_v1beta1_OpenStackConstraints(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackFloatingPool":                schema_pkg_apis_garden_v1beta1_OpenStackFloatingPool(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackLoadBalancerProvider":        schema_pkg_apis_garden_v1beta1_OpenStackLoadBalancerProvider(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineImage":                schema_pkg_apis_garden_v1beta1_OpenStackMachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineType":                 schema_pkg_apis_garden_v1beta1_OpenStackMachineType(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackNetworks":                    schema_pkg_apis_garden_v1beta1_OpenStackNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackProfile":                     schema_pkg_apis_garden_v1beta1_OpenStackProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackRouter":                      schema_pkg_apis_garden_v1beta1_OpenStackRouter(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackWorker":                      schema_pkg_apis_garden_v1beta1_OpenStackWorker(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketCloud":                          schema_pkg_apis_garden_v1beta1_PacketCloud(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketConstraints":                    schema_pkg_apis_garden_v1beta1_PacketConstraints(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketMachineImage":                   schema_pkg_apis_garden_v1beta1_PacketMachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketNetworks":                       schema_pkg_apis_garden_v1beta1_PacketNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketProfile":                        schema_pkg_apis_garden_v1beta1_PacketProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketWorker":                         schema_pkg_apis_garden_v1beta1_PacketWorker(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Project":                              schema_pkg_apis_garden_v1beta1_Project(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectCloudProfile":                  schema_pkg_apis_garden_v1beta1_ProjectCloudProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectList":                          schema_pkg_apis_garden_v1beta1_ProjectList(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectRestrictions":                  schema_pkg_apis_garden_v1beta1_ProjectRestrictions(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectSpec":                          schema_pkg_apis_garden_v1beta1_ProjectSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectStatus":                        schema_pkg_apis_garden_v1beta1_ProjectStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Quota":                                schema_pkg_apis_garden_v1beta1_Quota(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaList":                            schema_pkg_apis_garden_v1beta1_QuotaList(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec":                            schema_pkg_apis_garden_v1beta1_QuotaSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBinding":                        schema_pkg_apis_garden_v1beta1_SecretBinding(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBindingList":                    schema_pkg_apis_garden_v1beta1_SecretBindingList(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBindingRotation":                schema_pkg_apis_garden_v1beta1_SecretBindingRotation(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBindingRotationStatus":          schema_pkg_apis_garden_v1beta1_SecretBindingRotationStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBindingVaultReference":          schema_pkg_apis_garden_v1beta1_SecretBindingVaultReference(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Seed":                                 schema_pkg_apis_garden_v1beta1_Seed(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedBackup":                           schema_pkg_apis_garden_v1beta1_SeedBackup(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedCloud":                            schema_pkg_apis_garden_v1beta1_SeedCloud(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedDashboardOIDC":                    schema_pkg_apis_garden_v1beta1_SeedDashboardOIDC(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedIngressACME":                      schema_pkg_apis_garden_v1beta1_SeedIngressACME(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedIngressTLS":                       schema_pkg_apis_garden_v1beta1_SeedIngressTLS(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedList":                             schema_pkg_apis_garden_v1beta1_SeedList(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedNetworks":                         schema_pkg_apis_garden_v1beta1_SeedNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedOrphan":                           schema_pkg_apis_garden_v1beta1_SeedOrphan(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedProviderHealth":                   schema_pkg_apis_garden_v1beta1_SeedProviderHealth(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedProviderQuota":                    schema_pkg_apis_garden_v1beta1_SeedProviderQuota(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSettingDashboardAuthentication":   schema_pkg_apis_garden_v1beta1_SeedSettingDashboardAuthentication(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSettingExcessCapacityReservation": schema_pkg_apis_garden_v1beta1_SeedSettingExcessCapacityReservation(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSettingLoadBalancerServices":      schema_pkg_apis_garden_v1beta1_SeedSettingLoadBalancerServices(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSettingNetworkPolicies":           schema_pkg_apis_garden_v1beta1_SeedSettingNetworkPolicies(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSettingScheduling":                schema_pkg_apis_garden_v1beta1_SeedSettingScheduling(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSettingShootDNS":                  schema_pkg_apis_garden_v1beta1_SeedSettingShootDNS(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSettingVerticalPodAutoscaler":     schema_pkg_apis_garden_v1beta1_SeedSettingVerticalPodAutoscaler(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSettings":                         schema_pkg_apis_garden_v1beta1_SeedSettings(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSpec":                             schema_pkg_apis_garden_v1beta1_SeedSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedStatus":                           schema_pkg_apis_garden_v1beta1_SeedStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ServiceLoadBalancer":                  schema_pkg_apis_garden_v1beta1_ServiceLoadBalancer(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Shoot":                                schema_pkg_apis_garden_v1beta1_Shoot(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootAccessAudit":                     schema_pkg_apis_garden_v1beta1_ShootAccessAudit(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootList":                            schema_pkg_apis_garden_v1beta1_ShootList(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootSpec":                            schema_pkg_apis_garden_v1beta1_ShootSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootStatus":                          schema_pkg_apis_garden_v1beta1_ShootStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootTemplate":                        schema_pkg_apis_garden_v1beta1_ShootTemplate(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootTemplateList":                    schema_pkg_apis_garden_v1beta1_ShootTemplateList(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootTemplateReference":               schema_pkg_apis_garden_v1beta1_ShootTemplateReference(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootTemplateSpec":                    schema_pkg_apis_garden_v1beta1_ShootTemplateSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootTemplateWorker":                  schema_pkg_apis_garden_v1beta1_ShootTemplateWorker(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Storage":                              schema_pkg_apis_garden_v1beta1_Storage(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.StorageClass":                         schema_pkg_apis_garden_v1beta1_StorageClass(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.TerraformerSettings":                  schema_pkg_apis_garden_v1beta1_TerraformerSettings(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType":                           schema_pkg_apis_garden_v1beta1_VolumeType(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Worker":                               schema_pkg_apis_garden_v1beta1_Worker(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerCapacity":                       schema_pkg_apis_garden_v1beta1_WorkerCapacity(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerKubeletConfig":                  schema_pkg_apis_garden_v1beta1_WorkerKubeletConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerOSUpdates":                      schema_pkg_apis_garden_v1beta1_WorkerOSUpdates(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone":                                 schema_pkg_apis_garden_v1beta1_Zone(ref),
+		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                       schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
+		"k8s.io/api/core/v1.Affinity":                                                               schema_k8sio_api_core_v1_Affinity(ref),
+		"k8s.io/api/core/v1.AttachedVolume":                                                         schema_k8sio_api_core_v1_AttachedVolume(ref),
+		"k8s.io/api/core/v1.AvoidPods":                                                              schema_k8sio_api_core_v1_AvoidPods(ref),
+		"k8s.io/api/core/v1.AzureDiskVolumeSource":                                                  schema_k8sio_api_core_v1_AzureDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.AzureFilePersistentVolumeSource":                                        schema_k8sio_api_core_v1_AzureFilePersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.AzureFileVolumeSource":                                                  schema_k8sio_api_core_v1_AzureFileVolumeSource(ref),
+		"k8s.io/api/core/v1.Binding":                                                                schema_k8sio_api_core_v1_Binding(ref),
+		"k8s.io/api/core/v1.CSIPersistentVolumeSource":                                              schema_k8sio_api_core_v1_CSIPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.CSIVolumeSource":                                                        schema_k8sio_api_core_v1_CSIVolumeSource(ref),
+		"k8s.io/api/core/v1.Capabilities":                                                           schema_k8sio_api_core_v1_Capabilities(ref),
+		"k8s.io/api/core/v1.CephFSPersistentVolumeSource":                                           schema_k8sio_api_core_v1_CephFSPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.CephFSVolumeSource":                                                     schema_k8sio_api_core_v1_CephFSVolumeSource(ref),
+		"k8s.io/api/core/v1.CinderPersistentVolumeSource":                                           schema_k8sio_api_core_v1_CinderPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.CinderVolumeSource":                                                     schema_k8sio_api_core_v1_CinderVolumeSource(ref),
+		"k8s.io/api/core/v1.ClientIPConfig":                                                         schema_k8sio_api_core_v1_ClientIPConfig(ref),
+		"k8s.io/api/core/v1.ComponentCondition":                                                     schema_k8sio_api_core_v1_ComponentCondition(ref),
+		"k8s.io/api/core/v1.ComponentStatus":                                                        schema_k8sio_api_core_v1_ComponentStatus(ref),
+		"k8s.io/api/core/v1.ComponentStatusList":                                                    schema_k8sio_api_core_v1_ComponentStatusList(ref),
+		"k8s.io/api/core/v1.ConfigMap":                                                              schema_k8sio_api_core_v1_ConfigMap(ref),
+		"k8s.io/api/core/v1.ConfigMapEnvSource":                                                     schema_k8sio_api_core_v1_ConfigMapEnvSource(ref),
+		"k8s.io/api/core/v1.ConfigMapKeySelector":                                                   schema_k8sio_api_core_v1_ConfigMapKeySelector(ref),
+		"k8s.io/api/core/v1.ConfigMapList":                                                          schema_k8sio_api_core_v1_ConfigMapList(ref),
+		"k8s.io/api/core/v1.ConfigMapNodeConfigSource":                                              schema_k8sio_api_core_v1_ConfigMapNodeConfigSource(ref),
+		"k8s.io/api/core/v1.ConfigMapProjection":                                                    schema_k8sio_api_core_v1_ConfigMapProjection(ref),
+		"k8s.io/api/core/v1.ConfigMapVolumeSource":                                                  schema_k8sio_api_core_v1_ConfigMapVolumeSource(ref),
+		"k8s.io/api/core/v1.Container":                                                              schema_k8sio_api_core_v1_Container(ref),
+		"k8s.io/api/core/v1.ContainerImage":                                                         schema_k8sio_api_core_v1_ContainerImage(ref),
+		"k8s.io/api/core/v1.ContainerPort":                                                          schema_k8sio_api_core_v1_ContainerPort(ref),
+		"k8s.io/api/core/v1.ContainerState":                                                         schema_k8sio_api_core_v1_ContainerState(ref),
+		"k8s.io/api/core/v1.ContainerStateRunning":                                                  schema_k8sio_api_core_v1_ContainerStateRunning(ref),
+		"k8s.io/api/core/v1.ContainerStateTerminated":                                               schema_k8sio_api_core_v1_ContainerStateTerminated(ref),
+		"k8s.io/api/core/v1.ContainerStateWaiting":                                                  schema_k8sio_api_core_v1_ContainerStateWaiting(ref),
+		"k8s.io/api/core/v1.ContainerStatus":                                                        schema_k8sio_api_core_v1_ContainerStatus(ref),
+		"k8s.io/api/core/v1.DaemonEndpoint":                                                         schema_k8sio_api_core_v1_DaemonEndpoint(ref),
+		"k8s.io/api/core/v1.DownwardAPIProjection":                                                  schema_k8sio_api_core_v1_DownwardAPIProjection(ref),
+		"k8s.io/api/core/v1.DownwardAPIVolumeFile":                                                  schema_k8sio_api_core_v1_DownwardAPIVolumeFile(ref),
+		"k8s.io/api/core/v1.DownwardAPIVolumeSource":                                                schema_k8sio_api_core_v1_DownwardAPIVolumeSource(ref),
+		"k8s.io/api/core/v1.EmptyDirVolumeSource":                                                   schema_k8sio_api_core_v1_EmptyDirVolumeSource(ref),
+		"k8s.io/api/core/v1.EndpointAddress":                                                        schema_k8sio_api_core_v1_EndpointAddress(ref),
+		"k8s.io/api/core/v1.EndpointPort":                                                           schema_k8sio_api_core_v1_EndpointPort(ref),
+		"k8s.io/api/core/v1.EndpointSubset":                                                         schema_k8sio_api_core_v1_EndpointSubset(ref),
+		"k8s.io/api/core/v1.Endpoints":                                                              schema_k8sio_api_core_v1_Endpoints(ref),
+		"k8s.io/api/core/v1.EndpointsList":                                                          schema_k8sio_api_core_v1_EndpointsList(ref),
+		"k8s.io/api/core/v1.EnvFromSource":                                                          schema_k8sio_api_core_v1_EnvFromSource(ref),
+		"k8s.io/api/core/v1.EnvVar":                                                                 schema_k8sio_api_core_v1_EnvVar(ref),
+		"k8s.io/api/core/v1.EnvVarSource":                                                           schema_k8sio_api_core_v1_EnvVarSource(ref),
+		"k8s.io/api/core/v1.Event":                                                                  schema_k8sio_api_core_v1_Event(ref),
+		"k8s.io/api/core/v1.EventList":                                                              schema_k8sio_api_core_v1_EventList(ref),
+		"k8s.io/api/core/v1.EventSeries":                                                            schema_k8sio_api_core_v1_EventSeries(ref),
+		"k8s.io/api/core/v1.EventSource":                                                            schema_k8sio_api_core_v1_EventSource(ref),
+		"k8s.io/api/core/v1.ExecAction":                                                             schema_k8sio_api_core_v1_ExecAction(ref),
+		"k8s.io/api/core/v1.FCVolumeSource":                                                         schema_k8sio_api_core_v1_FCVolumeSource(ref),
+		"k8s.io/api/core/v1.FlexPersistentVolumeSource":                                             schema_k8sio_api_core_v1_FlexPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.FlexVolumeSource":                                                       schema_k8sio_api_core_v1_FlexVolumeSource(ref),
+		"k8s.io/api/core/v1.FlockerVolumeSource":                                                    schema_k8sio_api_core_v1_FlockerVolumeSource(ref),
+		"k8s.io/api/core/v1.GCEPersistentDiskVolumeSource":                                          schema_k8sio_api_core_v1_GCEPersistentDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.GitRepoVolumeSource":                                                    schema_k8sio_api_core_v1_GitRepoVolumeSource(ref),
+		"k8s.io/api/core/v1.GlusterfsPersistentVolumeSource":                                        schema_k8sio_api_core_v1_GlusterfsPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.GlusterfsVolumeSource":                                                  schema_k8sio_api_core_v1_GlusterfsVolumeSource(ref),
+		"k8s.io/api/core/v1.HTTPGetAction":                                                          schema_k8sio_api_core_v1_HTTPGetAction(ref),
+		"k8s.io/api/core/v1.HTTPHeader":                                                             schema_k8sio_api_core_v1_HTTPHeader(ref),
+		"k8s.io/api/core/v1.Handler":                                                                schema_k8sio_api_core_v1_Handler(ref),
+		"k8s.io/api/core/v1.HostAlias":                                                              schema_k8sio_api_core_v1_HostAlias(ref),
+		"k8s.io/api/core/v1.HostPathVolumeSource":                                                   schema_k8sio_api_core_v1_HostPathVolumeSource(ref),
+		"k8s.io/api/core/v1.ISCSIPersistentVolumeSource":                                            schema_k8sio_api_core_v1_ISCSIPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.ISCSIVolumeSource":                                                      schema_k8sio_api_core_v1_ISCSIVolumeSource(ref),
+		"k8s.io/api/core/v1.KeyToPath":                                                              schema_k8sio_api_core_v1_KeyToPath(ref),
+		"k8s.io/api/core/v1.Lifecycle":                                                              schema_k8sio_api_core_v1_Lifecycle(ref),
+		"k8s.io/api/core/v1.LimitRange":                                                             schema_k8sio_api_core_v1_LimitRange(ref),
+		"k8s.io/api/core/v1.LimitRangeItem":                                                         schema_k8sio_api_core_v1_LimitRangeItem(ref),
+		"k8s.io/api/core/v1.LimitRangeList":                                                         schema_k8sio_api_core_v1_LimitRangeList(ref),
+		"k8s.io/api/core/v1.LimitRangeSpec":                                                         schema_k8sio_api_core_v1_LimitRangeSpec(ref),
+		"k8s.io/api/core/v1.List":                                                                   schema_k8sio_api_core_v1_List(ref),
+		"k8s.io/api/core/v1.LoadBalancerIngress":                                                    schema_k8sio_api_core_v1_LoadBalancerIngress(ref),
+		"k8s.io/api/core/v1.LoadBalancerStatus":                                                     schema_k8sio_api_core_v1_LoadBalancerStatus(ref),
+		"k8s.io/api/core/v1.LocalObjectReference":                                                   schema_k8sio_api_core_v1_LocalObjectReference(ref),
+		"k8s.io/api/core/v1.LocalVolumeSource":                                                      schema_k8sio_api_core_v1_LocalVolumeSource(ref),
+		"k8s.io/api/core/v1.NFSVolumeSource":                                                        schema_k8sio_api_core_v1_NFSVolumeSource(ref),
+		"k8s.io/api/core/v1.Namespace":                                                              schema_k8sio_api_core_v1_Namespace(ref),
+		"k8s.io/api/core/v1.NamespaceList":                                                          schema_k8sio_api_core_v1_NamespaceList(ref),
+		"k8s.io/api/core/v1.NamespaceSpec":                                                          schema_k8sio_api_core_v1_NamespaceSpec(ref),
+		"k8s.io/api/core/v1.NamespaceStatus":                                                        schema_k8sio_api_core_v1_NamespaceStatus(ref),
+		"k8s.io/api/core/v1.Node":                                                                   schema_k8sio_api_core_v1_Node(ref),
+		"k8s.io/api/core/v1.NodeAddress":                                                            schema_k8sio_api_core_v1_NodeAddress(ref),
+		"k8s.io/api/core/v1.NodeAffinity":                                                           schema_k8sio_api_core_v1_NodeAffinity(ref),
+		"k8s.io/api/core/v1.NodeCondition":                                                          schema_k8sio_api_core_v1_NodeCondition(ref),
+		"k8s.io/api/core/v1.NodeConfigSource":                                                       schema_k8sio_api_core_v1_NodeConfigSource(ref),
+		"k8s.io/api/core/v1.NodeConfigStatus":                                                       schema_k8sio_api_core_v1_NodeConfigStatus(ref),
+		"k8s.io/api/core/v1.NodeDaemonEndpoints":                                                    schema_k8sio_api_core_v1_NodeDaemonEndpoints(ref),
+		"k8s.io/api/core/v1.NodeList":                                                               schema_k8sio_api_core_v1_NodeList(ref),
+		"k8s.io/api/core/v1.NodeProxyOptions":                                                       schema_k8sio_api_core_v1_NodeProxyOptions(ref),
+		"k8s.io/api/core/v1.NodeResources":                                                          schema_k8sio_api_core_v1_NodeResources(ref),
+		"k8s.io/api/core/v1.NodeSelector":                                                           schema_k8sio_api_core_v1_NodeSelector(ref),
+		"k8s.io/api/core/v1.NodeSelectorRequirement":                                                schema_k8sio_api_core_v1_NodeSelectorRequirement(ref),
+		"k8s.io/api/core/v1.NodeSelectorTerm":                                                       schema_k8sio_api_core_v1_NodeSelectorTerm(ref),
+		"k8s.io/api/core/v1.NodeSpec":                                                               schema_k8sio_api_core_v1_NodeSpec(ref),
+		"k8s.io/api/core/v1.NodeStatus":                                                             schema_k8sio_api_core_v1_NodeStatus(ref),
+		"k8s.io/api/core/v1.NodeSystemInfo":                                                         schema_k8sio_api_core_v1_NodeSystemInfo(ref),
+		"k8s.io/api/core/v1.ObjectFieldSelector":                                                    schema_k8sio_api_core_v1_ObjectFieldSelector(ref),
+		"k8s.io/api/core/v1.ObjectReference":                                                        schema_k8sio_api_core_v1_ObjectReference(ref),
+		"k8s.io/api/core/v1.PersistentVolume":                                                       schema_k8sio_api_core_v1_PersistentVolume(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaim":                                                  schema_k8sio_api_core_v1_PersistentVolumeClaim(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimCondition":                                         schema_k8sio_api_core_v1_PersistentVolumeClaimCondition(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimList":                                              schema_k8sio_api_core_v1_PersistentVolumeClaimList(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimSpec":                                              schema_k8sio_api_core_v1_PersistentVolumeClaimSpec(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimStatus":                                            schema_k8sio_api_core_v1_PersistentVolumeClaimStatus(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource":                                      schema_k8sio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
+		"k8s.io/api/core/v1.PersistentVolumeList":                                                   schema_k8sio_api_core_v1_PersistentVolumeList(ref),
+		"k8s.io/api/core/v1.PersistentVolumeSource":                                                 schema_k8sio_api_core_v1_PersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.PersistentVolumeSpec":                                                   schema_k8sio_api_core_v1_PersistentVolumeSpec(ref),
+		"k8s.io/api/core/v1.PersistentVolumeStatus":                                                 schema_k8sio_api_core_v1_PersistentVolumeStatus(ref),
+		"k8s.io/api/core/v1.PhotonPersistentDiskVolumeSource":                                       schema_k8sio_api_core_v1_PhotonPersistentDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.Pod":                                                                    schema_k8sio_api_core_v1_Pod(ref),
+		"k8s.io/api/core/v1.PodAffinity":                                                            schema_k8sio_api_core_v1_PodAffinity(ref),
+		"k8s.io/api/core/v1.PodAffinityTerm":                                                        schema_k8sio_api_core_v1_PodAffinityTerm(ref),
+		"k8s.io/api/core/v1.PodAntiAffinity":                                                        schema_k8sio_api_core_v1_PodAntiAffinity(ref),
+		"k8s.io/api/core/v1.PodAttachOptions":                                                       schema_k8sio_api_core_v1_PodAttachOptions(ref),
+		"k8s.io/api/core/v1.PodCondition":                                                           schema_k8sio_api_core_v1_PodCondition(ref),
+		"k8s.io/api/core/v1.PodDNSConfig":                                                           schema_k8sio_api_core_v1_PodDNSConfig(ref),
+		"k8s.io/api/core/v1.PodDNSConfigOption":                                                     schema_k8sio_api_core_v1_PodDNSConfigOption(ref),
+		"k8s.io/api/core/v1.PodExecOptions":                                                         schema_k8sio_api_core_v1_PodExecOptions(ref),
+		"k8s.io/api/core/v1.PodList":                                                                schema_k8sio_api_core_v1_PodList(ref),
+		"k8s.io/api/core/v1.PodLogOptions":                                                          schema_k8sio_api_core_v1_PodLogOptions(ref),
+		"k8s.io/api/core/v1.PodPortForwardOptions":                                                  schema_k8sio_api_core_v1_PodPortForwardOptions(ref),
+		"k8s.io/api/core/v1.PodProxyOptions":                                                        schema_k8sio_api_core_v1_PodProxyOptions(ref),
+		"k8s.io/api/core/v1.PodReadinessGate":                                                       schema_k8sio_api_core_v1_PodReadinessGate(ref),
+		"k8s.io/api/core/v1.PodSecurityContext":                                                     schema_k8sio_api_core_v1_PodSecurityContext(ref),
+		"k8s.io/api/core/v1.PodSignature":                                                           schema_k8sio_api_core_v1_PodSignature(ref),
+		"k8s.io/api/core/v1.PodSpec":                                                                schema_k8sio_api_core_v1_PodSpec(ref),
+		"k8s.io/api/core/v1.PodStatus":                                                              schema_k8sio_api_core_v1_PodStatus(ref),
+		"k8s.io/api/core/v1.PodStatusResult":                                                        schema_k8sio_api_core_v1_PodStatusResult(ref),
+		"k8s.io/api/core/v1.PodTemplate":                                                            schema_k8sio_api_core_v1_PodTemplate(ref),
+		"k8s.io/api/core/v1.PodTemplateList":                                                        schema_k8sio_api_core_v1_PodTemplateList(ref),
+		"k8s.io/api/core/v1.PodTemplateSpec":                                                        schema_k8sio_api_core_v1_PodTemplateSpec(ref),
+		"k8s.io/api/core/v1.PortworxVolumeSource":                                                   schema_k8sio_api_core_v1_PortworxVolumeSource(ref),
+		"k8s.io/api/core/v1.PreferAvoidPodsEntry":                                                   schema_k8sio_api_core_v1_PreferAvoidPodsEntry(ref),
+		"k8s.io/api/core/v1.PreferredSchedulingTerm":                                                schema_k8sio_api_core_v1_PreferredSchedulingTerm(ref),
+		"k8s.io/api/core/v1.Probe":                                                                  schema_k8sio_api_core_v1_Probe(ref),
+		"k8s.io/api/core/v1.ProjectedVolumeSource":                                                  schema_k8sio_api_core_v1_ProjectedVolumeSource(ref),
+		"k8s.io/api/core/v1.QuobyteVolumeSource":                                                    schema_k8sio_api_core_v1_QuobyteVolumeSource(ref),
+		"k8s.io/api/core/v1.RBDPersistentVolumeSource":                                              schema_k8sio_api_core_v1_RBDPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.RBDVolumeSource":                                                        schema_k8sio_api_core_v1_RBDVolumeSource(ref),
+		"k8s.io/api/core/v1.RangeAllocation":                                                        schema_k8sio_api_core_v1_RangeAllocation(ref),
+		"k8s.io/api/core/v1.ReplicationController":                                                  schema_k8sio_api_core_v1_ReplicationController(ref),
+		"k8s.io/api/core/v1.ReplicationControllerCondition":                                         schema_k8sio_api_core_v1_ReplicationControllerCondition(ref),
+		"k8s.io/api/core/v1.ReplicationControllerList":                                              schema_k8sio_api_core_v1_ReplicationControllerList(ref),
+		"k8s.io/api/core/v1.ReplicationControllerSpec":                                              schema_k8sio_api_core_v1_ReplicationControllerSpec(ref),
+		"k8s.io/api/core/v1.ReplicationControllerStatus":                                            schema_k8sio_api_core_v1_ReplicationControllerStatus(ref),
+		"k8s.io/api/core/v1.ResourceFieldSelector":                                                  schema_k8sio_api_core_v1_ResourceFieldSelector(ref),
+		"k8s.io/api/core/v1.ResourceQuota":                                                          schema_k8sio_api_core_v1_ResourceQuota(ref),
+		"k8s.io/api/core/v1.ResourceQuotaList":                                                      schema_k8sio_api_core_v1_ResourceQuotaList(ref),
+		"k8s.io/api/core/v1.ResourceQuotaSpec":                                                      schema_k8sio_api_core_v1_ResourceQuotaSpec(ref),
+		"k8s.io/api/core/v1.ResourceQuotaStatus":                                                    schema_k8sio_api_core_v1_ResourceQuotaStatus(ref),
+		"k8s.io/api/core/v1.ResourceRequirements":                                                   schema_k8sio_api_core_v1_ResourceRequirements(ref),
+		"k8s.io/api/core/v1.SELinuxOptions":                                                         schema_k8sio_api_core_v1_SELinuxOptions(ref),
+		"k8s.io/api/core/v1.ScaleIOPersistentVolumeSource":                                          schema_k8sio_api_core_v1_ScaleIOPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.ScaleIOVolumeSource":                                                    schema_k8sio_api_core_v1_ScaleIOVolumeSource(ref),
+		"k8s.io/api/core/v1.ScopeSelector":                                                          schema_k8sio_api_core_v1_ScopeSelector(ref),
+		"k8s.io/api/core/v1.ScopedResourceSelectorRequirement":                                      schema_k8sio_api_core_v1_ScopedResourceSelectorRequirement(ref),
+		"k8s.io/api/core/v1.Secret":                                                                 schema_k8sio_api_core_v1_Secret(ref),
+		"k8s.io/api/core/v1.SecretEnvSource":                                                        schema_k8sio_api_core_v1_SecretEnvSource(ref),
+		"k8s.io/api/core/v1.SecretKeySelector":                                                      schema_k8sio_api_core_v1_SecretKeySelector(ref),
+		"k8s.io/api/core/v1.SecretList":                                                             schema_k8sio_api_core_v1_SecretList(ref),
+		"k8s.io/api/core/v1.SecretProjection":                                                       schema_k8sio_api_core_v1_SecretProjection(ref),
+		"k8s.io/api/core/v1.SecretReference":                                                        schema_k8sio_api_core_v1_SecretReference(ref),
+		"k8s.io/api/core/v1.SecretVolumeSource":                                                     schema_k8sio_api_core_v1_SecretVolumeSource(ref),
+		"k8s.io/api/core/v1.SecurityContext":                                                        schema_k8sio_api_core_v1_SecurityContext(ref),
+		"k8s.io/api/core/v1.SerializedReference":                                                    schema_k8sio_api_core_v1_SerializedReference(ref),
+		"k8s.io/api/core/v1.Service":                                                                schema_k8sio_api_core_v1_Service(ref),
+		"k8s.io/api/core/v1.ServiceAccount":                                                         schema_k8sio_api_core_v1_ServiceAccount(ref),
+		"k8s.io/api/core/v1.ServiceAccountList":                                                     schema_k8sio_api_core_v1_ServiceAccountList(ref),
+		"k8s.io/api/core/v1.ServiceAccountTokenProjection":                                          schema_k8sio_api_core_v1_ServiceAccountTokenProjection(ref),
+		"k8s.io/api/core/v1.ServiceList":                                                            schema_k8sio_api_core_v1_ServiceList(ref),
+		"k8s.io/api/core/v1.ServicePort":                                                            schema_k8sio_api_core_v1_ServicePort(ref),
+		"k8s.io/api/core/v1.ServiceProxyOptions":                                                    schema_k8sio_api_core_v1_ServiceProxyOptions(ref),
+		"k8s.io/api/core/v1.ServiceSpec":                                                            schema_k8sio_api_core_v1_ServiceSpec(ref),
+		"k8s.io/api/core/v1.ServiceStatus":                                                          schema_k8sio_api_core_v1_ServiceStatus(ref),
+		"k8s.io/api/core/v1.SessionAffinityConfig":                                                  schema_k8sio_api_core_v1_SessionAffinityConfig(ref),
+		"k8s.io/api/core/v1.StorageOSPersistentVolumeSource":                                        schema_k8sio_api_core_v1_StorageOSPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.StorageOSVolumeSource":                                                  schema_k8sio_api_core_v1_StorageOSVolumeSource(ref),
+		"k8s.io/api/core/v1.Sysctl":                                                                 schema_k8sio_api_core_v1_Sysctl(ref),
+		"k8s.io/api/core/v1.TCPSocketAction":                                                        schema_k8sio_api_core_v1_TCPSocketAction(ref),
+		"k8s.io/api/core/v1.Taint":                                                                  schema_k8sio_api_core_v1_Taint(ref),
+		"k8s.io/api/core/v1.Toleration":                                                             schema_k8sio_api_core_v1_Toleration(ref),
+		"k8s.io/api/core/v1.TopologySelectorLabelRequirement":                                       schema_k8sio_api_core_v1_TopologySelectorLabelRequirement(ref),
+		"k8s.io/api/core/v1.TopologySelectorTerm":                                                   schema_k8sio_api_core_v1_TopologySelectorTerm(ref),
+		"k8s.io/api/core/v1.TypedLocalObjectReference":                                              schema_k8sio_api_core_v1_TypedLocalObjectReference(ref),
+		"k8s.io/api/core/v1.Volume":                                                                 schema_k8sio_api_core_v1_Volume(ref),
+		"k8s.io/api/core/v1.VolumeDevice":                                                           schema_k8sio_api_core_v1_VolumeDevice(ref),
+		"k8s.io/api/core/v1.VolumeMount":                                                            schema_k8sio_api_core_v1_VolumeMount(ref),
+		"k8s.io/api/core/v1.VolumeNodeAffinity":                                                     schema_k8sio_api_core_v1_VolumeNodeAffinity(ref),
+		"k8s.io/api/core/v1.VolumeProjection":                                                       schema_k8sio_api_core_v1_VolumeProjection(ref),
+		"k8s.io/api/core/v1.VolumeSource":                                                           schema_k8sio_api_core_v1_VolumeSource(ref),
+		"k8s.io/api/core/v1.VsphereVirtualDiskVolumeSource":                                         schema_k8sio_api_core_v1_VsphereVirtualDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.WeightedPodAffinityTerm":                                                schema_k8sio_api_core_v1_WeightedPodAffinityTerm(ref),
+		"k8s.io/api/rbac/v1.AggregationRule":                                                        schema_k8sio_api_rbac_v1_AggregationRule(ref),
+		"k8s.io/api/rbac/v1.ClusterRole":                                                            schema_k8sio_api_rbac_v1_ClusterRole(ref),
+		"k8s.io/api/rbac/v1.ClusterRoleBinding":                                                     schema_k8sio_api_rbac_v1_ClusterRoleBinding(ref),
+		"k8s.io/api/rbac/v1.ClusterRoleBindingList":                                                 schema_k8sio_api_rbac_v1_ClusterRoleBindingList(ref),
+		"k8s.io/api/rbac/v1.ClusterRoleList":                                                        schema_k8sio_api_rbac_v1_ClusterRoleList(ref),
+		"k8s.io/api/rbac/v1.PolicyRule":                                                             schema_k8sio_api_rbac_v1_PolicyRule(ref),
+		"k8s.io/api/rbac/v1.Role":                                                                   schema_k8sio_api_rbac_v1_Role(ref),
+		"k8s.io/api/rbac/v1.RoleBinding":                                                            schema_k8sio_api_rbac_v1_RoleBinding(ref),
+		"k8s.io/api/rbac/v1.RoleBindingList":                                                        schema_k8sio_api_rbac_v1_RoleBindingList(ref),
+		"k8s.io/api/rbac/v1.RoleList":                                                               schema_k8sio_api_rbac_v1_RoleList(ref),
+		"k8s.io/api/rbac/v1.RoleRef":                                                                schema_k8sio_api_rbac_v1_RoleRef(ref),
+		"k8s.io/api/rbac/v1.Subject":                                                                schema_k8sio_api_rbac_v1_Subject(ref),
+		"k8s.io/apimachinery/pkg/api/resource.Quantity":                                             schema_apimachinery_pkg_api_resource_Quantity(ref),
+		"k8s.io/apimachinery/pkg/api/resource.int64Amount":                                          schema_apimachinery_pkg_api_resource_int64Amount(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                             schema_pkg_apis_meta_v1_APIGroup(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                                         schema_pkg_apis_meta_v1_APIGroupList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                                          schema_pkg_apis_meta_v1_APIResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                                      schema_pkg_apis_meta_v1_APIResourceList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                                          schema_pkg_apis_meta_v1_APIVersions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                                        schema_pkg_apis_meta_v1_CreateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                                        schema_pkg_apis_meta_v1_DeleteOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                             schema_pkg_apis_meta_v1_Duration(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ExportOptions":                                        schema_pkg_apis_meta_v1_ExportOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Fields":                                               schema_pkg_apis_meta_v1_Fields(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                           schema_pkg_apis_meta_v1_GetOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                            schema_pkg_apis_meta_v1_GroupKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                                        schema_pkg_apis_meta_v1_GroupResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                                         schema_pkg_apis_meta_v1_GroupVersion(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                             schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                                     schema_pkg_apis_meta_v1_GroupVersionKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                                 schema_pkg_apis_meta_v1_GroupVersionResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Initializer":                                          schema_pkg_apis_meta_v1_Initializer(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Initializers":                                         schema_pkg_apis_meta_v1_Initializers(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                                        schema_pkg_apis_meta_v1_InternalEvent(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                                        schema_pkg_apis_meta_v1_LabelSelector(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                             schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                                 schema_pkg_apis_meta_v1_List(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                             schema_pkg_apis_meta_v1_ListMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                                          schema_pkg_apis_meta_v1_ListOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":                                   schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                            schema_pkg_apis_meta_v1_MicroTime(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                           schema_pkg_apis_meta_v1_ObjectMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                                       schema_pkg_apis_meta_v1_OwnerReference(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                                schema_pkg_apis_meta_v1_Patch(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":                                         schema_pkg_apis_meta_v1_PatchOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                                        schema_pkg_apis_meta_v1_Preconditions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                            schema_pkg_apis_meta_v1_RootPaths(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                            schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                               schema_pkg_apis_meta_v1_Status(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                                          schema_pkg_apis_meta_v1_StatusCause(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                                        schema_pkg_apis_meta_v1_StatusDetails(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                                 schema_pkg_apis_meta_v1_Time(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                            schema_pkg_apis_meta_v1_Timestamp(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                             schema_pkg_apis_meta_v1_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                                        schema_pkg_apis_meta_v1_UpdateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                           schema_pkg_apis_meta_v1_WatchEvent(ref),
+		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                              schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
+		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                                  schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/runtime.Unknown":                                                   schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
+		"k8s.io/apimachinery/pkg/util/intstr.IntOrString":                                           schema_apimachinery_pkg_util_intstr_IntOrString(ref),
+		"k8s.io/apimachinery/pkg/version.Info":                                                      schema_k8sio_apimachinery_pkg_version_Info(ref),
 	}
 }
 
@@ -1068,7 +1119,8 @@ func schema_pkg_apis_core_v1alpha1_Plant(ref common.ReferenceCallback) common.Op
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "Plant represents an external kubernetes cluster.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
 						SchemaProps: spec.SchemaProps{
@@ -1247,11 +1299,96 @@ func schema_pkg_apis_core_v1alpha1_ProviderConfig(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_APIServerSLO(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIServerSLO contains the service level objective attainment of the kube-apiserver of a Shoot cluster within a rolling window of 30 days.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"availability": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Availability is the percentage of kube-apiserver requests which were not answered with a server error.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"latency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Latency is the percentage of kube-apiserver requests which were answered within one second.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"samples": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Samples are the daily service level indicators the attainment is computed from.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.APIServerSLOSample"),
+									},
+								},
+							},
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time when the service level indicators were last computed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"availability", "latency", "lastUpdateTime"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.APIServerSLOSample", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_APIServerSLOSample(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIServerSLOSample contains the service level indicators of the kube-apiserver of a Shoot cluster for one day.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"day": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Day is the day (UTC) of the sample in the format YYYY-MM-DD.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"availability": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Availability is the percentage of kube-apiserver requests which were not answered with a server error.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"latency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Latency is the percentage of kube-apiserver requests which were answered within one second.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"day", "availability", "latency"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_AWSCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "AWSCloud contains the Shoot specification for AWS.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"machineImage": {
 						SchemaProps: spec.SchemaProps{
@@ -1292,12 +1429,18 @@ func schema_pkg_apis_garden_v1beta1_AWSCloud(ref common.ReferenceCallback) commo
 							},
 						},
 					},
+					"serviceLoadBalancer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceLoadBalancer contains AWS specific defaults for the load balancers of services of type LoadBalancer in the Shoot cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSServiceLoadBalancer"),
+						},
+					},
 				},
 				Required: []string{"networks", "workers", "zones"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSMachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSWorker"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSMachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSServiceLoadBalancer", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSWorker"},
 	}
 }
 
@@ -1528,12 +1671,38 @@ func schema_pkg_apis_garden_v1beta1_AWSNetworks(ref common.ReferenceCallback) co
 							},
 						},
 					},
+					"existingSubnets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExistingSubnets is a list of already existing subnets per zone which shall be used instead of creating new ones. It is only allowed in combination with an existing VPC. The routing (e.g., NAT gateways) for existing subnets must already be configured.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSZoneSubnets"),
+									},
+								},
+							},
+						},
+					},
+					"existingNATGateways": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExistingNATGateways is a list of already existing NAT gateways per zone which shall be used for the private subnets instead of creating new ones. It is only allowed in combination with an existing VPC and for zones without existing subnets.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSZoneNATGateway"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"vpc", "internal", "public", "workers"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSVPC"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSVPC", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSZoneNATGateway", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSZoneSubnets"},
 	}
 }
 
@@ -1563,7 +1732,8 @@ func schema_pkg_apis_garden_v1beta1_AWSRegionalMachineImage(ref common.Reference
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "AWSRegionalMachineImage defines the technical id of a machine image in a region.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
@@ -1579,6 +1749,13 @@ func schema_pkg_apis_garden_v1beta1_AWSRegionalMachineImage(ref common.Reference
 							Format:      "",
 						},
 					},
+					"architecture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Architecture is the CPU architecture the image is built for (default: amd64). A region may contain one entry per architecture.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name", "ami"},
 			},
@@ -1586,6 +1763,26 @@ func schema_pkg_apis_garden_v1beta1_AWSRegionalMachineImage(ref common.Reference
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_AWSServiceLoadBalancer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AWSServiceLoadBalancer contains AWS specific defaults for the load balancers of services of type LoadBalancer.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the load balancers ('classic' or 'nlb'). Defaults to 'classic'.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_AWSVPC(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1607,6 +1804,13 @@ func schema_pkg_apis_garden_v1beta1_AWSVPC(ref common.ReferenceCallback) common.
 							Format:      "",
 						},
 					},
+					"internetGatewayID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InternetGatewayID is the id of an existing internet gateway attached to the existing VPC. If it is not provided, the internet gateway attached to the VPC is discovered automatically.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1690,6 +1894,20 @@ func schema_pkg_apis_garden_v1beta1_AWSWorker(ref common.ReferenceCallback) comm
 							},
 						},
 					},
+					"instanceTagLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstanceTagLabels is a list of keys of the `Labels` of this worker pool whose key/value pairs are additionally added as tags (AWS, Alicloud) or labels (GCP) to the cloud provider instances of this worker pool, e.g. to allow cost and inventory tools to group the machines by their Kubernetes labels.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 					"taints": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
@@ -1703,6 +1921,79 @@ func schema_pkg_apis_garden_v1beta1_AWSWorker(ref common.ReferenceCallback) comm
 							},
 						},
 					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones is a subset of the Shoot's zones in which the machines of this worker pool are created. If it is empty then the worker pool spans all zones of the Shoot.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"osUpdates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OSUpdates contains configuration for in-place updates of the operating system of this worker pool. If it is set then the nodes receive OS patches in-place instead of being replaced.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerOSUpdates"),
+						},
+					},
+					"customMachineImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CustomMachineImage is the provider-specific id of a machine image which is not listed in the CloudProfile, e.g. an AMI on AWS or an URN ('<publisher>:<offer>:<sku>:<version>') on Azure. The image must run the same operating system as the machine image of the Shoot. It is only allowed if the CustomMachineImages feature gate of the Gardener API server is enabled and the CloudProfile allows custom machine images for the project of the Shoot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxPods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxPods is the maximum number of pods which can run on a node of this worker pool (default: 110). It must not exceed the number of addresses of the pod CIDR of a node.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"dataVolumes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataVolumes is a list of additional volumes which are attached to the machines of this worker pool, e.g. as local scratch disks. They are only supported for AWS and GCP.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DataVolume"),
+									},
+								},
+							},
+						},
+					},
+					"kubeletDataVolumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeletDataVolumeName is the name of a data volume which is formatted and mounted at the root directory of the kubelet ('/var/lib/kubelet'), i.e., the pod volumes and container logs are stored on it instead of the root volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kubelet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubelet contains configuration for the image garbage collection and the container log rotation of the kubelets of this worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerKubeletConfig"),
+						},
+					},
+					"architecture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Architecture is the CPU architecture of the machines of this worker pool (default: amd64). It must match the architecture of the machine type.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"capacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capacity contains configuration for cost-optimized worker pools which mix on-demand and spot (preemptible) machines and which may fall back to other machine types. It is only supported for GCP and Alicloud.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerCapacity"),
+						},
+					},
 					"volumeType": {
 						SchemaProps: spec.SchemaProps{
 							Description: "VolumeType is the type of the root volumes.",
@@ -1717,12 +2008,96 @@ func schema_pkg_apis_garden_v1beta1_AWSWorker(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"volumeIOPS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeIOPS is the number of I/O operations per second (IOPS) that the root volume supports. It is only allowed for the provisioned IOPS volume types (io1, io2) and for gp3 volumes.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"volumeThroughput": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeThroughput is the throughput in MiB/s that the root volume supports. It is only allowed for gp3 volumes.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax", "volumeType", "volumeSize"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DataVolume", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerCapacity", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerKubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerOSUpdates", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AWSZoneNATGateway(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AWSZoneNATGateway references an existing NAT gateway in a zone.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"zone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zone is the name of the availability zone the NAT gateway belongs to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the id of the existing NAT gateway. If it is not provided, the available NAT gateway of the existing VPC in the zone is discovered automatically.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"zone"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AWSZoneSubnets(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AWSZoneSubnets contains the ids of existing subnets in a zone.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"zone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zone is the name of the availability zone the subnets belong to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"internal": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Internal is the id of the existing private subnet (used for internal load balancers).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"public": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Public is the id of the existing public subnet (used for bastion and load balancers).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"workers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers is the id of the existing worker subnet (private) (used for the VMs).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"zone", "internal", "public", "workers"},
+			},
+		},
 	}
 }
 
@@ -1839,6 +2214,12 @@ func schema_pkg_apis_garden_v1beta1_Alicloud(ref common.ReferenceCallback) commo
 				Description: "Alicloud contains the Shoot specification for Alibaba cloud",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"apiServerLoadBalancer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIServerLoadBalancer contains the configuration of the SLB which exposes the kube-apiserver of the Shoot. The address type must not be 'intranet' because the kube-apiserver must be reachable from the Seed and Garden cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudLoadBalancer"),
+						},
+					},
 					"machineImage": {
 						SchemaProps: spec.SchemaProps{
 							Description: "MachineImage holds information about the machine image to use for all workers. It will default to the first image stated in the referenced CloudProfile if no value has been provided.",
@@ -1878,12 +2259,18 @@ func schema_pkg_apis_garden_v1beta1_Alicloud(ref common.ReferenceCallback) commo
 							},
 						},
 					},
+					"serviceLoadBalancer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceLoadBalancer contains Alicloud specific defaults for the SLBs of services of type LoadBalancer in the Shoot cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudLoadBalancer"),
+						},
+					},
 				},
 				Required: []string{"networks", "workers", "zones"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudMachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudWorker"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudLoadBalancer", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudMachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudWorker"},
 	}
 }
 
@@ -1974,6 +2361,40 @@ func schema_pkg_apis_garden_v1beta1_AlicloudConstraints(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_AlicloudLoadBalancer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlicloudLoadBalancer contains the configuration of an Alicloud server load balancer (SLB).",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the instance specification of the SLB, e.g. 'slb.s2.small'.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"addressType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AddressType is the address type of the SLB ('internet' or 'intranet'). Defaults to 'internet'.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bandwidth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bandwidth is the maximum bandwidth of the SLB in Mbps. If set, the SLB is charged by bandwidth.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_AlicloudMachineImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2041,9 +2462,17 @@ func schema_pkg_apis_garden_v1beta1_AlicloudMachineType(ref common.ReferenceCall
 							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
+					"architecture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Architecture is the CPU architecture of this machine type (default: amd64).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"zones": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Zones is a list of availability zones in which the machine type is available.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -2063,6 +2492,90 @@ func schema_pkg_apis_garden_v1beta1_AlicloudMachineType(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_AlicloudNatGateway(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlicloudNatGateway contains the configuration of the NAT gateways of a Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"perZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PerZone indicates that an enhanced NAT gateway is created for each zone (attached to the zone's worker vswitch) instead of using one NAT gateway for all zones.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"eipBandwidth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EIPBandwidth is the maximum bandwidth of the NAT EIPs in Mbps. It applies to all zones which do not specify their own bandwidth. Defaults to 100.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"eipInternetChargeType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EIPInternetChargeType is the internet charge type of the NAT EIPs ('PayByTraffic' or 'PayByBandwidth'). It applies to all zones which do not specify their own charge type. If not set, the charge type of the EIP of the VPC's NAT gateway is kept, or 'PayByTraffic' is used if there is none.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones contains the EIP configuration of the NAT of each zone.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudNatGatewayZone"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudNatGatewayZone"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AlicloudNatGatewayZone(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlicloudNatGatewayZone contains the EIP configuration of the NAT of a zone.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the zone.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"eipBandwidth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EIPBandwidth is the maximum bandwidth of the zone's EIP in Mbps. Defaults to 100.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"eipInternetChargeType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EIPInternetChargeType is the internet charge type of the zone's EIP ('PayByTraffic' or 'PayByBandwidth').",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_AlicloudNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2111,12 +2624,18 @@ func schema_pkg_apis_garden_v1beta1_AlicloudNetworks(ref common.ReferenceCallbac
 							},
 						},
 					},
+					"natGateway": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NatGateway contains the configuration of the NAT gateways which are used by the worker vswitches.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudNatGateway"),
+						},
+					},
 				},
 				Required: []string{"vpc", "workers"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudVPC"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudNatGateway", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudVPC"},
 	}
 }
 
@@ -2199,7 +2718,8 @@ func schema_pkg_apis_garden_v1beta1_AlicloudVolumeType(ref common.ReferenceCallb
 					},
 					"zones": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Zones is a list of availability zones in which the volume type is available.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -2294,6 +2814,20 @@ func schema_pkg_apis_garden_v1beta1_AlicloudWorker(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"instanceTagLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstanceTagLabels is a list of keys of the `Labels` of this worker pool whose key/value pairs are additionally added as tags (AWS, Alicloud) or labels (GCP) to the cloud provider instances of this worker pool, e.g. to allow cost and inventory tools to group the machines by their Kubernetes labels.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 					"taints": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
@@ -2307,6 +2841,79 @@ func schema_pkg_apis_garden_v1beta1_AlicloudWorker(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones is a subset of the Shoot's zones in which the machines of this worker pool are created. If it is empty then the worker pool spans all zones of the Shoot.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"osUpdates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OSUpdates contains configuration for in-place updates of the operating system of this worker pool. If it is set then the nodes receive OS patches in-place instead of being replaced.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerOSUpdates"),
+						},
+					},
+					"customMachineImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CustomMachineImage is the provider-specific id of a machine image which is not listed in the CloudProfile, e.g. an AMI on AWS or an URN ('<publisher>:<offer>:<sku>:<version>') on Azure. The image must run the same operating system as the machine image of the Shoot. It is only allowed if the CustomMachineImages feature gate of the Gardener API server is enabled and the CloudProfile allows custom machine images for the project of the Shoot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxPods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxPods is the maximum number of pods which can run on a node of this worker pool (default: 110). It must not exceed the number of addresses of the pod CIDR of a node.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"dataVolumes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataVolumes is a list of additional volumes which are attached to the machines of this worker pool, e.g. as local scratch disks. They are only supported for AWS and GCP.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DataVolume"),
+									},
+								},
+							},
+						},
+					},
+					"kubeletDataVolumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeletDataVolumeName is the name of a data volume which is formatted and mounted at the root directory of the kubelet ('/var/lib/kubelet'), i.e., the pod volumes and container logs are stored on it instead of the root volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kubelet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubelet contains configuration for the image garbage collection and the container log rotation of the kubelets of this worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerKubeletConfig"),
+						},
+					},
+					"architecture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Architecture is the CPU architecture of the machines of this worker pool (default: amd64). It must match the architecture of the machine type.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"capacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capacity contains configuration for cost-optimized worker pools which mix on-demand and spot (preemptible) machines and which may fall back to other machine types. It is only supported for GCP and Alicloud.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerCapacity"),
+						},
+					},
 					"volumeType": {
 						SchemaProps: spec.SchemaProps{
 							Description: "VolumeType is the type of the root volumes.",
@@ -2326,7 +2933,7 @@ func schema_pkg_apis_garden_v1beta1_AlicloudWorker(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DataVolume", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerCapacity", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerKubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerOSUpdates", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
@@ -2372,6 +2979,42 @@ func schema_pkg_apis_garden_v1beta1_AuditPolicy(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_AuthenticationWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuthenticationWebhook contains the configuration of an external token authentication webhook of the kube-apiserver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the HTTPS endpoint of the webhook which receives TokenReview requests.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"caBundle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CABundle is the PEM-encoded bundle of certificate authorities which is used to verify the serving certificate of the webhook. If empty, the system's trusted root certificates are used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cacheTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheTTL is the duration to cache the responses of the webhook. Defaults to 2m.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"url"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_AzureCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2710,7 +3353,64 @@ func schema_pkg_apis_garden_v1beta1_AzureVNet(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"peering": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Peering contains the configuration for peering the VNet with a hub VNet.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureVNetPeering"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureVNetPeering"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AzureVNetPeering(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AzureVNetPeering contains the configuration for peering the VNet of a Shoot with a remote (hub) VNet. Only the peering from the Shoot VNet to the remote VNet is created; the peering in the opposite direction must be established by the owner of the remote VNet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"remoteVNetID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemoteVNetID is the resource id of the remote VNet.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"remoteCIDRs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemoteCIDRs is the list of address spaces of the remote VNet. They must not overlap with the networks of the Shoot.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"allowForwardedTraffic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowForwardedTraffic controls whether forwarded traffic from VMs in the remote VNet is allowed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"useRemoteGateways": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UseRemoteGateways controls whether the gateways of the remote VNet are used.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"remoteVNetID", "remoteCIDRs"},
 			},
 		},
 	}
@@ -2793,6 +3493,20 @@ func schema_pkg_apis_garden_v1beta1_AzureWorker(ref common.ReferenceCallback) co
 							},
 						},
 					},
+					"instanceTagLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstanceTagLabels is a list of keys of the `Labels` of this worker pool whose key/value pairs are additionally added as tags (AWS, Alicloud) or labels (GCP) to the cloud provider instances of this worker pool, e.g. to allow cost and inventory tools to group the machines by their Kubernetes labels.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 					"taints": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
@@ -2806,10 +3520,83 @@ func schema_pkg_apis_garden_v1beta1_AzureWorker(ref common.ReferenceCallback) co
 							},
 						},
 					},
-					"volumeType": {
+					"zones": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeType is the type of the root volumes.",
-							Type:        []string{"string"},
+							Description: "Zones is a subset of the Shoot's zones in which the machines of this worker pool are created. If it is empty then the worker pool spans all zones of the Shoot.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"osUpdates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OSUpdates contains configuration for in-place updates of the operating system of this worker pool. If it is set then the nodes receive OS patches in-place instead of being replaced.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerOSUpdates"),
+						},
+					},
+					"customMachineImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CustomMachineImage is the provider-specific id of a machine image which is not listed in the CloudProfile, e.g. an AMI on AWS or an URN ('<publisher>:<offer>:<sku>:<version>') on Azure. The image must run the same operating system as the machine image of the Shoot. It is only allowed if the CustomMachineImages feature gate of the Gardener API server is enabled and the CloudProfile allows custom machine images for the project of the Shoot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxPods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxPods is the maximum number of pods which can run on a node of this worker pool (default: 110). It must not exceed the number of addresses of the pod CIDR of a node.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"dataVolumes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataVolumes is a list of additional volumes which are attached to the machines of this worker pool, e.g. as local scratch disks. They are only supported for AWS and GCP.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DataVolume"),
+									},
+								},
+							},
+						},
+					},
+					"kubeletDataVolumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeletDataVolumeName is the name of a data volume which is formatted and mounted at the root directory of the kubelet ('/var/lib/kubelet'), i.e., the pod volumes and container logs are stored on it instead of the root volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kubelet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubelet contains configuration for the image garbage collection and the container log rotation of the kubelets of this worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerKubeletConfig"),
+						},
+					},
+					"architecture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Architecture is the CPU architecture of the machines of this worker pool (default: amd64). It must match the architecture of the machine type.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"capacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capacity contains configuration for cost-optimized worker pools which mix on-demand and spot (preemptible) machines and which may fall back to other machine types. It is only supported for GCP and Alicloud.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerCapacity"),
+						},
+					},
+					"volumeType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeType is the type of the root volumes.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
@@ -2825,7 +3612,7 @@ func schema_pkg_apis_garden_v1beta1_AzureWorker(ref common.ReferenceCallback) co
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DataVolume", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerCapacity", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerKubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerOSUpdates", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
@@ -2979,6 +3766,13 @@ func schema_pkg_apis_garden_v1beta1_BackupInfrastructureSpec(ref common.Referenc
 							Format:      "",
 						},
 					},
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region is the region in which the backup bucket is created. Defaults to the region of the Seed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"seed", "shootUID"},
 			},
@@ -3012,11 +3806,24 @@ func schema_pkg_apis_garden_v1beta1_BackupInfrastructureStatus(ref common.Refere
 							Format:      "int64",
 						},
 					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represents the latest available observations of a BackupInfrastructure's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation"},
 	}
 }
 
@@ -3054,6 +3861,27 @@ func schema_pkg_apis_garden_v1beta1_Cloud(ref common.ReferenceCallback) common.O
 							Format:      "",
 						},
 					},
+					"tags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tags is a map of additional tags (e.g., cost center, owner, environment) which are applied to all resources managed by Gardener in the cloud provider account. On GCP, OpenStack and Alicloud they are applied to the machines only (as GCE labels, server metadata and ECS tags). Not supported for Packet and Local.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"serviceLoadBalancer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceLoadBalancer contains defaults for the load balancers of services of type LoadBalancer in the Shoot cluster. They are applied when such a service is created; annotations set on the service take precedence. Not supported for Packet and Local.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ServiceLoadBalancer"),
+						},
+					},
 					"aws": {
 						SchemaProps: spec.SchemaProps{
 							Description: "AWS contains the Shoot specification for the Amazon Web Services cloud.",
@@ -3101,7 +3929,7 @@ func schema_pkg_apis_garden_v1beta1_Cloud(ref common.ReferenceCallback) common.O
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Alicloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Local", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketCloud", "k8s.io/api/core/v1.LocalObjectReference"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Alicloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Local", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ServiceLoadBalancer", "k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
@@ -3279,11 +4107,23 @@ func schema_pkg_apis_garden_v1beta1_CloudProfileSpec(ref common.ReferenceCallbac
 							Format:      "",
 						},
 					},
+					"terraformer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Terraformer pins the version of the Terraformer which creates the infrastructure of the Shoots using this profile. If not set, the version of the image vector of the Gardener controller manager is used.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.TerraformerSettings"),
+						},
+					},
+					"customMachineImages": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CustomMachineImages controls which Shoots using this profile may specify custom machine images for their worker pools. If not set, custom machine images are not allowed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CustomMachineImages"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketProfile"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.CustomMachineImages", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.TerraformerSettings"},
 	}
 }
 
@@ -3308,6 +4148,34 @@ func schema_pkg_apis_garden_v1beta1_ClusterAutoscaler(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_CustomMachineImages(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CustomMachineImages controls which Shoots may specify custom machine images for their worker pools.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"projects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Projects is a list of names of the projects whose Shoots may use custom machine images.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"projects"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_DNS(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3343,6 +4211,13 @@ func schema_pkg_apis_garden_v1beta1_DNS(ref common.ReferenceCallback) common.Ope
 							Format:      "",
 						},
 					},
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTL is the time-to-live in seconds of the DNS records for the API server of the Shoot cluster. Lower values make changes of the API server endpoint visible faster at the cost of more DNS queries. Defaults to 120.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 			},
 		},
@@ -3370,795 +4245,772 @@ func schema_pkg_apis_garden_v1beta1_DNSProviderConstraint(ref common.ReferenceCa
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_GCPCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_DataVolume(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GCPCloud contains the Shoot specification for GCP.",
+				Description: "DataVolume contains the configuration of an additional volume which is attached to the machines of a worker pool.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"machineImage": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineImage holds information about the machine image to use for all workers. It will default to the first image stated in the referenced CloudProfile if no value has been provided.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPMachineImage"),
+							Description: "Name is the name of the data volume, it must be unique within the worker pool.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"networks": {
+					"size": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Networks holds information about the Kubernetes and infrastructure networks.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPNetworks"),
+							Description: "Size is the size of the data volume, e.g. '100Gi'.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"workers": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Workers is a list of worker groups.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPWorker"),
-									},
-								},
-							},
+							Description: "Type is the provider-specific type of the data volume (default: type of the root volume).",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"zones": {
+					"encrypted": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Zones is a list of availability zones to deploy the Shoot cluster to.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "Encrypted determines whether the data volume is encrypted (default: true).",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"networks", "workers", "zones"},
+				Required: []string{"name", "size"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPMachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPWorker"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_GCPConstraints(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_DeletionProtectionPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GCPConstraints is an object containing constraints for certain values in the Shoot specification.",
+				Description: "DeletionProtectionPolicy protects the Shoots matching its label selector from being deleted without the approval of multiple users, and it extends the retention of their backups after deletion.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"dnsProviders": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DNSProviders contains constraints regarding allowed values of the 'dns.provider' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint"),
-									},
-								},
-							},
-						},
-					},
-					"kubernetes": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kubernetes contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints"),
-						},
-					},
-					"machineImages": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineImages contains constraints regarding allowed values for machine images in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPMachineImage"),
-									},
-								},
-							},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"machineTypes": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineTypes contains constraints regarding allowed values for machine types in the 'workers' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType"),
-									},
-								},
-							},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"volumeTypes": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeTypes contains constraints regarding allowed values for volume types in the 'workers' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType"),
-									},
-								},
-							},
+							Description: "Standard object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"zones": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Zones contains constraints regarding allowed values for 'zones' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"),
-									},
-								},
-							},
+							Description: "Spec contains the specification of the DeletionProtectionPolicy.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DeletionProtectionPolicySpec"),
 						},
 					},
 				},
-				Required: []string{"dnsProviders", "kubernetes", "machineImages", "machineTypes", "volumeTypes", "zones"},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPMachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DeletionProtectionPolicySpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_GCPMachineImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_DeletionProtectionPolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GCPMachineImage defines the name of the machine image in the GCP environment.",
+				Description: "DeletionProtectionPolicyList is a collection of DeletionProtectionPolicies.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the image.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"image": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Image is the technical name of the image. It contains the image name and the Google Cloud project. Example: projects/<name>/global/images/version23",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"name", "image"},
-			},
-		},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of DeletionProtectionPolicies.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DeletionProtectionPolicy"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DeletionProtectionPolicy", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_GCPNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_DeletionProtectionPolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GCPNetworks holds information about the Kubernetes and infrastructure networks.",
+				Description: "DeletionProtectionPolicySpec is the specification of a DeletionProtectionPolicy.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"nodes": {
+					"shootSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Nodes is the CIDR of the node network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ShootSelector selects the Shoots (across all projects) which are protected by the policy.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"pods": {
+					"requiredApprovals": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Pods is the CIDR of the pod network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "RequiredApprovals is the number of distinct users who have to approve the deletion of a protected Shoot before it can be deleted.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"services": {
+					"backupRetention": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Services is the CIDR of the service network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "BackupRetention is the minimum duration the backup infrastructure of a deleted protected Shoot is kept before it is garbage collected.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"vpc": {
+				},
+				Required: []string{"shootSelector", "requiredApprovals"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_GCPCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GCPCloud contains the Shoot specification for GCP.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"machineImage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VPC indicates whether to use an existing VPC or create a new one.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPVPC"),
+							Description: "MachineImage holds information about the machine image to use for all workers. It will default to the first image stated in the referenced CloudProfile if no value has been provided.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPMachineImage"),
+						},
+					},
+					"networks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Networks holds information about the Kubernetes and infrastructure networks.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPNetworks"),
 						},
 					},
 					"workers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Workers is a list of CIDRs of worker subnets (private) to create (used for the VMs).",
+							Description: "Workers is a list of worker groups.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPWorker"),
 									},
 								},
 							},
 						},
 					},
-					"internal": {
+					"zones": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Internal is a private subnet (used for internal load balancers).",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Zones is a list of availability zones to deploy the Shoot cluster to.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"workers"},
+				Required: []string{"networks", "workers", "zones"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPVPC"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPMachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPWorker"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_GCPProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_GCPCloudNAT(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GCPProfile defines certain constraints and definitions for the GCP cloud.",
+				Description: "GCPCloudNAT contains the configuration of a Cloud NAT.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"constraints": {
+					"minPortsPerVM": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Constraints is an object containing constraints for certain values in the Shoot specification.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPConstraints"),
+							Description: "MinPortsPerVM is the minimum number of ports allocated to a VM from the NAT IPs. Defaults to 64.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"natIPNames": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NatIPNames is a list of names of existing static external IP addresses in the Shoot's region which are used for the NAT. If empty, NAT IPs are allocated automatically.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"logging": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Logging contains the logging configuration of the NAT. If not set, logging is disabled.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPCloudNATLogging"),
 						},
 					},
 				},
-				Required: []string{"constraints"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPConstraints"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPCloudNATLogging"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_GCPVPC(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_GCPCloudNATLogging(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GCPVPC indicates whether to use an existing VPC or create a new one.",
+				Description: "GCPCloudNATLogging contains the logging configuration of a Cloud NAT.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"filter": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of an existing GCP VPC.",
+							Description: "Filter specifies which NAT events are logged. Must be one of 'ERRORS_ONLY', 'TRANSLATIONS_ONLY' or 'ALL'.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"filter"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_GCPWorker(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_GCPConstraints(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GCPWorker is the definition of a worker group.",
+				Description: "GCPConstraints is an object containing constraints for certain values in the Shoot specification.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the worker group.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"machineType": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MachineType is the machine type of the worker group.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"autoScalerMin": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AutoScalerMin is the minimum number of VMs to create.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"autoScalerMax": {
+					"dnsProviders": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutoScalerMin is the maximum number of VMs to create.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "DNSProviders contains constraints regarding allowed values of the 'dns.provider' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint"),
+									},
+								},
+							},
 						},
 					},
-					"maxSurge": {
+					"kubernetes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxSurge is maximum number of VMs that are created during an update.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							Description: "Kubernetes contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints"),
 						},
 					},
-					"maxUnavailable": {
+					"machineImages": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							Description: "MachineImages contains constraints regarding allowed values for machine images in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPMachineImage"),
+									},
+								},
+							},
 						},
 					},
-					"annotations": {
+					"machineTypes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "MachineTypes contains constraints regarding allowed values for machine types in the 'workers' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType"),
 									},
 								},
 							},
 						},
 					},
-					"labels": {
+					"volumeTypes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "VolumeTypes contains constraints regarding allowed values for volume types in the 'workers' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType"),
 									},
 								},
 							},
 						},
 					},
-					"taints": {
+					"zones": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
+							Description: "Zones contains constraints regarding allowed values for 'zones' block in the Shoot specification.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.Taint"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"),
 									},
 								},
 							},
 						},
 					},
-					"volumeType": {
+				},
+				Required: []string{"dnsProviders", "kubernetes", "machineImages", "machineTypes", "volumeTypes", "zones"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPMachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_GCPMachineImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GCPMachineImage defines the name of the machine image in the GCP environment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeType is the type of the root volumes.",
+							Description: "Name is the name of the image.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"volumeSize": {
+					"image": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeSize is the size of the root volume.",
+							Description: "Image is the technical name of the image. It contains the image name and the Google Cloud project. Example: projects/<name>/global/images/version23",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax", "volumeType", "volumeSize"},
+				Required: []string{"name", "image"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Gardener(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_GCPNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Gardener holds the information about the Gardener",
+				Description: "GCPNetworks holds information about the Kubernetes and infrastructure networks.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
+					"nodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID is the Docker container id of the Gardener which last acted on a Shoot cluster.",
+							Description: "Nodes is the CIDR of the node network.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"pods": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the hostname (pod name) of the Gardener which last acted on a Shoot cluster.",
+							Description: "Pods is the CIDR of the pod network.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"version": {
+					"services": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version is the version of the Gardener which last acted on a Shoot cluster.",
+							Description: "Services is the CIDR of the service network.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"id", "name", "version"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_GardenerDuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "GardenerDuration is a workaround for missing OpenAPI functions on metav1.Duration struct.",
-				Type:        v1beta1.GardenerDuration{}.OpenAPISchemaType(),
-				Format:      v1beta1.GardenerDuration{}.OpenAPISchemaFormat(),
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_Heapster(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Heapster describes configuration values for the heapster addon.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"enabled": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Enabled indicates whether the addon is enabled or not.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-				},
-				Required: []string{"enabled"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_HelmTiller(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "HelmTiller describes configuration values for the helm-tiller addon.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"enabled": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Enabled indicates whether the addon is enabled or not.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-				},
-				Required: []string{"enabled"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_Hibernation(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Hibernation contains information whether the Shoot is suspended or not.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"enabled": {
+					"vpc": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled is true if Shoot is hibernated, false otherwise.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "VPC indicates whether to use an existing VPC or create a new one.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPVPC"),
 						},
 					},
-					"schedules": {
+					"workers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Schedules determine the hibernation schedules.",
+							Description: "Workers is a list of CIDRs of worker subnets (private) to create (used for the VMs).",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.HibernationSchedule"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"enabled"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HibernationSchedule"},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_HibernationSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "HibernationSchedule determines the hibernation schedule of a Shoot. A Shoot will be regularly hibernated at each start time and will be woken up at each end time. Start or End can be omitted, though at least one of each has to be specified.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"start": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Start is a Cron spec at which time a Shoot will be hibernated.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"end": {
+					"internal": {
 						SchemaProps: spec.SchemaProps{
-							Description: "End is a Cron spec at which time a Shoot will be woken up.",
+							Description: "Internal is a private subnet (used for internal load balancers).",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"location": {
+					"cloudNAT": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Location is the time location in which both start and and shall be evaluated.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "CloudNAT contains the configuration of a Cloud NAT for the worker subnet. If set, a Cloud Router and a Cloud NAT are created for the Shoot and the worker machines are created without external IPs.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPCloudNAT"),
 						},
 					},
 				},
+				Required: []string{"workers"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPCloudNAT", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPVPC"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_HorizontalPodAutoscalerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_GCPProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager. Note: Descriptions were taken from the Kubernetes documentation.",
+				Description: "GCPProfile defines certain constraints and definitions for the GCP cloud.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"downscaleDelay": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The period since last downscale, before another downscale can be performed in horizontal pod autoscaler.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
-						},
-					},
-					"syncPeriod": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The period for syncing the number of pods in horizontal pod autoscaler.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
-						},
-					},
-					"tolerance": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The minimum change (from 1.0) in the desired-to-actual metrics ratio for the horizontal pod autoscaler to consider scaling.",
-							Type:        []string{"number"},
-							Format:      "double",
-						},
-					},
-					"upscaleDelay": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The period since last upscale, before another upscale can be performed in horizontal pod autoscaler.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
-						},
-					},
-					"downscaleStabilization": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The configurable window at which the controller will choose the highest recommendation for autoscaling.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
-						},
-					},
-					"initialReadinessDelay": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The configurable period at which the horizontal pod autoscaler considers a Pod “not yet ready” given that it’s unready and it has  transitioned to unready during that time.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
-						},
-					},
-					"cpuInitializationPeriod": {
+					"constraints": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The period after which a ready pod transition is considered to be the first.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
+							Description: "Constraints is an object containing constraints for certain values in the Shoot specification.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPConstraints"),
 						},
 					},
 				},
+				Required: []string{"constraints"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPConstraints"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Kube2IAM(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_GCPVPC(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Kube2IAM describes configuration values for the kube2iam addon.",
+				Description: "GCPVPC indicates whether to use an existing VPC or create a new one.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled indicates whether the addon is enabled or not.",
-							Type:        []string{"boolean"},
+							Description: "Name is the name of an existing GCP VPC.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"roles": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Roles is list of AWS IAM roles which should be created by the Gardener.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAMRole"),
-									},
-								},
-							},
-						},
-					},
 				},
-				Required: []string{"enabled"},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAMRole"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Kube2IAMRole(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_GCPWorker(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Kube2IAMRole allows passing AWS IAM policies which will result in IAM roles.",
+				Description: "GCPWorker is the definition of a worker group.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the IAM role. Will be extended by the Shoot name.",
+							Description: "Name is the name of the worker group.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"description": {
+					"machineType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description is a human readable message indiciating what this IAM role can be used for.",
+							Description: "MachineType is the machine type of the worker group.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"policy": {
+					"autoScalerMin": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Policy is an AWS IAM policy document.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "AutoScalerMin is the minimum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-				},
-				Required: []string{"name", "description", "policy"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_KubeAPIServerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "KubeAPIServerConfig contains configuration settings for the kube-apiserver.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"featureGates": {
+					"autoScalerMax": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FeatureGates contains information about enabled feature gates.",
+							Description: "AutoScalerMin is the maximum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxSurge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSurge is maximum number of VMs that are created during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"boolean"},
+										Type:   []string{"string"},
 										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"runtimeConfig": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RuntimeConfig contains information about enabled or disabled APIs.",
+							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"boolean"},
+										Type:   []string{"string"},
 										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"oidcConfig": {
-						SchemaProps: spec.SchemaProps{
-							Description: "OIDCConfig contains configuration settings for the OIDC provider.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OIDCConfig"),
-						},
-					},
-					"admissionPlugins": {
+					"instanceTagLabels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AdmissionPlugins contains the list of user-defined admission plugins (additional to those managed by Gardener), and, if desired, the corresponding configuration.",
+							Description: "InstanceTagLabels is a list of keys of the `Labels` of this worker pool whose key/value pairs are additionally added as tags (AWS, Alicloud) or labels (GCP) to the cloud provider instances of this worker pool, e.g. to allow cost and inventory tools to group the machines by their Kubernetes labels.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AdmissionPlugin"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"auditConfig": {
+					"taints": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AuditConfig contains configuration settings for the audit of the kube-apiserver.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditConfig"),
+							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Taint"),
+									},
+								},
+							},
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones is a subset of the Shoot's zones in which the machines of this worker pool are created. If it is empty then the worker pool spans all zones of the Shoot.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"osUpdates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OSUpdates contains configuration for in-place updates of the operating system of this worker pool. If it is set then the nodes receive OS patches in-place instead of being replaced.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerOSUpdates"),
+						},
+					},
+					"customMachineImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CustomMachineImage is the provider-specific id of a machine image which is not listed in the CloudProfile, e.g. an AMI on AWS or an URN ('<publisher>:<offer>:<sku>:<version>') on Azure. The image must run the same operating system as the machine image of the Shoot. It is only allowed if the CustomMachineImages feature gate of the Gardener API server is enabled and the CloudProfile allows custom machine images for the project of the Shoot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxPods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxPods is the maximum number of pods which can run on a node of this worker pool (default: 110). It must not exceed the number of addresses of the pod CIDR of a node.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"dataVolumes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataVolumes is a list of additional volumes which are attached to the machines of this worker pool, e.g. as local scratch disks. They are only supported for AWS and GCP.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DataVolume"),
+									},
+								},
+							},
+						},
+					},
+					"kubeletDataVolumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeletDataVolumeName is the name of a data volume which is formatted and mounted at the root directory of the kubelet ('/var/lib/kubelet'), i.e., the pod volumes and container logs are stored on it instead of the root volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kubelet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubelet contains configuration for the image garbage collection and the container log rotation of the kubelets of this worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerKubeletConfig"),
+						},
+					},
+					"architecture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Architecture is the CPU architecture of the machines of this worker pool (default: amd64). It must match the architecture of the machine type.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"capacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capacity contains configuration for cost-optimized worker pools which mix on-demand and spot (preemptible) machines and which may fall back to other machine types. It is only supported for GCP and Alicloud.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerCapacity"),
+						},
+					},
+					"volumeType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeType is the type of the root volumes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeSize is the size of the root volume.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax", "volumeType", "volumeSize"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AdmissionPlugin", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OIDCConfig"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DataVolume", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerCapacity", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerKubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerOSUpdates", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_KubeControllerManagerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_Gardener(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubeControllerManagerConfig contains configuration settings for the kube-controller-manager.",
+				Description: "Gardener holds the information about the Gardener",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"featureGates": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FeatureGates contains information about enabled feature gates.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"boolean"},
-										Format: "",
-									},
-								},
-							},
+							Description: "ID is the Docker container id of the Gardener which last acted on a Shoot cluster.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"horizontalPodAutoscaler": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.HorizontalPodAutoscalerConfig"),
+							Description: "Name is the hostname (pod name) of the Gardener which last acted on a Shoot cluster.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version of the Gardener which last acted on a Shoot cluster.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"id", "name", "version"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HorizontalPodAutoscalerConfig"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_KubeLego(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_GardenerDuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubeLego describes configuration values for the kube-lego addon.",
+				Description: "GardenerDuration is a workaround for missing OpenAPI functions on metav1.Duration struct.",
+				Type:        v1beta1.GardenerDuration{}.OpenAPISchemaType(),
+				Format:      v1beta1.GardenerDuration{}.OpenAPISchemaFormat(),
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_Heapster(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Heapster describes configuration values for the heapster addon.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"enabled": {
@@ -4168,13 +5020,6 @@ func schema_pkg_apis_garden_v1beta1_KubeLego(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
-					"email": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Mail is the email address to register at Let's Encrypt.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 				},
 				Required: []string{"enabled"},
 			},
@@ -4182,96 +5027,89 @@ func schema_pkg_apis_garden_v1beta1_KubeLego(ref common.ReferenceCallback) commo
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_KubeProxyConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_HelmTiller(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubeProxyConfig contains configuration settings for the kube-proxy.",
+				Description: "HelmTiller describes configuration values for the helm-tiller addon.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"featureGates": {
-						SchemaProps: spec.SchemaProps{
-							Description: "FeatureGates contains information about enabled feature gates.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"boolean"},
-										Format: "",
-									},
-								},
-							},
-						},
-					},
-					"mode": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Mode specifies which proxy mode to use. defaults to IPTables.",
-							Type:        []string{"string"},
+							Description: "Enabled indicates whether the addon is enabled or not.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"enabled"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_KubeSchedulerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_Hibernation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubeSchedulerConfig contains configuration settings for the kube-scheduler.",
+				Description: "Hibernation contains information whether the Shoot is suspended or not.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"featureGates": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FeatureGates contains information about enabled feature gates.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Enabled is true if Shoot is hibernated, false otherwise.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"schedules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedules determine the hibernation schedules.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"boolean"},
-										Format: "",
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.HibernationSchedule"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"enabled"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HibernationSchedule"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_KubeletConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_HibernationSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubeletConfig contains configuration settings for the kubelet.",
+				Description: "HibernationSchedule determines the hibernation schedule of a Shoot. A Shoot will be regularly hibernated at each start time and will be woken up at each end time. Start or End can be omitted, though at least one of each has to be specified.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"featureGates": {
+					"start": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FeatureGates contains information about enabled feature gates.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"boolean"},
-										Format: "",
-									},
-								},
-							},
+							Description: "Start is a Cron spec at which time a Shoot will be hibernated.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"podPidsLimit": {
+					"end": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodPIDsLimit is the maximum number of process IDs per pod allowed by the kubelet.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "End is a Cron spec at which time a Shoot will be woken up.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"location": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Location is the time location in which both start and and shall be evaluated.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
@@ -4280,487 +5118,517 @@ func schema_pkg_apis_garden_v1beta1_KubeletConfig(ref common.ReferenceCallback)
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Kubernetes(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_HorizontalPodAutoscalerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Kubernetes contains the version and configuration variables for the Shoot control plane.",
+				Description: "HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager. Note: Descriptions were taken from the Kubernetes documentation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"allowPrivilegedContainers": {
+					"downscaleDelay": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AllowPrivilegedContainers indicates whether privileged containers are allowed in the Shoot (default: true).",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "The period since last downscale, before another downscale can be performed in horizontal pod autoscaler.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
 						},
 					},
-					"kubeAPIServer": {
+					"syncPeriod": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KubeAPIServer contains configuration settings for the kube-apiserver.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeAPIServerConfig"),
+							Description: "The period for syncing the number of pods in horizontal pod autoscaler.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
 						},
 					},
-					"cloudControllerManager": {
+					"tolerance": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CloudControllerManager contains configuration settings for the cloud-controller-manager.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudControllerManagerConfig"),
+							Description: "The minimum change (from 1.0) in the desired-to-actual metrics ratio for the horizontal pod autoscaler to consider scaling.",
+							Type:        []string{"number"},
+							Format:      "double",
 						},
 					},
-					"kubeControllerManager": {
-						SchemaProps: spec.SchemaProps{
-							Description: "KubeControllerManager contains configuration settings for the kube-controller-manager.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeControllerManagerConfig"),
-						},
-					},
-					"kubeScheduler": {
+					"upscaleDelay": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KubeScheduler contains configuration settings for the kube-scheduler.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeSchedulerConfig"),
+							Description: "The period since last upscale, before another upscale can be performed in horizontal pod autoscaler.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
 						},
 					},
-					"kubeProxy": {
+					"downscaleStabilization": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KubeProxy contains configuration settings for the kube-proxy.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeProxyConfig"),
+							Description: "The configurable window at which the controller will choose the highest recommendation for autoscaling.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
 						},
 					},
-					"kubelet": {
+					"initialReadinessDelay": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kubelet contains configuration settings for the kubelet.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig"),
+							Description: "The configurable period at which the horizontal pod autoscaler considers a Pod “not yet ready” given that it’s unready and it has  transitioned to unready during that time.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
 						},
 					},
-					"version": {
+					"cpuInitializationPeriod": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version is the semantic Kubernetes version to use for the Shoot cluster.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "The period after which a ready pod transition is considered to be the first.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
 						},
 					},
 				},
-				Required: []string{"version"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudControllerManagerConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeAPIServerConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeControllerManagerConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeProxyConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeSchedulerConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_KubernetesConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_Kube2IAM(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubernetesConfig contains common configuration fields for the control plane components.",
+				Description: "Kube2IAM describes configuration values for the kube2iam addon.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"featureGates": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FeatureGates contains information about enabled feature gates.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"boolean"},
-										Format: "",
-									},
-								},
-							},
+							Description: "Enabled indicates whether the addon is enabled or not.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_KubernetesConstraints(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "KubernetesConstraints contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"versions": {
+					"roles": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Versions is the list of allowed Kubernetes versions for Shoot clusters (e.g., 1.13.1).",
+							Description: "Roles is list of AWS IAM roles which should be created by the Gardener.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAMRole"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"versions"},
+				Required: []string{"enabled"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAMRole"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_KubernetesDashboard(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_Kube2IAMRole(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubernetesDashboard describes configuration values for the kubernetes-dashboard addon.",
+				Description: "Kube2IAMRole allows passing AWS IAM policies which will result in IAM roles.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled indicates whether the addon is enabled or not.",
-							Type:        []string{"boolean"},
+							Description: "Name is the name of the IAM role. Will be extended by the Shoot name.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"authenticationMode": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AuthenticationMode defines the authentication mode for the kubernetes-dashboard.",
+							Description: "Description is a human readable message indiciating what this IAM role can be used for.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy is an AWS IAM policy document.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"enabled"},
+				Required: []string{"name", "description", "policy"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Local(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_KubeAPIServerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Local contains the Shoot specification for local provider.",
+				Description: "KubeAPIServerConfig contains configuration settings for the kube-apiserver.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"networks": {
+					"featureGates": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Networks holds information about the Kubernetes and infrastructure networks.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalNetworks"),
+							Description: "FeatureGates contains information about enabled feature gates.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"boolean"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"machineImage": {
+					"runtimeConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineImage holds information about the machine image to use for all workers. It will default to the first image stated in the referenced CloudProfile if no value has been provided.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalMachineImage"),
+							Description: "RuntimeConfig contains information about enabled or disabled APIs.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"boolean"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"endpoint": {
+					"oidcConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Endpoint of the local service.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "OIDCConfig contains configuration settings for the OIDC provider.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OIDCConfig"),
 						},
 					},
-				},
-				Required: []string{"networks", "endpoint"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalMachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalNetworks"},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_LocalConstraints(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "LocalConstraints is an object containing constraints for certain values in the Shoot specification.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"dnsProviders": {
+					"admissionPlugins": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DNSProviders contains constraints regarding allowed values of the 'dns.provider' block in the Shoot specification.",
+							Description: "AdmissionPlugins contains the list of user-defined admission plugins (additional to those managed by Gardener), and, if desired, the corresponding configuration.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AdmissionPlugin"),
 									},
 								},
 							},
 						},
 					},
-					"machineImages": {
+					"auditConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineImages contains constraints regarding allowed values for machine images in the Shoot specification.",
+							Description: "AuditConfig contains configuration settings for the audit of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditConfig"),
+						},
+					},
+					"allowedCIDRs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedCIDRs is a list of CIDRs from which the load balancer of the kube-apiserver accepts connections. The node network, the static egress IPs of the worker nodes and the node and pod networks of the Seed are always allowed. If empty, the kube-apiserver is reachable from everywhere. It is not supported for Alicloud and local Shoots.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalMachineImage"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"dnsProviders", "machineImages"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalMachineImage"},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_LocalMachineImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "LocalMachineImage defines the machine image for Local Provider.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"enableBasicAuthentication": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the image.",
-							Type:        []string{"string"},
+							Description: "EnableBasicAuthentication defines whether the kube-apiserver accepts basic authentication. Basic authentication is deprecated and should be disabled. Defaults to true.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
+					"authenticationWebhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthenticationWebhook configures an external webhook which authenticates bearer tokens.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuthenticationWebhook"),
+						},
+					},
 				},
-				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AdmissionPlugin", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuthenticationWebhook", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OIDCConfig"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_LocalNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_KubeControllerManagerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LocalNetworks holds information about the Kubernetes and infrastructure networks.",
+				Description: "KubeControllerManagerConfig contains configuration settings for the kube-controller-manager.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"nodes": {
+					"featureGates": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Nodes is the CIDR of the node network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "FeatureGates contains information about enabled feature gates.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"boolean"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"pods": {
+					"horizontalPodAutoscaler": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Pods is the CIDR of the pod network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.HorizontalPodAutoscalerConfig"),
 						},
 					},
-					"services": {
+					"nodeCIDRMaskSize": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Services is the CIDR of the service network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "NodeCIDRMaskSize is the mask size of the pod CIDR which is assigned to every node (default: 24). It determines the maximum number of nodes of the Shoot (given by the size of the pods network) and the maximum number of pods per node. It cannot be changed once the Shoot has been created.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"workers": {
+					"nodeMonitorGracePeriod": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Workers is a CIDR of a worker subnet (private) to create (used for the VMs).",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "NodeMonitorGracePeriod is the amount of time which a running node may be unresponsive before it is marked unhealthy (default: 40s).",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
+						},
+					},
+					"podEvictionTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodEvictionTimeout is the grace period for deleting pods on failed nodes (default: 2m).",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
 						},
 					},
 				},
-				Required: []string{"workers"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.HorizontalPodAutoscalerConfig"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_LocalProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_KubeLego(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LocalProfile defines constraints and definitions for the local development.",
+				Description: "KubeLego describes configuration values for the kube-lego addon.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"constraints": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Constraints is an object containing constraints for certain values in the Shoot specification.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalConstraints"),
+							Description: "Enabled indicates whether the addon is enabled or not.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"email": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mail is the email address to register at Let's Encrypt.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"constraints"},
+				Required: []string{"enabled"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalConstraints"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_MachineType(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_KubeProxyConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MachineType contains certain properties of a machine type.",
+				Description: "KubeProxyConfig contains configuration settings for the kube-proxy.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"featureGates": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the machine type.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "FeatureGates contains information about enabled feature gates.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"boolean"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"usable": {
+					"mode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Usable defines if the machine type can be used for shoot clusters.",
-							Type:        []string{"boolean"},
+							Description: "Mode specifies which proxy mode to use. defaults to IPTables.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"cpu": {
-						SchemaProps: spec.SchemaProps{
-							Description: "CPU is the number of CPUs for this machine type.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-						},
-					},
-					"gpu": {
-						SchemaProps: spec.SchemaProps{
-							Description: "GPU is the number of GPUs for this machine type.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-						},
-					},
-					"memory": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Memory is the amount of memory for this machine type.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-						},
-					},
 				},
-				Required: []string{"name", "cpu", "gpu", "memory"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Maintenance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_KubeSchedulerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Maintenance contains information about the time window for maintenance operations and which operations should be performed.",
+				Description: "KubeSchedulerConfig contains configuration settings for the kube-scheduler.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"autoUpdate": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AutoUpdate contains information about which constraints should be automatically updated.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceAutoUpdate"),
-						},
-					},
-					"timeWindow": {
+					"featureGates": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TimeWindow contains information about the time window for maintenance operations.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceTimeWindow"),
+							Description: "FeatureGates contains information about enabled feature gates.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"boolean"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceAutoUpdate", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceTimeWindow"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_MaintenanceAutoUpdate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_KubeletConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MaintenanceAutoUpdate contains information about which constraints should be automatically updated.",
+				Description: "KubeletConfig contains configuration settings for the kubelet.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kubernetesVersion": {
+					"featureGates": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KubernetesVersion indicates whether the patch Kubernetes version may be automatically updated.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "FeatureGates contains information about enabled feature gates.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"boolean"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"podPidsLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodPIDsLimit is the maximum number of process IDs per pod allowed by the kubelet.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
-				Required: []string{"kubernetesVersion"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_MaintenanceTimeWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_Kubernetes(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MaintenanceTimeWindow contains information about the time window for maintenance operations.",
+				Description: "Kubernetes contains the version and configuration variables for the Shoot control plane.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"begin": {
+					"allowPrivilegedContainers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Begin is the beginning of the time window in the format HHMMSS+ZONE, e.g. \"220000+0100\". If not present, a random value will be computed.",
-							Type:        []string{"string"},
+							Description: "AllowPrivilegedContainers indicates whether privileged containers are allowed in the Shoot (default: true).",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"end": {
+					"kubeAPIServer": {
 						SchemaProps: spec.SchemaProps{
-							Description: "End is the end of the time window in the format HHMMSS+ZONE, e.g. \"220000+0100\". If not present, the value will be computed based on the \"Begin\" value.",
+							Description: "KubeAPIServer contains configuration settings for the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeAPIServerConfig"),
+						},
+					},
+					"cloudControllerManager": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CloudControllerManager contains configuration settings for the cloud-controller-manager.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudControllerManagerConfig"),
+						},
+					},
+					"kubeControllerManager": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeControllerManager contains configuration settings for the kube-controller-manager.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeControllerManagerConfig"),
+						},
+					},
+					"kubeScheduler": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeScheduler contains configuration settings for the kube-scheduler.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeSchedulerConfig"),
+						},
+					},
+					"kubeProxy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeProxy contains configuration settings for the kube-proxy.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeProxyConfig"),
+						},
+					},
+					"kubelet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubelet contains configuration settings for the kubelet.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig"),
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the semantic Kubernetes version to use for the Shoot cluster.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"begin", "end"},
+				Required: []string{"version"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudControllerManagerConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeAPIServerConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeControllerManagerConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeProxyConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeSchedulerConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Monocular(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_KubernetesConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Monocular describes configuration values for the monocular addon.",
+				Description: "KubernetesConfig contains common configuration fields for the control plane components.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"featureGates": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled indicates whether the addon is enabled or not.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "FeatureGates contains information about enabled feature gates.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"boolean"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"enabled"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_NginxIngress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_KubernetesConstraints(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NginxIngress describes configuration values for the nginx-ingress addon.",
+				Description: "KubernetesConstraints contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Enabled indicates whether the addon is enabled or not.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"loadBalancerSourceRanges": {
+					"versions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LoadBalancerSourceRanges is list of whitelist IP sources for NginxIngress",
+							Description: "Versions is the list of allowed Kubernetes versions for Shoot clusters (e.g., 1.13.1).",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -4773,177 +5641,80 @@ func schema_pkg_apis_garden_v1beta1_NginxIngress(ref common.ReferenceCallback) c
 						},
 					},
 				},
-				Required: []string{"enabled"},
+				Required: []string{"versions"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_OIDCConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_KubernetesDashboard(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OIDCConfig contains configuration settings for the OIDC provider. Note: Descriptions were taken from the Kubernetes documentation.",
+				Description: "KubernetesDashboard describes configuration values for the kubernetes-dashboard addon.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"caBundle": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If set, the OpenID server's certificate will be verified by one of the authorities in the oidc-ca-file, otherwise the host's root CA set will be used.",
-							Type:        []string{"string"},
+							Description: "Enabled indicates whether the addon is enabled or not.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"clientID": {
+					"authenticationMode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The client ID for the OpenID Connect client, must be set if oidc-issuer-url is set.",
+							Description: "AuthenticationMode defines the authentication mode for the kubernetes-dashboard.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"groupsClaim": {
+				},
+				Required: []string{"enabled"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_Local(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Local contains the Shoot specification for local provider.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"networks": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If provided, the name of a custom OpenID Connect claim for specifying user groups. The claim value is expected to be a string or array of strings. This flag is experimental, please see the authentication documentation for further details.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Networks holds information about the Kubernetes and infrastructure networks.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalNetworks"),
 						},
 					},
-					"groupsPrefix": {
+					"machineImage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If provided, all groups will be prefixed with this value to prevent conflicts with other authentication strategies.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MachineImage holds information about the machine image to use for all workers. It will default to the first image stated in the referenced CloudProfile if no value has been provided.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalMachineImage"),
 						},
 					},
-					"issuerURL": {
+					"endpoint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The URL of the OpenID issuer, only HTTPS scheme will be accepted. If set, it will be used to verify the OIDC JSON Web Token (JWT).",
+							Description: "Endpoint of the local service.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"requiredClaims": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ATTENTION: Only meaningful for Kubernetes >= 1.11 key=value pairs that describes a required claim in the ID Token. If set, the claim is verified to be present in the ID Token with a matching value.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
-						},
-					},
-					"signingAlgs": {
-						SchemaProps: spec.SchemaProps{
-							Description: "List of allowed JOSE asymmetric signing algorithms. JWTs with a 'alg' header value not in this list will be rejected. Values are defined by RFC 7518 https://tools.ietf.org/html/rfc7518#section-3.1",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
-						},
-					},
-					"usernameClaim": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The OpenID claim to use as the user name. Note that claims other than the default ('sub') is not guaranteed to be unique and immutable. This flag is experimental, please see the authentication documentation for further details. (default \"sub\")",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"usernamePrefix": {
-						SchemaProps: spec.SchemaProps{
-							Description: "If provided, all usernames will be prefixed with this value. If not provided, username claims other than 'email' are prefixed by the issuer URL to avoid clashes. To skip any prefixing, provide the value '-'.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_OpenStackCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackCloud contains the Shoot specification for OpenStack.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"floatingPoolName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "FloatingPoolName is the name of the floating pool to get FIPs from.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"loadBalancerProvider": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LoadBalancerProvider is the name of the load balancer provider in the OpenStack environment.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"machineImage": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MachineImage holds information about the machine image to use for all workers. It will default to the first image stated in the referenced CloudProfile if no value has been provided.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineImage"),
-						},
-					},
-					"networks": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Networks holds information about the Kubernetes and infrastructure networks.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackNetworks"),
-						},
-					},
-					"workers": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Workers is a list of worker groups.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackWorker"),
-									},
-								},
-							},
-						},
-					},
-					"zones": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Zones is a list of availability zones to deploy the Shoot cluster to.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
-						},
-					},
 				},
-				Required: []string{"floatingPoolName", "loadBalancerProvider", "networks", "workers", "zones"},
+				Required: []string{"networks", "endpoint"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackWorker"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalMachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalNetworks"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_OpenStackConstraints(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_LocalConstraints(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackConstraints is an object containing constraints for certain values in the Shoot specification.",
+				Description: "LocalConstraints is an object containing constraints for certain values in the Shoot specification.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"dnsProviders": {
@@ -4959,38 +5730,6 @@ func schema_pkg_apis_garden_v1beta1_OpenStackConstraints(ref common.ReferenceCal
 							},
 						},
 					},
-					"floatingPools": {
-						SchemaProps: spec.SchemaProps{
-							Description: "FloatingPools contains constraints regarding allowed values of the 'floatingPoolName' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackFloatingPool"),
-									},
-								},
-							},
-						},
-					},
-					"kubernetes": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kubernetes contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints"),
-						},
-					},
-					"loadBalancerProviders": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LoadBalancerProviders contains constraints regarding allowed values of the 'loadBalancerProvider' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackLoadBalancerProvider"),
-									},
-								},
-							},
-						},
-					},
 					"machineImages": {
 						SchemaProps: spec.SchemaProps{
 							Description: "MachineImages contains constraints regarding allowed values for machine images in the Shoot specification.",
@@ -4998,57 +5737,31 @@ func schema_pkg_apis_garden_v1beta1_OpenStackConstraints(ref common.ReferenceCal
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineImage"),
-									},
-								},
-							},
-						},
-					},
-					"machineTypes": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MachineTypes contains constraints regarding allowed values for machine types in the 'workers' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineType"),
-									},
-								},
-							},
-						},
-					},
-					"zones": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Zones contains constraints regarding allowed values for 'zones' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalMachineImage"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"dnsProviders", "floatingPools", "kubernetes", "loadBalancerProviders", "machineImages", "machineTypes", "zones"},
+				Required: []string{"dnsProviders", "machineImages"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackFloatingPool", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackLoadBalancerProvider", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalMachineImage"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_OpenStackFloatingPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_LocalMachineImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "FloatingPools contains constraints regarding allowed values of the 'floatingPoolName' block in the Shoot specification.",
+				Description: "LocalMachineImage defines the machine image for Local Provider.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the floating pool.",
+							Description: "Name is the name of the image.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -5060,60 +5773,82 @@ func schema_pkg_apis_garden_v1beta1_OpenStackFloatingPool(ref common.ReferenceCa
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_OpenStackLoadBalancerProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_LocalNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LoadBalancerProviders contains constraints regarding allowed values of the 'loadBalancerProvider' block in the Shoot specification.",
+				Description: "LocalNetworks holds information about the Kubernetes and infrastructure networks.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"nodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the load balancer provider.",
+							Description: "Nodes is the CIDR of the node network.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pods is the CIDR of the pod network.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"services": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Services is the CIDR of the service network.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"workers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers is a CIDR of a worker subnet (private) to create (used for the VMs).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"name"},
+				Required: []string{"workers"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_OpenStackMachineImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_LocalProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackMachineImage defines the name of the machine image in the OpenStack environment.",
+				Description: "LocalProfile defines constraints and definitions for the local development.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the image.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"image": {
+					"constraints": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Image is the technical name of the image.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Constraints is an object containing constraints for certain values in the Shoot specification.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalConstraints"),
 						},
 					},
 				},
-				Required: []string{"name", "image"},
+				Required: []string{"constraints"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalConstraints"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_OpenStackMachineType(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_MachineType(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackMachineType contains certain properties of a machine type in OpenStack",
+				Description: "MachineType contains certain properties of a machine type.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
@@ -5148,21 +5883,15 @@ func schema_pkg_apis_garden_v1beta1_OpenStackMachineType(ref common.ReferenceCal
 							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
-					"volumeType": {
+					"architecture": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeType is the type of that volume.",
+							Description: "Architecture is the CPU architecture of this machine type (default: amd64).",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"volumeSize": {
-						SchemaProps: spec.SchemaProps{
-							Description: "VolumeSize is the amount of disk storage for this machine type.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-						},
-					},
 				},
-				Required: []string{"name", "cpu", "gpu", "memory", "volumeType", "volumeSize"},
+				Required: []string{"name", "cpu", "gpu", "memory"},
 			},
 		},
 		Dependencies: []string{
@@ -5170,86 +5899,106 @@ func schema_pkg_apis_garden_v1beta1_OpenStackMachineType(ref common.ReferenceCal
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_OpenStackNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_Maintenance(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackNetworks holds information about the Kubernetes and infrastructure networks.",
+				Description: "Maintenance contains information about the time window for maintenance operations and which operations should be performed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"nodes": {
+					"autoUpdate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Nodes is the CIDR of the node network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "AutoUpdate contains information about which constraints should be automatically updated.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceAutoUpdate"),
 						},
 					},
-					"pods": {
+					"timeWindow": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Pods is the CIDR of the pod network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "TimeWindow contains information about the time window for maintenance operations.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceTimeWindow"),
 						},
 					},
-					"services": {
+					"confineSpecUpdateRollout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Services is the CIDR of the service network.",
-							Type:        []string{"string"},
+							Description: "ConfineSpecUpdateRollout prevents that changes to the specification of the Shoot are rolled out outside of its maintenance time window. Changes of the hibernation state and the deletion are not confined.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"router": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Router indicates whether to use an existing router or create a new one.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackRouter"),
-						},
-					},
-					"workers": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceAutoUpdate", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceTimeWindow"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_MaintenanceAutoUpdate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceAutoUpdate contains information about which constraints should be automatically updated.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kubernetesVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Workers is a list of CIDRs of worker subnets (private) to create (used for the VMs).",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "KubernetesVersion indicates whether the patch Kubernetes version may be automatically updated.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"workers"},
+				Required: []string{"kubernetesVersion"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackRouter"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_OpenStackProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_MaintenanceTimeWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackProfile defines certain constraints and definitions for the OpenStack cloud.",
+				Description: "MaintenanceTimeWindow contains information about the time window for maintenance operations.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"constraints": {
+					"begin": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Constraints is an object containing constraints for certain values in the Shoot specification.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackConstraints"),
+							Description: "Begin is the beginning of the time window in the format HHMMSS+ZONE, e.g. \"220000+0100\". If not present, a random value will be computed.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"keystoneURL": {
+					"end": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KeyStoneURL is the URL for auth{n,z} in OpenStack (pointing to KeyStone).",
+							Description: "End is the end of the time window in the format HHMMSS+ZONE, e.g. \"220000+0100\". If not present, the value will be computed based on the \"Begin\" value.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"dnsServers": {
+				},
+				Required: []string{"begin", "end"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_Monitoring(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Monitoring contains information about the monitoring stack of the Shoot which is deployed in the Seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DNSServers is a list of IPs of DNS servers used while creating subnets.",
+							Description: "Enabled indicates whether the monitoring stack (Prometheus, Alertmanager, Grafana and kube-state-metrics) is deployed for the Shoot. Defaults to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"scrapeTargets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScrapeTargets is an allowlist of the scrape jobs of the Shoot's Prometheus. If it is empty, all jobs are scraped.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -5261,100 +6010,113 @@ func schema_pkg_apis_garden_v1beta1_OpenStackProfile(ref common.ReferenceCallbac
 							},
 						},
 					},
-					"dhcpDomain": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DHCPDomain is the dhcp domain of the OpenStack system configured in nova.conf. Only meaningful for Kubernetes 1.10.1+. See https://github.com/kubernetes/kubernetes/pull/61890 for details.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"requestTimeout": {
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_Monocular(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Monocular describes configuration values for the monocular addon.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RequestTimeout specifies the HTTP timeout against the OpenStack API.",
-							Type:        []string{"string"},
+							Description: "Enabled indicates whether the addon is enabled or not.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"constraints", "keystoneURL"},
+				Required: []string{"enabled"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackConstraints"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_OpenStackRouter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_NginxIngress(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackRouter indicates whether to use an existing router or create a new one.",
+				Description: "NginxIngress describes configuration values for the nginx-ingress addon.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID is the router id of an existing OpenStack router.",
-							Type:        []string{"string"},
+							Description: "Enabled indicates whether the addon is enabled or not.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
+					"loadBalancerSourceRanges": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LoadBalancerSourceRanges is list of whitelist IP sources for NginxIngress",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"id"},
+				Required: []string{"enabled"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_OpenStackWorker(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_OIDCConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackWorker is the definition of a worker group.",
+				Description: "OIDCConfig contains configuration settings for the OIDC provider. Note: Descriptions were taken from the Kubernetes documentation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"caBundle": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the worker group.",
+							Description: "If set, the OpenID server's certificate will be verified by one of the authorities in the oidc-ca-file, otherwise the host's root CA set will be used.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"machineType": {
+					"clientID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineType is the machine type of the worker group.",
+							Description: "The client ID for the OpenID Connect client, must be set if oidc-issuer-url is set.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"autoScalerMin": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AutoScalerMin is the minimum number of VMs to create.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"autoScalerMax": {
+					"groupsClaim": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutoScalerMin is the maximum number of VMs to create.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "If provided, the name of a custom OpenID Connect claim for specifying user groups. The claim value is expected to be a string or array of strings. This flag is experimental, please see the authentication documentation for further details.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"maxSurge": {
+					"groupsPrefix": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxSurge is maximum number of VMs that are created during an update.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							Description: "If provided, all groups will be prefixed with this value to prevent conflicts with other authentication strategies.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"maxUnavailable": {
+					"issuerURL": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							Description: "The URL of the OpenID issuer, only HTTPS scheme will be accepted. If set, it will be used to verify the OIDC JSON Web Token (JWT).",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"annotations": {
+					"requiredClaims": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
+							Description: "ATTENTION: Only meaningful for Kubernetes >= 1.11 key=value pairs that describes a required claim in the ID Token. If set, the claim is verified to be present in the ID Token with a matching value.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -5367,12 +6129,11 @@ func schema_pkg_apis_garden_v1beta1_OpenStackWorker(ref common.ReferenceCallback
 							},
 						},
 					},
-					"labels": {
+					"signingAlgs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "List of allowed JOSE asymmetric signing algorithms. JWTs with a 'alg' header value not in this list will be rejected. Values are defined by RFC 7518 https://tools.ietf.org/html/rfc7518#section-3.1",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Type:   []string{"string"},
@@ -5382,55 +6143,74 @@ func schema_pkg_apis_garden_v1beta1_OpenStackWorker(ref common.ReferenceCallback
 							},
 						},
 					},
-					"taints": {
+					"usernameClaim": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.Taint"),
-									},
-								},
-							},
+							Description: "The OpenID claim to use as the user name. Note that claims other than the default ('sub') is not guaranteed to be unique and immutable. This flag is experimental, please see the authentication documentation for further details. (default \"sub\")",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"usernamePrefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If provided, all usernames will be prefixed with this value. If not provided, username claims other than 'email' are prefixed by the issuer URL to avoid clashes. To skip any prefixing, provide the value '-'.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_PacketCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_OpenStackCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PacketCloud contains the Shoot specification for Packet cloud",
+				Description: "OpenStackCloud contains the Shoot specification for OpenStack.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"machineImage": {
+					"floatingPoolName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineImage holds information about the machine image to use for all workers. It will default to the first image stated in the referenced CloudProfile if no value has been provided.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketMachineImage"),
+							Description: "FloatingPoolName is the name of the floating pool to get FIPs from.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"networks": {
+					"loadBalancerProvider": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Networks holds information about the Kubernetes and infrastructure networks.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketNetworks"),
+							Description: "LoadBalancerProvider is the name of the load balancer provider in the OpenStack environment.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"workers": {
+					"floatingPoolSubnetName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Workers is a list of worker groups.",
+							Description: "FloatingPoolSubnetName is the name of a subnet of the floating pool network. If set, FIPs for the router and for services of type LoadBalancer are allocated from this subnet only.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"machineImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineImage holds information about the machine image to use for all workers. It will default to the first image stated in the referenced CloudProfile if no value has been provided.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineImage"),
+						},
+					},
+					"networks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Networks holds information about the Kubernetes and infrastructure networks.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackNetworks"),
+						},
+					},
+					"workers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers is a list of worker groups.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketWorker"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackWorker"),
 									},
 								},
 							},
@@ -5438,7 +6218,7 @@ func schema_pkg_apis_garden_v1beta1_PacketCloud(ref common.ReferenceCallback) co
 					},
 					"zones": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Zones is a list of availability zones to deploy the Shoot cluster to, currently, only one is supported.",
+							Description: "Zones is a list of availability zones to deploy the Shoot cluster to.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -5451,19 +6231,19 @@ func schema_pkg_apis_garden_v1beta1_PacketCloud(ref common.ReferenceCallback) co
 						},
 					},
 				},
-				Required: []string{"networks", "workers", "zones"},
+				Required: []string{"floatingPoolName", "loadBalancerProvider", "networks", "workers", "zones"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketMachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketWorker"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackWorker"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_PacketConstraints(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_OpenStackConstraints(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PacketConstraints is an object containing constraints for certain values in the Shoot specification",
+				Description: "OpenStackConstraints is an object containing constraints for certain values in the Shoot specification.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"dnsProviders": {
@@ -5479,12 +6259,38 @@ func schema_pkg_apis_garden_v1beta1_PacketConstraints(ref common.ReferenceCallba
 							},
 						},
 					},
+					"floatingPools": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FloatingPools contains constraints regarding allowed values of the 'floatingPoolName' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackFloatingPool"),
+									},
+								},
+							},
+						},
+					},
 					"kubernetes": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Kubernetes contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.",
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints"),
 						},
 					},
+					"loadBalancerProviders": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LoadBalancerProviders contains constraints regarding allowed values of the 'loadBalancerProvider' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackLoadBalancerProvider"),
+									},
+								},
+							},
+						},
+					},
 					"machineImages": {
 						SchemaProps: spec.SchemaProps{
 							Description: "MachineImages contains constraints regarding allowed values for machine images in the Shoot specification.",
@@ -5492,7 +6298,7 @@ func schema_pkg_apis_garden_v1beta1_PacketConstraints(ref common.ReferenceCallba
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketMachineImage"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineImage"),
 									},
 								},
 							},
@@ -5505,40 +6311,635 @@ func schema_pkg_apis_garden_v1beta1_PacketConstraints(ref common.ReferenceCallba
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineType"),
 									},
 								},
 							},
 						},
 					},
-					"volumeTypes": {
+					"zones": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeTypes contains constraints regarding allowed values for volume types in the 'workers' block in the Shoot specification.",
+							Description: "Zones contains constraints regarding allowed values for 'zones' block in the Shoot specification.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"),
 									},
 								},
 							},
 						},
 					},
-					"zones": {
+				},
+				Required: []string{"dnsProviders", "floatingPools", "kubernetes", "loadBalancerProviders", "machineImages", "machineTypes", "zones"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackFloatingPool", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackLoadBalancerProvider", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_OpenStackFloatingPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FloatingPools contains constraints regarding allowed values of the 'floatingPoolName' block in the Shoot specification.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Zones contains constraints regarding allowed values for 'zones' block in the Shoot specification.",
+							Description: "Name is the name of the floating pool.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_OpenStackLoadBalancerProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LoadBalancerProviders contains constraints regarding allowed values of the 'loadBalancerProvider' block in the Shoot specification.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the load balancer provider.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_OpenStackMachineImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackMachineImage defines the name of the machine image in the OpenStack environment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the image.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image is the technical name of the image.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "image"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_OpenStackMachineType(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackMachineType contains certain properties of a machine type in OpenStack",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the machine type.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"usable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Usable defines if the machine type can be used for shoot clusters.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPU is the number of CPUs for this machine type.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"gpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GPU is the number of GPUs for this machine type.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory is the amount of memory for this machine type.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"architecture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Architecture is the CPU architecture of this machine type (default: amd64).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeType is the type of that volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeSize is the amount of disk storage for this machine type.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+				Required: []string{"name", "cpu", "gpu", "memory", "volumeType", "volumeSize"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_OpenStackNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackNetworks holds information about the Kubernetes and infrastructure networks.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes is the CIDR of the node network.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pods is the CIDR of the pod network.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"services": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Services is the CIDR of the service network.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"router": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Router indicates whether to use an existing router or create a new one.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackRouter"),
+						},
+					},
+					"workers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers is a list of CIDRs of worker subnets (private) to create (used for the VMs).",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"dnsProviders", "kubernetes", "machineImages", "machineTypes", "volumeTypes", "zones"},
+				Required: []string{"workers"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackRouter"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_OpenStackProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackProfile defines certain constraints and definitions for the OpenStack cloud.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"constraints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Constraints is an object containing constraints for certain values in the Shoot specification.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackConstraints"),
+						},
+					},
+					"keystoneURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyStoneURL is the URL for auth{n,z} in OpenStack (pointing to KeyStone).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dnsServers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSServers is a list of IPs of DNS servers used while creating subnets.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"dhcpDomain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DHCPDomain is the dhcp domain of the OpenStack system configured in nova.conf. Only meaningful for Kubernetes 1.10.1+. See https://github.com/kubernetes/kubernetes/pull/61890 for details.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"requestTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequestTimeout specifies the HTTP timeout against the OpenStack API.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"networkMTU": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkMTU is the MTU of the networks in the OpenStack system. It is propagated into the CNI and VPN configuration of Shoots; if not set, 1500 is assumed.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"constraints", "keystoneURL"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackConstraints"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_OpenStackRouter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackRouter indicates whether to use an existing router or create a new one.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the router id of an existing OpenStack router.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"id"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_OpenStackWorker(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackWorker is the definition of a worker group.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the worker group.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"machineType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineType is the machine type of the worker group.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"autoScalerMin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoScalerMin is the minimum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"autoScalerMax": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoScalerMin is the maximum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxSurge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSurge is maximum number of VMs that are created during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"instanceTagLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstanceTagLabels is a list of keys of the `Labels` of this worker pool whose key/value pairs are additionally added as tags (AWS, Alicloud) or labels (GCP) to the cloud provider instances of this worker pool, e.g. to allow cost and inventory tools to group the machines by their Kubernetes labels.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"taints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Taint"),
+									},
+								},
+							},
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones is a subset of the Shoot's zones in which the machines of this worker pool are created. If it is empty then the worker pool spans all zones of the Shoot.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"osUpdates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OSUpdates contains configuration for in-place updates of the operating system of this worker pool. If it is set then the nodes receive OS patches in-place instead of being replaced.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerOSUpdates"),
+						},
+					},
+					"customMachineImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CustomMachineImage is the provider-specific id of a machine image which is not listed in the CloudProfile, e.g. an AMI on AWS or an URN ('<publisher>:<offer>:<sku>:<version>') on Azure. The image must run the same operating system as the machine image of the Shoot. It is only allowed if the CustomMachineImages feature gate of the Gardener API server is enabled and the CloudProfile allows custom machine images for the project of the Shoot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxPods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxPods is the maximum number of pods which can run on a node of this worker pool (default: 110). It must not exceed the number of addresses of the pod CIDR of a node.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"dataVolumes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataVolumes is a list of additional volumes which are attached to the machines of this worker pool, e.g. as local scratch disks. They are only supported for AWS and GCP.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DataVolume"),
+									},
+								},
+							},
+						},
+					},
+					"kubeletDataVolumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeletDataVolumeName is the name of a data volume which is formatted and mounted at the root directory of the kubelet ('/var/lib/kubelet'), i.e., the pod volumes and container logs are stored on it instead of the root volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kubelet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubelet contains configuration for the image garbage collection and the container log rotation of the kubelets of this worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerKubeletConfig"),
+						},
+					},
+					"architecture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Architecture is the CPU architecture of the machines of this worker pool (default: amd64). It must match the architecture of the machine type.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"capacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capacity contains configuration for cost-optimized worker pools which mix on-demand and spot (preemptible) machines and which may fall back to other machine types. It is only supported for GCP and Alicloud.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerCapacity"),
+						},
+					},
+				},
+				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DataVolume", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerCapacity", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerKubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerOSUpdates", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_PacketCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PacketCloud contains the Shoot specification for Packet cloud",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"machineImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineImage holds information about the machine image to use for all workers. It will default to the first image stated in the referenced CloudProfile if no value has been provided.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketMachineImage"),
+						},
+					},
+					"networks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Networks holds information about the Kubernetes and infrastructure networks.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketNetworks"),
+						},
+					},
+					"workers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers is a list of worker groups.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketWorker"),
+									},
+								},
+							},
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones is a list of availability zones to deploy the Shoot cluster to, currently, only one is supported.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"networks", "workers", "zones"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketMachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketWorker"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_PacketConstraints(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PacketConstraints is an object containing constraints for certain values in the Shoot specification",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dnsProviders": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSProviders contains constraints regarding allowed values of the 'dns.provider' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint"),
+									},
+								},
+							},
+						},
+					},
+					"kubernetes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubernetes contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints"),
+						},
+					},
+					"machineImages": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineImages contains constraints regarding allowed values for machine images in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketMachineImage"),
+									},
+								},
+							},
+						},
+					},
+					"machineTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineTypes contains constraints regarding allowed values for machine types in the 'workers' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType"),
+									},
+								},
+							},
+						},
+					},
+					"volumeTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeTypes contains constraints regarding allowed values for volume types in the 'workers' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType"),
+									},
+								},
+							},
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones contains constraints regarding allowed values for 'zones' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"dnsProviders", "kubernetes", "machineImages", "machineTypes", "volumeTypes", "zones"},
 			},
 		},
 		Dependencies: []string{
@@ -5546,39 +6947,1186 @@ func schema_pkg_apis_garden_v1beta1_PacketConstraints(ref common.ReferenceCallba
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_PacketMachineImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_PacketMachineImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PacketMachineImage defines the machine image for Packet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the image.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the ID of the image.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "id"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_PacketNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PacketNetworks holds information about the Kubernetes and infrastructure networks.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes is the CIDR of the node network.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pods is the CIDR of the pod network.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"services": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Services is the CIDR of the service network.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_PacketProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PacketProfile defines constraints and definitions in Packet Cloud environment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"constraints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Constraints is an object containing constraints for certain values in the Shoot specification.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketConstraints"),
+						},
+					},
+				},
+				Required: []string{"constraints"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketConstraints"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_PacketWorker(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PacketWorker is the definition of a worker group.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the worker group.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"machineType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineType is the machine type of the worker group.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"autoScalerMin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoScalerMin is the minimum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"autoScalerMax": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoScalerMin is the maximum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxSurge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSurge is maximum number of VMs that are created during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"instanceTagLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstanceTagLabels is a list of keys of the `Labels` of this worker pool whose key/value pairs are additionally added as tags (AWS, Alicloud) or labels (GCP) to the cloud provider instances of this worker pool, e.g. to allow cost and inventory tools to group the machines by their Kubernetes labels.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"taints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Taint"),
+									},
+								},
+							},
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones is a subset of the Shoot's zones in which the machines of this worker pool are created. If it is empty then the worker pool spans all zones of the Shoot.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"osUpdates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OSUpdates contains configuration for in-place updates of the operating system of this worker pool. If it is set then the nodes receive OS patches in-place instead of being replaced.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerOSUpdates"),
+						},
+					},
+					"customMachineImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CustomMachineImage is the provider-specific id of a machine image which is not listed in the CloudProfile, e.g. an AMI on AWS or an URN ('<publisher>:<offer>:<sku>:<version>') on Azure. The image must run the same operating system as the machine image of the Shoot. It is only allowed if the CustomMachineImages feature gate of the Gardener API server is enabled and the CloudProfile allows custom machine images for the project of the Shoot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxPods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxPods is the maximum number of pods which can run on a node of this worker pool (default: 110). It must not exceed the number of addresses of the pod CIDR of a node.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"dataVolumes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataVolumes is a list of additional volumes which are attached to the machines of this worker pool, e.g. as local scratch disks. They are only supported for AWS and GCP.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DataVolume"),
+									},
+								},
+							},
+						},
+					},
+					"kubeletDataVolumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeletDataVolumeName is the name of a data volume which is formatted and mounted at the root directory of the kubelet ('/var/lib/kubelet'), i.e., the pod volumes and container logs are stored on it instead of the root volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kubelet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubelet contains configuration for the image garbage collection and the container log rotation of the kubelets of this worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerKubeletConfig"),
+						},
+					},
+					"architecture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Architecture is the CPU architecture of the machines of this worker pool (default: amd64). It must match the architecture of the machine type.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"capacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capacity contains configuration for cost-optimized worker pools which mix on-demand and spot (preemptible) machines and which may fall back to other machine types. It is only supported for GCP and Alicloud.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerCapacity"),
+						},
+					},
+					"volumeType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeType is the type of the root volumes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeSize is the size of the root volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax", "volumeType", "volumeSize"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DataVolume", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerCapacity", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerKubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerOSUpdates", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_Project(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Project holds certain properties about a Gardener project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the project properties.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Most recently observed status of the Project.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectSpec", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_ProjectCloudProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectCloudProfile is a cloud profile which may be used by the Shoots of a project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the cloud profile.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"regions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Regions is a list of regions of the cloud profile which may be used by the Shoots. All regions are allowed if it is empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_ProjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectList is a collection of Projects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of Projects.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Project"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Project", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_ProjectRestrictions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectRestrictions restricts the cloud profiles, regions and cloud provider secrets which may be used by the Shoots of a project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cloudProfiles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CloudProfiles is a list of cloud profiles which may be used by the Shoots. All cloud profiles are allowed if it is empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectCloudProfile"),
+									},
+								},
+							},
+						},
+					},
+					"secrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secrets is a list of references to cloud provider secrets which may be referenced by the secret bindings in the project namespace. All secrets are allowed if it is empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.SecretReference"),
+									},
+								},
+							},
+						},
+					},
+					"defaultSecretBinding": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultSecretBinding is the name of the secret binding which is used by Shoots that do not reference one.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectCloudProfile", "k8s.io/api/core/v1.SecretReference"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_ProjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectSpec is the specification of a Project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"createdBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreatedBy is a subject representing a user name, an email address, or any other identifier of a user who created the project.",
+							Ref:         ref("k8s.io/api/rbac/v1.Subject"),
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is a human-readable description of what the project is used for.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"owner": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Owner is a subject representing a user name, an email address, or any other identifier of a user owning the project.",
+							Ref:         ref("k8s.io/api/rbac/v1.Subject"),
+						},
+					},
+					"purpose": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Purpose is a human-readable explanation of the project's purpose.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"members": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Members is a list of subjects representing a user name, an email address, or any other identifier of a user that should be part of this project.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/rbac/v1.Subject"),
+									},
+								},
+							},
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the name of the namespace that has been created for the Project object. A nil value means that Gardener will determine the name of the namespace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"restrictions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Restrictions restricts the cloud profiles, regions and cloud provider secrets which may be used by the Shoots of the project.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectRestrictions"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectRestrictions", "k8s.io/api/rbac/v1.Subject"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_ProjectStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectStatus holds the most recently observed status of the project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this project.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current phase of the project.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_Quota(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Quota holds certain information about resource usage limitations and lifetime for Shoot objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the Quota constraints.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_QuotaList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuotaList is a collection of Quotas.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of Quotas.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Quota"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Quota", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_QuotaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuotaSpec is the specification of a Quota.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"clusterLifetimeDays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterLifetimeDays is the lifetime of a Shoot cluster in days before it will be terminated automatically.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"metrics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metrics is a list of resources which will be put under constraints.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"scope": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scope is the scope of the Quota object, either 'project' or 'secret'.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"metrics", "scope"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_SecretBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretBinding holds a reference to a secret with cloud provider credentials and the quotas which apply to its usage.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef is a reference to a secret object in the same or another namespace. It must not be set if the credentials are read from Vault.",
+							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+						},
+					},
+					"vaultRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VaultRef is a reference to a path in Vault from which the cloud provider credentials are read whenever a Shoot is reconciled.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBindingVaultReference"),
+						},
+					},
+					"quotas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Quotas is a list of references to Quota objects in the same or another namespace.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.ObjectReference"),
+									},
+								},
+							},
+						},
+					},
+					"rotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rotation describes a rotation of the cloud provider credentials to another secret. While the rotation is in progress, the Shoots switch to the new secret whereas the previous one is kept until all of them use it.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBindingRotation"),
+						},
+					},
+				},
+				Required: []string{"secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBindingRotation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBindingVaultReference", "k8s.io/api/core/v1.ObjectReference", "k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_SecretBindingList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretBindingList is a collection of SecretBindings.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of SecretBindings.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBinding"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBinding", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_SecretBindingRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretBindingRotation describes a rotation of the cloud provider credentials of a SecretBinding.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef is a reference to the secret containing the new credentials.",
+							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the progress of the rotation. It is maintained by the Gardener.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBindingRotationStatus"),
+						},
+					},
+				},
+				Required: []string{"secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBindingRotationStatus", "k8s.io/api/core/v1.SecretReference"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_SecretBindingRotationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretBindingRotationStatus contains the progress of a credentials rotation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current phase of the rotation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the last time the status has been updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is a human-readable message about the progress of the rotation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rotatedShoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RotatedShoots is the list of Shoots (in the namespace of the SecretBinding) which have been verified to work with the new credentials.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"previousSecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreviousSecretRef is a reference to the secret with the previous credentials after the rotation has been completed. It is no longer used by the Gardener.",
+							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+						},
+					},
+				},
+				Required: []string{"phase", "lastUpdateTime", "description"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_SecretBindingVaultReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretBindingVaultReference is a reference to cloud provider credentials stored in Vault.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the path of the credentials relative to the Vault path of the SecretBinding's namespace, e.g. 'aws/creds/deployer' for dynamic AWS credentials.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"keyMapping": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyMapping maps keys of the cloud provider secret to keys of the data returned by Vault. Keys which are not mapped are taken over unchanged.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"path"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_Seed(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Seed holds certain properties about a Seed cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the Seed cluster properties.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Most recently observed status of the Seed cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedStatus"),
+						},
+					},
+				},
+			},
+			VendorExtensible: spec.VendorExtensible{
+				Extensions: spec.Extensions{
+					"x-kubernetes-print-columns": "custom-columns=NAME:.metadata.name,DOMAIN:.spec.ingressDomain,CLOUDPROFILE:.spec.cloud.profile,REGION:.spec.cloud.profile,READY:.status.conditions[?(@.type == 'Available')].status",
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSpec", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_SeedBackup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedBackup holds the configuration of the backup infrastructure of the Shoot clusters hosted by a Seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region is the region in which the backup buckets are created, e.g. if backups must stay in a certain country. It must be a region of the cloud profile of the Seed unless another Provider is configured. Defaults to the region of the Seed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider is the cloud provider of the backup buckets if they shall be created with another cloud provider than the one of the Seed, e.g. to store the backups of the Shoots hosted by a GCP Seed in AWS S3. It requires a SecretRef and a Region. Defaults to the cloud provider of the Seed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef is a reference to a Secret containing the cloud provider credentials for the backup buckets. Defaults to the secret of the Seed.",
+							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretReference"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_SeedCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedCloud defines the cloud profile and the region this Seed cluster belongs to.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"profile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Profile is the name of a cloud profile.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region is a name of a region.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"profile", "region"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_SeedDashboardOIDC(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedDashboardOIDC configures the OpenID Connect proxy protecting the dashboards of the Shoots in a seed cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"issuerURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IssuerURL is the URL of the OpenID Connect provider. It must use the https scheme.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clientID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClientID is the ID of the OpenID Connect client. The client must allow redirects to \"https://*.<ingressDomain>/oauth2/callback\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef is a reference to a Secret object containing the secret of the OpenID Connect client in the key \"clientSecret\".",
+							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+						},
+					},
+				},
+				Required: []string{"issuerURL", "clientID", "secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretReference"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_SeedIngressACME(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedIngressACME configures the issuance of the ingress certificates via the ACME protocol.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"clusterIssuer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterIssuer is the name of the cert-manager ClusterIssuer in the Seed cluster which issues the certificates.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"clusterIssuer"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_SeedIngressTLS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedIngressTLS configures the TLS certificates of the ingresses in the Shoot namespaces of a Seed cluster. Exactly one of its fields must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef is a reference to a Secret object containing a wildcard certificate for \"*.<ingressDomain>\" in the keys \"tls.crt\" and \"tls.key\". The ingress hosts are flattened so that they are covered by the certificate.",
+							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+						},
+					},
+					"acme": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ACME configures the issuance of a certificate for every ingress via the ACME protocol. It requires that cert-manager is running in the Seed cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedIngressACME"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedIngressACME", "k8s.io/api/core/v1.SecretReference"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_SeedList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PacketMachineImage defines the machine image for Packet.",
+				Description: "SeedList is a collection of Seeds.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the image.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"id": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID is the ID of the image.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of Seeds.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Seed"),
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"name", "id"},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Seed", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_PacketNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_SeedNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PacketNetworks holds information about the Kubernetes and infrastructure networks.",
+				Description: "SeedNetworks contains CIDRs for the pod, service and node networks of a Kubernetes cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"nodes": {
@@ -5603,571 +8151,532 @@ func schema_pkg_apis_garden_v1beta1_PacketNetworks(ref common.ReferenceCallback)
 						},
 					},
 				},
+				Required: []string{"nodes", "pods", "services"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_PacketProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "PacketProfile defines constraints and definitions in Packet Cloud environment.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"constraints": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Constraints is an object containing constraints for certain values in the Shoot specification.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketConstraints"),
-						},
-					},
-				},
-				Required: []string{"constraints"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketConstraints"},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_PacketWorker(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_SeedOrphan(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PacketWorker is the definition of a worker group.",
+				Description: "SeedOrphan is a resource in the Seed cluster which does not belong to any Shoot anymore, e.g. because its deletion has failed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the worker group.",
+							Description: "Kind is the kind of the orphaned resource (Namespace, Secret, or DNSEntry).",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"machineType": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineType is the machine type of the worker group.",
+							Description: "Namespace is the namespace of the orphaned resource. It is empty for Namespaces.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"autoScalerMin": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AutoScalerMin is the minimum number of VMs to create.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"autoScalerMax": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AutoScalerMin is the maximum number of VMs to create.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"maxSurge": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MaxSurge is maximum number of VMs that are created during an update.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
-						},
-					},
-					"maxUnavailable": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							Description: "Name is the name of the orphaned resource.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"annotations": {
+					"detectionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "DetectionTime is the time when the resource has been detected as orphaned for the first time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"labels": {
+				},
+				Required: []string{"kind", "name", "detectionTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_SeedProviderHealth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedProviderHealth reports the health of the cloud provider account of a Seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastCheckTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "LastCheckTime is the last time the cloud provider account has been checked.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"taints": {
+					"quotas": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
+							Description: "Quotas is the list of quotas of the cloud provider account in the region of the Seed.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.Taint"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedProviderQuota"),
 									},
 								},
 							},
 						},
 					},
-					"volumeType": {
-						SchemaProps: spec.SchemaProps{
-							Description: "VolumeType is the type of the root volumes.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"volumeSize": {
-						SchemaProps: spec.SchemaProps{
-							Description: "VolumeSize is the size of the root volume.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 				},
-				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax", "volumeType", "volumeSize"},
+				Required: []string{"lastCheckTime"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedProviderQuota", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Project(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_SeedProviderQuota(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Project holds certain properties about a Gardener project.",
+				Description: "SeedProviderQuota is a quota of the cloud provider account of a Seed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Description: "Name is the name of the quota (e.g., instances, elastic-ips, or vpcs).",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"limit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Limit is the maximal number of resources allowed by the quota.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"spec": {
+					"used": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the project properties.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectSpec"),
+							Description: "Used is the number of resources currently counting against the quota.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"status": {
+				},
+				Required: []string{"name", "limit", "used"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_SeedSettingDashboardAuthentication(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedSettingDashboardAuthentication controls how the access to the monitoring and logging dashboards of the Shoots in a seed cluster is protected.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"oidc": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Most recently observed status of the Project.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectStatus"),
+							Description: "OIDC configures an OpenID Connect proxy in front of the dashboards. Only users which are owner or members of the Shoot's project are granted access.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedDashboardOIDC"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectSpec", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedDashboardOIDC"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_ProjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_SeedSettingExcessCapacityReservation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProjectList is a collection of Projects.",
+				Description: "SeedSettingExcessCapacityReservation controls the excess capacity reservation for shoot control planes in a seed cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
+							Description: "Enabled controls whether the excess capacity reservation is deployed into the seed cluster.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"replicas": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Replicas is the number of low-priority placeholder pods reserving capacity in the seed cluster. If not set, the number is computed from the number of Shoots hosted by the seed cluster.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"metadata": {
+					"resources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard list object metadata.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Resources are the resources (requests and limits) reserved by every placeholder pod. If not set, 500m CPU and 1200Mi memory are reserved per pod. Resources which are not given keep their default.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
 						},
 					},
-					"items": {
+				},
+				Required: []string{"enabled"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_SeedSettingLoadBalancerServices(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedSettingLoadBalancerServices controls the services of type LoadBalancer created in a seed cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of Projects.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Annotations are added to the services of type LoadBalancer, e.g. the kube-apiserver services of the Shoots. They take precedence over the annotations set by Gardener for the respective cloud provider.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Project"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Project", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_ProjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_SeedSettingNetworkPolicies(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedSettingNetworkPolicies controls the network policies deployed into the Shoot namespaces of a seed cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled controls whether a default-deny network policy plus the policies explicitly allowing the required traffic between the control plane components are deployed into the Shoot namespaces. Defaults to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"enabled"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_SeedSettingScheduling(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProjectSpec is the specification of a Project.",
+				Description: "SeedSettingScheduling controls whether a seed cluster is considered by the scheduling of new Shoots.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"createdBy": {
-						SchemaProps: spec.SchemaProps{
-							Description: "CreatedBy is a subject representing a user name, an email address, or any other identifier of a user who created the project.",
-							Ref:         ref("k8s.io/api/rbac/v1.Subject"),
-						},
-					},
-					"description": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Description is a human-readable description of what the project is used for.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"owner": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Owner is a subject representing a user name, an email address, or any other identifier of a user owning the project.",
-							Ref:         ref("k8s.io/api/rbac/v1.Subject"),
-						},
-					},
-					"purpose": {
+					"visible": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Purpose is a human-readable explanation of the project's purpose.",
-							Type:        []string{"string"},
+							Description: "Visible controls whether the seed cluster is selectable for the seedmanager admission plugin.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"members": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Members is a list of subjects representing a user name, an email address, or any other identifier of a user that should be part of this project.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/rbac/v1.Subject"),
-									},
-								},
-							},
-						},
-					},
-					"namespace": {
+				},
+				Required: []string{"visible"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_SeedSettingShootDNS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedSettingShootDNS controls whether the DNS records of the Shoots in a seed cluster are managed by Gardener.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the name of the namespace that has been created for the Project object. A nil value means that Gardener will determine the name of the namespace.",
-							Type:        []string{"string"},
+							Description: "Enabled controls whether the DNS records of the Shoots are managed. If disabled, only Shoots with an unmanaged DNS provider can be scheduled onto the seed cluster, and they are reached via the load balancer of their kube-apiserver. Shoots which already have DNS records keep them. Defaults to true.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"enabled"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/api/rbac/v1.Subject"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_ProjectStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_SeedSettingVerticalPodAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProjectStatus holds the most recently observed status of the project.",
+				Description: "SeedSettingVerticalPodAutoscaler controls whether the vertical pod autoscaler is deployed into a seed cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"observedGeneration": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this project.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"phase": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current phase of the project.",
-							Type:        []string{"string"},
+							Description: "Enabled controls whether the vertical pod autoscaler is deployed into the seed cluster and whether VerticalPodAutoscaler resources are created for the Shoot control plane components.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"enabled"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Quota(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_SeedSettings(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "SeedSettings contains certain settings for this seed cluster.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"networkPolicies": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "NetworkPolicies controls the network policies deployed into the Shoot namespaces of this seed cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSettingNetworkPolicies"),
 						},
 					},
-					"apiVersion": {
+					"dashboardAuthentication": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "DashboardAuthentication controls how the access to the monitoring and logging dashboards of the Shoots is protected. If not set, generated basic authentication credentials are used.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSettingDashboardAuthentication"),
 						},
 					},
-					"metadata": {
+					"excessCapacityReservation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "ExcessCapacityReservation controls the excess capacity reservation for shoot control planes in this seed cluster. If not set, the configuration of the Gardener controller manager is used.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSettingExcessCapacityReservation"),
 						},
 					},
-					"spec": {
+					"shootDNS": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the Quota constraints.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec"),
+							Description: "ShootDNS controls whether the DNS records of the Shoots in this seed cluster are managed by Gardener.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSettingShootDNS"),
+						},
+					},
+					"scheduling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scheduling controls whether this seed cluster is considered by the scheduling of new Shoots. If set, it takes precedence over the Visible field of the seed specification.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSettingScheduling"),
+						},
+					},
+					"verticalPodAutoscaler": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VerticalPodAutoscaler controls whether the vertical pod autoscaler is deployed into this seed cluster. If not set, the VPA feature gate of the Gardener controller manager is used.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSettingVerticalPodAutoscaler"),
+						},
+					},
+					"loadBalancerServices": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LoadBalancerServices controls the services of type LoadBalancer created in this seed cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSettingLoadBalancerServices"),
+						},
+					},
+					"terraformer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Terraformer pins the version of the Terraformer used for the Shoots and backup infrastructures in this seed cluster. It takes precedence over the version pinned in the CloudProfile.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.TerraformerSettings"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSettingDashboardAuthentication", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSettingExcessCapacityReservation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSettingLoadBalancerServices", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSettingNetworkPolicies", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSettingScheduling", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSettingShootDNS", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSettingVerticalPodAutoscaler", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.TerraformerSettings"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_QuotaList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_SeedSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "QuotaList is a collection of Quotas.",
+				Description: "SeedSpec is the specification of a Seed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"cloud": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Cloud defines the cloud profile and the region this Seed cluster belongs to.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedCloud"),
 						},
 					},
-					"apiVersion": {
+					"ingressDomain": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Description: "IngressDomain is the domain of the Seed cluster pointing to the ingress controller endpoint. It will be used to construct ingress URLs for system applications running in Shoot clusters.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"ingressTLS": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard list object metadata.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "IngressTLS configures the TLS certificates of the ingresses for the monitoring and logging endpoints of the Shoot clusters. If not set, self-signed certificates are generated for every ingress.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedIngressTLS"),
 						},
 					},
-					"items": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of Quotas.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Quota"),
-									},
-								},
-							},
+							Description: "SecretRef is a reference to a Secret object containing the Kubeconfig and the cloud provider credentials for the account the Seed cluster has been deployed to.",
+							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Quota", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_QuotaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "QuotaSpec is the specification of a Quota.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"clusterLifetimeDays": {
+					"networks": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ClusterLifetimeDays is the lifetime of a Shoot cluster in days before it will be terminated automatically.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Networks defines the pod, service and worker network of the Seed cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedNetworks"),
 						},
 					},
-					"metrics": {
+					"visible": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Metrics is a list of resources which will be put under constraints.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-									},
-								},
-							},
+							Description: "Visible labels the Seed cluster as selectable for the seedfinder admission controller.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"scope": {
+					"protected": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Scope is the scope of the Quota object, either 'project' or 'secret'.",
-							Type:        []string{"string"},
+							Description: "Protected prevent that the Seed Cluster can be used for regular Shoot cluster control planes.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
+					"settings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Settings contains certain settings for this seed cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSettings"),
+						},
+					},
+					"backup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backup holds the configuration of the backup infrastructure of the Shoot clusters hosted by this Seed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedBackup"),
+						},
+					},
 				},
-				Required: []string{"metrics", "scope"},
+				Required: []string{"cloud", "ingressDomain", "secretRef", "networks"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedBackup", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedIngressTLS", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSettings", "k8s.io/api/core/v1.SecretReference"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_SecretBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_SeedStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "SeedStatus holds the most recently observed status of the Seed cluster.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"secretRef": {
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef is a reference to a secret object in the same or another namespace.",
-							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+							Description: "Conditions represents the latest available observations of a Seed's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition"),
+									},
+								},
+							},
 						},
 					},
-					"quotas": {
+					"orphans": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Quotas is a list of references to Quota objects in the same or another namespace.",
+							Description: "Orphans is a list of resources in the Seed cluster which do not belong to any Shoot anymore.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.ObjectReference"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedOrphan"),
 									},
 								},
 							},
 						},
 					},
+					"kubernetesVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubernetesVersion is the Kubernetes version of the Seed cluster. It is used to check whether the Kubernetes versions of the Shoots hosted by the Seed cluster are compatible.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"providerHealth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderHealth reports the health of the cloud provider account of the Seed, i.e. the reachability of the cloud API and the remaining headroom of the quotas in the region of the Seed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedProviderHealth"),
+						},
+					},
 				},
-				Required: []string{"secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ObjectReference", "k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedOrphan", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedProviderHealth"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_SecretBindingList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_ServiceLoadBalancer(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SecretBindingList is a collection of SecretBindings.",
+				Description: "ServiceLoadBalancer contains defaults for the load balancers of services of type LoadBalancer in the Shoot cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"internal": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
-							Type:        []string{"string"},
+							Description: "Internal specifies whether the load balancers are only reachable from within the network of the Shoot.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Standard list object metadata.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of SecretBindings.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Annotations is a map of additional annotations which are added to the services.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBinding"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBinding", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Seed(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_Shoot(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Seed holds certain properties about a Seed cluster.",
+				Description: "Shoot represents a Shoot cluster created and managed by Gardener.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -6192,62 +8701,73 @@ func schema_pkg_apis_garden_v1beta1_Seed(ref common.ReferenceCallback) common.Op
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the Seed cluster properties.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSpec"),
+							Description: "Specification of the Shoot cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Most recently observed status of the Seed cluster.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedStatus"),
+							Description: "Most recently observed status of the Shoot cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootStatus"),
 						},
 					},
 				},
 			},
 			VendorExtensible: spec.VendorExtensible{
 				Extensions: spec.Extensions{
-					"x-kubernetes-print-columns": "custom-columns=NAME:.metadata.name,DOMAIN:.spec.ingressDomain,CLOUDPROFILE:.spec.cloud.profile,REGION:.spec.cloud.profile,READY:.status.conditions[?(@.type == 'Available')].status",
+					"x-kubernetes-print-columns": "custom-columns=NAMESPACE:.metadata.namespace,NAME:.metadata.name,SEED:.spec.cloud.seed,DOMAIN:.spec.dns.domain,VERSION:.spec.kubernetes.version,CONTROL:.status.conditions[?(@.type == 'ControlPlaneHealthy')].status,NODES:.status.conditions[?(@.type == 'EveryNodeReady')].status,SYSTEM:.status.conditions[?(@.type == 'SystemComponentsHealthy')].status,LATEST:.status.lastOperation.state",
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSpec", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootSpec", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_SeedCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_ShootAccessAudit(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedCloud defines the cloud profile and the region this Seed cluster belongs to.",
+				Description: "ShootAccessAudit contains the timestamps of security relevant accesses to a Shoot cluster and its cloud provider account which are observed by the Gardener.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"profile": {
+					"lastCredentialsUseTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Profile is the name of a cloud profile.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastCredentialsUseTime is the last time the Gardener used the cloud provider credentials of the Shoot to create, update or delete its infrastructure.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"region": {
+					"lastKubeconfigReadTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Region is a name of a region.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastKubeconfigReadTime is the last time the kubeconfig secret of the Shoot was read in the garden cluster.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastKubeconfigRotationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastKubeconfigRotationTime is the last time the kubeconfig secret of the Shoot was issued with new credentials.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastSSHKeypairReadTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSSHKeypairReadTime is the last time the SSH key pair for the worker nodes of the Shoot was read in the garden cluster.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"profile", "region"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_SeedList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_ShootList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedList is a collection of Seeds.",
+				Description: "ShootList is a list of Shoot objects.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -6272,12 +8792,12 @@ func schema_pkg_apis_garden_v1beta1_SeedList(ref common.ReferenceCallback) commo
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of Seeds.",
+							Description: "Items is the list of Shoots.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Seed"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Shoot"),
 									},
 								},
 							},
@@ -6288,110 +8808,116 @@ func schema_pkg_apis_garden_v1beta1_SeedList(ref common.ReferenceCallback) commo
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Seed", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Shoot", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_SeedNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_ShootSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedNetworks contains CIDRs for the pod, service and node networks of a Kubernetes cluster.",
+				Description: "ShootSpec is the specification of a Shoot.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"nodes": {
+					"addons": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Nodes is the CIDR of the node network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Addons contains information about enabled/disabled addons and their configuration.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons"),
 						},
 					},
-					"pods": {
+					"backup": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Pods is the CIDR of the pod network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "DEPRECATED: This field will be removed in a future version.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Backup"),
 						},
 					},
-					"services": {
+					"cloud": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Services is the CIDR of the service network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Cloud contains information about the cloud environment and their specific settings.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud"),
 						},
 					},
-				},
-				Required: []string{"nodes", "pods", "services"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_SeedSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "SeedSpec is the specification of a Seed.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"cloud": {
+					"dns": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Cloud defines the cloud profile and the region this Seed cluster belongs to.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedCloud"),
+							Description: "DNS contains information about the DNS settings of the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS"),
 						},
 					},
-					"ingressDomain": {
+					"hibernation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IngressDomain is the domain of the Seed cluster pointing to the ingress controller endpoint. It will be used to construct ingress URLs for system applications running in Shoot clusters.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Hibernation contains information whether the Shoot is suspended or not.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation"),
 						},
 					},
-					"secretRef": {
+					"kubernetes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef is a reference to a Secret object containing the Kubeconfig and the cloud provider credentials for the account the Seed cluster has been deployed to.",
-							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+							Description: "Kubernetes contains the version and configuration settings of the control plane components.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes"),
 						},
 					},
-					"networks": {
+					"maintenance": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Networks defines the pod, service and worker network of the Seed cluster.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedNetworks"),
+							Description: "Maintenance contains information about the time window for maintenance operations and which operations should be performed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance"),
 						},
 					},
-					"visible": {
+					"monitoring": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Visible labels the Seed cluster as selectable for the seedfinder admission controller.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Monitoring contains information about the monitoring stack of the Shoot which is deployed in the Seed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monitoring"),
 						},
 					},
-					"protected": {
+					"sizingProfile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Protected prevent that the Seed Cluster can be used for regular Shoot cluster control planes.",
-							Type:        []string{"boolean"},
+							Description: "SizingProfile is the profile which determines the resources and replica counts of the control plane and addon components of the Shoot. If it is not set then it is selected based on the maximum number of worker nodes.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"storage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Storage contains information about the StorageClasses which are managed by Gardener in the Shoot cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Storage"),
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template is a reference to a ShootTemplate whose settings are applied to the Shoot when it is created.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootTemplateReference"),
+						},
+					},
 				},
-				Required: []string{"cloud", "ingressDomain", "secretRef", "networks"},
+				Required: []string{"cloud", "dns", "kubernetes"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedNetworks", "k8s.io/api/core/v1.SecretReference"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Backup", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monitoring", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootTemplateReference", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Storage"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_SeedStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_ShootStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedStatus holds the most recently observed status of the Seed cluster.",
+				Description: "ShootStatus holds the most recently observed status of the Shoot cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions represents the latest available observations of a Seed's current state.",
+							Description: "Conditions represents the latest available observations of a Shoots's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"constraints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Constraints represents conditions of a Shoot's current state that constrain some operations on it.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -6402,19 +8928,127 @@ func schema_pkg_apis_garden_v1beta1_SeedStatus(ref common.ReferenceCallback) com
 							},
 						},
 					},
+					"gardener": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Gardener holds information about the Gardener which last acted on the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener"),
+						},
+					},
+					"lastOperation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastOperation holds information about the last operation on the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation"),
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError holds information about the last occurred error during an operation.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError"),
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this Shoot. It corresponds to the Shoot's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"retryCycleStartTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryCycleStartTime is the start time of the last retry cycle (used to determine how often an operation must be retried until we give up).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"retryCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryCount is the number of consecutive failed attempts of the current operation. It is reset when the operation succeeds, when a new retry cycle starts or when the Shoot is annotated with the `retry` operation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"seed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Seed is the name of the seed cluster that runs the control plane of the Shoot. This value is only written after a successful create/reconcile operation. It will be used when control planes are moved between Seeds.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"technicalID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TechnicalID is the name that is used for creating the Seed namespace, the infrastructure resources, and basically everything that is related to this particular Shoot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"uid": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UID is a unique identifier for the Shoot cluster to avoid portability between Kubernetes clusters. It is used to compute unique hashes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiServerSLO": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIServerSLO contains the service level objective attainment of the kube-apiserver of the Shoot cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.APIServerSLO"),
+						},
+					},
+					"egressIPs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EgressIPs is the list of static IP addresses the worker nodes of the Shoot cluster use for egress traffic to the internet. It is empty if the egress IPs are not known or not stable, e.g. when they are allocated automatically.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"kubeletVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeletVersion is the Kubernetes version of the kubelets of the Shoot, i.e., the version which has been applied by the last successful reconciliation. It is used to prevent updates which violate the version skew policy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reconciledStateHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReconciledStateHash is the hash of the effective desired state (the specification of the Shoot, the versions of the referenced cloud profile, seed and secrets, and the Gardener version) which has been applied by the last successful reconciliation. It is used to skip reconciliations if nothing has changed since then.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hibernated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hibernated indicates whether the Shoot has been hibernated by the last successful operation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"accessAudit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AccessAudit contains the timestamps of security relevant accesses to the Shoot and its cloud provider account.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootAccessAudit"),
+						},
+					},
 				},
+				Required: []string{"gardener", "technicalID", "uid"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.APIServerSLO", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootAccessAudit", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Shoot(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_ShootTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ShootTemplate contains default settings for Shoot clusters which reference it by name. Templates in the garden namespace can be referenced by Shoots of all projects.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
 						SchemaProps: spec.SchemaProps{
@@ -6438,34 +9072,24 @@ func schema_pkg_apis_garden_v1beta1_Shoot(ref common.ReferenceCallback) common.O
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specification of the Shoot cluster.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootSpec"),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Most recently observed status of the Shoot cluster.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootStatus"),
+							Description: "Spec contains the default settings of the ShootTemplate.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootTemplateSpec"),
 						},
 					},
 				},
-			},
-			VendorExtensible: spec.VendorExtensible{
-				Extensions: spec.Extensions{
-					"x-kubernetes-print-columns": "custom-columns=NAMESPACE:.metadata.namespace,NAME:.metadata.name,SEED:.spec.cloud.seed,DOMAIN:.spec.dns.domain,VERSION:.spec.kubernetes.version,CONTROL:.status.conditions[?(@.type == 'ControlPlaneHealthy')].status,NODES:.status.conditions[?(@.type == 'EveryNodeReady')].status,SYSTEM:.status.conditions[?(@.type == 'SystemComponentsHealthy')].status,LATEST:.status.lastOperation.state",
-				},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootSpec", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootTemplateSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_ShootList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_ShootTemplateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootList is a list of Shoot objects.",
+				Description: "ShootTemplateList is a collection of ShootTemplates.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -6490,12 +9114,12 @@ func schema_pkg_apis_garden_v1beta1_ShootList(ref common.ReferenceCallback) comm
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of Shoots.",
+							Description: "Items is the list of ShootTemplates.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Shoot"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootTemplate"),
 									},
 								},
 							},
@@ -6506,145 +9130,395 @@ func schema_pkg_apis_garden_v1beta1_ShootList(ref common.ReferenceCallback) comm
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Shoot", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootTemplate", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_ShootSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_ShootTemplateReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootSpec is the specification of a Shoot.",
+				Description: "ShootTemplateReference is a reference to a ShootTemplate.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the ShootTemplate.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the ShootTemplate. It defaults to the namespace of the Shoot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_ShootTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootTemplateSpec is the specification of a ShootTemplate. Its settings are only applied to a Shoot if the Shoot does not specify them itself.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"addons": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Addons contains information about enabled/disabled addons and their configuration.",
+							Description: "Addons contains the default addon configuration.",
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons"),
 						},
 					},
-					"backup": {
+					"networks": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DEPRECATED: This field will be removed in a future version.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Backup"),
+							Description: "Networks contains the default networks of the Kubernetes cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.K8SNetworks"),
 						},
 					},
-					"cloud": {
+					"workers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Cloud contains information about the cloud environment and their specific settings.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud"),
+							Description: "Workers is a list of default worker pools.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootTemplateWorker"),
+									},
+								},
+							},
 						},
 					},
-					"dns": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.K8SNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootTemplateWorker"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_ShootTemplateWorker(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootTemplateWorker is the provider independent configuration of a default worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DNS contains information about the DNS settings of the Shoot.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS"),
+							Description: "Name is the name of the worker group.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"hibernation": {
+					"machineType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Hibernation contains information whether the Shoot is suspended or not.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation"),
+							Description: "MachineType is the machine type of the worker group.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"kubernetes": {
+					"autoScalerMin": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kubernetes contains the version and configuration settings of the control plane components.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes"),
+							Description: "AutoScalerMin is the minimum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"maintenance": {
+					"autoScalerMax": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoScalerMin is the maximum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxSurge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSurge is maximum number of VMs that are created during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"instanceTagLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstanceTagLabels is a list of keys of the `Labels` of this worker pool whose key/value pairs are additionally added as tags (AWS, Alicloud) or labels (GCP) to the cloud provider instances of this worker pool, e.g. to allow cost and inventory tools to group the machines by their Kubernetes labels.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"taints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Taint"),
+									},
+								},
+							},
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones is a subset of the Shoot's zones in which the machines of this worker pool are created. If it is empty then the worker pool spans all zones of the Shoot.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"osUpdates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OSUpdates contains configuration for in-place updates of the operating system of this worker pool. If it is set then the nodes receive OS patches in-place instead of being replaced.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerOSUpdates"),
+						},
+					},
+					"customMachineImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CustomMachineImage is the provider-specific id of a machine image which is not listed in the CloudProfile, e.g. an AMI on AWS or an URN ('<publisher>:<offer>:<sku>:<version>') on Azure. The image must run the same operating system as the machine image of the Shoot. It is only allowed if the CustomMachineImages feature gate of the Gardener API server is enabled and the CloudProfile allows custom machine images for the project of the Shoot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxPods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxPods is the maximum number of pods which can run on a node of this worker pool (default: 110). It must not exceed the number of addresses of the pod CIDR of a node.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"dataVolumes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataVolumes is a list of additional volumes which are attached to the machines of this worker pool, e.g. as local scratch disks. They are only supported for AWS and GCP.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DataVolume"),
+									},
+								},
+							},
+						},
+					},
+					"kubeletDataVolumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeletDataVolumeName is the name of a data volume which is formatted and mounted at the root directory of the kubelet ('/var/lib/kubelet'), i.e., the pod volumes and container logs are stored on it instead of the root volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kubelet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubelet contains configuration for the image garbage collection and the container log rotation of the kubelets of this worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerKubeletConfig"),
+						},
+					},
+					"architecture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Architecture is the CPU architecture of the machines of this worker pool (default: amd64). It must match the architecture of the machine type.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"capacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capacity contains configuration for cost-optimized worker pools which mix on-demand and spot (preemptible) machines and which may fall back to other machine types. It is only supported for GCP and Alicloud.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerCapacity"),
+						},
+					},
+					"volumeType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeType is the type of the root volumes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeSize": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance"),
+							Description: "VolumeSize is the size of the root volume.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"cloud", "dns", "kubernetes"},
+				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Backup", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DataVolume", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerCapacity", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerKubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerOSUpdates", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_ShootStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_Storage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootStatus holds the most recently observed status of the Shoot cluster.",
+				Description: "Storage contains information about the StorageClasses which are managed by Gardener in the Shoot cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"conditions": {
+					"classes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions represents the latest available observations of a Shoots's current state.",
+							Description: "Classes is a list of StorageClasses which are created in addition to the default StorageClasses of the cloud provider. A class with the same name as a default StorageClass replaces it.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.StorageClass"),
 									},
-								},
-							},
-						},
-					},
-					"gardener": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Gardener holds information about the Gardener which last acted on the Shoot.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener"),
+								},
+							},
 						},
 					},
-					"lastOperation": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.StorageClass"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_StorageClass(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StorageClass contains the provider independent settings of a StorageClass in the Shoot cluster. Gardener computes the provisioner and its parameters based on the cloud provider of the Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastOperation holds information about the last operation on the Shoot.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation"),
+							Description: "Name is the name of the StorageClass.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastError": {
+					"default": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastError holds information about the last occurred error during an operation.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError"),
+							Description: "Default specifies whether the StorageClass is the default StorageClass of the Shoot cluster. At most one StorageClass can be the default one; it replaces the default StorageClass of the cloud provider.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"observedGeneration": {
+					"volumeType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this Shoot. It corresponds to the Shoot's generation, which is updated on mutation by the API Server.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "VolumeType is the type of the volumes provisioned for this StorageClass (e.g., gp2 or io1 on AWS, pd-ssd on GCP, Premium_LRS on Azure, cloud_ssd on Alicloud, or a Cinder volume type on OpenStack).",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"retryCycleStartTime": {
+					"encrypted": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RetryCycleStartTime is the start time of the last retry cycle (used to determine how often an operation must be retried until we give up).",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Encrypted specifies whether the provisioned volumes are encrypted. Only supported for AWS and Alicloud, the volumes on the other cloud providers are always encrypted at rest.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"seed": {
+					"zones": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Seed is the name of the seed cluster that runs the control plane of the Shoot. This value is only written after a successful create/reconcile operation. It will be used when control planes are moved between Seeds.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Zones restricts the provisioning of volumes to the given zones of the Shoot. All zones of the Shoot are allowed if it is not set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"technicalID": {
+					"parameters": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TechnicalID is the name that is used for creating the Seed namespace, the infrastructure resources, and basically everything that is related to this particular Shoot.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Parameters is a map of additional parameters which are passed to the provisioner as they are. They must not overwrite the parameters computed by Gardener.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"uid": {
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_TerraformerSettings(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TerraformerSettings contains the settings for the Terraformer which runs Terraform to create infrastructure resources.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UID is a unique identifier for the Shoot cluster to avoid portability between Kubernetes clusters. It is used to compute unique hashes.",
+							Description: "Version is the tag of the Terraformer image. The image bundles Terraform and its provider plugins, hence, the tag pins their versions. It must be a semantic version.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"gardener", "technicalID", "uid"},
+				Required: []string{"version"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -6760,6 +9634,20 @@ func schema_pkg_apis_garden_v1beta1_Worker(ref common.ReferenceCallback) common.
 							},
 						},
 					},
+					"instanceTagLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstanceTagLabels is a list of keys of the `Labels` of this worker pool whose key/value pairs are additionally added as tags (AWS, Alicloud) or labels (GCP) to the cloud provider instances of this worker pool, e.g. to allow cost and inventory tools to group the machines by their Kubernetes labels.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 					"taints": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
@@ -6773,12 +9661,190 @@ func schema_pkg_apis_garden_v1beta1_Worker(ref common.ReferenceCallback) common.
 							},
 						},
 					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones is a subset of the Shoot's zones in which the machines of this worker pool are created. If it is empty then the worker pool spans all zones of the Shoot.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"osUpdates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OSUpdates contains configuration for in-place updates of the operating system of this worker pool. If it is set then the nodes receive OS patches in-place instead of being replaced.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerOSUpdates"),
+						},
+					},
+					"customMachineImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CustomMachineImage is the provider-specific id of a machine image which is not listed in the CloudProfile, e.g. an AMI on AWS or an URN ('<publisher>:<offer>:<sku>:<version>') on Azure. The image must run the same operating system as the machine image of the Shoot. It is only allowed if the CustomMachineImages feature gate of the Gardener API server is enabled and the CloudProfile allows custom machine images for the project of the Shoot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxPods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxPods is the maximum number of pods which can run on a node of this worker pool (default: 110). It must not exceed the number of addresses of the pod CIDR of a node.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"dataVolumes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataVolumes is a list of additional volumes which are attached to the machines of this worker pool, e.g. as local scratch disks. They are only supported for AWS and GCP.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DataVolume"),
+									},
+								},
+							},
+						},
+					},
+					"kubeletDataVolumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeletDataVolumeName is the name of a data volume which is formatted and mounted at the root directory of the kubelet ('/var/lib/kubelet'), i.e., the pod volumes and container logs are stored on it instead of the root volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kubelet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubelet contains configuration for the image garbage collection and the container log rotation of the kubelets of this worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerKubeletConfig"),
+						},
+					},
+					"architecture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Architecture is the CPU architecture of the machines of this worker pool (default: amd64). It must match the architecture of the machine type.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"capacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capacity contains configuration for cost-optimized worker pools which mix on-demand and spot (preemptible) machines and which may fall back to other machine types. It is only supported for GCP and Alicloud.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerCapacity"),
+						},
+					},
 				},
 				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DataVolume", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerCapacity", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerKubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerOSUpdates", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_WorkerCapacity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerCapacity contains configuration for mixing on-demand and spot machines and for fallback machine types of a worker pool. Every combination of a machine type and a purchasing option results in its own machine deployments which are prioritized by the cluster-autoscaler.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"fallbackMachineTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FallbackMachineTypes is an ordered list of machine types which are used by the cluster-autoscaler if machines of the machine type of the worker pool cannot be created, e.g. because the cloud provider lacks capacity.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"spotPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SpotPercentage is the percentage of the maximum number of machines of the worker pool (autoScalerMax) which may be spot (preemptible) machines (default: 0). Spot machines are preferred by the cluster-autoscaler, however, the minimum number of machines (autoScalerMin) is always provided by on-demand machines.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_WorkerKubeletConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerKubeletConfig contains configuration for the kubelets of a worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"imageGCHighThresholdPercent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImageGCHighThresholdPercent is the percent of disk usage after which the image garbage collection is always run (default: 50).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"imageGCLowThresholdPercent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImageGCLowThresholdPercent is the percent of disk usage before which the image garbage collection is never run, it must be lower than the high threshold (default: 40).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"containerLogMaxSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContainerLogMaxSize is the maximum size of a container log file before it is rotated (default: 100Mi).",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"containerLogMaxFiles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContainerLogMaxFiles is the maximum number of rotated log files which are kept per container (default: 14).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_WorkerOSUpdates(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerOSUpdates contains configuration for in-place updates of the operating system of a worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"channel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Channel is the update channel from which the operating system receives in-place updates.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rebootWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RebootWindow is the daily time window in which nodes may be rebooted to activate updates. If it is not set then the maintenance time window of the Shoot is used.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceTimeWindow"),
+						},
+					},
+				},
+				Required: []string{"channel"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceTimeWindow"},
 	}
 }
 
@@ -6810,6 +9876,34 @@ func schema_pkg_apis_garden_v1beta1_Zone(ref common.ReferenceCallback) common.Op
 							},
 						},
 					},
+					"unavailableMachineTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UnavailableMachineTypes is a list of machine type names that cannot be used in the zones of this entry. Zones of the same region with different availability can be declared in separate entries.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"unavailableVolumeTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UnavailableVolumeTypes is a list of volume type names that cannot be used in the zones of this entry. Zones of the same region with different availability can be declared in separate entries.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"region", "names"},
 			},
@@ -0,0 +1,90 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/api"
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/openapi"
+
+	"github.com/go-openapi/spec"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	openapinamer "k8s.io/apiserver/pkg/endpoints/openapi"
+	"k8s.io/kube-openapi/pkg/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OpenAPI definitions", func() {
+	var (
+		definitions map[string]common.OpenAPIDefinition
+
+		gardenerDefinitions = func() map[string]common.OpenAPIDefinition {
+			result := map[string]common.OpenAPIDefinition{}
+			for name, definition := range definitions {
+				if strings.HasPrefix(name, "github.com/gardener/gardener/") {
+					result[name] = definition
+				}
+			}
+			return result
+		}
+	)
+
+	BeforeEach(func() {
+		definitions = GetOpenAPIDefinitions(func(path string) spec.Ref {
+			return spec.MustCreateRef("#/definitions/" + path)
+		})
+	})
+
+	It("should contain a definition for every kind of the Gardener API groups", func() {
+		for _, gv := range []schema.GroupVersion{gardenv1beta1.SchemeGroupVersion, gardencorev1alpha1.SchemeGroupVersion} {
+			for kind, typ := range api.Scheme.KnownTypes(gv) {
+				if typ.PkgPath() != reflect.TypeOf(gardenv1beta1.Shoot{}).PkgPath() && typ.PkgPath() != reflect.TypeOf(gardencorev1alpha1.Plant{}).PkgPath() {
+					continue
+				}
+				Expect(definitions).To(HaveKey(typ.PkgPath()+"."+typ.Name()), fmt.Sprintf("missing definition for kind %s of %s", kind, gv))
+			}
+		}
+	})
+
+	It("should describe every Gardener type and property so that `kubectl explain` is helpful", func() {
+		for name, definition := range gardenerDefinitions() {
+			Expect(definition.Schema.Description).NotTo(BeEmpty(), fmt.Sprintf("missing description of %s", name))
+			for property, schema := range definition.Schema.Properties {
+				Expect(schema.Description).NotTo(BeEmpty(), fmt.Sprintf("missing description of %s.%s", name, property))
+			}
+		}
+	})
+
+	It("should name the definitions of top-level kinds with their group, version and kind", func() {
+		namer := openapinamer.NewDefinitionNamer(api.Scheme)
+
+		name, extensions := namer.GetDefinitionName("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Shoot")
+
+		Expect(name).To(Equal("com.github.gardener.gardener.pkg.apis.garden.v1beta1.Shoot"))
+		Expect(extensions).To(HaveKey("x-kubernetes-group-version-kind"))
+		Expect(extensions["x-kubernetes-group-version-kind"]).To(ConsistOf(And(
+			HaveKeyWithValue("group", gardenv1beta1.GroupName),
+			HaveKeyWithValue("version", "v1beta1"),
+			HaveKeyWithValue("kind", "Shoot"),
+		)))
+	})
+})
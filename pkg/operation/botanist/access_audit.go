@@ -0,0 +1,60 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RecordCredentialsUse records in the access audit of the Shoot status that the Gardener has used the cloud provider
+// credentials of the Shoot to create, update or delete its infrastructure.
+func (b *Botanist) RecordCredentialsUse() error {
+	return b.updateAccessAudit(func(accessAudit *gardenv1beta1.ShootAccessAudit, now *metav1.Time) {
+		accessAudit.LastCredentialsUseTime = now
+	})
+}
+
+// RecordKubeconfigRotation records in the access audit of the Shoot status that the kubeconfig of the Shoot has been
+// issued with new credentials.
+func (b *Botanist) RecordKubeconfigRotation() error {
+	return b.updateAccessAudit(func(accessAudit *gardenv1beta1.ShootAccessAudit, now *metav1.Time) {
+		accessAudit.LastKubeconfigRotationTime = now
+	})
+}
+
+func (b *Botanist) updateAccessAudit(mutate func(*gardenv1beta1.ShootAccessAudit, *metav1.Time)) error {
+	var (
+		now      = metav1.Now()
+		newShoot = b.Shoot.Info.DeepCopy()
+	)
+
+	if err := kutil.TryUpdateStatus(context.TODO(), kutil.DefaultBackoff, b.K8sGardenClient.Client(), newShoot, func() error {
+		if newShoot.Status.AccessAudit == nil {
+			newShoot.Status.AccessAudit = &gardenv1beta1.ShootAccessAudit{}
+		}
+		mutate(newShoot.Status.AccessAudit, &now)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	b.Shoot.Info = newShoot
+	return nil
+}
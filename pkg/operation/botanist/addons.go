@@ -40,7 +40,7 @@ func (b *Botanist) EnsureIngressDNSRecord(ctx context.Context) error {
 		return err
 	}
 
-	if err := b.deployDNSEntry(ctx, DNSPurposeIngress, b.Shoot.GetIngressFQDN("*"), loadBalancerIngress); err != nil {
+	if err := b.deployDNSEntry(ctx, DNSPurposeIngress, b.Shoot.GetIngressFQDN("*"), loadBalancerIngress, nil); err != nil {
 		return err
 	}
 
@@ -60,9 +60,11 @@ func (b *Botanist) GenerateKubernetesDashboardConfig() (map[string]interface{},
 		values  map[string]interface{}
 	)
 
-	if enabled && b.Shoot.Info.Spec.Addons.KubernetesDashboard.AuthenticationMode != nil {
-		values = map[string]interface{}{
-			"authenticationMode": *b.Shoot.Info.Spec.Addons.KubernetesDashboard.AuthenticationMode,
+	if enabled {
+		values = map[string]interface{}{}
+
+		if authenticationMode := b.Shoot.Info.Spec.Addons.KubernetesDashboard.AuthenticationMode; authenticationMode != nil {
+			values["authenticationMode"] = *authenticationMode
 		}
 	}
 
@@ -27,6 +27,7 @@ import (
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -142,7 +143,14 @@ func (b *Botanist) RegisterAsSeed(protected, visible *bool, minimumVolumeSize *s
 	}
 	_, err := b.K8sGardenClient.Garden().GardenV1beta1().Seeds().Create(seed)
 	if apierrors.IsAlreadyExists(err) {
-		_, err = b.K8sGardenClient.Garden().GardenV1beta1().Seeds().Update(seed)
+		existingSeed := &gardenv1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: seed.Name}}
+		return kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, b.K8sGardenClient.Client(), existingSeed, func() error {
+			existingSeed.OwnerReferences = seed.OwnerReferences
+			existingSeed.Annotations = seed.Annotations
+			existingSeed.Labels = seed.Labels
+			existingSeed.Spec = seed.Spec
+			return nil
+		})
 	}
 	return err
 }
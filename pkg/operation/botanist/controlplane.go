@@ -19,12 +19,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	"github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	controllermanagerfeatures "github.com/gardener/gardener/pkg/controllermanager/features"
 	"github.com/gardener/gardener/pkg/features"
@@ -34,9 +36,13 @@ import (
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/secrets"
 
+	"gopkg.in/yaml.v2"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -122,6 +128,34 @@ func (b *Botanist) DeployCloudMetadataServiceNetworkPolicy() error {
 	return b.ApplyChartSeed(filepath.Join(chartPathControlPlane, "cloud-metadata-service"), b.Shoot.SeedNamespace, "cloud-metadata-service", values, nil)
 }
 
+// DeployNetworkPolicies deploys the network policies into the Shoot namespace in the Seed cluster. They deny all
+// ingress traffic to the control plane components which is not explicitly allowed, e.g., only the kube-apiserver
+// and Prometheus may talk to etcd. If the network policies are disabled in the Seed settings then they are deleted.
+func (b *Botanist) DeployNetworkPolicies(ctx context.Context) error {
+	if settings := b.Seed.Info.Spec.Settings; settings != nil && settings.NetworkPolicies != nil && !settings.NetworkPolicies.Enabled {
+		return b.DeleteNetworkPolicies(ctx)
+	}
+	return b.ApplyChartSeed(filepath.Join(chartPathControlPlane, "network-policies"), b.Shoot.SeedNamespace, "network-policies", nil, nil)
+}
+
+// DeleteNetworkPolicies deletes the network policies deployed by DeployNetworkPolicies.
+func (b *Botanist) DeleteNetworkPolicies(ctx context.Context) error {
+	for _, name := range []string{
+		common.NetworkPolicyDenyAll,
+		common.NetworkPolicyAllowKubeAPIServer,
+		common.NetworkPolicyAllowEtcd,
+		common.NetworkPolicyAllowFromShootNamespace,
+		common.NetworkPolicyAllowFromSeed,
+		common.NetworkPolicyAllowIngressEndpoints,
+	} {
+		networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: b.Shoot.SeedNamespace, Name: name}}
+		if err := b.K8sSeedClient.Client().Delete(ctx, networkPolicy); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
 // DeleteKubeAPIServer deletes the kube-apiserver deployment in the Seed cluster which holds the Shoot's control plane.
 func (b *Botanist) DeleteKubeAPIServer() error {
 	err := b.K8sSeedClient.DeleteDeployment(b.Shoot.SeedNamespace, common.KubeAPIServerDeploymentName)
@@ -156,10 +190,27 @@ func (b *Botanist) RefreshKubeControllerManagerChecksums() error {
 // DeployBackupInfrastructure creates a BackupInfrastructure resource into the project namespace of shoot on garden cluster.
 // BackupInfrastructure controller acting on resource will actually create required cloud resources and updates the status.
 func (b *Botanist) DeployBackupInfrastructure() error {
+	var (
+		name                 = common.GenerateBackupInfrastructureName(b.Shoot.SeedNamespace, b.Shoot.Info.Status.UID)
+		backupInfrastructure = map[string]interface{}{
+			"name": name,
+		}
+	)
+
+	// The region of an existing backup bucket must not change, hence the backup region of the Seed is only used for
+	// new BackupInfrastructures.
+	existing, err := b.K8sGardenClient.Garden().GardenV1beta1().BackupInfrastructures(b.Shoot.Info.Namespace).Get(name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		backupInfrastructure["region"] = b.Seed.GetBackupRegion()
+	case err != nil:
+		return err
+	case existing.Spec.Region != nil:
+		backupInfrastructure["region"] = *existing.Spec.Region
+	}
+
 	return b.ApplyChartGarden(filepath.Join(common.ChartPath, "garden-project", "charts", "backup-infrastructure"), b.Shoot.Info.Namespace, "backup-infrastructure", nil, map[string]interface{}{
-		"backupInfrastructure": map[string]interface{}{
-			"name": common.GenerateBackupInfrastructureName(b.Shoot.SeedNamespace, b.Shoot.Info.Status.UID),
-		},
+		"backupInfrastructure": backupInfrastructure,
 		"seed": map[string]interface{}{
 			"name": b.Seed.Info.Name,
 		},
@@ -173,7 +224,40 @@ func (b *Botanist) DeployBackupInfrastructure() error {
 // DeleteBackupInfrastructure deletes the sets deletionTimestamp on the backupInfrastructure resource in the Garden namespace
 // which is responsible for actual deletion of cloud resource for Shoot's backup infrastructure.
 func (b *Botanist) DeleteBackupInfrastructure() error {
-	err := b.K8sGardenClient.Garden().GardenV1beta1().BackupInfrastructures(b.Shoot.Info.Namespace).Delete(common.GenerateBackupInfrastructureName(b.Shoot.SeedNamespace, b.Shoot.Info.Status.UID), nil)
+	name := common.GenerateBackupInfrastructureName(b.Shoot.SeedNamespace, b.Shoot.Info.Status.UID)
+
+	if err := b.annotateBackupInfrastructureRetention(name); err != nil {
+		return err
+	}
+
+	err := b.K8sGardenClient.Garden().GardenV1beta1().BackupInfrastructures(b.Shoot.Info.Namespace).Delete(name, nil)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// annotateBackupInfrastructureRetention annotates the BackupInfrastructure with the backup retention required by the
+// DeletionProtectionPolicies matching the Shoot. It has to be determined now because the Shoot does not exist anymore
+// when the BackupInfrastructure is garbage collected.
+func (b *Botanist) annotateBackupInfrastructureRetention(name string) error {
+	policies, err := b.K8sGardenInformers.DeletionProtectionPolicies().Lister().List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	retention, err := helper.GetShootBackupRetention(policies, b.Shoot.Info)
+	if err != nil {
+		return err
+	}
+	if retention == 0 {
+		return nil
+	}
+
+	backupInfrastructure := &v1beta1.BackupInfrastructure{ObjectMeta: metav1.ObjectMeta{Namespace: b.Shoot.Info.Namespace, Name: name}}
+	err = kutil.TryUpdate(context.TODO(), kutil.DefaultBackoff, b.K8sGardenClient.Client(), backupInfrastructure, func() error {
+		metav1.SetMetaDataAnnotation(&backupInfrastructure.ObjectMeta, common.BackupInfrastructureRetention, retention.String())
+		return nil
+	})
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
@@ -204,7 +288,7 @@ func (b *Botanist) DeployMachineControllerManager() error {
 				"uid": b.SeedNamespaceObject.UID,
 			},
 			"vpa": map[string]interface{}{
-				"enabled": controllermanagerfeatures.FeatureGate.Enabled(features.VPA),
+				"enabled": b.Seed.VerticalPodAutoscalerEnabled(),
 			},
 		}
 	)
@@ -234,10 +318,15 @@ func (b *Botanist) DeployClusterAutoscaler() error {
 		return b.DeleteClusterAutoscaler()
 	}
 
-	var workerPools []map[string]interface{}
+	var (
+		workerPools []map[string]interface{}
+		priorities  = map[int][]string{}
+	)
 	for _, worker := range b.MachineDeployments {
-		// Skip worker pools for which min=0. Auto scaler cannot handle worker pools having a min count of 0.
-		if worker.Minimum == 0 {
+		// Skip worker pools for which min=0. Auto scaler cannot handle worker pools having a min count of 0. The
+		// machine deployments of worker pools with capacity configuration are the exception as only one of them
+		// carries the minimum of the worker pool.
+		if worker.Minimum == 0 && (worker.Priority == 0 || worker.Maximum == 0) {
 			continue
 		}
 
@@ -246,6 +335,21 @@ func (b *Botanist) DeployClusterAutoscaler() error {
 			"min":  worker.Minimum,
 			"max":  worker.Maximum,
 		})
+
+		if worker.Priority > 0 {
+			priorities[worker.Priority] = append(priorities[worker.Priority], fmt.Sprintf("^%s$", regexp.QuoteMeta(fmt.Sprintf("%s.%s", b.Shoot.SeedNamespace, worker.Name))))
+		}
+	}
+
+	expander := "least-waste"
+	if len(priorities) > 0 {
+		expander = "priority"
+	}
+	// The API server of hibernated Shoots is not running, the configuration is reconciled once they are woken up.
+	if !b.Shoot.IsHibernated {
+		if err := b.reconcileClusterAutoscalerPriorities(priorities); err != nil {
+			return err
+		}
 	}
 
 	defaultValues := map[string]interface{}{
@@ -256,6 +360,7 @@ func (b *Botanist) DeployClusterAutoscaler() error {
 			"uid": b.SeedNamespaceObject.UID,
 		},
 		"replicas":    b.Shoot.GetReplicas(1),
+		"expander":    expander,
 		"workerPools": workerPools,
 	}
 
@@ -267,6 +372,26 @@ func (b *Botanist) DeployClusterAutoscaler() error {
 	return b.ApplyChartSeed(filepath.Join(chartPathControlPlane, gardencorev1alpha1.DeploymentNameClusterAutoscaler), b.Shoot.SeedNamespace, gardencorev1alpha1.DeploymentNameClusterAutoscaler, nil, values)
 }
 
+// reconcileClusterAutoscalerPriorities writes the configuration of the priority expander of the cluster-autoscaler
+// into the Shoot. The priorities map to regular expressions matching the node groups ('<namespace>.<machine deployment
+// name>'). The configuration is deleted if no worker pool has a capacity configuration.
+func (b *Botanist) reconcileClusterAutoscalerPriorities(priorities map[int][]string) error {
+	if len(priorities) == 0 {
+		if err := b.K8sShootClient.DeleteConfigMap(metav1.NamespaceSystem, common.ClusterAutoscalerPriorityExpanderConfigMapName); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	data, err := yaml.Marshal(priorities)
+	if err != nil {
+		return err
+	}
+
+	_, err = b.K8sShootClient.CreateConfigMap(metav1.NamespaceSystem, common.ClusterAutoscalerPriorityExpanderConfigMapName, map[string]string{"priorities": string(data)}, true)
+	return err
+}
+
 // DeleteClusterAutoscaler deletes the cluster-autoscaler deployment in the Seed cluster which holds the Shoot's control plane.
 func (b *Botanist) DeleteClusterAutoscaler() error {
 	err := b.K8sSeedClient.DeleteDeployment(b.Shoot.SeedNamespace, gardencorev1alpha1.DeploymentNameClusterAutoscaler)
@@ -279,6 +404,10 @@ func (b *Botanist) DeleteClusterAutoscaler() error {
 // DeploySeedMonitoring will install the Helm release "seed-monitoring" in the Seed clusters. It comprises components
 // to monitor the Shoot cluster whose control plane runs in the Seed cluster.
 func (b *Botanist) DeploySeedMonitoring() error {
+	if !b.Shoot.WantsMonitoring {
+		return common.DeleteMonitoring(b.K8sSeedClient, b.Shoot.SeedNamespace)
+	}
+
 	var (
 		credentials      = b.Secrets["monitoring-ingress-credentials"]
 		basicAuth        = utils.CreateSHA1Secret(credentials.Data[secrets.DataKeyUserName], credentials.Data[secrets.DataKeyPassword])
@@ -289,18 +418,12 @@ func (b *Botanist) DeploySeedMonitoring() error {
 
 	var (
 		alertManagerConfig = map[string]interface{}{
-			"ingress": map[string]interface{}{
-				"basicAuthSecret": basicAuth,
-				"host":            alertManagerHost,
-			},
+			"ingress":  b.dashboardIngressValues("alertmanager", alertManagerHost, basicAuth),
 			"replicas": b.Shoot.GetReplicas(1),
 			"storage":  b.Seed.GetValidVolumeSize("1Gi"),
 		}
 		grafanaConfig = map[string]interface{}{
-			"ingress": map[string]interface{}{
-				"basicAuthSecret": basicAuth,
-				"host":            grafanaHost,
-			},
+			"ingress":  b.dashboardIngressValues("grafana", grafanaHost, basicAuth),
 			"replicas": b.Shoot.GetReplicas(1),
 		}
 		prometheusConfig = map[string]interface{}{
@@ -310,17 +433,16 @@ func (b *Botanist) DeploySeedMonitoring() error {
 				"services": b.Shoot.GetServiceNetwork(),
 				"nodes":    b.Shoot.GetNodeNetwork(),
 			},
-			"ingress": map[string]interface{}{
-				"basicAuthSecret": basicAuth,
-				"host":            prometheusHost,
-			},
+			"ingress":       b.dashboardIngressValues("prometheus", prometheusHost, basicAuth),
+			"scrapeTargets": b.Shoot.GetPrometheusScrapeTargets(),
 			"namespace": map[string]interface{}{
 				"uid": b.SeedNamespaceObject.UID,
 			},
-			"objectCount": b.Shoot.GetNodeCount(),
+			"objectCount":               b.Shoot.GetNodeCount(),
+			"enableBasicAuthentication": helper.ShootWantsBasicAuthentication(b.Shoot.Info),
 			"podAnnotations": map[string]interface{}{
 				"checksum/secret-prometheus":                b.CheckSums["prometheus"],
-				"checksum/secret-kube-apiserver-basic-auth": b.CheckSums["kube-apiserver-basic-auth"],
+				"checksum/secret-kube-apiserver-basic-auth": b.CheckSums[common.KubeAPIServerBasicAuthSecretName],
 				"checksum/secret-vpn-seed":                  b.CheckSums["vpn-seed"],
 				"checksum/secret-vpn-seed-tlsauth":          b.CheckSums["vpn-seed-tlsauth"],
 			},
@@ -346,7 +468,7 @@ func (b *Botanist) DeploySeedMonitoring() error {
 				"provider":  b.Shoot.CloudProvider,
 			},
 			"vpa": map[string]interface{}{
-				"enabled": controllermanagerfeatures.FeatureGate.Enabled(features.VPA),
+				"enabled": b.Seed.VerticalPodAutoscalerEnabled(),
 			},
 			"ignoreAlerts": b.Shoot.IgnoreAlerts,
 		}
@@ -498,10 +620,7 @@ func (b *Botanist) DeploySeedLogging() error {
 	ct := b.Shoot.Info.CreationTimestamp.Time
 
 	elasticKibanaCurator := map[string]interface{}{
-		"ingress": map[string]interface{}{
-			"basicAuthSecret": basicAuth,
-			"host":            kibanaHost,
-		},
+		"ingress": b.dashboardIngressValues("kibana", kibanaHost, basicAuth),
 		"elasticsearch": map[string]interface{}{
 			"replicaCount": b.Shoot.GetReplicas(1),
 		},
@@ -613,7 +732,7 @@ func (b *Botanist) DeleteCertBroker() error {
 	if err := b.K8sSeedClient.DeleteDeployment(b.Shoot.SeedNamespace, common.CertBrokerResourceName); err != nil && !apierrors.IsNotFound(err) {
 		return err
 	}
-	if err := b.K8sSeedClient.DeleteSecret(b.Shoot.SeedNamespace, common.CertBrokerResourceName); err != nil && !apierrors.IsNotFound(err) {
+	if err := b.SeedNamespaceSecrets.Delete(context.TODO(), common.CertBrokerResourceName); err != nil {
 		return err
 	}
 	if err := b.K8sSeedClient.DeleteServiceAccount(b.Shoot.SeedNamespace, common.CertBrokerResourceName); err != nil && !apierrors.IsNotFound(err) {
@@ -711,3 +830,20 @@ func (b *Botanist) HibernateControlPlane(ctx context.Context) error {
 
 	return nil
 }
+
+// IsWakingUp returns true if the Shoot shall not be hibernated but its control plane is still scaled down, i.e.,
+// the current reconciliation wakes the Shoot up.
+func (b *Botanist) IsWakingUp(ctx context.Context) (bool, error) {
+	if b.Shoot.IsHibernated {
+		return false, nil
+	}
+
+	etcd := &appsv1.StatefulSet{}
+	if err := b.K8sSeedClient.Client().Get(ctx, kutil.Key(b.Shoot.SeedNamespace, common.EtcdMainStatefulSetName), etcd); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return etcd.Spec.Replicas != nil && *etcd.Spec.Replicas == 0, nil
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	"github.com/hashicorp/go-multierror"
+	certificatesv1beta1 "k8s.io/api/certificates/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	nodeUserNamePrefix = "system:node:"
+	nodesGroup         = "system:nodes"
+)
+
+var allowedKubeletServingUsages = sets.NewString(
+	string(certificatesv1beta1.UsageDigitalSignature),
+	string(certificatesv1beta1.UsageKeyEncipherment),
+	string(certificatesv1beta1.UsageServerAuth),
+)
+
+// ApproveKubeletServingCertificateSigningRequests approves all pending CertificateSigningRequests in the Shoot
+// cluster which have been created by kubelets for their serving certificates, as long as the requested subject
+// and subject alternative names match the respective Node object. The kube-controller-manager only approves the
+// client certificates of kubelets, hence, serving certificate requests would stay pending otherwise.
+func (b *Botanist) ApproveKubeletServingCertificateSigningRequests() error {
+	csrList, err := b.K8sShootClient.Kubernetes().CertificatesV1beta1().CertificateSigningRequests().List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	var result error
+	for _, csr := range csrList.Items {
+		if !isPendingKubeletServingCSR(&csr) {
+			continue
+		}
+
+		nodeName := strings.TrimPrefix(csr.Spec.Username, nodeUserNamePrefix)
+		node, err := b.K8sShootClient.Kubernetes().CoreV1().Nodes().Get(nodeName, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				b.Logger.Infof("Not approving CertificateSigningRequest %s because node %s does not exist", csr.Name, nodeName)
+				continue
+			}
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		if err := ValidateKubeletServingCSR(&csr, node); err != nil {
+			b.Logger.Infof("Not approving CertificateSigningRequest %s: %v", csr.Name, err)
+			continue
+		}
+
+		csr.Status.Conditions = append(csr.Status.Conditions, certificatesv1beta1.CertificateSigningRequestCondition{
+			Type:           certificatesv1beta1.CertificateApproved,
+			Reason:         "GardenerAutoApproved",
+			Message:        "Kubelet serving certificate has been approved by Gardener",
+			LastUpdateTime: metav1.Now(),
+		})
+		if _, err := b.K8sShootClient.Kubernetes().CertificatesV1beta1().CertificateSigningRequests().UpdateApproval(&csr); err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+		b.Logger.Infof("Approved kubelet serving CertificateSigningRequest %s of node %s", csr.Name, nodeName)
+
+		patch := []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:"true"}}}`, common.NodeKubeletServingCertificateApproved))
+		if _, err := b.K8sShootClient.Kubernetes().CoreV1().Nodes().Patch(nodeName, types.MergePatchType, patch); err != nil && !apierrors.IsNotFound(err) {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result
+}
+
+// KubeletServingCertificatesApproved returns true if a serving certificate request of the kubelet of every Node in the
+// Shoot cluster has been approved, i.e., if all kubelets serve with a certificate signed by the cluster CA.
+func (b *Botanist) KubeletServingCertificatesApproved() (bool, error) {
+	nodeList, err := b.K8sShootClient.Kubernetes().CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	for _, node := range nodeList.Items {
+		if !metav1.HasAnnotation(node.ObjectMeta, common.NodeKubeletServingCertificateApproved) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// isPendingKubeletServingCSR returns true if the given CertificateSigningRequest has neither been approved nor
+// denied yet and was requested by a node for server authentication.
+func isPendingKubeletServingCSR(csr *certificatesv1beta1.CertificateSigningRequest) bool {
+	if len(csr.Status.Conditions) > 0 || len(csr.Status.Certificate) > 0 {
+		return false
+	}
+	if !strings.HasPrefix(csr.Spec.Username, nodeUserNamePrefix) {
+		return false
+	}
+	for _, usage := range csr.Spec.Usages {
+		if usage == certificatesv1beta1.UsageServerAuth {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateKubeletServingCSR checks whether the given CertificateSigningRequest is a valid request of the kubelet
+// running on the given node for its serving certificate.
+func ValidateKubeletServingCSR(csr *certificatesv1beta1.CertificateSigningRequest, node *corev1.Node) error {
+	userName := nodeUserNamePrefix + node.Name
+	if csr.Spec.Username != userName {
+		return fmt.Errorf("requesting user %q does not match node %q", csr.Spec.Username, node.Name)
+	}
+	if !sets.NewString(csr.Spec.Groups...).Has(nodesGroup) {
+		return fmt.Errorf("requesting user is not in group %q", nodesGroup)
+	}
+	for _, usage := range csr.Spec.Usages {
+		if !allowedKubeletServingUsages.Has(string(usage)) {
+			return fmt.Errorf("usage %q is not allowed for kubelet serving certificates", usage)
+		}
+	}
+
+	block, _ := pem.Decode(csr.Spec.Request)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return fmt.Errorf("request does not contain a PEM encoded certificate request")
+	}
+	x509cr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("could not parse certificate request: %v", err)
+	}
+
+	if x509cr.Subject.CommonName != userName {
+		return fmt.Errorf("common name %q does not match %q", x509cr.Subject.CommonName, userName)
+	}
+	if len(x509cr.Subject.Organization) != 1 || x509cr.Subject.Organization[0] != nodesGroup {
+		return fmt.Errorf("organization must only contain %q", nodesGroup)
+	}
+	if len(x509cr.EmailAddresses) > 0 {
+		return fmt.Errorf("email addresses are not allowed")
+	}
+	if len(x509cr.DNSNames) == 0 && len(x509cr.IPAddresses) == 0 {
+		return fmt.Errorf("at least one DNS name or IP address is required")
+	}
+
+	var (
+		nodeDNSNames    = sets.NewString()
+		nodeIPAddresses = sets.NewString()
+	)
+	for _, address := range node.Status.Addresses {
+		switch address.Type {
+		case corev1.NodeHostName, corev1.NodeInternalDNS, corev1.NodeExternalDNS:
+			nodeDNSNames.Insert(address.Address)
+		case corev1.NodeInternalIP, corev1.NodeExternalIP:
+			if ip := net.ParseIP(address.Address); ip != nil {
+				nodeIPAddresses.Insert(ip.String())
+			}
+		}
+	}
+
+	for _, dnsName := range x509cr.DNSNames {
+		if !nodeDNSNames.Has(dnsName) {
+			return fmt.Errorf("DNS name %q is not an address of node %q", dnsName, node.Name)
+		}
+	}
+	for _, ip := range x509cr.IPAddresses {
+		if !nodeIPAddresses.Has(ip.String()) {
+			return fmt.Errorf("IP address %q is not an address of node %q", ip.String(), node.Name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"net"
+
+	"github.com/gardener/gardener/pkg/operation/botanist"
+
+	certificatesv1beta1 "k8s.io/api/certificates/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("kubelet serving CSRs", func() {
+	var (
+		node *corev1.Node
+		csr  *certificatesv1beta1.CertificateSigningRequest
+
+		newCertificateRequest = func(commonName string, organization []string, dnsNames []string, ips []net.IP) []byte {
+			key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			template := &x509.CertificateRequest{
+				Subject: pkix.Name{
+					CommonName:   commonName,
+					Organization: organization,
+				},
+				DNSNames:    dnsNames,
+				IPAddresses: ips,
+			}
+			der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+			Expect(err).NotTo(HaveOccurred())
+
+			return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})
+		}
+	)
+
+	BeforeEach(func() {
+		node = &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+			Status: corev1.NodeStatus{
+				Addresses: []corev1.NodeAddress{
+					{Type: corev1.NodeHostName, Address: "node-1"},
+					{Type: corev1.NodeInternalDNS, Address: "node-1.internal"},
+					{Type: corev1.NodeInternalIP, Address: "10.250.0.5"},
+				},
+			},
+		}
+		csr = &certificatesv1beta1.CertificateSigningRequest{
+			Spec: certificatesv1beta1.CertificateSigningRequestSpec{
+				Username: "system:node:node-1",
+				Groups:   []string{"system:nodes", "system:authenticated"},
+				Usages: []certificatesv1beta1.KeyUsage{
+					certificatesv1beta1.UsageDigitalSignature,
+					certificatesv1beta1.UsageKeyEncipherment,
+					certificatesv1beta1.UsageServerAuth,
+				},
+				Request: newCertificateRequest("system:node:node-1", []string{"system:nodes"}, []string{"node-1", "node-1.internal"}, []net.IP{net.ParseIP("10.250.0.5")}),
+			},
+		}
+	})
+
+	It("should accept a valid request", func() {
+		Expect(botanist.ValidateKubeletServingCSR(csr, node)).To(Succeed())
+	})
+
+	It("should reject requests of other users", func() {
+		csr.Spec.Username = "system:node:node-2"
+
+		Expect(botanist.ValidateKubeletServingCSR(csr, node)).NotTo(Succeed())
+	})
+
+	It("should reject requests of users not in the nodes group", func() {
+		csr.Spec.Groups = []string{"system:authenticated"}
+
+		Expect(botanist.ValidateKubeletServingCSR(csr, node)).NotTo(Succeed())
+	})
+
+	It("should reject requests with client auth usage", func() {
+		csr.Spec.Usages = append(csr.Spec.Usages, certificatesv1beta1.UsageClientAuth)
+
+		Expect(botanist.ValidateKubeletServingCSR(csr, node)).NotTo(Succeed())
+	})
+
+	It("should reject requests with a wrong common name", func() {
+		csr.Spec.Request = newCertificateRequest("system:node:node-2", []string{"system:nodes"}, []string{"node-1"}, nil)
+
+		Expect(botanist.ValidateKubeletServingCSR(csr, node)).NotTo(Succeed())
+	})
+
+	It("should reject requests for DNS names which do not belong to the node", func() {
+		csr.Spec.Request = newCertificateRequest("system:node:node-1", []string{"system:nodes"}, []string{"node-1", "kubernetes.default"}, nil)
+
+		Expect(botanist.ValidateKubeletServingCSR(csr, node)).NotTo(Succeed())
+	})
+
+	It("should reject requests for IP addresses which do not belong to the node", func() {
+		csr.Spec.Request = newCertificateRequest("system:node:node-1", []string{"system:nodes"}, nil, []net.IP{net.ParseIP("10.250.0.6")})
+
+		Expect(botanist.ValidateKubeletServingCSR(csr, node)).NotTo(Succeed())
+	})
+
+	It("should reject requests without subject alternative names", func() {
+		csr.Spec.Request = newCertificateRequest("system:node:node-1", []string{"system:nodes"}, nil, nil)
+
+		Expect(botanist.ValidateKubeletServingCSR(csr, node)).NotTo(Succeed())
+	})
+})
@@ -0,0 +1,165 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	controllermanagerfeatures "github.com/gardener/gardener/pkg/controllermanager/features"
+	"github.com/gardener/gardener/pkg/features"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// dashboardOIDC returns the OIDC configuration protecting the dashboards of the Shoots on the Seed, if any.
+func (b *Botanist) dashboardOIDC() *gardenv1beta1.SeedDashboardOIDC {
+	if settings := b.Seed.Info.Spec.Settings; settings != nil && settings.DashboardAuthentication != nil {
+		return settings.DashboardAuthentication.OIDC
+	}
+	return nil
+}
+
+// dashboardIngressValues returns the chart values for the ingress of the dashboard of the given component. The
+// ingress is either protected by the given basic authentication credentials or by the dashboard OIDC proxy.
+func (b *Botanist) dashboardIngressValues(component, host, basicAuth string) map[string]interface{} {
+	values := utils.MergeMaps(map[string]interface{}{
+		"basicAuthSecret": basicAuth,
+		"host":            host,
+	}, b.Seed.GetIngressTLSValues(component))
+
+	if b.dashboardOIDC() != nil {
+		values["authURL"] = fmt.Sprintf("http://%s.%s.svc.cluster.local:4180/oauth2/auth", common.DashboardOIDCProxyName, b.Shoot.SeedNamespace)
+	}
+	return values
+}
+
+// dashboardIngresses returns the hosts of the dashboards of the Shoot and the names of the secrets containing
+// their TLS certificates.
+func (b *Botanist) dashboardIngresses() []interface{} {
+	components := map[string]string{}
+	if b.Shoot.WantsMonitoring {
+		components["alertmanager"] = b.Seed.GetIngressFQDN("a", b.Shoot.Info.Name, b.Garden.Project.Name)
+		components["grafana"] = b.Seed.GetIngressFQDN("g", b.Shoot.Info.Name, b.Garden.Project.Name)
+		components["prometheus"] = b.ComputePrometheusIngressFQDN()
+	}
+	if controllermanagerfeatures.FeatureGate.Enabled(features.Logging) {
+		components["kibana"] = b.Seed.GetIngressFQDN("k", b.Shoot.Info.Name, b.Garden.Project.Name)
+	}
+
+	var ingresses []interface{}
+	for _, component := range sets.StringKeySet(components).List() {
+		ingresses = append(ingresses, map[string]interface{}{
+			"host":          components[component],
+			"tlsSecretName": b.Seed.GetIngressTLSValues(component)["tlsSecretName"],
+		})
+	}
+	return ingresses
+}
+
+// ProjectMembers returns the names of all users which are owner or member of the given project together with their
+// role, sorted by name. Other kinds of subjects (groups, service accounts) are ignored as they cannot be mapped to
+// OIDC identities.
+func ProjectMembers(project *gardenv1beta1.Project) []interface{} {
+	roles := map[string]string{}
+	for _, member := range project.Spec.Members {
+		if member.Kind == rbacv1.UserKind {
+			roles[member.Name] = "member"
+		}
+	}
+	if owner := project.Spec.Owner; owner != nil && owner.Kind == rbacv1.UserKind {
+		roles[owner.Name] = "owner"
+	}
+
+	var members []interface{}
+	for _, email := range sets.StringKeySet(roles).List() {
+		members = append(members, map[string]interface{}{
+			"email": email,
+			"role":  roles[email],
+		})
+	}
+	return members
+}
+
+// DeployDashboardOIDCProxy deploys an OpenID Connect proxy into the Shoot namespace in the Seed which protects the
+// monitoring and logging dashboards of the Shoot if configured in the Seed settings. Only the owner and the members
+// of the Shoot's project as well as the monitoring basic authentication credentials are granted access. If OIDC is
+// not configured, the proxy is deleted.
+func (b *Botanist) DeployDashboardOIDCProxy(ctx context.Context) error {
+	oidc := b.dashboardOIDC()
+	if oidc == nil {
+		return b.DeleteDashboardOIDCProxy(ctx)
+	}
+
+	clientSecret, err := b.K8sGardenClient.GetSecret(oidc.SecretRef.Namespace, oidc.SecretRef.Name)
+	if err != nil {
+		return fmt.Errorf("could not read OIDC client secret of seed %s: %v", b.Seed.Info.Name, err)
+	}
+
+	var (
+		credentials = b.Secrets["monitoring-ingress-credentials"]
+		cookie      = b.Secrets[common.DashboardOIDCProxyCookieSecretName]
+		values      = map[string]interface{}{
+			"replicas": b.Shoot.GetReplicas(1),
+			"oidc": map[string]interface{}{
+				"issuerURL":    oidc.IssuerURL,
+				"clientID":     oidc.ClientID,
+				"clientSecret": string(clientSecret.Data["clientSecret"]),
+			},
+			"cookieSecret":    string(cookie.Data[secrets.DataKeyPassword]),
+			"basicAuthSecret": utils.CreateSHA1Secret(credentials.Data[secrets.DataKeyUserName], credentials.Data[secrets.DataKeyPassword]),
+			"shoot": map[string]interface{}{
+				"name":    b.Shoot.Info.Name,
+				"project": b.Garden.Project.Name,
+			},
+			"members":   ProjectMembers(b.Garden.Project),
+			"ingresses": b.dashboardIngresses(),
+		}
+	)
+
+	values, err = b.InjectSeedSeedImages(values, common.OAuth2ProxyImageName)
+	if err != nil {
+		return err
+	}
+	return b.ChartApplierSeed.ApplyChart(ctx, filepath.Join(chartPathControlPlane, common.DashboardOIDCProxyName), b.Shoot.SeedNamespace, common.DashboardOIDCProxyName, nil, values)
+}
+
+// DeleteDashboardOIDCProxy deletes the resources deployed by DeployDashboardOIDCProxy.
+func (b *Botanist) DeleteDashboardOIDCProxy(ctx context.Context) error {
+	objectMeta := metav1.ObjectMeta{Namespace: b.Shoot.SeedNamespace, Name: common.DashboardOIDCProxyName}
+	for _, obj := range []runtime.Object{
+		&extensionsv1beta1.Ingress{ObjectMeta: objectMeta},
+		&corev1.Service{ObjectMeta: objectMeta},
+		&appsv1.Deployment{ObjectMeta: objectMeta},
+		&corev1.Secret{ObjectMeta: objectMeta},
+	} {
+		if err := b.K8sSeedClient.Client().Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
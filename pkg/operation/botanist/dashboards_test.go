@@ -0,0 +1,87 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"path/filepath"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/operation/botanist"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/helm/pkg/chartutil"
+	"k8s.io/helm/pkg/engine"
+	"sigs.k8s.io/yaml"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("dashboards", func() {
+	Describe("#ProjectMembers", func() {
+		It("should return the owner and the members of kind user", func() {
+			project := &gardenv1beta1.Project{
+				Spec: gardenv1beta1.ProjectSpec{
+					Owner: &rbacv1.Subject{Kind: rbacv1.UserKind, Name: "owner@example.com"},
+					Members: []rbacv1.Subject{
+						{Kind: rbacv1.UserKind, Name: "member@example.com"},
+						{Kind: rbacv1.UserKind, Name: "owner@example.com"},
+						{Kind: rbacv1.GroupKind, Name: "group"},
+						{Kind: rbacv1.ServiceAccountKind, Name: "robot", Namespace: "garden-dev"},
+					},
+				},
+			}
+
+			Expect(botanist.ProjectMembers(project)).To(Equal([]interface{}{
+				map[string]interface{}{"email": "member@example.com", "role": "member"},
+				map[string]interface{}{"email": "owner@example.com", "role": "owner"},
+			}))
+		})
+
+		It("should return nothing for projects without users", func() {
+			Expect(botanist.ProjectMembers(&gardenv1beta1.Project{})).To(BeEmpty())
+		})
+	})
+
+	Describe("dashboard-oidc-proxy chart", func() {
+		It("should render the authenticated emails of the project members", func() {
+			capabilities := &chartutil.Capabilities{
+				KubeVersion: &version.Info{GitVersion: "v1.14.1"},
+				APIVersions: chartutil.NewVersionSet("v1", "apps/v1", "extensions/v1beta1", "networking.k8s.io/v1beta1"),
+			}
+			values := map[string]interface{}{
+				"shoot": map[string]interface{}{"name": "bar", "project": "foo"},
+				"members": []interface{}{
+					map[string]interface{}{"email": "member@example.com", "role": "member"},
+					map[string]interface{}{"email": "owner@example.com", "role": "owner"},
+				},
+			}
+
+			renderedChart, err := chartrenderer.New(engine.New(), capabilities).Render(filepath.Join("..", "..", "..", "charts", "seed-controlplane", "charts", "dashboard-oidc-proxy"), "dashboard-oidc-proxy", "shoot--foo--bar", values)
+			Expect(err).NotTo(HaveOccurred())
+
+			secret := &corev1.Secret{}
+			Expect(yaml.Unmarshal([]byte(renderedChart.Files()["dashboard-oidc-proxy/templates/secret.yaml"]), secret)).To(Succeed())
+			Expect(string(secret.Data["authenticated-emails"])).To(Equal(`# Users which are granted access to the dashboards of Shoot bar in project foo.
+# member
+member@example.com
+# owner
+owner@example.com`))
+		})
+	})
+})
@@ -28,6 +28,7 @@ import (
 	dnsv1alpha1 "github.com/gardener/external-dns-management/pkg/apis/dns/v1alpha1"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 
@@ -43,12 +44,29 @@ const (
 	DNSPurposeExternal = "external"
 )
 
+// ManagesShootDNS returns true if the DNS records of the Shoot are managed by Gardener. Seeds may disable the
+// management of DNS records for their Shoots, however, Shoots which already have an internal domain DNS record keep
+// their records as the kubeconfigs and certificates handed out so far refer to them.
+func (b *Botanist) ManagesShootDNS(ctx context.Context) (bool, error) {
+	if b.Seed.ShootDNSEnabled() {
+		return true, nil
+	}
+
+	if err := b.K8sSeedClient.Client().Get(ctx, kutil.Key(b.Shoot.SeedNamespace, DNSPurposeInternal), &dnsv1alpha1.DNSEntry{}); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
 // DeployInternalDomainDNSRecord deploys the DNS record for the internal cluster domain.
 func (b *Botanist) DeployInternalDomainDNSRecord(ctx context.Context) error {
 	if err := b.deployDNSProvider(ctx, DNSPurposeInternal, b.Garden.InternalDomain.Provider, b.Garden.InternalDomain.SecretData, b.Shoot.InternalClusterDomain); err != nil {
 		return err
 	}
-	if err := b.deployDNSEntry(ctx, DNSPurposeInternal, b.Shoot.InternalClusterDomain, b.APIServerAddress); err != nil {
+	if err := b.deployDNSEntry(ctx, DNSPurposeInternal, b.Shoot.InternalClusterDomain, b.APIServerAddress, b.Shoot.Info.Spec.DNS.TTL); err != nil {
 		return err
 	}
 	return b.deleteLegacyTerraformDNSResources(ctx, common.TerraformerPurposeInternalDNSDeprecated)
@@ -71,7 +89,7 @@ func (b *Botanist) DeployExternalDomainDNSRecord(ctx context.Context) error {
 	if err := b.deployDNSProvider(ctx, DNSPurposeExternal, b.Shoot.ExternalDomain.Provider, b.Shoot.ExternalDomain.SecretData, *b.Shoot.Info.Spec.DNS.Domain); err != nil {
 		return err
 	}
-	if err := b.deployDNSEntry(ctx, DNSPurposeExternal, *b.Shoot.ExternalClusterDomain, b.Shoot.InternalClusterDomain); err != nil {
+	if err := b.deployDNSEntry(ctx, DNSPurposeExternal, *b.Shoot.ExternalClusterDomain, b.Shoot.InternalClusterDomain, b.Shoot.Info.Spec.DNS.TTL); err != nil {
 		return err
 	}
 	return b.deleteLegacyTerraformDNSResources(ctx, common.TerraformerPurposeExternalDNSDeprecated)
@@ -143,12 +161,15 @@ func (b *Botanist) deleteDNSProvider(ctx context.Context, name string) error {
 	return kutil.WaitUntilResourceDeleted(ctx, b.K8sSeedClient.Client(), &dnsv1alpha1.DNSProvider{ObjectMeta: metav1.ObjectMeta{Namespace: b.Shoot.SeedNamespace, Name: name}}, 5*time.Second)
 }
 
-func (b *Botanist) deployDNSEntry(ctx context.Context, name, dnsName, target string) error {
+func (b *Botanist) deployDNSEntry(ctx context.Context, name, dnsName, target string, ttl *int64) error {
 	values := map[string]interface{}{
 		"name":    name,
 		"dnsName": dnsName,
 		"targets": []string{target},
 	}
+	if ttl != nil {
+		values["ttl"] = *ttl
+	}
 
 	if err := b.ChartApplierSeed.ApplyChart(ctx, filepath.Join(dnsChartPath, "entry"), b.Shoot.SeedNamespace, name, nil, values); err != nil {
 		return err
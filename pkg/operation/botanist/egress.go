@@ -0,0 +1,83 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/operation/terraformer"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// UpdateShootEgressIPs reads the static egress IP addresses of the Shoot worker nodes from the Terraform state of the
+// infrastructure and exports them into the Shoot status, so that users can register them in external firewalls.
+// The list is cleared if the infrastructure does not provide static egress IP addresses.
+func (b *Botanist) UpdateShootEgressIPs() error {
+	tf, err := b.NewShootTerraformer(common.TerraformerPurposeInfra)
+	if err != nil {
+		return err
+	}
+
+	var egressIPs []string
+	stateVariables, err := tf.GetStateOutputVariables(common.TerraformerOutputKeyEgressIPs)
+	switch {
+	case err == nil:
+		egressIPs = ParseEgressIPs(stateVariables[common.TerraformerOutputKeyEgressIPs])
+	case terraformer.IsVariablesNotFoundError(err), apierrors.IsNotFound(err):
+	default:
+		return err
+	}
+
+	if reflect.DeepEqual(b.Shoot.Info.Status.EgressIPs, egressIPs) {
+		return nil
+	}
+
+	newShoot := b.Shoot.Info.DeepCopy()
+	if err := kutil.TryUpdateStatus(context.TODO(), kutil.DefaultBackoff, b.K8sGardenClient.Client(), newShoot, func() error {
+		newShoot.Status.EgressIPs = egressIPs
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	b.Shoot.Info = newShoot
+	return nil
+}
+
+// ParseEgressIPs parses the given comma-separated list of egress IP addresses as written by the Terraform
+// infrastructure configuration. Empty and invalid entries are dropped, duplicates are only returned once.
+func ParseEgressIPs(value string) []string {
+	var (
+		egressIPs []string
+		seen      = map[string]bool{}
+	)
+
+	for _, ip := range strings.Split(value, ",") {
+		ip = strings.TrimSpace(ip)
+		if net.ParseIP(ip) == nil || seen[ip] {
+			continue
+		}
+		seen[ip] = true
+		egressIPs = append(egressIPs, ip)
+	}
+
+	return egressIPs
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"github.com/gardener/gardener/pkg/operation/botanist"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("egress", func() {
+	Describe("#ParseEgressIPs", func() {
+		It("should return nothing for an empty value", func() {
+			Expect(botanist.ParseEgressIPs("")).To(BeEmpty())
+		})
+
+		It("should return the egress IPs in their original order", func() {
+			Expect(botanist.ParseEgressIPs("52.1.2.3, 34.5.6.7,10.0.0.1")).To(Equal([]string{"52.1.2.3", "34.5.6.7", "10.0.0.1"}))
+		})
+
+		It("should drop empty, invalid and duplicate entries", func() {
+			Expect(botanist.ParseEgressIPs(",52.1.2.3,,foo,52.1.2.3,")).To(Equal([]string{"52.1.2.3"}))
+		})
+	})
+})
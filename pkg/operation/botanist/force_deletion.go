@@ -0,0 +1,137 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// orphanedResourcesTerraformerPurposes are the purposes of the Terraform configurations whose resources are recorded
+// when a Shoot is force-deleted.
+var orphanedResourcesTerraformerPurposes = []string{
+	common.TerraformerPurposeInfra,
+	common.TerraformerPurposeKube2IAM,
+}
+
+// OrphanedResourcesConfigMapName returns the name of the config map in the Garden cluster which contains the
+// resources that have been left behind by the force deletion of the Shoot with the given name.
+func OrphanedResourcesConfigMapName(shootName string) string {
+	return fmt.Sprintf("%s.%s", shootName, common.ShootOrphanedResourcesSuffix)
+}
+
+// RecordOrphanedResources collects the IDs of all infrastructure resources known to the Terraform states and the
+// provider IDs of all machines of the Shoot. As they cannot be deleted during a force deletion, they are stored in a
+// config map in the namespace of the Shoot in the Garden cluster so that operators can clean them up manually.
+func (b *Botanist) RecordOrphanedResources(ctx context.Context) error {
+	data := make(map[string]string)
+
+	for _, purpose := range orphanedResourcesTerraformerPurposes {
+		tf, err := b.NewShootTerraformer(purpose)
+		if err != nil {
+			return err
+		}
+		ids, err := tf.GetStateResourceIDs()
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if ids.Len() > 0 {
+			data[purpose] = strings.Join(ids.List(), "\n")
+		}
+	}
+
+	machineList := &machinev1alpha1.MachineList{}
+	if err := b.K8sSeedClient.Client().List(ctx, &client.ListOptions{Namespace: b.Shoot.SeedNamespace}, machineList); err != nil {
+		return err
+	}
+	var machines []string
+	for _, machine := range machineList.Items {
+		id := machine.Spec.ProviderID
+		if len(id) == 0 {
+			id = machine.Name
+		}
+		machines = append(machines, id)
+	}
+	if len(machines) > 0 {
+		sort.Strings(machines)
+		data["machines"] = strings.Join(machines, "\n")
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      OrphanedResourcesConfigMapName(b.Shoot.Info.Name),
+			Namespace: b.Shoot.Info.Namespace,
+		},
+	}
+	return kutil.CreateOrUpdate(ctx, b.K8sGardenClient.Client(), configMap, func() error {
+		configMap.Data = data
+		return nil
+	})
+}
+
+// FinalizeMachineResources deletes the machine-controller-manager and removes the finalizers of all machine resources,
+// machine classes and machine class secrets in the Shoot namespace in the Seed cluster. It is used during the force
+// deletion of a Shoot whose machines cannot be deleted anymore because the cloud provider account is not accessible.
+func (b *Botanist) FinalizeMachineResources(ctx context.Context) error {
+	if err := b.K8sSeedClient.DeleteDeployment(b.Shoot.SeedNamespace, common.MachineControllerManagerDeploymentName); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	for _, list := range []runtime.Object{
+		&machinev1alpha1.MachineDeploymentList{},
+		&machinev1alpha1.MachineSetList{},
+		&machinev1alpha1.MachineList{},
+		&machinev1alpha1.AWSMachineClassList{},
+		&machinev1alpha1.AzureMachineClassList{},
+		&machinev1alpha1.GCPMachineClassList{},
+		&machinev1alpha1.OpenStackMachineClassList{},
+		&machinev1alpha1.AlicloudMachineClassList{},
+		&machinev1alpha1.PacketMachineClassList{},
+	} {
+		if err := b.K8sSeedClient.Client().List(ctx, &client.ListOptions{Namespace: b.Shoot.SeedNamespace}, list); err != nil {
+			return err
+		}
+		if err := FinalizeAll(ctx, b.K8sSeedClient.Client(), list); err != nil {
+			return err
+		}
+	}
+
+	secretList := &corev1.SecretList{}
+	if err := b.K8sSeedClient.Client().List(ctx, &client.ListOptions{
+		Namespace:     b.Shoot.SeedNamespace,
+		LabelSelector: labels.SelectorFromSet(labels.Set{gardencorev1alpha1.GardenPurpose: gardencorev1alpha1.GardenPurposeMachineClass}),
+	}, secretList); err != nil {
+		return err
+	}
+	return FinalizeAll(ctx, b.K8sSeedClient.Client(), secretList)
+}
@@ -38,6 +38,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
@@ -138,6 +139,10 @@ func (b *HealthChecker) checkNodes(condition gardencorev1alpha1.Condition, objec
 
 func (b *HealthChecker) checkMachineDeployments(condition gardencorev1alpha1.Condition, objects []*machinev1alpha1.MachineDeployment) *gardencorev1alpha1.Condition {
 	for _, object := range objects {
+		if reason, ok := object.Annotations[common.MachineDeploymentRolloutPaused]; ok && object.Spec.Paused {
+			c := b.FailedCondition(condition, "RolloutPaused", fmt.Sprintf("Rollout of machine deployment %s is paused: %s", object.Name, reason))
+			return &c
+		}
 		if err := health.CheckMachineDeployment(object); err != nil {
 			c := b.FailedCondition(condition, "MachineDeploymentUnhealthy", fmt.Sprintf("Machine deployment %s is unhealthy: %v", object.Name, err))
 			return &c
@@ -580,8 +585,10 @@ func (b *Botanist) checkControlPlane(
 	if exitCondition, err := checker.CheckControlPlane(b.Shoot.Info, b.Shoot.SeedNamespace, b.Seed.CloudProvider, condition, seedDeploymentLister, seedStatefulSetLister, machineDeploymentLister); err != nil || exitCondition != nil {
 		return exitCondition, err
 	}
-	if exitCondition, err := checker.CheckMonitoringControlPlane(b.Shoot.SeedNamespace, b.Shoot.WantsAlertmanager, condition, seedDeploymentLister, seedStatefulSetLister); err != nil || exitCondition != nil {
-		return exitCondition, err
+	if b.Shoot.WantsMonitoring {
+		if exitCondition, err := checker.CheckMonitoringControlPlane(b.Shoot.SeedNamespace, b.Shoot.WantsAlertmanager, condition, seedDeploymentLister, seedStatefulSetLister); err != nil || exitCondition != nil {
+			return exitCondition, err
+		}
 	}
 	if controllermanagerfeatures.FeatureGate.Enabled(features.Logging) {
 		if exitCondition, err := checker.CheckLoggingControlPlane(b.Shoot.SeedNamespace, condition, seedDeploymentLister, seedStatefulSetLister); err != nil || exitCondition != nil {
@@ -891,6 +898,9 @@ func (b *Botanist) MonitoringHealthChecks(checker *HealthChecker, inactiveAlerts
 	if b.Shoot.IsHibernated {
 		return shootHibernatedCondition(inactiveAlerts)
 	}
+	if !b.Shoot.WantsMonitoring {
+		return gardencorev1alpha1helper.UpdatedCondition(inactiveAlerts, gardencorev1alpha1.ConditionTrue, "ConditionNotChecked", "Monitoring of the Shoot cluster has been disabled.")
+	}
 	if err := b.InitializeMonitoringClient(); err != nil {
 		message := fmt.Sprintf("Could not initialize Shoot monitoring API client for health check: %+v", err)
 		b.Logger.Error(message)
@@ -898,3 +908,23 @@ func (b *Botanist) MonitoringHealthChecks(checker *HealthChecker, inactiveAlerts
 	}
 	return b.checkAlerts(checker, inactiveAlerts)
 }
+
+// BackupChecks reflects the BucketReady condition of the Shoot's BackupInfrastructure in the given BackupReady
+// condition.
+func (b *Botanist) BackupChecks(backupReady gardencorev1alpha1.Condition) gardencorev1alpha1.Condition {
+	backupInfrastructureName := common.GenerateBackupInfrastructureName(b.Shoot.SeedNamespace, b.Shoot.Info.Status.UID)
+
+	backupInfrastructure, err := b.K8sGardenInformers.BackupInfrastructures().Lister().BackupInfrastructures(b.Shoot.Info.Namespace).Get(backupInfrastructureName)
+	if apierrors.IsNotFound(err) {
+		return gardencorev1alpha1helper.UpdatedCondition(backupReady, gardencorev1alpha1.ConditionUnknown, "BackupInfrastructureNotFound", "The BackupInfrastructure of the Shoot has not been created yet.")
+	}
+	if err != nil {
+		return gardencorev1alpha1helper.UpdatedConditionUnknownError(backupReady, err)
+	}
+
+	bucketReady := gardencorev1alpha1helper.GetCondition(backupInfrastructure.Status.Conditions, gardenv1beta1.BackupInfrastructureBucketReady)
+	if bucketReady == nil {
+		return gardencorev1alpha1helper.UpdatedCondition(backupReady, gardencorev1alpha1.ConditionUnknown, "BucketNotYetProbed", "The backup bucket has not been probed yet.")
+	}
+	return gardencorev1alpha1helper.UpdatedCondition(backupReady, bucketReady.Status, bucketReady.Reason, bucketReady.Message)
+}
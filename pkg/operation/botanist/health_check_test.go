@@ -0,0 +1,105 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("health check", func() {
+	Describe("#BackupChecks", func() {
+		const (
+			namespace     = "garden-dev"
+			seedNamespace = "shoot--dev--test"
+		)
+
+		var (
+			gardenInformerFactory gardeninformers.SharedInformerFactory
+			b                     *botanist.Botanist
+			backupReady           gardencorev1alpha1.Condition
+			backupInfrastructure  *gardenv1beta1.BackupInfrastructure
+		)
+
+		BeforeEach(func() {
+			gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
+			b = &botanist.Botanist{
+				Operation: &operation.Operation{
+					K8sGardenInformers: gardenInformerFactory.Garden().V1beta1(),
+					Shoot: &shoot.Shoot{
+						SeedNamespace: seedNamespace,
+						Info: &gardenv1beta1.Shoot{
+							ObjectMeta: metav1.ObjectMeta{Namespace: namespace},
+							Status:     gardenv1beta1.ShootStatus{UID: "1234"},
+						},
+					},
+				},
+			}
+			backupReady = gardencorev1alpha1.Condition{Type: gardenv1beta1.ShootBackupReady}
+			backupInfrastructure = &gardenv1beta1.BackupInfrastructure{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      common.GenerateBackupInfrastructureName(seedNamespace, "1234"),
+					Namespace: namespace,
+				},
+			}
+		})
+
+		It("should report an unknown condition if the BackupInfrastructure does not exist", func() {
+			Expect(b.BackupChecks(backupReady)).To(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(gardencorev1alpha1.ConditionUnknown),
+				"Reason": Equal("BackupInfrastructureNotFound"),
+			}))
+		})
+
+		It("should report an unknown condition if the bucket has not been probed yet", func() {
+			Expect(gardenInformerFactory.Garden().V1beta1().BackupInfrastructures().Informer().GetStore().Add(backupInfrastructure)).To(Succeed())
+
+			Expect(b.BackupChecks(backupReady)).To(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(gardencorev1alpha1.ConditionUnknown),
+				"Reason": Equal("BucketNotYetProbed"),
+			}))
+		})
+
+		It("should mirror the BucketReady condition of the BackupInfrastructure", func() {
+			backupInfrastructure.Status.Conditions = []gardencorev1alpha1.Condition{
+				{
+					Type:    gardenv1beta1.BackupInfrastructureBucketReady,
+					Status:  gardencorev1alpha1.ConditionFalse,
+					Reason:  "BucketProbeFailed",
+					Message: "access denied",
+				},
+			}
+			Expect(gardenInformerFactory.Garden().V1beta1().BackupInfrastructures().Informer().GetStore().Add(backupInfrastructure)).To(Succeed())
+
+			Expect(b.BackupChecks(backupReady)).To(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(gardenv1beta1.ShootBackupReady),
+				"Status":  Equal(gardencorev1alpha1.ConditionFalse),
+				"Reason":  Equal("BucketProbeFailed"),
+				"Message": Equal("access denied"),
+			}))
+		})
+	})
+})
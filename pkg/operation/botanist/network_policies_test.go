@@ -0,0 +1,106 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"path/filepath"
+
+	"github.com/gardener/gardener/pkg/chartrenderer"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/helm/pkg/chartutil"
+	"k8s.io/helm/pkg/engine"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("network policies", func() {
+	var (
+		namespace       = "shoot--foo--bar"
+		networkPolicies map[string]*networkingv1.NetworkPolicy
+
+		podSelector = func(labels map[string]string) networkingv1.NetworkPolicyPeer {
+			return networkingv1.NetworkPolicyPeer{PodSelector: &metav1.LabelSelector{MatchLabels: labels}}
+		}
+		namespaceSelector = func(labels map[string]string) networkingv1.NetworkPolicyPeer {
+			return networkingv1.NetworkPolicyPeer{NamespaceSelector: &metav1.LabelSelector{MatchLabels: labels}}
+		}
+	)
+
+	BeforeEach(func() {
+		capabilities := &chartutil.Capabilities{
+			KubeVersion: &version.Info{GitVersion: "v1.14.1"},
+			APIVersions: chartutil.NewVersionSet("v1", "networking.k8s.io/v1/NetworkPolicy"),
+		}
+		renderedChart, err := chartrenderer.New(engine.New(), capabilities).Render(filepath.Join("..", "..", "..", "charts", "seed-controlplane", "charts", "network-policies"), "network-policies", namespace, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		networkPolicies = map[string]*networkingv1.NetworkPolicy{}
+		for _, content := range renderedChart.Files() {
+			networkPolicy := &networkingv1.NetworkPolicy{}
+			Expect(yaml.Unmarshal([]byte(content), networkPolicy)).To(Succeed())
+			Expect(networkPolicy.Namespace).To(Equal(namespace))
+			networkPolicies[networkPolicy.Name] = networkPolicy
+		}
+	})
+
+	It("should deny all ingress traffic by default", func() {
+		Expect(networkPolicies).To(HaveKey("deny-all"))
+		Expect(networkPolicies["deny-all"].Spec.PodSelector).To(Equal(metav1.LabelSelector{}))
+		Expect(networkPolicies["deny-all"].Spec.Ingress).To(BeEmpty())
+	})
+
+	It("should only allow the kube-apiserver and Prometheus of the Shoot to reach etcd", func() {
+		Expect(networkPolicies).To(HaveKey("allow-etcd"))
+
+		var peers []networkingv1.NetworkPolicyPeer
+		for _, rule := range networkPolicies["allow-etcd"].Spec.Ingress {
+			peers = append(peers, rule.From...)
+		}
+		Expect(peers).To(ConsistOf(
+			podSelector(map[string]string{"app": "kubernetes", "role": "apiserver"}),
+			podSelector(map[string]string{"app": "prometheus", "role": "monitoring"}),
+		))
+	})
+
+	It("should only allow the garden namespace of the Seed to reach the control plane", func() {
+		Expect(networkPolicies).To(HaveKey("allow-from-seed"))
+		Expect(networkPolicies["allow-from-seed"].Spec.Ingress).To(ConsistOf(networkingv1.NetworkPolicyIngressRule{
+			From: []networkingv1.NetworkPolicyPeer{namespaceSelector(map[string]string{"role": "garden"})},
+		}))
+	})
+
+	It("should only allow the ingress controller namespace of the Seed to reach the monitoring and logging UIs", func() {
+		Expect(networkPolicies).To(HaveKey("allow-ingress-endpoints"))
+		Expect(networkPolicies["allow-ingress-endpoints"].Spec.Ingress).To(ConsistOf(networkingv1.NetworkPolicyIngressRule{
+			From: []networkingv1.NetworkPolicyPeer{namespaceSelector(map[string]string{"role": "kube-system"})},
+		}))
+	})
+
+	It("should not allow traffic from all namespaces", func() {
+		for name, networkPolicy := range networkPolicies {
+			for _, rule := range networkPolicy.Spec.Ingress {
+				for _, peer := range rule.From {
+					if peer.NamespaceSelector != nil {
+						Expect(*peer.NamespaceSelector).NotTo(Equal(metav1.LabelSelector{}), "network policy %s selects all namespaces", name)
+					}
+				}
+			}
+		}
+	})
+})
@@ -16,6 +16,7 @@ package botanist
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net"
@@ -24,6 +25,7 @@ import (
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	"github.com/gardener/gardener/pkg/apis/garden"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	controllermanagerfeatures "github.com/gardener/gardener/pkg/controllermanager/features"
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/operation/common"
@@ -33,6 +35,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/authentication/user"
 )
@@ -105,6 +108,14 @@ func (b *Botanist) generateWantedSecrets(basicAuthAPIServer *secrets.BasicAuth,
 		apiServerCertDNSNames = append(apiServerCertDNSNames, *(b.Shoot.Info.Spec.DNS.Domain), *(b.Shoot.ExternalClusterDomain))
 	}
 
+	if b.Shoot.DisableDNS && len(b.Shoot.LoadBalancerIngress) > 0 {
+		if ip := net.ParseIP(b.Shoot.LoadBalancerIngress); ip != nil {
+			apiServerIPAddresses = append(apiServerIPAddresses, ip)
+		} else {
+			apiServerCertDNSNames = append(apiServerCertDNSNames, b.Shoot.LoadBalancerIngress)
+		}
+	}
+
 	secretList := []secrets.ConfigInterface{
 		// Secret definition for kube-apiserver
 		&secrets.ControlPlaneSecretConfig{
@@ -136,6 +147,19 @@ func (b *Botanist) generateWantedSecrets(basicAuthAPIServer *secrets.BasicAuth,
 			},
 		},
 
+		// Secret definition for the gardener-shoot-webhook which runs as sidecar of the kube-apiserver
+		&secrets.CertificateSecretConfig{
+			Name: "gardener-shoot-webhook",
+
+			CommonName:   "gardener-shoot-webhook",
+			Organization: nil,
+			DNSNames:     []string{"localhost"},
+			IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+
+			CertType:  secrets.ServerCert,
+			SigningCA: certificateAuthorities[gardencorev1alpha1.SecretNameCACluster],
+		},
+
 		// Secret definition for kube-aggregator
 		&secrets.ControlPlaneSecretConfig{
 			CertificateSecretConfig: &secrets.CertificateSecretConfig{
@@ -540,6 +564,19 @@ func (b *Botanist) generateWantedSecrets(basicAuthAPIServer *secrets.BasicAuth,
 			PasswordLength: 32,
 		},
 
+		// Secret definition for the static tokens of the kube-apiserver
+		&secrets.StaticTokenSecretConfig{
+			Name: common.KubeAPIServerStaticTokenSecretName,
+
+			Tokens: []secrets.TokenConfig{
+				{
+					Username: common.KubeAPIServerHealthCheckUsername,
+					UserID:   common.KubeAPIServerHealthCheckUsername,
+				},
+			},
+			TokenLength: 32,
+		},
+
 		// Secret definition for ssh-keypair
 		&secrets.RSASecretConfig{
 			Name:       "ssh-keypair",
@@ -689,6 +726,19 @@ func (b *Botanist) generateWantedSecrets(basicAuthAPIServer *secrets.BasicAuth,
 		)
 	}
 
+	if b.dashboardOIDC() != nil {
+		secretList = append(secretList,
+			// Secret definition for the cookie secret of the dashboard OIDC proxy
+			&secrets.BasicAuthSecretConfig{
+				Name:   common.DashboardOIDCProxyCookieSecretName,
+				Format: secrets.BasicAuthFormatNormal,
+
+				Username:       "cookie",
+				PasswordLength: 32,
+			},
+		)
+	}
+
 	certManagementEnabled := controllermanagerfeatures.FeatureGate.Enabled(features.CertificateManagement)
 	if certManagementEnabled {
 		secretList = append(secretList,
@@ -731,11 +781,18 @@ func (b *Botanist) DeploySecrets() error {
 		return err
 	}
 
-	basicAuthAPIServer, err := b.generateBasicAuthAPIServer(existingSecretsMap)
-	if err != nil {
+	if err := b.deleteBasicAuthSecrets(existingSecretsMap); err != nil {
 		return err
 	}
 
+	var basicAuthAPIServer *secrets.BasicAuth
+	if helper.ShootWantsBasicAuthentication(b.Shoot.Info) {
+		basicAuthAPIServer, err = b.generateBasicAuthAPIServer(existingSecretsMap)
+		if err != nil {
+			return err
+		}
+	}
+
 	if err := b.deployOpenVPNTLSAuthSecret(existingSecretsMap); err != nil {
 		return err
 	}
@@ -749,6 +806,12 @@ func (b *Botanist) DeploySecrets() error {
 		return err
 	}
 
+	if b.Seed.UsesWildcardIngressCertificate() {
+		if err := b.deployIngressWildcardSecret(); err != nil {
+			return err
+		}
+	}
+
 	b.mutex.Lock()
 	defer b.mutex.Unlock()
 
@@ -777,7 +840,7 @@ func (b *Botanist) DeployCloudProviderSecret() error {
 		}
 	)
 
-	if _, err := b.K8sSeedClient.CreateSecretObject(secret, true); err != nil {
+	if _, err := b.SeedNamespaceSecrets.CreateOrUpdate(context.TODO(), secret); err != nil {
 		return err
 	}
 
@@ -804,18 +867,7 @@ func (b *Botanist) DeleteGardenSecrets() error {
 }
 
 func (b *Botanist) fetchExistingSecrets() (map[string]*corev1.Secret, error) {
-	secretList, err := b.K8sSeedClient.ListSecrets(b.Shoot.SeedNamespace, metav1.ListOptions{})
-	if err != nil {
-		return nil, err
-	}
-
-	existingSecretsMap := make(map[string]*corev1.Secret, len(secretList.Items))
-	for _, secret := range secretList.Items {
-		secretObj := secret
-		existingSecretsMap[secret.Name] = &secretObj
-	}
-
-	return existingSecretsMap, nil
+	return b.SeedNamespaceSecrets.List(context.TODO(), labels.Everything())
 }
 
 // Delete the etcd server certificate if it has been generated by an old version
@@ -841,7 +893,7 @@ func (b *Botanist) deleteOldETCDServerCertificate(existingSecretsMap map[string]
 	}
 
 	b.Logger.Infof("Will recreate secret %s", certificateETCDServer)
-	if err := b.K8sSeedClient.DeleteSecret(b.Shoot.SeedNamespace, certificateETCDServer); err != nil && !apierrors.IsNotFound(err) {
+	if err := b.SeedNamespaceSecrets.Delete(context.TODO(), certificateETCDServer); err != nil {
 		return err
 	}
 	delete(existingSecretsMap, certificateETCDServer)
@@ -849,8 +901,35 @@ func (b *Botanist) deleteOldETCDServerCertificate(existingSecretsMap map[string]
 	return nil
 }
 
+// deleteBasicAuthSecrets deletes the basic authentication credentials of the kube-apiserver if the Shoot does not
+// want basic authentication anymore. The kubeconfig of the cluster admin is deleted whenever it does not match the
+// basic authentication setting so that it gets regenerated.
+func (b *Botanist) deleteBasicAuthSecrets(existingSecretsMap map[string]*corev1.Secret) error {
+	wantsBasicAuth := helper.ShootWantsBasicAuthentication(b.Shoot.Info)
+
+	if kubecfg, ok := existingSecretsMap["kubecfg"]; ok {
+		if _, hasBasicAuth := kubecfg.Data[secrets.DataKeyPassword]; hasBasicAuth != wantsBasicAuth {
+			b.Logger.Info("Will recreate secret kubecfg")
+			if err := b.SeedNamespaceSecrets.Delete(context.TODO(), "kubecfg"); err != nil {
+				return err
+			}
+			delete(existingSecretsMap, "kubecfg")
+		}
+	}
+
+	if _, ok := existingSecretsMap[common.KubeAPIServerBasicAuthSecretName]; ok && !wantsBasicAuth {
+		b.Logger.Infof("Will delete secret %s", common.KubeAPIServerBasicAuthSecretName)
+		if err := b.SeedNamespaceSecrets.Delete(context.TODO(), common.KubeAPIServerBasicAuthSecretName); err != nil {
+			return err
+		}
+		delete(existingSecretsMap, common.KubeAPIServerBasicAuthSecretName)
+	}
+
+	return nil
+}
+
 func (b *Botanist) generateCertificateAuthorities(existingSecretsMap map[string]*corev1.Secret) (map[string]*secrets.Certificate, error) {
-	generatedSecrets, certificateAuthorities, err := secrets.GenerateCertificateAuthorities(b.K8sSeedClient, existingSecretsMap, wantedCertificateAuthorities, b.Shoot.SeedNamespace)
+	generatedSecrets, certificateAuthorities, err := secrets.GenerateCertificateAuthorities(b.SeedNamespaceSecrets, existingSecretsMap, wantedCertificateAuthorities, b.Shoot.SeedNamespace)
 	if err != nil {
 		return nil, err
 	}
@@ -867,7 +946,7 @@ func (b *Botanist) generateCertificateAuthorities(existingSecretsMap map[string]
 
 func (b *Botanist) generateBasicAuthAPIServer(existingSecretsMap map[string]*corev1.Secret) (*secrets.BasicAuth, error) {
 	basicAuthSecretAPIServer := &secrets.BasicAuthSecretConfig{
-		Name:           "kube-apiserver-basic-auth",
+		Name:           common.KubeAPIServerBasicAuthSecretName,
 		Format:         secrets.BasicAuthFormatCSV,
 		Username:       "admin",
 		PasswordLength: 32,
@@ -895,7 +974,7 @@ func (b *Botanist) generateBasicAuthAPIServer(existingSecretsMap map[string]*cor
 	b.mutex.Lock()
 	defer b.mutex.Unlock()
 
-	b.Secrets[basicAuthSecretAPIServer.Name], err = b.K8sSeedClient.CreateSecret(b.Shoot.SeedNamespace, basicAuthSecretAPIServer.Name, corev1.SecretTypeOpaque, basicAuth.SecretData(), false)
+	b.Secrets[basicAuthSecretAPIServer.Name], err = b.SeedNamespaceSecrets.CreateSecret(b.Shoot.SeedNamespace, basicAuthSecretAPIServer.Name, corev1.SecretTypeOpaque, basicAuth.SecretData(), false)
 	if err != nil {
 		return nil, err
 	}
@@ -904,7 +983,7 @@ func (b *Botanist) generateBasicAuthAPIServer(existingSecretsMap map[string]*cor
 }
 
 func (b *Botanist) generateShootSecrets(existingSecretsMap map[string]*corev1.Secret, wantedSecretsList []secrets.ConfigInterface) error {
-	deployedClusterSecrets, err := secrets.GenerateClusterSecrets(b.K8sSeedClient, existingSecretsMap, wantedSecretsList, b.Shoot.SeedNamespace)
+	deployedClusterSecrets, err := secrets.GenerateClusterSecrets(b.SeedNamespaceSecrets, existingSecretsMap, wantedSecretsList, b.Shoot.SeedNamespace)
 	if err != nil {
 		return err
 	}
@@ -920,12 +999,25 @@ func (b *Botanist) generateShootSecrets(existingSecretsMap map[string]*corev1.Se
 }
 
 // SyncShootCredentialsToGarden copies the kubeconfig generated for the user as well as the SSH keypair to
-// the project namespace in the Garden cluster.
+// the project namespace in the Garden cluster. If the kubeconfig is issued for the first time or with new
+// credentials, the rotation is recorded in the access audit of the Shoot status.
 func (b *Botanist) SyncShootCredentialsToGarden() error {
+	var kubeconfigRotated bool
+
 	for key, value := range map[string]string{"kubeconfig": "kubecfg", "ssh-keypair": "ssh-keypair"} {
+		name := fmt.Sprintf("%s.%s", b.Shoot.Info.Name, key)
+
+		if key == "kubeconfig" {
+			existing, err := b.ProjectSecrets.Get(context.TODO(), name)
+			if err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+			kubeconfigRotated = existing == nil || !bytes.Equal(existing.Data[secrets.DataKeyKubeconfig], b.Secrets[value].Data[secrets.DataKeyKubeconfig])
+		}
+
 		secretObj := &corev1.Secret{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      fmt.Sprintf("%s.%s", b.Shoot.Info.Name, key),
+				Name:      name,
 				Namespace: b.Shoot.Info.Namespace,
 				OwnerReferences: []metav1.OwnerReference{
 					*metav1.NewControllerRef(b.Shoot.Info, gardenv1beta1.SchemeGroupVersion.WithKind("Shoot")),
@@ -934,11 +1026,14 @@ func (b *Botanist) SyncShootCredentialsToGarden() error {
 			Type: corev1.SecretTypeOpaque,
 			Data: b.Secrets[value].Data,
 		}
-		if _, err := b.K8sGardenClient.CreateSecretObject(secretObj, true); err != nil {
+		if _, err := b.ProjectSecrets.CreateOrUpdate(context.TODO(), secretObj); err != nil {
 			return err
 		}
 	}
 
+	if kubeconfigRotated {
+		return b.RecordKubeconfigRotation()
+	}
 	return nil
 }
 
@@ -964,10 +1059,45 @@ func (b *Botanist) deployOpenVPNTLSAuthSecret(existingSecretsMap map[string]*cor
 	b.mutex.Lock()
 	defer b.mutex.Unlock()
 
-	b.Secrets[name], err = b.K8sSeedClient.CreateSecret(b.Shoot.SeedNamespace, name, corev1.SecretTypeOpaque, data, false)
+	b.Secrets[name], err = b.SeedNamespaceSecrets.CreateSecret(b.Shoot.SeedNamespace, name, corev1.SecretTypeOpaque, data, false)
 	return err
 }
 
+// deployIngressWildcardSecret copies the wildcard certificate referenced by the Seed into the Shoot namespace so
+// that it can be used by the ingresses of the monitoring and logging endpoints.
+func (b *Botanist) deployIngressWildcardSecret() error {
+	secretRef := b.Seed.Info.Spec.IngressTLS.SecretRef
+
+	wildcardSecret, err := b.K8sGardenClient.GetSecret(secretRef.Namespace, secretRef.Name)
+	if err != nil {
+		return fmt.Errorf("could not read wildcard certificate of seed %s: %v", b.Seed.Info.Name, err)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: common.IngressWildcardSecretName,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       wildcardSecret.Data[corev1.TLSCertKey],
+			corev1.TLSPrivateKeyKey: wildcardSecret.Data[corev1.TLSPrivateKeyKey],
+		},
+	}
+	if ca, ok := wildcardSecret.Data[secrets.DataKeyCertificateCA]; ok {
+		secret.Data[secrets.DataKeyCertificateCA] = ca
+	}
+
+	if _, err := b.SeedNamespaceSecrets.CreateOrUpdate(context.TODO(), secret); err != nil {
+		return err
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.Secrets[common.IngressWildcardSecretName] = secret
+	return nil
+}
+
 func generateOpenVPNTLSAuth() ([]byte, error) {
 	var (
 		out bytes.Buffer
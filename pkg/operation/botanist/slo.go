@@ -0,0 +1,137 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+
+	prometheusmodel "github.com/prometheus/common/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// APIServerSLOWindowDays is the number of days of the rolling window the service level objective attainment of
+	// the kube-apiserver is computed for.
+	APIServerSLOWindowDays = 30
+
+	apiServerAvailabilitySLI = "shoot:apiserver_request_availability:ratio_rate5m"
+	apiServerLatencySLI      = "shoot:apiserver_request_latency:ratio_rate5m"
+
+	apiServerSLODayLayout = "2006-01-02"
+)
+
+// ComputeAPIServerSLO queries the service level indicators of the kube-apiserver for the current day from the Shoot
+// Prometheus and returns the updated service level objective attainment of the rolling window.
+func (b *Botanist) ComputeAPIServerSLO(now time.Time) (*gardenv1beta1.APIServerSLO, error) {
+	if err := b.InitializeMonitoringClient(); err != nil {
+		return nil, err
+	}
+
+	var (
+		startOfDay = now.UTC().Truncate(24 * time.Hour)
+		elapsed    = now.Sub(startOfDay)
+	)
+	if elapsed < 5*time.Minute {
+		elapsed = 5 * time.Minute
+	}
+
+	availability, err := b.queryAverageSLI(apiServerAvailabilitySLI, elapsed, now)
+	if err != nil {
+		return nil, err
+	}
+	latency, err := b.queryAverageSLI(apiServerLatencySLI, elapsed, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return UpdateAPIServerSLO(b.Shoot.Info.Status.APIServerSLO, now, availability, latency), nil
+}
+
+// queryAverageSLI returns the average of the given service level indicator within the given duration before <now>.
+func (b *Botanist) queryAverageSLI(sli string, duration time.Duration, now time.Time) (float64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	result, err := b.MonitoringClient.Query(ctx, fmt.Sprintf("avg_over_time(%s[%ds])", sli, int64(duration.Seconds())), now)
+	if err != nil {
+		return 0, fmt.Errorf("service level indicator %s can't be queried from Shoot Prometheus (%v)", sli, err)
+	}
+
+	vector, ok := result.(prometheusmodel.Vector)
+	if !ok {
+		return 0, fmt.Errorf("unexpected metrics format for service level indicator %s", sli)
+	}
+	if len(vector) == 0 || math.IsNaN(float64(vector[0].Value)) {
+		return 0, fmt.Errorf("no samples found for service level indicator %s", sli)
+	}
+	return float64(vector[0].Value), nil
+}
+
+// UpdateAPIServerSLO stores the given service level indicator ratios as sample of the day of <now> and returns the
+// service level objective attainment computed from the samples of the last APIServerSLOWindowDays days.
+func UpdateAPIServerSLO(current *gardenv1beta1.APIServerSLO, now time.Time, availability, latency float64) *gardenv1beta1.APIServerSLO {
+	var (
+		today     = now.UTC().Format(apiServerSLODayLayout)
+		oldestDay = now.UTC().AddDate(0, 0, -(APIServerSLOWindowDays - 1)).Format(apiServerSLODayLayout)
+		samples   = []gardenv1beta1.APIServerSLOSample{{
+			Day:          today,
+			Availability: formatPercentage(availability),
+			Latency:      formatPercentage(latency),
+		}}
+	)
+
+	if current != nil {
+		for _, sample := range current.Samples {
+			// Days are formatted so that their lexical order equals their chronological order.
+			if sample.Day == today || sample.Day < oldestDay {
+				continue
+			}
+			samples = append(samples, sample)
+		}
+	}
+	sort.Slice(samples, func(i, j int) bool { return samples[i].Day < samples[j].Day })
+
+	var availabilitySum, latencySum float64
+	for _, sample := range samples {
+		availabilitySum += parsePercentage(sample.Availability)
+		latencySum += parsePercentage(sample.Latency)
+	}
+
+	return &gardenv1beta1.APIServerSLO{
+		Availability:   formatPercentage(availabilitySum / float64(len(samples)) / 100),
+		Latency:        formatPercentage(latencySum / float64(len(samples)) / 100),
+		Samples:        samples,
+		LastUpdateTime: metav1.NewTime(now),
+	}
+}
+
+func formatPercentage(ratio float64) string {
+	return strconv.FormatFloat(ratio*100, 'f', 3, 64)
+}
+
+func parsePercentage(percentage string) float64 {
+	value, err := strconv.ParseFloat(percentage, 64)
+	if err != nil {
+		return 0
+	}
+	return value
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation/botanist"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("slo", func() {
+	Describe("#UpdateAPIServerSLO", func() {
+		now := time.Date(2019, time.May, 31, 12, 0, 0, 0, time.UTC)
+
+		It("should initialize the attainment with the first sample", func() {
+			slo := botanist.UpdateAPIServerSLO(nil, now, 0.9995, 0.99)
+
+			Expect(slo.Availability).To(Equal("99.950"))
+			Expect(slo.Latency).To(Equal("99.000"))
+			Expect(slo.Samples).To(Equal([]gardenv1beta1.APIServerSLOSample{
+				{Day: "2019-05-31", Availability: "99.950", Latency: "99.000"},
+			}))
+			Expect(slo.LastUpdateTime.Time).To(Equal(now))
+		})
+
+		It("should replace the sample of the current day and drop samples outside of the window", func() {
+			current := &gardenv1beta1.APIServerSLO{
+				Samples: []gardenv1beta1.APIServerSLOSample{
+					{Day: "2019-05-01", Availability: "0.000", Latency: "0.000"},
+					{Day: "2019-05-02", Availability: "99.000", Latency: "98.000"},
+					{Day: "2019-05-31", Availability: "50.000", Latency: "50.000"},
+				},
+			}
+
+			slo := botanist.UpdateAPIServerSLO(current, now, 1, 1)
+
+			Expect(slo.Availability).To(Equal("99.500"))
+			Expect(slo.Latency).To(Equal("99.000"))
+			Expect(slo.Samples).To(Equal([]gardenv1beta1.APIServerSLOSample{
+				{Day: "2019-05-02", Availability: "99.000", Latency: "98.000"},
+				{Day: "2019-05-31", Availability: "100.000", Latency: "100.000"},
+			}))
+		})
+	})
+})
@@ -0,0 +1,185 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+const (
+	// tunnelProbeTimeout is the time a single probe through the VPN tunnel may take.
+	tunnelProbeTimeout = 15 * time.Second
+	// vpnSeedContainerName is the name of the container in the kube-apiserver pod which terminates the VPN tunnel.
+	vpnSeedContainerName = "vpn-seed"
+	// kubeDNSName is the name of the service and the k8s-app label value of the cluster DNS of the Shoot.
+	kubeDNSName = "kube-dns"
+)
+
+// TunnelProbe is a TCP endpoint in one of the networks of the Shoot which is probed from the Seed through the
+// VPN tunnel.
+type TunnelProbe struct {
+	// Network is the name of the probed network, i.e., node, pod or service.
+	Network string
+	// CIDR is the CIDR of the probed network.
+	CIDR gardencorev1alpha1.CIDR
+	// Address is the IP address of the endpoint.
+	Address string
+	// Port is the TCP port of the endpoint.
+	Port int
+}
+
+// Command returns the shell command connecting to the probed endpoint.
+func (p TunnelProbe) Command() string {
+	return fmt.Sprintf("nc -z -w 5 %s %d", p.Address, p.Port)
+}
+
+// TunnelProbeResult is the result of a TunnelProbe. Err is nil if the endpoint was reachable.
+type TunnelProbeResult struct {
+	Probe TunnelProbe
+	Err   error
+}
+
+// TunnelChecks probes endpoints in the node, pod and service networks of the Shoot from the vpn-seed container
+// of the kube-apiserver and reflects the results in the given TunnelHealthy condition.
+func (b *Botanist) TunnelChecks(initializeShootClients func() error, tunnelHealthy gardencorev1alpha1.Condition) gardencorev1alpha1.Condition {
+	if b.Shoot.IsHibernated {
+		return shootHibernatedCondition(tunnelHealthy)
+	}
+
+	if err := initializeShootClients(); err != nil {
+		message := fmt.Sprintf("Could not initialize Shoot client for tunnel check: %+v", err)
+		b.Logger.Error(message)
+		return gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(tunnelHealthy, message)
+	}
+
+	probes, err := b.computeTunnelProbes()
+	if err != nil {
+		return gardencorev1alpha1helper.UpdatedConditionUnknownError(tunnelHealthy, err)
+	}
+
+	podName, err := b.runningAPIServerPodName()
+	if err != nil {
+		return gardencorev1alpha1helper.UpdatedConditionUnknownError(tunnelHealthy, err)
+	}
+
+	var (
+		executor = kubernetes.NewPodExecutor(b.K8sSeedClient.RESTConfig())
+		results  = make([]TunnelProbeResult, 0, len(probes))
+	)
+
+	for _, probe := range probes {
+		ctx, cancel := context.WithTimeout(context.TODO(), tunnelProbeTimeout)
+		_, err := executor.Execute(ctx, b.Shoot.SeedNamespace, podName, vpnSeedContainerName, probe.Command())
+		cancel()
+
+		if err != nil {
+			if _, ok := err.(utilexec.ExitError); !ok {
+				return gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(tunnelHealthy, fmt.Sprintf("Could not probe the %s network through the VPN tunnel: %+v", probe.Network, err))
+			}
+		}
+		results = append(results, TunnelProbeResult{Probe: probe, Err: err})
+	}
+
+	return b.pardonCondition(CheckTunnelProbeResults(tunnelHealthy, results))
+}
+
+// CheckTunnelProbeResults computes the TunnelHealthy condition based on the given probe results. The message of
+// an unhealthy condition names every network which is unreachable through the VPN tunnel.
+func CheckTunnelProbeResults(condition gardencorev1alpha1.Condition, results []TunnelProbeResult) gardencorev1alpha1.Condition {
+	if len(results) == 0 {
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionUnknown, "NoProbeTargets", "There are no endpoints in the Shoot's networks which could be probed through the VPN tunnel yet.")
+	}
+
+	var unreachable []string
+	for _, result := range results {
+		if result.Err != nil {
+			unreachable = append(unreachable, fmt.Sprintf("%s network %s (%s:%d)", result.Probe.Network, result.Probe.CIDR, result.Probe.Address, result.Probe.Port))
+		}
+	}
+
+	if len(unreachable) == 0 {
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "TunnelHealthy", "All probed networks of the Shoot are reachable through the VPN tunnel.")
+	}
+	return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "NetworkUnreachable", fmt.Sprintf("The following networks of the Shoot are unreachable through the VPN tunnel: %s.", strings.Join(unreachable, ", ")))
+}
+
+// computeTunnelProbes computes an endpoint in each of the node, pod and service networks of the Shoot. Networks
+// without an endpoint (e.g., no node has joined yet) are not probed.
+func (b *Botanist) computeTunnelProbes() ([]TunnelProbe, error) {
+	var probes []TunnelProbe
+
+	nodes, err := b.K8sShootClient.Kubernetes().CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if address := firstNodeInternalIP(nodes.Items); len(address) > 0 {
+		probes = append(probes, TunnelProbe{Network: "node", CIDR: b.Shoot.GetNodeNetwork(), Address: address, Port: 10250})
+	}
+
+	pods, err := b.K8sShootClient.Kubernetes().CoreV1().Pods(metav1.NamespaceSystem).List(metav1.ListOptions{LabelSelector: "k8s-app=" + kubeDNSName})
+	if err != nil {
+		return nil, err
+	}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == corev1.PodRunning && len(pod.Status.PodIP) > 0 {
+			probes = append(probes, TunnelProbe{Network: "pod", CIDR: b.Shoot.GetPodNetwork(), Address: pod.Status.PodIP, Port: 53})
+			break
+		}
+	}
+
+	service, err := b.K8sShootClient.Kubernetes().CoreV1().Services(metav1.NamespaceSystem).Get(kubeDNSName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	probes = append(probes, TunnelProbe{Network: "service", CIDR: b.Shoot.GetServiceNetwork(), Address: service.Spec.ClusterIP, Port: 53})
+
+	return probes, nil
+}
+
+func firstNodeInternalIP(nodes []corev1.Node) string {
+	for _, node := range nodes {
+		for _, address := range node.Status.Addresses {
+			if address.Type == corev1.NodeInternalIP {
+				return address.Address
+			}
+		}
+	}
+	return ""
+}
+
+// runningAPIServerPodName returns the name of a running kube-apiserver pod of the Shoot.
+func (b *Botanist) runningAPIServerPodName() (string, error) {
+	pods, err := b.K8sSeedClient.Kubernetes().CoreV1().Pods(b.Shoot.SeedNamespace).List(metav1.ListOptions{LabelSelector: "app=kubernetes,role=apiserver"})
+	if err != nil {
+		return "", err
+	}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == corev1.PodRunning && pod.DeletionTimestamp == nil {
+			return pod.Name, nil
+		}
+	}
+	return "", fmt.Errorf("no running kube-apiserver pod found in namespace %s", b.Shoot.SeedNamespace)
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"errors"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation/botanist"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("tunnel check", func() {
+	Describe("#CheckTunnelProbeResults", func() {
+		var (
+			tunnelHealthy = gardencorev1alpha1.Condition{Type: gardenv1beta1.ShootTunnelHealthy}
+			nodeProbe     = botanist.TunnelProbe{Network: "node", CIDR: "10.250.0.0/16", Address: "10.250.0.5", Port: 10250}
+			podProbe      = botanist.TunnelProbe{Network: "pod", CIDR: "100.96.0.0/11", Address: "100.96.1.3", Port: 53}
+			serviceProbe  = botanist.TunnelProbe{Network: "service", CIDR: "100.64.0.0/13", Address: "100.64.0.10", Port: 53}
+		)
+
+		It("should report an unknown condition if there is nothing to probe", func() {
+			Expect(botanist.CheckTunnelProbeResults(tunnelHealthy, nil)).To(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(gardencorev1alpha1.ConditionUnknown),
+				"Reason": Equal("NoProbeTargets"),
+			}))
+		})
+
+		It("should report a healthy tunnel if all networks are reachable", func() {
+			Expect(botanist.CheckTunnelProbeResults(tunnelHealthy, []botanist.TunnelProbeResult{
+				{Probe: nodeProbe},
+				{Probe: podProbe},
+				{Probe: serviceProbe},
+			})).To(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(gardenv1beta1.ShootTunnelHealthy),
+				"Status": Equal(gardencorev1alpha1.ConditionTrue),
+				"Reason": Equal("TunnelHealthy"),
+			}))
+		})
+
+		It("should name every unreachable network", func() {
+			Expect(botanist.CheckTunnelProbeResults(tunnelHealthy, []botanist.TunnelProbeResult{
+				{Probe: nodeProbe},
+				{Probe: podProbe, Err: errors.New("command terminated with exit code 1")},
+				{Probe: serviceProbe, Err: errors.New("command terminated with exit code 1")},
+			})).To(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(gardencorev1alpha1.ConditionFalse),
+				"Reason":  Equal("NetworkUnreachable"),
+				"Message": Equal("The following networks of the Shoot are unreachable through the VPN tunnel: pod network 100.96.0.0/11 (100.96.1.3:53), service network 100.64.0.0/13 (100.64.0.10:53)."),
+			}))
+		})
+	})
+
+	Describe("TunnelProbe", func() {
+		It("should connect to the endpoint with a timeout", func() {
+			probe := botanist.TunnelProbe{Address: "10.250.0.5", Port: 10250}
+
+			Expect(probe.Command()).To(Equal("nc -z -w 5 10.250.0.5 10250"))
+		})
+	})
+})
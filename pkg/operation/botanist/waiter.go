@@ -46,6 +46,7 @@ func (b *Botanist) WaitUntilKubeAPIServerServiceIsReady(ctx context.Context) err
 			return false, nil
 		}
 		b.Operation.APIServerAddress = loadBalancerIngress
+		b.Shoot.LoadBalancerIngress = loadBalancerIngress
 		return true, nil
 	}, ctx.Done()); err != nil {
 		return e
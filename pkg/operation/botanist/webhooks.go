@@ -0,0 +1,267 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"fmt"
+	"strings"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/hashicorp/go-multierror"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// IsProblematicWebhook checks whether the given webhook would block the creation of pods in the kube-system
+// namespace (having the given labels) while its backend is not running. This is the case if it fails closed,
+// is served by a service inside the Shoot cluster, and intercepts pod creations in the kube-system namespace.
+// Such webhooks deadlock the wake-up of hibernated Shoots as their backends cannot be scheduled before the
+// system components are running.
+func IsProblematicWebhook(webhook admissionregistrationv1beta1.Webhook, kubeSystemLabels labels.Set) bool {
+	if webhook.FailurePolicy == nil || *webhook.FailurePolicy != admissionregistrationv1beta1.Fail {
+		return false
+	}
+	if webhook.ClientConfig.Service == nil {
+		return false
+	}
+
+	if webhook.NamespaceSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(webhook.NamespaceSelector)
+		if err != nil || !selector.Matches(kubeSystemLabels) {
+			return false
+		}
+	}
+
+	for _, rule := range webhook.Rules {
+		if matchesPodCreation(rule) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesPodCreation(rule admissionregistrationv1beta1.RuleWithOperations) bool {
+	var (
+		operations = make([]string, 0, len(rule.Operations))
+		apiGroups  = sets.NewString(rule.APIGroups...)
+		resources  = sets.NewString(rule.Resources...)
+	)
+	for _, operation := range rule.Operations {
+		operations = append(operations, string(operation))
+	}
+
+	return sets.NewString(operations...).HasAny(string(admissionregistrationv1beta1.Create), string(admissionregistrationv1beta1.OperationAll)) &&
+		apiGroups.HasAny("", "*") &&
+		resources.HasAny("pods", "*", "*/*")
+}
+
+// ProblematicWebhooks returns the names of all webhooks in the Shoot cluster which would block the wake-up of
+// the Shoot, see IsProblematicWebhook.
+func (b *Botanist) ProblematicWebhooks() ([]string, error) {
+	kubeSystemLabels, err := b.kubeSystemNamespaceLabels()
+	if err != nil {
+		return nil, err
+	}
+
+	var problematic []string
+
+	validatingWebhookConfigurations, err := b.K8sShootClient.Kubernetes().AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, config := range validatingWebhookConfigurations.Items {
+		for _, webhook := range config.Webhooks {
+			if IsProblematicWebhook(webhook, kubeSystemLabels) {
+				problematic = append(problematic, fmt.Sprintf("ValidatingWebhookConfiguration %s/%s", config.Name, webhook.Name))
+			}
+		}
+	}
+
+	mutatingWebhookConfigurations, err := b.K8sShootClient.Kubernetes().AdmissionregistrationV1beta1().MutatingWebhookConfigurations().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, config := range mutatingWebhookConfigurations.Items {
+		for _, webhook := range config.Webhooks {
+			if IsProblematicWebhook(webhook, kubeSystemLabels) {
+				problematic = append(problematic, fmt.Sprintf("MutatingWebhookConfiguration %s/%s", config.Name, webhook.Name))
+			}
+		}
+	}
+
+	return problematic, nil
+}
+
+// RelaxProblematicWebhooks temporarily sets the failure policy of all webhooks which would block the wake-up of
+// the Shoot to 'Ignore'. The names of the changed webhooks are remembered in an annotation of the respective
+// webhook configuration so that RestoreProblematicWebhooks can revert the change afterwards.
+func (b *Botanist) RelaxProblematicWebhooks() error {
+	kubeSystemLabels, err := b.kubeSystemNamespaceLabels()
+	if err != nil {
+		return err
+	}
+
+	var (
+		client = b.K8sShootClient.Kubernetes().AdmissionregistrationV1beta1()
+		result error
+	)
+
+	validatingWebhookConfigurations, err := client.ValidatingWebhookConfigurations().List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, config := range validatingWebhookConfigurations.Items {
+		if relaxed := relaxWebhooks(&config.ObjectMeta, config.Webhooks, kubeSystemLabels); len(relaxed) > 0 {
+			b.Logger.Infof("Temporarily ignoring failures of webhooks %v of ValidatingWebhookConfiguration %s during wake-up", relaxed, config.Name)
+			if _, err := client.ValidatingWebhookConfigurations().Update(&config); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	mutatingWebhookConfigurations, err := client.MutatingWebhookConfigurations().List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, config := range mutatingWebhookConfigurations.Items {
+		if relaxed := relaxWebhooks(&config.ObjectMeta, config.Webhooks, kubeSystemLabels); len(relaxed) > 0 {
+			b.Logger.Infof("Temporarily ignoring failures of webhooks %v of MutatingWebhookConfiguration %s during wake-up", relaxed, config.Name)
+			if _, err := client.MutatingWebhookConfigurations().Update(&config); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result
+}
+
+// RestoreProblematicWebhooks reverts the changes done by RelaxProblematicWebhooks.
+func (b *Botanist) RestoreProblematicWebhooks() error {
+	var (
+		client = b.K8sShootClient.Kubernetes().AdmissionregistrationV1beta1()
+		result error
+	)
+
+	validatingWebhookConfigurations, err := client.ValidatingWebhookConfigurations().List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, config := range validatingWebhookConfigurations.Items {
+		if restoreWebhooks(&config.ObjectMeta, config.Webhooks) {
+			if _, err := client.ValidatingWebhookConfigurations().Update(&config); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	mutatingWebhookConfigurations, err := client.MutatingWebhookConfigurations().List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, config := range mutatingWebhookConfigurations.Items {
+		if restoreWebhooks(&config.ObjectMeta, config.Webhooks) {
+			if _, err := client.MutatingWebhookConfigurations().Update(&config); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result
+}
+
+func (b *Botanist) kubeSystemNamespaceLabels() (labels.Set, error) {
+	namespace, err := b.K8sShootClient.Kubernetes().CoreV1().Namespaces().Get(metav1.NamespaceSystem, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return labels.Set{}, nil
+		}
+		return nil, err
+	}
+	return labels.Set(namespace.Labels), nil
+}
+
+// relaxWebhooks sets the failure policy of all problematic webhooks to 'Ignore' and remembers their names in
+// an annotation of the given object. It returns the names of the changed webhooks.
+func relaxWebhooks(meta *metav1.ObjectMeta, webhooks []admissionregistrationv1beta1.Webhook, kubeSystemLabels labels.Set) []string {
+	relaxed := sets.NewString()
+	if value, ok := meta.Annotations[common.ShootRelaxedWebhooks]; ok {
+		relaxed.Insert(strings.Split(value, ",")...)
+	}
+
+	var changed []string
+	for i, webhook := range webhooks {
+		if !IsProblematicWebhook(webhook, kubeSystemLabels) {
+			continue
+		}
+		ignore := admissionregistrationv1beta1.Ignore
+		webhooks[i].FailurePolicy = &ignore
+		relaxed.Insert(webhook.Name)
+		changed = append(changed, webhook.Name)
+	}
+
+	if len(changed) > 0 {
+		metav1.SetMetaDataAnnotation(meta, common.ShootRelaxedWebhooks, strings.Join(relaxed.List(), ","))
+	}
+	return changed
+}
+
+// restoreWebhooks sets the failure policy of all webhooks remembered in the annotation of the given object back
+// to 'Fail' and removes the annotation. It returns true if the object has been changed.
+func restoreWebhooks(meta *metav1.ObjectMeta, webhooks []admissionregistrationv1beta1.Webhook) bool {
+	value, ok := meta.Annotations[common.ShootRelaxedWebhooks]
+	if !ok {
+		return false
+	}
+
+	relaxed := sets.NewString(strings.Split(value, ",")...)
+	for i, webhook := range webhooks {
+		if relaxed.Has(webhook.Name) {
+			fail := admissionregistrationv1beta1.Fail
+			webhooks[i].FailurePolicy = &fail
+		}
+	}
+
+	delete(meta.Annotations, common.ShootRelaxedWebhooks)
+	return true
+}
+
+// ConstraintsChecks computes the constraints of the Shoot.
+func (b *Botanist) ConstraintsChecks(initializeShootClients func() error, hibernationPossible gardencorev1alpha1.Condition) gardencorev1alpha1.Condition {
+	if b.Shoot.IsHibernated {
+		return hibernationPossible
+	}
+
+	if err := initializeShootClients(); err != nil {
+		message := fmt.Sprintf("Could not initialize Shoot client for constraints check: %+v", err)
+		b.Logger.Error(message)
+		return gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(hibernationPossible, message)
+	}
+
+	problematicWebhooks, err := b.ProblematicWebhooks()
+	if err != nil {
+		return gardencorev1alpha1helper.UpdatedConditionUnknownError(hibernationPossible, err)
+	}
+	if len(problematicWebhooks) > 0 {
+		message := fmt.Sprintf("The following webhooks fail closed and intercept pod creations in the kube-system namespace while being served inside the cluster, hence, they would block the wake-up. Their failure policy will temporarily be set to 'Ignore' during wake-ups: %s", strings.Join(problematicWebhooks, ", "))
+		return gardencorev1alpha1helper.UpdatedCondition(hibernationPossible, gardencorev1alpha1.ConditionFalse, "ProblematicWebhooks", message)
+	}
+
+	return gardencorev1alpha1helper.UpdatedCondition(hibernationPossible, gardencorev1alpha1.ConditionTrue, "NoProblematicWebhooks", "No webhooks found which would block the wake-up.")
+}
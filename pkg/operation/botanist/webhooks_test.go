@@ -0,0 +1,105 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"github.com/gardener/gardener/pkg/operation/botanist"
+
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("webhooks", func() {
+	var (
+		webhook          admissionregistrationv1beta1.Webhook
+		kubeSystemLabels labels.Set
+	)
+
+	BeforeEach(func() {
+		fail := admissionregistrationv1beta1.Fail
+		kubeSystemLabels = labels.Set{"role": "kube-system"}
+		webhook = admissionregistrationv1beta1.Webhook{
+			Name: "pods.example.com",
+			ClientConfig: admissionregistrationv1beta1.WebhookClientConfig{
+				Service: &admissionregistrationv1beta1.ServiceReference{Namespace: "default", Name: "webhook"},
+			},
+			Rules: []admissionregistrationv1beta1.RuleWithOperations{{
+				Operations: []admissionregistrationv1beta1.OperationType{admissionregistrationv1beta1.Create},
+				Rule: admissionregistrationv1beta1.Rule{
+					APIGroups:   []string{""},
+					APIVersions: []string{"v1"},
+					Resources:   []string{"pods"},
+				},
+			}},
+			FailurePolicy: &fail,
+		}
+	})
+
+	Describe("#IsProblematicWebhook", func() {
+		It("should consider a failing webhook for pod creations served inside the cluster problematic", func() {
+			Expect(botanist.IsProblematicWebhook(webhook, kubeSystemLabels)).To(BeTrue())
+		})
+
+		It("should consider webhooks intercepting all operations and resources problematic", func() {
+			webhook.Rules[0].Operations = []admissionregistrationv1beta1.OperationType{admissionregistrationv1beta1.OperationAll}
+			webhook.Rules[0].APIGroups = []string{"*"}
+			webhook.Rules[0].Resources = []string{"*/*"}
+
+			Expect(botanist.IsProblematicWebhook(webhook, kubeSystemLabels)).To(BeTrue())
+		})
+
+		It("should not consider webhooks with failure policy 'Ignore' problematic", func() {
+			ignore := admissionregistrationv1beta1.Ignore
+			webhook.FailurePolicy = &ignore
+
+			Expect(botanist.IsProblematicWebhook(webhook, kubeSystemLabels)).To(BeFalse())
+		})
+
+		It("should not consider webhooks served outside of the cluster problematic", func() {
+			url := "https://webhook.example.com"
+			webhook.ClientConfig = admissionregistrationv1beta1.WebhookClientConfig{URL: &url}
+
+			Expect(botanist.IsProblematicWebhook(webhook, kubeSystemLabels)).To(BeFalse())
+		})
+
+		It("should not consider webhooks excluding the kube-system namespace problematic", func() {
+			webhook.NamespaceSelector = &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{
+					Key:      "role",
+					Operator: metav1.LabelSelectorOpNotIn,
+					Values:   []string{"kube-system"},
+				}},
+			}
+
+			Expect(botanist.IsProblematicWebhook(webhook, kubeSystemLabels)).To(BeFalse())
+		})
+
+		It("should not consider webhooks for other resources problematic", func() {
+			webhook.Rules[0].Resources = []string{"configmaps"}
+
+			Expect(botanist.IsProblematicWebhook(webhook, kubeSystemLabels)).To(BeFalse())
+		})
+
+		It("should not consider webhooks for pod updates only problematic", func() {
+			webhook.Rules[0].Operations = []admissionregistrationv1beta1.OperationType{admissionregistrationv1beta1.Update}
+
+			Expect(botanist.IsProblematicWebhook(webhook, kubeSystemLabels)).To(BeFalse())
+		})
+	})
+})
@@ -15,16 +15,21 @@
 package alicloudbotanist
 
 import (
+	"context"
+	"strconv"
+	"strings"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation/common"
 )
 
 // DeployKube2IAMResources - Not needed on Alicloud
-func (b *AlicloudBotanist) DeployKube2IAMResources() error {
+func (b *AlicloudBotanist) DeployKube2IAMResources(ctx context.Context) error {
 	return nil
 }
 
 // DestroyKube2IAMResources - Not needed on Alicloud
-func (b *AlicloudBotanist) DestroyKube2IAMResources() error {
+func (b *AlicloudBotanist) DestroyKube2IAMResources(ctx context.Context) error {
 	return nil
 }
 
@@ -46,21 +51,38 @@ func (b *AlicloudBotanist) GenerateNginxIngressConfig() (map[string]interface{},
 
 // GenerateStorageClassesConfig generates values which are required to render the chart shoot-storageclasses properly.
 func (b *AlicloudBotanist) GenerateStorageClassesConfig() (map[string]interface{}, error) {
-	return map[string]interface{}{
-		"StorageClasses": []map[string]interface{}{
-			{
-				"Name":           "default",
-				"IsDefaultClass": true,
-				"Provisioner":    "diskplugin.csi.alibabacloud.com",
-				"Parameters": map[string]interface{}{
-					"csi.storage.k8s.io/fstype": "ext4",
-					"type":                      "cloud_ssd",
-					"readOnly":                  "false",
-				},
+	return b.Shoot.ComputeStorageClasses([]map[string]interface{}{
+		{
+			"Name":           "default",
+			"IsDefaultClass": true,
+			"Provisioner":    "diskplugin.csi.alibabacloud.com",
+			"Parameters": map[string]interface{}{
+				"csi.storage.k8s.io/fstype": "ext4",
+				"type":                      "cloud_ssd",
+				"readOnly":                  "false",
 			},
 		},
-	}, nil
+	}, "", b.storageClassParameters), nil
+}
 
+// storageClassParameters computes the provisioner and its parameters for the given StorageClass of the Shoot. The CSI
+// disk plugin expects the zones as a parameter instead of allowed topologies.
+func (b *AlicloudBotanist) storageClassParameters(class gardenv1beta1.StorageClass) (string, map[string]interface{}) {
+	parameters := map[string]interface{}{
+		"csi.storage.k8s.io/fstype": "ext4",
+		"type":                      "cloud_ssd",
+		"readOnly":                  "false",
+	}
+	if class.VolumeType != nil {
+		parameters["type"] = *class.VolumeType
+	}
+	if class.Encrypted != nil {
+		parameters["encrypted"] = strconv.FormatBool(*class.Encrypted)
+	}
+	if len(class.Zones) > 0 {
+		parameters["zoneId"] = strings.Join(class.Zones, ",")
+	}
+	return "diskplugin.csi.alibabacloud.com", parameters
 }
 
 // GenerateVPNShootConfig generate cloud-specific vpn override - nothing unique for alicloud
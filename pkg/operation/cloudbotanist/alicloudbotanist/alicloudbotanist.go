@@ -39,7 +39,7 @@ func New(o *operation.Operation, purpose string) (*AlicloudBotanist, error) {
 		cloudProvider = o.Shoot.CloudProvider
 		secret = o.Shoot.Secret
 		region = o.Shoot.Info.Spec.Cloud.Region
-		client, err = alicloud.NewClient(string(secret.Data[AccessKeyID]), string(secret.Data[AccessKeySecret]), region)
+		client, err = alicloud.NewClientWithRateLimiter(string(secret.Data[AccessKeyID]), string(secret.Data[AccessKeySecret]), region, o.CloudAPIRateLimiter)
 		if err != nil {
 			return nil, err
 		}
@@ -47,6 +47,9 @@ func New(o *operation.Operation, purpose string) (*AlicloudBotanist, error) {
 	case common.CloudPurposeSeed:
 		cloudProvider = o.Seed.CloudProvider
 		secret = o.Seed.Secret
+	case common.CloudPurposeBackup:
+		cloudProvider = o.Seed.BackupProvider
+		secret = o.Seed.BackupSecret
 	}
 
 	if cloudProvider != gardenv1beta1.CloudProviderAlicloud {
@@ -64,3 +67,8 @@ func New(o *operation.Operation, purpose string) (*AlicloudBotanist, error) {
 func (b *AlicloudBotanist) GetCloudProviderName() string {
 	return b.CloudProviderName
 }
+
+// GetNetworkMTU returns the MTU of the network the nodes are connected to.
+func (b *AlicloudBotanist) GetNetworkMTU() int32 {
+	return common.DefaultNetworkMTU
+}
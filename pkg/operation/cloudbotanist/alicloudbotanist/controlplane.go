@@ -18,6 +18,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/gardener/gardener/pkg/operation/common"
 )
@@ -156,8 +157,8 @@ func (b *AlicloudBotanist) GenerateEtcdBackupConfig() (map[string][]byte, map[st
 
 	secretData := map[string][]byte{
 		StorageEndpoint: []byte(stateVariables[StorageEndpoint]),
-		AccessKeyID:     b.Seed.Secret.Data[AccessKeyID],
-		AccessKeySecret: b.Seed.Secret.Data[AccessKeySecret],
+		AccessKeyID:     b.Seed.BackupSecret.Data[AccessKeyID],
+		AccessKeySecret: b.Seed.BackupSecret.Data[AccessKeySecret],
 	}
 
 	backupConfigData := map[string]interface{}{
@@ -241,7 +242,29 @@ func (b *AlicloudBotanist) GenerateCSIConfig() (map[string]interface{}, error) {
 // GenerateKubeAPIServerServiceConfig generates the cloud provider specific values which are required to render the
 // Service manifest of the kube-apiserver-service properly.
 func (b *AlicloudBotanist) GenerateKubeAPIServerServiceConfig() (map[string]interface{}, error) {
-	return nil, nil
+	if b.Shoot.Info.Spec.Cloud.Alicloud == nil || b.Shoot.Info.Spec.Cloud.Alicloud.APIServerLoadBalancer == nil {
+		return nil, nil
+	}
+
+	var (
+		loadBalancer = b.Shoot.Info.Spec.Cloud.Alicloud.APIServerLoadBalancer
+		annotations  = map[string]interface{}{}
+	)
+
+	if loadBalancer.Spec != nil {
+		annotations["service.beta.kubernetes.io/alicloud-loadbalancer-spec"] = *loadBalancer.Spec
+	}
+	if loadBalancer.AddressType != nil {
+		annotations["service.beta.kubernetes.io/alicloud-loadbalancer-address-type"] = *loadBalancer.AddressType
+	}
+	if loadBalancer.Bandwidth != nil {
+		annotations["service.beta.kubernetes.io/alicloud-loadbalancer-charge-type"] = "paybybandwidth"
+		annotations["service.beta.kubernetes.io/alicloud-loadbalancer-bandwidth"] = strconv.Itoa(int(*loadBalancer.Bandwidth))
+	}
+
+	return map[string]interface{}{
+		"annotations": annotations,
+	}, nil
 }
 
 // DeployCloudSpecificControlPlane does nothing currently for Alicloud
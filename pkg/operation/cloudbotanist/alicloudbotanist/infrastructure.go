@@ -15,16 +15,23 @@
 package alicloudbotanist
 
 import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/alicloud"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
 	"github.com/gardener/gardener/pkg/utils/secrets"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 // DeployInfrastructure kicks off a Terraform job which deploys the infrastructure.
-func (b *AlicloudBotanist) DeployInfrastructure() error {
+func (b *AlicloudBotanist) DeployInfrastructure(ctx context.Context) error {
 	var (
 		err error
 
@@ -43,8 +50,11 @@ func (b *AlicloudBotanist) DeployInfrastructure() error {
 			return err
 		}
 
-		if natGatewayID, snatTableID, err = b.AlicloudClient.GetNatGatewayInfo(vpcID); err != nil {
-			return err
+		// the NAT gateway of the existing VPC is only used if no NAT gateways per zone are requested
+		if !b.natGatewayPerZone() {
+			if natGatewayID, snatTableID, err = b.AlicloudClient.GetNatGatewayInfo(vpcID); err != nil {
+				return err
+			}
 		}
 	} else {
 		vpcCIDR = string(*b.Shoot.Info.Spec.Cloud.Alicloud.Networks.VPC.CIDR)
@@ -60,37 +70,75 @@ func (b *AlicloudBotanist) DeployInfrastructure() error {
 		return err
 	}
 
-	return tf.SetVariablesEnvironment(b.generateTerraformInfraVariablesEnvironment()).
-		InitializeWith(b.ChartInitializer("alicloud-infra", vals)).
-		Apply()
+	tf = tf.SetVariablesEnvironment(b.generateTerraformInfraVariablesEnvironment()).
+		InitializeWith(b.ChartInitializer("alicloud-infra", vals))
+
+	// The resources of the zones have been moved into a module per zone, the state of existing Shoots must be moved
+	// accordingly as Terraform would recreate the resources otherwise.
+	if err := tf.MoveStateResources(ctx, zoneStateResourceMoves(len(b.Shoot.Info.Spec.Cloud.Alicloud.Zones))); err != nil {
+		return err
+	}
+	return tf.Apply(ctx)
+}
+
+// zoneResources are the types and names of the resources of a zone inside the module of the zone.
+var zoneResources = [][2]string{
+	{"alicloud_vswitch", "vsw"},
+	{"alicloud_nat_gateway", "nat_gateway"},
+	{"alicloud_route_table", "rt"},
+	{"alicloud_route_table_attachment", "rt_asso"},
+	{"alicloud_route_entry", "natgw_route"},
+	{"alicloud_eip", "eip_natgw"},
+	{"alicloud_eip_association", "eip_natgw_asso"},
+	{"alicloud_snat_entry", "snat"},
+}
+
+// zoneStateResourceMoves returns the moves of the resources of the given number of zones from the root module, where
+// they were suffixed with the index of the zone, into the modules of the zones.
+func zoneStateResourceMoves(zones int) []terraformer.StateResourceMove {
+	var moves []terraformer.StateResourceMove
+	for idx := 0; idx < zones; idx++ {
+		for _, resource := range zoneResources {
+			moves = append(moves, terraformer.StateResourceMove{
+				Address:    fmt.Sprintf("%s.%s_z%d", resource[0], resource[1], idx),
+				Module:     fmt.Sprintf("zone_z%d", idx),
+				NewAddress: fmt.Sprintf("%s.%s", resource[0], resource[1]),
+			})
+		}
+	}
+	return moves
 }
 
 // DestroyInfrastructure kicks off a Terraform job which destroys the infrastructure.
-func (b *AlicloudBotanist) DestroyInfrastructure() error {
+func (b *AlicloudBotanist) DestroyInfrastructure(ctx context.Context) error {
 	tf, err := b.NewShootTerraformer(common.TerraformerPurposeInfra)
 	if err != nil {
 		return err
 	}
 
 	return tf.SetVariablesEnvironment(b.generateTerraformInfraVariablesEnvironment()).
-		Destroy()
+		Destroy(ctx)
 }
 
 // DeployBackupInfrastructure kicks off a Terraform job which deploys the infrastructure resources for backup.
 // It sets up the User and the Bucket to store the backups. Allocate permission to the User to access the bucket.
-func (b *AlicloudBotanist) DeployBackupInfrastructure() error {
+func (b *AlicloudBotanist) DeployBackupInfrastructure(ctx context.Context) error {
 	tf, err := b.NewBackupInfrastructureTerraformer()
 	if err != nil {
 		return err
 	}
+	values, err := b.generateTerraformBackupConfig()
+	if err != nil {
+		return err
+	}
 	return tf.
 		SetVariablesEnvironment(b.generateTerraformBackupVariablesEnvironment()).
-		InitializeWith(b.ChartInitializer("alicloud-backup", b.generateTerraformBackupConfig())).
-		Apply()
+		InitializeWith(b.ChartInitializer("alicloud-backup", values)).
+		Apply(ctx)
 }
 
 // DestroyBackupInfrastructure kicks off a Terraform job which destroys the infrastructure for etcd backup.
-func (b *AlicloudBotanist) DestroyBackupInfrastructure() error {
+func (b *AlicloudBotanist) DestroyBackupInfrastructure(ctx context.Context) error {
 	tf, err := b.NewBackupInfrastructureTerraformer()
 	if err != nil {
 		return err
@@ -107,7 +155,7 @@ func (b *AlicloudBotanist) DestroyBackupInfrastructure() error {
 	}
 
 	err = cleanSnapshots(stateVariables[BucketName], stateVariables[StorageEndpoint],
-		string(b.Seed.Secret.Data[AccessKeyID]), string(b.Seed.Secret.Data[AccessKeySecret]))
+		string(b.Seed.BackupSecret.Data[AccessKeyID]), string(b.Seed.BackupSecret.Data[AccessKeySecret]))
 	if err != nil {
 		return err
 	}
@@ -115,7 +163,58 @@ func (b *AlicloudBotanist) DestroyBackupInfrastructure() error {
 	// Clean the bucket using terraformer
 	return tf.
 		SetVariablesEnvironment(b.generateTerraformBackupVariablesEnvironment()).
-		Destroy()
+		Destroy(ctx)
+}
+
+// ProbeBackupInfrastructure verifies that the backup bucket exists, that it is writable, and that the credentials of
+// the Seed are valid by writing and deleting a probe object.
+func (b *AlicloudBotanist) ProbeBackupInfrastructure(ctx context.Context) error {
+	tf, err := b.NewBackupInfrastructureTerraformer()
+	if err != nil {
+		return err
+	}
+	stateVariables, err := tf.GetStateOutputVariables(BucketName, StorageEndpoint)
+	if err != nil {
+		return err
+	}
+
+	return probeBucket(stateVariables[BucketName], stateVariables[StorageEndpoint],
+		string(b.Seed.BackupSecret.Data[AccessKeyID]), string(b.Seed.BackupSecret.Data[AccessKeySecret]))
+}
+
+// PurgeBackupInfrastructure deletes all snapshots of the backup bucket and the bucket itself directly via the OSS API,
+// i.e., without relying on the Terraform state.
+func (b *AlicloudBotanist) PurgeBackupInfrastructure(ctx context.Context) error {
+	region, err := b.BackupRegion()
+	if err != nil {
+		return err
+	}
+
+	var (
+		bucketName      = b.Operation.BackupInfrastructure.Name
+		storageEndpoint = fmt.Sprintf("oss-%s.aliyuncs.com", region)
+		accessKeyID     = string(b.Seed.BackupSecret.Data[AccessKeyID])
+		accessKeySecret = string(b.Seed.BackupSecret.Data[AccessKeySecret])
+	)
+
+	client, err := oss.New(storageEndpoint, accessKeyID, accessKeySecret)
+	if err != nil {
+		return err
+	}
+
+	exists, err := client.IsBucketExist(bucketName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		b.Logger.Infof("Alicloud backup storage bucket %q does not exist, nothing to purge.", bucketName)
+		return nil
+	}
+
+	if err := cleanSnapshots(bucketName, storageEndpoint, accessKeyID, accessKeySecret); err != nil {
+		return err
+	}
+	return client.DeleteBucket(bucketName)
 }
 
 // generateTerraformInfraVariablesEnvironment generates the environment containing the credentials which
@@ -131,8 +230,19 @@ func (b *AlicloudBotanist) generateTerraformInfraVariablesEnvironment() map[stri
 // generateTerraformInfraConfig creates the Terraform variables and the Terraform config (for the infrastructure)
 // and returns them (these values will be stored as a ConfigMap and a Secret in the Garden cluster.
 func (b *AlicloudBotanist) generateTerraformInfraConfig(createVPC bool, vpcID, natGatewayID, snatTableID, vpcCIDR string) (map[string]interface{}, error) {
-	chargeType, err := b.fetchEIPInternetChargeType()
-	if err != nil {
+	var (
+		natGateway = b.Shoot.Info.Spec.Cloud.Alicloud.Networks.NatGateway
+		bandwidth  = DefaultEIPBandwidth
+		chargeType string
+		err        error
+	)
+
+	if natGateway != nil && natGateway.EIPBandwidth != nil {
+		bandwidth = *natGateway.EIPBandwidth
+	}
+	if natGateway != nil && natGateway.EIPInternetChargeType != nil {
+		chargeType = *natGateway.EIPInternetChargeType
+	} else if chargeType, err = b.fetchEIPInternetChargeType(); err != nil {
 		return nil, err
 	}
 
@@ -141,12 +251,40 @@ func (b *AlicloudBotanist) generateTerraformInfraConfig(createVPC bool, vpcID, n
 		zones     = []map[string]interface{}{}
 	)
 
+	natGatewayPerZone := b.natGatewayPerZone()
+
 	for idx, zone := range b.Shoot.Info.Spec.Cloud.Alicloud.Zones {
+		var (
+			eipBandwidth  = bandwidth
+			eipChargeType = chargeType
+		)
+
+		if natConfig := b.natGatewayZoneConfig(zone); natConfig != nil {
+			if natConfig.EIPBandwidth != nil {
+				eipBandwidth = *natConfig.EIPBandwidth
+			}
+			if natConfig.EIPInternetChargeType != nil {
+				eipChargeType = *natConfig.EIPInternetChargeType
+			}
+		}
+
+		// The NAT gateway of a zone is created in the module of the zone, hence it is only passed if it is shared.
+		zoneNatGateway := map[string]interface{}{"create": natGatewayPerZone}
+		if !natGatewayPerZone {
+			zoneNatGateway["id"] = natGatewayID
+			zoneNatGateway["snatTableID"] = snatTableID
+		}
+
 		zones = append(zones, map[string]interface{}{
 			"name": zone,
 			"cidr": map[string]interface{}{
 				"worker": b.Shoot.Info.Spec.Cloud.Alicloud.Networks.Workers[idx],
 			},
+			"natGateway": zoneNatGateway,
+			"eip": map[string]interface{}{
+				"bandwidth":          eipBandwidth,
+				"internetChargeType": eipChargeType,
+			},
 		})
 	}
 
@@ -155,14 +293,12 @@ func (b *AlicloudBotanist) generateTerraformInfraConfig(createVPC bool, vpcID, n
 			"region": b.Shoot.Info.Spec.Cloud.Region,
 		},
 		"create": map[string]interface{}{
-			"vpc": createVPC,
+			"vpc":        createVPC,
+			"natGateway": createVPC && !natGatewayPerZone,
 		},
 		"vpc": map[string]interface{}{
-			"cidr":               vpcCIDR,
-			"id":                 vpcID,
-			"natGatewayID":       natGatewayID,
-			"snatTableID":        snatTableID,
-			"internetChargeType": chargeType,
+			"cidr": vpcCIDR,
+			"id":   vpcID,
 		},
 		"clusterName":  b.Shoot.SeedNamespace,
 		"sshPublicKey": string(sshSecret.Data[secrets.DataKeySSHAuthorizedKeys]),
@@ -170,6 +306,28 @@ func (b *AlicloudBotanist) generateTerraformInfraConfig(createVPC bool, vpcID, n
 	}, nil
 }
 
+// natGatewayPerZone returns true if an enhanced NAT gateway should be created for each zone of the Shoot.
+func (b *AlicloudBotanist) natGatewayPerZone() bool {
+	natGateway := b.Shoot.Info.Spec.Cloud.Alicloud.Networks.NatGateway
+	return natGateway != nil && natGateway.PerZone
+}
+
+// natGatewayZoneConfig returns the NAT configuration for the given zone or nil if there is none.
+func (b *AlicloudBotanist) natGatewayZoneConfig(zone string) *gardenv1beta1.AlicloudNatGatewayZone {
+	natGateway := b.Shoot.Info.Spec.Cloud.Alicloud.Networks.NatGateway
+	if natGateway == nil {
+		return nil
+	}
+	for _, natConfig := range natGateway.Zones {
+		if natConfig.Name == zone {
+			return &natConfig
+		}
+	}
+	return nil
+}
+
+// fetchEIPInternetChargeType returns the internet charge type of the EIP of the VPC's NAT gateway. It is used
+// to keep the charge type of existing Shoots stable if it is not specified explicitly.
 func (b *AlicloudBotanist) fetchEIPInternetChargeType() (string, error) {
 	var (
 		vpcID = "vpc_id"
@@ -190,21 +348,26 @@ func (b *AlicloudBotanist) fetchEIPInternetChargeType() (string, error) {
 }
 
 func (b *AlicloudBotanist) generateTerraformBackupVariablesEnvironment() map[string]string {
-	return terraformer.GenerateVariablesEnvironment(b.Seed.Secret, map[string]string{
+	return terraformer.GenerateVariablesEnvironment(b.Seed.BackupSecret, map[string]string{
 		"ACCESS_KEY_ID":     AccessKeyID,
 		"ACCESS_KEY_SECRET": AccessKeySecret,
 	})
 }
 
-func (b *AlicloudBotanist) generateTerraformBackupConfig() map[string]interface{} {
+func (b *AlicloudBotanist) generateTerraformBackupConfig() (map[string]interface{}, error) {
+	region, err := b.BackupRegion()
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]interface{}{
 		"alicloud": map[string]interface{}{
-			"region": b.Seed.Info.Spec.Cloud.Region,
+			"region": region,
 		},
 		"bucket": map[string]interface{}{
 			"name": b.Operation.BackupInfrastructure.Name,
 		},
-	}
+	}, nil
 }
 
 func cleanSnapshots(bucketName, storageEndpoint, accessKeyID, accessKeySecret string) error {
@@ -227,9 +390,10 @@ func cleanSnapshots(bucketName, storageEndpoint, accessKeyID, accessKeySecret st
 		for _, object := range lsRes.Objects {
 			snapshots = append(snapshots, object.Key)
 		}
-		_, err = bucket.DeleteObjects(snapshots)
-		if err != nil {
-			return err
+		if len(snapshots) > 0 {
+			if _, err := bucket.DeleteObjects(snapshots); err != nil {
+				return err
+			}
 		}
 		if !lsRes.IsTruncated {
 			break
@@ -237,3 +401,56 @@ func cleanSnapshots(bucketName, storageEndpoint, accessKeyID, accessKeySecret st
 	}
 	return nil
 }
+
+func probeBucket(bucketName, storageEndpoint, accessKeyID, accessKeySecret string) error {
+	client, err := oss.New(storageEndpoint, accessKeyID, accessKeySecret)
+	if err != nil {
+		return err
+	}
+
+	bucket, err := client.Bucket(bucketName)
+	if err != nil {
+		return err
+	}
+
+	if err := bucket.PutObject(common.BackupBucketProbeObjectName, strings.NewReader(time.Now().UTC().Format(time.RFC3339))); err != nil {
+		return fmt.Errorf("could not write object %q to bucket %q: %v", common.BackupBucketProbeObjectName, bucketName, err)
+	}
+	if err := bucket.DeleteObject(common.BackupBucketProbeObjectName); err != nil {
+		return fmt.Errorf("could not delete object %q from bucket %q: %v", common.BackupBucketProbeObjectName, bucketName, err)
+	}
+	return nil
+}
+
+// ListOrphanedInfrastructureResources lists the VPC, VSwitches, NAT gateways and EIPs of the Shoot which exist
+// in the Alicloud account but are not tracked in the Terraform state.
+func (b *AlicloudBotanist) ListOrphanedInfrastructureResources(ctx context.Context) ([]string, error) {
+	tf, err := b.NewShootTerraformer(common.TerraformerPurposeInfra)
+	if err != nil {
+		return nil, err
+	}
+	stateIdentifiers, err := tf.GetStateResourceIdentifiers()
+	if err != nil {
+		return nil, err
+	}
+
+	clusterName := b.Shoot.SeedNamespace
+	names := sets.NewString(clusterName + "-natgw")
+	// An existing VPC is not managed by Terraform.
+	if b.Shoot.Info.Spec.Cloud.Alicloud.Networks.VPC.ID == nil {
+		names.Insert(clusterName + "-vpc")
+	}
+	for idx, zone := range b.Shoot.Info.Spec.Cloud.Alicloud.Zones {
+		names.Insert(
+			fmt.Sprintf("%s-%s-vsw", clusterName, zone),
+			fmt.Sprintf("%s-natgw-z%d", clusterName, idx),
+			fmt.Sprintf("%s-eip-natgw-z%d", clusterName, idx),
+		)
+	}
+
+	resources, err := b.AlicloudClient.ListNetworkResources(names)
+	if err != nil {
+		return nil, err
+	}
+	return common.OrphanedInfrastructureResources(stateIdentifiers, resources), nil
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -36,11 +37,11 @@ func (b *AlicloudBotanist) GetMachineClassInfo() (classKind, classPlural, classC
 
 // GenerateMachineClassSecretData generates the secret data for the machine class secret (except the userData field
 // which is computed elsewhere).
-func (b *AlicloudBotanist) GenerateMachineClassSecretData() map[string][]byte {
+func (b *AlicloudBotanist) GenerateMachineClassSecretData() (map[string][]byte, error) {
 	return map[string][]byte{
 		machinev1alpha1.AlicloudAccessKeyID:     b.Shoot.Secret.Data[AccessKeyID],
 		machinev1alpha1.AlicloudAccessKeySecret: b.Shoot.Secret.Data[AccessKeySecret],
-	}
+	}, nil
 }
 
 // GenerateMachineConfig generates the configuration values for the cloud-specific machine class Helm chart. It
@@ -55,7 +56,6 @@ func (b *AlicloudBotanist) GenerateMachineConfig() ([]map[string]interface{}, op
 		zones               = b.Shoot.Info.Spec.Cloud.Alicloud.Zones
 		machineDeployments  = operation.MachineDeployments{}
 		machineClasses      = []map[string]interface{}{}
-		secretData          = b.GenerateMachineClassSecretData()
 		tfOutputNameVswitch = func(zoneIndex int) string {
 			return fmt.Sprintf("vswitch_id_z%d", zoneIndex)
 		}
@@ -72,55 +72,66 @@ func (b *AlicloudBotanist) GenerateMachineConfig() ([]map[string]interface{}, op
 	if err != nil {
 		return nil, nil, err
 	}
+	secretData, err := b.GenerateMachineClassSecretData()
+	if err != nil {
+		return nil, nil, err
+	}
 	for zoneIndex, zone := range zones {
 		for _, worker := range workers {
-			machineClassSpec := map[string]interface{}{
-				"imageID":         b.Shoot.Info.Spec.Cloud.Alicloud.MachineImage.ID,
-				"instanceType":    worker.MachineType,
-				"region":          b.Shoot.Info.Spec.Cloud.Region,
-				"zoneID":          zone,
-				"securityGroupID": stateVariables[securityGroupID],
-				"vSwitchID":       stateVariables[tfOutputNameVswitch(zoneIndex)],
-				"systemDisk": map[string]interface{}{
-					"category": worker.VolumeType,
-					"size":     common.DiskSize(worker.VolumeSize),
-				},
-				"instanceChargeType":      "PostPaid",
-				"internetChargeType":      "PayByTraffic",
-				"internetMaxBandwidthIn":  5,
-				"internetMaxBandwidthOut": 5,
-				"spotStrategy":            "NoSpot",
-				"tags": map[string]string{
-					fmt.Sprintf("kubernetes.io/cluster/%s", b.Shoot.SeedNamespace):     "1",
-					fmt.Sprintf("kubernetes.io/role/worker/%s", b.Shoot.SeedNamespace): "1",
-				},
-				"secret": map[string]interface{}{
-					UserData: b.Shoot.CloudConfigMap[worker.Name].Downloader.Content,
-				},
-				"keyPairName": stateVariables[keyPairName],
+			if _, _, ok := common.WorkerZoneIndex(worker.Worker, zones, zoneIndex); !ok {
+				continue
 			}
 
-			var (
-				machineClassSpecHash = common.MachineClassHash(machineClassSpec, b.Shoot.KubernetesMajorMinorVersion)
-				deploymentName       = fmt.Sprintf("%s-%s-%s", b.Shoot.SeedNamespace, worker.Name, zone)
-				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
-			)
-
-			machineDeployments = append(machineDeployments, operation.MachineDeployment{
-				Name:        deploymentName,
-				ClassName:   className,
-				Minimum:     worker.AutoScalerMin,
-				Maximum:     worker.AutoScalerMax,
-				Labels:      worker.Labels,
-				Annotations: worker.Annotations,
-				Taints:      worker.Taints,
-			})
-
-			machineClassSpec["name"] = className
-			machineClassSpec["secret"].(map[string]interface{})[AccessKeyID] = string(secretData[machinev1alpha1.AlicloudAccessKeyID])
-			machineClassSpec["secret"].(map[string]interface{})[AccessKeySecret] = string(secretData[machinev1alpha1.AlicloudAccessKeySecret])
-
-			machineClasses = append(machineClasses, machineClassSpec)
+			for _, variant := range common.WorkerMachineVariants(worker.Worker) {
+				machineClassSpec := map[string]interface{}{
+					"imageID":         common.WorkerMachineImage(worker.Worker, b.Shoot.Info.Spec.Cloud.Alicloud.MachineImage.ID),
+					"instanceType":    variant.MachineType,
+					"region":          b.Shoot.Info.Spec.Cloud.Region,
+					"zoneID":          zone,
+					"securityGroupID": stateVariables[securityGroupID],
+					"vSwitchID":       stateVariables[tfOutputNameVswitch(zoneIndex)],
+					"systemDisk": map[string]interface{}{
+						"category": worker.VolumeType,
+						"size":     common.DiskSize(worker.VolumeSize),
+					},
+					"instanceChargeType":      "PostPaid",
+					"internetChargeType":      "PayByTraffic",
+					"internetMaxBandwidthIn":  5,
+					"internetMaxBandwidthOut": 5,
+					"spotStrategy":            spotStrategy(variant.Spot),
+					"tags": utils.MergeStringMaps(b.Shoot.Info.Spec.Cloud.Tags, common.WorkerInstanceTags(worker.Worker, nil), map[string]string{
+						fmt.Sprintf("kubernetes.io/cluster/%s", b.Shoot.SeedNamespace):     "1",
+						fmt.Sprintf("kubernetes.io/role/worker/%s", b.Shoot.SeedNamespace): "1",
+					}),
+					"secret": map[string]interface{}{
+						UserData: b.Shoot.CloudConfigMap[worker.Name].Downloader.Content,
+					},
+					"keyPairName": stateVariables[keyPairName],
+				}
+
+				var (
+					machineClassSpecHash = common.MachineClassHash(machineClassSpec, b.Shoot.KubernetesMajorMinorVersion)
+					deploymentName       = fmt.Sprintf("%s-%s-%s%s", b.Shoot.SeedNamespace, worker.Name, zone, variant.Suffix)
+					className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
+				)
+
+				machineDeployments = append(machineDeployments, operation.MachineDeployment{
+					Name:        deploymentName,
+					ClassName:   className,
+					Minimum:     variant.Minimum,
+					Maximum:     variant.Maximum,
+					Labels:      worker.Labels,
+					Annotations: worker.Annotations,
+					Taints:      worker.Taints,
+					Priority:    variant.Priority,
+				})
+
+				machineClassSpec["name"] = className
+				machineClassSpec["secret"].(map[string]interface{})[AccessKeyID] = string(secretData[machinev1alpha1.AlicloudAccessKeyID])
+				machineClassSpec["secret"].(map[string]interface{})[AccessKeySecret] = string(secretData[machinev1alpha1.AlicloudAccessKeySecret])
+
+				machineClasses = append(machineClasses, machineClassSpec)
+			}
 
 		}
 	}
@@ -128,6 +139,20 @@ func (b *AlicloudBotanist) GenerateMachineConfig() ([]map[string]interface{}, op
 	return machineClasses, machineDeployments, nil
 }
 
+// GetMachineDeploymentNames returns the names of the MachineDeployments which may exist for the worker pools
+// in the current zones of the Shoot (regardless of the zones selected by the individual worker pools).
+func (b *AlicloudBotanist) GetMachineDeploymentNames() sets.String {
+	names := sets.NewString()
+	for _, zone := range b.Shoot.Info.Spec.Cloud.Alicloud.Zones {
+		for _, worker := range b.Shoot.Info.Spec.Cloud.Alicloud.Workers {
+			for _, variant := range common.WorkerMachineVariants(worker.Worker) {
+				names.Insert(fmt.Sprintf("%s-%s-%s%s", b.Shoot.SeedNamespace, worker.Name, zone, variant.Suffix))
+			}
+		}
+	}
+	return names
+}
+
 // ListMachineClasses returns two sets of strings whereas the first contains the names of all machine
 // classes, and the second the names of all referenced secrets.
 func (b *AlicloudBotanist) ListMachineClasses() (sets.String, sets.String, error) {
@@ -170,3 +195,11 @@ func (b *AlicloudBotanist) CleanupMachineClasses(existingMachineDeployments oper
 
 	return nil
 }
+
+// spotStrategy returns the spot strategy of the machine class. Spot instances are bid for at the current market price.
+func spotStrategy(spot bool) string {
+	if spot {
+		return "SpotAsPriceGo"
+	}
+	return "NoSpot"
+}
@@ -37,4 +37,7 @@ const (
 	BucketName = "bucketName"
 	// StorageEndpoint is a constant for the access endpoint of the Alicloud OSS object storage.
 	StorageEndpoint = "storageEndpoint"
+
+	// DefaultEIPBandwidth is the default bandwidth (in Mbps) of the EIPs used for the NAT gateways.
+	DefaultEIPBandwidth int32 = 100
 )
@@ -15,18 +15,22 @@
 package awsbotanist
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation/common"
+
+	corev1 "k8s.io/api/core/v1"
 )
 
 // DeployKube2IAMResources creates the respective IAM roles which have been specified in the Shoot manifest
 // addon section. Moreover, some default IAM roles will be created.
-func (b *AWSBotanist) DeployKube2IAMResources() error {
+func (b *AWSBotanist) DeployKube2IAMResources(ctx context.Context) error {
 	if !b.Shoot.Kube2IAMEnabled() {
-		return b.DestroyKube2IAMResources()
+		return b.DestroyKube2IAMResources(ctx)
 	}
 
 	values, err := b.generateTerraformKube2IAMConfig(b.Shoot.Info.Spec.Addons.Kube2IAM.Roles)
@@ -42,19 +46,19 @@ func (b *AWSBotanist) DeployKube2IAMResources() error {
 	return tf.
 		SetVariablesEnvironment(b.generateTerraformInfraVariablesEnvironment()).
 		InitializeWith(b.ChartInitializer("aws-kube2iam", values)).
-		Apply()
+		Apply(ctx)
 }
 
 // DestroyKube2IAMResources destroy the kube2iam resources created by Terraform. This comprises IAM roles and
 // policies.
-func (b *AWSBotanist) DestroyKube2IAMResources() error {
+func (b *AWSBotanist) DestroyKube2IAMResources(ctx context.Context) error {
 	tf, err := b.NewShootTerraformer(common.TerraformerPurposeKube2IAM)
 	if err != nil {
 		return err
 	}
 	return tf.
 		SetVariablesEnvironment(b.generateTerraformInfraVariablesEnvironment()).
-		Destroy()
+		Destroy(ctx)
 }
 
 // generateTerraformKube2IAMConfig creates the Terraform variables and the Terraform config (for kube2iam)
@@ -132,26 +136,38 @@ func (b *AWSBotanist) GenerateKube2IAMConfig() (map[string]interface{}, error) {
 
 // GenerateStorageClassesConfig generates values which are required to render the chart shoot-storageclasses properly.
 func (b *AWSBotanist) GenerateStorageClassesConfig() (map[string]interface{}, error) {
-	return map[string]interface{}{
-		"StorageClasses": []map[string]interface{}{
-			{
-				"Name":           "default",
-				"IsDefaultClass": true,
-				"Provisioner":    "kubernetes.io/aws-ebs",
-				"Parameters": map[string]interface{}{
-					"type": "gp2",
-				},
+	return b.Shoot.ComputeStorageClasses([]map[string]interface{}{
+		{
+			"Name":           "default",
+			"IsDefaultClass": true,
+			"Provisioner":    "kubernetes.io/aws-ebs",
+			"Parameters": map[string]interface{}{
+				"type": "gp2",
 			},
-			{
-				"Name":           "gp2",
-				"IsDefaultClass": false,
-				"Provisioner":    "kubernetes.io/aws-ebs",
-				"Parameters": map[string]interface{}{
-					"type": "gp2",
-				},
+		},
+		{
+			"Name":           "gp2",
+			"IsDefaultClass": false,
+			"Provisioner":    "kubernetes.io/aws-ebs",
+			"Parameters": map[string]interface{}{
+				"type": "gp2",
 			},
 		},
-	}, nil
+	}, corev1.LabelZoneFailureDomain, b.storageClassParameters), nil
+}
+
+// storageClassParameters computes the provisioner and its parameters for the given StorageClass of the Shoot.
+func (b *AWSBotanist) storageClassParameters(class gardenv1beta1.StorageClass) (string, map[string]interface{}) {
+	parameters := map[string]interface{}{
+		"type": "gp2",
+	}
+	if class.VolumeType != nil {
+		parameters["type"] = *class.VolumeType
+	}
+	if class.Encrypted != nil {
+		parameters["encrypted"] = strconv.FormatBool(*class.Encrypted)
+	}
+	return "kubernetes.io/aws-ebs", parameters
 }
 
 // GenerateNginxIngressConfig generates values which are required to render the chart nginx-ingress properly.
@@ -21,6 +21,8 @@ import (
 	"github.com/gardener/gardener/pkg/client/aws"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
+
+	"golang.org/x/time/rate"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -30,6 +32,7 @@ func New(o *operation.Operation, purpose string) (*AWSBotanist, error) {
 		cloudProvider gardenv1beta1.CloudProvider
 		secret        *corev1.Secret
 		region        string
+		rateLimiter   *rate.Limiter
 	)
 
 	switch purpose {
@@ -37,10 +40,15 @@ func New(o *operation.Operation, purpose string) (*AWSBotanist, error) {
 		cloudProvider = o.Shoot.CloudProvider
 		secret = o.Shoot.Secret
 		region = o.Shoot.Info.Spec.Cloud.Region
+		rateLimiter = o.CloudAPIRateLimiter
 	case common.CloudPurposeSeed:
 		cloudProvider = o.Seed.CloudProvider
 		secret = o.Seed.Secret
 		region = o.Seed.Info.Spec.Cloud.Region
+	case common.CloudPurposeBackup:
+		cloudProvider = o.Seed.BackupProvider
+		secret = o.Seed.BackupSecret
+		region = o.Seed.GetBackupRegion()
 	}
 
 	if cloudProvider != gardenv1beta1.CloudProviderAWS {
@@ -50,7 +58,7 @@ func New(o *operation.Operation, purpose string) (*AWSBotanist, error) {
 	return &AWSBotanist{
 		Operation:         o,
 		CloudProviderName: "aws",
-		AWSClient:         aws.NewClient(string(secret.Data[AccessKeyID]), string(secret.Data[SecretAccessKey]), region),
+		AWSClient:         aws.NewClientWithRateLimiter(string(secret.Data[AccessKeyID]), string(secret.Data[SecretAccessKey]), region, rateLimiter),
 	}, nil
 }
 
@@ -58,3 +66,8 @@ func New(o *operation.Operation, purpose string) (*AWSBotanist, error) {
 func (b *AWSBotanist) GetCloudProviderName() string {
 	return b.CloudProviderName
 }
+
+// GetNetworkMTU returns the MTU of the network the nodes are connected to.
+func (b *AWSBotanist) GetNetworkMTU() int32 {
+	return common.DefaultNetworkMTU
+}
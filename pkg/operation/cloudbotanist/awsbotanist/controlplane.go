@@ -176,10 +176,15 @@ func (b *AWSBotanist) GenerateEtcdBackupConfig() (map[string][]byte, map[string]
 		return nil, nil, err
 	}
 
+	region, err := b.BackupRegion()
+	if err != nil {
+		return nil, nil, err
+	}
+
 	secretData := map[string][]byte{
-		Region:          []byte(b.Seed.Info.Spec.Cloud.Region),
-		AccessKeyID:     b.Seed.Secret.Data[AccessKeyID],
-		SecretAccessKey: b.Seed.Secret.Data[SecretAccessKey],
+		Region:          []byte(region),
+		AccessKeyID:     b.Seed.BackupSecret.Data[AccessKeyID],
+		SecretAccessKey: b.Seed.BackupSecret.Data[SecretAccessKey],
 	}
 
 	backupConfigData := map[string]interface{}{
@@ -15,10 +15,12 @@
 package awsbotanist
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/client/aws"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
 	"github.com/gardener/gardener/pkg/utils/flow"
@@ -28,23 +30,39 @@ import (
 )
 
 // DeployInfrastructure kicks off a Terraform job which deploys the infrastructure.
-func (b *AWSBotanist) DeployInfrastructure() error {
+func (b *AWSBotanist) DeployInfrastructure(ctx context.Context) error {
 	var (
 		createVPC         = true
 		vpcID             = "${aws_vpc.vpc.id}"
 		internetGatewayID = "${aws_internet_gateway.igw.id}"
 		vpcCIDR           = ""
+		existingSubnets   map[string]map[string]interface{}
+		natGatewayIDs     map[string]string
+		err               error
 	)
 
 	// check if we should use an existing VPC or create a new one
 	if b.Shoot.Info.Spec.Cloud.AWS.Networks.VPC.ID != nil {
 		createVPC = false
 		vpcID = *b.Shoot.Info.Spec.Cloud.AWS.Networks.VPC.ID
-		igwID, err := b.AWSClient.GetInternetGateway(vpcID)
-		if err != nil {
+		if igwID := b.Shoot.Info.Spec.Cloud.AWS.Networks.VPC.InternetGatewayID; igwID != nil {
+			internetGatewayID = *igwID
+		} else {
+			igwID, err := b.AWSClient.GetInternetGateway(vpcID)
+			if err != nil {
+				return gardencorev1alpha1helper.DetermineError(err.Error())
+			}
+			internetGatewayID = igwID
+		}
+		if existingSubnets, err = b.checkExistingSubnets(vpcID); err != nil {
+			return err
+		}
+		if err := b.tagExistingSubnets(); err != nil {
+			return gardencorev1alpha1helper.DetermineError(err.Error())
+		}
+		if natGatewayIDs, err = b.determineExistingNATGateways(vpcID); err != nil {
 			return gardencorev1alpha1helper.DetermineError(err.Error())
 		}
-		internetGatewayID = igwID
 	} else if b.Shoot.Info.Spec.Cloud.AWS.Networks.VPC.CIDR != nil {
 		vpcCIDR = string(*b.Shoot.Info.Spec.Cloud.AWS.Networks.VPC.CIDR)
 	}
@@ -55,18 +73,107 @@ func (b *AWSBotanist) DeployInfrastructure() error {
 	}
 	return tf.
 		SetVariablesEnvironment(b.generateTerraformInfraVariablesEnvironment()).
-		InitializeWith(b.ChartInitializer("aws-infra", b.generateTerraformInfraConfig(createVPC, vpcID, internetGatewayID, vpcCIDR))).
-		Apply()
+		InitializeWith(b.ChartInitializer("aws-infra", b.generateTerraformInfraConfig(createVPC, vpcID, internetGatewayID, vpcCIDR, existingSubnets, natGatewayIDs))).
+		Apply(ctx)
+}
+
+// checkExistingSubnets verifies that all existing subnets referenced in the Shoot specification belong to the
+// given <vpcID> and are located in the zone they have been specified for. It returns the ids and the actual CIDRs
+// of the subnets per zone, as the security group rules must allow the ranges of the existing subnets rather than
+// the ones of the Shoot specification.
+func (b *AWSBotanist) checkExistingSubnets(vpcID string) (map[string]map[string]interface{}, error) {
+	existingSubnets := map[string]map[string]interface{}{}
+
+	for _, subnets := range b.Shoot.Info.Spec.Cloud.AWS.Networks.ExistingSubnets {
+		var (
+			ids   = map[string]interface{}{}
+			cidrs = map[string]interface{}{}
+		)
+
+		for role, subnetID := range map[string]string{"worker": subnets.Workers, "public": subnets.Public, "internal": subnets.Internal} {
+			subnet, err := b.AWSClient.GetSubnetInfo(subnetID)
+			if err != nil {
+				return nil, gardencorev1alpha1helper.DetermineError(err.Error())
+			}
+			if subnet.VPCID != vpcID {
+				return nil, fmt.Errorf("subnet %s does not belong to vpc %s", subnetID, vpcID)
+			}
+			if subnet.AvailabilityZone != subnets.Zone {
+				return nil, fmt.Errorf("subnet %s is located in zone %s instead of %s", subnetID, subnet.AvailabilityZone, subnets.Zone)
+			}
+			ids[role] = subnetID
+			cidrs[role] = subnet.CIDR
+		}
+
+		existingSubnets[subnets.Zone] = map[string]interface{}{
+			"ids":   ids,
+			"cidrs": cidrs,
+		}
+	}
+	return existingSubnets, nil
+}
+
+// tagExistingSubnets tags the existing subnets referenced in the Shoot specification so that they are discovered
+// by the cloud controller manager for load balancers. The cluster tag uses the value "shared" (in contrast to the
+// value "1" used for the resources managed by Terraform) as the subnets do not belong to the Shoot.
+func (b *AWSBotanist) tagExistingSubnets() error {
+	clusterTag := fmt.Sprintf("kubernetes.io/cluster/%s", b.Shoot.SeedNamespace)
+
+	for _, subnets := range b.Shoot.Info.Spec.Cloud.AWS.Networks.ExistingSubnets {
+		if err := b.AWSClient.TagResources([]string{subnets.Workers}, map[string]string{clusterTag: "shared"}); err != nil {
+			return err
+		}
+		if err := b.AWSClient.TagResources([]string{subnets.Public}, map[string]string{clusterTag: "shared", "kubernetes.io/role/elb": "use"}); err != nil {
+			return err
+		}
+		if err := b.AWSClient.TagResources([]string{subnets.Internal}, map[string]string{clusterTag: "shared", "kubernetes.io/role/internal-elb": "use"}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// untagExistingSubnets removes the cluster tag which has been added by tagExistingSubnets from the existing subnets.
+// The role tags are kept as they might be used by other clusters sharing the subnets.
+func (b *AWSBotanist) untagExistingSubnets(ctx context.Context) error {
+	var subnetIDs []string
+	for _, subnets := range b.Shoot.Info.Spec.Cloud.AWS.Networks.ExistingSubnets {
+		subnetIDs = append(subnetIDs, subnets.Workers, subnets.Public, subnets.Internal)
+	}
+	if len(subnetIDs) == 0 {
+		return nil
+	}
+	return b.AWSClient.UntagResources(subnetIDs, fmt.Sprintf("kubernetes.io/cluster/%s", b.Shoot.SeedNamespace))
+}
+
+// determineExistingNATGateways returns the ids of the existing NAT gateways per zone which shall be used instead
+// of creating new ones. NAT gateways without an id in the Shoot specification are discovered in the given <vpcID>.
+func (b *AWSBotanist) determineExistingNATGateways(vpcID string) (map[string]string, error) {
+	natGatewayIDs := map[string]string{}
+
+	for _, natGateway := range b.Shoot.Info.Spec.Cloud.AWS.Networks.ExistingNATGateways {
+		if natGateway.ID != nil {
+			natGatewayIDs[natGateway.Zone] = *natGateway.ID
+			continue
+		}
+
+		id, err := b.AWSClient.GetNATGateway(vpcID, natGateway.Zone)
+		if err != nil {
+			return nil, err
+		}
+		natGatewayIDs[natGateway.Zone] = id
+	}
+	return natGatewayIDs, nil
 }
 
 // DestroyInfrastructure kicks off a Terraform job which destroys the infrastructure.
-func (b *AWSBotanist) DestroyInfrastructure() error {
+func (b *AWSBotanist) DestroyInfrastructure(ctx context.Context) error {
 	tf, err := b.NewShootTerraformer(common.TerraformerPurposeInfra)
 	if err != nil {
 		return err
 	}
 
-	configExists, err := tf.ConfigExists()
+	configExists, err := tf.ConfigExists(ctx)
 	if err != nil {
 		return err
 	}
@@ -76,19 +183,25 @@ func (b *AWSBotanist) DestroyInfrastructure() error {
 
 		destroyKubernetesLoadBalancersAndSecurityGroups = g.Add(flow.Task{
 			Name: "Destroying Kubernetes load balancers and security groups",
-			Fn:   flow.SimpleTaskFn(b.destroyKubernetesLoadBalancersAndSecurityGroups).RetryUntilTimeout(10*time.Second, 5*time.Minute).DoIf(configExists),
+			Fn:   flow.TaskFn(b.destroyKubernetesLoadBalancersAndSecurityGroups).RetryUntilTimeout(10*time.Second, 5*time.Minute).DoIf(configExists),
 		})
 
-		_ = g.Add(flow.Task{
+		destroyInfrastructure = g.Add(flow.Task{
 			Name:         "Destroying Shoot infrastructure",
-			Fn:           flow.SimpleTaskFn(tf.SetVariablesEnvironment(b.generateTerraformInfraVariablesEnvironment()).Destroy),
+			Fn:           flow.TaskFn(tf.SetVariablesEnvironment(b.generateTerraformInfraVariablesEnvironment()).Destroy),
 			Dependencies: flow.NewTaskIDs(destroyKubernetesLoadBalancersAndSecurityGroups),
 		})
 
+		_ = g.Add(flow.Task{
+			Name:         "Removing the cluster tag from existing subnets",
+			Fn:           flow.TaskFn(b.untagExistingSubnets),
+			Dependencies: flow.NewTaskIDs(destroyInfrastructure),
+		})
+
 		f = g.Compile()
 	)
 
-	if err := f.Run(flow.Opts{Logger: b.Logger}); err != nil {
+	if err := f.Run(flow.Opts{Logger: b.Logger, Context: ctx}); err != nil {
 		return flow.Causes(err)
 	}
 	return nil
@@ -106,7 +219,7 @@ func (b *AWSBotanist) generateTerraformInfraVariablesEnvironment() map[string]st
 
 // generateTerraformInfraConfig creates the Terraform variables and the Terraform config (for the infrastructure)
 // and returns them (these values will be stored as a ConfigMap and a Secret in the Garden cluster.
-func (b *AWSBotanist) generateTerraformInfraConfig(createVPC bool, vpcID, internetGatewayID, vpcCIDR string) map[string]interface{} {
+func (b *AWSBotanist) generateTerraformInfraConfig(createVPC bool, vpcID, internetGatewayID, vpcCIDR string, existingSubnets map[string]map[string]interface{}, natGatewayIDs map[string]string) map[string]interface{} {
 	var (
 		sshSecret      = b.Secrets["ssh-keypair"]
 		dhcpDomainName = "ec2.internal"
@@ -118,14 +231,29 @@ func (b *AWSBotanist) generateTerraformInfraConfig(createVPC bool, vpcID, intern
 	}
 
 	for zoneIndex, zone := range b.Shoot.Info.Spec.Cloud.AWS.Zones {
-		zones = append(zones, map[string]interface{}{
+		values := map[string]interface{}{
 			"name": zone,
 			"cidr": map[string]interface{}{
 				"worker":   b.Shoot.Info.Spec.Cloud.AWS.Networks.Workers[zoneIndex],
 				"public":   b.Shoot.Info.Spec.Cloud.AWS.Networks.Public[zoneIndex],
 				"internal": b.Shoot.Info.Spec.Cloud.AWS.Networks.Internal[zoneIndex],
 			},
-		})
+			"natGateway": map[string]interface{}{
+				"create": true,
+				"id":     fmt.Sprintf("${aws_nat_gateway.natgw_z%d.id}", zoneIndex),
+			},
+		}
+		if subnets, ok := existingSubnets[zone]; ok {
+			values["existingSubnets"] = subnets["ids"]
+			values["cidr"] = subnets["cidrs"]
+		}
+		if natGatewayID, ok := natGatewayIDs[zone]; ok {
+			values["natGateway"] = map[string]interface{}{
+				"create": false,
+				"id":     natGatewayID,
+			}
+		}
+		zones = append(zones, values)
 	}
 
 	return map[string]interface{}{
@@ -142,12 +270,13 @@ func (b *AWSBotanist) generateTerraformInfraConfig(createVPC bool, vpcID, intern
 			"dhcpDomainName":    dhcpDomainName,
 			"internetGatewayID": internetGatewayID,
 		},
-		"clusterName": b.Shoot.SeedNamespace,
-		"zones":       zones,
+		"clusterName":    b.Shoot.SeedNamespace,
+		"additionalTags": b.Shoot.Info.Spec.Cloud.Tags,
+		"zones":          zones,
 	}
 }
 
-func (b *AWSBotanist) destroyKubernetesLoadBalancersAndSecurityGroups() error {
+func (b *AWSBotanist) destroyKubernetesLoadBalancersAndSecurityGroups(ctx context.Context) error {
 	t, err := b.NewShootTerraformer(common.TerraformerPurposeInfra)
 	if err != nil {
 		return err
@@ -172,23 +301,23 @@ func (b *AWSBotanist) destroyKubernetesLoadBalancersAndSecurityGroups() error {
 	vpcID := stateVariables[vpcIDKey]
 
 	// Find load balancers and security groups.
-	loadBalancers, err := b.AWSClient.ListKubernetesELBs(vpcID, b.Shoot.SeedNamespace)
+	loadBalancers, err := b.AWSClient.ListKubernetesELBs(ctx, vpcID, b.Shoot.SeedNamespace)
 	if err != nil {
 		return err
 	}
-	securityGroups, err := b.AWSClient.ListKubernetesSecurityGroups(vpcID, b.Shoot.SeedNamespace)
+	securityGroups, err := b.AWSClient.ListKubernetesSecurityGroups(ctx, vpcID, b.Shoot.SeedNamespace)
 	if err != nil {
 		return err
 	}
 
 	// Destroy load balancers and security groups.
 	for _, loadBalancerName := range loadBalancers {
-		if err := b.AWSClient.DeleteELB(loadBalancerName); err != nil {
+		if err := b.AWSClient.DeleteELB(ctx, loadBalancerName); err != nil {
 			return err
 		}
 	}
 	for _, securityGroupID := range securityGroups {
-		if err := b.AWSClient.DeleteSecurityGroup(securityGroupID); err != nil {
+		if err := b.AWSClient.DeleteSecurityGroup(ctx, securityGroupID); err != nil {
 			return err
 		}
 	}
@@ -196,35 +325,95 @@ func (b *AWSBotanist) destroyKubernetesLoadBalancersAndSecurityGroups() error {
 	return nil
 }
 
+// ListOrphanedInfrastructureResources lists all EC2 resources which have been tagged by the Terraform infrastructure
+// configuration of the Shoot but which are not contained in the Terraform state (anymore), e.g., left-overs of failed
+// destroy runs. It returns them in the form "<type>/<id>".
+func (b *AWSBotanist) ListOrphanedInfrastructureResources(ctx context.Context) ([]string, error) {
+	tf, err := b.NewShootTerraformer(common.TerraformerPurposeInfra)
+	if err != nil {
+		return nil, err
+	}
+	stateIdentifiers, err := tf.GetStateResourceIdentifiers()
+	if err != nil {
+		return nil, err
+	}
+
+	taggedResources, err := b.AWSClient.ListTerraformManagedResources(ctx, b.Shoot.SeedNamespace)
+	if err != nil {
+		return nil, err
+	}
+	return common.OrphanedInfrastructureResources(stateIdentifiers, taggedResources), nil
+}
+
 // DeployBackupInfrastructure kicks off a Terraform job which deploys the infrastructure resources for backup.
 // It sets up the User and the Bucket to store the backups. Allocate permission to the User to access the bucket.
-func (b *AWSBotanist) DeployBackupInfrastructure() error {
+func (b *AWSBotanist) DeployBackupInfrastructure(ctx context.Context) error {
 	tf, err := b.NewBackupInfrastructureTerraformer()
 	if err != nil {
 		return err
 	}
+	values, err := b.generateTerraformBackupConfig()
+	if err != nil {
+		return err
+	}
 	return tf.
 		SetVariablesEnvironment(b.generateTerraformBackupVariablesEnvironment()).
-		InitializeWith(b.ChartInitializer("aws-backup", b.generateTerraformBackupConfig())).
-		Apply()
+		InitializeWith(b.ChartInitializer("aws-backup", values)).
+		Apply(ctx)
 }
 
 // DestroyBackupInfrastructure kicks off a Terraform job which destroys the infrastructure for etcd backup.
-func (b *AWSBotanist) DestroyBackupInfrastructure() error {
+func (b *AWSBotanist) DestroyBackupInfrastructure(ctx context.Context) error {
 	tf, err := b.NewBackupInfrastructureTerraformer()
 	if err != nil {
 		return err
 	}
 	return tf.
 		SetVariablesEnvironment(b.generateTerraformBackupVariablesEnvironment()).
-		Destroy()
+		Destroy(ctx)
+}
+
+// ProbeBackupInfrastructure verifies that the backup bucket exists, that it is writable, and that the credentials of
+// the Seed are valid by writing and deleting a probe object.
+func (b *AWSBotanist) ProbeBackupInfrastructure(ctx context.Context) error {
+	bucketName := "bucketName"
+
+	tf, err := b.NewBackupInfrastructureTerraformer()
+	if err != nil {
+		return err
+	}
+	stateVariables, err := tf.GetStateOutputVariables(bucketName)
+	if err != nil {
+		return err
+	}
+
+	region, err := b.BackupRegion()
+	if err != nil {
+		return err
+	}
+
+	// The backup bucket may be located in a different region than the Seed.
+	awsClient := aws.NewClient(string(b.Seed.BackupSecret.Data[AccessKeyID]), string(b.Seed.BackupSecret.Data[SecretAccessKey]), region)
+	return awsClient.ProbeBucket(ctx, stateVariables[bucketName], common.BackupBucketProbeObjectName)
+}
+
+// PurgeBackupInfrastructure deletes all objects of the backup bucket and the bucket itself directly via the AWS API,
+// i.e., without relying on the Terraform state.
+func (b *AWSBotanist) PurgeBackupInfrastructure(ctx context.Context) error {
+	region, err := b.BackupRegion()
+	if err != nil {
+		return err
+	}
+
+	awsClient := aws.NewClient(string(b.Seed.BackupSecret.Data[AccessKeyID]), string(b.Seed.BackupSecret.Data[SecretAccessKey]), region)
+	return awsClient.PurgeBucket(ctx, b.Operation.BackupInfrastructure.Name)
 }
 
 // generateTerraformBackupVariablesEnvironment generates the environment containing the credentials which
 // are required to validate/apply/destroy the Terraform configuration. These environment must contain
 // Terraform variables which are prefixed with TF_VAR_.
 func (b *AWSBotanist) generateTerraformBackupVariablesEnvironment() map[string]string {
-	return terraformer.GenerateVariablesEnvironment(b.Seed.Secret, map[string]string{
+	return terraformer.GenerateVariablesEnvironment(b.Seed.BackupSecret, map[string]string{
 		"ACCESS_KEY_ID":     AccessKeyID,
 		"SECRET_ACCESS_KEY": SecretAccessKey,
 	})
@@ -232,14 +421,19 @@ func (b *AWSBotanist) generateTerraformBackupVariablesEnvironment() map[string]s
 
 // generateTerraformBackupConfig creates the Terraform variables and the Terraform config (for the backup)
 // and returns them.
-func (b *AWSBotanist) generateTerraformBackupConfig() map[string]interface{} {
+func (b *AWSBotanist) generateTerraformBackupConfig() (map[string]interface{}, error) {
+	region, err := b.BackupRegion()
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]interface{}{
 		"aws": map[string]interface{}{
-			"region": b.Seed.Info.Spec.Cloud.Region,
+			"region": region,
 		},
 		"bucket": map[string]interface{}{
 			"name": b.Operation.BackupInfrastructure.Name,
 		},
 		"clusterName": b.Operation.BackupInfrastructure.Name,
-	}
+	}, nil
 }
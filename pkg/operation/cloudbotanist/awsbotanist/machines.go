@@ -17,8 +17,11 @@ package awsbotanist
 import (
 	"fmt"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -35,11 +38,11 @@ func (b *AWSBotanist) GetMachineClassInfo() (classKind, classPlural, classChartN
 
 // GenerateMachineClassSecretData generates the secret data for the machine class secret (except the userData field
 // which is computed elsewhere).
-func (b *AWSBotanist) GenerateMachineClassSecretData() map[string][]byte {
+func (b *AWSBotanist) GenerateMachineClassSecretData() (map[string][]byte, error) {
 	return map[string][]byte{
 		machinev1alpha1.AWSAccessKeyID:     b.Shoot.Secret.Data[AccessKeyID],
 		machinev1alpha1.AWSSecretAccessKey: b.Shoot.Secret.Data[SecretAccessKey],
-	}
+	}, nil
 }
 
 // GenerateMachineConfig generates the configuration values for the cloud-specific machine class Helm chart. It
@@ -53,7 +56,6 @@ func (b *AWSBotanist) GenerateMachineConfig() ([]map[string]interface{}, operati
 		outputVariables    = []string{iamInstanceProfile, keyName, securityGroup}
 		workers            = b.Shoot.Info.Spec.Cloud.AWS.Workers
 		zones              = b.Shoot.Info.Spec.Cloud.AWS.Zones
-		zoneLen            = len(zones)
 
 		machineDeployments = operation.MachineDeployments{}
 		machineClasses     = []map[string]interface{}{}
@@ -75,11 +77,60 @@ func (b *AWSBotanist) GenerateMachineConfig() ([]map[string]interface{}, operati
 	if err != nil {
 		return nil, nil, err
 	}
+	secretData, err := b.GenerateMachineClassSecretData()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tags := map[string]string{}
+	for key, value := range b.Shoot.Info.Spec.Cloud.Tags {
+		tags[key] = value
+	}
+	tags[fmt.Sprintf("kubernetes.io/cluster/%s", b.Shoot.SeedNamespace)] = "1"
+	tags["kubernetes.io/role/node"] = "1"
 
 	for zoneIndex := range zones {
 		for _, worker := range workers {
+			workerZoneIndex, workerZoneLen, ok := common.WorkerZoneIndex(worker.Worker, zones, zoneIndex)
+			if !ok {
+				continue
+			}
+
+			ami, err := b.workerAMI(worker.Worker)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			ebs := map[string]interface{}{
+				"volumeSize": common.DiskSize(worker.VolumeSize),
+				"volumeType": worker.VolumeType,
+			}
+			if worker.VolumeIOPS != nil {
+				ebs["iops"] = *worker.VolumeIOPS
+			}
+			if worker.VolumeThroughput != nil {
+				ebs["throughput"] = *worker.VolumeThroughput
+			}
+
+			blockDevices := []map[string]interface{}{
+				{
+					"ebs": ebs,
+				},
+			}
+			for i, volume := range worker.DataVolumes {
+				blockDevices = append(blockDevices, map[string]interface{}{
+					"deviceName": dataVolumeDeviceName(i),
+					"ebs": map[string]interface{}{
+						"volumeSize":          common.DiskSize(volume.Size),
+						"volumeType":          common.DataVolumeType(volume, worker.VolumeType),
+						"encrypted":           common.DataVolumeEncrypted(volume),
+						"deleteOnTermination": true,
+					},
+				})
+			}
+
 			machineClassSpec := map[string]interface{}{
-				"ami":                b.Shoot.Info.Spec.Cloud.AWS.MachineImage.AMI,
+				"ami":                common.WorkerMachineImage(worker.Worker, ami),
 				"region":             b.Shoot.Info.Spec.Cloud.Region,
 				"machineType":        worker.MachineType,
 				"iamInstanceProfile": stateVariables[iamInstanceProfile],
@@ -90,37 +141,26 @@ func (b *AWSBotanist) GenerateMachineConfig() ([]map[string]interface{}, operati
 						"securityGroupIDs": []string{stateVariables[securityGroup]},
 					},
 				},
-				"tags": map[string]string{
-					fmt.Sprintf("kubernetes.io/cluster/%s", b.Shoot.SeedNamespace): "1",
-					"kubernetes.io/role/node":                                      "1",
-				},
+				"tags": utils.MergeStringMaps(common.WorkerInstanceTags(worker.Worker, nil), tags),
 				"secret": map[string]interface{}{
 					"cloudConfig": b.Shoot.CloudConfigMap[worker.Name].Downloader.Content,
 				},
-				"blockDevices": []map[string]interface{}{
-					{
-						"ebs": map[string]interface{}{
-							"volumeSize": common.DiskSize(worker.VolumeSize),
-							"volumeType": worker.VolumeType,
-						},
-					},
-				},
+				"blockDevices": blockDevices,
 			}
 
 			var (
 				machineClassSpecHash = common.MachineClassHash(machineClassSpec, b.Shoot.KubernetesMajorMinorVersion)
 				deploymentName       = fmt.Sprintf("%s-%s-z%d", b.Shoot.SeedNamespace, worker.Name, zoneIndex+1)
 				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
-				secretData           = b.GenerateMachineClassSecretData()
 			)
 
 			machineDeployments = append(machineDeployments, operation.MachineDeployment{
 				Name:           deploymentName,
 				ClassName:      className,
-				Minimum:        common.DistributeOverZones(zoneIndex, worker.AutoScalerMin, zoneLen),
-				Maximum:        common.DistributeOverZones(zoneIndex, worker.AutoScalerMax, zoneLen),
-				MaxSurge:       common.DistributePositiveIntOrPercent(zoneIndex, *worker.MaxSurge, zoneLen, worker.AutoScalerMax),
-				MaxUnavailable: common.DistributePositiveIntOrPercent(zoneIndex, *worker.MaxUnavailable, zoneLen, worker.AutoScalerMin),
+				Minimum:        common.DistributeOverZones(workerZoneIndex, worker.AutoScalerMin, workerZoneLen),
+				Maximum:        common.DistributeOverZones(workerZoneIndex, worker.AutoScalerMax, workerZoneLen),
+				MaxSurge:       common.DistributePositiveIntOrPercent(workerZoneIndex, *worker.MaxSurge, workerZoneLen, worker.AutoScalerMax),
+				MaxUnavailable: common.DistributePositiveIntOrPercent(workerZoneIndex, *worker.MaxUnavailable, workerZoneLen, worker.AutoScalerMin),
 				Labels:         worker.Labels,
 				Annotations:    worker.Annotations,
 				Taints:         worker.Taints,
@@ -137,6 +177,37 @@ func (b *AWSBotanist) GenerateMachineConfig() ([]map[string]interface{}, operati
 	return machineClasses, machineDeployments, nil
 }
 
+// workerAMI returns the AMI of the Shoot's machine image for the architecture of the given worker pool. The AMI of
+// the Shoot is built for amd64, AMIs for other architectures are looked up in the CloudProfile.
+func (b *AWSBotanist) workerAMI(worker gardenv1beta1.Worker) (string, error) {
+	var (
+		machineImage = b.Shoot.Info.Spec.Cloud.AWS.MachineImage
+		arch         = helper.WorkerArchitecture(worker)
+	)
+
+	if arch == gardenv1beta1.ArchitectureAMD64 || worker.CustomMachineImage != nil {
+		return machineImage.AMI, nil
+	}
+
+	ami, ok := helper.FindAWSMachineImageAMI(b.Shoot.CloudProfile.Spec.AWS.Constraints.MachineImages, machineImage.Name, b.Shoot.Info.Spec.Cloud.Region, arch)
+	if !ok {
+		return "", fmt.Errorf("could not find an AMI of machine image %s for architecture %s in region %s", machineImage.Name, arch, b.Shoot.Info.Spec.Cloud.Region)
+	}
+	return ami, nil
+}
+
+// GetMachineDeploymentNames returns the names of the MachineDeployments which may exist for the worker pools
+// in the current zones of the Shoot (regardless of the zones selected by the individual worker pools).
+func (b *AWSBotanist) GetMachineDeploymentNames() sets.String {
+	names := sets.NewString()
+	for zoneIndex := range b.Shoot.Info.Spec.Cloud.AWS.Zones {
+		for _, worker := range b.Shoot.Info.Spec.Cloud.AWS.Workers {
+			names.Insert(fmt.Sprintf("%s-%s-z%d", b.Shoot.SeedNamespace, worker.Name, zoneIndex+1))
+		}
+	}
+	return names
+}
+
 // ListMachineClasses returns two sets of strings whereas the first contains the names of all machine
 // classes, and the second the names of all referenced secrets.
 func (b *AWSBotanist) ListMachineClasses() (sets.String, sets.String, error) {
@@ -179,3 +250,9 @@ func (b *AWSBotanist) CleanupMachineClasses(existingMachineDeployments operation
 
 	return nil
 }
+
+// dataVolumeDeviceName returns the device name of the data volume with the given <index>. The names '/dev/sd[f-p]'
+// are recommended by AWS for EBS volumes.
+func dataVolumeDeviceName(index int) string {
+	return fmt.Sprintf("/dev/sd%c", 'f'+index)
+}
@@ -14,15 +14,20 @@
 
 package azurebotanist
 
-import "github.com/gardener/gardener/pkg/operation/common"
+import (
+	"context"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation/common"
+)
 
 // DeployKube2IAMResources - Not needed on Azure
-func (b *AzureBotanist) DeployKube2IAMResources() error {
+func (b *AzureBotanist) DeployKube2IAMResources(ctx context.Context) error {
 	return nil
 }
 
 // DestroyKube2IAMResources - Not needed on Azure.
-func (b *AzureBotanist) DestroyKube2IAMResources() error {
+func (b *AzureBotanist) DestroyKube2IAMResources(ctx context.Context) error {
 	return nil
 }
 
@@ -33,45 +38,55 @@ func (b *AzureBotanist) GenerateKube2IAMConfig() (map[string]interface{}, error)
 
 // GenerateStorageClassesConfig generates values which are required to render the chart shoot-storageclasses properly.
 func (b *AzureBotanist) GenerateStorageClassesConfig() (map[string]interface{}, error) {
-	return map[string]interface{}{
-		"StorageClasses": []map[string]interface{}{
-			{
-				"Name":           "default",
-				"IsDefaultClass": true,
-				"Provisioner":    "kubernetes.io/azure-disk",
-				"Parameters": map[string]interface{}{
-					"storageaccounttype": "Standard_LRS",
-					"kind":               "managed",
-				},
+	return b.Shoot.ComputeStorageClasses([]map[string]interface{}{
+		{
+			"Name":           "default",
+			"IsDefaultClass": true,
+			"Provisioner":    "kubernetes.io/azure-disk",
+			"Parameters": map[string]interface{}{
+				"storageaccounttype": "Standard_LRS",
+				"kind":               "managed",
 			},
-			{
-				"Name":           "managed-standard-hdd",
-				"IsDefaultClass": false,
-				"Provisioner":    "kubernetes.io/azure-disk",
-				"Parameters": map[string]interface{}{
-					"storageaccounttype": "Standard_LRS",
-					"kind":               "managed",
-				},
+		},
+		{
+			"Name":           "managed-standard-hdd",
+			"IsDefaultClass": false,
+			"Provisioner":    "kubernetes.io/azure-disk",
+			"Parameters": map[string]interface{}{
+				"storageaccounttype": "Standard_LRS",
+				"kind":               "managed",
 			},
-			{
-				"Name":           "managed-premium-ssd",
-				"IsDefaultClass": false,
-				"Provisioner":    "kubernetes.io/azure-disk",
-				"Parameters": map[string]interface{}{
-					"storageaccounttype": "Premium_LRS",
-					"kind":               "managed",
-				},
+		},
+		{
+			"Name":           "managed-premium-ssd",
+			"IsDefaultClass": false,
+			"Provisioner":    "kubernetes.io/azure-disk",
+			"Parameters": map[string]interface{}{
+				"storageaccounttype": "Premium_LRS",
+				"kind":               "managed",
 			},
-			{
-				"Name":           "files",
-				"IsDefaultClass": false,
-				"Provisioner":    "kubernetes.io/azure-file",
-				"Parameters": map[string]interface{}{
-					"skuName": "Standard_LRS",
-				},
+		},
+		{
+			"Name":           "files",
+			"IsDefaultClass": false,
+			"Provisioner":    "kubernetes.io/azure-file",
+			"Parameters": map[string]interface{}{
+				"skuName": "Standard_LRS",
 			},
 		},
-	}, nil
+	}, "", b.storageClassParameters), nil
+}
+
+// storageClassParameters computes the provisioner and its parameters for the given StorageClass of the Shoot.
+func (b *AzureBotanist) storageClassParameters(class gardenv1beta1.StorageClass) (string, map[string]interface{}) {
+	parameters := map[string]interface{}{
+		"storageaccounttype": "Standard_LRS",
+		"kind":               "managed",
+	}
+	if class.VolumeType != nil {
+		parameters["storageaccounttype"] = *class.VolumeType
+	}
+	return "kubernetes.io/azure-disk", parameters
 }
 
 // GenerateNginxIngressConfig generates values which are required to render the chart nginx-ingress properly.
@@ -30,12 +30,20 @@ func New(o *operation.Operation, purpose string) (*AzureBotanist, error) {
 		cloudProvider = o.Shoot.CloudProvider
 	case common.CloudPurposeSeed:
 		cloudProvider = o.Seed.CloudProvider
+	case common.CloudPurposeBackup:
+		cloudProvider = o.Seed.BackupProvider
 	}
 
 	if cloudProvider != gardenv1beta1.CloudProviderAzure {
 		return nil, errors.New("cannot instantiate an Azure botanist if neither Shoot nor Seed cluster specifies Azure")
 	}
 
+	// Terraform and the control plane of a Shoot run in the Seed, i.e. a managed identity would be the one of the Seed
+	// nodes. The cloud provider secret belongs to the owner of the Shoot, hence it must not be allowed to use it.
+	if purpose == common.CloudPurposeShoot && o.Shoot.Secret != nil && usesManagedIdentity(o.Shoot.Secret.Data) {
+		return nil, errors.New("managed identities are not supported for the cloud provider secrets of Shoots, a service principal is required")
+	}
+
 	return &AzureBotanist{
 		Operation:         o,
 		CloudProviderName: "azure",
@@ -46,3 +54,21 @@ func New(o *operation.Operation, purpose string) (*AzureBotanist, error) {
 func (b *AzureBotanist) GetCloudProviderName() string {
 	return b.CloudProviderName
 }
+
+// GetNetworkMTU returns the MTU of the network the nodes are connected to.
+func (b *AzureBotanist) GetNetworkMTU() int32 {
+	return common.DefaultNetworkMTU
+}
+
+// usesManagedIdentity returns true if the given secret data specifies that the system-assigned managed identity of
+// the virtual machines shall be used instead of a service principal. It is only supported for the backup secret of a
+// Seed.
+func usesManagedIdentity(secretData map[string][]byte) bool {
+	return string(secretData[UseManagedIdentity]) == "true"
+}
+
+// usesClientCertificate returns true if the given secret data contains a certificate which shall be used instead
+// of the client secret to authenticate the service principal.
+func usesClientCertificate(secretData map[string][]byte) bool {
+	return !usesManagedIdentity(secretData) && len(secretData[ClientCertificate]) > 0
+}
@@ -20,7 +20,16 @@ import (
 
 // GenerateCloudConfigUserDataConfig generates values which are required to render the chart shoot-cloud-config properly.
 func (b *AzureBotanist) GenerateCloudConfigUserDataConfig() *common.CloudConfigUserDataConfig {
-	return &common.CloudConfigUserDataConfig{
+	userDataConfig := &common.CloudConfigUserDataConfig{
 		ProvisionCloudProviderConfig: true,
 	}
+
+	// The cloud provider config references the service principal certificate, hence it must exist on the nodes.
+	if usesClientCertificate(b.Shoot.Secret.Data) {
+		userDataConfig.CloudProviderConfigFiles = map[string][]byte{
+			ClientCertificatePath: b.Shoot.Secret.Data[ClientCertificate],
+		}
+	}
+
+	return userDataConfig
 }
@@ -32,8 +32,7 @@ subnetName: %q
 securityGroupName: %q
 routeTableName: %q
 primaryAvailabilitySetName: %q
-aadClientId: %q
-aadClientSecret: %q
+%s
 cloudProviderBackoff: true
 cloudProviderBackoffRetries: 6
 cloudProviderBackoffExponent: 1.5
@@ -78,8 +77,7 @@ func (b *AzureBotanist) GenerateCloudProviderConfig() (string, error) {
 		stateVariables[securityGroupName],
 		stateVariables[routeTableName],
 		stateVariables[availabilitySetName],
-		string(b.Shoot.Secret.Data[ClientID]),
-		string(b.Shoot.Secret.Data[ClientSecret]),
+		cloudProviderCredentialsConfig(b.Shoot.Secret.Data),
 	)
 
 	// https://github.com/kubernetes/kubernetes/pull/70866
@@ -96,6 +94,26 @@ cloudProviderBackoffMode: v2`)
 	return cloudProviderConfig, nil
 }
 
+// cloudProviderCredentialsConfig returns the credentials part of the cloud provider config. A service principal
+// certificate is preferred over a client secret.
+func cloudProviderCredentialsConfig(secretData map[string][]byte) string {
+	if usesClientCertificate(secretData) {
+		return fmt.Sprintf(`aadClientId: %q
+aadClientCertPath: %q
+aadClientCertPassword: %q`,
+			string(secretData[ClientID]),
+			ClientCertificatePath,
+			string(secretData[ClientCertificatePassword]),
+		)
+	}
+
+	return fmt.Sprintf(`aadClientId: %q
+aadClientSecret: %q`,
+		string(secretData[ClientID]),
+		string(secretData[ClientSecret]),
+	)
+}
+
 // RefreshCloudProviderConfig refreshes the cloud provider credentials in the existing cloud
 // provider config.
 func (b *AzureBotanist) RefreshCloudProviderConfig(currentConfig map[string]string) map[string]string {
@@ -109,6 +127,7 @@ func (b *AzureBotanist) RefreshCloudProviderConfig(currentConfig map[string]stri
 	updated = common.ReplaceCloudProviderConfigKey(updated, separator, "subscriptionId", string(b.Shoot.Secret.Data[SubscriptionID]))
 	updated = common.ReplaceCloudProviderConfigKey(updated, separator, "aadClientId", string(b.Shoot.Secret.Data[ClientID]))
 	updated = common.ReplaceCloudProviderConfigKey(updated, separator, "aadClientSecret", string(b.Shoot.Secret.Data[ClientSecret]))
+	updated = common.ReplaceCloudProviderConfigKey(updated, separator, "aadClientCertPassword", string(b.Shoot.Secret.Data[ClientCertificatePassword]))
 
 	return map[string]string{
 		common.CloudProviderConfigMapKey: updated,
@@ -156,7 +175,8 @@ func (b *AzureBotanist) GenerateKubeAPIServerConfig() (map[string]interface{}, e
 // GenerateCloudControllerManagerConfig generates the cloud provider specific values which are required to
 // render the Deployment manifest of the cloud-controller-manager properly.
 func (b *AzureBotanist) GenerateCloudControllerManagerConfig() (map[string]interface{}, string, error) {
-	return nil, common.CloudControllerManagerDeploymentName, nil
+	values, err := b.InjectOutOfTreeCloudControllerManager(nil, common.AzureCloudControllerManagerImageName, "/usr/local/bin/cloud-controller-manager")
+	return values, common.CloudControllerManagerDeploymentName, err
 }
 
 // GenerateCSIConfig generates the configuration for CSI charts
@@ -15,17 +15,23 @@
 package azurebotanist
 
 import (
+	"context"
+	"encoding/base64"
 	"fmt"
+	"strings"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/azure"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
 	"github.com/gardener/gardener/pkg/utils"
+
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 // DeployInfrastructure kicks off a Terraform job which deploys the infrastructure.
-func (b *AzureBotanist) DeployInfrastructure() error {
+func (b *AzureBotanist) DeployInfrastructure(ctx context.Context) error {
 	var (
 		createResourceGroup = true
 		createVNet          = true
@@ -40,7 +46,7 @@ func (b *AzureBotanist) DeployInfrastructure() error {
 		resourceGroupName = b.Shoot.Info.Spec.Cloud.Azure.ResourceGroup.Name
 	}
 
-	// check if we should use an existing ResourceGroup or create a new one
+	// check if we should use an existing VNet or create a new one
 	if b.Shoot.Info.Spec.Cloud.Azure.Networks.VNet.Name != nil {
 		createVNet = false
 		vnetName = *b.Shoot.Info.Spec.Cloud.Azure.Networks.VNet.Name
@@ -64,18 +70,18 @@ func (b *AzureBotanist) DeployInfrastructure() error {
 	return tf.
 		SetVariablesEnvironment(b.generateTerraformInfraVariablesEnvironment()).
 		InitializeWith(b.ChartInitializer("azure-infra", b.generateTerraformInfraConfig(createResourceGroup, createVNet, resourceGroupName, vnetName, vnetCIDR, countUpdateDomains, countFaultDomains))).
-		Apply()
+		Apply(ctx)
 }
 
 // DestroyInfrastructure kicks off a Terraform job which destroys the infrastructure.
-func (b *AzureBotanist) DestroyInfrastructure() error {
+func (b *AzureBotanist) DestroyInfrastructure(ctx context.Context) error {
 	tf, err := b.NewShootTerraformer(common.TerraformerPurposeInfra)
 	if err != nil {
 		return err
 	}
 	return tf.
 		SetVariablesEnvironment(b.generateTerraformInfraVariablesEnvironment()).
-		Destroy()
+		Destroy(ctx)
 }
 
 // generateTerraformInfraVariablesEnvironment generates the environment containing the credentials which
@@ -83,22 +89,34 @@ func (b *AzureBotanist) DestroyInfrastructure() error {
 // Terraform variables which are prefixed with TF_VAR_.
 func (b *AzureBotanist) generateTerraformInfraVariablesEnvironment() map[string]string {
 	return terraformer.GenerateVariablesEnvironment(b.Shoot.Secret, map[string]string{
-		"CLIENT_ID":     ClientID,
-		"CLIENT_SECRET": ClientSecret,
+		"CLIENT_ID":                   ClientID,
+		"CLIENT_SECRET":               ClientSecret,
+		"CLIENT_CERTIFICATE_PASSWORD": ClientCertificatePassword,
 	})
 }
 
 // generateTerraformInfraConfig creates the Terraform variables and the Terraform config (for the infrastructure)
 // and returns them (these values will be stored as a ConfigMap and a Secret in the Garden cluster.
 func (b *AzureBotanist) generateTerraformInfraConfig(createResourceGroup, createVNet bool, resourceGroupName, vnetName string, vnetCIDR gardencorev1alpha1.CIDR, countUpdateDomains, countFaultDomains gardenv1beta1.AzureDomainCount) map[string]interface{} {
+	var peering map[string]interface{}
+	if p := b.Shoot.Info.Spec.Cloud.Azure.Networks.VNet.Peering; p != nil {
+		peering = map[string]interface{}{
+			"remoteVNetID":          p.RemoteVNetID,
+			"allowForwardedTraffic": p.AllowForwardedTraffic,
+			"useRemoteGateways":     p.UseRemoteGateways,
+		}
+	}
+
 	return map[string]interface{}{
 		"azure": map[string]interface{}{
-			"subscriptionID":     string(b.Shoot.Secret.Data[SubscriptionID]),
-			"tenantID":           string(b.Shoot.Secret.Data[TenantID]),
-			"region":             b.Shoot.Info.Spec.Cloud.Region,
-			"countUpdateDomains": countUpdateDomains.Count,
-			"countFaultDomains":  countFaultDomains.Count,
+			"subscriptionID":       string(b.Shoot.Secret.Data[SubscriptionID]),
+			"tenantID":             string(b.Shoot.Secret.Data[TenantID]),
+			"region":               b.Shoot.Info.Spec.Cloud.Region,
+			"countUpdateDomains":   countUpdateDomains.Count,
+			"countFaultDomains":    countFaultDomains.Count,
+			"useClientCertificate": usesClientCertificate(b.Shoot.Secret.Data),
 		},
+		"variablesFiles": terraformVariablesFiles(b.Shoot.Secret.Data),
 		"create": map[string]interface{}{
 			"resourceGroup": createResourceGroup,
 			"vnet":          createVNet,
@@ -110,71 +128,124 @@ func (b *AzureBotanist) generateTerraformInfraConfig(createResourceGroup, create
 				"cidr": vnetCIDR,
 			},
 		},
-		"clusterName": b.Shoot.SeedNamespace,
+		"clusterName":    b.Shoot.SeedNamespace,
+		"additionalTags": b.Shoot.Info.Spec.Cloud.Tags,
 		"networks": map[string]interface{}{
 			"worker": b.Shoot.Info.Spec.Cloud.Azure.Networks.Workers,
 		},
+		"peering": peering,
 	}
 }
 
 // DeployBackupInfrastructure kicks off a Terraform job which creates the infrastructure resources for backup.
-func (b *AzureBotanist) DeployBackupInfrastructure() error {
+func (b *AzureBotanist) DeployBackupInfrastructure(ctx context.Context) error {
 	tf, err := b.NewBackupInfrastructureTerraformer()
 	if err != nil {
 		return err
 	}
+	values, err := b.generateTerraformBackupConfig()
+	if err != nil {
+		return err
+	}
 	return tf.
 		SetVariablesEnvironment(b.generateTerraformBackupVariablesEnvironment()).
-		InitializeWith(b.ChartInitializer("azure-backup", b.generateTerraformBackupConfig())).
-		Apply()
+		InitializeWith(b.ChartInitializer("azure-backup", values)).
+		Apply(ctx)
 }
 
 // DestroyBackupInfrastructure kicks off a Terraform job which destroys the infrastructure for backup.
-func (b *AzureBotanist) DestroyBackupInfrastructure() error {
+func (b *AzureBotanist) DestroyBackupInfrastructure(ctx context.Context) error {
 	tf, err := b.NewBackupInfrastructureTerraformer()
 	if err != nil {
 		return err
 	}
 	return tf.
 		SetVariablesEnvironment(b.generateTerraformBackupVariablesEnvironment()).
-		Destroy()
+		Destroy(ctx)
+}
+
+// ProbeBackupInfrastructure verifies that the backup container exists, that it is writable, and that the storage
+// account key is valid by writing and deleting a probe blob.
+func (b *AzureBotanist) ProbeBackupInfrastructure(ctx context.Context) error {
+	var (
+		storageAccountName = "storageAccountName"
+		storageAccessKey   = "storageAccessKey"
+		containerName      = "containerName"
+	)
+
+	tf, err := b.NewBackupInfrastructureTerraformer()
+	if err != nil {
+		return err
+	}
+	stateVariables, err := tf.GetStateOutputVariables(storageAccountName, storageAccessKey, containerName)
+	if err != nil {
+		return err
+	}
+
+	storageClient, err := azure.NewStorageClient(stateVariables[storageAccountName], stateVariables[storageAccessKey])
+	if err != nil {
+		return err
+	}
+	return storageClient.ProbeContainer(ctx, stateVariables[containerName], common.BackupBucketProbeObjectName)
+}
+
+// PurgeBackupInfrastructure deletes the resource group of the backup infrastructure (including the storage account
+// and all backups) directly via the Azure Resource Manager API, i.e., without relying on the Terraform state. It is
+// only supported for service principals which authenticate with a client secret.
+func (b *AzureBotanist) PurgeBackupInfrastructure(ctx context.Context) error {
+	data := b.Seed.BackupSecret.Data
+	if usesManagedIdentity(data) || usesClientCertificate(data) {
+		return common.ErrBackupInfrastructurePurgeNotSupported
+	}
+
+	client := azure.NewResourceGroupClient(string(data[TenantID]), string(data[SubscriptionID]), string(data[ClientID]), string(data[ClientSecret]))
+	return client.DeleteResourceGroup(ctx, b.backupResourceGroupName())
+}
+
+// backupResourceGroupName returns the name of the resource group which contains the backup infrastructure.
+func (b *AzureBotanist) backupResourceGroupName() string {
+	// TODO: Remove this and use only "--" as separator, once we have all shoots deployed as per new naming conventions.
+	if common.IsFollowingNewNamingConvention(b.BackupInfrastructure.Name) {
+		return fmt.Sprintf("backup--%s", b.BackupInfrastructure.Name)
+	}
+	shootUIDSHA := utils.ComputeSHA1Hex([]byte(b.BackupInfrastructure.Spec.ShootUID))
+	return fmt.Sprintf("%s-backup-%s", common.ExtractShootName(b.BackupInfrastructure.Name), shootUIDSHA[:15])
 }
 
 // generateTerraformBackupVariablesEnvironment generates the environment containing the credentials which
 // are required to validate/apply/destroy the Terraform configuration. These environment must contain
 // Terraform variables which are prefixed with TF_VAR_.
 func (b *AzureBotanist) generateTerraformBackupVariablesEnvironment() map[string]string {
-	return terraformer.GenerateVariablesEnvironment(b.Seed.Secret, map[string]string{
-		"CLIENT_ID":     ClientID,
-		"CLIENT_SECRET": ClientSecret,
+	return terraformer.GenerateVariablesEnvironment(b.Seed.BackupSecret, map[string]string{
+		"CLIENT_ID":                   ClientID,
+		"CLIENT_SECRET":               ClientSecret,
+		"CLIENT_CERTIFICATE_PASSWORD": ClientCertificatePassword,
 	})
 }
 
 // generateTerraformBackupConfig creates the Terraform variables and the Terraform config (for the backup)
 // and returns them.
-func (b *AzureBotanist) generateTerraformBackupConfig() map[string]interface{} {
-	var (
-		shootUIDSHA       = utils.ComputeSHA1Hex([]byte(b.BackupInfrastructure.Spec.ShootUID))
-		resourceGroupName string
-	)
+func (b *AzureBotanist) generateTerraformBackupConfig() (map[string]interface{}, error) {
+	shootUIDSHA := utils.ComputeSHA1Hex([]byte(b.BackupInfrastructure.Spec.ShootUID))
 
-	// TODO: Remove this and use only "--" as separator, once we have all shoots deployed as per new naming conventions.
-	if common.IsFollowingNewNamingConvention(b.BackupInfrastructure.Name) {
-		resourceGroupName = fmt.Sprintf("backup--%s", b.BackupInfrastructure.Name)
-	} else {
-		resourceGroupName = fmt.Sprintf("%s-backup-%s", common.ExtractShootName(b.BackupInfrastructure.Name), shootUIDSHA[:15])
+	region, err := b.BackupRegion()
+	if err != nil {
+		return nil, err
 	}
 
 	return map[string]interface{}{
 		"azure": map[string]interface{}{
-			"subscriptionID":     string(b.Seed.Secret.Data[SubscriptionID]),
-			"tenantID":           string(b.Seed.Secret.Data[TenantID]),
-			"region":             b.Seed.Info.Spec.Cloud.Region,
-			"storageAccountName": fmt.Sprintf("bkp%s", shootUIDSHA[:15]),
-			"resourceGroupName":  resourceGroupName,
+			"subscriptionID":       string(b.Seed.BackupSecret.Data[SubscriptionID]),
+			"tenantID":             string(b.Seed.BackupSecret.Data[TenantID]),
+			"region":               region,
+			"storageAccountName":   fmt.Sprintf("bkp%s", shootUIDSHA[:15]),
+			"resourceGroupName":    b.backupResourceGroupName(),
+			"useManagedIdentity":   usesManagedIdentity(b.Seed.BackupSecret.Data),
+			"useClientCertificate": usesClientCertificate(b.Seed.BackupSecret.Data),
 		},
-		"clusterName": b.BackupInfrastructure.Name,
-	}
+		"variablesFiles": terraformVariablesFiles(b.Seed.BackupSecret.Data),
+		"clusterName":    b.BackupInfrastructure.Name,
+	}, nil
 }
 
 func findDomainCountForRegion(region string, domainCounts []gardenv1beta1.AzureDomainCount) (gardenv1beta1.AzureDomainCount, error) {
@@ -185,3 +256,68 @@ func findDomainCountForRegion(region string, domainCounts []gardenv1beta1.AzureD
 	}
 	return gardenv1beta1.AzureDomainCount{}, fmt.Errorf("could not find a domain count for region %s", region)
 }
+
+// ListOrphanedInfrastructureResources lists the resources which are created by the Terraform infrastructure
+// configuration of the Shoot but which are not contained in the Terraform state (anymore), e.g., left-overs of failed
+// destroy runs. It returns them in the form "<type>/<id>". The detection is only supported for service principals
+// which authenticate with a client secret.
+func (b *AzureBotanist) ListOrphanedInfrastructureResources(ctx context.Context) ([]string, error) {
+	data := b.Shoot.Secret.Data
+	if usesClientCertificate(data) {
+		return nil, nil
+	}
+
+	tf, err := b.NewShootTerraformer(common.TerraformerPurposeInfra)
+	if err != nil {
+		return nil, err
+	}
+	stateIdentifiers, err := tf.GetStateResourceIdentifiers()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		clusterName       = b.Shoot.SeedNamespace
+		resourceGroupName = clusterName
+		// managedResources contains the lower case types and names of the resources created by Terraform.
+		managedResources = sets.NewString(
+			"microsoft.network/routetables/worker_route_table",
+			"microsoft.network/networksecuritygroups/"+clusterName+"-workers",
+			"microsoft.compute/availabilitysets/"+clusterName+"-avset-workers",
+		)
+	)
+	// An existing resource group or VNet is not managed by Terraform.
+	if rg := b.Shoot.Info.Spec.Cloud.Azure.ResourceGroup; rg != nil {
+		resourceGroupName = rg.Name
+	} else {
+		managedResources.Insert("microsoft.resources/resourcegroups/" + clusterName)
+	}
+	if b.Shoot.Info.Spec.Cloud.Azure.Networks.VNet.Name == nil {
+		managedResources.Insert("microsoft.network/virtualnetworks/" + clusterName)
+	}
+
+	client := azure.NewResourceGroupClientWithRateLimiter(string(data[TenantID]), string(data[SubscriptionID]), string(data[ClientID]), string(data[ClientSecret]), b.CloudAPIRateLimiter)
+	resources, err := client.ListResources(ctx, resourceGroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	createdResources := map[string]string{}
+	for id, resource := range resources {
+		if managedResources.Has(strings.ToLower(resource.Type + "/" + resource.Name)) {
+			createdResources[id] = resource.Type
+		}
+	}
+	return common.OrphanedInfrastructureResources(stateIdentifiers, createdResources), nil
+}
+
+// terraformVariablesFiles returns the additional files (with base64-encoded content) which are stored in the
+// Terraformer variables Secret. The Terraform provider can only read the service principal certificate from a file.
+func terraformVariablesFiles(secretData map[string][]byte) map[string]interface{} {
+	if !usesClientCertificate(secretData) {
+		return nil
+	}
+	return map[string]interface{}{
+		terraformClientCertificateFile: base64.StdEncoding.EncodeToString(secretData[ClientCertificate]),
+	}
+}
@@ -16,7 +16,9 @@ package azurebotanist
 
 import (
 	"fmt"
+	"strings"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils/secrets"
@@ -35,14 +37,19 @@ func (b *AzureBotanist) GetMachineClassInfo() (classKind, classPlural, classChar
 }
 
 // GenerateMachineClassSecretData generates the secret data for the machine class secret (except the userData field
-// which is computed elsewhere).
-func (b *AzureBotanist) GenerateMachineClassSecretData() map[string][]byte {
+// which is computed elsewhere). The machine-controller-manager only supports service principals with a client
+// secret, hence the secret must contain it even if the other components use a certificate.
+func (b *AzureBotanist) GenerateMachineClassSecretData() (map[string][]byte, error) {
+	if len(b.Shoot.Secret.Data[ClientSecret]) == 0 {
+		return nil, fmt.Errorf("the cloud provider secret does not contain the key %q which is required by the machine-controller-manager, it does not support service principal certificates", ClientSecret)
+	}
+
 	return map[string][]byte{
 		machinev1alpha1.AzureClientID:       b.Shoot.Secret.Data[ClientID],
 		machinev1alpha1.AzureClientSecret:   b.Shoot.Secret.Data[ClientSecret],
 		machinev1alpha1.AzureSubscriptionID: b.Shoot.Secret.Data[SubscriptionID],
 		machinev1alpha1.AzureTenantID:       b.Shoot.Secret.Data[TenantID],
-	}
+	}, nil
 }
 
 // GenerateMachineConfig generates the configuration values for the cloud-specific machine class Helm chart. It
@@ -69,6 +76,18 @@ func (b *AzureBotanist) GenerateMachineConfig() ([]map[string]interface{}, opera
 	if err != nil {
 		return nil, nil, err
 	}
+	secretData, err := b.GenerateMachineClassSecretData()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tags := map[string]interface{}{}
+	for key, value := range b.Shoot.Info.Spec.Cloud.Tags {
+		tags[key] = value
+	}
+	tags["Name"] = b.Shoot.SeedNamespace
+	tags[fmt.Sprintf("kubernetes.io-cluster-%s", b.Shoot.SeedNamespace)] = "1"
+	tags["kubernetes.io-role-node"] = "1"
 
 	for _, worker := range workers {
 		machineClassSpec := map[string]interface{}{
@@ -77,21 +96,12 @@ func (b *AzureBotanist) GenerateMachineConfig() ([]map[string]interface{}, opera
 			"vnetName":          stateVariables[vnetName],
 			"subnetName":        stateVariables[subnetName],
 			"availabilitySetID": stateVariables[availabilitySetID],
-			"tags": map[string]interface{}{
-				"Name": b.Shoot.SeedNamespace,
-				fmt.Sprintf("kubernetes.io-cluster-%s", b.Shoot.SeedNamespace): "1",
-				"kubernetes.io-role-node":                                      "1",
-			},
+			"tags":              tags,
 			"secret": map[string]interface{}{
 				"cloudConfig": b.Shoot.CloudConfigMap[worker.Name].Downloader.Content,
 			},
-			"machineType": worker.MachineType,
-			"image": map[string]interface{}{
-				"publisher": b.Shoot.Info.Spec.Cloud.Azure.MachineImage.Publisher,
-				"offer":     b.Shoot.Info.Spec.Cloud.Azure.MachineImage.Offer,
-				"sku":       b.Shoot.Info.Spec.Cloud.Azure.MachineImage.SKU,
-				"version":   b.Shoot.Info.Spec.Cloud.Azure.MachineImage.Version,
-			},
+			"machineType":  worker.MachineType,
+			"image":        machineImage(worker.Worker, b.Shoot.Info.Spec.Cloud.Azure.MachineImage),
 			"volumeSize":   common.DiskSize(worker.VolumeSize),
 			"sshPublicKey": string(b.Secrets["ssh-keypair"].Data[secrets.DataKeySSHAuthorizedKeys]),
 		}
@@ -100,7 +110,6 @@ func (b *AzureBotanist) GenerateMachineConfig() ([]map[string]interface{}, opera
 			machineClassSpecHash = common.MachineClassHash(machineClassSpec, b.Shoot.KubernetesMajorMinorVersion)
 			deploymentName       = fmt.Sprintf("%s-%s", b.Shoot.SeedNamespace, worker.Name)
 			className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
-			secretData           = b.GenerateMachineClassSecretData()
 		)
 
 		machineDeployments = append(machineDeployments, operation.MachineDeployment{
@@ -127,6 +136,16 @@ func (b *AzureBotanist) GenerateMachineConfig() ([]map[string]interface{}, opera
 	return machineClasses, machineDeployments, nil
 }
 
+// GetMachineDeploymentNames returns the names of the MachineDeployments which may exist for the worker pools
+// in the current zones of the Shoot (regardless of the zones selected by the individual worker pools).
+func (b *AzureBotanist) GetMachineDeploymentNames() sets.String {
+	names := sets.NewString()
+	for _, worker := range b.Shoot.Info.Spec.Cloud.Azure.Workers {
+		names.Insert(fmt.Sprintf("%s-%s", b.Shoot.SeedNamespace, worker.Name))
+	}
+	return names
+}
+
 // ListMachineClasses returns two sets of strings whereas the first contains the names of all machine
 // classes, and the second the names of all referenced secrets.
 func (b *AzureBotanist) ListMachineClasses() (sets.String, sets.String, error) {
@@ -169,3 +188,25 @@ func (b *AzureBotanist) CleanupMachineClasses(existingMachineDeployments operati
 
 	return nil
 }
+
+// machineImage returns the image reference for the machine class of the given worker. A custom machine image is
+// given as URN of the form <publisher>:<offer>:<sku>:<version>.
+func machineImage(worker gardenv1beta1.Worker, image *gardenv1beta1.AzureMachineImage) map[string]interface{} {
+	if worker.CustomMachineImage != nil {
+		if parts := strings.Split(*worker.CustomMachineImage, ":"); len(parts) == 4 {
+			return map[string]interface{}{
+				"publisher": parts[0],
+				"offer":     parts[1],
+				"sku":       parts[2],
+				"version":   parts[3],
+			}
+		}
+	}
+
+	return map[string]interface{}{
+		"publisher": image.Publisher,
+		"offer":     image.Offer,
+		"sku":       image.SKU,
+		"version":   image.Version,
+	}
+}
@@ -31,4 +31,20 @@ const (
 	ClientID = "clientID"
 	// ClientSecret is a constant for the key in a cloud provider secret that holds the Azure client secret.
 	ClientSecret = "clientSecret"
+	// ClientCertificate is a constant for the key in a cloud provider secret that holds the PKCS#12 bundle of the
+	// certificate of the Azure service principal. It is used instead of the client secret if present.
+	ClientCertificate = "clientCertificate"
+	// ClientCertificatePassword is a constant for the key in a cloud provider secret that holds the password of the
+	// PKCS#12 bundle of the Azure service principal certificate.
+	ClientCertificatePassword = "clientCertificatePassword"
+	// UseManagedIdentity is a constant for the key in a backup secret that specifies whether the system-assigned managed
+	// identity of the virtual machines shall be used instead of a service principal.
+	UseManagedIdentity = "useManagedIdentity"
+
+	// ClientCertificatePath is the path of the service principal certificate on the nodes and in the control plane
+	// components (the cloud provider secret is mounted to /srv/cloudprovider).
+	ClientCertificatePath = "/srv/cloudprovider/" + ClientCertificate
+	// terraformClientCertificateFile is the name of the service principal certificate in the Terraformer variables
+	// Secret (which is mounted to /tfvars).
+	terraformClientCertificateFile = "client-certificate.pfx"
 )
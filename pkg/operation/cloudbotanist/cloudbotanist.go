@@ -39,6 +39,8 @@ func New(o *operation.Operation, purpose string) (CloudBotanist, error) {
 		cloudProvider = o.Shoot.CloudProvider
 	case common.CloudPurposeSeed:
 		cloudProvider = o.Seed.CloudProvider
+	case common.CloudPurposeBackup:
+		cloudProvider = o.Seed.BackupProvider
 	default:
 		return nil, errors.New("unsupported cloud botanist purpose")
 	}
@@ -14,15 +14,22 @@
 
 package gcpbotanist
 
-import "github.com/gardener/gardener/pkg/operation/common"
+import (
+	"context"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	corev1 "k8s.io/api/core/v1"
+)
 
 // DeployKube2IAMResources - Not needed on GCP
-func (b *GCPBotanist) DeployKube2IAMResources() error {
+func (b *GCPBotanist) DeployKube2IAMResources(ctx context.Context) error {
 	return nil
 }
 
 // DestroyKube2IAMResources - Not needed on GCP.
-func (b *GCPBotanist) DestroyKube2IAMResources() error {
+func (b *GCPBotanist) DestroyKube2IAMResources(ctx context.Context) error {
 	return nil
 }
 
@@ -33,26 +40,35 @@ func (b *GCPBotanist) GenerateKube2IAMConfig() (map[string]interface{}, error) {
 
 // GenerateStorageClassesConfig generates values which are required to render the chart storage-classes properly.
 func (b *GCPBotanist) GenerateStorageClassesConfig() (map[string]interface{}, error) {
-	return map[string]interface{}{
-		"StorageClasses": []map[string]interface{}{
-			{
-				"Name":           "default",
-				"IsDefaultClass": true,
-				"Provisioner":    "kubernetes.io/gce-pd",
-				"Parameters": map[string]interface{}{
-					"type": "pd-standard",
-				},
+	return b.Shoot.ComputeStorageClasses([]map[string]interface{}{
+		{
+			"Name":           "default",
+			"IsDefaultClass": true,
+			"Provisioner":    "kubernetes.io/gce-pd",
+			"Parameters": map[string]interface{}{
+				"type": "pd-standard",
 			},
-			{
-				"Name":           "gce-sc-fast",
-				"IsDefaultClass": false,
-				"Provisioner":    "kubernetes.io/gce-pd",
-				"Parameters": map[string]interface{}{
-					"type": "pd-ssd",
-				},
+		},
+		{
+			"Name":           "gce-sc-fast",
+			"IsDefaultClass": false,
+			"Provisioner":    "kubernetes.io/gce-pd",
+			"Parameters": map[string]interface{}{
+				"type": "pd-ssd",
 			},
 		},
-	}, nil
+	}, corev1.LabelZoneFailureDomain, b.storageClassParameters), nil
+}
+
+// storageClassParameters computes the provisioner and its parameters for the given StorageClass of the Shoot.
+func (b *GCPBotanist) storageClassParameters(class gardenv1beta1.StorageClass) (string, map[string]interface{}) {
+	parameters := map[string]interface{}{
+		"type": "pd-standard",
+	}
+	if class.VolumeType != nil {
+		parameters["type"] = *class.VolumeType
+	}
+	return "kubernetes.io/gce-pd", parameters
 }
 
 // GenerateNginxIngressConfig generates values which are required to render the chart nginx-ingress properly.
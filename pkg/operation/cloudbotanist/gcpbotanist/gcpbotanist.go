@@ -22,19 +22,29 @@ import (
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/gcp"
+	controllermanagerfeatures "github.com/gardener/gardener/pkg/controllermanager/features"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
+
+	"golang.org/x/time/rate"
 )
 
 // New takes an operation object <o> and creates a new GCPBotanist object.
 func New(o *operation.Operation, purpose string) (*GCPBotanist, error) {
-	var cloudProvider gardenv1beta1.CloudProvider
+	var (
+		cloudProvider gardenv1beta1.CloudProvider
+		rateLimiter   *rate.Limiter
+	)
 
 	switch purpose {
 	case common.CloudPurposeShoot:
 		cloudProvider = o.Shoot.CloudProvider
+		rateLimiter = o.CloudAPIRateLimiter
 	case common.CloudPurposeSeed:
 		cloudProvider = o.Seed.CloudProvider
+	case common.CloudPurposeBackup:
+		cloudProvider = o.Seed.BackupProvider
 	}
 
 	if cloudProvider != gardenv1beta1.CloudProviderGCP {
@@ -58,6 +68,8 @@ func New(o *operation.Operation, purpose string) (*GCPBotanist, error) {
 		serviceAccountJSON = o.Shoot.Secret.Data[ServiceAccountJSON]
 	case common.CloudPurposeSeed:
 		serviceAccountJSON = o.Seed.Secret.Data[ServiceAccountJSON]
+	case common.CloudPurposeBackup:
+		serviceAccountJSON = o.Seed.BackupSecret.Data[ServiceAccountJSON]
 	}
 
 	project, err := ExtractProjectID(serviceAccountJSON)
@@ -71,7 +83,7 @@ func New(o *operation.Operation, purpose string) (*GCPBotanist, error) {
 		return nil, err
 	}
 
-	client, err := gcp.NewClient(context.TODO(), serviceAccountJSON, project)
+	client, err := gcp.NewClientWithRateLimiter(context.TODO(), serviceAccountJSON, project, rateLimiter)
 	if err != nil {
 		return nil, err
 	}
@@ -91,6 +103,12 @@ func (b *GCPBotanist) GetCloudProviderName() string {
 	return b.CloudProviderName
 }
 
+// GetNetworkMTU returns the MTU of the network the nodes are connected to. GCP VPC networks
+// use an MTU of 1460 bytes.
+func (b *GCPBotanist) GetNetworkMTU() int32 {
+	return NetworkMTU
+}
+
 // MinifyServiceAccount uses the provided service account JSON objects and minifies it.
 // This is required when you want to inject it as environment variable into Terraform.
 func MinifyServiceAccount(serviceAccountJSON []byte) (string, error) {
@@ -112,3 +130,9 @@ func ExtractProjectID(ServiceAccountJSON []byte) (string, error) {
 	}
 	return j.Project, nil
 }
+
+// usesMinimalServiceAccount returns true if the machine-controller-manager shall use a service account with minimal
+// permissions which is created for the Shoot instead of the service account of the cloud provider secret.
+func usesMinimalServiceAccount() bool {
+	return controllermanagerfeatures.FeatureGate.Enabled(features.GCPMinimalServiceAccount)
+}
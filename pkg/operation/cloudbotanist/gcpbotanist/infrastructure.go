@@ -16,16 +16,21 @@ package gcpbotanist
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
+	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 // DeployInfrastructure kicks off a Terraform job which deploys the infrastructure.
-func (b *GCPBotanist) DeployInfrastructure() error {
+func (b *GCPBotanist) DeployInfrastructure(ctx context.Context) error {
 	var (
 		vpcName   = "${google_compute_network.network.name}"
 		createVPC = true
@@ -40,20 +45,90 @@ func (b *GCPBotanist) DeployInfrastructure() error {
 	if err != nil {
 		return err
 	}
-	return tf.
+	if err := tf.
 		SetVariablesEnvironment(b.generateTerraformInfraVariablesEnvironment()).
 		InitializeWith(b.ChartInitializer("gcp-infra", b.generateTerraformInfraConfig(createVPC, vpcName))).
-		Apply()
+		Apply(ctx); err != nil {
+		return err
+	}
+
+	if !usesMinimalServiceAccount() {
+		if err := b.K8sSeedClient.DeleteSecret(b.Shoot.SeedNamespace, machineControllerServiceAccountSecretName); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+	return b.ensureMachineControllerServiceAccountKey(ctx, tf, time.Now())
+}
+
+// ensureMachineControllerServiceAccountKey creates a key for the service account of the machine-controller-manager and
+// stores it in a secret in the Shoot namespace of the Seed, hence it is never part of the Terraform state. A new key is
+// created whenever the key generation changes. The key of the previous generation is kept until the next rotation so
+// that it remains valid until all machine class secrets have been updated, all other keys are deleted.
+func (b *GCPBotanist) ensureMachineControllerServiceAccountKey(ctx context.Context, tf *terraformer.Terraformer, now time.Time) error {
+	serviceAccountEmail := "machine_controller_service_account_email"
+	stateVariables, err := tf.GetStateOutputVariables(serviceAccountEmail)
+	if err != nil {
+		return err
+	}
+	email := stateVariables[serviceAccountEmail]
+
+	secret, err := b.K8sSeedClient.GetSecret(b.Shoot.SeedNamespace, machineControllerServiceAccountSecretName)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      machineControllerServiceAccountSecretName,
+				Namespace: b.Shoot.SeedNamespace,
+			},
+		}
+	}
+
+	generation := strconv.FormatInt(machineControllerServiceAccountKeyGeneration(now), 10)
+	if string(secret.Data[keyGeneration]) != generation || len(secret.Data[ServiceAccountJSON]) == 0 {
+		key, err := b.GCPClient.CreateServiceAccountKey(ctx, email)
+		if err != nil {
+			return err
+		}
+
+		secret.Data = map[string][]byte{
+			ServiceAccountJSON: key.PrivateKeyData,
+			keyName:            []byte(key.Name),
+			previousKeyName:    secret.Data[keyName],
+			keyGeneration:      []byte(generation),
+		}
+		if _, err := b.K8sSeedClient.CreateSecretObject(secret, true); err != nil {
+			return err
+		}
+	}
+
+	keep := sets.NewString(string(secret.Data[keyName]), string(secret.Data[previousKeyName]))
+	keys, err := b.GCPClient.ListServiceAccountKeys(ctx, email)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if keep.Has(key.Name) {
+			continue
+		}
+		b.Logger.Infof("Deleting stale key %q of the machine-controller-manager service account", key.Name)
+		if err := b.GCPClient.DeleteServiceAccountKey(ctx, key.Name); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // DestroyInfrastructure kicks off a Terraform job which destroys the infrastructure.
-func (b *GCPBotanist) DestroyInfrastructure() error {
+func (b *GCPBotanist) DestroyInfrastructure(ctx context.Context) error {
 	tf, err := b.NewShootTerraformer(common.TerraformerPurposeInfra)
 	if err != nil {
 		return err
 	}
 
-	configExists, err := tf.ConfigExists()
+	configExists, err := tf.ConfigExists(ctx)
 	if err != nil {
 		return err
 	}
@@ -72,14 +147,14 @@ func (b *GCPBotanist) DestroyInfrastructure() error {
 
 		_ = g.Add(flow.Task{
 			Name:         "Destroying Shoot infrastructure",
-			Fn:           flow.SimpleTaskFn(tf.SetVariablesEnvironment(b.generateTerraformInfraVariablesEnvironment()).Destroy),
+			Fn:           flow.TaskFn(tf.SetVariablesEnvironment(b.generateTerraformInfraVariablesEnvironment()).Destroy),
 			Dependencies: flow.NewTaskIDs(destroyKubernetesFirewallRulesStep, destroyKubernetesRoutesStep),
 		})
 
 		f = g.Compile()
 	)
 
-	if err := f.Run(flow.Opts{Logger: b.Logger}); err != nil {
+	if err := f.Run(flow.Opts{Logger: b.Logger, Context: ctx}); err != nil {
 		return flow.Causes(err)
 	}
 	return nil
@@ -97,18 +172,36 @@ func (b *GCPBotanist) generateTerraformInfraVariablesEnvironment() map[string]st
 // generateTerraformInfraConfig creates the Terraform variables and the Terraform config (for the infrastructure)
 // and returns them (these values will be stored as a ConfigMap and a Secret in the Garden cluster.
 func (b *GCPBotanist) generateTerraformInfraConfig(createVPC bool, vpcName string) map[string]interface{} {
-	var internal string
+	var (
+		internal       string
+		cloudNAT       = b.Shoot.Info.Spec.Cloud.GCP.Networks.CloudNAT
+		cloudNATConfig = map[string]interface{}{}
+	)
 	if b.Shoot.Info.Spec.Cloud.GCP.Networks.Internal != nil {
 		internal = string(*b.Shoot.Info.Spec.Cloud.GCP.Networks.Internal)
 	}
+	if cloudNAT != nil {
+		if cloudNAT.MinPortsPerVM != nil {
+			cloudNATConfig["minPortsPerVM"] = *cloudNAT.MinPortsPerVM
+		}
+		if len(cloudNAT.NatIPNames) > 0 {
+			cloudNATConfig["natIPNames"] = cloudNAT.NatIPNames
+		}
+		if cloudNAT.Logging != nil {
+			cloudNATConfig["logFilter"] = cloudNAT.Logging.Filter
+		}
+	}
+
 	return map[string]interface{}{
 		"google": map[string]interface{}{
 			"region":  b.Shoot.Info.Spec.Cloud.Region,
 			"project": b.Project,
 		},
 		"create": map[string]interface{}{
-			"vpc": createVPC,
+			"vpc":      createVPC,
+			"cloudNAT": cloudNAT != nil,
 		},
+		"cloudNAT": cloudNATConfig,
 		"vpc": map[string]interface{}{
 			"name": vpcName,
 		},
@@ -119,9 +212,27 @@ func (b *GCPBotanist) generateTerraformInfraConfig(createVPC bool, vpcName strin
 			"worker":   b.Shoot.Info.Spec.Cloud.GCP.Networks.Workers[0],
 			"internal": internal,
 		},
+		"machineControllerServiceAccount": map[string]interface{}{
+			"enabled":   usesMinimalServiceAccount(),
+			"accountID": machineControllerServiceAccountID(b.Shoot.SeedNamespace),
+		},
 	}
 }
 
+// machineControllerServiceAccountID returns the account id of the service account of the machine-controller-manager
+// of the Shoot with the given namespace in the Seed. Account ids must not be longer than 30 characters, hence it is
+// derived from a hash of the namespace. The id of the custom role of the service account is derived from it as well.
+func machineControllerServiceAccountID(seedNamespace string) string {
+	return "gardener-mcm-" + utils.ComputeSHA1Hex([]byte(seedNamespace))[:16]
+}
+
+// machineControllerServiceAccountKeyGeneration returns the generation of the key of the service account of the
+// machine-controller-manager at the given time. A new key is created whenever the generation changes, and the key
+// of the previous generation is kept until the next rotation.
+func machineControllerServiceAccountKeyGeneration(now time.Time) int64 {
+	return now.Unix() / int64(machineControllerServiceAccountKeyRotationPeriod/time.Second)
+}
+
 func (b *GCPBotanist) getVpcName() (string, error) {
 	t, err := b.NewShootTerraformer(common.TerraformerPurposeInfra)
 	if err != nil {
@@ -198,26 +309,53 @@ func (b *GCPBotanist) destroyKubernetesRoutes(ctx context.Context) error {
 }
 
 // DeployBackupInfrastructure kicks off a Terraform job which deploys the infrastructure resources for backup.
-func (b *GCPBotanist) DeployBackupInfrastructure() error {
+func (b *GCPBotanist) DeployBackupInfrastructure(ctx context.Context) error {
 	tf, err := b.NewBackupInfrastructureTerraformer()
 	if err != nil {
 		return err
 	}
+	values, err := b.generateTerraformBackupConfig()
+	if err != nil {
+		return err
+	}
 	return tf.
 		SetVariablesEnvironment(b.generateTerraformBackupVariablesEnvironment()).
-		InitializeWith(b.ChartInitializer("gcp-backup", b.generateTerraformBackupConfig())).
-		Apply()
+		InitializeWith(b.ChartInitializer("gcp-backup", values)).
+		Apply(ctx)
 }
 
 // DestroyBackupInfrastructure kicks off a Terraform job which destroys the infrastructure for backup.
-func (b *GCPBotanist) DestroyBackupInfrastructure() error {
+func (b *GCPBotanist) DestroyBackupInfrastructure(ctx context.Context) error {
 	tf, err := b.NewBackupInfrastructureTerraformer()
 	if err != nil {
 		return err
 	}
 	return tf.
 		SetVariablesEnvironment(b.generateTerraformBackupVariablesEnvironment()).
-		Destroy()
+		Destroy(ctx)
+}
+
+// ProbeBackupInfrastructure verifies that the backup bucket exists, that it is writable, and that the credentials of
+// the Seed are valid by writing and deleting a probe object.
+func (b *GCPBotanist) ProbeBackupInfrastructure(ctx context.Context) error {
+	bucketName := "bucketName"
+
+	tf, err := b.NewBackupInfrastructureTerraformer()
+	if err != nil {
+		return err
+	}
+	stateVariables, err := tf.GetStateOutputVariables(bucketName)
+	if err != nil {
+		return err
+	}
+
+	return b.GCPClient.ProbeBucket(ctx, stateVariables[bucketName], common.BackupBucketProbeObjectName)
+}
+
+// PurgeBackupInfrastructure deletes all objects of the backup bucket and the bucket itself directly via the Google
+// Cloud Storage API, i.e., without relying on the Terraform state.
+func (b *GCPBotanist) PurgeBackupInfrastructure(ctx context.Context) error {
+	return b.GCPClient.PurgeBucket(ctx, b.Operation.BackupInfrastructure.Name)
 }
 
 // generateTerraformBackupVariablesEnvironment generates the environment containing the credentials which
@@ -231,15 +369,54 @@ func (b *GCPBotanist) generateTerraformBackupVariablesEnvironment() map[string]s
 
 // generateTerraformBackupConfig creates the Terraform variables and the Terraform config (for the backup)
 // and returns them.
-func (b *GCPBotanist) generateTerraformBackupConfig() map[string]interface{} {
+func (b *GCPBotanist) generateTerraformBackupConfig() (map[string]interface{}, error) {
+	region, err := b.BackupRegion()
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]interface{}{
 		"google": map[string]interface{}{
-			"region":  b.Seed.Info.Spec.Cloud.Region,
+			"region":  region,
 			"project": b.Project,
 		},
 		"bucket": map[string]interface{}{
 			"name": b.Operation.BackupInfrastructure.Name,
 		},
 		"clusterName": b.Operation.BackupInfrastructure.Name,
+	}, nil
+}
+
+// ListOrphanedInfrastructureResources lists the network resources which are created by the Terraform infrastructure
+// configuration of the Shoot but which are not contained in the Terraform state (anymore), e.g., left-overs of failed
+// destroy runs. It returns them in the form "<type>/<name>".
+func (b *GCPBotanist) ListOrphanedInfrastructureResources(ctx context.Context) ([]string, error) {
+	tf, err := b.NewShootTerraformer(common.TerraformerPurposeInfra)
+	if err != nil {
+		return nil, err
+	}
+	stateIdentifiers, err := tf.GetStateResourceIdentifiers()
+	if err != nil {
+		return nil, err
+	}
+
+	clusterName := b.Shoot.SeedNamespace
+	names := sets.NewString(
+		clusterName+"-nodes",
+		clusterName+"-internal",
+		clusterName+"-cloud-router",
+		clusterName+"-allow-internal-access",
+		clusterName+"-allow-external-access",
+		clusterName+"-allow-health-checks",
+	)
+	// An existing VPC is not managed by Terraform.
+	if b.VPCName == "" {
+		names.Insert(clusterName)
+	}
+
+	resources, err := b.GCPClient.ListNetworkResources(ctx, b.Project, b.Shoot.Info.Spec.Cloud.Region, names)
+	if err != nil {
+		return nil, err
 	}
+	return common.OrphanedInfrastructureResources(stateIdentifiers, resources), nil
 }
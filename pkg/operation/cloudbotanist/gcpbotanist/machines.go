@@ -19,6 +19,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -35,10 +36,20 @@ func (b *GCPBotanist) GetMachineClassInfo() (classKind, classPlural, classChartN
 
 // GenerateMachineClassSecretData generates the secret data for the machine class secret (except the userData field
 // which is computed elsewhere).
-func (b *GCPBotanist) GenerateMachineClassSecretData() map[string][]byte {
-	return map[string][]byte{
-		machinev1alpha1.GCPServiceAccountJSON: b.Shoot.Secret.Data[ServiceAccountJSON],
+func (b *GCPBotanist) GenerateMachineClassSecretData() (map[string][]byte, error) {
+	serviceAccountJSON := b.Shoot.Secret.Data[ServiceAccountJSON]
+
+	if usesMinimalServiceAccount() {
+		secret, err := b.K8sSeedClient.GetSecret(b.Shoot.SeedNamespace, machineControllerServiceAccountSecretName)
+		if err != nil {
+			return nil, err
+		}
+		serviceAccountJSON = secret.Data[ServiceAccountJSON]
 	}
+
+	return map[string][]byte{
+		machinev1alpha1.GCPServiceAccountJSON: serviceAccountJSON,
+	}, nil
 }
 
 // GenerateMachineConfig generates the configuration values for the cloud-specific machine class Helm chart. It
@@ -51,7 +62,6 @@ func (b *GCPBotanist) GenerateMachineConfig() ([]map[string]interface{}, operati
 		outputVariables     = []string{serviceAccountEmail, subnetNodes}
 		workers             = b.Shoot.Info.Spec.Cloud.GCP.Workers
 		zones               = b.Shoot.Info.Spec.Cloud.GCP.Zones
-		zoneLen             = len(zones)
 
 		machineDeployments = operation.MachineDeployments{}
 		machineClasses     = []map[string]interface{}{}
@@ -64,88 +74,136 @@ func (b *GCPBotanist) GenerateMachineConfig() ([]map[string]interface{}, operati
 	if err != nil {
 		return nil, nil, err
 	}
+	secretData, err := b.GenerateMachineClassSecretData()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	diskLabels := map[string]interface{}{}
+	for key, value := range b.Shoot.Info.Spec.Cloud.Tags {
+		diskLabels[key] = value
+	}
+	diskLabels["name"] = b.Shoot.Info.Name
 
 	for zoneIndex, zone := range zones {
 		for _, worker := range workers {
-			machineClassSpec := map[string]interface{}{
-				"region":             b.Shoot.Info.Spec.Cloud.Region,
-				"zone":               zone,
-				"canIpForward":       true,
-				"deletionProtection": false,
-				"description":        fmt.Sprintf("Machine of Shoot %s created by machine-controller-manager.", b.Shoot.Info.Name),
-				"disks": []map[string]interface{}{
-					{
-						"autoDelete": true,
-						"boot":       true,
-						"sizeGb":     common.DiskSize(worker.VolumeSize),
-						"type":       worker.VolumeType,
-						"image":      b.Shoot.Info.Spec.Cloud.GCP.MachineImage.Image,
-						"labels": map[string]interface{}{
-							"name": b.Shoot.Info.Name,
-						},
-					},
-				},
-				"labels": map[string]interface{}{
-					"name": b.Shoot.Info.Name,
+			workerZoneIndex, workerZoneLen, ok := common.WorkerZoneIndex(worker.Worker, zones, zoneIndex)
+			if !ok {
+				continue
+			}
+
+			disks := []map[string]interface{}{
+				{
+					"autoDelete": true,
+					"boot":       true,
+					"sizeGb":     common.DiskSize(worker.VolumeSize),
+					"type":       worker.VolumeType,
+					"image":      common.WorkerMachineImage(worker.Worker, b.Shoot.Info.Spec.Cloud.GCP.MachineImage.Image),
+					"labels":     diskLabels,
 				},
-				"machineType": worker.MachineType,
-				"networkInterfaces": []map[string]interface{}{
-					{
-						"subnetwork": stateVariables[subnetNodes],
+			}
+			for _, volume := range worker.DataVolumes {
+				disks = append(disks, map[string]interface{}{
+					"autoDelete": true,
+					"boot":       false,
+					"sizeGb":     common.DiskSize(volume.Size),
+					"type":       common.DataVolumeType(volume, worker.VolumeType),
+					"image":      "",
+					"labels":     diskLabels,
+				})
+			}
+
+			labels := map[string]interface{}{}
+			for key, value := range b.Shoot.Info.Spec.Cloud.Tags {
+				labels[key] = value
+			}
+			for key, value := range common.WorkerInstanceTags(worker.Worker, utils.SanitizeGCPLabel) {
+				labels[key] = value
+			}
+			labels["name"] = b.Shoot.Info.Name
+
+			networkInterface := map[string]interface{}{
+				"subnetwork": stateVariables[subnetNodes],
+			}
+			// GCP only translates the traffic of machines without an external IP via the Cloud NAT.
+			if b.Shoot.Info.Spec.Cloud.GCP.Networks.CloudNAT != nil {
+				networkInterface["disableExternalIP"] = true
+			}
+
+			for _, variant := range common.WorkerMachineVariants(worker.Worker) {
+				machineClassSpec := map[string]interface{}{
+					"region":             b.Shoot.Info.Spec.Cloud.Region,
+					"zone":               zone,
+					"canIpForward":       true,
+					"deletionProtection": false,
+					"description":        fmt.Sprintf("Machine of Shoot %s created by machine-controller-manager.", b.Shoot.Info.Name),
+					"disks":              disks,
+					"labels":             labels,
+					"machineType":        variant.MachineType,
+					"networkInterfaces":  []map[string]interface{}{networkInterface},
+					"scheduling":         scheduling(variant.Spot),
+					"secret": map[string]interface{}{
+						"cloudConfig": b.Shoot.CloudConfigMap[worker.Name].Downloader.Content,
 					},
-				},
-				"scheduling": map[string]interface{}{
-					"automaticRestart":  true,
-					"onHostMaintenance": "MIGRATE",
-					"preemptible":       false,
-				},
-				"secret": map[string]interface{}{
-					"cloudConfig": b.Shoot.CloudConfigMap[worker.Name].Downloader.Content,
-				},
-				"serviceAccounts": []map[string]interface{}{
-					{
-						"email": stateVariables[serviceAccountEmail],
-						"scopes": []string{
-							"https://www.googleapis.com/auth/compute",
+					"serviceAccounts": []map[string]interface{}{
+						{
+							"email": stateVariables[serviceAccountEmail],
+							"scopes": []string{
+								"https://www.googleapis.com/auth/compute",
+							},
 						},
 					},
-				},
-				"tags": []string{
-					b.Shoot.SeedNamespace,
-					fmt.Sprintf("kubernetes-io-cluster-%s", b.Shoot.SeedNamespace),
-					"kubernetes-io-role-node",
-				},
-			}
+					"tags": []string{
+						b.Shoot.SeedNamespace,
+						fmt.Sprintf("kubernetes-io-cluster-%s", b.Shoot.SeedNamespace),
+						"kubernetes-io-role-node",
+					},
+				}
+
+				var (
+					machineClassSpecHash = common.MachineClassHash(machineClassSpec, b.Shoot.KubernetesMajorMinorVersion)
+					deploymentName       = fmt.Sprintf("%s-%s-z%d%s", b.Shoot.SeedNamespace, worker.Name, zoneIndex+1, variant.Suffix)
+					className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
+				)
+
+				machineDeployments = append(machineDeployments, operation.MachineDeployment{
+					Name:           deploymentName,
+					ClassName:      className,
+					Minimum:        common.DistributeOverZones(workerZoneIndex, variant.Minimum, workerZoneLen),
+					Maximum:        common.DistributeOverZones(workerZoneIndex, variant.Maximum, workerZoneLen),
+					MaxSurge:       common.DistributePositiveIntOrPercent(workerZoneIndex, *worker.MaxSurge, workerZoneLen, variant.Maximum),
+					MaxUnavailable: common.DistributePositiveIntOrPercent(workerZoneIndex, *worker.MaxUnavailable, workerZoneLen, variant.Minimum),
+					Labels:         worker.Labels,
+					Annotations:    worker.Annotations,
+					Taints:         worker.Taints,
+					Priority:       variant.Priority,
+				})
+
+				machineClassSpec["name"] = className
+				machineClassSpec["secret"].(map[string]interface{})["serviceAccountJSON"] = string(secretData[machinev1alpha1.GCPServiceAccountJSON])
 
-			var (
-				machineClassSpecHash = common.MachineClassHash(machineClassSpec, b.Shoot.KubernetesMajorMinorVersion)
-				deploymentName       = fmt.Sprintf("%s-%s-z%d", b.Shoot.SeedNamespace, worker.Name, zoneIndex+1)
-				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
-				secretData           = b.GenerateMachineClassSecretData()
-			)
-
-			machineDeployments = append(machineDeployments, operation.MachineDeployment{
-				Name:           deploymentName,
-				ClassName:      className,
-				Minimum:        common.DistributeOverZones(zoneIndex, worker.AutoScalerMin, zoneLen),
-				Maximum:        common.DistributeOverZones(zoneIndex, worker.AutoScalerMax, zoneLen),
-				MaxSurge:       common.DistributePositiveIntOrPercent(zoneIndex, *worker.MaxSurge, zoneLen, worker.AutoScalerMax),
-				MaxUnavailable: common.DistributePositiveIntOrPercent(zoneIndex, *worker.MaxUnavailable, zoneLen, worker.AutoScalerMin),
-				Labels:         worker.Labels,
-				Annotations:    worker.Annotations,
-				Taints:         worker.Taints,
-			})
-
-			machineClassSpec["name"] = className
-			machineClassSpec["secret"].(map[string]interface{})["serviceAccountJSON"] = string(secretData[machinev1alpha1.GCPServiceAccountJSON])
-
-			machineClasses = append(machineClasses, machineClassSpec)
+				machineClasses = append(machineClasses, machineClassSpec)
+			}
 		}
 	}
 
 	return machineClasses, machineDeployments, nil
 }
 
+// GetMachineDeploymentNames returns the names of the MachineDeployments which may exist for the worker pools
+// in the current zones of the Shoot (regardless of the zones selected by the individual worker pools).
+func (b *GCPBotanist) GetMachineDeploymentNames() sets.String {
+	names := sets.NewString()
+	for zoneIndex := range b.Shoot.Info.Spec.Cloud.GCP.Zones {
+		for _, worker := range b.Shoot.Info.Spec.Cloud.GCP.Workers {
+			for _, variant := range common.WorkerMachineVariants(worker.Worker) {
+				names.Insert(fmt.Sprintf("%s-%s-z%d%s", b.Shoot.SeedNamespace, worker.Name, zoneIndex+1, variant.Suffix))
+			}
+		}
+	}
+	return names
+}
+
 // ListMachineClasses returns two sets of strings whereas the first contains the names of all machine
 // classes, and the second the names of all referenced secrets.
 func (b *GCPBotanist) ListMachineClasses() (sets.String, sets.String, error) {
@@ -188,3 +246,20 @@ func (b *GCPBotanist) CleanupMachineClasses(existingMachineDeployments operation
 
 	return nil
 }
+
+// scheduling returns the scheduling configuration of the machine class. Preemptible machines can neither be restarted
+// automatically nor be migrated during host maintenance.
+func scheduling(preemptible bool) map[string]interface{} {
+	if preemptible {
+		return map[string]interface{}{
+			"automaticRestart":  false,
+			"onHostMaintenance": "TERMINATE",
+			"preemptible":       true,
+		}
+	}
+	return map[string]interface{}{
+		"automaticRestart":  true,
+		"onHostMaintenance": "MIGRATE",
+		"preemptible":       false,
+	}
+}
@@ -15,6 +15,8 @@
 package gcpbotanist
 
 import (
+	"time"
+
 	"github.com/gardener/gardener/pkg/client/gcp"
 	"github.com/gardener/gardener/pkg/operation"
 )
@@ -34,4 +36,19 @@ const (
 	ServiceAccountJSON = "serviceaccount.json"
 	// ProjectID is a constant for the key in a Google service account storing the project id.
 	ProjectID = "project_id"
+	// NetworkMTU is the MTU of GCP VPC networks.
+	NetworkMTU = 1460
+
+	// machineControllerServiceAccountKeyRotationPeriod is the period after which the key of the service account of the
+	// machine-controller-manager is rotated (with the next reconciliation of the Shoot).
+	machineControllerServiceAccountKeyRotationPeriod = 30 * 24 * time.Hour
+	// machineControllerServiceAccountSecretName is the name of the secret in the Shoot namespace of the Seed which
+	// stores the key of the service account of the machine-controller-manager.
+	machineControllerServiceAccountSecretName = "machine-controller-manager-gcp"
+	// keyName, previousKeyName and keyGeneration are the keys in the secret of the service account of the
+	// machine-controller-manager which store the names of the current and previous key and the generation of the
+	// current key.
+	keyName         = "keyName"
+	previousKeyName = "previousKeyName"
+	keyGeneration   = "keyGeneration"
 )
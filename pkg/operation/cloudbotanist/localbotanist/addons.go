@@ -14,15 +14,20 @@
 
 package localbotanist
 
-import "github.com/gardener/gardener/pkg/operation/common"
+import (
+	"context"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation/common"
+)
 
 // DeployKube2IAMResources - Not needed on Local.
-func (b *LocalBotanist) DeployKube2IAMResources() error {
+func (b *LocalBotanist) DeployKube2IAMResources(ctx context.Context) error {
 	return nil
 }
 
 // DestroyKube2IAMResources - Not needed on Local.
-func (b *LocalBotanist) DestroyKube2IAMResources() error {
+func (b *LocalBotanist) DestroyKube2IAMResources(ctx context.Context) error {
 	return nil
 }
 
@@ -33,16 +38,19 @@ func (b *LocalBotanist) GenerateKube2IAMConfig() (map[string]interface{}, error)
 
 // GenerateStorageClassesConfig generates values which are required to render the chart shoot-storageclasses properly.
 func (b *LocalBotanist) GenerateStorageClassesConfig() (map[string]interface{}, error) {
-	return map[string]interface{}{
-		"StorageClasses": []map[string]interface{}{
-			{
-				"Name":           "default",
-				"IsDefaultClass": true,
-				"Provisioner":    "k8s.io/minikube-hostpath",
-				"Parameters":     map[string]interface{}{},
-			},
+	return b.Shoot.ComputeStorageClasses([]map[string]interface{}{
+		{
+			"Name":           "default",
+			"IsDefaultClass": true,
+			"Provisioner":    "k8s.io/minikube-hostpath",
+			"Parameters":     map[string]interface{}{},
 		},
-	}, nil
+	}, "", b.storageClassParameters), nil
+}
+
+// storageClassParameters computes the provisioner and its parameters for the given StorageClass of the Shoot.
+func (b *LocalBotanist) storageClassParameters(class gardenv1beta1.StorageClass) (string, map[string]interface{}) {
+	return "k8s.io/minikube-hostpath", map[string]interface{}{}
 }
 
 // GenerateNginxIngressConfig generates values which are required to render the chart nginx-ingress properly.
@@ -14,22 +14,43 @@
 
 package localbotanist
 
+import (
+	"context"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+)
+
 // DeployInfrastructure does currently nothing for Local.
-func (b *LocalBotanist) DeployInfrastructure() error {
+func (b *LocalBotanist) DeployInfrastructure(ctx context.Context) error {
 	return nil
 }
 
 // DestroyInfrastructure does currently nothing for Local.
-func (b *LocalBotanist) DestroyInfrastructure() error {
+func (b *LocalBotanist) DestroyInfrastructure(ctx context.Context) error {
 	return nil
 }
 
 // DeployBackupInfrastructure does currently nothing for Local.
-func (b *LocalBotanist) DeployBackupInfrastructure() error {
+func (b *LocalBotanist) DeployBackupInfrastructure(ctx context.Context) error {
 	return nil
 }
 
 // DestroyBackupInfrastructure does currently nothing for Local.
-func (b *LocalBotanist) DestroyBackupInfrastructure() error {
+func (b *LocalBotanist) DestroyBackupInfrastructure(ctx context.Context) error {
 	return nil
 }
+
+// ProbeBackupInfrastructure is not supported for Local as there is no backup bucket.
+func (b *LocalBotanist) ProbeBackupInfrastructure(ctx context.Context) error {
+	return common.ErrBackupBucketProbeNotSupported
+}
+
+// PurgeBackupInfrastructure does currently nothing for Local.
+func (b *LocalBotanist) PurgeBackupInfrastructure(ctx context.Context) error {
+	return nil
+}
+
+// ListOrphanedInfrastructureResources does currently nothing for Local.
+func (b *LocalBotanist) ListOrphanedInfrastructureResources(ctx context.Context) ([]string, error) {
+	return nil, nil
+}
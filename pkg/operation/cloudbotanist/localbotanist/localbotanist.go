@@ -18,6 +18,7 @@ import (
 	"errors"
 
 	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/common"
 )
 
 // New takes an operation object <o> and creates a new LocalBotanist object.
@@ -40,3 +41,8 @@ func New(o *operation.Operation) (*LocalBotanist, error) {
 func (b *LocalBotanist) GetCloudProviderName() string {
 	return b.CloudProviderName
 }
+
+// GetNetworkMTU returns the MTU of the network the nodes are connected to.
+func (b *LocalBotanist) GetNetworkMTU() int32 {
+	return common.DefaultNetworkMTU
+}
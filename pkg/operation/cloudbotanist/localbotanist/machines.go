@@ -30,8 +30,8 @@ func (b *LocalBotanist) GetMachineClassInfo() (classKind, classPlural, classChar
 
 // GenerateMachineClassSecretData generates the secret data for the machine class secret (except the userData field
 // which is computed elsewhere).
-func (b *LocalBotanist) GenerateMachineClassSecretData() map[string][]byte {
-	return map[string][]byte{}
+func (b *LocalBotanist) GenerateMachineClassSecretData() (map[string][]byte, error) {
+	return map[string][]byte{}, nil
 }
 
 // GenerateMachineConfig generates the configuration values for the cloud-specific machine class Helm chart. It
@@ -41,6 +41,12 @@ func (b *LocalBotanist) GenerateMachineConfig() ([]map[string]interface{}, opera
 	return nil, nil, nil
 }
 
+// GetMachineDeploymentNames returns the names of the MachineDeployments which may exist for the worker pools
+// in the current zones of the Shoot (regardless of the zones selected by the individual worker pools).
+func (b *LocalBotanist) GetMachineDeploymentNames() sets.String {
+	return sets.NewString()
+}
+
 // ListMachineClasses returns two sets of strings whereas the first contains the names of all machine
 // classes, and the second the names of all referenced secrets.
 func (b *LocalBotanist) ListMachineClasses() (sets.String, sets.String, error) {
@@ -15,18 +15,22 @@
 package openstackbotanist
 
 import (
+	"context"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation/common"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // DeployKube2IAMResources - Not needed on OpenStack
-func (b *OpenStackBotanist) DeployKube2IAMResources() error {
+func (b *OpenStackBotanist) DeployKube2IAMResources(ctx context.Context) error {
 	return nil
 }
 
 // DestroyKube2IAMResources - Not needed on OpenStack.
-func (b *OpenStackBotanist) DestroyKube2IAMResources() error {
+func (b *OpenStackBotanist) DestroyKube2IAMResources(ctx context.Context) error {
 	return nil
 }
 
@@ -38,23 +42,36 @@ func (b *OpenStackBotanist) GenerateKube2IAMConfig() (map[string]interface{}, er
 // GenerateStorageClassesConfig generates values which are required to render the chart shoot-storageclasses properly.
 func (b *OpenStackBotanist) GenerateStorageClassesConfig() (map[string]interface{}, error) {
 	// Delete legacy storage class (named "default") as we can't update the parameters (this legacy class
-	// did set `.parameters.type=default`).
-	if err := b.K8sShootClient.Kubernetes().StorageV1().StorageClasses().Delete("default", &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
-		return nil, err
+	// did set `.parameters.type=default`). It is kept if the Shoot specifies a storage class with this name.
+	if !b.Shoot.HasStorageClass("default") {
+		if err := b.K8sShootClient.Kubernetes().StorageV1().StorageClasses().Delete("default", &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
 	}
 
-	return map[string]interface{}{
-		"StorageClasses": []map[string]interface{}{
-			{
-				"Name":           "default-class",
-				"IsDefaultClass": true,
-				"Provisioner":    "kubernetes.io/cinder",
-				"Parameters": map[string]interface{}{
-					"availability": b.Shoot.Info.Spec.Cloud.OpenStack.Zones[0],
-				},
+	return b.Shoot.ComputeStorageClasses([]map[string]interface{}{
+		{
+			"Name":           "default-class",
+			"IsDefaultClass": true,
+			"Provisioner":    "kubernetes.io/cinder",
+			"Parameters": map[string]interface{}{
+				"availability": b.Shoot.Info.Spec.Cloud.OpenStack.Zones[0],
 			},
 		},
-	}, nil
+	}, corev1.LabelZoneFailureDomain, b.storageClassParameters), nil
+}
+
+// storageClassParameters computes the provisioner and its parameters for the given StorageClass of the Shoot. The
+// volumes are created in the first zone of the Shoot unless the StorageClass restricts the zones itself.
+func (b *OpenStackBotanist) storageClassParameters(class gardenv1beta1.StorageClass) (string, map[string]interface{}) {
+	parameters := map[string]interface{}{}
+	if class.VolumeType != nil {
+		parameters["type"] = *class.VolumeType
+	}
+	if len(class.Zones) == 0 {
+		parameters["availability"] = b.Shoot.Info.Spec.Cloud.OpenStack.Zones[0]
+	}
+	return "kubernetes.io/cinder", parameters
 }
 
 // GenerateNginxIngressConfig generates values which are required to render the chart nginx-ingress properly.
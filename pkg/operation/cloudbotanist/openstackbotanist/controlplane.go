@@ -24,10 +24,7 @@ import (
 const cloudProviderConfigTemplate = `
 [Global]
 auth-url=%q
-domain-name=%q
-tenant-name=%q
-username=%q
-password=%q
+%s
 [LoadBalancer]
 lb-version=v2
 lb-provider=%q
@@ -45,13 +42,19 @@ monitor-max-retries=5
 func (b *OpenStackBotanist) GenerateCloudProviderConfig() (string, error) {
 	var (
 		floatingNetworkID = "floating_network_id"
+		floatingSubnetID  = "floating_subnet_id"
 		subnetID          = "subnet_id"
+		outputVariables   = []string{floatingNetworkID, subnetID}
 	)
+	if b.Shoot.Info.Spec.Cloud.OpenStack.FloatingPoolSubnetName != nil {
+		outputVariables = append(outputVariables, floatingSubnetID)
+	}
+
 	tf, err := b.NewShootTerraformer(common.TerraformerPurposeInfra)
 	if err != nil {
 		return "", err
 	}
-	stateVariables, err := tf.GetStateOutputVariables(floatingNetworkID, subnetID)
+	stateVariables, err := tf.GetStateOutputVariables(outputVariables...)
 	if err != nil {
 		return "", err
 	}
@@ -59,15 +62,24 @@ func (b *OpenStackBotanist) GenerateCloudProviderConfig() (string, error) {
 	cloudProviderConfig := fmt.Sprintf(
 		cloudProviderConfigTemplate,
 		b.Shoot.CloudProfile.Spec.OpenStack.KeyStoneURL,
-		string(b.Shoot.Secret.Data[DomainName]),
-		string(b.Shoot.Secret.Data[TenantName]),
-		string(b.Shoot.Secret.Data[UserName]),
-		string(b.Shoot.Secret.Data[Password]),
+		cloudProviderCredentialsConfig(b.Shoot.Secret.Data),
 		b.Shoot.Info.Spec.Cloud.OpenStack.LoadBalancerProvider,
 		stateVariables[floatingNetworkID],
 		stateVariables[subnetID],
 	)
 
+	// The in-tree provider only talks to Octavia directly if explicitly told so, otherwise it uses the
+	// (deprecated) Neutron LBaaS v2 API even if 'octavia' is configured as lb-provider.
+	if b.Shoot.Info.Spec.Cloud.OpenStack.LoadBalancerProvider == LoadBalancerProviderOctavia {
+		cloudProviderConfig += `
+use-octavia=true`
+	}
+
+	if floatingSubnet, ok := stateVariables[floatingSubnetID]; ok {
+		cloudProviderConfig += fmt.Sprintf(`
+floating-subnet-id=%q`, floatingSubnet)
+	}
+
 	// https://github.com/kubernetes/kubernetes/pull/63903#issue-188306465
 	needsDHCPDomain, err := utils.CheckVersionMeetsConstraint(b.Shoot.Info.Spec.Kubernetes.Version, ">= 1.10.1, < 1.10.3")
 	if err != nil {
@@ -92,6 +104,28 @@ request-timeout=%s`, *b.Shoot.CloudProfile.Spec.OpenStack.RequestTimeout)
 	return cloudProviderConfig, nil
 }
 
+// cloudProviderCredentialsConfig returns the credentials part of the [Global] section of the cloud provider config.
+// Application credentials are preferred over user name and password if they are present in the secret.
+func cloudProviderCredentialsConfig(secretData map[string][]byte) string {
+	if usesApplicationCredentials(secretData) {
+		return fmt.Sprintf(`application-credential-id=%q
+application-credential-secret=%q`,
+			string(secretData[ApplicationCredentialID]),
+			string(secretData[ApplicationCredentialSecret]),
+		)
+	}
+
+	return fmt.Sprintf(`domain-name=%q
+tenant-name=%q
+username=%q
+password=%q`,
+		string(secretData[DomainName]),
+		string(secretData[TenantName]),
+		string(secretData[UserName]),
+		string(secretData[Password]),
+	)
+}
+
 // RefreshCloudProviderConfig refreshes the cloud provider credentials in the existing cloud
 // provider config.
 func (b *OpenStackBotanist) RefreshCloudProviderConfig(currentConfig map[string]string) map[string]string {
@@ -106,6 +140,8 @@ func (b *OpenStackBotanist) RefreshCloudProviderConfig(currentConfig map[string]
 	updated = common.ReplaceCloudProviderConfigKey(updated, separator, "tenant-name", string(b.Shoot.Secret.Data[TenantName]))
 	updated = common.ReplaceCloudProviderConfigKey(updated, separator, "username", string(b.Shoot.Secret.Data[UserName]))
 	updated = common.ReplaceCloudProviderConfigKey(updated, separator, "password", string(b.Shoot.Secret.Data[Password]))
+	updated = common.ReplaceCloudProviderConfigKey(updated, separator, "application-credential-id", string(b.Shoot.Secret.Data[ApplicationCredentialID]))
+	updated = common.ReplaceCloudProviderConfigKey(updated, separator, "application-credential-secret", string(b.Shoot.Secret.Data[ApplicationCredentialSecret]))
 
 	return map[string]string{
 		common.CloudProviderConfigMapKey: updated,
@@ -138,7 +174,8 @@ func (b *OpenStackBotanist) GenerateKubeAPIServerConfig() (map[string]interface{
 // GenerateCloudControllerManagerConfig generates the cloud provider specific values which are required to
 // render the Deployment manifest of the cloud-controller-manager properly.
 func (b *OpenStackBotanist) GenerateCloudControllerManagerConfig() (map[string]interface{}, string, error) {
-	return nil, common.CloudControllerManagerDeploymentName, nil
+	values, err := b.InjectOutOfTreeCloudControllerManager(nil, common.OpenStackCloudControllerManagerImageName, "/bin/openstack-cloud-controller-manager")
+	return values, common.CloudControllerManagerDeploymentName, err
 }
 
 // GenerateCSIConfig generates the configuration for CSI charts
@@ -183,68 +220,53 @@ func (b *OpenStackBotanist) GenerateEtcdBackupConfig() (map[string][]byte, map[s
 		return nil, nil, err
 	}
 
+	// The order of the variables is kept stable to not roll the etcd pods unnecessarily.
+	credentialsEnv := [][2]string{
+		{"OS_DOMAIN_NAME", DomainName},
+		{"OS_USERNAME", UserName},
+		{"OS_PASSWORD", Password},
+		{"OS_TENANT_NAME", TenantName},
+	}
+	if usesApplicationCredentials(b.Seed.BackupSecret.Data) {
+		credentialsEnv = [][2]string{
+			{"OS_APPLICATION_CREDENTIAL_ID", ApplicationCredentialID},
+			{"OS_APPLICATION_CREDENTIAL_SECRET", ApplicationCredentialSecret},
+		}
+	}
+
 	secretData := map[string][]byte{
-		UserName:   b.Seed.Secret.Data[UserName],
-		Password:   b.Seed.Secret.Data[Password],
-		TenantName: b.Seed.Secret.Data[TenantName],
-		AuthURL:    []byte(b.Seed.CloudProfile.Spec.OpenStack.KeyStoneURL),
-		DomainName: b.Seed.Secret.Data[DomainName],
+		AuthURL: []byte(b.Seed.CloudProfile.Spec.OpenStack.KeyStoneURL),
+	}
+	env := []map[string]interface{}{
+		backupSecretEnvVar("OS_AUTH_URL", AuthURL),
+	}
+	for _, e := range credentialsEnv {
+		secretData[e[1]] = b.Seed.BackupSecret.Data[e[1]]
+		env = append(env, backupSecretEnvVar(e[0], e[1]))
 	}
 
 	backupConfigData := map[string]interface{}{
 		"schedule":         b.Operation.ShootBackup.Schedule,
 		"storageProvider":  "Swift",
 		"storageContainer": stateVariables[containerName],
-		"env": []map[string]interface{}{
-			{
-				"name": "OS_AUTH_URL",
-				"valueFrom": map[string]interface{}{
-					"secretKeyRef": map[string]interface{}{
-						"name": common.BackupSecretName,
-						"key":  AuthURL,
-					},
-				},
-			},
-			{
-				"name": "OS_DOMAIN_NAME",
-				"valueFrom": map[string]interface{}{
-					"secretKeyRef": map[string]interface{}{
-						"name": common.BackupSecretName,
-						"key":  DomainName,
-					},
-				},
-			},
-			{
-				"name": "OS_USERNAME",
-				"valueFrom": map[string]interface{}{
-					"secretKeyRef": map[string]interface{}{
-						"name": common.BackupSecretName,
-						"key":  UserName,
-					},
-				},
-			},
-			{
-				"name": "OS_PASSWORD",
-				"valueFrom": map[string]interface{}{
-					"secretKeyRef": map[string]interface{}{
-						"name": common.BackupSecretName,
-						"key":  Password,
-					},
-				},
-			},
-			{
-				"name": "OS_TENANT_NAME",
-				"valueFrom": map[string]interface{}{
-					"secretKeyRef": map[string]interface{}{
-						"name": common.BackupSecretName,
-						"key":  TenantName,
-					},
-				},
+		"env":              env,
+		"volumeMount":      []map[string]interface{}{},
+	}
+	return secretData, backupConfigData, nil
+}
+
+// backupSecretEnvVar returns an environment variable for the etcd backup sidecar which references the given key
+// of the backup secret.
+func backupSecretEnvVar(name, key string) map[string]interface{} {
+	return map[string]interface{}{
+		"name": name,
+		"valueFrom": map[string]interface{}{
+			"secretKeyRef": map[string]interface{}{
+				"name": common.BackupSecretName,
+				"key":  key,
 			},
 		},
-		"volumeMount": []map[string]interface{}{},
 	}
-	return secretData, backupConfigData, nil
 }
 
 // DeployCloudSpecificControlPlane does currently nothing for OpenStack.
@@ -15,13 +15,17 @@
 package openstackbotanist
 
 import (
+	"context"
+
+	"github.com/gardener/gardener/pkg/client/openstack"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
 	"github.com/gardener/gardener/pkg/utils/secrets"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 // DeployInfrastructure kicks off a Terraform job which deploys the infrastructure.
-func (b *OpenStackBotanist) DeployInfrastructure() error {
+func (b *OpenStackBotanist) DeployInfrastructure(ctx context.Context) error {
 	var (
 		routerID     = "${openstack_networking_router_v2.router.id}"
 		createRouter = true
@@ -39,18 +43,18 @@ func (b *OpenStackBotanist) DeployInfrastructure() error {
 	return tf.
 		SetVariablesEnvironment(b.generateTerraformInfraVariablesEnvironment()).
 		InitializeWith(b.ChartInitializer("openstack-infra", b.generateTerraformInfraConfig(createRouter, routerID))).
-		Apply()
+		Apply(ctx)
 }
 
 // DestroyInfrastructure kicks off a Terraform job which destroys the infrastructure.
-func (b *OpenStackBotanist) DestroyInfrastructure() error {
+func (b *OpenStackBotanist) DestroyInfrastructure(ctx context.Context) error {
 	tf, err := b.NewShootTerraformer(common.TerraformerPurposeInfra)
 	if err != nil {
 		return err
 	}
 	return tf.
 		SetVariablesEnvironment(b.generateTerraformInfraVariablesEnvironment()).
-		Destroy()
+		Destroy(ctx)
 }
 
 // generateTerraformInfraVariablesEnvironment generates the environment containing the credentials which
@@ -58,23 +62,33 @@ func (b *OpenStackBotanist) DestroyInfrastructure() error {
 // Terraform variables which are prefixed with TF_VAR_.
 func (b *OpenStackBotanist) generateTerraformInfraVariablesEnvironment() map[string]string {
 	return terraformer.GenerateVariablesEnvironment(b.Shoot.Secret, map[string]string{
-		"USER_NAME": UserName,
-		"PASSWORD":  Password,
+		"USER_NAME":                     UserName,
+		"PASSWORD":                      Password,
+		"APPLICATION_CREDENTIAL_ID":     ApplicationCredentialID,
+		"APPLICATION_CREDENTIAL_SECRET": ApplicationCredentialSecret,
 	})
 }
 
 // generateTerraformInfraConfig creates the Terraform variables and the Terraform config (for the infrastructure)
 // and returns them (these values will be stored as a ConfigMap and a Secret in the Garden cluster.
 func (b *OpenStackBotanist) generateTerraformInfraConfig(createRouter bool, routerID string) map[string]interface{} {
+	openStackConfig := map[string]interface{}{
+		"authURL":              b.Shoot.CloudProfile.Spec.OpenStack.KeyStoneURL,
+		"domainName":           string(b.Shoot.Secret.Data[DomainName]),
+		"tenantName":           string(b.Shoot.Secret.Data[TenantName]),
+		"region":               b.Shoot.Info.Spec.Cloud.Region,
+		"floatingPoolName":     b.Shoot.Info.Spec.Cloud.OpenStack.FloatingPoolName,
+		"loadBalancerProvider": b.Shoot.Info.Spec.Cloud.OpenStack.LoadBalancerProvider,
+	}
+	if subnetName := b.Shoot.Info.Spec.Cloud.OpenStack.FloatingPoolSubnetName; subnetName != nil {
+		openStackConfig["floatingPoolSubnetName"] = *subnetName
+	}
+	if usesApplicationCredentials(b.Shoot.Secret.Data) {
+		openStackConfig["applicationCredentials"] = true
+	}
+
 	return map[string]interface{}{
-		"openstack": map[string]interface{}{
-			"authURL":              b.Shoot.CloudProfile.Spec.OpenStack.KeyStoneURL,
-			"domainName":           string(b.Shoot.Secret.Data[DomainName]),
-			"tenantName":           string(b.Shoot.Secret.Data[TenantName]),
-			"region":               b.Shoot.Info.Spec.Cloud.Region,
-			"floatingPoolName":     b.Shoot.Info.Spec.Cloud.OpenStack.FloatingPoolName,
-			"loadBalancerProvider": b.Shoot.Info.Spec.Cloud.OpenStack.LoadBalancerProvider,
-		},
+		"openstack": openStackConfig,
 		"create": map[string]interface{}{
 			"router": createRouter,
 		},
@@ -91,51 +105,104 @@ func (b *OpenStackBotanist) generateTerraformInfraConfig(createRouter bool, rout
 }
 
 // DeployBackupInfrastructure kicks off a Terraform job which creates the infrastructure resources for backup.
-func (b *OpenStackBotanist) DeployBackupInfrastructure() error {
+func (b *OpenStackBotanist) DeployBackupInfrastructure(ctx context.Context) error {
 	tf, err := b.NewBackupInfrastructureTerraformer()
 	if err != nil {
 		return err
 	}
+	values, err := b.generateTerraformBackupConfig()
+	if err != nil {
+		return err
+	}
 	return tf.
 		SetVariablesEnvironment(b.generateTerraformBackupVariablesEnvironment()).
-		InitializeWith(b.ChartInitializer("openstack-backup", b.generateTerraformBackupConfig())).
-		Apply()
+		InitializeWith(b.ChartInitializer("openstack-backup", values)).
+		Apply(ctx)
 }
 
 // DestroyBackupInfrastructure kicks off a Terraform job which destroys the infrastructure for backup.
-func (b *OpenStackBotanist) DestroyBackupInfrastructure() error {
+func (b *OpenStackBotanist) DestroyBackupInfrastructure(ctx context.Context) error {
 	tf, err := b.NewBackupInfrastructureTerraformer()
 	if err != nil {
 		return err
 	}
 	return tf.
 		SetVariablesEnvironment(b.generateTerraformBackupVariablesEnvironment()).
-		Destroy()
+		Destroy(ctx)
+}
+
+// ProbeBackupInfrastructure is not yet supported for OpenStack.
+func (b *OpenStackBotanist) ProbeBackupInfrastructure(ctx context.Context) error {
+	return common.ErrBackupBucketProbeNotSupported
+}
+
+// PurgeBackupInfrastructure is not yet supported for OpenStack.
+func (b *OpenStackBotanist) PurgeBackupInfrastructure(ctx context.Context) error {
+	return common.ErrBackupInfrastructurePurgeNotSupported
 }
 
 // generateTerraformBackupVariablesEnvironment generates the environment containing the credentials which
 // are required to validate/apply/destroy the Terraform configuration. These environment must contain
 // Terraform variables which are prefixed with TF_VAR_.
 func (b *OpenStackBotanist) generateTerraformBackupVariablesEnvironment() map[string]string {
-	return terraformer.GenerateVariablesEnvironment(b.Seed.Secret, map[string]string{
-		"USER_NAME": UserName,
-		"PASSWORD":  Password,
+	return terraformer.GenerateVariablesEnvironment(b.Seed.BackupSecret, map[string]string{
+		"USER_NAME":                     UserName,
+		"PASSWORD":                      Password,
+		"APPLICATION_CREDENTIAL_ID":     ApplicationCredentialID,
+		"APPLICATION_CREDENTIAL_SECRET": ApplicationCredentialSecret,
 	})
 }
 
 // generateTerraformBackupConfig creates the Terraform variables and the Terraform config (for the backup)
 // and returns them.
-func (b *OpenStackBotanist) generateTerraformBackupConfig() map[string]interface{} {
+func (b *OpenStackBotanist) generateTerraformBackupConfig() (map[string]interface{}, error) {
+	region, err := b.BackupRegion()
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]interface{}{
 		"openstack": map[string]interface{}{
-			"authURL":    b.Seed.CloudProfile.Spec.OpenStack.KeyStoneURL,
-			"domainName": string(b.Seed.Secret.Data[DomainName]),
-			"tenantName": string(b.Seed.Secret.Data[TenantName]),
-			"region":     b.Seed.Info.Spec.Cloud.Region,
+			"authURL":                b.Seed.CloudProfile.Spec.OpenStack.KeyStoneURL,
+			"domainName":             string(b.Seed.BackupSecret.Data[DomainName]),
+			"tenantName":             string(b.Seed.BackupSecret.Data[TenantName]),
+			"region":                 region,
+			"applicationCredentials": usesApplicationCredentials(b.Seed.BackupSecret.Data),
 		},
 		"container": map[string]interface{}{
 			"name": b.Operation.BackupInfrastructure.Name,
 		},
 		"clusterName": b.Operation.BackupInfrastructure.Name,
+	}, nil
+}
+
+// ListOrphanedInfrastructureResources lists the networks, subnets, routers and security groups named after the
+// Shoot which exist in the OpenStack project but are not contained in the Terraform state.
+func (b *OpenStackBotanist) ListOrphanedInfrastructureResources(ctx context.Context) ([]string, error) {
+	tf, err := b.NewShootTerraformer(common.TerraformerPurposeInfra)
+	if err != nil {
+		return nil, err
+	}
+	stateIdentifiers, err := tf.GetStateResourceIdentifiers()
+	if err != nil {
+		return nil, err
+	}
+
+	client := openstack.NewNetworkClientWithRateLimiter(b.Shoot.CloudProfile.Spec.OpenStack.KeyStoneURL, b.Shoot.Info.Spec.Cloud.Region, openstack.Credentials{
+		DomainName:                  string(b.Shoot.Secret.Data[DomainName]),
+		TenantName:                  string(b.Shoot.Secret.Data[TenantName]),
+		UserName:                    string(b.Shoot.Secret.Data[UserName]),
+		UserDomainName:              string(b.Shoot.Secret.Data[UserDomainName]),
+		Password:                    string(b.Shoot.Secret.Data[Password]),
+		ApplicationCredentialID:     string(b.Shoot.Secret.Data[ApplicationCredentialID]),
+		ApplicationCredentialSecret: string(b.Shoot.Secret.Data[ApplicationCredentialSecret]),
+	}, b.CloudAPIRateLimiter)
+
+	// All network resources are named after the Shoot. An existing router is not managed by Terraform, and its
+	// name is chosen by the user, hence it is never matched.
+	resources, err := client.ListNetworkResources(ctx, sets.NewString(b.Shoot.SeedNamespace))
+	if err != nil {
+		return nil, err
 	}
+	return common.OrphanedInfrastructureResources(stateIdentifiers, resources), nil
 }
@@ -34,8 +34,15 @@ func (b *OpenStackBotanist) GetMachineClassInfo() (classKind, classPlural, class
 }
 
 // GenerateMachineClassSecretData generates the secret data for the machine class secret (except the userData field
-// which is computed elsewhere).
-func (b *OpenStackBotanist) GenerateMachineClassSecretData() map[string][]byte {
+// which is computed elsewhere). The machine-controller-manager does not support application credentials, hence the
+// secret must contain user name and password even if the other components use an application credential.
+func (b *OpenStackBotanist) GenerateMachineClassSecretData() (map[string][]byte, error) {
+	for _, key := range []string{DomainName, TenantName, UserName, Password} {
+		if len(b.Shoot.Secret.Data[key]) == 0 {
+			return nil, fmt.Errorf("the cloud provider secret does not contain the key %q which is required by the machine-controller-manager, it does not support application credentials", key)
+		}
+	}
+
 	return map[string][]byte{
 		machinev1alpha1.OpenStackAuthURL:    []byte(b.Shoot.CloudProfile.Spec.OpenStack.KeyStoneURL),
 		machinev1alpha1.OpenStackInsecure:   []byte("true"),
@@ -43,7 +50,7 @@ func (b *OpenStackBotanist) GenerateMachineClassSecretData() map[string][]byte {
 		machinev1alpha1.OpenStackTenantName: b.Shoot.Secret.Data[TenantName],
 		machinev1alpha1.OpenStackUsername:   b.Shoot.Secret.Data[UserName],
 		machinev1alpha1.OpenStackPassword:   b.Shoot.Secret.Data[Password],
-	}
+	}, nil
 }
 
 // GenerateMachineConfig generates the configuration values for the cloud-specific machine class Helm chart. It
@@ -57,7 +64,6 @@ func (b *OpenStackBotanist) GenerateMachineConfig() ([]map[string]interface{}, o
 		outputVariables   = []string{networkID, keyName, securityGroupName}
 		workers           = b.Shoot.Info.Spec.Cloud.OpenStack.Workers
 		zones             = b.Shoot.Info.Spec.Cloud.OpenStack.Zones
-		zoneLen           = len(zones)
 
 		machineDeployments = operation.MachineDeployments{}
 		machineClasses     = []map[string]interface{}{}
@@ -70,22 +76,35 @@ func (b *OpenStackBotanist) GenerateMachineConfig() ([]map[string]interface{}, o
 	if err != nil {
 		return nil, nil, err
 	}
+	secretData, err := b.GenerateMachineClassSecretData()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tags := map[string]string{}
+	for key, value := range b.Shoot.Info.Spec.Cloud.Tags {
+		tags[key] = value
+	}
+	tags[fmt.Sprintf("kubernetes.io-cluster-%s", b.Shoot.SeedNamespace)] = "1"
+	tags["kubernetes.io-role-node"] = "1"
 
 	for zoneIndex, zone := range zones {
 		for _, worker := range workers {
+			workerZoneIndex, workerZoneLen, ok := common.WorkerZoneIndex(worker.Worker, zones, zoneIndex)
+			if !ok {
+				continue
+			}
+
 			machineClassSpec := map[string]interface{}{
 				"region":           b.Shoot.Info.Spec.Cloud.Region,
 				"availabilityZone": zone,
 				"machineType":      worker.MachineType,
 				"keyName":          stateVariables[keyName],
-				"imageName":        b.Shoot.Info.Spec.Cloud.OpenStack.MachineImage.Image,
+				"imageName":        common.WorkerMachineImage(worker.Worker, b.Shoot.Info.Spec.Cloud.OpenStack.MachineImage.Image),
 				"networkID":        stateVariables[networkID],
 				"podNetworkCidr":   b.Shoot.GetPodNetwork(),
 				"securityGroups":   []string{stateVariables[securityGroupName]},
-				"tags": map[string]string{
-					fmt.Sprintf("kubernetes.io-cluster-%s", b.Shoot.SeedNamespace): "1",
-					"kubernetes.io-role-node":                                      "1",
-				},
+				"tags":             tags,
 				"secret": map[string]interface{}{
 					"cloudConfig": b.Shoot.CloudConfigMap[worker.Name].Downloader.Content,
 				},
@@ -95,16 +114,15 @@ func (b *OpenStackBotanist) GenerateMachineConfig() ([]map[string]interface{}, o
 				machineClassSpecHash = common.MachineClassHash(machineClassSpec, b.Shoot.KubernetesMajorMinorVersion)
 				deploymentName       = fmt.Sprintf("%s-%s-z%d", b.Shoot.SeedNamespace, worker.Name, zoneIndex+1)
 				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
-				secretData           = b.GenerateMachineClassSecretData()
 			)
 
 			machineDeployments = append(machineDeployments, operation.MachineDeployment{
 				Name:           deploymentName,
 				ClassName:      className,
-				Minimum:        common.DistributeOverZones(zoneIndex, worker.AutoScalerMin, zoneLen),
-				Maximum:        common.DistributeOverZones(zoneIndex, worker.AutoScalerMax, zoneLen),
-				MaxSurge:       common.DistributePositiveIntOrPercent(zoneIndex, *worker.MaxSurge, zoneLen, worker.AutoScalerMax),
-				MaxUnavailable: common.DistributePositiveIntOrPercent(zoneIndex, *worker.MaxUnavailable, zoneLen, worker.AutoScalerMin),
+				Minimum:        common.DistributeOverZones(workerZoneIndex, worker.AutoScalerMin, workerZoneLen),
+				Maximum:        common.DistributeOverZones(workerZoneIndex, worker.AutoScalerMax, workerZoneLen),
+				MaxSurge:       common.DistributePositiveIntOrPercent(workerZoneIndex, *worker.MaxSurge, workerZoneLen, worker.AutoScalerMax),
+				MaxUnavailable: common.DistributePositiveIntOrPercent(workerZoneIndex, *worker.MaxUnavailable, workerZoneLen, worker.AutoScalerMin),
 				Labels:         worker.Labels,
 				Annotations:    worker.Annotations,
 				Taints:         worker.Taints,
@@ -124,6 +142,18 @@ func (b *OpenStackBotanist) GenerateMachineConfig() ([]map[string]interface{}, o
 	return machineClasses, machineDeployments, nil
 }
 
+// GetMachineDeploymentNames returns the names of the MachineDeployments which may exist for the worker pools
+// in the current zones of the Shoot (regardless of the zones selected by the individual worker pools).
+func (b *OpenStackBotanist) GetMachineDeploymentNames() sets.String {
+	names := sets.NewString()
+	for zoneIndex := range b.Shoot.Info.Spec.Cloud.OpenStack.Zones {
+		for _, worker := range b.Shoot.Info.Spec.Cloud.OpenStack.Workers {
+			names.Insert(fmt.Sprintf("%s-%s-z%d", b.Shoot.SeedNamespace, worker.Name, zoneIndex+1))
+		}
+	}
+	return names
+}
+
 // ListMachineClasses returns two sets of strings whereas the first contains the names of all machine
 // classes, and the second the names of all referenced secrets.
 func (b *OpenStackBotanist) ListMachineClasses() (sets.String, sets.String, error) {
@@ -24,21 +24,35 @@ import (
 
 // New takes an operation object <o> and creates a new OpenStackBotanist object.
 func New(o *operation.Operation, purpose string) (*OpenStackBotanist, error) {
-	var cloudProvider gardenv1beta1.CloudProvider
+	var (
+		cloudProvider gardenv1beta1.CloudProvider
+		cloudProfile  *gardenv1beta1.CloudProfile
+	)
 	switch purpose {
 	case common.CloudPurposeShoot:
 		cloudProvider = o.Shoot.CloudProvider
+		cloudProfile = o.Shoot.CloudProfile
 	case common.CloudPurposeSeed:
 		cloudProvider = o.Seed.CloudProvider
+		cloudProfile = o.Seed.CloudProfile
+	case common.CloudPurposeBackup:
+		cloudProvider = o.Seed.BackupProvider
+		cloudProfile = o.Seed.CloudProfile
 	}
 
 	if cloudProvider != gardenv1beta1.CloudProviderOpenStack {
 		return nil, errors.New("cannot instantiate an OpenStack botanist if neither Shoot nor Seed cluster specifies OpenStack")
 	}
 
+	networkMTU := int32(common.DefaultNetworkMTU)
+	if cloudProfile != nil && cloudProfile.Spec.OpenStack != nil && cloudProfile.Spec.OpenStack.NetworkMTU != nil {
+		networkMTU = *cloudProfile.Spec.OpenStack.NetworkMTU
+	}
+
 	return &OpenStackBotanist{
 		Operation:         o,
 		CloudProviderName: "openstack",
+		NetworkMTU:        networkMTU,
 	}, nil
 }
 
@@ -46,3 +60,15 @@ func New(o *operation.Operation, purpose string) (*OpenStackBotanist, error) {
 func (b *OpenStackBotanist) GetCloudProviderName() string {
 	return b.CloudProviderName
 }
+
+// GetNetworkMTU returns the MTU of the network the nodes are connected to. It depends on the Neutron setup
+// of the OpenStack system, hence it is taken from the CloudProfile if configured there.
+func (b *OpenStackBotanist) GetNetworkMTU() int32 {
+	return b.NetworkMTU
+}
+
+// usesApplicationCredentials returns true if the given secret data contains an OpenStack application credential
+// which shall be used instead of user name and password.
+func usesApplicationCredentials(secretData map[string][]byte) bool {
+	return len(secretData[ApplicationCredentialID]) > 0 && len(secretData[ApplicationCredentialSecret]) > 0
+}
@@ -20,6 +20,7 @@ import "github.com/gardener/gardener/pkg/operation"
 type OpenStackBotanist struct {
 	*operation.Operation
 	CloudProviderName string
+	NetworkMTU        int32
 }
 
 const (
@@ -35,4 +36,13 @@ const (
 	Password = "password"
 	// AuthURL is a constant for the key in a backup secret that holds the OpenStack authentication URL.
 	AuthURL = "authURL"
+	// ApplicationCredentialID is a constant for the key in a cloud provider secret and backup secret that holds the
+	// OpenStack application credential id.
+	ApplicationCredentialID = "applicationCredentialID"
+	// ApplicationCredentialSecret is a constant for the key in a cloud provider secret and backup secret that holds the
+	// OpenStack application credential secret.
+	ApplicationCredentialSecret = "applicationCredentialSecret"
+
+	// LoadBalancerProviderOctavia is the name of the Octavia load balancer provider.
+	LoadBalancerProviderOctavia = "octavia"
 )
@@ -15,6 +15,8 @@
 package cloudbotanist
 
 import (
+	"context"
+
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -24,12 +26,16 @@ import (
 // is responsible for all operations which require IaaS specific knowledge.
 type CloudBotanist interface {
 	GetCloudProviderName() string
+	GetNetworkMTU() int32
 
 	// Infrastructure
-	DeployInfrastructure() error
-	DestroyInfrastructure() error
-	DeployBackupInfrastructure() error
-	DestroyBackupInfrastructure() error
+	DeployInfrastructure(ctx context.Context) error
+	DestroyInfrastructure(ctx context.Context) error
+	DeployBackupInfrastructure(ctx context.Context) error
+	DestroyBackupInfrastructure(ctx context.Context) error
+	ProbeBackupInfrastructure(ctx context.Context) error
+	PurgeBackupInfrastructure(ctx context.Context) error
+	ListOrphanedInfrastructureResources(ctx context.Context) ([]string, error)
 
 	// Control Plane
 	GenerateCloudProviderConfig() (string, error)
@@ -49,13 +55,14 @@ type CloudBotanist interface {
 	// Machines
 	GetMachineClassInfo() (string, string, string)
 	GenerateMachineConfig() ([]map[string]interface{}, operation.MachineDeployments, error)
-	GenerateMachineClassSecretData() map[string][]byte
+	GetMachineDeploymentNames() sets.String
+	GenerateMachineClassSecretData() (map[string][]byte, error)
 	ListMachineClasses() (sets.String, sets.String, error)
 	CleanupMachineClasses(existingMachineDeployments operation.MachineDeployments) error
 
 	// Addons
-	DeployKube2IAMResources() error
-	DestroyKube2IAMResources() error
+	DeployKube2IAMResources(ctx context.Context) error
+	DestroyKube2IAMResources(ctx context.Context) error
 	GenerateKube2IAMConfig() (map[string]interface{}, error)
 	GenerateStorageClassesConfig() (map[string]interface{}, error)
 	GenerateNginxIngressConfig() (map[string]interface{}, error)
@@ -12,22 +12,21 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package utils
+package common
 
 import (
 	"strings"
 
-	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils"
 )
 
-const separator = ","
+const tasksSeparator = ","
 
 // AddTasks adds a task to the ShootTasks annotation of the passed map.
 func AddTasks(existingAnnotations map[string]string, tasksToAdd ...string) {
 	var tasks []string
-	if len(existingAnnotations[common.ShootTasks]) > 0 {
-		tasks = strings.Split(existingAnnotations[common.ShootTasks], separator)
+	if len(existingAnnotations[ShootTasks]) > 0 {
+		tasks = strings.Split(existingAnnotations[ShootTasks], tasksSeparator)
 	}
 	for _, taskToAdd := range tasksToAdd {
 		if utils.ValueExists(taskToAdd, tasks) {
@@ -35,20 +34,20 @@ func AddTasks(existingAnnotations map[string]string, tasksToAdd ...string) {
 		}
 		tasks = append(tasks, taskToAdd)
 	}
-	existingAnnotations[common.ShootTasks] = strings.Join(tasks, separator)
+	existingAnnotations[ShootTasks] = strings.Join(tasks, tasksSeparator)
 }
 
 // HasTask checks if the passed task is part of the ShootTasks annotation.
 func HasTask(existingAnnotations map[string]string, taskToCheck string) bool {
-	existingTasks, ok := existingAnnotations[common.ShootTasks]
+	existingTasks, ok := existingAnnotations[ShootTasks]
 	if !ok {
 		return false
 	}
-	tasks := strings.Split(existingTasks, separator)
+	tasks := strings.Split(existingTasks, tasksSeparator)
 	return utils.ValueExists(taskToCheck, tasks)
 }
 
 // RemoveAllTasks removes the ShootTasks annotation from the passed map.
 func RemoveAllTasks(existingAnnotations map[string]string) {
-	delete(existingAnnotations, common.ShootTasks)
+	delete(existingAnnotations, ShootTasks)
 }
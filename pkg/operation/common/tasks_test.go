@@ -0,0 +1,58 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common_test
+
+import (
+	"strings"
+
+	. "github.com/gardener/gardener/pkg/operation/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("tasks", func() {
+	DescribeTable("#AddTask",
+		func(existingTasks map[string]string, tasks []string, expectedTasks []string) {
+			AddTasks(existingTasks, tasks...)
+			shootTasks := existingTasks[ShootTasks]
+			Expect(strings.Split(shootTasks, ",")).To(Equal(expectedTasks))
+		},
+		Entry("task to absent annotation", map[string]string{},
+			[]string{ShootTaskDeployInfrastructure}, []string{ShootTaskDeployInfrastructure}),
+		Entry("tasks to empty list", map[string]string{},
+			[]string{ShootTaskDeployInfrastructure, ShootTaskDeployKube2IAMResource}, []string{ShootTaskDeployInfrastructure, ShootTaskDeployKube2IAMResource}),
+		Entry("task to empty list", map[string]string{ShootTaskDeployInfrastructure: ""},
+			[]string{ShootTaskDeployInfrastructure}, []string{ShootTaskDeployInfrastructure}),
+		Entry("task to empty list twice", map[string]string{},
+			[]string{ShootTaskDeployInfrastructure, ShootTaskDeployInfrastructure}, []string{ShootTaskDeployInfrastructure}),
+		Entry("tasks to filled list", map[string]string{ShootTasks: ShootTaskDeployInfrastructure},
+			[]string{ShootTaskDeployKube2IAMResource}, []string{ShootTaskDeployInfrastructure, ShootTaskDeployKube2IAMResource}),
+		Entry("tasks already in list", map[string]string{ShootTasks: ShootTaskDeployInfrastructure},
+			[]string{ShootTaskDeployKube2IAMResource, ShootTaskDeployInfrastructure}, []string{ShootTaskDeployInfrastructure, ShootTaskDeployKube2IAMResource}),
+	)
+
+	DescribeTable("#HasTask",
+		func(existingTasks map[string]string, task string, expectedResult bool) {
+			result := HasTask(existingTasks, task)
+			Expect(result).To(Equal(expectedResult))
+		},
+		Entry("absent task annotation", map[string]string{}, ShootTaskDeployInfrastructure, false),
+		Entry("empty task list", map[string]string{ShootTasks: ""}, ShootTaskDeployInfrastructure, false),
+		Entry("task not in list", map[string]string{ShootTasks: ShootTaskDeployKube2IAMResource + "," + "dummyTask"}, ShootTaskDeployInfrastructure, false),
+		Entry("task in list", map[string]string{ShootTasks: ShootTaskDeployKube2IAMResource + "," + ShootTaskDeployInfrastructure}, ShootTaskDeployKube2IAMResource, true),
+	)
+})
@@ -15,6 +15,7 @@
 package common
 
 import (
+	"errors"
 	"fmt"
 	"path/filepath"
 
@@ -29,12 +30,26 @@ const (
 	// authenticate against the respective cloud provider (required to store the backups of Shoot clusters).
 	BackupSecretName = "etcd-backup"
 
+	// BackupBucketProbeObjectName is the name of the object which is written to and deleted from backup buckets in
+	// order to verify that they are writable.
+	BackupBucketProbeObjectName = "gardener-bucket-probe"
+
 	// BackupInfrastructureOperation is a constant for an annotation on a Backupinfrastructure indicating that an operation shall be performed.
 	BackupInfrastructureOperation = "backupinfrastructure.garden.sapcloud.io/operation"
 
+	// BackupInfrastructureRetention is a constant for an annotation on a BackupInfrastructure whose value is the minimum
+	// duration the BackupInfrastructure is kept after its deletion (it is set according to the DeletionProtectionPolicies
+	// which matched the Shoot when it was deleted).
+	BackupInfrastructureRetention = "backupinfrastructure.garden.sapcloud.io/retention"
+
 	// BackupInfrastructureReconcile is a constant for an annotation on a Backupinfrastructure indicating that a Backupinfrastructure reconciliation shall be triggered.
 	BackupInfrastructureReconcile = "reconcile"
 
+	// BackupInfrastructureForcePurge is a constant for an annotation on a Backupinfrastructure indicating that the backup
+	// bucket shall be purged directly via the cloud provider API instead of destroying it with Terraform. It is only
+	// respected if the deletion is confirmed with the ConfirmationForcePurge annotation.
+	BackupInfrastructureForcePurge = "force-purge"
+
 	// ChartPath is the path to the Helm charts.
 	ChartPath = "charts"
 
@@ -46,6 +61,15 @@ const (
 	// will be downloaded.
 	CloudConfigFilePath = "/var/lib/cloud-config-downloader/downloads/cloud_config"
 
+	// CloudProfileCatalogSecretRef is an annotation on a CloudProfile whose value references a secret in the form
+	// <namespace>/<name>. If it is set then the CloudProfile is refreshed from the catalog of the cloud provider
+	// using the credentials of that secret.
+	CloudProfileCatalogSecretRef = "cloudprofile.garden.sapcloud.io/catalog-secret-ref"
+
+	// CloudProfileCatalogGenerated is an annotation on a CloudProfile which records the entries that have been
+	// generated from the catalog of the cloud provider. It is maintained by the Gardener controller manager.
+	CloudProfileCatalogGenerated = "cloudprofile.garden.sapcloud.io/catalog-generated"
+
 	// CloudProviderSecretName is the name of the secret containing the cloud provider credentials.
 	CloudProviderSecretName = "cloudprovider"
 
@@ -55,16 +79,48 @@ const (
 	// CloudProviderConfigMapKey is the key storing the cloud provider config as value in the cloud provider configmap.
 	CloudProviderConfigMapKey = "cloudprovider.conf"
 
+	// ClusterAutoscalerPriorityExpanderConfigMapName is the name of the config map in the kube-system namespace of the
+	// Shoot which contains the configuration of the priority expander of the cluster-autoscaler.
+	ClusterAutoscalerPriorityExpanderConfigMapName = "cluster-autoscaler-priority-expander"
+
+	// DefaultNetworkMTU is the MTU of the networks of cloud providers which do not deviate from the Ethernet default.
+	DefaultNetworkMTU = 1500
+
 	// CloudPurposeShoot is a constant used while instantiating a cloud botanist for the Shoot cluster.
 	CloudPurposeShoot = "shoot"
 
 	// CloudPurposeSeed is a constant used while instantiating a cloud botanist for the Seed cluster.
 	CloudPurposeSeed = "seed"
 
+	// CloudPurposeBackup is a constant used while instantiating a cloud botanist for the backup infrastructure of the
+	// Shoots hosted by a Seed cluster.
+	CloudPurposeBackup = "backup"
+
 	// ConfirmationDeletion is an annotation on a Shoot resource whose value must be set to "true" in order to
 	// allow deleting the Shoot (if the annotation is not set any DELETE request will be denied).
 	ConfirmationDeletion = "confirmation.garden.sapcloud.io/deletion"
 
+	// ConfirmationDeletionApproval is an annotation on a Shoot resource whose value must be set to "true" by a user in
+	// order to approve the deletion of a Shoot protected by a DeletionProtectionPolicy. The annotation is removed by the
+	// ShootDeletionProtection admission plugin which records the approving user in the ShootDeletionApprovedBy annotation.
+	ConfirmationDeletionApproval = "confirmation.garden.sapcloud.io/deletion-approval"
+
+	// ConfirmationForceDeletion is an annotation on a Shoot resource whose value must be set to the name of the Shoot in
+	// order to confirm its force deletion requested with the ShootForceDelete annotation.
+	ConfirmationForceDeletion = "confirmation.garden.sapcloud.io/force-deletion"
+
+	// ConfirmationForcePurge is an annotation on a BackupInfrastructure resource whose value must be set to the name of
+	// the BackupInfrastructure in order to allow purging all backups and the backup bucket without Terraform.
+	ConfirmationForcePurge = "confirmation.garden.sapcloud.io/force-purge"
+
+	// ConfirmationOrphanDeletion is an annotation on a Seed resource whose value must be set to "true" in order to
+	// allow the Gardener to delete the orphaned resources reported in the Seed status.
+	ConfirmationOrphanDeletion = "confirmation.garden.sapcloud.io/orphan-deletion"
+
+	// ConfirmationWorkerPoolDeletion is an annotation on a Shoot resource whose value must contain the comma-separated
+	// names of the worker pools which are removed by an update of the Shoot (otherwise the update will be denied).
+	ConfirmationWorkerPoolDeletion = "confirmation.garden.sapcloud.io/worker-pool-deletion"
+
 	// ControllerManagerInternalConfigMapName is the name of the internal config map in which the Gardener controller
 	// manager stores its configuration.
 	ControllerManagerInternalConfigMapName = "gardener-controller-manager-internal-config"
@@ -104,6 +160,11 @@ const (
 	// It is also used by the gardener-apiserver.
 	GardenNamespace = "garden"
 
+	// GardenFreeze is a constant for an annotation on the garden namespace which freezes the whole landscape if its
+	// value is "true": the Gardener controller manager does not start new Shoot operations and the gardener-apiserver
+	// rejects modifications of the Shoot specifications by users who are not allowed to update the garden namespace.
+	GardenFreeze = "garden.sapcloud.io/freeze"
+
 	// GardenRole is the key for an annotation on a Kubernetes object indicating what it is used for.
 	GardenRole = "garden.sapcloud.io/role"
 
@@ -152,6 +213,9 @@ const (
 	// GardenRoleCertificateManagement is the value of GardenRole key indicating type 'certificate-management'.
 	GardenRoleCertificateManagement = "certificate-management"
 
+	// GardenRoleVault is the value of GardenRole key indicating type 'vault'.
+	GardenRoleVault = "vault"
+
 	// GardenRoleVpa is the value of GardenRole key indicating type 'vpa'.
 	GardenRoleVpa = "vpa"
 
@@ -180,6 +244,17 @@ const (
 	// KubeAPIServerDeploymentName is the name of the kube-apiserver deployment.
 	KubeAPIServerDeploymentName = "kube-apiserver"
 
+	// KubeAPIServerBasicAuthSecretName is the name of the secret containing the basic authentication credentials
+	// of the kube-apiserver.
+	KubeAPIServerBasicAuthSecretName = "kube-apiserver-basic-auth"
+
+	// KubeAPIServerStaticTokenSecretName is the name of the secret containing the static tokens of the kube-apiserver.
+	KubeAPIServerStaticTokenSecretName = "kube-apiserver-static-token"
+
+	// KubeAPIServerHealthCheckUsername is the name of the user which is used for the health checks of the
+	// kube-apiserver.
+	KubeAPIServerHealthCheckUsername = "health-check"
+
 	// AWSLBReadvertiserDeploymentName is the name for the aws-lb-readvertiser
 	AWSLBReadvertiserDeploymentName = "aws-lb-readvertiser"
 
@@ -296,6 +371,9 @@ const (
 	// TerraformerJobSuffix is the suffix used for the name of the Job which executes the Terraform configuration.
 	TerraformerJobSuffix = ".tf-job"
 
+	// TerraformerRunSuffix is the suffix used for the names of the TerraformRuns which record the Terraform executions.
+	TerraformerRunSuffix = ".tf-run"
+
 	// TerraformerPurposeInfra is a constant for the complete Terraform setup with purpose 'infrastructure'.
 	TerraformerPurposeInfra = "infra"
 
@@ -317,11 +395,40 @@ const (
 	// TerraformerPurposeKube2IAM is a constant for the complete Terraform setup with purpose 'kube2iam roles'.
 	TerraformerPurposeKube2IAM = "kube2iam"
 
+	// TerraformerOutputKeyEgressIPs is the key of the Terraform output variable of the infrastructure setup which contains the
+	// comma-separated list of the static IP addresses used by the Shoot worker nodes for egress traffic.
+	TerraformerOutputKeyEgressIPs = "egress_ips"
+
 	// ShootExpirationTimestamp is an annotation on a Shoot resource whose value represents the time when the Shoot lifetime
 	// is expired. The lifetime can be extended, but at most by the minimal value of the 'clusterLifetimeDays' property
 	// of referenced quotas.
 	ShootExpirationTimestamp = "shoot.garden.sapcloud.io/expirationTimestamp"
 
+	// ShootDeletionApprovedBy is an annotation on a Shoot resource which contains a JSON object mapping the names of the
+	// users who have approved its deletion to the time of their approval. It is only maintained by the
+	// ShootDeletionProtection admission plugin.
+	ShootDeletionApprovedBy = "shoot.garden.sapcloud.io/deletion-approved-by"
+
+	// ShootKubeletServingCertificates is a constant for an annotation on a Shoot resource indicating that the kubelets
+	// request their serving certificates from the cluster CA. If its value is "true", the kube-apiserver verifies them.
+	// It is set to "true" by Gardener when the Shoot is created. The kube-apiserver cannot verify the self-signed
+	// serving certificates of the kubelets on already existing nodes, hence, it is set to
+	// ShootKubeletServingCertificatesRollingOut for existing Shoots until all their nodes have received a serving
+	// certificate.
+	ShootKubeletServingCertificates = "shoot.garden.sapcloud.io/kubelet-serving-certificates"
+
+	// ShootKubeletServingCertificatesRollingOut is the value of the ShootKubeletServingCertificates annotation while the
+	// kubelets of an existing Shoot are switched to serving certificates signed by the cluster CA.
+	ShootKubeletServingCertificatesRollingOut = "rolling-out"
+
+	// NodeKubeletServingCertificateApproved is a constant for an annotation on a Node in a Shoot cluster indicating
+	// that Gardener has approved a serving certificate request of its kubelet.
+	NodeKubeletServingCertificateApproved = "node.garden.sapcloud.io/kubelet-serving-certificate-approved"
+
+	// ShootRelaxedWebhooks is a constant for an annotation on a webhook configuration in the Shoot cluster which contains the
+	// comma-separated names of the webhooks whose failure policy has temporarily been set to 'Ignore' during a wake-up.
+	ShootRelaxedWebhooks = "shoot.garden.sapcloud.io/relaxed-webhooks"
+
 	// ShootUseAsSeed is a constant for an annotation on a Shoot resource indicating that the Shoot shall be registered as Seed in the
 	// Garden cluster once successfully created.
 	ShootUseAsSeed = "shoot.garden.sapcloud.io/use-as-seed"
@@ -345,6 +452,27 @@ const (
 	// possible.
 	ShootOperationMaintain = "maintain"
 
+	// ShootIgnoreVersionSkew is a constant for an annotation on a Shoot which allows operators to bypass the Kubernetes
+	// version skew checks of the ShootVersionSkew admission plugin if its value is "true". It is only honoured for
+	// requests of users who are allowed to update the garden namespace.
+	ShootIgnoreVersionSkew = "shoot.garden.sapcloud.io/ignore-version-skew"
+
+	// ShootForceDelete is a constant for an annotation on a Shoot which allows operators to force the deletion of a
+	// Shoot whose cloud provider account or credentials are no longer available if its value is "true". It is only
+	// respected if the Shoot is already marked for deletion and the force deletion is confirmed with the
+	// ConfirmationForceDeletion annotation. Only operators may set both annotations (see the ShootForceDeletion
+	// admission plugin).
+	ShootForceDelete = "shoot.garden.sapcloud.io/force-delete"
+
+	// ShootOrphanedResourcesSuffix is the suffix of the name of the config map in the Garden cluster which contains
+	// the infrastructure resources that could not be cleaned up during the force deletion of a Shoot.
+	ShootOrphanedResourcesSuffix = "orphaned-resources"
+
+	// ShootInfrastructureImports is a constant for an annotation on a Shoot containing a comma-separated list of
+	// '<address>=<id>' pairs of pre-existing infrastructure resources which shall be imported into the Terraform
+	// state of the Shoot's infrastructure.
+	ShootInfrastructureImports = "shoot.garden.sapcloud.io/infrastructure-imports"
+
 	// ShootTasks is a constant for an annotation on a Shoot which states that certain tasks should be done.
 	ShootTasks = "shoot.garden.sapcloud.io/tasks"
 
@@ -360,6 +488,14 @@ const (
 	// ShootOperationReconcile is a constant for an annotation on a Shoot indicating that a Shoot reconciliation shall be triggered.
 	ShootOperationReconcile = "reconcile"
 
+	// ShootReconcile is a constant for an annotation on a Shoot which may be used to control whether unchanged Shoots
+	// are reconciled if the ShootReconcileChangeDetection feature gate is enabled.
+	ShootReconcile = "shoot.garden.sapcloud.io/reconcile"
+
+	// ShootReconcileAlways is a value for the ShootReconcile annotation which states that the Shoot shall always be
+	// reconciled, even if its desired state has not changed since the last successful reconciliation.
+	ShootReconcileAlways = "always"
+
 	// ShootSyncPeriod is a constant for an annotation on a Shoot which may be used to overwrite the global Shoot controller sync period.
 	// The value must be a duration. It can also be used to disable the reconciliation at all by setting it to 0m. Disabling the reconciliation
 	// does only mean that the period reconciliation is disabled. However, when the Gardener is restarted/redeployed or the specification is
@@ -375,6 +511,18 @@ const (
 	// which value will be the value of `shoot.status.uid`
 	ShootUID = "shoot.garden.sapcloud.io/uid"
 
+	// MachineDeploymentKubernetesVersion is an annotation key for a MachineDeployment in the seed cluster whose value
+	// is the Kubernetes minor version the machines of the MachineDeployment have completely been rolled to.
+	MachineDeploymentKubernetesVersion = "worker.garden.sapcloud.io/kubernetes-version"
+
+	// MachineDeploymentRolloutPaused is an annotation key for a MachineDeployment in the seed cluster whose value
+	// is the reason why its rollout has been paused.
+	MachineDeploymentRolloutPaused = "worker.garden.sapcloud.io/rollout-paused"
+
+	// MachineDeploymentRolloutPausedGeneration is an annotation key for a MachineDeployment in the seed cluster whose
+	// value is the generation of the Shoot during which its rollout has been paused.
+	MachineDeploymentRolloutPausedGeneration = "worker.garden.sapcloud.io/rollout-paused-generation"
+
 	// AnnotateSeedNamespacePrefix is such a prefix so that the shoot namespace in the seed cluster
 	// will be annotated with the annotations of the shoot resource starting with it.
 	// For example, if the shoot is annotated with <AnnotateSeedNamespacePrefix>key=value,
@@ -414,6 +562,12 @@ const (
 	// VPNSeedImageName is the name of the VPNSeed image.
 	VPNSeedImageName = "vpn-seed"
 
+	// ShootWebhookImageName is the name of the gardener-shoot-webhook image.
+	ShootWebhookImageName = "gardener-shoot-webhook"
+
+	// ShootWebhookPort is the port on which the gardener-shoot-webhook serves the webhooks for the kube-apiserver.
+	ShootWebhookPort = 9443
+
 	// NodeExporterImageName is the name of the NodeExporter image.
 	NodeExporterImageName = "node-exporter"
 
@@ -468,6 +622,12 @@ const (
 	// AlicloudControllerManagerImageName is the name of the AlicloudControllerManager image.
 	AlicloudControllerManagerImageName = "alicloud-controller-manager"
 
+	// OpenStackCloudControllerManagerImageName is the name of the out-of-tree OpenStack cloud-controller-manager image.
+	OpenStackCloudControllerManagerImageName = "openstack-cloud-controller-manager"
+
+	// AzureCloudControllerManagerImageName is the name of the out-of-tree Azure cloud-controller-manager image.
+	AzureCloudControllerManagerImageName = "azure-cloud-controller-manager"
+
 	// CSI Images
 
 	// CSIAttacherImageName is the name of csi attacher - https://github.com/kubernetes-csi/external-attacher
@@ -538,6 +698,39 @@ const (
 	// DependancyWatchdogDeploymentName is the name of the dependency controller resources.
 	DependancyWatchdogDeploymentName = "dependency-watchdog"
 
+	// NetworkPolicyDenyAll is the name of the network policy denying all ingress traffic in a Shoot namespace in the Seed.
+	NetworkPolicyDenyAll = "deny-all"
+
+	// NetworkPolicyAllowKubeAPIServer is the name of the network policy allowing ingress traffic to the kube-apiserver.
+	NetworkPolicyAllowKubeAPIServer = "allow-kube-apiserver"
+
+	// NetworkPolicyAllowEtcd is the name of the network policy allowing ingress traffic to etcd.
+	NetworkPolicyAllowEtcd = "allow-etcd"
+
+	// NetworkPolicyAllowFromShootNamespace is the name of the network policy allowing ingress traffic between the control
+	// plane components of a Shoot.
+	NetworkPolicyAllowFromShootNamespace = "allow-from-shoot-namespace"
+
+	// NetworkPolicyAllowFromSeed is the name of the network policy allowing ingress traffic from the Seed's system namespaces.
+	NetworkPolicyAllowFromSeed = "allow-from-seed"
+
+	// NetworkPolicyAllowIngressEndpoints is the name of the network policy allowing ingress traffic to the monitoring and
+	// logging UIs exposed via the Seed's ingress controller.
+	NetworkPolicyAllowIngressEndpoints = "allow-ingress-endpoints"
+
+	// DashboardOIDCProxyName is the name of the OpenID Connect proxy protecting the monitoring and logging dashboards.
+	DashboardOIDCProxyName = "dashboard-oidc-proxy"
+
+	// DashboardOIDCProxyCookieSecretName is the name of the secret containing the cookie secret of the dashboard OIDC proxy.
+	DashboardOIDCProxyCookieSecretName = "dashboard-oidc-proxy-cookie"
+
+	// OAuth2ProxyImageName is the name of the oauth2-proxy image.
+	OAuth2ProxyImageName = "oauth2-proxy"
+
+	// IngressWildcardSecretName is the name of the secret in a Shoot namespace in the Seed containing the wildcard
+	// certificate used by the ingresses of the monitoring and logging endpoints.
+	IngressWildcardSecretName = "ingress-wildcard-tls"
+
 	// SeedSpecHash is a constant for a label on `ControllerInstallation`s (similar to `pod-template-hash` on `Pod`s).
 	SeedSpecHash = "seed-spec-hash"
 
@@ -555,9 +748,24 @@ const (
 
 	// VpaExporterImageName is the name of the vpa-exporter image
 	VpaExporterImageName = "vpa-exporter"
+
+	// KuredImageName is the name of the kured image which coordinates the reboots of worker nodes after in-place
+	// operating system updates.
+	KuredImageName = "kured"
+
+	// RebootManagerName is the name of the reboot manager resources in the kube-system namespace of a Shoot.
+	RebootManagerName = "reboot-manager"
 )
 
 var (
+	// ErrBackupBucketProbeNotSupported is returned by Cloud Botanists which cannot probe the backup buckets of their
+	// cloud provider.
+	ErrBackupBucketProbeNotSupported = errors.New("probing the backup bucket is not supported for this cloud provider")
+
+	// ErrBackupInfrastructurePurgeNotSupported is returned by Cloud Botanists which cannot purge the backup
+	// infrastructure of their cloud provider without Terraform.
+	ErrBackupInfrastructurePurgeNotSupported = errors.New("purging the backup infrastructure is not supported for this cloud provider")
+
 	// TerraformerChartPath is the path where the seed-terraformer charts reside.
 	TerraformerChartPath = filepath.Join(ChartPath, "seed-terraformer", "charts")
 
@@ -630,4 +838,7 @@ type CloudConfigUserDataConfig struct {
 	HostnameOverride             bool
 	EnableCSI                    bool
 	ProviderIDProvided           bool
+	// CloudProviderConfigFiles are additional files (path to content) referenced by the cloud provider config which
+	// must exist on the nodes.
+	CloudProviderConfigFiles map[string][]byte
 }
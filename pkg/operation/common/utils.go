@@ -21,6 +21,7 @@ import (
 	"math"
 	"net"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -37,10 +38,13 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -115,6 +119,139 @@ func DistributePositiveIntOrPercent(zoneIndex int, intOrPercent intstr.IntOrStri
 	return intstr.FromInt(DistributeOverZones(zoneIndex, int(intOrPercent.IntVal), zoneSize))
 }
 
+// WorkerZoneIndex determines whether the given <worker> uses the zone with index <zoneIndex> of the Shoot's
+// <zones>. If so, it returns the position of the zone within the zones used by the worker together with the
+// number of these zones. A worker which does not select a subset of zones spans all zones of the Shoot.
+func WorkerZoneIndex(worker gardenv1beta1.Worker, zones []string, zoneIndex int) (int, int, bool) {
+	if len(worker.Zones) == 0 {
+		return zoneIndex, len(zones), true
+	}
+
+	workerZones := sets.NewString(worker.Zones...)
+	if !workerZones.Has(zones[zoneIndex]) {
+		return 0, 0, false
+	}
+
+	var workerZoneIndex, workerZoneCount int
+	for i, zone := range zones {
+		if !workerZones.Has(zone) {
+			continue
+		}
+		if i < zoneIndex {
+			workerZoneIndex++
+		}
+		workerZoneCount++
+	}
+	return workerZoneIndex, workerZoneCount, true
+}
+
+// WorkerMachineImage returns the custom machine image of the given <worker> if it specifies one, otherwise it
+// returns <defaultImage>.
+func WorkerMachineImage(worker gardenv1beta1.Worker, defaultImage string) string {
+	if worker.CustomMachineImage != nil {
+		return *worker.CustomMachineImage
+	}
+	return defaultImage
+}
+
+// WorkerMachineVariant is a combination of a machine type and a purchasing option (on-demand or spot) of a worker
+// pool. Every variant results in its own machine deployments.
+type WorkerMachineVariant struct {
+	// Suffix is appended to the names of the machine deployments of the variant. It is empty for the on-demand variant
+	// of the machine type of the worker pool so that the names of existing machine deployments do not change.
+	Suffix string
+	// MachineType is the machine type of the variant.
+	MachineType string
+	// Spot indicates whether the variant uses spot (preemptible) machines.
+	Spot bool
+	// Minimum is the minimum number of machines of the variant (before it is distributed over the zones).
+	Minimum int
+	// Maximum is the maximum number of machines of the variant (before it is distributed over the zones).
+	Maximum int
+	// Priority is the priority of the variant for the priority expander of the cluster-autoscaler. It is 0 for worker
+	// pools without capacity configuration.
+	Priority int
+}
+
+// WorkerMachineVariants computes the machine variants of the given worker pool. Without capacity configuration there
+// is only the on-demand variant of the machine type of the worker pool. Otherwise, there is an on-demand and (if a
+// spot percentage is configured) a spot variant for the machine type and every fallback machine type. The variants
+// are ordered by their priority: spot before on-demand machines, and the machine type of the worker pool before its
+// fallback machine types. Only the on-demand variant of the machine type of the worker pool has a minimum.
+func WorkerMachineVariants(worker gardenv1beta1.Worker) []WorkerMachineVariant {
+	if worker.Capacity == nil {
+		return []WorkerMachineVariant{{
+			MachineType: worker.MachineType,
+			Minimum:     worker.AutoScalerMin,
+			Maximum:     worker.AutoScalerMax,
+		}}
+	}
+
+	var (
+		machineTypes = append([]string{worker.MachineType}, worker.Capacity.FallbackMachineTypes...)
+		spotMaximum  int
+
+		spotVariants     []WorkerMachineVariant
+		onDemandVariants []WorkerMachineVariant
+	)
+
+	if spotPercentage := worker.Capacity.SpotPercentage; spotPercentage != nil {
+		spotMaximum = worker.AutoScalerMax * int(*spotPercentage) / 100
+	}
+
+	for i, machineType := range machineTypes {
+		var (
+			suffix  string
+			minimum int
+		)
+		if i == 0 {
+			minimum = worker.AutoScalerMin
+		} else {
+			suffix = fmt.Sprintf("-fb%d", i)
+		}
+
+		if spotMaximum > 0 {
+			spotVariants = append(spotVariants, WorkerMachineVariant{
+				Suffix:      suffix + "-spot",
+				MachineType: machineType,
+				Spot:        true,
+				Maximum:     spotMaximum,
+			})
+		}
+		onDemandVariants = append(onDemandVariants, WorkerMachineVariant{
+			Suffix:      suffix,
+			MachineType: machineType,
+			Minimum:     minimum,
+			Maximum:     worker.AutoScalerMax - spotMaximum,
+		})
+	}
+
+	variants := append(spotVariants, onDemandVariants...)
+	for i := range variants {
+		variants[i].Priority = 10 * (len(variants) - i)
+	}
+	return variants
+}
+
+// WorkerInstanceTags returns the labels of the given <worker> which are selected by its `instanceTagLabels` and shall
+// be added as tags to the cloud provider instances of the worker pool. If <sanitize> is not nil then it is applied to
+// the keys and values, e.g. to satisfy the restrictions of the cloud provider. Selected keys which do not exist in the
+// labels of the worker pool are skipped.
+func WorkerInstanceTags(worker gardenv1beta1.Worker, sanitize func(string) string) map[string]string {
+	tags := make(map[string]string, len(worker.InstanceTagLabels))
+	for _, key := range worker.InstanceTagLabels {
+		value, ok := worker.Labels[key]
+		if !ok {
+			continue
+		}
+		if sanitize != nil {
+			key, value = sanitize(key), sanitize(value)
+		}
+		tags[key] = value
+	}
+	return tags
+}
+
 // ComputeClusterIP parses the provided <cidr> and sets the last byte to the value of <lastByte>.
 // For example, <cidr> = 100.64.0.0/11 and <lastByte> = 10 the result would be 100.64.0.10
 func ComputeClusterIP(cidr gardencorev1alpha1.CIDR, lastByte byte) string {
@@ -136,6 +273,24 @@ func DiskSize(size string) int {
 	return i
 }
 
+// DataVolumeType returns the type of the given data <volume>. If the volume does not specify a type then the
+// <defaultType> (usually the type of the worker's root volume) is returned.
+func DataVolumeType(volume gardenv1beta1.DataVolume, defaultType string) string {
+	if volume.Type != nil {
+		return *volume.Type
+	}
+	return defaultType
+}
+
+// DataVolumeEncrypted returns whether the given data <volume> shall be encrypted. Data volumes are encrypted
+// unless explicitly specified otherwise.
+func DataVolumeEncrypted(volume gardenv1beta1.DataVolume) bool {
+	if volume.Encrypted != nil {
+		return *volume.Encrypted
+	}
+	return true
+}
+
 // MachineClassHash returns the SHA256-hash value of the <val> struct's representation concatenated with the
 // provided <version>.
 func MachineClassHash(machineClassSpec map[string]interface{}, version string) string {
@@ -193,6 +348,20 @@ func GenerateTerraformVariablesEnvironment(secret *corev1.Secret, keyValueMap ma
 	return out
 }
 
+// OrphanedInfrastructureResources returns those of the given cloud <resources> (mapping their identifiers to their
+// types) whose identifier is not contained in the set of lower case <stateIdentifiers> of the Terraform state. The
+// orphaned resources are returned sorted in the form "<type>/<identifier>".
+func OrphanedInfrastructureResources(stateIdentifiers sets.String, resources map[string]string) []string {
+	orphans := []string{}
+	for identifier, resourceType := range resources {
+		if !stateIdentifiers.Has(strings.ToLower(identifier)) {
+			orphans = append(orphans, fmt.Sprintf("%s/%s", resourceType, identifier))
+		}
+	}
+	sort.Strings(orphans)
+	return orphans
+}
+
 // ExtractShootName returns Shoot resource name extracted from provided <backupInfrastructureName>.
 func ExtractShootName(backupInfrastructureName string) string {
 	tokens := strings.Split(backupInfrastructureName, "-")
@@ -264,6 +433,15 @@ func ProjectNameForNamespace(namespace *corev1.Namespace) string {
 	return namespace.Name
 }
 
+// IsLandscapeFrozen returns true if the given garden namespace is annotated with the freeze annotation.
+func IsLandscapeFrozen(gardenNamespace *corev1.Namespace) bool {
+	if gardenNamespace == nil {
+		return false
+	}
+	frozen, _ := strconv.ParseBool(gardenNamespace.Annotations[GardenFreeze])
+	return frozen
+}
+
 // MergeOwnerReferences merges the newReferences with the list of existing references.
 func MergeOwnerReferences(references []metav1.OwnerReference, newReferences ...metav1.OwnerReference) []metav1.OwnerReference {
 	uids := make(map[types.UID]struct{})
@@ -511,6 +689,92 @@ func DeleteAlertmanager(k8sClient kubernetes.Interface, namespace string) error
 	return nil
 }
 
+// DeleteMonitoring deletes the monitoring stack of a Shoot (Prometheus, Alertmanager, Grafana and kube-state-metrics)
+// in the given namespace, including the volumes of Prometheus and Alertmanager.
+func DeleteMonitoring(k8sClient kubernetes.Interface, namespace string) error {
+	var (
+		deployments = []string{GrafanaDeploymentName, KubeStateMetricsSeedDeploymentName, KubeStateMetricsShootDeploymentName}
+		ingresses   = []string{"grafana", "prometheus"}
+		services    = []string{"grafana", "prometheus-web", KubeStateMetricsSeedDeploymentName, KubeStateMetricsShootDeploymentName}
+		secrets     = []string{"grafana-basic-auth", "prometheus-basic-auth"}
+		configmaps  = []string{"prometheus-config", "prometheus-rules", "blackbox-exporter-config-prometheus", "grafana-dashboard-providers", "grafana-dashboards", "grafana-datasources"}
+
+		serviceAccounts = []string{"prometheus", KubeStateMetricsSeedDeploymentName}
+		// The volumes are created from the volume claim templates of the stateful sets and named
+		// <template>-<statefulset>-<ordinal>.
+		volumeClaimPrefixes = []string{"prometheus-db-" + PrometheusStatefulSetName + "-", "alertmanager-db-" + AlertManagerStatefulSetName + "-"}
+	)
+
+	if err := DeleteAlertmanager(k8sClient, namespace); err != nil {
+		return err
+	}
+	if err := k8sClient.DeleteStatefulSet(namespace, PrometheusStatefulSetName); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	for _, deployment := range deployments {
+		if err := k8sClient.DeleteDeployment(namespace, deployment); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	for _, ingress := range ingresses {
+		if err := k8sClient.DeleteIngress(namespace, ingress); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	for _, svc := range services {
+		if err := k8sClient.DeleteService(namespace, svc); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	for _, secret := range secrets {
+		if err := k8sClient.DeleteSecret(namespace, secret); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	for _, name := range configmaps {
+		if err := k8sClient.DeleteConfigMap(namespace, name); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	if err := k8sClient.DeleteClusterRoleBinding(fmt.Sprintf("prometheus-%s", namespace)); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err := k8sClient.DeleteRoleBinding(namespace, KubeStateMetricsSeedDeploymentName); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	for _, name := range serviceAccounts {
+		if err := k8sClient.DeleteServiceAccount(namespace, name); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	// The VPA is only deployed if the Seed runs the vertical pod autoscaler, hence, its CRD might not exist.
+	vpa := &unstructured.Unstructured{}
+	vpa.SetAPIVersion("autoscaling.k8s.io/v1beta2")
+	vpa.SetKind("VerticalPodAutoscaler")
+	vpa.SetNamespace(namespace)
+	vpa.SetName("prometheus-vpa")
+	if err := k8sClient.Client().Delete(context.TODO(), vpa); err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+		return err
+	}
+
+	pvcList := &corev1.PersistentVolumeClaimList{}
+	if err := k8sClient.Client().List(context.TODO(), &client.ListOptions{Namespace: namespace}, pvcList); err != nil {
+		return err
+	}
+	for _, pvc := range pvcList.Items {
+		for _, prefix := range volumeClaimPrefixes {
+			if !strings.HasPrefix(pvc.Name, prefix) {
+				continue
+			}
+			if err := k8sClient.Client().Delete(context.TODO(), pvc.DeepCopy()); err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // GetDomainInfoFromAnnotations returns the provider and the domain that is specified in the give annotations.
 func GetDomainInfoFromAnnotations(annotations map[string]string) (provider string, domain string, err error) {
 	if annotations == nil {
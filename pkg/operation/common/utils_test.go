@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
 	. "github.com/gardener/gardener/pkg/operation/common"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -30,6 +31,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -83,6 +85,98 @@ var _ = Describe("common", func() {
 			})
 		})
 
+		Describe("#WorkerZoneIndex", func() {
+			zones := []string{"zone-a", "zone-b", "zone-c"}
+
+			It("should use all zones if the worker does not select any", func() {
+				index, count, ok := WorkerZoneIndex(gardenv1beta1.Worker{}, zones, 2)
+
+				Expect(ok).To(BeTrue())
+				Expect(index).To(Equal(2))
+				Expect(count).To(Equal(3))
+			})
+
+			It("should compute the position within the selected zones", func() {
+				worker := gardenv1beta1.Worker{Zones: []string{"zone-c", "zone-a"}}
+
+				index, count, ok := WorkerZoneIndex(worker, zones, 2)
+				Expect(ok).To(BeTrue())
+				Expect(index).To(Equal(1))
+				Expect(count).To(Equal(2))
+
+				_, _, ok = WorkerZoneIndex(worker, zones, 1)
+				Expect(ok).To(BeFalse())
+			})
+		})
+
+		Describe("#WorkerMachineImage", func() {
+			It("should return the default image if the worker does not specify one", func() {
+				Expect(WorkerMachineImage(gardenv1beta1.Worker{}, "default")).To(Equal("default"))
+			})
+
+			It("should return the custom image of the worker", func() {
+				image := "custom"
+
+				Expect(WorkerMachineImage(gardenv1beta1.Worker{CustomMachineImage: &image}, "default")).To(Equal("custom"))
+			})
+		})
+
+		Describe("#WorkerInstanceTags", func() {
+			It("should return the selected labels of the worker", func() {
+				worker := gardenv1beta1.Worker{
+					Labels:            map[string]string{"Team": "A", "other": "b"},
+					InstanceTagLabels: []string{"Team", "missing"},
+				}
+
+				Expect(WorkerInstanceTags(worker, nil)).To(Equal(map[string]string{"Team": "A"}))
+				Expect(WorkerInstanceTags(worker, strings.ToLower)).To(Equal(map[string]string{"team": "a"}))
+			})
+		})
+
+		Describe("#WorkerMachineVariants", func() {
+			It("should return only the on-demand variant without capacity configuration", func() {
+				worker := gardenv1beta1.Worker{MachineType: "m1", AutoScalerMin: 2, AutoScalerMax: 5}
+
+				Expect(WorkerMachineVariants(worker)).To(Equal([]WorkerMachineVariant{
+					{MachineType: "m1", Minimum: 2, Maximum: 5},
+				}))
+			})
+
+			It("should return prioritized spot and fallback variants", func() {
+				spotPercentage := int32(40)
+				worker := gardenv1beta1.Worker{
+					MachineType:   "m1",
+					AutoScalerMin: 2,
+					AutoScalerMax: 10,
+					Capacity: &gardenv1beta1.WorkerCapacity{
+						FallbackMachineTypes: []string{"m2"},
+						SpotPercentage:       &spotPercentage,
+					},
+				}
+
+				Expect(WorkerMachineVariants(worker)).To(Equal([]WorkerMachineVariant{
+					{Suffix: "-spot", MachineType: "m1", Spot: true, Maximum: 4, Priority: 40},
+					{Suffix: "-fb1-spot", MachineType: "m2", Spot: true, Maximum: 4, Priority: 30},
+					{MachineType: "m1", Minimum: 2, Maximum: 6, Priority: 20},
+					{Suffix: "-fb1", MachineType: "m2", Maximum: 6, Priority: 10},
+				}))
+			})
+
+			It("should not return spot variants if the spot share is zero", func() {
+				worker := gardenv1beta1.Worker{
+					MachineType:   "m1",
+					AutoScalerMin: 1,
+					AutoScalerMax: 3,
+					Capacity:      &gardenv1beta1.WorkerCapacity{FallbackMachineTypes: []string{"m2"}},
+				}
+
+				Expect(WorkerMachineVariants(worker)).To(Equal([]WorkerMachineVariant{
+					{MachineType: "m1", Minimum: 1, Maximum: 3, Priority: 20},
+					{Suffix: "-fb1", MachineType: "m2", Maximum: 3, Priority: 10},
+				}))
+			})
+		})
+
 		Describe("#ComputeClusterIP", func() {
 			It("should return a cluster IP as string", func() {
 				var (
@@ -384,4 +478,27 @@ var _ = Describe("common", func() {
 			Expect(fg).To(Equal(result))
 		})
 	})
+
+	Describe("#OrphanedInfrastructureResources", func() {
+		It("should return the resources which are not contained in the state", func() {
+			stateIdentifiers := sets.NewString("vpc-1", "shoot--foo--bar-nodes")
+
+			Expect(OrphanedInfrastructureResources(stateIdentifiers, map[string]string{
+				"vpc-1":                 "vpc",
+				"vpc-2":                 "vpc",
+				"shoot--foo--bar-nodes": "subnetwork",
+				"Shoot--Foo--Bar-Old":   "subnetwork",
+			})).To(Equal([]string{"subnetwork/Shoot--Foo--Bar-Old", "vpc/vpc-2"}))
+		})
+
+		It("should compare the identifiers case-insensitively", func() {
+			Expect(OrphanedInfrastructureResources(sets.NewString("/subscriptions/1/resourcegroups/rg"), map[string]string{
+				"/subscriptions/1/resourceGroups/RG": "resourceGroup",
+			})).To(BeEmpty())
+		})
+
+		It("should return all resources for an empty state", func() {
+			Expect(OrphanedInfrastructureResources(sets.NewString(), map[string]string{"vpc-1": "vpc"})).To(Equal([]string{"vpc/vpc-1"}))
+		})
+	})
 })
@@ -114,6 +114,7 @@ func ReadGardenSecrets(k8sInformers kubeinformers.SharedInformerFactory) (map[st
 		numberOfInternalDomainSecrets               = 0
 		numberOfOpenVPNDiffieHellmanSecrets         = 0
 		numberOfCertificateManagementConfigurations = 0
+		numberOfVaultSecrets                        = 0
 	)
 
 	selector, err := labels.Parse(common.GardenRole)
@@ -180,6 +181,14 @@ func ReadGardenSecrets(k8sInformers kubeinformers.SharedInformerFactory) (map[st
 			logger.Logger.Infof("Found certificate management configuration %s.", secret.Name)
 			numberOfCertificateManagementConfigurations++
 		}
+
+		// Retrieving the connection details of the Vault from which the cloud provider credentials of SecretBindings
+		// with a Vault reference are read.
+		if secret.Labels[common.GardenRole] == common.GardenRoleVault {
+			secretsMap[common.GardenRoleVault] = secret
+			logger.Logger.Infof("Found Vault secret %s.", secret.Name)
+			numberOfVaultSecrets++
+		}
 	}
 
 	// For each Shoot we create a LoadBalancer(LB) pointing to the API server of the Shoot. Because the technical address
@@ -209,6 +218,11 @@ func ReadGardenSecrets(k8sInformers kubeinformers.SharedInformerFactory) (map[st
 		return nil, fmt.Errorf("can only accept at most one certificate management configuration secret, but found %d", numberOfCertificateManagementConfigurations)
 	}
 
+	// All SecretBindings with a Vault reference are read from the same Vault, hence there must not be more than one.
+	if numberOfVaultSecrets > 1 {
+		return nil, fmt.Errorf("can only accept at most one Vault secret, but found %d", numberOfVaultSecrets)
+	}
+
 	return secretsMap, nil
 }
 
@@ -15,11 +15,24 @@
 package hybridbotanist
 
 import (
+	"context"
+	"fmt"
 	"path/filepath"
 
-	controllermanagerfeatures "github.com/gardener/gardener/pkg/controllermanager/features"
-	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	addonManagerModeLabel        = "addonmanager.kubernetes.io/mode"
+	addonManagerModeReconcile    = "Reconcile"
+	addonManagerModeEnsureExists = "EnsureExists"
 )
 
 // DeployKubeAddonManager deploys the Kubernetes Addon Manager which will use labeled Kubernetes resources in order
@@ -62,7 +75,7 @@ func (b *HybridBotanist) DeployKubeAddonManager() error {
 			},
 		},
 		"vpa": map[string]interface{}{
-			"enabled": controllermanagerfeatures.FeatureGate.Enabled(features.VPA),
+			"enabled": b.Seed.VerticalPodAutoscalerEnabled(),
 		},
 	}
 
@@ -73,3 +86,127 @@ func (b *HybridBotanist) DeployKubeAddonManager() error {
 
 	return b.ApplyChartSeed(filepath.Join(common.ChartPath, "seed-controlplane", "charts", name), b.Shoot.SeedNamespace, name, values, nil)
 }
+
+// MigrateStorageClasses deletes the storage classes managed by the Kubernetes Addon Manager whose provisioner, parameters
+// or zones differ from the desired ones, e.g. after a switch from a flexvolume to a CSI provisioner or after a change of
+// the storage classes in the Shoot specification. These fields of a storage class are immutable, hence the Kubernetes
+// Addon Manager cannot update such storage classes and recreates them instead. Already provisioned volumes are not
+// affected.
+func (b *HybridBotanist) MigrateStorageClasses(ctx context.Context) error {
+	desiredStorageClasses, err := b.desiredStorageClasses()
+	if err != nil {
+		return err
+	}
+
+	for _, desired := range desiredStorageClasses {
+		name, _ := desired["Name"].(string)
+
+		storageClass := &storagev1.StorageClass{}
+		if err := b.K8sShootClient.Client().Get(ctx, kutil.Key(name), storageClass); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if storageClass.Labels[addonManagerModeLabel] != addonManagerModeReconcile || !storageClassChanged(storageClass, desired) {
+			continue
+		}
+
+		b.Logger.Infof("Deleting storage class %q to change its provisioner, parameters or zones", name)
+		if err := b.K8sShootClient.Client().Delete(ctx, storageClass); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ProtectStorageClasses prevents that the Kubernetes Addon Manager prunes storage classes which are no longer desired,
+// e.g. after they have been removed from the Shoot specification, but which are still used by persistent volumes. Such
+// storage classes are relabelled so that the Kubernetes Addon Manager only ensures their existence. They are not
+// reconciled anymore and can be deleted by the owner of the Shoot once they are no longer used.
+func (b *HybridBotanist) ProtectStorageClasses(ctx context.Context) error {
+	desiredStorageClasses, err := b.desiredStorageClasses()
+	if err != nil {
+		return err
+	}
+	desiredNames := sets.NewString()
+	for _, desired := range desiredStorageClasses {
+		name, _ := desired["Name"].(string)
+		desiredNames.Insert(name)
+	}
+
+	storageClassList := &storagev1.StorageClassList{}
+	if err := b.K8sShootClient.Client().List(ctx, client.MatchingLabels(map[string]string{addonManagerModeLabel: addonManagerModeReconcile}), storageClassList); err != nil {
+		return err
+	}
+
+	var undesired []storagev1.StorageClass
+	for _, storageClass := range storageClassList.Items {
+		if !desiredNames.Has(storageClass.Name) {
+			undesired = append(undesired, storageClass)
+		}
+	}
+	if len(undesired) == 0 {
+		return nil
+	}
+
+	persistentVolumeList := &corev1.PersistentVolumeList{}
+	if err := b.K8sShootClient.Client().List(ctx, &client.ListOptions{}, persistentVolumeList); err != nil {
+		return err
+	}
+	usedNames := sets.NewString()
+	for _, persistentVolume := range persistentVolumeList.Items {
+		usedNames.Insert(persistentVolume.Spec.StorageClassName)
+	}
+
+	for _, storageClass := range undesired {
+		if !usedNames.Has(storageClass.Name) {
+			continue
+		}
+
+		b.Logger.Infof("Keeping storage class %q as it is still used by persistent volumes", storageClass.Name)
+		storageClass.Labels[addonManagerModeLabel] = addonManagerModeEnsureExists
+		if err := b.K8sShootClient.Client().Update(ctx, &storageClass); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *HybridBotanist) desiredStorageClasses() ([]map[string]interface{}, error) {
+	config, err := b.ShootCloudBotanist.GenerateStorageClassesConfig()
+	if err != nil {
+		return nil, err
+	}
+	desiredStorageClasses, _ := config["StorageClasses"].([]map[string]interface{})
+	return desiredStorageClasses, nil
+}
+
+// storageClassChanged returns true if the provisioner, the parameters or the zones of the given storage class differ
+// from the <desired> values of the shoot-storageclasses chart.
+func storageClassChanged(storageClass *storagev1.StorageClass, desired map[string]interface{}) bool {
+	if provisioner, _ := desired["Provisioner"].(string); storageClass.Provisioner != provisioner {
+		return true
+	}
+
+	parameters, _ := desired["Parameters"].(map[string]interface{})
+	if len(parameters) != len(storageClass.Parameters) {
+		return true
+	}
+	for key, value := range parameters {
+		if actual, ok := storageClass.Parameters[key]; !ok || actual != fmt.Sprint(value) {
+			return true
+		}
+	}
+
+	zones, _ := desired["Zones"].([]string)
+	var actualZones []string
+	for _, term := range storageClass.AllowedTopologies {
+		for _, expression := range term.MatchLabelExpressions {
+			actualZones = append(actualZones, expression.Values...)
+		}
+	}
+	return !sets.NewString(zones...).Equal(sets.NewString(actualZones...))
+}
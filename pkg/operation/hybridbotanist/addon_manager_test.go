@@ -0,0 +1,176 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"context"
+	"io/ioutil"
+
+	"github.com/gardener/gardener/pkg/logger"
+	mockkubernetes "github.com/gardener/gardener/pkg/mock/gardener/kubernetes"
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/cloudbotanist"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+type fakeCloudBotanist struct {
+	cloudbotanist.CloudBotanist
+	storageClasses []map[string]interface{}
+}
+
+func (b *fakeCloudBotanist) GenerateStorageClassesConfig() (map[string]interface{}, error) {
+	return map[string]interface{}{"StorageClasses": b.storageClasses}, nil
+}
+
+var _ = Describe("addon manager", func() {
+	newStorageClass := func(name, mode string) *storagev1.StorageClass {
+		return &storagev1.StorageClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{"addonmanager.kubernetes.io/mode": mode},
+			},
+			Provisioner: "kubernetes.io/aws-ebs",
+			Parameters:  map[string]string{"type": "gp2", "iopsPerGB": "10"},
+			AllowedTopologies: []corev1.TopologySelectorTerm{
+				{
+					MatchLabelExpressions: []corev1.TopologySelectorLabelRequirement{
+						{Key: corev1.LabelZoneFailureDomain, Values: []string{"eu-west-1a", "eu-west-1b"}},
+					},
+				},
+			},
+		}
+	}
+
+	Describe("#storageClassChanged", func() {
+		DescribeTable("should compare the storage class with the desired values",
+			func(mutate func(map[string]interface{}), expected bool) {
+				desired := map[string]interface{}{
+					"Name":        "default",
+					"Provisioner": "kubernetes.io/aws-ebs",
+					"Parameters":  map[string]interface{}{"type": "gp2", "iopsPerGB": 10},
+					"Zones":       []string{"eu-west-1b", "eu-west-1a"},
+				}
+				mutate(desired)
+
+				Expect(ExportStorageClassChanged(newStorageClass("default", "Reconcile"), desired)).To(Equal(expected))
+			},
+			Entry("unchanged", func(desired map[string]interface{}) {}, false),
+			Entry("changed provisioner", func(desired map[string]interface{}) {
+				desired["Provisioner"] = "ebs.csi.aws.com"
+			}, true),
+			Entry("changed parameter", func(desired map[string]interface{}) {
+				desired["Parameters"] = map[string]interface{}{"type": "io1", "iopsPerGB": 10}
+			}, true),
+			Entry("added parameter", func(desired map[string]interface{}) {
+				desired["Parameters"] = map[string]interface{}{"type": "gp2", "iopsPerGB": 10, "encrypted": true}
+			}, true),
+			Entry("removed parameter", func(desired map[string]interface{}) {
+				desired["Parameters"] = map[string]interface{}{"type": "gp2"}
+			}, true),
+			Entry("changed zones", func(desired map[string]interface{}) {
+				desired["Zones"] = []string{"eu-west-1a"}
+			}, true),
+			Entry("removed zones", func(desired map[string]interface{}) {
+				delete(desired, "Zones")
+			}, true),
+		)
+	})
+
+	Describe("#ProtectStorageClasses", func() {
+		var (
+			ctx = context.TODO()
+
+			ctrl           *gomock.Controller
+			c              client.Client
+			hybridBotanist *HybridBotanist
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		DescribeTable("should only keep the undesired storage classes which are still used",
+			func(desired []string, used []string, expectedModes map[string]string) {
+				objects := []runtime.Object{
+					newStorageClass("default", "Reconcile"),
+					newStorageClass("gp2", "Reconcile"),
+					newStorageClass("io1", "EnsureExists"),
+				}
+				for _, name := range used {
+					objects = append(objects, &corev1.PersistentVolume{
+						ObjectMeta: metav1.ObjectMeta{Name: "pv-" + name},
+						Spec:       corev1.PersistentVolumeSpec{StorageClassName: name},
+					})
+				}
+				c = fake.NewFakeClientWithScheme(scheme.Scheme, objects...)
+
+				k8sShootClient := mockkubernetes.NewMockInterface(ctrl)
+				k8sShootClient.EXPECT().Client().Return(c).AnyTimes()
+
+				var desiredStorageClasses []map[string]interface{}
+				for _, name := range desired {
+					desiredStorageClasses = append(desiredStorageClasses, map[string]interface{}{"Name": name})
+				}
+
+				hybridBotanist = &HybridBotanist{
+					Operation: &operation.Operation{
+						Logger:         logger.NewShootLogger(&logrus.Logger{Out: ioutil.Discard, Formatter: &logrus.TextFormatter{}}, "bar", "garden-foo", ""),
+						K8sShootClient: k8sShootClient,
+					},
+					ShootCloudBotanist: &fakeCloudBotanist{storageClasses: desiredStorageClasses},
+				}
+
+				Expect(hybridBotanist.ProtectStorageClasses(ctx)).To(Succeed())
+
+				for name, mode := range expectedModes {
+					storageClass := &storagev1.StorageClass{}
+					Expect(c.Get(ctx, kutil.Key(name), storageClass)).To(Succeed())
+					Expect(storageClass.Labels).To(HaveKeyWithValue("addonmanager.kubernetes.io/mode", mode), name)
+				}
+			},
+			Entry("all storage classes desired",
+				[]string{"default", "gp2"}, []string{"default", "gp2"},
+				map[string]string{"default": "Reconcile", "gp2": "Reconcile"}),
+			Entry("undesired storage class not used",
+				[]string{"default"}, []string{"default"},
+				map[string]string{"default": "Reconcile", "gp2": "Reconcile"}),
+			Entry("undesired storage class still used",
+				[]string{"default"}, []string{"default", "gp2"},
+				map[string]string{"default": "Reconcile", "gp2": "EnsureExists"}),
+			Entry("no storage class desired",
+				nil, []string{"gp2", "io1"},
+				map[string]string{"default": "Reconcile", "gp2": "EnsureExists", "io1": "EnsureExists"}),
+		)
+	})
+})
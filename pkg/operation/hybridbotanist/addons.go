@@ -24,7 +24,10 @@ import (
 	controllermanagerfeatures "github.com/gardener/gardener/pkg/controllermanager/features"
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/shootwebhook"
 	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/chart"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/gardener/gardener/pkg/utils/secrets"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -39,12 +42,14 @@ func (b *HybridBotanist) generateCoreAddonsChart() (*chartrenderer.RenderedChart
 		kubeProxySecret  = b.Secrets["kube-proxy"]
 		vpnShootSecret   = b.Secrets["vpn-shoot"]
 		vpnTLSAuthSecret = b.Secrets["vpn-seed-tlsauth"]
+		sizing           = b.Shoot.GetSizing()
 		global           = map[string]interface{}{
 			"kubernetesVersion": b.Shoot.Info.Spec.Kubernetes.Version,
 			"podNetwork":        b.Shoot.GetPodNetwork(),
 		}
 		calicoConfig = map[string]interface{}{
 			"cloudProvider": b.Shoot.CloudProvider,
+			"vethMTU":       b.computePodNetworkMTU(),
 		}
 		coreDNSConfig = map[string]interface{}{
 			"service": map[string]interface{}{
@@ -55,6 +60,19 @@ func (b *HybridBotanist) generateCoreAddonsChart() (*chartrenderer.RenderedChart
 					"clusterDomain": gardenv1beta1.DefaultDomain,
 				},
 			},
+			"deployment": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": map[string]interface{}{
+						"resources": sizing.CoreDNS.Values(),
+					},
+				},
+			},
+			"horizontalPodAutoScaler": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"minReplicas": sizing.CoreDNSMinReplicas,
+					"maxReplicas": sizing.CoreDNSMaxReplicas,
+				},
+			},
 		}
 		clusterAutoscaler = map[string]interface{}{
 			"enabled": b.Shoot.WantsClusterAutoscaler,
@@ -72,7 +90,7 @@ func (b *HybridBotanist) generateCoreAddonsChart() (*chartrenderer.RenderedChart
 		}
 		metricsServerConfig = map[string]interface{}{
 			"tls": map[string]interface{}{
-				"caBundle": b.Secrets[gardencorev1alpha1.SecretNameCAMetricsServer].Data[secrets.DataKeyCertificateCA],
+				"caBundle": b.Secrets[gardencorev1alpha1.SecretNameCAMetricsServer].Data[secrets.DataKeyCertificateBundle],
 			},
 			"secret": map[string]interface{}{
 				"data": b.Secrets["metrics-server"].Data,
@@ -82,6 +100,7 @@ func (b *HybridBotanist) generateCoreAddonsChart() (*chartrenderer.RenderedChart
 			"podNetwork":     b.Shoot.GetPodNetwork(),
 			"serviceNetwork": b.Shoot.GetServiceNetwork(),
 			"nodeNetwork":    b.Shoot.GetNodeNetwork(),
+			"maxSegmentSize": b.computeVPNMaxSegmentSize(),
 			"tlsAuth":        vpnTLSAuthSecret.Data["vpn.tlsauth"],
 			"podAnnotations": map[string]interface{}{
 				"checksum/secret-vpn-shoot": b.CheckSums["vpn-shoot"],
@@ -100,10 +119,19 @@ func (b *HybridBotanist) generateCoreAddonsChart() (*chartrenderer.RenderedChart
 		vpnShootConfig["diffieHellmanKey"] = openvpnDiffieHellmanSecret.Data["dh2048.pem"]
 	}
 
-	calico, err := b.InjectShootShootImages(calicoConfig, common.CalicoNodeImageName, common.CalicoCNIImageName, common.CalicoTyphaImageName)
+	calico, err := b.InjectShootShootImages(calicoConfig, common.CalicoTyphaImageName)
 	if err != nil {
 		return nil, err
 	}
+	calicoArchitectures := map[string]interface{}{}
+	for _, arch := range b.Shoot.GetArchitectures() {
+		values, err := chart.InjectImages(nil, b.ImageVector, []string{common.CalicoNodeImageName, common.CalicoCNIImageName}, imagevector.RuntimeVersion(b.ShootVersion()), imagevector.TargetVersion(b.ShootVersion()), imagevector.Architecture(arch))
+		if err != nil {
+			return nil, err
+		}
+		calicoArchitectures[arch] = values["images"]
+	}
+	calico["architectures"] = calicoArchitectures
 
 	coreDNS, err := b.InjectShootShootImages(coreDNSConfig, common.CoreDNSImageName)
 	if err != nil {
@@ -144,6 +172,17 @@ func (b *HybridBotanist) generateCoreAddonsChart() (*chartrenderer.RenderedChart
 		return nil, err
 	}
 
+	rebootManagerWorkerPools, err := b.computeRebootManagerWorkerPools()
+	if err != nil {
+		return nil, err
+	}
+	rebootManager, err := b.InjectShootShootImages(map[string]interface{}{
+		"workerPools": rebootManagerWorkerPools,
+	}, common.KuredImageName)
+	if err != nil {
+		return nil, err
+	}
+
 	if _, err := b.K8sShootClient.CreateSecret(metav1.NamespaceSystem, "vpn-shoot", corev1.SecretTypeOpaque, vpnShootSecret.Data, true); err != nil {
 		return nil, err
 	}
@@ -158,6 +197,7 @@ func (b *HybridBotanist) generateCoreAddonsChart() (*chartrenderer.RenderedChart
 		"vpn-shoot":      vpnShoot,
 		"calico":         calico,
 		"metrics-server": metricsServer,
+		"reboot-manager": rebootManager,
 		"monitoring": map[string]interface{}{
 			"node-exporter":     nodeExporter,
 			"blackbox-exporter": blackboxExporter,
@@ -165,9 +205,52 @@ func (b *HybridBotanist) generateCoreAddonsChart() (*chartrenderer.RenderedChart
 		"cert-broker": map[string]interface{}{
 			"enabled": controllermanagerfeatures.FeatureGate.Enabled(features.CertificateManagement),
 		},
+		"gardener-shoot-webhook": map[string]interface{}{
+			"enabled":  len(b.Shoot.GetServiceLoadBalancerAnnotations()) > 0,
+			"url":      fmt.Sprintf("https://localhost:%d%s", common.ShootWebhookPort, shootwebhook.ServiceDefaultsPath),
+			"caBundle": b.Secrets[gardencorev1alpha1.SecretNameCACluster].Data[secrets.DataKeyCertificateBundle],
+		},
 	})
 }
 
+// computeRebootManagerWorkerPools returns the configuration of the reboot manager for all worker pools which receive
+// in-place operating system updates. Nodes are only rebooted within the reboot window of the worker pool which
+// defaults to the maintenance time window of the Shoot. The windows are converted to UTC.
+func (b *HybridBotanist) computeRebootManagerWorkerPools() ([]interface{}, error) {
+	var workerPools []interface{}
+
+	for _, worker := range b.Shoot.GetWorkers() {
+		if worker.OSUpdates == nil {
+			continue
+		}
+
+		window := worker.OSUpdates.RebootWindow
+		if window == nil {
+			if maintenance := b.Shoot.Info.Spec.Maintenance; maintenance != nil {
+				window = maintenance.TimeWindow
+			}
+		}
+		if window == nil {
+			return nil, fmt.Errorf("no reboot window found for worker pool %q", worker.Name)
+		}
+
+		rebootWindow, err := utils.ParseMaintenanceTimeWindow(window.Begin, window.End)
+		if err != nil {
+			return nil, err
+		}
+
+		workerPools = append(workerPools, map[string]interface{}{
+			"name": worker.Name,
+			"rebootWindow": map[string]interface{}{
+				"begin": fmt.Sprintf("%.02d:%.02d", rebootWindow.Begin().Hour(), rebootWindow.Begin().Minute()),
+				"end":   fmt.Sprintf("%.02d:%.02d", rebootWindow.End().Hour(), rebootWindow.End().Minute()),
+			},
+		})
+	}
+
+	return workerPools, nil
+}
+
 // generateOptionalAddonsChart renders the kube-addon-manager chart for the optional addons. It
 // will be stored as a Secret (as it may contain credentials) and mounted into the Pod. The configuration
 // contains specially labelled Kubernetes manifests which will be created and periodically reconciled.
@@ -16,8 +16,10 @@ package hybridbotanist
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"path/filepath"
+	"strconv"
 	"sync"
 	"time"
 
@@ -43,6 +45,14 @@ import (
 
 var operatingSystemConfigChartPath = filepath.Join(common.ChartPath, "seed-operatingsystemconfig")
 
+// caBundleRolloutMaxDelaySeconds is the maximum random delay (in seconds) each node waits before it re-bootstraps its
+// kubelet after the cluster CA bundle has changed. It spreads the kubelet restarts over the worker nodes.
+const caBundleRolloutMaxDelaySeconds = 600
+
+// cloudConfigDownloaderSecretName is the name of the secret containing the client certificate of the
+// cloud-config-downloader. It is passed to the nodes with the cloud-config so that they pick up re-signed certificates.
+const cloudConfigDownloaderSecretName = "cloud-config-downloader"
+
 // first hard, second soft
 func getEvictionMemoryAvailable(machineTypes []gardenv1beta1.MachineType, machineType string) (string, string) {
 	memoryThreshold, _ := resource.ParseQuantity("8Gi")
@@ -131,11 +141,12 @@ func (b *HybridBotanist) generateOriginalConfig() (map[string]interface{}, error
 		}
 
 		kubelet = map[string]interface{}{
-			"caCert":             string(b.Secrets[gardencorev1alpha1.SecretNameCAKubelet].Data[secrets.DataKeyCertificateCA]),
+			"caCert":             string(b.Secrets[gardencorev1alpha1.SecretNameCAKubelet].Data[secrets.DataKeyCertificateBundle]),
 			"parameters":         userDataConfig.KubeletParameters,
 			"hostnameOverride":   userDataConfig.HostnameOverride,
 			"enableCSI":          userDataConfig.EnableCSI,
 			"providerIDProvided": userDataConfig.ProviderIDProvided,
+			"serverTLSBootstrap": b.Shoot.UsesKubeletServingCertificates(),
 		}
 
 		originalConfig = map[string]interface{}{
@@ -155,6 +166,14 @@ func (b *HybridBotanist) generateOriginalConfig() (map[string]interface{}, error
 			return nil, err
 		}
 		cloudProvider["config"] = cloudProviderConfig
+
+		if len(userDataConfig.CloudProviderConfigFiles) > 0 {
+			files := make(map[string]interface{}, len(userDataConfig.CloudProviderConfigFiles))
+			for path, content := range userDataConfig.CloudProviderConfigFiles {
+				files[path] = base64.StdEncoding.EncodeToString(content)
+			}
+			cloudProvider["files"] = files
+		}
 	}
 
 	kubeletConfig := b.Shoot.Info.Spec.Kubernetes.Kubelet
@@ -200,11 +219,45 @@ func (b *HybridBotanist) computeOperatingSystemConfigsForWorker(machineTypes []g
 		"reloadConfigFilePath": common.CloudConfigFilePath,
 		"secretName":           secretName,
 	}
-	originalConfig["worker"] = map[string]interface{}{
+	workerConfig := map[string]interface{}{
 		"name":                        worker.Name,
 		"evictionHardMemoryAvailable": evictionHardMemoryAvailable,
 		"evictionSoftMemoryAvailable": evictionSoftMemoryAvailable,
 	}
+	if worker.OSUpdates != nil {
+		workerConfig["osUpdates"] = map[string]interface{}{
+			"channel": worker.OSUpdates.Channel,
+		}
+	}
+	if worker.MaxPods != nil {
+		workerConfig["maxPods"] = *worker.MaxPods
+	}
+	if worker.KubeletDataVolumeName != nil {
+		for _, volume := range worker.DataVolumes {
+			if volume.Name == *worker.KubeletDataVolumeName {
+				workerConfig["kubeletDataVolume"] = map[string]interface{}{
+					"size": strconv.FormatInt(int64(common.DiskSize(volume.Size))*1024*1024*1024, 10),
+				}
+			}
+		}
+	}
+	if kubelet := worker.Kubelet; kubelet != nil {
+		kubeletConfig := map[string]interface{}{}
+		if kubelet.ImageGCHighThresholdPercent != nil {
+			kubeletConfig["imageGCHighThresholdPercent"] = *kubelet.ImageGCHighThresholdPercent
+		}
+		if kubelet.ImageGCLowThresholdPercent != nil {
+			kubeletConfig["imageGCLowThresholdPercent"] = *kubelet.ImageGCLowThresholdPercent
+		}
+		if kubelet.ContainerLogMaxSize != nil {
+			kubeletConfig["containerLogMaxSize"] = strconv.FormatInt(kubelet.ContainerLogMaxSize.Value(), 10)
+		}
+		if kubelet.ContainerLogMaxFiles != nil {
+			kubeletConfig["containerLogMaxFiles"] = *kubelet.ContainerLogMaxFiles
+		}
+		workerConfig["kubelet"] = kubeletConfig
+	}
+	originalConfig["worker"] = workerConfig
 
 	downloader, err := b.applyAndWaitForShootOperatingSystemConfig(filepath.Join(operatingSystemConfigChartPath, "downloader"), fmt.Sprintf("%s-downloader", secretName), downloaderConfig)
 	if err != nil {
@@ -279,9 +332,20 @@ func (b *HybridBotanist) generateCloudConfigExecutionChart() (*chartrenderer.Ren
 	}
 
 	config := map[string]interface{}{
-		"bootstrapToken": bootstraptokenutil.TokenFromIDAndSecret(string(bootstrapTokenSecret.Data[bootstraptokenapi.BootstrapTokenIDKey]), string(bootstrapTokenSecret.Data[bootstraptokenapi.BootstrapTokenSecretKey])),
-		"configFilePath": common.CloudConfigFilePath,
-		"workers":        workers,
+		"bootstrapToken":                 bootstraptokenutil.TokenFromIDAndSecret(string(bootstrapTokenSecret.Data[bootstraptokenapi.BootstrapTokenIDKey]), string(bootstrapTokenSecret.Data[bootstraptokenapi.BootstrapTokenSecretKey])),
+		"configFilePath":                 common.CloudConfigFilePath,
+		"workers":                        workers,
+		"caBundleRolloutMaxDelaySeconds": caBundleRolloutMaxDelaySeconds,
+	}
+
+	if caSecret, ok := b.Secrets[gardencorev1alpha1.SecretNameCACluster]; ok {
+		config["caBundle"] = string(caSecret.Data[secrets.DataKeyCertificateBundle])
+	}
+	if downloaderSecret, ok := b.Secrets[cloudConfigDownloaderSecretName]; ok {
+		config["downloader"] = map[string]interface{}{
+			"clientCert": string(downloaderSecret.Data[cloudConfigDownloaderSecretName+".crt"]),
+			"clientKey":  string(downloaderSecret.Data[cloudConfigDownloaderSecretName+".key"]),
+		}
 	}
 
 	config, err = b.InjectShootShootImages(config, common.HyperkubeImageName)
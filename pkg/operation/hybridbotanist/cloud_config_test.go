@@ -0,0 +1,102 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"encoding/base64"
+	"path/filepath"
+
+	"github.com/gardener/gardener/pkg/chartrenderer"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/helm/pkg/chartutil"
+	"k8s.io/helm/pkg/engine"
+	"sigs.k8s.io/yaml"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("shoot-cloud-config chart", func() {
+	var values map[string]interface{}
+
+	BeforeEach(func() {
+		values = map[string]interface{}{
+			"images":         map[string]interface{}{"hyperkube": "k8s.gcr.io/hyperkube:v1.14.1"},
+			"bootstrapToken": "abcdef.0123456789abcdef",
+			"configFilePath": "/var/lib/cloud-config-downloader/downloads/cloud_config",
+			"workers": []interface{}{
+				map[string]interface{}{
+					"name":        "cpu-worker",
+					"secretName":  "cloud-config-cpu-worker-ab234",
+					"cloudConfig": "cloud-config",
+					"command":     "/usr/bin/reload",
+					"units":       []interface{}{"kubelet.service"},
+				},
+			},
+			"caBundleRolloutMaxDelaySeconds": 600,
+		}
+	})
+
+	renderScript := func() string {
+		capabilities := &chartutil.Capabilities{
+			KubeVersion: &version.Info{GitVersion: "v1.14.1"},
+			APIVersions: chartutil.NewVersionSet("v1", "rbac.authorization.k8s.io/v1"),
+		}
+
+		renderedChart, err := chartrenderer.New(engine.New(), capabilities).Render(filepath.Join("..", "..", "..", "charts", "shoot-cloud-config"), "shoot-cloud-config-execution", "kube-system", values)
+		Expect(err).NotTo(HaveOccurred())
+
+		secret := &corev1.Secret{}
+		Expect(yaml.Unmarshal([]byte(renderedChart.Files()["shoot-cloud-config/templates/secret-cloud-config-data.yaml"]), secret)).To(Succeed())
+		return string(secret.Data["script"])
+	}
+
+	It("should neither update the downloader credentials nor re-bootstrap the kubelet without CA bundle", func() {
+		script := renderScript()
+
+		Expect(script).NotTo(ContainSubstring(`update-credential "`))
+		Expect(script).NotTo(ContainSubstring("KUBELET_REBOOTSTRAP"))
+	})
+
+	It("should update the downloader credentials and re-bootstrap the kubelet with CA bundle", func() {
+		values["caBundle"] = "ca-bundle"
+		values["downloader"] = map[string]interface{}{
+			"clientCert": "client-certificate",
+			"clientKey":  "client-key",
+		}
+
+		script := renderScript()
+
+		for _, data := range []string{"ca-bundle", "client-certificate", "client-key"} {
+			Expect(script).To(ContainSubstring(base64.StdEncoding.EncodeToString([]byte(data)) + "\n"))
+		}
+		Expect(script).To(ContainSubstring(`update-credential "$PATH_CLOUDCONFIG_DOWNLOADER_CLIENT_CERT"`))
+		Expect(script).To(ContainSubstring(`update-credential "$PATH_CLOUDCONFIG_DOWNLOADER_CLIENT_KEY"`))
+		Expect(script).To(ContainSubstring(`update-credential "$PATH_CLOUDCONFIG_DOWNLOADER_CA_CERT"`))
+		Expect(script).To(ContainSubstring(`if ! diff "$PATH_CA_BUNDLE" "$PATH_CA_BUNDLE_KUBELET" >/dev/null; then`))
+		Expect(script).To(ContainSubstring("RANDOM % 600"))
+		Expect(script).To(ContainSubstring(`if [[ "$KUBELET_REBOOTSTRAP" == "true" ]]; then`))
+	})
+
+	It("should fail without maximum delay of the kubelet re-bootstrap", func() {
+		values["caBundle"] = "ca-bundle"
+		delete(values, "caBundleRolloutMaxDelaySeconds")
+
+		_, err := chartrenderer.New(engine.New(), nil).Render(filepath.Join("..", "..", "..", "charts", "shoot-cloud-config"), "shoot-cloud-config-execution", "kube-system", values)
+		Expect(err).To(HaveOccurred())
+	})
+})
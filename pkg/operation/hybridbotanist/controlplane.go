@@ -24,9 +24,9 @@ import (
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardenv1beta1helper "github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
-	controllermanagerfeatures "github.com/gardener/gardener/pkg/controllermanager/features"
-	"github.com/gardener/gardener/pkg/features"
+	"github.com/gardener/gardener/pkg/operation/cloudbotanist"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -35,6 +35,8 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	audit_internal "k8s.io/apiserver/pkg/apis/audit"
@@ -62,51 +64,6 @@ func init() {
 	_ = audit_internal.AddToScheme(runtimeScheme)
 }
 
-// getResourcesForAPIServer returns the cpu and memory requirements for API server based on nodeCount
-func getResourcesForAPIServer(nodeCount int) (string, string, string, string) {
-	var (
-		cpuRequest    string
-		memoryRequest string
-		cpuLimit      string
-		memoryLimit   string
-	)
-
-	switch {
-	case nodeCount <= 2:
-		cpuRequest = "800m"
-		memoryRequest = "800Mi"
-
-		cpuLimit = "1000m"
-		memoryLimit = "1200Mi"
-	case nodeCount <= 10:
-		cpuRequest = "1000m"
-		memoryRequest = "1100Mi"
-
-		cpuLimit = "1200m"
-		memoryLimit = "1900Mi"
-	case nodeCount <= 50:
-		cpuRequest = "1200m"
-		memoryRequest = "1600Mi"
-
-		cpuLimit = "1500m"
-		memoryLimit = "3900Mi"
-	case nodeCount <= 100:
-		cpuRequest = "2500m"
-		memoryRequest = "5200Mi"
-
-		cpuLimit = "3000m"
-		memoryLimit = "5900Mi"
-	default:
-		cpuRequest = "3000m"
-		memoryRequest = "5200Mi"
-
-		cpuLimit = "4000m"
-		memoryLimit = "7800Mi"
-	}
-
-	return cpuRequest, memoryRequest, cpuLimit, memoryLimit
-}
-
 // DeployETCDStorageClass create the high iops storageclass required for volume used by etcd pods in seed cluster.
 func (b *HybridBotanist) DeployETCDStorageClass(ctx context.Context) error {
 	storageClassConfig := b.SeedCloudBotanist.GenerateETCDStorageClassConfig()
@@ -115,16 +72,22 @@ func (b *HybridBotanist) DeployETCDStorageClass(ctx context.Context) error {
 
 // DeployETCD deploys two etcd clusters via StatefulSets. The first etcd cluster (called 'main') is used for all the
 // data the Shoot Kubernetes cluster needs to store, whereas the second etcd luster (called 'events') is only used to
-// store the events data. The objectstore is also set up to store the backups.
+// store the events data. The objectstore is also set up to store the backups. The backup configuration is generated
+// by a CloudBotanist for the backup provider of the Seed which might differ from the Seed's own cloud provider.
 func (b *HybridBotanist) DeployETCD() error {
-	secretData, backupConfigData, err := b.SeedCloudBotanist.GenerateEtcdBackupConfig()
+	backupCloudBotanist, err := cloudbotanist.New(b.Operation, common.CloudPurposeBackup)
+	if err != nil {
+		return err
+	}
+
+	secretData, backupConfigData, err := backupCloudBotanist.GenerateEtcdBackupConfig()
 	if err != nil {
 		return err
 	}
 
 	// Some cloud botanists do not yet support backup and won't return secret data.
 	if secretData != nil {
-		if _, err := b.K8sSeedClient.CreateSecret(b.Shoot.SeedNamespace, common.BackupSecretName, corev1.SecretTypeOpaque, secretData, true); err != nil {
+		if _, err := b.SeedNamespaceSecrets.CreateSecret(b.Shoot.SeedNamespace, common.BackupSecretName, corev1.SecretTypeOpaque, secretData, true); err != nil {
 			return err
 		}
 	}
@@ -137,7 +100,7 @@ func (b *HybridBotanist) DeployETCD() error {
 			"checksum/secret-etcd-client-tls": b.CheckSums["etcd-client-tls"],
 		},
 		"vpa": map[string]interface{}{
-			"enabled": controllermanagerfeatures.FeatureGate.Enabled(features.VPA),
+			"enabled": b.Seed.VerticalPodAutoscalerEnabled(),
 		},
 		"storage":          b.Seed.GetValidVolumeSize("10Gi"),
 		"storageClassName": storageClassConfig["name"].(string),
@@ -292,12 +255,34 @@ func (b *HybridBotanist) DeployKubeAPIServerService() error {
 		return err
 	}
 
+	if sourceRanges := b.Shoot.GetKubeAPIServerSourceRanges(b.Seed.Info.Spec.Networks); len(sourceRanges) > 0 {
+		defaultValues["loadBalancerSourceRanges"] = sourceRanges
+	}
+
+	// The load balancer annotations configured for the Seed take precedence over the cloud specific ones.
+	if annotations := b.Seed.GetLoadBalancerServiceAnnotations(); len(annotations) > 0 {
+		seedAnnotations := make(map[string]interface{}, len(annotations))
+		for key, value := range annotations {
+			seedAnnotations[key] = value
+		}
+		cloudSpecificValues = utils.MergeMaps(cloudSpecificValues, map[string]interface{}{"annotations": seedAnnotations})
+	}
+
 	return b.ApplyChartSeed(filepath.Join(chartPathControlPlane, name), b.Shoot.SeedNamespace, name, defaultValues, cloudSpecificValues)
 }
 
 // DeployKubeAPIServer asks the Cloud Botanist to provide the cloud specific configuration values for the
 // kube-apiserver deployment.
 func (b *HybridBotanist) DeployKubeAPIServer() error {
+	staticToken, err := secrets.LoadStaticTokenFromCSV(common.KubeAPIServerStaticTokenSecretName, b.Secrets[common.KubeAPIServerStaticTokenSecretName].Data[secrets.DataKeyStaticTokenCSV])
+	if err != nil {
+		return err
+	}
+	healthCheckToken, err := staticToken.GetTokenForUsername(common.KubeAPIServerHealthCheckUsername)
+	if err != nil {
+		return err
+	}
+
 	defaultValues := map[string]interface{}{
 		"etcdServicePort":   2379,
 		"kubernetesVersion": b.Shoot.Info.Spec.Kubernetes.Version,
@@ -309,25 +294,26 @@ func (b *HybridBotanist) DeployKubeAPIServer() error {
 			"pod":     b.Seed.Info.Spec.Networks.Pods,
 			"node":    b.Seed.Info.Spec.Networks.Nodes,
 		},
-		"seedCloudProvider": b.Seed.CloudProvider,
-		"maxReplicas":       3,
-		"securePort":        443,
-		"probeCredentials":  utils.EncodeBase64([]byte(fmt.Sprintf("%s:%s", b.Secrets["kubecfg"].Data[secrets.DataKeyUserName], b.Secrets["kubecfg"].Data[secrets.DataKeyPassword]))),
+		"seedCloudProvider":         b.Seed.CloudProvider,
+		"vpnMaxSegmentSize":         b.computeVPNMaxSegmentSize(),
+		"maxReplicas":               3,
+		"securePort":                443,
+		"probeToken":                healthCheckToken.Token,
+		"enableBasicAuthentication": gardenv1beta1helper.ShootWantsBasicAuthentication(b.Shoot.Info),
+		"verifyKubeletCertificates": b.Shoot.VerifiesKubeletServingCertificates(),
 		"podAnnotations": map[string]interface{}{
-			"checksum/secret-ca":                        b.CheckSums[gardencorev1alpha1.SecretNameCACluster],
-			"checksum/secret-ca-front-proxy":            b.CheckSums[gardencorev1alpha1.SecretNameCAFrontProxy],
-			"checksum/secret-kube-apiserver":            b.CheckSums[common.KubeAPIServerDeploymentName],
-			"checksum/secret-kube-aggregator":           b.CheckSums["kube-aggregator"],
-			"checksum/secret-kube-apiserver-kubelet":    b.CheckSums["kube-apiserver-kubelet"],
-			"checksum/secret-kube-apiserver-basic-auth": b.CheckSums["kube-apiserver-basic-auth"],
-			"checksum/secret-vpn-seed":                  b.CheckSums["vpn-seed"],
-			"checksum/secret-vpn-seed-tlsauth":          b.CheckSums["vpn-seed-tlsauth"],
-			"checksum/secret-service-account-key":       b.CheckSums["service-account-key"],
-			"checksum/secret-etcd-ca":                   b.CheckSums[gardencorev1alpha1.SecretNameCAETCD],
-			"checksum/secret-etcd-client-tls":           b.CheckSums["etcd-client-tls"],
-		},
-		"vpa": map[string]interface{}{
-			"enabled": controllermanagerfeatures.FeatureGate.Enabled(features.VPA),
+			"checksum/secret-ca":                          b.CheckSums[gardencorev1alpha1.SecretNameCACluster],
+			"checksum/secret-ca-front-proxy":              b.CheckSums[gardencorev1alpha1.SecretNameCAFrontProxy],
+			"checksum/secret-kube-apiserver":              b.CheckSums[common.KubeAPIServerDeploymentName],
+			"checksum/secret-kube-aggregator":             b.CheckSums["kube-aggregator"],
+			"checksum/secret-kube-apiserver-kubelet":      b.CheckSums["kube-apiserver-kubelet"],
+			"checksum/secret-kube-apiserver-basic-auth":   b.CheckSums[common.KubeAPIServerBasicAuthSecretName],
+			"checksum/secret-kube-apiserver-static-token": b.CheckSums[common.KubeAPIServerStaticTokenSecretName],
+			"checksum/secret-vpn-seed":                    b.CheckSums["vpn-seed"],
+			"checksum/secret-vpn-seed-tlsauth":            b.CheckSums["vpn-seed-tlsauth"],
+			"checksum/secret-service-account-key":         b.CheckSums["service-account-key"],
+			"checksum/secret-etcd-ca":                     b.CheckSums[gardencorev1alpha1.SecretNameCAETCD],
+			"checksum/secret-etcd-client-tls":             b.CheckSums["etcd-client-tls"],
 		},
 	}
 	cloudSpecificExposeValues, err := b.SeedCloudBotanist.GenerateKubeAPIServerExposeConfig()
@@ -370,17 +356,9 @@ func (b *HybridBotanist) DeployKubeAPIServer() error {
 			defaultValues["replicas"] = 0
 		}
 
-		cpuRequest, memoryRequest, cpuLimit, memoryLimit := getResourcesForAPIServer(b.Shoot.GetNodeCount())
-		defaultValues["apiServerResources"] = map[string]interface{}{
-			"limits": map[string]interface{}{
-				"cpu":    cpuLimit,
-				"memory": memoryLimit,
-			},
-			"requests": map[string]interface{}{
-				"cpu":    cpuRequest,
-				"memory": memoryRequest,
-			},
-		}
+		sizing := b.Shoot.GetSizing()
+		defaultValues["apiServerResources"] = sizing.KubeAPIServer.Values()
+		defaultValues["maxReplicas"] = sizing.KubeAPIServerMaxReplicas
 	}
 
 	var (
@@ -401,6 +379,19 @@ func (b *HybridBotanist) DeployKubeAPIServer() error {
 			defaultValues["oidcConfig"] = apiServerConfig.OIDCConfig
 		}
 
+		if webhook := apiServerConfig.AuthenticationWebhook; webhook != nil {
+			authenticationWebhook := map[string]interface{}{
+				"url": webhook.URL,
+			}
+			if webhook.CABundle != nil {
+				authenticationWebhook["caBundle"] = *webhook.CABundle
+			}
+			if webhook.CacheTTL != nil {
+				authenticationWebhook["cacheTTL"] = webhook.CacheTTL.Duration.String()
+			}
+			defaultValues["authenticationWebhook"] = authenticationWebhook
+		}
+
 		for _, plugin := range apiServerConfig.AdmissionPlugins {
 			pluginOverwritesDefault := false
 
@@ -449,6 +440,16 @@ func (b *HybridBotanist) DeployKubeAPIServer() error {
 		defaultValues["podAnnotations"].(map[string]interface{})["checksum/configmap-cloud-provider-config"] = b.CheckSums[common.CloudProviderConfigName]
 	}
 
+	serviceLoadBalancerAnnotations := b.Shoot.GetServiceLoadBalancerAnnotations()
+	if len(serviceLoadBalancerAnnotations) > 0 {
+		defaultValues["shootWebhook"] = map[string]interface{}{
+			"enabled":                        true,
+			"port":                           common.ShootWebhookPort,
+			"serviceLoadBalancerAnnotations": serviceLoadBalancerAnnotations,
+		}
+		defaultValues["podAnnotations"].(map[string]interface{})["checksum/secret-gardener-shoot-webhook"] = b.CheckSums["gardener-shoot-webhook"]
+	}
+
 	values, err := b.InjectSeedShootImages(defaultValues,
 		common.HyperkubeImageName,
 		common.VPNSeedImageName,
@@ -459,6 +460,14 @@ func (b *HybridBotanist) DeployKubeAPIServer() error {
 		return err
 	}
 
+	if len(serviceLoadBalancerAnnotations) > 0 {
+		image, err := b.ShootWebhookImage()
+		if err != nil {
+			return err
+		}
+		values["images"].(map[string]interface{})[common.ShootWebhookImageName] = image
+	}
+
 	// If shoot is hibernated we don't want the HPA to interfer with our scaling decisions.
 	if b.Shoot.IsHibernated {
 		if err := b.K8sSeedClient.DeleteHorizontalPodAutoscaler(b.Shoot.SeedNamespace, common.KubeAPIServerDeploymentName); err != nil && !apierrors.IsNotFound(err) {
@@ -481,6 +490,18 @@ func (b *HybridBotanist) DeployKubeAPIServer() error {
 			return err
 		}
 	}
+
+	// The kube-apiserver is scaled horizontally by its HPA based on its CPU and memory utilization and its resources
+	// are determined by the sizing profile, hence, it must not be controlled by a VPA as well. Delete the VPA which
+	// was deployed by earlier versions. This code can be removed in a future version.
+	vpa := &unstructured.Unstructured{}
+	vpa.SetAPIVersion("autoscaling.k8s.io/v1beta2")
+	vpa.SetKind("VerticalPodAutoscaler")
+	vpa.SetNamespace(b.Shoot.SeedNamespace)
+	vpa.SetName("kube-apiserver-vpa")
+	if err := b.K8sSeedClient.Client().Delete(context.TODO(), vpa); err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+		return err
+	}
 	return nil
 }
 
@@ -530,7 +551,7 @@ func (b *HybridBotanist) DeployKubeControllerManager() error {
 		},
 		"objectCount": b.Shoot.GetNodeCount(),
 		"vpa": map[string]interface{}{
-			"enabled": controllermanagerfeatures.FeatureGate.Enabled(features.VPA),
+			"enabled": b.Seed.VerticalPodAutoscalerEnabled(),
 		},
 	}
 	cloudSpecificValues, err := b.ShootCloudBotanist.GenerateKubeControllerManagerConfig()
@@ -553,6 +574,18 @@ func (b *HybridBotanist) DeployKubeControllerManager() error {
 		if controllerManagerConfig.HorizontalPodAutoscalerConfig != nil {
 			defaultValues["horizontalPodAutoscaler"] = controllerManagerConfig.HorizontalPodAutoscalerConfig
 		}
+
+		if controllerManagerConfig.NodeCIDRMaskSize != nil {
+			defaultValues["nodeCIDRMaskSize"] = *controllerManagerConfig.NodeCIDRMaskSize
+		}
+
+		if controllerManagerConfig.NodeMonitorGracePeriod != nil {
+			defaultValues["nodeMonitorGracePeriod"] = controllerManagerConfig.NodeMonitorGracePeriod.Duration.String()
+		}
+
+		if controllerManagerConfig.PodEvictionTimeout != nil {
+			defaultValues["podEvictionTimeout"] = controllerManagerConfig.PodEvictionTimeout.Duration.String()
+		}
 	}
 
 	values, err := b.InjectSeedShootImages(defaultValues, common.HyperkubeImageName)
@@ -579,7 +612,7 @@ func (b *HybridBotanist) DeployCloudControllerManager() error {
 			"checksum/configmap-cloud-provider-config":        b.CheckSums[common.CloudProviderConfigName],
 		},
 		"vpa": map[string]interface{}{
-			"enabled": controllermanagerfeatures.FeatureGate.Enabled(features.VPA),
+			"enabled": b.Seed.VerticalPodAutoscalerEnabled(),
 		},
 	}
 	cloudSpecificValues, chartName, err := b.ShootCloudBotanist.GenerateCloudControllerManagerConfig()
@@ -628,7 +661,7 @@ func (b *HybridBotanist) DeployKubeScheduler() error {
 			"checksum/secret-kube-scheduler-server": b.CheckSums[common.KubeSchedulerServerName],
 		},
 		"vpa": map[string]interface{}{
-			"enabled": controllermanagerfeatures.FeatureGate.Enabled(features.VPA),
+			"enabled": b.Seed.VerticalPodAutoscalerEnabled(),
 		},
 	}
 	cloudValues, err := b.ShootCloudBotanist.GenerateKubeSchedulerConfig()
@@ -643,6 +676,8 @@ func (b *HybridBotanist) DeployKubeScheduler() error {
 				"memory": "512Mi",
 			},
 		}
+	} else {
+		defaultValues["resources"] = b.Shoot.GetSizing().KubeScheduler.Values()
 	}
 
 	schedulerConfig := b.Shoot.Info.Spec.Kubernetes.KubeScheduler
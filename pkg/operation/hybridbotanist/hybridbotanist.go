@@ -30,3 +30,42 @@ func New(o *operation.Operation, b *botanist.Botanist, seedCB, shootCB cloudbota
 		ShootCloudBotanist: shootCB,
 	}, nil
 }
+
+const (
+	// calicoTunnelOverhead is subtracted from the network MTU of the Shoot for the MTU of the pod interfaces and
+	// the IP-in-IP tunnel device. It covers the IP-in-IP header with headroom and results in Calico's default MTU
+	// of 1440 on networks with an MTU of 1500.
+	calicoTunnelOverhead = 60
+	// tcpIPHeaderLength is the length of the IPv4 and TCP headers (without options) which is subtracted from an MTU
+	// to get the maximum segment size of TCP connections.
+	tcpIPHeaderLength = 40
+)
+
+// computePodNetworkMTU computes the MTU for the pod interfaces of the Shoot based on the MTU of its nodes' network.
+func (b *HybridBotanist) computePodNetworkMTU() int32 {
+	return podNetworkMTU(b.ShootCloudBotanist.GetNetworkMTU())
+}
+
+// computeVPNMaxSegmentSize computes the maximum segment size which is enforced for the TCP connections through the
+// VPN tunnel between the Seed and the Shoot.
+func (b *HybridBotanist) computeVPNMaxSegmentSize() int32 {
+	return vpnMaxSegmentSize(b.SeedCloudBotanist.GetNetworkMTU(), b.ShootCloudBotanist.GetNetworkMTU())
+}
+
+// podNetworkMTU returns the MTU of the pod interfaces for the given MTU of the nodes' network.
+func podNetworkMTU(networkMTU int32) int32 {
+	return networkMTU - calicoTunnelOverhead
+}
+
+// vpnMaxSegmentSize returns the maximum segment size of TCP connections through the VPN tunnel. The VPN images do not
+// allow configuring the MTU of the tunnel device, hence, the packets leaving the tunnel may exceed the MTU of the pod
+// network of the Shoot or the network of the Seed and are dropped. Clamping the maximum segment size to the smaller
+// of both MTUs avoids such packets for the TCP connections of the kube-apiserver to the Shoot (e.g. logs, exec,
+// port-forward or webhooks).
+func vpnMaxSegmentSize(seedNetworkMTU, shootNetworkMTU int32) int32 {
+	mtu := podNetworkMTU(shootNetworkMTU)
+	if seedNetworkMTU < mtu {
+		mtu = seedNetworkMTU
+	}
+	return mtu - tcpIPHeaderLength
+}
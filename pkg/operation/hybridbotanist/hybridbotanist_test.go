@@ -0,0 +1,44 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("hybridbotanist", func() {
+	DescribeTable("#podNetworkMTU",
+		func(networkMTU, expected int32) {
+			Expect(ExportPodNetworkMTU(networkMTU)).To(Equal(expected))
+		},
+		Entry("Ethernet default", int32(1500), int32(1440)),
+		Entry("GCP", int32(1460), int32(1400)),
+		Entry("OpenStack overlay network", int32(1450), int32(1390)),
+	)
+
+	DescribeTable("#vpnMaxSegmentSize",
+		func(seedNetworkMTU, shootNetworkMTU, expected int32) {
+			Expect(ExportVPNMaxSegmentSize(seedNetworkMTU, shootNetworkMTU)).To(Equal(expected))
+		},
+		Entry("same network MTUs", int32(1500), int32(1500), int32(1400)),
+		Entry("smaller MTU of the Shoot network", int32(1500), int32(1460), int32(1360)),
+		Entry("smaller MTU of the Seed network", int32(1460), int32(1500), int32(1400)),
+		Entry("Seed network MTU smaller than the Shoot pod network MTU", int32(1400), int32(1500), int32(1360)),
+	)
+})
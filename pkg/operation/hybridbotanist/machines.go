@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -33,6 +34,7 @@ import (
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -118,6 +120,13 @@ func (b *HybridBotanist) ReconcileMachines() error {
 		return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("Failed while waiting for all machine deployments to be ready: '%s'", err.Error()))
 	}
 
+	// Record the Kubernetes version the machines have completely been rolled to.
+	if !b.Shoot.IsHibernated {
+		if err := b.updateMachineDeploymentKubernetesVersions(wantedMachineDeployments); err != nil {
+			return err
+		}
+	}
+
 	// Delete all old machine deployments (i.e. those which were not previously computed but exist in the cluster).
 	if err := b.cleanupMachineDeployments(existingMachineDeployments, wantedMachineDeployments); err != nil {
 		return fmt.Errorf("Failed to cleanup the machine deployments: '%s'", err.Error())
@@ -143,6 +152,161 @@ func (b *HybridBotanist) ReconcileMachines() error {
 	return nil
 }
 
+// DeleteMachinesOfRemovedZones deletes the MachineDeployments of those zones which have been removed from the Shoot.
+// Their replicas are moved to the remaining MachineDeployments of the same worker pool first so that the capacity of
+// the pool is kept while the machines are drained. It does not wait for the machines to be deleted but returns an
+// error as long as MachineDeployments of removed zones exist. This must happen before the infrastructure is
+// reconciled as the subnets of removed zones cannot be deleted as long as machines are running in them, i.e., the
+// infrastructure is reconciled by a later reconciliation once the machines are gone.
+func (b *HybridBotanist) DeleteMachinesOfRemovedZones() error {
+	existingMachineDeployments, err := b.K8sSeedClient.Machine().MachineV1alpha1().MachineDeployments(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	var (
+		wantedMachineDeploymentNames = b.ShootCloudBotanist.GetMachineDeploymentNames()
+		keptMachineDeployments       = map[string][]machinev1alpha1.MachineDeployment{}
+		removedMachineDeployments    = map[string][]machinev1alpha1.MachineDeployment{}
+	)
+
+	for _, existingMachineDeployment := range existingMachineDeployments.Items {
+		workerName, ok := b.workerPoolOf(existingMachineDeployment.Name)
+		if !ok {
+			continue
+		}
+		if wantedMachineDeploymentNames.Has(existingMachineDeployment.Name) {
+			keptMachineDeployments[workerName] = append(keptMachineDeployments[workerName], existingMachineDeployment)
+			continue
+		}
+		removedMachineDeployments[workerName] = append(removedMachineDeployments[workerName], existingMachineDeployment)
+	}
+
+	if len(removedMachineDeployments) == 0 {
+		return nil
+	}
+
+	var removedMachineDeploymentNames []string
+	for workerName, removed := range removedMachineDeployments {
+		if !b.Shoot.IsHibernated {
+			if err := b.moveReplicas(removed, keptMachineDeployments[workerName]); err != nil {
+				return err
+			}
+		}
+
+		for _, machineDeployment := range removed {
+			if machineDeployment.DeletionTimestamp == nil {
+				b.Logger.Infof("Deleting machine deployment %q of a removed zone", machineDeployment.Name)
+				if err := b.K8sSeedClient.Machine().MachineV1alpha1().MachineDeployments(b.Shoot.SeedNamespace).Delete(machineDeployment.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+					return err
+				}
+			}
+			removedMachineDeploymentNames = append(removedMachineDeploymentNames, machineDeployment.Name)
+		}
+	}
+
+	sort.Strings(removedMachineDeploymentNames)
+	return fmt.Errorf("waiting until the machines of the removed zones have been drained and deleted (machine deployments %s), the infrastructure will be reconciled afterwards", strings.Join(removedMachineDeploymentNames, ", "))
+}
+
+// moveReplicas distributes the replicas of the <removed> MachineDeployments of a worker pool over its <kept>
+// MachineDeployments. The replicas of the kept MachineDeployments are only increased, never decreased. If the
+// cluster-autoscaler is used, the maximum of the pool might be exceeded until the machines are reconciled.
+func (b *HybridBotanist) moveReplicas(removed, kept []machinev1alpha1.MachineDeployment) error {
+	if len(kept) == 0 {
+		b.Logger.Infof("Worker pool of machine deployments of removed zones has no machine deployments in the remaining zones, its capacity is reduced until its machines are reconciled")
+		return nil
+	}
+
+	total := 0
+	for _, machineDeployment := range append(append([]machinev1alpha1.MachineDeployment{}, removed...), kept...) {
+		if machineDeployment.DeletionTimestamp == nil {
+			total += int(machineDeployment.Spec.Replicas)
+		}
+	}
+
+	sort.Slice(kept, func(i, j int) bool { return kept[i].Name < kept[j].Name })
+	for i, machineDeployment := range kept {
+		replicas := common.DistributeOverZones(i, total, len(kept))
+		if replicas <= int(machineDeployment.Spec.Replicas) {
+			continue
+		}
+
+		b.Logger.Infof("Scaling machine deployment %q from %d to %d replicas to take over the machines of removed zones", machineDeployment.Name, machineDeployment.Spec.Replicas, replicas)
+		machineDeployment.Spec.Replicas = int32(replicas)
+		if _, err := b.K8sSeedClient.Machine().MachineV1alpha1().MachineDeployments(b.Shoot.SeedNamespace).Update(&machineDeployment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rebalancedReplicas distributes the replicas of the existing MachineDeployments of a worker pool evenly over its
+// wanted MachineDeployments if the zones of the pool have changed (i.e., a zone has been added or removed). The
+// replicas stay within the minimum and maximum of the individual MachineDeployments. It returns the replicas of the
+// rebalanced MachineDeployments by name.
+func (b *HybridBotanist) rebalancedReplicas(existingMachineDeployments *machinev1alpha1.MachineDeploymentList, wantedMachineDeployments operation.MachineDeployments) map[string]int {
+	var (
+		existingNames = map[string]sets.String{}
+		existingTotal = map[string]int{}
+		wantedNames   = map[string]sets.String{}
+		wanted        = map[string]operation.MachineDeployments{}
+		out           = map[string]int{}
+	)
+
+	for _, machineDeployment := range existingMachineDeployments.Items {
+		if workerName, ok := b.workerPoolOf(machineDeployment.Name); ok && machineDeployment.DeletionTimestamp == nil {
+			if existingNames[workerName] == nil {
+				existingNames[workerName] = sets.NewString()
+			}
+			existingNames[workerName].Insert(machineDeployment.Name)
+			existingTotal[workerName] += int(machineDeployment.Spec.Replicas)
+		}
+	}
+	for _, machineDeployment := range wantedMachineDeployments {
+		if workerName, ok := b.workerPoolOf(machineDeployment.Name); ok {
+			if wantedNames[workerName] == nil {
+				wantedNames[workerName] = sets.NewString()
+			}
+			wantedNames[workerName].Insert(machineDeployment.Name)
+			wanted[workerName] = append(wanted[workerName], machineDeployment)
+		}
+	}
+
+	for workerName, names := range wantedNames {
+		if existingNames[workerName] == nil || existingNames[workerName].Equal(names) {
+			continue
+		}
+
+		for i, machineDeployment := range wanted[workerName] {
+			replicas := common.DistributeOverZones(i, existingTotal[workerName], len(wanted[workerName]))
+			if replicas < machineDeployment.Minimum {
+				replicas = machineDeployment.Minimum
+			}
+			if replicas > machineDeployment.Maximum {
+				replicas = machineDeployment.Maximum
+			}
+			out[machineDeployment.Name] = replicas
+		}
+	}
+	return out
+}
+
+// workerPoolOf returns the name of the worker pool of the Shoot the MachineDeployment with the given name belongs
+// to. MachineDeployments of removed worker pools are only deleted after the new machines are ready.
+func (b *HybridBotanist) workerPoolOf(machineDeploymentName string) (string, bool) {
+	var poolName string
+	for _, workerName := range b.Shoot.GetWorkerNames() {
+		if strings.HasPrefix(machineDeploymentName, fmt.Sprintf("%s-%s-", b.Shoot.SeedNamespace, workerName)) && len(workerName) > len(poolName) {
+			poolName = workerName
+		}
+	}
+	return poolName, len(poolName) > 0
+}
+
 // DestroyMachines deletes all existing MachineDeployments. As it won't trigger the drain of nodes it needs to label
 // the existing machines. In case an errors occurs, it will return it.
 func (b *HybridBotanist) DestroyMachines() error {
@@ -203,11 +367,19 @@ func (b *HybridBotanist) RefreshMachineClassSecrets() error {
 		return err
 	}
 
+	secretData, err := b.ShootCloudBotanist.GenerateMachineClassSecretData()
+	if err != nil {
+		return err
+	}
+
 	// Refresh all secrets by updating the cloud provider credentials to the latest known values.
 	for _, secret := range secretList.Items {
 		var newSecret = secret
 
-		newSecret.Data = b.ShootCloudBotanist.GenerateMachineClassSecretData()
+		newSecret.Data = make(map[string][]byte, len(secretData)+1)
+		for key, value := range secretData {
+			newSecret.Data[key] = value
+		}
 		newSecret.Data["userData"] = secret.Data["userData"]
 
 		if _, err := b.K8sSeedClient.UpdateSecretObject(&newSecret); err != nil {
@@ -222,8 +394,9 @@ func (b *HybridBotanist) RefreshMachineClassSecrets() error {
 // does that based on the provided list of to-be-deployed <wantedMachineDeployments>.
 func (b *HybridBotanist) generateMachineDeploymentConfig(existingMachineDeployments *machinev1alpha1.MachineDeploymentList, wantedMachineDeployments operation.MachineDeployments, classKind string) (map[string]interface{}, error) {
 	var (
-		values   = []map[string]interface{}{}
-		replicas int
+		values             = []map[string]interface{}{}
+		replicas           int
+		rebalancedReplicas = b.rebalancedReplicas(existingMachineDeployments, wantedMachineDeployments)
 	)
 
 	for _, deployment := range wantedMachineDeployments {
@@ -248,6 +421,7 @@ func (b *HybridBotanist) generateMachineDeploymentConfig(existingMachineDeployme
 			},
 		}
 		existingMachineDeployment := getExistingMachineDeployment(existingMachineDeployments, deployment.Name)
+		config["annotations"], config["paused"] = b.machineDeploymentAnnotations(existingMachineDeployment)
 
 		switch {
 		// If the Shoot is hibernated then the machine deployment's replicas should be zero.
@@ -257,14 +431,18 @@ func (b *HybridBotanist) generateMachineDeploymentConfig(existingMachineDeployme
 		// we can use either min or max.
 		case !b.Shoot.WantsClusterAutoscaler:
 			replicas = deployment.Minimum
-		// If the machine deployment does not yet exist we set replicas to min so that the cluster
-		// autoscaler can scale them as required.
-		case existingMachineDeployment == nil:
-			replicas = deployment.Minimum
 		// If the Shoot was hibernated and is now woken up we set replicas to min so that the cluster
 		// autoscaler can scale them as required.
 		case shootIsWokenUp(b.Shoot.IsHibernated, existingMachineDeployments):
 			replicas = deployment.Minimum
+		// If the zones of the worker pool have changed then the replicas of the pool are distributed
+		// evenly over its new zones.
+		case hasRebalancedReplicas(rebalancedReplicas, deployment.Name):
+			replicas = rebalancedReplicas[deployment.Name]
+		// If the machine deployment does not yet exist we set replicas to min so that the cluster
+		// autoscaler can scale them as required.
+		case existingMachineDeployment == nil:
+			replicas = deployment.Minimum
 		// If the shoot worker pool minimum was updated and if the current machine deployment replica
 		// count is less than minimum, we update the machine deployment replica count to updated minimum.
 		case int(existingMachineDeployment.Spec.Replicas) < deployment.Minimum:
@@ -322,6 +500,13 @@ func (b *HybridBotanist) waitUntilMachineDeploymentsAvailable(wantedMachineDeplo
 			return false, err
 		}
 
+		// Pause the rollouts of worker pools which are rolled due to a Kubernetes upgrade if they are unhealthy.
+		if !b.Shoot.IsHibernated {
+			if err := b.checkKubernetesUpgradeRollouts(existingMachineDeployments.Items, wantedMachineDeployments); err != nil {
+				return false, err
+			}
+		}
+
 		// Collect the numbers of ready and desired replicas.
 		for _, existingMachineDeployment := range existingMachineDeployments.Items {
 			// If the Shoots get hibernated we want to wait until all machine deployments have been deleted entirely.
@@ -517,6 +702,11 @@ func getDeploymentSpecReplicas(existingMachineDeployments *machinev1alpha1.Machi
 	return -1
 }
 
+func hasRebalancedReplicas(rebalancedReplicas map[string]int, name string) bool {
+	_, ok := rebalancedReplicas[name]
+	return ok
+}
+
 func getExistingMachineDeployment(existingMachineDeployments *machinev1alpha1.MachineDeploymentList, name string) *machinev1alpha1.MachineDeployment {
 	for _, machineDeployment := range existingMachineDeployments.Items {
 		if machineDeployment.Name == name {
@@ -0,0 +1,222 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// machineReadyTimeout is the duration new machines of a worker pool which is rolled due to a Kubernetes upgrade
+// have to become ready before the rollout is paused.
+const machineReadyTimeout = 20 * time.Minute
+
+// machineDeploymentAnnotations computes the annotations of the wanted MachineDeployment based on the existing one.
+// The Kubernetes version annotation is only moved forward once the rollout has completed (see
+// updateMachineDeploymentKubernetesVersions), and a paused rollout stays paused during the current generation of
+// the Shoot.
+func (b *HybridBotanist) machineDeploymentAnnotations(existingMachineDeployment *machinev1alpha1.MachineDeployment) (map[string]string, bool) {
+	annotations := map[string]string{
+		common.MachineDeploymentKubernetesVersion: b.Shoot.KubernetesMajorMinorVersion,
+	}
+	if existingMachineDeployment == nil {
+		return annotations, false
+	}
+
+	if version, ok := existingMachineDeployment.Annotations[common.MachineDeploymentKubernetesVersion]; ok {
+		annotations[common.MachineDeploymentKubernetesVersion] = version
+	}
+
+	if !b.isRolloutPaused(existingMachineDeployment) {
+		return annotations, false
+	}
+	annotations[common.MachineDeploymentRolloutPaused] = existingMachineDeployment.Annotations[common.MachineDeploymentRolloutPaused]
+	annotations[common.MachineDeploymentRolloutPausedGeneration] = existingMachineDeployment.Annotations[common.MachineDeploymentRolloutPausedGeneration]
+	return annotations, true
+}
+
+// isKubernetesUpgrade checks whether the machines of the given MachineDeployment are rolled because the Kubernetes
+// minor version of the Shoot has been upgraded.
+func (b *HybridBotanist) isKubernetesUpgrade(machineDeployment *machinev1alpha1.MachineDeployment) bool {
+	version, ok := machineDeployment.Annotations[common.MachineDeploymentKubernetesVersion]
+	return ok && version != b.Shoot.KubernetesMajorMinorVersion
+}
+
+// isRolloutPaused checks whether the rollout of the given MachineDeployment has been paused during the current
+// generation of the Shoot. Only a change of the Shoot specification increases its generation and resumes the rollout,
+// retrying the reconciliation keeps it paused.
+func (b *HybridBotanist) isRolloutPaused(machineDeployment *machinev1alpha1.MachineDeployment) bool {
+	return machineDeployment.Spec.Paused && machineDeployment.Annotations[common.MachineDeploymentRolloutPausedGeneration] == strconv.FormatInt(b.Shoot.Info.Generation, 10)
+}
+
+// rolloutPauseReason checks whether the rollout of the given MachineDeployment must be paused. This is the case if
+// new machines did not become ready in time or if a pod disruption budget is violated which covers pods affected by
+// the rollout (see affectedPods). It returns the reason for pausing the rollout, or an empty string if the rollout may
+// continue.
+func rolloutPauseReason(machineDeployment *machinev1alpha1.MachineDeployment, machines []machinev1alpha1.Machine, podDisruptionBudgets []policyv1beta1.PodDisruptionBudget, pods []corev1.Pod, now time.Time) string {
+	nodeNames := sets.NewString()
+	for _, machine := range machines {
+		if machine.Labels["name"] != machineDeployment.Name {
+			continue
+		}
+		if len(machine.Status.Node) > 0 {
+			nodeNames.Insert(machine.Status.Node)
+		}
+		if machine.Spec.Class.Name == machineDeployment.Spec.Template.Spec.Class.Name && machine.Status.CurrentStatus.Phase != machinev1alpha1.MachineRunning && now.Sub(machine.CreationTimestamp.Time) > machineReadyTimeout {
+			return fmt.Sprintf("machine %s did not become ready within %s", machine.Name, machineReadyTimeout)
+		}
+	}
+
+	pods = affectedPods(pods, nodeNames)
+	for _, pdb := range podDisruptionBudgets {
+		if !coversAnyPod(&pdb, pods) {
+			continue
+		}
+		if err := health.CheckPodDisruptionBudget(&pdb); err != nil {
+			return fmt.Sprintf("pod disruption budget %s/%s is violated: %v", pdb.Namespace, pdb.Name, err)
+		}
+	}
+
+	return ""
+}
+
+// affectedPods returns the pods which are affected by the rollout of a MachineDeployment, i.e., the pods running on
+// the nodes of its old or new machines as well as the pods which are not scheduled (e.g., because they have been
+// evicted from a drained node and do not fit on the remaining nodes).
+func affectedPods(pods []corev1.Pod, nodeNames sets.String) []corev1.Pod {
+	var out []corev1.Pod
+	for _, pod := range pods {
+		if len(pod.Spec.NodeName) == 0 || nodeNames.Has(pod.Spec.NodeName) {
+			out = append(out, pod)
+		}
+	}
+	return out
+}
+
+// coversAnyPod checks whether the selector of the given pod disruption budget matches any of the given pods.
+func coversAnyPod(pdb *policyv1beta1.PodDisruptionBudget, pods []corev1.Pod) bool {
+	if pdb.Spec.Selector == nil {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+	if err != nil || selector.Empty() {
+		return false
+	}
+	for _, pod := range pods {
+		if pod.Namespace == pdb.Namespace && selector.Matches(labels.Set(pod.Labels)) {
+			return true
+		}
+	}
+	return false
+}
+
+// pauseRollout pauses the rollout of the given MachineDeployment and records the reason and the generation of the
+// Shoot in its annotations.
+func (b *HybridBotanist) pauseRollout(machineDeployment *machinev1alpha1.MachineDeployment, reason string) error {
+	machineDeployment = machineDeployment.DeepCopy()
+	if machineDeployment.Annotations == nil {
+		machineDeployment.Annotations = map[string]string{}
+	}
+	machineDeployment.Annotations[common.MachineDeploymentRolloutPaused] = reason
+	machineDeployment.Annotations[common.MachineDeploymentRolloutPausedGeneration] = strconv.FormatInt(b.Shoot.Info.Generation, 10)
+	machineDeployment.Spec.Paused = true
+
+	b.Logger.Infof("Pausing rollout of machine deployment %s: %s", machineDeployment.Name, reason)
+	_, err := b.K8sSeedClient.Machine().MachineV1alpha1().MachineDeployments(b.Shoot.SeedNamespace).Update(machineDeployment)
+	return err
+}
+
+// checkKubernetesUpgradeRollouts checks the rollouts of all wanted MachineDeployments whose machines are rolled due to
+// a Kubernetes upgrade, and pauses them if necessary. It returns an error if any of these rollouts is paused.
+func (b *HybridBotanist) checkKubernetesUpgradeRollouts(existingMachineDeployments []machinev1alpha1.MachineDeployment, wantedMachineDeployments operation.MachineDeployments) error {
+	var upgradingMachineDeployments []machinev1alpha1.MachineDeployment
+	for _, existingMachineDeployment := range existingMachineDeployments {
+		if wantedMachineDeployments.ContainsName(existingMachineDeployment.Name) && b.isKubernetesUpgrade(&existingMachineDeployment) {
+			upgradingMachineDeployments = append(upgradingMachineDeployments, existingMachineDeployment)
+		}
+	}
+	if len(upgradingMachineDeployments) == 0 {
+		return nil
+	}
+
+	machines, err := b.K8sSeedClient.Machine().MachineV1alpha1().Machines(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	podDisruptionBudgets := &policyv1beta1.PodDisruptionBudgetList{}
+	if err := b.K8sShootClient.Client().List(context.TODO(), &client.ListOptions{}, podDisruptionBudgets); err != nil {
+		return err
+	}
+	pods := &corev1.PodList{}
+	if err := b.K8sShootClient.Client().List(context.TODO(), &client.ListOptions{}, pods); err != nil {
+		return err
+	}
+
+	for _, machineDeployment := range upgradingMachineDeployments {
+		if b.isRolloutPaused(&machineDeployment) {
+			return fmt.Errorf("rollout of machine deployment %s is paused: %s", machineDeployment.Name, machineDeployment.Annotations[common.MachineDeploymentRolloutPaused])
+		}
+
+		if reason := rolloutPauseReason(&machineDeployment, machines.Items, podDisruptionBudgets.Items, pods.Items, time.Now()); reason != "" {
+			if err := b.pauseRollout(&machineDeployment, reason); err != nil {
+				return err
+			}
+			return fmt.Errorf("rollout of machine deployment %s is paused: %s", machineDeployment.Name, reason)
+		}
+	}
+
+	return nil
+}
+
+// updateMachineDeploymentKubernetesVersions records the Kubernetes minor version of the Shoot in the annotations of all
+// wanted MachineDeployments after their machines have completely been rolled.
+func (b *HybridBotanist) updateMachineDeploymentKubernetesVersions(wantedMachineDeployments operation.MachineDeployments) error {
+	existingMachineDeployments, err := b.K8sSeedClient.Machine().MachineV1alpha1().MachineDeployments(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, existingMachineDeployment := range existingMachineDeployments.Items {
+		if !wantedMachineDeployments.ContainsName(existingMachineDeployment.Name) || existingMachineDeployment.Annotations[common.MachineDeploymentKubernetesVersion] == b.Shoot.KubernetesMajorMinorVersion {
+			continue
+		}
+
+		machineDeployment := existingMachineDeployment.DeepCopy()
+		if machineDeployment.Annotations == nil {
+			machineDeployment.Annotations = map[string]string{}
+		}
+		machineDeployment.Annotations[common.MachineDeploymentKubernetesVersion] = b.Shoot.KubernetesMajorMinorVersion
+		if _, err := b.K8sSeedClient.Machine().MachineV1alpha1().MachineDeployments(b.Shoot.SeedNamespace).Update(machineDeployment); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+)
+
+var _ = Describe("rollout", func() {
+	Describe("#rolloutPauseReason", func() {
+		var (
+			now               = time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+			machineDeployment *machinev1alpha1.MachineDeployment
+
+			newMachine = func(name, className, nodeName string, phase machinev1alpha1.MachinePhase, age time.Duration) machinev1alpha1.Machine {
+				return machinev1alpha1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              name,
+						Labels:            map[string]string{"name": "shoot--foo--bar-worker-z1"},
+						CreationTimestamp: metav1.NewTime(now.Add(-age)),
+					},
+					Spec: machinev1alpha1.MachineSpec{
+						Class: machinev1alpha1.ClassSpec{Name: className},
+					},
+					Status: machinev1alpha1.MachineStatus{
+						Node:          nodeName,
+						CurrentStatus: machinev1alpha1.CurrentStatus{Phase: phase},
+					},
+				}
+			}
+			newPod = func(namespace, name, nodeName string, labels map[string]string) corev1.Pod {
+				return corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels},
+					Spec:       corev1.PodSpec{NodeName: nodeName},
+				}
+			}
+			newPodDisruptionBudget = func(namespace, name string, labels map[string]string, currentHealthy int32) policyv1beta1.PodDisruptionBudget {
+				return policyv1beta1.PodDisruptionBudget{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+					Spec: policyv1beta1.PodDisruptionBudgetSpec{
+						Selector: &metav1.LabelSelector{MatchLabels: labels},
+					},
+					Status: policyv1beta1.PodDisruptionBudgetStatus{
+						CurrentHealthy: currentHealthy,
+						DesiredHealthy: 2,
+					},
+				}
+			}
+
+			machines []machinev1alpha1.Machine
+		)
+
+		BeforeEach(func() {
+			machineDeployment = &machinev1alpha1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar-worker-z1"},
+				Spec: machinev1alpha1.MachineDeploymentSpec{
+					Template: machinev1alpha1.MachineTemplateSpec{
+						Spec: machinev1alpha1.MachineSpec{
+							Class: machinev1alpha1.ClassSpec{Name: "shoot--foo--bar-worker-z1-new"},
+						},
+					},
+				},
+			}
+			machines = []machinev1alpha1.Machine{
+				newMachine("old", "shoot--foo--bar-worker-z1-old", "node-old", machinev1alpha1.MachineRunning, time.Hour),
+				newMachine("new", "shoot--foo--bar-worker-z1-new", "node-new", machinev1alpha1.MachineRunning, time.Minute),
+			}
+		})
+
+		It("should not pause the rollout if everything is healthy", func() {
+			pods := []corev1.Pod{newPod("default", "app", "node-old", map[string]string{"app": "foo"})}
+			pdbs := []policyv1beta1.PodDisruptionBudget{newPodDisruptionBudget("default", "app", map[string]string{"app": "foo"}, 2)}
+
+			Expect(ExportRolloutPauseReason(machineDeployment, machines, pdbs, pods, now)).To(BeEmpty())
+		})
+
+		It("should pause the rollout if a new machine did not become ready in time", func() {
+			machines = append(machines, newMachine("pending", "shoot--foo--bar-worker-z1-new", "", machinev1alpha1.MachinePending, time.Hour))
+
+			Expect(ExportRolloutPauseReason(machineDeployment, machines, nil, nil, now)).To(ContainSubstring("machine pending did not become ready"))
+		})
+
+		It("should not pause the rollout if an old machine is not ready", func() {
+			machines = append(machines, newMachine("failed", "shoot--foo--bar-worker-z1-old", "", machinev1alpha1.MachineFailed, time.Hour))
+
+			Expect(ExportRolloutPauseReason(machineDeployment, machines, nil, nil, now)).To(BeEmpty())
+		})
+
+		It("should not pause the rollout if a new machine is still within its timeout", func() {
+			machines = append(machines, newMachine("pending", "shoot--foo--bar-worker-z1-new", "", machinev1alpha1.MachinePending, time.Minute))
+
+			Expect(ExportRolloutPauseReason(machineDeployment, machines, nil, nil, now)).To(BeEmpty())
+		})
+
+		DescribeTable("violated pod disruption budgets",
+			func(pod corev1.Pod, pdbNamespace string, matcher types.GomegaMatcher) {
+				pdbs := []policyv1beta1.PodDisruptionBudget{newPodDisruptionBudget(pdbNamespace, "app", map[string]string{"app": "foo"}, 1)}
+
+				Expect(ExportRolloutPauseReason(machineDeployment, machines, pdbs, []corev1.Pod{pod}, now)).To(matcher)
+			},
+			Entry("should pause the rollout if the pod runs on an old node", newPod("default", "app", "node-old", map[string]string{"app": "foo"}), "default", ContainSubstring("pod disruption budget default/app is violated")),
+			Entry("should pause the rollout if the pod runs on a new node", newPod("default", "app", "node-new", map[string]string{"app": "foo"}), "default", ContainSubstring("pod disruption budget default/app is violated")),
+			Entry("should pause the rollout if the pod is not scheduled", newPod("default", "app", "", map[string]string{"app": "foo"}), "default", ContainSubstring("pod disruption budget default/app is violated")),
+			Entry("should not pause the rollout if the pod runs on a node of another worker pool", newPod("default", "app", "node-other", map[string]string{"app": "foo"}), "default", BeEmpty()),
+			Entry("should not pause the rollout if the pod is not selected", newPod("default", "app", "node-old", map[string]string{"app": "bar"}), "default", BeEmpty()),
+			Entry("should not pause the rollout if the pod is in another namespace", newPod("default", "app", "node-old", map[string]string{"app": "foo"}), "kube-system", BeEmpty()),
+		)
+	})
+
+	Describe("#isRolloutPaused", func() {
+		var (
+			hybridBotanist    *HybridBotanist
+			machineDeployment *machinev1alpha1.MachineDeployment
+		)
+
+		BeforeEach(func() {
+			hybridBotanist = &HybridBotanist{
+				Operation: &operation.Operation{
+					Shoot: &shoot.Shoot{
+						Info: &gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Generation: 2}},
+					},
+				},
+			}
+			machineDeployment = &machinev1alpha1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{common.MachineDeploymentRolloutPausedGeneration: "2"},
+				},
+				Spec: machinev1alpha1.MachineDeploymentSpec{Paused: true},
+			}
+		})
+
+		It("should keep the rollout paused during the same generation of the shoot", func() {
+			Expect(ExportIsRolloutPaused(hybridBotanist, machineDeployment)).To(BeTrue())
+		})
+
+		It("should resume the rollout once the generation of the shoot has changed", func() {
+			hybridBotanist.Shoot.Info.Generation = 3
+
+			Expect(ExportIsRolloutPaused(hybridBotanist, machineDeployment)).To(BeFalse())
+		})
+
+		It("should not consider a machine deployment paused by someone else", func() {
+			delete(machineDeployment.Annotations, common.MachineDeploymentRolloutPausedGeneration)
+
+			Expect(ExportIsRolloutPaused(hybridBotanist, machineDeployment)).To(BeFalse())
+		})
+	})
+})
@@ -24,6 +24,8 @@ import (
 
 	"github.com/gardener/gardener/pkg/utils/chart"
 
+	"github.com/Masterminds/semver"
+
 	"github.com/gardener/gardener/pkg/operation/terraformer"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
@@ -32,6 +34,7 @@ import (
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/operation/garden"
 	"github.com/gardener/gardener/pkg/operation/seed"
@@ -41,29 +44,30 @@ import (
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/secrets"
+	"github.com/gardener/gardener/pkg/version"
 
 	prometheusapi "github.com/prometheus/client_golang/api"
 	prometheusclient "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/sirupsen/logrus"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // New creates a new operation object with a Shoot resource object.
-func New(shoot *gardenv1beta1.Shoot, logger *logrus.Entry, k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.Interface, gardenerInfo *gardenv1beta1.Gardener, secretsMap map[string]*corev1.Secret, imageVector imagevector.ImageVector, shootBackup *config.ShootBackup) (*Operation, error) {
-	return newOperation(logger, k8sGardenClient, k8sGardenInformers, gardenerInfo, secretsMap, imageVector, shoot.Namespace, *(shoot.Spec.Cloud.Seed), shoot, nil, shootBackup)
+func New(shoot *gardenv1beta1.Shoot, log *logrus.Entry, k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.Interface, gardenerInfo *gardenv1beta1.Gardener, secretsMap map[string]*corev1.Secret, imageVector imagevector.ImageVector, shootBackup *config.ShootBackup) (*Operation, error) {
+	return newOperation(log, k8sGardenClient, k8sGardenInformers, gardenerInfo, secretsMap, imageVector, shoot.Namespace, *(shoot.Spec.Cloud.Seed), shoot, nil, shootBackup)
 }
 
 // NewWithBackupInfrastructure creates a new operation object without a Shoot resource object but the BackupInfrastructure resource.
-func NewWithBackupInfrastructure(backupInfrastructure *gardenv1beta1.BackupInfrastructure, logger *logrus.Entry, k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.Interface, gardenerInfo *gardenv1beta1.Gardener, secretsMap map[string]*corev1.Secret, imageVector imagevector.ImageVector) (*Operation, error) {
-	return newOperation(logger, k8sGardenClient, k8sGardenInformers, gardenerInfo, secretsMap, imageVector, backupInfrastructure.Namespace, backupInfrastructure.Spec.Seed, nil, backupInfrastructure, nil)
+func NewWithBackupInfrastructure(backupInfrastructure *gardenv1beta1.BackupInfrastructure, log *logrus.Entry, k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.Interface, gardenerInfo *gardenv1beta1.Gardener, secretsMap map[string]*corev1.Secret, imageVector imagevector.ImageVector) (*Operation, error) {
+	return newOperation(log, k8sGardenClient, k8sGardenInformers, gardenerInfo, secretsMap, imageVector, backupInfrastructure.Namespace, backupInfrastructure.Spec.Seed, nil, backupInfrastructure, nil)
 }
 
 func newOperation(
-	logger *logrus.Entry,
+	log *logrus.Entry,
 	k8sGardenClient kubernetes.Interface,
 	k8sGardenInformers gardeninformers.Interface,
 	gardenerInfo *gardenv1beta1.Gardener,
@@ -100,7 +104,7 @@ func newOperation(
 	}
 
 	operation := &Operation{
-		Logger:               logger,
+		Logger:               logger.WithProjectAndSeed(log, gardenObj.Project.Name, seedName),
 		GardenerInfo:         gardenerInfo,
 		Secrets:              secrets,
 		ImageVector:          imageVector,
@@ -121,12 +125,13 @@ func newOperation(
 			return nil, err
 		}
 		operation.Shoot = shootObj
+		operation.ProjectSecrets = kutil.NewSecretsCache(k8sGardenClient.Client(), shoot.Namespace)
 		operation.Shoot.IgnoreAlerts = helper.ShootIgnoreAlerts(shoot)
-		operation.Shoot.WantsAlertmanager = helper.ShootWantsAlertmanager(shoot, secrets) && !operation.Shoot.IgnoreAlerts
+		operation.Shoot.WantsAlertmanager = helper.ShootWantsAlertmanager(shoot, secrets) && !operation.Shoot.IgnoreAlerts && operation.Shoot.WantsMonitoring
 
 		shootedSeed, err := helper.ReadShootedSeed(shoot)
 		if err != nil {
-			logger.Warnf("Cannot use shoot %s/%s as shooted seed: %+v", shoot.Namespace, shoot.Name, err)
+			operation.Logger.Warnf("Cannot use shoot %s/%s as shooted seed: %+v", shoot.Namespace, shoot.Name, err)
 		} else {
 			operation.ShootedSeed = shootedSeed
 		}
@@ -144,13 +149,14 @@ func (o *Operation) InitializeSeedClients() error {
 		return nil
 	}
 
-	k8sSeedClient, err := kubernetes.NewClientFromSecretObject(o.Seed.Secret, client.Options{
-		Scheme: kubernetes.SeedScheme,
-	})
+	k8sSeedClient, err := o.Seed.NewClient()
 	if err != nil {
 		return err
 	}
 	o.K8sSeedClient = k8sSeedClient
+	if o.Shoot != nil {
+		o.SeedNamespaceSecrets = kutil.NewSecretsCache(k8sSeedClient.Client(), o.Shoot.SeedNamespace)
+	}
 
 	renderer, err := chartrenderer.NewForConfig(k8sSeedClient.RESTConfig())
 	if err != nil {
@@ -185,7 +191,12 @@ func (o *Operation) InitializeShootClients() error {
 		}
 	}
 
-	k8sShootClient, err := kubernetes.NewClientFromSecret(o.K8sSeedClient, o.Shoot.SeedNamespace, gardenv1beta1.GardenerName, client.Options{
+	gardenerSecret, err := o.SeedNamespaceSecrets.Get(context.TODO(), gardenv1beta1.GardenerName)
+	if err != nil {
+		return err
+	}
+
+	k8sShootClient, err := kubernetes.NewClientFromSecretObject(gardenerSecret, client.Options{
 		Scheme: kubernetes.ShootScheme,
 	})
 	if err != nil {
@@ -217,7 +228,7 @@ func (o *Operation) controlPlaneHibernated() (bool, error) {
 	return true, nil
 }
 
-//ComputePrometheusIngressFQDN computes full qualified domain name for prometheus ingress sub-resource and returns it.
+// ComputePrometheusIngressFQDN computes full qualified domain name for prometheus ingress sub-resource and returns it.
 func (o *Operation) ComputePrometheusIngressFQDN() string {
 	return o.Seed.GetIngressFQDN("p", o.Shoot.Info.Name, o.Garden.Project.Name)
 }
@@ -232,17 +243,13 @@ func (o *Operation) InitializeMonitoringClient() error {
 		return nil
 	}
 
-	// Read the CA.
-	tlsSecret, err := o.K8sSeedClient.GetSecret(o.Shoot.SeedNamespace, "prometheus-tls")
+	ca, err := o.monitoringCertPool()
 	if err != nil {
 		return err
 	}
 
-	ca := x509.NewCertPool()
-	ca.AppendCertsFromPEM(tlsSecret.Data[secrets.DataKeyCertificateCA])
-
 	// Read the basic auth credentials.
-	credentials, err := o.K8sSeedClient.GetSecret(o.Shoot.SeedNamespace, "monitoring-ingress-credentials")
+	credentials, err := o.SeedNamespaceSecrets.Get(context.TODO(), "monitoring-ingress-credentials")
 	if err != nil {
 		return err
 	}
@@ -262,6 +269,34 @@ func (o *Operation) InitializeMonitoringClient() error {
 	return nil
 }
 
+// monitoringCertPool returns the certificates to trust when talking to the Prometheus ingress. It is the CA of the
+// self-signed certificate generated by Gardener unless the Seed uses certificates issued by others. In this case,
+// the system certificates are trusted, plus the CA shipped with the wildcard certificate (if any).
+func (o *Operation) monitoringCertPool() (*x509.CertPool, error) {
+	if o.Seed.UsesACMEIngressCertificates() {
+		return x509.SystemCertPool()
+	}
+
+	var (
+		secretName = "prometheus-tls"
+		ca         = x509.NewCertPool()
+		err        error
+	)
+	if o.Seed.UsesWildcardIngressCertificate() {
+		secretName = common.IngressWildcardSecretName
+		if ca, err = x509.SystemCertPool(); err != nil {
+			return nil, err
+		}
+	}
+
+	tlsSecret, err := o.SeedNamespaceSecrets.Get(context.TODO(), secretName)
+	if err != nil {
+		return nil, err
+	}
+	ca.AppendCertsFromPEM(tlsSecret.Data[secrets.DataKeyCertificateCA])
+	return ca, nil
+}
+
 // ApplyChartGarden takes a path to a chart <chartPath>, name of the release <name>, release's namespace <namespace>
 // and two maps <defaultValues>, <additionalValues>, and renders the template based on the merged result of both value maps.
 // The resulting manifest will be applied to the Garden cluster.
@@ -296,22 +331,22 @@ func (o *Operation) ReportShootProgress(stats *flow.Stats) {
 		description    = makeDescription(stats)
 		progress       = stats.ProgressPercent()
 		lastUpdateTime = metav1.Now()
+
+		newShoot = o.Shoot.Info.DeepCopy()
 	)
 
-	newShoot, err := kutil.TryUpdateShootStatus(o.K8sGardenClient.Garden(), retry.DefaultRetry, o.Shoot.Info.ObjectMeta,
-		func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
-			if shoot.Status.LastOperation == nil {
-				return nil, fmt.Errorf("last operation of Shoot %s/%s is unset", shoot.Namespace, shoot.Name)
-			}
-			if shoot.Status.LastOperation.LastUpdateTime.After(lastUpdateTime.Time) {
-				return nil, fmt.Errorf("last operation of Shoot %s/%s was updated mid-air", shoot.Namespace, shoot.Name)
-			}
-			shoot.Status.LastOperation.Description = description
-			shoot.Status.LastOperation.Progress = progress
-			shoot.Status.LastOperation.LastUpdateTime = lastUpdateTime
-			return shoot, nil
-		})
-	if err != nil {
+	if err := kutil.TryUpdateStatus(context.TODO(), kutil.DefaultBackoff, o.K8sGardenClient.Client(), newShoot, func() error {
+		if newShoot.Status.LastOperation == nil {
+			return fmt.Errorf("last operation of Shoot %s/%s is unset", newShoot.Namespace, newShoot.Name)
+		}
+		if newShoot.Status.LastOperation.LastUpdateTime.After(lastUpdateTime.Time) {
+			return fmt.Errorf("last operation of Shoot %s/%s was updated mid-air", newShoot.Namespace, newShoot.Name)
+		}
+		newShoot.Status.LastOperation.Description = description
+		newShoot.Status.LastOperation.Progress = progress
+		newShoot.Status.LastOperation.LastUpdateTime = lastUpdateTime
+		return nil
+	}); err != nil {
 		o.Logger.Errorf("Could not report shoot progress: %v", err)
 		return
 	}
@@ -322,13 +357,28 @@ func (o *Operation) ReportShootProgress(stats *flow.Stats) {
 // ReportBackupInfrastructureProgress will update the phase and error in the BackupInfrastructure manifest `status` section
 // by the current progress of the Flow execution.
 func (o *Operation) ReportBackupInfrastructureProgress(stats *flow.Stats) {
-	o.BackupInfrastructure.Status.LastOperation.Description = makeDescription(stats)
-	o.BackupInfrastructure.Status.LastOperation.Progress = stats.ProgressPercent()
-	o.BackupInfrastructure.Status.LastOperation.LastUpdateTime = metav1.Now()
+	var (
+		description    = makeDescription(stats)
+		progress       = stats.ProgressPercent()
+		lastUpdateTime = metav1.Now()
+
+		newBackupInfrastructure = o.BackupInfrastructure.DeepCopy()
+	)
 
-	if newBackupInfrastructure, err := o.K8sGardenClient.Garden().GardenV1beta1().BackupInfrastructures(o.BackupInfrastructure.Namespace).UpdateStatus(o.BackupInfrastructure); err == nil {
-		o.BackupInfrastructure = newBackupInfrastructure
+	if err := kutil.TryUpdateStatus(context.TODO(), kutil.DefaultBackoff, o.K8sGardenClient.Client(), newBackupInfrastructure, func() error {
+		if newBackupInfrastructure.Status.LastOperation == nil {
+			return fmt.Errorf("last operation of BackupInfrastructure %s/%s is unset", newBackupInfrastructure.Namespace, newBackupInfrastructure.Name)
+		}
+		newBackupInfrastructure.Status.LastOperation.Description = description
+		newBackupInfrastructure.Status.LastOperation.Progress = progress
+		newBackupInfrastructure.Status.LastOperation.LastUpdateTime = lastUpdateTime
+		return nil
+	}); err != nil {
+		o.Logger.Errorf("Could not report backup infrastructure progress: %v", err)
+		return
 	}
+
+	o.BackupInfrastructure = newBackupInfrastructure
 }
 
 // SeedVersion is a shorthand for the kubernetes version of the K8sSeedClient.
@@ -355,6 +405,37 @@ func (o *Operation) InjectSeedShootImages(values map[string]interface{}, names .
 	return o.injectImages(values, names, imagevector.RuntimeVersion(o.SeedVersion()), imagevector.TargetVersion(o.ShootVersion()))
 }
 
+// InjectOutOfTreeCloudControllerManager injects the image <imageName> and the <command> of the out-of-tree
+// cloud-controller-manager of the cloud provider into the given <values> if the Shoot uses it. Otherwise, the values
+// are returned unchanged and the in-tree cloud-controller-manager of the hyperkube image is deployed.
+func (o *Operation) InjectOutOfTreeCloudControllerManager(values map[string]interface{}, imageName, command string) (map[string]interface{}, error) {
+	if !o.Shoot.UsesOutOfTreeCloudControllerManager() {
+		return values, nil
+	}
+	if values == nil {
+		values = map[string]interface{}{}
+	}
+	values["outOfTree"] = map[string]interface{}{
+		"image":   imageName,
+		"command": command,
+	}
+	return o.InjectSeedShootImages(values, imageName)
+}
+
+// ShootWebhookImage returns the image of the gardener-shoot-webhook. It is built together with Gardener, hence its
+// tag defaults to the version of the Gardener controller manager if the image vector does not specify one.
+func (o *Operation) ShootWebhookImage() (string, error) {
+	image, err := o.ImageVector.FindImage(common.ShootWebhookImageName, imagevector.RuntimeVersion(o.SeedVersion()))
+	if err != nil {
+		return "", err
+	}
+	if image.Tag == nil {
+		tag := version.Get().GitVersion
+		image.Tag = &tag
+	}
+	return image.String(), nil
+}
+
 // InjectShootShootImages injects images that shall run on the Shoot and target the Shoot's Kubernetes version.
 func (o *Operation) InjectShootShootImages(values map[string]interface{}, names ...string) (map[string]interface{}, error) {
 	return o.injectImages(values, names, imagevector.RuntimeVersion(o.ShootVersion()), imagevector.TargetVersion(o.ShootVersion()))
@@ -366,7 +447,41 @@ func (o *Operation) newTerraformer(purpose, namespace, name string) (*terraforme
 		return nil, err
 	}
 
-	return terraformer.NewForConfig(o.Logger, o.K8sSeedClient.RESTConfig(), purpose, namespace, name, image.String())
+	if version := o.pinnedTerraformerVersion(); version != nil {
+		image.Tag = version
+	}
+
+	tf, err := terraformer.NewForConfig(o.Logger, o.K8sSeedClient.RESTConfig(), purpose, namespace, name, image.String())
+	if err != nil {
+		return nil, err
+	}
+	tf.SetOperationID(logger.OperationID(o.Logger))
+	if image.Tag != nil {
+		if _, err := semver.NewVersion(*image.Tag); err == nil {
+			tf.SetVersion(*image.Tag)
+		}
+	}
+	return tf, nil
+}
+
+// pinnedTerraformerVersion returns the Terraformer version pinned in the settings of the Seed or, if not set there, in
+// the CloudProfile of the Shoot (or of the Seed if there is no Shoot). It returns nil if no version is pinned.
+func (o *Operation) pinnedTerraformerVersion() *string {
+	if o.Seed == nil {
+		return nil
+	}
+	if settings := o.Seed.Info.Spec.Settings; settings != nil && settings.Terraformer != nil {
+		return &settings.Terraformer.Version
+	}
+
+	cloudProfile := o.Seed.CloudProfile
+	if o.Shoot != nil {
+		cloudProfile = o.Shoot.CloudProfile
+	}
+	if cloudProfile != nil && cloudProfile.Spec.Terraformer != nil {
+		return &cloudProfile.Spec.Terraformer.Version
+	}
+	return nil
 }
 
 // NewBackupInfrastructureTerraformer creates a new Terraformer for the matching BackupInfrastructure.
@@ -381,9 +496,46 @@ func (o *Operation) NewBackupInfrastructureTerraformer() (*terraformer.Terraform
 	return o.newTerraformer(common.TerraformerPurposeBackup, common.GenerateBackupNamespaceName(backupInfrastructureName), backupInfrastructureName)
 }
 
-// NewShootTerraformer creates a new Terraformer for the current shoot with the given purpose.
+// BackupRegion returns the region of the backup bucket of the current BackupInfrastructure or of the one belonging
+// to the current Shoot. It defaults to the region of the Seed.
+func (o *Operation) BackupRegion() (string, error) {
+	backupInfrastructure := o.BackupInfrastructure
+	if backupInfrastructure == nil && o.Shoot != nil {
+		existing, err := o.K8sGardenClient.Garden().GardenV1beta1().BackupInfrastructures(o.Shoot.Info.Namespace).Get(common.GenerateBackupInfrastructureName(o.Shoot.SeedNamespace, o.Shoot.Info.Status.UID), metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return "", err
+		}
+		if err == nil {
+			backupInfrastructure = existing
+		}
+	}
+
+	if backupInfrastructure != nil && backupInfrastructure.Spec.Region != nil {
+		return *backupInfrastructure.Spec.Region, nil
+	}
+	return o.Seed.Info.Spec.Cloud.Region, nil
+}
+
+// NewShootTerraformer creates a new Terraformer for the current shoot with the given purpose. It uses the rate limiter
+// of the Shoot's cloud provider account.
+// Pre-existing resources listed in the infrastructure imports annotation of the Shoot are imported into the
+// state of the infrastructure Terraformer.
 func (o *Operation) NewShootTerraformer(purpose string) (*terraformer.Terraformer, error) {
-	return o.newTerraformer(purpose, o.Shoot.SeedNamespace, o.Shoot.Info.Name)
+	tf, err := o.newTerraformer(purpose, o.Shoot.SeedNamespace, o.Shoot.Info.Name)
+	if err != nil {
+		return nil, err
+	}
+	tf.SetRateLimiter(o.CloudAPIRateLimiter)
+
+	if value, ok := o.Shoot.Info.Annotations[common.ShootInfrastructureImports]; ok && purpose == common.TerraformerPurposeInfra {
+		imports, err := terraformer.ParseResourceImports(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid annotation %s: %v", common.ShootInfrastructureImports, err)
+		}
+		tf.SetResourceImports(imports)
+	}
+
+	return tf, nil
 }
 
 // ChartInitializer initializes a terraformer based on the given chart and values.
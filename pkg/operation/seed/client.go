@@ -0,0 +1,82 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed
+
+import (
+	"sync"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	localClientConfigs     = map[string]*rest.Config{}
+	localClientConfigsLock sync.RWMutex
+)
+
+// SetLocalClientConfig registers the <config> which is used to access the Seed cluster <seedName> instead of the
+// kubeconfig in the Seed secret, e.g. by a seed agent running inside of the Seed cluster. A nil <config> removes
+// the registration.
+func SetLocalClientConfig(seedName string, config *rest.Config) {
+	localClientConfigsLock.Lock()
+	defer localClientConfigsLock.Unlock()
+
+	if config == nil {
+		delete(localClientConfigs, seedName)
+		return
+	}
+	localClientConfigs[seedName] = config
+}
+
+func localClientConfig(seedName string) *rest.Config {
+	localClientConfigsLock.RLock()
+	defer localClientConfigsLock.RUnlock()
+	return localClientConfigs[seedName]
+}
+
+// NewClientFromSecret creates a Kubernetes client for the Seed cluster <seedName>. It uses the local client config
+// of the Seed if one has been registered and the kubeconfig in the Seed <secret> otherwise.
+func NewClientFromSecret(seedName string, secret *corev1.Secret) (kubernetes.Interface, error) {
+	opts := client.Options{
+		Scheme: kubernetes.SeedScheme,
+	}
+
+	if config := localClientConfig(seedName); config != nil {
+		return kubernetes.NewForConfig(rest.CopyConfig(config), opts)
+	}
+	return kubernetes.NewClientFromSecretObject(secret, opts)
+}
+
+// NewClient creates a Kubernetes client for the given <seed>. The Seed secret is only read from the Garden cluster
+// if no local client config has been registered for the Seed.
+func NewClient(k8sGardenClient kubernetes.Interface, seed *gardenv1beta1.Seed) (kubernetes.Interface, error) {
+	if localClientConfig(seed.Name) != nil {
+		return NewClientFromSecret(seed.Name, nil)
+	}
+
+	secret, err := k8sGardenClient.GetSecret(seed.Spec.SecretRef.Namespace, seed.Spec.SecretRef.Name)
+	if err != nil {
+		return nil, err
+	}
+	return NewClientFromSecret(seed.Name, secret)
+}
+
+// NewClient creates a Kubernetes client for the Seed cluster.
+func (s *Seed) NewClient() (kubernetes.Interface, error) {
+	return NewClientFromSecret(s.Info.Name, s.Secret)
+}
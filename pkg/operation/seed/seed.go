@@ -45,8 +45,6 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/client-go/util/retry"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -86,6 +84,21 @@ func New(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformer
 	}
 	seedObj.CloudProvider = cloudProvider
 
+	seedObj.BackupProvider = cloudProvider
+	seedObj.BackupSecret = secret
+	if backup := seed.Spec.Backup; backup != nil {
+		if backup.Provider != nil {
+			seedObj.BackupProvider = *backup.Provider
+		}
+		if backup.SecretRef != nil {
+			backupSecret, err := k8sGardenClient.GetSecret(backup.SecretRef.Namespace, backup.SecretRef.Name)
+			if err != nil {
+				return nil, err
+			}
+			seedObj.BackupSecret = backupSecret
+		}
+	}
+
 	return seedObj, nil
 }
 
@@ -154,8 +167,8 @@ func generateWantedSecrets(seed *Seed, certificateAuthorities map[string]*utilse
 		)
 	}
 
-	// VPA feature gate
-	if controllermanagerfeatures.FeatureGate.Enabled(features.VPA) {
+	// Vertical pod autoscaler
+	if seed.VerticalPodAutoscalerEnabled() {
 		secretList = append(secretList,
 			&utilsecrets.CertificateSecretConfig{
 				Name: "vpa-tls-certs",
@@ -195,9 +208,7 @@ func BootstrapCluster(seed *Seed, secrets map[string]*corev1.Secret, imageVector
 	const chartName = "seed-bootstrap"
 	var existingSecretsMap = map[string]*corev1.Secret{}
 
-	k8sSeedClient, err := kubernetes.NewClientFromSecretObject(seed.Secret, client.Options{
-		Scheme: kubernetes.SeedScheme,
-	})
+	k8sSeedClient, err := seed.NewClient()
 	if err != nil {
 		return err
 	}
@@ -210,17 +221,14 @@ func BootstrapCluster(seed *Seed, secrets map[string]*corev1.Secret, imageVector
 		return err
 	}
 
-	if _, err := kutils.TryUpdateNamespace(k8sSeedClient.Kubernetes(), retry.DefaultBackoff, gardenNamespace.ObjectMeta, func(ns *corev1.Namespace) (*corev1.Namespace, error) {
-		kutils.SetMetaDataLabel(&ns.ObjectMeta, "role", common.GardenNamespace)
-		return ns, nil
-	}); err != nil {
-		return err
-	}
-	if _, err := kutils.TryUpdateNamespace(k8sSeedClient.Kubernetes(), retry.DefaultBackoff, metav1.ObjectMeta{Name: metav1.NamespaceSystem}, func(ns *corev1.Namespace) (*corev1.Namespace, error) {
-		kutils.SetMetaDataLabel(&ns.ObjectMeta, "role", metav1.NamespaceSystem)
-		return ns, nil
-	}); err != nil {
-		return err
+	for _, name := range []string{common.GardenNamespace, metav1.NamespaceSystem} {
+		namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if err := kutils.TryUpdate(context.TODO(), kutils.DefaultBackoff, k8sSeedClient.Client(), namespace, func() error {
+			kutils.SetMetaDataLabel(&namespace.ObjectMeta, "role", name)
+			return nil
+		}); err != nil {
+			return err
+		}
 	}
 
 	images, err := imagevector.FindImages(imageVector,
@@ -308,9 +316,9 @@ func BootstrapCluster(seed *Seed, secrets map[string]*corev1.Secret, imageVector
 		}
 	}
 
-	// VPA feature gate
+	// Vertical pod autoscaler
 	var (
-		vpaEnabled        = controllermanagerfeatures.FeatureGate.Enabled(features.VPA)
+		vpaEnabled        = seed.VerticalPodAutoscalerEnabled()
 		vpaPodAnnotations map[string]interface{}
 	)
 
@@ -405,14 +413,14 @@ func BootstrapCluster(seed *Seed, secrets map[string]*corev1.Secret, imageVector
 	applierOptions.MergeFuncs[vpaGK] = retainStatusInformation
 	applierOptions.MergeFuncs[issuerGK] = retainStatusInformation
 
-	return chartApplier.ApplyChartWithOptions(context.TODO(), filepath.Join("charts", chartName), common.GardenNamespace, chartName, nil, map[string]interface{}{
+	values := map[string]interface{}{
 		"cloudProvider": seed.CloudProvider,
 		"global": map[string]interface{}{
 			"images": chart.ImageMapToValues(images),
 		},
-		"reserveExcessCapacity": seed.reserveExcessCapacity,
+		"reserveExcessCapacity": seed.excessCapacityReservationEnabled(),
 		"replicas": map[string]interface{}{
-			"reserve-excess-capacity": DesiredExcessCapacity(numberOfAssociatedShoots),
+			"reserve-excess-capacity": seed.GetExcessCapacityReservationReplicas(numberOfAssociatedShoots),
 		},
 		"prometheus": map[string]interface{}{
 			"objectCount": nodeCount,
@@ -451,7 +459,14 @@ func BootstrapCluster(seed *Seed, secrets map[string]*corev1.Secret, imageVector
 			"enabled":        vpaEnabled,
 			"podAnnotations": vpaPodAnnotations,
 		},
-	}, applierOptions)
+	}
+
+	// Only override the chart defaults if resources are configured for the Seed, a nil value would remove them.
+	if resources := seed.GetExcessCapacityReservationResources(); len(resources) > 0 {
+		values["reserveExcessCapacityResources"] = resources
+	}
+
+	return chartApplier.ApplyChartWithOptions(context.TODO(), filepath.Join("charts", chartName), common.GardenNamespace, chartName, nil, values, applierOptions)
 }
 
 func createClusterIssuer(k8sSeedclient kubernetes.Interface, certificateManagement *corev1.Secret) (map[string]interface{}, error) {
@@ -580,14 +595,53 @@ func GetFluentdReplicaCount(k8sSeedClient kubernetes.Interface) (int32, error) {
 }
 
 // GetIngressFQDN returns the fully qualified domain name of ingress sub-resource for the Seed cluster. The
-// end result is '<subDomain>.<shootName>.<projectName>.<seed-ingress-domain>'.
+// end result is '<subDomain>.<shootName>.<projectName>.<seed-ingress-domain>'. If the Seed uses a wildcard
+// certificate for its ingresses, the end result is '<subDomain>--<shootName>--<projectName>.<seed-ingress-domain>'
+// so that the host is covered by the certificate.
 func (s *Seed) GetIngressFQDN(subDomain, shootName, projectName string) string {
+	if s.UsesWildcardIngressCertificate() {
+		if shootName == "" {
+			return fmt.Sprintf("%s--%s.%s", subDomain, projectName, s.Info.Spec.IngressDomain)
+		}
+		return fmt.Sprintf("%s--%s--%s.%s", subDomain, shootName, projectName, s.Info.Spec.IngressDomain)
+	}
 	if shootName == "" {
 		return fmt.Sprintf("%s.%s.%s", subDomain, projectName, s.Info.Spec.IngressDomain)
 	}
 	return fmt.Sprintf("%s.%s.%s.%s", subDomain, shootName, projectName, s.Info.Spec.IngressDomain)
 }
 
+// UsesWildcardIngressCertificate returns true if the ingresses in the Shoot namespaces of the Seed cluster use
+// a wildcard certificate provided by the operator.
+func (s *Seed) UsesWildcardIngressCertificate() bool {
+	return s.Info.Spec.IngressTLS != nil && s.Info.Spec.IngressTLS.SecretRef != nil
+}
+
+// UsesACMEIngressCertificates returns true if the certificates of the ingresses in the Shoot namespaces of the
+// Seed cluster are issued via ACME.
+func (s *Seed) UsesACMEIngressCertificates() bool {
+	return s.Info.Spec.IngressTLS != nil && s.Info.Spec.IngressTLS.ACME != nil
+}
+
+// GetIngressTLSValues returns the chart values configuring the TLS certificate of the ingress of the given component
+// in a Shoot namespace. By default, the self-signed certificate '<component>-tls' generated by Gardener is used.
+func (s *Seed) GetIngressTLSValues(component string) map[string]interface{} {
+	switch {
+	case s.UsesWildcardIngressCertificate():
+		return map[string]interface{}{
+			"tlsSecretName": common.IngressWildcardSecretName,
+		}
+	case s.UsesACMEIngressCertificates():
+		return map[string]interface{}{
+			"tlsSecretName": fmt.Sprintf("%s-acme-tls", component),
+			"clusterIssuer": s.Info.Spec.IngressTLS.ACME.ClusterIssuer,
+		}
+	}
+	return map[string]interface{}{
+		"tlsSecretName": fmt.Sprintf("%s-tls", component),
+	}
+}
+
 // CheckMinimumK8SVersion checks whether the Kubernetes version of the Seed cluster fulfills the minimal requirements.
 func (s *Seed) CheckMinimumK8SVersion() error {
 	// We require CRD status subresources for the extension controllers that we install into the seeds.
@@ -596,14 +650,12 @@ func (s *Seed) CheckMinimumK8SVersion() error {
 	// this case.
 	minSeedVersion := "1.10"
 
-	k8sSeedClient, err := kubernetes.NewClientFromSecretObject(s.Secret, client.Options{
-		Scheme: kubernetes.SeedScheme,
-	})
+	seedVersion, err := s.GetK8SVersion()
 	if err != nil {
 		return err
 	}
 
-	seedVersionOK, err := utils.CompareVersions(k8sSeedClient.Version(), ">=", minSeedVersion)
+	seedVersionOK, err := utils.CompareVersions(seedVersion, ">=", minSeedVersion)
 	if err != nil {
 		return err
 	}
@@ -613,11 +665,79 @@ func (s *Seed) CheckMinimumK8SVersion() error {
 	return nil
 }
 
+// GetK8SVersion returns the Kubernetes version of the Seed cluster.
+func (s *Seed) GetK8SVersion() (string, error) {
+	k8sSeedClient, err := s.NewClient()
+	if err != nil {
+		return "", err
+	}
+	return k8sSeedClient.Version(), nil
+}
+
 // MustReserveExcessCapacity configures whether we have to reserve excess capacity in the Seed cluster.
 func (s *Seed) MustReserveExcessCapacity(must bool) {
 	s.reserveExcessCapacity = must
 }
 
+// excessCapacityReservationEnabled returns true if excess capacity has to be reserved in the Seed cluster. The setting of
+// the Seed takes precedence over the configuration of the Gardener controller manager.
+func (s *Seed) excessCapacityReservationEnabled() bool {
+	if settings := s.Info.Spec.Settings; settings != nil && settings.ExcessCapacityReservation != nil {
+		return settings.ExcessCapacityReservation.Enabled
+	}
+	return s.reserveExcessCapacity
+}
+
+// GetExcessCapacityReservationReplicas returns the number of placeholder pods reserving excess capacity in the Seed
+// cluster. The replicas configured for the Seed take precedence over the computed desired excess capacity.
+func (s *Seed) GetExcessCapacityReservationReplicas(numberOfAssociatedShoots int) int {
+	if settings := s.Info.Spec.Settings; settings != nil && settings.ExcessCapacityReservation != nil && settings.ExcessCapacityReservation.Replicas != nil {
+		return int(*settings.ExcessCapacityReservation.Replicas)
+	}
+	return DesiredExcessCapacity(numberOfAssociatedShoots)
+}
+
+// GetExcessCapacityReservationResources returns the chart values for the resources reserved by every placeholder pod
+// in the Seed cluster, or nil if the chart defaults shall be used.
+func (s *Seed) GetExcessCapacityReservationResources() map[string]interface{} {
+	settings := s.Info.Spec.Settings
+	if settings == nil || settings.ExcessCapacityReservation == nil || len(settings.ExcessCapacityReservation.Resources) == 0 {
+		return nil
+	}
+
+	resources := make(map[string]interface{}, len(settings.ExcessCapacityReservation.Resources))
+	for name, quantity := range settings.ExcessCapacityReservation.Resources {
+		resources[string(name)] = quantity.String()
+	}
+	return resources
+}
+
+// VerticalPodAutoscalerEnabled returns true if the vertical pod autoscaler is deployed into the Seed cluster. The
+// setting of the Seed takes precedence over the VPA feature gate.
+func (s *Seed) VerticalPodAutoscalerEnabled() bool {
+	if settings := s.Info.Spec.Settings; settings != nil && settings.VerticalPodAutoscaler != nil {
+		return settings.VerticalPodAutoscaler.Enabled
+	}
+	return controllermanagerfeatures.FeatureGate.Enabled(features.VPA)
+}
+
+// ShootDNSEnabled returns true if the DNS records of the Shoots in the Seed cluster are managed by Gardener.
+func (s *Seed) ShootDNSEnabled() bool {
+	if settings := s.Info.Spec.Settings; settings != nil && settings.ShootDNS != nil {
+		return settings.ShootDNS.Enabled
+	}
+	return true
+}
+
+// GetLoadBalancerServiceAnnotations returns the annotations which have to be added to the services of type
+// LoadBalancer in the Seed cluster.
+func (s *Seed) GetLoadBalancerServiceAnnotations() map[string]string {
+	if settings := s.Info.Spec.Settings; settings != nil && settings.LoadBalancerServices != nil {
+		return settings.LoadBalancerServices.Annotations
+	}
+	return nil
+}
+
 // GetValidVolumeSize is to get a valid volume size.
 // If the given size is smaller than the minimum volume size permitted by cloud provider on which seed cluster is running, it will return the minimum size.
 func (s *Seed) GetValidVolumeSize(size string) string {
@@ -637,3 +757,12 @@ func (s *Seed) GetValidVolumeSize(size string) string {
 
 	return size
 }
+
+// GetBackupRegion returns the region in which the backup buckets of the Shoots hosted by the Seed are created. It
+// defaults to the region of the Seed.
+func (s *Seed) GetBackupRegion() string {
+	if backup := s.Info.Spec.Backup; backup != nil && backup.Region != nil {
+		return *backup.Region
+	}
+	return s.Info.Spec.Cloud.Region
+}
@@ -17,12 +17,17 @@ package seed_test
 import (
 	"context"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
 	mock "github.com/gardener/gardener/pkg/mock/gardener/kubernetes"
 	. "github.com/gardener/gardener/pkg/operation/seed"
 	"github.com/golang/mock/gomock"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	. "github.com/onsi/ginkgo"
@@ -46,6 +51,89 @@ var _ = Describe("seed", func() {
 		ctrl.Finish()
 	})
 
+	Describe("#GetIngressFQDN", func() {
+		var seed *Seed
+
+		BeforeEach(func() {
+			seed = &Seed{
+				Info: &gardenv1beta1.Seed{
+					Spec: gardenv1beta1.SeedSpec{IngressDomain: "ingress.seed.example.com"},
+				},
+			}
+		})
+
+		It("should return a host below the shoot and project domain", func() {
+			Expect(seed.GetIngressFQDN("g", "shoot", "project")).To(Equal("g.shoot.project.ingress.seed.example.com"))
+			Expect(seed.GetIngressFQDN("g", "", "project")).To(Equal("g.project.ingress.seed.example.com"))
+		})
+
+		It("should return a flat host if a wildcard certificate is used", func() {
+			seed.Info.Spec.IngressTLS = &gardenv1beta1.SeedIngressTLS{
+				SecretRef: &corev1.SecretReference{Name: "wildcard", Namespace: "garden"},
+			}
+
+			Expect(seed.GetIngressFQDN("g", "shoot", "project")).To(Equal("g--shoot--project.ingress.seed.example.com"))
+			Expect(seed.GetIngressFQDN("g", "", "project")).To(Equal("g--project.ingress.seed.example.com"))
+		})
+	})
+
+	Describe("#GetExcessCapacityReservationReplicas", func() {
+		It("should return the desired excess capacity if no replicas are configured", func() {
+			seed := &Seed{Info: &gardenv1beta1.Seed{}}
+
+			Expect(seed.GetExcessCapacityReservationReplicas(200)).To(Equal(DesiredExcessCapacity(200)))
+		})
+
+		It("should return the configured replicas", func() {
+			replicas := int32(3)
+			seed := &Seed{
+				Info: &gardenv1beta1.Seed{
+					Spec: gardenv1beta1.SeedSpec{
+						Settings: &gardenv1beta1.SeedSettings{
+							ExcessCapacityReservation: &gardenv1beta1.SeedSettingExcessCapacityReservation{
+								Enabled:  true,
+								Replicas: &replicas,
+							},
+						},
+					},
+				},
+			}
+
+			Expect(seed.GetExcessCapacityReservationReplicas(200)).To(Equal(3))
+		})
+	})
+
+	Describe("#GetExcessCapacityReservationResources", func() {
+		It("should return nil if no resources are configured", func() {
+			seed := &Seed{Info: &gardenv1beta1.Seed{}}
+
+			Expect(seed.GetExcessCapacityReservationResources()).To(BeNil())
+		})
+
+		It("should return the configured resources as chart values", func() {
+			seed := &Seed{
+				Info: &gardenv1beta1.Seed{
+					Spec: gardenv1beta1.SeedSpec{
+						Settings: &gardenv1beta1.SeedSettings{
+							ExcessCapacityReservation: &gardenv1beta1.SeedSettingExcessCapacityReservation{
+								Enabled: true,
+								Resources: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("1"),
+									corev1.ResourceMemory: resource.MustParse("2Gi"),
+								},
+							},
+						},
+					},
+				},
+			}
+
+			Expect(seed.GetExcessCapacityReservationResources()).To(Equal(map[string]interface{}{
+				"cpu":    "1",
+				"memory": "2Gi",
+			}))
+		})
+	})
+
 	Describe("#GetFluentdReplicaCount", func() {
 		It("should return single replica when stateful set does not exist", func() {
 			restMockClient.EXPECT().Client().Return(runtimeClient)
@@ -74,4 +162,47 @@ var _ = Describe("seed", func() {
 			Expect(replicas).To(Equal(expectedReplicas))
 		})
 	})
+
+	Describe("#NewClient", func() {
+		var seed *gardenv1beta1.Seed
+
+		BeforeEach(func() {
+			seed = &gardenv1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: "seed"},
+				Spec: gardenv1beta1.SeedSpec{
+					SecretRef: corev1.SecretReference{Namespace: "garden", Name: "seed-secret"},
+				},
+			}
+		})
+
+		AfterEach(func() {
+			SetLocalClientConfig(seed.Name, nil)
+		})
+
+		It("should use the kubeconfig of the Seed secret", func() {
+			restMockClient.EXPECT().GetSecret("garden", "seed-secret").Return(&corev1.Secret{}, nil)
+
+			_, err := NewClient(restMockClient, seed)
+
+			Expect(err).To(MatchError(ContainSubstring("'kubeconfig'")))
+		})
+
+		It("should not read the Seed secret if a local client config is registered", func() {
+			SetLocalClientConfig(seed.Name, &rest.Config{Host: "http://127.0.0.1:0"})
+
+			_, err := NewClient(restMockClient, seed)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err).NotTo(MatchError(ContainSubstring("'kubeconfig'")))
+		})
+
+		It("should ignore local client configs of other Seeds", func() {
+			SetLocalClientConfig("other-seed", &rest.Config{Host: "http://127.0.0.1:0"})
+			defer SetLocalClientConfig("other-seed", nil)
+
+			_, err := (&Seed{Info: seed, Secret: &corev1.Secret{}}).NewClient()
+
+			Expect(err).To(MatchError(ContainSubstring("'kubeconfig'")))
+		})
+	})
 })
@@ -25,5 +25,7 @@ type Seed struct {
 	Secret                *corev1.Secret
 	CloudProvider         gardenv1beta1.CloudProvider
 	CloudProfile          *gardenv1beta1.CloudProfile
+	BackupProvider        gardenv1beta1.CloudProvider
+	BackupSecret          *corev1.Secret
 	reserveExcessCapacity bool
 }
@@ -0,0 +1,74 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"strconv"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+)
+
+// internalLoadBalancerAnnotations contains the annotations per cloud provider which make the load balancer of a service
+// only reachable from within the network of the Shoot.
+var internalLoadBalancerAnnotations = map[gardenv1beta1.CloudProvider]map[string]string{
+	gardenv1beta1.CloudProviderAWS:       {"service.beta.kubernetes.io/aws-load-balancer-internal": "true"},
+	gardenv1beta1.CloudProviderAzure:     {"service.beta.kubernetes.io/azure-load-balancer-internal": "true"},
+	gardenv1beta1.CloudProviderGCP:       {"cloud.google.com/load-balancer-type": "Internal"},
+	gardenv1beta1.CloudProviderOpenStack: {"service.beta.kubernetes.io/openstack-internal-load-balancer": "true"},
+	gardenv1beta1.CloudProviderAlicloud:  {"service.beta.kubernetes.io/alicloud-loadbalancer-address-type": "intranet"},
+}
+
+// GetServiceLoadBalancerAnnotations returns the annotations which are added to services of type LoadBalancer in the
+// Shoot cluster when they are created. They are computed from the provider independent and the cloud provider
+// specific defaults of the Shoot specification; additional annotations take precedence over the computed ones.
+func (s *Shoot) GetServiceLoadBalancerAnnotations() map[string]string {
+	var (
+		cloud       = s.Info.Spec.Cloud
+		annotations = map[string]string{}
+	)
+
+	if loadBalancer := cloud.ServiceLoadBalancer; loadBalancer != nil && loadBalancer.Internal != nil && *loadBalancer.Internal {
+		for key, value := range internalLoadBalancerAnnotations[s.CloudProvider] {
+			annotations[key] = value
+		}
+	}
+
+	switch {
+	case cloud.AWS != nil && cloud.AWS.ServiceLoadBalancer != nil:
+		if loadBalancerType := cloud.AWS.ServiceLoadBalancer.Type; loadBalancerType != nil && *loadBalancerType == "nlb" {
+			annotations["service.beta.kubernetes.io/aws-load-balancer-type"] = "nlb"
+		}
+	case cloud.Alicloud != nil && cloud.Alicloud.ServiceLoadBalancer != nil:
+		loadBalancer := cloud.Alicloud.ServiceLoadBalancer
+		if loadBalancer.Spec != nil {
+			annotations["service.beta.kubernetes.io/alicloud-loadbalancer-spec"] = *loadBalancer.Spec
+		}
+		if loadBalancer.AddressType != nil {
+			annotations["service.beta.kubernetes.io/alicloud-loadbalancer-address-type"] = *loadBalancer.AddressType
+		}
+		if loadBalancer.Bandwidth != nil {
+			annotations["service.beta.kubernetes.io/alicloud-loadbalancer-charge-type"] = "paybybandwidth"
+			annotations["service.beta.kubernetes.io/alicloud-loadbalancer-bandwidth"] = strconv.Itoa(int(*loadBalancer.Bandwidth))
+		}
+	}
+
+	if loadBalancer := cloud.ServiceLoadBalancer; loadBalancer != nil {
+		for key, value := range loadBalancer.Annotations {
+			annotations[key] = value
+		}
+	}
+
+	return annotations
+}
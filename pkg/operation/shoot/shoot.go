@@ -16,7 +16,10 @@ package shoot
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net"
+	"path"
 	"strings"
 
 	"github.com/Masterminds/semver"
@@ -26,18 +29,26 @@ import (
 	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/client/vault"
+	controllermanagerfeatures "github.com/gardener/gardener/pkg/controllermanager/features"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/operation/garden"
 	"github.com/gardener/gardener/pkg/utils"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // New takes a <k8sGardenClient>, the <k8sGardenInformers> and a <shoot> manifest, and creates a new Shoot representation.
 // It will add the CloudProfile, the cloud provider secret, compute the internal cluster domain and identify the cloud provider.
+// If the secret binding of the Shoot references Vault the cloud provider secret is empty, the credentials must be read
+// with ReadVaultCredentials by the operations which need them.
 func New(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.Interface, shoot *gardenv1beta1.Shoot, projectName, internalDomain string, defaultDomains []*garden.DefaultDomain) (*Shoot, error) {
 	var (
 		secret *corev1.Secret
@@ -49,13 +60,14 @@ func New(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformer
 		return nil, err
 	}
 
-	binding, err := k8sGardenInformers.SecretBindings().Lister().SecretBindings(shoot.Namespace).Get(shoot.Spec.Cloud.SecretBindingRef.Name)
+	// A Shoot which is force-deleted may reference a secret binding or cloud provider secret which does no longer
+	// exist. In this case an empty secret is used as the credentials will not be needed anymore.
+	secret, err = getCloudProviderSecret(k8sGardenClient, k8sGardenInformers, shoot)
 	if err != nil {
-		return nil, err
-	}
-	secret, err = k8sGardenClient.GetSecret(binding.SecretRef.Namespace, binding.SecretRef.Name)
-	if err != nil {
-		return nil, err
+		if !apierrors.IsNotFound(err) || !helper.ShootWantsForceDeletion(shoot) {
+			return nil, err
+		}
+		secret = &corev1.Secret{}
 	}
 
 	shootObj := &Shoot{
@@ -70,6 +82,7 @@ func New(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformer
 
 		IsHibernated:           helper.IsShootHibernated(shoot),
 		WantsClusterAutoscaler: false,
+		WantsMonitoring:        helper.ShootWantsMonitoring(shoot),
 	}
 	shootObj.CloudConfigMap = make(map[string]CloudConfig, len(shootObj.GetWorkerNames()))
 
@@ -128,6 +141,16 @@ func (s *Shoot) GetWorkerNames() []string {
 	return workerNames
 }
 
+// GetArchitectures returns the sorted list of CPU architectures of the worker groups in the Shoot manifest. It
+// always contains amd64.
+func (s *Shoot) GetArchitectures() []string {
+	architectures := sets.NewString(gardenv1beta1.ArchitectureAMD64)
+	for _, worker := range s.GetWorkers() {
+		architectures.Insert(helper.WorkerArchitecture(worker))
+	}
+	return architectures.List()
+}
+
 // GetNodeCount returns the sum of all 'autoScalerMax' fields of all worker groups of the Shoot.
 func (s *Shoot) GetNodeCount() int {
 	nodeCount := 0
@@ -210,6 +233,138 @@ func (s *Shoot) NginxIngressEnabled() bool {
 	return s.Info.Spec.Addons != nil && s.Info.Spec.Addons.NginxIngress != nil && s.Info.Spec.Addons.NginxIngress.Enabled
 }
 
+// GetKubeAPIServerSourceRanges returns the source ranges of the kube-apiserver load balancer. They consist of the
+// allowed CIDRs of the Shoot manifest, the node network of the Shoot, the node and pod networks of the Seed which
+// hosts the control plane, and the static egress IPs of the worker nodes, so that the kubelets and the control plane
+// components are still able to reach the kube-apiserver. An empty list is returned if no CIDRs are configured.
+func (s *Shoot) GetKubeAPIServerSourceRanges(seedNetworks gardenv1beta1.SeedNetworks) []string {
+	kubeAPIServer := s.Info.Spec.Kubernetes.KubeAPIServer
+	if kubeAPIServer == nil || len(kubeAPIServer.AllowedCIDRs) == 0 {
+		return nil
+	}
+
+	sourceRanges := make([]string, 0, len(kubeAPIServer.AllowedCIDRs)+3+len(s.Info.Status.EgressIPs))
+	for _, cidr := range kubeAPIServer.AllowedCIDRs {
+		sourceRanges = append(sourceRanges, string(cidr))
+	}
+	if k8sNetworks := s.GetK8SNetworks(); k8sNetworks != nil && k8sNetworks.Nodes != nil {
+		sourceRanges = append(sourceRanges, string(*k8sNetworks.Nodes))
+	}
+	for _, cidr := range []gardencorev1alpha1.CIDR{seedNetworks.Nodes, seedNetworks.Pods} {
+		if len(cidr) > 0 {
+			sourceRanges = append(sourceRanges, string(cidr))
+		}
+	}
+	for _, egressIP := range s.Info.Status.EgressIPs {
+		ip := net.ParseIP(egressIP)
+		switch {
+		case ip == nil:
+		case ip.To4() != nil:
+			sourceRanges = append(sourceRanges, egressIP+"/32")
+		default:
+			sourceRanges = append(sourceRanges, egressIP+"/128")
+		}
+	}
+	return sourceRanges
+}
+
+func getCloudProviderSecret(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.Interface, shoot *gardenv1beta1.Shoot) (*corev1.Secret, error) {
+	binding, err := k8sGardenInformers.SecretBindings().Lister().SecretBindings(shoot.Namespace).Get(shoot.Spec.Cloud.SecretBindingRef.Name)
+	if err != nil {
+		return nil, err
+	}
+	if binding.VaultRef != nil {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: binding.Name, Namespace: binding.Namespace}}, nil
+	}
+	secretRef := helper.SecretBindingActiveSecretRef(binding)
+	return k8sGardenClient.GetSecret(secretRef.Namespace, secretRef.Name)
+}
+
+// ReadVaultCredentials reads the cloud provider credentials of the Shoot from the given <vaultClient> if its secret
+// binding references Vault. Every read may return new dynamic credentials, hence it must only be called by the
+// operations which deploy them, i.e. the reconciliation and the deletion of the Shoot.
+func (s *Shoot) ReadVaultCredentials(k8sGardenInformers gardeninformers.Interface, vaultClient vault.ClientInterface) error {
+	binding, err := k8sGardenInformers.SecretBindings().Lister().SecretBindings(s.Info.Namespace).Get(s.Info.Spec.Cloud.SecretBindingRef.Name)
+	if err != nil {
+		if apierrors.IsNotFound(err) && helper.ShootWantsForceDeletion(s.Info) {
+			return nil
+		}
+		return err
+	}
+	if binding.VaultRef == nil {
+		return nil
+	}
+
+	secret, err := ReadCloudProviderSecretFromVault(vaultClient, binding, VaultKey(s.Info))
+	if err != nil {
+		return err
+	}
+	s.Secret = secret
+	return nil
+}
+
+// VaultKey returns the key for which the Vault credentials of the given Shoot are issued.
+func VaultKey(shoot *gardenv1beta1.Shoot) string {
+	return fmt.Sprintf("%s/%s", shoot.Namespace, shoot.Name)
+}
+
+// ReadCloudProviderSecretFromVault reads the cloud provider credentials of the given <binding> issued for the given
+// <key> from Vault and returns them as secret. The path of the credentials is confined to the Vault path of the
+// binding's namespace. The keys of the Vault data are renamed according to the key mapping of the binding, non-string
+// values are encoded as JSON.
+func ReadCloudProviderSecretFromVault(vaultClient vault.ClientInterface, binding *gardenv1beta1.SecretBinding, key string) (*corev1.Secret, error) {
+	if vaultClient == nil {
+		return nil, fmt.Errorf("secret binding %s/%s references Vault but no Vault is configured", binding.Namespace, binding.Name)
+	}
+
+	vaultPath := path.Join(vaultClient.PathPrefix(), binding.Namespace, binding.VaultRef.Path)
+	vaultData, err := vaultClient.Read(key, vaultPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not read credentials of secret binding %s/%s from Vault: %v", binding.Namespace, binding.Name, err)
+	}
+
+	mappedVaultKeys := sets.NewString()
+	for _, vaultKey := range binding.VaultRef.KeyMapping {
+		if _, ok := vaultData[vaultKey]; !ok {
+			return nil, fmt.Errorf("credentials of secret binding %s/%s read from Vault do not contain key %q", binding.Namespace, binding.Name, vaultKey)
+		}
+		mappedVaultKeys.Insert(vaultKey)
+	}
+
+	// vaultKeys maps the keys of the secret to the keys of the Vault data.
+	vaultKeys := make(map[string]string, len(vaultData))
+	for vaultKey := range vaultData {
+		if !mappedVaultKeys.Has(vaultKey) {
+			vaultKeys[vaultKey] = vaultKey
+		}
+	}
+	for key, vaultKey := range binding.VaultRef.KeyMapping {
+		vaultKeys[key] = vaultKey
+	}
+
+	data := make(map[string][]byte, len(vaultKeys))
+	for key, vaultKey := range vaultKeys {
+		switch value := vaultData[vaultKey].(type) {
+		case string:
+			data[key] = []byte(value)
+		default:
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				return nil, err
+			}
+			data[key] = encoded
+		}
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      binding.Name,
+			Namespace: binding.Namespace,
+		},
+		Data: data,
+	}, nil
+}
+
 // ComputeCloudConfigSecretName computes the name for a secret which contains the original cloud config for
 // the worker group with the given <workerName>. It is build by the cloud config secret prefix, the worker
 // name itself and a hash of the minor Kubernetes version of the Shoot cluster.
@@ -217,6 +372,15 @@ func (s *Shoot) ComputeCloudConfigSecretName(workerName string) string {
 	return fmt.Sprintf("%s-%s-%s", common.CloudConfigPrefix, workerName, utils.ComputeSHA256Hex([]byte(s.KubernetesMajorMinorVersion))[:5])
 }
 
+// GetPrometheusScrapeTargets returns the allowlist of scrape jobs of the Shoot's Prometheus. An empty list means
+// that all jobs are scraped.
+func (s *Shoot) GetPrometheusScrapeTargets() []string {
+	if monitoring := s.Info.Spec.Monitoring; monitoring != nil {
+		return monitoring.ScrapeTargets
+	}
+	return nil
+}
+
 // GetReplicas returns the given <wokenUp> number if the shoot is not hibernated, or zero otherwise.
 func (s *Shoot) GetReplicas(wokenUp int) int {
 	if s.IsHibernated {
@@ -230,14 +394,57 @@ func (s *Shoot) UsesCSI() bool {
 	return s.CloudProvider == gardenv1beta1.CloudProviderAlicloud
 }
 
+// UsesKubeletServingCertificates returns true if the kubelets of the Shoot request their serving certificates from the
+// cluster CA (serverTLSBootstrap). This requires at least Kubernetes 1.12 and is enabled for all Shoots which carry the
+// common.ShootKubeletServingCertificates annotation.
+func (s *Shoot) UsesKubeletServingCertificates() bool {
+	switch s.Info.Annotations[common.ShootKubeletServingCertificates] {
+	case "true", common.ShootKubeletServingCertificatesRollingOut:
+	default:
+		return false
+	}
+	atLeast112, err := utils.CompareVersions(s.Info.Spec.Kubernetes.Version, ">=", "1.12")
+	return err == nil && atLeast112
+}
+
+// VerifiesKubeletServingCertificates returns true if the kube-apiserver of the Shoot verifies the serving certificates
+// of the kubelets. This is only the case once all nodes of the Shoot have received a serving certificate signed by the
+// cluster CA, see common.ShootKubeletServingCertificates.
+func (s *Shoot) VerifiesKubeletServingCertificates() bool {
+	return s.UsesKubeletServingCertificates() && s.Info.Annotations[common.ShootKubeletServingCertificates] == "true"
+}
+
+// UsesOutOfTreeCloudControllerManager returns true if the cloud-controller-manager of the Shoot is run from the
+// out-of-tree implementation of its cloud provider instead of the in-tree one contained in the hyperkube image.
+// For OpenStack and Azure, this is controlled by the OutOfTreeCloudControllerManager feature gate and requires at
+// least Kubernetes 1.13.
+func (s *Shoot) UsesOutOfTreeCloudControllerManager() bool {
+	switch s.CloudProvider {
+	case gardenv1beta1.CloudProviderAlicloud:
+		return true
+	case gardenv1beta1.CloudProviderOpenStack, gardenv1beta1.CloudProviderAzure:
+		if !controllermanagerfeatures.FeatureGate.Enabled(features.OutOfTreeCloudControllerManager) {
+			return false
+		}
+		atLeast113, err := utils.CompareVersions(s.Info.Spec.Kubernetes.Version, ">=", "1.13")
+		return err == nil && atLeast113
+	}
+	return false
+}
+
 // ComputeAPIServerURL takes a boolean value identifying whether the component connecting to the API server
 // runs in the Seed cluster <runsInSeed>, and a boolean value <useInternalClusterDomain> which determines whether the
-// internal or the external cluster domain should be used.
+// internal or the external cluster domain should be used. If the DNS records of the Shoot are not managed then the
+// address of the kube-apiserver's load balancer is used.
 func (s *Shoot) ComputeAPIServerURL(runsInSeed, useInternalClusterDomain bool) string {
 	if runsInSeed {
 		return common.KubeAPIServerDeploymentName
 	}
 
+	if s.DisableDNS && len(s.LoadBalancerIngress) > 0 {
+		return s.LoadBalancerIngress
+	}
+
 	if dnsProvider := s.Info.Spec.DNS.Provider; dnsProvider != nil && *dnsProvider == gardenv1beta1.DNSUnmanaged {
 		return s.InternalClusterDomain
 	}
@@ -15,6 +15,8 @@
 package shoot_test
 
 import (
+	controllermanagerfeatures "github.com/gardener/gardener/pkg/controllermanager/features"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -22,6 +24,7 @@ import (
 )
 
 func TestCommon(t *testing.T) {
+	controllermanagerfeatures.RegisterFeatureGates()
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Shoot Suite")
 }
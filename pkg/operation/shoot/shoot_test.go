@@ -18,8 +18,12 @@ import (
 	"context"
 	"fmt"
 
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	controllermanagerfeatures "github.com/gardener/gardener/pkg/controllermanager/features"
+	"github.com/gardener/gardener/pkg/features"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
+	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/operation/garden"
 	. "github.com/gardener/gardener/pkg/operation/shoot"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -86,6 +90,275 @@ var _ = Describe("shoot", func() {
 		})
 	})
 
+	Describe("#ComputeAPIServerURL", func() {
+		BeforeEach(func() {
+			externalClusterDomain := "api.shoot.example.com"
+			shoot.InternalClusterDomain = "api.shoot.project.internal.example.com"
+			shoot.ExternalClusterDomain = &externalClusterDomain
+			shoot.LoadBalancerIngress = "1.2.3.4"
+		})
+
+		It("should return the kube-apiserver service for components running in the seed", func() {
+			shoot.DisableDNS = true
+
+			Expect(shoot.ComputeAPIServerURL(true, false)).To(Equal(common.KubeAPIServerDeploymentName))
+		})
+
+		It("should return the cluster domains if the DNS records are managed", func() {
+			Expect(shoot.ComputeAPIServerURL(false, true)).To(Equal("api.shoot.project.internal.example.com"))
+			Expect(shoot.ComputeAPIServerURL(false, false)).To(Equal("api.shoot.example.com"))
+		})
+
+		It("should return the load balancer address if the DNS records are not managed", func() {
+			shoot.DisableDNS = true
+
+			Expect(shoot.ComputeAPIServerURL(false, true)).To(Equal("1.2.3.4"))
+			Expect(shoot.ComputeAPIServerURL(false, false)).To(Equal("1.2.3.4"))
+		})
+
+		It("should return the internal cluster domain if the load balancer address is not known", func() {
+			shoot.DisableDNS = true
+			shoot.LoadBalancerIngress = ""
+
+			Expect(shoot.ComputeAPIServerURL(false, true)).To(Equal("api.shoot.project.internal.example.com"))
+		})
+	})
+
+	Describe("#UsesOutOfTreeCloudControllerManager", func() {
+		setFeatureGate := func(enabled bool) {
+			Expect(controllermanagerfeatures.FeatureGate.Set(fmt.Sprintf("%s=%t", features.OutOfTreeCloudControllerManager, enabled))).To(Succeed())
+		}
+
+		AfterEach(func() {
+			setFeatureGate(false)
+		})
+
+		DescribeTable("should determine whether the out-of-tree cloud-controller-manager is used",
+			func(cloudProvider gardenv1beta1.CloudProvider, version string, featureGate, expected bool) {
+				setFeatureGate(featureGate)
+				shoot.CloudProvider = cloudProvider
+				shoot.Info.Spec.Kubernetes.Version = version
+
+				Expect(shoot.UsesOutOfTreeCloudControllerManager()).To(Equal(expected))
+			},
+			Entry("alicloud", gardenv1beta1.CloudProviderAlicloud, "1.14.1", false, true),
+			Entry("openstack without feature gate", gardenv1beta1.CloudProviderOpenStack, "1.14.1", false, false),
+			Entry("openstack with feature gate", gardenv1beta1.CloudProviderOpenStack, "1.14.1", true, true),
+			Entry("openstack with feature gate but too old version", gardenv1beta1.CloudProviderOpenStack, "1.12.7", true, false),
+			Entry("azure with feature gate", gardenv1beta1.CloudProviderAzure, "1.13.5", true, true),
+			Entry("aws with feature gate", gardenv1beta1.CloudProviderAWS, "1.14.1", true, false),
+			Entry("gcp with feature gate", gardenv1beta1.CloudProviderGCP, "1.14.1", true, false),
+		)
+	})
+
+	Describe("#UsesKubeletServingCertificates", func() {
+		DescribeTable("should determine whether the kubelet serving certificates are bootstrapped",
+			func(annotations map[string]string, version string, expected bool) {
+				shoot.Info.Annotations = annotations
+				shoot.Info.Spec.Kubernetes.Version = version
+
+				Expect(shoot.UsesKubeletServingCertificates()).To(Equal(expected))
+			},
+			Entry("Shoot created with it", map[string]string{common.ShootKubeletServingCertificates: "true"}, "1.14.1", true),
+			Entry("Shoot created with it but too old version", map[string]string{common.ShootKubeletServingCertificates: "true"}, "1.11.10", false),
+			Entry("Shoot rolling it out", map[string]string{common.ShootKubeletServingCertificates: common.ShootKubeletServingCertificatesRollingOut}, "1.14.1", true),
+			Entry("Shoot not reconciled since", nil, "1.14.1", false),
+		)
+	})
+
+	Describe("#VerifiesKubeletServingCertificates", func() {
+		DescribeTable("should determine whether the kube-apiserver verifies the kubelet serving certificates",
+			func(annotations map[string]string, version string, expected bool) {
+				shoot.Info.Annotations = annotations
+				shoot.Info.Spec.Kubernetes.Version = version
+
+				Expect(shoot.VerifiesKubeletServingCertificates()).To(Equal(expected))
+			},
+			Entry("Shoot created with it", map[string]string{common.ShootKubeletServingCertificates: "true"}, "1.14.1", true),
+			Entry("Shoot created with it but too old version", map[string]string{common.ShootKubeletServingCertificates: "true"}, "1.11.10", false),
+			Entry("Shoot rolling it out", map[string]string{common.ShootKubeletServingCertificates: common.ShootKubeletServingCertificatesRollingOut}, "1.14.1", false),
+			Entry("Shoot not reconciled since", nil, "1.14.1", false),
+		)
+	})
+
+	Describe("#GetKubeAPIServerSourceRanges", func() {
+		var seedNetworks = gardenv1beta1.SeedNetworks{
+			Nodes: gardencorev1alpha1.CIDR("172.16.0.0/16"),
+			Pods:  gardencorev1alpha1.CIDR("100.64.0.0/11"),
+		}
+
+		It("should return nothing if no CIDRs are allowed", func() {
+			shoot.Info.Status.EgressIPs = []string{"52.1.2.3"}
+
+			Expect(shoot.GetKubeAPIServerSourceRanges(seedNetworks)).To(BeEmpty())
+		})
+
+		It("should return the allowed CIDRs, the node network, the seed networks and the egress IPs", func() {
+			nodes := gardencorev1alpha1.CIDR("10.250.0.0/16")
+			shoot.CloudProvider = gardenv1beta1.CloudProviderAWS
+			shoot.Info.Spec.Cloud.AWS = &gardenv1beta1.AWSCloud{
+				Networks: gardenv1beta1.AWSNetworks{
+					K8SNetworks: gardencorev1alpha1.K8SNetworks{Nodes: &nodes},
+				},
+			}
+			shoot.Info.Spec.Kubernetes.KubeAPIServer = &gardenv1beta1.KubeAPIServerConfig{
+				AllowedCIDRs: []gardencorev1alpha1.CIDR{"10.0.0.0/8"},
+			}
+			shoot.Info.Status.EgressIPs = []string{"52.1.2.3", "2001:db8::1"}
+
+			Expect(shoot.GetKubeAPIServerSourceRanges(seedNetworks)).To(Equal([]string{
+				"10.0.0.0/8",
+				"10.250.0.0/16",
+				"172.16.0.0/16",
+				"100.64.0.0/11",
+				"52.1.2.3/32",
+				"2001:db8::1/128",
+			}))
+		})
+	})
+
+	Describe("#GetSizingProfile", func() {
+		BeforeEach(func() {
+			shoot.CloudProvider = gardenv1beta1.CloudProviderAWS
+			shoot.Info.Spec.Cloud.AWS = &gardenv1beta1.AWSCloud{}
+		})
+
+		DescribeTable("should select the profile based on the maximum number of nodes",
+			func(autoScalerMax1, autoScalerMax2 int, expected gardenv1beta1.SizingProfile) {
+				shoot.Info.Spec.Cloud.AWS.Workers = []gardenv1beta1.AWSWorker{
+					{Worker: gardenv1beta1.Worker{AutoScalerMax: autoScalerMax1}},
+					{Worker: gardenv1beta1.Worker{AutoScalerMax: autoScalerMax2}},
+				}
+
+				Expect(shoot.GetSizingProfile()).To(Equal(expected))
+			},
+
+			Entry("tiny", 1, 1, gardenv1beta1.SizingProfileTiny),
+			Entry("small", 2, 8, gardenv1beta1.SizingProfileSmall),
+			Entry("medium", 10, 1, gardenv1beta1.SizingProfileMedium),
+			Entry("large", 50, 50, gardenv1beta1.SizingProfileLarge),
+			Entry("xlarge", 100, 1, gardenv1beta1.SizingProfileXLarge),
+		)
+
+		It("should return the profile of the Shoot manifest", func() {
+			profile := gardenv1beta1.SizingProfileLarge
+			shoot.Info.Spec.SizingProfile = &profile
+
+			Expect(shoot.GetSizingProfile()).To(Equal(gardenv1beta1.SizingProfileLarge))
+			Expect(shoot.GetSizing().KubeAPIServerMaxReplicas).To(Equal(4))
+		})
+	})
+
+	Describe("#ComputeStorageClasses", func() {
+		var (
+			defaults       func() []map[string]interface{}
+			parametersFunc = func(class gardenv1beta1.StorageClass) (string, map[string]interface{}) {
+				parameters := map[string]interface{}{"type": "standard", "fstype": "ext4"}
+				if class.VolumeType != nil {
+					parameters["type"] = *class.VolumeType
+				}
+				return "provisioner", parameters
+			}
+			trueVar = true
+			fast    = "fast"
+		)
+
+		BeforeEach(func() {
+			defaults = func() []map[string]interface{} {
+				return []map[string]interface{}{
+					{"Name": "default", "IsDefaultClass": true, "Provisioner": "provisioner", "Parameters": map[string]interface{}{"type": "standard"}},
+					{"Name": "ssd", "IsDefaultClass": false, "Provisioner": "provisioner", "Parameters": map[string]interface{}{"type": "ssd"}},
+				}
+			}
+		})
+
+		It("should return the default storage classes if the Shoot does not specify any", func() {
+			Expect(shoot.ComputeStorageClasses(defaults(), "zone", parametersFunc)).To(Equal(map[string]interface{}{
+				"StorageClasses": defaults(),
+			}))
+		})
+
+		It("should add and replace storage classes and move the default", func() {
+			shoot.Info.Spec.Storage = &gardenv1beta1.Storage{
+				Classes: []gardenv1beta1.StorageClass{
+					{Name: "ssd", VolumeType: &fast, Parameters: map[string]string{"fstype": "xfs"}},
+					{Name: "zonal", Default: &trueVar, Zones: []string{"zone-a"}},
+				},
+			}
+
+			Expect(shoot.ComputeStorageClasses(defaults(), "zone", parametersFunc)).To(Equal(map[string]interface{}{
+				"StorageClasses": []map[string]interface{}{
+					{"Name": "default", "IsDefaultClass": false, "Provisioner": "provisioner", "Parameters": map[string]interface{}{"type": "standard"}},
+					{"Name": "ssd", "IsDefaultClass": false, "Provisioner": "provisioner", "Parameters": map[string]interface{}{"type": "fast", "fstype": "xfs"}},
+					{"Name": "zonal", "IsDefaultClass": true, "Provisioner": "provisioner", "Parameters": map[string]interface{}{"type": "standard", "fstype": "ext4"}, "TopologyKey": "zone", "Zones": []string{"zone-a"}},
+				},
+			}))
+			Expect(shoot.HasStorageClass("zonal")).To(BeTrue())
+			Expect(shoot.HasStorageClass("default")).To(BeFalse())
+		})
+
+		It("should not render allowed topologies without a topology key", func() {
+			shoot.Info.Spec.Storage = &gardenv1beta1.Storage{
+				Classes: []gardenv1beta1.StorageClass{{Name: "zonal", Zones: []string{"zone-a"}}},
+			}
+
+			values := shoot.ComputeStorageClasses(nil, "", parametersFunc)
+
+			Expect(values["StorageClasses"]).To(ConsistOf(Not(HaveKey("Zones"))))
+		})
+	})
+
+	Describe("#GetServiceLoadBalancerAnnotations", func() {
+		var (
+			trueVar   = true
+			nlb       = "nlb"
+			intranet  = "intranet"
+			small     = "slb.s1.small"
+			bandwidth = int32(10)
+		)
+
+		It("should return no annotations if no defaults are configured", func() {
+			shoot.CloudProvider = gardenv1beta1.CloudProviderAWS
+			shoot.Info.Spec.Cloud.AWS = &gardenv1beta1.AWSCloud{}
+
+			Expect(shoot.GetServiceLoadBalancerAnnotations()).To(BeEmpty())
+		})
+
+		It("should compute the annotations for AWS", func() {
+			shoot.CloudProvider = gardenv1beta1.CloudProviderAWS
+			shoot.Info.Spec.Cloud.ServiceLoadBalancer = &gardenv1beta1.ServiceLoadBalancer{
+				Internal:    &trueVar,
+				Annotations: map[string]string{"service.beta.kubernetes.io/aws-load-balancer-internal": "0.0.0.0/0"},
+			}
+			shoot.Info.Spec.Cloud.AWS = &gardenv1beta1.AWSCloud{
+				ServiceLoadBalancer: &gardenv1beta1.AWSServiceLoadBalancer{Type: &nlb},
+			}
+
+			Expect(shoot.GetServiceLoadBalancerAnnotations()).To(Equal(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-internal": "0.0.0.0/0",
+				"service.beta.kubernetes.io/aws-load-balancer-type":     "nlb",
+			}))
+		})
+
+		It("should compute the annotations for Alicloud", func() {
+			shoot.CloudProvider = gardenv1beta1.CloudProviderAlicloud
+			shoot.Info.Spec.Cloud.Alicloud = &gardenv1beta1.Alicloud{
+				ServiceLoadBalancer: &gardenv1beta1.AlicloudLoadBalancer{
+					Spec:        &small,
+					AddressType: &intranet,
+					Bandwidth:   &bandwidth,
+				},
+			}
+
+			Expect(shoot.GetServiceLoadBalancerAnnotations()).To(Equal(map[string]string{
+				"service.beta.kubernetes.io/alicloud-loadbalancer-spec":         "slb.s1.small",
+				"service.beta.kubernetes.io/alicloud-loadbalancer-address-type": "intranet",
+				"service.beta.kubernetes.io/alicloud-loadbalancer-charge-type":  "paybybandwidth",
+				"service.beta.kubernetes.io/alicloud-loadbalancer-bandwidth":    "10",
+			}))
+		})
+	})
+
 	DescribeTable("#ConstructInternalClusterDomain",
 		func(shootName, shootProject, internalDomain, expected string) {
 			Expect(ConstructInternalClusterDomain(shootName, shootProject, internalDomain)).To(Equal(expected))
@@ -233,4 +506,92 @@ var _ = Describe("shoot", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 	})
+
+	Describe("#ReadCloudProviderSecretFromVault", func() {
+		var (
+			vaultClient *fakeVaultClient
+			binding     *gardenv1beta1.SecretBinding
+		)
+
+		BeforeEach(func() {
+			vaultClient = &fakeVaultClient{
+				pathPrefix: "gardener",
+				secrets: map[string]map[string]interface{}{
+					"gardener/garden-dev/aws/creds/deployer": {
+						"access_key":     "AKIA",
+						"secret_key":     "secret",
+						"security_token": "token",
+						"ttl":            3600,
+					},
+				},
+			}
+			binding = &gardenv1beta1.SecretBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "aws", Namespace: "garden-dev"},
+				VaultRef: &gardenv1beta1.SecretBindingVaultReference{
+					Path: "aws/creds/deployer",
+					KeyMapping: map[string]string{
+						"accessKeyID":     "access_key",
+						"secretAccessKey": "secret_key",
+					},
+				},
+			}
+		})
+
+		It("should read the credentials below the namespace's path and map the keys", func() {
+			secret, err := ReadCloudProviderSecretFromVault(vaultClient, binding, "garden-dev/shoot")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secret.Name).To(Equal("aws"))
+			Expect(secret.Namespace).To(Equal("garden-dev"))
+			Expect(secret.Data).To(Equal(map[string][]byte{
+				"accessKeyID":     []byte("AKIA"),
+				"secretAccessKey": []byte("secret"),
+				"security_token":  []byte("token"),
+				"ttl":             []byte("3600"),
+			}))
+		})
+
+		It("should fail if a mapped key is missing", func() {
+			binding.VaultRef.KeyMapping["region"] = "region"
+
+			_, err := ReadCloudProviderSecretFromVault(vaultClient, binding, "garden-dev/shoot")
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail if the credentials cannot be read", func() {
+			binding.Namespace = "garden-other"
+
+			_, err := ReadCloudProviderSecretFromVault(vaultClient, binding, "garden-dev/shoot")
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail if no Vault is configured", func() {
+			_, err := ReadCloudProviderSecretFromVault(nil, binding, "garden-dev/shoot")
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
+
+type fakeVaultClient struct {
+	pathPrefix string
+	secrets    map[string]map[string]interface{}
+}
+
+func (f *fakeVaultClient) Read(key, path string) (map[string]interface{}, error) {
+	data, ok := f.secrets[path]
+	if !ok {
+		return nil, fmt.Errorf("vault returned status code 404")
+	}
+	return data, nil
+}
+
+func (f *fakeVaultClient) Revoke(key string) error {
+	return nil
+}
+
+func (f *fakeVaultClient) PathPrefix() string {
+	return f.pathPrefix
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+)
+
+// ComponentResources contains the CPU and memory requests and limits of a system component.
+type ComponentResources struct {
+	CPURequest    string
+	MemoryRequest string
+	CPULimit      string
+	MemoryLimit   string
+}
+
+// Values returns the resources in the format expected by the charts.
+func (r ComponentResources) Values() map[string]interface{} {
+	return map[string]interface{}{
+		"requests": map[string]interface{}{
+			"cpu":    r.CPURequest,
+			"memory": r.MemoryRequest,
+		},
+		"limits": map[string]interface{}{
+			"cpu":    r.CPULimit,
+			"memory": r.MemoryLimit,
+		},
+	}
+}
+
+// Sizing contains the resources and replica counts of the system components of a Shoot for a sizing profile.
+type Sizing struct {
+	KubeAPIServer            ComponentResources
+	KubeAPIServerMaxReplicas int
+	KubeScheduler            ComponentResources
+	CoreDNS                  ComponentResources
+	CoreDNSMinReplicas       int
+	CoreDNSMaxReplicas       int
+}
+
+var sizings = map[gardenv1beta1.SizingProfile]Sizing{
+	gardenv1beta1.SizingProfileTiny: {
+		KubeAPIServer:            ComponentResources{CPURequest: "800m", MemoryRequest: "800Mi", CPULimit: "1000m", MemoryLimit: "1200Mi"},
+		KubeAPIServerMaxReplicas: 3,
+		KubeScheduler:            ComponentResources{CPURequest: "100m", MemoryRequest: "32Mi", CPULimit: "400m", MemoryLimit: "512Mi"},
+		CoreDNS:                  ComponentResources{CPURequest: "50m", MemoryRequest: "15Mi", CPULimit: "100m", MemoryLimit: "100Mi"},
+		CoreDNSMinReplicas:       2,
+		CoreDNSMaxReplicas:       5,
+	},
+	gardenv1beta1.SizingProfileSmall: {
+		KubeAPIServer:            ComponentResources{CPURequest: "1000m", MemoryRequest: "1100Mi", CPULimit: "1200m", MemoryLimit: "1900Mi"},
+		KubeAPIServerMaxReplicas: 3,
+		KubeScheduler:            ComponentResources{CPURequest: "100m", MemoryRequest: "32Mi", CPULimit: "400m", MemoryLimit: "512Mi"},
+		CoreDNS:                  ComponentResources{CPURequest: "50m", MemoryRequest: "15Mi", CPULimit: "100m", MemoryLimit: "100Mi"},
+		CoreDNSMinReplicas:       2,
+		CoreDNSMaxReplicas:       5,
+	},
+	gardenv1beta1.SizingProfileMedium: {
+		KubeAPIServer:            ComponentResources{CPURequest: "1200m", MemoryRequest: "1600Mi", CPULimit: "1500m", MemoryLimit: "3900Mi"},
+		KubeAPIServerMaxReplicas: 3,
+		KubeScheduler:            ComponentResources{CPURequest: "200m", MemoryRequest: "128Mi", CPULimit: "600m", MemoryLimit: "768Mi"},
+		CoreDNS:                  ComponentResources{CPURequest: "100m", MemoryRequest: "50Mi", CPULimit: "250m", MemoryLimit: "200Mi"},
+		CoreDNSMinReplicas:       2,
+		CoreDNSMaxReplicas:       5,
+	},
+	gardenv1beta1.SizingProfileLarge: {
+		KubeAPIServer:            ComponentResources{CPURequest: "2500m", MemoryRequest: "5200Mi", CPULimit: "3000m", MemoryLimit: "5900Mi"},
+		KubeAPIServerMaxReplicas: 4,
+		KubeScheduler:            ComponentResources{CPURequest: "400m", MemoryRequest: "256Mi", CPULimit: "1000m", MemoryLimit: "1Gi"},
+		CoreDNS:                  ComponentResources{CPURequest: "200m", MemoryRequest: "100Mi", CPULimit: "500m", MemoryLimit: "400Mi"},
+		CoreDNSMinReplicas:       3,
+		CoreDNSMaxReplicas:       10,
+	},
+	gardenv1beta1.SizingProfileXLarge: {
+		KubeAPIServer:            ComponentResources{CPURequest: "3000m", MemoryRequest: "5200Mi", CPULimit: "4000m", MemoryLimit: "7800Mi"},
+		KubeAPIServerMaxReplicas: 5,
+		KubeScheduler:            ComponentResources{CPURequest: "800m", MemoryRequest: "512Mi", CPULimit: "1500m", MemoryLimit: "2Gi"},
+		CoreDNS:                  ComponentResources{CPURequest: "300m", MemoryRequest: "200Mi", CPULimit: "1000m", MemoryLimit: "800Mi"},
+		CoreDNSMinReplicas:       3,
+		CoreDNSMaxReplicas:       15,
+	},
+}
+
+// GetSizingProfile returns the sizing profile of the Shoot. If it is not specified in the Shoot manifest then it is
+// selected based on the maximum number of worker nodes.
+func (s *Shoot) GetSizingProfile() gardenv1beta1.SizingProfile {
+	if profile := s.Info.Spec.SizingProfile; profile != nil {
+		if _, ok := sizings[*profile]; ok {
+			return *profile
+		}
+	}
+
+	switch nodeCount := s.GetNodeCount(); {
+	case nodeCount <= 2:
+		return gardenv1beta1.SizingProfileTiny
+	case nodeCount <= 10:
+		return gardenv1beta1.SizingProfileSmall
+	case nodeCount <= 50:
+		return gardenv1beta1.SizingProfileMedium
+	case nodeCount <= 100:
+		return gardenv1beta1.SizingProfileLarge
+	default:
+		return gardenv1beta1.SizingProfileXLarge
+	}
+}
+
+// GetSizing returns the resources and replica counts of the system components for the sizing profile of the Shoot.
+func (s *Shoot) GetSizing() Sizing {
+	return sizings[s.GetSizingProfile()]
+}
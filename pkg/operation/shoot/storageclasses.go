@@ -0,0 +1,105 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+)
+
+// HasStorageClass returns true if the Shoot specification contains a StorageClass with the given <name>.
+func (s *Shoot) HasStorageClass(name string) bool {
+	if storage := s.Info.Spec.Storage; storage != nil {
+		for _, class := range storage.Classes {
+			if class.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// StorageClassParametersFunc computes the provisioner and its parameters for the given StorageClass of the Shoot
+// specification based on the cloud provider of the Shoot.
+type StorageClassParametersFunc func(class gardenv1beta1.StorageClass) (provisioner string, parameters map[string]interface{})
+
+// ComputeStorageClasses merges the StorageClasses of the Shoot specification into the given <defaults> of the cloud
+// provider and returns the values which are required to render the chart shoot-storageclasses. A StorageClass of the
+// Shoot specification replaces the default StorageClass with the same name, and if one of them is marked as default
+// then none of the remaining StorageClasses is the default one anymore. The additional parameters of a StorageClass
+// are merged into the computed ones; the validation ensures that they do not overwrite the essential parameters of the
+// cloud provider. The zones of a StorageClass are rendered as
+// allowed topologies with the given <topologyKey>; they are skipped if the <topologyKey> is empty, i.e. if the cloud
+// provider expects them as provisioner parameters.
+func (s *Shoot) ComputeStorageClasses(defaults []map[string]interface{}, topologyKey string, parametersFunc StorageClassParametersFunc) map[string]interface{} {
+	var classes []gardenv1beta1.StorageClass
+	if storage := s.Info.Spec.Storage; storage != nil {
+		classes = storage.Classes
+	}
+
+	var (
+		storageClasses = make([]map[string]interface{}, 0, len(defaults)+len(classes))
+		indices        = make(map[string]int, len(defaults)+len(classes))
+		hasDefault     bool
+	)
+
+	for _, class := range classes {
+		if class.Default != nil && *class.Default {
+			hasDefault = true
+		}
+	}
+
+	for _, storageClass := range defaults {
+		if hasDefault {
+			storageClass["IsDefaultClass"] = false
+		}
+		name, _ := storageClass["Name"].(string)
+		indices[name] = len(storageClasses)
+		storageClasses = append(storageClasses, storageClass)
+	}
+
+	for _, class := range classes {
+		provisioner, computed := parametersFunc(class)
+
+		parameters := make(map[string]interface{}, len(class.Parameters)+len(computed))
+		for key, value := range computed {
+			parameters[key] = value
+		}
+		for key, value := range class.Parameters {
+			parameters[key] = value
+		}
+
+		storageClass := map[string]interface{}{
+			"Name":           class.Name,
+			"IsDefaultClass": class.Default != nil && *class.Default,
+			"Provisioner":    provisioner,
+			"Parameters":     parameters,
+		}
+		if len(class.Zones) > 0 && len(topologyKey) > 0 {
+			storageClass["TopologyKey"] = topologyKey
+			storageClass["Zones"] = class.Zones
+		}
+
+		if index, ok := indices[class.Name]; ok {
+			storageClasses[index] = storageClass
+			continue
+		}
+		indices[class.Name] = len(storageClasses)
+		storageClasses = append(storageClasses, storageClass)
+	}
+
+	return map[string]interface{}{
+		"StorageClasses": storageClasses,
+	}
+}
@@ -32,9 +32,12 @@ type Shoot struct {
 	InternalClusterDomain string
 	ExternalClusterDomain *string
 	ExternalDomain        *ExternalDomain
+	DisableDNS            bool
+	LoadBalancerIngress   string
 
 	WantsClusterAutoscaler bool
 	WantsAlertmanager      bool
+	WantsMonitoring        bool
 	IgnoreAlerts           bool
 	IsHibernated           bool
 
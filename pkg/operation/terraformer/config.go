@@ -18,6 +18,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
+	"sort"
 	"strings"
 
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -36,6 +38,10 @@ const (
 	TFVarsKey = "terraform.tfvars"
 	// StateKey is the key of the terraform.tfstate file inside the state ConfigMap.
 	StateKey = "terraform.tfstate"
+	// ModuleKeyPrefix is the prefix of the keys inside the configuration ConfigMap which contain the main.tf file of a
+	// local Terraform module. The key 'module.<name>.tf' is mounted as 'modules/<name>/main.tf', i.e. the module is
+	// referenced with source = "./modules/<name>".
+	ModuleKeyPrefix = "module."
 )
 
 // SetVariablesEnvironment sets the provided <tfvarsEnvironment> on the Terraformer object.
@@ -98,6 +104,30 @@ func createOrUpdateConfigMap(ctx context.Context, c client.Client, namespace, na
 	})
 }
 
+// configurationItems returns the paths the keys of the given configuration ConfigMap are mounted at. It returns nil
+// if the configuration does not contain any module, i.e. if every key is mounted with its own name.
+func configurationItems(configMap *corev1.ConfigMap) []corev1.KeyToPath {
+	var (
+		items      []corev1.KeyToPath
+		hasModules bool
+	)
+
+	for key := range configMap.Data {
+		itemPath := key
+		if strings.HasPrefix(key, ModuleKeyPrefix) && strings.HasSuffix(key, ".tf") {
+			itemPath = path.Join("modules", strings.TrimSuffix(strings.TrimPrefix(key, ModuleKeyPrefix), ".tf"), MainKey)
+			hasModules = true
+		}
+		items = append(items, corev1.KeyToPath{Key: key, Path: itemPath})
+	}
+
+	if !hasModules {
+		return nil
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Key < items[j].Key })
+	return items
+}
+
 // CreateOrUpdateConfigurationConfigMap creates or updates the Terraform configuration ConfigMap
 // with the given main and variables content.
 func CreateOrUpdateConfigurationConfigMap(ctx context.Context, c client.Client, namespace, name, main, variables string) (*corev1.ConfigMap, error) {
@@ -161,7 +191,7 @@ func (t *Terraformer) prepare(ctx context.Context) (int, error) {
 	}
 
 	// Clean up possible existing job/pod artifacts from previous runs
-	if err := t.ensureCleanedUp(); err != nil {
+	if err := t.ensureCleanedUp(ctx); err != nil {
 		return -1, err
 	}
 
@@ -193,8 +223,8 @@ func (t *Terraformer) verifyConfigExists(ctx context.Context) (int, error) {
 }
 
 // ConfigExists returns true if all three Terraform configuration secrets/configmaps exist, and false otherwise.
-func (t *Terraformer) ConfigExists() (bool, error) {
-	numberOfExistingResources, err := t.verifyConfigExists(context.TODO())
+func (t *Terraformer) ConfigExists(ctx context.Context) (bool, error) {
+	numberOfExistingResources, err := t.verifyConfigExists(ctx)
 	return numberOfExistingResources == numberOfConfigResources, err
 }
 
@@ -216,12 +246,16 @@ func (t *Terraformer) CleanupConfiguration(ctx context.Context) error {
 		return err
 	}
 
+	t.logger.Debugf("Deleting Terraform state backup ConfigMap '%s'", t.stateBackupName())
+	if err := t.client.Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: t.stateBackupName()}}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
 	return nil
 }
 
 // ensureCleanedUp deletes the job, pods, and waits until everything has been cleaned up.
-func (t *Terraformer) ensureCleanedUp() error {
-	ctx := context.TODO()
+func (t *Terraformer) ensureCleanedUp(ctx context.Context) error {
 	jobPodList, err := t.listJobPods(ctx)
 	if err != nil {
 		return err
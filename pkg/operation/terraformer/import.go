@@ -0,0 +1,219 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	resourceAddressRegex = regexp.MustCompile(`^([a-z0-9]+)_[a-z0-9_]+\.[a-zA-Z0-9_-]+(\[[0-9]+\])?$`)
+	resourceIDRegex      = regexp.MustCompile(`^[a-zA-Z0-9_.:/@-]+$`)
+)
+
+// ResourceImport is a pre-existing infrastructure resource which shall be adopted into the Terraform state.
+type ResourceImport struct {
+	// Address is the Terraform address of the resource in the root module, e.g. 'aws_vpc.vpc'.
+	Address string
+	// ID is the provider-specific id of the pre-existing resource, e.g. 'vpc-0123456789abcdef'.
+	ID string
+}
+
+// ParseResourceImports parses the given comma-separated list of '<address>=<id>' pairs.
+func ParseResourceImports(value string) ([]ResourceImport, error) {
+	var (
+		imports   []ResourceImport
+		addresses = map[string]bool{}
+	)
+
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if len(pair) == 0 {
+			continue
+		}
+
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid resource import %q, expected '<address>=<id>'", pair)
+		}
+
+		resourceImport := ResourceImport{Address: strings.TrimSpace(parts[0]), ID: strings.TrimSpace(parts[1])}
+		if err := validateResourceImport(resourceImport); err != nil {
+			return nil, err
+		}
+		if addresses[resourceImport.Address] {
+			return nil, fmt.Errorf("resource address %q must only be imported once", resourceImport.Address)
+		}
+		addresses[resourceImport.Address] = true
+
+		imports = append(imports, resourceImport)
+	}
+
+	return imports, nil
+}
+
+func validateResourceImport(resourceImport ResourceImport) error {
+	if !resourceAddressRegex.MatchString(resourceImport.Address) {
+		return fmt.Errorf("invalid resource address %q, expected '<type>.<name>' or '<type>.<name>[<index>]'", resourceImport.Address)
+	}
+	if !resourceIDRegex.MatchString(resourceImport.ID) {
+		return fmt.Errorf("invalid id %q for resource %q", resourceImport.ID, resourceImport.Address)
+	}
+	return nil
+}
+
+// SetResourceImports sets the <imports> which shall be adopted into the Terraform state before the next Apply.
+func (t *Terraformer) SetResourceImports(imports []ResourceImport) *Terraformer {
+	t.resourceImports = imports
+	return t
+}
+
+// ImportStateResources adopts the given pre-existing resources into the root module of the Terraform state like
+// `terraform import` does: The resources are only recorded with their ids, and Terraform reads their attributes from
+// the cloud provider while it refreshes the state during the next execution. Resources whose address is already part
+// of the state are skipped, hence it can be called before every execution.
+func (t *Terraformer) ImportStateResources(ctx context.Context, imports []ResourceImport) error {
+	if len(imports) == 0 {
+		return nil
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := t.client.Get(ctx, kutil.Key(t.namespace, t.stateName), configMap); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: t.stateName}}
+	}
+
+	state, imported, err := importStateResources([]byte(configMap.Data[StateKey]), imports)
+	if err != nil || len(imported) == 0 {
+		return err
+	}
+
+	t.logger.Infof("Importing resources %s into Terraform state '%s'", strings.Join(imported, ", "), t.stateName)
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[StateKey] = string(state)
+	if len(configMap.ResourceVersion) == 0 {
+		return t.client.Create(ctx, configMap)
+	}
+	return t.client.Update(ctx, configMap)
+}
+
+// importStateResources records the given resources with their ids in the root module of the given Terraform
+// <stateData>. It returns the new state and the addresses of the imported resources. An empty state is initialized.
+func importStateResources(stateData []byte, imports []ResourceImport) ([]byte, []string, error) {
+	// The state is decoded generically in order to keep all fields which are unknown to Gardener.
+	state := map[string]interface{}{
+		"version": json.Number("3"),
+		"serial":  json.Number("0"),
+		"modules": []interface{}{},
+	}
+	if len(stateData) > 0 {
+		decoder := json.NewDecoder(bytes.NewReader(stateData))
+		decoder.UseNumber()
+		if err := decoder.Decode(&state); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	modules, _ := state["modules"].([]interface{})
+	rootModule := findStateModule(modules, "root")
+	if rootModule == nil {
+		rootModule = map[string]interface{}{
+			"path":       []interface{}{"root"},
+			"outputs":    map[string]interface{}{},
+			"resources":  map[string]interface{}{},
+			"depends_on": []interface{}{},
+		}
+		modules = append(modules, rootModule)
+	}
+	rootResources, ok := rootModule["resources"].(map[string]interface{})
+	if !ok {
+		rootResources = map[string]interface{}{}
+		rootModule["resources"] = rootResources
+	}
+
+	var imported []string
+	for _, resourceImport := range imports {
+		if err := validateResourceImport(resourceImport); err != nil {
+			return nil, nil, err
+		}
+
+		key := stateResourceKey(resourceImport.Address)
+		if _, ok := rootResources[key]; ok {
+			continue
+		}
+
+		var (
+			resourceType = strings.SplitN(resourceImport.Address, ".", 2)[0]
+			provider     = resourceAddressRegex.FindStringSubmatch(resourceImport.Address)[1]
+		)
+		rootResources[key] = map[string]interface{}{
+			"type":       resourceType,
+			"depends_on": []interface{}{},
+			"primary": map[string]interface{}{
+				"id":         resourceImport.ID,
+				"attributes": map[string]interface{}{"id": resourceImport.ID},
+				"meta":       map[string]interface{}{},
+				"tainted":    false,
+			},
+			"deposed":  []interface{}{},
+			"provider": "provider." + provider,
+		}
+		imported = append(imported, resourceImport.Address)
+	}
+
+	if len(imported) == 0 {
+		return stateData, nil, nil
+	}
+
+	state["modules"] = modules
+	if serial, ok := state["serial"].(json.Number); ok {
+		value, err := serial.Int64()
+		if err != nil {
+			return nil, nil, err
+		}
+		state["serial"] = json.Number(strconv.FormatInt(value+1, 10))
+	}
+
+	newStateData, err := json.MarshalIndent(state, "", "    ")
+	if err != nil {
+		return nil, nil, err
+	}
+	return newStateData, imported, nil
+}
+
+// stateResourceKey returns the key of the resource with the given <address> in the resources of a Terraform state
+// module, e.g. 'aws_subnet.nodes.1' for 'aws_subnet.nodes[1]'.
+func stateResourceKey(address string) string {
+	if i := strings.Index(address, "["); i >= 0 {
+		return address[:i] + "." + strings.TrimSuffix(address[i+1:], "]")
+	}
+	return address
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// regexProgress matches the lines of the Terraform output which report the progress of single resources, e.g.
+// 'aws_vpc.vpc: Creating...' or 'aws_vpc.vpc: Still destroying... (10s elapsed)'.
+var regexProgress = regexp.MustCompile(`^\S+: (Creating|Creation complete|Still creating|Modifying|Modifications complete|Still modifying|Destroying|Destruction complete|Still destroying|Importing|Import prepared)`)
+
+// logJobProgress fetches the output of the pods of the running Terraform Job which has been written after <since> and
+// logs the lines which report the progress of resources, so that it can be followed in the operation log. <since> is
+// advanced to the time of the newest line.
+func (t *Terraformer) logJobProgress(ctx context.Context, since *time.Time) {
+	jobPodList, err := t.listJobPods(ctx)
+	if err != nil {
+		t.logger.Debugf("Could not list the pods of Terraform job '%s' to report its progress: %v", t.jobName, err)
+		return
+	}
+
+	newest := *since
+	for _, jobPod := range jobPodList.Items {
+		options := &corev1.PodLogOptions{Timestamps: true}
+		if !since.IsZero() {
+			// The API server only respects the seconds, hence, already logged lines are filtered out again below.
+			sinceTime := metav1.NewTime(*since)
+			options.SinceTime = &sinceTime
+		}
+
+		logs, err := kubernetes.GetPodLogs(t.coreV1Client.Pods(jobPod.Namespace), jobPod.Name, options)
+		if err != nil {
+			// The container might not have been started yet.
+			continue
+		}
+
+		lines, timestamp := progressLines(string(logs), *since)
+		for _, line := range lines {
+			t.logger.Infof("Terraform: %s", line)
+		}
+		if timestamp.After(newest) {
+			newest = timestamp
+		}
+	}
+	*since = newest
+}
+
+// progressLines returns the lines of the given <logs> (prefixed with timestamps) which have been written after <since>
+// and report the progress of resources. It also returns the time of the newest line.
+func progressLines(logs string, since time.Time) ([]string, time.Time) {
+	var (
+		lines  []string
+		newest = since
+	)
+
+	for _, line := range strings.Split(stripColors(logs), "\n") {
+		parts := strings.SplitN(line, " ", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		timestamp, err := time.Parse(time.RFC3339Nano, parts[0])
+		if err != nil || !timestamp.After(since) {
+			continue
+		}
+		if timestamp.After(newest) {
+			newest = timestamp
+		}
+		if message := strings.TrimSpace(parts[1]); regexProgress.MatchString(message) {
+			lines = append(lines, message)
+		}
+	}
+
+	return lines, newest
+}
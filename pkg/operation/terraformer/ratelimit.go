@@ -0,0 +1,46 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// SetRateLimiter sets the token bucket of the cloud provider account the Terraform configuration acts on. Every
+// execution of Terraform consumes one token, so that concurrent executions for the same account do not exceed
+// the API rate limits of the cloud provider.
+func (t *Terraformer) SetRateLimiter(limiter *rate.Limiter) *Terraformer {
+	t.rateLimiter = limiter
+	return t
+}
+
+func (t *Terraformer) waitForRateLimiter(ctx context.Context) error {
+	if t.rateLimiter == nil {
+		return nil
+	}
+
+	start := time.Now()
+	if err := t.rateLimiter.Wait(ctx); err != nil {
+		return fmt.Errorf("failed waiting for the cloud API rate limit: %v", err)
+	}
+	if waited := time.Since(start); waited > time.Second {
+		t.logger.Infof("Waited %s for the cloud API rate limit of the account before executing Terraform", waited.Round(time.Second))
+	}
+	return nil
+}
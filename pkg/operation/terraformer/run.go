@@ -0,0 +1,262 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// RunPurposeLabel is the label of a TerraformRun which contains the purpose of the Terraform configuration.
+	RunPurposeLabel = "terraformer.gardener.cloud/purpose"
+	// RunLogsKey is the key of the ConfigMap referenced by a TerraformRun which contains the logs of the run.
+	RunLogsKey = "terraform.log"
+
+	// runHistoryLimit is the number of TerraformRuns which are kept per Terraform configuration.
+	runHistoryLimit = 10
+	// runLogsLimit is the maximum size of the logs which are stored for a TerraformRun. Only the end of longer
+	// logs is kept as it contains the summary and the errors of the run.
+	runLogsLimit = 32 * 1024
+	// runErrorsLimit is the maximum number of errors which are stored for a TerraformRun.
+	runErrorsLimit = 10
+)
+
+var (
+	regexApplyChanges   = regexp.MustCompile(`Resources: (\d+) added, (\d+) changed, (\d+) destroyed`)
+	regexDestroyChanges = regexp.MustCompile(`Destroy complete! Resources: (\d+) destroyed`)
+	regexColors         = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+)
+
+// startRun records the start of a Terraform execution with the given <command> as TerraformRun. The record is only
+// used for auditing, hence, failures are logged but do not fail the execution.
+func (t *Terraformer) startRun(ctx context.Context, command string) *extensionsv1alpha1.TerraformRun {
+	run := &extensionsv1alpha1.TerraformRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    t.namespace,
+			GenerateName: fmt.Sprintf("%s.%s%s-", t.name, t.purpose, common.TerraformerRunSuffix),
+			Labels:       t.runLabels(),
+		},
+		Spec: extensionsv1alpha1.TerraformRunSpec{
+			Name:    t.name,
+			Purpose: t.purpose,
+			Command: command,
+		},
+		Status: extensionsv1alpha1.TerraformRunStatus{
+			Outcome:   extensionsv1alpha1.TerraformRunRunning,
+			StartTime: metav1.Now(),
+		},
+	}
+
+	if err := t.client.Create(ctx, run); err != nil {
+		t.logger.Warnf("Could not record the Terraform run: %v", err)
+		return nil
+	}
+	return run
+}
+
+// completeRun records the outcome of the given <run>, stores the (truncated) <logList> in a ConfigMap owned by
+// the run and deletes the oldest runs of the Terraform configuration which exceed the history limit.
+func (t *Terraformer) completeRun(ctx context.Context, run *extensionsv1alpha1.TerraformRun, succeeded bool, exitCode *int32, logList map[string]string) {
+	if run == nil {
+		return
+	}
+
+	now := metav1.Now()
+	run.Status.CompletionTime = &now
+	run.Status.ExitCode = exitCode
+	run.Status.Changes = parseTerraformChanges(logList)
+	run.Status.Errors = parseTerraformErrors(logList)
+	run.Status.Outcome = extensionsv1alpha1.TerraformRunFailed
+	if succeeded {
+		run.Status.Outcome = extensionsv1alpha1.TerraformRunSucceeded
+	}
+
+	if len(logList) > 0 {
+		logs := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       run.Namespace,
+				Name:            run.Name + "-logs",
+				Labels:          t.runLabels(),
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(run, extensionsv1alpha1.SchemeGroupVersion.WithKind("TerraformRun"))},
+			},
+			Data: map[string]string{RunLogsKey: truncateRunLogs(logList)},
+		}
+		if err := t.client.Create(ctx, logs); err != nil {
+			t.logger.Warnf("Could not store the logs of Terraform run '%s': %v", run.Name, err)
+		} else {
+			run.Status.LogsRef = &corev1.LocalObjectReference{Name: logs.Name}
+		}
+	}
+
+	if err := t.client.Update(ctx, run); err != nil {
+		t.logger.Warnf("Could not record the outcome of Terraform run '%s': %v", run.Name, err)
+	}
+
+	if err := t.pruneRuns(ctx); err != nil {
+		t.logger.Warnf("Could not delete old Terraform runs: %v", err)
+	}
+}
+
+// pruneRuns deletes the oldest TerraformRuns of the Terraform configuration which exceed the history limit. The
+// ConfigMaps containing their logs are garbage collected.
+func (t *Terraformer) pruneRuns(ctx context.Context) error {
+	runList := &extensionsv1alpha1.TerraformRunList{}
+	if err := t.client.List(ctx, &client.ListOptions{Namespace: t.namespace, LabelSelector: labels.SelectorFromSet(t.runLabels())}, runList); err != nil {
+		return err
+	}
+
+	var runs []extensionsv1alpha1.TerraformRun
+	for _, run := range runList.Items {
+		if run.Spec.Name == t.name {
+			runs = append(runs, run)
+		}
+	}
+	if len(runs) <= runHistoryLimit {
+		return nil
+	}
+
+	sort.Slice(runs, func(i, j int) bool {
+		return runs[i].Status.StartTime.Before(&runs[j].Status.StartTime)
+	})
+
+	for _, run := range runs[:len(runs)-runHistoryLimit] {
+		if err := t.client.Delete(ctx, run.DeepCopy()); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *Terraformer) runLabels() map[string]string {
+	return map[string]string{RunPurposeLabel: t.purpose}
+}
+
+// parseTerraformChanges parses the summary of the changed resources from the logs of a Terraform run. It returns
+// nil if the logs do not contain a summary.
+func parseTerraformChanges(logList map[string]string) *extensionsv1alpha1.TerraformRunChanges {
+	var changes *extensionsv1alpha1.TerraformRunChanges
+
+	for _, podName := range sortedPodNames(logList) {
+		logs := logList[podName]
+		if match := regexApplyChanges.FindAllStringSubmatch(logs, -1); len(match) > 0 {
+			last := match[len(match)-1]
+			changes = &extensionsv1alpha1.TerraformRunChanges{Added: atoi(last[1]), Changed: atoi(last[2]), Destroyed: atoi(last[3])}
+		}
+		if match := regexDestroyChanges.FindAllStringSubmatch(logs, -1); len(match) > 0 {
+			changes = &extensionsv1alpha1.TerraformRunChanges{Destroyed: atoi(match[len(match)-1][1])}
+		}
+	}
+
+	return changes
+}
+
+// parseTerraformErrors parses the errors reported by Terraform from the logs of a run and classifies them. It keeps
+// at most runErrorsLimit errors and omits duplicates.
+func parseTerraformErrors(logList map[string]string) []extensionsv1alpha1.TerraformRunError {
+	var (
+		runErrors []extensionsv1alpha1.TerraformRunError
+		seen      = map[string]struct{}{}
+	)
+
+	for _, podName := range sortedPodNames(logList) {
+		for _, message := range strings.Split(findTerraformErrors(logList[podName]), "\n*") {
+			message = strings.TrimSpace(strings.TrimPrefix(message, "*"))
+			if _, ok := seen[message]; ok || message == "" {
+				continue
+			}
+			seen[message] = struct{}{}
+
+			if len(runErrors) == runErrorsLimit {
+				return runErrors
+			}
+			runErrors = append(runErrors, extensionsv1alpha1.TerraformRunError{
+				Message: message,
+				Code:    gardencorev1alpha1helper.DetermineErrorCode(message),
+			})
+		}
+	}
+
+	return runErrors
+}
+
+// stripColors removes ANSI color codes from the given Terraform <logs>. Terraform is run with '-no-color', but older
+// Terraformer images may not respect it.
+func stripColors(logs string) string {
+	return regexColors.ReplaceAllString(logs, "")
+}
+
+// truncateRunLogs concatenates the logs of all pods of a run and keeps at most the last runLogsLimit bytes.
+func truncateRunLogs(logList map[string]string) string {
+	var logs strings.Builder
+	for _, podName := range sortedPodNames(logList) {
+		fmt.Fprintf(&logs, "--- Logs of pod '%s' ---\n%s\n", podName, logList[podName])
+	}
+
+	result := logs.String()
+	if len(result) > runLogsLimit {
+		result = "[... truncated ...]\n" + result[len(result)-runLogsLimit:]
+	}
+	return result
+}
+
+// terraformExitCode returns the exit code of the Terraform container of the most recently started pod in the given
+// <podList>, or nil if none of the pods has terminated.
+func terraformExitCode(podList *corev1.PodList) *int32 {
+	var (
+		exitCode *int32
+		latest   metav1.Time
+	)
+
+	for _, pod := range podList.Items {
+		for _, status := range pod.Status.ContainerStatuses {
+			if terminated := status.State.Terminated; terminated != nil && !terminated.StartedAt.Before(&latest) {
+				code := terminated.ExitCode
+				exitCode = &code
+				latest = terminated.StartedAt
+			}
+		}
+	}
+
+	return exitCode
+}
+
+func sortedPodNames(logList map[string]string) []string {
+	podNames := make([]string, 0, len(logList))
+	for podName := range logList {
+		podNames = append(podNames, podName)
+	}
+	sort.Strings(podNames)
+	return podNames
+}
+
+func atoi(s string) int {
+	i, _ := strconv.Atoi(s)
+	return i
+}
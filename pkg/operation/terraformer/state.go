@@ -15,9 +15,13 @@
 package terraformer
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
+
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	corev1 "k8s.io/api/core/v1"
 
@@ -27,10 +31,19 @@ import (
 
 type terraformState struct {
 	Modules []struct {
-		Outputs map[string]map[string]interface{} `json:"outputs"`
+		Outputs   map[string]map[string]interface{} `json:"outputs"`
+		Resources map[string]terraformStateResource `json:"resources"`
 	} `json:"modules"`
 }
 
+type terraformStateResource struct {
+	Type    string `json:"type"`
+	Primary struct {
+		ID         string            `json:"id"`
+		Attributes map[string]string `json:"attributes"`
+	} `json:"primary"`
+}
+
 // GetState returns the Terraform state as byte slice.
 func (t *Terraformer) GetState() ([]byte, error) {
 	ctx := context.TODO()
@@ -80,6 +93,275 @@ func (t *Terraformer) GetStateOutputVariables(variables ...string) (map[string]s
 	return output, nil
 }
 
+// GetStateResourceIDs returns the set of the primary IDs of all resources which are managed by the
+// Terraform state. It can be used to determine whether a resource in the infrastructure is known to
+// Terraform or not.
+func (t *Terraformer) GetStateResourceIDs() (sets.String, error) {
+	stateConfigMap, err := t.GetState()
+	if err != nil {
+		return nil, err
+	}
+	return resourceIDsFromState(stateConfigMap)
+}
+
+// resourceIDsFromState parses the given Terraform <stateData> and returns the primary IDs of all
+// resources in all modules.
+func resourceIDsFromState(stateData []byte) (sets.String, error) {
+	ids := sets.NewString()
+	if len(stateData) == 0 {
+		return ids, nil
+	}
+
+	var state terraformState
+	if err := json.Unmarshal(stateData, &state); err != nil {
+		return nil, err
+	}
+
+	for _, module := range state.Modules {
+		for _, resource := range module.Resources {
+			if len(resource.Primary.ID) > 0 {
+				ids.Insert(resource.Primary.ID)
+			}
+		}
+	}
+	return ids, nil
+}
+
+// GetStateResourceIdentifiers returns the set of the primary IDs and the names of all resources which are managed by
+// the Terraform state, in lower case as some cloud providers treat them case-insensitively. Contrary to
+// GetStateResourceIDs, a state which does not exist (yet or anymore) is treated like an empty state.
+func (t *Terraformer) GetStateResourceIdentifiers() (sets.String, error) {
+	stateConfigMap, err := t.GetState()
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	return resourceIdentifiersFromState(stateConfigMap)
+}
+
+// resourceIdentifiersFromState parses the given Terraform <stateData> and returns the lower case primary IDs and names
+// of all resources in all modules.
+func resourceIdentifiersFromState(stateData []byte) (sets.String, error) {
+	identifiers := sets.NewString()
+	if len(stateData) == 0 {
+		return identifiers, nil
+	}
+
+	var state terraformState
+	if err := json.Unmarshal(stateData, &state); err != nil {
+		return nil, err
+	}
+
+	for _, module := range state.Modules {
+		for _, resource := range module.Resources {
+			for _, identifier := range []string{resource.Primary.ID, resource.Primary.Attributes["name"]} {
+				if len(identifier) > 0 {
+					identifiers.Insert(strings.ToLower(identifier))
+				}
+			}
+		}
+	}
+	return identifiers, nil
+}
+
+// StateSummary summarizes the given Terraform <stateData> without revealing sensitive values: It returns the
+// addresses of all resources mapped to their primary IDs, and the names of all outputs.
+func StateSummary(stateData []byte) (map[string]string, []string, error) {
+	var (
+		resources = map[string]string{}
+		outputs   = sets.NewString()
+	)
+	if len(stateData) == 0 {
+		return resources, nil, nil
+	}
+
+	var state terraformState
+	if err := json.Unmarshal(stateData, &state); err != nil {
+		return nil, nil, err
+	}
+
+	for _, module := range state.Modules {
+		for address, resource := range module.Resources {
+			resources[address] = resource.Primary.ID
+		}
+		for name := range module.Outputs {
+			outputs.Insert(name)
+		}
+	}
+	return resources, outputs.List(), nil
+}
+
+// StateResourceMove describes the move of a resource of the root module of a Terraform state into a child module of
+// the root module.
+type StateResourceMove struct {
+	// Address is the address of the resource in the root module, e.g. 'alicloud_vswitch.vsw_z0'.
+	Address string
+	// Module is the name of the child module the resource is moved into, e.g. 'zone_z0'.
+	Module string
+	// NewAddress is the address of the resource in the child module, e.g. 'alicloud_vswitch.vsw'.
+	NewAddress string
+}
+
+// MoveStateResources moves the given resources of the root module of the Terraform state into child modules like
+// `terraform state mv` does, so that Terraform does not destroy and recreate resources whose configuration has been
+// moved into a module. Resources which are not part of the root module are skipped, hence it can be called before
+// every execution.
+func (t *Terraformer) MoveStateResources(ctx context.Context, moves []StateResourceMove) error {
+	configMap := &corev1.ConfigMap{}
+	if err := t.client.Get(ctx, kutil.Key(t.namespace, t.stateName), configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	state, moved, err := moveStateResources([]byte(configMap.Data[StateKey]), moves)
+	if err != nil || !moved {
+		return err
+	}
+
+	t.logger.Infof("Moving resources of Terraform state '%s' into modules", t.stateName)
+	configMap.Data[StateKey] = string(state)
+	return t.client.Update(ctx, configMap)
+}
+
+// moveStateResources moves the given resources of the root module of the given Terraform <stateData> into child
+// modules. It returns the new state and whether any resource has been moved. Dependencies of the moved resources on
+// resources of other modules are dropped as Terraform does not record dependencies across modules.
+func moveStateResources(stateData []byte, moves []StateResourceMove) ([]byte, bool, error) {
+	if len(stateData) == 0 {
+		return stateData, false, nil
+	}
+
+	// The state is decoded generically in order to keep all fields which are unknown to Gardener.
+	var state map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(stateData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&state); err != nil {
+		return nil, false, err
+	}
+
+	modules, _ := state["modules"].([]interface{})
+	rootModule := findStateModule(modules, "root")
+	if rootModule == nil {
+		return stateData, false, nil
+	}
+	rootResources, _ := rootModule["resources"].(map[string]interface{})
+
+	movedResources := map[string]StateResourceMove{}
+	for _, move := range moves {
+		resource, ok := rootResources[move.Address]
+		if !ok {
+			continue
+		}
+
+		module := findStateModule(modules, "root", move.Module)
+		if module == nil {
+			module = map[string]interface{}{
+				"path":       []interface{}{"root", move.Module},
+				"outputs":    map[string]interface{}{},
+				"resources":  map[string]interface{}{},
+				"depends_on": []interface{}{},
+			}
+			modules = append(modules, module)
+		}
+		moduleResources, ok := module["resources"].(map[string]interface{})
+		if !ok {
+			moduleResources = map[string]interface{}{}
+			module["resources"] = moduleResources
+		}
+
+		delete(rootResources, move.Address)
+		moduleResources[move.NewAddress] = resource
+		movedResources[move.Address] = move
+	}
+
+	if len(movedResources) == 0 {
+		return stateData, false, nil
+	}
+
+	for _, resource := range rootResources {
+		rewriteStateDependencies(resource, func(dependency string) (string, bool) {
+			_, moved := movedResources[dependency]
+			return dependency, !moved
+		})
+	}
+	for _, move := range movedResources {
+		module := findStateModule(modules, "root", move.Module)
+		rewriteStateDependencies(module["resources"].(map[string]interface{})[move.NewAddress], func(dependency string) (string, bool) {
+			if dependencyMove, ok := movedResources[dependency]; ok && dependencyMove.Module == move.Module {
+				return dependencyMove.NewAddress, true
+			}
+			return "", false
+		})
+	}
+
+	state["modules"] = modules
+	if serial, ok := state["serial"].(json.Number); ok {
+		value, err := serial.Int64()
+		if err != nil {
+			return nil, false, err
+		}
+		state["serial"] = json.Number(strconv.FormatInt(value+1, 10))
+	}
+
+	newStateData, err := json.MarshalIndent(state, "", "    ")
+	if err != nil {
+		return nil, false, err
+	}
+	return newStateData, true, nil
+}
+
+// findStateModule returns the module with the given <path> of the given Terraform state modules, or nil if there is
+// no such module.
+func findStateModule(modules []interface{}, path ...string) map[string]interface{} {
+	for _, m := range modules {
+		module, ok := m.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		modulePath, _ := module["path"].([]interface{})
+		if len(modulePath) != len(path) {
+			continue
+		}
+		matches := true
+		for i, element := range modulePath {
+			if element != path[i] {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return module
+		}
+	}
+	return nil
+}
+
+// rewriteStateDependencies replaces the dependencies of the given Terraform state <resource> by the result of the
+// given <rewrite> function. Dependencies for which it returns false are dropped.
+func rewriteStateDependencies(resource interface{}, rewrite func(string) (string, bool)) {
+	r, ok := resource.(map[string]interface{})
+	if !ok {
+		return
+	}
+	dependencies, ok := r["depends_on"].([]interface{})
+	if !ok {
+		return
+	}
+
+	rewritten := []interface{}{}
+	for _, d := range dependencies {
+		dependency, ok := d.(string)
+		if !ok {
+			continue
+		}
+		if newDependency, keep := rewrite(dependency); keep {
+			rewritten = append(rewritten, newDependency)
+		}
+	}
+	r["depends_on"] = rewritten
+}
+
 // isStateEmpty returns true if the Terraform state is empty, and false otherwise.
 func (t *Terraformer) isStateEmpty() bool {
 	state, err := t.GetState()
@@ -15,15 +15,24 @@
 package terraformer
 
 import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"testing"
 )
 
@@ -255,4 +264,506 @@ var _ = Describe("terraformer", func() {
 			Expect(runInitializer(false)).NotTo(HaveOccurred())
 		})
 	})
+
+	Describe("#checkStateVersion, #recordStateVersion", func() {
+		const (
+			namespace = "namespace"
+			stateName = "name.infra.tf-state"
+		)
+
+		var (
+			ctx        = context.TODO()
+			stateKey   = kutil.Key(namespace, stateName)
+			backupKey  = kutil.Key(namespace, stateName+"-backup")
+			tf         *Terraformer
+			stateOfVer = func(version string) func(context.Context, types.NamespacedName, *corev1.ConfigMap) error {
+				return func(_ context.Context, _ types.NamespacedName, configMap *corev1.ConfigMap) error {
+					*configMap = corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace:   namespace,
+							Name:        stateName,
+							Annotations: map[string]string{StateVersionAnnotation: version},
+						},
+						Data: map[string]string{StateKey: "{}"},
+					}
+					return nil
+				}
+			}
+		)
+
+		BeforeEach(func() {
+			tf = &Terraformer{
+				logger:    logrus.New(),
+				client:    client,
+				namespace: namespace,
+				stateName: stateName,
+			}
+		})
+
+		It("should do nothing if no version is set", func() {
+			record, err := tf.checkStateVersion(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(record).To(BeFalse())
+		})
+
+		It("should do nothing if the state has been written by the same version", func() {
+			client.EXPECT().Get(ctx, stateKey, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).DoAndReturn(stateOfVer("0.12.0"))
+
+			record, err := tf.SetVersion("0.12.0").checkStateVersion(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(record).To(BeFalse())
+		})
+
+		It("should reject a downgrade", func() {
+			client.EXPECT().Get(ctx, stateKey, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).DoAndReturn(stateOfVer("0.13.0"))
+
+			_, err := tf.SetVersion("0.12.0").checkStateVersion(ctx)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should back up the state on an upgrade without recording the new version yet", func() {
+			gomock.InOrder(
+				client.EXPECT().Get(ctx, stateKey, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).DoAndReturn(stateOfVer("0.11.0")),
+				client.EXPECT().Get(ctx, backupKey, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).Return(apierrors.NewNotFound(configMapGroupResource, stateName+"-backup")),
+				client.EXPECT().Get(ctx, backupKey, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).Return(apierrors.NewNotFound(configMapGroupResource, stateName+"-backup")),
+				client.EXPECT().Create(ctx, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   namespace,
+						Name:        stateName + "-backup",
+						Annotations: map[string]string{StateVersionAnnotation: "0.11.0"},
+					},
+					Data: map[string]string{StateKey: "{}"},
+				}),
+			)
+
+			record, err := tf.SetVersion("0.12.0").checkStateVersion(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(record).To(BeTrue())
+		})
+
+		It("should keep the backup taken before a failed execution of the newer version", func() {
+			gomock.InOrder(
+				client.EXPECT().Get(ctx, stateKey, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).DoAndReturn(stateOfVer("0.11.0")),
+				client.EXPECT().Get(ctx, backupKey, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).DoAndReturn(func(_ context.Context, _ types.NamespacedName, configMap *corev1.ConfigMap) error {
+					return stateOfVer("0.11.0")(ctx, backupKey, configMap)
+				}),
+			)
+
+			record, err := tf.SetVersion("0.12.0").checkStateVersion(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(record).To(BeTrue())
+		})
+
+		It("should record the version on the state", func() {
+			gomock.InOrder(
+				client.EXPECT().Get(ctx, stateKey, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).DoAndReturn(stateOfVer("0.11.0")),
+				client.EXPECT().Update(ctx, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   namespace,
+						Name:        stateName,
+						Annotations: map[string]string{StateVersionAnnotation: "0.12.0"},
+					},
+					Data: map[string]string{StateKey: "{}"},
+				}),
+			)
+
+			Expect(tf.SetVersion("0.12.0").recordStateVersion(ctx)).To(Succeed())
+		})
+	})
+
+	Describe("#resourceIDsFromState", func() {
+		It("should return an empty set for an empty state", func() {
+			ids, err := resourceIDsFromState(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ids.Len()).To(Equal(0))
+		})
+
+		It("should return the primary IDs of all resources", func() {
+			state := []byte(`{"modules":[{"resources":{"aws_vpc.vpc":{"type":"aws_vpc","primary":{"id":"vpc-1"}},"aws_subnet.nodes_z0":{"type":"aws_subnet","primary":{"id":"subnet-1"}}}},{"resources":{"aws_eip.eip_natgateway_z0":{"type":"aws_eip","primary":{"id":"eipalloc-1"}}}}]}`)
+
+			ids, err := resourceIDsFromState(state)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ids.List()).To(ConsistOf("vpc-1", "subnet-1", "eipalloc-1"))
+		})
+
+		It("should fail for an invalid state", func() {
+			_, err := resourceIDsFromState([]byte("{"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#resourceIdentifiersFromState", func() {
+		It("should return an empty set for an empty state", func() {
+			ids, err := resourceIdentifiersFromState(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ids.Len()).To(Equal(0))
+		})
+
+		It("should return the primary IDs of all resources", func() {
+			state := []byte(`{"modules":[{"resources":{"aws_vpc.vpc":{"type":"aws_vpc","primary":{"id":"vpc-1"}},"aws_subnet.nodes_z0":{"type":"aws_subnet","primary":{"id":"subnet-1"}}}},{"resources":{"aws_eip.eip_natgateway_z0":{"type":"aws_eip","primary":{"id":"eipalloc-1"}}}}]}`)
+
+			ids, err := resourceIdentifiersFromState(state)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ids.List()).To(ConsistOf("vpc-1", "subnet-1", "eipalloc-1"))
+		})
+
+		It("should return the lower case names of all resources", func() {
+			state := []byte(`{"modules":[{"resources":{"azurerm_virtual_network.vnet":{"type":"azurerm_virtual_network","primary":{"id":"/subscriptions/1/resourceGroups/RG/providers/Microsoft.Network/virtualNetworks/shoot--foo--bar","attributes":{"name":"shoot--foo--bar"}}}}}]}`)
+
+			ids, err := resourceIdentifiersFromState(state)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ids.List()).To(ConsistOf("/subscriptions/1/resourcegroups/rg/providers/microsoft.network/virtualnetworks/shoot--foo--bar", "shoot--foo--bar"))
+		})
+
+		It("should fail for an invalid state", func() {
+			_, err := resourceIdentifiersFromState([]byte("{"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#StateSummary", func() {
+		It("should return the resources and the output names but no output values", func() {
+			state := []byte(`{"modules":[{"outputs":{"vpc_id":{"value":"vpc-1"},"secret":{"value":"s3cr3t","sensitive":true}},"resources":{"aws_vpc.vpc":{"type":"aws_vpc","primary":{"id":"vpc-1","attributes":{"cidr_block":"10.250.0.0/16"}}}}}]}`)
+
+			resources, outputs, err := StateSummary(state)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resources).To(Equal(map[string]string{"aws_vpc.vpc": "vpc-1"}))
+			Expect(outputs).To(Equal([]string{"secret", "vpc_id"}))
+		})
+
+		It("should return nothing for an empty state", func() {
+			resources, outputs, err := StateSummary(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resources).To(BeEmpty())
+			Expect(outputs).To(BeEmpty())
+		})
+	})
+
+	Describe("#configurationItems", func() {
+		It("should mount the modules into their directories", func() {
+			configMap := &corev1.ConfigMap{Data: map[string]string{
+				MainKey:             "main",
+				VariablesKey:        "variables",
+				"module.zone_z0.tf": "zone",
+			}}
+
+			Expect(configurationItems(configMap)).To(Equal([]corev1.KeyToPath{
+				{Key: MainKey, Path: MainKey},
+				{Key: "module.zone_z0.tf", Path: "modules/zone_z0/main.tf"},
+				{Key: VariablesKey, Path: VariablesKey},
+			}))
+		})
+
+		It("should return nil if there are no modules", func() {
+			configMap := &corev1.ConfigMap{Data: map[string]string{
+				MainKey:      "main",
+				VariablesKey: "variables",
+			}}
+
+			Expect(configurationItems(configMap)).To(BeNil())
+		})
+	})
+
+	Describe("#moveStateResources", func() {
+		var moves = []StateResourceMove{
+			{Address: "alicloud_vswitch.vsw_z0", Module: "zone_z0", NewAddress: "alicloud_vswitch.vsw"},
+			{Address: "alicloud_eip.eip_natgw_z0", Module: "zone_z0", NewAddress: "alicloud_eip.eip_natgw"},
+			{Address: "alicloud_snat_entry.snat_z0", Module: "zone_z0", NewAddress: "alicloud_snat_entry.snat"},
+		}
+
+		It("should move the resources into the modules and rewrite their dependencies", func() {
+			state := []byte(`{"version":3,"serial":7,"lineage":"1234","modules":[{"path":["root"],"outputs":{"vpc_id":{"type":"string","value":"vpc-1"}},"resources":{` +
+				`"alicloud_vpc.vpc":{"type":"alicloud_vpc","depends_on":[],"primary":{"id":"vpc-1"},"provider":"provider.alicloud"},` +
+				`"alicloud_vswitch.vsw_z0":{"type":"alicloud_vswitch","depends_on":["alicloud_vpc.vpc"],"primary":{"id":"vsw-1"},"provider":"provider.alicloud"},` +
+				`"alicloud_eip.eip_natgw_z0":{"type":"alicloud_eip","depends_on":[],"primary":{"id":"eip-1"},"provider":"provider.alicloud"},` +
+				`"alicloud_snat_entry.snat_z0":{"type":"alicloud_snat_entry","depends_on":["alicloud_eip.eip_natgw_z0","alicloud_nat_gateway.nat_gateway","alicloud_vswitch.vsw_z0"],"primary":{"id":"snat-1"},"provider":"provider.alicloud"}` +
+				`},"depends_on":[]}]}`)
+
+			newState, moved, err := moveStateResources(state, moves)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(moved).To(BeTrue())
+			Expect(newState).To(MatchJSON(`{"version":3,"serial":8,"lineage":"1234","modules":[{"path":["root"],"outputs":{"vpc_id":{"type":"string","value":"vpc-1"}},"resources":{` +
+				`"alicloud_vpc.vpc":{"type":"alicloud_vpc","depends_on":[],"primary":{"id":"vpc-1"},"provider":"provider.alicloud"}` +
+				`},"depends_on":[]},{"path":["root","zone_z0"],"outputs":{},"resources":{` +
+				`"alicloud_vswitch.vsw":{"type":"alicloud_vswitch","depends_on":[],"primary":{"id":"vsw-1"},"provider":"provider.alicloud"},` +
+				`"alicloud_eip.eip_natgw":{"type":"alicloud_eip","depends_on":[],"primary":{"id":"eip-1"},"provider":"provider.alicloud"},` +
+				`"alicloud_snat_entry.snat":{"type":"alicloud_snat_entry","depends_on":["alicloud_eip.eip_natgw","alicloud_vswitch.vsw"],"primary":{"id":"snat-1"},"provider":"provider.alicloud"}` +
+				`},"depends_on":[]}]}`))
+		})
+
+		It("should not change a state whose resources have been moved already", func() {
+			state := []byte(`{"version":3,"serial":8,"modules":[{"path":["root"],"resources":{}},{"path":["root","zone_z0"],"resources":{"alicloud_vswitch.vsw":{"type":"alicloud_vswitch","primary":{"id":"vsw-1"}}}}]}`)
+
+			newState, moved, err := moveStateResources(state, moves)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(moved).To(BeFalse())
+			Expect(newState).To(Equal(state))
+		})
+
+		It("should not change an empty state", func() {
+			newState, moved, err := moveStateResources(nil, moves)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(moved).To(BeFalse())
+			Expect(newState).To(BeEmpty())
+		})
+
+		It("should fail for an invalid state", func() {
+			_, _, err := moveStateResources([]byte("{"), moves)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#ParseResourceImports", func() {
+		It("should parse the list of resource imports", func() {
+			imports, err := ParseResourceImports("aws_vpc.vpc=vpc-1, aws_nat_gateway.nat_gateway_z0=nat-2,")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(imports).To(Equal([]ResourceImport{
+				{Address: "aws_vpc.vpc", ID: "vpc-1"},
+				{Address: "aws_nat_gateway.nat_gateway_z0", ID: "nat-2"},
+			}))
+		})
+
+		It("should accept indexed addresses and path-like ids", func() {
+			imports, err := ParseResourceImports("azurerm_subnet.workers[0]=/subscriptions/abc/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/workers")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(imports).To(HaveLen(1))
+		})
+
+		It("should return nothing for an empty value", func() {
+			imports, err := ParseResourceImports("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(imports).To(BeEmpty())
+		})
+
+		It("should reject pairs without id", func() {
+			_, err := ParseResourceImports("aws_vpc.vpc")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject invalid addresses and ids", func() {
+			_, err := ParseResourceImports("vpc=vpc-1")
+			Expect(err).To(HaveOccurred())
+
+			_, err = ParseResourceImports("aws_vpc.vpc=vpc-1;rm -rf /")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject duplicate addresses", func() {
+			_, err := ParseResourceImports("aws_vpc.vpc=vpc-1,aws_vpc.vpc=vpc-2")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#importStateResources", func() {
+		var imports = []ResourceImport{
+			{Address: "aws_vpc.vpc", ID: "vpc-1"},
+			{Address: "aws_subnet.nodes[1]", ID: "subnet-2"},
+		}
+
+		It("should record the resources with their ids in the root module", func() {
+			state := []byte(`{"version":3,"serial":7,"lineage":"1234","modules":[{"path":["root"],"outputs":{},"resources":{` +
+				`"aws_vpc.vpc":{"type":"aws_vpc","depends_on":[],"primary":{"id":"vpc-0"},"provider":"provider.aws"}` +
+				`},"depends_on":[]}]}`)
+
+			newState, imported, err := importStateResources(state, imports)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(imported).To(ConsistOf("aws_subnet.nodes[1]"))
+			Expect(newState).To(MatchJSON(`{"version":3,"serial":8,"lineage":"1234","modules":[{"path":["root"],"outputs":{},"resources":{` +
+				`"aws_vpc.vpc":{"type":"aws_vpc","depends_on":[],"primary":{"id":"vpc-0"},"provider":"provider.aws"},` +
+				`"aws_subnet.nodes.1":{"type":"aws_subnet","depends_on":[],"primary":{"id":"subnet-2","attributes":{"id":"subnet-2"},"meta":{},"tainted":false},"deposed":[],"provider":"provider.aws"}` +
+				`},"depends_on":[]}]}`))
+		})
+
+		It("should initialize an empty state", func() {
+			newState, imported, err := importStateResources(nil, imports[:1])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(imported).To(ConsistOf("aws_vpc.vpc"))
+			Expect(newState).To(MatchJSON(`{"version":3,"serial":1,"modules":[{"path":["root"],"outputs":{},"resources":{` +
+				`"aws_vpc.vpc":{"type":"aws_vpc","depends_on":[],"primary":{"id":"vpc-1","attributes":{"id":"vpc-1"},"meta":{},"tainted":false},"deposed":[],"provider":"provider.aws"}` +
+				`},"depends_on":[]}]}`))
+		})
+
+		It("should not change a state which contains all resources already", func() {
+			state := []byte(`{"version":3,"serial":8,"modules":[{"path":["root"],"resources":{"aws_vpc.vpc":{"type":"aws_vpc","primary":{"id":"vpc-1"}}}}]}`)
+
+			newState, imported, err := importStateResources(state, imports[:1])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(imported).To(BeEmpty())
+			Expect(newState).To(Equal(state))
+		})
+
+		It("should fail for an invalid state", func() {
+			_, _, err := importStateResources([]byte("{"), imports)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#env", func() {
+		It("should disable colored output", func() {
+			tf := &Terraformer{stateName: "state"}
+
+			Expect(tf.env()).To(ContainElement(corev1.EnvVar{Name: "TF_IN_AUTOMATION", Value: "true"}))
+			Expect(tf.env()).To(ContainElement(corev1.EnvVar{Name: "TF_CLI_ARGS_apply", Value: "-no-color"}))
+			Expect(tf.env()).To(ContainElement(corev1.EnvVar{Name: "TF_CLI_ARGS_destroy", Value: "-no-color"}))
+		})
+	})
+
+	Describe("#operationLabels", func() {
+		It("should contain the operation ID", func() {
+			tf := (&Terraformer{}).SetOperationID("1234")
+
+			Expect(tf.operationLabels()).To(Equal(map[string]string{OperationIDLabel: "1234"}))
+		})
+
+		It("should be empty if no operation ID is set", func() {
+			Expect((&Terraformer{}).operationLabels()).To(BeEmpty())
+		})
+	})
+
+	Describe("#parseTerraformChanges", func() {
+		It("should parse the summary of an apply", func() {
+			Expect(parseTerraformChanges(map[string]string{
+				"pod-1": "Plan: 3 to add, 1 to change, 0 to destroy.",
+				"pod-2": "aws_vpc.vpc: Creation complete\n\nApply complete! Resources: 3 added, 1 changed, 0 destroyed.\n",
+			})).To(Equal(&extensionsv1alpha1.TerraformRunChanges{Added: 3, Changed: 1}))
+		})
+
+		It("should parse the summary of a destroy", func() {
+			Expect(parseTerraformChanges(map[string]string{
+				"pod-1": "Destroy complete! Resources: 12 destroyed.",
+			})).To(Equal(&extensionsv1alpha1.TerraformRunChanges{Destroyed: 12}))
+		})
+
+		It("should return nil if there is no summary", func() {
+			Expect(parseTerraformChanges(map[string]string{"pod-1": "Error: timeout"})).To(BeNil())
+		})
+	})
+
+	Describe("#parseTerraformErrors", func() {
+		It("should classify the errors of all pods and omit duplicates", func() {
+			Expect(parseTerraformErrors(map[string]string{
+				"pod-1": "Error: Error applying plan:\n\n2 error(s) occurred:\n\n* aws_vpc.vpc: VpcLimitExceeded: The maximum number of VPCs has been reached.\n* aws_subnet.nodes: timeout while waiting for state to become 'available'\n",
+				"pod-2": "Error: Error applying plan:\n\n1 error(s) occurred:\n\n* aws_vpc.vpc: VpcLimitExceeded: The maximum number of VPCs has been reached.\n",
+			})).To(Equal([]extensionsv1alpha1.TerraformRunError{
+				{Message: "aws_subnet.nodes: timeout while waiting for state to become 'available'", Code: gardencorev1alpha1.ErrorInfraTimeout},
+				{Message: "aws_vpc.vpc: VpcLimitExceeded: The maximum number of VPCs has been reached.", Code: gardencorev1alpha1.ErrorInfraQuotaExceeded},
+			}))
+		})
+
+		It("should return nil if there are no errors", func() {
+			Expect(parseTerraformErrors(map[string]string{"pod-1": "Apply complete! Resources: 0 added, 0 changed, 0 destroyed."})).To(BeNil())
+		})
+	})
+
+	Describe("#stripColors", func() {
+		It("should remove ANSI color codes", func() {
+			Expect(stripColors("\x1b[31mError: \x1b[0m\x1b[1mfoo\x1b[0m")).To(Equal("Error: foo"))
+		})
+	})
+
+	Describe("#progressLines", func() {
+		It("should return the progress lines written after the given time", func() {
+			logs := strings.Join([]string{
+				"2019-06-08T11:00:00.5Z aws_vpc.vpc: Creating...",
+				"2019-06-08T11:00:01.5Z aws_vpc.vpc: Creation complete after 1s [id=vpc-123]",
+				"2019-06-08T11:00:02.5Z \x1b[1maws_subnet.nodes: Still creating... (10s elapsed)\x1b[0m",
+				"2019-06-08T11:00:03.5Z Apply complete! Resources: 2 added, 0 changed, 0 destroyed.",
+				"no timestamp",
+				"",
+			}, "\n")
+
+			lines, newest := progressLines(logs, time.Date(2019, 6, 8, 11, 0, 0, 500000000, time.UTC))
+
+			Expect(lines).To(Equal([]string{
+				"aws_vpc.vpc: Creation complete after 1s [id=vpc-123]",
+				"aws_subnet.nodes: Still creating... (10s elapsed)",
+			}))
+			Expect(newest).To(Equal(time.Date(2019, 6, 8, 11, 0, 3, 500000000, time.UTC)))
+		})
+	})
+
+	Describe("#truncateRunLogs", func() {
+		It("should keep the end of long logs", func() {
+			logs := truncateRunLogs(map[string]string{"pod-1": strings.Repeat("a", runLogsLimit) + "Error: timeout"})
+
+			Expect(logs).To(HavePrefix("[... truncated ...]\n"))
+			Expect(logs).To(HaveSuffix("Error: timeout\n"))
+			Expect(len(logs)).To(Equal(runLogsLimit + len("[... truncated ...]\n")))
+		})
+
+		It("should concatenate the logs of all pods", func() {
+			Expect(truncateRunLogs(map[string]string{"pod-2": "two", "pod-1": "one"})).To(Equal("--- Logs of pod 'pod-1' ---\none\n--- Logs of pod 'pod-2' ---\ntwo\n"))
+		})
+	})
+
+	Describe("#terraformExitCode", func() {
+		It("should return the exit code of the most recently started pod", func() {
+			pod := func(exitCode int32, startedAt time.Time) corev1.Pod {
+				return corev1.Pod{Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: exitCode, StartedAt: metav1.NewTime(startedAt)}},
+				}}}}
+			}
+			now := time.Now()
+
+			exitCode := terraformExitCode(&corev1.PodList{Items: []corev1.Pod{pod(1, now), pod(2, now.Add(-time.Minute))}})
+
+			Expect(exitCode).NotTo(BeNil())
+			Expect(*exitCode).To(Equal(int32(1)))
+			Expect(terraformExitCode(&corev1.PodList{})).To(BeNil())
+		})
+	})
+
+	Describe("#pruneRuns", func() {
+		const (
+			namespace = "namespace"
+			name      = "shoot"
+		)
+
+		var (
+			ctx = context.TODO()
+			tf  *Terraformer
+		)
+
+		BeforeEach(func() {
+			tf = &Terraformer{
+				logger:    logrus.New(),
+				client:    client,
+				namespace: namespace,
+				name:      name,
+				purpose:   "infra",
+			}
+		})
+
+		It("should delete the oldest runs exceeding the history limit", func() {
+			var (
+				now  = time.Now()
+				runs []extensionsv1alpha1.TerraformRun
+			)
+			for i := 0; i < runHistoryLimit+2; i++ {
+				runs = append(runs, extensionsv1alpha1.TerraformRun{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: fmt.Sprintf("run-%d", i)},
+					Spec:       extensionsv1alpha1.TerraformRunSpec{Name: name},
+					Status:     extensionsv1alpha1.TerraformRunStatus{StartTime: metav1.NewTime(now.Add(time.Duration(-i) * time.Minute))},
+				})
+			}
+			// Runs of other Terraform configurations with the same purpose are not counted.
+			runs = append(runs, extensionsv1alpha1.TerraformRun{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "other"},
+				Spec:       extensionsv1alpha1.TerraformRunSpec{Name: "other"},
+			})
+
+			gomock.InOrder(
+				client.EXPECT().
+					List(ctx, gomock.Any(), gomock.AssignableToTypeOf(&extensionsv1alpha1.TerraformRunList{})).
+					DoAndReturn(func(_ context.Context, _ interface{}, list *extensionsv1alpha1.TerraformRunList) error {
+						list.Items = runs
+						return nil
+					}),
+				client.EXPECT().Delete(ctx, runs[runHistoryLimit+1].DeepCopy()),
+				client.EXPECT().Delete(ctx, runs[runHistoryLimit].DeepCopy()),
+			)
+
+			Expect(tf.pruneRuns(ctx)).To(Succeed())
+		})
+	})
 })
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils"
@@ -43,7 +44,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const jobNameLabel = "job-name"
+const (
+	jobNameLabel = "job-name"
+
+	// OperationIDLabel is the label of the Terraformer Pods which contains the ID of the operation which started them.
+	OperationIDLabel = "terraformer.gardener.cloud/operation-id"
+)
 
 // NewForConfig creates a new Terraformer and its dependencies from the given configuration.
 func NewForConfig(
@@ -54,7 +60,7 @@ func NewForConfig(
 	name,
 	image string,
 ) (*Terraformer, error) {
-	c, err := client.New(config, client.Options{})
+	c, err := client.New(config, client.Options{Scheme: kubernetes.SeedScheme})
 	if err != nil {
 		return nil, err
 	}
@@ -91,6 +97,7 @@ func New(
 		client:       client,
 		coreV1Client: coreV1Client,
 
+		name:      name,
 		namespace: namespace,
 		purpose:   purpose,
 		image:     image,
@@ -103,20 +110,26 @@ func New(
 	}
 }
 
-// Apply executes the Terraform Job by running the 'terraform apply' command.
-func (t *Terraformer) Apply() error {
+// Apply executes the Terraform Job by running the 'terraform apply' command. Resources which have been set with
+// SetResourceImports are imported into the Terraform state beforehand. If the given context is cancelled
+// while the Job is running, the Job is cleaned up and the cancellation is returned.
+func (t *Terraformer) Apply(ctx context.Context) error {
 	if !t.configurationDefined {
 		return errors.New("Terraformer configuration has not been defined, cannot execute the Terraform scripts")
 	}
-	return t.execute(context.TODO(), "apply")
+	if err := t.ImportStateResources(ctx, t.resourceImports); err != nil {
+		return err
+	}
+	return t.execute(ctx, "apply")
 }
 
-// Destroy executes the Terraform Job by running the 'terraform destroy' command.
-func (t *Terraformer) Destroy() error {
-	if err := t.execute(context.TODO(), "destroy"); err != nil {
+// Destroy executes the Terraform Job by running the 'terraform destroy' command. If the given context is cancelled
+// while the Job is running, the Job is cleaned up and the cancellation is returned.
+func (t *Terraformer) Destroy(ctx context.Context) error {
+	if err := t.execute(ctx, "destroy"); err != nil {
 		return err
 	}
-	return t.CleanupConfiguration(context.TODO())
+	return t.CleanupConfiguration(ctx)
 }
 
 // execute creates a Terraform Job which runs the provided scriptName (apply or destroy), waits for the Job to be completed
@@ -131,7 +144,9 @@ func (t *Terraformer) execute(ctx context.Context, scriptName string) error {
 	)
 
 	// We should retry the preparation check in order to allow the kube-apiserver to actually create the ConfigMaps.
-	if err := wait.PollImmediate(5*time.Second, 30*time.Second, func() (bool, error) {
+	prepareCtx, cancelPrepare := context.WithTimeout(ctx, 30*time.Second)
+	defer cancelPrepare()
+	if err := wait.PollImmediateUntil(5*time.Second, func() (bool, error) {
 		numberOfExistingResources, err := t.prepare(ctx)
 		if err != nil {
 			return false, err
@@ -147,13 +162,27 @@ func (t *Terraformer) execute(ctx context.Context, scriptName string) error {
 			t.logger.Error("Can not execute Terraform Job as ConfigMaps/Secrets are missing!")
 			return false, nil
 		}
-	}); err != nil {
+	}, prepareCtx.Done()); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
 		return err
 	}
 	if !execute {
 		return nil
 	}
 
+	recordStateVersion, err := t.checkStateVersion(ctx)
+	if err != nil {
+		return err
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := t.client.Get(ctx, kutil.Key(t.namespace, t.configName), configMap); err != nil {
+		return err
+	}
+	t.configurationItems = configurationItems(configMap)
+
 	// In case of scriptName == 'destroy', we need to first check whether the Terraform state contains
 	// something at all. If it does not contain anything, then the 'apply' could never be executed, probably
 	// because of syntax errors. In this case, we want to skip the Terraform job (as it wouldn't do anything
@@ -163,8 +192,19 @@ func (t *Terraformer) execute(ctx context.Context, scriptName string) error {
 		skipJob = t.isStateEmpty()
 	}
 
+	// The validation Pod and the Job both call the cloud provider API, hence, we wait for the rate limiter of the
+	// cloud provider account before starting them.
+	var run *extensionsv1alpha1.TerraformRun
+	if !skipPod || !skipJob {
+		if err := t.waitForRateLimiter(ctx); err != nil {
+			return err
+		}
+		run = t.startRun(ctx, scriptName)
+	}
+
 	if !skipPod {
 		if err := t.deployTerraformerPod(ctx, "validate"); err != nil {
+			t.completeRun(ctx, run, false, nil, nil)
 			return err
 		}
 
@@ -186,6 +226,7 @@ func (t *Terraformer) execute(ctx context.Context, scriptName string) error {
 	if !skipJob {
 		// Create Terraform Job which executes the provided scriptName
 		if err := t.deployTerraformerJob(ctx, scriptName); err != nil {
+			t.completeRun(ctx, run, false, nil, nil)
 			return fmt.Errorf("Failed to deploy the Terraformer: %s", err.Error())
 		}
 
@@ -194,6 +235,21 @@ func (t *Terraformer) execute(ctx context.Context, scriptName string) error {
 		t.logger.Infof("Terraform '%s' finished.", t.jobName)
 	}
 
+	// If the execution has been aborted, the context can no longer be used to wait for and to clean up the Job. A running
+	// Job must not be interrupted as Terraform would leave a locked or incomplete state behind and leak the resources it
+	// already created, hence, we let it finish before we clean it up.
+	aborted := ctx.Err()
+	if aborted != nil {
+		t.logger.Infof("Terraform '%s' has been aborted: %v", t.jobName, aborted)
+		if !skipJob {
+			t.logger.Infof("Waiting for the running Terraform job '%s' to finish before cleaning it up...", t.jobName)
+			succeeded = t.waitForJob(context.Background())
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), cleanupTimeout)
+		defer cancel()
+	}
+
 	// Retrieve the logs of the Pods belonging to the completed Job
 	jobPodList, err := t.listJobPods(ctx)
 	if err != nil {
@@ -208,15 +264,27 @@ func (t *Terraformer) execute(ctx context.Context, scriptName string) error {
 		logList = map[string]string{}
 	}
 	for podName, podLogs := range logList {
-		t.logger.Infof("Logs of Pod '%s' belonging to Terraform job '%s':\n%s", podName, t.jobName, podLogs)
+		logList[podName] = stripColors(podLogs)
+		t.logger.Infof("Logs of Pod '%s' belonging to Terraform job '%s':\n%s", podName, t.jobName, logList[podName])
 	}
 
+	// Record the outcome of the execution, the exit code of the validation Pod is only relevant if the Job was skipped
+	runExitCode := terraformExitCode(jobPodList)
+	if skipJob && !skipPod {
+		runExitCode = &exitCode
+	}
+	t.completeRun(ctx, run, succeeded, runExitCode, logList)
+
 	// Delete the Terraform Job and all its belonging Pods
 	t.logger.Infof("Cleaning up pods created by Terraform job '%s'...", t.jobName)
 	if err := t.cleanupJob(ctx, jobPodList); err != nil {
 		return err
 	}
 
+	if aborted != nil {
+		return aborted
+	}
+
 	// Evaluate whether the execution was successful or not
 	t.logger.Infof("Terraformer execution for job '%s' has been completed.", t.jobName)
 	if !succeeded {
@@ -226,10 +294,19 @@ func (t *Terraformer) execute(ctx context.Context, scriptName string) error {
 		}
 		return gardencorev1alpha1helper.DetermineError(errorMessage)
 	}
+
+	// The version is only recorded once the Job has successfully written the state, otherwise a failed execution of a
+	// newer version could not be rolled back.
+	if recordStateVersion && !skipJob {
+		return t.recordStateVersion(ctx)
+	}
 	return nil
 }
 
 const (
+	// cleanupTimeout is the time the Terraformer takes at most to clean up the Job of an aborted execution.
+	cleanupTimeout = 2 * time.Minute
+
 	terraformerName = "terraformer"
 	rbacName        = "gardener.cloud:system:terraformer"
 )
@@ -295,6 +372,9 @@ func (t *Terraformer) deployTerraformerPod(ctx context.Context, scriptName strin
 			pod.Labels = make(map[string]string)
 		}
 		pod.Labels[jobNameLabel] = t.jobName
+		for key, value := range t.operationLabels() {
+			pod.Labels[key] = value
+		}
 		pod.Spec = *t.podSpec(scriptName)
 		return nil
 	})
@@ -321,6 +401,7 @@ func (t *Terraformer) deployTerraformerJob(ctx context.Context, scriptName strin
 			ObjectMeta: metav1.ObjectMeta{
 				Namespace: t.namespace,
 				Name:      t.jobName,
+				Labels:    t.operationLabels(),
 			},
 			Spec: *podSpec,
 		}
@@ -329,11 +410,31 @@ func (t *Terraformer) deployTerraformerJob(ctx context.Context, scriptName strin
 	})
 }
 
+// SetOperationID sets the ID of the operation which executes the Terraformer. It is added as label to the Pods of the
+// Terraformer, so that their logs can be correlated with the logs of the operation.
+func (t *Terraformer) SetOperationID(operationID string) *Terraformer {
+	t.operationID = operationID
+	return t
+}
+
+func (t *Terraformer) operationLabels() map[string]string {
+	if len(t.operationID) == 0 {
+		return nil
+	}
+	return map[string]string{OperationIDLabel: t.operationID}
+}
+
 func (t *Terraformer) env() []corev1.EnvVar {
 	envVars := []corev1.EnvVar{
 		{Name: "MAX_BACKOFF_SEC", Value: "60"},
 		{Name: "MAX_TIME_SEC", Value: "1800"},
 		{Name: "TF_STATE_CONFIG_MAP_NAME", Value: t.stateName},
+		// Terraform shall not print hints for interactive usage or colored output, the logs are parsed for errors and
+		// the summary of the changes.
+		{Name: "TF_IN_AUTOMATION", Value: "true"},
+	}
+	for _, command := range []string{"init", "validate", "plan", "apply", "destroy", "refresh"} {
+		envVars = append(envVars, corev1.EnvVar{Name: "TF_CLI_ARGS_" + command, Value: "-no-color"})
 	}
 	for k, v := range t.variablesEnvironment {
 		envVars = append(envVars, corev1.EnvVar{Name: k, Value: v})
@@ -398,6 +499,7 @@ func (t *Terraformer) podSpec(scriptName string) *corev1.PodSpec {
 				VolumeSource: corev1.VolumeSource{
 					ConfigMap: &corev1.ConfigMapVolumeSource{
 						LocalObjectReference: corev1.LocalObjectReference{Name: t.configName},
+						Items:                t.configurationItems,
 					},
 				},
 			},
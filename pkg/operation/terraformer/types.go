@@ -15,17 +15,22 @@
 package terraformer
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
 )
 
 // Terraformer is a struct containing configuration parameters for the Terraform script it acts on.
+// * name is the name of the Terraform configuration (e.g. the name of the Shoot).
 // * purpose is a one-word description depicting what the Terraformer does (e.g. 'infrastructure').
 // * namespace is the namespace in which the Terraformer will act.
 // * image is the Docker image name of the Terraformer image.
+// * version is the version of the Terraformer image which is recorded on the state ConfigMap.
 // * configName is the name of the ConfigMap containing the main Terraform file ('main.tf').
+// * configurationItems are the paths the keys of the configuration ConfigMap are mounted at if it contains modules.
 // * variablesName is the name of the Secret containing the Terraform variables ('terraform.tfvars').
 // * stateName is the name of the ConfigMap containing the Terraform state ('terraform.tfstate').
 // * podName is the name of the Pod which will validate the Terraform file.
@@ -35,22 +40,33 @@ import (
 //   with TF_VAR_).
 // * configurationDefined indicates whether the required configuration ConfigMaps/Secrets have been
 //   successfully defined.
+// * rateLimiter is the token bucket of the cloud provider account which is consumed by every execution
+//   of Terraform.
+// * operationID is the ID of the operation executing the Terraformer which is added as label to its Pods.
+// * resourceImports is a list of pre-existing resources which will be imported into the Terraform
+//   state before the next 'terraform apply'.
 type Terraformer struct {
 	logger       logrus.FieldLogger
 	client       client.Client
 	coreV1Client corev1client.CoreV1Interface
 
+	name      string
 	purpose   string
 	namespace string
 	image     string
+	version   string
 
 	configName           string
+	configurationItems   []corev1.KeyToPath
 	variablesName        string
 	stateName            string
 	podName              string
 	jobName              string
 	variablesEnvironment map[string]string
 	configurationDefined bool
+	rateLimiter          *rate.Limiter
+	operationID          string
+	resourceImports      []ResourceImport
 }
 
 const numberOfConfigResources = 3
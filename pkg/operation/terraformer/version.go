@@ -0,0 +1,106 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/utils"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StateVersionAnnotation is the annotation on the state ConfigMap which records the version of the Terraformer that
+// has been used for the state most recently.
+const StateVersionAnnotation = "terraformer.gardener.cloud/version"
+
+// SetVersion sets the <version> of the Terraformer image. If it is set, the Terraformer refuses to run on a state
+// which has been written by a newer version, and it backs up the state before a newer version writes it.
+func (t *Terraformer) SetVersion(version string) *Terraformer {
+	t.version = version
+	return t
+}
+
+func (t *Terraformer) stateBackupName() string {
+	return t.stateName + "-backup"
+}
+
+// checkStateVersion compares the version of the Terraformer with the version recorded on the state ConfigMap. A
+// downgrade is rejected because older Terraform versions cannot read states written by newer ones. Before a newer
+// version runs for the first time, the state is copied into a backup ConfigMap so that it can be restored for a
+// rollback. Gardener does not migrate states itself, Terraform upgrades the state to its own format when it writes
+// it. It returns whether the version has to be recorded on the state ConfigMap after a successful execution.
+func (t *Terraformer) checkStateVersion(ctx context.Context) (bool, error) {
+	if len(t.version) == 0 {
+		return false, nil
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := t.client.Get(ctx, kutil.Key(t.namespace, t.stateName), configMap); err != nil {
+		return false, err
+	}
+
+	stateVersion, ok := configMap.Annotations[StateVersionAnnotation]
+	if stateVersion == t.version {
+		return false, nil
+	}
+	if !ok || len(configMap.Data[StateKey]) == 0 {
+		return true, nil
+	}
+
+	downgrade, err := utils.CompareVersions(t.version, "<", stateVersion)
+	if err != nil {
+		return false, err
+	}
+	if downgrade {
+		return false, fmt.Errorf("the Terraform state %q has been written by Terraformer version %s, downgrading to version %s is not supported", t.stateName, stateVersion, t.version)
+	}
+
+	// A previous execution of the newer version might have failed after it has written the state already, the backup
+	// taken before that execution must not be overwritten.
+	backup := &corev1.ConfigMap{}
+	if err := t.client.Get(ctx, kutil.Key(t.namespace, t.stateBackupName()), backup); err == nil && backup.Annotations[StateVersionAnnotation] == stateVersion {
+		return true, nil
+	} else if err != nil && !apierrors.IsNotFound(err) {
+		return false, err
+	}
+
+	t.logger.Infof("Backing up Terraform state '%s' of Terraformer version %s before it is written by version %s", t.stateName, stateVersion, t.version)
+	backup = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: t.namespace, Name: t.stateBackupName()}}
+	if err := kutil.CreateOrUpdate(ctx, t.client, backup, func() error {
+		metav1.SetMetaDataAnnotation(&backup.ObjectMeta, StateVersionAnnotation, stateVersion)
+		backup.Data = configMap.Data
+		return nil
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// recordStateVersion records the version of the Terraformer on the state ConfigMap. It must only be called after the
+// Terraformer has successfully written the state.
+func (t *Terraformer) recordStateVersion(ctx context.Context) error {
+	configMap := &corev1.ConfigMap{}
+	if err := t.client.Get(ctx, kutil.Key(t.namespace, t.stateName), configMap); err != nil {
+		return err
+	}
+
+	metav1.SetMetaDataAnnotation(&configMap.ObjectMeta, StateVersionAnnotation, t.version)
+	return t.client.Update(ctx, configMap)
+}
@@ -103,9 +103,13 @@ func (t *Terraformer) waitForJob(ctx context.Context) bool {
 	ctx, cancel := context.WithTimeout(ctx, time.Hour)
 	defer cancel()
 
-	var succeeded = false
+	var (
+		succeeded     = false
+		progressSince time.Time
+	)
 	if err := wait.PollUntil(5*time.Second, func() (bool, error) {
 		t.logger.Infof("Waiting for Terraform Job '%s' to be completed...", t.jobName)
+		t.logJobProgress(ctx, &progressSince)
 		job := &batchv1.Job{}
 		err := t.client.Get(ctx, kutil.Key(t.namespace, t.jobName), job)
 		if err != nil {
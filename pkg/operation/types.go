@@ -28,9 +28,11 @@ import (
 	"github.com/gardener/gardener/pkg/operation/seed"
 	"github.com/gardener/gardener/pkg/operation/shoot"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	prometheusapi "github.com/prometheus/client_golang/api"
 	prometheusclient "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -59,6 +61,14 @@ type Operation struct {
 	ShootBackup          *config.ShootBackup
 	MachineDeployments   MachineDeployments
 	MonitoringClient     prometheusclient.API
+
+	// SeedNamespaceSecrets caches the secrets of the Shoot namespace in the Seed cluster.
+	SeedNamespaceSecrets *kutil.SecretsCache
+	// ProjectSecrets caches the secrets of the Shoot's project namespace in the Garden cluster.
+	ProjectSecrets *kutil.SecretsCache
+	// CloudAPIRateLimiter is the token bucket of the Shoot's cloud provider account. It is shared by all operations
+	// acting on the same account and nil if the rate is not limited.
+	CloudAPIRateLimiter *rate.Limiter
 }
 
 // MachineDeployment holds information about the name, class, replicas of a MachineDeployment
@@ -73,6 +83,9 @@ type MachineDeployment struct {
 	Labels         map[string]string
 	Annotations    map[string]string
 	Taints         []corev1.Taint
+	// Priority is the priority of the machine deployment for the priority expander of the cluster-autoscaler. It is 0
+	// for machine deployments of worker pools without capacity configuration.
+	Priority int
 }
 
 // MachineDeployments is a list of machine deployments.
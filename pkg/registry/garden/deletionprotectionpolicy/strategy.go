@@ -0,0 +1,65 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletionprotectionpolicy
+
+import (
+	"context"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/apis/garden/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/storage/names"
+)
+
+type deletionProtectionPolicyStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy defines the storage strategy for DeletionProtectionPolicies.
+var Strategy = deletionProtectionPolicyStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (deletionProtectionPolicyStrategy) NamespaceScoped() bool {
+	return false
+}
+
+func (deletionProtectionPolicyStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+}
+
+func (deletionProtectionPolicyStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	policy := obj.(*garden.DeletionProtectionPolicy)
+	return validation.ValidateDeletionProtectionPolicy(policy)
+}
+
+func (deletionProtectionPolicyStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (deletionProtectionPolicyStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (deletionProtectionPolicyStrategy) PrepareForUpdate(ctx context.Context, newObj, oldObj runtime.Object) {
+}
+
+func (deletionProtectionPolicyStrategy) ValidateUpdate(ctx context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldPolicy, newPolicy := oldObj.(*garden.DeletionProtectionPolicy), newObj.(*garden.DeletionProtectionPolicy)
+	return validation.ValidateDeletionProtectionPolicyUpdate(newPolicy, oldPolicy)
+}
+
+func (deletionProtectionPolicyStrategy) AllowUnconditionalUpdate() bool {
+	return true
+}
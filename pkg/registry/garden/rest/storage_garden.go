@@ -20,19 +20,25 @@ import (
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	backupinfrastructurestore "github.com/gardener/gardener/pkg/registry/garden/backupinfrastructure/storage"
 	cloudprofilestore "github.com/gardener/gardener/pkg/registry/garden/cloudprofile/storage"
+	deletionprotectionpolicystore "github.com/gardener/gardener/pkg/registry/garden/deletionprotectionpolicy/storage"
 	projectstore "github.com/gardener/gardener/pkg/registry/garden/project/storage"
 	quotastore "github.com/gardener/gardener/pkg/registry/garden/quota/storage"
 	secretbinding "github.com/gardener/gardener/pkg/registry/garden/secretbinding/storage"
 	seedstore "github.com/gardener/gardener/pkg/registry/garden/seed/storage"
 	shootstore "github.com/gardener/gardener/pkg/registry/garden/shoot/storage"
+	shoottemplatestore "github.com/gardener/gardener/pkg/registry/garden/shoottemplate/storage"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/registry/generic"
 	"k8s.io/apiserver/pkg/registry/rest"
 	genericapiserver "k8s.io/apiserver/pkg/server"
 )
 
-// StorageProvider is an empty struct.
-type StorageProvider struct{}
+// StorageProvider contains configurations related to the Garden resources.
+type StorageProvider struct {
+	// OperationLogLocation describes how the operation logs of Shoots are retrieved. If it is nil then the log
+	// subresource of Shoots is not available.
+	OperationLogLocation *shootstore.OperationLogLocation
+}
 
 // NewRESTStorage creates a new API group info object and registers the v1beta1 Garden storage.
 func (p StorageProvider) NewRESTStorage(restOptionsGetter generic.RESTOptionsGetter) genericapiserver.APIGroupInfo {
@@ -56,6 +62,9 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 	cloudprofileStorage := cloudprofilestore.NewStorage(restOptionsGetter)
 	storage["cloudprofiles"] = cloudprofileStorage.CloudProfile
 
+	deletionProtectionPolicyStorage := deletionprotectionpolicystore.NewStorage(restOptionsGetter)
+	storage["deletionprotectionpolicies"] = deletionProtectionPolicyStorage.DeletionProtectionPolicy
+
 	projectStorage := projectstore.NewStorage(restOptionsGetter)
 	storage["projects"] = projectStorage.Project
 	storage["projects/status"] = projectStorage.Status
@@ -70,9 +79,13 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 	storage["seeds"] = seedStorage.Seed
 	storage["seeds/status"] = seedStorage.Status
 
-	shootStorage := shootstore.NewStorage(restOptionsGetter)
+	shootStorage := shootstore.NewStorage(restOptionsGetter, p.OperationLogLocation)
 	storage["shoots"] = shootStorage.Shoot
 	storage["shoots/status"] = shootStorage.Status
+	storage["shoots/log"] = shootStorage.Log
+
+	shootTemplateStorage := shoottemplatestore.NewStorage(restOptionsGetter)
+	storage["shoottemplates"] = shootTemplateStorage.ShootTemplate
 
 	return storage
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/gardener/gardener/pkg/apis/garden"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/apis/garden/validation"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -47,6 +48,10 @@ func (secretBindingStrategy) PrepareForCreate(ctx context.Context, obj runtime.O
 		finalizers.Insert(gardenv1beta1.GardenerName)
 	}
 	binding.Finalizers = finalizers.UnsortedList()
+
+	if binding.Rotation != nil {
+		binding.Rotation.Status = nil
+	}
 }
 
 func (secretBindingStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
@@ -62,8 +67,13 @@ func (secretBindingStrategy) AllowCreateOnUpdate() bool {
 }
 
 func (secretBindingStrategy) PrepareForUpdate(ctx context.Context, newObj, oldObj runtime.Object) {
-	_ = oldObj.(*garden.SecretBinding)
-	_ = newObj.(*garden.SecretBinding)
+	oldBinding := oldObj.(*garden.SecretBinding)
+	newBinding := newObj.(*garden.SecretBinding)
+
+	// A new rotation starts without any progress.
+	if newBinding.Rotation != nil && (oldBinding.Rotation == nil || !apiequality.Semantic.DeepEqual(oldBinding.Rotation.SecretRef, newBinding.Rotation.SecretRef)) {
+		newBinding.Rotation.Status = nil
+	}
 }
 
 func (secretBindingStrategy) AllowUnconditionalUpdate() bool {
@@ -0,0 +1,118 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+// OperationLogLocation describes how the operation logs of Shoots are retrieved from the Gardener controller manager.
+type OperationLogLocation struct {
+	// URL is the base URL of the HTTPS server of the Gardener controller manager.
+	URL *url.URL
+	// Transport is used for the requests to the Gardener controller manager. It must authenticate them.
+	Transport http.RoundTripper
+}
+
+// operationLogQueryParameters are the query parameters of the log subresource which are passed to the Gardener
+// controller manager.
+var operationLogQueryParameters = []string{"follow", "tailLines"}
+
+// LogREST implements the log subresource of Shoots. It streams the log of the operation which is currently running
+// for a Shoot by proxying the request to the Gardener controller manager, which runs the operation.
+type LogREST struct {
+	store    *genericregistry.Store
+	location *OperationLogLocation
+}
+
+var _ rest.Connecter = &LogREST{}
+
+// New creates a new (empty) internal Shoot object.
+func (r *LogREST) New() runtime.Object {
+	return &garden.Shoot{}
+}
+
+// ConnectMethods returns the methods supported by the log subresource.
+func (r *LogREST) ConnectMethods() []string {
+	return []string{http.MethodGet}
+}
+
+// NewConnectOptions returns no options, the query parameters are passed to the Gardener controller manager as they are.
+func (r *LogREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return nil, false, ""
+}
+
+// Connect returns a handler which proxies the request to the operation log of the Shoot with the given name in the
+// Gardener controller manager.
+func (r *LogREST) Connect(ctx context.Context, name string, _ runtime.Object, responder rest.Responder) (http.Handler, error) {
+	if r.location == nil || r.location.URL == nil {
+		return nil, apierrors.NewServiceUnavailable("streaming of operation logs is not configured")
+	}
+
+	obj, err := r.store.Get(ctx, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	shoot, ok := obj.(*garden.Shoot)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type %T", obj)
+	}
+
+	location := *r.location.URL
+	location.Path = path.Join(location.Path, "operationlogs", shoot.Namespace, shoot.Name)
+
+	return newOperationLogProxy(&location, r.location.Transport, responder), nil
+}
+
+// newOperationLogProxy creates a handler which streams the response of the given <location> to the client.
+func newOperationLogProxy(location *url.URL, transport http.RoundTripper, responder rest.Responder) http.Handler {
+	return &httputil.ReverseProxy{
+		Director: func(req *http.Request) {
+			query := url.Values{}
+			for _, parameter := range operationLogQueryParameters {
+				if value, ok := req.URL.Query()[parameter]; ok {
+					query[parameter] = value
+				}
+			}
+
+			req.URL.Scheme = location.Scheme
+			req.URL.Host = location.Host
+			req.URL.Path = location.Path
+			req.URL.RawQuery = query.Encode()
+			req.Host = location.Host
+			// The credentials of the client must not be passed on, the transport authenticates the request itself.
+			req.Header.Del("Authorization")
+		},
+		Transport:     transport,
+		FlushInterval: 100 * time.Millisecond,
+		ErrorHandler: func(w http.ResponseWriter, req *http.Request, err error) {
+			responder.Error(apierrors.NewServiceUnavailable(fmt.Sprintf("could not retrieve the operation log: %v", err)))
+		},
+	}
+}
@@ -31,19 +31,22 @@ type REST struct {
 	*genericregistry.Store
 }
 
-// ShootStorage implements the storage for Shoots and their status subresource.
+// ShootStorage implements the storage for Shoots and their status and log subresources.
 type ShootStorage struct {
 	Shoot  *REST
 	Status *StatusREST
+	Log    *LogREST
 }
 
-// NewStorage creates a new ShootStorage object.
-func NewStorage(optsGetter generic.RESTOptionsGetter) ShootStorage {
+// NewStorage creates a new ShootStorage object. Requests to the log subresource are rejected if no
+// <operationLogLocation> is given.
+func NewStorage(optsGetter generic.RESTOptionsGetter, operationLogLocation *OperationLogLocation) ShootStorage {
 	shootRest, shootStatusRest := NewREST(optsGetter)
 
 	return ShootStorage{
 		Shoot:  shootRest,
 		Status: shootStatusRest,
+		Log:    &LogREST{store: shootRest.Store, location: operationLogLocation},
 	}
 }
 
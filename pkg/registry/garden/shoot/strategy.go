@@ -21,6 +21,7 @@ import (
 	"github.com/gardener/gardener/pkg/api"
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/apis/garden/helper"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/apis/garden/validation"
 	"github.com/gardener/gardener/pkg/operation/common"
@@ -69,6 +70,14 @@ func (shootStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Obje
 	if mustIncreaseGeneration(oldShoot, newShoot) {
 		newShoot.Generation = oldShoot.Generation + 1
 	}
+
+	// The subnets of added or removed zones are only created or deleted when the infrastructure gets reconciled.
+	if !apiequality.Semantic.DeepEqual(helper.GetShootZones(oldShoot.Spec.Cloud), helper.GetShootZones(newShoot.Spec.Cloud)) {
+		if newShoot.Annotations == nil {
+			newShoot.Annotations = map[string]string{}
+		}
+		common.AddTasks(newShoot.Annotations, common.ShootTaskDeployInfrastructure)
+	}
 }
 
 func mustIncreaseGeneration(oldShoot, newShoot *garden.Shoot) bool {
@@ -91,6 +100,12 @@ func mustIncreaseGeneration(oldShoot, newShoot *garden.Shoot) bool {
 			if val, ok := newShoot.Annotations[common.ShootOperation]; ok && val == common.ShootOperationRetry {
 				mustIncrease = true
 			}
+		case gardencore.LastOperationStateError:
+			// The shoot state is erroneous and the retry or reconcile annotation is set. The retry annotation starts a
+			// new retry cycle which resets the number of consecutive failed attempts and the retry backoff.
+			if val, ok := newShoot.Annotations[common.ShootOperation]; ok && (val == common.ShootOperationRetry || val == common.ShootOperationReconcile) {
+				mustIncrease = true
+			}
 		default:
 			// The shoot state is not failed and the reconcile annotation is set.
 			if val, ok := newShoot.Annotations[common.ShootOperation]; ok && val == common.ShootOperationReconcile {
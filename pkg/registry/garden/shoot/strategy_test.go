@@ -15,9 +15,12 @@
 package shoot_test
 
 import (
+	"context"
 	"testing"
 
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/operation/common"
 	strategy "github.com/gardener/gardener/pkg/registry/garden/shoot"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -25,6 +28,7 @@ import (
 	"k8s.io/apiserver/pkg/storage"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
@@ -33,6 +37,55 @@ func TestHealth(t *testing.T) {
 	RunSpecs(t, "Shoot Suite")
 }
 
+var _ = Describe("PrepareForUpdate", func() {
+	It("should request an infrastructure reconciliation if the zones change", func() {
+		oldShoot := newShoot("foo")
+		oldShoot.Spec.Cloud.AWS = &garden.AWSCloud{Zones: []string{"zone-a"}}
+		newShoot := oldShoot.DeepCopy()
+		newShoot.Spec.Cloud.AWS.Zones = append(newShoot.Spec.Cloud.AWS.Zones, "zone-b")
+
+		strategy.Strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+		Expect(newShoot.Annotations).To(HaveKeyWithValue(common.ShootTasks, common.ShootTaskDeployInfrastructure))
+	})
+
+	It("should not touch the annotations if the zones stay the same", func() {
+		oldShoot := newShoot("foo")
+		oldShoot.Spec.Cloud.AWS = &garden.AWSCloud{Zones: []string{"zone-a"}}
+		newShoot := oldShoot.DeepCopy()
+
+		strategy.Strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+		Expect(newShoot.Annotations).NotTo(HaveKey(common.ShootTasks))
+	})
+
+	DescribeTable("operation annotation",
+		func(state gardencore.LastOperationState, operation string, increaseGeneration bool) {
+			oldShoot := newShoot("foo")
+			oldShoot.Generation = 1
+			oldShoot.Status.LastOperation = &gardencore.LastOperation{State: state}
+			newShoot := oldShoot.DeepCopy()
+			newShoot.Annotations = map[string]string{common.ShootOperation: operation}
+
+			strategy.Strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+			if increaseGeneration {
+				Expect(newShoot.Generation).To(Equal(int64(2)))
+				Expect(newShoot.Annotations).NotTo(HaveKey(common.ShootOperation))
+			} else {
+				Expect(newShoot.Generation).To(Equal(int64(1)))
+				Expect(newShoot.Annotations).To(HaveKeyWithValue(common.ShootOperation, operation))
+			}
+		},
+		Entry("retry a failed shoot", gardencore.LastOperationStateFailed, common.ShootOperationRetry, true),
+		Entry("reconcile a failed shoot", gardencore.LastOperationStateFailed, common.ShootOperationReconcile, false),
+		Entry("retry an erroneous shoot", gardencore.LastOperationStateError, common.ShootOperationRetry, true),
+		Entry("reconcile an erroneous shoot", gardencore.LastOperationStateError, common.ShootOperationReconcile, true),
+		Entry("retry a succeeded shoot", gardencore.LastOperationStateSucceeded, common.ShootOperationRetry, false),
+		Entry("reconcile a succeeded shoot", gardencore.LastOperationStateSucceeded, common.ShootOperationReconcile, true),
+	)
+})
+
 var _ = Describe("ToSelectableFields", func() {
 	It("should return correct fields", func() {
 		result := strategy.ToSelectableFields(newShoot("foo"))
@@ -0,0 +1,65 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoottemplate
+
+import (
+	"context"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/apis/garden/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/storage/names"
+)
+
+type shootTemplateStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy defines the storage strategy for ShootTemplates.
+var Strategy = shootTemplateStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (shootTemplateStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (shootTemplateStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+}
+
+func (shootTemplateStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	shootTemplate := obj.(*garden.ShootTemplate)
+	return validation.ValidateShootTemplate(shootTemplate)
+}
+
+func (shootTemplateStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (shootTemplateStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (shootTemplateStrategy) PrepareForUpdate(ctx context.Context, newObj, oldObj runtime.Object) {
+}
+
+func (shootTemplateStrategy) ValidateUpdate(ctx context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldShootTemplate, newShootTemplate := oldObj.(*garden.ShootTemplate), newObj.(*garden.ShootTemplate)
+	return validation.ValidateShootTemplateUpdate(newShootTemplate, oldShootTemplate)
+}
+
+func (shootTemplateStrategy) AllowUnconditionalUpdate() bool {
+	return true
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootwebhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ServiceDefaultsPath is the path under which the handler for the defaults of services is served.
+const ServiceDefaultsPath = "/webhooks/default-service-load-balancers"
+
+var serviceResource = metav1.GroupVersionResource{Group: "", Version: "v1", Resource: "services"}
+
+// NewServiceDefaultsHandler returns a HTTP handler which adds the given <annotations> to services of type
+// LoadBalancer when they are created. Annotations which are already set on a service are not overwritten.
+func NewServiceDefaultsHandler(logger logrus.FieldLogger, annotations map[string]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		review := admissionv1beta1.AdmissionReview{}
+
+		if contentType := r.Header.Get("Content-Type"); contentType != "application/json" {
+			err := fmt.Errorf("contentType=%s, expect application/json", contentType)
+			logger.Error(err)
+			respond(w, logger, errToAdmissionResponse(err))
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err == nil {
+			err = json.Unmarshal(body, &review)
+		}
+		if err != nil {
+			logger.Error(err)
+			respond(w, logger, errToAdmissionResponse(err))
+			return
+		}
+		if review.Request == nil {
+			err := fmt.Errorf("invalid request body (missing admission request)")
+			logger.Error(err)
+			respond(w, logger, errToAdmissionResponse(err))
+			return
+		}
+
+		response := DefaultServiceAnnotations(review.Request, annotations)
+		response.UID = review.Request.UID
+		respond(w, logger, response)
+	}
+}
+
+// DefaultServiceAnnotations computes the admission response for the given <request>. If the request creates a service
+// of type LoadBalancer then the response contains a JSON patch which adds all <annotations> which are not yet set on
+// the service. All other requests are admitted without changes.
+func DefaultServiceAnnotations(request *admissionv1beta1.AdmissionRequest, annotations map[string]string) *admissionv1beta1.AdmissionResponse {
+	if request.Operation != admissionv1beta1.Create || request.Resource != serviceResource || len(request.SubResource) > 0 {
+		return &admissionv1beta1.AdmissionResponse{Allowed: true}
+	}
+
+	service := &corev1.Service{}
+	if err := json.Unmarshal(request.Object.Raw, service); err != nil {
+		return errToAdmissionResponse(err)
+	}
+	if service.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return &admissionv1beta1.AdmissionResponse{Allowed: true}
+	}
+
+	var missing []string
+	for key := range annotations {
+		if _, ok := service.Annotations[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) == 0 {
+		return &admissionv1beta1.AdmissionResponse{Allowed: true}
+	}
+	sort.Strings(missing)
+
+	var patch []jsonPatchOperation
+	if service.Annotations == nil {
+		patch = append(patch, jsonPatchOperation{Operation: "add", Path: "/metadata/annotations", Value: map[string]string{}})
+	}
+	for _, key := range missing {
+		patch = append(patch, jsonPatchOperation{Operation: "add", Path: "/metadata/annotations/" + escapeJSONPointer(key), Value: annotations[key]})
+	}
+
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		return errToAdmissionResponse(err)
+	}
+
+	patchType := admissionv1beta1.PatchTypeJSONPatch
+	return &admissionv1beta1.AdmissionResponse{
+		Allowed:   true,
+		Patch:     patchBytes,
+		PatchType: &patchType,
+	}
+}
+
+type jsonPatchOperation struct {
+	Operation string      `json:"op"`
+	Path      string      `json:"path"`
+	Value     interface{} `json:"value"`
+}
+
+// escapeJSONPointer escapes the given annotation key so that it can be used as part of a JSON pointer (RFC 6901).
+func escapeJSONPointer(key string) string {
+	return strings.Replace(strings.Replace(key, "~", "~0", -1), "/", "~1", -1)
+}
+
+func errToAdmissionResponse(err error) *admissionv1beta1.AdmissionResponse {
+	return &admissionv1beta1.AdmissionResponse{
+		Allowed: false,
+		Result: &metav1.Status{
+			Message: err.Error(),
+		},
+	}
+}
+
+func respond(w http.ResponseWriter, logger logrus.FieldLogger, response *admissionv1beta1.AdmissionResponse) {
+	jsonResponse, err := json.Marshal(admissionv1beta1.AdmissionReview{Response: response})
+	if err != nil {
+		logger.Error(err)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(jsonResponse); err != nil {
+		logger.Error(err)
+	}
+}
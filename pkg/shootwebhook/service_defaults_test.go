@@ -0,0 +1,105 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootwebhook_test
+
+import (
+	"encoding/json"
+
+	. "github.com/gardener/gardener/pkg/shootwebhook"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("#DefaultServiceAnnotations", func() {
+	var (
+		annotations = map[string]string{
+			"service.beta.kubernetes.io/aws-load-balancer-type":     "nlb",
+			"service.beta.kubernetes.io/aws-load-balancer-internal": "true",
+		}
+
+		request = func(operation admissionv1beta1.Operation, service *corev1.Service) *admissionv1beta1.AdmissionRequest {
+			raw, err := json.Marshal(service)
+			Expect(err).NotTo(HaveOccurred())
+
+			return &admissionv1beta1.AdmissionRequest{
+				Operation: operation,
+				Resource:  metav1.GroupVersionResource{Version: "v1", Resource: "services"},
+				Object:    runtime.RawExtension{Raw: raw},
+			}
+		}
+	)
+
+	It("should not patch services which are not of type LoadBalancer", func() {
+		service := &corev1.Service{Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP}}
+
+		response := DefaultServiceAnnotations(request(admissionv1beta1.Create, service), annotations)
+
+		Expect(response.Allowed).To(BeTrue())
+		Expect(response.Patch).To(BeNil())
+	})
+
+	It("should not patch services which are updated", func() {
+		service := &corev1.Service{Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer}}
+
+		response := DefaultServiceAnnotations(request(admissionv1beta1.Update, service), annotations)
+
+		Expect(response.Allowed).To(BeTrue())
+		Expect(response.Patch).To(BeNil())
+	})
+
+	It("should add the missing annotations without overwriting existing ones", func() {
+		service := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{"service.beta.kubernetes.io/aws-load-balancer-internal": "0.0.0.0/0"},
+			},
+			Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+		}
+
+		response := DefaultServiceAnnotations(request(admissionv1beta1.Create, service), annotations)
+
+		Expect(response.Allowed).To(BeTrue())
+		Expect(*response.PatchType).To(Equal(admissionv1beta1.PatchTypeJSONPatch))
+		Expect(response.Patch).To(MatchJSON(`[{"op":"add","path":"/metadata/annotations/service.beta.kubernetes.io~1aws-load-balancer-type","value":"nlb"}]`))
+	})
+
+	It("should create the annotations if the service has none", func() {
+		service := &corev1.Service{Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer}}
+
+		response := DefaultServiceAnnotations(request(admissionv1beta1.Create, service), annotations)
+
+		Expect(response.Allowed).To(BeTrue())
+		Expect(response.Patch).To(MatchJSON(`[
+			{"op":"add","path":"/metadata/annotations","value":{}},
+			{"op":"add","path":"/metadata/annotations/service.beta.kubernetes.io~1aws-load-balancer-internal","value":"true"},
+			{"op":"add","path":"/metadata/annotations/service.beta.kubernetes.io~1aws-load-balancer-type","value":"nlb"}
+		]`))
+	})
+
+	It("should deny objects which cannot be decoded", func() {
+		response := DefaultServiceAnnotations(&admissionv1beta1.AdmissionRequest{
+			Operation: admissionv1beta1.Create,
+			Resource:  metav1.GroupVersionResource{Version: "v1", Resource: "services"},
+			Object:    runtime.RawExtension{Raw: []byte("{")},
+		}, annotations)
+
+		Expect(response.Allowed).To(BeFalse())
+	})
+})
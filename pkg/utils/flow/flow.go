@@ -32,6 +32,9 @@ const (
 	logKeyTask = "task"
 )
 
+// ErrStopped is the cause of a canceled Flow whose StopCh has been closed.
+var ErrStopped = errors.New("flow has been stopped")
+
 // ProgressReporter is continuously called on progress in a flow.
 type ProgressReporter func(*Stats)
 
@@ -99,6 +102,11 @@ type Opts struct {
 	Logger           logrus.FieldLogger
 	ProgressReporter func(stats *Stats)
 	Context          context.Context
+	// StopCh stops the execution of further tasks once it is closed. In contrast to a canceled Context, the running
+	// tasks are not interrupted but may finish.
+	StopCh <-chan struct{}
+	// Tracker records the progress of the single tasks, so that it can be inspected while the Flow is running.
+	Tracker *Tracker
 }
 
 // Run starts an execution of a Flow.
@@ -108,7 +116,7 @@ func (f *Flow) Run(opts Opts) error {
 	if ctx == nil {
 		ctx = context.Background()
 	}
-	return newExecution(f, opts.Logger, opts.ProgressReporter).run(ctx)
+	return newExecution(f, opts.Logger, opts.ProgressReporter, opts.Tracker).run(ctx, opts.StopCh)
 }
 
 type nodeResult struct {
@@ -153,7 +161,7 @@ func InitialStats(all TaskIDs) *Stats {
 	}
 }
 
-func newExecution(flow *Flow, logger logrus.FieldLogger, reporter ProgressReporter) *execution {
+func newExecution(flow *Flow, logger logrus.FieldLogger, reporter ProgressReporter, tracker *Tracker) *execution {
 	all := NewTaskIDs()
 
 	for name := range flow.nodes {
@@ -171,6 +179,7 @@ func newExecution(flow *Flow, logger logrus.FieldLogger, reporter ProgressReport
 		nil,
 		logger,
 		reporter,
+		tracker,
 		make(chan *nodeResult),
 		make(map[TaskID]int),
 	}
@@ -184,6 +193,7 @@ type execution struct {
 
 	log              logrus.FieldLogger
 	progressReporter ProgressReporter
+	tracker          *Tracker
 
 	done          chan *nodeResult
 	triggerCounts map[TaskID]int
@@ -201,8 +211,10 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 
 		start := time.Now().UTC()
 		log.Debugf("Started")
-		err := e.flow.nodes[id].fn(ctx)
+		e.tracker.taskStarted(id, start)
+		err := e.flow.nodes[id].fn(withTrackedTask(ctx, e.tracker, id))
 		end := time.Now().UTC()
+		e.tracker.taskFinished(id, end, err)
 		log.Debugf("Finished, took %s", end.Sub(start))
 
 		if err != nil {
@@ -242,9 +254,23 @@ func (e *execution) reportProgress() {
 	}
 }
 
-func (e *execution) run(ctx context.Context) error {
+// stopped returns the reason why no further tasks must be started, if any.
+func stopped(ctx context.Context, stopCh <-chan struct{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	select {
+	case <-stopCh:
+		return ErrStopped
+	default:
+		return nil
+	}
+}
+
+func (e *execution) run(ctx context.Context, stopCh <-chan struct{}) error {
 	defer close(e.done)
 	e.log.Infof("Starting flow")
+	e.tracker.start(e.flow.name, e.stats.All)
 	e.reportProgress()
 
 	var (
@@ -252,7 +278,7 @@ func (e *execution) run(ctx context.Context) error {
 		roots     = e.flow.nodes.rootIDs()
 	)
 	for name := range roots {
-		if cancelErr = ctx.Err(); cancelErr == nil {
+		if cancelErr = stopped(ctx, stopCh); cancelErr == nil {
 			e.runNode(ctx, name)
 			e.reportProgress()
 		}
@@ -265,7 +291,7 @@ func (e *execution) run(ctx context.Context) error {
 			e.updateFailure(result.TaskID)
 		} else {
 			e.updateSuccess(result.TaskID)
-			if cancelErr = ctx.Err(); cancelErr == nil {
+			if cancelErr = stopped(ctx, stopCh); cancelErr == nil {
 				e.processTriggers(ctx, result.TaskID)
 			}
 		}
@@ -160,6 +160,28 @@ var _ = Describe("Flow", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(flow.WasCanceled(err)).To(BeTrue())
 		})
+
+		It("should stop the execution in between tasks without interrupting the running task", func() {
+			var (
+				g      = flow.NewGraph("foo")
+				stopCh = make(chan struct{})
+				x      = g.Add(flow.Task{Name: "x", Fn: func(ctx context.Context) error {
+					close(stopCh)
+					Expect(ctx.Err()).NotTo(HaveOccurred())
+					return nil
+				}})
+				_ = g.Add(flow.Task{Name: "y", Fn: func(ctx context.Context) error {
+					Fail("Task has been called")
+					return nil
+				}, Dependencies: flow.NewTaskIDs(x)})
+				f = g.Compile()
+			)
+
+			err := f.Run(flow.Opts{StopCh: stopCh})
+			Expect(err).To(HaveOccurred())
+			Expect(flow.WasCanceled(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(flow.ErrStopped.Error()))
+		})
 	})
 
 	Describe("#Sequential", func() {
@@ -66,6 +66,7 @@ func (t TaskFn) Retry(interval time.Duration) TaskFn {
 	return func(ctx context.Context) error {
 		return utils.RetryUntil(ctx, interval, func() (ok, severe bool, err error) {
 			if err := t(ctx); err != nil {
+				recordFailedAttempt(ctx, err)
 				return false, false, err
 			}
 			return true, false, nil
@@ -93,6 +94,7 @@ func (t TaskFn) RetryUntilTimeout(interval, timeout time.Duration) TaskFn {
 
 		return utils.RetryUntil(ctx, interval, func() (ok, severe bool, err error) {
 			if err := t(ctx); err != nil {
+				recordFailedAttempt(ctx, err)
 				return false, false, err
 			}
 			return true, false, nil
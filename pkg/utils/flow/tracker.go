@@ -0,0 +1,259 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flow
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// TaskState is the state of a task within a Flow execution.
+type TaskState string
+
+const (
+	// TaskStatePending is the state of tasks which have not been started yet.
+	TaskStatePending TaskState = "Pending"
+	// TaskStateRunning is the state of tasks which are currently running.
+	TaskStateRunning TaskState = "Running"
+	// TaskStateSucceeded is the state of tasks which have finished successfully.
+	TaskStateSucceeded TaskState = "Succeeded"
+	// TaskStateFailed is the state of tasks which have finished with an error.
+	TaskStateFailed TaskState = "Failed"
+)
+
+// TaskStatus is the status of a single task of a Flow execution.
+type TaskStatus struct {
+	ID        TaskID     `json:"id"`
+	State     TaskState  `json:"state"`
+	StartTime *time.Time `json:"startTime,omitempty"`
+	// Duration is the time the task has been running so far, or the time it took if it has finished.
+	Duration string `json:"duration,omitempty"`
+	// Retries is the number of failed attempts of a task which is retried.
+	Retries int `json:"retries,omitempty"`
+	// LastError is the error of the last failed attempt of the task.
+	LastError string `json:"lastError,omitempty"`
+}
+
+// Snapshot is the status of a Flow execution at a certain point in time.
+type Snapshot struct {
+	Name      string       `json:"name"`
+	StartTime time.Time    `json:"startTime"`
+	Tasks     []TaskStatus `json:"tasks"`
+}
+
+type taskRecord struct {
+	state     TaskState
+	startTime time.Time
+	endTime   time.Time
+	retries   int
+	lastError error
+}
+
+// Tracker records the progress of the tasks of a Flow execution so that it can be inspected while the Flow is
+// running. All methods may be called on a nil Tracker, in which case nothing is recorded.
+type Tracker struct {
+	mutex     sync.RWMutex
+	name      string
+	startTime time.Time
+	tasks     map[TaskID]*taskRecord
+}
+
+// NewTracker creates a new Tracker. It is passed to a Flow execution with the Opts.
+func NewTracker() *Tracker {
+	return &Tracker{tasks: make(map[TaskID]*taskRecord)}
+}
+
+func (t *Tracker) start(name string, all TaskIDs) {
+	if t == nil {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.name = name
+	t.startTime = time.Now().UTC()
+	for id := range all {
+		t.tasks[id] = &taskRecord{state: TaskStatePending}
+	}
+}
+
+func (t *Tracker) taskStarted(id TaskID, startTime time.Time) {
+	t.update(id, func(task *taskRecord) {
+		task.state = TaskStateRunning
+		task.startTime = startTime
+	})
+}
+
+func (t *Tracker) taskFinished(id TaskID, endTime time.Time, err error) {
+	t.update(id, func(task *taskRecord) {
+		task.state = TaskStateSucceeded
+		if err != nil {
+			task.state = TaskStateFailed
+			task.lastError = err
+		}
+		task.endTime = endTime
+	})
+}
+
+func (t *Tracker) attemptFailed(id TaskID, err error) {
+	t.update(id, func(task *taskRecord) {
+		task.retries++
+		task.lastError = err
+	})
+}
+
+func (t *Tracker) update(id TaskID, mutate func(*taskRecord)) {
+	if t == nil {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if task, ok := t.tasks[id]; ok {
+		mutate(task)
+	}
+}
+
+// Snapshot returns the current status of the tracked Flow execution. The tasks are sorted by their start time,
+// pending tasks come last.
+func (t *Tracker) Snapshot() *Snapshot {
+	if t == nil {
+		return nil
+	}
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	var (
+		now      = time.Now().UTC()
+		snapshot = &Snapshot{Name: t.name, StartTime: t.startTime}
+	)
+	for id, task := range t.tasks {
+		status := TaskStatus{ID: id, State: task.state, Retries: task.retries}
+		if task.state != TaskStatePending {
+			startTime := task.startTime
+			endTime := task.endTime
+			if task.state == TaskStateRunning {
+				endTime = now
+			}
+			status.StartTime = &startTime
+			status.Duration = endTime.Sub(startTime).Round(time.Millisecond).String()
+		}
+		if task.lastError != nil {
+			status.LastError = task.lastError.Error()
+		}
+		snapshot.Tasks = append(snapshot.Tasks, status)
+	}
+
+	sort.Slice(snapshot.Tasks, func(i, j int) bool {
+		a, b := snapshot.Tasks[i], snapshot.Tasks[j]
+		if (a.StartTime == nil) != (b.StartTime == nil) {
+			return b.StartTime == nil
+		}
+		if a.StartTime != nil && !a.StartTime.Equal(*b.StartTime) {
+			return a.StartTime.Before(*b.StartTime)
+		}
+		return a.ID < b.ID
+	})
+	return snapshot
+}
+
+type trackedTaskKey struct{}
+
+type trackedTask struct {
+	tracker *Tracker
+	id      TaskID
+}
+
+func withTrackedTask(ctx context.Context, tracker *Tracker, id TaskID) context.Context {
+	if tracker == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, trackedTaskKey{}, trackedTask{tracker, id})
+}
+
+// recordFailedAttempt records a failed attempt of a retried task in the Tracker of the Flow execution, if any.
+func recordFailedAttempt(ctx context.Context, err error) {
+	if task, ok := ctx.Value(trackedTaskKey{}).(trackedTask); ok {
+		task.tracker.attemptFailed(task.id, err)
+	}
+}
+
+// Registry holds the Trackers of the currently running Flow executions by a key, e.g. the key of the object the
+// Flows act on. All methods may be called on a nil Registry, in which case nothing is tracked.
+type Registry struct {
+	mutex    sync.RWMutex
+	trackers map[string]*Tracker
+}
+
+// NewRegistry creates a new Registry.
+func NewRegistry() *Registry {
+	return &Registry{trackers: make(map[string]*Tracker)}
+}
+
+// Track registers a new Tracker for the given key and returns it together with a function which removes it from
+// the Registry again once the Flow execution has finished.
+func (r *Registry) Track(key string) (*Tracker, func()) {
+	if r == nil {
+		return nil, func() {}
+	}
+
+	tracker := NewTracker()
+
+	r.mutex.Lock()
+	r.trackers[key] = tracker
+	r.mutex.Unlock()
+
+	return tracker, func() {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+
+		if r.trackers[key] == tracker {
+			delete(r.trackers, key)
+		}
+	}
+}
+
+// Snapshot returns the current status of the Flow execution tracked for the given key. It returns false if no
+// Flow is running for the key.
+func (r *Registry) Snapshot(key string) (*Snapshot, bool) {
+	if r == nil {
+		return nil, false
+	}
+	r.mutex.RLock()
+	tracker, ok := r.trackers[key]
+	r.mutex.RUnlock()
+
+	if !ok {
+		return nil, false
+	}
+	return tracker.Snapshot(), true
+}
+
+// Snapshots returns the current status of all tracked Flow executions by their keys.
+func (r *Registry) Snapshots() map[string]*Snapshot {
+	snapshots := make(map[string]*Snapshot)
+	if r == nil {
+		return snapshots
+	}
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for key, tracker := range r.trackers {
+		snapshots[key] = tracker.Snapshot()
+	}
+	return snapshots
+}
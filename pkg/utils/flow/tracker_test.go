@@ -0,0 +1,136 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flow_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("Tracker", func() {
+	It("should record the states of the tasks", func() {
+		var (
+			tracker = flow.NewTracker()
+			err     = errors.New("err")
+			g       = flow.NewGraph("foo")
+			x       = g.Add(flow.Task{Name: "x", Fn: flow.EmptyTaskFn})
+			y       = g.Add(flow.Task{Name: "y", Fn: func(ctx context.Context) error { return err }, Dependencies: flow.NewTaskIDs(x)})
+			_       = g.Add(flow.Task{Name: "z", Fn: flow.EmptyTaskFn, Dependencies: flow.NewTaskIDs(y)})
+			f       = g.Compile()
+		)
+
+		Expect(f.Run(flow.Opts{Tracker: tracker})).To(HaveOccurred())
+
+		snapshot := tracker.Snapshot()
+		Expect(snapshot.Name).To(Equal("foo"))
+		Expect(snapshot.Tasks).To(HaveLen(3))
+		Expect(snapshot.Tasks[0]).To(MatchFields(IgnoreExtras, Fields{
+			"ID":        Equal(flow.TaskID("x")),
+			"State":     Equal(flow.TaskStateSucceeded),
+			"StartTime": Not(BeNil()),
+		}))
+		Expect(snapshot.Tasks[1]).To(MatchFields(IgnoreExtras, Fields{
+			"ID":        Equal(flow.TaskID("y")),
+			"State":     Equal(flow.TaskStateFailed),
+			"LastError": Equal("err"),
+		}))
+		Expect(snapshot.Tasks[2]).To(MatchFields(IgnoreExtras, Fields{
+			"ID":        Equal(flow.TaskID("z")),
+			"State":     Equal(flow.TaskStatePending),
+			"StartTime": BeNil(),
+		}))
+	})
+
+	It("should record the failed attempts of retried tasks", func() {
+		var (
+			tracker  = flow.NewTracker()
+			attempts = 0
+			g        = flow.NewGraph("foo")
+			_        = g.Add(flow.Task{Name: "x", Fn: flow.TaskFn(func(ctx context.Context) error {
+				attempts++
+				if attempts < 3 {
+					return errors.New("not yet")
+				}
+				return nil
+			}).RetryUntilTimeout(time.Millisecond, time.Minute)})
+			f = g.Compile()
+		)
+
+		Expect(f.Run(flow.Opts{Tracker: tracker})).To(Succeed())
+
+		Expect(tracker.Snapshot().Tasks).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"State":     Equal(flow.TaskStateSucceeded),
+			"Retries":   Equal(2),
+			"LastError": Equal("not yet"),
+		})))
+	})
+})
+
+var _ = Describe("Registry", func() {
+	It("should return the snapshots of running flows only", func() {
+		var (
+			registry = flow.NewRegistry()
+			started  = make(chan struct{})
+			release  = make(chan struct{})
+			done     = make(chan struct{})
+			g        = flow.NewGraph("foo")
+			_        = g.Add(flow.Task{Name: "x", Fn: func(ctx context.Context) error {
+				close(started)
+				<-release
+				return nil
+			}})
+			f = g.Compile()
+		)
+
+		tracker, untrack := registry.Track("garden-dev/foo")
+		go func() {
+			defer close(done)
+			defer untrack()
+			Expect(f.Run(flow.Opts{Tracker: tracker})).To(Succeed())
+		}()
+
+		<-started
+		snapshot, ok := registry.Snapshot("garden-dev/foo")
+		Expect(ok).To(BeTrue())
+		Expect(snapshot.Tasks).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"ID":    Equal(flow.TaskID("x")),
+			"State": Equal(flow.TaskStateRunning),
+		})))
+		Expect(registry.Snapshots()).To(HaveKey("garden-dev/foo"))
+
+		close(release)
+		<-done
+		_, ok = registry.Snapshot("garden-dev/foo")
+		Expect(ok).To(BeFalse())
+		Expect(registry.Snapshots()).To(BeEmpty())
+	})
+
+	It("should not track anything if it is nil", func() {
+		var registry *flow.Registry
+
+		tracker, untrack := registry.Track("garden-dev/foo")
+		Expect(tracker).To(BeNil())
+		untrack()
+		Expect(tracker.Snapshot()).To(BeNil())
+		Expect(registry.Snapshots()).To(BeEmpty())
+	})
+})
@@ -65,9 +65,15 @@ func mergeImageSources(old, override *ImageSource) *ImageSource {
 		runtimeVersion = old.RuntimeVersion
 	}
 
+	architecture := override.Architecture
+	if architecture == nil {
+		architecture = old.Architecture
+	}
+
 	return &ImageSource{
 		Name:           override.Name,
 		RuntimeVersion: runtimeVersion,
+		Architecture:   architecture,
 		Repository:     override.Repository,
 		Tag:            tag,
 	}
@@ -76,17 +82,22 @@ func mergeImageSources(old, override *ImageSource) *ImageSource {
 type imageSourceKey struct {
 	Name           string
 	RuntimeVersion string
+	Architecture   string
 }
 
 func computeKey(source *ImageSource) imageSourceKey {
-	var runtimeVersion string
+	var runtimeVersion, architecture string
 	if source.RuntimeVersion != nil {
 		runtimeVersion = *source.RuntimeVersion
 	}
+	if source.Architecture != nil {
+		architecture = *source.Architecture
+	}
 
 	return imageSourceKey{
 		Name:           source.Name,
 		RuntimeVersion: runtimeVersion,
+		Architecture:   architecture,
 	}
 }
 
@@ -135,7 +146,7 @@ func WithEnvOverride(vector ImageVector) (ImageVector, error) {
 
 // String implements Stringer.
 func (o *FindOptions) String() string {
-	return fmt.Sprintf("runtime version %v target version %v", o.RuntimeVersion, o.TargetVersion)
+	return fmt.Sprintf("runtime version %v target version %v architecture %v", o.RuntimeVersion, o.TargetVersion, o.Architecture)
 }
 
 // ApplyOptions applies the given FindOptionFuncs to these FindOptions. Returns a pointer to the mutated value.
@@ -160,6 +171,34 @@ func TargetVersion(version string) FindOptionFunc {
 	}
 }
 
+// Architecture sets the Architecture of the FindOptions to the given CPU architecture.
+func Architecture(architecture string) FindOptionFunc {
+	return func(options *FindOptions) {
+		options.Architecture = &architecture
+	}
+}
+
+// defaultArchitecture is the architecture which is assumed if no architecture is given in the FindOptions.
+const defaultArchitecture = "amd64"
+
+// checkArchitecture checks whether an image for the given <architecture> matches the wanted <architecture>. Images
+// without architecture (e.g. multi-arch images) match every architecture but are scored lower.
+func checkArchitecture(architecture, wanted *string) (score int, ok bool) {
+	if architecture == nil {
+		return 0, true
+	}
+
+	wantedArchitecture := defaultArchitecture
+	if wanted != nil {
+		wantedArchitecture = *wanted
+	}
+
+	if *architecture != wantedArchitecture {
+		return 0, false
+	}
+	return 1, true
+}
+
 func checkConstraint(constraint, version *string) (score int, ok bool, err error) {
 	if constraint == nil || version == nil {
 		return 0, true, nil
@@ -185,6 +224,13 @@ func match(source *ImageSource, name string, opts *FindOptions) (score int, ok b
 
 	score += runtimeScore
 
+	architectureScore, ok := checkArchitecture(source.Architecture, opts.Architecture)
+	if !ok {
+		return 0, false, nil
+	}
+
+	score += architectureScore
+
 	return score, true, nil
 }
 
@@ -213,6 +259,7 @@ func (v ImageVector) FindImage(name string, opts ...FindOptionFunc) (*Image, err
 
 			if ok && (bestCandidate == nil || score > bestScore) {
 				bestCandidate = source
+				bestScore = score
 			}
 		}
 	}
@@ -78,6 +78,9 @@ var _ = Describe("imagevector", func() {
 
 			image3Name string
 			image3Src1 *ImageSource
+
+			arm64       string
+			image1Arm64 *ImageSource
 		)
 
 		resetValues := func() {
@@ -148,6 +151,14 @@ var _ = Describe("imagevector", func() {
 				Repository: repo3,
 			}
 
+			arm64 = "arm64"
+			image1Arm64 = &ImageSource{
+				Name:         image1Name,
+				Repository:   repo3,
+				Tag:          &tag1,
+				Architecture: &arm64,
+			}
+
 			image1Src1Vector = ImageVector{image1Src1}
 
 			image1Src1VectorJSON = fmt.Sprintf(`
@@ -308,6 +319,24 @@ images:
 				[]FindOptionFunc{k8s164RuntimeVersion},
 				Equal(image1Src1.ToImage(nil)),
 				Not(HaveOccurred())),
+			Entry("two entries, match with architecture",
+				ImageVector{image1Src5, image1Arm64},
+				image1Name,
+				[]FindOptionFunc{Architecture(arm64)},
+				Equal(image1Arm64.ToImage(nil)),
+				Not(HaveOccurred())),
+			Entry("two entries, match without architecture",
+				ImageVector{image1Arm64, image1Src5},
+				image1Name,
+				nil,
+				Equal(image1Src5.ToImage(nil)),
+				Not(HaveOccurred())),
+			Entry("single entry, architecture mismatch",
+				ImageVector{image1Arm64},
+				image1Name,
+				[]FindOptionFunc{Architecture("amd64")},
+				BeNil(),
+				HaveOccurred()),
 		)
 
 		Describe("#FindImages", func() {
@@ -16,10 +16,12 @@ package imagevector
 
 // ImageSource contains the repository and the tag of a Docker container image. If the respective
 // image is only valid for a specific Kubernetes version, then it must also contain the 'versions'
-// field describing for which versions it can be used.
+// field describing for which versions it can be used. If the image is only built for a specific
+// CPU architecture, then it must contain the 'architecture' field.
 type ImageSource struct {
 	Name           string  `json:"name" yaml:"name"`
 	RuntimeVersion *string `json:"runtimeVersion,omitempty" yaml:"runtimeVersion,omitempty"`
+	Architecture   *string `json:"architecture,omitempty" yaml:"architecture,omitempty"`
 
 	Repository string  `json:"repository" yaml:"repository"`
 	Tag        *string `json:"tag,omitempty" yaml:"tag,omitempty"`
@@ -39,6 +41,7 @@ type ImageVector []*ImageSource
 type FindOptions struct {
 	RuntimeVersion *string
 	TargetVersion  *string
+	Architecture   *string
 }
 
 // FindOptionFunc is a function that mutates FindOptions.
@@ -23,6 +23,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"k8s.io/client-go/rest"
@@ -284,6 +285,19 @@ func CheckMachineDeployment(deployment *machinev1alpha1.MachineDeployment) error
 	return nil
 }
 
+// CheckPodDisruptionBudget checks whether the given PodDisruptionBudget is respected.
+// A PodDisruptionBudget is considered respected if its controller observed its current generation and if at least
+// as many of the selected pods are healthy as desired.
+func CheckPodDisruptionBudget(pdb *policyv1beta1.PodDisruptionBudget) error {
+	if pdb.Status.ObservedGeneration < pdb.Generation {
+		return fmt.Errorf("observed generation outdated (%d/%d)", pdb.Status.ObservedGeneration, pdb.Generation)
+	}
+	if pdb.Status.CurrentHealthy < pdb.Status.DesiredHealthy {
+		return fmt.Errorf("not enough healthy pods (%d/%d)", pdb.Status.CurrentHealthy, pdb.Status.DesiredHealthy)
+	}
+	return nil
+}
+
 // Now determines the current time.
 var Now = time.Now
 
@@ -23,6 +23,7 @@ import (
 	"github.com/onsi/gomega/types"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"testing"
@@ -163,6 +164,24 @@ var _ = Describe("health", func() {
 		)
 	})
 
+	Context("CheckPodDisruptionBudget", func() {
+		DescribeTable("pod disruption budgets",
+			func(pdb *policyv1beta1.PodDisruptionBudget, matcher types.GomegaMatcher) {
+				err := health.CheckPodDisruptionBudget(pdb)
+				Expect(err).To(matcher)
+			},
+			Entry("respected", &policyv1beta1.PodDisruptionBudget{
+				Status: policyv1beta1.PodDisruptionBudgetStatus{CurrentHealthy: 2, DesiredHealthy: 2},
+			}, BeNil()),
+			Entry("not observed at latest generation", &policyv1beta1.PodDisruptionBudget{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+			}, HaveOccurred()),
+			Entry("not enough healthy pods", &policyv1beta1.PodDisruptionBudget{
+				Status: policyv1beta1.PodDisruptionBudgetStatus{CurrentHealthy: 1, DesiredHealthy: 2},
+			}, HaveOccurred()),
+		)
+	})
+
 	Context("CheckMachineDeployment", func() {
 		DescribeTable("machine deployments",
 			func(machineDeployment *gardenv1alpha1.MachineDeployment, matcher types.GomegaMatcher) {
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -127,3 +128,31 @@ func WaitUntilResourceDeletedWithDefaults(ctx context.Context, c client.Client,
 
 	return WaitUntilResourceDeleted(ctx, c, obj, 5*time.Second)
 }
+
+// ListPageSize is the number of objects which are requested per page by EachListItem.
+const ListPageSize = 500
+
+// EachListItem lists the objects returned by the given list function in pages of ListPageSize objects and calls fn
+// for every item. In contrast to a full list, neither the API server nor the caller has to load all objects at once.
+func EachListItem(listFunc func(metav1.ListOptions) (runtime.Object, error), fn func(runtime.Object) error) error {
+	options := metav1.ListOptions{Limit: ListPageSize}
+
+	for {
+		list, err := listFunc(options)
+		if err != nil {
+			return err
+		}
+		if err := meta.EachListItem(list, fn); err != nil {
+			return err
+		}
+
+		listMeta, err := meta.ListAccessor(list)
+		if err != nil {
+			return err
+		}
+		if len(listMeta.GetContinue()) == 0 {
+			return nil
+		}
+		options.Continue = listMeta.GetContinue()
+	}
+}
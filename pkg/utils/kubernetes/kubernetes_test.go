@@ -97,6 +97,47 @@ var _ = Describe("kubernetes", func() {
 		})
 	})
 
+	Describe("#EachListItem", func() {
+		It("should call the function for the items of all pages", func() {
+			var (
+				pages = map[string]*corev1.ConfigMapList{
+					"": {
+						ListMeta: metav1.ListMeta{Continue: "page-2"},
+						Items:    []corev1.ConfigMap{{ObjectMeta: metav1.ObjectMeta{Name: "a"}}, {ObjectMeta: metav1.ObjectMeta{Name: "b"}}},
+					},
+					"page-2": {
+						Items: []corev1.ConfigMap{{ObjectMeta: metav1.ObjectMeta{Name: "c"}}},
+					},
+				}
+				names []string
+			)
+
+			err := EachListItem(func(options metav1.ListOptions) (runtime.Object, error) {
+				Expect(options.Limit).To(Equal(int64(ListPageSize)))
+				return pages[options.Continue], nil
+			}, func(obj runtime.Object) error {
+				names = append(names, obj.(*corev1.ConfigMap).Name)
+				return nil
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names).To(Equal([]string{"a", "b", "c"}))
+		})
+
+		It("should return the error of the list function", func() {
+			listErr := errors.New("error")
+
+			err := EachListItem(func(metav1.ListOptions) (runtime.Object, error) {
+				return nil, listErr
+			}, func(runtime.Object) error {
+				Fail("function must not be called")
+				return nil
+			})
+
+			Expect(err).To(BeIdenticalTo(listErr))
+		})
+	})
+
 	Describe("#CreateOrUpdate", func() {
 		const (
 			namespace = "foo"
@@ -0,0 +1,268 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SecretsCache reads the Secrets of a single namespace and keeps them in memory so that subsequent reads do not
+// hit the API server again. Writes go through the cache and are skipped if the content of the Secret did not change.
+// It is meant to live for the duration of one operation only, i.e., it does not watch for changes done by others.
+type SecretsCache struct {
+	client    client.Client
+	namespace string
+
+	mutex   sync.RWMutex
+	secrets map[string]*corev1.Secret
+	listed  bool
+}
+
+// NewSecretsCache creates a new SecretsCache for the given <namespace>.
+func NewSecretsCache(c client.Client, namespace string) *SecretsCache {
+	return &SecretsCache{
+		client:    c,
+		namespace: namespace,
+		secrets:   make(map[string]*corev1.Secret),
+	}
+}
+
+// List returns all Secrets of the namespace matching the given <selector>. All Secrets of the namespace are read with
+// a single request the first time, later calls are served from the cache.
+func (s *SecretsCache) List(ctx context.Context, selector labels.Selector) (map[string]*corev1.Secret, error) {
+	if err := s.fill(ctx); err != nil {
+		return nil, err
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	out := make(map[string]*corev1.Secret, len(s.secrets))
+	for name, secret := range s.secrets {
+		if selector == nil || selector.Matches(labels.Set(secret.Labels)) {
+			out[name] = secret.DeepCopy()
+		}
+	}
+	return out, nil
+}
+
+// Get returns the Secret with the given <name>. It is read from the API server only if it is not yet cached. Secrets
+// which do not exist are not remembered as they might be created by others in the meantime.
+func (s *SecretsCache) Get(ctx context.Context, name string) (*corev1.Secret, error) {
+	s.mutex.RLock()
+	secret, ok := s.secrets[name]
+	s.mutex.RUnlock()
+
+	if ok {
+		return secret.DeepCopy(), nil
+	}
+
+	secret = &corev1.Secret{}
+	if err := s.client.Get(ctx, Key(s.namespace, name), secret); err != nil {
+		return nil, err
+	}
+	s.store(secret)
+	return secret.DeepCopy(), nil
+}
+
+// CreateOrUpdate creates the given <secret> or updates the existing one with its type, data, labels, annotations and
+// owner references. The labels of the existing Secret are replaced by the given ones, annotations are merged. The
+// update is skipped if the existing Secret already has the desired content. It returns whether the Secret has been
+// changed.
+func (s *SecretsCache) CreateOrUpdate(ctx context.Context, secret *corev1.Secret) (bool, error) {
+	secret.Namespace = s.namespace
+
+	existing, err := s.Get(ctx, secret.Name)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, err
+		}
+		if err := s.client.Create(ctx, secret); err != nil {
+			return false, err
+		}
+		s.store(secret)
+		return true, nil
+	}
+
+	if SecretContentHash(existing) == SecretContentHash(secret) &&
+		equalLabels(secret.Labels, existing.Labels) &&
+		isSubset(secret.Annotations, existing.Annotations) &&
+		(len(secret.OwnerReferences) == 0 || equalOwnerReferences(secret, existing)) {
+		secret.ObjectMeta = existing.ObjectMeta
+		return false, nil
+	}
+
+	existing.Labels = secret.Labels
+	for key, value := range secret.Annotations {
+		if existing.Annotations == nil {
+			existing.Annotations = make(map[string]string)
+		}
+		existing.Annotations[key] = value
+	}
+	if len(secret.OwnerReferences) > 0 {
+		existing.OwnerReferences = secret.OwnerReferences
+	}
+	existing.Type = secret.Type
+	existing.Data = secret.Data
+
+	if err := s.client.Update(ctx, existing); err != nil {
+		s.forget(secret.Name)
+		return false, err
+	}
+	s.store(existing)
+	secret.ObjectMeta = existing.ObjectMeta
+	return true, nil
+}
+
+// CreateSecret creates a Secret with the given <name>, <secretType> and <data> in the namespace of the cache. If it
+// already exists, it is only updated if <updateIfExists> is true, otherwise an AlreadyExists error is returned. It
+// has the same signature as kubernetes.Interface#CreateSecret so that the cache can be used wherever Secrets are
+// generated.
+func (s *SecretsCache) CreateSecret(namespace, name string, secretType corev1.SecretType, data map[string][]byte, updateIfExists bool) (*corev1.Secret, error) {
+	if namespace != s.namespace {
+		return nil, fmt.Errorf("cannot create secret %s/%s with a cache for namespace %s", namespace, name, s.namespace)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Type: secretType,
+		Data: data,
+	}
+
+	if !updateIfExists {
+		if err := s.client.Create(context.TODO(), secret); err != nil {
+			return nil, err
+		}
+		s.store(secret)
+		return secret, nil
+	}
+
+	if _, err := s.CreateOrUpdate(context.TODO(), secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// Delete deletes the Secret with the given <name>. It does not return an error if the Secret does not exist.
+func (s *SecretsCache) Delete(ctx context.Context, name string) error {
+	secret := &corev1.Secret{}
+	secret.Namespace, secret.Name = s.namespace, name
+	s.forget(name)
+	if err := s.client.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *SecretsCache) fill(ctx context.Context) error {
+	s.mutex.RLock()
+	listed := s.listed
+	s.mutex.RUnlock()
+	if listed {
+		return nil
+	}
+
+	secretList := &corev1.SecretList{}
+	if err := s.client.List(ctx, &client.ListOptions{Namespace: s.namespace}, secretList); err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.secrets = make(map[string]*corev1.Secret, len(secretList.Items))
+	for _, secret := range secretList.Items {
+		secretObj := secret
+		s.secrets[secret.Name] = &secretObj
+	}
+	s.listed = true
+	return nil
+}
+
+func (s *SecretsCache) store(secret *corev1.Secret) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.secrets[secret.Name] = secret.DeepCopy()
+}
+
+func (s *SecretsCache) forget(name string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.secrets, name)
+}
+
+// SecretContentHash computes a hash over the type and the data of the given <secret>. An empty type is treated
+// like the default type 'Opaque'.
+func SecretContentHash(secret *corev1.Secret) string {
+	secretType := secret.Type
+	if len(secretType) == 0 {
+		secretType = corev1.SecretTypeOpaque
+	}
+
+	keys := make([]string, 0, len(secret.Data))
+	for key := range secret.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	hash := sha256.New()
+	hash.Write([]byte(secretType))
+	for _, key := range keys {
+		hash.Write([]byte{0})
+		hash.Write([]byte(key))
+		hash.Write([]byte{0})
+		hash.Write(secret.Data[key])
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func isSubset(subset, set map[string]string) bool {
+	for key, value := range subset {
+		if v, ok := set[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+func equalLabels(a, b map[string]string) bool {
+	return len(a) == len(b) && isSubset(a, b)
+}
+
+func equalOwnerReferences(a, b *corev1.Secret) bool {
+	if len(a.OwnerReferences) != len(b.OwnerReferences) {
+		return false
+	}
+	for i := range a.OwnerReferences {
+		if a.OwnerReferences[i].UID != b.OwnerReferences[i].UID || a.OwnerReferences[i].Name != b.OwnerReferences[i].Name || a.OwnerReferences[i].Kind != b.OwnerReferences[i].Kind {
+			return false
+		}
+	}
+	return true
+}
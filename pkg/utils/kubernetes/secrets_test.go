@@ -0,0 +1,241 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+
+	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
+	"github.com/golang/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var _ = Describe("SecretsCache", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx  = context.TODO()
+		ctrl *gomock.Controller
+		c    *mockclient.MockClient
+
+		cache  *SecretsCache
+		secret *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+
+		cache = NewSecretsCache(c, namespace)
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "secret", Labels: map[string]string{"foo": "bar"}},
+			Type:       corev1.SecretTypeOpaque,
+			Data:       map[string][]byte{"key": []byte("value")},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#List", func() {
+		It("should list the secrets only once and filter them by label", func() {
+			other := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "other"}}
+
+			c.EXPECT().
+				List(gomock.Any(), &client.ListOptions{Namespace: namespace}, gomock.AssignableToTypeOf(&corev1.SecretList{})).
+				DoAndReturn(func(_ context.Context, _ *client.ListOptions, list *corev1.SecretList) error {
+					list.Items = []corev1.Secret{*secret, *other}
+					return nil
+				})
+
+			all, err := cache.List(ctx, labels.Everything())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(all).To(HaveLen(2))
+
+			filtered, err := cache.List(ctx, labels.SelectorFromSet(labels.Set{"foo": "bar"}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filtered).To(HaveKey("secret"))
+			Expect(filtered).To(HaveLen(1))
+
+			result, err := cache.Get(ctx, "other")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Name).To(Equal("other"))
+		})
+	})
+
+	Describe("#CreateOrUpdate", func() {
+		It("should create a non-existing secret", func() {
+			gomock.InOrder(
+				c.EXPECT().
+					Get(gomock.Any(), Key(namespace, "secret"), gomock.AssignableToTypeOf(&corev1.Secret{})).
+					Return(apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "secret")),
+				c.EXPECT().
+					Create(gomock.Any(), secret).
+					Return(nil),
+			)
+
+			changed, err := cache.CreateOrUpdate(ctx, secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeTrue())
+		})
+
+		It("should skip the update if the content did not change", func() {
+			existing := secret.DeepCopy()
+
+			c.EXPECT().
+				Get(gomock.Any(), Key(namespace, "secret"), gomock.AssignableToTypeOf(&corev1.Secret{})).
+				DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *corev1.Secret) error {
+					existing.DeepCopyInto(obj)
+					return nil
+				})
+
+			changed, err := cache.CreateOrUpdate(ctx, secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeFalse())
+
+			changed, err = cache.CreateOrUpdate(ctx, secret.DeepCopy())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeFalse())
+		})
+
+		It("should update the secret if the data changed", func() {
+			existing := secret.DeepCopy()
+			secret.Data["key"] = []byte("new-value")
+
+			gomock.InOrder(
+				c.EXPECT().
+					Get(gomock.Any(), Key(namespace, "secret"), gomock.AssignableToTypeOf(&corev1.Secret{})).
+					DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *corev1.Secret) error {
+						existing.DeepCopyInto(obj)
+						return nil
+					}),
+				c.EXPECT().
+					Update(gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
+					DoAndReturn(func(_ context.Context, obj *corev1.Secret) error {
+						Expect(obj.Data).To(Equal(secret.Data))
+						return nil
+					}),
+			)
+
+			changed, err := cache.CreateOrUpdate(ctx, secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeTrue())
+		})
+
+		It("should remove labels which are not desired anymore", func() {
+			existing := secret.DeepCopy()
+			existing.Labels["other"] = "label"
+
+			gomock.InOrder(
+				c.EXPECT().
+					Get(gomock.Any(), Key(namespace, "secret"), gomock.AssignableToTypeOf(&corev1.Secret{})).
+					DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *corev1.Secret) error {
+						existing.DeepCopyInto(obj)
+						return nil
+					}),
+				c.EXPECT().
+					Update(gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
+					DoAndReturn(func(_ context.Context, obj *corev1.Secret) error {
+						Expect(obj.Labels).To(Equal(map[string]string{"foo": "bar"}))
+						return nil
+					}),
+			)
+
+			changed, err := cache.CreateOrUpdate(ctx, secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeTrue())
+		})
+	})
+
+	Describe("#CreateSecret", func() {
+		It("should serve the created secret from the cache", func() {
+			c.EXPECT().
+				Create(gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
+				Return(nil)
+
+			created, err := cache.CreateSecret(namespace, "secret", corev1.SecretTypeOpaque, secret.Data, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created.Data).To(Equal(secret.Data))
+
+			result, err := cache.Get(ctx, "secret")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Data).To(Equal(secret.Data))
+		})
+
+		It("should update the cached secret if it exists", func() {
+			existing := secret.DeepCopy()
+
+			gomock.InOrder(
+				c.EXPECT().
+					Get(gomock.Any(), Key(namespace, "secret"), gomock.AssignableToTypeOf(&corev1.Secret{})).
+					DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *corev1.Secret) error {
+						existing.DeepCopyInto(obj)
+						return nil
+					}),
+				c.EXPECT().
+					Update(gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
+					Return(nil),
+			)
+
+			data := map[string][]byte{"key": []byte("new-value")}
+			_, err := cache.CreateSecret(namespace, "secret", corev1.SecretTypeOpaque, data, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := cache.Get(ctx, "secret")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Data).To(Equal(data))
+		})
+
+		It("should refuse secrets of other namespaces", func() {
+			_, err := cache.CreateSecret("other", "secret", corev1.SecretTypeOpaque, secret.Data, false)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should ignore secrets which do not exist", func() {
+			c.EXPECT().
+				Delete(gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
+				Return(apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "secret"))
+
+			Expect(cache.Delete(ctx, "secret")).To(Succeed())
+		})
+	})
+
+	Describe("#SecretContentHash", func() {
+		It("should treat an empty type like the opaque type", func() {
+			untyped := secret.DeepCopy()
+			untyped.Type = ""
+
+			Expect(SecretContentHash(untyped)).To(Equal(SecretContentHash(secret)))
+		})
+
+		It("should change if the data changes", func() {
+			changed := secret.DeepCopy()
+			changed.Data["key"] = []byte("other")
+
+			Expect(SecretContentHash(changed)).NotTo(Equal(SecretContentHash(secret)))
+		})
+	})
+})
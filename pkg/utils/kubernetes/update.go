@@ -0,0 +1,98 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultBackoff is the backoff used by controllers when updating objects. It retries with an
+// exponentially growing interval up to five times (10ms, 50ms, 250ms, 1.25s).
+var DefaultBackoff = retry.DefaultBackoff
+
+// RetryOnConflict executes <fn> and retries it with the given <backoff> characteristics as long as it
+// returns Conflict errors. Other errors are returned immediately. Contrary to retry.RetryOnConflict,
+// waiting between the attempts is aborted as soon as the given context is done.
+func RetryOnConflict(ctx context.Context, backoff wait.Backoff, fn func() error) error {
+	var (
+		duration = backoff.Duration
+		err      error
+	)
+
+	for i := 0; i < backoff.Steps; i++ {
+		if i > 0 {
+			sleep := duration
+			if backoff.Jitter > 0 {
+				sleep = wait.Jitter(duration, backoff.Jitter)
+			}
+			if err := utils.Sleep(ctx, sleep); err != nil {
+				return err
+			}
+			duration = time.Duration(float64(duration) * backoff.Factor)
+		}
+
+		if err = fn(); err == nil || !apierrors.IsConflict(err) {
+			return err
+		}
+	}
+	return err
+}
+
+// TryUpdate tries to update the given <obj>. It retries with the given <backoff> characteristics as long
+// as it gets Conflict errors. The transformation function is applied to the current state of the object
+// which is read into <obj> before. If the transformation leaves the object semantically unchanged, no
+// update is done and the operation returns normally.
+func TryUpdate(ctx context.Context, backoff wait.Backoff, c client.Client, obj runtime.Object, transform func() error) error {
+	return tryUpdate(ctx, backoff, c, obj, c.Update, transform)
+}
+
+// TryUpdateStatus tries to update the status of the given <obj>. It behaves like TryUpdate but uses the
+// status subresource for the update.
+func TryUpdateStatus(ctx context.Context, backoff wait.Backoff, c client.Client, obj runtime.Object, transform func() error) error {
+	return tryUpdate(ctx, backoff, c, obj, c.Status().Update, transform)
+}
+
+func tryUpdate(ctx context.Context, backoff wait.Backoff, c client.Client, obj runtime.Object, updateFunc func(context.Context, runtime.Object) error, transform func() error) error {
+	key, err := client.ObjectKeyFromObject(obj)
+	if err != nil {
+		return err
+	}
+
+	return RetryOnConflict(ctx, backoff, func() error {
+		if err := c.Get(ctx, key, obj); err != nil {
+			return err
+		}
+
+		before := obj.DeepCopyObject()
+		if err := transform(); err != nil {
+			return err
+		}
+
+		if equality.Semantic.DeepEqual(before, obj) {
+			return nil
+		}
+		return updateFunc(ctx, obj)
+	})
+}
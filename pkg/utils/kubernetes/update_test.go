@@ -0,0 +1,176 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("update", func() {
+	const (
+		namespace = "foo"
+		name      = "bar"
+	)
+
+	var (
+		ctrl *gomock.Controller
+		c    *mockclient.MockClient
+
+		backoff  = wait.Backoff{Duration: time.Millisecond, Factor: 1, Steps: 3}
+		conflict = apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, name, errors.New("conflict"))
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#RetryOnConflict", func() {
+		It("should retry as long as the function returns conflicts", func() {
+			attempts := 0
+
+			Expect(RetryOnConflict(context.TODO(), backoff, func() error {
+				attempts++
+				if attempts < 3 {
+					return conflict
+				}
+				return nil
+			})).To(Succeed())
+			Expect(attempts).To(Equal(3))
+		})
+
+		It("should return the conflict if the steps are exhausted", func() {
+			attempts := 0
+
+			Expect(RetryOnConflict(context.TODO(), backoff, func() error {
+				attempts++
+				return conflict
+			})).To(Equal(conflict))
+			Expect(attempts).To(Equal(3))
+		})
+
+		It("should not retry on other errors", func() {
+			var (
+				attempts    = 0
+				expectedErr = errors.New("unexpected error")
+			)
+
+			Expect(RetryOnConflict(context.TODO(), backoff, func() error {
+				attempts++
+				return expectedErr
+			})).To(Equal(expectedErr))
+			Expect(attempts).To(Equal(1))
+		})
+
+		It("should stop retrying if the context is done", func() {
+			var (
+				ctx, cancel = context.WithCancel(context.TODO())
+				attempts    = 0
+			)
+
+			Expect(RetryOnConflict(ctx, wait.Backoff{Duration: time.Hour, Factor: 1, Steps: 3}, func() error {
+				attempts++
+				cancel()
+				return conflict
+			})).To(Equal(context.Canceled))
+			Expect(attempts).To(Equal(1))
+		})
+	})
+
+	Describe("#TryUpdate", func() {
+		It("should update the object with the transformation applied to the current state", func() {
+			configMap := &corev1.ConfigMap{ObjectMeta: ObjectMeta(namespace, name)}
+
+			gomock.InOrder(
+				c.EXPECT().
+					Get(gomock.Any(), Key(namespace, name), configMap).
+					Return(nil),
+				c.EXPECT().
+					Update(gomock.Any(), configMap).
+					DoAndReturn(func(_ context.Context, obj runtime.Object) error {
+						Expect(obj.(*corev1.ConfigMap).Data).To(Equal(map[string]string{"foo": "bar"}))
+						return nil
+					}),
+			)
+
+			Expect(TryUpdate(context.TODO(), backoff, c, configMap, func() error {
+				configMap.Data = map[string]string{"foo": "bar"}
+				return nil
+			})).To(Succeed())
+		})
+
+		It("should fetch the object again and retry on conflicts", func() {
+			var (
+				configMap = &corev1.ConfigMap{ObjectMeta: ObjectMeta(namespace, name)}
+				called    = 0
+			)
+
+			gomock.InOrder(
+				c.EXPECT().Get(gomock.Any(), Key(namespace, name), configMap).Return(nil),
+				c.EXPECT().Update(gomock.Any(), configMap).Return(conflict),
+				c.EXPECT().Get(gomock.Any(), Key(namespace, name), configMap).
+					DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+						obj.(*corev1.ConfigMap).Data = nil
+						return nil
+					}),
+				c.EXPECT().Update(gomock.Any(), configMap).Return(nil),
+			)
+
+			Expect(TryUpdate(context.TODO(), backoff, c, configMap, func() error {
+				called++
+				configMap.Data = map[string]string{"foo": "bar"}
+				return nil
+			})).To(Succeed())
+			Expect(called).To(Equal(2))
+		})
+
+		It("should not update the object if the transformation did not change it", func() {
+			configMap := &corev1.ConfigMap{ObjectMeta: ObjectMeta(namespace, name)}
+
+			c.EXPECT().Get(gomock.Any(), Key(namespace, name), configMap).Return(nil)
+
+			Expect(TryUpdate(context.TODO(), backoff, c, configMap, func() error { return nil })).To(Succeed())
+		})
+
+		It("should return the error of the transformation without updating", func() {
+			var (
+				configMap   = &corev1.ConfigMap{ObjectMeta: ObjectMeta(namespace, name)}
+				expectedErr = errors.New("unexpected error")
+			)
+
+			c.EXPECT().Get(gomock.Any(), Key(namespace, name), configMap).Return(nil)
+
+			Expect(TryUpdate(context.TODO(), backoff, c, configMap, func() error { return expectedErr })).To(Equal(expectedErr))
+		})
+	})
+})
@@ -18,6 +18,7 @@ import (
 	"io/ioutil"
 	"net"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -127,3 +128,19 @@ func TestEmail(email string) bool {
 	match, _ := regexp.MatchString(`^[^@]+@(?:[a-zA-Z-0-9]+\.)+[a-zA-Z]{2,}$`, email)
 	return match
 }
+
+// gcpLabelMaxLength is the maximum length of the keys and values of labels of GCP resources.
+const gcpLabelMaxLength = 63
+
+var gcpLabelInvalidCharacters = regexp.MustCompile(`[^a-z0-9_-]`)
+
+// SanitizeGCPLabel converts the given string into a valid key or value of a label of a GCP resource, i.e., it is
+// lowercased, all characters other than lowercase letters, digits, underscores and dashes are replaced by underscores,
+// and the result is truncated to 63 characters.
+func SanitizeGCPLabel(s string) string {
+	s = gcpLabelInvalidCharacters.ReplaceAllString(strings.ToLower(s), "_")
+	if len(s) > gcpLabelMaxLength {
+		s = s[:gcpLabelMaxLength]
+	}
+	return s
+}
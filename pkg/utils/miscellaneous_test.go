@@ -14,6 +14,8 @@
 package utils_test
 
 import (
+	"strings"
+
 	. "github.com/gardener/gardener/pkg/utils"
 
 	. "github.com/onsi/ginkgo"
@@ -21,6 +23,17 @@ import (
 )
 
 var _ = Describe("utils", func() {
+	Describe("#SanitizeGCPLabel", func() {
+		It("should lowercase the string and replace invalid characters", func() {
+			Expect(SanitizeGCPLabel("Example.com/Team")).To(Equal("example_com_team"))
+			Expect(SanitizeGCPLabel("cost-center_1")).To(Equal("cost-center_1"))
+		})
+
+		It("should truncate the string to 63 characters", func() {
+			Expect(SanitizeGCPLabel(strings.Repeat("a", 70))).To(Equal(strings.Repeat("a", 63)))
+		})
+	})
+
 	Describe("#MergeStringMaps", func() {
 		It("should return nil", func() {
 			result := MergeStringMaps(nil, nil)
@@ -0,0 +1,62 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimiter
+
+import (
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// Registry manages one token bucket per key, e.g. per cloud provider account, which is shared by all users of the
+// same key within the process.
+type Registry struct {
+	limit rate.Limit
+	burst int
+
+	lock     sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+// NewRegistry creates a new Registry whose token buckets are refilled with <qps> tokens per second and hold at most
+// <burst> tokens. The burst is at least one, otherwise no token could ever be taken from the buckets.
+func NewRegistry(qps float32, burst int) *Registry {
+	if burst < 1 {
+		burst = 1
+	}
+	return &Registry{
+		limit:    rate.Limit(qps),
+		burst:    burst,
+		limiters: make(map[string]*rate.Limiter),
+	}
+}
+
+// Get returns the token bucket for the given <key> and creates it if it does not exist yet. It returns nil if the
+// Registry is nil, i.e., if no rate limit has been configured.
+func (r *Registry) Get(key string) *rate.Limiter {
+	if r == nil {
+		return nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	limiter, ok := r.limiters[key]
+	if !ok {
+		limiter = rate.NewLimiter(r.limit, r.burst)
+		r.limiters[key] = limiter
+	}
+	return limiter
+}
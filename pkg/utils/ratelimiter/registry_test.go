@@ -0,0 +1,43 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimiter_test
+
+import (
+	. "github.com/gardener/gardener/pkg/utils/ratelimiter"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/time/rate"
+)
+
+var _ = Describe("Registry", func() {
+	Describe("#Get", func() {
+		It("should return nil for a nil registry", func() {
+			var registry *Registry
+
+			Expect(registry.Get("garden-dev/secret")).To(BeNil())
+		})
+
+		It("should share the token bucket per key", func() {
+			registry := NewRegistry(2, 5)
+
+			limiter := registry.Get("garden-dev/secret")
+			Expect(limiter.Limit()).To(Equal(rate.Limit(2)))
+			Expect(limiter.Burst()).To(Equal(5))
+			Expect(registry.Get("garden-dev/secret")).To(BeIdenticalTo(limiter))
+			Expect(registry.Get("garden-dev/other-secret")).NotTo(BeIdenticalTo(limiter))
+		})
+	})
+})
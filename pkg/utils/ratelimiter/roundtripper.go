@@ -0,0 +1,47 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimiter
+
+import (
+	"fmt"
+	"net/http"
+
+	"golang.org/x/time/rate"
+)
+
+// NewRoundTripper returns an http.RoundTripper which waits for a token of the given <limiter> before it passes a
+// request to <next>. It uses http.DefaultTransport if <next> is nil and returns <next> if <limiter> is nil, i.e., if
+// no rate limit has been configured.
+func NewRoundTripper(limiter *rate.Limiter, next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if limiter == nil {
+		return next
+	}
+	return &roundTripper{limiter, next}
+}
+
+type roundTripper struct {
+	limiter *rate.Limiter
+	next    http.RoundTripper
+}
+
+func (r *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := r.limiter.Wait(req.Context()); err != nil {
+		return nil, fmt.Errorf("failed waiting for the cloud API rate limit: %v", err)
+	}
+	return r.next.RoundTrip(req)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimiter_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/gardener/gardener/pkg/utils/ratelimiter"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/time/rate"
+)
+
+type fakeRoundTripper struct {
+	requests int
+}
+
+func (f *fakeRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	f.requests++
+	return &http.Response{StatusCode: http.StatusOK, Request: req}, nil
+}
+
+var _ = Describe("RoundTripper", func() {
+	var (
+		next *fakeRoundTripper
+		req  *http.Request
+	)
+
+	BeforeEach(func() {
+		next = &fakeRoundTripper{}
+
+		var err error
+		req, err = http.NewRequest(http.MethodGet, "https://example.com", nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("#NewRoundTripper", func() {
+		It("should return the next round tripper if no limiter is given", func() {
+			Expect(NewRoundTripper(nil, next)).To(BeIdenticalTo(next))
+		})
+
+		It("should fall back to the default transport", func() {
+			Expect(NewRoundTripper(nil, nil)).To(BeIdenticalTo(http.DefaultTransport))
+		})
+
+		It("should pass the request on after taking a token", func() {
+			limiter := rate.NewLimiter(1, 1)
+
+			resp, err := NewRoundTripper(limiter, next).RoundTrip(req)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(next.requests).To(Equal(1))
+			Expect(limiter.Allow()).To(BeFalse())
+		})
+
+		It("should not pass the request on if the context is done before a token is available", func() {
+			limiter := rate.NewLimiter(0.001, 1)
+			Expect(limiter.Allow()).To(BeTrue())
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			_, err := NewRoundTripper(limiter, next).RoundTrip(req.WithContext(ctx))
+
+			Expect(err).To(HaveOccurred())
+			Expect(next.requests).To(BeZero())
+		})
+	})
+})
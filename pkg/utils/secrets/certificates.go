@@ -23,10 +23,10 @@ import (
 	"fmt"
 	"math/big"
 	"net"
+	"reflect"
 	"sync"
 	"time"
 
-	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/utils"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	corev1 "k8s.io/api/core/v1"
@@ -53,6 +53,21 @@ const (
 	DataKeyCertificateCA = "ca.crt"
 	// DataKeyPrivateKeyCA is the key in a secret data holding the CA private key.
 	DataKeyPrivateKeyCA = "ca.key"
+	// DataKeyCertificateCAPrevious is the key in a secret data holding the CA certificate which has been replaced by
+	// the current one and is still trusted for one rollout period.
+	DataKeyCertificateCAPrevious = "ca-previous.crt"
+	// DataKeyCertificateCANext is the key in a secret data holding the CA certificate which will replace the current
+	// one once it has been trusted for one rollout period.
+	DataKeyCertificateCANext = "ca-next.crt"
+	// DataKeyPrivateKeyCANext is the key in a secret data holding the private key of the next CA.
+	DataKeyPrivateKeyCANext = "ca-next.key"
+	// DataKeyCertificateBundle is the key in a secret data holding all CA certificates which are currently trusted,
+	// i.e., the current, the next and the previous one. Components verifying peers must use it instead of ca.crt.
+	DataKeyCertificateBundle = "bundle.crt"
+
+	// DefaultCARolloutPeriod is the default duration for which a new CA is trusted before it is used for signing,
+	// and for which the replaced CA is still trusted afterwards.
+	DefaultCARolloutPeriod = 7 * 24 * time.Hour
 )
 
 // CertificateSecretConfig contains the specification a to-be-generated CA, server, or client certificate.
@@ -67,6 +82,16 @@ type CertificateSecretConfig struct {
 
 	CertType  certType
 	SigningCA *Certificate
+
+	// Validity is the duration for which the certificate is valid. Defaults to 10 years.
+	Validity *time.Duration
+	// RenewBefore is the duration before the expiration of the certificate at which it is regenerated.
+	// Defaults to a fifth of the validity.
+	RenewBefore *time.Duration
+	// RolloutPeriod is only relevant for CAs. A renewed CA is published in the trust bundle for this duration before
+	// it is used for signing, and the replaced CA stays in the bundle for this duration afterwards. Certificates signed
+	// by the replaced CA are re-signed spread over this duration. Defaults to DefaultCARolloutPeriod.
+	RolloutPeriod *time.Duration
 }
 
 // Certificate contains the private key, and the certificate. It does also contain the CA certificate
@@ -81,6 +106,14 @@ type Certificate struct {
 
 	Certificate    *x509.Certificate
 	CertificatePEM []byte
+
+	// PreviousCertificatePEM is only set for CAs which have been renewed. It contains the replaced CA certificate
+	// which is still trusted so that components can roll over to the new CA without outage.
+	PreviousCertificatePEM []byte
+	// Next is only set for CAs which are about to be renewed. It is trusted but not yet used for signing.
+	Next *Certificate
+
+	rolloutPeriod time.Duration
 }
 
 // GetName returns the name of the secret.
@@ -116,6 +149,10 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 	if err != nil {
 		return nil, err
 	}
+	// Use the signed certificate instead of the template so that it can be used to verify signatures.
+	if certificate, err = utils.DecodeCertificate(certificatePEM); err != nil {
+		return nil, err
+	}
 
 	return &Certificate{
 		Name: s.Name,
@@ -140,17 +177,43 @@ func (c *Certificate) SecretData() map[string][]byte {
 		// compatibility).
 		data[DataKeyCertificateCA] = c.CertificatePEM
 		data[DataKeyPrivateKeyCA] = c.PrivateKeyPEM
+		data[DataKeyCertificateBundle] = c.CertificateBundlePEM()
+		if len(c.PreviousCertificatePEM) > 0 {
+			data[DataKeyCertificateCAPrevious] = c.PreviousCertificatePEM
+		}
+		if c.Next != nil {
+			data[DataKeyCertificateCANext] = c.Next.CertificatePEM
+			data[DataKeyPrivateKeyCANext] = c.Next.PrivateKeyPEM
+		}
 	case c.CA != nil:
 		// The certificate is not a CA certificate, so we add the signing CA certificate to it and use different
 		// keys in the secret data.
 		data[DataKeyPrivateKey] = c.PrivateKeyPEM
 		data[DataKeyCertificate] = c.CertificatePEM
-		data[DataKeyCertificateCA] = c.CA.CertificatePEM
+		data[DataKeyCertificateCA] = c.CA.CertificateBundlePEM()
 	}
 
 	return data
 }
 
+// CertificateBundlePEM returns the PEM encoded certificate together with the next and the previous certificate (if
+// any). It is meant to be used as trust bundle for CAs which are rolled over.
+func (c *Certificate) CertificateBundlePEM() []byte {
+	bundle := append([]byte{}, c.CertificatePEM...)
+	if c.Next != nil {
+		bundle = append(bundle, c.Next.CertificatePEM...)
+	}
+	return append(bundle, c.PreviousCertificatePEM...)
+}
+
+// RolloutPeriod returns the rollout period of the CA, see CertificateSecretConfig.RolloutPeriod.
+func (c *Certificate) RolloutPeriod() time.Duration {
+	if c.rolloutPeriod == 0 {
+		return DefaultCARolloutPeriod
+	}
+	return c.rolloutPeriod
+}
+
 // LoadCertificate takes a byte slice representation of a certificate and the corresponding private key, and returns its de-serialized private
 // key, certificate template and PEM certificate which can be used to sign other x509 certificates.
 func LoadCertificate(name string, privateKeyPEM, certificatePEM []byte) (*Certificate, error) {
@@ -192,7 +255,7 @@ func LoadCAFromSecret(k8sClient client.Client, namespace, name string) (*corev1.
 // generateCertificateTemplate creates a X509 Certificate object based on the provided information regarding
 // common name, organization, SANs (DNS names and IP addresses). It can create a server or a client certificate
 // or both, depending on the <certType> value. If <isCACert> is true, then a CA certificate is being created.
-// The certificates a valid for 10 years.
+// The certificates are valid for 10 years unless a different validity is configured.
 func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificate {
 	var (
 		serialNumber, _ = rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
@@ -215,6 +278,10 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 		}
 	)
 
+	if s.Validity != nil {
+		template.NotAfter = now.Add(*s.Validity)
+	}
+
 	switch s.CertType {
 	case CACert:
 		template.KeyUsage |= x509.KeyUsageCertSign | x509.KeyUsageCRLSign
@@ -240,11 +307,13 @@ func signCertificate(certificateTemplate *x509.Certificate, privateKey *rsa.Priv
 	return utils.EncodeCertificate(certificate), nil
 }
 
-func generateCA(k8sClusterClient kubernetes.Interface, config *CertificateSecretConfig, namespace string) (*corev1.Secret, *Certificate, error) {
+// generateCA generates a new CA and deploys it.
+func generateCA(k8sClusterClient SecretCreator, config *CertificateSecretConfig, namespace string) (*corev1.Secret, *Certificate, error) {
 	certificate, err := config.GenerateCertificate()
 	if err != nil {
 		return nil, nil, err
 	}
+	certificate.rolloutPeriod = config.rolloutPeriod()
 
 	secret, err := k8sClusterClient.CreateSecret(namespace, config.GetName(), corev1.SecretTypeOpaque, certificate.SecretData(), false)
 	if err != nil {
@@ -253,18 +322,87 @@ func generateCA(k8sClusterClient kubernetes.Interface, config *CertificateSecret
 	return secret, certificate, nil
 }
 
-func loadCA(name string, existingSecret *corev1.Secret) (*corev1.Secret, *Certificate, error) {
+// loadCA loads the current CA from the existing secret together with the next CA (if any) and the previous CA
+// certificate (if it is still trusted at <now>).
+func loadCA(name string, existingSecret *corev1.Secret, rolloutPeriod time.Duration, now time.Time) (*Certificate, error) {
 	certificate, err := LoadCertificate(name, existingSecret.Data[DataKeyPrivateKeyCA], existingSecret.Data[DataKeyCertificateCA])
+	if err != nil {
+		return nil, err
+	}
+	certificate.rolloutPeriod = rolloutPeriod
+
+	if nextPEM, ok := existingSecret.Data[DataKeyCertificateCANext]; ok {
+		if certificate.Next, err = LoadCertificate(name, existingSecret.Data[DataKeyPrivateKeyCANext], nextPEM); err != nil {
+			return nil, err
+		}
+	}
+
+	// The previous CA certificate is trusted for one rollout period after the current CA has been activated (and
+	// only as long as it is valid).
+	if previousPEM, ok := existingSecret.Data[DataKeyCertificateCAPrevious]; ok {
+		if previous, err := utils.DecodeCertificate(previousPEM); err == nil && now.Before(previous.NotAfter) && now.Before(certificate.resignedUntil()) {
+			certificate.PreviousCertificatePEM = previousPEM
+		}
+	}
+	return certificate, nil
+}
+
+// loadOrRenewCA loads the CA from the existing secret and rolls it over if it does not match the configuration
+// anymore, see CertificateSecretConfig.NeedsRenewal. The rollover happens in two steps so that all components
+// trust the new CA before it is used: First, a new CA is generated and added to the trust bundle while the current
+// CA is still used for signing. After one rollout period (or once the current CA expires) the new CA is activated.
+// The replaced CA stays in the trust bundle for another rollout period while the certificates it has signed are
+// re-signed. The secret is updated whenever its content does not match the CA anymore.
+func loadOrRenewCA(k8sClusterClient SecretCreator, config *CertificateSecretConfig, namespace, name string, existingSecret *corev1.Secret) (*corev1.Secret, *Certificate, error) {
+	var (
+		now           = time.Now()
+		rolloutPeriod = config.rolloutPeriod()
+	)
+
+	certificate, err := loadCA(name, existingSecret, rolloutPeriod, now)
 	if err != nil {
 		return nil, nil, err
 	}
-	return existingSecret, certificate, nil
+
+	if next := certificate.Next; next != nil {
+		if !now.Before(next.Certificate.NotBefore.Add(rolloutPeriod)) || !now.Before(certificate.Certificate.NotAfter) {
+			next.rolloutPeriod = rolloutPeriod
+			if now.Before(certificate.Certificate.NotAfter) {
+				next.PreviousCertificatePEM = certificate.CertificatePEM
+			}
+			certificate = next
+		}
+	} else if renew, _ := config.NeedsRenewal(certificate.CertificatePEM, now); renew {
+		if certificate.Next, err = config.GenerateCertificate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	data := certificate.SecretData()
+	if reflect.DeepEqual(data, existingSecret.Data) {
+		return existingSecret, certificate, nil
+	}
+
+	secret, err := k8sClusterClient.CreateSecret(namespace, name, corev1.SecretTypeOpaque, data, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	return secret, certificate, nil
+}
+
+// rolloutPeriod returns the configured rollout period or DefaultCARolloutPeriod.
+func (s *CertificateSecretConfig) rolloutPeriod() time.Duration {
+	if s.RolloutPeriod != nil {
+		return *s.RolloutPeriod
+	}
+	return DefaultCARolloutPeriod
 }
 
 // GenerateCertificateAuthorities get a map of wanted certificates and check If they exist in the existingSecretsMap based on the keys in the map. If they exist it get only the certificate from the corresponding
 // existing secret and makes a certificate Interface from the existing secret. If there is no existing secret contaning the wanted certificate, we make one certificate and with it we deploy in K8s cluster
-// a secret with that  certificate and then return the newly existing secret. The function returns a map of secrets contaning the wanted CA, a map with the wanted CA certificate and an error.
-func GenerateCertificateAuthorities(k8sClusterClient kubernetes.Interface, existingSecretsMap map[string]*corev1.Secret, wantedCertificateAuthorities map[string]*CertificateSecretConfig, namespace string) (map[string]*corev1.Secret, map[string]*Certificate, error) {
+// a secret with that  certificate and then return the newly existing secret. Existing CAs which are about to expire or do not match their configuration anymore are rolled over, see
+// loadOrRenewCA. The function returns a map of secrets contaning the wanted CA, a map with the wanted CA certificate and an error.
+func GenerateCertificateAuthorities(k8sClusterClient SecretCreator, existingSecretsMap map[string]*corev1.Secret, wantedCertificateAuthorities map[string]*CertificateSecretConfig, namespace string) (map[string]*corev1.Secret, map[string]*Certificate, error) {
 	type caOutput struct {
 		secret      *corev1.Secret
 		certificate *Certificate
@@ -289,11 +427,11 @@ func GenerateCertificateAuthorities(k8sClusterClient kubernetes.Interface, exist
 				results <- &caOutput{secret, certificate, err}
 			}(config)
 		} else {
-			go func(name string, existingSecret *corev1.Secret) {
+			go func(name string, config *CertificateSecretConfig, existingSecret *corev1.Secret) {
 				defer wg.Done()
-				secret, certificate, err := loadCA(name, existingSecret)
+				secret, certificate, err := loadOrRenewCA(k8sClusterClient, config, namespace, name, existingSecret)
 				results <- &caOutput{secret, certificate, err}
-			}(name, existingSecret)
+			}(name, config, existingSecret)
 		}
 	}
 
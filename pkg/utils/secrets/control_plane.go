@@ -89,7 +89,7 @@ func (s *ControlPlaneSecretConfig) GenerateControlPlane() (*ControlPlane, error)
 // SecretData computes the data map which can be used in a Kubernetes secret.
 func (c *ControlPlane) SecretData() map[string][]byte {
 	data := map[string][]byte{
-		DataKeyCertificateCA:          c.Certificate.CA.CertificatePEM,
+		DataKeyCertificateCA:          c.Certificate.CA.CertificateBundlePEM(),
 		fmt.Sprintf("%s.key", c.Name): c.Certificate.PrivateKeyPEM,
 		fmt.Sprintf("%s.crt", c.Name): c.Certificate.CertificatePEM,
 	}
@@ -113,7 +113,7 @@ func generateKubeconfig(secret *ControlPlaneSecretConfig, certificate *Certifica
 	values := map[string]interface{}{
 		"APIServerURL": secret.KubeConfigRequest.APIServerURL,
 
-		"CACertificate":     utils.EncodeBase64(secret.CertificateSecretConfig.SigningCA.CertificatePEM),
+		"CACertificate":     utils.EncodeBase64(secret.CertificateSecretConfig.SigningCA.CertificateBundlePEM()),
 		"ClientCertificate": utils.EncodeBase64(certificate.CertificatePEM),
 		"ClientKey":         utils.EncodeBase64(certificate.PrivateKeyPEM),
 		"ClusterName":       secret.KubeConfigRequest.ClusterName,
@@ -17,14 +17,16 @@ package secrets
 import (
 	"fmt"
 	"sync"
+	"time"
 
-	"github.com/gardener/gardener/pkg/client/kubernetes"
 	corev1 "k8s.io/api/core/v1"
 )
 
-// GenerateClusterSecrets try to deploy in the k8s cluster each secret in the wantedSecretsList. If the secret already exist it jumps to the next one.
+// GenerateClusterSecrets try to deploy in the k8s cluster each secret in the wantedSecretsList. If the secret already exist it jumps to the next one, unless
+// it contains a certificate which needs to be renewed (see CertificateSecretConfig.NeedsRenewal). In this case the secret is regenerated and updated.
+// Secrets whose CA bundle is outdated get the current bundle of their signing CA without being re-signed.
 // The function returns a map with all of the successfully deployed wanted secrets plus those already deployed (only from the wantedSecretsList).
-func GenerateClusterSecrets(k8sClusterClient kubernetes.Interface, existingSecretsMap map[string]*corev1.Secret, wantedSecretsList []ConfigInterface, namespace string) (map[string]*corev1.Secret, error) {
+func GenerateClusterSecrets(k8sClusterClient SecretCreator, existingSecretsMap map[string]*corev1.Secret, wantedSecretsList []ConfigInterface, namespace string) (map[string]*corev1.Secret, error) {
 	type secretOutput struct {
 		secret *corev1.Secret
 		err    error
@@ -35,21 +37,42 @@ func GenerateClusterSecrets(k8sClusterClient kubernetes.Interface, existingSecre
 		deployedClusterSecrets = map[string]*corev1.Secret{}
 		wg                     sync.WaitGroup
 		errorList              = []error{}
+		now                    = time.Now()
 	)
 
 	for _, s := range wantedSecretsList {
 		name := s.GetName()
 
+		var (
+			update   = false
+			generate = s.Generate
+		)
 		if existingSecret, ok := existingSecretsMap[name]; ok {
-			deployedClusterSecrets[name] = existingSecret
-			continue
+			certificateConfig, certificatePEM := certificateToRenew(s, existingSecret)
+			if certificateConfig == nil {
+				deployedClusterSecrets[name] = existingSecret
+				continue
+			}
+			if renew, _ := certificateConfig.NeedsRenewal(certificatePEM, now); !renew {
+				// Only the trust bundle is updated if it changed, the certificate is kept. If the existing
+				// certificate cannot be loaded it is regenerated.
+				obj, err := withCurrentCABundle(s, existingSecret)
+				if err == nil && obj == nil {
+					deployedClusterSecrets[name] = existingSecret
+					continue
+				}
+				if obj != nil {
+					generate = func() (Interface, error) { return obj, nil }
+				}
+			}
+			update = true
 		}
 
 		wg.Add(1)
-		go func(s ConfigInterface) {
+		go func(name string, generate func() (Interface, error), update bool) {
 			defer wg.Done()
 
-			obj, err := s.Generate()
+			obj, err := generate()
 			if err != nil {
 				results <- &secretOutput{err: err}
 				return
@@ -60,9 +83,9 @@ func GenerateClusterSecrets(k8sClusterClient kubernetes.Interface, existingSecre
 				secretType = corev1.SecretTypeTLS
 			}
 
-			secret, err := k8sClusterClient.CreateSecret(namespace, s.GetName(), secretType, obj.SecretData(), false)
+			secret, err := k8sClusterClient.CreateSecret(namespace, name, secretType, obj.SecretData(), update)
 			results <- &secretOutput{secret: secret, err: err}
-		}(s)
+		}(name, generate, update)
 	}
 
 	go func() {
@@ -0,0 +1,163 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"bytes"
+	"crypto/x509"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// NeedsRenewal checks whether the given PEM encoded certificate has to be regenerated in order to match the
+// configuration. This is the case if it cannot be parsed, if it expires within the renewal period, if its subject
+// or subject alternative names differ from the desired ones, or if it has not been signed by the current signing
+// CA. Certificates signed by the previous CA are only renewed once their turn in the rollout period has come. It
+// returns a human readable reason in case a renewal is required.
+func (s *CertificateSecretConfig) NeedsRenewal(certificatePEM []byte, now time.Time) (bool, string) {
+	certificate, err := utils.DecodeCertificate(certificatePEM)
+	if err != nil {
+		return true, fmt.Sprintf("certificate cannot be parsed: %v", err)
+	}
+
+	if renewAt := certificate.NotAfter.Add(-s.renewBefore(certificate.NotAfter.Sub(certificate.NotBefore))); !now.Before(renewAt) {
+		return true, fmt.Sprintf("certificate expires at %s", certificate.NotAfter.UTC().Format(time.RFC3339))
+	}
+
+	if certificate.Subject.CommonName != s.CommonName || !sets.NewString(certificate.Subject.Organization...).Equal(sets.NewString(s.Organization...)) {
+		return true, "subject of certificate changed"
+	}
+	if !sets.NewString(certificate.DNSNames...).Equal(sets.NewString(s.DNSNames...)) || !ipSet(certificate.IPAddresses).Equal(ipSet(s.IPAddresses)) {
+		return true, "subject alternative names of certificate changed"
+	}
+
+	if ca := s.SigningCA; ca != nil && certificate.CheckSignatureFrom(ca.Certificate) != nil {
+		// Certificates signed by the previous CA are still trusted. They are re-signed spread over the rollout
+		// period so that not all components are restarted at once.
+		if !ca.hasSignedPrevious(certificate) {
+			return true, "certificate has not been signed by a trusted CA"
+		}
+		if !now.Before(ca.resignAt(s.Name)) {
+			return true, "certificate has been signed by the previous CA"
+		}
+	}
+
+	return false, ""
+}
+
+// hasSignedPrevious checks whether the given certificate has been signed by the previous certificate of the CA.
+func (c *Certificate) hasSignedPrevious(certificate *x509.Certificate) bool {
+	if len(c.PreviousCertificatePEM) == 0 {
+		return false
+	}
+	previous, err := utils.DecodeCertificate(c.PreviousCertificatePEM)
+	if err != nil {
+		return false
+	}
+	return certificate.CheckSignatureFrom(previous) == nil
+}
+
+// resignAt returns the point in time at which the certificate with the given name is re-signed after the CA has
+// been activated. It is derived from the name so that the certificates are spread over the rollout period.
+func (c *Certificate) resignAt(name string) time.Time {
+	hash := fnv.New32a()
+	hash.Write([]byte(name))
+	offset := time.Duration(hash.Sum32()) * time.Second % c.RolloutPeriod()
+	return c.Certificate.NotBefore.Add(c.RolloutPeriod() + offset)
+}
+
+// resignedUntil returns the point in time at which all certificates signed by the previous CA have been re-signed,
+// i.e., one rollout period after the CA has been activated. The previous CA is not trusted anymore afterwards.
+func (c *Certificate) resignedUntil() time.Time {
+	return c.Certificate.NotBefore.Add(2 * c.RolloutPeriod())
+}
+
+// renewBefore returns the configured renewal period or a fifth of the given validity.
+func (s *CertificateSecretConfig) renewBefore(validity time.Duration) time.Duration {
+	if s.RenewBefore != nil {
+		return *s.RenewBefore
+	}
+	return validity / 5
+}
+
+// certificateToRenew returns the certificate configuration and the PEM encoded certificate stored in the given
+// existing secret if the configuration describes a certificate. Otherwise, it returns nil.
+func certificateToRenew(config ConfigInterface, existingSecret *corev1.Secret) (*CertificateSecretConfig, []byte) {
+	switch c := config.(type) {
+	case *CertificateSecretConfig:
+		if c.CertType == CACert {
+			return c, existingSecret.Data[DataKeyCertificateCA]
+		}
+		return c, existingSecret.Data[DataKeyCertificate]
+	case *ControlPlaneSecretConfig:
+		return c.CertificateSecretConfig, existingSecret.Data[fmt.Sprintf("%s.crt", c.Name)]
+	}
+	return nil, nil
+}
+
+// withCurrentCABundle returns the content of the given existing secret with the current trust bundle of the signing
+// CA if the secret contains an outdated one, e.g., because the next CA has been added to the bundle. The certificate
+// itself is kept. It returns nil if the bundle is up to date.
+func withCurrentCABundle(config ConfigInterface, existingSecret *corev1.Secret) (Interface, error) {
+	switch c := config.(type) {
+	case *CertificateSecretConfig:
+		if c.SigningCA == nil || bytes.Equal(existingSecret.Data[DataKeyCertificateCA], c.SigningCA.CertificateBundlePEM()) {
+			return nil, nil
+		}
+		certificate, err := LoadCertificate(c.Name, existingSecret.Data[DataKeyPrivateKey], existingSecret.Data[DataKeyCertificate])
+		if err != nil {
+			return nil, err
+		}
+		certificate.CA = c.SigningCA
+		return certificate, nil
+
+	case *ControlPlaneSecretConfig:
+		if c.SigningCA == nil || bytes.Equal(existingSecret.Data[DataKeyCertificateCA], c.SigningCA.CertificateBundlePEM()) {
+			return nil, nil
+		}
+		certificate, err := LoadCertificate(c.Name, existingSecret.Data[fmt.Sprintf("%s.key", c.Name)], existingSecret.Data[fmt.Sprintf("%s.crt", c.Name)])
+		if err != nil {
+			return nil, err
+		}
+		certificate.CA = c.SigningCA
+
+		controlPlane := &ControlPlane{
+			Name:        c.Name,
+			Certificate: certificate,
+			BasicAuth:   c.BasicAuth,
+		}
+		if c.KubeConfigRequest != nil {
+			if controlPlane.Kubeconfig, err = generateKubeconfig(c, certificate); err != nil {
+				return nil, err
+			}
+		}
+		return controlPlane, nil
+	}
+	return nil, nil
+}
+
+func ipSet(ips []net.IP) sets.String {
+	out := sets.NewString()
+	for _, ip := range ips {
+		out.Insert(ip.String())
+	}
+	return out
+}
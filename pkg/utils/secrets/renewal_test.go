@@ -0,0 +1,250 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets_test
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	. "github.com/gardener/gardener/pkg/utils/secrets"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("renewal", func() {
+	var (
+		ca     *Certificate
+		config *CertificateSecretConfig
+		now    time.Time
+	)
+
+	BeforeEach(func() {
+		var err error
+		ca, err = (&CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: CACert}).GenerateCertificate()
+		Expect(err).NotTo(HaveOccurred())
+
+		validity := 24 * time.Hour
+		config = &CertificateSecretConfig{
+			Name:         "server",
+			CommonName:   "server",
+			Organization: []string{"gardener"},
+			DNSNames:     []string{"server", "server.local"},
+			IPAddresses:  []net.IP{net.ParseIP("10.0.0.1")},
+			CertType:     ServerCert,
+			SigningCA:    ca,
+			Validity:     &validity,
+		}
+		now = time.Now()
+	})
+
+	Describe("#NeedsRenewal", func() {
+		It("should not renew a certificate matching the configuration", func() {
+			certificate, err := config.GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			renew, reason := config.NeedsRenewal(certificate.CertificatePEM, now)
+			Expect(renew).To(BeFalse())
+			Expect(reason).To(BeEmpty())
+		})
+
+		It("should renew a certificate which cannot be parsed", func() {
+			renew, _ := config.NeedsRenewal([]byte("foo"), now)
+			Expect(renew).To(BeTrue())
+		})
+
+		It("should renew a certificate within the default renewal period", func() {
+			certificate, err := config.GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			renew, _ := config.NeedsRenewal(certificate.CertificatePEM, now.Add(19*time.Hour))
+			Expect(renew).To(BeFalse())
+			renew, _ = config.NeedsRenewal(certificate.CertificatePEM, now.Add(20*time.Hour))
+			Expect(renew).To(BeTrue())
+		})
+
+		It("should respect the configured renewal period", func() {
+			certificate, err := config.GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			renewBefore := time.Hour
+			config.RenewBefore = &renewBefore
+
+			renew, _ := config.NeedsRenewal(certificate.CertificatePEM, now.Add(22*time.Hour))
+			Expect(renew).To(BeFalse())
+			renew, _ = config.NeedsRenewal(certificate.CertificatePEM, now.Add(23*time.Hour+time.Minute))
+			Expect(renew).To(BeTrue())
+		})
+
+		It("should renew a certificate whose subject alternative names changed", func() {
+			certificate, err := config.GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			config.DNSNames = append(config.DNSNames, "server.example.com")
+
+			renew, _ := config.NeedsRenewal(certificate.CertificatePEM, now)
+			Expect(renew).To(BeTrue())
+		})
+
+		It("should not renew a certificate whose subject alternative names are ordered differently", func() {
+			certificate, err := config.GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			config.DNSNames = []string{"server.local", "server"}
+
+			renew, _ := config.NeedsRenewal(certificate.CertificatePEM, now)
+			Expect(renew).To(BeFalse())
+		})
+
+		It("should renew a certificate which has not been signed by the current CA", func() {
+			certificate, err := config.GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			config.SigningCA, err = (&CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: CACert}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			renew, _ := config.NeedsRenewal(certificate.CertificatePEM, now)
+			Expect(renew).To(BeTrue())
+		})
+
+		It("should renew a certificate signed by the previous CA only once its turn in the rollout period has come", func() {
+			config.Validity = nil
+			certificate, err := config.GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			config.SigningCA, err = (&CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: CACert}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			config.SigningCA.PreviousCertificatePEM = ca.CertificatePEM
+
+			activation := config.SigningCA.Certificate.NotBefore.Add(DefaultCARolloutPeriod)
+			renew, _ := config.NeedsRenewal(certificate.CertificatePEM, activation.Add(-time.Second))
+			Expect(renew).To(BeFalse())
+			renew, _ = config.NeedsRenewal(certificate.CertificatePEM, activation.Add(DefaultCARolloutPeriod))
+			Expect(renew).To(BeTrue())
+		})
+	})
+
+	Describe("#SecretData", func() {
+		It("should add the next and the previous CA certificate to the CA bundle", func() {
+			next, err := (&CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: CACert}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			ca.Next = next
+			ca.PreviousCertificatePEM = []byte("previous")
+
+			certificate, err := config.GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ca.SecretData()).To(HaveKeyWithValue(DataKeyCertificateCA, ca.CertificatePEM))
+			Expect(ca.SecretData()).To(HaveKeyWithValue(DataKeyCertificateCANext, next.CertificatePEM))
+			Expect(ca.SecretData()).To(HaveKeyWithValue(DataKeyCertificateCAPrevious, []byte("previous")))
+			Expect(ca.SecretData()).To(HaveKeyWithValue(DataKeyCertificateBundle, ca.CertificateBundlePEM()))
+			Expect(certificate.SecretData()).To(HaveKeyWithValue(DataKeyCertificateCA, ca.CertificateBundlePEM()))
+			Expect(bytes.HasPrefix(ca.CertificateBundlePEM(), ca.CertificatePEM)).To(BeTrue())
+			Expect(bytes.Contains(ca.CertificateBundlePEM(), next.CertificatePEM)).To(BeTrue())
+			Expect(bytes.HasSuffix(ca.CertificateBundlePEM(), []byte("previous"))).To(BeTrue())
+		})
+	})
+
+	Describe("#GenerateCertificateAuthorities", func() {
+		It("should roll over a CA in two steps", func() {
+			var (
+				creator       = &fakeSecretCreator{secrets: map[string]*corev1.Secret{}}
+				validity      = time.Hour
+				renewBefore   = 2 * time.Hour
+				rolloutPeriod = time.Hour
+				caConfig      = &CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: CACert, Validity: &validity, RolloutPeriod: &rolloutPeriod}
+				wanted        = map[string]*CertificateSecretConfig{"ca": caConfig}
+			)
+
+			_, cas, err := GenerateCertificateAuthorities(creator, creator.secrets, wanted, "namespace")
+			Expect(err).NotTo(HaveOccurred())
+			initial := creator.secrets["ca"].Data[DataKeyCertificateCA]
+			Expect(cas["ca"].CertificatePEM).To(Equal(initial))
+
+			By("adding the next CA to the bundle while still signing with the current one")
+			caConfig.RenewBefore = &renewBefore
+			_, cas, err = GenerateCertificateAuthorities(creator, creator.secrets, wanted, "namespace")
+			Expect(err).NotTo(HaveOccurred())
+			data := creator.secrets["ca"].Data
+			Expect(data[DataKeyCertificateCA]).To(Equal(initial))
+			Expect(data).To(HaveKey(DataKeyCertificateCANext))
+			Expect(bytes.Contains(data[DataKeyCertificateBundle], data[DataKeyCertificateCANext])).To(BeTrue())
+			Expect(cas["ca"].CertificatePEM).To(Equal(initial))
+			next := data[DataKeyCertificateCANext]
+
+			By("keeping the next CA inactive during the rollout period")
+			caConfig.RenewBefore = nil
+			_, cas, err = GenerateCertificateAuthorities(creator, creator.secrets, wanted, "namespace")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cas["ca"].CertificatePEM).To(Equal(initial))
+
+			By("activating the next CA and keeping the previous one in the bundle")
+			rolloutPeriod = time.Nanosecond
+			_, cas, err = GenerateCertificateAuthorities(creator, creator.secrets, wanted, "namespace")
+			Expect(err).NotTo(HaveOccurred())
+			data = creator.secrets["ca"].Data
+			Expect(data[DataKeyCertificateCA]).To(Equal(next))
+			Expect(data).NotTo(HaveKey(DataKeyCertificateCANext))
+			Expect(data[DataKeyCertificateCAPrevious]).To(Equal(initial))
+			Expect(cas["ca"].CertificatePEM).To(Equal(next))
+
+			By("dropping the previous CA after the rollout period")
+			_, _, err = GenerateCertificateAuthorities(creator, creator.secrets, wanted, "namespace")
+			Expect(err).NotTo(HaveOccurred())
+			data = creator.secrets["ca"].Data
+			Expect(data).NotTo(HaveKey(DataKeyCertificateCAPrevious))
+			Expect(data[DataKeyCertificateBundle]).To(Equal(next))
+		})
+	})
+
+	Describe("#GenerateClusterSecrets", func() {
+		It("should update the CA bundle of existing secrets without re-signing them", func() {
+			creator := &fakeSecretCreator{secrets: map[string]*corev1.Secret{}}
+
+			_, err := GenerateClusterSecrets(creator, creator.secrets, []ConfigInterface{config}, "namespace")
+			Expect(err).NotTo(HaveOccurred())
+			certificatePEM := creator.secrets["server"].Data[DataKeyCertificate]
+
+			ca.Next, err = (&CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: CACert}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = GenerateClusterSecrets(creator, creator.secrets, []ConfigInterface{config}, "namespace")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(creator.secrets["server"].Data[DataKeyCertificate]).To(Equal(certificatePEM))
+			Expect(creator.secrets["server"].Data[DataKeyCertificateCA]).To(Equal(ca.CertificateBundlePEM()))
+		})
+	})
+})
+
+type fakeSecretCreator struct {
+	mutex   sync.Mutex
+	secrets map[string]*corev1.Secret
+}
+
+func (f *fakeSecretCreator) CreateSecret(namespace, name string, secretType corev1.SecretType, data map[string][]byte, updateIfExists bool) (*corev1.Secret, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if _, ok := f.secrets[name]; ok && !updateIfExists {
+		return nil, fmt.Errorf("secret %s already exists", name)
+	}
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}, Type: secretType, Data: data}
+	f.secrets[name] = secret
+	return secret, nil
+}
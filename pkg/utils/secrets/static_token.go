@@ -0,0 +1,148 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+const (
+	// DataKeyStaticTokenCSV is the key in a secret data holding the CSV format of a secret.
+	DataKeyStaticTokenCSV = "static_tokens.csv"
+)
+
+// StaticTokenSecretConfig contains the specification a to-be-generated static token secret.
+type StaticTokenSecretConfig struct {
+	Name string
+
+	Tokens []TokenConfig
+	// TokenLength is the length of the generated tokens.
+	TokenLength int
+}
+
+// TokenConfig contains the user information of a to-be-generated token.
+type TokenConfig struct {
+	Username string
+	UserID   string
+	Groups   []string
+}
+
+// StaticToken contains the tokens of a static token secret.
+type StaticToken struct {
+	Name string
+
+	Tokens []Token
+}
+
+// Token contains a token and the information of the user it authenticates.
+type Token struct {
+	Username string
+	UserID   string
+	Groups   []string
+	Token    string
+}
+
+// GetName returns the name of the secret.
+func (s *StaticTokenSecretConfig) GetName() string {
+	return s.Name
+}
+
+// Generate implements ConfigInterface.
+func (s *StaticTokenSecretConfig) Generate() (Interface, error) {
+	return s.GenerateStaticToken()
+}
+
+// GenerateStaticToken computes a random token for every configured user.
+func (s *StaticTokenSecretConfig) GenerateStaticToken() (*StaticToken, error) {
+	staticToken := &StaticToken{
+		Name: s.Name,
+	}
+
+	for _, config := range s.Tokens {
+		token, err := utils.GenerateRandomString(s.TokenLength)
+		if err != nil {
+			return nil, err
+		}
+
+		staticToken.Tokens = append(staticToken.Tokens, Token{
+			Username: config.Username,
+			UserID:   config.UserID,
+			Groups:   config.Groups,
+			Token:    token,
+		})
+	}
+
+	return staticToken, nil
+}
+
+// SecretData computes the data map which can be used in a Kubernetes secret. The tokens are rendered in the
+// format of the kube-apiserver's token authentication file.
+func (s *StaticToken) SecretData() map[string][]byte {
+	lines := make([]string, 0, len(s.Tokens))
+	for _, token := range s.Tokens {
+		line := fmt.Sprintf("%s,%s,%s", token.Token, token.Username, token.UserID)
+		if len(token.Groups) > 0 {
+			line += fmt.Sprintf(",%q", strings.Join(token.Groups, ","))
+		}
+		lines = append(lines, line)
+	}
+
+	return map[string][]byte{
+		DataKeyStaticTokenCSV: []byte(strings.Join(lines, "\n")),
+	}
+}
+
+// GetTokenForUsername returns the token of the user with the given <username>.
+func (s *StaticToken) GetTokenForUsername(username string) (*Token, error) {
+	for _, token := range s.Tokens {
+		if token.Username == username {
+			return &token, nil
+		}
+	}
+	return nil, fmt.Errorf("static token for username %q not found", username)
+}
+
+// LoadStaticTokenFromCSV loads the static tokens from the given CSV-formatted <data>.
+func LoadStaticTokenFromCSV(name string, data []byte) (*StaticToken, error) {
+	staticToken := &StaticToken{
+		Name: name,
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		if len(strings.TrimSpace(line)) == 0 {
+			continue
+		}
+
+		csv := strings.SplitN(line, ",", 4)
+		if len(csv) < 3 {
+			return nil, fmt.Errorf("invalid CSV for loading static token data: %s", line)
+		}
+
+		token := Token{
+			Token:    csv[0],
+			Username: csv[1],
+			UserID:   csv[2],
+		}
+		if len(csv) == 4 {
+			token.Groups = strings.Split(strings.Trim(csv[3], `"`), ",")
+		}
+		staticToken.Tokens = append(staticToken.Tokens, token)
+	}
+
+	return staticToken, nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets_test
+
+import (
+	. "github.com/gardener/gardener/pkg/utils/secrets"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("StaticToken", func() {
+	var config *StaticTokenSecretConfig
+
+	BeforeEach(func() {
+		config = &StaticTokenSecretConfig{
+			Name: "static-token",
+			Tokens: []TokenConfig{
+				{Username: "health-check", UserID: "health-check"},
+				{Username: "admin", UserID: "admin", Groups: []string{"system:masters", "admins"}},
+			},
+			TokenLength: 32,
+		}
+	})
+
+	It("should generate a token for every user", func() {
+		staticToken, err := config.GenerateStaticToken()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(staticToken.Tokens).To(HaveLen(2))
+		for _, token := range staticToken.Tokens {
+			Expect(token.Token).To(HaveLen(32))
+		}
+		Expect(staticToken.Tokens[0].Token).NotTo(Equal(staticToken.Tokens[1].Token))
+	})
+
+	It("should load the tokens from the secret data", func() {
+		staticToken, err := config.GenerateStaticToken()
+		Expect(err).NotTo(HaveOccurred())
+
+		loaded, err := LoadStaticTokenFromCSV("static-token", staticToken.SecretData()[DataKeyStaticTokenCSV])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(loaded).To(Equal(staticToken))
+
+		token, err := loaded.GetTokenForUsername("admin")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token.Groups).To(Equal([]string{"system:masters", "admins"}))
+
+		_, err = loaded.GetTokenForUsername("unknown")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should render the token authentication file format", func() {
+		staticToken := &StaticToken{
+			Tokens: []Token{
+				{Username: "health-check", UserID: "health-check", Token: "foo"},
+				{Username: "admin", UserID: "admin", Groups: []string{"a", "b"}, Token: "bar"},
+			},
+		}
+
+		Expect(string(staticToken.SecretData()[DataKeyStaticTokenCSV])).To(Equal("foo,health-check,health-check\nbar,admin,admin,\"a,b\""))
+	})
+
+	It("should fail loading invalid data", func() {
+		_, err := LoadStaticTokenFromCSV("static-token", []byte("foo,bar"))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -14,6 +14,10 @@
 
 package secrets
 
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
 // ConfigInterface define functions needed for generating a specific secret.
 type ConfigInterface interface {
 	GetName() string
@@ -24,3 +28,8 @@ type ConfigInterface interface {
 type Interface interface {
 	SecretData() map[string][]byte
 }
+
+// SecretCreator creates Secrets in a cluster, see kubernetes.Interface#CreateSecret.
+type SecretCreator interface {
+	CreateSecret(namespace, name string, secretType corev1.SecretType, data map[string][]byte, updateIfExists bool) (*corev1.Secret, error)
+}
@@ -21,6 +21,15 @@ import (
 	"github.com/Masterminds/semver"
 )
 
+const (
+	// MaxShootMinorVersionsAheadOfSeed is the maximum number of minor versions the Kubernetes version of a Shoot may
+	// be newer than the Kubernetes version of the Seed cluster hosting its control plane.
+	MaxShootMinorVersionsAheadOfSeed = 2
+	// MaxShootMinorVersionsBehindSeed is the maximum number of minor versions the Kubernetes version of a Shoot may
+	// be older than the Kubernetes version of the Seed cluster hosting its control plane.
+	MaxShootMinorVersionsBehindSeed = 4
+)
+
 // CompareVersions returns true if the constraint <version1> compared by <operator> to <version2>
 // returns true, and false otherwise.
 // The comparison is based on semantic versions, i.e. <version1> and <version2> will be converted
@@ -57,3 +66,28 @@ func CheckVersionMeetsConstraint(version, constraint string) (bool, error) {
 
 	return c.Check(v), nil
 }
+
+// CheckSeedShootVersionCompatibility checks whether the Kubernetes version <shootVersion> of a Shoot is compatible with
+// the Kubernetes version <seedVersion> of the Seed cluster hosting its control plane, i.e. whether both have the same
+// major version and the Shoot is neither more than MaxShootMinorVersionsAheadOfSeed minor versions newer nor more than
+// MaxShootMinorVersionsBehindSeed minor versions older than the Seed.
+func CheckSeedShootVersionCompatibility(seedVersion, shootVersion string) error {
+	seedV, err := semver.NewVersion(normalizeVersion(seedVersion))
+	if err != nil {
+		return fmt.Errorf("invalid seed kubernetes version %q: %v", seedVersion, err)
+	}
+	shootV, err := semver.NewVersion(normalizeVersion(shootVersion))
+	if err != nil {
+		return fmt.Errorf("invalid shoot kubernetes version %q: %v", shootVersion, err)
+	}
+
+	switch {
+	case shootV.Major() != seedV.Major():
+		return fmt.Errorf("shoot kubernetes version %s must have the same major version as the seed kubernetes version %s", shootVersion, seedVersion)
+	case shootV.Minor() > seedV.Minor()+MaxShootMinorVersionsAheadOfSeed:
+		return fmt.Errorf("shoot kubernetes version %s must not be more than %d minor versions newer than the seed kubernetes version %s", shootVersion, MaxShootMinorVersionsAheadOfSeed, seedVersion)
+	case seedV.Minor() > shootV.Minor()+MaxShootMinorVersionsBehindSeed:
+		return fmt.Errorf("shoot kubernetes version %s must not be more than %d minor versions older than the seed kubernetes version %s", shootVersion, MaxShootMinorVersionsBehindSeed, seedVersion)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils_test
+
+import (
+	. "github.com/gardener/gardener/pkg/utils"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("utils", func() {
+	DescribeTable("#CheckSeedShootVersionCompatibility",
+		func(seedVersion, shootVersion string, compatible bool) {
+			err := CheckSeedShootVersionCompatibility(seedVersion, shootVersion)
+			if compatible {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("same version", "1.14.3", "1.14.3", true),
+		Entry("seed version with prefix and suffix", "v1.14.3-gke.1", "1.14.0", true),
+		Entry("shoot two minor versions newer", "1.12.0", "1.14.0", true),
+		Entry("shoot three minor versions newer", "1.12.0", "1.15.0", false),
+		Entry("shoot four minor versions older", "1.15.0", "1.11.0", true),
+		Entry("shoot five minor versions older", "1.15.0", "1.10.0", false),
+		Entry("different major version", "1.15.0", "2.15.0", false),
+		Entry("invalid seed version", "foo", "1.15.0", false),
+		Entry("invalid shoot version", "1.15.0", "foo", false),
+	)
+})
@@ -0,0 +1,168 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package freeze
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	kubeinformers "k8s.io/client-go/informers"
+	kubecorev1listers "k8s.io/client-go/listers/core/v1"
+)
+
+const (
+	// PluginName is the name of this admission plugin.
+	PluginName = "LandscapeFreeze"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// Freeze contains listers and and admission handler.
+type Freeze struct {
+	*admission.Handler
+	authorizer      authorizer.Authorizer
+	namespaceLister kubecorev1listers.NamespaceLister
+	readyFunc       admission.ReadyFunc
+}
+
+var (
+	_ = admissioninitializer.WantsKubeInformerFactory(&Freeze{})
+	_ = admissioninitializer.WantsAuthorizer(&Freeze{})
+
+	readyFuncs = []admission.ReadyFunc{}
+)
+
+// New creates a new Freeze admission plugin.
+func New() (*Freeze, error) {
+	return &Freeze{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (f *Freeze) AssignReadyFunc(fn admission.ReadyFunc) {
+	f.readyFunc = fn
+	f.SetReadyFunc(fn)
+}
+
+// SetAuthorizer gets the authorizer.
+func (f *Freeze) SetAuthorizer(authorizer authorizer.Authorizer) {
+	f.authorizer = authorizer
+}
+
+// SetKubeInformerFactory gets Lister from SharedInformerFactory.
+func (f *Freeze) SetKubeInformerFactory(factory kubeinformers.SharedInformerFactory) {
+	namespaceInformer := factory.Core().V1().Namespaces()
+	f.namespaceLister = namespaceInformer.Lister()
+
+	readyFuncs = append(readyFuncs, namespaceInformer.Informer().HasSynced)
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (f *Freeze) ValidateInitialization() error {
+	if f.authorizer == nil {
+		return errors.New("missing authorizer")
+	}
+	if f.namespaceLister == nil {
+		return errors.New("missing namespace lister")
+	}
+	return nil
+}
+
+// Validate rejects the creation of Shoots and modifications of their specifications while the landscape is frozen,
+// i.e., while the garden namespace is annotated with 'garden.sapcloud.io/freeze=true'. Operators who are allowed to
+// update the garden namespace are not affected.
+func (f *Freeze) Validate(a admission.Attributes, o admission.ObjectInterfaces) error {
+	// Ignore all kinds other than Shoot
+	if a.GetKind().GroupKind() != garden.Kind("Shoot") {
+		return nil
+	}
+
+	// Ignore updates to subresources
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	// Wait until the caches have been synced
+	if f.readyFunc == nil {
+		f.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	if !f.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	shoot, ok := a.GetObject().(*garden.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+	if a.GetOperation() == admission.Update {
+		oldShoot, ok := a.GetOldObject().(*garden.Shoot)
+		if !ok {
+			return apierrors.NewBadRequest("could not convert old resource into Shoot object")
+		}
+		if apiequality.Semantic.DeepEqual(shoot.Spec, oldShoot.Spec) {
+			return nil
+		}
+	}
+
+	gardenNamespace, err := f.namespaceLister.Get(common.GardenNamespace)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return apierrors.NewInternalError(err)
+	}
+	if !common.IsLandscapeFrozen(gardenNamespace) || f.isOperator(a) {
+		return nil
+	}
+
+	return admission.NewForbidden(a, fmt.Errorf("the landscape is frozen (%s annotation on namespace %q), shoot specifications cannot be changed", common.GardenFreeze, common.GardenNamespace))
+}
+
+// isOperator returns true if the requesting user is allowed to update the garden namespace.
+func (f *Freeze) isOperator(a admission.Attributes) bool {
+	attributes := authorizer.AttributesRecord{
+		User:            a.GetUserInfo(),
+		Verb:            "update",
+		APIGroup:        "",
+		APIVersion:      "v1",
+		Resource:        "namespaces",
+		Name:            common.GardenNamespace,
+		ResourceRequest: true,
+	}
+	decision, _, _ := f.authorizer.Authorize(attributes)
+	return decision == authorizer.DecisionAllow
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package freeze_test
+
+import (
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/plugin/pkg/global/freeze"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	kubeinformers "k8s.io/client-go/informers"
+)
+
+type fakeAuthorizerType struct{}
+
+func (fakeAuthorizerType) Authorize(a authorizer.Attributes) (authorizer.Decision, string, error) {
+	if a.GetUser().GetName() == "operator" && a.GetResource() == "namespaces" && a.GetName() == common.GardenNamespace && a.GetVerb() == "update" {
+		return authorizer.DecisionAllow, "", nil
+	}
+	return authorizer.DecisionDeny, "", nil
+}
+
+var _ = Describe("freeze", func() {
+	Describe("#Validate", func() {
+		var (
+			admissionHandler    *Freeze
+			kubeInformerFactory kubeinformers.SharedInformerFactory
+
+			gardenNamespace *corev1.Namespace
+			oldShoot        *garden.Shoot
+			shoot           *garden.Shoot
+		)
+
+		BeforeEach(func() {
+			admissionHandler, _ = New()
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+			admissionHandler.SetAuthorizer(fakeAuthorizerType{})
+			kubeInformerFactory = kubeinformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetKubeInformerFactory(kubeInformerFactory)
+
+			gardenNamespace = &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        common.GardenNamespace,
+					Annotations: map[string]string{common.GardenFreeze: "true"},
+				},
+			}
+			oldShoot = &garden.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot",
+					Namespace: "garden-dev",
+				},
+				Spec: garden.ShootSpec{
+					Kubernetes: garden.Kubernetes{
+						Version: "1.13.4",
+					},
+				},
+			}
+			shoot = oldShoot.DeepCopy()
+		})
+
+		validate := func(operation admission.Operation, userName string) error {
+			Expect(kubeInformerFactory.Core().V1().Namespaces().Informer().GetStore().Add(gardenNamespace)).To(Succeed())
+
+			attrs := admission.NewAttributesRecord(shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", operation, false, &user.DefaultInfo{Name: userName})
+			return admissionHandler.Validate(attrs, nil)
+		}
+
+		It("should forbid creating shoots while the landscape is frozen", func() {
+			err := validate(admission.Create, "user")
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		})
+
+		It("should forbid changing the specification while the landscape is frozen", func() {
+			shoot.Spec.Kubernetes.Version = "1.13.5"
+
+			err := validate(admission.Update, "user")
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		})
+
+		It("should allow changing the metadata while the landscape is frozen", func() {
+			shoot.Labels = map[string]string{"foo": "bar"}
+
+			Expect(validate(admission.Update, "user")).To(Succeed())
+		})
+
+		It("should allow operators to change the specification while the landscape is frozen", func() {
+			shoot.Spec.Kubernetes.Version = "1.13.5"
+
+			Expect(validate(admission.Update, "operator")).To(Succeed())
+		})
+
+		It("should allow changing the specification if the landscape is not frozen", func() {
+			gardenNamespace.Annotations = nil
+			shoot.Spec.Kubernetes.Version = "1.13.5"
+
+			Expect(validate(admission.Update, "user")).To(Succeed())
+		})
+	})
+})
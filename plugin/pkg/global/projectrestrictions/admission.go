@@ -0,0 +1,345 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projectrestrictions
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+)
+
+const (
+	// PluginName is the name of this admission plugin.
+	PluginName = "ProjectRestrictions"
+
+	// restrictVerb is the verb users must be allowed to use on a Project to change its restrictions. Project members
+	// are not allowed to use it, otherwise they could lift the restrictions of their own project.
+	restrictVerb = "restrict"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// ProjectRestrictions contains listers and an admission handler.
+type ProjectRestrictions struct {
+	*admission.Handler
+	authorizer          authorizer.Authorizer
+	projectLister       gardenlisters.ProjectLister
+	secretBindingLister gardenlisters.SecretBindingLister
+	readyFunc           admission.ReadyFunc
+}
+
+var (
+	_ = admissioninitializer.WantsInternalGardenInformerFactory(&ProjectRestrictions{})
+	_ = admissioninitializer.WantsAuthorizer(&ProjectRestrictions{})
+
+	readyFuncs = []admission.ReadyFunc{}
+)
+
+// New creates a new ProjectRestrictions admission plugin.
+func New() (*ProjectRestrictions, error) {
+	return &ProjectRestrictions{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (p *ProjectRestrictions) AssignReadyFunc(f admission.ReadyFunc) {
+	p.readyFunc = f
+	p.SetReadyFunc(f)
+}
+
+// SetAuthorizer gets the authorizer.
+func (p *ProjectRestrictions) SetAuthorizer(authorizer authorizer.Authorizer) {
+	p.authorizer = authorizer
+}
+
+// SetInternalGardenInformerFactory gets Lister from SharedInformerFactory.
+func (p *ProjectRestrictions) SetInternalGardenInformerFactory(f gardeninformers.SharedInformerFactory) {
+	projectInformer := f.Garden().InternalVersion().Projects()
+	p.projectLister = projectInformer.Lister()
+
+	secretBindingInformer := f.Garden().InternalVersion().SecretBindings()
+	p.secretBindingLister = secretBindingInformer.Lister()
+
+	readyFuncs = append(readyFuncs, projectInformer.Informer().HasSynced, secretBindingInformer.Informer().HasSynced)
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (p *ProjectRestrictions) ValidateInitialization() error {
+	if p.authorizer == nil {
+		return errors.New("missing authorizer")
+	}
+	if p.projectLister == nil {
+		return errors.New("missing project lister")
+	}
+	if p.secretBindingLister == nil {
+		return errors.New("missing secret binding lister")
+	}
+	return nil
+}
+
+// Admit ensures that only privileged users change the restrictions of Projects. It ensures that SecretBindings only
+// reference the cloud provider secrets allowed by the restrictions of their project. It defaults the secret binding of
+// Shoots to the default secret binding of their project and ensures that Shoots only use the cloud profiles, regions
+// and cloud provider secrets allowed by the restrictions of their project.
+func (p *ProjectRestrictions) Admit(a admission.Attributes, o admission.ObjectInterfaces) error {
+	// Wait until the caches have been synced
+	if p.readyFunc == nil {
+		p.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	if !p.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	// Ignore updates to status or other subresources
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	switch a.GetKind().GroupKind() {
+	case garden.Kind("Project"):
+		return p.admitProject(a)
+	case garden.Kind("SecretBinding"):
+		return p.admitSecretBinding(a)
+	case garden.Kind("Shoot"):
+		return p.admitShoot(a)
+	}
+	return nil
+}
+
+func (p *ProjectRestrictions) admitProject(a admission.Attributes) error {
+	project, ok := a.GetObject().(*garden.Project)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Project object")
+	}
+
+	var oldRestrictions *garden.ProjectRestrictions
+	if a.GetOperation() == admission.Update {
+		oldProject, ok := a.GetOldObject().(*garden.Project)
+		if !ok {
+			return apierrors.NewBadRequest("could not convert old resource into Project object")
+		}
+		oldRestrictions = oldProject.Spec.Restrictions
+	}
+
+	if apiequality.Semantic.DeepEqual(project.Spec.Restrictions, oldRestrictions) {
+		return nil
+	}
+
+	restrictAttributes := authorizer.AttributesRecord{
+		User:            a.GetUserInfo(),
+		Verb:            restrictVerb,
+		APIGroup:        gardenv1beta1.SchemeGroupVersion.Group,
+		APIVersion:      gardenv1beta1.SchemeGroupVersion.Version,
+		Resource:        "projects",
+		Name:            project.Name,
+		ResourceRequest: true,
+	}
+	if decision, _, _ := p.authorizer.Authorize(restrictAttributes); decision != authorizer.DecisionAllow {
+		return admission.NewForbidden(a, fmt.Errorf("user is not allowed to change the restrictions of project %q (requires the %q verb)", project.Name, restrictVerb))
+	}
+	return nil
+}
+
+func (p *ProjectRestrictions) admitSecretBinding(a admission.Attributes) error {
+	binding, ok := a.GetObject().(*garden.SecretBinding)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into SecretBinding object")
+	}
+
+	restrictions, project, err := p.getRestrictions(binding.Namespace)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	if restrictions == nil || len(restrictions.Secrets) == 0 {
+		return nil
+	}
+
+	// We only want to check the secrets which are newly referenced, i.e., existing SecretBindings are not rejected if
+	// the restrictions of their project are tightened afterwards. On CREATE operations we just use an empty
+	// SecretBinding object.
+	oldBinding := &garden.SecretBinding{}
+	if a.GetOperation() == admission.Update {
+		old, ok := a.GetOldObject().(*garden.SecretBinding)
+		if !ok {
+			return apierrors.NewBadRequest("could not convert old resource into SecretBinding object")
+		}
+		oldBinding = old
+	}
+
+	if err := checkSecretBinding(restrictions, binding, oldBinding); err != nil {
+		return admission.NewForbidden(a, fmt.Errorf("project %q: %v", project, err))
+	}
+	return nil
+}
+
+func (p *ProjectRestrictions) admitShoot(a admission.Attributes) error {
+	shoot, ok := a.GetObject().(*garden.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	// Shoots in namespaces which do not belong to a project are not restricted.
+	restrictions, project, err := p.getRestrictions(shoot.Namespace)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	if restrictions == nil {
+		return nil
+	}
+
+	if len(shoot.Spec.Cloud.SecretBindingRef.Name) == 0 && restrictions.DefaultSecretBinding != nil {
+		shoot.Spec.Cloud.SecretBindingRef.Name = *restrictions.DefaultSecretBinding
+	}
+
+	// We only want to check the fields which have changed, i.e., existing Shoots are not rejected if the restrictions
+	// of their project are tightened afterwards. On CREATE operations we just use an empty Shoot object.
+	oldShoot := &garden.Shoot{}
+	if a.GetOperation() == admission.Update {
+		old, ok := a.GetOldObject().(*garden.Shoot)
+		if !ok {
+			return apierrors.NewBadRequest("could not convert old resource into Shoot object")
+		}
+		oldShoot = old
+	}
+
+	if shoot.Spec.Cloud.Profile != oldShoot.Spec.Cloud.Profile || shoot.Spec.Cloud.Region != oldShoot.Spec.Cloud.Region {
+		if err := checkCloudProfile(restrictions, shoot.Spec.Cloud.Profile, shoot.Spec.Cloud.Region); err != nil {
+			return admission.NewForbidden(a, fmt.Errorf("project %q: %v", project, err))
+		}
+	}
+
+	if shoot.Spec.Cloud.SecretBindingRef.Name != oldShoot.Spec.Cloud.SecretBindingRef.Name && len(restrictions.Secrets) > 0 {
+		binding, err := p.secretBindingLister.SecretBindings(shoot.Namespace).Get(shoot.Spec.Cloud.SecretBindingRef.Name)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return admission.NewForbidden(a, fmt.Errorf("project %q: secret binding %q not found", project, shoot.Spec.Cloud.SecretBindingRef.Name))
+			}
+			return apierrors.NewInternalError(err)
+		}
+		if err := checkSecretBinding(restrictions, binding, &garden.SecretBinding{}); err != nil {
+			return admission.NewForbidden(a, fmt.Errorf("project %q: %v", project, err))
+		}
+	}
+
+	return nil
+}
+
+// getRestrictions returns the restrictions and the name of the project of the given <namespace>. Namespaces which do
+// not belong to a project are not restricted. Errors while listing the projects are returned, hence the admission
+// fails closed.
+func (p *ProjectRestrictions) getRestrictions(namespace string) (*garden.ProjectRestrictions, string, error) {
+	projects, err := p.projectLister.List(labels.Everything())
+	if err != nil {
+		return nil, "", err
+	}
+	for _, project := range projects {
+		if project.Spec.Namespace != nil && *project.Spec.Namespace == namespace {
+			return project.Spec.Restrictions, project.Name, nil
+		}
+	}
+	return nil, "", nil
+}
+
+func checkCloudProfile(restrictions *garden.ProjectRestrictions, name, region string) error {
+	if len(restrictions.CloudProfiles) == 0 {
+		return nil
+	}
+
+	for _, cloudProfile := range restrictions.CloudProfiles {
+		if cloudProfile.Name != name {
+			continue
+		}
+		if len(cloudProfile.Regions) == 0 {
+			return nil
+		}
+		for _, r := range cloudProfile.Regions {
+			if r == region {
+				return nil
+			}
+		}
+		return fmt.Errorf("region %q of cloud profile %q is not allowed", region, name)
+	}
+
+	return fmt.Errorf("cloud profile %q is not allowed", name)
+}
+
+// checkSecretBinding ensures that the given secret <binding> only references allowed secrets, except for the secrets
+// which are already referenced by the <oldBinding>. The name of a binding does not restrict anything as project members
+// may recreate bindings with any name, and bindings reading their credentials from Vault are not restricted to any
+// secret, hence they are rejected.
+func checkSecretBinding(restrictions *garden.ProjectRestrictions, binding, oldBinding *garden.SecretBinding) error {
+	if binding.VaultRef != nil && oldBinding.VaultRef == nil {
+		return fmt.Errorf("secret binding %q reads its credentials from Vault, which is not allowed", binding.Name)
+	}
+
+	allowed := make(map[corev1.SecretReference]bool, len(restrictions.Secrets))
+	for _, secret := range restrictions.Secrets {
+		allowed[secret] = true
+	}
+	for _, secret := range referencedSecrets(oldBinding) {
+		allowed[secret] = true
+	}
+	for _, secret := range referencedSecrets(binding) {
+		if !allowed[secret] {
+			return fmt.Errorf("secret binding %q references secret %s/%s which is not allowed", binding.Name, secret.Namespace, secret.Name)
+		}
+	}
+	return nil
+}
+
+// referencedSecrets returns the secrets referenced by the given secret <binding>, including the new secret of a
+// rotation in progress. References without namespace are defaulted to the namespace of the binding.
+func referencedSecrets(binding *garden.SecretBinding) []corev1.SecretReference {
+	var secrets []corev1.SecretReference
+	if len(binding.SecretRef.Name) > 0 {
+		secrets = append(secrets, binding.SecretRef)
+	}
+	if binding.Rotation != nil {
+		secrets = append(secrets, binding.Rotation.SecretRef)
+	}
+
+	for i := range secrets {
+		if len(secrets[i].Namespace) == 0 {
+			secrets[i].Namespace = binding.Namespace
+		}
+	}
+	return secrets
+}
@@ -0,0 +1,337 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projectrestrictions_test
+
+import (
+	"github.com/gardener/gardener/pkg/apis/garden"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	. "github.com/gardener/gardener/plugin/pkg/global/projectrestrictions"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/client-go/tools/cache"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type fakeAuthorizerType struct{}
+
+func (fakeAuthorizerType) Authorize(a authorizer.Attributes) (authorizer.Decision, string, error) {
+	if a.GetUser().GetName() == "admin" && a.GetVerb() == "restrict" {
+		return authorizer.DecisionAllow, "", nil
+	}
+	return authorizer.DecisionDeny, "", nil
+}
+
+var _ = Describe("projectrestrictions", func() {
+	var (
+		admissionHandler      *ProjectRestrictions
+		gardenInformerFactory gardeninformers.SharedInformerFactory
+		projectStore          cache.Store
+		secretBindingStore    cache.Store
+
+		namespace = "garden-dev"
+		project   *garden.Project
+		shoot     *garden.Shoot
+	)
+
+	BeforeEach(func() {
+		admissionHandler, _ = New()
+		admissionHandler.AssignReadyFunc(func() bool { return true })
+
+		gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
+		admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+		admissionHandler.SetAuthorizer(fakeAuthorizerType{})
+
+		projectStore = gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore()
+		secretBindingStore = gardenInformerFactory.Garden().InternalVersion().SecretBindings().Informer().GetStore()
+		Expect(secretBindingStore.Add(newSecretBinding(namespace, "aws-secret", "aws", "garden"))).To(Succeed())
+		Expect(secretBindingStore.Add(newSecretBinding(namespace, "gcp-secret", "gcp", "garden"))).To(Succeed())
+
+		project = &garden.Project{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "dev",
+			},
+			Spec: garden.ProjectSpec{
+				Namespace: &namespace,
+				Restrictions: &garden.ProjectRestrictions{
+					CloudProfiles: []garden.ProjectCloudProfile{
+						{Name: "aws"},
+						{Name: "gcp", Regions: []string{"europe-west1"}},
+					},
+					Secrets: []corev1.SecretReference{
+						{Name: "aws", Namespace: "garden"},
+						{Name: "gcp", Namespace: "garden"},
+					},
+					DefaultSecretBinding: makeStringPointer("aws-secret"),
+				},
+			},
+		}
+		shoot = &garden.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "shoot",
+				Namespace: namespace,
+			},
+			Spec: garden.ShootSpec{
+				Cloud: garden.Cloud{
+					Profile: "gcp",
+					Region:  "europe-west1",
+				},
+			},
+		}
+	})
+
+	create := func(shoot *garden.Shoot) error {
+		attrs := admission.NewAttributesRecord(shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+		return admissionHandler.Admit(attrs, nil)
+	}
+
+	update := func(newShoot, oldShoot *garden.Shoot) error {
+		attrs := admission.NewAttributesRecord(newShoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), newShoot.Namespace, newShoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+		return admissionHandler.Admit(attrs, nil)
+	}
+
+	Context("projects", func() {
+		var (
+			admin  = &user.DefaultInfo{Name: "admin"}
+			member = &user.DefaultInfo{Name: "member"}
+		)
+
+		updateProject := func(newProject, oldProject *garden.Project, userInfo user.Info) error {
+			attrs := admission.NewAttributesRecord(newProject, oldProject, garden.Kind("Project").WithVersion("version"), "", newProject.Name, garden.Resource("projects").WithVersion("version"), "", admission.Update, false, userInfo)
+			return admissionHandler.Admit(attrs, nil)
+		}
+
+		It("should allow privileged users to change the restrictions", func() {
+			newProject := project.DeepCopy()
+			newProject.Spec.Restrictions.Secrets = nil
+
+			Expect(updateProject(newProject, project, admin)).To(Succeed())
+		})
+
+		It("should forbid other users to change the restrictions", func() {
+			newProject := project.DeepCopy()
+			newProject.Spec.Restrictions = nil
+
+			err := updateProject(newProject, project, member)
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		})
+
+		It("should forbid other users to create projects with restrictions", func() {
+			attrs := admission.NewAttributesRecord(project, nil, garden.Kind("Project").WithVersion("version"), "", project.Name, garden.Resource("projects").WithVersion("version"), "", admission.Create, false, member)
+
+			err := admissionHandler.Admit(attrs, nil)
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		})
+
+		It("should allow other users to update projects without changing the restrictions", func() {
+			newProject := project.DeepCopy()
+			newProject.Spec.Description = makeStringPointer("foo")
+
+			Expect(updateProject(newProject, project, member)).To(Succeed())
+		})
+	})
+
+	Context("secret bindings", func() {
+		var binding *garden.SecretBinding
+
+		BeforeEach(func() {
+			binding = newSecretBinding(namespace, "gcp-secret", "gcp", "garden")
+		})
+
+		createBinding := func(binding *garden.SecretBinding) error {
+			attrs := admission.NewAttributesRecord(binding, nil, garden.Kind("SecretBinding").WithVersion("version"), binding.Namespace, binding.Name, garden.Resource("secretbindings").WithVersion("version"), "", admission.Create, false, nil)
+			return admissionHandler.Admit(attrs, nil)
+		}
+
+		updateBinding := func(newBinding, oldBinding *garden.SecretBinding) error {
+			attrs := admission.NewAttributesRecord(newBinding, oldBinding, garden.Kind("SecretBinding").WithVersion("version"), newBinding.Namespace, newBinding.Name, garden.Resource("secretbindings").WithVersion("version"), "", admission.Update, false, nil)
+			return admissionHandler.Admit(attrs, nil)
+		}
+
+		It("should allow bindings referencing allowed secrets", func() {
+			Expect(projectStore.Add(project)).To(Succeed())
+
+			Expect(createBinding(binding)).To(Succeed())
+		})
+
+		It("should reject bindings referencing other secrets with an allowed name", func() {
+			Expect(projectStore.Add(project)).To(Succeed())
+			binding.SecretRef = corev1.SecretReference{Name: "gcp"}
+
+			err := createBinding(binding)
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(`references secret garden-dev/gcp which is not allowed`))
+		})
+
+		It("should reject bindings reading their credentials from Vault", func() {
+			Expect(projectStore.Add(project)).To(Succeed())
+			binding.SecretRef = corev1.SecretReference{}
+			binding.VaultRef = &garden.SecretBindingVaultReference{Path: "gcp/key/deployer"}
+
+			err := createBinding(binding)
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		})
+
+		It("should reject rotations to secrets which are not allowed", func() {
+			Expect(projectStore.Add(project)).To(Succeed())
+			newBinding := binding.DeepCopy()
+			newBinding.Rotation = &garden.SecretBindingRotation{SecretRef: corev1.SecretReference{Name: "other", Namespace: "garden"}}
+
+			err := updateBinding(newBinding, binding)
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		})
+
+		It("should not reject updates of existing bindings if the restrictions were tightened", func() {
+			Expect(projectStore.Add(project)).To(Succeed())
+			binding.SecretRef = corev1.SecretReference{Name: "other", Namespace: "garden"}
+			newBinding := binding.DeepCopy()
+			newBinding.Labels = map[string]string{"foo": "bar"}
+
+			Expect(updateBinding(newBinding, binding)).To(Succeed())
+		})
+	})
+
+	It("should do nothing if the project has no restrictions", func() {
+		project.Spec.Restrictions = nil
+		Expect(projectStore.Add(project)).To(Succeed())
+		shoot.Spec.Cloud.Profile = "azure"
+
+		Expect(create(shoot)).To(Succeed())
+		Expect(shoot.Spec.Cloud.SecretBindingRef.Name).To(BeEmpty())
+	})
+
+	It("should default the secret binding", func() {
+		Expect(projectStore.Add(project)).To(Succeed())
+
+		Expect(create(shoot)).To(Succeed())
+		Expect(shoot.Spec.Cloud.SecretBindingRef.Name).To(Equal("aws-secret"))
+	})
+
+	It("should not overwrite an explicitly referenced secret binding", func() {
+		Expect(projectStore.Add(project)).To(Succeed())
+		shoot.Spec.Cloud.SecretBindingRef.Name = "gcp-secret"
+
+		Expect(create(shoot)).To(Succeed())
+		Expect(shoot.Spec.Cloud.SecretBindingRef.Name).To(Equal("gcp-secret"))
+	})
+
+	It("should allow all regions of a cloud profile without region restrictions", func() {
+		Expect(projectStore.Add(project)).To(Succeed())
+		shoot.Spec.Cloud.Profile = "aws"
+		shoot.Spec.Cloud.Region = "eu-west-1"
+
+		Expect(create(shoot)).To(Succeed())
+	})
+
+	It("should reject cloud profiles which are not allowed", func() {
+		Expect(projectStore.Add(project)).To(Succeed())
+		shoot.Spec.Cloud.Profile = "azure"
+
+		err := create(shoot)
+
+		Expect(err).To(HaveOccurred())
+		Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring(`cloud profile "azure" is not allowed`))
+	})
+
+	It("should reject regions which are not allowed", func() {
+		Expect(projectStore.Add(project)).To(Succeed())
+		shoot.Spec.Cloud.Region = "us-east1"
+
+		err := create(shoot)
+
+		Expect(err).To(HaveOccurred())
+		Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring(`region "us-east1" of cloud profile "gcp" is not allowed`))
+	})
+
+	It("should reject secret bindings referencing secrets which are not allowed", func() {
+		Expect(projectStore.Add(project)).To(Succeed())
+		Expect(secretBindingStore.Add(newSecretBinding(namespace, "other-secret", "other", "garden"))).To(Succeed())
+		shoot.Spec.Cloud.SecretBindingRef.Name = "other-secret"
+
+		err := create(shoot)
+
+		Expect(err).To(HaveOccurred())
+		Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring(`secret binding "other-secret" references secret garden/other which is not allowed`))
+	})
+
+	It("should reject secret bindings which do not exist", func() {
+		Expect(projectStore.Add(project)).To(Succeed())
+		shoot.Spec.Cloud.SecretBindingRef.Name = "missing-secret"
+
+		err := create(shoot)
+
+		Expect(err).To(HaveOccurred())
+		Expect(apierrors.IsForbidden(err)).To(BeTrue())
+	})
+
+	It("should not reject updates of existing shoots if the restrictions were tightened", func() {
+		Expect(projectStore.Add(project)).To(Succeed())
+		shoot.Spec.Cloud.Region = "us-east1"
+		shoot.Spec.Cloud.SecretBindingRef.Name = "other-secret"
+		newShoot := shoot.DeepCopy()
+		newShoot.Labels = map[string]string{"foo": "bar"}
+
+		Expect(update(newShoot, shoot)).To(Succeed())
+	})
+
+	It("should reject updates changing the secret binding to one which is not allowed", func() {
+		Expect(projectStore.Add(project)).To(Succeed())
+		Expect(secretBindingStore.Add(newSecretBinding(namespace, "other-secret", "other", "garden"))).To(Succeed())
+		shoot.Spec.Cloud.SecretBindingRef.Name = "gcp-secret"
+		newShoot := shoot.DeepCopy()
+		newShoot.Spec.Cloud.SecretBindingRef.Name = "other-secret"
+
+		err := update(newShoot, shoot)
+
+		Expect(err).To(HaveOccurred())
+		Expect(apierrors.IsForbidden(err)).To(BeTrue())
+	})
+})
+
+func makeStringPointer(s string) *string {
+	return &s
+}
+
+func newSecretBinding(namespace, name, secretName, secretNamespace string) *garden.SecretBinding {
+	return &garden.SecretBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		SecretRef: corev1.SecretReference{
+			Name:      secretName,
+			Namespace: secretNamespace,
+		},
+	}
+}
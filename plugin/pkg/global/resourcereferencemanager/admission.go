@@ -28,6 +28,7 @@ import (
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/plugin/pkg/utils"
 
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -273,22 +274,33 @@ func (r *ReferenceManager) Admit(a admission.Attributes, o admission.ObjectInter
 }
 
 func (r *ReferenceManager) ensureSecretBindingReferences(attributes admission.Attributes, binding *garden.SecretBinding) error {
-	readAttributes := authorizer.AttributesRecord{
-		User:            attributes.GetUserInfo(),
-		Verb:            "get",
-		APIGroup:        "",
-		APIVersion:      "v1",
-		Resource:        "secrets",
-		Namespace:       binding.SecretRef.Namespace,
-		Name:            binding.SecretRef.Name,
-		ResourceRequest: true,
+	// Credentials read from Vault are not stored in secrets, the Vault path is confined to the binding's namespace.
+	var secretRefs []corev1.SecretReference
+	if binding.VaultRef == nil {
+		secretRefs = append(secretRefs, binding.SecretRef)
 	}
-	if decision, _, _ := r.authorizer.Authorize(readAttributes); decision != authorizer.DecisionAllow {
-		return errors.New("SecretBinding cannot reference a secret you are not allowed to read")
+	if binding.Rotation != nil {
+		secretRefs = append(secretRefs, binding.Rotation.SecretRef)
 	}
 
-	if err := r.lookupSecret(binding.SecretRef.Namespace, binding.SecretRef.Name); err != nil {
-		return err
+	for _, secretRef := range secretRefs {
+		readAttributes := authorizer.AttributesRecord{
+			User:            attributes.GetUserInfo(),
+			Verb:            "get",
+			APIGroup:        "",
+			APIVersion:      "v1",
+			Resource:        "secrets",
+			Namespace:       secretRef.Namespace,
+			Name:            secretRef.Name,
+			ResourceRequest: true,
+		}
+		if decision, _, _ := r.authorizer.Authorize(readAttributes); decision != authorizer.DecisionAllow {
+			return errors.New("SecretBinding cannot reference a secret you are not allowed to read")
+		}
+
+		if err := r.lookupSecret(secretRef.Namespace, secretRef.Name); err != nil {
+			return err
+		}
 	}
 
 	var (
@@ -337,6 +349,12 @@ func (r *ReferenceManager) ensureSeedReferences(seed *garden.Seed) error {
 		return err
 	}
 
+	if backup := seed.Spec.Backup; backup != nil && backup.SecretRef != nil {
+		if err := r.lookupSecret(backup.SecretRef.Namespace, backup.SecretRef.Name); err != nil {
+			return err
+		}
+	}
+
 	return r.lookupSecret(seed.Spec.SecretRef.Namespace, seed.Spec.SecretRef.Name)
 }
 
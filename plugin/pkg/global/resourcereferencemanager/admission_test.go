@@ -224,6 +224,23 @@ var _ = Describe("resourcereferencemanager", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 
+			It("should accept a binding reading the credentials from Vault without looking up secrets", func() {
+				gardenInformerFactory.Garden().InternalVersion().Quotas().Informer().GetStore().Add(&quota)
+				kubeClient.AddReactor("get", "secrets", func(action testing.Action) (bool, runtime.Object, error) {
+					return true, nil, fmt.Errorf("nope, out of luck")
+				})
+				vaultBinding := secretBinding.DeepCopy()
+				vaultBinding.SecretRef = corev1.SecretReference{}
+				vaultBinding.VaultRef = &garden.SecretBindingVaultReference{Path: "aws/creds/deployer"}
+
+				user := &user.DefaultInfo{Name: allowedUser}
+				attrs := admission.NewAttributesRecord(vaultBinding, nil, garden.Kind("SecretBinding").WithVersion("version"), vaultBinding.Namespace, vaultBinding.Name, garden.Resource("secretbindings").WithVersion("version"), "", admission.Create, false, user)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
 			It("should reject because the referenced secret does not exist", func() {
 				gardenInformerFactory.Garden().InternalVersion().Quotas().Informer().GetStore().Add(&quota)
 				kubeClient.AddReactor("get", "secrets", func(action testing.Action) (bool, runtime.Object, error) {
@@ -238,6 +255,27 @@ var _ = Describe("resourcereferencemanager", func() {
 				Expect(err).To(HaveOccurred())
 			})
 
+			It("should reject because the referenced rotation secret does not exist", func() {
+				kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&secret)
+				gardenInformerFactory.Garden().InternalVersion().Quotas().Informer().GetStore().Add(&quota)
+				kubeClient.AddReactor("get", "secrets", func(action testing.Action) (bool, runtime.Object, error) {
+					return true, nil, fmt.Errorf("nope, out of luck")
+				})
+				secretBinding.Rotation = &garden.SecretBindingRotation{
+					SecretRef: corev1.SecretReference{
+						Namespace: secret.Namespace,
+						Name:      "new-secret",
+					},
+				}
+
+				user := &user.DefaultInfo{Name: allowedUser}
+				attrs := admission.NewAttributesRecord(&secretBinding, nil, garden.Kind("SecretBinding").WithVersion("version"), secretBinding.Namespace, secretBinding.Name, garden.Resource("secretbindings").WithVersion("version"), "", admission.Create, false, user)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+			})
+
 			It("should reject because the user is not allowed to read the referenced secret", func() {
 				kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&secret)
 				gardenInformerFactory.Garden().InternalVersion().Quotas().Informer().GetStore().Add(&quota)
@@ -368,6 +406,24 @@ var _ = Describe("resourcereferencemanager", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 
+			It("should reject because the referenced backup secret does not exist", func() {
+				kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&secret)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				kubeClient.AddReactor("get", "secrets", func(action testing.Action) (bool, runtime.Object, error) {
+					return true, nil, fmt.Errorf("nope, out of luck")
+				})
+				seedWithBackup := seed.DeepCopy()
+				seedWithBackup.Spec.Backup = &garden.SeedBackup{
+					SecretRef: &corev1.SecretReference{Namespace: secret.Namespace, Name: "backup-secret"},
+				}
+
+				attrs := admission.NewAttributesRecord(seedWithBackup, nil, garden.Kind("Seed").WithVersion("version"), "", seed.Name, garden.Resource("seeds").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+			})
+
 			It("should reject because the referenced cloud profile does not exist", func() {
 				kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&secret)
 
@@ -0,0 +1,341 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletionprotection
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/apis/garden/helper"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	"github.com/gardener/gardener/pkg/client/garden/clientset/internalversion"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	multierror "github.com/hashicorp/go-multierror"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+const (
+	// PluginName is the name of this admission plugin.
+	PluginName = "ShootDeletionProtection"
+
+	// ApprovalTTL is the duration after which an approval of the deletion of a Shoot expires.
+	ApprovalTTL = 24 * time.Hour
+)
+
+// Now returns the current time. It is a variable so that it can be overwritten in tests.
+var Now = time.Now
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// DeletionProtection contains listers and an admission handler.
+type DeletionProtection struct {
+	*admission.Handler
+	gardenClient                   internalversion.Interface
+	shootLister                    gardenlisters.ShootLister
+	deletionProtectionPolicyLister gardenlisters.DeletionProtectionPolicyLister
+	readyFunc                      admission.ReadyFunc
+}
+
+var (
+	_ = admissioninitializer.WantsInternalGardenInformerFactory(&DeletionProtection{})
+	_ = admissioninitializer.WantsInternalGardenClientset(&DeletionProtection{})
+
+	readyFuncs = []admission.ReadyFunc{}
+)
+
+// New creates a new DeletionProtection admission plugin.
+func New() (*DeletionProtection, error) {
+	return &DeletionProtection{
+		Handler: admission.NewHandler(admission.Create, admission.Update, admission.Delete),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (d *DeletionProtection) AssignReadyFunc(f admission.ReadyFunc) {
+	d.readyFunc = f
+	d.SetReadyFunc(f)
+}
+
+// SetInternalGardenInformerFactory gets Lister from SharedInformerFactory.
+func (d *DeletionProtection) SetInternalGardenInformerFactory(f gardeninformers.SharedInformerFactory) {
+	shootInformer := f.Garden().InternalVersion().Shoots()
+	d.shootLister = shootInformer.Lister()
+
+	deletionProtectionPolicyInformer := f.Garden().InternalVersion().DeletionProtectionPolicies()
+	d.deletionProtectionPolicyLister = deletionProtectionPolicyInformer.Lister()
+
+	readyFuncs = append(readyFuncs, shootInformer.Informer().HasSynced, deletionProtectionPolicyInformer.Informer().HasSynced)
+}
+
+// SetInternalGardenClientset gets the clientset from the Kubernetes client.
+func (d *DeletionProtection) SetInternalGardenClientset(c internalversion.Interface) {
+	d.gardenClient = c
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (d *DeletionProtection) ValidateInitialization() error {
+	if d.shootLister == nil {
+		return errors.New("missing shoot lister")
+	}
+	if d.deletionProtectionPolicyLister == nil {
+		return errors.New("missing deletion protection policy lister")
+	}
+	return nil
+}
+
+// Admit records the users approving the deletion of a Shoot. Users approve (or revoke their approval) by setting the
+// deletion approval annotation to "true" (or "false"), the list of approving users cannot be modified directly.
+// Approvals expire after ApprovalTTL and are removed with the next update of the Shoot.
+func (d *DeletionProtection) Admit(a admission.Attributes, o admission.ObjectInterfaces) error {
+	// Ignore all kinds other than Shoot
+	if a.GetKind().GroupKind() != garden.Kind("Shoot") {
+		return nil
+	}
+
+	// Ignore updates to subresources and deletions
+	if a.GetSubresource() != "" || a.GetOperation() == admission.Delete {
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*garden.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	approvals := map[string]time.Time{}
+	if a.GetOperation() == admission.Update {
+		oldShoot, ok := a.GetOldObject().(*garden.Shoot)
+		if !ok {
+			return apierrors.NewBadRequest("could not convert old resource into Shoot object")
+		}
+		approvals = deletionApprovals(oldShoot)
+
+		if value, ok := shoot.Annotations[common.ConfirmationDeletionApproval]; ok {
+			approved, err := strconv.ParseBool(value)
+			if err != nil {
+				return admission.NewForbidden(a, fmt.Errorf("value of the %q annotation must be a boolean: %v", common.ConfirmationDeletionApproval, err))
+			}
+			if userInfo := a.GetUserInfo(); userInfo != nil && len(userInfo.GetName()) > 0 {
+				if approved {
+					approvals[userInfo.GetName()] = Now().UTC().Truncate(time.Second)
+				} else {
+					delete(approvals, userInfo.GetName())
+				}
+			}
+		}
+	}
+
+	delete(shoot.Annotations, common.ConfirmationDeletionApproval)
+	delete(shoot.Annotations, common.ShootDeletionApprovedBy)
+	if len(approvals) > 0 {
+		value, err := json.Marshal(approvals)
+		if err != nil {
+			return apierrors.NewInternalError(err)
+		}
+		if shoot.Annotations == nil {
+			shoot.Annotations = make(map[string]string)
+		}
+		shoot.Annotations[common.ShootDeletionApprovedBy] = string(value)
+	}
+
+	return nil
+}
+
+// Validate denies the deletion of Shoots matched by DeletionProtectionPolicies unless it has been approved by the
+// required number of distinct users. As the policies select Shoots by their labels, label changes which lower the
+// number of required approvals are denied the same way.
+func (d *DeletionProtection) Validate(a admission.Attributes, o admission.ObjectInterfaces) error {
+	// Ignore all kinds other than Shoot
+	if a.GetKind().GroupKind() != garden.Kind("Shoot") {
+		return nil
+	}
+
+	// Ignore updates to subresources and creations
+	if a.GetSubresource() != "" || a.GetOperation() == admission.Create {
+		return nil
+	}
+
+	// Wait until the caches have been synced
+	if d.readyFunc == nil {
+		d.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	if !d.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	policies, err := d.deletionProtectionPolicyLister.List(labels.Everything())
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+
+	if a.GetOperation() == admission.Update {
+		return validateLabelUpdate(a, policies)
+	}
+
+	// DELETECOLLECTION requests have an empty resource name. They are only allowed if the deletion of all Shoots in
+	// the namespace has been approved.
+	if a.GetName() == "" {
+		shoots, err := d.shootLister.Shoots(a.GetNamespace()).List(labels.Everything())
+		if err != nil {
+			return apierrors.NewInternalError(err)
+		}
+
+		var result error
+		for _, shoot := range shoots {
+			if err := checkIfDeletionIsApproved(policies, shoot); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+		if result != nil {
+			return admission.NewForbidden(a, result)
+		}
+		return nil
+	}
+
+	// Read the Shoot from the cache first. If the deletion is not approved we do a live lookup to allow clients to
+	// send approve+delete requests subsequently very fast.
+	shoot, err := d.shootLister.Shoots(a.GetNamespace()).Get(a.GetName())
+	if err == nil {
+		if checkIfDeletionIsApproved(policies, shoot) == nil {
+			return nil
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return apierrors.NewInternalError(err)
+	}
+
+	shoot, err = d.gardenClient.Garden().Shoots(a.GetNamespace()).Get(a.GetName(), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if err := checkIfDeletionIsApproved(policies, shoot); err != nil {
+		return admission.NewForbidden(a, err)
+	}
+	return nil
+}
+
+// validateLabelUpdate denies label changes which lower the number of approvals required for the deletion of a Shoot
+// unless its deletion has already been approved by the number of users required before the change. Otherwise the
+// owner of a Shoot could remove it from all policies and delete it without any approval.
+func validateLabelUpdate(a admission.Attributes, policies []*garden.DeletionProtectionPolicy) error {
+	shoot, ok := a.GetObject().(*garden.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+	oldShoot, ok := a.GetOldObject().(*garden.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert old resource into Shoot object")
+	}
+
+	if labels.Equals(shoot.Labels, oldShoot.Labels) {
+		return nil
+	}
+
+	oldRequiredApprovals, err := helper.GetShootRequiredDeletionApprovals(policies, oldShoot)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	requiredApprovals, err := helper.GetShootRequiredDeletionApprovals(policies, shoot)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	if requiredApprovals >= oldRequiredApprovals {
+		return nil
+	}
+
+	if err := checkIfDeletionIsApproved(policies, oldShoot); err != nil {
+		return admission.NewForbidden(a, fmt.Errorf("labels of shoot %q must not be changed to lower the number of required deletion approvals from %d to %d: %v", oldShoot.Name, oldRequiredApprovals, requiredApprovals, err))
+	}
+	return nil
+}
+
+func checkIfDeletionIsApproved(policies []*garden.DeletionProtectionPolicy, shoot *garden.Shoot) error {
+	requiredApprovals, err := helper.GetShootRequiredDeletionApprovals(policies, shoot)
+	if err != nil {
+		return err
+	}
+
+	approvedBy := approvers(deletionApprovals(shoot))
+	if int32(len(approvedBy)) >= requiredApprovals {
+		return nil
+	}
+
+	approvedByText := "<none>"
+	if len(approvedBy) > 0 {
+		approvedByText = strings.Join(approvedBy, ", ")
+	}
+	return fmt.Errorf("deletion of shoot %q must be approved by %d distinct users within %s via the %q annotation, approved by: %s", shoot.Name, requiredApprovals, ApprovalTTL, common.ConfirmationDeletionApproval, approvedByText)
+}
+
+// deletionApprovals returns the users who have approved the deletion of the given Shoot together with the time of
+// their approval. Expired approvals are omitted. Values which cannot be parsed are treated as if no user had approved
+// the deletion.
+func deletionApprovals(shoot *garden.Shoot) map[string]time.Time {
+	approvals := map[string]time.Time{}
+
+	value, ok := shoot.Annotations[common.ShootDeletionApprovedBy]
+	if !ok {
+		return approvals
+	}
+	if err := json.Unmarshal([]byte(value), &approvals); err != nil {
+		return map[string]time.Time{}
+	}
+
+	now := Now()
+	for user, approvedAt := range approvals {
+		if len(user) == 0 || now.Sub(approvedAt) > ApprovalTTL {
+			delete(approvals, user)
+		}
+	}
+	return approvals
+}
+
+func approvers(approvals map[string]time.Time) []string {
+	users := make([]string, 0, len(approvals))
+	for user := range approvals {
+		users = append(users, user)
+	}
+	sort.Strings(users)
+	return users
+}
@@ -0,0 +1,338 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletionprotection_test
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/client/garden/clientset/internalversion/fake"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/deletionprotection"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("deletionprotection", func() {
+	var (
+		admissionHandler      *DeletionProtection
+		gardenInformerFactory gardeninformers.SharedInformerFactory
+		gardenClient          *fake.Clientset
+		shootStore            cache.Store
+		policyStore           cache.Store
+
+		shoot  *garden.Shoot
+		policy *garden.DeletionProtectionPolicy
+
+		alice = &user.DefaultInfo{Name: "alice"}
+		bob   = &user.DefaultInfo{Name: "bob"}
+
+		now    = time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC)
+		oldNow func() time.Time
+
+		approvedAt = func(approvals map[string]time.Time) string {
+			value, err := json.Marshal(approvals)
+			Expect(err).NotTo(HaveOccurred())
+			return string(value)
+		}
+		approvedBy = func(users ...string) string {
+			approvals := map[string]time.Time{}
+			for _, user := range users {
+				approvals[user] = now
+			}
+			return approvedAt(approvals)
+		}
+	)
+
+	BeforeEach(func() {
+		oldNow = Now
+		Now = func() time.Time { return now }
+
+		admissionHandler, _ = New()
+		admissionHandler.AssignReadyFunc(func() bool { return true })
+
+		gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
+		admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+
+		gardenClient = &fake.Clientset{}
+		admissionHandler.SetInternalGardenClientset(gardenClient)
+
+		shootStore = gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore()
+		policyStore = gardenInformerFactory.Garden().InternalVersion().DeletionProtectionPolicies().Informer().GetStore()
+
+		shoot = &garden.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "shoot",
+				Namespace: "garden-dev",
+				Labels:    map[string]string{"environment": "production"},
+			},
+		}
+		policy = &garden.DeletionProtectionPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "production",
+			},
+			Spec: garden.DeletionProtectionPolicySpec{
+				ShootSelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"environment": "production"},
+				},
+				RequiredApprovals: 2,
+				BackupRetention:   &metav1.Duration{Duration: 720 * time.Hour},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		Now = oldNow
+	})
+
+	Describe("#Admit", func() {
+		update := func(newShoot, oldShoot *garden.Shoot, userInfo user.Info) error {
+			attrs := admission.NewAttributesRecord(newShoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), newShoot.Namespace, newShoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, userInfo)
+			return admissionHandler.Admit(attrs, nil)
+		}
+
+		It("should remove the approval annotations when the shoot is created", func() {
+			shoot.Annotations = map[string]string{
+				common.ConfirmationDeletionApproval: "true",
+				common.ShootDeletionApprovedBy:      approvedBy("alice", "bob"),
+			}
+			attrs := admission.NewAttributesRecord(shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, alice)
+
+			Expect(admissionHandler.Admit(attrs, nil)).To(Succeed())
+			Expect(shoot.Annotations).To(BeEmpty())
+		})
+
+		It("should record the users approving the deletion", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Annotations = map[string]string{common.ConfirmationDeletionApproval: "true"}
+
+			Expect(update(newShoot, shoot, bob)).To(Succeed())
+			Expect(newShoot.Annotations).To(Equal(map[string]string{common.ShootDeletionApprovedBy: approvedBy("bob")}))
+
+			oldShoot := newShoot.DeepCopy()
+			newShoot.Annotations[common.ConfirmationDeletionApproval] = "true"
+
+			Expect(update(newShoot, oldShoot, alice)).To(Succeed())
+			Expect(newShoot.Annotations).To(Equal(map[string]string{common.ShootDeletionApprovedBy: approvedBy("alice", "bob")}))
+		})
+
+		It("should remove the approval of a user revoking it", func() {
+			shoot.Annotations = map[string]string{common.ShootDeletionApprovedBy: approvedBy("alice", "bob")}
+			newShoot := shoot.DeepCopy()
+			newShoot.Annotations[common.ConfirmationDeletionApproval] = "false"
+
+			Expect(update(newShoot, shoot, alice)).To(Succeed())
+			Expect(newShoot.Annotations).To(Equal(map[string]string{common.ShootDeletionApprovedBy: approvedBy("bob")}))
+		})
+
+		It("should not allow modifying the approving users directly", func() {
+			shoot.Annotations = map[string]string{common.ShootDeletionApprovedBy: approvedBy("bob")}
+			newShoot := shoot.DeepCopy()
+			newShoot.Annotations[common.ShootDeletionApprovedBy] = approvedBy("alice", "bob", "carol")
+
+			Expect(update(newShoot, shoot, alice)).To(Succeed())
+			Expect(newShoot.Annotations).To(Equal(map[string]string{common.ShootDeletionApprovedBy: approvedBy("bob")}))
+		})
+
+		It("should remove expired approvals", func() {
+			shoot.Annotations = map[string]string{common.ShootDeletionApprovedBy: approvedAt(map[string]time.Time{
+				"alice": now.Add(-ApprovalTTL - time.Second),
+				"bob":   now.Add(-time.Hour),
+			})}
+			newShoot := shoot.DeepCopy()
+
+			Expect(update(newShoot, shoot, alice)).To(Succeed())
+			Expect(newShoot.Annotations).To(Equal(map[string]string{common.ShootDeletionApprovedBy: approvedAt(map[string]time.Time{
+				"bob": now.Add(-time.Hour),
+			})}))
+		})
+
+		It("should reject invalid approval annotation values", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Annotations = map[string]string{common.ConfirmationDeletionApproval: "yes, please"}
+
+			err := update(newShoot, shoot, alice)
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		})
+	})
+
+	Describe("#Validate", func() {
+		deleteShoot := func(name string) error {
+			attrs := admission.NewAttributesRecord(nil, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, name, garden.Resource("shoots").WithVersion("version"), "", admission.Delete, false, alice)
+			return admissionHandler.Validate(attrs, nil)
+		}
+
+		It("should allow the deletion if no policy exists", func() {
+			Expect(shootStore.Add(shoot)).To(Succeed())
+
+			Expect(deleteShoot(shoot.Name)).To(Succeed())
+		})
+
+		It("should allow the deletion of shoots not matched by a policy", func() {
+			shoot.Labels = map[string]string{"environment": "development"}
+			Expect(shootStore.Add(shoot)).To(Succeed())
+			Expect(policyStore.Add(policy)).To(Succeed())
+
+			Expect(deleteShoot(shoot.Name)).To(Succeed())
+		})
+
+		It("should reject the deletion if it has not been approved by enough users", func() {
+			shoot.Annotations = map[string]string{common.ShootDeletionApprovedBy: approvedBy("alice")}
+			Expect(shootStore.Add(shoot)).To(Succeed())
+			Expect(policyStore.Add(policy)).To(Succeed())
+			gardenClient.AddReactor("get", "shoots", func(action testing.Action) (bool, runtime.Object, error) {
+				return true, shoot, nil
+			})
+
+			err := deleteShoot(shoot.Name)
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("must be approved by 2 distinct users"))
+		})
+
+		It("should allow the deletion if it has been approved by enough users", func() {
+			shoot.Annotations = map[string]string{common.ShootDeletionApprovedBy: approvedBy("alice", "bob")}
+			Expect(shootStore.Add(shoot)).To(Succeed())
+			Expect(policyStore.Add(policy)).To(Succeed())
+
+			Expect(deleteShoot(shoot.Name)).To(Succeed())
+		})
+
+		It("should reject the deletion if the approvals have expired", func() {
+			shoot.Annotations = map[string]string{common.ShootDeletionApprovedBy: approvedAt(map[string]time.Time{
+				"alice": now.Add(-ApprovalTTL - time.Second),
+				"bob":   now,
+			})}
+			Expect(shootStore.Add(shoot)).To(Succeed())
+			Expect(policyStore.Add(policy)).To(Succeed())
+			gardenClient.AddReactor("get", "shoots", func(action testing.Action) (bool, runtime.Object, error) {
+				return true, shoot, nil
+			})
+
+			err := deleteShoot(shoot.Name)
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("approved by: bob"))
+		})
+
+		It("should reject the deletion if the approvals cannot be parsed", func() {
+			shoot.Annotations = map[string]string{common.ShootDeletionApprovedBy: "alice,bob"}
+			Expect(shootStore.Add(shoot)).To(Succeed())
+			Expect(policyStore.Add(policy)).To(Succeed())
+			gardenClient.AddReactor("get", "shoots", func(action testing.Action) (bool, runtime.Object, error) {
+				return true, shoot, nil
+			})
+
+			err := deleteShoot(shoot.Name)
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		})
+
+		It("should apply the strictest of all matching policies", func() {
+			shoot.Annotations = map[string]string{common.ShootDeletionApprovedBy: approvedBy("alice", "bob")}
+			strictPolicy := policy.DeepCopy()
+			strictPolicy.Name = "strict"
+			strictPolicy.Spec.RequiredApprovals = 3
+			Expect(shootStore.Add(shoot)).To(Succeed())
+			Expect(policyStore.Add(policy)).To(Succeed())
+			Expect(policyStore.Add(strictPolicy)).To(Succeed())
+			gardenClient.AddReactor("get", "shoots", func(action testing.Action) (bool, runtime.Object, error) {
+				return true, shoot, nil
+			})
+
+			err := deleteShoot(shoot.Name)
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		})
+
+		It("should look up the shoot live if the cached one has not been approved yet", func() {
+			Expect(shootStore.Add(shoot)).To(Succeed())
+			Expect(policyStore.Add(policy)).To(Succeed())
+			gardenClient.AddReactor("get", "shoots", func(action testing.Action) (bool, runtime.Object, error) {
+				liveShoot := shoot.DeepCopy()
+				liveShoot.Annotations = map[string]string{common.ShootDeletionApprovedBy: approvedBy("alice", "bob")}
+				return true, liveShoot, nil
+			})
+
+			Expect(deleteShoot(shoot.Name)).To(Succeed())
+		})
+
+		It("should reject the deletion of a collection containing a shoot whose deletion has not been approved", func() {
+			approvedShoot := shoot.DeepCopy()
+			approvedShoot.Name = "approved"
+			approvedShoot.Annotations = map[string]string{common.ShootDeletionApprovedBy: approvedBy("alice", "bob")}
+			Expect(shootStore.Add(shoot)).To(Succeed())
+			Expect(shootStore.Add(approvedShoot)).To(Succeed())
+			Expect(policyStore.Add(policy)).To(Succeed())
+
+			err := deleteShoot("")
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(`deletion of shoot "shoot"`))
+			Expect(err.Error()).NotTo(ContainSubstring(`deletion of shoot "approved"`))
+		})
+
+		Context("label updates", func() {
+			updateLabels := func(newLabels map[string]string) error {
+				newShoot := shoot.DeepCopy()
+				newShoot.Labels = newLabels
+				attrs := admission.NewAttributesRecord(newShoot, shoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, alice)
+				return admissionHandler.Validate(attrs, nil)
+			}
+
+			BeforeEach(func() {
+				Expect(policyStore.Add(policy)).To(Succeed())
+			})
+
+			It("should reject label changes removing the shoot from a policy without approval", func() {
+				shoot.Annotations = map[string]string{common.ShootDeletionApprovedBy: approvedBy("alice")}
+
+				err := updateLabels(map[string]string{"environment": "development"})
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("from 2 to 0"))
+			})
+
+			It("should allow label changes removing the shoot from a policy if its deletion has been approved", func() {
+				shoot.Annotations = map[string]string{common.ShootDeletionApprovedBy: approvedBy("alice", "bob")}
+
+				Expect(updateLabels(map[string]string{"environment": "development"})).To(Succeed())
+			})
+
+			It("should allow label changes not lowering the required approvals", func() {
+				Expect(updateLabels(map[string]string{"environment": "production", "team": "dev"})).To(Succeed())
+			})
+		})
+	})
+})
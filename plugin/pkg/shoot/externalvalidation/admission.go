@@ -0,0 +1,247 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package externalvalidation
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/plugin/pkg/shoot/externalvalidation/apis/externalvalidation"
+	"github.com/gardener/gardener/plugin/pkg/shoot/externalvalidation/validation"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+const (
+	// PluginName is the name of this admission plugin.
+	PluginName = "ShootExternalValidation"
+
+	// maxResponseSize is the maximum size of a response of an external validation webhook which is read.
+	maxResponseSize = 1 << 20
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		// load the configuration provided (if any)
+		configuration, err := LoadConfiguration(config)
+		if err != nil {
+			return nil, err
+		}
+
+		// validate the configuration
+		if err := validation.ValidateConfiguration(configuration); err != nil {
+			return nil, err
+		}
+
+		return New(configuration)
+	})
+}
+
+// ExternalValidation contains the external validation webhooks and an admission handler.
+type ExternalValidation struct {
+	*admission.Handler
+	webhooks []*webhook
+}
+
+// webhook is an external validation webhook along with the HTTP client used to call it.
+type webhook struct {
+	name          string
+	url           string
+	failurePolicy externalvalidation.FailurePolicyType
+	client        *http.Client
+}
+
+// New creates a new ExternalValidation admission plugin.
+func New(configuration *externalvalidation.Configuration) (*ExternalValidation, error) {
+	var webhooks []*webhook
+
+	for _, w := range configuration.Webhooks {
+		tlsConfig := &tls.Config{}
+		if len(w.CABundle) > 0 {
+			rootCAs := x509.NewCertPool()
+			if !rootCAs.AppendCertsFromPEM(w.CABundle) {
+				return nil, fmt.Errorf("could not parse CA bundle of webhook %q", w.Name)
+			}
+			tlsConfig.RootCAs = rootCAs
+		}
+
+		timeout := time.Duration(0)
+		if w.TimeoutSeconds != nil {
+			timeout = time.Duration(*w.TimeoutSeconds) * time.Second
+		}
+
+		failurePolicy := externalvalidation.Fail
+		if w.FailurePolicy != nil {
+			failurePolicy = *w.FailurePolicy
+		}
+
+		webhooks = append(webhooks, &webhook{
+			name:          w.Name,
+			url:           w.URL,
+			failurePolicy: failurePolicy,
+			client: &http.Client{
+				Timeout:   timeout,
+				Transport: &http.Transport{TLSClientConfig: tlsConfig},
+			},
+		})
+	}
+
+	return &ExternalValidation{
+		Handler:  admission.NewHandler(admission.Create, admission.Update),
+		webhooks: webhooks,
+	}, nil
+}
+
+// Validate sends created Shoots and Shoots whose specification is changed to the configured external validation
+// webhooks. The request is rejected if one of the webhooks denies it. Errors calling a webhook reject the request
+// unless the failure policy of the webhook is 'Ignore'.
+func (e *ExternalValidation) Validate(a admission.Attributes, o admission.ObjectInterfaces) error {
+	if len(e.webhooks) == 0 {
+		return nil
+	}
+
+	// Ignore all kinds other than Shoot
+	if a.GetKind().GroupKind() != garden.Kind("Shoot") {
+		return nil
+	}
+
+	// Ignore updates to subresources
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*garden.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	request := &ShootReviewRequest{
+		Operation: string(a.GetOperation()),
+		DryRun:    a.IsDryRun(),
+	}
+
+	if a.GetOperation() == admission.Update {
+		oldShoot, ok := a.GetOldObject().(*garden.Shoot)
+		if !ok {
+			return apierrors.NewBadRequest("could not convert old resource into Shoot object")
+		}
+		if apiequality.Semantic.DeepEqual(shoot.Spec, oldShoot.Spec) {
+			return nil
+		}
+
+		versionedOldShoot, err := toVersionedShoot(oldShoot)
+		if err != nil {
+			return apierrors.NewInternalError(err)
+		}
+		request.OldShoot = versionedOldShoot
+	}
+
+	versionedShoot, err := toVersionedShoot(shoot)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	request.Shoot = versionedShoot
+
+	if userInfo := a.GetUserInfo(); userInfo != nil {
+		request.UserInfo = authenticationv1.UserInfo{
+			Username: userInfo.GetName(),
+			UID:      userInfo.GetUID(),
+			Groups:   userInfo.GetGroups(),
+		}
+		if extra := userInfo.GetExtra(); len(extra) > 0 {
+			request.UserInfo.Extra = make(map[string]authenticationv1.ExtraValue, len(extra))
+			for key, values := range extra {
+				request.UserInfo.Extra[key] = authenticationv1.ExtraValue(values)
+			}
+		}
+	}
+
+	var denials []string
+	for _, w := range e.webhooks {
+		response, err := w.review(request)
+		if err != nil {
+			if w.failurePolicy == externalvalidation.Ignore {
+				utilruntime.HandleError(fmt.Errorf("failed calling external validation webhook %q, ignoring: %v", w.name, err))
+				continue
+			}
+			return apierrors.NewInternalError(fmt.Errorf("failed calling external validation webhook %q: %v", w.name, err))
+		}
+
+		if !response.Allowed {
+			reason := "no reason given"
+			if len(response.Messages) > 0 {
+				reason = strings.Join(response.Messages, ", ")
+			}
+			denials = append(denials, fmt.Sprintf("%s (%s)", w.name, reason))
+		}
+	}
+
+	if len(denials) > 0 {
+		return admission.NewForbidden(a, fmt.Errorf("denied by external validation webhooks: %s", strings.Join(denials, "; ")))
+	}
+	return nil
+}
+
+// review sends the given request to the webhook and returns its response.
+func (w *webhook) review(request *ShootReviewRequest) (*ShootReviewResponse, error) {
+	body, err := json.Marshal(&ShootReview{Request: request})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := w.client.Post(w.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	review := &ShootReview{}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(review); err != nil {
+		return nil, fmt.Errorf("could not decode response: %v", err)
+	}
+	if review.Response == nil {
+		return nil, fmt.Errorf("response is missing")
+	}
+	return review.Response, nil
+}
+
+// toVersionedShoot converts the given internal Shoot into a v1beta1 Shoot which is sent to the webhooks.
+func toVersionedShoot(shoot *garden.Shoot) (*gardenv1beta1.Shoot, error) {
+	versionedShoot := &gardenv1beta1.Shoot{}
+	if err := api.Scheme.Convert(shoot, versionedShoot, nil); err != nil {
+		return nil, err
+	}
+	versionedShoot.SetGroupVersionKind(gardenv1beta1.SchemeGroupVersion.WithKind("Shoot"))
+	return versionedShoot, nil
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package externalvalidation_test
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/externalvalidation"
+	"github.com/gardener/gardener/plugin/pkg/shoot/externalvalidation/apis/externalvalidation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+)
+
+var _ = Describe("externalvalidation", func() {
+	Describe("#LoadConfiguration", func() {
+		It("should return an empty configuration if none is provided", func() {
+			configuration, err := LoadConfiguration(nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(configuration.Webhooks).To(BeEmpty())
+		})
+
+		It("should decode the configuration and apply the defaults", func() {
+			configuration, err := LoadConfiguration(strings.NewReader(`apiVersion: externalvalidation.admission.config.gardener.cloud/v1alpha1
+kind: Configuration
+webhooks:
+- name: naming
+  url: https://policies.example.com/naming
+`))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(configuration.Webhooks).To(HaveLen(1))
+			Expect(configuration.Webhooks[0].Name).To(Equal("naming"))
+			Expect(*configuration.Webhooks[0].TimeoutSeconds).To(Equal(int32(10)))
+			Expect(*configuration.Webhooks[0].FailurePolicy).To(Equal(externalvalidation.Fail))
+		})
+	})
+
+	Describe("#Validate", func() {
+		var (
+			server   *httptest.Server
+			reviews  []*ShootReview
+			response *ShootReviewResponse
+			status   int
+
+			shoot = garden.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot",
+					Namespace: "garden-dev",
+				},
+				Spec: garden.ShootSpec{
+					Cloud: garden.Cloud{
+						Region: "eu-west-1",
+					},
+				},
+			}
+			userInfo = &user.DefaultInfo{Name: "john.doe", Groups: []string{"developers"}}
+		)
+
+		BeforeEach(func() {
+			reviews = nil
+			response = &ShootReviewResponse{Allowed: true}
+			status = http.StatusOK
+
+			server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				review := &ShootReview{}
+				Expect(json.NewDecoder(r.Body).Decode(review)).To(Succeed())
+				reviews = append(reviews, review)
+
+				w.WriteHeader(status)
+				Expect(json.NewEncoder(w).Encode(&ShootReview{Response: response})).To(Succeed())
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		newAdmissionHandler := func(failurePolicy externalvalidation.FailurePolicyType) *ExternalValidation {
+			caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+			admissionHandler, err := New(&externalvalidation.Configuration{
+				Webhooks: []externalvalidation.Webhook{
+					{
+						Name:          "regions",
+						URL:           server.URL,
+						CABundle:      caBundle,
+						FailurePolicy: &failurePolicy,
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			return admissionHandler
+		}
+
+		It("should send created Shoots to the webhook and admit them if allowed", func() {
+			admissionHandler := newAdmissionHandler(externalvalidation.Fail)
+			attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, userInfo)
+
+			Expect(admissionHandler.Validate(attrs, nil)).To(Succeed())
+			Expect(reviews).To(HaveLen(1))
+			Expect(reviews[0].Request.Operation).To(Equal("CREATE"))
+			Expect(reviews[0].Request.UserInfo.Username).To(Equal("john.doe"))
+			Expect(reviews[0].Request.UserInfo.Groups).To(ConsistOf("developers"))
+			Expect(reviews[0].Request.Shoot.Kind).To(Equal("Shoot"))
+			Expect(reviews[0].Request.Shoot.Name).To(Equal(shoot.Name))
+			Expect(reviews[0].Request.Shoot.Spec.Cloud.Region).To(Equal("eu-west-1"))
+			Expect(reviews[0].Request.OldShoot).To(BeNil())
+			Expect(reviews[0].Request.DryRun).To(BeFalse())
+		})
+
+		It("should mark dry-run requests", func() {
+			admissionHandler := newAdmissionHandler(externalvalidation.Fail)
+			attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, true, userInfo)
+
+			Expect(admissionHandler.Validate(attrs, nil)).To(Succeed())
+			Expect(reviews).To(HaveLen(1))
+			Expect(reviews[0].Request.DryRun).To(BeTrue())
+		})
+
+		It("should reject Shoots denied by the webhook and return its messages", func() {
+			response = &ShootReviewResponse{Allowed: false, Messages: []string{"region eu-west-1 is not allowed"}}
+			admissionHandler := newAdmissionHandler(externalvalidation.Fail)
+			attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, userInfo)
+
+			err := admissionHandler.Validate(attrs, nil)
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("regions (region eu-west-1 is not allowed)"))
+		})
+
+		It("should send the old Shoot if the specification is changed", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Spec.Cloud.Region = "eu-central-1"
+			admissionHandler := newAdmissionHandler(externalvalidation.Fail)
+			attrs := admission.NewAttributesRecord(newShoot, &shoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, userInfo)
+
+			Expect(admissionHandler.Validate(attrs, nil)).To(Succeed())
+			Expect(reviews).To(HaveLen(1))
+			Expect(reviews[0].Request.Operation).To(Equal("UPDATE"))
+			Expect(reviews[0].Request.Shoot.Spec.Cloud.Region).To(Equal("eu-central-1"))
+			Expect(reviews[0].Request.OldShoot.Spec.Cloud.Region).To(Equal("eu-west-1"))
+		})
+
+		It("should not call the webhook if the specification is unchanged", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Labels = map[string]string{"foo": "bar"}
+			admissionHandler := newAdmissionHandler(externalvalidation.Fail)
+			attrs := admission.NewAttributesRecord(newShoot, &shoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, userInfo)
+
+			Expect(admissionHandler.Validate(attrs, nil)).To(Succeed())
+			Expect(reviews).To(BeEmpty())
+		})
+
+		It("should reject the request if the webhook fails and the failure policy is 'Fail'", func() {
+			status = http.StatusInternalServerError
+			admissionHandler := newAdmissionHandler(externalvalidation.Fail)
+			attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, userInfo)
+
+			err := admissionHandler.Validate(attrs, nil)
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsInternalError(err)).To(BeTrue())
+		})
+
+		It("should admit the request if the webhook fails and the failure policy is 'Ignore'", func() {
+			status = http.StatusInternalServerError
+			admissionHandler := newAdmissionHandler(externalvalidation.Ignore)
+			attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, userInfo)
+
+			Expect(admissionHandler.Validate(attrs, nil)).To(Succeed())
+		})
+
+		It("should ignore other kinds than Shoots", func() {
+			project := garden.Project{ObjectMeta: metav1.ObjectMeta{Name: "dev"}}
+			admissionHandler := newAdmissionHandler(externalvalidation.Fail)
+			attrs := admission.NewAttributesRecord(&project, nil, garden.Kind("Project").WithVersion("version"), "", project.Name, garden.Resource("projects").WithVersion("version"), "", admission.Create, false, userInfo)
+
+			Expect(admissionHandler.Validate(attrs, nil)).To(Succeed())
+			Expect(reviews).To(BeEmpty())
+		})
+	})
+})
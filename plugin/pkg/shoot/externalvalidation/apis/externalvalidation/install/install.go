@@ -0,0 +1,31 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	"github.com/gardener/gardener/plugin/pkg/shoot/externalvalidation/apis/externalvalidation"
+	externalvalidationv1alpha1 "github.com/gardener/gardener/plugin/pkg/shoot/externalvalidation/apis/externalvalidation/v1alpha1"
+)
+
+// Install registers the API group and adds types to a scheme.
+func Install(scheme *runtime.Scheme) {
+	utilruntime.Must(externalvalidation.AddToScheme(scheme))
+	utilruntime.Must(externalvalidationv1alpha1.AddToScheme(scheme))
+
+	utilruntime.Must(scheme.SetVersionPriority(externalvalidationv1alpha1.SchemeGroupVersion))
+}
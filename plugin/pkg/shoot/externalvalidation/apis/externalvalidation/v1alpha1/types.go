@@ -0,0 +1,55 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+const (
+	// Ignore means that an error calling the webhook is ignored and the request is admitted.
+	Ignore FailurePolicyType = "Ignore"
+	// Fail means that an error calling the webhook causes the request to be rejected.
+	Fail FailurePolicyType = "Fail"
+)
+
+// FailurePolicyType specifies how errors calling an external validation webhook are handled.
+type FailurePolicyType string
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Configuration provides the configuration for the ShootExternalValidation admission plugin.
+type Configuration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Webhooks is the list of external validation webhooks which are called for every created or updated Shoot.
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+// Webhook describes an external validation webhook.
+type Webhook struct {
+	// Name is the name of the webhook, it is part of the messages of rejected requests.
+	Name string `json:"name"`
+	// URL is the HTTPS endpoint to which the Shoots are sent.
+	URL string `json:"url"`
+	// CABundle is a PEM encoded CA bundle used to verify the serving certificate of the webhook. The system trust
+	// roots are used if it is empty.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+	// TimeoutSeconds is the timeout for calling the webhook (default: 10).
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+	// FailurePolicy defines how errors calling the webhook are handled (default: Fail).
+	// +optional
+	FailurePolicy *FailurePolicyType `json:"failurePolicy,omitempty"`
+}
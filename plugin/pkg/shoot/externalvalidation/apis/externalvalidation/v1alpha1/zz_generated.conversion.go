@@ -0,0 +1,107 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	unsafe "unsafe"
+
+	externalvalidation "github.com/gardener/gardener/plugin/pkg/shoot/externalvalidation/apis/externalvalidation"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*Configuration)(nil), (*externalvalidation.Configuration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Configuration_To_externalvalidation_Configuration(a.(*Configuration), b.(*externalvalidation.Configuration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*externalvalidation.Configuration)(nil), (*Configuration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_externalvalidation_Configuration_To_v1alpha1_Configuration(a.(*externalvalidation.Configuration), b.(*Configuration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Webhook)(nil), (*externalvalidation.Webhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Webhook_To_externalvalidation_Webhook(a.(*Webhook), b.(*externalvalidation.Webhook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*externalvalidation.Webhook)(nil), (*Webhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_externalvalidation_Webhook_To_v1alpha1_Webhook(a.(*externalvalidation.Webhook), b.(*Webhook), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_Configuration_To_externalvalidation_Configuration(in *Configuration, out *externalvalidation.Configuration, s conversion.Scope) error {
+	out.Webhooks = *(*[]externalvalidation.Webhook)(unsafe.Pointer(&in.Webhooks))
+	return nil
+}
+
+// Convert_v1alpha1_Configuration_To_externalvalidation_Configuration is an autogenerated conversion function.
+func Convert_v1alpha1_Configuration_To_externalvalidation_Configuration(in *Configuration, out *externalvalidation.Configuration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Configuration_To_externalvalidation_Configuration(in, out, s)
+}
+
+func autoConvert_externalvalidation_Configuration_To_v1alpha1_Configuration(in *externalvalidation.Configuration, out *Configuration, s conversion.Scope) error {
+	out.Webhooks = *(*[]Webhook)(unsafe.Pointer(&in.Webhooks))
+	return nil
+}
+
+// Convert_externalvalidation_Configuration_To_v1alpha1_Configuration is an autogenerated conversion function.
+func Convert_externalvalidation_Configuration_To_v1alpha1_Configuration(in *externalvalidation.Configuration, out *Configuration, s conversion.Scope) error {
+	return autoConvert_externalvalidation_Configuration_To_v1alpha1_Configuration(in, out, s)
+}
+
+func autoConvert_v1alpha1_Webhook_To_externalvalidation_Webhook(in *Webhook, out *externalvalidation.Webhook, s conversion.Scope) error {
+	out.Name = in.Name
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.TimeoutSeconds = (*int32)(unsafe.Pointer(in.TimeoutSeconds))
+	out.FailurePolicy = (*externalvalidation.FailurePolicyType)(unsafe.Pointer(in.FailurePolicy))
+	return nil
+}
+
+// Convert_v1alpha1_Webhook_To_externalvalidation_Webhook is an autogenerated conversion function.
+func Convert_v1alpha1_Webhook_To_externalvalidation_Webhook(in *Webhook, out *externalvalidation.Webhook, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Webhook_To_externalvalidation_Webhook(in, out, s)
+}
+
+func autoConvert_externalvalidation_Webhook_To_v1alpha1_Webhook(in *externalvalidation.Webhook, out *Webhook, s conversion.Scope) error {
+	out.Name = in.Name
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.TimeoutSeconds = (*int32)(unsafe.Pointer(in.TimeoutSeconds))
+	out.FailurePolicy = (*FailurePolicyType)(unsafe.Pointer(in.FailurePolicy))
+	return nil
+}
+
+// Convert_externalvalidation_Webhook_To_v1alpha1_Webhook is an autogenerated conversion function.
+func Convert_externalvalidation_Webhook_To_v1alpha1_Webhook(in *externalvalidation.Webhook, out *Webhook, s conversion.Scope) error {
+	return autoConvert_externalvalidation_Webhook_To_v1alpha1_Webhook(in, out, s)
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package externalvalidation
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+)
+
+// ShootReview is sent to the external validation webhooks as body of a POST request. The webhooks answer with a
+// ShootReview whose response is set.
+type ShootReview struct {
+	// Request contains the Shoot to validate.
+	Request *ShootReviewRequest `json:"request,omitempty"`
+	// Response contains the verdict of the webhook.
+	Response *ShootReviewResponse `json:"response,omitempty"`
+}
+
+// ShootReviewRequest contains the Shoot to validate.
+type ShootReviewRequest struct {
+	// Operation is the operation performed on the Shoot, i.e. CREATE or UPDATE.
+	Operation string `json:"operation"`
+	// UserInfo is information about the requesting user.
+	UserInfo authenticationv1.UserInfo `json:"userInfo"`
+	// Shoot is the Shoot to validate.
+	Shoot *gardenv1beta1.Shoot `json:"shoot"`
+	// OldShoot is the existing Shoot. It is only set for UPDATE operations.
+	OldShoot *gardenv1beta1.Shoot `json:"oldShoot,omitempty"`
+	// DryRun indicates that the request is not persisted. Webhooks with side effects must not apply them for dry-run
+	// requests.
+	DryRun bool `json:"dryRun"`
+}
+
+// ShootReviewResponse contains the verdict of an external validation webhook.
+type ShootReviewResponse struct {
+	// Allowed indicates whether the request is admitted.
+	Allowed bool `json:"allowed"`
+	// Messages explain why the request is denied.
+	Messages []string `json:"messages,omitempty"`
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"crypto/x509"
+	"fmt"
+	"net/url"
+
+	externalvalidationapi "github.com/gardener/gardener/plugin/pkg/shoot/externalvalidation/apis/externalvalidation"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// ValidateConfiguration validates the configuration.
+func ValidateConfiguration(config *externalvalidationapi.Configuration) error {
+	names := sets.NewString()
+
+	for i, webhook := range config.Webhooks {
+		if len(webhook.Name) == 0 {
+			return fmt.Errorf("webhook %d of the external validation admission plugin has no name", i)
+		}
+		if names.Has(webhook.Name) {
+			return fmt.Errorf("webhook name %q is used more than once in the external validation admission plugin", webhook.Name)
+		}
+		names.Insert(webhook.Name)
+
+		u, err := url.Parse(webhook.URL)
+		if err != nil {
+			return fmt.Errorf("webhook %q has an invalid URL: %v", webhook.Name, err)
+		}
+		if u.Scheme != "https" || len(u.Host) == 0 {
+			return fmt.Errorf("webhook %q must have an absolute https URL, got %q", webhook.Name, webhook.URL)
+		}
+
+		if len(webhook.CABundle) > 0 && !x509.NewCertPool().AppendCertsFromPEM(webhook.CABundle) {
+			return fmt.Errorf("webhook %q has a CA bundle without any PEM encoded certificate", webhook.Name)
+		}
+		if webhook.TimeoutSeconds != nil && (*webhook.TimeoutSeconds < 1 || *webhook.TimeoutSeconds > 30) {
+			return fmt.Errorf("webhook %q has an invalid timeout of %d seconds, it must be between 1 and 30 seconds", webhook.Name, *webhook.TimeoutSeconds)
+		}
+		if webhook.FailurePolicy != nil && !isSupportedFailurePolicy(*webhook.FailurePolicy) {
+			return fmt.Errorf("webhook %q has an unknown failure policy %q, valid failure policies are: %v", webhook.Name, *webhook.FailurePolicy, externalvalidationapi.FailurePolicies)
+		}
+	}
+
+	return nil
+}
+
+func isSupportedFailurePolicy(failurePolicy externalvalidationapi.FailurePolicyType) bool {
+	for _, policy := range externalvalidationapi.FailurePolicies {
+		if policy == failurePolicy {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	externalvalidationapi "github.com/gardener/gardener/plugin/pkg/shoot/externalvalidation/apis/externalvalidation"
+)
+
+var _ = Describe("externalvalidation", func() {
+	Describe("#ValidateConfiguration", func() {
+		var configuration *externalvalidationapi.Configuration
+
+		BeforeEach(func() {
+			failurePolicy := externalvalidationapi.Ignore
+			timeoutSeconds := int32(5)
+
+			configuration = &externalvalidationapi.Configuration{
+				Webhooks: []externalvalidationapi.Webhook{
+					{
+						Name:           "naming",
+						URL:            "https://policies.example.com/naming",
+						TimeoutSeconds: &timeoutSeconds,
+						FailurePolicy:  &failurePolicy,
+					},
+				},
+			}
+		})
+
+		It("should pass for a valid configuration", func() {
+			Expect(ValidateConfiguration(configuration)).To(Succeed())
+		})
+
+		It("should pass for an empty configuration", func() {
+			Expect(ValidateConfiguration(&externalvalidationapi.Configuration{})).To(Succeed())
+		})
+
+		It("should fail for webhooks without name", func() {
+			configuration.Webhooks[0].Name = ""
+
+			Expect(ValidateConfiguration(configuration)).NotTo(Succeed())
+		})
+
+		It("should fail for duplicate webhook names", func() {
+			configuration.Webhooks = append(configuration.Webhooks, configuration.Webhooks[0])
+
+			Expect(ValidateConfiguration(configuration)).NotTo(Succeed())
+		})
+
+		It("should fail for non-https URLs", func() {
+			configuration.Webhooks[0].URL = "http://policies.example.com/naming"
+
+			Expect(ValidateConfiguration(configuration)).NotTo(Succeed())
+		})
+
+		It("should fail for invalid CA bundles", func() {
+			configuration.Webhooks[0].CABundle = []byte("foo")
+
+			Expect(ValidateConfiguration(configuration)).NotTo(Succeed())
+		})
+
+		It("should fail for invalid timeouts", func() {
+			timeoutSeconds := int32(0)
+			configuration.Webhooks[0].TimeoutSeconds = &timeoutSeconds
+
+			Expect(ValidateConfiguration(configuration)).NotTo(Succeed())
+		})
+
+		It("should fail for unknown failure policies", func() {
+			failurePolicy := externalvalidationapi.FailurePolicyType("Retry")
+			configuration.Webhooks[0].FailurePolicy = &failurePolicy
+
+			Expect(ValidateConfiguration(configuration)).NotTo(Succeed())
+		})
+	})
+})
@@ -0,0 +1,111 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package forcedeletion
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	"github.com/gardener/gardener/pkg/operation/common"
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+)
+
+const (
+	// PluginName is the name of this admission plugin.
+	PluginName = "ShootForceDeletion"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// ForceDeletion contains the authorizer and admission handler.
+type ForceDeletion struct {
+	*admission.Handler
+	authorizer authorizer.Authorizer
+}
+
+var _ = admissioninitializer.WantsAuthorizer(&ForceDeletion{})
+
+// New creates a new ForceDeletion admission plugin.
+func New() (*ForceDeletion, error) {
+	return &ForceDeletion{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}, nil
+}
+
+// SetAuthorizer gets the authorizer.
+func (f *ForceDeletion) SetAuthorizer(authorizer authorizer.Authorizer) {
+	f.authorizer = authorizer
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (f *ForceDeletion) ValidateInitialization() error {
+	if f.authorizer == nil {
+		return errors.New("missing authorizer")
+	}
+	return nil
+}
+
+// Validate rejects requests which set or change the 'shoot.garden.sapcloud.io/force-delete' or the
+// 'confirmation.garden.sapcloud.io/force-deletion' annotation of a Shoot unless the requesting user is allowed to update
+// the garden namespace. Force deletion skips the destruction of the infrastructure, hence, only operators may request it.
+func (f *ForceDeletion) Validate(a admission.Attributes, o admission.ObjectInterfaces) error {
+	// Ignore all kinds other than Shoot
+	if a.GetKind().GroupKind() != garden.Kind("Shoot") {
+		return nil
+	}
+
+	// Ignore updates to subresources
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*garden.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+	var oldAnnotations map[string]string
+	if a.GetOperation() == admission.Update {
+		oldShoot, ok := a.GetOldObject().(*garden.Shoot)
+		if !ok {
+			return apierrors.NewBadRequest("could not convert old resource into Shoot object")
+		}
+		oldAnnotations = oldShoot.Annotations
+	}
+
+	for _, annotation := range []string{common.ShootForceDelete, common.ConfirmationForceDeletion} {
+		value, ok := shoot.Annotations[annotation]
+		if !ok {
+			continue
+		}
+		if oldValue, oldOk := oldAnnotations[annotation]; oldOk && oldValue == value {
+			continue
+		}
+		if !admissionutils.IsOperator(a, f.authorizer) {
+			return admission.NewForbidden(a, fmt.Errorf("only operators are allowed to set the %s annotation", annotation))
+		}
+	}
+	return nil
+}
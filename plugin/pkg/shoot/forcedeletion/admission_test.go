@@ -0,0 +1,128 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package forcedeletion_test
+
+import (
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/forcedeletion"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+)
+
+type fakeAuthorizerType struct{}
+
+func (fakeAuthorizerType) Authorize(a authorizer.Attributes) (authorizer.Decision, string, error) {
+	if a.GetUser().GetName() == "operator" && a.GetResource() == "namespaces" && a.GetName() == common.GardenNamespace && a.GetVerb() == "update" {
+		return authorizer.DecisionAllow, "", nil
+	}
+	return authorizer.DecisionDeny, "", nil
+}
+
+var _ = Describe("forcedeletion", func() {
+	Describe("#Validate", func() {
+		var (
+			admissionHandler *ForceDeletion
+
+			oldShoot = garden.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot",
+					Namespace: "garden-dev",
+				},
+			}
+		)
+
+		BeforeEach(func() {
+			admissionHandler, _ = New()
+			admissionHandler.SetAuthorizer(fakeAuthorizerType{})
+		})
+
+		validate := func(userName string, oldAnnotations, annotations map[string]string) error {
+			old := oldShoot.DeepCopy()
+			old.Annotations = oldAnnotations
+			shoot := old.DeepCopy()
+			shoot.Annotations = annotations
+
+			attrs := admission.NewAttributesRecord(shoot, old, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, &user.DefaultInfo{Name: userName})
+			return admissionHandler.Validate(attrs, nil)
+		}
+
+		It("should allow operators to request the force deletion", func() {
+			Expect(validate("operator", nil, map[string]string{
+				common.ShootForceDelete:          "true",
+				common.ConfirmationForceDeletion: "shoot",
+			})).To(Succeed())
+		})
+
+		It("should forbid other users to request the force deletion", func() {
+			err := validate("user", nil, map[string]string{common.ShootForceDelete: "true"})
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		})
+
+		It("should forbid other users to confirm the force deletion", func() {
+			err := validate("user", map[string]string{common.ShootForceDelete: "true"}, map[string]string{
+				common.ShootForceDelete:          "true",
+				common.ConfirmationForceDeletion: "shoot",
+			})
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		})
+
+		It("should allow other users to update a Shoot whose force deletion has been requested", func() {
+			annotations := map[string]string{common.ShootForceDelete: "true", "foo": "bar"}
+
+			Expect(validate("user", map[string]string{common.ShootForceDelete: "true"}, annotations)).To(Succeed())
+		})
+
+		It("should allow other users to remove the annotations", func() {
+			Expect(validate("user", map[string]string{common.ShootForceDelete: "true"}, nil)).To(Succeed())
+		})
+
+		It("should ignore other kinds than Shoot", func() {
+			project := garden.Project{}
+			attrs := admission.NewAttributesRecord(&project, &project, garden.Kind("Project").WithVersion("version"), "", "project", garden.Resource("projects").WithVersion("version"), "", admission.Update, false, nil)
+
+			Expect(admissionHandler.Validate(attrs, nil)).To(Succeed())
+		})
+	})
+
+	Describe("#ValidateInitialization", func() {
+		It("should return an error if the authorizer is missing", func() {
+			admissionHandler, _ := New()
+
+			Expect(admissionHandler.ValidateInitialization()).To(HaveOccurred())
+		})
+	})
+
+	Describe("#New", func() {
+		It("should only handle CREATE and UPDATE operations", func() {
+			admissionHandler, err := New()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admissionHandler.Handles(admission.Create)).To(BeTrue())
+			Expect(admissionHandler.Handles(admission.Update)).To(BeTrue())
+			Expect(admissionHandler.Handles(admission.Delete)).To(BeFalse())
+		})
+	})
+})
@@ -25,6 +25,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/admission"
@@ -241,6 +242,26 @@ var _ = Describe("quotavalidator", func() {
 				Expect(err).To(HaveOccurred())
 			})
 
+			It("should pass for dry-run requests because all quotas limits are sufficient", func() {
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, true, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject dry-run requests like regular requests because the limits of at least one quota are exceeded", func() {
+				shoot.Spec.Cloud.GCP.Workers[0].AutoScalerMax = 2
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				dryRunAttrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, true, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+				dryRunErr := admissionHandler.Admit(dryRunAttrs, nil)
+
+				Expect(apierrors.IsForbidden(dryRunErr)).To(BeTrue())
+				Expect(dryRunErr.Error()).To(ContainSubstring("Quota limits exceeded"))
+				Expect(dryRunErr).To(Equal(err))
+			})
+
 			It("should fail because other shoots exhaust quota limits", func() {
 				shoot2 := *shoot.DeepCopy()
 				shoot2.Name = "test-shoot-2"
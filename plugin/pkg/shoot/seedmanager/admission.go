@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
@@ -28,13 +29,17 @@ import (
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
 	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/plugin/pkg/shoot/seedmanager/validation"
 	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
 
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
 
 	"github.com/gardener/gardener/plugin/pkg/shoot/seedmanager/apis/seedmanager"
 )
@@ -65,6 +70,7 @@ func Register(plugins *admission.Plugins) {
 // SeedManager contains listers and and admission handler.
 type SeedManager struct {
 	*admission.Handler
+	authorizer  authorizer.Authorizer
 	seedLister  gardenlisters.SeedLister
 	shootLister gardenlisters.ShootLister
 	readyFunc   admission.ReadyFunc
@@ -73,6 +79,7 @@ type SeedManager struct {
 
 var (
 	_ = admissioninitializer.WantsInternalGardenInformerFactory(&SeedManager{})
+	_ = admissioninitializer.WantsAuthorizer(&SeedManager{})
 
 	readyFuncs = []admission.ReadyFunc{}
 )
@@ -91,6 +98,11 @@ func (s *SeedManager) AssignReadyFunc(f admission.ReadyFunc) {
 	s.SetReadyFunc(f)
 }
 
+// SetAuthorizer gets the authorizer.
+func (s *SeedManager) SetAuthorizer(authorizer authorizer.Authorizer) {
+	s.authorizer = authorizer
+}
+
 // SetInternalGardenInformerFactory gets Lister from SharedInformerFactory.
 func (s *SeedManager) SetInternalGardenInformerFactory(f gardeninformers.SharedInformerFactory) {
 	seedInformer := f.Garden().InternalVersion().Seeds()
@@ -104,6 +116,9 @@ func (s *SeedManager) SetInternalGardenInformerFactory(f gardeninformers.SharedI
 
 // ValidateInitialization checks whether the plugin was correctly initialized.
 func (s *SeedManager) ValidateInitialization() error {
+	if s.authorizer == nil {
+		return errors.New("missing authorizer")
+	}
 	if s.seedLister == nil {
 		return errors.New("missing seed lister")
 	}
@@ -141,6 +156,21 @@ func (s *SeedManager) Admit(a admission.Attributes, o admission.ObjectInterfaces
 		return apierrors.NewBadRequest("could not convert resource into Shoot object")
 	}
 
+	// If the Shoot is used as a Seed then an upgrade of its Kubernetes version must remain compatible with the Kubernetes
+	// versions of the Shoots it hosts.
+	var (
+		oldShoot                   *garden.Shoot
+		ignoreVersionCompatibility = s.ignoreVersionCompatibility(a, shoot)
+	)
+	if a.GetOperation() == admission.Update {
+		if oldShoot, ok = a.GetOldObject().(*garden.Shoot); !ok {
+			return apierrors.NewBadRequest("could not convert old resource into Shoot object")
+		}
+		if err := s.validateShootedSeedVersionCompatibility(shoot, oldShoot, ignoreVersionCompatibility); err != nil {
+			return admission.NewForbidden(a, err)
+		}
+	}
+
 	// If the Shoot manifest already specifies a desired Seed cluster, then we check whether it is protected or not.
 	// In case it is protected then we only allow Shoot resources to reference it which are part of the Garden namespace.
 	// Also, we don't allow shoot to be created on the seed which is already marked to be deleted.
@@ -158,10 +188,20 @@ func (s *SeedManager) Admit(a admission.Attributes, o admission.ObjectInterfaces
 			return admission.NewForbidden(a, errors.New("forbidden to use a seed marked to be deleted"))
 		}
 
+		if a.GetOperation() == admission.Create && !verifySeedSupportsShootDNS(seed, shoot) {
+			return admission.NewForbidden(a, errors.New("forbidden to use a seed with disabled shoot DNS for a shoot with managed DNS"))
+		}
+
 		if hasDisjointedNetworks, allErrs := validateDisjointedNetworks(seed, shoot); !hasDisjointedNetworks {
 			return admission.NewForbidden(a, allErrs.ToAggregate())
 		}
 
+		if mustCheckVersionCompatibility(shoot, oldShoot, ignoreVersionCompatibility) {
+			if err := verifySeedVersionCompatibility(seed, shoot); err != nil {
+				return admission.NewForbidden(a, err)
+			}
+		}
+
 		return nil
 	}
 
@@ -218,6 +258,32 @@ func determineSeed(shoot *garden.Shoot, seedLister gardenlisters.SeedLister, sho
 		return nil, errors.New("no adequate seed cluster found with disjoint network")
 	}
 
+	old = candidates
+	candidates = nil
+
+	for _, seed := range old {
+		if err := verifySeedVersionCompatibility(seed, shoot); err == nil {
+			candidates = append(candidates, seed)
+		}
+	}
+
+	if candidates == nil {
+		return nil, errors.New("no adequate seed cluster found with a compatible kubernetes version")
+	}
+
+	old = candidates
+	candidates = nil
+
+	for _, seed := range old {
+		if verifySeedProviderHealth(seed) {
+			candidates = append(candidates, seed)
+		}
+	}
+
+	if candidates == nil {
+		return nil, errors.New("no adequate seed cluster found with a healthy cloud provider account")
+	}
+
 	var (
 		bestCandidate *garden.Seed
 		min           *int
@@ -237,7 +303,7 @@ func determineSeed(shoot *garden.Shoot, seedLister gardenlisters.SeedLister, sho
 func determineCandidatesWithSameRegionStrategy(seedList []*garden.Seed, shoot *garden.Shoot, candidates []*garden.Seed) []*garden.Seed {
 	// Determine all candidate seed clusters matching the shoot's cloud and region.
 	for _, seed := range seedList {
-		if seed.DeletionTimestamp == nil && seed.Spec.Cloud.Profile == shoot.Spec.Cloud.Profile && seed.Spec.Cloud.Region == shoot.Spec.Cloud.Region && seed.Spec.Visible != nil && *seed.Spec.Visible && verifySeedAvailability(seed) {
+		if seed.DeletionTimestamp == nil && seed.Spec.Cloud.Profile == shoot.Spec.Cloud.Profile && seed.Spec.Cloud.Region == shoot.Spec.Cloud.Region && gardenhelper.IsSeedVisible(seed) && verifySeedSupportsShootDNS(seed, shoot) && verifySeedAvailability(seed) {
 			candidates = append(candidates, seed)
 		}
 	}
@@ -256,7 +322,7 @@ func determineCandidatesWithMinimalDistanceStrategy(seeds []*garden.Seed, shoot
 
 	// Determine all candidate seed clusters with matching cloud provider but different region that are lexicographically closest to the shoot
 	for _, seed := range seeds {
-		if seed.DeletionTimestamp == nil && seed.Spec.Cloud.Profile == shoot.Spec.Cloud.Profile && seed.Spec.Visible != nil && *seed.Spec.Visible && verifySeedAvailability(seed) {
+		if seed.DeletionTimestamp == nil && seed.Spec.Cloud.Profile == shoot.Spec.Cloud.Profile && gardenhelper.IsSeedVisible(seed) && verifySeedSupportsShootDNS(seed, shoot) && verifySeedAvailability(seed) {
 			seedRegion := seed.Spec.Cloud.Region
 
 			for currentMaxMatchingCharacters < len(shootRegion) {
@@ -293,9 +359,89 @@ func verifySeedAvailability(seed *garden.Seed) bool {
 	return false
 }
 
+// verifySeedProviderHealth returns false if the cloud API of the given Seed's cloud provider account is unreachable or
+// if its quotas are nearly exhausted. Seeds whose cloud provider accounts are not checked are considered healthy.
+func verifySeedProviderHealth(seed *garden.Seed) bool {
+	if cond := gardencorehelper.GetCondition(seed.Status.Conditions, garden.SeedProviderHealthy); cond != nil {
+		return cond.Status != gardencore.ConditionFalse
+	}
+	return true
+}
+
+// verifySeedSupportsShootDNS returns false if the given Shoot requires its DNS records to be managed but the shoot DNS
+// is disabled for the given Seed.
+func verifySeedSupportsShootDNS(seed *garden.Seed, shoot *garden.Shoot) bool {
+	return gardenhelper.IsSeedShootDNSEnabled(seed) || gardenhelper.ShootUsesUnmanagedDNS(shoot)
+}
+
 func validateDisjointedNetworks(seed *garden.Seed, shoot *garden.Shoot) (bool, field.ErrorList) {
 	// error cannot occur due to our static validation
 	k8sNetworks, _ := gardenhelper.GetK8SNetworks(shoot)
 	allErrs := admissionutils.ValidateNetworkDisjointedness(seed.Spec.Networks, k8sNetworks, field.NewPath(""))
 	return len(allErrs) == 0, allErrs
 }
+
+// validateShootedSeedVersionCompatibility checks whether the Kubernetes version update of the given <shoot> remains
+// compatible with the Kubernetes versions of the Shoots hosted by it if it is registered as Seed.
+func (s *SeedManager) validateShootedSeedVersionCompatibility(shoot, oldShoot *garden.Shoot, ignoreVersionCompatibility bool) error {
+	if shoot.Spec.Kubernetes.Version == oldShoot.Spec.Kubernetes.Version || len(shoot.Spec.Kubernetes.Version) == 0 || ignoreVersionCompatibility {
+		return nil
+	}
+
+	seed, err := s.seedLister.Get(shoot.Name)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(seed, shoot) {
+		return nil
+	}
+
+	shootList, err := s.shootLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	var incompatibleShoots []string
+	for _, hostedShoot := range shootList {
+		if hostedShoot.Spec.Cloud.Seed == nil || *hostedShoot.Spec.Cloud.Seed != seed.Name || len(hostedShoot.Spec.Kubernetes.Version) == 0 {
+			continue
+		}
+		if err := utils.CheckSeedShootVersionCompatibility(shoot.Spec.Kubernetes.Version, hostedShoot.Spec.Kubernetes.Version); err != nil {
+			incompatibleShoots = append(incompatibleShoots, fmt.Sprintf("%s/%s (%s)", hostedShoot.Namespace, hostedShoot.Name, hostedShoot.Spec.Kubernetes.Version))
+		}
+	}
+	if len(incompatibleShoots) > 0 {
+		sort.Strings(incompatibleShoots)
+		return fmt.Errorf("kubernetes version %s of the seed is not compatible with the kubernetes versions of the following hosted shoots: %s", shoot.Spec.Kubernetes.Version, strings.Join(incompatibleShoots, ", "))
+	}
+	return nil
+}
+
+// mustCheckVersionCompatibility returns true if the Shoot is created or if its Kubernetes version or Seed changes.
+func mustCheckVersionCompatibility(shoot, oldShoot *garden.Shoot, ignoreVersionCompatibility bool) bool {
+	if ignoreVersionCompatibility {
+		return false
+	}
+	if oldShoot == nil {
+		return true
+	}
+	return shoot.Spec.Kubernetes.Version != oldShoot.Spec.Kubernetes.Version || !apiequality.Semantic.DeepEqual(shoot.Spec.Cloud.Seed, oldShoot.Spec.Cloud.Seed)
+}
+
+// ignoreVersionCompatibility returns true if the Shoot is annotated to bypass the Kubernetes version checks and if the
+// requesting user is an operator. The annotation is not honoured for requests of other users.
+func (s *SeedManager) ignoreVersionCompatibility(a admission.Attributes, shoot *garden.Shoot) bool {
+	return shoot.Annotations[common.ShootIgnoreVersionSkew] == "true" && admissionutils.IsOperator(a, s.authorizer)
+}
+
+// verifySeedVersionCompatibility returns an error if the Kubernetes version of the given Shoot is not compatible with
+// the Kubernetes version of the given Seed. Seeds which have not yet reported their version are considered compatible.
+func verifySeedVersionCompatibility(seed *garden.Seed, shoot *garden.Shoot) error {
+	if len(seed.Status.KubernetesVersion) == 0 || len(shoot.Spec.Kubernetes.Version) == 0 {
+		return nil
+	}
+	return utils.CheckSeedShootVersionCompatibility(seed.Status.KubernetesVersion, shoot.Spec.Kubernetes.Version)
+}
@@ -18,17 +18,29 @@ import (
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/apis/garden"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	"github.com/gardener/gardener/pkg/operation/common"
 	. "github.com/gardener/gardener/plugin/pkg/shoot/seedmanager"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
 
 	seedmanagerapi "github.com/gardener/gardener/plugin/pkg/shoot/seedmanager/apis/seedmanager"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
 
+type fakeAuthorizerType struct{}
+
+func (fakeAuthorizerType) Authorize(a authorizer.Attributes) (authorizer.Decision, string, error) {
+	if a.GetUser() != nil && a.GetUser().GetName() == "operator" && a.GetResource() == "namespaces" && a.GetName() == common.GardenNamespace && a.GetVerb() == "update" {
+		return authorizer.DecisionAllow, "", nil
+	}
+	return authorizer.DecisionDeny, "", nil
+}
+
 var _ = Describe("seedmanager", func() {
 	Describe("#Admit", func() {
 		var (
@@ -107,6 +119,7 @@ var _ = Describe("seedmanager", func() {
 				admissionHandler.AssignReadyFunc(func() bool { return true })
 				gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
 				admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+				admissionHandler.SetAuthorizer(fakeAuthorizerType{})
 
 				seed = *seedBase.DeepCopy()
 				shoot = *shootBase.DeepCopy()
@@ -181,6 +194,134 @@ var _ = Describe("seedmanager", func() {
 				Expect(err).To(HaveOccurred())
 				Expect(apierrors.IsForbidden(err)).To(BeTrue())
 			})
+
+			It("should fail because the kubernetes version of the shoot is not compatible with the seed", func() {
+				seed.Status.KubernetesVersion = "1.12.5"
+				shoot.Spec.Kubernetes.Version = "1.15.0"
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should pass because the kubernetes version of the shoot does not change", func() {
+				seed.Status.KubernetesVersion = "1.12.5"
+				shoot.Spec.Kubernetes.Version = "1.15.0"
+				oldShoot := shoot.DeepCopy()
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should pass because the version compatibility check is ignored by an operator", func() {
+				seed.Status.KubernetesVersion = "1.12.5"
+				shoot.Spec.Kubernetes.Version = "1.15.0"
+				shoot.Annotations = map[string]string{common.ShootIgnoreVersionSkew: "true"}
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, &user.DefaultInfo{Name: "operator"})
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should fail because the version compatibility check cannot be ignored by other users", func() {
+				seed.Status.KubernetesVersion = "1.12.5"
+				shoot.Spec.Kubernetes.Version = "1.15.0"
+				shoot.Annotations = map[string]string{common.ShootIgnoreVersionSkew: "true"}
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, &user.DefaultInfo{Name: "user"})
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+		})
+
+		Context("Shoot is used as Seed - version compatibility with hosted shoots", func() {
+			var (
+				shootedSeed  garden.Shoot
+				hostedShoot  garden.Shoot
+				oldShootSeed *garden.Shoot
+			)
+
+			BeforeEach(func() {
+				admissionHandler, _ = New(&defaultAdmissionConfiguration)
+				admissionHandler.AssignReadyFunc(func() bool { return true })
+				gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
+				admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+				admissionHandler.SetAuthorizer(fakeAuthorizerType{})
+
+				shootedSeed = *shootBase.DeepCopy()
+				shootedSeed.Name = seedName
+				shootedSeed.Namespace = "garden"
+				shootedSeed.UID = "1234"
+				shootedSeed.Spec.Cloud.Seed = makeStrPtr("other-seed")
+				shootedSeed.Spec.Kubernetes.Version = "1.14.0"
+				oldShootSeed = shootedSeed.DeepCopy()
+
+				seed = *seedBase.DeepCopy()
+				seed.OwnerReferences = []metav1.OwnerReference{
+					*metav1.NewControllerRef(&shootedSeed, garden.Kind("Shoot").WithVersion("version")),
+				}
+
+				otherSeed := *seedBase.DeepCopy()
+				otherSeed.Name = "other-seed"
+
+				hostedShoot = *shootBase.DeepCopy()
+				hostedShoot.Spec.Cloud.Seed = &seedName
+				hostedShoot.Spec.Kubernetes.Version = "1.10.0"
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&otherSeed)
+				gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(&hostedShoot)
+			})
+
+			It("should pass because the upgrade remains compatible with the hosted shoots", func() {
+				hostedShoot.Spec.Kubernetes.Version = "1.11.0"
+				gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Update(&hostedShoot)
+				shootedSeed.Spec.Kubernetes.Version = "1.15.0"
+
+				attrs := admission.NewAttributesRecord(&shootedSeed, oldShootSeed, garden.Kind("Shoot").WithVersion("version"), shootedSeed.Namespace, shootedSeed.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should fail because the upgrade is not compatible with the hosted shoots", func() {
+				shootedSeed.Spec.Kubernetes.Version = "1.15.0"
+
+				attrs := admission.NewAttributesRecord(&shootedSeed, oldShootSeed, garden.Kind("Shoot").WithVersion("version"), shootedSeed.Namespace, shootedSeed.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should pass because the seed is not controlled by the shoot", func() {
+				seed.OwnerReferences = nil
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Update(&seed)
+				shootedSeed.Spec.Kubernetes.Version = "1.15.0"
+
+				attrs := admission.NewAttributesRecord(&shootedSeed, oldShootSeed, garden.Kind("Shoot").WithVersion("version"), shootedSeed.Namespace, shootedSeed.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
 		})
 
 		Context("Shoot does not reference a Seed - find an adequate one using 'Same Region' seed determination strategy", func() {
@@ -192,6 +333,7 @@ var _ = Describe("seedmanager", func() {
 				admissionHandler.AssignReadyFunc(func() bool { return true })
 				gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
 				admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+				admissionHandler.SetAuthorizer(fakeAuthorizerType{})
 
 				seed = *seedBase.DeepCopy()
 				shoot = *shootBase.DeepCopy()
@@ -254,6 +396,7 @@ var _ = Describe("seedmanager", func() {
 				admissionHandler.AssignReadyFunc(func() bool { return true })
 				gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
 				admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+				admissionHandler.SetAuthorizer(fakeAuthorizerType{})
 
 				seed = *seedBase.DeepCopy()
 				shoot = *shootBase.DeepCopy()
@@ -345,6 +488,7 @@ var _ = Describe("seedmanager", func() {
 				admissionHandler.AssignReadyFunc(func() bool { return true })
 				gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
 				admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+				admissionHandler.SetAuthorizer(fakeAuthorizerType{})
 
 				seed = *seedBase.DeepCopy()
 				shoot = *shootBase.DeepCopy()
@@ -362,6 +506,29 @@ var _ = Describe("seedmanager", func() {
 				Expect(*shoot.Spec.Cloud.Seed).To(Equal(seedName))
 			})
 
+			It("should determine the same seed cluster for dry-run requests as for regular requests", func() {
+				secondSeed := seedBase
+				secondSeed.Name = "seed-2"
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&secondSeed)
+
+				secondShoot := shootBase
+				secondShoot.Name = "shoot-2"
+				secondShoot.Spec.Cloud.Seed = &seed.Name
+				gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(&secondShoot)
+
+				dryRunShoot := shoot.DeepCopy()
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				dryRunAttrs := admission.NewAttributesRecord(dryRunShoot, nil, garden.Kind("Shoot").WithVersion("version"), dryRunShoot.Namespace, dryRunShoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, true, nil)
+
+				Expect(admissionHandler.Admit(dryRunAttrs, nil)).To(Succeed())
+				Expect(admissionHandler.Admit(attrs, nil)).To(Succeed())
+
+				Expect(*dryRunShoot.Spec.Cloud.Seed).To(Equal(secondSeed.Name))
+				Expect(*dryRunShoot).To(Equal(shoot))
+			})
+
 			It("should find the best seed cluster 1) referencing the same profile 2) same  region 3) indicating availability", func() {
 				secondSeed := seedBase
 				secondSeed.Name = "seed-2"
@@ -456,10 +623,133 @@ var _ = Describe("seedmanager", func() {
 				Expect(apierrors.IsForbidden(err)).To(BeTrue())
 				Expect(shoot.Spec.Cloud.Seed).To(BeNil())
 			})
+
+			It("should fail because it cannot find a seed cluster due to the scheduling setting", func() {
+				seed.Spec.Settings = &garden.SeedSettings{
+					Scheduling: &garden.SeedSettingScheduling{Visible: false},
+				}
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(shoot.Spec.Cloud.Seed).To(BeNil())
+			})
+
+			It("should fail because it cannot find a seed cluster managing the shoot DNS", func() {
+				seed.Spec.Settings = &garden.SeedSettings{
+					ShootDNS: &garden.SeedSettingShootDNS{Enabled: false},
+				}
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(shoot.Spec.Cloud.Seed).To(BeNil())
+			})
+
+			It("should fail because it cannot find a seed cluster with a compatible kubernetes version", func() {
+				seed.Status.KubernetesVersion = "1.15.0"
+				shoot.Spec.Kubernetes.Version = "1.10.0"
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should fail because it cannot find a seed cluster with a healthy cloud provider account", func() {
+				seed.Status.Conditions = append(seed.Status.Conditions, gardencore.Condition{
+					Type:   garden.SeedProviderHealthy,
+					Status: gardencore.ConditionFalse,
+				})
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(shoot.Spec.Cloud.Seed).To(BeNil())
+			})
+
+			It("should not choose a seed with an unhealthy cloud provider account even if it hosts fewer shoots", func() {
+				secondSeed := *seedBase.DeepCopy()
+				secondSeed.Name = "seed-2"
+				secondSeed.Status.Conditions = append(secondSeed.Status.Conditions, gardencore.Condition{
+					Type:   garden.SeedProviderHealthy,
+					Status: gardencore.ConditionFalse,
+				})
+				seed.Status.Conditions = append(seed.Status.Conditions, gardencore.Condition{
+					Type:   garden.SeedProviderHealthy,
+					Status: gardencore.ConditionTrue,
+				})
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&secondSeed)
+
+				secondShoot := shootBase
+				secondShoot.Name = "shoot-2"
+				secondShoot.Spec.Cloud.Seed = &seed.Name
+
+				gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(&secondShoot)
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*shoot.Spec.Cloud.Seed).To(Equal(seed.Name))
+			})
+
+			It("should pass because the cloud provider account of the seed has not been checked", func() {
+				seed.Status.Conditions = append(seed.Status.Conditions, gardencore.Condition{
+					Type:   garden.SeedProviderHealthy,
+					Status: gardencore.ConditionUnknown,
+				})
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*shoot.Spec.Cloud.Seed).To(Equal(seedName))
+			})
+
+			It("should pass because the shoot with unmanaged DNS can use a seed with disabled shoot DNS", func() {
+				unmanaged := garden.DNSUnmanaged
+				shoot.Spec.DNS.Provider = &unmanaged
+				seed.Spec.Settings = &garden.SeedSettings{
+					ShootDNS: &garden.SeedSettingShootDNS{Enabled: false},
+				}
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*shoot.Spec.Cloud.Seed).To(Equal(seedName))
+			})
 		})
 	})
 })
 
+func makeStrPtr(s string) *string {
+	return &s
+}
+
 func makeCIDRPtr(cidr string) *gardencore.CIDR {
 	c := gardencore.CIDR(cidr)
 	return &c
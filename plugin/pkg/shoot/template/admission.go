@@ -0,0 +1,296 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gardener/gardener/pkg/api"
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/apis/garden/helper"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+const (
+	// PluginName is the name of this admission plugin.
+	PluginName = "ShootTemplate"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// Template contains listers and and admission handler.
+type Template struct {
+	*admission.Handler
+	shootTemplateLister gardenlisters.ShootTemplateLister
+	readyFunc           admission.ReadyFunc
+}
+
+var (
+	_ = admissioninitializer.WantsInternalGardenInformerFactory(&Template{})
+
+	readyFuncs = []admission.ReadyFunc{}
+)
+
+// New creates a new Template admission plugin.
+func New() (*Template, error) {
+	return &Template{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (t *Template) AssignReadyFunc(f admission.ReadyFunc) {
+	t.readyFunc = f
+	t.SetReadyFunc(f)
+}
+
+// SetInternalGardenInformerFactory gets Lister from SharedInformerFactory.
+func (t *Template) SetInternalGardenInformerFactory(f gardeninformers.SharedInformerFactory) {
+	shootTemplateInformer := f.Garden().InternalVersion().ShootTemplates()
+	t.shootTemplateLister = shootTemplateInformer.Lister()
+
+	readyFuncs = append(readyFuncs, shootTemplateInformer.Informer().HasSynced)
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (t *Template) ValidateInitialization() error {
+	if t.shootTemplateLister == nil {
+		return errors.New("missing shoot template lister")
+	}
+	return nil
+}
+
+// Admit expands the ShootTemplate referenced by a Shoot on creation, i.e., it applies all settings of the template
+// which are not specified by the Shoot itself.
+func (t *Template) Admit(a admission.Attributes, o admission.ObjectInterfaces) error {
+	// Wait until the caches have been synced
+	if t.readyFunc == nil {
+		t.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	if !t.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	// Ignore all kinds other than Shoot
+	if a.GetKind().GroupKind() != garden.Kind("Shoot") {
+		return nil
+	}
+
+	// Ignore updates to subresources
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*garden.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+	if shoot.Spec.Template == nil {
+		return nil
+	}
+
+	networks := k8sNetworks(&shoot.Spec.Cloud)
+
+	// The template is only expanded when the Shoot is created. Networks which have not been defaulted because of the
+	// template reference are taken from the existing Shoot.
+	if a.GetOperation() == admission.Update {
+		oldShoot, ok := a.GetOldObject().(*garden.Shoot)
+		if !ok {
+			return apierrors.NewBadRequest("could not convert old resource into Shoot object")
+		}
+		if oldNetworks := k8sNetworks(&oldShoot.Spec.Cloud); networks != nil && oldNetworks != nil {
+			mergeK8SNetworks(networks, oldNetworks)
+		}
+		return nil
+	}
+
+	namespace := shoot.Spec.Template.Namespace
+	if len(namespace) == 0 {
+		namespace = shoot.Namespace
+	}
+	if namespace != shoot.Namespace && namespace != common.GardenNamespace {
+		return admission.NewForbidden(a, fmt.Errorf("shoot templates can only be referenced from the namespace of the shoot or from the %q namespace", common.GardenNamespace))
+	}
+
+	shootTemplate, err := t.shootTemplateLister.ShootTemplates(namespace).Get(shoot.Spec.Template.Name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return admission.NewForbidden(a, fmt.Errorf("shoot template %s/%s not found", namespace, shoot.Spec.Template.Name))
+		}
+		return apierrors.NewInternalError(err)
+	}
+
+	if err := expandShootTemplate(shoot, shootTemplate.Spec); err != nil {
+		return admission.NewForbidden(a, err)
+	}
+	return nil
+}
+
+// expandShootTemplate applies all settings of the given ShootTemplateSpec to the Shoot which are not specified by
+// the Shoot itself. Kubernetes networks which are neither specified by the Shoot nor by the template get the
+// usual defaults.
+func expandShootTemplate(shoot *garden.Shoot, spec garden.ShootTemplateSpec) error {
+	if shoot.Spec.Addons == nil && spec.Addons != nil {
+		shoot.Spec.Addons = spec.Addons.DeepCopy()
+	}
+
+	if len(spec.Workers) > 0 {
+		if err := setDefaultWorkers(&shoot.Spec.Cloud, spec.Workers); err != nil {
+			return err
+		}
+	}
+
+	networks := k8sNetworks(&shoot.Spec.Cloud)
+	if networks == nil {
+		return nil
+	}
+	if spec.Networks != nil {
+		mergeK8SNetworks(networks, spec.Networks)
+	}
+
+	defaultNetworks, err := defaultK8SNetworks(shoot)
+	if err != nil {
+		return err
+	}
+	mergeK8SNetworks(networks, &defaultNetworks)
+	return nil
+}
+
+// setDefaultWorkers sets the worker pools of the given template if the Shoot does not specify any.
+func setDefaultWorkers(cloud *garden.Cloud, templateWorkers []garden.ShootTemplateWorker) error {
+	provider, err := helper.DetermineCloudProviderInShoot(*cloud)
+	if err != nil {
+		return err
+	}
+
+	switch provider {
+	case garden.CloudProviderAWS:
+		if len(cloud.AWS.Workers) == 0 {
+			for _, worker := range templateWorkers {
+				cloud.AWS.Workers = append(cloud.AWS.Workers, garden.AWSWorker{Worker: *worker.Worker.DeepCopy(), VolumeType: worker.VolumeType, VolumeSize: worker.VolumeSize})
+			}
+		}
+	case garden.CloudProviderAzure:
+		if len(cloud.Azure.Workers) == 0 {
+			for _, worker := range templateWorkers {
+				cloud.Azure.Workers = append(cloud.Azure.Workers, garden.AzureWorker{Worker: *worker.Worker.DeepCopy(), VolumeType: worker.VolumeType, VolumeSize: worker.VolumeSize})
+			}
+		}
+	case garden.CloudProviderGCP:
+		if len(cloud.GCP.Workers) == 0 {
+			for _, worker := range templateWorkers {
+				cloud.GCP.Workers = append(cloud.GCP.Workers, garden.GCPWorker{Worker: *worker.Worker.DeepCopy(), VolumeType: worker.VolumeType, VolumeSize: worker.VolumeSize})
+			}
+		}
+	case garden.CloudProviderOpenStack:
+		if len(cloud.OpenStack.Workers) == 0 {
+			for _, worker := range templateWorkers {
+				cloud.OpenStack.Workers = append(cloud.OpenStack.Workers, garden.OpenStackWorker{Worker: *worker.Worker.DeepCopy()})
+			}
+		}
+	case garden.CloudProviderAlicloud:
+		if len(cloud.Alicloud.Workers) == 0 {
+			for _, worker := range templateWorkers {
+				cloud.Alicloud.Workers = append(cloud.Alicloud.Workers, garden.AlicloudWorker{Worker: *worker.Worker.DeepCopy(), VolumeType: worker.VolumeType, VolumeSize: worker.VolumeSize})
+			}
+		}
+	case garden.CloudProviderPacket:
+		if len(cloud.Packet.Workers) == 0 {
+			for _, worker := range templateWorkers {
+				cloud.Packet.Workers = append(cloud.Packet.Workers, garden.PacketWorker{Worker: *worker.Worker.DeepCopy(), VolumeType: worker.VolumeType, VolumeSize: worker.VolumeSize})
+			}
+		}
+	}
+	return nil
+}
+
+// defaultK8SNetworks returns the Kubernetes networks the given Shoot would have been defaulted to if it did not
+// reference a ShootTemplate.
+func defaultK8SNetworks(shoot *garden.Shoot) (gardencore.K8SNetworks, error) {
+	var (
+		shootCopy     = shoot.DeepCopy()
+		versionedCopy = &gardenv1beta1.Shoot{}
+	)
+	shootCopy.Spec.Template = nil
+
+	if err := api.Scheme.Convert(shootCopy, versionedCopy, nil); err != nil {
+		return gardencore.K8SNetworks{}, err
+	}
+	api.Scheme.Default(versionedCopy)
+	if err := api.Scheme.Convert(versionedCopy, shootCopy, nil); err != nil {
+		return gardencore.K8SNetworks{}, err
+	}
+
+	return helper.GetK8SNetworks(shootCopy)
+}
+
+// k8sNetworks returns a pointer to the Kubernetes networks of the cloud provider of the given Cloud.
+func k8sNetworks(cloud *garden.Cloud) *gardencore.K8SNetworks {
+	switch {
+	case cloud.AWS != nil:
+		return &cloud.AWS.Networks.K8SNetworks
+	case cloud.Azure != nil:
+		return &cloud.Azure.Networks.K8SNetworks
+	case cloud.GCP != nil:
+		return &cloud.GCP.Networks.K8SNetworks
+	case cloud.OpenStack != nil:
+		return &cloud.OpenStack.Networks.K8SNetworks
+	case cloud.Alicloud != nil:
+		return &cloud.Alicloud.Networks.K8SNetworks
+	case cloud.Packet != nil:
+		return &cloud.Packet.Networks.K8SNetworks
+	case cloud.Local != nil:
+		return &cloud.Local.Networks.K8SNetworks
+	}
+	return nil
+}
+
+// mergeK8SNetworks sets all networks of <networks> which are not specified to the ones of <defaults>.
+func mergeK8SNetworks(networks, defaults *gardencore.K8SNetworks) {
+	if networks.Nodes == nil && defaults.Nodes != nil {
+		nodes := *defaults.Nodes
+		networks.Nodes = &nodes
+	}
+	if networks.Pods == nil && defaults.Pods != nil {
+		pods := *defaults.Pods
+		networks.Pods = &pods
+	}
+	if networks.Services == nil && defaults.Services != nil {
+		services := *defaults.Services
+		networks.Services = &services
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template_test
+
+import (
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/template"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+var _ = Describe("template", func() {
+	Describe("#Admit", func() {
+		var (
+			admissionHandler      *Template
+			gardenInformerFactory gardeninformers.SharedInformerFactory
+			shoot                 garden.Shoot
+			shootTemplate         garden.ShootTemplate
+
+			namespace        = "my-namespace"
+			templateName     = "my-template"
+			vpcCIDR          = gardencore.CIDR("10.250.0.0/16")
+			templatePodsCIDR = gardencore.CIDR("100.100.0.0/16")
+			shootPodsCIDR    = gardencore.CIDR("100.200.0.0/16")
+			servicesCIDR     = gardencore.CIDR("100.64.0.0/16")
+
+			templateWorker = garden.Worker{
+				Name:          "template-worker",
+				MachineType:   "m5.large",
+				AutoScalerMin: 1,
+				AutoScalerMax: 2,
+			}
+			shootWorker = garden.Worker{
+				Name:          "shoot-worker",
+				MachineType:   "m5.xlarge",
+				AutoScalerMin: 1,
+				AutoScalerMax: 1,
+			}
+
+			shootTemplateBase = garden.ShootTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      templateName,
+					Namespace: namespace,
+				},
+				Spec: garden.ShootTemplateSpec{
+					Addons: &garden.Addons{
+						KubernetesDashboard: &garden.KubernetesDashboard{
+							Addon: garden.Addon{Enabled: true},
+						},
+					},
+					Networks: &gardencore.K8SNetworks{
+						Pods:     &templatePodsCIDR,
+						Services: &servicesCIDR,
+					},
+					Workers: []garden.ShootTemplateWorker{
+						{
+							Worker:     templateWorker,
+							VolumeType: "gp2",
+							VolumeSize: "20Gi",
+						},
+					},
+				},
+			}
+
+			shootBase = garden.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot",
+					Namespace: namespace,
+				},
+				Spec: garden.ShootSpec{
+					Template: &garden.ShootTemplateReference{
+						Name: templateName,
+					},
+					Cloud: garden.Cloud{
+						AWS: &garden.AWSCloud{
+							Networks: garden.AWSNetworks{
+								K8SNetworks: gardencore.K8SNetworks{
+									Nodes: &vpcCIDR,
+								},
+								VPC: garden.AWSVPC{
+									CIDR: &vpcCIDR,
+								},
+							},
+						},
+					},
+				},
+			}
+		)
+
+		BeforeEach(func() {
+			admissionHandler, _ = New()
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+			gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+
+			shoot = *shootBase.DeepCopy()
+			shootTemplate = *shootTemplateBase.DeepCopy()
+		})
+
+		It("should do nothing because the shoot does not reference a template", func() {
+			shoot.Spec.Template = nil
+			shootBefore := shoot.DeepCopy()
+			attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+			err := admissionHandler.Admit(attrs, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shoot).To(Equal(*shootBefore))
+		})
+
+		It("should reject because the referenced template does not exist", func() {
+			attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+			err := admissionHandler.Admit(attrs, nil)
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		})
+
+		It("should reject because the referenced template is in a foreign namespace", func() {
+			shootTemplate.Namespace = "other-namespace"
+			shoot.Spec.Template.Namespace = shootTemplate.Namespace
+			gardenInformerFactory.Garden().InternalVersion().ShootTemplates().Informer().GetStore().Add(&shootTemplate)
+			attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+			err := admissionHandler.Admit(attrs, nil)
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		})
+
+		It("should expand the template of the shoot's namespace", func() {
+			gardenInformerFactory.Garden().InternalVersion().ShootTemplates().Informer().GetStore().Add(&shootTemplate)
+			attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+			err := admissionHandler.Admit(attrs, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shoot.Spec.Addons).To(Equal(shootTemplate.Spec.Addons))
+			Expect(shoot.Spec.Cloud.AWS.Workers).To(Equal([]garden.AWSWorker{
+				{
+					Worker:     templateWorker,
+					VolumeType: "gp2",
+					VolumeSize: "20Gi",
+				},
+			}))
+			Expect(shoot.Spec.Cloud.AWS.Networks.K8SNetworks).To(Equal(gardencore.K8SNetworks{
+				Nodes:    &vpcCIDR,
+				Pods:     &templatePodsCIDR,
+				Services: &servicesCIDR,
+			}))
+		})
+
+		It("should expand the template of the garden namespace", func() {
+			shootTemplate.Namespace = common.GardenNamespace
+			shoot.Spec.Template.Namespace = common.GardenNamespace
+			gardenInformerFactory.Garden().InternalVersion().ShootTemplates().Informer().GetStore().Add(&shootTemplate)
+			attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+			err := admissionHandler.Admit(attrs, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shoot.Spec.Cloud.AWS.Workers).To(HaveLen(1))
+			Expect(shoot.Spec.Cloud.AWS.Networks.Pods).To(Equal(&templatePodsCIDR))
+		})
+
+		It("should not overwrite settings specified by the shoot", func() {
+			shoot.Spec.Addons = &garden.Addons{}
+			shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{{Worker: shootWorker}}
+			shoot.Spec.Cloud.AWS.Networks.Pods = &shootPodsCIDR
+			gardenInformerFactory.Garden().InternalVersion().ShootTemplates().Informer().GetStore().Add(&shootTemplate)
+			attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+			err := admissionHandler.Admit(attrs, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shoot.Spec.Addons).To(Equal(&garden.Addons{}))
+			Expect(shoot.Spec.Cloud.AWS.Workers).To(Equal([]garden.AWSWorker{{Worker: shootWorker}}))
+			Expect(shoot.Spec.Cloud.AWS.Networks.Pods).To(Equal(&shootPodsCIDR))
+			Expect(shoot.Spec.Cloud.AWS.Networks.Services).To(Equal(&servicesCIDR))
+		})
+
+		It("should default the networks which are neither specified by the shoot nor by the template", func() {
+			shootTemplate.Spec.Networks = nil
+			gardenInformerFactory.Garden().InternalVersion().ShootTemplates().Informer().GetStore().Add(&shootTemplate)
+			attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+			err := admissionHandler.Admit(attrs, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(*shoot.Spec.Cloud.AWS.Networks.Pods)).To(Equal(string(gardencorev1alpha1.DefaultPodNetworkCIDR)))
+			Expect(string(*shoot.Spec.Cloud.AWS.Networks.Services)).To(Equal(string(gardencorev1alpha1.DefaultServiceNetworkCIDR)))
+		})
+
+		It("should keep the networks of the existing shoot on updates", func() {
+			oldShoot := shoot.DeepCopy()
+			oldShoot.Spec.Cloud.AWS.Networks.Pods = &templatePodsCIDR
+			oldShoot.Spec.Cloud.AWS.Networks.Services = &servicesCIDR
+			attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+
+			err := admissionHandler.Admit(attrs, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shoot.Spec.Cloud.AWS.Workers).To(BeEmpty())
+			Expect(shoot.Spec.Cloud.AWS.Networks.Pods).To(Equal(&templatePodsCIDR))
+			Expect(shoot.Spec.Cloud.AWS.Networks.Services).To(Equal(&servicesCIDR))
+		})
+	})
+
+	Describe("#ValidateInitialization", func() {
+		It("should return error if no ShootTemplateLister is set", func() {
+			admissionHandler, _ := New()
+
+			err := admissionHandler.ValidateInitialization()
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should not return error if ShootTemplateLister is set", func() {
+			admissionHandler, _ := New()
+			admissionHandler.SetInternalGardenInformerFactory(gardeninformers.NewSharedInformerFactory(nil, 0))
+
+			err := admissionHandler.ValidateInitialization()
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
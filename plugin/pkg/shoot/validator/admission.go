@@ -18,18 +18,23 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"strings"
 
 	"github.com/gardener/gardener/pkg/apis/garden"
 	"github.com/gardener/gardener/pkg/apis/garden/helper"
 	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	apiserverfeatures "github.com/gardener/gardener/pkg/apiserver/features"
 	informers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
 	listers "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
+	"github.com/gardener/gardener/pkg/features"
+	"github.com/gardener/gardener/pkg/utils"
 	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/admission"
 )
@@ -235,6 +240,7 @@ func (v *ValidateShoot) Admit(a admission.Attributes, o admission.ObjectInterfac
 			seed:         seed,
 			shoot:        shoot,
 			oldShoot:     oldShoot,
+			project:      project,
 		}
 		allErrs field.ErrorList
 	)
@@ -312,6 +318,11 @@ func (v *ValidateShoot) Admit(a admission.Attributes, o admission.ObjectInterfac
 		allErrs = validateAlicloud(validationContext)
 	}
 
+	allErrs = append(allErrs, validateCustomMachineImages(validationContext, field.NewPath("spec", "cloud", string(cloudProviderInShoot), "workers"))...)
+	if cloudProviderInShoot != garden.CloudProviderLocal {
+		allErrs = append(allErrs, validateWorkerNetworksCapacity(validationContext, cloudProviderInShoot, field.NewPath("spec", "cloud", string(cloudProviderInShoot), "networks", "workers"))...)
+	}
+
 	dnsErrors, err := validateDNSDomainUniqueness(v.shootLister, shoot.Name, shoot.Spec.DNS)
 	if err != nil {
 		return apierrors.NewInternalError(err)
@@ -330,6 +341,191 @@ type validationContext struct {
 	seed         *garden.Seed
 	shoot        *garden.Shoot
 	oldShoot     *garden.Shoot
+	project      *garden.Project
+}
+
+// validateCustomMachineImages ensures that custom machine images are only referenced by worker pools if the feature
+// gate is enabled and the project is allowed to use them by the CloudProfile. Existing references are not revalidated.
+func validateCustomMachineImages(c *validationContext, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	oldWorkers := helper.GetShootWorkers(c.oldShoot.Spec.Cloud)
+	for i, worker := range helper.GetShootWorkers(c.shoot.Spec.Cloud) {
+		if worker.CustomMachineImage == nil {
+			continue
+		}
+
+		var oldImage *string
+		for _, ow := range oldWorkers {
+			if ow.Name == worker.Name {
+				oldImage = ow.CustomMachineImage
+				break
+			}
+		}
+		if oldImage != nil && *oldImage == *worker.CustomMachineImage {
+			continue
+		}
+
+		idxPath := fldPath.Index(i).Child("customMachineImage")
+		if !apiserverfeatures.FeatureGate.Enabled(features.CustomMachineImages) {
+			allErrs = append(allErrs, field.Forbidden(idxPath, fmt.Sprintf("custom machine images require the %s feature gate", features.CustomMachineImages)))
+			continue
+		}
+		if settings := c.cloudProfile.Spec.CustomMachineImages; settings == nil || !utils.ValueExists(c.project.Name, settings.Projects) {
+			allErrs = append(allErrs, field.Forbidden(idxPath, fmt.Sprintf("project %q is not allowed to use custom machine images of cloud profile %q", c.project.Name, c.cloudProfile.Name)))
+		}
+	}
+
+	return allErrs
+}
+
+const (
+	// gcpMaxMachineNameLength is the maximum length of the names of GCP instances.
+	gcpMaxMachineNameLength = 63
+	// azureMaxMachineNameLength is the maximum length of the names of Azure Linux virtual machines.
+	azureMaxMachineNameLength = 64
+	// openStackMaxMachineNameLength is the maximum length of the host names which Nova derives from the server names.
+	openStackMaxMachineNameLength = 63
+	// alicloudMaxMachineNameLength is the maximum length of the host names of Alicloud Linux instances, which are set
+	// to the names of the machines.
+	alicloudMaxMachineNameLength = 64
+	// azureMaxWorkerNodes is the maximum number of virtual machines in an Azure availability set. All worker nodes of
+	// a Shoot on Azure are placed in the same availability set.
+	azureMaxWorkerNodes = 200
+	// machineNameSuffixLength is the number of characters which the machine-controller-manager appends to the names of
+	// the machine deployments: the hash of the machine set (up to 10 characters) and the random suffix of the machine
+	// (5 characters), each separated by a hyphen.
+	machineNameSuffixLength = 17
+)
+
+// reservedWorkerNetworkAddresses maps cloud providers to the number of addresses of every subnet which they reserve
+// for themselves. Other cloud providers are expected to reserve the network and the broadcast address.
+var reservedWorkerNetworkAddresses = map[garden.CloudProvider]int64{
+	garden.CloudProviderAWS:   5,
+	garden.CloudProviderAzure: 5,
+	garden.CloudProviderGCP:   4,
+}
+
+// validateMachineNameLength ensures that the names of the machines of a new worker pool, which consist of the given
+// <deploymentName> and the suffixes of the machine-controller-manager, do not exceed the <maxLength> of the cloud
+// provider. Existing worker pools are not revalidated.
+func validateMachineNameLength(worker, oldWorker garden.Worker, deploymentName string, maxLength int, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(oldWorker.Name) > 0 {
+		return allErrs
+	}
+	if length := len(deploymentName) + machineNameSuffixLength; length > maxLength {
+		allErrs = append(allErrs, field.Invalid(fldPath, worker.Name, fmt.Sprintf("the names of the machines of the worker pool (%s-<hash>-<suffix>) may have up to %d characters but must not exceed %d characters, use a shorter worker pool name", deploymentName, length, maxLength)))
+	}
+
+	return allErrs
+}
+
+// longestMachineVariantSuffix returns the longest suffix which is appended to the names of the machine deployments of
+// the given worker pool for its fallback machine types and spot machines.
+func longestMachineVariantSuffix(worker garden.Worker) string {
+	if worker.Capacity == nil {
+		return ""
+	}
+
+	var suffix string
+	if n := len(worker.Capacity.FallbackMachineTypes); n > 0 {
+		suffix = fmt.Sprintf("-fb%d", n)
+	}
+	if spotPercentage := worker.Capacity.SpotPercentage; spotPercentage != nil && *spotPercentage > 0 {
+		suffix += "-spot"
+	}
+	return suffix
+}
+
+// longestZoneName returns the longest of the given zone names.
+func longestZoneName(zones []string) string {
+	var longest string
+	for _, zone := range zones {
+		if len(zone) > len(longest) {
+			longest = zone
+		}
+	}
+	return longest
+}
+
+// technicalID returns the technical id of the Shoot, which prefixes the names of its machines.
+func technicalID(c *validationContext) string {
+	if len(c.shoot.Status.TechnicalID) > 0 {
+		return c.shoot.Status.TechnicalID
+	}
+	return fmt.Sprintf("shoot--%s--%s", c.project.Name, c.shoot.Name)
+}
+
+// validateWorkerNetworksCapacity ensures that the worker networks offer an address for the maximum number of nodes of
+// all worker pools. It is only checked if the Shoot is created or if the maximum number of nodes or the worker networks
+// change, so that existing Shoots are not rejected.
+func validateWorkerNetworksCapacity(c *validationContext, cloudProvider garden.CloudProvider, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var (
+		workerNetworks = helper.GetShootWorkerNetworks(c.shoot.Spec.Cloud)
+		maxNodes       = workersMaximum(c.shoot)
+	)
+	if len(workerNetworks) == 0 {
+		return allErrs
+	}
+	if maxNodes <= workersMaximum(c.oldShoot) && apiequality.Semantic.DeepEqual(workerNetworks, helper.GetShootWorkerNetworks(c.oldShoot.Spec.Cloud)) {
+		return allErrs
+	}
+
+	reserved, ok := reservedWorkerNetworkAddresses[cloudProvider]
+	if !ok {
+		reserved = 2
+	}
+
+	var capacity int64
+	for _, cidr := range workerNetworks {
+		_, network, err := net.ParseCIDR(string(cidr))
+		if err != nil {
+			// Already reported by the static validation.
+			return allErrs
+		}
+		ones, bits := network.Mask.Size()
+		if bits-ones > 32 {
+			// IPv6 networks offer enough addresses.
+			return allErrs
+		}
+		if addresses := int64(1)<<uint(bits-ones) - reserved; addresses > 0 {
+			capacity += addresses
+		}
+	}
+
+	if maxNodes > capacity {
+		allErrs = append(allErrs, field.Invalid(fldPath, workerNetworks, fmt.Sprintf("the worker networks offer only %d node addresses but the worker pools may scale up to %d nodes", capacity, maxNodes)))
+	}
+
+	return allErrs
+}
+
+// workersMaximum returns the maximum number of nodes of all worker pools of the given Shoot.
+func workersMaximum(shoot *garden.Shoot) int64 {
+	var maxNodes int64
+	for _, worker := range helper.GetShootWorkers(shoot.Spec.Cloud) {
+		maxNodes += int64(worker.AutoScalerMax)
+	}
+	return maxNodes
+}
+
+// validateWorkersMaximum ensures that the worker pools cannot scale up to more than <limit> nodes in total, which is
+// the limit of the given <resource> of the cloud provider. Like validateWorkerNetworksCapacity, it is only checked if
+// the Shoot is created or if the maximum number of nodes increases, so that existing Shoots are not rejected.
+func validateWorkersMaximum(c *validationContext, limit int64, resource string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	maxNodes := workersMaximum(c.shoot)
+	if maxNodes <= limit || maxNodes <= workersMaximum(c.oldShoot) {
+		return allErrs
+	}
+	allErrs = append(allErrs, field.Invalid(fldPath, maxNodes, fmt.Sprintf("the worker pools may scale up to %d nodes in total but an %s supports at most %d nodes", maxNodes, resource, limit)))
+
+	return allErrs
 }
 
 func validateAWS(c *validationContext) field.ErrorList {
@@ -363,9 +559,16 @@ func validateAWS(c *validationContext) field.ErrorList {
 		if ok, validMachineTypes := validateMachineTypes(c.cloudProfile.Spec.AWS.Constraints.MachineTypes, worker.MachineType, oldWorker.MachineType); !ok {
 			allErrs = append(allErrs, field.NotSupported(idxPath.Child("machineType"), worker.MachineType, validMachineTypes))
 		}
+		allErrs = append(allErrs, validateWorkerArchitecture(c.cloudProfile.Spec.AWS.Constraints.MachineTypes, worker.Worker, idxPath)...)
+		if arch := helper.WorkerArchitecture(worker.Worker); arch != garden.ArchitectureAMD64 && worker.CustomMachineImage == nil {
+			if !awsMachineImageExistsForArchitecture(c.cloudProfile.Spec.AWS.Constraints.MachineImages, c.shoot.Spec.Cloud.AWS.MachineImage.Name, c.shoot.Spec.Cloud.Region, arch) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("architecture"), arch, fmt.Sprintf("machine image %s is not available for this architecture in region %s", c.shoot.Spec.Cloud.AWS.MachineImage.Name, c.shoot.Spec.Cloud.Region)))
+			}
+		}
 		if ok, validVolumeTypes := validateVolumeTypes(c.cloudProfile.Spec.AWS.Constraints.VolumeTypes, worker.VolumeType, oldWorker.VolumeType); !ok {
 			allErrs = append(allErrs, field.NotSupported(idxPath.Child("volumeType"), worker.VolumeType, validVolumeTypes))
 		}
+		allErrs = append(allErrs, validateWorkerAvailabilityInZones(c.cloudProfile.Spec.AWS.Constraints.Zones, c.shoot.Spec.Cloud.Region, worker.Worker, oldWorker.Worker, worker.VolumeType, oldWorker.VolumeType, c.shoot.Spec.Cloud.AWS.Zones, c.oldShoot.Spec.Cloud.AWS.Zones, idxPath)...)
 	}
 
 	for i, zone := range c.shoot.Spec.Cloud.AWS.Zones {
@@ -416,7 +619,9 @@ func validateAzure(c *validationContext) field.ErrorList {
 		if ok, validVolumeTypes := validateVolumeTypes(c.cloudProfile.Spec.Azure.Constraints.VolumeTypes, worker.VolumeType, oldWorker.VolumeType); !ok {
 			allErrs = append(allErrs, field.NotSupported(idxPath.Child("volumeType"), worker.VolumeType, validVolumeTypes))
 		}
+		allErrs = append(allErrs, validateMachineNameLength(worker.Worker, oldWorker.Worker, fmt.Sprintf("%s-%s", technicalID(c), worker.Name), azureMaxMachineNameLength, idxPath.Child("name"))...)
 	}
+	allErrs = append(allErrs, validateWorkersMaximum(c, azureMaxWorkerNodes, "Azure availability set", path.Child("workers"))...)
 
 	if ok := validateAzureDomainCount(c.cloudProfile.Spec.Azure.CountFaultDomains, c.shoot.Spec.Cloud.Region); !ok {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "cloud", "region"), c.shoot.Spec.Cloud.Region, "no fault domain count known for this region"))
@@ -462,6 +667,9 @@ func validateGCP(c *validationContext) field.ErrorList {
 		if ok, validVolumeTypes := validateVolumeTypes(c.cloudProfile.Spec.GCP.Constraints.VolumeTypes, worker.VolumeType, oldWorker.MachineType); !ok {
 			allErrs = append(allErrs, field.NotSupported(idxPath.Child("volumeType"), worker.VolumeType, validVolumeTypes))
 		}
+		allErrs = append(allErrs, validateFallbackMachineTypes(c.cloudProfile.Spec.GCP.Constraints.MachineTypes, worker.Worker, oldWorker.Worker, idxPath)...)
+		allErrs = append(allErrs, validateWorkerAvailabilityInZones(c.cloudProfile.Spec.GCP.Constraints.Zones, c.shoot.Spec.Cloud.Region, worker.Worker, oldWorker.Worker, worker.VolumeType, oldWorker.VolumeType, c.shoot.Spec.Cloud.GCP.Zones, c.oldShoot.Spec.Cloud.GCP.Zones, idxPath)...)
+		allErrs = append(allErrs, validateMachineNameLength(worker.Worker, oldWorker.Worker, fmt.Sprintf("%s-%s-z%d%s", technicalID(c), worker.Name, len(c.shoot.Spec.Cloud.GCP.Zones), longestMachineVariantSuffix(worker.Worker)), gcpMaxMachineNameLength, idxPath.Child("name"))...)
 	}
 
 	for i, zone := range c.shoot.Spec.Cloud.GCP.Zones {
@@ -512,6 +720,7 @@ func validatePacket(c *validationContext) field.ErrorList {
 		if ok, validVolumeTypes := validateVolumeTypes(c.cloudProfile.Spec.Packet.Constraints.VolumeTypes, worker.VolumeType, oldWorker.MachineType); !ok {
 			allErrs = append(allErrs, field.NotSupported(idxPath.Child("volumeType"), worker.VolumeType, validVolumeTypes))
 		}
+		allErrs = append(allErrs, validateWorkerAvailabilityInZones(c.cloudProfile.Spec.Packet.Constraints.Zones, c.shoot.Spec.Cloud.Region, worker.Worker, oldWorker.Worker, worker.VolumeType, oldWorker.VolumeType, c.shoot.Spec.Cloud.Packet.Zones, c.oldShoot.Spec.Cloud.Packet.Zones, idxPath)...)
 	}
 
 	for i, zone := range c.shoot.Spec.Cloud.Packet.Zones {
@@ -546,7 +755,7 @@ func validateOpenStack(c *validationContext) field.ErrorList {
 		allErrs = append(allErrs, field.NotSupported(field.NewPath("spec", "kubernetes", "version"), c.shoot.Spec.Kubernetes.Version, validKubernetesVersions))
 	}
 	if ok, validLoadBalancerProviders := validateLoadBalancerProviderConstraints(c.cloudProfile.Spec.OpenStack.Constraints.LoadBalancerProviders, c.shoot.Spec.Cloud.OpenStack.LoadBalancerProvider, c.oldShoot.Spec.Cloud.OpenStack.LoadBalancerProvider); !ok {
-		allErrs = append(allErrs, field.NotSupported(path.Child("floatingPoolName"), c.shoot.Spec.Cloud.OpenStack.LoadBalancerProvider, validLoadBalancerProviders))
+		allErrs = append(allErrs, field.NotSupported(path.Child("loadBalancerProvider"), c.shoot.Spec.Cloud.OpenStack.LoadBalancerProvider, validLoadBalancerProviders))
 	}
 	if ok, validMachineImages := validateOpenStackMachineImagesConstraints(c.cloudProfile.Spec.OpenStack.Constraints.MachineImages, c.shoot.Spec.Cloud.OpenStack.MachineImage, c.oldShoot.Spec.Cloud.OpenStack.MachineImage); !ok {
 		allErrs = append(allErrs, field.NotSupported(path.Child("machineImage"), *c.shoot.Spec.Cloud.OpenStack.MachineImage, validMachineImages))
@@ -565,6 +774,8 @@ func validateOpenStack(c *validationContext) field.ErrorList {
 		if ok, validMachineTypes := validateOpenStackMachineTypes(c.cloudProfile.Spec.OpenStack.Constraints.MachineTypes, worker.MachineType, oldWorker.MachineType); !ok {
 			allErrs = append(allErrs, field.NotSupported(idxPath.Child("machineType"), worker.MachineType, validMachineTypes))
 		}
+		allErrs = append(allErrs, validateWorkerAvailabilityInZones(c.cloudProfile.Spec.OpenStack.Constraints.Zones, c.shoot.Spec.Cloud.Region, worker.Worker, oldWorker.Worker, "", "", c.shoot.Spec.Cloud.OpenStack.Zones, c.oldShoot.Spec.Cloud.OpenStack.Zones, idxPath)...)
+		allErrs = append(allErrs, validateMachineNameLength(worker.Worker, oldWorker.Worker, fmt.Sprintf("%s-%s-z%d", technicalID(c), worker.Name, len(c.shoot.Spec.Cloud.OpenStack.Zones)), openStackMaxMachineNameLength, idxPath.Child("name"))...)
 	}
 
 	for i, zone := range c.shoot.Spec.Cloud.OpenStack.Zones {
@@ -621,6 +832,9 @@ func validateAlicloud(c *validationContext) field.ErrorList {
 		if ok, volumeType, validZones := validateAlicloudVolumeTypesAvailableInZones(c.cloudProfile.Spec.Alicloud.Constraints.VolumeTypes, worker.VolumeType, oldWorker.VolumeType, c.shoot.Spec.Cloud.Alicloud.Zones); !ok {
 			allErrs = append(allErrs, field.Invalid(idxPath.Child("volumeType"), worker.VolumeType, fmt.Sprintf("only zones %v define volume type %s", validZones, volumeType)))
 		}
+		allErrs = append(allErrs, validateFallbackMachineTypes(alicloudMachineTypes(c.cloudProfile.Spec.Alicloud.Constraints.MachineTypes), worker.Worker, oldWorker.Worker, idxPath)...)
+		allErrs = append(allErrs, validateWorkerAvailabilityInZones(c.cloudProfile.Spec.Alicloud.Constraints.Zones, c.shoot.Spec.Cloud.Region, worker.Worker, oldWorker.Worker, worker.VolumeType, oldWorker.VolumeType, c.shoot.Spec.Cloud.Alicloud.Zones, c.oldShoot.Spec.Cloud.Alicloud.Zones, idxPath)...)
+		allErrs = append(allErrs, validateMachineNameLength(worker.Worker, oldWorker.Worker, fmt.Sprintf("%s-%s-%s%s", technicalID(c), worker.Name, longestZoneName(c.shoot.Spec.Cloud.Alicloud.Zones), longestMachineVariantSuffix(worker.Worker)), alicloudMaxMachineNameLength, idxPath.Child("name"))...)
 	}
 
 	for i, zone := range c.shoot.Spec.Cloud.Alicloud.Zones {
@@ -758,6 +972,48 @@ func validateMachineTypes(constraints []garden.MachineType, machineType, oldMach
 	return false, validValues
 }
 
+// validateFallbackMachineTypes ensures that the fallback machine types of the worker pool are offered by the
+// CloudProfile. Fallback machine types which have already been used by the old worker pool remain allowed.
+func validateFallbackMachineTypes(constraints []garden.MachineType, worker, oldWorker garden.Worker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if worker.Capacity == nil {
+		return allErrs
+	}
+
+	oldFallbackMachineTypes := sets.NewString()
+	if oldWorker.Capacity != nil {
+		oldFallbackMachineTypes.Insert(oldWorker.Capacity.FallbackMachineTypes...)
+	}
+
+	for i, machineType := range worker.Capacity.FallbackMachineTypes {
+		if oldFallbackMachineTypes.Has(machineType) {
+			continue
+		}
+		if ok, validMachineTypes := validateMachineTypes(constraints, machineType, ""); !ok {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("capacity", "fallbackMachineTypes").Index(i), machineType, validMachineTypes))
+		}
+	}
+
+	return allErrs
+}
+
+// validateWorkerArchitecture ensures that the architecture of the worker pool matches the one of its machine type.
+func validateWorkerArchitecture(constraints []garden.MachineType, worker garden.Worker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, t := range constraints {
+		if t.Name != worker.MachineType {
+			continue
+		}
+		if workerArch, machineTypeArch := helper.WorkerArchitecture(worker), helper.MachineTypeArchitecture(t); workerArch != machineTypeArch {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("architecture"), workerArch, fmt.Sprintf("machine type %s has architecture %s", t.Name, machineTypeArch)))
+		}
+	}
+
+	return allErrs
+}
+
 func validateOpenStackMachineTypes(constraints []garden.OpenStackMachineType, machineType, oldMachineType string) (bool, []string) {
 	machineTypes := []garden.MachineType{}
 	for _, t := range constraints {
@@ -768,12 +1024,15 @@ func validateOpenStackMachineTypes(constraints []garden.OpenStackMachineType, ma
 }
 
 func validateAlicloudMachineTypes(constraints []garden.AlicloudMachineType, machineType, oldMachineType string) (bool, []string) {
+	return validateMachineTypes(alicloudMachineTypes(constraints), machineType, oldMachineType)
+}
+
+func alicloudMachineTypes(constraints []garden.AlicloudMachineType) []garden.MachineType {
 	machineTypes := []garden.MachineType{}
 	for _, t := range constraints {
 		machineTypes = append(machineTypes, t.MachineType)
 	}
-
-	return validateMachineTypes(machineTypes, machineType, oldMachineType)
+	return machineTypes
 }
 
 // To check whether machine type of worker is available in zones of the shoot,
@@ -882,6 +1141,57 @@ func validateZones(constraints []garden.Zone, region, zone string) (bool, []stri
 	return false, validValues
 }
 
+// validateWorkerAvailabilityInZones checks that neither the machine type nor the volume type of the worker pool is
+// declared as unavailable in one of the zones the worker pool is created in. Already existing combinations are not
+// validated again.
+func validateWorkerAvailabilityInZones(constraints []garden.Zone, region string, worker, oldWorker garden.Worker, volumeType, oldVolumeType string, zones, oldZones []string, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs      = field.ErrorList{}
+		workerZones  = getWorkerZones(worker, zones)
+		zonesChanged = !apiequality.Semantic.DeepEqual(workerZones, getWorkerZones(oldWorker, oldZones))
+	)
+
+	if zonesChanged || worker.MachineType != oldWorker.MachineType {
+		if unavailable := unavailableZones(constraints, region, workerZones, worker.MachineType, func(z garden.Zone) []string { return z.UnavailableMachineTypes }); len(unavailable) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("machineType"), worker.MachineType, fmt.Sprintf("machine type is not available in zones %v", unavailable)))
+		}
+	}
+
+	if len(volumeType) > 0 && (zonesChanged || volumeType != oldVolumeType) {
+		if unavailable := unavailableZones(constraints, region, workerZones, volumeType, func(z garden.Zone) []string { return z.UnavailableVolumeTypes }); len(unavailable) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("volumeType"), volumeType, fmt.Sprintf("volume type is not available in zones %v", unavailable)))
+		}
+	}
+
+	return allErrs
+}
+
+// getWorkerZones returns the zones of the worker pool, i.e. the Shoot's zones unless the pool selects a subset.
+func getWorkerZones(worker garden.Worker, zones []string) []string {
+	if len(worker.Zones) > 0 {
+		return worker.Zones
+	}
+	return zones
+}
+
+// unavailableZones returns those of the given zones in the region for which <name> is listed as unavailable.
+func unavailableZones(constraints []garden.Zone, region string, zones []string, name string, unavailableNames func(garden.Zone) []string) []string {
+	var result []string
+
+	for _, z := range constraints {
+		if z.Region != region || !utils.ValueExists(name, unavailableNames(z)) {
+			continue
+		}
+		for _, n := range z.Names {
+			if utils.ValueExists(n, zones) {
+				result = append(result, n)
+			}
+		}
+	}
+
+	return result
+}
+
 func validateAzureDomainCount(count []garden.AzureDomainCount, region string) bool {
 	for _, c := range count {
 		if c.Region == region {
@@ -938,7 +1248,7 @@ func getAWSMachineImage(shoot *garden.Shoot, cloudProfile *garden.CloudProfile)
 
 func findAWSMachineImageForRegion(machineImageMapping garden.AWSMachineImageMapping, region string) (*garden.AWSMachineImage, error) {
 	for _, regionalMachineImage := range machineImageMapping.Regions {
-		if regionalMachineImage.Name == region {
+		if regionalMachineImage.Name == region && helper.IsAWSRegionalMachineImageForArchitecture(regionalMachineImage, garden.ArchitectureAMD64) {
 			return &garden.AWSMachineImage{
 				Name: machineImageMapping.Name,
 				AMI:  regionalMachineImage.AMI,
@@ -948,6 +1258,20 @@ func findAWSMachineImageForRegion(machineImageMapping garden.AWSMachineImageMapp
 	return nil, fmt.Errorf("could not find an AMI for region %s and machine image %s", region, machineImageMapping.Name)
 }
 
+func awsMachineImageExistsForArchitecture(machineImageMappings []garden.AWSMachineImageMapping, name garden.MachineImageName, region, arch string) bool {
+	for _, machineImageMapping := range machineImageMappings {
+		if machineImageMapping.Name != name {
+			continue
+		}
+		for _, regionalMachineImage := range machineImageMapping.Regions {
+			if regionalMachineImage.Name == region && helper.IsAWSRegionalMachineImageForArchitecture(regionalMachineImage, arch) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func validateAWSMachineImagesConstraints(constraints []garden.AWSMachineImageMapping, region string, image, oldImage *garden.AWSMachineImage) (bool, []string) {
 	if apiequality.Semantic.DeepEqual(*image, *oldImage) {
 		return true, nil
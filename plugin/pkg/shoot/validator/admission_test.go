@@ -20,7 +20,9 @@ import (
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/apis/garden"
+	apiserverfeatures "github.com/gardener/gardener/pkg/apiserver/features"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	"github.com/gardener/gardener/pkg/features"
 	. "github.com/gardener/gardener/plugin/pkg/shoot/validator"
 
 	corev1 "k8s.io/api/core/v1"
@@ -580,6 +582,76 @@ var _ = Describe("validator", func() {
 				Expect(apierrors.IsForbidden(err)).To(BeTrue())
 			})
 
+			Context("worker architecture", func() {
+				var arm64 = garden.ArchitectureARM64
+
+				BeforeEach(func() {
+					cloudProfile.Spec.AWS = awsProfile.DeepCopy()
+					cloudProfile.Spec.AWS.Constraints.MachineTypes = append(cloudProfile.Spec.AWS.Constraints.MachineTypes, garden.MachineType{
+						Name:         "machine-type-arm64",
+						CPU:          resource.MustParse("2"),
+						GPU:          resource.MustParse("0"),
+						Memory:       resource.MustParse("100Gi"),
+						Architecture: &arm64,
+					})
+					cloudProfile.Spec.AWS.Constraints.MachineImages[0].Regions = append(cloudProfile.Spec.AWS.Constraints.MachineImages[0].Regions, garden.AWSRegionalMachineImage{
+						Name:         "europe",
+						AMI:          "ami-87654321",
+						Architecture: &arm64,
+					})
+
+					shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+						{
+							Worker: garden.Worker{
+								MachineType:  "machine-type-arm64",
+								Architecture: &arm64,
+							},
+						},
+					}
+				})
+
+				It("should not reject arm64 workers with an arm64 machine type and machine image", func() {
+					gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+					gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+					gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+					err := admissionHandler.Admit(attrs, nil)
+
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should reject arm64 workers with an amd64 machine type", func() {
+					shoot.Spec.Cloud.AWS.Workers[0].MachineType = "machine-type-1"
+
+					gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+					gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+					gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+					err := admissionHandler.Admit(attrs, nil)
+
+					Expect(err).To(HaveOccurred())
+					Expect(apierrors.IsForbidden(err)).To(BeTrue())
+					Expect(err.Error()).To(ContainSubstring("machine type machine-type-1 has architecture amd64"))
+				})
+
+				It("should reject arm64 workers if the machine image is not available for arm64", func() {
+					cloudProfile.Spec.AWS.Constraints.MachineImages[0].Regions = cloudProfile.Spec.AWS.Constraints.MachineImages[0].Regions[:1]
+
+					gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+					gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+					gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+					err := admissionHandler.Admit(attrs, nil)
+
+					Expect(err).To(HaveOccurred())
+					Expect(apierrors.IsForbidden(err)).To(BeTrue())
+					Expect(err.Error()).To(ContainSubstring("is not available for this architecture"))
+				})
+			})
+
 			It("should reject due to an invalid machine type", func() {
 				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
 					{
@@ -635,6 +707,120 @@ var _ = Describe("validator", func() {
 				Expect(apierrors.IsForbidden(err)).To(BeTrue())
 			})
 
+			It("should reject due to a machine type which is unavailable in a zone", func() {
+				cloudProfile.Spec.AWS = awsProfile.DeepCopy()
+				cloudProfile.Spec.AWS.Constraints.Zones[0].UnavailableMachineTypes = []string{"machine-type-1"}
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("machine type is not available in zones [europe-a]"))
+			})
+
+			It("should reject due to a volume type which is unavailable in a zone", func() {
+				cloudProfile.Spec.AWS = awsProfile.DeepCopy()
+				cloudProfile.Spec.AWS.Constraints.Zones[0].UnavailableVolumeTypes = []string{"volume-type-1"}
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("volume type is not available in zones [europe-a]"))
+			})
+
+			It("should not reject an unchanged worker pool whose machine type became unavailable", func() {
+				cloudProfile.Spec.AWS = awsProfile.DeepCopy()
+				cloudProfile.Spec.AWS.Constraints.Zones[0].UnavailableMachineTypes = []string{"machine-type-1"}
+				oldShoot := shoot.DeepCopy()
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("custom machine images", func() {
+				var customImage = "ami-0123456789"
+
+				BeforeEach(func() {
+					shoot.Spec.Cloud.AWS.Workers[0].CustomMachineImage = &customImage
+					gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+					gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				})
+
+				It("should reject a custom machine image if the feature gate is disabled", func() {
+					Expect(apiserverfeatures.FeatureGate.Set(fmt.Sprintf("%s=false", features.CustomMachineImages))).To(Succeed())
+					cloudProfile.Spec.CustomMachineImages = &garden.CustomMachineImages{Projects: []string{projectName}}
+
+					gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+					err := admissionHandler.Admit(attrs, nil)
+
+					Expect(err).To(HaveOccurred())
+					Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				})
+
+				Context("feature gate enabled", func() {
+					BeforeEach(func() {
+						Expect(apiserverfeatures.FeatureGate.Set(fmt.Sprintf("%s=true", features.CustomMachineImages))).To(Succeed())
+					})
+
+					AfterEach(func() {
+						Expect(apiserverfeatures.FeatureGate.Set(fmt.Sprintf("%s=false", features.CustomMachineImages))).To(Succeed())
+					})
+
+					It("should reject a custom machine image if the project is not allowed by the cloud profile", func() {
+						cloudProfile.Spec.CustomMachineImages = &garden.CustomMachineImages{Projects: []string{"other-project"}}
+
+						gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+						attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+						err := admissionHandler.Admit(attrs, nil)
+
+						Expect(err).To(HaveOccurred())
+						Expect(apierrors.IsForbidden(err)).To(BeTrue())
+					})
+
+					It("should allow a custom machine image if the project is allowed by the cloud profile", func() {
+						cloudProfile.Spec.CustomMachineImages = &garden.CustomMachineImages{Projects: []string{projectName}}
+
+						gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+						attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+						err := admissionHandler.Admit(attrs, nil)
+
+						Expect(err).NotTo(HaveOccurred())
+					})
+				})
+
+				It("should not reject an unchanged custom machine image", func() {
+					oldShoot := shoot.DeepCopy()
+
+					gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+
+					err := admissionHandler.Admit(attrs, nil)
+
+					Expect(err).NotTo(HaveOccurred())
+				})
+			})
+
 			It("should reject due to an invalid region where no machine image has been specified", func() {
 				shoot.Spec.Cloud.Region = "asia"
 				shoot.Spec.Cloud.AWS.Zones = []string{"asia-a"}
@@ -878,6 +1064,40 @@ var _ = Describe("validator", func() {
 				Expect(apierrors.IsForbidden(err)).To(BeTrue())
 			})
 
+			It("should reject worker pools exceeding the size of an availability set", func() {
+				shoot.Spec.Cloud.Azure.Workers = []garden.AzureWorker{workers[0], workers[0]}
+				shoot.Spec.Cloud.Azure.Workers[1].Name = "big"
+				shoot.Spec.Cloud.Azure.Workers[1].AutoScalerMax = 200
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("may scale up to 201 nodes in total but an Azure availability set supports at most 200 nodes"))
+			})
+
+			It("should not reject existing worker pools exceeding the size of an availability set", func() {
+				shoot.Spec.Cloud.Azure.Workers = []garden.AzureWorker{workers[0], workers[0]}
+				shoot.Spec.Cloud.Azure.Workers[1].Name = "big"
+				shoot.Spec.Cloud.Azure.Workers[1].AutoScalerMax = 200
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Cloud.Azure.Workers[1].AutoScalerMin = 2
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
 			It("should reject due to an invalid region where no update domain count has been specified", func() {
 				shoot.Spec.Cloud.Region = "australia"
 
@@ -1092,6 +1312,22 @@ var _ = Describe("validator", func() {
 				Expect(apierrors.IsForbidden(err)).To(BeTrue())
 			})
 
+			It("should reject due to an invalid fallback machine type", func() {
+				shoot.Spec.Cloud.GCP.Workers[0].Capacity = &garden.WorkerCapacity{
+					FallbackMachineTypes: []string{"not-allowed"},
+				}
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
 			It("should reject due to an invalid zone", func() {
 				shoot.Spec.Cloud.GCP.Zones = []string{"invalid-zone"}
 
@@ -1105,6 +1341,63 @@ var _ = Describe("validator", func() {
 				Expect(err).To(HaveOccurred())
 				Expect(apierrors.IsForbidden(err)).To(BeTrue())
 			})
+
+			It("should reject a new worker pool whose machine names would be too long", func() {
+				shoot.Status.TechnicalID = "shoot--my-long-project--my-long-shoot-name"
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("spec.cloud.gcp.workers[0].name"))
+			})
+
+			It("should not revalidate the machine names of existing worker pools", func() {
+				shoot.Status.TechnicalID = "shoot--my-long-project--my-long-shoot-name"
+				oldShoot := shoot.DeepCopy()
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject worker pools exceeding the capacity of the worker networks and report all errors", func() {
+				shoot.Spec.Cloud.GCP.Networks.Workers = []gardencore.CIDR{"10.250.0.0/29"}
+				shoot.Spec.Cloud.GCP.Workers = []garden.GCPWorker{
+					{
+						Worker: garden.Worker{
+							Name:          "worker-name",
+							MachineType:   "machine-type-1",
+							AutoScalerMin: 1,
+							AutoScalerMax: 5,
+						},
+						VolumeSize: "10Gi",
+						VolumeType: "not-allowed",
+					},
+				}
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("offer only 4 node addresses but the worker pools may scale up to 5 nodes"))
+				Expect(err.Error()).To(ContainSubstring("spec.cloud.gcp.workers[0].volumeType"))
+			})
 		})
 
 		Context("tests for Packet cloud", func() {
@@ -1534,6 +1827,21 @@ var _ = Describe("validator", func() {
 				Expect(apierrors.IsForbidden(err)).To(BeTrue())
 			})
 
+			It("should reject a new worker pool whose machine names would be too long", func() {
+				shoot.Status.TechnicalID = "shoot--my-long-project--my-long-shoot-name"
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("spec.cloud.openstack.workers[0].name"))
+			})
+
 			It("should reject due to an invalid zone", func() {
 				shoot.Spec.Cloud.OpenStack.Zones = []string{"invalid-zone"}
 
@@ -1758,6 +2066,21 @@ var _ = Describe("validator", func() {
 				Expect(apierrors.IsForbidden(err)).To(BeTrue())
 			})
 
+			It("should reject a new worker pool whose machine names would be too long", func() {
+				shoot.Status.TechnicalID = "shoot--my-long-project--my-long-shoot-name"
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("spec.cloud.alicloud.workers[0].name"))
+			})
+
 			It("should reject due to an invalid zone", func() {
 				shoot.Spec.Cloud.Alicloud.Zones = []string{"invalid-zone"}
 
@@ -15,6 +15,8 @@
 package validator_test
 
 import (
+	apiserverfeatures "github.com/gardener/gardener/pkg/apiserver/features"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -22,6 +24,7 @@ import (
 )
 
 func TestValidator(t *testing.T) {
+	apiserverfeatures.RegisterFeatureGates()
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Admission ShootValidator Suite")
 }
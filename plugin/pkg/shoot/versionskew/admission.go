@@ -0,0 +1,143 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versionskew
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	"github.com/gardener/gardener/pkg/operation/common"
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
+
+	"github.com/Masterminds/semver"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+)
+
+const (
+	// PluginName is the name of this admission plugin.
+	PluginName = "ShootVersionSkew"
+
+	// maxKubeletMinorVersionSkew is the maximum number of minor versions the kubelets may be older than the
+	// kube-apiserver, see https://kubernetes.io/docs/setup/version-skew-policy/.
+	maxKubeletMinorVersionSkew = 2
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// VersionSkew contains the authorizer and admission handler.
+type VersionSkew struct {
+	*admission.Handler
+	authorizer authorizer.Authorizer
+}
+
+var _ = admissioninitializer.WantsAuthorizer(&VersionSkew{})
+
+// New creates a new VersionSkew admission plugin.
+func New() (*VersionSkew, error) {
+	return &VersionSkew{
+		Handler: admission.NewHandler(admission.Update),
+	}, nil
+}
+
+// SetAuthorizer gets the authorizer.
+func (v *VersionSkew) SetAuthorizer(authorizer authorizer.Authorizer) {
+	v.authorizer = authorizer
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (v *VersionSkew) ValidateInitialization() error {
+	if v.authorizer == nil {
+		return errors.New("missing authorizer")
+	}
+	return nil
+}
+
+// Validate rejects Shoot updates which downgrade the Kubernetes version, skip a minor version or which would result
+// in kubelets that are more than two minor versions older than the kube-apiserver. Operators who are allowed to update
+// the garden namespace can bypass these checks by annotating the Shoot with 'shoot.garden.sapcloud.io/ignore-version-skew=true'
+// in their update request. The annotation is not honoured for requests of other users.
+func (v *VersionSkew) Validate(a admission.Attributes, o admission.ObjectInterfaces) error {
+	// Ignore all kinds other than Shoot
+	if a.GetKind().GroupKind() != garden.Kind("Shoot") {
+		return nil
+	}
+
+	// Ignore updates to subresources
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*garden.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+	oldShoot, ok := a.GetOldObject().(*garden.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert old resource into Shoot object")
+	}
+
+	if shoot.Spec.Kubernetes.Version == oldShoot.Spec.Kubernetes.Version || len(shoot.Spec.Kubernetes.Version) == 0 {
+		return nil
+	}
+	if shoot.Annotations[common.ShootIgnoreVersionSkew] == "true" && admissionutils.IsOperator(a, v.authorizer) {
+		return nil
+	}
+
+	if err := checkVersionSkew(shoot.Spec.Kubernetes.Version, oldShoot.Spec.Kubernetes.Version, oldShoot.Status.KubeletVersion); err != nil {
+		return admission.NewForbidden(a, err)
+	}
+	return nil
+}
+
+func checkVersionSkew(newVersion, oldVersion, kubeletVersion string) error {
+	newV, err := semver.NewVersion(newVersion)
+	if err != nil {
+		return fmt.Errorf("invalid kubernetes version %q: %v", newVersion, err)
+	}
+	oldV, err := semver.NewVersion(oldVersion)
+	if err != nil {
+		return fmt.Errorf("invalid kubernetes version %q: %v", oldVersion, err)
+	}
+
+	if newV.LessThan(oldV) {
+		return fmt.Errorf("kubernetes version downgrade from %s to %s is not supported", oldVersion, newVersion)
+	}
+	if newV.Major() != oldV.Major() || newV.Minor() > oldV.Minor()+1 {
+		return fmt.Errorf("kubernetes version upgrade from %s to %s cannot skip a minor version", oldVersion, newVersion)
+	}
+
+	if len(kubeletVersion) == 0 {
+		return nil
+	}
+	kubeletV, err := semver.NewVersion(kubeletVersion)
+	if err != nil {
+		return fmt.Errorf("invalid kubelet version %q: %v", kubeletVersion, err)
+	}
+	if newV.Major() != kubeletV.Major() || newV.Minor() > kubeletV.Minor()+maxKubeletMinorVersionSkew {
+		return fmt.Errorf("kubernetes version %s must not be more than %d minor versions newer than the version %s of the kubelets, wait until the previous upgrade has been completed", newVersion, maxKubeletMinorVersionSkew, kubeletVersion)
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versionskew_test
+
+import (
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/versionskew"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+)
+
+type fakeAuthorizerType struct{}
+
+func (fakeAuthorizerType) Authorize(a authorizer.Attributes) (authorizer.Decision, string, error) {
+	if a.GetUser().GetName() == "operator" && a.GetResource() == "namespaces" && a.GetName() == common.GardenNamespace && a.GetVerb() == "update" {
+		return authorizer.DecisionAllow, "", nil
+	}
+	return authorizer.DecisionDeny, "", nil
+}
+
+var _ = Describe("versionskew", func() {
+	Describe("#Validate", func() {
+		var (
+			admissionHandler *VersionSkew
+
+			oldShoot = garden.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot",
+					Namespace: "garden-dev",
+				},
+				Spec: garden.ShootSpec{
+					Kubernetes: garden.Kubernetes{
+						Version: "1.12.3",
+					},
+				},
+			}
+		)
+
+		BeforeEach(func() {
+			admissionHandler, _ = New()
+			admissionHandler.SetAuthorizer(fakeAuthorizerType{})
+		})
+
+		validateAs := func(userName, version, kubeletVersion string, annotations map[string]string) error {
+			old := oldShoot.DeepCopy()
+			old.Status.KubeletVersion = kubeletVersion
+			shoot := old.DeepCopy()
+			shoot.Annotations = annotations
+			shoot.Spec.Kubernetes.Version = version
+
+			attrs := admission.NewAttributesRecord(shoot, old, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, &user.DefaultInfo{Name: userName})
+			return admissionHandler.Validate(attrs, nil)
+		}
+
+		validate := func(version, kubeletVersion string, annotations map[string]string) error {
+			return validateAs("user", version, kubeletVersion, annotations)
+		}
+
+		DescribeTable("should allow the update",
+			func(version, kubeletVersion string) {
+				Expect(validate(version, kubeletVersion, nil)).To(Succeed())
+			},
+
+			Entry("unchanged version", "1.12.3", "1.10.1"),
+			Entry("patch upgrade", "1.12.5", "1.12.3"),
+			Entry("minor upgrade", "1.13.1", "1.12.3"),
+			Entry("minor upgrade with kubelets two minor versions older", "1.13.1", "1.11.5"),
+			Entry("minor upgrade without known kubelet version", "1.13.1", ""),
+		)
+
+		DescribeTable("should forbid the update",
+			func(version, kubeletVersion string) {
+				err := validate(version, kubeletVersion, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			},
+
+			Entry("patch downgrade", "1.12.2", "1.12.3"),
+			Entry("minor downgrade", "1.11.5", "1.12.3"),
+			Entry("upgrade skipping a minor version", "1.14.0", "1.12.3"),
+			Entry("major upgrade", "2.0.0", "1.12.3"),
+			Entry("minor upgrade with kubelets three minor versions older", "1.13.1", "1.10.1"),
+		)
+
+		It("should allow the update if an operator ignores the version skew", func() {
+			Expect(validateAs("operator", "1.11.5", "1.12.3", map[string]string{common.ShootIgnoreVersionSkew: "true"})).To(Succeed())
+		})
+
+		It("should forbid the update if another user ignores the version skew", func() {
+			err := validate("1.11.5", "1.12.3", map[string]string{common.ShootIgnoreVersionSkew: "true"})
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		})
+
+		It("should ignore other kinds than Shoot", func() {
+			project := garden.Project{}
+			attrs := admission.NewAttributesRecord(&project, &project, garden.Kind("Project").WithVersion("version"), "", "project", garden.Resource("projects").WithVersion("version"), "", admission.Update, false, nil)
+
+			Expect(admissionHandler.Validate(attrs, nil)).To(Succeed())
+		})
+	})
+
+	Describe("#ValidateInitialization", func() {
+		It("should return an error if the authorizer is missing", func() {
+			admissionHandler, _ := New()
+
+			Expect(admissionHandler.ValidateInitialization()).To(HaveOccurred())
+		})
+	})
+
+	Describe("#New", func() {
+		It("should only handle UPDATE operations", func() {
+			admissionHandler, err := New()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admissionHandler.Handles(admission.Create)).To(BeFalse())
+			Expect(admissionHandler.Handles(admission.Update)).To(BeTrue())
+			Expect(admissionHandler.Handles(admission.Delete)).To(BeFalse())
+		})
+	})
+})
@@ -19,10 +19,12 @@ import (
 
 	"github.com/gardener/gardener/pkg/apis/garden"
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
+	"github.com/gardener/gardener/pkg/operation/common"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
 )
 
 // SkipVerification is a common function to skip object verification during admission
@@ -43,3 +45,19 @@ func GetProject(namespace string, projectLister gardenlisters.ProjectLister) (*g
 	}
 	return nil, fmt.Errorf("no project found for namespace %q", namespace)
 }
+
+// IsOperator returns true if the user of the given admission request is allowed to update the garden namespace, i.e.
+// if the user is a Gardener operator.
+func IsOperator(a admission.Attributes, authz authorizer.Authorizer) bool {
+	attributes := authorizer.AttributesRecord{
+		User:            a.GetUserInfo(),
+		Verb:            "update",
+		APIGroup:        "",
+		APIVersion:      "v1",
+		Resource:        "namespaces",
+		Name:            common.GardenNamespace,
+		ResourceRequest: true,
+	}
+	decision, _, _ := authz.Authorize(attributes)
+	return decision == authorizer.DecisionAllow
+}
@@ -19,11 +19,10 @@ import (
 	"fmt"
 	"time"
 
-	"k8s.io/client-go/util/retry"
-
 	"k8s.io/api/core/v1"
 
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -83,17 +82,11 @@ func (s *PlantTest) CreatePlantSecret(ctx context.Context, kubeConfigContent []b
 
 // UpdatePlantSecret updates the Secret of the Plant
 func (s *PlantTest) UpdatePlantSecret(ctx context.Context, updatedPlantSecret *v1.Secret) error {
-	if err := retry.RetryOnConflict(retry.DefaultBackoff, func() (err error) {
-		existingSecret := &v1.Secret{}
-		if err = s.GardenClient.Client().Get(ctx, client.ObjectKey{Namespace: updatedPlantSecret.Namespace, Name: updatedPlantSecret.Name}, existingSecret); err != nil {
-			return err
-		}
+	existingSecret := &v1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: updatedPlantSecret.Namespace, Name: updatedPlantSecret.Name}}
+	return kutil.TryUpdate(ctx, kutil.DefaultBackoff, s.GardenClient.Client(), existingSecret, func() error {
 		existingSecret.Data = updatedPlantSecret.Data
-		return s.GardenClient.Client().Update(ctx, existingSecret)
-	}); err != nil {
-		return err
-	}
-	return nil
+		return nil
+	})
 }
 
 // GetPlantSecret retrieves the Secret of the Plant. Returns the Secret.